@@ -25,395 +25,436 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("pb/protos/service/service.proto", fileDescriptor_93a29dc403579097) }
 
 var fileDescriptor_93a29dc403579097 = []byte{
-	// 6195 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x9d, 0x5f, 0x6f, 0x24, 0x49,
-	0x52, 0xc0, 0xcf, 0x3c, 0x70, 0xd0, 0xc7, 0x1d, 0xd0, 0x0b, 0xcb, 0xdd, 0x72, 0x37, 0xff, 0x67,
-	0xec, 0x19, 0xdb, 0xe5, 0x59, 0xcf, 0xce, 0xee, 0x72, 0x87, 0x04, 0x3d, 0xf6, 0xd8, 0xdb, 0xb7,
-	0xf6, 0xac, 0x71, 0xdb, 0x33, 0x62, 0x25, 0x24, 0x6a, 0xba, 0xd2, 0xdd, 0x85, 0xab, 0x2b, 0xeb,
-	0xaa, 0xb2, 0x3d, 0xd3, 0x87, 0x40, 0x20, 0x10, 0x08, 0x04, 0xe2, 0x04, 0x1c, 0x82, 0x27, 0x24,
-	0x3e, 0x01, 0x7c, 0x0b, 0x1e, 0xef, 0x91, 0x47, 0xb4, 0xfb, 0x45, 0x50, 0x65, 0x66, 0xe5, 0x9f,
-	0xa8, 0x88, 0xac, 0xf2, 0xf2, 0x34, 0x23, 0xc7, 0x2f, 0x22, 0xf2, 0x6f, 0x64, 0x46, 0x56, 0x56,
-	0xf5, 0xe0, 0x66, 0xf1, 0x7a, 0xa7, 0x28, 0xb9, 0xe0, 0xd5, 0x4e, 0xc5, 0xca, 0xab, 0x74, 0xca,
-	0x9a, 0x7f, 0x23, 0xf9, 0xe7, 0xe1, 0xd7, 0xe3, 0x7c, 0x25, 0x56, 0x05, 0x7b, 0xef, 0xdb, 0x96,
-	0x9c, 0xf2, 0xc5, 0x22, 0xce, 0x93, 0x4a, 0x21, 0xef, 0xbd, 0x6b, 0x25, 0xec, 0x8a, 0xe5, 0x42,
-	0xff, 0x7d, 0xf7, 0xbf, 0x7e, 0xfa, 0x73, 0x83, 0x6f, 0xed, 0x65, 0x29, 0xcb, 0xc5, 0x9e, 0xd6,
-	0x18, 0x7e, 0x3e, 0xf8, 0xe6, 0xa8, 0x28, 0x0e, 0x99, 0x78, 0xc9, 0xca, 0x2a, 0xe5, 0xf9, 0xf0,
-	0x6e, 0xa4, 0x1d, 0x44, 0xa7, 0xc5, 0x34, 0x1a, 0x15, 0x45, 0x64, 0x85, 0xd1, 0x29, 0xfb, 0xd1,
-	0x92, 0x55, 0xe2, 0xbd, 0x7b, 0x61, 0xa8, 0x2a, 0x78, 0x5e, 0xb1, 0xe1, 0xc5, 0xe0, 0x57, 0x47,
-	0x45, 0x31, 0x61, 0x62, 0x9f, 0xd5, 0x15, 0x98, 0x88, 0x58, 0xb0, 0xe1, 0x7a, 0x4b, 0xd5, 0x07,
-	0x8c, 0x8f, 0x8d, 0x6e, 0x50, 0xfb, 0x39, 0x1b, 0x7c, 0xa3, 0xf6, 0x33, 0x5f, 0x8a, 0x84, 0xbf,
-	0xc9, 0x87, 0xb7, 0xdb, 0x8a, 0x5a, 0x64, 0x6c, 0xdf, 0x09, 0x21, 0xda, 0xea, 0xab, 0xc1, 0x2f,
-	0xbd, 0x8a, 0xb3, 0x8c, 0x89, 0xbd, 0x92, 0xd5, 0x05, 0xf7, 0x75, 0x94, 0x28, 0x52, 0x32, 0x63,
-	0xf7, 0x6e, 0x90, 0xd1, 0x86, 0x3f, 0x1f, 0x7c, 0x53, 0x49, 0x4e, 0xd9, 0x94, 0x5f, 0xb1, 0x72,
-	0x88, 0x6a, 0x69, 0x21, 0xd1, 0xe4, 0x2d, 0x08, 0xda, 0xde, 0xe3, 0xf9, 0x15, 0x2b, 0x05, 0x6e,
-	0x5b, 0x0b, 0xc3, 0xb6, 0x2d, 0xa4, 0x6d, 0xff, 0xcd, 0xda, 0xe0, 0xbb, 0xa3, 0xe9, 0x94, 0x2f,
-	0x73, 0x71, 0xc4, 0xa7, 0x71, 0x76, 0x94, 0xe6, 0x97, 0x2f, 0xd8, 0x9b, 0xbd, 0x79, 0xcd, 0xe7,
-	0x33, 0x36, 0x7c, 0xe2, 0xb7, 0xaa, 0x42, 0x23, 0xc3, 0x46, 0x2e, 0x6c, 0x7c, 0x7f, 0x70, 0x3d,
-	0x25, 0x5d, 0x96, 0x7f, 0x58, 0x1b, 0xdc, 0x80, 0x65, 0x99, 0xf0, 0xec, 0x8a, 0xd9, 0xd2, 0x3c,
-	0xed, 0x30, 0xec, 0xe3, 0xa6, 0x3c, 0x1f, 0x5e, 0x57, 0x4d, 0x97, 0xe8, 0xcf, 0xd6, 0x06, 0xdf,
-	0x81, 0x25, 0x52, 0x3d, 0x3f, 0x2a, 0x8a, 0xe1, 0xe3, 0x0e, 0xab, 0x86, 0x34, 0xe5, 0x78, 0xff,
-	0x1a, 0x1a, 0xba, 0x08, 0x7f, 0x32, 0xf8, 0x36, 0x2c, 0xc1, 0x51, 0x5a, 0x89, 0x51, 0x51, 0x54,
-	0xc3, 0x9d, 0x0e, 0x73, 0x0d, 0x68, 0xfc, 0x3f, 0xee, 0xaf, 0x10, 0x68, 0x81, 0x53, 0x76, 0xc5,
-	0x2f, 0x7b, 0xb5, 0x80, 0x21, 0x7b, 0xb7, 0x80, 0xab, 0xa1, 0x8b, 0x90, 0x0d, 0xde, 0x71, 0xe7,
-	0xec, 0x84, 0x55, 0x32, 0xa6, 0x3d, 0xa4, 0xa7, 0xa5, 0x46, 0x8c, 0xd3, 0x47, 0x7d, 0x50, 0xed,
-	0x2d, 0x1d, 0x0c, 0xb5, 0xb7, 0x8c, 0x57, 0xc6, 0xd9, 0x06, 0x6a, 0xc1, 0x21, 0x8c, 0xaf, 0x87,
-	0x3d, 0x48, 0xed, 0xea, 0x0f, 0x07, 0xbf, 0xfc, 0x8a, 0x97, 0x97, 0x55, 0x11, 0x4f, 0x99, 0x8e,
-	0x47, 0xf7, 0x7d, 0xed, 0x46, 0x0a, 0x43, 0xd2, 0x83, 0x2e, 0xcc, 0x89, 0x1c, 0x8d, 0xf0, 0xb3,
-	0x82, 0xc1, 0x85, 0xc0, 0x2a, 0xd6, 0x42, 0x2a, 0x72, 0x40, 0x48, 0xdb, 0xbe, 0x1c, 0x0c, 0xad,
-	0xed, 0xd7, 0x7f, 0xc4, 0xa6, 0x62, 0x94, 0x24, 0xb0, 0x57, 0xac, 0xae, 0x24, 0xa2, 0x51, 0x92,
-	0x50, 0xbd, 0x82, 0xa3, 0xda, 0xd9, 0x9b, 0xc1, 0xbb, 0xc0, 0x99, 0x1c, 0xaa, 0x49, 0x32, 0xdc,
-	0x0e, 0x5b, 0xd1, 0x98, 0x71, 0x1a, 0xf5, 0xc5, 0x9d, 0xf1, 0x8f, 0x78, 0x3e, 0x65, 0x0b, 0x7e,
-	0xc5, 0xc0, 0xf8, 0x47, 0xad, 0x29, 0x92, 0x18, 0xff, 0x61, 0x0d, 0x64, 0x98, 0x4c, 0x58, 0xc6,
-	0xa6, 0x82, 0x1c, 0x26, 0x4a, 0xdc, 0x39, 0x4c, 0x0c, 0xe6, 0xcc, 0xb0, 0x46, 0x78, 0xc8, 0xc4,
-	0xde, 0xb2, 0x2c, 0x59, 0x2e, 0xc8, 0xbe, 0xb4, 0x48, 0x67, 0x5f, 0x7a, 0x28, 0x52, 0x9f, 0x43,
-	0x26, 0x46, 0x59, 0x46, 0xd6, 0x47, 0x89, 0x3b, 0xeb, 0x63, 0x30, 0xed, 0x61, 0x3a, 0xf8, 0x15,
-	0xa7, 0xc5, 0xc4, 0x38, 0xbf, 0xe0, 0x43, 0xba, 0x2d, 0xa4, 0xdc, 0xf8, 0x58, 0xef, 0xe4, 0x90,
-	0x6a, 0x3c, 0x7f, 0x5b, 0xf0, 0x92, 0xee, 0x16, 0x25, 0xee, 0xac, 0x86, 0xc1, 0xb4, 0x87, 0x3f,
-	0x18, 0x7c, 0x4b, 0x07, 0xc8, 0x66, 0x53, 0x71, 0x0f, 0x8d, 0x9e, 0x70, 0x57, 0x71, 0xbf, 0x83,
-	0x6a, 0x99, 0x3f, 0x4e, 0x67, 0x65, 0x1d, 0x7d, 0x70, 0xf3, 0x5a, 0xda, 0x61, 0xde, 0x52, 0xda,
-	0x3c, 0x1f, 0xfc, 0x9a, 0x6f, 0x7e, 0x2f, 0xce, 0xa7, 0x2c, 0x1b, 0x3e, 0x0a, 0xa9, 0x2b, 0xc6,
-	0xb8, 0xda, 0xec, 0xc5, 0xda, 0x60, 0xa7, 0x09, 0x1d, 0x4c, 0xef, 0xa2, 0xda, 0x20, 0x94, 0xde,
-	0x0b, 0x43, 0x2d, 0xdb, 0xfb, 0x2c, 0x63, 0xa4, 0x6d, 0x25, 0xec, 0xb0, 0x6d, 0x20, 0x6d, 0xbb,
-	0x1c, 0xfc, 0xba, 0xe9, 0xe6, 0x7a, 0x73, 0x26, 0xe5, 0xf5, 0xa2, 0xb3, 0x49, 0xf4, 0xa3, 0x0b,
-	0x19, 0x5f, 0x5b, 0xfd, 0xe0, 0x56, 0x7d, 0x74, 0x44, 0xc1, 0xeb, 0x03, 0xe2, 0xc9, 0xbd, 0x30,
-	0xa4, 0x6d, 0xff, 0xed, 0xda, 0xe0, 0x7b, 0x5a, 0xf6, 0x3c, 0x8f, 0x5f, 0x67, 0x4c, 0xae, 0xee,
-	0x2f, 0x98, 0x78, 0xc3, 0xcb, 0xcb, 0xc9, 0x2a, 0x9f, 0x12, 0x7b, 0x4a, 0x1c, 0xee, 0xd8, 0x53,
-	0x92, 0x4a, 0xba, 0x30, 0x7f, 0x6c, 0xb6, 0x4f, 0x7b, 0xf3, 0x38, 0x9f, 0xb1, 0x1f, 0x56, 0x3c,
-	0x1f, 0x15, 0xe9, 0x28, 0x49, 0xca, 0x61, 0x84, 0x77, 0x3d, 0xe4, 0x4c, 0x09, 0x76, 0x7a, 0xf3,
-	0x4e, 0x0e, 0xa3, 0x5b, 0x59, 0xf0, 0x02, 0xe6, 0x30, 0x4d, 0xf3, 0x09, 0x5e, 0x50, 0x39, 0x8c,
-	0x8f, 0xb4, 0xac, 0x1e, 0xd7, 0x6b, 0x10, 0x6e, 0xf5, 0xd8, 0x5d, 0x74, 0xee, 0x84, 0x10, 0xbb,
-	0x06, 0x34, 0x0d, 0xc5, 0xf3, 0x8b, 0x74, 0x76, 0x5e, 0x24, 0xf5, 0x1c, 0x7a, 0x88, 0xd7, 0xd9,
-	0x41, 0x88, 0x35, 0x80, 0x40, 0xb5, 0xb7, 0xbf, 0xb7, 0x5b, 0x7d, 0x1d, 0x97, 0x0e, 0x4a, 0xbe,
-	0x38, 0x62, 0xb3, 0x78, 0xba, 0xd2, 0xc1, 0xf4, 0x83, 0x50, 0x14, 0x83, 0xb4, 0x29, 0xc4, 0xd3,
-	0x6b, 0x6a, 0xe9, 0xf2, 0xfc, 0xfb, 0xda, 0xe0, 0x9e, 0x37, 0x4e, 0xf4, 0x60, 0x52, 0xa5, 0x1f,
-	0xe5, 0xc9, 0x29, 0xab, 0x44, 0x5c, 0x8a, 0xe1, 0xf7, 0x03, 0x63, 0x80, 0xd0, 0x31, 0x65, 0xfb,
-	0xc1, 0x57, 0xd2, 0xb5, 0xbd, 0x3e, 0xa9, 0x57, 0x09, 0x1d, 0x7f, 0xfc, 0x5e, 0x97, 0x12, 0x18,
-	0x7d, 0xee, 0x84, 0x10, 0xdb, 0xeb, 0x52, 0x30, 0xce, 0xaf, 0x52, 0xc1, 0x0e, 0x59, 0xce, 0xca,
-	0x76, 0xaf, 0x2b, 0x55, 0x1f, 0x21, 0x7a, 0x9d, 0x40, 0xed, 0xd9, 0x81, 0xe3, 0x4d, 0x55, 0x1c,
-	0x9c, 0x1d, 0xb8, 0x06, 0x14, 0x40, 0x9c, 0x1d, 0xa0, 0xa0, 0x8d, 0xa8, 0x5e, 0xad, 0xcc, 0x8e,
-	0x66, 0x33, 0x50, 0xd8, 0xd6, 0x9e, 0x66, 0xab, 0x1f, 0x4c, 0xb4, 0xa4, 0x38, 0xac, 0x8d, 0x04,
-	0x5b, 0x52, 0x21, 0xbd, 0x5a, 0xd2, 0xa0, 0x68, 0x4b, 0xaa, 0xa4, 0x29, 0xd0, 0x92, 0x0a, 0xe8,
-	0xd1, 0x92, 0x06, 0xb4, 0x9b, 0x1c, 0xc7, 0xcf, 0xcb, 0x94, 0xbd, 0x01, 0x9b, 0x1c, 0x57, 0xb9,
-	0x16, 0x13, 0x9b, 0x1c, 0x04, 0xd3, 0x1e, 0x5e, 0x0c, 0x7e, 0x51, 0x0a, 0x7f, 0xc8, 0xd3, 0x7c,
-	0x78, 0x13, 0x51, 0xaa, 0x05, 0xc6, 0xea, 0x2d, 0x1a, 0x00, 0x25, 0xae, 0xff, 0xaa, 0x77, 0x1c,
-	0xf7, 0x09, 0x25, 0xb0, 0xd9, 0x78, 0xd0, 0x85, 0xd9, 0xdd, 0xa5, 0x14, 0xd6, 0x51, 0x79, 0x32,
-	0x8f, 0xcb, 0x34, 0x9f, 0x0d, 0x31, 0x5d, 0x47, 0x4e, 0xec, 0x2e, 0x31, 0x0e, 0x0c, 0x27, 0xad,
-	0x38, 0x2a, 0x8a, 0xb2, 0x0e, 0xf6, 0xd8, 0x70, 0xf2, 0x91, 0xe0, 0x70, 0x6a, 0xa1, 0xb8, 0xb7,
-	0x7d, 0x36, 0xcd, 0xd2, 0x3c, 0xe8, 0x4d, 0x23, 0x7d, 0xbc, 0x59, 0x14, 0x0c, 0xde, 0x23, 0x16,
-	0x5f, 0xb1, 0xa6, 0x66, 0x58, 0xcb, 0xb8, 0x40, 0x70, 0xf0, 0x02, 0xd0, 0xa6, 0xf2, 0x52, 0x7c,
-	0x1c, 0x5f, 0xb2, 0xba, 0x81, 0x59, 0xbd, 0x55, 0x18, 0x62, 0xfa, 0x1e, 0x41, 0xa4, 0xf2, 0x38,
-	0xa9, 0x5d, 0x2d, 0x07, 0xef, 0x4a, 0xf9, 0x49, 0x5c, 0x8a, 0x74, 0x9a, 0x16, 0x71, 0xde, 0xa4,
-	0x88, 0x58, 0x14, 0x69, 0x51, 0xc6, 0xe5, 0x76, 0x4f, 0x5a, 0xbb, 0xfd, 0x97, 0xb5, 0xc1, 0x6d,
-	0xe8, 0xf7, 0x84, 0x95, 0x8b, 0x54, 0x9e, 0x34, 0x54, 0x3a, 0xc2, 0x7e, 0x14, 0x36, 0xda, 0x52,
-	0x30, 0xa5, 0xf9, 0xf8, 0xfa, 0x8a, 0x76, 0x7f, 0x39, 0xd1, 0xd9, 0xd7, 0x67, 0x65, 0xd2, 0x3a,
-	0x0e, 0x9d, 0x34, 0x29, 0x95, 0x14, 0x12, 0xfb, 0xcb, 0x16, 0x04, 0x66, 0xf8, 0x79, 0x5e, 0x35,
-	0xd6, 0xb1, 0x19, 0x6e, 0xc5, 0xc1, 0x19, 0xee, 0x61, 0x76, 0x86, 0x9f, 0x2c, 0x5f, 0x67, 0x69,
-	0x35, 0x4f, 0xf3, 0x99, 0x4e, 0x26, 0x7c, 0x5d, 0x2b, 0x86, 0xf9, 0xc4, 0x7a, 0x27, 0x87, 0x39,
-	0xd1, 0x83, 0x85, 0x74, 0x02, 0x86, 0xc9, 0x7a, 0x27, 0x67, 0x73, 0x3c, 0x2b, 0x3d, 0x4a, 0x2b,
-	0x01, 0x72, 0x3c, 0x47, 0xb5, 0x96, 0x12, 0x39, 0x5e, 0x9b, 0xb2, 0x39, 0x9e, 0x5b, 0x87, 0x8a,
-	0x67, 0x57, 0xec, 0xbc, 0x4c, 0x41, 0x8e, 0xe7, 0x95, 0xaf, 0x61, 0x88, 0x1c, 0x8f, 0x62, 0x6d,
-	0xa0, 0xb2, 0xc4, 0x21, 0x13, 0x13, 0x11, 0x8b, 0x65, 0x05, 0x02, 0x95, 0x63, 0xc3, 0x20, 0x44,
-	0xa0, 0x22, 0x50, 0xed, 0xed, 0xf7, 0x06, 0x03, 0x75, 0x2e, 0x23, 0xcf, 0xce, 0xfc, 0xb5, 0x47,
-	0x1f, 0xd8, 0x78, 0x07, 0x67, 0xb7, 0x03, 0x84, 0x9d, 0x18, 0xea, 0xef, 0xa7, 0xec, 0xa2, 0x64,
-	0xd5, 0x1c, 0x4c, 0x0c, 0xad, 0xa3, 0x85, 0xc4, 0xc4, 0x68, 0x41, 0x76, 0x8b, 0xa8, 0x44, 0xf2,
-	0xb8, 0x71, 0x88, 0x96, 0x46, 0x8a, 0x88, 0x2d, 0x22, 0x40, 0x60, 0x23, 0x4c, 0xe6, 0xfc, 0x0d,
-	0xde, 0x08, 0xb5, 0x24, 0xdc, 0x08, 0x9a, 0xb0, 0x4f, 0x61, 0x74, 0x41, 0xb1, 0xa7, 0x30, 0x4d,
-	0x31, 0x42, 0x4f, 0x61, 0x20, 0x63, 0xc7, 0xa3, 0x6b, 0xf8, 0x19, 0xe7, 0x97, 0x8b, 0xb8, 0xbc,
-	0x04, 0xe3, 0xd1, 0x53, 0x6e, 0x18, 0x62, 0x3c, 0x52, 0xac, 0x1d, 0x8f, 0xae, 0xc3, 0x3a, 0xc1,
-	0x38, 0x2f, 0x33, 0x30, 0x1e, 0x3d, 0x1b, 0x1a, 0x21, 0xc6, 0x23, 0x81, 0xda, 0xc8, 0xe7, 0x7a,
-	0x9b, 0x30, 0x78, 0xe4, 0xe4, 0xa9, 0x4f, 0x18, 0x75, 0xe4, 0x84, 0x60, 0x70, 0x08, 0x1d, 0x96,
-	0x71, 0x31, 0xc7, 0x87, 0x90, 0x14, 0x85, 0x87, 0x50, 0x83, 0xc0, 0xfe, 0x9e, 0xb0, 0xb8, 0x9c,
-	0xce, 0xf1, 0xfe, 0x56, 0xb2, 0x70, 0x7f, 0x1b, 0x06, 0xf6, 0xb7, 0x12, 0xbc, 0x4a, 0xc5, 0xfc,
-	0x98, 0x89, 0x18, 0xef, 0x6f, 0x9f, 0x09, 0xf7, 0x77, 0x8b, 0xb5, 0x99, 0x85, 0xeb, 0x70, 0xb2,
-	0x7c, 0x5d, 0x4d, 0xcb, 0xf4, 0x35, 0x1b, 0x06, 0xac, 0x18, 0x88, 0xc8, 0x2c, 0x48, 0x58, 0xfb,
-	0xfc, 0xc9, 0xda, 0xe0, 0x66, 0xd3, 0xed, 0xbc, 0xaa, 0xf4, 0xba, 0xea, 0xbb, 0x7f, 0x8a, 0xf7,
-	0x2f, 0x81, 0x13, 0xcf, 0xc5, 0x7a, 0xa8, 0x39, 0xfb, 0x0e, 0xbc, 0x48, 0xe7, 0x79, 0x65, 0x0a,
-	0xf5, 0x51, 0x1f, 0xeb, 0x8e, 0x02, 0xb1, 0xef, 0xe8, 0xa5, 0x68, 0xb7, 0x7c, 0xba, 0x7f, 0x1a,
-	0xd9, 0x38, 0xa9, 0xc0, 0x96, 0xaf, 0x69, 0x6f, 0x87, 0x20, 0xb6, 0x7c, 0x38, 0x09, 0x87, 0xc2,
-	0x61, 0xc9, 0x97, 0x45, 0xd5, 0x31, 0x14, 0x00, 0x14, 0x1e, 0x0a, 0x6d, 0x58, 0xfb, 0x7c, 0x3b,
-	0xf8, 0x0d, 0x77, 0xf8, 0xb9, 0x8d, 0xbd, 0x4d, 0x8f, 0x29, 0xac, 0x89, 0xa3, 0xbe, 0xb8, 0xdd,
-	0xad, 0x34, 0x9e, 0xc5, 0x3e, 0x13, 0x71, 0x9a, 0x55, 0xc3, 0x07, 0xb8, 0x8d, 0x46, 0x4e, 0xec,
-	0x56, 0x30, 0x0e, 0xc6, 0xb7, 0xfd, 0x65, 0x91, 0xa5, 0xd3, 0xf6, 0x03, 0x31, 0xad, 0x6b, 0xc4,
-	0xe1, 0xf8, 0xe6, 0x62, 0x30, 0x5e, 0xd7, 0xdb, 0x4a, 0xf9, 0x9f, 0xb3, 0x55, 0xc1, 0xf0, 0x78,
-	0xed, 0x21, 0xe1, 0x78, 0x0d, 0x51, 0x58, 0x9f, 0x09, 0x13, 0x47, 0xf1, 0x8a, 0x2f, 0x89, 0x78,
-	0x6d, 0xc4, 0xe1, 0xfa, 0xb8, 0x98, 0xcd, 0x3b, 0x8c, 0x87, 0x71, 0x2e, 0x58, 0x99, 0xc7, 0xd9,
-	0x41, 0x16, 0xcf, 0xaa, 0x21, 0x11, 0x63, 0x7c, 0x8a, 0xc8, 0x3b, 0x68, 0x1a, 0x69, 0xc6, 0x71,
-	0x75, 0x10, 0x5f, 0xf1, 0x32, 0x15, 0x74, 0x33, 0x5a, 0xa4, 0xb3, 0x19, 0x3d, 0x14, 0xf5, 0x36,
-	0x2a, 0xa7, 0xf3, 0xf4, 0x8a, 0x25, 0x01, 0x6f, 0x0d, 0xd2, 0xc3, 0x9b, 0x83, 0x22, 0x9d, 0x36,
-	0xe1, 0xcb, 0x72, 0xca, 0xc8, 0x4e, 0x53, 0xe2, 0xce, 0x4e, 0x33, 0x98, 0xf6, 0xf0, 0x97, 0x6b,
-	0x83, 0xdf, 0x54, 0x52, 0xf7, 0x29, 0xd5, 0x7e, 0x5c, 0xcd, 0x5f, 0xf3, 0xb8, 0x4c, 0x86, 0xef,
-	0x63, 0x76, 0x50, 0xd4, 0xb8, 0xde, 0xbd, 0x8e, 0x0a, 0x6c, 0xd6, 0x7a, 0x4f, 0x6f, 0x67, 0x1c,
-	0xda, 0xac, 0x1e, 0x12, 0x6e, 0x56, 0x88, 0xc2, 0x00, 0x22, 0xe5, 0xea, 0x10, 0xf3, 0x01, 0xa9,
-	0xef, 0x9f, 0x64, 0xae, 0x77, 0x72, 0x30, 0x3e, 0xd6, 0x42, 0x7f, 0xb4, 0x6c, 0x53, 0x36, 0xf0,
-	0x11, 0x13, 0xf5, 0xc5, 0x49, 0xcf, 0x66, 0x56, 0x84, 0x3d, 0xb7, 0x66, 0x46, 0xd4, 0x17, 0x27,
-	0x3c, 0x3b, 0x61, 0x2d, 0xe4, 0x19, 0x09, 0x6d, 0x51, 0x5f, 0x1c, 0xee, 0xbe, 0x34, 0xd3, 0xac,
-	0x0b, 0x8f, 0x02, 0x76, 0xe0, 0xda, 0xb0, 0xd9, 0x8b, 0xd5, 0x0e, 0xff, 0x7a, 0x6d, 0xf0, 0x5d,
-	0xeb, 0xf1, 0x98, 0x27, 0xe9, 0xc5, 0x4a, 0x41, 0x2f, 0xe3, 0x6c, 0xc9, 0xaa, 0xe1, 0x2e, 0x65,
-	0xad, 0xcd, 0x9a, 0x12, 0x3c, 0xb9, 0x96, 0x0e, 0x9c, 0x3b, 0xa3, 0xa2, 0xc8, 0x56, 0x67, 0x6c,
-	0x51, 0x64, 0xe4, 0xdc, 0xf1, 0x90, 0xf0, 0xdc, 0x81, 0x28, 0xdc, 0x95, 0x9f, 0xf1, 0x7a, 0xcf,
-	0x8f, 0xee, 0xca, 0xa5, 0x28, 0xbc, 0x2b, 0x6f, 0x10, 0xb8, 0x57, 0x3a, 0xe3, 0x7b, 0x3c, 0xcb,
-	0xd8, 0x54, 0xb4, 0x6f, 0xba, 0x18, 0x4d, 0x4b, 0x84, 0xf7, 0x4a, 0x80, 0xb4, 0x27, 0x7e, 0x4d,
-	0x0e, 0x19, 0x97, 0xec, 0xd9, 0xea, 0x28, 0xcd, 0x2f, 0x87, 0xf8, 0xb6, 0xc0, 0x02, 0xc4, 0x89,
-	0x1f, 0x0a, 0xc2, 0x5c, 0xf5, 0x3c, 0x4f, 0x38, 0x9e, 0xab, 0xd6, 0x92, 0x70, 0xae, 0xaa, 0x09,
-	0x68, 0xf2, 0x94, 0x51, 0x26, 0x6b, 0x49, 0xd8, 0xa4, 0x26, 0xb0, 0x50, 0xa8, 0x9f, 0x76, 0x91,
-	0xa1, 0x10, 0x3c, 0xdf, 0x5a, 0xef, 0xe4, 0x60, 0xce, 0xa5, 0x1d, 0xa0, 0x23, 0x02, 0x18, 0xbf,
-	0x1b, 0x64, 0xe0, 0xd0, 0x6f, 0xb2, 0xe1, 0x03, 0x26, 0xa6, 0x73, 0x7c, 0xe8, 0x7b, 0x48, 0x78,
-	0xe8, 0x43, 0x14, 0x56, 0x63, 0xbc, 0xa0, 0xab, 0xa1, 0x64, 0xe1, 0x6a, 0x18, 0x06, 0x76, 0x82,
-	0x12, 0xc8, 0xb3, 0xb1, 0x07, 0xb4, 0xa2, 0x77, 0x3a, 0xb6, 0xde, 0xc9, 0x69, 0x27, 0xff, 0x6c,
-	0x52, 0x37, 0x25, 0x7d, 0xc1, 0xeb, 0x79, 0xf1, 0x32, 0xce, 0xd2, 0x24, 0x16, 0xec, 0x8c, 0x5f,
-	0xb2, 0x1c, 0xcf, 0x92, 0x74, 0x69, 0x15, 0x1f, 0x79, 0x0a, 0xe1, 0x2c, 0x29, 0xac, 0x08, 0xbb,
-	0x50, 0xd1, 0xe7, 0x15, 0xdb, 0x8b, 0x2b, 0x22, 0x7a, 0x79, 0x48, 0xb8, 0x0b, 0x21, 0x0a, 0xf7,
-	0xa8, 0x4a, 0xfe, 0xfc, 0x6d, 0xc1, 0xca, 0x94, 0xe5, 0x53, 0x86, 0xef, 0x51, 0x21, 0x15, 0xde,
-	0xa3, 0x22, 0x34, 0xcc, 0xcf, 0xf6, 0x63, 0xc1, 0x9e, 0xad, 0xce, 0xd2, 0x05, 0xab, 0x44, 0xbc,
-	0x28, 0xf0, 0xfc, 0x0c, 0x40, 0xe1, 0xfc, 0xac, 0x0d, 0xb7, 0x8e, 0x83, 0x4c, 0x10, 0x6c, 0x5f,
-	0x8a, 0x83, 0x44, 0xe0, 0x52, 0x1c, 0x81, 0xc2, 0x86, 0xb5, 0x00, 0xfa, 0xd0, 0xa1, 0x65, 0x25,
-	0xf8, 0xd0, 0x81, 0xa6, 0x5b, 0x87, 0x6c, 0x86, 0x99, 0xd4, 0x53, 0xb3, 0xa3, 0xe8, 0x13, 0x77,
-	0x8a, 0x6e, 0xf6, 0x62, 0xf1, 0x53, 0xbd, 0x53, 0x96, 0xc5, 0x72, 0xa9, 0x0a, 0x1c, 0x9d, 0x35,
-	0x4c, 0x9f, 0x53, 0x3d, 0x87, 0xd5, 0x0e, 0xff, 0x7c, 0x6d, 0xf0, 0x1e, 0xe6, 0xf1, 0xb3, 0x42,
-	0xfa, 0x7d, 0xdc, 0x6d, 0x4b, 0x91, 0xc4, 0xad, 0xbf, 0xb0, 0x86, 0xbd, 0xb8, 0xd2, 0x88, 0xec,
-	0xa5, 0x40, 0x5d, 0x00, 0x7f, 0xa3, 0x66, 0xca, 0x0f, 0x39, 0xe2, 0xe2, 0x4a, 0x88, 0xb7, 0x39,
-	0x90, 0x5f, 0xae, 0x0a, 0xe4, 0x40, 0xc6, 0x86, 0x16, 0x13, 0x39, 0x10, 0x82, 0xd9, 0x0b, 0x9d,
-	0xbe, 0x07, 0xf3, 0xa4, 0x68, 0x3b, 0x64, 0xa1, 0xfd, 0xcc, 0x28, 0xea, 0x8b, 0xdb, 0xb0, 0xe0,
-	0xb6, 0xeb, 0xab, 0x54, 0xcc, 0xe5, 0xe6, 0x0e, 0x84, 0x05, 0xaf, 0x91, 0x0c, 0x44, 0x84, 0x05,
-	0x12, 0x86, 0xdb, 0x9f, 0x06, 0xac, 0x83, 0x02, 0xb6, 0x88, 0x18, 0x43, 0x6e, 0x48, 0xd8, 0xe8,
-	0x06, 0xe1, 0x44, 0x69, 0xc4, 0x3a, 0xcf, 0x7a, 0x14, 0xb2, 0x00, 0x72, 0xad, 0xcd, 0x5e, 0xac,
-	0x76, 0xf8, 0xa7, 0x83, 0xef, 0xb4, 0x2a, 0x76, 0xc0, 0x62, 0xb1, 0x2c, 0x59, 0x02, 0x6e, 0xa7,
-	0xb7, 0xcb, 0xdd, 0x80, 0xc4, 0xed, 0xf4, 0xa0, 0x42, 0x2b, 0x21, 0x68, 0x38, 0x35, 0x9e, 0x4d,
-	0x19, 0x76, 0x43, 0x26, 0x7d, 0x36, 0x98, 0x10, 0xd0, 0x3a, 0xad, 0x9c, 0xde, 0x1d, 0x5d, 0xa3,
-	0xab, 0x38, 0xcd, 0xe4, 0x53, 0xe7, 0xf7, 0x43, 0x46, 0x3d, 0x34, 0x98, 0xd3, 0x93, 0x2a, 0xad,
-	0x25, 0x41, 0x06, 0x17, 0x27, 0x17, 0xdc, 0xa2, 0x43, 0x10, 0x92, 0x0a, 0x6e, 0xf7, 0xa4, 0xb5,
-	0x5b, 0xd1, 0xac, 0xb5, 0xf5, 0x9f, 0xdd, 0x41, 0x8e, 0x79, 0xd5, 0xaa, 0xc8, 0x48, 0xdf, 0xee,
-	0x49, 0xdb, 0x57, 0x23, 0xda, 0x5e, 0xf5, 0x0a, 0xb8, 0xd3, 0x69, 0x0a, 0x2c, 0x82, 0x8f, 0xfb,
-	0x2b, 0x68, 0xf7, 0xff, 0x6a, 0x0e, 0xc1, 0x95, 0xff, 0x29, 0x5f, 0x2c, 0x58, 0x9e, 0xb0, 0xa4,
-	0xd1, 0xa8, 0xea, 0x64, 0xed, 0x63, 0xda, 0xae, 0x51, 0x88, 0x5c, 0x0d, 0x53, 0xa2, 0xdf, 0xfa,
-	0x0a, 0x9a, 0xba, 0x68, 0xff, 0xb9, 0x36, 0x78, 0x88, 0x16, 0xad, 0x19, 0xb8, 0x5e, 0x11, 0x7f,
-	0xb7, 0x8f, 0x23, 0x4c, 0xd3, 0x14, 0x75, 0xf4, 0xff, 0xb0, 0xa0, 0x8b, 0xfc, 0x6f, 0x6b, 0x83,
-	0x3b, 0x56, 0xb1, 0x1e, 0xde, 0x7b, 0x3c, 0xbf, 0xc8, 0xd2, 0xa9, 0x90, 0x8f, 0x96, 0xb5, 0x0a,
-	0xdd, 0x9c, 0x94, 0x46, 0x77, 0x73, 0x06, 0x34, 0x75, 0xd9, 0xfe, 0x69, 0x6d, 0x70, 0xcb, 0x6d,
-	0x4e, 0xf9, 0x5c, 0x5a, 0x1d, 0xc5, 0x36, 0x8a, 0xd5, 0xf0, 0x43, 0xba, 0x0d, 0x30, 0xde, 0x94,
-	0xeb, 0xa3, 0x6b, 0xeb, 0xb5, 0xf2, 0xf7, 0x55, 0x61, 0x2f, 0x5a, 0x6c, 0x50, 0xe6, 0x5a, 0x2b,
-	0xe7, 0xc3, 0x1e, 0xa4, 0x75, 0xf5, 0x49, 0x5a, 0x09, 0x5e, 0xae, 0x26, 0x73, 0xfe, 0xa6, 0x79,
-	0xab, 0xd0, 0x77, 0xa5, 0x81, 0xc8, 0x21, 0x08, 0x57, 0x38, 0xd9, 0x72, 0x65, 0xdf, 0x3e, 0xac,
-	0x08, 0x57, 0x0e, 0xd1, 0xe1, 0xca, 0x27, 0xed, 0xb2, 0xdc, 0xd4, 0xca, 0xbe, 0x2a, 0xb9, 0x8e,
-	0x17, 0xb5, 0xfd, 0xba, 0xe4, 0x46, 0x37, 0x68, 0xb3, 0x02, 0x2d, 0xde, 0x4f, 0x2f, 0x2e, 0x4c,
-	0x9d, 0xf0, 0x92, 0xba, 0x08, 0x91, 0x15, 0x10, 0xa8, 0x4d, 0x6c, 0x0f, 0xd2, 0x8c, 0xc9, 0x27,
-	0x65, 0x9f, 0x5d, 0x5c, 0x64, 0x3c, 0x4e, 0x40, 0x62, 0x5b, 0x8b, 0x23, 0x57, 0x4e, 0x24, 0xb6,
-	0x18, 0x67, 0xaf, 0x31, 0xd4, 0xd2, 0x7a, 0x7a, 0xe7, 0xd3, 0x34, 0x83, 0xf7, 0xe1, 0xa5, 0xa6,
-	0x11, 0x12, 0xd7, 0x18, 0x5a, 0x90, 0xdd, 0x7c, 0xd6, 0xa2, 0x7a, 0x5a, 0x36, 0xe5, 0xbf, 0xdf,
-	0x56, 0x74, 0xc4, 0xc4, 0xe6, 0x13, 0xc1, 0xec, 0x99, 0x4e, 0x2d, 0x3c, 0x2f, 0xa4, 0xf1, 0x5b,
-	0x6d, 0x2d, 0x25, 0x21, 0xce, 0x74, 0x7c, 0xc2, 0x9e, 0x53, 0xd4, 0x7f, 0xdf, 0xe7, 0x6f, 0x72,
-	0x69, 0xf4, 0x4e, 0x5b, 0xa5, 0x91, 0x11, 0xe7, 0x14, 0x90, 0xb1, 0xf3, 0x41, 0x1a, 0x4e, 0xab,
-	0x69, 0x5c, 0x26, 0x27, 0x25, 0x93, 0xe6, 0x37, 0x10, 0x55, 0x8f, 0x20, 0xe6, 0x03, 0x4e, 0x6a,
-	0x57, 0x9f, 0x0e, 0x7e, 0x41, 0xba, 0x2a, 0x79, 0x31, 0xbc, 0x81, 0xa8, 0x95, 0xce, 0x45, 0xf5,
-	0x9b, 0xa4, 0xdc, 0xde, 0x3c, 0x32, 0xc3, 0xf0, 0xbc, 0x8a, 0x67, 0xf0, 0xed, 0x12, 0x3b, 0xb8,
-	0xa4, 0x94, 0xb8, 0x79, 0xd4, 0xa6, 0xfc, 0x01, 0xf8, 0x82, 0x27, 0xda, 0x3a, 0xd2, 0x98, 0x46,
-	0x18, 0x1a, 0x80, 0x2e, 0x64, 0xe7, 0xab, 0x2c, 0x3a, 0x13, 0xa3, 0xa5, 0xe0, 0xa6, 0x4b, 0x91,
-	0x96, 0x04, 0x08, 0x31, 0x5f, 0x09, 0xd4, 0x46, 0xa1, 0x1a, 0xd8, 0x8b, 0xa7, 0x73, 0x3b, 0x7c,
-	0x90, 0x89, 0xe8, 0x01, 0x44, 0x14, 0x42, 0x41, 0xfb, 0x9c, 0xc0, 0xf8, 0x51, 0x57, 0x5a, 0x8d,
-	0xb7, 0x6d, 0xc2, 0x88, 0x8f, 0x11, 0x29, 0x57, 0x00, 0xb7, 0x29, 0xd7, 0x8b, 0xf8, 0x2a, 0x9d,
-	0x99, 0x6d, 0xb1, 0x5a, 0x6b, 0x2a, 0x90, 0x72, 0x59, 0x26, 0x72, 0x20, 0x22, 0xe5, 0x22, 0x61,
-	0x67, 0xc9, 0xb6, 0xcc, 0x61, 0xf3, 0x00, 0x63, 0x9c, 0x5f, 0xf0, 0x3a, 0x41, 0x3b, 0x4a, 0xf3,
-	0x4b, 0xb8, 0x64, 0x3b, 0x26, 0x71, 0x9e, 0x58, 0xb2, 0xfb, 0xe8, 0xd9, 0xa4, 0xbe, 0x39, 0xdd,
-	0xb7, 0x57, 0x7c, 0x94, 0x06, 0x48, 0xea, 0xcd, 0x43, 0x00, 0xc8, 0x11, 0x49, 0x7d, 0x88, 0xb7,
-	0x53, 0xc6, 0x38, 0xcf, 0x78, 0x0e, 0xa7, 0x8c, 0xb5, 0x50, 0x0b, 0x89, 0x29, 0xd3, 0x82, 0xec,
-	0x20, 0x6e, 0x44, 0xea, 0xbc, 0x78, 0x94, 0x65, 0x60, 0x10, 0x1b, 0x55, 0x03, 0x10, 0x83, 0x18,
-	0x05, 0xb5, 0x9f, 0xd3, 0xc1, 0x37, 0xea, 0x26, 0x3d, 0x29, 0xd9, 0x55, 0xca, 0xe0, 0x6d, 0x34,
-	0x47, 0x42, 0x84, 0x6e, 0x9f, 0xb0, 0x91, 0xea, 0x3c, 0xaf, 0x8a, 0x2c, 0xae, 0xe6, 0xfa, 0x7e,
-	0x92, 0x5f, 0xe7, 0x46, 0x08, 0x6f, 0x28, 0xdd, 0xef, 0xa0, 0xec, 0x7a, 0xdc, 0xc8, 0xcc, 0x84,
-	0x7b, 0x80, 0xab, 0xb6, 0x66, 0xda, 0x7a, 0x27, 0x67, 0x27, 0xf7, 0x61, 0x9c, 0x65, 0xac, 0x5c,
-	0x35, 0xb2, 0xe3, 0x38, 0x4f, 0x2f, 0x58, 0x25, 0xc0, 0xe4, 0xd6, 0x54, 0x04, 0x31, 0x62, 0x72,
-	0x07, 0x70, 0x7b, 0xe6, 0x00, 0x3c, 0x8f, 0xf3, 0x84, 0xbd, 0x05, 0x67, 0x0e, 0xd0, 0x8e, 0x64,
-	0x88, 0x33, 0x07, 0x8a, 0xb5, 0x0f, 0xc3, 0x9e, 0x65, 0x7c, 0x7a, 0xa9, 0x57, 0x6f, 0xbf, 0x83,
-	0xa5, 0x04, 0x2e, 0xdf, 0x77, 0x42, 0x88, 0x5d, 0xbf, 0xa5, 0xe0, 0x94, 0x15, 0x59, 0x3c, 0x85,
-	0x57, 0x12, 0x95, 0x8e, 0x96, 0x11, 0xeb, 0x37, 0x64, 0x40, 0x71, 0xf5, 0x55, 0x47, 0xac, 0xb8,
-	0xe0, 0xa6, 0xe3, 0x9d, 0x10, 0x62, 0x77, 0x30, 0x52, 0x30, 0x29, 0xb2, 0x54, 0x80, 0x69, 0xa0,
-	0x34, 0xa4, 0x84, 0x98, 0x06, 0x3e, 0x01, 0x4c, 0x1e, 0xb3, 0x72, 0xc6, 0x50, 0x93, 0x52, 0x12,
-	0x34, 0xd9, 0x10, 0xf6, 0xdd, 0x0e, 0x55, 0x77, 0x5e, 0xac, 0xc0, 0xbb, 0x1d, 0xba, 0x5a, 0xbc,
-	0x58, 0x11, 0xef, 0x76, 0x78, 0x00, 0x28, 0xe2, 0x49, 0x5c, 0x09, 0xbc, 0x88, 0x52, 0x12, 0x2c,
-	0x62, 0x43, 0xd8, 0x3d, 0x8f, 0x2a, 0xe2, 0x52, 0x80, 0x3d, 0x8f, 0x2e, 0x80, 0x73, 0x29, 0xe7,
-	0x26, 0x29, 0xb7, 0x91, 0x44, 0xf5, 0x0a, 0x13, 0x07, 0x29, 0xcb, 0x92, 0x0a, 0x44, 0x12, 0xdd,
-	0xee, 0x8d, 0x94, 0x88, 0x24, 0x6d, 0x0a, 0x0c, 0x25, 0xfd, 0x44, 0x0f, 0xab, 0x1d, 0x78, 0xa0,
-	0x77, 0x27, 0x84, 0xd8, 0xf8, 0xd4, 0x14, 0x7a, 0x2f, 0x2e, 0xcb, 0xb4, 0xde, 0x4c, 0x3d, 0xc0,
-	0x0b, 0xd4, 0xc8, 0x89, 0xf8, 0x84, 0x71, 0x60, 0x7a, 0x35, 0x81, 0x1b, 0x2b, 0x18, 0x0c, 0xdd,
-	0x77, 0x83, 0x8c, 0x4d, 0x16, 0xa4, 0xc4, 0xb9, 0x55, 0x82, 0xb5, 0x26, 0x72, 0xa9, 0xe4, 0x41,
-	0x17, 0xe6, 0xbc, 0xce, 0x6a, 0x5c, 0x1c, 0xf3, 0x2b, 0x76, 0xc6, 0x9f, 0xbf, 0x4d, 0x2b, 0x91,
-	0xe6, 0x33, 0xbd, 0x72, 0x3f, 0x21, 0x2c, 0x61, 0x30, 0xf1, 0x3a, 0x6b, 0xa7, 0x92, 0xdd, 0x40,
-	0x80, 0xb2, 0xbc, 0x60, 0x6f, 0xd0, 0x0d, 0x04, 0xb4, 0x68, 0x38, 0x62, 0x03, 0x11, 0xe2, 0xed,
-	0x69, 0xaf, 0x71, 0xae, 0x3f, 0x24, 0x73, 0xc6, 0x9b, 0xbd, 0x1c, 0x65, 0x0d, 0x82, 0xc4, 0x81,
-	0x5b, 0x50, 0xc1, 0xa6, 0x42, 0xc6, 0xbf, 0x9d, 0x62, 0x1b, 0x84, 0x9d, 0xf6, 0x34, 0x7b, 0xd8,
-	0x83, 0x44, 0x5c, 0xd9, 0xab, 0x51, 0x94, 0xab, 0xf6, 0xcd, 0xa8, 0x87, 0x3d, 0x48, 0xe7, 0xe4,
-	0xd8, 0xad, 0xd6, 0xb3, 0x78, 0x7a, 0x39, 0x2b, 0xf9, 0x32, 0x4f, 0xf6, 0x78, 0xc6, 0x4b, 0x70,
-	0x72, 0xec, 0x95, 0x1a, 0xa0, 0xc4, 0xc9, 0x71, 0x87, 0x8a, 0xdd, 0xc1, 0xb9, 0xa5, 0x18, 0x65,
-	0xe9, 0x0c, 0x1e, 0x86, 0x78, 0x86, 0x24, 0x40, 0xec, 0xe0, 0x50, 0x10, 0x19, 0x44, 0xea, 0xb0,
-	0x44, 0xa4, 0xd3, 0x38, 0x53, 0xfe, 0x76, 0x68, 0x33, 0x1e, 0xd8, 0x39, 0x88, 0x10, 0x05, 0xa4,
-	0x9e, 0x67, 0xcb, 0x32, 0x1f, 0xe7, 0x82, 0x93, 0xf5, 0x6c, 0x80, 0xce, 0x7a, 0x3a, 0x20, 0x08,
-	0xab, 0x67, 0xec, 0x6d, 0x5d, 0x9a, 0xfa, 0x1f, 0x2c, 0xac, 0xd6, 0x7f, 0x8f, 0xb4, 0x3c, 0x14,
-	0x56, 0x01, 0x07, 0x2a, 0xa3, 0x9d, 0xa8, 0x01, 0x13, 0xd0, 0xf6, 0x87, 0xc9, 0x46, 0x37, 0x88,
-	0xfb, 0x99, 0x88, 0x55, 0xc6, 0x42, 0x7e, 0x24, 0xd0, 0xc7, 0x4f, 0x03, 0xda, 0xcc, 0xdb, 0xab,
-	0xcf, 0x9c, 0x4d, 0x2f, 0x5b, 0x37, 0x3d, 0xfd, 0x82, 0x2a, 0x84, 0xc8, 0xbc, 0x09, 0x14, 0xef,
-	0xa2, 0xf1, 0x94, 0xe7, 0xa1, 0x2e, 0xaa, 0xe5, 0x7d, 0xba, 0x48, 0x73, 0x36, 0xf9, 0x35, 0x52,
-	0x3d, 0x32, 0x55, 0x37, 0x6d, 0x12, 0x16, 0x5c, 0x88, 0x48, 0x7e, 0x49, 0xd8, 0xee, 0xc9, 0xa1,
-	0xcf, 0xe3, 0xf6, 0x6b, 0x30, 0x2d, 0x2b, 0xc7, 0xf4, 0x6b, 0x30, 0x14, 0x4b, 0x57, 0x52, 0x8d,
-	0x91, 0x0e, 0x2b, 0xfe, 0x38, 0xd9, 0xea, 0x07, 0xdb, 0x94, 0xc7, 0xf3, 0xb9, 0x97, 0xb1, 0xb8,
-	0x54, 0x5e, 0xb7, 0x03, 0x86, 0x2c, 0x46, 0xa4, 0x3c, 0x01, 0x1c, 0x84, 0x30, 0xcf, 0xf3, 0x1e,
-	0xcf, 0x05, 0xcb, 0x05, 0x16, 0xc2, 0x7c, 0x63, 0x1a, 0x0c, 0x85, 0x30, 0x4a, 0x01, 0x8c, 0x5b,
-	0x7d, 0x48, 0xf5, 0x22, 0x5e, 0xa0, 0x3b, 0xb6, 0xe6, 0xd8, 0xa9, 0x96, 0x87, 0xc6, 0x2d, 0xe0,
-	0x9c, 0x3b, 0x10, 0xae, 0x97, 0xb3, 0xb8, 0x9c, 0x99, 0xd3, 0x8d, 0x64, 0xf8, 0x98, 0xb6, 0xe3,
-	0x93, 0xc4, 0x1d, 0x88, 0xb0, 0x06, 0x08, 0x3b, 0xe3, 0x45, 0x3c, 0x33, 0x35, 0x45, 0x6a, 0x20,
-	0xe5, 0xad, 0xaa, 0x6e, 0x74, 0x83, 0xc0, 0xcf, 0xcb, 0x34, 0x61, 0x3c, 0xe0, 0x47, 0xca, 0xfb,
-	0xf8, 0x81, 0x20, 0xd8, 0xbd, 0xc9, 0x73, 0x38, 0xf5, 0xa9, 0xb7, 0x3c, 0xd1, 0x79, 0x6c, 0x44,
-	0x34, 0x0f, 0xe0, 0x42, 0xbb, 0x37, 0x82, 0x07, 0x73, 0xb4, 0x39, 0x5b, 0x0f, 0xcd, 0x51, 0x73,
-	0x74, 0xde, 0x67, 0x8e, 0x62, 0xb0, 0xf6, 0xf9, 0x63, 0x3d, 0x47, 0xf7, 0x63, 0x11, 0xd7, 0xfb,
-	0xf6, 0x97, 0x29, 0x7b, 0xa3, 0x13, 0x61, 0xa4, 0xbe, 0x0d, 0x15, 0xc9, 0x2f, 0x04, 0x80, 0xac,
-	0x78, 0xa7, 0x37, 0x1f, 0xf0, 0xad, 0x33, 0x84, 0x4e, 0xdf, 0x20, 0x55, 0xd8, 0xe9, 0xcd, 0x07,
-	0x7c, 0xeb, 0x0f, 0xaa, 0x74, 0xfa, 0x06, 0x5f, 0x55, 0xd9, 0xe9, 0xcd, 0x6b, 0xdf, 0x7f, 0xd1,
-	0x4c, 0x5c, 0xd7, 0x79, 0xbd, 0x0f, 0x9b, 0x8a, 0xf4, 0x8a, 0x61, 0xdb, 0x49, 0xdf, 0x9e, 0x41,
-	0x43, 0xdb, 0x49, 0x5a, 0xc5, 0xf9, 0xae, 0x24, 0x56, 0x8a, 0x13, 0x5e, 0xa5, 0xf2, 0x0e, 0xd3,
-	0x93, 0x1e, 0x46, 0x1b, 0x38, 0x94, 0x34, 0x85, 0x94, 0xec, 0xa5, 0x08, 0x0f, 0xb5, 0x2f, 0x76,
-	0x6c, 0x05, 0xec, 0xb5, 0xdf, 0xef, 0xd8, 0xee, 0x49, 0xdb, 0xeb, 0x09, 0x1e, 0xd3, 0x3c, 0x58,
-	0x9e, 0x30, 0x74, 0x95, 0x30, 0xa6, 0xcc, 0x85, 0x03, 0xf7, 0x09, 0xfb, 0xe3, 0xfe, 0x0a, 0x1d,
-	0xee, 0x47, 0x49, 0xd2, 0xcf, 0xbd, 0x7b, 0x33, 0xe3, 0x71, 0x7f, 0x05, 0xed, 0xfe, 0xaf, 0x9a,
-	0xb4, 0x06, 0xfa, 0xd7, 0x73, 0x70, 0xb7, 0x8f, 0x45, 0x30, 0x0f, 0x9f, 0x5c, 0x4b, 0x47, 0x17,
-	0xe4, 0xef, 0x9a, 0xfc, 0xbd, 0x41, 0xe5, 0xdb, 0x75, 0xf2, 0x01, 0xb7, 0x9e, 0x92, 0xa1, 0x51,
-	0x65, 0x61, 0x38, 0x31, 0x9f, 0x5e, 0x53, 0xcb, 0xf9, 0xc8, 0xa9, 0x07, 0xeb, 0x37, 0xcc, 0x9d,
-	0xf2, 0x84, 0x2c, 0x3b, 0x34, 0x2c, 0xd0, 0x87, 0xd7, 0x55, 0xa3, 0xa6, 0xaa, 0x03, 0xcb, 0x2f,
-	0x4c, 0x3d, 0xe9, 0x69, 0xd8, 0xfb, 0xe6, 0xd4, 0x07, 0xd7, 0x53, 0xd2, 0x65, 0xf9, 0x8f, 0xb5,
-	0xc1, 0x7d, 0x8f, 0xb5, 0x8f, 0x33, 0xc0, 0xa1, 0xcb, 0x0f, 0x02, 0xf6, 0x29, 0x25, 0x53, 0xb8,
-	0xdf, 0xfe, 0x6a, 0xca, 0xf6, 0xee, 0xa2, 0xa7, 0x72, 0x90, 0x66, 0x82, 0x95, 0xed, 0x8f, 0x51,
-	0xfa, 0x76, 0x15, 0x15, 0xd1, 0x1f, 0xa3, 0x0c, 0xe0, 0xce, 0xc7, 0x28, 0x11, 0xcf, 0xe8, 0xc7,
-	0x28, 0x51, 0x6b, 0xc1, 0x8f, 0x51, 0x86, 0x35, 0xa8, 0xd5, 0xa5, 0x29, 0x82, 0x3a, 0x36, 0xef,
-	0x65, 0xd1, 0x3f, 0x45, 0xdf, 0xbd, 0x8e, 0x0a, 0xb1, 0xbe, 0x2a, 0x4e, 0xde, 0x42, 0xee, 0xd1,
-	0xa6, 0xde, 0x4d, 0xe4, 0x9d, 0xde, 0xbc, 0xf6, 0xfd, 0x23, 0x9d, 0x5c, 0x99, 0xd5, 0x84, 0x97,
-	0xf2, 0x43, 0xa4, 0x9b, 0xa1, 0xd5, 0xa1, 0xb6, 0xe0, 0xf6, 0xfc, 0x56, 0x3f, 0x98, 0xa8, 0x6e,
-	0x4d, 0xe8, 0x4e, 0x8f, 0xba, 0x0c, 0x81, 0x2e, 0xdf, 0xe9, 0xcd, 0x13, 0xcb, 0x88, 0xf2, 0xad,
-	0x7a, 0xbb, 0x87, 0x31, 0xbf, 0xaf, 0x1f, 0xf7, 0x57, 0xd0, 0xee, 0xaf, 0xf4, 0xae, 0xd5, 0x75,
-	0x2f, 0xfb, 0x79, 0xbb, 0xcb, 0xd4, 0xc4, 0xeb, 0xe6, 0xa8, 0x2f, 0x1e, 0xda, 0xbf, 0xb8, 0x4b,
-	0x68, 0xd7, 0xfe, 0x05, 0x5d, 0x46, 0x3f, 0xb8, 0x9e, 0x92, 0x2e, 0xcb, 0x3f, 0xae, 0x0d, 0x6e,
-	0x92, 0x65, 0xd1, 0xe3, 0xe0, 0xc3, 0xbe, 0x96, 0xc1, 0x78, 0xf8, 0xe8, 0xda, 0x7a, 0xba, 0x50,
-	0x3f, 0x5d, 0x1b, 0xdc, 0x0a, 0x14, 0x4a, 0x0d, 0x90, 0x6b, 0x58, 0xf7, 0x07, 0xca, 0xc7, 0xd7,
-	0x57, 0xa4, 0x96, 0x7b, 0x17, 0x9f, 0xb4, 0x3f, 0x2c, 0x18, 0xb0, 0x3d, 0xa1, 0x3f, 0x2c, 0xd8,
-	0xad, 0x05, 0xcf, 0x98, 0xe2, 0xd7, 0x4d, 0xce, 0x87, 0x9e, 0x31, 0xc9, 0x6b, 0xbc, 0xc1, 0x4f,
-	0x09, 0x61, 0x1c, 0xe6, 0xe4, 0xf9, 0xdb, 0x22, 0xce, 0x13, 0xda, 0x89, 0x92, 0x77, 0x3b, 0x31,
-	0x1c, 0x3c, 0x9b, 0xab, 0xa5, 0xa7, 0xbc, 0xc9, 0xe3, 0x1e, 0x52, 0xfa, 0x06, 0x09, 0x9e, 0xcd,
-	0xb5, 0x50, 0xc2, 0x9b, 0xde, 0x35, 0x86, 0xbc, 0x81, 0xcd, 0xe2, 0xa3, 0x3e, 0x28, 0xc8, 0x10,
-	0x8c, 0x37, 0x73, 0xe4, 0xbf, 0x15, 0xb2, 0xd2, 0x3a, 0xf6, 0xdf, 0xee, 0x49, 0x13, 0x6e, 0x27,
-	0x4c, 0x7c, 0xc2, 0xe2, 0x84, 0x95, 0x41, 0xb7, 0x86, 0xea, 0xe5, 0xd6, 0xa5, 0x31, 0xb7, 0x7b,
-	0x3c, 0x5b, 0x2e, 0x72, 0xdd, 0x99, 0xa4, 0x5b, 0x97, 0xea, 0x76, 0x0b, 0x68, 0x78, 0x2a, 0x69,
-	0xdd, 0xca, 0xed, 0xe5, 0xa3, 0xb0, 0x19, 0x6f, 0x57, 0xb9, 0xd9, 0x8b, 0xa5, 0xeb, 0xa9, 0x87,
-	0x51, 0x47, 0x3d, 0xc1, 0x48, 0xda, 0xee, 0x49, 0xc3, 0xe3, 0x41, 0xc7, 0xad, 0x19, 0x4f, 0x3b,
-	0x1d, 0xb6, 0x5a, 0x43, 0xea, 0x71, 0x7f, 0x05, 0x78, 0x18, 0xab, 0x47, 0xd5, 0x51, 0x5a, 0x89,
-	0x83, 0x34, 0xcb, 0x86, 0x9b, 0x81, 0x61, 0xd2, 0x40, 0xc1, 0xc3, 0x58, 0x04, 0x26, 0x46, 0x72,
-	0x73, 0x78, 0x99, 0x0f, 0xbb, 0xec, 0x48, 0xaa, 0xd7, 0x48, 0x76, 0x69, 0x70, 0xa0, 0xe6, 0x34,
-	0xb5, 0xa9, 0x6d, 0x14, 0x6e, 0xb8, 0x56, 0x85, 0x77, 0x7a, 0xf3, 0xe0, 0x69, 0xbf, 0xa4, 0xe4,
-	0xca, 0x72, 0x8f, 0x32, 0xe1, 0xad, 0x24, 0xf7, 0x3b, 0x28, 0x70, 0x28, 0xa9, 0xa6, 0xd1, 0xab,
-	0x34, 0x99, 0x31, 0x81, 0x3e, 0xa8, 0x72, 0x81, 0xe0, 0x83, 0x2a, 0x00, 0x82, 0xae, 0x53, 0x7f,
-	0x37, 0xa7, 0xb1, 0xe3, 0x04, 0xeb, 0x3a, 0xad, 0xec, 0x50, 0xa1, 0xae, 0x43, 0x69, 0x10, 0x0d,
-	0x8c, 0x5b, 0xfd, 0x81, 0x94, 0x47, 0x21, 0x33, 0xe0, 0x2b, 0x29, 0x9b, 0xbd, 0x58, 0xb0, 0xa2,
-	0x58, 0x87, 0xe9, 0x22, 0x15, 0xd8, 0x8a, 0xe2, 0xd8, 0xa8, 0x91, 0xd0, 0x8a, 0xd2, 0x46, 0xa9,
-	0xea, 0xd5, 0x7b, 0x84, 0x71, 0x12, 0xae, 0x9e, 0x62, 0xfa, 0x55, 0xcf, 0xb0, 0xad, 0xe7, 0xaa,
-	0xb9, 0x19, 0x32, 0x62, 0xae, 0x93, 0x65, 0x64, 0x6c, 0x3b, 0xbf, 0x37, 0x62, 0xc1, 0x50, 0xd4,
-	0xa1, 0x14, 0xe0, 0xf3, 0x82, 0xe6, 0x17, 0x4a, 0x26, 0x4c, 0x8c, 0x8a, 0x82, 0xc5, 0x65, 0x9c,
-	0x4f, 0xd1, 0xe4, 0xd4, 0xfc, 0xe2, 0x88, 0x47, 0x86, 0x92, 0x53, 0x52, 0x03, 0x3c, 0xb5, 0xf7,
-	0xdf, 0x4c, 0x47, 0xa6, 0x82, 0xf9, 0x90, 0x9b, 0xff, 0x62, 0xfa, 0xc3, 0x1e, 0x24, 0x7c, 0x6a,
-	0xdf, 0x00, 0xe6, 0xdc, 0x5d, 0x39, 0x7d, 0x3f, 0x60, 0xca, 0x47, 0x43, 0x89, 0x30, 0xad, 0x02,
-	0x06, 0xb5, 0x73, 0xb6, 0xf8, 0x29, 0x5b, 0x61, 0x83, 0xda, 0x3d, 0x24, 0xfc, 0x94, 0xad, 0x42,
-	0x83, 0xba, 0x8d, 0x82, 0x7d, 0xa6, 0x9b, 0x07, 0x3d, 0x08, 0xe8, 0xbb, 0xa9, 0xcf, 0x7a, 0x27,
-	0x07, 0x66, 0xce, 0x7e, 0x7a, 0xe5, 0x3d, 0xa6, 0x40, 0x0a, 0xba, 0x9f, 0x5e, 0xe1, 0x4f, 0x29,
-	0x36, 0x7b, 0xb1, 0xf0, 0x46, 0x40, 0x2c, 0xd8, 0xdb, 0xe6, 0x51, 0x3d, 0x52, 0x5c, 0x29, 0x6f,
-	0x3d, 0xab, 0xdf, 0xe8, 0x06, 0xed, 0xfd, 0xdb, 0x93, 0x92, 0x4f, 0x59, 0x55, 0xe9, 0xef, 0x12,
-	0xfb, 0x17, 0x9c, 0xb4, 0x2c, 0x02, 0x5f, 0x25, 0xbe, 0x17, 0x86, 0x9c, 0x8f, 0x89, 0x2a, 0x91,
-	0xfd, 0x0e, 0xd9, 0x03, 0x54, 0xb3, 0xfd, 0x09, 0xb2, 0xf5, 0x4e, 0xce, 0x4e, 0x2f, 0x2d, 0x75,
-	0x3f, 0x3c, 0xb6, 0x81, 0xaa, 0x63, 0xdf, 0x1c, 0x7b, 0xd8, 0x83, 0xd4, 0xae, 0x3e, 0x19, 0x7c,
-	0xfd, 0x88, 0xcf, 0x26, 0x2c, 0x4f, 0x86, 0xdf, 0xf3, 0x6f, 0xf0, 0xf2, 0x59, 0x54, 0xff, 0xd9,
-	0x18, 0xbd, 0x41, 0x89, 0xed, 0x1d, 0xc4, 0x7d, 0xf6, 0x7a, 0x39, 0x9b, 0x88, 0x58, 0x80, 0x3b,
-	0x88, 0xf2, 0xef, 0x51, 0x2d, 0x20, 0xee, 0x20, 0x7a, 0x00, 0xb0, 0x77, 0x56, 0x32, 0x86, 0xda,
-	0xab, 0x05, 0x41, 0x7b, 0x1a, 0xb0, 0xbb, 0x08, 0x63, 0xaf, 0xde, 0xa8, 0xc3, 0x3b, 0x83, 0x56,
-	0x47, 0x4a, 0x89, 0x5d, 0x44, 0x9b, 0xb2, 0x83, 0x5b, 0x55, 0x5f, 0x7e, 0x07, 0x6a, 0xb9, 0x58,
-	0xc4, 0xe5, 0x0a, 0x0c, 0x6e, 0x5d, 0x4b, 0x07, 0x20, 0x06, 0x37, 0x0a, 0xda, 0x59, 0xdb, 0x34,
-	0xf3, 0xf4, 0xf2, 0x90, 0x97, 0x7c, 0x29, 0xd2, 0x9c, 0xc1, 0x6f, 0x01, 0x99, 0x06, 0x75, 0x19,
-	0x62, 0xd6, 0x52, 0xac, 0xdd, 0xe5, 0x4a, 0x42, 0x5d, 0x67, 0x94, 0x3f, 0x00, 0x51, 0x09, 0x5e,
-	0xc2, 0xc7, 0x99, 0xca, 0x0a, 0x84, 0x88, 0x5d, 0x2e, 0x09, 0x83, 0xbe, 0x3f, 0x49, 0xf3, 0x19,
-	0xda, 0xf7, 0x27, 0xee, 0xb7, 0xbe, 0x6f, 0xd1, 0x80, 0x9d, 0x50, 0xaa, 0xd1, 0xd4, 0x04, 0xd0,
-	0x6f, 0xda, 0xa3, 0x8d, 0xee, 0x12, 0xc4, 0x84, 0xc2, 0x49, 0xe0, 0xea, 0xb3, 0x82, 0xe5, 0x2c,
-	0x69, 0x2e, 0xed, 0x61, 0xae, 0x3c, 0x22, 0xe8, 0x0a, 0x92, 0x36, 0x16, 0x49, 0xf9, 0xe9, 0x32,
-	0x3f, 0x29, 0xf9, 0x45, 0x9a, 0xb1, 0x12, 0xc4, 0x22, 0xa5, 0xee, 0xc8, 0x89, 0x58, 0x84, 0x71,
-	0xf6, 0xf6, 0x87, 0x94, 0x7a, 0xbf, 0x62, 0x72, 0x56, 0xc6, 0x53, 0x78, 0xfb, 0x43, 0xd9, 0x68,
-	0x63, 0xc4, 0xc9, 0x60, 0x00, 0x77, 0x36, 0x3a, 0xca, 0x75, 0xbe, 0x92, 0xe3, 0x43, 0xbf, 0x70,
-	0x2d, 0xbf, 0x80, 0x5d, 0x81, 0x8d, 0x8e, 0x36, 0x87, 0x91, 0xc4, 0x46, 0x27, 0xac, 0x61, 0x97,
-	0x12, 0xc9, 0xbd, 0xd0, 0xb7, 0x9a, 0xc0, 0x52, 0xa2, 0x6c, 0x34, 0x42, 0x62, 0x29, 0x69, 0x41,
-	0x20, 0x20, 0x35, 0xd3, 0x60, 0x86, 0x06, 0x24, 0x23, 0x0d, 0x06, 0x24, 0x97, 0xb2, 0x81, 0x62,
-	0x9c, 0xa7, 0x22, 0x8d, 0xb3, 0x09, 0x13, 0x27, 0x71, 0x19, 0x2f, 0x98, 0x60, 0x25, 0x0c, 0x14,
-	0x1a, 0x89, 0x3c, 0x86, 0x08, 0x14, 0x14, 0xab, 0x1d, 0xfe, 0xce, 0xe0, 0x9d, 0x7a, 0xdd, 0x67,
-	0xb9, 0xfe, 0xfd, 0xb5, 0xe7, 0xf2, 0xd7, 0x33, 0x87, 0xef, 0x1a, 0x1b, 0x13, 0x51, 0xb2, 0x78,
-	0xd1, 0xd8, 0xfe, 0x96, 0xf9, 0xbb, 0x04, 0x1f, 0xaf, 0xd5, 0xe3, 0xf9, 0x05, 0x17, 0xe9, 0x45,
-	0x9d, 0x66, 0xeb, 0x17, 0x98, 0xc0, 0x78, 0x76, 0xc5, 0x51, 0xe0, 0x4b, 0x41, 0x18, 0x67, 0xe3,
-	0xb4, 0x2b, 0x3d, 0x65, 0x45, 0x06, 0xe3, 0xb4, 0xa7, 0x2d, 0x01, 0x22, 0x4e, 0xa3, 0xa0, 0x9d,
-	0x9c, 0xae, 0xf8, 0x8c, 0x85, 0x2b, 0x73, 0xc6, 0xfa, 0x55, 0xe6, 0xcc, 0x7b, 0x27, 0x24, 0x1b,
-	0xbc, 0x73, 0xcc, 0x16, 0xaf, 0x59, 0x59, 0xcd, 0xd3, 0x82, 0xfa, 0x4a, 0xb7, 0x25, 0x3a, 0xbf,
-	0xd2, 0x4d, 0xa0, 0x76, 0x25, 0xb0, 0xc0, 0xb8, 0x7a, 0x11, 0x2f, 0x98, 0xfc, 0xee, 0x11, 0x58,
-	0x09, 0x1c, 0x23, 0x0e, 0x44, 0xac, 0x04, 0x24, 0xec, 0xbc, 0x5e, 0x66, 0x99, 0x53, 0x36, 0xab,
-	0x47, 0x58, 0x79, 0x12, 0xaf, 0x16, 0x2c, 0x17, 0xda, 0x24, 0x38, 0x93, 0x77, 0x4c, 0xe2, 0x3c,
-	0x71, 0x26, 0xdf, 0x47, 0xcf, 0x09, 0x4d, 0x5e, 0xc3, 0x9f, 0xf0, 0x52, 0xa8, 0x1f, 0x56, 0x3c,
-	0x2f, 0x33, 0x10, 0x9a, 0xfc, 0x46, 0xf5, 0x48, 0x22, 0x34, 0x85, 0x35, 0x9c, 0x5f, 0xd2, 0xf1,
-	0xca, 0xf0, 0x92, 0x95, 0x66, 0x9c, 0x3c, 0x5f, 0xc4, 0x69, 0xa6, 0x47, 0xc3, 0xf7, 0x03, 0xb6,
-	0x09, 0x1d, 0xe2, 0x97, 0x74, 0xfa, 0xea, 0x3a, 0xbf, 0x3d, 0x14, 0x2e, 0x21, 0x78, 0x44, 0xd0,
-	0x61, 0x9f, 0x78, 0x44, 0xd0, 0xad, 0x65, 0x33, 0x77, 0xcb, 0x4a, 0x6e, 0x25, 0x89, 0x3d, 0x9e,
-	0xc0, 0xf3, 0x42, 0xc7, 0x26, 0x00, 0x89, 0xcc, 0x3d, 0xa8, 0x60, 0xb7, 0x06, 0x16, 0x3b, 0x48,
-	0xf3, 0x38, 0x4b, 0x7f, 0x0c, 0xb7, 0xf5, 0x8e, 0x9d, 0x86, 0x20, 0xb6, 0x06, 0x38, 0x89, 0xb9,
-	0x3a, 0x64, 0xe2, 0x2c, 0xad, 0x43, 0xff, 0x46, 0xa0, 0xdd, 0x24, 0xd1, 0xed, 0xca, 0x21, 0x9d,
-	0xaf, 0x66, 0xc3, 0x66, 0x1d, 0x15, 0xc5, 0xa4, 0x5e, 0x55, 0x4f, 0xd9, 0x94, 0xa5, 0x85, 0x18,
-	0x3e, 0x0d, 0xb7, 0x15, 0xc0, 0x89, 0x8b, 0x16, 0x3d, 0xd4, 0xb0, 0x40, 0x55, 0xf7, 0xc1, 0xa1,
-	0xfe, 0x6d, 0x42, 0x32, 0x50, 0x39, 0x50, 0x77, 0xa0, 0xf2, 0x61, 0xbb, 0xdc, 0xfa, 0x3e, 0x4f,
-	0x59, 0xc2, 0xd8, 0x62, 0xf8, 0x28, 0x64, 0x45, 0x31, 0xc4, 0x72, 0x4b, 0xb1, 0x76, 0x63, 0xe6,
-	0x34, 0xfb, 0x6e, 0x1d, 0x28, 0x4a, 0x9e, 0x2c, 0xeb, 0xdd, 0xe6, 0x36, 0x61, 0xe7, 0xe5, 0x6e,
-	0xe4, 0x60, 0xc4, 0xc6, 0x2c, 0x80, 0x63, 0xcd, 0x2b, 0x3d, 0xeb, 0x48, 0xb3, 0x19, 0x34, 0x04,
-	0x42, 0xcb, 0x56, 0x3f, 0x18, 0x9d, 0xbb, 0xbb, 0x5e, 0x58, 0x24, 0xe7, 0xae, 0xae, 0x80, 0x01,
-	0x3b, 0xe7, 0x2e, 0xa2, 0x80, 0x46, 0xfc, 0x97, 0xbb, 0xa3, 0x7c, 0x55, 0xaf, 0x56, 0xe3, 0x4a,
-	0xad, 0x80, 0x01, 0x83, 0x3e, 0xd9, 0x19, 0xf1, 0x31, 0x0d, 0xe7, 0x28, 0x0c, 0x29, 0xc3, 0x28,
-	0xcb, 0xb8, 0x7c, 0xe4, 0xd1, 0x6d, 0xb2, 0x41, 0x89, 0xa3, 0xb0, 0x0e, 0x15, 0x6c, 0xd3, 0xf1,
-	0x72, 0x77, 0x2f, 0x2e, 0xc5, 0x21, 0x13, 0xe4, 0xa6, 0xe3, 0xe5, 0x6e, 0xa4, 0x91, 0xce, 0x4d,
-	0x87, 0x87, 0xda, 0x53, 0x73, 0xe8, 0x4d, 0xdf, 0xde, 0xda, 0x0a, 0x5b, 0x01, 0x97, 0xb6, 0xb6,
-	0x7b, 0xd2, 0xce, 0x0d, 0xa0, 0xba, 0xfa, 0x13, 0xf5, 0xf3, 0xf1, 0xe7, 0x15, 0x2b, 0x75, 0xae,
-	0x52, 0xd7, 0xf5, 0x31, 0x78, 0x2f, 0xdd, 0x70, 0x91, 0x03, 0x46, 0x6e, 0x95, 0xdf, 0xbf, 0x86,
-	0x86, 0xad, 0xb9, 0xc3, 0xe9, 0x8f, 0xd4, 0xc8, 0x1b, 0xd3, 0x5b, 0xa4, 0x31, 0x87, 0x22, 0x6a,
-	0x4e, 0xd3, 0x36, 0xae, 0xb4, 0xdd, 0x8e, 0xf2, 0xd5, 0x18, 0xde, 0xba, 0x42, 0x2c, 0x49, 0x8c,
-	0x88, 0x2b, 0x01, 0xdc, 0x79, 0x9e, 0x56, 0xf2, 0x38, 0x99, 0xc6, 0x95, 0x38, 0x89, 0x57, 0x19,
-	0x8f, 0x13, 0x99, 0x1a, 0xc0, 0xe7, 0x69, 0x0d, 0x13, 0xb9, 0x10, 0xf5, 0x3c, 0x8d, 0x82, 0xdd,
-	0x04, 0x4f, 0xfe, 0x2a, 0xbe, 0xbe, 0x8d, 0x0e, 0x13, 0x3c, 0x59, 0x5e, 0x78, 0x13, 0xfd, 0x5e,
-	0x18, 0xb2, 0x6f, 0xd1, 0x2a, 0x91, 0xcc, 0x64, 0x6e, 0x61, 0x3a, 0x5e, 0x0e, 0x73, 0x3b, 0x40,
-	0xd8, 0xef, 0x7f, 0xa9, 0xbf, 0x37, 0xbf, 0xc1, 0x29, 0xf4, 0xcf, 0x93, 0x6c, 0x61, 0xba, 0x2e,
-	0xe4, 0x5d, 0x72, 0xdd, 0xee, 0x49, 0xdb, 0x4c, 0x75, 0x6f, 0x1e, 0x8b, 0x51, 0x92, 0x1c, 0xb3,
-	0x0a, 0xf9, 0xc4, 0x48, 0x2d, 0x8c, 0xac, 0x94, 0xc8, 0x54, 0xdb, 0x94, 0x1d, 0xe8, 0xb5, 0xec,
-	0x79, 0x92, 0x0a, 0x2d, 0x6b, 0xde, 0xf1, 0xd8, 0x6a, 0x1b, 0x68, 0x53, 0x44, 0xad, 0x68, 0xda,
-	0x2e, 0x29, 0x35, 0x73, 0xc6, 0x67, 0xb3, 0x8c, 0x69, 0xe8, 0x94, 0xc5, 0xea, 0xeb, 0xcc, 0x3b,
-	0x6d, 0x5b, 0x28, 0x48, 0x2c, 0x29, 0x41, 0x05, 0x9b, 0x89, 0xd6, 0x98, 0x7a, 0xaa, 0xdd, 0x34,
-	0xec, 0x7a, 0xdb, 0x8c, 0x07, 0x10, 0x99, 0x28, 0x0a, 0xda, 0x37, 0x77, 0x6b, 0xf1, 0x21, 0x6b,
-	0x5a, 0x02, 0x7e, 0x63, 0x52, 0x2a, 0x3b, 0x62, 0xe2, 0xcd, 0x5d, 0x04, 0xb3, 0x7b, 0x1f, 0xe0,
-	0xe1, 0xd9, 0x6a, 0x9c, 0xc0, 0xa3, 0x06, 0xa8, 0x2f, 0x19, 0x62, 0xef, 0x43, 0xb1, 0x7e, 0xd7,
-	0x99, 0xa3, 0xf3, 0xa3, 0xb8, 0xb2, 0x95, 0x43, 0xba, 0x0e, 0x05, 0x43, 0x5d, 0x47, 0x29, 0xf8,
-	0x4d, 0xea, 0x9e, 0xce, 0x23, 0x4d, 0x8a, 0x1d, 0xcd, 0x3f, 0xe8, 0xc2, 0xec, 0xf1, 0x41, 0x2d,
-	0x3c, 0x65, 0x71, 0x62, 0x2a, 0x86, 0xe8, 0xba, 0x72, 0xe2, 0xf8, 0x00, 0xe3, 0xb4, 0x93, 0xdf,
-	0x1f, 0x0c, 0x55, 0x35, 0x4a, 0xd7, 0xcd, 0x2d, 0xac, 0x88, 0x35, 0x41, 0x04, 0x2a, 0x9f, 0x70,
-	0x72, 0x3f, 0xaf, 0x8b, 0xce, 0xb8, 0x76, 0xa0, 0xdf, 0x2c, 0xaf, 0x40, 0xee, 0xe7, 0x37, 0x7b,
-	0x8b, 0x26, 0x72, 0xbf, 0x6e, 0x2d, 0xe7, 0xab, 0x77, 0xa0, 0xcb, 0x0e, 0x4a, 0xbe, 0x80, 0x65,
-	0xfa, 0x38, 0xd8, 0x3d, 0x88, 0x06, 0xf1, 0xd5, 0xbb, 0x7e, 0x9a, 0xf0, 0xa7, 0xca, 0x74, 0x90,
-	0xc5, 0x7f, 0xaa, 0x4c, 0x0b, 0xc3, 0x3f, 0x55, 0x66, 0x21, 0xfb, 0x29, 0x83, 0x66, 0x1c, 0x8d,
-	0xb2, 0x6c, 0x78, 0x1b, 0x1f, 0x1a, 0xee, 0x37, 0x62, 0xee, 0x84, 0x10, 0xe7, 0x17, 0xcd, 0xc7,
-	0xaf, 0xca, 0x54, 0xa4, 0xf9, 0xec, 0x8c, 0xf3, 0x0c, 0x3e, 0x4b, 0x19, 0x8d, 0x23, 0x57, 0x4a,
-	0xfd, 0xa2, 0x79, 0x8b, 0xb2, 0x0b, 0xe7, 0x68, 0x3c, 0x5a, 0x0a, 0x7e, 0x91, 0x66, 0x19, 0x18,
-	0x8f, 0xa3, 0x71, 0xd4, 0x48, 0x88, 0xf1, 0xe8, 0x13, 0xce, 0xef, 0x70, 0x8f, 0xe5, 0x63, 0x49,
-	0xfd, 0x68, 0xe6, 0x2e, 0xd4, 0x71, 0x84, 0xd4, 0xef, 0x70, 0x43, 0xc8, 0xf9, 0x5d, 0xf1, 0x31,
-	0xf6, 0xeb, 0x64, 0x9b, 0x50, 0x1d, 0x81, 0xa8, 0xdf, 0x15, 0xa7, 0x60, 0xe7, 0x63, 0x09, 0x27,
-	0xcb, 0x6a, 0xee, 0x9f, 0x65, 0xaa, 0x53, 0x2b, 0xf5, 0xb9, 0xf3, 0x27, 0xe0, 0xf7, 0xf7, 0x7c,
-	0x36, 0xf2, 0x60, 0xe2, 0xde, 0x6c, 0xa7, 0x92, 0xf3, 0x75, 0x58, 0xc8, 0x4e, 0x98, 0x50, 0xbf,
-	0x09, 0xca, 0x13, 0xf8, 0x0e, 0x4a, 0xcb, 0xac, 0xcb, 0x12, 0xef, 0xa0, 0x74, 0xe9, 0x38, 0x87,
-	0x11, 0x48, 0x49, 0x0e, 0x78, 0xa9, 0xc8, 0x7a, 0x55, 0x7a, 0xda, 0x69, 0xd8, 0xc5, 0x89, 0xc3,
-	0x88, 0x1e, 0x6a, 0xf6, 0xea, 0x54, 0xbb, 0xa3, 0x2a, 0x26, 0xea, 0xa2, 0x44, 0x5d, 0xcd, 0xad,
-	0x38, 0xe2, 0xea, 0x54, 0x88, 0x57, 0xce, 0x9f, 0xdd, 0xfe, 0xef, 0x2f, 0x6e, 0xac, 0xfd, 0xec,
-	0x8b, 0x1b, 0x6b, 0xff, 0xfb, 0xc5, 0x8d, 0xb5, 0x9f, 0x7c, 0x79, 0xe3, 0x6b, 0x3f, 0xfb, 0xf2,
-	0xc6, 0xd7, 0xfe, 0xe7, 0xcb, 0x1b, 0x5f, 0xfb, 0xfc, 0xeb, 0x95, 0xda, 0x8b, 0xbf, 0xfe, 0xf9,
-	0xa2, 0xe4, 0x82, 0x3f, 0xf9, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x77, 0xa9, 0x88, 0x92, 0x3c,
-	0x8b, 0x00, 0x00,
+	// 6860 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x9d, 0xdd, 0x6f, 0x1d, 0xdb,
+	0x55, 0xc0, 0x6b, 0x1e, 0x28, 0x9c, 0xd2, 0x02, 0xa7, 0xed, 0xa5, 0xbd, 0xb4, 0xb9, 0x49, 0x6e,
+	0x12, 0xdb, 0xb1, 0x3d, 0xf6, 0x4d, 0xee, 0x17, 0x2d, 0x12, 0x9c, 0xd8, 0x89, 0xeb, 0x36, 0x4e,
+	0x8c, 0x8f, 0x93, 0x2b, 0x2a, 0x21, 0x31, 0x3e, 0xb3, 0x7d, 0x3c, 0x78, 0x3c, 0x33, 0x9d, 0x99,
+	0x73, 0x92, 0x53, 0x04, 0x02, 0x81, 0x40, 0x54, 0x20, 0x2a, 0xbe, 0x04, 0x4f, 0x48, 0xfc, 0x05,
+	0x3c, 0xf1, 0x37, 0xf0, 0xd8, 0x47, 0x1e, 0x51, 0xfb, 0x8f, 0xa0, 0xfd, 0xbd, 0xf7, 0xda, 0x6b,
+	0xed, 0x19, 0x97, 0x87, 0x28, 0x92, 0xd7, 0x6f, 0xad, 0xb5, 0xbf, 0xf7, 0xda, 0x1f, 0xb3, 0xcf,
+	0xe8, 0xbd, 0xfa, 0x7c, 0xb7, 0x6e, 0xaa, 0xae, 0x6a, 0x77, 0x5b, 0xd6, 0x2c, 0xf3, 0x19, 0xd3,
+	0xff, 0x27, 0xe2, 0xcf, 0xe3, 0xcf, 0xa7, 0xe5, 0xaa, 0x5b, 0xd5, 0xec, 0xdd, 0xaf, 0x59, 0x72,
+	0x56, 0x5d, 0x5f, 0xa7, 0x65, 0xd6, 0x4a, 0xe4, 0xdd, 0x77, 0xac, 0x84, 0x2d, 0x59, 0xd9, 0xa9,
+	0xbf, 0x3f, 0xfa, 0xd1, 0x7f, 0xfd, 0xc2, 0xe8, 0x4b, 0xfb, 0x45, 0xce, 0xca, 0x6e, 0x5f, 0x69,
+	0x8c, 0xbf, 0x3f, 0xfa, 0xe2, 0xa4, 0xae, 0x0f, 0x59, 0xf7, 0x9a, 0x35, 0x6d, 0x5e, 0x95, 0xe3,
+	0xf7, 0x13, 0xe5, 0x20, 0x39, 0xad, 0x67, 0xc9, 0xa4, 0xae, 0x13, 0x2b, 0x4c, 0x4e, 0xd9, 0x0f,
+	0x16, 0xac, 0xed, 0xde, 0xbd, 0x17, 0x87, 0xda, 0xba, 0x2a, 0x5b, 0x36, 0xbe, 0x18, 0xfd, 0xfa,
+	0xa4, 0xae, 0xa7, 0xac, 0x3b, 0x60, 0x3c, 0x03, 0xd3, 0x2e, 0xed, 0xd8, 0x78, 0x3d, 0x50, 0xf5,
+	0x01, 0xe3, 0x63, 0xa3, 0x1f, 0x54, 0x7e, 0xce, 0x46, 0x5f, 0xe0, 0x7e, 0x2e, 0x17, 0x5d, 0x56,
+	0xbd, 0x29, 0xc7, 0x77, 0x42, 0x45, 0x25, 0x32, 0xb6, 0xef, 0xc6, 0x10, 0x65, 0xf5, 0xb3, 0xd1,
+	0xaf, 0x7c, 0x96, 0x16, 0x05, 0xeb, 0xf6, 0x1b, 0xc6, 0x13, 0xee, 0xeb, 0x48, 0x51, 0x22, 0x65,
+	0xc6, 0xee, 0xfb, 0x51, 0x46, 0x19, 0xfe, 0xfe, 0xe8, 0x8b, 0x52, 0x72, 0xca, 0x66, 0xd5, 0x92,
+	0x35, 0x63, 0x54, 0x4b, 0x09, 0x89, 0x22, 0x0f, 0x20, 0x68, 0x7b, 0xbf, 0x2a, 0x97, 0xac, 0xe9,
+	0x70, 0xdb, 0x4a, 0x18, 0xb7, 0x6d, 0x21, 0x65, 0xfb, 0x6f, 0xd6, 0x46, 0xdf, 0x98, 0xcc, 0x66,
+	0xd5, 0xa2, 0xec, 0x9e, 0x57, 0xb3, 0xb4, 0x78, 0x9e, 0x97, 0x57, 0x2f, 0xd8, 0x9b, 0xfd, 0x4b,
+	0xce, 0x97, 0x73, 0x36, 0x7e, 0xec, 0x97, 0xaa, 0x44, 0x13, 0xc3, 0x26, 0x2e, 0x6c, 0x7c, 0x7f,
+	0x78, 0x33, 0x25, 0x95, 0x96, 0xbf, 0x5f, 0x1b, 0xdd, 0x82, 0x69, 0x99, 0x56, 0xc5, 0x92, 0xd9,
+	0xd4, 0x7c, 0xd4, 0x63, 0xd8, 0xc7, 0x4d, 0x7a, 0x3e, 0xbe, 0xa9, 0x9a, 0x4a, 0xd1, 0x9f, 0xad,
+	0x8d, 0xbe, 0x0e, 0x53, 0x24, 0x6b, 0x7e, 0x52, 0xd7, 0xe3, 0xbd, 0x1e, 0xab, 0x86, 0x34, 0xe9,
+	0xf8, 0xe0, 0x06, 0x1a, 0x2a, 0x09, 0x7f, 0x32, 0xfa, 0x1a, 0x4c, 0xc1, 0xf3, 0xbc, 0xed, 0x26,
+	0x75, 0xdd, 0x8e, 0x77, 0x7b, 0xcc, 0x69, 0xd0, 0xf8, 0xdf, 0x1b, 0xae, 0x10, 0x29, 0x81, 0x53,
+	0xb6, 0xac, 0xae, 0x06, 0x95, 0x80, 0x21, 0x07, 0x97, 0x80, 0xab, 0xa1, 0x92, 0x50, 0x8c, 0xbe,
+	0xec, 0xf6, 0xd9, 0x29, 0x6b, 0xc5, 0x98, 0xb6, 0x49, 0x77, 0x4b, 0x85, 0x18, 0xa7, 0x0f, 0x87,
+	0xa0, 0xca, 0x5b, 0x3e, 0x1a, 0x2b, 0x6f, 0x45, 0xd5, 0x1a, 0x67, 0x1b, 0xa8, 0x05, 0x87, 0x30,
+	0xbe, 0x36, 0x07, 0x90, 0xca, 0xd5, 0x1f, 0x8e, 0x7e, 0xf5, 0xb3, 0xaa, 0xb9, 0x6a, 0xeb, 0x74,
+	0xc6, 0xd4, 0x78, 0x74, 0xdf, 0xd7, 0xd6, 0x52, 0x38, 0x24, 0x3d, 0xe8, 0xc3, 0x9c, 0x91, 0x43,
+	0x0b, 0x5f, 0xd6, 0x0c, 0x4e, 0x04, 0x56, 0x91, 0x0b, 0xa9, 0x91, 0x03, 0x42, 0xca, 0xf6, 0xd5,
+	0x68, 0x6c, 0x6d, 0x9f, 0xff, 0x11, 0x9b, 0x75, 0x93, 0x2c, 0x83, 0xb5, 0x62, 0x75, 0x05, 0x91,
+	0x4c, 0xb2, 0x8c, 0xaa, 0x15, 0x1c, 0x55, 0xce, 0xde, 0x8c, 0xde, 0x01, 0xce, 0x44, 0x53, 0xcd,
+	0xb2, 0xf1, 0x4e, 0xdc, 0x8a, 0xc2, 0x8c, 0xd3, 0x64, 0x28, 0xee, 0xb4, 0x7f, 0xc4, 0xf3, 0x29,
+	0xbb, 0xae, 0x96, 0x0c, 0xb4, 0x7f, 0xd4, 0x9a, 0x24, 0x89, 0xf6, 0x1f, 0xd7, 0x40, 0x9a, 0xc9,
+	0x94, 0x15, 0x6c, 0xd6, 0x91, 0xcd, 0x44, 0x8a, 0x7b, 0x9b, 0x89, 0xc1, 0x9c, 0x1e, 0xa6, 0x85,
+	0x87, 0xac, 0xdb, 0x5f, 0x34, 0x0d, 0x2b, 0x3b, 0xb2, 0x2e, 0x2d, 0xd2, 0x5b, 0x97, 0x1e, 0x8a,
+	0xe4, 0xe7, 0x90, 0x75, 0x93, 0xa2, 0x20, 0xf3, 0x23, 0xc5, 0xbd, 0xf9, 0x31, 0x98, 0xf2, 0x30,
+	0x1b, 0xfd, 0x9a, 0x53, 0x62, 0xdd, 0x51, 0x79, 0x51, 0x8d, 0xe9, 0xb2, 0x10, 0x72, 0xe3, 0x63,
+	0xbd, 0x97, 0x43, 0xb2, 0xf1, 0xf4, 0x6d, 0x5d, 0x35, 0x74, 0xb5, 0x48, 0x71, 0x6f, 0x36, 0x0c,
+	0xa6, 0x3c, 0xfc, 0xc1, 0xe8, 0x4b, 0x6a, 0x80, 0xd4, 0x41, 0xc5, 0x3d, 0x74, 0xf4, 0x84, 0x51,
+	0xc5, 0xfd, 0x1e, 0x2a, 0x30, 0x7f, 0x9c, 0xcf, 0x1b, 0x3e, 0xfa, 0xe0, 0xe6, 0x95, 0xb4, 0xc7,
+	0xbc, 0xa5, 0x94, 0xf9, 0x6a, 0xf4, 0x15, 0xdf, 0xfc, 0x7e, 0x5a, 0xce, 0x58, 0x31, 0x7e, 0x18,
+	0x53, 0x97, 0x8c, 0x71, 0xb5, 0x35, 0x88, 0xb5, 0x83, 0x9d, 0x22, 0xd4, 0x60, 0xfa, 0x3e, 0xaa,
+	0x0d, 0x86, 0xd2, 0x7b, 0x71, 0x28, 0xb0, 0x7d, 0xc0, 0x0a, 0x46, 0xda, 0x96, 0xc2, 0x1e, 0xdb,
+	0x06, 0x52, 0xb6, 0x9b, 0xd1, 0x57, 0x4d, 0x35, 0xf3, 0xe0, 0x4c, 0xc8, 0xf9, 0xa4, 0xb3, 0x45,
+	0xd4, 0xa3, 0x0b, 0x19, 0x5f, 0xdb, 0xc3, 0xe0, 0x20, 0x3f, 0x6a, 0x44, 0xc1, 0xf3, 0x03, 0xc6,
+	0x93, 0x7b, 0x71, 0x48, 0xd9, 0xfe, 0xd1, 0xda, 0xe8, 0x9b, 0x4a, 0xf6, 0xb4, 0x4c, 0xcf, 0x0b,
+	0x26, 0x66, 0xf7, 0x17, 0xac, 0x7b, 0x53, 0x35, 0x57, 0xd3, 0x55, 0x39, 0x23, 0x62, 0x4a, 0x1c,
+	0xee, 0x89, 0x29, 0x49, 0x25, 0x95, 0x98, 0x3f, 0x36, 0xe1, 0xd3, 0xfe, 0x65, 0x5a, 0xce, 0xd9,
+	0x77, 0xdb, 0xaa, 0x9c, 0xd4, 0xf9, 0x24, 0xcb, 0x9a, 0x71, 0x82, 0x57, 0x3d, 0xe4, 0x4c, 0x0a,
+	0x76, 0x07, 0xf3, 0xce, 0x1a, 0x46, 0x95, 0x72, 0x57, 0xd5, 0x70, 0x0d, 0xa3, 0x8b, 0xaf, 0xab,
+	0x6a, 0x6a, 0x0d, 0xe3, 0x23, 0x81, 0xd5, 0x63, 0x3e, 0x07, 0xe1, 0x56, 0x8f, 0xdd, 0x49, 0xe7,
+	0x6e, 0x0c, 0xb1, 0x73, 0x80, 0x2e, 0xa8, 0xaa, 0xbc, 0xc8, 0xe7, 0xaf, 0xea, 0x8c, 0xf7, 0xa1,
+	0x4d, 0x3c, 0xcf, 0x0e, 0x42, 0xcc, 0x01, 0x04, 0xaa, 0xbc, 0xfd, 0x9d, 0x0d, 0xf5, 0xd5, 0xb8,
+	0xf4, 0xac, 0xa9, 0xae, 0x9f, 0xb3, 0x79, 0x3a, 0x5b, 0xa9, 0xc1, 0xf4, 0xc3, 0xd8, 0x28, 0x06,
+	0x69, 0x93, 0x88, 0x8f, 0x6e, 0xa8, 0xa5, 0xd2, 0xf3, 0xef, 0x6b, 0xa3, 0x7b, 0x5e, 0x3b, 0x51,
+	0x8d, 0x49, 0xa6, 0x7e, 0x52, 0x66, 0xa7, 0xac, 0xed, 0xd2, 0xa6, 0x1b, 0x7f, 0x2b, 0xd2, 0x06,
+	0x08, 0x1d, 0x93, 0xb6, 0x6f, 0xff, 0x5c, 0xba, 0xb6, 0xd6, 0xa7, 0x75, 0x3a, 0x63, 0x6a, 0xfc,
+	0xf1, 0x6b, 0x5d, 0x48, 0xe0, 0xe8, 0x73, 0x37, 0x86, 0xd8, 0x5a, 0x17, 0x82, 0xa3, 0x72, 0x99,
+	0x77, 0xec, 0x90, 0x95, 0xac, 0x09, 0x6b, 0x5d, 0xaa, 0xfa, 0x08, 0x51, 0xeb, 0x04, 0x6a, 0xf7,
+	0x0e, 0x1c, 0x6f, 0x32, 0xe3, 0x60, 0xef, 0xc0, 0x35, 0x20, 0x01, 0x62, 0xef, 0x00, 0x05, 0xed,
+	0x88, 0xea, 0xe5, 0xca, 0x44, 0x34, 0x5b, 0x91, 0xc4, 0x06, 0x31, 0xcd, 0xf6, 0x30, 0x98, 0x28,
+	0xc9, 0xee, 0x90, 0x1b, 0x89, 0x96, 0xa4, 0x44, 0x06, 0x95, 0xa4, 0x41, 0xd1, 0x92, 0x94, 0x8b,
+	0xa6, 0x48, 0x49, 0x4a, 0x60, 0x40, 0x49, 0x1a, 0xd0, 0x06, 0x39, 0x8e, 0x9f, 0xd7, 0x39, 0x7b,
+	0x03, 0x82, 0x1c, 0x57, 0x99, 0x8b, 0x89, 0x20, 0x07, 0xc1, 0x94, 0x87, 0x17, 0xa3, 0x5f, 0x16,
+	0xc2, 0xef, 0x56, 0x79, 0x39, 0x7e, 0x0f, 0x51, 0xe2, 0x02, 0x63, 0xf5, 0x36, 0x0d, 0x80, 0x14,
+	0xf3, 0xbf, 0xaa, 0x88, 0xe3, 0x3e, 0xa1, 0x04, 0x82, 0x8d, 0x07, 0x7d, 0x98, 0x8d, 0x2e, 0x85,
+	0x90, 0x8f, 0xca, 0xd3, 0xcb, 0xb4, 0xc9, 0xcb, 0xf9, 0x18, 0xd3, 0x75, 0xe4, 0x44, 0x74, 0x89,
+	0x71, 0xa0, 0x39, 0x29, 0xc5, 0x49, 0x5d, 0x37, 0x7c, 0xb0, 0xc7, 0x9a, 0x93, 0x8f, 0x44, 0x9b,
+	0x53, 0x80, 0xe2, 0xde, 0x0e, 0xd8, 0xac, 0xc8, 0xcb, 0xa8, 0x37, 0x85, 0x0c, 0xf1, 0x66, 0x51,
+	0xd0, 0x78, 0x9f, 0xb3, 0x74, 0xc9, 0x74, 0xce, 0xb0, 0x92, 0x71, 0x81, 0x68, 0xe3, 0x05, 0xa0,
+	0x5d, 0xca, 0x0b, 0xf1, 0x71, 0x7a, 0xc5, 0x78, 0x01, 0x33, 0x1e, 0x2a, 0x8c, 0x31, 0x7d, 0x8f,
+	0x20, 0x96, 0xf2, 0x38, 0xa9, 0x5c, 0x2d, 0x46, 0xef, 0x08, 0xf9, 0x49, 0xda, 0x74, 0xf9, 0x2c,
+	0xaf, 0xd3, 0x52, 0x2f, 0x11, 0xb1, 0x51, 0x24, 0xa0, 0x8c, 0xcb, 0x9d, 0x81, 0xb4, 0x72, 0xfb,
+	0x2f, 0x6b, 0xa3, 0x3b, 0xd0, 0xef, 0x09, 0x6b, 0xae, 0x73, 0xb1, 0xd3, 0xd0, 0xaa, 0x11, 0xf6,
+	0x93, 0xb8, 0xd1, 0x40, 0xc1, 0xa4, 0xe6, 0xd3, 0x9b, 0x2b, 0xaa, 0x84, 0xbd, 0x1d, 0xfd, 0x46,
+	0x50, 0x1e, 0x55, 0xc1, 0xa6, 0xac, 0x1b, 0xf7, 0x65, 0x51, 0x62, 0xc4, 0x82, 0x3d, 0x82, 0xdb,
+	0xc8, 0x76, 0xaa, 0xd6, 0x7d, 0x2f, 0x9b, 0x2c, 0xd8, 0x88, 0x9d, 0xea, 0xc5, 0x9c, 0x10, 0x12,
+	0x91, 0x6d, 0x00, 0x81, 0xb1, 0xe5, 0x55, 0xd9, 0x6a, 0xeb, 0xd8, 0xd8, 0x62, 0xc5, 0xd1, 0xb1,
+	0xc5, 0xc3, 0x94, 0x87, 0x4b, 0xd5, 0x35, 0x26, 0xb3, 0x2e, 0x5f, 0xe6, 0xdd, 0x8a, 0xef, 0x07,
+	0xa0, 0x2d, 0x56, 0x03, 0x62, 0xc7, 0x20, 0xda, 0x62, 0x21, 0x69, 0x77, 0x54, 0x3c, 0x4f, 0xd3,
+	0xc5, 0x79, 0x3b, 0x6b, 0xf2, 0x73, 0x86, 0x56, 0x90, 0x31, 0x62, 0xb0, 0x68, 0x05, 0xa1, 0xb8,
+	0xdd, 0xd0, 0xf4, 0x1c, 0xbf, 0x2a, 0x5b, 0xe3, 0x7a, 0x37, 0x66, 0xcb, 0x01, 0x89, 0x0d, 0xcd,
+	0xa8, 0x82, 0x72, 0xdf, 0xa9, 0xd8, 0x40, 0x53, 0xc7, 0x69, 0x73, 0x35, 0x65, 0xac, 0x44, 0x3b,
+	0xaa, 0x31, 0xa5, 0xa9, 0x68, 0x47, 0xc5, 0x68, 0x30, 0xc0, 0x4e, 0x16, 0x59, 0xde, 0x3d, 0xaf,
+	0xe6, 0x2a, 0xc6, 0x45, 0xeb, 0xcb, 0x43, 0xa2, 0x03, 0x6c, 0x80, 0xda, 0x19, 0xea, 0x64, 0x71,
+	0x5e, 0xe4, 0xed, 0x65, 0x5e, 0xce, 0xd5, 0x62, 0xd8, 0x6f, 0x81, 0x56, 0x0c, 0xd7, 0xc3, 0xeb,
+	0xbd, 0x1c, 0xe6, 0x44, 0x0d, 0x76, 0xa4, 0x13, 0x30, 0xcc, 0xad, 0xf7, 0x72, 0x76, 0x8f, 0xc2,
+	0x4a, 0x45, 0x67, 0xb8, 0x47, 0xa9, 0x7a, 0x1d, 0xe1, 0x7e, 0x0f, 0x65, 0xf7, 0x28, 0xdc, 0x3c,
+	0xb4, 0xfc, 0x18, 0xe0, 0x55, 0x93, 0x83, 0x3d, 0x0a, 0x2f, 0x7d, 0x9a, 0x21, 0xf6, 0x28, 0x28,
+	0xd6, 0xb6, 0x03, 0x4b, 0x1c, 0xb2, 0x6e, 0xda, 0xa5, 0xdd, 0xa2, 0x05, 0xed, 0xc0, 0xb1, 0x61,
+	0x10, 0xa2, 0x1d, 0x10, 0xa8, 0xf2, 0xf6, 0x7b, 0xa3, 0x91, 0xdc, 0x57, 0x14, 0x7b, 0xbf, 0x7e,
+	0xec, 0x24, 0x05, 0xfe, 0xc6, 0xef, 0x9d, 0x08, 0x61, 0x87, 0x57, 0xf9, 0xf7, 0x53, 0x76, 0xd1,
+	0xb0, 0xf6, 0x12, 0x0c, 0xaf, 0x4a, 0x47, 0x09, 0x89, 0xe1, 0x35, 0x80, 0x6c, 0xd8, 0x2e, 0x45,
+	0x7c, 0x09, 0x7f, 0x90, 0xa7, 0xf3, 0xb2, 0x6a, 0xbb, 0x7c, 0xd6, 0x82, 0xb0, 0x5d, 0xa9, 0x03,
+	0x88, 0x08, 0xdb, 0x49, 0xd8, 0xc6, 0x22, 0x12, 0x79, 0x56, 0x35, 0x33, 0x76, 0xca, 0x5a, 0xbe,
+	0x3f, 0xb1, 0x8e, 0x99, 0x70, 0x00, 0x22, 0x16, 0x41, 0x41, 0xbb, 0x7c, 0x93, 0x62, 0x71, 0x14,
+	0x30, 0x46, 0x4b, 0x5a, 0x88, 0x88, 0xe5, 0x1b, 0x40, 0x60, 0x05, 0x4f, 0x2f, 0xab, 0x37, 0x78,
+	0x05, 0x73, 0x49, 0xbc, 0x82, 0x15, 0x61, 0x4f, 0x48, 0x55, 0x42, 0xb1, 0x13, 0x52, 0x9d, 0x8c,
+	0xd8, 0x09, 0x29, 0x64, 0x6c, 0x5f, 0x73, 0x0d, 0x3f, 0xa9, 0xaa, 0xab, 0xeb, 0xb4, 0xb9, 0x02,
+	0x7d, 0xcd, 0x53, 0xd6, 0x0c, 0xd1, 0xd7, 0x28, 0xd6, 0xf6, 0x35, 0xd7, 0x21, 0x5f, 0xfc, 0xbf,
+	0x6a, 0x0a, 0xd0, 0xd7, 0x3c, 0x1b, 0x0a, 0x21, 0xfa, 0x1a, 0x81, 0xda, 0xd8, 0xc0, 0xf5, 0xc6,
+	0x23, 0x9d, 0xfb, 0xb4, 0xba, 0x1b, 0xe1, 0x3c, 0xe8, 0xc3, 0x60, 0x13, 0x3a, 0x6c, 0xd2, 0xfa,
+	0x12, 0x6f, 0x42, 0x42, 0x14, 0x6f, 0x42, 0x1a, 0x81, 0xf5, 0x3d, 0x65, 0x69, 0x33, 0xbb, 0xc4,
+	0xeb, 0x5b, 0xca, 0xe2, 0xf5, 0x6d, 0x18, 0x58, 0xdf, 0x52, 0xf0, 0x59, 0xde, 0x5d, 0x1e, 0xb3,
+	0x2e, 0xc5, 0xeb, 0xdb, 0x67, 0xe2, 0xf5, 0x1d, 0xb0, 0x76, 0xab, 0x4f, 0x75, 0xe5, 0x9c, 0xef,
+	0x9f, 0xd4, 0x05, 0x8f, 0x3f, 0x1b, 0xb6, 0xe4, 0x8b, 0xd6, 0x04, 0xed, 0xa8, 0x01, 0x47, 0x6c,
+	0xf5, 0xc5, 0x78, 0xbb, 0x00, 0x08, 0x9c, 0x4f, 0xea, 0xba, 0x58, 0x8d, 0xb7, 0x7b, 0x4c, 0x09,
+	0x8a, 0x88, 0x2b, 0x68, 0x3a, 0x18, 0x32, 0x45, 0xa1, 0xd8, 0x20, 0x2e, 0x52, 0x72, 0x61, 0x08,
+	0xb7, 0x3d, 0x0c, 0x56, 0x3e, 0x7f, 0xbc, 0x36, 0x7a, 0x4f, 0x37, 0xf5, 0xaa, 0x6d, 0x55, 0xb4,
+	0xed, 0xbb, 0xff, 0x08, 0x6f, 0xd3, 0x04, 0x4e, 0x9c, 0xd3, 0x0f, 0x50, 0x73, 0xd6, 0x41, 0x78,
+	0x92, 0xdc, 0xe8, 0xf2, 0x93, 0x21, 0xd6, 0xb1, 0x28, 0xf3, 0xd3, 0x9b, 0x2b, 0xda, 0x25, 0xa8,
+	0xaa, 0x1f, 0x2d, 0x3b, 0xca, 0xda, 0x31, 0x3a, 0x6d, 0xb8, 0x04, 0x11, 0xd0, 0xe3, 0x24, 0x6c,
+	0x0a, 0x87, 0x4d, 0xb5, 0xa8, 0xdb, 0x9e, 0xa6, 0x00, 0xa0, 0x78, 0x53, 0x08, 0x61, 0xbb, 0xcc,
+	0x73, 0x9b, 0x9f, 0x5b, 0xd8, 0x3b, 0x74, 0x9b, 0xc2, 0x8a, 0x38, 0x19, 0x8a, 0xdb, 0xe8, 0x53,
+	0x7b, 0xee, 0x0e, 0x58, 0x97, 0xe6, 0x45, 0x3b, 0x7e, 0x80, 0xdb, 0xd0, 0x72, 0x22, 0xfa, 0xc4,
+	0x38, 0x38, 0xa6, 0x1f, 0x2c, 0xea, 0x22, 0x9f, 0x85, 0x07, 0xf4, 0x4a, 0xd7, 0x88, 0xe3, 0x63,
+	0xba, 0x8b, 0xc1, 0x4a, 0x3b, 0x6b, 0xd2, 0xb2, 0xbd, 0x60, 0xcd, 0x59, 0x25, 0x9a, 0x14, 0x5e,
+	0x69, 0x00, 0x8a, 0x57, 0x5a, 0x08, 0xc3, 0x79, 0x91, 0x2f, 0x70, 0xa5, 0xf3, 0x55, 0xcd, 0xf0,
+	0x79, 0xd1, 0x43, 0xe2, 0xf3, 0x22, 0x44, 0x61, 0x19, 0x4e, 0x59, 0xf7, 0x3c, 0x5d, 0x55, 0x0b,
+	0x62, 0x5e, 0x34, 0xe2, 0x78, 0x19, 0xba, 0x18, 0x1c, 0x7a, 0xc5, 0x11, 0x6d, 0xc7, 0x9a, 0x32,
+	0x2d, 0x9e, 0x15, 0xe9, 0xbc, 0x1d, 0x13, 0xe3, 0x9a, 0x4f, 0xc5, 0x87, 0x5e, 0x84, 0x46, 0x8a,
+	0xf1, 0xa8, 0x7d, 0x96, 0x2e, 0xab, 0x26, 0xef, 0xe8, 0x62, 0xb4, 0x48, 0x6f, 0x31, 0x7a, 0x28,
+	0xea, 0x6d, 0xd2, 0xcc, 0x2e, 0xf3, 0x25, 0xcb, 0x22, 0xde, 0x34, 0x32, 0xc0, 0x9b, 0x83, 0x22,
+	0x95, 0x36, 0xad, 0x16, 0xcd, 0x8c, 0x91, 0x95, 0x26, 0xc5, 0xbd, 0x95, 0x66, 0x30, 0xe5, 0xe1,
+	0x2f, 0xd7, 0x46, 0xbf, 0x29, 0xa5, 0xee, 0x49, 0xfd, 0x41, 0xda, 0x5e, 0x9e, 0x57, 0x69, 0x93,
+	0x8d, 0x3f, 0xc0, 0xec, 0xa0, 0xa8, 0x71, 0xfd, 0xe8, 0x26, 0x2a, 0xb0, 0x58, 0xf9, 0xba, 0xd0,
+	0xf6, 0x72, 0xb4, 0x58, 0x3d, 0x24, 0x5e, 0xac, 0x10, 0x85, 0x83, 0x96, 0x90, 0xcb, 0x83, 0x9c,
+	0x07, 0xa4, 0xbe, 0x7f, 0x9a, 0xb3, 0xde, 0xcb, 0xc1, 0x31, 0x99, 0x0b, 0xfd, 0xd6, 0xb2, 0x43,
+	0xd9, 0xc0, 0x5b, 0x4c, 0x32, 0x14, 0x27, 0x3d, 0x9b, 0x5e, 0x11, 0xf7, 0x1c, 0xf4, 0x8c, 0x64,
+	0x28, 0x4e, 0x78, 0x76, 0x86, 0xb5, 0x98, 0x67, 0x64, 0x68, 0x4b, 0x86, 0xe2, 0x30, 0xca, 0x55,
+	0x8c, 0x9e, 0x8b, 0x1e, 0x46, 0xec, 0xc0, 0xf9, 0x68, 0x6b, 0x10, 0xab, 0x1c, 0xfe, 0xf5, 0xda,
+	0xe8, 0x1b, 0xd6, 0xe3, 0x71, 0x95, 0xe5, 0x17, 0x2b, 0x09, 0xbd, 0x4e, 0x8b, 0x05, 0x6b, 0xc7,
+	0x8f, 0x28, 0x6b, 0x21, 0x6b, 0x52, 0xf0, 0xf8, 0x46, 0x3a, 0xb0, 0xef, 0x88, 0x98, 0xf4, 0x8c,
+	0x5d, 0xd7, 0x05, 0xd9, 0x77, 0x3c, 0x24, 0xde, 0x77, 0x20, 0x0a, 0x57, 0x3f, 0x67, 0x15, 0x5f,
+	0x5b, 0xa1, 0xab, 0x1f, 0x21, 0x8a, 0xaf, 0x7e, 0x34, 0x02, 0xe3, 0xb3, 0xb3, 0x6a, 0xbf, 0x2a,
+	0x0a, 0x36, 0xeb, 0xc2, 0xdb, 0x7e, 0x46, 0xd3, 0x12, 0xf1, 0xf8, 0x0c, 0x90, 0x70, 0xa7, 0x41,
+	0x1c, 0x1f, 0x3c, 0x59, 0xf1, 0xeb, 0x8e, 0xf8, 0x4e, 0x83, 0x03, 0xc4, 0x77, 0x1a, 0x7c, 0x10,
+	0xee, 0x09, 0xbc, 0x2a, 0xb3, 0x0a, 0xdf, 0x13, 0xe0, 0x92, 0xf8, 0x9e, 0x80, 0x22, 0xa0, 0xc9,
+	0x53, 0x46, 0x99, 0x3c, 0x65, 0x7d, 0x26, 0x4f, 0x99, 0x6b, 0xd2, 0x1b, 0x0a, 0xd5, 0x6e, 0x28,
+	0x39, 0x14, 0x82, 0xad, 0xd0, 0xf5, 0x5e, 0x0e, 0xae, 0x6d, 0x95, 0x03, 0xb4, 0x45, 0x00, 0xe3,
+	0xef, 0x47, 0x19, 0xd8, 0xf4, 0xf5, 0xae, 0xc3, 0x33, 0xd6, 0xcd, 0x2e, 0xf1, 0xa6, 0xef, 0x21,
+	0xf1, 0xa6, 0x0f, 0x51, 0x98, 0x8d, 0xa3, 0x6b, 0x3a, 0x1b, 0x52, 0x16, 0xcf, 0x86, 0x61, 0x60,
+	0x25, 0x48, 0x81, 0xd8, 0x5f, 0x7d, 0x40, 0x2b, 0x7a, 0x3b, 0xac, 0xeb, 0xbd, 0x9c, 0x72, 0xf2,
+	0x4f, 0x66, 0xb9, 0x28, 0xa5, 0x2f, 0x2a, 0xde, 0x2f, 0x5e, 0xa7, 0x45, 0x9e, 0xa5, 0x1d, 0x3b,
+	0xab, 0xae, 0x58, 0x89, 0xaf, 0xcc, 0x54, 0x6a, 0x25, 0x9f, 0x78, 0x0a, 0xf1, 0x95, 0x59, 0x5c,
+	0x11, 0x56, 0xa1, 0xa4, 0x5f, 0xb5, 0x6c, 0x3f, 0x6d, 0x89, 0xd1, 0xcb, 0x43, 0xe2, 0x55, 0x08,
+	0x51, 0x18, 0xa3, 0x4a, 0xf9, 0xd3, 0xb7, 0x35, 0x6b, 0x72, 0x56, 0xce, 0x18, 0x1e, 0xa3, 0x42,
+	0x2a, 0x1e, 0xa3, 0x22, 0x34, 0x5c, 0x5e, 0x1c, 0xa4, 0x1d, 0x7b, 0xb2, 0x3a, 0xcb, 0xaf, 0x59,
+	0xdb, 0xa5, 0xd7, 0x35, 0xbe, 0xbc, 0x00, 0x50, 0x7c, 0x79, 0x11, 0xc2, 0xc1, 0xa2, 0x29, 0xed,
+	0xf8, 0xf9, 0x5f, 0x4b, 0x2d, 0x9a, 0xb4, 0xb8, 0x67, 0xd1, 0xe4, 0x60, 0xc1, 0xc6, 0x9e, 0x19,
+	0x66, 0xc3, 0xab, 0xc7, 0x90, 0x88, 0x5c, 0x3d, 0x26, 0x50, 0x58, 0x75, 0x16, 0x40, 0x8f, 0x76,
+	0x03, 0x2b, 0xd1, 0xa3, 0x5d, 0x9a, 0x0e, 0xb6, 0x4b, 0x0d, 0x33, 0xe5, 0x9d, 0xbf, 0x27, 0xe9,
+	0x53, 0x77, 0x10, 0xd8, 0x1a, 0xc4, 0xe2, 0xfb, 0xb3, 0xa7, 0xac, 0x48, 0xc5, 0x64, 0x18, 0xd9,
+	0x04, 0xd5, 0xcc, 0x90, 0xfd, 0x59, 0x87, 0x55, 0x0e, 0xff, 0x7c, 0x6d, 0xf4, 0x2e, 0xe6, 0xf1,
+	0x65, 0x2d, 0xfc, 0xee, 0xf5, 0xdb, 0x7a, 0x59, 0x7b, 0xde, 0x3f, 0xb8, 0x81, 0x86, 0xdd, 0x33,
+	0xd4, 0x22, 0x7b, 0xf5, 0x5a, 0x25, 0xc0, 0x0f, 0x05, 0x4d, 0xfa, 0x21, 0x47, 0xec, 0x19, 0xc6,
+	0x78, 0xdb, 0x53, 0xfc, 0x74, 0xb5, 0xa0, 0xa7, 0x18, 0x1b, 0x4a, 0x4c, 0xf4, 0x14, 0x04, 0xb3,
+	0x87, 0xbc, 0xbe, 0x07, 0x73, 0x2a, 0xbe, 0x13, 0xb3, 0x10, 0x9e, 0x8f, 0x27, 0x43, 0x71, 0x3b,
+	0xf0, 0xb8, 0xe5, 0xca, 0x37, 0x6b, 0x45, 0xf8, 0x08, 0x06, 0x1e, 0xaf, 0x90, 0x0c, 0x44, 0x0c,
+	0x3c, 0x24, 0x0c, 0x03, 0x2c, 0x0d, 0xf2, 0x41, 0x01, 0x9b, 0xa6, 0x8c, 0x21, 0x77, 0x48, 0xd8,
+	0xe8, 0x07, 0x61, 0x47, 0xd1, 0x62, 0xb5, 0x92, 0x7b, 0x18, 0xb3, 0x00, 0x56, 0x73, 0x5b, 0x83,
+	0x58, 0xe5, 0xf0, 0x4f, 0x47, 0x5f, 0x0f, 0x32, 0xf6, 0x8c, 0xa5, 0xdd, 0xa2, 0x61, 0xd9, 0x78,
+	0xb7, 0x27, 0xdd, 0x1a, 0x24, 0x8e, 0xcc, 0xa3, 0x0a, 0xc1, 0x92, 0x43, 0x73, 0xb2, 0x3d, 0x9b,
+	0x34, 0x3c, 0x8a, 0x99, 0xf4, 0xd9, 0xe8, 0x92, 0x83, 0xd6, 0x09, 0x76, 0x0d, 0xdc, 0xd6, 0x35,
+	0x59, 0xa6, 0x79, 0x21, 0xee, 0xf6, 0x7c, 0x10, 0x33, 0xea, 0xa1, 0xd1, 0x5d, 0x03, 0x52, 0x25,
+	0x98, 0x12, 0xc4, 0xe0, 0xe2, 0xac, 0x36, 0xb7, 0xe9, 0x21, 0x08, 0x59, 0x6c, 0xee, 0x0c, 0xa4,
+	0xed, 0xd5, 0x05, 0xfb, 0x67, 0xb7, 0x91, 0x63, 0x5e, 0x95, 0x2a, 0xd2, 0xd2, 0x77, 0x06, 0xd2,
+	0xf6, 0xbe, 0x46, 0xe8, 0x55, 0xcd, 0x80, 0xbb, 0xbd, 0xa6, 0xc0, 0x24, 0xb8, 0x37, 0x5c, 0x41,
+	0xb9, 0xff, 0x57, 0xb3, 0xb5, 0x2f, 0xfd, 0xf3, 0xcf, 0x62, 0x59, 0x99, 0xb1, 0x4c, 0x6b, 0xb4,
+	0x7c, 0x39, 0xf8, 0x29, 0x6d, 0xd7, 0x28, 0x24, 0xae, 0x86, 0x49, 0xd1, 0x6f, 0xfd, 0x1c, 0x9a,
+	0x2a, 0x69, 0xff, 0xb9, 0x36, 0xda, 0x44, 0x93, 0xa6, 0x1b, 0xae, 0x97, 0xc4, 0xdf, 0x1d, 0xe2,
+	0x08, 0xd3, 0x34, 0x49, 0x9d, 0xfc, 0x3f, 0x2c, 0xa8, 0x24, 0xff, 0xdb, 0xda, 0xe8, 0xae, 0x55,
+	0xe4, 0xcd, 0x9b, 0xdf, 0x38, 0x2e, 0xf2, 0x59, 0x27, 0x2e, 0x40, 0x28, 0x15, 0xba, 0x38, 0x29,
+	0x8d, 0xfe, 0xe2, 0x8c, 0x68, 0xaa, 0xb4, 0xfd, 0xe3, 0xda, 0xe8, 0xb6, 0x5b, 0x9c, 0xe2, 0xf6,
+	0x84, 0xdc, 0xec, 0xd5, 0x8a, 0xed, 0xf8, 0x63, 0xba, 0x0c, 0x30, 0xde, 0xa4, 0xeb, 0x93, 0x1b,
+	0xeb, 0x05, 0x3b, 0x04, 0xab, 0xda, 0x5e, 0x2a, 0xdb, 0xa0, 0xcc, 0x05, 0x33, 0xe7, 0xe6, 0x00,
+	0xd2, 0xba, 0xfa, 0x4e, 0xde, 0x76, 0x55, 0xb3, 0xe2, 0x47, 0xf2, 0xfa, 0xdb, 0x6d, 0xdf, 0x95,
+	0x02, 0x12, 0x87, 0x20, 0x5c, 0xe1, 0x64, 0xe0, 0xca, 0x7e, 0xe3, 0xdd, 0x12, 0xae, 0x1c, 0xa2,
+	0xc7, 0x95, 0x4f, 0xda, 0x69, 0x59, 0xe7, 0xca, 0x88, 0xc1, 0xb4, 0x6c, 0x92, 0x1a, 0x7e, 0x94,
+	0xbe, 0xd1, 0x0f, 0xda, 0x55, 0x81, 0x12, 0x1f, 0xe4, 0x17, 0x17, 0x26, 0x4f, 0x78, 0x4a, 0x5d,
+	0x84, 0x58, 0x15, 0x10, 0xa8, 0xdd, 0x71, 0xb4, 0x05, 0xf8, 0xa4, 0xa8, 0x66, 0x57, 0xc6, 0xe3,
+	0x0e, 0x55, 0x36, 0x1e, 0x46, 0x84, 0x56, 0x11, 0xdc, 0x86, 0x1f, 0x0a, 0x3a, 0x65, 0xfc, 0x3f,
+	0x26, 0x38, 0xb8, 0xe3, 0xa8, 0xed, 0x78, 0x0c, 0x11, 0x7e, 0x50, 0xac, 0xdd, 0x25, 0x78, 0x96,
+	0x17, 0x4c, 0x9c, 0x22, 0xbd, 0xbc, 0xb8, 0x28, 0xaa, 0x34, 0x03, 0xbb, 0x04, 0x5c, 0x9c, 0xb8,
+	0x72, 0x62, 0x97, 0x00, 0xe3, 0xec, 0xbd, 0x22, 0x2e, 0xe5, 0x23, 0x59, 0x39, 0xcb, 0x0b, 0xf8,
+	0x81, 0x95, 0xd0, 0x34, 0x42, 0xe2, 0x5e, 0x51, 0x00, 0xd9, 0x38, 0x9b, 0x8b, 0xf8, 0x08, 0xa4,
+	0xd3, 0x7f, 0x3f, 0x54, 0x74, 0xc4, 0x44, 0x9c, 0x8d, 0x60, 0x76, 0x83, 0x8c, 0x0b, 0x5f, 0xd5,
+	0xc2, 0xf8, 0xed, 0x50, 0xeb, 0x55, 0xed, 0xd9, 0xbd, 0x13, 0x21, 0xec, 0xa6, 0x0f, 0xff, 0xfb,
+	0x41, 0xf5, 0xa6, 0x14, 0x46, 0xef, 0x86, 0x2a, 0x5a, 0x46, 0x6c, 0xfa, 0x40, 0xc6, 0x76, 0x7d,
+	0x61, 0x38, 0x6f, 0x67, 0x69, 0x93, 0x9d, 0x34, 0x4c, 0x98, 0xdf, 0x40, 0x54, 0x3d, 0x82, 0xe8,
+	0xfa, 0x38, 0xe9, 0xbb, 0x3a, 0xba, 0x4e, 0xe7, 0x4c, 0x1e, 0x47, 0x56, 0xcd, 0x35, 0xe6, 0xca,
+	0x27, 0x62, 0xae, 0x02, 0x52, 0xb9, 0xfa, 0xde, 0xe8, 0x97, 0x44, 0xae, 0x9a, 0xaa, 0x1e, 0xdf,
+	0x42, 0x52, 0xd8, 0x38, 0x1f, 0x59, 0xbd, 0x47, 0xca, 0xed, 0xad, 0x43, 0xd3, 0xe2, 0x5f, 0xb5,
+	0xe9, 0x1c, 0x7e, 0x19, 0x69, 0xdb, 0xb1, 0x90, 0x12, 0xb7, 0x0e, 0x43, 0xca, 0x6f, 0xeb, 0x2f,
+	0xaa, 0x4c, 0x59, 0x47, 0xea, 0xcd, 0x08, 0x63, 0x6d, 0xdd, 0x85, 0xec, 0x28, 0x28, 0x92, 0xce,
+	0xba, 0xc9, 0xa2, 0xab, 0x4c, 0xeb, 0x41, 0x4a, 0x12, 0x20, 0xc4, 0x28, 0x48, 0xa0, 0x76, 0x6c,
+	0xe7, 0xc0, 0x7e, 0x3a, 0xbb, 0xb4, 0x2d, 0x15, 0xe9, 0xf3, 0x1e, 0x40, 0x8c, 0xed, 0x28, 0x68,
+	0x47, 0x5b, 0xe3, 0x47, 0x7e, 0x8e, 0x61, 0xbc, 0xed, 0x10, 0x46, 0x7c, 0x8c, 0x18, 0x6d, 0x23,
+	0xb8, 0xdf, 0x84, 0x55, 0x09, 0xe8, 0xe1, 0x63, 0x83, 0x2c, 0x23, 0x38, 0x82, 0x6c, 0x0e, 0x20,
+	0xed, 0x9a, 0x99, 0xcb, 0x1d, 0x99, 0xba, 0x1d, 0xba, 0x15, 0xda, 0x08, 0x20, 0x62, 0xcd, 0x4c,
+	0xc2, 0xd6, 0xe7, 0x8b, 0x74, 0x99, 0xcf, 0xcd, 0x5a, 0x4a, 0x06, 0x28, 0xd0, 0xa7, 0x65, 0x12,
+	0x07, 0x22, 0x7c, 0x92, 0xb0, 0x13, 0xe7, 0x59, 0xe6, 0x50, 0x9f, 0xab, 0xf1, 0xaf, 0xab, 0xf9,
+	0xaa, 0x9e, 0x9f, 0x66, 0xc0, 0x38, 0xcf, 0x31, 0x89, 0xf3, 0x44, 0x9c, 0x37, 0x44, 0xcf, 0xee,
+	0x04, 0xe9, 0x43, 0x27, 0x7b, 0xc3, 0x4f, 0x6a, 0x80, 0x9d, 0x20, 0x8d, 0x25, 0x90, 0x23, 0x76,
+	0x82, 0x62, 0xbc, 0x1d, 0x11, 0x8c, 0xf3, 0xa2, 0x2a, 0xe1, 0x88, 0x60, 0x2d, 0x70, 0x21, 0x31,
+	0x22, 0x04, 0x90, 0xed, 0xa3, 0x5a, 0x24, 0x8f, 0x31, 0xf8, 0x07, 0xf7, 0xeb, 0xb8, 0xaa, 0x01,
+	0x88, 0x3e, 0x8a, 0x82, 0x36, 0x2e, 0xd1, 0x62, 0x1e, 0x07, 0xa6, 0x4d, 0xce, 0x17, 0xcd, 0x30,
+	0x2e, 0x31, 0x16, 0x5c, 0x86, 0x88, 0x4b, 0x28, 0xd6, 0xd9, 0x3f, 0xd4, 0xc8, 0x51, 0x39, 0x2b,
+	0x16, 0x19, 0xe3, 0xd7, 0x7c, 0xf5, 0x95, 0xbf, 0x3d, 0xdc, 0x56, 0x48, 0x12, 0xfb, 0x87, 0x71,
+	0x8d, 0xb0, 0xd5, 0x38, 0x98, 0xbc, 0xf8, 0x97, 0xf4, 0x9a, 0xf3, 0xaf, 0xfe, 0xed, 0x0e, 0xe6,
+	0x6d, 0x5c, 0xf3, 0xdd, 0x6a, 0xc1, 0xaf, 0xa6, 0xbc, 0xac, 0x59, 0xf9, 0xa2, 0x0a, 0xae, 0x27,
+	0x29, 0x69, 0xa2, 0xc5, 0x44, 0x5c, 0x83, 0x60, 0x76, 0xf4, 0x53, 0xc2, 0x03, 0x71, 0x1b, 0x15,
+	0x3b, 0x1e, 0xd5, 0xda, 0x0e, 0x41, 0x8c, 0x7e, 0x38, 0x19, 0xb8, 0xe2, 0x17, 0xd9, 0x59, 0xc7,
+	0x17, 0x89, 0x2d, 0xe1, 0xca, 0x21, 0x7a, 0x5c, 0xf9, 0x64, 0xe0, 0x6a, 0xda, 0xeb, 0x6a, 0x3a,
+	0xd8, 0xd5, 0x94, 0x70, 0xb5, 0x9f, 0x16, 0xac, 0xcc, 0xd2, 0x46, 0x76, 0x19, 0xf1, 0x09, 0xa5,
+	0xef, 0x4a, 0x03, 0x89, 0x25, 0x08, 0x57, 0x38, 0x69, 0x83, 0x16, 0x2d, 0x57, 0xe7, 0x84, 0xf7,
+	0x70, 0x65, 0x70, 0x52, 0x78, 0xbf, 0x87, 0x0a, 0x73, 0xf2, 0x8c, 0xb1, 0x4c, 0xdd, 0x0e, 0x27,
+	0x72, 0x62, 0x89, 0xbe, 0x9c, 0x78, 0xa4, 0x5d, 0x70, 0xb8, 0xae, 0x90, 0x63, 0x49, 0x4f, 0x3d,
+	0x72, 0x2c, 0x89, 0x71, 0x78, 0x7e, 0xd4, 0x86, 0x56, 0x24, 0x3f, 0x60, 0x27, 0x6b, 0x73, 0x00,
+	0x69, 0xfb, 0xa9, 0xeb, 0xea, 0x30, 0xb8, 0x1a, 0xee, 0x69, 0x1f, 0x92, 0x57, 0xc3, 0x11, 0xcc,
+	0x79, 0xe7, 0x87, 0x9d, 0x5f, 0x56, 0xd5, 0x15, 0xfa, 0xf4, 0x85, 0x92, 0xc5, 0x9f, 0xbe, 0x08,
+	0x20, 0x7b, 0xf1, 0x42, 0x89, 0x44, 0x45, 0xdc, 0x41, 0x95, 0xbc, 0x3a, 0xb8, 0x1b, 0x43, 0x82,
+	0x14, 0xab, 0x92, 0xc7, 0x53, 0x0c, 0x0a, 0xfd, 0x5e, 0x1c, 0x72, 0x9e, 0xb3, 0x91, 0xa2, 0x03,
+	0x56, 0xe4, 0x4b, 0xd6, 0xc8, 0xcf, 0xe8, 0x36, 0x51, 0x65, 0x17, 0xa1, 0x9e, 0xb3, 0xc1, 0x51,
+	0xe5, 0xed, 0x74, 0xf4, 0x05, 0x1e, 0x4a, 0xe8, 0x69, 0xc7, 0x5f, 0xfc, 0x39, 0x12, 0x62, 0xf1,
+	0xe7, 0x13, 0xb6, 0x2f, 0xbf, 0x2a, 0xdb, 0xba, 0x48, 0xdb, 0x4b, 0x75, 0x2d, 0xdf, 0xcf, 0xb9,
+	0x16, 0xc2, 0x8b, 0xf9, 0xf7, 0x7b, 0x28, 0xdb, 0xc1, 0xb4, 0xcc, 0xc4, 0xd1, 0x0f, 0x70, 0xd5,
+	0x20, 0x80, 0x5e, 0xef, 0xe5, 0x6c, 0xcc, 0x7e, 0x98, 0x16, 0x05, 0x6b, 0x56, 0x5a, 0x76, 0x9c,
+	0x96, 0xf9, 0x05, 0x6b, 0xe1, 0x27, 0xa0, 0x8a, 0x4a, 0x20, 0x46, 0xc4, 0xec, 0x11, 0xdc, 0x46,
+	0x22, 0xc0, 0xf3, 0x51, 0x99, 0xb1, 0xb7, 0x20, 0x12, 0x81, 0x76, 0x04, 0x43, 0x44, 0x22, 0x14,
+	0xeb, 0x75, 0x91, 0x2c, 0x5d, 0x4e, 0xc5, 0x1b, 0x11, 0x41, 0x17, 0xc9, 0xd2, 0x65, 0x32, 0xf5,
+	0x9e, 0x82, 0xb8, 0x1b, 0x43, 0xec, 0xa6, 0x82, 0xb6, 0x5a, 0xd5, 0xa0, 0x5d, 0x19, 0x0d, 0x67,
+	0x59, 0x7b, 0x27, 0x42, 0x40, 0x93, 0xe2, 0x49, 0x24, 0xd4, 0xa4, 0xf7, 0x18, 0xd2, 0x9d, 0x08,
+	0x61, 0xf3, 0x2e, 0x36, 0x8c, 0xd4, 0xde, 0x87, 0xaf, 0x21, 0x24, 0x70, 0xf3, 0xe3, 0x6e, 0x0c,
+	0xb1, 0xbb, 0x1f, 0x42, 0xa0, 0xbe, 0x7a, 0x18, 0x63, 0x3a, 0x4a, 0x46, 0xec, 0x7e, 0x40, 0x06,
+	0x24, 0x57, 0x8d, 0x93, 0x58, 0x72, 0xc1, 0x28, 0x79, 0x37, 0x86, 0xd8, 0x72, 0x15, 0x82, 0x69,
+	0x5d, 0xe4, 0x1d, 0x28, 0x57, 0xa9, 0x21, 0x24, 0x44, 0xb9, 0xfa, 0x04, 0x30, 0x79, 0xcc, 0x9a,
+	0x39, 0x43, 0x4d, 0x0a, 0x49, 0xd4, 0xa4, 0x26, 0xec, 0x53, 0x0b, 0x32, 0xef, 0x55, 0xbd, 0x02,
+	0x4f, 0x2d, 0xa8, 0x6c, 0x55, 0xf5, 0x8a, 0x78, 0x6a, 0xc1, 0x03, 0x40, 0x12, 0x4f, 0xd2, 0xb6,
+	0xc3, 0x93, 0x28, 0x24, 0xd1, 0x24, 0x6a, 0xc2, 0x6e, 0xe3, 0xc8, 0x24, 0x2e, 0x3a, 0xb0, 0x8d,
+	0xa3, 0x12, 0xe0, 0xdc, 0x0f, 0x7f, 0x8f, 0x94, 0xdb, 0x51, 0x54, 0xd6, 0x0a, 0xeb, 0x9e, 0xe5,
+	0xac, 0xc8, 0x5a, 0x30, 0x8a, 0xaa, 0x72, 0xd7, 0x52, 0x62, 0x14, 0x0d, 0x29, 0xd0, 0x94, 0xd4,
+	0xe5, 0x32, 0x2c, 0x77, 0xe0, 0x6e, 0xd9, 0xdd, 0x18, 0x62, 0xc7, 0x66, 0x9d, 0xe8, 0xfd, 0xb4,
+	0x69, 0x72, 0xbe, 0x3f, 0xf4, 0x00, 0x4f, 0x90, 0x96, 0x13, 0x63, 0x33, 0xc6, 0x81, 0xee, 0xa5,
+	0x27, 0x2d, 0x2c, 0x61, 0x70, 0xda, 0x7a, 0x3f, 0xca, 0xd8, 0x50, 0x47, 0x48, 0x9c, 0x0b, 0xce,
+	0x58, 0x69, 0x22, 0xf7, 0x9b, 0x1f, 0xf4, 0x61, 0xce, 0xeb, 0x52, 0xc6, 0x05, 0x7f, 0xc2, 0xe8,
+	0xac, 0x7a, 0xfa, 0x36, 0x6f, 0x79, 0xdc, 0xad, 0x56, 0xeb, 0x8f, 0x09, 0x4b, 0x18, 0x4c, 0xbc,
+	0x2e, 0xd5, 0xab, 0x64, 0x97, 0x7f, 0x20, 0x2d, 0x2f, 0xd8, 0x1b, 0x74, 0xd3, 0x00, 0x5a, 0x34,
+	0x1c, 0xb1, 0xfc, 0x8b, 0xf1, 0xf6, 0x5a, 0x80, 0x71, 0xae, 0xde, 0x75, 0x3d, 0xab, 0xf4, 0xfe,
+	0x0d, 0x65, 0x0d, 0x82, 0xc4, 0xc9, 0x6c, 0x54, 0xc1, 0x46, 0xd0, 0xc6, 0xbf, 0xed, 0x62, 0x1b,
+	0x84, 0x9d, 0xb0, 0x9b, 0x6d, 0x0e, 0x20, 0x11, 0x57, 0xf6, 0x96, 0x3e, 0xe5, 0x2a, 0xbc, 0xa4,
+	0xbf, 0x39, 0x80, 0x74, 0xae, 0x18, 0xb8, 0xd9, 0x7a, 0x92, 0xce, 0xae, 0xe6, 0x4d, 0xb5, 0x28,
+	0xb3, 0xfd, 0xaa, 0xa8, 0x1a, 0x70, 0xc5, 0xc0, 0x4b, 0x35, 0x40, 0x89, 0x2b, 0x06, 0x3d, 0x2a,
+	0x76, 0xd7, 0xc6, 0x4d, 0xc5, 0xa4, 0xc8, 0xe7, 0xf0, 0xd4, 0xcc, 0x33, 0x24, 0x00, 0x62, 0xd7,
+	0x06, 0x05, 0x91, 0x46, 0x24, 0x4f, 0xd5, 0xba, 0x7c, 0x96, 0x16, 0xd2, 0xdf, 0x2e, 0x6d, 0xc6,
+	0x03, 0x7b, 0x1b, 0x11, 0xa2, 0x80, 0xe4, 0xf3, 0x6c, 0xd1, 0x94, 0x47, 0x65, 0x57, 0x91, 0xf9,
+	0xd4, 0x40, 0x6f, 0x3e, 0x1d, 0x10, 0x0c, 0xab, 0x67, 0xec, 0x2d, 0x4f, 0x0d, 0xff, 0x0f, 0x1b,
+	0x56, 0xf9, 0xdf, 0x13, 0x25, 0x8f, 0x0d, 0xab, 0x80, 0x03, 0x99, 0x51, 0x4e, 0x64, 0x83, 0x89,
+	0x68, 0xfb, 0xcd, 0x64, 0xa3, 0x1f, 0xc4, 0xfd, 0x4c, 0xbb, 0x55, 0xc1, 0x62, 0x7e, 0x04, 0x30,
+	0xc4, 0x8f, 0x06, 0xed, 0x42, 0xca, 0xcb, 0xcf, 0x25, 0x9b, 0x5d, 0x05, 0x1f, 0x1d, 0xf9, 0x09,
+	0x95, 0x08, 0xb1, 0x90, 0x22, 0x50, 0xbc, 0x8a, 0x8e, 0x66, 0x55, 0x19, 0xab, 0x22, 0x2e, 0x1f,
+	0x52, 0x45, 0x8a, 0xb3, 0x1b, 0xde, 0x46, 0xaa, 0x5a, 0xa6, 0xac, 0xa6, 0x2d, 0xc2, 0x82, 0x0b,
+	0x11, 0x1b, 0xde, 0x24, 0x6c, 0xd7, 0x23, 0xd0, 0xe7, 0x71, 0xf8, 0xe5, 0x7b, 0x60, 0xe5, 0x98,
+	0xfe, 0xf2, 0x9d, 0x62, 0xe9, 0x4c, 0xca, 0x36, 0xd2, 0x63, 0xc5, 0x6f, 0x27, 0xdb, 0xc3, 0x60,
+	0xbb, 0xdc, 0xf3, 0x7c, 0xee, 0x17, 0x2c, 0x6d, 0xa4, 0xd7, 0x9d, 0x88, 0x21, 0x8b, 0x11, 0xcb,
+	0xbd, 0x08, 0x0e, 0x86, 0x30, 0xcf, 0xf3, 0x7e, 0x55, 0x76, 0xac, 0xec, 0xb0, 0x21, 0xcc, 0x37,
+	0xa6, 0xc0, 0xd8, 0x10, 0x46, 0x29, 0x80, 0x76, 0xab, 0xce, 0x89, 0x5e, 0xa4, 0xd7, 0x68, 0xc4,
+	0xa6, 0xcf, 0x7e, 0xb8, 0x3c, 0xd6, 0x6e, 0x01, 0xe7, 0x6c, 0x76, 0xbb, 0x5e, 0xce, 0xd2, 0x66,
+	0x6e, 0x4e, 0x34, 0xb2, 0xf1, 0x1e, 0x6d, 0xc7, 0x27, 0x89, 0xcd, 0xee, 0xb8, 0x06, 0x18, 0x76,
+	0xc4, 0x19, 0xac, 0xce, 0x29, 0x92, 0x03, 0x21, 0x0f, 0xb2, 0xba, 0xd1, 0x0f, 0x02, 0x3f, 0xaf,
+	0xf3, 0x8c, 0x55, 0x11, 0x3f, 0x42, 0x3e, 0xc4, 0x0f, 0x04, 0x41, 0xf4, 0x26, 0x8e, 0x16, 0xe5,
+	0xcb, 0xeb, 0x65, 0xa6, 0xd6, 0xb1, 0x09, 0x51, 0x3c, 0x80, 0x8b, 0x45, 0x6f, 0x04, 0x0f, 0xfa,
+	0xa8, 0xbe, 0x99, 0x10, 0xeb, 0xa3, 0xe6, 0xe2, 0xc1, 0x90, 0x3e, 0x8a, 0xc1, 0xca, 0xe7, 0x0f,
+	0x55, 0x1f, 0x3d, 0x48, 0xbb, 0x94, 0xc7, 0xed, 0x7c, 0x03, 0x59, 0x2d, 0x84, 0x91, 0xfc, 0x6a,
+	0x2a, 0xe1, 0x18, 0x5c, 0x15, 0xef, 0x0e, 0xe6, 0x23, 0xbe, 0xd5, 0x0a, 0xa1, 0xd7, 0x37, 0x58,
+	0x2a, 0xec, 0x0e, 0xe6, 0x23, 0xbe, 0xd5, 0xfb, 0xa6, 0xbd, 0xbe, 0xc1, 0x23, 0xa7, 0xbb, 0x83,
+	0x79, 0xe5, 0xfb, 0x2f, 0x74, 0xc7, 0x75, 0x9d, 0xf3, 0x38, 0x6c, 0xd6, 0xe5, 0x4b, 0x86, 0x85,
+	0x93, 0xbe, 0x3d, 0x83, 0xc6, 0xc2, 0x49, 0x5a, 0xc5, 0xf9, 0x99, 0x07, 0x2c, 0x15, 0x27, 0x55,
+	0x9b, 0x8b, 0x33, 0x9d, 0xc7, 0x03, 0x8c, 0x6a, 0x38, 0xb6, 0x68, 0x8a, 0x29, 0xd9, 0xdb, 0xb3,
+	0x1e, 0x6a, 0xbf, 0x31, 0xde, 0x8e, 0xd8, 0x0b, 0x3f, 0x35, 0xde, 0x19, 0x48, 0xdb, 0x7b, 0xac,
+	0x1e, 0xa3, 0x6f, 0x20, 0x4e, 0x19, 0x3a, 0x4b, 0x18, 0x53, 0x9a, 0x4b, 0xdc, 0xab, 0x98, 0x7b,
+	0xc3, 0x15, 0x7a, 0xdc, 0xf3, 0xfb, 0xbb, 0x83, 0xdc, 0xbb, 0x57, 0x78, 0xf7, 0x86, 0x2b, 0x28,
+	0xf7, 0x7f, 0xa5, 0x97, 0x35, 0xd0, 0xbf, 0xea, 0x83, 0x8f, 0x86, 0x58, 0x04, 0xfd, 0xf0, 0xf1,
+	0x8d, 0x74, 0x54, 0x42, 0xfe, 0x56, 0xaf, 0xdf, 0x35, 0x2a, 0x1e, 0x97, 0x10, 0x37, 0x21, 0x55,
+	0x97, 0x8c, 0xb5, 0x2a, 0x0b, 0xc3, 0x8e, 0xf9, 0xd1, 0x0d, 0xb5, 0x9c, 0xdf, 0x1c, 0xf1, 0x60,
+	0xf5, 0x60, 0x96, 0x93, 0x9e, 0x98, 0x65, 0x87, 0x86, 0x09, 0xfa, 0xf8, 0xa6, 0x6a, 0x54, 0x57,
+	0x75, 0x60, 0xf1, 0xe0, 0xf3, 0xe3, 0x81, 0x86, 0xbd, 0x27, 0xa0, 0x3f, 0xbc, 0x99, 0x92, 0x4a,
+	0xcb, 0x7f, 0xac, 0x8d, 0xee, 0x7b, 0xac, 0xbd, 0xc2, 0x00, 0x36, 0x5d, 0xbe, 0x1d, 0xb1, 0x4f,
+	0x29, 0x99, 0xc4, 0xfd, 0xf6, 0xcf, 0xa7, 0x6c, 0x3f, 0x72, 0xf1, 0x54, 0x9e, 0xe5, 0x45, 0xc7,
+	0x9a, 0xf0, 0xb7, 0x21, 0x7c, 0xbb, 0x92, 0x4a, 0xe8, 0xdf, 0x86, 0x88, 0xe0, 0xce, 0x6f, 0x43,
+	0x20, 0x9e, 0xd1, 0xdf, 0x86, 0x40, 0xad, 0x45, 0x7f, 0x1b, 0x22, 0xae, 0x41, 0xcd, 0x2e, 0x3a,
+	0x09, 0x72, 0xdb, 0x7c, 0x90, 0x45, 0x7f, 0x17, 0xfd, 0xd1, 0x4d, 0x54, 0x88, 0xf9, 0x55, 0x72,
+	0xe2, 0x73, 0xb5, 0x01, 0x65, 0xea, 0x7d, 0xb2, 0xb6, 0x3b, 0x98, 0x57, 0xbe, 0x7f, 0x30, 0xfa,
+	0x8a, 0x47, 0x71, 0x29, 0xaf, 0xfb, 0xad, 0xd8, 0xec, 0xc0, 0x2d, 0xb8, 0x35, 0xbf, 0x3d, 0x0c,
+	0x26, 0xb2, 0xcb, 0x09, 0x55, 0xe9, 0x49, 0x9f, 0x21, 0x50, 0xe5, 0xbb, 0x83, 0x79, 0x62, 0x1a,
+	0x91, 0xbe, 0x65, 0x6d, 0x0f, 0x30, 0xe6, 0xd7, 0xf5, 0xde, 0x70, 0x05, 0xe5, 0x7e, 0x39, 0xfa,
+	0xaa, 0x87, 0x71, 0x8a, 0xff, 0x8b, 0x76, 0x35, 0x61, 0x6a, 0xea, 0x55, 0x73, 0x32, 0x14, 0x8f,
+	0xc5, 0x2f, 0xee, 0x14, 0xda, 0x17, 0xbf, 0xa0, 0xd3, 0xe8, 0x87, 0x37, 0x53, 0x52, 0x69, 0xf9,
+	0x87, 0xb5, 0xd1, 0x7b, 0x64, 0x5a, 0x54, 0x3b, 0xf8, 0x78, 0xa8, 0x65, 0xd0, 0x1e, 0x3e, 0xb9,
+	0xb1, 0x9e, 0x4a, 0xd4, 0x3f, 0xaf, 0x8d, 0x6e, 0x47, 0x12, 0x25, 0x1b, 0xc8, 0x0d, 0xac, 0xfb,
+	0x0d, 0xe5, 0xd3, 0x9b, 0x2b, 0x52, 0xd3, 0xbd, 0x8b, 0x4f, 0xc3, 0x77, 0xfe, 0x23, 0xb6, 0xa7,
+	0xf4, 0x3b, 0xff, 0xfd, 0x5a, 0x70, 0x8f, 0x29, 0x3d, 0xd7, 0x6b, 0x3e, 0x74, 0x8f, 0x89, 0x8b,
+	0xe3, 0x2f, 0xa3, 0x62, 0x1c, 0xe6, 0xe4, 0xe9, 0xdb, 0x3a, 0x2d, 0x33, 0xda, 0x89, 0x94, 0xf7,
+	0x3b, 0x31, 0x1c, 0xdc, 0x9b, 0xe3, 0xd2, 0xd3, 0x4a, 0xaf, 0xe3, 0x36, 0x29, 0x7d, 0x83, 0x44,
+	0xf7, 0xe6, 0x02, 0x94, 0xf0, 0xa6, 0xa2, 0xc6, 0x98, 0x37, 0x10, 0x2c, 0x3e, 0x1c, 0x82, 0x82,
+	0x15, 0x82, 0xf1, 0x66, 0xb6, 0xfc, 0xb7, 0x63, 0x56, 0x82, 0x6d, 0xff, 0x9d, 0x81, 0x34, 0xe1,
+	0x76, 0xca, 0xba, 0xef, 0xb0, 0x94, 0x7f, 0xee, 0x13, 0x73, 0x6b, 0xa8, 0x41, 0x6e, 0x5d, 0x1a,
+	0x73, 0xbb, 0x5f, 0x15, 0x8b, 0xeb, 0x52, 0x55, 0x26, 0xe9, 0xd6, 0xa5, 0xfa, 0xdd, 0x02, 0x1a,
+	0xee, 0x4a, 0x5a, 0xb7, 0x22, 0xbc, 0x7c, 0x18, 0x37, 0xe3, 0x45, 0x95, 0x5b, 0x83, 0x58, 0x3a,
+	0x9f, 0xaa, 0x19, 0xf5, 0xe4, 0x13, 0xb4, 0xa4, 0x9d, 0x81, 0x34, 0xdc, 0x1e, 0x74, 0xdc, 0x9a,
+	0xf6, 0xb4, 0xdb, 0x63, 0x2b, 0x68, 0x52, 0x7b, 0xc3, 0x15, 0xe0, 0x66, 0xac, 0x6a, 0x55, 0x7c,
+	0x6b, 0xe6, 0x59, 0x5e, 0x14, 0xe3, 0xad, 0x48, 0x33, 0xd1, 0x50, 0x74, 0x33, 0x16, 0x81, 0x89,
+	0x96, 0xac, 0x37, 0x2f, 0xcb, 0x71, 0x9f, 0x1d, 0x41, 0x0d, 0x6a, 0xc9, 0x2e, 0x0d, 0x36, 0xd4,
+	0x9c, 0xa2, 0x36, 0xb9, 0x4d, 0xe2, 0x05, 0x17, 0x64, 0x78, 0x77, 0x30, 0x0f, 0x4e, 0xfb, 0x05,
+	0x35, 0x0d, 0xef, 0x3f, 0x5a, 0xa1, 0x3f, 0x93, 0xdc, 0xef, 0xa1, 0xc0, 0xa6, 0xa4, 0xec, 0x46,
+	0x9f, 0xe5, 0xd9, 0x9c, 0x75, 0xe8, 0x41, 0x95, 0x0b, 0x44, 0x0f, 0xaa, 0x00, 0x08, 0xaa, 0x4e,
+	0xfe, 0xdd, 0xec, 0xc6, 0x1e, 0x65, 0x58, 0xd5, 0x29, 0x65, 0x87, 0x8a, 0x55, 0x1d, 0x4a, 0x83,
+	0xd1, 0xc0, 0xb8, 0x55, 0x6f, 0xf5, 0x3d, 0x8c, 0x99, 0x01, 0x0f, 0xf6, 0x6d, 0x0d, 0x62, 0xc1,
+	0x8c, 0x62, 0x1d, 0xe6, 0xd7, 0x79, 0x87, 0xcd, 0x28, 0x8e, 0x0d, 0x8e, 0xc4, 0x66, 0x94, 0x10,
+	0xa5, 0xb2, 0xc7, 0x63, 0x84, 0xa3, 0x2c, 0x9e, 0x3d, 0xc9, 0x0c, 0xcb, 0x9e, 0x61, 0x83, 0x73,
+	0xd5, 0xd2, 0x34, 0x99, 0xee, 0x52, 0x2d, 0x96, 0x91, 0xb6, 0xed, 0xfc, 0xfc, 0xa7, 0x05, 0x63,
+	0xa3, 0x0e, 0xa5, 0x00, 0xcf, 0x0b, 0xf4, 0x0f, 0x86, 0xf2, 0x4d, 0xc1, 0xba, 0x66, 0x69, 0x93,
+	0x96, 0x33, 0x74, 0x71, 0x6a, 0x7e, 0x00, 0xd4, 0x23, 0x63, 0x8b, 0x53, 0x52, 0x03, 0x9c, 0xda,
+	0xfb, 0x8f, 0x24, 0x21, 0x5d, 0x41, 0x03, 0x89, 0xff, 0x46, 0xd2, 0xe6, 0x00, 0x12, 0x9e, 0xda,
+	0x6b, 0xc0, 0xec, 0xbb, 0x4b, 0xa7, 0x1f, 0x44, 0x4c, 0xf9, 0x68, 0x6c, 0x21, 0x4c, 0xab, 0x80,
+	0x46, 0xed, 0xec, 0x2d, 0x7e, 0x8f, 0xad, 0xb0, 0x46, 0xed, 0x6e, 0x12, 0x7e, 0x8f, 0xad, 0x62,
+	0x8d, 0x3a, 0x44, 0x41, 0x9c, 0xe9, 0xae, 0x83, 0x1e, 0x44, 0xf4, 0xdd, 0xa5, 0xcf, 0x7a, 0x2f,
+	0x07, 0x7a, 0xce, 0x41, 0xbe, 0xf4, 0x8e, 0x29, 0x90, 0x84, 0x1e, 0xe4, 0x4b, 0xfc, 0x94, 0x62,
+	0x6b, 0x10, 0x0b, 0x6f, 0x04, 0xa4, 0x1d, 0x7b, 0xab, 0x8f, 0xea, 0x91, 0xe4, 0x0a, 0x79, 0x70,
+	0x56, 0xbf, 0xd1, 0x0f, 0xda, 0x1b, 0xc8, 0x27, 0x4d, 0x35, 0x63, 0x6d, 0xab, 0x7e, 0x26, 0xc8,
+	0xbf, 0xe0, 0xa4, 0x64, 0x09, 0xf8, 0x91, 0xa0, 0x7b, 0x71, 0xc8, 0xf9, 0x6d, 0x04, 0x29, 0xb2,
+	0xcf, 0xf0, 0x3e, 0x40, 0x35, 0xc3, 0x17, 0x78, 0xd7, 0x7b, 0x39, 0xdb, 0xbd, 0x94, 0xd4, 0x7d,
+	0x77, 0x77, 0x03, 0x55, 0xc7, 0x9e, 0xdc, 0xdd, 0x1c, 0x40, 0x2a, 0x57, 0xdf, 0x19, 0x7d, 0xfe,
+	0x79, 0x35, 0x9f, 0xb2, 0x32, 0x1b, 0x7f, 0xd3, 0xd3, 0x7a, 0x5e, 0xcd, 0x13, 0xfe, 0x67, 0x63,
+	0xf4, 0x16, 0x25, 0xb6, 0x77, 0x10, 0x0f, 0xd8, 0xf9, 0x62, 0x3e, 0xed, 0xd2, 0x0e, 0xdc, 0x41,
+	0x14, 0x7f, 0x4f, 0xb8, 0x80, 0xb8, 0x83, 0xe8, 0x01, 0xc0, 0xde, 0x59, 0xc3, 0x18, 0x6a, 0x8f,
+	0x0b, 0xa2, 0xf6, 0x14, 0x60, 0xa3, 0x08, 0x63, 0x8f, 0x07, 0xea, 0xf0, 0xce, 0xa0, 0xd5, 0x11,
+	0x52, 0x22, 0x8a, 0x08, 0x29, 0xdb, 0xb8, 0x65, 0xf6, 0xc5, 0x93, 0xa4, 0x8b, 0xeb, 0xeb, 0xb4,
+	0x59, 0x81, 0xc6, 0xad, 0x72, 0xe9, 0x00, 0x44, 0xe3, 0x46, 0x41, 0xdb, 0x00, 0xad, 0x9f, 0xd7,
+	0xac, 0xc9, 0x2f, 0x56, 0xa0, 0x01, 0x3a, 0xda, 0x52, 0x4e, 0x34, 0x40, 0x8c, 0xc3, 0x9c, 0x9c,
+	0xb2, 0x3a, 0xcd, 0x1b, 0xda, 0x89, 0x94, 0xf7, 0x3b, 0x31, 0x9c, 0x1d, 0x7f, 0x74, 0x83, 0x99,
+	0x5d, 0x1d, 0x56, 0x4d, 0xb5, 0xe8, 0xf2, 0x32, 0xf8, 0xac, 0xcc, 0x34, 0x0d, 0x97, 0x21, 0xc6,
+	0x1f, 0x8a, 0xb5, 0xf1, 0xba, 0x20, 0xe4, 0xc5, 0x4c, 0xf1, 0xcb, 0x92, 0xe2, 0xb3, 0xf8, 0x31,
+	0x66, 0x05, 0x42, 0x44, 0xbc, 0x4e, 0xc2, 0xa0, 0x15, 0x9f, 0xf0, 0xdf, 0x12, 0xc3, 0x5a, 0xf1,
+	0x89, 0xfb, 0x23, 0x62, 0xb7, 0x69, 0xc0, 0x0e, 0x0d, 0xb2, 0xd0, 0x64, 0x57, 0x56, 0x8f, 0x4b,
+	0xa1, 0xcd, 0xc7, 0x25, 0x88, 0xa1, 0x01, 0x27, 0x81, 0xab, 0x97, 0x35, 0x2b, 0x59, 0xa6, 0xaf,
+	0x1f, 0x62, 0xae, 0x3c, 0x22, 0xea, 0x0a, 0x92, 0xa0, 0xbd, 0x9d, 0x2e, 0xca, 0x93, 0xa6, 0xba,
+	0xc8, 0x0b, 0x86, 0xb7, 0x37, 0x47, 0x1e, 0x6d, 0x6f, 0x3e, 0x67, 0xef, 0xb1, 0x08, 0xa9, 0xf7,
+	0xf3, 0xa8, 0x67, 0x4d, 0x3a, 0x83, 0xf7, 0x58, 0xa4, 0x8d, 0x10, 0x23, 0xf6, 0x38, 0x23, 0xb8,
+	0x13, 0xb2, 0x49, 0xd7, 0xe5, 0x4a, 0xb4, 0x0f, 0xf5, 0xc6, 0x90, 0xf8, 0x69, 0xad, 0x16, 0x84,
+	0x6c, 0xca, 0x1c, 0x46, 0x12, 0x21, 0x5b, 0x5c, 0xc3, 0x4e, 0x8a, 0x82, 0x7b, 0xa1, 0xee, 0x67,
+	0x81, 0x49, 0x51, 0xda, 0xd0, 0x42, 0x62, 0x52, 0x0c, 0x20, 0x30, 0xb4, 0xea, 0x6e, 0x30, 0x47,
+	0x87, 0x56, 0x23, 0x8d, 0x0e, 0xad, 0x2e, 0x65, 0x07, 0x8a, 0xa3, 0x32, 0xef, 0x72, 0xf1, 0x55,
+	0xdf, 0x49, 0xda, 0xa4, 0xd7, 0xac, 0x63, 0x0d, 0x1c, 0x28, 0x14, 0x92, 0x78, 0x0c, 0x31, 0x50,
+	0x50, 0xac, 0x72, 0xf8, 0x3b, 0xa3, 0x2f, 0xf3, 0x08, 0x86, 0x95, 0xea, 0x87, 0xdd, 0x9f, 0x2e,
+	0x59, 0xd9, 0xb5, 0xe3, 0x77, 0x8c, 0x8d, 0x69, 0xd7, 0xb0, 0xf4, 0x5a, 0xdb, 0xfe, 0x92, 0xf9,
+	0xbb, 0x00, 0xf7, 0xd6, 0x78, 0x7b, 0xe6, 0x6f, 0x54, 0x5e, 0xe4, 0x33, 0xf3, 0xf9, 0x35, 0x68,
+	0xcf, 0xae, 0x38, 0x89, 0x7c, 0xe7, 0x86, 0x71, 0x76, 0xc6, 0x71, 0xa5, 0xa7, 0x8c, 0x7f, 0x9a,
+	0x1a, 0xd1, 0x16, 0x00, 0x31, 0xe3, 0xa0, 0xa0, 0xed, 0x9c, 0xae, 0xf8, 0x8c, 0xc5, 0x33, 0x73,
+	0xc6, 0x86, 0x65, 0xe6, 0xcc, 0xfb, 0xb2, 0xa7, 0x18, 0x7d, 0xf9, 0x98, 0x5d, 0x9f, 0xb3, 0xa6,
+	0xbd, 0xcc, 0x6b, 0xea, 0xe7, 0x93, 0x2c, 0xd1, 0xfb, 0xf3, 0x49, 0x04, 0x6a, 0x67, 0x02, 0x0b,
+	0x1c, 0xb5, 0xfc, 0xf2, 0x90, 0x78, 0x4c, 0x14, 0xcc, 0x04, 0x8e, 0x11, 0x07, 0x22, 0x66, 0x02,
+	0x12, 0x76, 0x3e, 0x8e, 0xb7, 0xcc, 0x29, 0x9b, 0xf3, 0x16, 0xd6, 0x9c, 0xa4, 0xab, 0x6b, 0x56,
+	0x76, 0xca, 0x24, 0x38, 0x5d, 0x70, 0x4c, 0xe2, 0x3c, 0x71, 0xba, 0x30, 0x44, 0xcf, 0x19, 0x9a,
+	0xbc, 0x82, 0x3f, 0xa9, 0x9a, 0x2e, 0x2d, 0xf8, 0x02, 0x90, 0xff, 0xa4, 0xce, 0x5e, 0xa4, 0x50,
+	0x3d, 0x92, 0x18, 0x9a, 0xe2, 0x1a, 0xce, 0x4f, 0xf4, 0x7a, 0x69, 0x10, 0xe1, 0x88, 0x6a, 0x27,
+	0x4f, 0xaf, 0xd3, 0xbc, 0x50, 0xad, 0xe1, 0x5b, 0x11, 0xdb, 0x84, 0x0e, 0xf1, 0x13, 0xbd, 0x43,
+	0x75, 0x9d, 0x1f, 0x35, 0x8e, 0xa7, 0x10, 0x1c, 0x76, 0xf4, 0xd8, 0x27, 0x0e, 0x3b, 0xfa, 0xb5,
+	0xec, 0x1e, 0x84, 0x65, 0x05, 0xb7, 0x12, 0xc4, 0x7e, 0x95, 0xc1, 0x9d, 0x4f, 0xc7, 0x26, 0x00,
+	0x89, 0x3d, 0x88, 0xa8, 0x82, 0x0d, 0x0d, 0x2c, 0xf6, 0x2c, 0x2f, 0xd3, 0x22, 0xff, 0x21, 0x5c,
+	0xa0, 0x38, 0x76, 0x34, 0x41, 0x84, 0x06, 0x38, 0x89, 0xb9, 0x3a, 0x64, 0xdd, 0x59, 0xce, 0x87,
+	0xfe, 0x8d, 0x48, 0xb9, 0x09, 0xa2, 0xdf, 0x95, 0x43, 0x3a, 0x3f, 0x7f, 0x03, 0x8b, 0x75, 0x52,
+	0xd7, 0x53, 0x3e, 0xab, 0x9e, 0xb2, 0x19, 0xcb, 0xeb, 0x6e, 0xfc, 0x51, 0xbc, 0xac, 0x00, 0x4e,
+	0x5c, 0x19, 0x19, 0xa0, 0x86, 0x0d, 0x54, 0xbc, 0x0e, 0x0e, 0x99, 0x78, 0xe6, 0x82, 0x1e, 0xa8,
+	0x1c, 0xa8, 0x7f, 0xa0, 0xf2, 0x61, 0x3b, 0xdd, 0xfa, 0x3e, 0x4f, 0x59, 0xc6, 0xd8, 0xf5, 0xf8,
+	0x61, 0xcc, 0x8a, 0x64, 0x88, 0xe9, 0x96, 0x62, 0x6d, 0x60, 0xe6, 0x14, 0xfb, 0x23, 0x3e, 0x50,
+	0x34, 0x55, 0xb6, 0xe0, 0xd1, 0xe6, 0x0e, 0x61, 0xe7, 0xf5, 0xa3, 0xc4, 0xc1, 0x88, 0xc0, 0x2c,
+	0x82, 0x63, 0xc5, 0x2b, 0x3c, 0xa3, 0x0f, 0xb3, 0x40, 0x43, 0xd1, 0x87, 0x59, 0x48, 0x18, 0xed,
+	0xbb, 0x8f, 0xbc, 0x61, 0x71, 0xbc, 0x1b, 0x35, 0x65, 0xc1, 0xde, 0xbe, 0x8b, 0x28, 0xa0, 0x23,
+	0xfe, 0xeb, 0x47, 0x93, 0x72, 0xc5, 0x67, 0xab, 0xa3, 0x56, 0xce, 0x80, 0x11, 0x83, 0x3e, 0xd9,
+	0x3b, 0xe2, 0x63, 0x1a, 0xce, 0xa6, 0x1e, 0x92, 0x86, 0x49, 0x51, 0x54, 0xe2, 0xf0, 0xa6, 0xdf,
+	0xa4, 0x46, 0x89, 0x4d, 0xbd, 0x1e, 0x15, 0x2c, 0xe8, 0x78, 0xfd, 0x68, 0x3f, 0x6d, 0x3a, 0xfe,
+	0x09, 0xff, 0x26, 0x6d, 0x4a, 0x21, 0xbd, 0x41, 0x87, 0x87, 0xda, 0xfd, 0x7f, 0xe8, 0x4d, 0xdd,
+	0x43, 0xdb, 0x8e, 0x5b, 0x01, 0xd7, 0xcf, 0x76, 0x06, 0xd2, 0xce, 0x5d, 0x26, 0x9e, 0xfd, 0x29,
+	0x6b, 0x96, 0x39, 0x7f, 0xb1, 0x8a, 0x35, 0x6a, 0xad, 0xc2, 0xf3, 0xba, 0x07, 0x5e, 0xd5, 0x31,
+	0x5c, 0xe2, 0x80, 0x89, 0x9b, 0xe5, 0x0f, 0x6e, 0xa0, 0x61, 0x73, 0xee, 0x70, 0xea, 0x5d, 0x46,
+	0xfe, 0x97, 0xf1, 0x36, 0x69, 0xcc, 0xa1, 0x88, 0x9c, 0xd3, 0xb4, 0x1d, 0x57, 0x42, 0xb7, 0x93,
+	0x72, 0x75, 0x04, 0xef, 0x8f, 0x21, 0x96, 0x04, 0x46, 0x8c, 0x2b, 0x11, 0xdc, 0x39, 0x19, 0x6c,
+	0xaa, 0x34, 0x9b, 0xa5, 0x6d, 0x77, 0x92, 0xae, 0xf8, 0xfd, 0x70, 0xb1, 0x34, 0x80, 0x27, 0x83,
+	0x9a, 0x49, 0x5c, 0x88, 0x3a, 0x19, 0xa4, 0x60, 0x77, 0x81, 0xc7, 0xd3, 0xa4, 0xef, 0xd5, 0xc3,
+	0x05, 0x1e, 0x97, 0x05, 0x77, 0xea, 0xef, 0xc5, 0x21, 0xfb, 0x3d, 0xb0, 0x14, 0x89, 0x95, 0xcc,
+	0x6d, 0x4c, 0xc7, 0x5b, 0xc3, 0xdc, 0x89, 0x10, 0xf6, 0xc9, 0x5b, 0xf9, 0xf7, 0x17, 0xac, 0x7b,
+	0x53, 0x35, 0x57, 0x7c, 0x98, 0xe4, 0x49, 0x1f, 0x6f, 0x63, 0xba, 0x2e, 0xe4, 0x5d, 0xd7, 0xdd,
+	0x19, 0x48, 0x3b, 0x4f, 0xa9, 0x5c, 0xa6, 0xfc, 0x1a, 0xd9, 0x31, 0x6b, 0x91, 0xf7, 0xdf, 0xb8,
+	0x30, 0xb1, 0x52, 0xea, 0x29, 0x95, 0x80, 0xb2, 0x0d, 0x9d, 0xcb, 0x9e, 0x66, 0x79, 0xa7, 0x64,
+	0xfa, 0x6b, 0x95, 0xed, 0xd0, 0x40, 0x48, 0x11, 0xb9, 0xa2, 0x69, 0x3b, 0xa5, 0x70, 0xe6, 0xac,
+	0x9a, 0xcf, 0x0b, 0xa6, 0xa0, 0x53, 0x96, 0xca, 0x37, 0x7d, 0x76, 0x43, 0x5b, 0x28, 0x48, 0x4c,
+	0x29, 0x51, 0x05, 0xbb, 0x12, 0xe5, 0x98, 0x3c, 0x9f, 0xd7, 0x05, 0xbb, 0x1e, 0x9a, 0xf1, 0x00,
+	0x62, 0x25, 0x8a, 0x82, 0xce, 0x73, 0x2b, 0x97, 0x29, 0x1f, 0xb7, 0x94, 0x08, 0x3e, 0xab, 0x2e,
+	0x94, 0x1d, 0x31, 0xf5, 0xdc, 0x4a, 0x88, 0xd9, 0xd8, 0x07, 0x78, 0x78, 0xb2, 0xe2, 0xbf, 0xeb,
+	0xf7, 0x30, 0xaa, 0x2f, 0x18, 0x22, 0xf6, 0xa1, 0x58, 0xbf, 0xea, 0xcc, 0x21, 0xc0, 0xf3, 0xb4,
+	0xb5, 0x99, 0x43, 0xaa, 0x0e, 0x05, 0x63, 0x55, 0x47, 0x29, 0xf8, 0x45, 0xea, 0x9e, 0x33, 0x20,
+	0x45, 0x8a, 0x1d, 0x32, 0x3c, 0xe8, 0xc3, 0x9c, 0x37, 0x7f, 0x2e, 0xd3, 0xee, 0x94, 0xa5, 0x99,
+	0xc9, 0x18, 0xa2, 0xeb, 0xca, 0xa9, 0x37, 0x7f, 0x10, 0x4e, 0x39, 0xf9, 0xfd, 0xd1, 0x58, 0x66,
+	0xa3, 0x71, 0xdd, 0xdc, 0xc6, 0x92, 0xc8, 0x09, 0x62, 0xa0, 0xf2, 0x09, 0x67, 0xed, 0xe7, 0x55,
+	0xd1, 0x59, 0xa5, 0x1c, 0xa8, 0x6f, 0xe4, 0x5b, 0xb0, 0xf6, 0xf3, 0x8b, 0x3d, 0xa0, 0x89, 0xb5,
+	0x5f, 0xbf, 0x96, 0xf3, 0xd0, 0x33, 0xa8, 0x32, 0x7e, 0x87, 0x1a, 0xa6, 0xe9, 0xd3, 0x68, 0xf5,
+	0x20, 0x1a, 0xc4, 0x43, 0xcf, 0xc3, 0x34, 0xe1, 0x6f, 0x48, 0xab, 0x41, 0x16, 0xff, 0x0d, 0x69,
+	0x25, 0x8c, 0xff, 0x86, 0xb4, 0x85, 0xec, 0xa3, 0x0c, 0xba, 0x1d, 0xf1, 0x77, 0xee, 0xee, 0xe0,
+	0x4d, 0xc3, 0x7d, 0xe1, 0xee, 0x6e, 0x0c, 0xb1, 0x13, 0xc2, 0xe4, 0xe8, 0xb3, 0x26, 0xe7, 0xd7,
+	0xcf, 0xcf, 0xaa, 0xaa, 0x80, 0xa7, 0x42, 0x93, 0xa3, 0xc4, 0x95, 0x12, 0x13, 0x42, 0x48, 0xd9,
+	0x89, 0x73, 0x72, 0xc4, 0x5f, 0x69, 0xbc, 0xe0, 0x37, 0x65, 0x6e, 0x43, 0x25, 0x2d, 0x21, 0xda,
+	0xa3, 0x4f, 0xd8, 0x32, 0x9e, 0x1c, 0x89, 0x03, 0x56, 0x75, 0xc8, 0xf4, 0x3e, 0xd4, 0x71, 0x84,
+	0x44, 0x19, 0x07, 0x90, 0x8d, 0x5b, 0x26, 0x47, 0xd8, 0x4f, 0x2b, 0x6f, 0x41, 0x75, 0x04, 0x22,
+	0xe2, 0x16, 0x12, 0x76, 0x9e, 0x7d, 0x38, 0x59, 0xb4, 0x97, 0xfe, 0x5e, 0xa6, 0xdc, 0xb5, 0x92,
+	0xbf, 0x21, 0xf4, 0x18, 0xfc, 0x30, 0xba, 0xcf, 0x26, 0x1e, 0x4c, 0xdc, 0x00, 0xee, 0x55, 0x72,
+	0x7e, 0x10, 0x01, 0xb2, 0xfc, 0x20, 0xbb, 0x4e, 0x67, 0xec, 0x98, 0x6f, 0xae, 0x3c, 0x8a, 0x9b,
+	0x75, 0x59, 0xe2, 0x6b, 0x9a, 0x3e, 0x1d, 0x67, 0x33, 0x02, 0x49, 0x89, 0xf8, 0x09, 0x72, 0x4e,
+	0xf2, 0x59, 0xe9, 0xa3, 0x5e, 0xc3, 0x2e, 0x4e, 0x6c, 0x46, 0x0c, 0x50, 0xb3, 0x97, 0xc0, 0xc2,
+	0x8a, 0x6a, 0xf9, 0x6d, 0xa3, 0x16, 0x5c, 0x02, 0x43, 0x8a, 0x5b, 0x72, 0xc4, 0x25, 0xb0, 0x18,
+	0x2f, 0x9d, 0x3f, 0xb9, 0xf3, 0xdf, 0x3f, 0xbd, 0xb5, 0xf6, 0x93, 0x9f, 0xde, 0x5a, 0xfb, 0xdf,
+	0x9f, 0xde, 0x5a, 0xfb, 0xf1, 0xcf, 0x6e, 0x7d, 0xee, 0x27, 0x3f, 0xbb, 0xf5, 0xb9, 0xff, 0xf9,
+	0xd9, 0xad, 0xcf, 0x7d, 0xff, 0xf3, 0xad, 0x8c, 0xc5, 0xcf, 0x7f, 0xb1, 0x6e, 0xaa, 0xae, 0x7a,
+	0xfc, 0x7f, 0x03, 0x00, 0x18, 0x4f, 0xf0, 0x22, 0x95, 0x9b, 0x00, 0x00,
 }
 
 // This is a compile-time assertion to ensure that this generated file
@@ -484,8 +525,14 @@ type ClientCommandsHandler interface {
 	SpaceMakeShareable(context.Context, *pb.RpcSpaceMakeShareableRequest) *pb.RpcSpaceMakeShareableResponse
 	SpaceParticipantRemove(context.Context, *pb.RpcSpaceParticipantRemoveRequest) *pb.RpcSpaceParticipantRemoveResponse
 	SpaceParticipantPermissionsChange(context.Context, *pb.RpcSpaceParticipantPermissionsChangeRequest) *pb.RpcSpaceParticipantPermissionsChangeResponse
+	SpaceParticipantRoleSet(context.Context, *pb.RpcSpaceParticipantRoleSetRequest) *pb.RpcSpaceParticipantRoleSetResponse
 	SpaceSetOrder(context.Context, *pb.RpcSpaceSetOrderRequest) *pb.RpcSpaceSetOrderResponse
 	SpaceUnsetOrder(context.Context, *pb.RpcSpaceUnsetOrderRequest) *pb.RpcSpaceUnsetOrderResponse
+	SpaceActivityList(context.Context, *pb.RpcSpaceActivityListRequest) *pb.RpcSpaceActivityListResponse
+	SpaceActivitySubscribe(context.Context, *pb.RpcSpaceActivitySubscribeRequest) *pb.RpcSpaceActivitySubscribeResponse
+	SpaceActivityUnsubscribe(context.Context, *pb.RpcSpaceActivityUnsubscribeRequest) *pb.RpcSpaceActivityUnsubscribeResponse
+	SpaceActivityMarkSeen(context.Context, *pb.RpcSpaceActivityMarkSeenRequest) *pb.RpcSpaceActivityMarkSeenResponse
+	SpaceAuditLogExport(context.Context, *pb.RpcSpaceAuditLogExportRequest) *pb.RpcSpaceAuditLogExportResponse
 	// Publishing
 	// ***
 	PublishingCreate(context.Context, *pb.RpcPublishingCreateRequest) *pb.RpcPublishingCreateResponse
@@ -497,6 +544,8 @@ type ClientCommandsHandler interface {
 	// ***
 	ObjectOpen(context.Context, *pb.RpcObjectOpenRequest) *pb.RpcObjectOpenResponse
 	ObjectRefresh(context.Context, *pb.RpcObjectRefreshRequest) *pb.RpcObjectRefreshResponse
+	ObjectSyncDiagnostics(context.Context, *pb.RpcObjectSyncDiagnosticsRequest) *pb.RpcObjectSyncDiagnosticsResponse
+	ObjectForceResync(context.Context, *pb.RpcObjectForceResyncRequest) *pb.RpcObjectForceResyncResponse
 	ObjectClose(context.Context, *pb.RpcObjectCloseRequest) *pb.RpcObjectCloseResponse
 	ObjectShow(context.Context, *pb.RpcObjectShowRequest) *pb.RpcObjectShowResponse
 	// ObjectCreate just creates the new page, without adding the link to it from some other page
@@ -508,6 +557,8 @@ type ClientCommandsHandler interface {
 	ObjectGraph(context.Context, *pb.RpcObjectGraphRequest) *pb.RpcObjectGraphResponse
 	ObjectSearch(context.Context, *pb.RpcObjectSearchRequest) *pb.RpcObjectSearchResponse
 	ObjectSearchWithMeta(context.Context, *pb.RpcObjectSearchWithMetaRequest) *pb.RpcObjectSearchWithMetaResponse
+	ObjectFindReplacePreview(context.Context, *pb.RpcObjectFindReplacePreviewRequest) *pb.RpcObjectFindReplacePreviewResponse
+	ObjectFindReplaceApply(context.Context, *pb.RpcObjectFindReplaceApplyRequest) *pb.RpcObjectFindReplaceApplyResponse
 	ObjectSearchSubscribe(context.Context, *pb.RpcObjectSearchSubscribeRequest) *pb.RpcObjectSearchSubscribeResponse
 	ObjectCrossSpaceSearchSubscribe(context.Context, *pb.RpcObjectCrossSpaceSearchSubscribeRequest) *pb.RpcObjectCrossSpaceSearchSubscribeResponse
 	ObjectCrossSpaceSearchUnsubscribe(context.Context, *pb.RpcObjectCrossSpaceSearchUnsubscribeRequest) *pb.RpcObjectCrossSpaceSearchUnsubscribeResponse
@@ -516,6 +567,7 @@ type ClientCommandsHandler interface {
 	ObjectSearchUnsubscribe(context.Context, *pb.RpcObjectSearchUnsubscribeRequest) *pb.RpcObjectSearchUnsubscribeResponse
 	ObjectSetDetails(context.Context, *pb.RpcObjectSetDetailsRequest) *pb.RpcObjectSetDetailsResponse
 	ObjectDuplicate(context.Context, *pb.RpcObjectDuplicateRequest) *pb.RpcObjectDuplicateResponse
+	ObjectTransferToSpace(context.Context, *pb.RpcObjectTransferToSpaceRequest) *pb.RpcObjectTransferToSpaceResponse
 	// ObjectSetObjectType sets an existing object type to the object so it will appear in sets and suggests relations from this type
 	ObjectSetObjectType(context.Context, *pb.RpcObjectSetObjectTypeRequest) *pb.RpcObjectSetObjectTypeResponse
 	ObjectSetLayout(context.Context, *pb.RpcObjectSetLayoutRequest) *pb.RpcObjectSetLayoutResponse
@@ -547,6 +599,7 @@ type ClientCommandsHandler interface {
 	ObjectImportUseCase(context.Context, *pb.RpcObjectImportUseCaseRequest) *pb.RpcObjectImportUseCaseResponse
 	ObjectImportExperience(context.Context, *pb.RpcObjectImportExperienceRequest) *pb.RpcObjectImportExperienceResponse
 	ObjectDateByTimestamp(context.Context, *pb.RpcObjectDateByTimestampRequest) *pb.RpcObjectDateByTimestampResponse
+	ObjectDateParse(context.Context, *pb.RpcObjectDateParseRequest) *pb.RpcObjectDateParseResponse
 	// Collections
 	// ***
 	ObjectCollectionAdd(context.Context, *pb.RpcObjectCollectionAddRequest) *pb.RpcObjectCollectionAddResponse
@@ -581,6 +634,8 @@ type ClientCommandsHandler interface {
 	HistoryGetVersions(context.Context, *pb.RpcHistoryGetVersionsRequest) *pb.RpcHistoryGetVersionsResponse
 	HistorySetVersion(context.Context, *pb.RpcHistorySetVersionRequest) *pb.RpcHistorySetVersionResponse
 	HistoryDiffVersions(context.Context, *pb.RpcHistoryDiffVersionsRequest) *pb.RpcHistoryDiffVersionsResponse
+	HistoryGetBlockVersions(context.Context, *pb.RpcHistoryGetBlockVersionsRequest) *pb.RpcHistoryGetBlockVersionsResponse
+	HistoryRestoreBlocks(context.Context, *pb.RpcHistoryRestoreBlocksRequest) *pb.RpcHistoryRestoreBlocksResponse
 	// Files
 	// ***
 	FileSpaceOffload(context.Context, *pb.RpcFileSpaceOffloadRequest) *pb.RpcFileSpaceOffloadResponse
@@ -589,17 +644,37 @@ type ClientCommandsHandler interface {
 	FileUpload(context.Context, *pb.RpcFileUploadRequest) *pb.RpcFileUploadResponse
 	FileDownload(context.Context, *pb.RpcFileDownloadRequest) *pb.RpcFileDownloadResponse
 	FileDiscardPreload(context.Context, *pb.RpcFileDiscardPreloadRequest) *pb.RpcFileDiscardPreloadResponse
+	FileImageTransform(context.Context, *pb.RpcFileImageTransformRequest) *pb.RpcFileImageTransformResponse
 	FileDrop(context.Context, *pb.RpcFileDropRequest) *pb.RpcFileDropResponse
 	FileSpaceUsage(context.Context, *pb.RpcFileSpaceUsageRequest) *pb.RpcFileSpaceUsageResponse
 	FileNodeUsage(context.Context, *pb.RpcFileNodeUsageRequest) *pb.RpcFileNodeUsageResponse
 	FileSetAutoDownload(context.Context, *pb.RpcFileSetAutoDownloadRequest) *pb.RpcFileSetAutoDownloadResponse
 	FileCacheDownload(context.Context, *pb.RpcFileCacheDownloadRequest) *pb.RpcFileCacheDownloadResponse
 	FileCacheCancelDownload(context.Context, *pb.RpcFileCacheCancelDownloadRequest) *pb.RpcFileCacheCancelDownloadResponse
+	FileSetAutoOffload(context.Context, *pb.RpcFileSetAutoOffloadRequest) *pb.RpcFileSetAutoOffloadResponse
+	FileAutoOffloadStatus(context.Context, *pb.RpcFileAutoOffloadStatusRequest) *pb.RpcFileAutoOffloadStatusResponse
 	NavigationListObjects(context.Context, *pb.RpcNavigationListObjectsRequest) *pb.RpcNavigationListObjectsResponse
 	NavigationGetObjectInfoWithLinks(context.Context, *pb.RpcNavigationGetObjectInfoWithLinksRequest) *pb.RpcNavigationGetObjectInfoWithLinksResponse
 	TemplateCreateFromObject(context.Context, *pb.RpcTemplateCreateFromObjectRequest) *pb.RpcTemplateCreateFromObjectResponse
 	TemplateClone(context.Context, *pb.RpcTemplateCloneRequest) *pb.RpcTemplateCloneResponse
 	TemplateExportAll(context.Context, *pb.RpcTemplateExportAllRequest) *pb.RpcTemplateExportAllResponse
+	TemplateGetVariables(context.Context, *pb.RpcTemplateGetVariablesRequest) *pb.RpcTemplateGetVariablesResponse
+	TemplateIncludeSyncPreview(context.Context, *pb.RpcTemplateIncludeSyncPreviewRequest) *pb.RpcTemplateIncludeSyncPreviewResponse
+	TemplateIncludeSyncApply(context.Context, *pb.RpcTemplateIncludeSyncApplyRequest) *pb.RpcTemplateIncludeSyncApplyResponse
+	JournalOpenNote(context.Context, *pb.RpcJournalOpenNoteRequest) *pb.RpcJournalOpenNoteResponse
+	JournalDateSection(context.Context, *pb.RpcJournalDateSectionRequest) *pb.RpcJournalDateSectionResponse
+	JournalGetSettings(context.Context, *pb.RpcJournalGetSettingsRequest) *pb.RpcJournalGetSettingsResponse
+	JournalSetSettings(context.Context, *pb.RpcJournalSetSettingsRequest) *pb.RpcJournalSetSettingsResponse
+	CalendarExportView(context.Context, *pb.RpcCalendarExportViewRequest) *pb.RpcCalendarExportViewResponse
+	CalendarImport(context.Context, *pb.RpcCalendarImportRequest) *pb.RpcCalendarImportResponse
+	CalendarFeedCreate(context.Context, *pb.RpcCalendarFeedCreateRequest) *pb.RpcCalendarFeedCreateResponse
+	CalendarFeedList(context.Context, *pb.RpcCalendarFeedListRequest) *pb.RpcCalendarFeedListResponse
+	CalendarFeedRemove(context.Context, *pb.RpcCalendarFeedRemoveRequest) *pb.RpcCalendarFeedRemoveResponse
+	CalendarFeedGet(context.Context, *pb.RpcCalendarFeedGetRequest) *pb.RpcCalendarFeedGetResponse
+	WebhookCreate(context.Context, *pb.RpcWebhookCreateRequest) *pb.RpcWebhookCreateResponse
+	WebhookList(context.Context, *pb.RpcWebhookListRequest) *pb.RpcWebhookListResponse
+	WebhookRemove(context.Context, *pb.RpcWebhookRemoveRequest) *pb.RpcWebhookRemoveResponse
+	WebhookDeliveryList(context.Context, *pb.RpcWebhookDeliveryListRequest) *pb.RpcWebhookDeliveryListResponse
 	LinkPreview(context.Context, *pb.RpcLinkPreviewRequest) *pb.RpcLinkPreviewResponse
 	UnsplashSearch(context.Context, *pb.RpcUnsplashSearchRequest) *pb.RpcUnsplashSearchResponse
 	// UnsplashDownload downloads picture from unsplash by ID, put it to the IPFS and returns the hash.
@@ -607,6 +682,9 @@ type ClientCommandsHandler interface {
 	UnsplashDownload(context.Context, *pb.RpcUnsplashDownloadRequest) *pb.RpcUnsplashDownloadResponse
 	GalleryDownloadManifest(context.Context, *pb.RpcGalleryDownloadManifestRequest) *pb.RpcGalleryDownloadManifestResponse
 	GalleryDownloadIndex(context.Context, *pb.RpcGalleryDownloadIndexRequest) *pb.RpcGalleryDownloadIndexResponse
+	WebdavStart(context.Context, *pb.RpcWebdavStartRequest) *pb.RpcWebdavStartResponse
+	WebdavStop(context.Context, *pb.RpcWebdavStopRequest) *pb.RpcWebdavStopResponse
+	WebdavInfo(context.Context, *pb.RpcWebdavInfoRequest) *pb.RpcWebdavInfoResponse
 	// General Block commands
 	// ***
 	BlockUpload(context.Context, *pb.RpcBlockUploadRequest) *pb.RpcBlockUploadResponse
@@ -719,6 +797,8 @@ type ClientCommandsHandler interface {
 	DebugTree(context.Context, *pb.RpcDebugTreeRequest) *pb.RpcDebugTreeResponse
 	DebugTreeHeads(context.Context, *pb.RpcDebugTreeHeadsRequest) *pb.RpcDebugTreeHeadsResponse
 	DebugSpaceSummary(context.Context, *pb.RpcDebugSpaceSummaryRequest) *pb.RpcDebugSpaceSummaryResponse
+	DebugSpaceVerify(context.Context, *pb.RpcDebugSpaceVerifyRequest) *pb.RpcDebugSpaceVerifyResponse
+	DebugSpaceRepair(context.Context, *pb.RpcDebugSpaceRepairRequest) *pb.RpcDebugSpaceRepairResponse
 	DebugStackGoroutines(context.Context, *pb.RpcDebugStackGoroutinesRequest) *pb.RpcDebugStackGoroutinesResponse
 	DebugExportLocalstore(context.Context, *pb.RpcDebugExportLocalstoreRequest) *pb.RpcDebugExportLocalstoreResponse
 	DebugPing(context.Context, *pb.RpcDebugPingRequest) *pb.RpcDebugPingResponse
@@ -1872,6 +1952,26 @@ func SpaceParticipantPermissionsChange(b []byte) (resp []byte) {
 	return resp
 }
 
+func SpaceParticipantRoleSet(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcSpaceParticipantRoleSetResponse{Error: &pb.RpcSpaceParticipantRoleSetResponseError{Code: pb.RpcSpaceParticipantRoleSetResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcSpaceParticipantRoleSetRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcSpaceParticipantRoleSetResponse{Error: &pb.RpcSpaceParticipantRoleSetResponseError{Code: pb.RpcSpaceParticipantRoleSetResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.SpaceParticipantRoleSet(context.Background(), in).Marshal()
+	return resp
+}
+
 func SpaceSetOrder(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
@@ -1912,6 +2012,106 @@ func SpaceUnsetOrder(b []byte) (resp []byte) {
 	return resp
 }
 
+func SpaceActivityList(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcSpaceActivityListResponse{Error: &pb.RpcSpaceActivityListResponseError{Code: pb.RpcSpaceActivityListResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcSpaceActivityListRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcSpaceActivityListResponse{Error: &pb.RpcSpaceActivityListResponseError{Code: pb.RpcSpaceActivityListResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.SpaceActivityList(context.Background(), in).Marshal()
+	return resp
+}
+
+func SpaceActivitySubscribe(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcSpaceActivitySubscribeResponse{Error: &pb.RpcSpaceActivitySubscribeResponseError{Code: pb.RpcSpaceActivitySubscribeResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcSpaceActivitySubscribeRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcSpaceActivitySubscribeResponse{Error: &pb.RpcSpaceActivitySubscribeResponseError{Code: pb.RpcSpaceActivitySubscribeResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.SpaceActivitySubscribe(context.Background(), in).Marshal()
+	return resp
+}
+
+func SpaceActivityUnsubscribe(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcSpaceActivityUnsubscribeResponse{Error: &pb.RpcSpaceActivityUnsubscribeResponseError{Code: pb.RpcSpaceActivityUnsubscribeResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcSpaceActivityUnsubscribeRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcSpaceActivityUnsubscribeResponse{Error: &pb.RpcSpaceActivityUnsubscribeResponseError{Code: pb.RpcSpaceActivityUnsubscribeResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.SpaceActivityUnsubscribe(context.Background(), in).Marshal()
+	return resp
+}
+
+func SpaceActivityMarkSeen(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcSpaceActivityMarkSeenResponse{Error: &pb.RpcSpaceActivityMarkSeenResponseError{Code: pb.RpcSpaceActivityMarkSeenResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcSpaceActivityMarkSeenRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcSpaceActivityMarkSeenResponse{Error: &pb.RpcSpaceActivityMarkSeenResponseError{Code: pb.RpcSpaceActivityMarkSeenResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.SpaceActivityMarkSeen(context.Background(), in).Marshal()
+	return resp
+}
+
+func SpaceAuditLogExport(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcSpaceAuditLogExportResponse{Error: &pb.RpcSpaceAuditLogExportResponseError{Code: pb.RpcSpaceAuditLogExportResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcSpaceAuditLogExportRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcSpaceAuditLogExportResponse{Error: &pb.RpcSpaceAuditLogExportResponseError{Code: pb.RpcSpaceAuditLogExportResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.SpaceAuditLogExport(context.Background(), in).Marshal()
+	return resp
+}
+
 func PublishingCreate(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
@@ -2052,6 +2252,46 @@ func ObjectRefresh(b []byte) (resp []byte) {
 	return resp
 }
 
+func ObjectSyncDiagnostics(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcObjectSyncDiagnosticsResponse{Error: &pb.RpcObjectSyncDiagnosticsResponseError{Code: pb.RpcObjectSyncDiagnosticsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcObjectSyncDiagnosticsRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcObjectSyncDiagnosticsResponse{Error: &pb.RpcObjectSyncDiagnosticsResponseError{Code: pb.RpcObjectSyncDiagnosticsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.ObjectSyncDiagnostics(context.Background(), in).Marshal()
+	return resp
+}
+
+func ObjectForceResync(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcObjectForceResyncResponse{Error: &pb.RpcObjectForceResyncResponseError{Code: pb.RpcObjectForceResyncResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcObjectForceResyncRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcObjectForceResyncResponse{Error: &pb.RpcObjectForceResyncResponseError{Code: pb.RpcObjectForceResyncResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.ObjectForceResync(context.Background(), in).Marshal()
+	return resp
+}
+
 func ObjectClose(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
@@ -2232,6 +2472,46 @@ func ObjectSearchWithMeta(b []byte) (resp []byte) {
 	return resp
 }
 
+func ObjectFindReplacePreview(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcObjectFindReplacePreviewResponse{Error: &pb.RpcObjectFindReplacePreviewResponseError{Code: pb.RpcObjectFindReplacePreviewResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcObjectFindReplacePreviewRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcObjectFindReplacePreviewResponse{Error: &pb.RpcObjectFindReplacePreviewResponseError{Code: pb.RpcObjectFindReplacePreviewResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.ObjectFindReplacePreview(context.Background(), in).Marshal()
+	return resp
+}
+
+func ObjectFindReplaceApply(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcObjectFindReplaceApplyResponse{Error: &pb.RpcObjectFindReplaceApplyResponseError{Code: pb.RpcObjectFindReplaceApplyResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcObjectFindReplaceApplyRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcObjectFindReplaceApplyResponse{Error: &pb.RpcObjectFindReplaceApplyResponseError{Code: pb.RpcObjectFindReplaceApplyResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.ObjectFindReplaceApply(context.Background(), in).Marshal()
+	return resp
+}
+
 func ObjectSearchSubscribe(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
@@ -2392,6 +2672,26 @@ func ObjectDuplicate(b []byte) (resp []byte) {
 	return resp
 }
 
+func ObjectTransferToSpace(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcObjectTransferToSpaceResponse{Error: &pb.RpcObjectTransferToSpaceResponseError{Code: pb.RpcObjectTransferToSpaceResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcObjectTransferToSpaceRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcObjectTransferToSpaceResponse{Error: &pb.RpcObjectTransferToSpaceResponseError{Code: pb.RpcObjectTransferToSpaceResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.ObjectTransferToSpace(context.Background(), in).Marshal()
+	return resp
+}
+
 func ObjectSetObjectType(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
@@ -2972,6 +3272,26 @@ func ObjectDateByTimestamp(b []byte) (resp []byte) {
 	return resp
 }
 
+func ObjectDateParse(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcObjectDateParseResponse{Error: &pb.RpcObjectDateParseResponseError{Code: pb.RpcObjectDateParseResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcObjectDateParseRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcObjectDateParseResponse{Error: &pb.RpcObjectDateParseResponseError{Code: pb.RpcObjectDateParseResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.ObjectDateParse(context.Background(), in).Marshal()
+	return resp
+}
+
 func ObjectCollectionAdd(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
@@ -3492,6 +3812,46 @@ func HistoryDiffVersions(b []byte) (resp []byte) {
 	return resp
 }
 
+func HistoryGetBlockVersions(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcHistoryGetBlockVersionsResponse{Error: &pb.RpcHistoryGetBlockVersionsResponseError{Code: pb.RpcHistoryGetBlockVersionsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcHistoryGetBlockVersionsRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcHistoryGetBlockVersionsResponse{Error: &pb.RpcHistoryGetBlockVersionsResponseError{Code: pb.RpcHistoryGetBlockVersionsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.HistoryGetBlockVersions(context.Background(), in).Marshal()
+	return resp
+}
+
+func HistoryRestoreBlocks(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcHistoryRestoreBlocksResponse{Error: &pb.RpcHistoryRestoreBlocksResponseError{Code: pb.RpcHistoryRestoreBlocksResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcHistoryRestoreBlocksRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcHistoryRestoreBlocksResponse{Error: &pb.RpcHistoryRestoreBlocksResponseError{Code: pb.RpcHistoryRestoreBlocksResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.HistoryRestoreBlocks(context.Background(), in).Marshal()
+	return resp
+}
+
 func FileSpaceOffload(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
@@ -3612,6 +3972,26 @@ func FileDiscardPreload(b []byte) (resp []byte) {
 	return resp
 }
 
+func FileImageTransform(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcFileImageTransformResponse{Error: &pb.RpcFileImageTransformResponseError{Code: pb.RpcFileImageTransformResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcFileImageTransformRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcFileImageTransformResponse{Error: &pb.RpcFileImageTransformResponseError{Code: pb.RpcFileImageTransformResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.FileImageTransform(context.Background(), in).Marshal()
+	return resp
+}
+
 func FileDrop(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
@@ -3732,6 +4112,46 @@ func FileCacheCancelDownload(b []byte) (resp []byte) {
 	return resp
 }
 
+func FileSetAutoOffload(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcFileSetAutoOffloadResponse{Error: &pb.RpcFileSetAutoOffloadResponseError{Code: pb.RpcFileSetAutoOffloadResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcFileSetAutoOffloadRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcFileSetAutoOffloadResponse{Error: &pb.RpcFileSetAutoOffloadResponseError{Code: pb.RpcFileSetAutoOffloadResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.FileSetAutoOffload(context.Background(), in).Marshal()
+	return resp
+}
+
+func FileAutoOffloadStatus(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcFileAutoOffloadStatusResponse{Error: &pb.RpcFileAutoOffloadStatusResponseError{Code: pb.RpcFileAutoOffloadStatusResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcFileAutoOffloadStatusRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcFileAutoOffloadStatusResponse{Error: &pb.RpcFileAutoOffloadStatusResponseError{Code: pb.RpcFileAutoOffloadStatusResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.FileAutoOffloadStatus(context.Background(), in).Marshal()
+	return resp
+}
+
 func NavigationListObjects(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
@@ -3832,3551 +4252,4015 @@ func TemplateExportAll(b []byte) (resp []byte) {
 	return resp
 }
 
-func LinkPreview(b []byte) (resp []byte) {
+func TemplateGetVariables(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcLinkPreviewResponse{Error: &pb.RpcLinkPreviewResponseError{Code: pb.RpcLinkPreviewResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcTemplateGetVariablesResponse{Error: &pb.RpcTemplateGetVariablesResponseError{Code: pb.RpcTemplateGetVariablesResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcLinkPreviewRequest)
+	in := new(pb.RpcTemplateGetVariablesRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcLinkPreviewResponse{Error: &pb.RpcLinkPreviewResponseError{Code: pb.RpcLinkPreviewResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcTemplateGetVariablesResponse{Error: &pb.RpcTemplateGetVariablesResponseError{Code: pb.RpcTemplateGetVariablesResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.LinkPreview(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.TemplateGetVariables(context.Background(), in).Marshal()
 	return resp
 }
 
-func UnsplashSearch(b []byte) (resp []byte) {
+func TemplateIncludeSyncPreview(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcUnsplashSearchResponse{Error: &pb.RpcUnsplashSearchResponseError{Code: pb.RpcUnsplashSearchResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcTemplateIncludeSyncPreviewResponse{Error: &pb.RpcTemplateIncludeSyncPreviewResponseError{Code: pb.RpcTemplateIncludeSyncPreviewResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcUnsplashSearchRequest)
+	in := new(pb.RpcTemplateIncludeSyncPreviewRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcUnsplashSearchResponse{Error: &pb.RpcUnsplashSearchResponseError{Code: pb.RpcUnsplashSearchResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcTemplateIncludeSyncPreviewResponse{Error: &pb.RpcTemplateIncludeSyncPreviewResponseError{Code: pb.RpcTemplateIncludeSyncPreviewResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.UnsplashSearch(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.TemplateIncludeSyncPreview(context.Background(), in).Marshal()
 	return resp
 }
 
-func UnsplashDownload(b []byte) (resp []byte) {
+func TemplateIncludeSyncApply(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcUnsplashDownloadResponse{Error: &pb.RpcUnsplashDownloadResponseError{Code: pb.RpcUnsplashDownloadResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcTemplateIncludeSyncApplyResponse{Error: &pb.RpcTemplateIncludeSyncApplyResponseError{Code: pb.RpcTemplateIncludeSyncApplyResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcUnsplashDownloadRequest)
+	in := new(pb.RpcTemplateIncludeSyncApplyRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcUnsplashDownloadResponse{Error: &pb.RpcUnsplashDownloadResponseError{Code: pb.RpcUnsplashDownloadResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcTemplateIncludeSyncApplyResponse{Error: &pb.RpcTemplateIncludeSyncApplyResponseError{Code: pb.RpcTemplateIncludeSyncApplyResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.UnsplashDownload(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.TemplateIncludeSyncApply(context.Background(), in).Marshal()
 	return resp
 }
 
-func GalleryDownloadManifest(b []byte) (resp []byte) {
+func JournalOpenNote(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcGalleryDownloadManifestResponse{Error: &pb.RpcGalleryDownloadManifestResponseError{Code: pb.RpcGalleryDownloadManifestResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcJournalOpenNoteResponse{Error: &pb.RpcJournalOpenNoteResponseError{Code: pb.RpcJournalOpenNoteResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcGalleryDownloadManifestRequest)
+	in := new(pb.RpcJournalOpenNoteRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcGalleryDownloadManifestResponse{Error: &pb.RpcGalleryDownloadManifestResponseError{Code: pb.RpcGalleryDownloadManifestResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcJournalOpenNoteResponse{Error: &pb.RpcJournalOpenNoteResponseError{Code: pb.RpcJournalOpenNoteResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.GalleryDownloadManifest(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.JournalOpenNote(context.Background(), in).Marshal()
 	return resp
 }
 
-func GalleryDownloadIndex(b []byte) (resp []byte) {
+func JournalDateSection(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcGalleryDownloadIndexResponse{Error: &pb.RpcGalleryDownloadIndexResponseError{Code: pb.RpcGalleryDownloadIndexResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcJournalDateSectionResponse{Error: &pb.RpcJournalDateSectionResponseError{Code: pb.RpcJournalDateSectionResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcGalleryDownloadIndexRequest)
+	in := new(pb.RpcJournalDateSectionRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcGalleryDownloadIndexResponse{Error: &pb.RpcGalleryDownloadIndexResponseError{Code: pb.RpcGalleryDownloadIndexResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcJournalDateSectionResponse{Error: &pb.RpcJournalDateSectionResponseError{Code: pb.RpcJournalDateSectionResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.GalleryDownloadIndex(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.JournalDateSection(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockUpload(b []byte) (resp []byte) {
+func JournalGetSettings(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockUploadResponse{Error: &pb.RpcBlockUploadResponseError{Code: pb.RpcBlockUploadResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcJournalGetSettingsResponse{Error: &pb.RpcJournalGetSettingsResponseError{Code: pb.RpcJournalGetSettingsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockUploadRequest)
+	in := new(pb.RpcJournalGetSettingsRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockUploadResponse{Error: &pb.RpcBlockUploadResponseError{Code: pb.RpcBlockUploadResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcJournalGetSettingsResponse{Error: &pb.RpcJournalGetSettingsResponseError{Code: pb.RpcJournalGetSettingsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockUpload(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.JournalGetSettings(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockReplace(b []byte) (resp []byte) {
+func JournalSetSettings(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockReplaceResponse{Error: &pb.RpcBlockReplaceResponseError{Code: pb.RpcBlockReplaceResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcJournalSetSettingsResponse{Error: &pb.RpcJournalSetSettingsResponseError{Code: pb.RpcJournalSetSettingsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockReplaceRequest)
+	in := new(pb.RpcJournalSetSettingsRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockReplaceResponse{Error: &pb.RpcBlockReplaceResponseError{Code: pb.RpcBlockReplaceResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcJournalSetSettingsResponse{Error: &pb.RpcJournalSetSettingsResponseError{Code: pb.RpcJournalSetSettingsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockReplace(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.JournalSetSettings(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockCreate(b []byte) (resp []byte) {
+func CalendarExportView(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockCreateResponse{Error: &pb.RpcBlockCreateResponseError{Code: pb.RpcBlockCreateResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcCalendarExportViewResponse{Error: &pb.RpcCalendarExportViewResponseError{Code: pb.RpcCalendarExportViewResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockCreateRequest)
+	in := new(pb.RpcCalendarExportViewRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockCreateResponse{Error: &pb.RpcBlockCreateResponseError{Code: pb.RpcBlockCreateResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcCalendarExportViewResponse{Error: &pb.RpcCalendarExportViewResponseError{Code: pb.RpcCalendarExportViewResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockCreate(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.CalendarExportView(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockSplit(b []byte) (resp []byte) {
+func CalendarImport(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockSplitResponse{Error: &pb.RpcBlockSplitResponseError{Code: pb.RpcBlockSplitResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcCalendarImportResponse{Error: &pb.RpcCalendarImportResponseError{Code: pb.RpcCalendarImportResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockSplitRequest)
+	in := new(pb.RpcCalendarImportRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockSplitResponse{Error: &pb.RpcBlockSplitResponseError{Code: pb.RpcBlockSplitResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcCalendarImportResponse{Error: &pb.RpcCalendarImportResponseError{Code: pb.RpcCalendarImportResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockSplit(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.CalendarImport(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockMerge(b []byte) (resp []byte) {
+func CalendarFeedCreate(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockMergeResponse{Error: &pb.RpcBlockMergeResponseError{Code: pb.RpcBlockMergeResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcCalendarFeedCreateResponse{Error: &pb.RpcCalendarFeedCreateResponseError{Code: pb.RpcCalendarFeedCreateResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockMergeRequest)
+	in := new(pb.RpcCalendarFeedCreateRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockMergeResponse{Error: &pb.RpcBlockMergeResponseError{Code: pb.RpcBlockMergeResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcCalendarFeedCreateResponse{Error: &pb.RpcCalendarFeedCreateResponseError{Code: pb.RpcCalendarFeedCreateResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockMerge(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.CalendarFeedCreate(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockCopy(b []byte) (resp []byte) {
+func CalendarFeedList(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockCopyResponse{Error: &pb.RpcBlockCopyResponseError{Code: pb.RpcBlockCopyResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcCalendarFeedListResponse{Error: &pb.RpcCalendarFeedListResponseError{Code: pb.RpcCalendarFeedListResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockCopyRequest)
+	in := new(pb.RpcCalendarFeedListRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockCopyResponse{Error: &pb.RpcBlockCopyResponseError{Code: pb.RpcBlockCopyResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcCalendarFeedListResponse{Error: &pb.RpcCalendarFeedListResponseError{Code: pb.RpcCalendarFeedListResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockCopy(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.CalendarFeedList(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockPaste(b []byte) (resp []byte) {
+func CalendarFeedRemove(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockPasteResponse{Error: &pb.RpcBlockPasteResponseError{Code: pb.RpcBlockPasteResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcCalendarFeedRemoveResponse{Error: &pb.RpcCalendarFeedRemoveResponseError{Code: pb.RpcCalendarFeedRemoveResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockPasteRequest)
+	in := new(pb.RpcCalendarFeedRemoveRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockPasteResponse{Error: &pb.RpcBlockPasteResponseError{Code: pb.RpcBlockPasteResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcCalendarFeedRemoveResponse{Error: &pb.RpcCalendarFeedRemoveResponseError{Code: pb.RpcCalendarFeedRemoveResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockPaste(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.CalendarFeedRemove(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockCut(b []byte) (resp []byte) {
+func CalendarFeedGet(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockCutResponse{Error: &pb.RpcBlockCutResponseError{Code: pb.RpcBlockCutResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcCalendarFeedGetResponse{Error: &pb.RpcCalendarFeedGetResponseError{Code: pb.RpcCalendarFeedGetResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockCutRequest)
+	in := new(pb.RpcCalendarFeedGetRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockCutResponse{Error: &pb.RpcBlockCutResponseError{Code: pb.RpcBlockCutResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcCalendarFeedGetResponse{Error: &pb.RpcCalendarFeedGetResponseError{Code: pb.RpcCalendarFeedGetResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockCut(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.CalendarFeedGet(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockSetFields(b []byte) (resp []byte) {
+func WebhookCreate(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockSetFieldsResponse{Error: &pb.RpcBlockSetFieldsResponseError{Code: pb.RpcBlockSetFieldsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcWebhookCreateResponse{Error: &pb.RpcWebhookCreateResponseError{Code: pb.RpcWebhookCreateResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockSetFieldsRequest)
+	in := new(pb.RpcWebhookCreateRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockSetFieldsResponse{Error: &pb.RpcBlockSetFieldsResponseError{Code: pb.RpcBlockSetFieldsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcWebhookCreateResponse{Error: &pb.RpcWebhookCreateResponseError{Code: pb.RpcWebhookCreateResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockSetFields(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.WebhookCreate(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockExport(b []byte) (resp []byte) {
+func WebhookList(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockExportResponse{Error: &pb.RpcBlockExportResponseError{Code: pb.RpcBlockExportResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcWebhookListResponse{Error: &pb.RpcWebhookListResponseError{Code: pb.RpcWebhookListResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockExportRequest)
+	in := new(pb.RpcWebhookListRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockExportResponse{Error: &pb.RpcBlockExportResponseError{Code: pb.RpcBlockExportResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcWebhookListResponse{Error: &pb.RpcWebhookListResponseError{Code: pb.RpcWebhookListResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockExport(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.WebhookList(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockSetCarriage(b []byte) (resp []byte) {
+func WebhookRemove(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockSetCarriageResponse{Error: &pb.RpcBlockSetCarriageResponseError{Code: pb.RpcBlockSetCarriageResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcWebhookRemoveResponse{Error: &pb.RpcWebhookRemoveResponseError{Code: pb.RpcWebhookRemoveResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockSetCarriageRequest)
+	in := new(pb.RpcWebhookRemoveRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockSetCarriageResponse{Error: &pb.RpcBlockSetCarriageResponseError{Code: pb.RpcBlockSetCarriageResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcWebhookRemoveResponse{Error: &pb.RpcWebhookRemoveResponseError{Code: pb.RpcWebhookRemoveResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockSetCarriage(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.WebhookRemove(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockPreview(b []byte) (resp []byte) {
+func WebhookDeliveryList(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockPreviewResponse{Error: &pb.RpcBlockPreviewResponseError{Code: pb.RpcBlockPreviewResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcWebhookDeliveryListResponse{Error: &pb.RpcWebhookDeliveryListResponseError{Code: pb.RpcWebhookDeliveryListResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockPreviewRequest)
+	in := new(pb.RpcWebhookDeliveryListRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockPreviewResponse{Error: &pb.RpcBlockPreviewResponseError{Code: pb.RpcBlockPreviewResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcWebhookDeliveryListResponse{Error: &pb.RpcWebhookDeliveryListResponseError{Code: pb.RpcWebhookDeliveryListResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockPreview(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.WebhookDeliveryList(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockListDelete(b []byte) (resp []byte) {
+func LinkPreview(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockListDeleteResponse{Error: &pb.RpcBlockListDeleteResponseError{Code: pb.RpcBlockListDeleteResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcLinkPreviewResponse{Error: &pb.RpcLinkPreviewResponseError{Code: pb.RpcLinkPreviewResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockListDeleteRequest)
+	in := new(pb.RpcLinkPreviewRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockListDeleteResponse{Error: &pb.RpcBlockListDeleteResponseError{Code: pb.RpcBlockListDeleteResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcLinkPreviewResponse{Error: &pb.RpcLinkPreviewResponseError{Code: pb.RpcLinkPreviewResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockListDelete(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.LinkPreview(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockListMoveToExistingObject(b []byte) (resp []byte) {
+func UnsplashSearch(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockListMoveToExistingObjectResponse{Error: &pb.RpcBlockListMoveToExistingObjectResponseError{Code: pb.RpcBlockListMoveToExistingObjectResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcUnsplashSearchResponse{Error: &pb.RpcUnsplashSearchResponseError{Code: pb.RpcUnsplashSearchResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockListMoveToExistingObjectRequest)
+	in := new(pb.RpcUnsplashSearchRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockListMoveToExistingObjectResponse{Error: &pb.RpcBlockListMoveToExistingObjectResponseError{Code: pb.RpcBlockListMoveToExistingObjectResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcUnsplashSearchResponse{Error: &pb.RpcUnsplashSearchResponseError{Code: pb.RpcUnsplashSearchResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockListMoveToExistingObject(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.UnsplashSearch(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockListMoveToNewObject(b []byte) (resp []byte) {
+func UnsplashDownload(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockListMoveToNewObjectResponse{Error: &pb.RpcBlockListMoveToNewObjectResponseError{Code: pb.RpcBlockListMoveToNewObjectResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcUnsplashDownloadResponse{Error: &pb.RpcUnsplashDownloadResponseError{Code: pb.RpcUnsplashDownloadResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockListMoveToNewObjectRequest)
+	in := new(pb.RpcUnsplashDownloadRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockListMoveToNewObjectResponse{Error: &pb.RpcBlockListMoveToNewObjectResponseError{Code: pb.RpcBlockListMoveToNewObjectResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcUnsplashDownloadResponse{Error: &pb.RpcUnsplashDownloadResponseError{Code: pb.RpcUnsplashDownloadResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockListMoveToNewObject(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.UnsplashDownload(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockListConvertToObjects(b []byte) (resp []byte) {
+func GalleryDownloadManifest(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockListConvertToObjectsResponse{Error: &pb.RpcBlockListConvertToObjectsResponseError{Code: pb.RpcBlockListConvertToObjectsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcGalleryDownloadManifestResponse{Error: &pb.RpcGalleryDownloadManifestResponseError{Code: pb.RpcGalleryDownloadManifestResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockListConvertToObjectsRequest)
+	in := new(pb.RpcGalleryDownloadManifestRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockListConvertToObjectsResponse{Error: &pb.RpcBlockListConvertToObjectsResponseError{Code: pb.RpcBlockListConvertToObjectsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcGalleryDownloadManifestResponse{Error: &pb.RpcGalleryDownloadManifestResponseError{Code: pb.RpcGalleryDownloadManifestResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockListConvertToObjects(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.GalleryDownloadManifest(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockListSetFields(b []byte) (resp []byte) {
+func GalleryDownloadIndex(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockListSetFieldsResponse{Error: &pb.RpcBlockListSetFieldsResponseError{Code: pb.RpcBlockListSetFieldsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcGalleryDownloadIndexResponse{Error: &pb.RpcGalleryDownloadIndexResponseError{Code: pb.RpcGalleryDownloadIndexResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockListSetFieldsRequest)
+	in := new(pb.RpcGalleryDownloadIndexRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockListSetFieldsResponse{Error: &pb.RpcBlockListSetFieldsResponseError{Code: pb.RpcBlockListSetFieldsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcGalleryDownloadIndexResponse{Error: &pb.RpcGalleryDownloadIndexResponseError{Code: pb.RpcGalleryDownloadIndexResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockListSetFields(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.GalleryDownloadIndex(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockListDuplicate(b []byte) (resp []byte) {
+func WebdavStart(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockListDuplicateResponse{Error: &pb.RpcBlockListDuplicateResponseError{Code: pb.RpcBlockListDuplicateResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcWebdavStartResponse{Error: &pb.RpcWebdavStartResponseError{Code: pb.RpcWebdavStartResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockListDuplicateRequest)
+	in := new(pb.RpcWebdavStartRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockListDuplicateResponse{Error: &pb.RpcBlockListDuplicateResponseError{Code: pb.RpcBlockListDuplicateResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcWebdavStartResponse{Error: &pb.RpcWebdavStartResponseError{Code: pb.RpcWebdavStartResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockListDuplicate(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.WebdavStart(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockListSetBackgroundColor(b []byte) (resp []byte) {
+func WebdavStop(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockListSetBackgroundColorResponse{Error: &pb.RpcBlockListSetBackgroundColorResponseError{Code: pb.RpcBlockListSetBackgroundColorResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcWebdavStopResponse{Error: &pb.RpcWebdavStopResponseError{Code: pb.RpcWebdavStopResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockListSetBackgroundColorRequest)
+	in := new(pb.RpcWebdavStopRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockListSetBackgroundColorResponse{Error: &pb.RpcBlockListSetBackgroundColorResponseError{Code: pb.RpcBlockListSetBackgroundColorResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcWebdavStopResponse{Error: &pb.RpcWebdavStopResponseError{Code: pb.RpcWebdavStopResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockListSetBackgroundColor(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.WebdavStop(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockListSetAlign(b []byte) (resp []byte) {
+func WebdavInfo(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockListSetAlignResponse{Error: &pb.RpcBlockListSetAlignResponseError{Code: pb.RpcBlockListSetAlignResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcWebdavInfoResponse{Error: &pb.RpcWebdavInfoResponseError{Code: pb.RpcWebdavInfoResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockListSetAlignRequest)
+	in := new(pb.RpcWebdavInfoRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockListSetAlignResponse{Error: &pb.RpcBlockListSetAlignResponseError{Code: pb.RpcBlockListSetAlignResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcWebdavInfoResponse{Error: &pb.RpcWebdavInfoResponseError{Code: pb.RpcWebdavInfoResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockListSetAlign(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.WebdavInfo(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockListSetVerticalAlign(b []byte) (resp []byte) {
+func BlockUpload(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockListSetVerticalAlignResponse{Error: &pb.RpcBlockListSetVerticalAlignResponseError{Code: pb.RpcBlockListSetVerticalAlignResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockUploadResponse{Error: &pb.RpcBlockUploadResponseError{Code: pb.RpcBlockUploadResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockListSetVerticalAlignRequest)
+	in := new(pb.RpcBlockUploadRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockListSetVerticalAlignResponse{Error: &pb.RpcBlockListSetVerticalAlignResponseError{Code: pb.RpcBlockListSetVerticalAlignResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockUploadResponse{Error: &pb.RpcBlockUploadResponseError{Code: pb.RpcBlockUploadResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockListSetVerticalAlign(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockUpload(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockListTurnInto(b []byte) (resp []byte) {
+func BlockReplace(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockListTurnIntoResponse{Error: &pb.RpcBlockListTurnIntoResponseError{Code: pb.RpcBlockListTurnIntoResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockReplaceResponse{Error: &pb.RpcBlockReplaceResponseError{Code: pb.RpcBlockReplaceResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockListTurnIntoRequest)
+	in := new(pb.RpcBlockReplaceRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockListTurnIntoResponse{Error: &pb.RpcBlockListTurnIntoResponseError{Code: pb.RpcBlockListTurnIntoResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockReplaceResponse{Error: &pb.RpcBlockReplaceResponseError{Code: pb.RpcBlockReplaceResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockListTurnInto(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockReplace(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockTextSetText(b []byte) (resp []byte) {
+func BlockCreate(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockTextSetTextResponse{Error: &pb.RpcBlockTextSetTextResponseError{Code: pb.RpcBlockTextSetTextResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockCreateResponse{Error: &pb.RpcBlockCreateResponseError{Code: pb.RpcBlockCreateResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockTextSetTextRequest)
+	in := new(pb.RpcBlockCreateRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockTextSetTextResponse{Error: &pb.RpcBlockTextSetTextResponseError{Code: pb.RpcBlockTextSetTextResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockCreateResponse{Error: &pb.RpcBlockCreateResponseError{Code: pb.RpcBlockCreateResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockTextSetText(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockCreate(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockTextSetColor(b []byte) (resp []byte) {
+func BlockSplit(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockTextSetColorResponse{Error: &pb.RpcBlockTextSetColorResponseError{Code: pb.RpcBlockTextSetColorResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockSplitResponse{Error: &pb.RpcBlockSplitResponseError{Code: pb.RpcBlockSplitResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockTextSetColorRequest)
+	in := new(pb.RpcBlockSplitRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockTextSetColorResponse{Error: &pb.RpcBlockTextSetColorResponseError{Code: pb.RpcBlockTextSetColorResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockSplitResponse{Error: &pb.RpcBlockSplitResponseError{Code: pb.RpcBlockSplitResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockTextSetColor(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockSplit(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockTextSetStyle(b []byte) (resp []byte) {
+func BlockMerge(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockTextSetStyleResponse{Error: &pb.RpcBlockTextSetStyleResponseError{Code: pb.RpcBlockTextSetStyleResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockMergeResponse{Error: &pb.RpcBlockMergeResponseError{Code: pb.RpcBlockMergeResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockTextSetStyleRequest)
+	in := new(pb.RpcBlockMergeRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockTextSetStyleResponse{Error: &pb.RpcBlockTextSetStyleResponseError{Code: pb.RpcBlockTextSetStyleResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockMergeResponse{Error: &pb.RpcBlockMergeResponseError{Code: pb.RpcBlockMergeResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockTextSetStyle(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockMerge(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockTextSetChecked(b []byte) (resp []byte) {
+func BlockCopy(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockTextSetCheckedResponse{Error: &pb.RpcBlockTextSetCheckedResponseError{Code: pb.RpcBlockTextSetCheckedResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockCopyResponse{Error: &pb.RpcBlockCopyResponseError{Code: pb.RpcBlockCopyResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockTextSetCheckedRequest)
+	in := new(pb.RpcBlockCopyRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockTextSetCheckedResponse{Error: &pb.RpcBlockTextSetCheckedResponseError{Code: pb.RpcBlockTextSetCheckedResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockCopyResponse{Error: &pb.RpcBlockCopyResponseError{Code: pb.RpcBlockCopyResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockTextSetChecked(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockCopy(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockTextSetIcon(b []byte) (resp []byte) {
+func BlockPaste(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockTextSetIconResponse{Error: &pb.RpcBlockTextSetIconResponseError{Code: pb.RpcBlockTextSetIconResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockPasteResponse{Error: &pb.RpcBlockPasteResponseError{Code: pb.RpcBlockPasteResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockTextSetIconRequest)
+	in := new(pb.RpcBlockPasteRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockTextSetIconResponse{Error: &pb.RpcBlockTextSetIconResponseError{Code: pb.RpcBlockTextSetIconResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockPasteResponse{Error: &pb.RpcBlockPasteResponseError{Code: pb.RpcBlockPasteResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockTextSetIcon(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockPaste(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockTextListSetColor(b []byte) (resp []byte) {
+func BlockCut(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockTextListSetColorResponse{Error: &pb.RpcBlockTextListSetColorResponseError{Code: pb.RpcBlockTextListSetColorResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockCutResponse{Error: &pb.RpcBlockCutResponseError{Code: pb.RpcBlockCutResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockTextListSetColorRequest)
+	in := new(pb.RpcBlockCutRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockTextListSetColorResponse{Error: &pb.RpcBlockTextListSetColorResponseError{Code: pb.RpcBlockTextListSetColorResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockCutResponse{Error: &pb.RpcBlockCutResponseError{Code: pb.RpcBlockCutResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockTextListSetColor(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockCut(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockTextListSetMark(b []byte) (resp []byte) {
+func BlockSetFields(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockTextListSetMarkResponse{Error: &pb.RpcBlockTextListSetMarkResponseError{Code: pb.RpcBlockTextListSetMarkResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockSetFieldsResponse{Error: &pb.RpcBlockSetFieldsResponseError{Code: pb.RpcBlockSetFieldsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockTextListSetMarkRequest)
+	in := new(pb.RpcBlockSetFieldsRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockTextListSetMarkResponse{Error: &pb.RpcBlockTextListSetMarkResponseError{Code: pb.RpcBlockTextListSetMarkResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockSetFieldsResponse{Error: &pb.RpcBlockSetFieldsResponseError{Code: pb.RpcBlockSetFieldsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockTextListSetMark(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockSetFields(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockTextListSetStyle(b []byte) (resp []byte) {
+func BlockExport(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockTextListSetStyleResponse{Error: &pb.RpcBlockTextListSetStyleResponseError{Code: pb.RpcBlockTextListSetStyleResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockExportResponse{Error: &pb.RpcBlockExportResponseError{Code: pb.RpcBlockExportResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockTextListSetStyleRequest)
+	in := new(pb.RpcBlockExportRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockTextListSetStyleResponse{Error: &pb.RpcBlockTextListSetStyleResponseError{Code: pb.RpcBlockTextListSetStyleResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockExportResponse{Error: &pb.RpcBlockExportResponseError{Code: pb.RpcBlockExportResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockTextListSetStyle(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockExport(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockTextListClearStyle(b []byte) (resp []byte) {
+func BlockSetCarriage(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockTextListClearStyleResponse{Error: &pb.RpcBlockTextListClearStyleResponseError{Code: pb.RpcBlockTextListClearStyleResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockSetCarriageResponse{Error: &pb.RpcBlockSetCarriageResponseError{Code: pb.RpcBlockSetCarriageResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockTextListClearStyleRequest)
+	in := new(pb.RpcBlockSetCarriageRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockTextListClearStyleResponse{Error: &pb.RpcBlockTextListClearStyleResponseError{Code: pb.RpcBlockTextListClearStyleResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockSetCarriageResponse{Error: &pb.RpcBlockSetCarriageResponseError{Code: pb.RpcBlockSetCarriageResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockTextListClearStyle(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockSetCarriage(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockTextListClearContent(b []byte) (resp []byte) {
+func BlockPreview(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockTextListClearContentResponse{Error: &pb.RpcBlockTextListClearContentResponseError{Code: pb.RpcBlockTextListClearContentResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockPreviewResponse{Error: &pb.RpcBlockPreviewResponseError{Code: pb.RpcBlockPreviewResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockTextListClearContentRequest)
+	in := new(pb.RpcBlockPreviewRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockTextListClearContentResponse{Error: &pb.RpcBlockTextListClearContentResponseError{Code: pb.RpcBlockTextListClearContentResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockPreviewResponse{Error: &pb.RpcBlockPreviewResponseError{Code: pb.RpcBlockPreviewResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockTextListClearContent(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockPreview(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockFileSetName(b []byte) (resp []byte) {
+func BlockListDelete(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockFileSetNameResponse{Error: &pb.RpcBlockFileSetNameResponseError{Code: pb.RpcBlockFileSetNameResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockListDeleteResponse{Error: &pb.RpcBlockListDeleteResponseError{Code: pb.RpcBlockListDeleteResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockFileSetNameRequest)
+	in := new(pb.RpcBlockListDeleteRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockFileSetNameResponse{Error: &pb.RpcBlockFileSetNameResponseError{Code: pb.RpcBlockFileSetNameResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockListDeleteResponse{Error: &pb.RpcBlockListDeleteResponseError{Code: pb.RpcBlockListDeleteResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockFileSetName(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockListDelete(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockFileSetTargetObjectId(b []byte) (resp []byte) {
+func BlockListMoveToExistingObject(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockFileSetTargetObjectIdResponse{Error: &pb.RpcBlockFileSetTargetObjectIdResponseError{Code: pb.RpcBlockFileSetTargetObjectIdResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockListMoveToExistingObjectResponse{Error: &pb.RpcBlockListMoveToExistingObjectResponseError{Code: pb.RpcBlockListMoveToExistingObjectResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockFileSetTargetObjectIdRequest)
+	in := new(pb.RpcBlockListMoveToExistingObjectRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockFileSetTargetObjectIdResponse{Error: &pb.RpcBlockFileSetTargetObjectIdResponseError{Code: pb.RpcBlockFileSetTargetObjectIdResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockListMoveToExistingObjectResponse{Error: &pb.RpcBlockListMoveToExistingObjectResponseError{Code: pb.RpcBlockListMoveToExistingObjectResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockFileSetTargetObjectId(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockListMoveToExistingObject(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockImageSetName(b []byte) (resp []byte) {
+func BlockListMoveToNewObject(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockImageSetNameResponse{Error: &pb.RpcBlockImageSetNameResponseError{Code: pb.RpcBlockImageSetNameResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockListMoveToNewObjectResponse{Error: &pb.RpcBlockListMoveToNewObjectResponseError{Code: pb.RpcBlockListMoveToNewObjectResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockImageSetNameRequest)
+	in := new(pb.RpcBlockListMoveToNewObjectRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockImageSetNameResponse{Error: &pb.RpcBlockImageSetNameResponseError{Code: pb.RpcBlockImageSetNameResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockListMoveToNewObjectResponse{Error: &pb.RpcBlockListMoveToNewObjectResponseError{Code: pb.RpcBlockListMoveToNewObjectResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockImageSetName(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockListMoveToNewObject(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockVideoSetName(b []byte) (resp []byte) {
+func BlockListConvertToObjects(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockVideoSetNameResponse{Error: &pb.RpcBlockVideoSetNameResponseError{Code: pb.RpcBlockVideoSetNameResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockListConvertToObjectsResponse{Error: &pb.RpcBlockListConvertToObjectsResponseError{Code: pb.RpcBlockListConvertToObjectsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockVideoSetNameRequest)
+	in := new(pb.RpcBlockListConvertToObjectsRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockVideoSetNameResponse{Error: &pb.RpcBlockVideoSetNameResponseError{Code: pb.RpcBlockVideoSetNameResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockListConvertToObjectsResponse{Error: &pb.RpcBlockListConvertToObjectsResponseError{Code: pb.RpcBlockListConvertToObjectsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockVideoSetName(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockListConvertToObjects(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockFileCreateAndUpload(b []byte) (resp []byte) {
+func BlockListSetFields(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockFileCreateAndUploadResponse{Error: &pb.RpcBlockFileCreateAndUploadResponseError{Code: pb.RpcBlockFileCreateAndUploadResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockListSetFieldsResponse{Error: &pb.RpcBlockListSetFieldsResponseError{Code: pb.RpcBlockListSetFieldsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockFileCreateAndUploadRequest)
+	in := new(pb.RpcBlockListSetFieldsRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockFileCreateAndUploadResponse{Error: &pb.RpcBlockFileCreateAndUploadResponseError{Code: pb.RpcBlockFileCreateAndUploadResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockListSetFieldsResponse{Error: &pb.RpcBlockListSetFieldsResponseError{Code: pb.RpcBlockListSetFieldsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockFileCreateAndUpload(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockListSetFields(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockFileListSetStyle(b []byte) (resp []byte) {
+func BlockListDuplicate(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockFileListSetStyleResponse{Error: &pb.RpcBlockFileListSetStyleResponseError{Code: pb.RpcBlockFileListSetStyleResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockListDuplicateResponse{Error: &pb.RpcBlockListDuplicateResponseError{Code: pb.RpcBlockListDuplicateResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockFileListSetStyleRequest)
+	in := new(pb.RpcBlockListDuplicateRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockFileListSetStyleResponse{Error: &pb.RpcBlockFileListSetStyleResponseError{Code: pb.RpcBlockFileListSetStyleResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockListDuplicateResponse{Error: &pb.RpcBlockListDuplicateResponseError{Code: pb.RpcBlockListDuplicateResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockFileListSetStyle(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockListDuplicate(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockDataviewViewCreate(b []byte) (resp []byte) {
+func BlockListSetBackgroundColor(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockDataviewViewCreateResponse{Error: &pb.RpcBlockDataviewViewCreateResponseError{Code: pb.RpcBlockDataviewViewCreateResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockListSetBackgroundColorResponse{Error: &pb.RpcBlockListSetBackgroundColorResponseError{Code: pb.RpcBlockListSetBackgroundColorResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockDataviewViewCreateRequest)
+	in := new(pb.RpcBlockListSetBackgroundColorRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockDataviewViewCreateResponse{Error: &pb.RpcBlockDataviewViewCreateResponseError{Code: pb.RpcBlockDataviewViewCreateResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockListSetBackgroundColorResponse{Error: &pb.RpcBlockListSetBackgroundColorResponseError{Code: pb.RpcBlockListSetBackgroundColorResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockDataviewViewCreate(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockListSetBackgroundColor(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockDataviewViewDelete(b []byte) (resp []byte) {
+func BlockListSetAlign(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockDataviewViewDeleteResponse{Error: &pb.RpcBlockDataviewViewDeleteResponseError{Code: pb.RpcBlockDataviewViewDeleteResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockListSetAlignResponse{Error: &pb.RpcBlockListSetAlignResponseError{Code: pb.RpcBlockListSetAlignResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockDataviewViewDeleteRequest)
+	in := new(pb.RpcBlockListSetAlignRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockDataviewViewDeleteResponse{Error: &pb.RpcBlockDataviewViewDeleteResponseError{Code: pb.RpcBlockDataviewViewDeleteResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockListSetAlignResponse{Error: &pb.RpcBlockListSetAlignResponseError{Code: pb.RpcBlockListSetAlignResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockDataviewViewDelete(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockListSetAlign(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockDataviewViewUpdate(b []byte) (resp []byte) {
+func BlockListSetVerticalAlign(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockDataviewViewUpdateResponse{Error: &pb.RpcBlockDataviewViewUpdateResponseError{Code: pb.RpcBlockDataviewViewUpdateResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockListSetVerticalAlignResponse{Error: &pb.RpcBlockListSetVerticalAlignResponseError{Code: pb.RpcBlockListSetVerticalAlignResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockDataviewViewUpdateRequest)
+	in := new(pb.RpcBlockListSetVerticalAlignRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockDataviewViewUpdateResponse{Error: &pb.RpcBlockDataviewViewUpdateResponseError{Code: pb.RpcBlockDataviewViewUpdateResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockListSetVerticalAlignResponse{Error: &pb.RpcBlockListSetVerticalAlignResponseError{Code: pb.RpcBlockListSetVerticalAlignResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockDataviewViewUpdate(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockListSetVerticalAlign(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockDataviewViewSetActive(b []byte) (resp []byte) {
+func BlockListTurnInto(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockDataviewViewSetActiveResponse{Error: &pb.RpcBlockDataviewViewSetActiveResponseError{Code: pb.RpcBlockDataviewViewSetActiveResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockListTurnIntoResponse{Error: &pb.RpcBlockListTurnIntoResponseError{Code: pb.RpcBlockListTurnIntoResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockDataviewViewSetActiveRequest)
+	in := new(pb.RpcBlockListTurnIntoRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockDataviewViewSetActiveResponse{Error: &pb.RpcBlockDataviewViewSetActiveResponseError{Code: pb.RpcBlockDataviewViewSetActiveResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockListTurnIntoResponse{Error: &pb.RpcBlockListTurnIntoResponseError{Code: pb.RpcBlockListTurnIntoResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockDataviewViewSetActive(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockListTurnInto(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockDataviewViewSetPosition(b []byte) (resp []byte) {
+func BlockTextSetText(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockDataviewViewSetPositionResponse{Error: &pb.RpcBlockDataviewViewSetPositionResponseError{Code: pb.RpcBlockDataviewViewSetPositionResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockTextSetTextResponse{Error: &pb.RpcBlockTextSetTextResponseError{Code: pb.RpcBlockTextSetTextResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockDataviewViewSetPositionRequest)
+	in := new(pb.RpcBlockTextSetTextRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockDataviewViewSetPositionResponse{Error: &pb.RpcBlockDataviewViewSetPositionResponseError{Code: pb.RpcBlockDataviewViewSetPositionResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockTextSetTextResponse{Error: &pb.RpcBlockTextSetTextResponseError{Code: pb.RpcBlockTextSetTextResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockDataviewViewSetPosition(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockTextSetText(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockDataviewSetSource(b []byte) (resp []byte) {
+func BlockTextSetColor(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockDataviewSetSourceResponse{Error: &pb.RpcBlockDataviewSetSourceResponseError{Code: pb.RpcBlockDataviewSetSourceResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockTextSetColorResponse{Error: &pb.RpcBlockTextSetColorResponseError{Code: pb.RpcBlockTextSetColorResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockDataviewSetSourceRequest)
+	in := new(pb.RpcBlockTextSetColorRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockDataviewSetSourceResponse{Error: &pb.RpcBlockDataviewSetSourceResponseError{Code: pb.RpcBlockDataviewSetSourceResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockTextSetColorResponse{Error: &pb.RpcBlockTextSetColorResponseError{Code: pb.RpcBlockTextSetColorResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockDataviewSetSource(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockTextSetColor(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockDataviewRelationSet(b []byte) (resp []byte) {
+func BlockTextSetStyle(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockDataviewRelationSetResponse{Error: &pb.RpcBlockDataviewRelationSetResponseError{Code: pb.RpcBlockDataviewRelationSetResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockTextSetStyleResponse{Error: &pb.RpcBlockTextSetStyleResponseError{Code: pb.RpcBlockTextSetStyleResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockDataviewRelationSetRequest)
+	in := new(pb.RpcBlockTextSetStyleRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockDataviewRelationSetResponse{Error: &pb.RpcBlockDataviewRelationSetResponseError{Code: pb.RpcBlockDataviewRelationSetResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockTextSetStyleResponse{Error: &pb.RpcBlockTextSetStyleResponseError{Code: pb.RpcBlockTextSetStyleResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockDataviewRelationSet(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockTextSetStyle(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockDataviewRelationAdd(b []byte) (resp []byte) {
+func BlockTextSetChecked(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockDataviewRelationAddResponse{Error: &pb.RpcBlockDataviewRelationAddResponseError{Code: pb.RpcBlockDataviewRelationAddResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockTextSetCheckedResponse{Error: &pb.RpcBlockTextSetCheckedResponseError{Code: pb.RpcBlockTextSetCheckedResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockDataviewRelationAddRequest)
+	in := new(pb.RpcBlockTextSetCheckedRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockDataviewRelationAddResponse{Error: &pb.RpcBlockDataviewRelationAddResponseError{Code: pb.RpcBlockDataviewRelationAddResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockTextSetCheckedResponse{Error: &pb.RpcBlockTextSetCheckedResponseError{Code: pb.RpcBlockTextSetCheckedResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockDataviewRelationAdd(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockTextSetChecked(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockDataviewRelationDelete(b []byte) (resp []byte) {
+func BlockTextSetIcon(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockDataviewRelationDeleteResponse{Error: &pb.RpcBlockDataviewRelationDeleteResponseError{Code: pb.RpcBlockDataviewRelationDeleteResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockTextSetIconResponse{Error: &pb.RpcBlockTextSetIconResponseError{Code: pb.RpcBlockTextSetIconResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockDataviewRelationDeleteRequest)
+	in := new(pb.RpcBlockTextSetIconRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockDataviewRelationDeleteResponse{Error: &pb.RpcBlockDataviewRelationDeleteResponseError{Code: pb.RpcBlockDataviewRelationDeleteResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockTextSetIconResponse{Error: &pb.RpcBlockTextSetIconResponseError{Code: pb.RpcBlockTextSetIconResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockDataviewRelationDelete(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockTextSetIcon(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockDataviewGroupOrderUpdate(b []byte) (resp []byte) {
+func BlockTextListSetColor(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockDataviewGroupOrderUpdateResponse{Error: &pb.RpcBlockDataviewGroupOrderUpdateResponseError{Code: pb.RpcBlockDataviewGroupOrderUpdateResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockTextListSetColorResponse{Error: &pb.RpcBlockTextListSetColorResponseError{Code: pb.RpcBlockTextListSetColorResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockDataviewGroupOrderUpdateRequest)
+	in := new(pb.RpcBlockTextListSetColorRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockDataviewGroupOrderUpdateResponse{Error: &pb.RpcBlockDataviewGroupOrderUpdateResponseError{Code: pb.RpcBlockDataviewGroupOrderUpdateResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockTextListSetColorResponse{Error: &pb.RpcBlockTextListSetColorResponseError{Code: pb.RpcBlockTextListSetColorResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockDataviewGroupOrderUpdate(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockTextListSetColor(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockDataviewObjectOrderUpdate(b []byte) (resp []byte) {
+func BlockTextListSetMark(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockDataviewObjectOrderUpdateResponse{Error: &pb.RpcBlockDataviewObjectOrderUpdateResponseError{Code: pb.RpcBlockDataviewObjectOrderUpdateResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockTextListSetMarkResponse{Error: &pb.RpcBlockTextListSetMarkResponseError{Code: pb.RpcBlockTextListSetMarkResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockDataviewObjectOrderUpdateRequest)
+	in := new(pb.RpcBlockTextListSetMarkRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockDataviewObjectOrderUpdateResponse{Error: &pb.RpcBlockDataviewObjectOrderUpdateResponseError{Code: pb.RpcBlockDataviewObjectOrderUpdateResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockTextListSetMarkResponse{Error: &pb.RpcBlockTextListSetMarkResponseError{Code: pb.RpcBlockTextListSetMarkResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockDataviewObjectOrderUpdate(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockTextListSetMark(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockDataviewObjectOrderMove(b []byte) (resp []byte) {
+func BlockTextListSetStyle(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockDataviewObjectOrderMoveResponse{Error: &pb.RpcBlockDataviewObjectOrderMoveResponseError{Code: pb.RpcBlockDataviewObjectOrderMoveResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockTextListSetStyleResponse{Error: &pb.RpcBlockTextListSetStyleResponseError{Code: pb.RpcBlockTextListSetStyleResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockDataviewObjectOrderMoveRequest)
+	in := new(pb.RpcBlockTextListSetStyleRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockDataviewObjectOrderMoveResponse{Error: &pb.RpcBlockDataviewObjectOrderMoveResponseError{Code: pb.RpcBlockDataviewObjectOrderMoveResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockTextListSetStyleResponse{Error: &pb.RpcBlockTextListSetStyleResponseError{Code: pb.RpcBlockTextListSetStyleResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockDataviewObjectOrderMove(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockTextListSetStyle(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockDataviewCreateFromExistingObject(b []byte) (resp []byte) {
+func BlockTextListClearStyle(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockDataviewCreateFromExistingObjectResponse{Error: &pb.RpcBlockDataviewCreateFromExistingObjectResponseError{Code: pb.RpcBlockDataviewCreateFromExistingObjectResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockTextListClearStyleResponse{Error: &pb.RpcBlockTextListClearStyleResponseError{Code: pb.RpcBlockTextListClearStyleResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockDataviewCreateFromExistingObjectRequest)
+	in := new(pb.RpcBlockTextListClearStyleRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockDataviewCreateFromExistingObjectResponse{Error: &pb.RpcBlockDataviewCreateFromExistingObjectResponseError{Code: pb.RpcBlockDataviewCreateFromExistingObjectResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockTextListClearStyleResponse{Error: &pb.RpcBlockTextListClearStyleResponseError{Code: pb.RpcBlockTextListClearStyleResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockDataviewCreateFromExistingObject(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockTextListClearStyle(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockDataviewFilterAdd(b []byte) (resp []byte) {
+func BlockTextListClearContent(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockDataviewFilterAddResponse{Error: &pb.RpcBlockDataviewFilterAddResponseError{Code: pb.RpcBlockDataviewFilterAddResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockTextListClearContentResponse{Error: &pb.RpcBlockTextListClearContentResponseError{Code: pb.RpcBlockTextListClearContentResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockDataviewFilterAddRequest)
+	in := new(pb.RpcBlockTextListClearContentRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockDataviewFilterAddResponse{Error: &pb.RpcBlockDataviewFilterAddResponseError{Code: pb.RpcBlockDataviewFilterAddResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockTextListClearContentResponse{Error: &pb.RpcBlockTextListClearContentResponseError{Code: pb.RpcBlockTextListClearContentResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockDataviewFilterAdd(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockTextListClearContent(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockDataviewFilterRemove(b []byte) (resp []byte) {
+func BlockFileSetName(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockDataviewFilterRemoveResponse{Error: &pb.RpcBlockDataviewFilterRemoveResponseError{Code: pb.RpcBlockDataviewFilterRemoveResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockFileSetNameResponse{Error: &pb.RpcBlockFileSetNameResponseError{Code: pb.RpcBlockFileSetNameResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockDataviewFilterRemoveRequest)
+	in := new(pb.RpcBlockFileSetNameRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockDataviewFilterRemoveResponse{Error: &pb.RpcBlockDataviewFilterRemoveResponseError{Code: pb.RpcBlockDataviewFilterRemoveResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockFileSetNameResponse{Error: &pb.RpcBlockFileSetNameResponseError{Code: pb.RpcBlockFileSetNameResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockDataviewFilterRemove(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockFileSetName(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockDataviewFilterReplace(b []byte) (resp []byte) {
+func BlockFileSetTargetObjectId(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockDataviewFilterReplaceResponse{Error: &pb.RpcBlockDataviewFilterReplaceResponseError{Code: pb.RpcBlockDataviewFilterReplaceResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockFileSetTargetObjectIdResponse{Error: &pb.RpcBlockFileSetTargetObjectIdResponseError{Code: pb.RpcBlockFileSetTargetObjectIdResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockDataviewFilterReplaceRequest)
+	in := new(pb.RpcBlockFileSetTargetObjectIdRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockDataviewFilterReplaceResponse{Error: &pb.RpcBlockDataviewFilterReplaceResponseError{Code: pb.RpcBlockDataviewFilterReplaceResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockFileSetTargetObjectIdResponse{Error: &pb.RpcBlockFileSetTargetObjectIdResponseError{Code: pb.RpcBlockFileSetTargetObjectIdResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockDataviewFilterReplace(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockFileSetTargetObjectId(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockDataviewFilterSort(b []byte) (resp []byte) {
+func BlockImageSetName(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockDataviewFilterSortResponse{Error: &pb.RpcBlockDataviewFilterSortResponseError{Code: pb.RpcBlockDataviewFilterSortResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockImageSetNameResponse{Error: &pb.RpcBlockImageSetNameResponseError{Code: pb.RpcBlockImageSetNameResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockDataviewFilterSortRequest)
+	in := new(pb.RpcBlockImageSetNameRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockDataviewFilterSortResponse{Error: &pb.RpcBlockDataviewFilterSortResponseError{Code: pb.RpcBlockDataviewFilterSortResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockImageSetNameResponse{Error: &pb.RpcBlockImageSetNameResponseError{Code: pb.RpcBlockImageSetNameResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockDataviewFilterSort(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockImageSetName(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockDataviewSortAdd(b []byte) (resp []byte) {
+func BlockVideoSetName(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockDataviewSortAddResponse{Error: &pb.RpcBlockDataviewSortAddResponseError{Code: pb.RpcBlockDataviewSortAddResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockVideoSetNameResponse{Error: &pb.RpcBlockVideoSetNameResponseError{Code: pb.RpcBlockVideoSetNameResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockDataviewSortAddRequest)
+	in := new(pb.RpcBlockVideoSetNameRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockDataviewSortAddResponse{Error: &pb.RpcBlockDataviewSortAddResponseError{Code: pb.RpcBlockDataviewSortAddResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockVideoSetNameResponse{Error: &pb.RpcBlockVideoSetNameResponseError{Code: pb.RpcBlockVideoSetNameResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockDataviewSortAdd(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockVideoSetName(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockDataviewSortRemove(b []byte) (resp []byte) {
+func BlockFileCreateAndUpload(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockDataviewSortRemoveResponse{Error: &pb.RpcBlockDataviewSortRemoveResponseError{Code: pb.RpcBlockDataviewSortRemoveResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockFileCreateAndUploadResponse{Error: &pb.RpcBlockFileCreateAndUploadResponseError{Code: pb.RpcBlockFileCreateAndUploadResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockDataviewSortRemoveRequest)
+	in := new(pb.RpcBlockFileCreateAndUploadRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockDataviewSortRemoveResponse{Error: &pb.RpcBlockDataviewSortRemoveResponseError{Code: pb.RpcBlockDataviewSortRemoveResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockFileCreateAndUploadResponse{Error: &pb.RpcBlockFileCreateAndUploadResponseError{Code: pb.RpcBlockFileCreateAndUploadResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockDataviewSortRemove(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockFileCreateAndUpload(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockDataviewSortReplace(b []byte) (resp []byte) {
+func BlockFileListSetStyle(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockDataviewSortReplaceResponse{Error: &pb.RpcBlockDataviewSortReplaceResponseError{Code: pb.RpcBlockDataviewSortReplaceResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockFileListSetStyleResponse{Error: &pb.RpcBlockFileListSetStyleResponseError{Code: pb.RpcBlockFileListSetStyleResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockDataviewSortReplaceRequest)
+	in := new(pb.RpcBlockFileListSetStyleRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockDataviewSortReplaceResponse{Error: &pb.RpcBlockDataviewSortReplaceResponseError{Code: pb.RpcBlockDataviewSortReplaceResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockFileListSetStyleResponse{Error: &pb.RpcBlockFileListSetStyleResponseError{Code: pb.RpcBlockFileListSetStyleResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockDataviewSortReplace(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockFileListSetStyle(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockDataviewSortSort(b []byte) (resp []byte) {
+func BlockDataviewViewCreate(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockDataviewSortSSortResponse{Error: &pb.RpcBlockDataviewSortSSortResponseError{Code: pb.RpcBlockDataviewSortSSortResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockDataviewViewCreateResponse{Error: &pb.RpcBlockDataviewViewCreateResponseError{Code: pb.RpcBlockDataviewViewCreateResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockDataviewSortSSortRequest)
+	in := new(pb.RpcBlockDataviewViewCreateRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockDataviewSortSSortResponse{Error: &pb.RpcBlockDataviewSortSSortResponseError{Code: pb.RpcBlockDataviewSortSSortResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockDataviewViewCreateResponse{Error: &pb.RpcBlockDataviewViewCreateResponseError{Code: pb.RpcBlockDataviewViewCreateResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockDataviewSortSort(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockDataviewViewCreate(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockDataviewViewRelationAdd(b []byte) (resp []byte) {
+func BlockDataviewViewDelete(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockDataviewViewRelationAddResponse{Error: &pb.RpcBlockDataviewViewRelationAddResponseError{Code: pb.RpcBlockDataviewViewRelationAddResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockDataviewViewDeleteResponse{Error: &pb.RpcBlockDataviewViewDeleteResponseError{Code: pb.RpcBlockDataviewViewDeleteResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockDataviewViewRelationAddRequest)
+	in := new(pb.RpcBlockDataviewViewDeleteRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockDataviewViewRelationAddResponse{Error: &pb.RpcBlockDataviewViewRelationAddResponseError{Code: pb.RpcBlockDataviewViewRelationAddResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockDataviewViewDeleteResponse{Error: &pb.RpcBlockDataviewViewDeleteResponseError{Code: pb.RpcBlockDataviewViewDeleteResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockDataviewViewRelationAdd(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockDataviewViewDelete(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockDataviewViewRelationRemove(b []byte) (resp []byte) {
+func BlockDataviewViewUpdate(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockDataviewViewRelationRemoveResponse{Error: &pb.RpcBlockDataviewViewRelationRemoveResponseError{Code: pb.RpcBlockDataviewViewRelationRemoveResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockDataviewViewUpdateResponse{Error: &pb.RpcBlockDataviewViewUpdateResponseError{Code: pb.RpcBlockDataviewViewUpdateResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockDataviewViewRelationRemoveRequest)
+	in := new(pb.RpcBlockDataviewViewUpdateRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockDataviewViewRelationRemoveResponse{Error: &pb.RpcBlockDataviewViewRelationRemoveResponseError{Code: pb.RpcBlockDataviewViewRelationRemoveResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockDataviewViewUpdateResponse{Error: &pb.RpcBlockDataviewViewUpdateResponseError{Code: pb.RpcBlockDataviewViewUpdateResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockDataviewViewRelationRemove(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockDataviewViewUpdate(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockDataviewViewRelationReplace(b []byte) (resp []byte) {
+func BlockDataviewViewSetActive(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockDataviewViewRelationReplaceResponse{Error: &pb.RpcBlockDataviewViewRelationReplaceResponseError{Code: pb.RpcBlockDataviewViewRelationReplaceResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockDataviewViewSetActiveResponse{Error: &pb.RpcBlockDataviewViewSetActiveResponseError{Code: pb.RpcBlockDataviewViewSetActiveResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockDataviewViewRelationReplaceRequest)
+	in := new(pb.RpcBlockDataviewViewSetActiveRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockDataviewViewRelationReplaceResponse{Error: &pb.RpcBlockDataviewViewRelationReplaceResponseError{Code: pb.RpcBlockDataviewViewRelationReplaceResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockDataviewViewSetActiveResponse{Error: &pb.RpcBlockDataviewViewSetActiveResponseError{Code: pb.RpcBlockDataviewViewSetActiveResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockDataviewViewRelationReplace(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockDataviewViewSetActive(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockDataviewViewRelationSort(b []byte) (resp []byte) {
+func BlockDataviewViewSetPosition(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockDataviewViewRelationSortResponse{Error: &pb.RpcBlockDataviewViewRelationSortResponseError{Code: pb.RpcBlockDataviewViewRelationSortResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockDataviewViewSetPositionResponse{Error: &pb.RpcBlockDataviewViewSetPositionResponseError{Code: pb.RpcBlockDataviewViewSetPositionResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockDataviewViewRelationSortRequest)
+	in := new(pb.RpcBlockDataviewViewSetPositionRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockDataviewViewRelationSortResponse{Error: &pb.RpcBlockDataviewViewRelationSortResponseError{Code: pb.RpcBlockDataviewViewRelationSortResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockDataviewViewSetPositionResponse{Error: &pb.RpcBlockDataviewViewSetPositionResponseError{Code: pb.RpcBlockDataviewViewSetPositionResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockDataviewViewRelationSort(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockDataviewViewSetPosition(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockTableCreate(b []byte) (resp []byte) {
+func BlockDataviewSetSource(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockTableCreateResponse{Error: &pb.RpcBlockTableCreateResponseError{Code: pb.RpcBlockTableCreateResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockDataviewSetSourceResponse{Error: &pb.RpcBlockDataviewSetSourceResponseError{Code: pb.RpcBlockDataviewSetSourceResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockTableCreateRequest)
+	in := new(pb.RpcBlockDataviewSetSourceRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockTableCreateResponse{Error: &pb.RpcBlockTableCreateResponseError{Code: pb.RpcBlockTableCreateResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockDataviewSetSourceResponse{Error: &pb.RpcBlockDataviewSetSourceResponseError{Code: pb.RpcBlockDataviewSetSourceResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockTableCreate(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockDataviewSetSource(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockTableExpand(b []byte) (resp []byte) {
+func BlockDataviewRelationSet(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockTableExpandResponse{Error: &pb.RpcBlockTableExpandResponseError{Code: pb.RpcBlockTableExpandResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockDataviewRelationSetResponse{Error: &pb.RpcBlockDataviewRelationSetResponseError{Code: pb.RpcBlockDataviewRelationSetResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockTableExpandRequest)
+	in := new(pb.RpcBlockDataviewRelationSetRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockTableExpandResponse{Error: &pb.RpcBlockTableExpandResponseError{Code: pb.RpcBlockTableExpandResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockDataviewRelationSetResponse{Error: &pb.RpcBlockDataviewRelationSetResponseError{Code: pb.RpcBlockDataviewRelationSetResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockTableExpand(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockDataviewRelationSet(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockTableRowCreate(b []byte) (resp []byte) {
+func BlockDataviewRelationAdd(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockTableRowCreateResponse{Error: &pb.RpcBlockTableRowCreateResponseError{Code: pb.RpcBlockTableRowCreateResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockDataviewRelationAddResponse{Error: &pb.RpcBlockDataviewRelationAddResponseError{Code: pb.RpcBlockDataviewRelationAddResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockTableRowCreateRequest)
+	in := new(pb.RpcBlockDataviewRelationAddRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockTableRowCreateResponse{Error: &pb.RpcBlockTableRowCreateResponseError{Code: pb.RpcBlockTableRowCreateResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockDataviewRelationAddResponse{Error: &pb.RpcBlockDataviewRelationAddResponseError{Code: pb.RpcBlockDataviewRelationAddResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockTableRowCreate(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockDataviewRelationAdd(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockTableRowDelete(b []byte) (resp []byte) {
+func BlockDataviewRelationDelete(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockTableRowDeleteResponse{Error: &pb.RpcBlockTableRowDeleteResponseError{Code: pb.RpcBlockTableRowDeleteResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockDataviewRelationDeleteResponse{Error: &pb.RpcBlockDataviewRelationDeleteResponseError{Code: pb.RpcBlockDataviewRelationDeleteResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockTableRowDeleteRequest)
+	in := new(pb.RpcBlockDataviewRelationDeleteRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockTableRowDeleteResponse{Error: &pb.RpcBlockTableRowDeleteResponseError{Code: pb.RpcBlockTableRowDeleteResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockDataviewRelationDeleteResponse{Error: &pb.RpcBlockDataviewRelationDeleteResponseError{Code: pb.RpcBlockDataviewRelationDeleteResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockTableRowDelete(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockDataviewRelationDelete(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockTableRowDuplicate(b []byte) (resp []byte) {
+func BlockDataviewGroupOrderUpdate(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockTableRowDuplicateResponse{Error: &pb.RpcBlockTableRowDuplicateResponseError{Code: pb.RpcBlockTableRowDuplicateResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockDataviewGroupOrderUpdateResponse{Error: &pb.RpcBlockDataviewGroupOrderUpdateResponseError{Code: pb.RpcBlockDataviewGroupOrderUpdateResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockTableRowDuplicateRequest)
+	in := new(pb.RpcBlockDataviewGroupOrderUpdateRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockTableRowDuplicateResponse{Error: &pb.RpcBlockTableRowDuplicateResponseError{Code: pb.RpcBlockTableRowDuplicateResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockDataviewGroupOrderUpdateResponse{Error: &pb.RpcBlockDataviewGroupOrderUpdateResponseError{Code: pb.RpcBlockDataviewGroupOrderUpdateResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockTableRowDuplicate(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockDataviewGroupOrderUpdate(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockTableRowSetHeader(b []byte) (resp []byte) {
+func BlockDataviewObjectOrderUpdate(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockTableRowSetHeaderResponse{Error: &pb.RpcBlockTableRowSetHeaderResponseError{Code: pb.RpcBlockTableRowSetHeaderResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockDataviewObjectOrderUpdateResponse{Error: &pb.RpcBlockDataviewObjectOrderUpdateResponseError{Code: pb.RpcBlockDataviewObjectOrderUpdateResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockTableRowSetHeaderRequest)
+	in := new(pb.RpcBlockDataviewObjectOrderUpdateRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockTableRowSetHeaderResponse{Error: &pb.RpcBlockTableRowSetHeaderResponseError{Code: pb.RpcBlockTableRowSetHeaderResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockDataviewObjectOrderUpdateResponse{Error: &pb.RpcBlockDataviewObjectOrderUpdateResponseError{Code: pb.RpcBlockDataviewObjectOrderUpdateResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockTableRowSetHeader(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockDataviewObjectOrderUpdate(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockTableColumnCreate(b []byte) (resp []byte) {
+func BlockDataviewObjectOrderMove(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockTableColumnCreateResponse{Error: &pb.RpcBlockTableColumnCreateResponseError{Code: pb.RpcBlockTableColumnCreateResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockDataviewObjectOrderMoveResponse{Error: &pb.RpcBlockDataviewObjectOrderMoveResponseError{Code: pb.RpcBlockDataviewObjectOrderMoveResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockTableColumnCreateRequest)
+	in := new(pb.RpcBlockDataviewObjectOrderMoveRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockTableColumnCreateResponse{Error: &pb.RpcBlockTableColumnCreateResponseError{Code: pb.RpcBlockTableColumnCreateResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockDataviewObjectOrderMoveResponse{Error: &pb.RpcBlockDataviewObjectOrderMoveResponseError{Code: pb.RpcBlockDataviewObjectOrderMoveResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockTableColumnCreate(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockDataviewObjectOrderMove(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockTableColumnMove(b []byte) (resp []byte) {
+func BlockDataviewCreateFromExistingObject(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockTableColumnMoveResponse{Error: &pb.RpcBlockTableColumnMoveResponseError{Code: pb.RpcBlockTableColumnMoveResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockDataviewCreateFromExistingObjectResponse{Error: &pb.RpcBlockDataviewCreateFromExistingObjectResponseError{Code: pb.RpcBlockDataviewCreateFromExistingObjectResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockTableColumnMoveRequest)
+	in := new(pb.RpcBlockDataviewCreateFromExistingObjectRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockTableColumnMoveResponse{Error: &pb.RpcBlockTableColumnMoveResponseError{Code: pb.RpcBlockTableColumnMoveResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockDataviewCreateFromExistingObjectResponse{Error: &pb.RpcBlockDataviewCreateFromExistingObjectResponseError{Code: pb.RpcBlockDataviewCreateFromExistingObjectResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockTableColumnMove(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockDataviewCreateFromExistingObject(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockTableColumnDelete(b []byte) (resp []byte) {
+func BlockDataviewFilterAdd(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockTableColumnDeleteResponse{Error: &pb.RpcBlockTableColumnDeleteResponseError{Code: pb.RpcBlockTableColumnDeleteResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockDataviewFilterAddResponse{Error: &pb.RpcBlockDataviewFilterAddResponseError{Code: pb.RpcBlockDataviewFilterAddResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockTableColumnDeleteRequest)
+	in := new(pb.RpcBlockDataviewFilterAddRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockTableColumnDeleteResponse{Error: &pb.RpcBlockTableColumnDeleteResponseError{Code: pb.RpcBlockTableColumnDeleteResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockDataviewFilterAddResponse{Error: &pb.RpcBlockDataviewFilterAddResponseError{Code: pb.RpcBlockDataviewFilterAddResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockTableColumnDelete(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockDataviewFilterAdd(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockTableColumnDuplicate(b []byte) (resp []byte) {
+func BlockDataviewFilterRemove(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockTableColumnDuplicateResponse{Error: &pb.RpcBlockTableColumnDuplicateResponseError{Code: pb.RpcBlockTableColumnDuplicateResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockDataviewFilterRemoveResponse{Error: &pb.RpcBlockDataviewFilterRemoveResponseError{Code: pb.RpcBlockDataviewFilterRemoveResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockTableColumnDuplicateRequest)
+	in := new(pb.RpcBlockDataviewFilterRemoveRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockTableColumnDuplicateResponse{Error: &pb.RpcBlockTableColumnDuplicateResponseError{Code: pb.RpcBlockTableColumnDuplicateResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockDataviewFilterRemoveResponse{Error: &pb.RpcBlockDataviewFilterRemoveResponseError{Code: pb.RpcBlockDataviewFilterRemoveResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockTableColumnDuplicate(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockDataviewFilterRemove(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockTableRowListFill(b []byte) (resp []byte) {
+func BlockDataviewFilterReplace(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockTableRowListFillResponse{Error: &pb.RpcBlockTableRowListFillResponseError{Code: pb.RpcBlockTableRowListFillResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockDataviewFilterReplaceResponse{Error: &pb.RpcBlockDataviewFilterReplaceResponseError{Code: pb.RpcBlockDataviewFilterReplaceResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockTableRowListFillRequest)
+	in := new(pb.RpcBlockDataviewFilterReplaceRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockTableRowListFillResponse{Error: &pb.RpcBlockTableRowListFillResponseError{Code: pb.RpcBlockTableRowListFillResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockDataviewFilterReplaceResponse{Error: &pb.RpcBlockDataviewFilterReplaceResponseError{Code: pb.RpcBlockDataviewFilterReplaceResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockTableRowListFill(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockDataviewFilterReplace(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockTableRowListClean(b []byte) (resp []byte) {
+func BlockDataviewFilterSort(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockTableRowListCleanResponse{Error: &pb.RpcBlockTableRowListCleanResponseError{Code: pb.RpcBlockTableRowListCleanResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockDataviewFilterSortResponse{Error: &pb.RpcBlockDataviewFilterSortResponseError{Code: pb.RpcBlockDataviewFilterSortResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockTableRowListCleanRequest)
+	in := new(pb.RpcBlockDataviewFilterSortRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockTableRowListCleanResponse{Error: &pb.RpcBlockTableRowListCleanResponseError{Code: pb.RpcBlockTableRowListCleanResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockDataviewFilterSortResponse{Error: &pb.RpcBlockDataviewFilterSortResponseError{Code: pb.RpcBlockDataviewFilterSortResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockTableRowListClean(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockDataviewFilterSort(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockTableColumnListFill(b []byte) (resp []byte) {
+func BlockDataviewSortAdd(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockTableColumnListFillResponse{Error: &pb.RpcBlockTableColumnListFillResponseError{Code: pb.RpcBlockTableColumnListFillResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockDataviewSortAddResponse{Error: &pb.RpcBlockDataviewSortAddResponseError{Code: pb.RpcBlockDataviewSortAddResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockTableColumnListFillRequest)
+	in := new(pb.RpcBlockDataviewSortAddRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockTableColumnListFillResponse{Error: &pb.RpcBlockTableColumnListFillResponseError{Code: pb.RpcBlockTableColumnListFillResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockDataviewSortAddResponse{Error: &pb.RpcBlockDataviewSortAddResponseError{Code: pb.RpcBlockDataviewSortAddResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockTableColumnListFill(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockDataviewSortAdd(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockTableSort(b []byte) (resp []byte) {
+func BlockDataviewSortRemove(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockTableSortResponse{Error: &pb.RpcBlockTableSortResponseError{Code: pb.RpcBlockTableSortResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockDataviewSortRemoveResponse{Error: &pb.RpcBlockDataviewSortRemoveResponseError{Code: pb.RpcBlockDataviewSortRemoveResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockTableSortRequest)
+	in := new(pb.RpcBlockDataviewSortRemoveRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockTableSortResponse{Error: &pb.RpcBlockTableSortResponseError{Code: pb.RpcBlockTableSortResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockDataviewSortRemoveResponse{Error: &pb.RpcBlockDataviewSortRemoveResponseError{Code: pb.RpcBlockDataviewSortRemoveResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockTableSort(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockDataviewSortRemove(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockCreateWidget(b []byte) (resp []byte) {
+func BlockDataviewSortReplace(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockCreateWidgetResponse{Error: &pb.RpcBlockCreateWidgetResponseError{Code: pb.RpcBlockCreateWidgetResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockDataviewSortReplaceResponse{Error: &pb.RpcBlockDataviewSortReplaceResponseError{Code: pb.RpcBlockDataviewSortReplaceResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockCreateWidgetRequest)
+	in := new(pb.RpcBlockDataviewSortReplaceRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockCreateWidgetResponse{Error: &pb.RpcBlockCreateWidgetResponseError{Code: pb.RpcBlockCreateWidgetResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockDataviewSortReplaceResponse{Error: &pb.RpcBlockDataviewSortReplaceResponseError{Code: pb.RpcBlockDataviewSortReplaceResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockCreateWidget(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockDataviewSortReplace(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockWidgetSetTargetId(b []byte) (resp []byte) {
+func BlockDataviewSortSort(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockWidgetSetTargetIdResponse{Error: &pb.RpcBlockWidgetSetTargetIdResponseError{Code: pb.RpcBlockWidgetSetTargetIdResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockDataviewSortSSortResponse{Error: &pb.RpcBlockDataviewSortSSortResponseError{Code: pb.RpcBlockDataviewSortSSortResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockWidgetSetTargetIdRequest)
+	in := new(pb.RpcBlockDataviewSortSSortRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockWidgetSetTargetIdResponse{Error: &pb.RpcBlockWidgetSetTargetIdResponseError{Code: pb.RpcBlockWidgetSetTargetIdResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockDataviewSortSSortResponse{Error: &pb.RpcBlockDataviewSortSSortResponseError{Code: pb.RpcBlockDataviewSortSSortResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockWidgetSetTargetId(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockDataviewSortSort(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockWidgetSetLayout(b []byte) (resp []byte) {
+func BlockDataviewViewRelationAdd(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockWidgetSetLayoutResponse{Error: &pb.RpcBlockWidgetSetLayoutResponseError{Code: pb.RpcBlockWidgetSetLayoutResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockDataviewViewRelationAddResponse{Error: &pb.RpcBlockDataviewViewRelationAddResponseError{Code: pb.RpcBlockDataviewViewRelationAddResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockWidgetSetLayoutRequest)
+	in := new(pb.RpcBlockDataviewViewRelationAddRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockWidgetSetLayoutResponse{Error: &pb.RpcBlockWidgetSetLayoutResponseError{Code: pb.RpcBlockWidgetSetLayoutResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockDataviewViewRelationAddResponse{Error: &pb.RpcBlockDataviewViewRelationAddResponseError{Code: pb.RpcBlockDataviewViewRelationAddResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockWidgetSetLayout(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockDataviewViewRelationAdd(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockWidgetSetLimit(b []byte) (resp []byte) {
+func BlockDataviewViewRelationRemove(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockWidgetSetLimitResponse{Error: &pb.RpcBlockWidgetSetLimitResponseError{Code: pb.RpcBlockWidgetSetLimitResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockDataviewViewRelationRemoveResponse{Error: &pb.RpcBlockDataviewViewRelationRemoveResponseError{Code: pb.RpcBlockDataviewViewRelationRemoveResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockWidgetSetLimitRequest)
+	in := new(pb.RpcBlockDataviewViewRelationRemoveRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockWidgetSetLimitResponse{Error: &pb.RpcBlockWidgetSetLimitResponseError{Code: pb.RpcBlockWidgetSetLimitResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockDataviewViewRelationRemoveResponse{Error: &pb.RpcBlockDataviewViewRelationRemoveResponseError{Code: pb.RpcBlockDataviewViewRelationRemoveResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockWidgetSetLimit(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockDataviewViewRelationRemove(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockWidgetSetViewId(b []byte) (resp []byte) {
+func BlockDataviewViewRelationReplace(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockWidgetSetViewIdResponse{Error: &pb.RpcBlockWidgetSetViewIdResponseError{Code: pb.RpcBlockWidgetSetViewIdResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockDataviewViewRelationReplaceResponse{Error: &pb.RpcBlockDataviewViewRelationReplaceResponseError{Code: pb.RpcBlockDataviewViewRelationReplaceResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockWidgetSetViewIdRequest)
+	in := new(pb.RpcBlockDataviewViewRelationReplaceRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockWidgetSetViewIdResponse{Error: &pb.RpcBlockWidgetSetViewIdResponseError{Code: pb.RpcBlockWidgetSetViewIdResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockDataviewViewRelationReplaceResponse{Error: &pb.RpcBlockDataviewViewRelationReplaceResponseError{Code: pb.RpcBlockDataviewViewRelationReplaceResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockWidgetSetViewId(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockDataviewViewRelationReplace(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockLinkCreateWithObject(b []byte) (resp []byte) {
+func BlockDataviewViewRelationSort(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockLinkCreateWithObjectResponse{Error: &pb.RpcBlockLinkCreateWithObjectResponseError{Code: pb.RpcBlockLinkCreateWithObjectResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockDataviewViewRelationSortResponse{Error: &pb.RpcBlockDataviewViewRelationSortResponseError{Code: pb.RpcBlockDataviewViewRelationSortResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockLinkCreateWithObjectRequest)
+	in := new(pb.RpcBlockDataviewViewRelationSortRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockLinkCreateWithObjectResponse{Error: &pb.RpcBlockLinkCreateWithObjectResponseError{Code: pb.RpcBlockLinkCreateWithObjectResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockDataviewViewRelationSortResponse{Error: &pb.RpcBlockDataviewViewRelationSortResponseError{Code: pb.RpcBlockDataviewViewRelationSortResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockLinkCreateWithObject(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockDataviewViewRelationSort(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockLinkListSetAppearance(b []byte) (resp []byte) {
+func BlockTableCreate(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockLinkListSetAppearanceResponse{Error: &pb.RpcBlockLinkListSetAppearanceResponseError{Code: pb.RpcBlockLinkListSetAppearanceResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockTableCreateResponse{Error: &pb.RpcBlockTableCreateResponseError{Code: pb.RpcBlockTableCreateResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockLinkListSetAppearanceRequest)
+	in := new(pb.RpcBlockTableCreateRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockLinkListSetAppearanceResponse{Error: &pb.RpcBlockLinkListSetAppearanceResponseError{Code: pb.RpcBlockLinkListSetAppearanceResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockTableCreateResponse{Error: &pb.RpcBlockTableCreateResponseError{Code: pb.RpcBlockTableCreateResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockLinkListSetAppearance(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockTableCreate(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockBookmarkFetch(b []byte) (resp []byte) {
+func BlockTableExpand(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockBookmarkFetchResponse{Error: &pb.RpcBlockBookmarkFetchResponseError{Code: pb.RpcBlockBookmarkFetchResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockTableExpandResponse{Error: &pb.RpcBlockTableExpandResponseError{Code: pb.RpcBlockTableExpandResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockBookmarkFetchRequest)
+	in := new(pb.RpcBlockTableExpandRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockBookmarkFetchResponse{Error: &pb.RpcBlockBookmarkFetchResponseError{Code: pb.RpcBlockBookmarkFetchResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockTableExpandResponse{Error: &pb.RpcBlockTableExpandResponseError{Code: pb.RpcBlockTableExpandResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockBookmarkFetch(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockTableExpand(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockBookmarkCreateAndFetch(b []byte) (resp []byte) {
+func BlockTableRowCreate(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockBookmarkCreateAndFetchResponse{Error: &pb.RpcBlockBookmarkCreateAndFetchResponseError{Code: pb.RpcBlockBookmarkCreateAndFetchResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockTableRowCreateResponse{Error: &pb.RpcBlockTableRowCreateResponseError{Code: pb.RpcBlockTableRowCreateResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockBookmarkCreateAndFetchRequest)
+	in := new(pb.RpcBlockTableRowCreateRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockBookmarkCreateAndFetchResponse{Error: &pb.RpcBlockBookmarkCreateAndFetchResponseError{Code: pb.RpcBlockBookmarkCreateAndFetchResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockTableRowCreateResponse{Error: &pb.RpcBlockTableRowCreateResponseError{Code: pb.RpcBlockTableRowCreateResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockBookmarkCreateAndFetch(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockTableRowCreate(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockRelationSetKey(b []byte) (resp []byte) {
+func BlockTableRowDelete(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockRelationSetKeyResponse{Error: &pb.RpcBlockRelationSetKeyResponseError{Code: pb.RpcBlockRelationSetKeyResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockTableRowDeleteResponse{Error: &pb.RpcBlockTableRowDeleteResponseError{Code: pb.RpcBlockTableRowDeleteResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockRelationSetKeyRequest)
+	in := new(pb.RpcBlockTableRowDeleteRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockRelationSetKeyResponse{Error: &pb.RpcBlockRelationSetKeyResponseError{Code: pb.RpcBlockRelationSetKeyResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockTableRowDeleteResponse{Error: &pb.RpcBlockTableRowDeleteResponseError{Code: pb.RpcBlockTableRowDeleteResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockRelationSetKey(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockTableRowDelete(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockRelationAdd(b []byte) (resp []byte) {
+func BlockTableRowDuplicate(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockRelationAddResponse{Error: &pb.RpcBlockRelationAddResponseError{Code: pb.RpcBlockRelationAddResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockTableRowDuplicateResponse{Error: &pb.RpcBlockTableRowDuplicateResponseError{Code: pb.RpcBlockTableRowDuplicateResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockRelationAddRequest)
+	in := new(pb.RpcBlockTableRowDuplicateRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockRelationAddResponse{Error: &pb.RpcBlockRelationAddResponseError{Code: pb.RpcBlockRelationAddResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockTableRowDuplicateResponse{Error: &pb.RpcBlockTableRowDuplicateResponseError{Code: pb.RpcBlockTableRowDuplicateResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockRelationAdd(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockTableRowDuplicate(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockDivListSetStyle(b []byte) (resp []byte) {
+func BlockTableRowSetHeader(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockDivListSetStyleResponse{Error: &pb.RpcBlockDivListSetStyleResponseError{Code: pb.RpcBlockDivListSetStyleResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockTableRowSetHeaderResponse{Error: &pb.RpcBlockTableRowSetHeaderResponseError{Code: pb.RpcBlockTableRowSetHeaderResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockDivListSetStyleRequest)
+	in := new(pb.RpcBlockTableRowSetHeaderRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockDivListSetStyleResponse{Error: &pb.RpcBlockDivListSetStyleResponseError{Code: pb.RpcBlockDivListSetStyleResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockTableRowSetHeaderResponse{Error: &pb.RpcBlockTableRowSetHeaderResponseError{Code: pb.RpcBlockTableRowSetHeaderResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockDivListSetStyle(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockTableRowSetHeader(context.Background(), in).Marshal()
 	return resp
 }
 
-func BlockLatexSetText(b []byte) (resp []byte) {
+func BlockTableColumnCreate(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBlockLatexSetTextResponse{Error: &pb.RpcBlockLatexSetTextResponseError{Code: pb.RpcBlockLatexSetTextResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockTableColumnCreateResponse{Error: &pb.RpcBlockTableColumnCreateResponseError{Code: pb.RpcBlockTableColumnCreateResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBlockLatexSetTextRequest)
+	in := new(pb.RpcBlockTableColumnCreateRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBlockLatexSetTextResponse{Error: &pb.RpcBlockLatexSetTextResponseError{Code: pb.RpcBlockLatexSetTextResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockTableColumnCreateResponse{Error: &pb.RpcBlockTableColumnCreateResponseError{Code: pb.RpcBlockTableColumnCreateResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BlockLatexSetText(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockTableColumnCreate(context.Background(), in).Marshal()
 	return resp
 }
 
-func ProcessCancel(b []byte) (resp []byte) {
+func BlockTableColumnMove(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcProcessCancelResponse{Error: &pb.RpcProcessCancelResponseError{Code: pb.RpcProcessCancelResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockTableColumnMoveResponse{Error: &pb.RpcBlockTableColumnMoveResponseError{Code: pb.RpcBlockTableColumnMoveResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcProcessCancelRequest)
+	in := new(pb.RpcBlockTableColumnMoveRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcProcessCancelResponse{Error: &pb.RpcProcessCancelResponseError{Code: pb.RpcProcessCancelResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockTableColumnMoveResponse{Error: &pb.RpcBlockTableColumnMoveResponseError{Code: pb.RpcBlockTableColumnMoveResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.ProcessCancel(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockTableColumnMove(context.Background(), in).Marshal()
 	return resp
 }
 
-func ProcessSubscribe(b []byte) (resp []byte) {
+func BlockTableColumnDelete(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcProcessSubscribeResponse{Error: &pb.RpcProcessSubscribeResponseError{Code: pb.RpcProcessSubscribeResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockTableColumnDeleteResponse{Error: &pb.RpcBlockTableColumnDeleteResponseError{Code: pb.RpcBlockTableColumnDeleteResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcProcessSubscribeRequest)
+	in := new(pb.RpcBlockTableColumnDeleteRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcProcessSubscribeResponse{Error: &pb.RpcProcessSubscribeResponseError{Code: pb.RpcProcessSubscribeResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockTableColumnDeleteResponse{Error: &pb.RpcBlockTableColumnDeleteResponseError{Code: pb.RpcBlockTableColumnDeleteResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.ProcessSubscribe(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockTableColumnDelete(context.Background(), in).Marshal()
 	return resp
 }
 
-func ProcessUnsubscribe(b []byte) (resp []byte) {
+func BlockTableColumnDuplicate(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcProcessUnsubscribeResponse{Error: &pb.RpcProcessUnsubscribeResponseError{Code: pb.RpcProcessUnsubscribeResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockTableColumnDuplicateResponse{Error: &pb.RpcBlockTableColumnDuplicateResponseError{Code: pb.RpcBlockTableColumnDuplicateResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcProcessUnsubscribeRequest)
+	in := new(pb.RpcBlockTableColumnDuplicateRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcProcessUnsubscribeResponse{Error: &pb.RpcProcessUnsubscribeResponseError{Code: pb.RpcProcessUnsubscribeResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockTableColumnDuplicateResponse{Error: &pb.RpcBlockTableColumnDuplicateResponseError{Code: pb.RpcBlockTableColumnDuplicateResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.ProcessUnsubscribe(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockTableColumnDuplicate(context.Background(), in).Marshal()
 	return resp
 }
 
-func LogSend(b []byte) (resp []byte) {
+func BlockTableRowListFill(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcLogSendResponse{Error: &pb.RpcLogSendResponseError{Code: pb.RpcLogSendResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockTableRowListFillResponse{Error: &pb.RpcBlockTableRowListFillResponseError{Code: pb.RpcBlockTableRowListFillResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcLogSendRequest)
+	in := new(pb.RpcBlockTableRowListFillRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcLogSendResponse{Error: &pb.RpcLogSendResponseError{Code: pb.RpcLogSendResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockTableRowListFillResponse{Error: &pb.RpcBlockTableRowListFillResponseError{Code: pb.RpcBlockTableRowListFillResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.LogSend(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockTableRowListFill(context.Background(), in).Marshal()
 	return resp
 }
 
-func DebugStat(b []byte) (resp []byte) {
+func BlockTableRowListClean(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcDebugStatResponse{Error: &pb.RpcDebugStatResponseError{Code: pb.RpcDebugStatResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockTableRowListCleanResponse{Error: &pb.RpcBlockTableRowListCleanResponseError{Code: pb.RpcBlockTableRowListCleanResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcDebugStatRequest)
+	in := new(pb.RpcBlockTableRowListCleanRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcDebugStatResponse{Error: &pb.RpcDebugStatResponseError{Code: pb.RpcDebugStatResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockTableRowListCleanResponse{Error: &pb.RpcBlockTableRowListCleanResponseError{Code: pb.RpcBlockTableRowListCleanResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.DebugStat(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockTableRowListClean(context.Background(), in).Marshal()
 	return resp
 }
 
-func DebugTree(b []byte) (resp []byte) {
+func BlockTableColumnListFill(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcDebugTreeResponse{Error: &pb.RpcDebugTreeResponseError{Code: pb.RpcDebugTreeResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockTableColumnListFillResponse{Error: &pb.RpcBlockTableColumnListFillResponseError{Code: pb.RpcBlockTableColumnListFillResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcDebugTreeRequest)
+	in := new(pb.RpcBlockTableColumnListFillRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcDebugTreeResponse{Error: &pb.RpcDebugTreeResponseError{Code: pb.RpcDebugTreeResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockTableColumnListFillResponse{Error: &pb.RpcBlockTableColumnListFillResponseError{Code: pb.RpcBlockTableColumnListFillResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.DebugTree(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockTableColumnListFill(context.Background(), in).Marshal()
 	return resp
 }
 
-func DebugTreeHeads(b []byte) (resp []byte) {
+func BlockTableSort(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcDebugTreeHeadsResponse{Error: &pb.RpcDebugTreeHeadsResponseError{Code: pb.RpcDebugTreeHeadsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockTableSortResponse{Error: &pb.RpcBlockTableSortResponseError{Code: pb.RpcBlockTableSortResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcDebugTreeHeadsRequest)
+	in := new(pb.RpcBlockTableSortRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcDebugTreeHeadsResponse{Error: &pb.RpcDebugTreeHeadsResponseError{Code: pb.RpcDebugTreeHeadsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockTableSortResponse{Error: &pb.RpcBlockTableSortResponseError{Code: pb.RpcBlockTableSortResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.DebugTreeHeads(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockTableSort(context.Background(), in).Marshal()
 	return resp
 }
 
-func DebugSpaceSummary(b []byte) (resp []byte) {
+func BlockCreateWidget(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcDebugSpaceSummaryResponse{Error: &pb.RpcDebugSpaceSummaryResponseError{Code: pb.RpcDebugSpaceSummaryResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockCreateWidgetResponse{Error: &pb.RpcBlockCreateWidgetResponseError{Code: pb.RpcBlockCreateWidgetResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcDebugSpaceSummaryRequest)
+	in := new(pb.RpcBlockCreateWidgetRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcDebugSpaceSummaryResponse{Error: &pb.RpcDebugSpaceSummaryResponseError{Code: pb.RpcDebugSpaceSummaryResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockCreateWidgetResponse{Error: &pb.RpcBlockCreateWidgetResponseError{Code: pb.RpcBlockCreateWidgetResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.DebugSpaceSummary(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockCreateWidget(context.Background(), in).Marshal()
 	return resp
 }
 
-func DebugStackGoroutines(b []byte) (resp []byte) {
+func BlockWidgetSetTargetId(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcDebugStackGoroutinesResponse{Error: &pb.RpcDebugStackGoroutinesResponseError{Code: pb.RpcDebugStackGoroutinesResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockWidgetSetTargetIdResponse{Error: &pb.RpcBlockWidgetSetTargetIdResponseError{Code: pb.RpcBlockWidgetSetTargetIdResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcDebugStackGoroutinesRequest)
+	in := new(pb.RpcBlockWidgetSetTargetIdRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcDebugStackGoroutinesResponse{Error: &pb.RpcDebugStackGoroutinesResponseError{Code: pb.RpcDebugStackGoroutinesResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockWidgetSetTargetIdResponse{Error: &pb.RpcBlockWidgetSetTargetIdResponseError{Code: pb.RpcBlockWidgetSetTargetIdResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.DebugStackGoroutines(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockWidgetSetTargetId(context.Background(), in).Marshal()
 	return resp
 }
 
-func DebugExportLocalstore(b []byte) (resp []byte) {
+func BlockWidgetSetLayout(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcDebugExportLocalstoreResponse{Error: &pb.RpcDebugExportLocalstoreResponseError{Code: pb.RpcDebugExportLocalstoreResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockWidgetSetLayoutResponse{Error: &pb.RpcBlockWidgetSetLayoutResponseError{Code: pb.RpcBlockWidgetSetLayoutResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcDebugExportLocalstoreRequest)
+	in := new(pb.RpcBlockWidgetSetLayoutRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcDebugExportLocalstoreResponse{Error: &pb.RpcDebugExportLocalstoreResponseError{Code: pb.RpcDebugExportLocalstoreResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockWidgetSetLayoutResponse{Error: &pb.RpcBlockWidgetSetLayoutResponseError{Code: pb.RpcBlockWidgetSetLayoutResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.DebugExportLocalstore(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockWidgetSetLayout(context.Background(), in).Marshal()
 	return resp
 }
 
-func DebugPing(b []byte) (resp []byte) {
+func BlockWidgetSetLimit(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcDebugPingResponse{Error: &pb.RpcDebugPingResponseError{Code: pb.RpcDebugPingResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockWidgetSetLimitResponse{Error: &pb.RpcBlockWidgetSetLimitResponseError{Code: pb.RpcBlockWidgetSetLimitResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcDebugPingRequest)
+	in := new(pb.RpcBlockWidgetSetLimitRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcDebugPingResponse{Error: &pb.RpcDebugPingResponseError{Code: pb.RpcDebugPingResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockWidgetSetLimitResponse{Error: &pb.RpcBlockWidgetSetLimitResponseError{Code: pb.RpcBlockWidgetSetLimitResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.DebugPing(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockWidgetSetLimit(context.Background(), in).Marshal()
 	return resp
 }
 
-func DebugSubscriptions(b []byte) (resp []byte) {
+func BlockWidgetSetViewId(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcDebugSubscriptionsResponse{Error: &pb.RpcDebugSubscriptionsResponseError{Code: pb.RpcDebugSubscriptionsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockWidgetSetViewIdResponse{Error: &pb.RpcBlockWidgetSetViewIdResponseError{Code: pb.RpcBlockWidgetSetViewIdResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcDebugSubscriptionsRequest)
+	in := new(pb.RpcBlockWidgetSetViewIdRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcDebugSubscriptionsResponse{Error: &pb.RpcDebugSubscriptionsResponseError{Code: pb.RpcDebugSubscriptionsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockWidgetSetViewIdResponse{Error: &pb.RpcBlockWidgetSetViewIdResponseError{Code: pb.RpcBlockWidgetSetViewIdResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.DebugSubscriptions(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockWidgetSetViewId(context.Background(), in).Marshal()
 	return resp
 }
 
-func DebugOpenedObjects(b []byte) (resp []byte) {
+func BlockLinkCreateWithObject(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcDebugOpenedObjectsResponse{Error: &pb.RpcDebugOpenedObjectsResponseError{Code: pb.RpcDebugOpenedObjectsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockLinkCreateWithObjectResponse{Error: &pb.RpcBlockLinkCreateWithObjectResponseError{Code: pb.RpcBlockLinkCreateWithObjectResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcDebugOpenedObjectsRequest)
+	in := new(pb.RpcBlockLinkCreateWithObjectRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcDebugOpenedObjectsResponse{Error: &pb.RpcDebugOpenedObjectsResponseError{Code: pb.RpcDebugOpenedObjectsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockLinkCreateWithObjectResponse{Error: &pb.RpcBlockLinkCreateWithObjectResponseError{Code: pb.RpcBlockLinkCreateWithObjectResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.DebugOpenedObjects(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockLinkCreateWithObject(context.Background(), in).Marshal()
 	return resp
 }
 
-func DebugRunProfiler(b []byte) (resp []byte) {
+func BlockLinkListSetAppearance(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcDebugRunProfilerResponse{Error: &pb.RpcDebugRunProfilerResponseError{Code: pb.RpcDebugRunProfilerResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockLinkListSetAppearanceResponse{Error: &pb.RpcBlockLinkListSetAppearanceResponseError{Code: pb.RpcBlockLinkListSetAppearanceResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcDebugRunProfilerRequest)
+	in := new(pb.RpcBlockLinkListSetAppearanceRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcDebugRunProfilerResponse{Error: &pb.RpcDebugRunProfilerResponseError{Code: pb.RpcDebugRunProfilerResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockLinkListSetAppearanceResponse{Error: &pb.RpcBlockLinkListSetAppearanceResponseError{Code: pb.RpcBlockLinkListSetAppearanceResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.DebugRunProfiler(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockLinkListSetAppearance(context.Background(), in).Marshal()
 	return resp
 }
 
-func DebugAccountSelectTrace(b []byte) (resp []byte) {
+func BlockBookmarkFetch(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcDebugAccountSelectTraceResponse{Error: &pb.RpcDebugAccountSelectTraceResponseError{Code: pb.RpcDebugAccountSelectTraceResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockBookmarkFetchResponse{Error: &pb.RpcBlockBookmarkFetchResponseError{Code: pb.RpcBlockBookmarkFetchResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcDebugAccountSelectTraceRequest)
+	in := new(pb.RpcBlockBookmarkFetchRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcDebugAccountSelectTraceResponse{Error: &pb.RpcDebugAccountSelectTraceResponseError{Code: pb.RpcDebugAccountSelectTraceResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockBookmarkFetchResponse{Error: &pb.RpcBlockBookmarkFetchResponseError{Code: pb.RpcBlockBookmarkFetchResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.DebugAccountSelectTrace(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockBookmarkFetch(context.Background(), in).Marshal()
 	return resp
 }
 
-func DebugAnystoreObjectChanges(b []byte) (resp []byte) {
+func BlockBookmarkCreateAndFetch(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcDebugAnystoreObjectChangesResponse{Error: &pb.RpcDebugAnystoreObjectChangesResponseError{Code: pb.RpcDebugAnystoreObjectChangesResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockBookmarkCreateAndFetchResponse{Error: &pb.RpcBlockBookmarkCreateAndFetchResponseError{Code: pb.RpcBlockBookmarkCreateAndFetchResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcDebugAnystoreObjectChangesRequest)
+	in := new(pb.RpcBlockBookmarkCreateAndFetchRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcDebugAnystoreObjectChangesResponse{Error: &pb.RpcDebugAnystoreObjectChangesResponseError{Code: pb.RpcDebugAnystoreObjectChangesResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockBookmarkCreateAndFetchResponse{Error: &pb.RpcBlockBookmarkCreateAndFetchResponseError{Code: pb.RpcBlockBookmarkCreateAndFetchResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.DebugAnystoreObjectChanges(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockBookmarkCreateAndFetch(context.Background(), in).Marshal()
 	return resp
 }
 
-func DebugNetCheck(b []byte) (resp []byte) {
+func BlockRelationSetKey(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcDebugNetCheckResponse{Error: &pb.RpcDebugNetCheckResponseError{Code: pb.RpcDebugNetCheckResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockRelationSetKeyResponse{Error: &pb.RpcBlockRelationSetKeyResponseError{Code: pb.RpcBlockRelationSetKeyResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcDebugNetCheckRequest)
+	in := new(pb.RpcBlockRelationSetKeyRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcDebugNetCheckResponse{Error: &pb.RpcDebugNetCheckResponseError{Code: pb.RpcDebugNetCheckResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockRelationSetKeyResponse{Error: &pb.RpcBlockRelationSetKeyResponseError{Code: pb.RpcBlockRelationSetKeyResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.DebugNetCheck(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockRelationSetKey(context.Background(), in).Marshal()
 	return resp
 }
 
-func DebugExportLog(b []byte) (resp []byte) {
+func BlockRelationAdd(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcDebugExportLogResponse{Error: &pb.RpcDebugExportLogResponseError{Code: pb.RpcDebugExportLogResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockRelationAddResponse{Error: &pb.RpcBlockRelationAddResponseError{Code: pb.RpcBlockRelationAddResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcDebugExportLogRequest)
+	in := new(pb.RpcBlockRelationAddRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcDebugExportLogResponse{Error: &pb.RpcDebugExportLogResponseError{Code: pb.RpcDebugExportLogResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockRelationAddResponse{Error: &pb.RpcBlockRelationAddResponseError{Code: pb.RpcBlockRelationAddResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.DebugExportLog(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockRelationAdd(context.Background(), in).Marshal()
 	return resp
 }
 
-func InitialSetParameters(b []byte) (resp []byte) {
+func BlockDivListSetStyle(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcInitialSetParametersResponse{Error: &pb.RpcInitialSetParametersResponseError{Code: pb.RpcInitialSetParametersResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockDivListSetStyleResponse{Error: &pb.RpcBlockDivListSetStyleResponseError{Code: pb.RpcBlockDivListSetStyleResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcInitialSetParametersRequest)
+	in := new(pb.RpcBlockDivListSetStyleRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcInitialSetParametersResponse{Error: &pb.RpcInitialSetParametersResponseError{Code: pb.RpcInitialSetParametersResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockDivListSetStyleResponse{Error: &pb.RpcBlockDivListSetStyleResponseError{Code: pb.RpcBlockDivListSetStyleResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.InitialSetParameters(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockDivListSetStyle(context.Background(), in).Marshal()
 	return resp
 }
 
-func NotificationList(b []byte) (resp []byte) {
+func BlockLatexSetText(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcNotificationListResponse{Error: &pb.RpcNotificationListResponseError{Code: pb.RpcNotificationListResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBlockLatexSetTextResponse{Error: &pb.RpcBlockLatexSetTextResponseError{Code: pb.RpcBlockLatexSetTextResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcNotificationListRequest)
+	in := new(pb.RpcBlockLatexSetTextRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcNotificationListResponse{Error: &pb.RpcNotificationListResponseError{Code: pb.RpcNotificationListResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBlockLatexSetTextResponse{Error: &pb.RpcBlockLatexSetTextResponseError{Code: pb.RpcBlockLatexSetTextResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.NotificationList(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BlockLatexSetText(context.Background(), in).Marshal()
 	return resp
 }
 
-func NotificationReply(b []byte) (resp []byte) {
+func ProcessCancel(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcNotificationReplyResponse{Error: &pb.RpcNotificationReplyResponseError{Code: pb.RpcNotificationReplyResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcProcessCancelResponse{Error: &pb.RpcProcessCancelResponseError{Code: pb.RpcProcessCancelResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcNotificationReplyRequest)
+	in := new(pb.RpcProcessCancelRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcNotificationReplyResponse{Error: &pb.RpcNotificationReplyResponseError{Code: pb.RpcNotificationReplyResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcProcessCancelResponse{Error: &pb.RpcProcessCancelResponseError{Code: pb.RpcProcessCancelResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.NotificationReply(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.ProcessCancel(context.Background(), in).Marshal()
 	return resp
 }
 
-func NotificationTest(b []byte) (resp []byte) {
+func ProcessSubscribe(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcNotificationTestResponse{Error: &pb.RpcNotificationTestResponseError{Code: pb.RpcNotificationTestResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcProcessSubscribeResponse{Error: &pb.RpcProcessSubscribeResponseError{Code: pb.RpcProcessSubscribeResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcNotificationTestRequest)
+	in := new(pb.RpcProcessSubscribeRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcNotificationTestResponse{Error: &pb.RpcNotificationTestResponseError{Code: pb.RpcNotificationTestResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcProcessSubscribeResponse{Error: &pb.RpcProcessSubscribeResponseError{Code: pb.RpcProcessSubscribeResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.NotificationTest(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.ProcessSubscribe(context.Background(), in).Marshal()
 	return resp
 }
 
-func MembershipGetStatus(b []byte) (resp []byte) {
+func ProcessUnsubscribe(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcMembershipGetStatusResponse{Error: &pb.RpcMembershipGetStatusResponseError{Code: pb.RpcMembershipGetStatusResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcProcessUnsubscribeResponse{Error: &pb.RpcProcessUnsubscribeResponseError{Code: pb.RpcProcessUnsubscribeResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcMembershipGetStatusRequest)
+	in := new(pb.RpcProcessUnsubscribeRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcMembershipGetStatusResponse{Error: &pb.RpcMembershipGetStatusResponseError{Code: pb.RpcMembershipGetStatusResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcProcessUnsubscribeResponse{Error: &pb.RpcProcessUnsubscribeResponseError{Code: pb.RpcProcessUnsubscribeResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.MembershipGetStatus(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.ProcessUnsubscribe(context.Background(), in).Marshal()
 	return resp
 }
 
-func MembershipIsNameValid(b []byte) (resp []byte) {
+func LogSend(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcMembershipIsNameValidResponse{Error: &pb.RpcMembershipIsNameValidResponseError{Code: pb.RpcMembershipIsNameValidResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcLogSendResponse{Error: &pb.RpcLogSendResponseError{Code: pb.RpcLogSendResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcMembershipIsNameValidRequest)
+	in := new(pb.RpcLogSendRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcMembershipIsNameValidResponse{Error: &pb.RpcMembershipIsNameValidResponseError{Code: pb.RpcMembershipIsNameValidResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcLogSendResponse{Error: &pb.RpcLogSendResponseError{Code: pb.RpcLogSendResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.MembershipIsNameValid(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.LogSend(context.Background(), in).Marshal()
 	return resp
 }
 
-func MembershipRegisterPaymentRequest(b []byte) (resp []byte) {
+func DebugStat(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcMembershipRegisterPaymentRequestResponse{Error: &pb.RpcMembershipRegisterPaymentRequestResponseError{Code: pb.RpcMembershipRegisterPaymentRequestResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcDebugStatResponse{Error: &pb.RpcDebugStatResponseError{Code: pb.RpcDebugStatResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcMembershipRegisterPaymentRequestRequest)
+	in := new(pb.RpcDebugStatRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcMembershipRegisterPaymentRequestResponse{Error: &pb.RpcMembershipRegisterPaymentRequestResponseError{Code: pb.RpcMembershipRegisterPaymentRequestResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcDebugStatResponse{Error: &pb.RpcDebugStatResponseError{Code: pb.RpcDebugStatResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.MembershipRegisterPaymentRequest(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.DebugStat(context.Background(), in).Marshal()
 	return resp
 }
 
-func MembershipGetPortalLinkUrl(b []byte) (resp []byte) {
+func DebugTree(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcMembershipGetPortalLinkUrlResponse{Error: &pb.RpcMembershipGetPortalLinkUrlResponseError{Code: pb.RpcMembershipGetPortalLinkUrlResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcDebugTreeResponse{Error: &pb.RpcDebugTreeResponseError{Code: pb.RpcDebugTreeResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcMembershipGetPortalLinkUrlRequest)
+	in := new(pb.RpcDebugTreeRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcMembershipGetPortalLinkUrlResponse{Error: &pb.RpcMembershipGetPortalLinkUrlResponseError{Code: pb.RpcMembershipGetPortalLinkUrlResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcDebugTreeResponse{Error: &pb.RpcDebugTreeResponseError{Code: pb.RpcDebugTreeResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.MembershipGetPortalLinkUrl(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.DebugTree(context.Background(), in).Marshal()
 	return resp
 }
 
-func MembershipGetVerificationEmailStatus(b []byte) (resp []byte) {
+func DebugTreeHeads(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcMembershipGetVerificationEmailStatusResponse{Error: &pb.RpcMembershipGetVerificationEmailStatusResponseError{Code: pb.RpcMembershipGetVerificationEmailStatusResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcDebugTreeHeadsResponse{Error: &pb.RpcDebugTreeHeadsResponseError{Code: pb.RpcDebugTreeHeadsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcMembershipGetVerificationEmailStatusRequest)
+	in := new(pb.RpcDebugTreeHeadsRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcMembershipGetVerificationEmailStatusResponse{Error: &pb.RpcMembershipGetVerificationEmailStatusResponseError{Code: pb.RpcMembershipGetVerificationEmailStatusResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcDebugTreeHeadsResponse{Error: &pb.RpcDebugTreeHeadsResponseError{Code: pb.RpcDebugTreeHeadsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.MembershipGetVerificationEmailStatus(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.DebugTreeHeads(context.Background(), in).Marshal()
 	return resp
 }
 
-func MembershipGetVerificationEmail(b []byte) (resp []byte) {
+func DebugSpaceSummary(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcMembershipGetVerificationEmailResponse{Error: &pb.RpcMembershipGetVerificationEmailResponseError{Code: pb.RpcMembershipGetVerificationEmailResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcDebugSpaceSummaryResponse{Error: &pb.RpcDebugSpaceSummaryResponseError{Code: pb.RpcDebugSpaceSummaryResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcMembershipGetVerificationEmailRequest)
+	in := new(pb.RpcDebugSpaceSummaryRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcMembershipGetVerificationEmailResponse{Error: &pb.RpcMembershipGetVerificationEmailResponseError{Code: pb.RpcMembershipGetVerificationEmailResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcDebugSpaceSummaryResponse{Error: &pb.RpcDebugSpaceSummaryResponseError{Code: pb.RpcDebugSpaceSummaryResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.MembershipGetVerificationEmail(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.DebugSpaceSummary(context.Background(), in).Marshal()
 	return resp
 }
 
-func MembershipVerifyEmailCode(b []byte) (resp []byte) {
+func DebugSpaceVerify(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcMembershipVerifyEmailCodeResponse{Error: &pb.RpcMembershipVerifyEmailCodeResponseError{Code: pb.RpcMembershipVerifyEmailCodeResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcDebugSpaceVerifyResponse{Error: &pb.RpcDebugSpaceVerifyResponseError{Code: pb.RpcDebugSpaceVerifyResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcMembershipVerifyEmailCodeRequest)
+	in := new(pb.RpcDebugSpaceVerifyRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcMembershipVerifyEmailCodeResponse{Error: &pb.RpcMembershipVerifyEmailCodeResponseError{Code: pb.RpcMembershipVerifyEmailCodeResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcDebugSpaceVerifyResponse{Error: &pb.RpcDebugSpaceVerifyResponseError{Code: pb.RpcDebugSpaceVerifyResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.MembershipVerifyEmailCode(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.DebugSpaceVerify(context.Background(), in).Marshal()
 	return resp
 }
 
-func MembershipFinalize(b []byte) (resp []byte) {
+func DebugSpaceRepair(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcMembershipFinalizeResponse{Error: &pb.RpcMembershipFinalizeResponseError{Code: pb.RpcMembershipFinalizeResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcDebugSpaceRepairResponse{Error: &pb.RpcDebugSpaceRepairResponseError{Code: pb.RpcDebugSpaceRepairResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcMembershipFinalizeRequest)
+	in := new(pb.RpcDebugSpaceRepairRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcMembershipFinalizeResponse{Error: &pb.RpcMembershipFinalizeResponseError{Code: pb.RpcMembershipFinalizeResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcDebugSpaceRepairResponse{Error: &pb.RpcDebugSpaceRepairResponseError{Code: pb.RpcDebugSpaceRepairResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.MembershipFinalize(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.DebugSpaceRepair(context.Background(), in).Marshal()
 	return resp
 }
 
-func MembershipGetTiers(b []byte) (resp []byte) {
+func DebugStackGoroutines(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcMembershipGetTiersResponse{Error: &pb.RpcMembershipGetTiersResponseError{Code: pb.RpcMembershipGetTiersResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcDebugStackGoroutinesResponse{Error: &pb.RpcDebugStackGoroutinesResponseError{Code: pb.RpcDebugStackGoroutinesResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcMembershipGetTiersRequest)
+	in := new(pb.RpcDebugStackGoroutinesRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcMembershipGetTiersResponse{Error: &pb.RpcMembershipGetTiersResponseError{Code: pb.RpcMembershipGetTiersResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcDebugStackGoroutinesResponse{Error: &pb.RpcDebugStackGoroutinesResponseError{Code: pb.RpcDebugStackGoroutinesResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.MembershipGetTiers(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.DebugStackGoroutines(context.Background(), in).Marshal()
 	return resp
 }
 
-func MembershipVerifyAppStoreReceipt(b []byte) (resp []byte) {
+func DebugExportLocalstore(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcMembershipVerifyAppStoreReceiptResponse{Error: &pb.RpcMembershipVerifyAppStoreReceiptResponseError{Code: pb.RpcMembershipVerifyAppStoreReceiptResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcDebugExportLocalstoreResponse{Error: &pb.RpcDebugExportLocalstoreResponseError{Code: pb.RpcDebugExportLocalstoreResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcMembershipVerifyAppStoreReceiptRequest)
+	in := new(pb.RpcDebugExportLocalstoreRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcMembershipVerifyAppStoreReceiptResponse{Error: &pb.RpcMembershipVerifyAppStoreReceiptResponseError{Code: pb.RpcMembershipVerifyAppStoreReceiptResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcDebugExportLocalstoreResponse{Error: &pb.RpcDebugExportLocalstoreResponseError{Code: pb.RpcDebugExportLocalstoreResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.MembershipVerifyAppStoreReceipt(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.DebugExportLocalstore(context.Background(), in).Marshal()
 	return resp
 }
 
-func MembershipCodeGetInfo(b []byte) (resp []byte) {
+func DebugPing(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcMembershipCodeGetInfoResponse{Error: &pb.RpcMembershipCodeGetInfoResponseError{Code: pb.RpcMembershipCodeGetInfoResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcDebugPingResponse{Error: &pb.RpcDebugPingResponseError{Code: pb.RpcDebugPingResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcMembershipCodeGetInfoRequest)
+	in := new(pb.RpcDebugPingRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcMembershipCodeGetInfoResponse{Error: &pb.RpcMembershipCodeGetInfoResponseError{Code: pb.RpcMembershipCodeGetInfoResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcDebugPingResponse{Error: &pb.RpcDebugPingResponseError{Code: pb.RpcDebugPingResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.MembershipCodeGetInfo(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.DebugPing(context.Background(), in).Marshal()
 	return resp
 }
 
-func MembershipCodeRedeem(b []byte) (resp []byte) {
+func DebugSubscriptions(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcMembershipCodeRedeemResponse{Error: &pb.RpcMembershipCodeRedeemResponseError{Code: pb.RpcMembershipCodeRedeemResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcDebugSubscriptionsResponse{Error: &pb.RpcDebugSubscriptionsResponseError{Code: pb.RpcDebugSubscriptionsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcMembershipCodeRedeemRequest)
+	in := new(pb.RpcDebugSubscriptionsRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcMembershipCodeRedeemResponse{Error: &pb.RpcMembershipCodeRedeemResponseError{Code: pb.RpcMembershipCodeRedeemResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcDebugSubscriptionsResponse{Error: &pb.RpcDebugSubscriptionsResponseError{Code: pb.RpcDebugSubscriptionsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.MembershipCodeRedeem(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.DebugSubscriptions(context.Background(), in).Marshal()
 	return resp
 }
 
-func MembershipV2GetProducts(b []byte) (resp []byte) {
+func DebugOpenedObjects(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcMembershipV2GetProductsResponse{Error: &pb.RpcMembershipV2GetProductsResponseError{Code: pb.RpcMembershipV2GetProductsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcDebugOpenedObjectsResponse{Error: &pb.RpcDebugOpenedObjectsResponseError{Code: pb.RpcDebugOpenedObjectsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcMembershipV2GetProductsRequest)
+	in := new(pb.RpcDebugOpenedObjectsRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcMembershipV2GetProductsResponse{Error: &pb.RpcMembershipV2GetProductsResponseError{Code: pb.RpcMembershipV2GetProductsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcDebugOpenedObjectsResponse{Error: &pb.RpcDebugOpenedObjectsResponseError{Code: pb.RpcDebugOpenedObjectsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.MembershipV2GetProducts(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.DebugOpenedObjects(context.Background(), in).Marshal()
 	return resp
 }
 
-func MembershipV2GetStatus(b []byte) (resp []byte) {
+func DebugRunProfiler(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcMembershipV2GetStatusResponse{Error: &pb.RpcMembershipV2GetStatusResponseError{Code: pb.RpcMembershipV2GetStatusResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcDebugRunProfilerResponse{Error: &pb.RpcDebugRunProfilerResponseError{Code: pb.RpcDebugRunProfilerResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcMembershipV2GetStatusRequest)
+	in := new(pb.RpcDebugRunProfilerRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcMembershipV2GetStatusResponse{Error: &pb.RpcMembershipV2GetStatusResponseError{Code: pb.RpcMembershipV2GetStatusResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcDebugRunProfilerResponse{Error: &pb.RpcDebugRunProfilerResponseError{Code: pb.RpcDebugRunProfilerResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.MembershipV2GetStatus(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.DebugRunProfiler(context.Background(), in).Marshal()
 	return resp
 }
 
-func MembershipV2GetPortalLink(b []byte) (resp []byte) {
+func DebugAccountSelectTrace(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcMembershipV2GetPortalLinkResponse{Error: &pb.RpcMembershipV2GetPortalLinkResponseError{Code: pb.RpcMembershipV2GetPortalLinkResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcDebugAccountSelectTraceResponse{Error: &pb.RpcDebugAccountSelectTraceResponseError{Code: pb.RpcDebugAccountSelectTraceResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcMembershipV2GetPortalLinkRequest)
+	in := new(pb.RpcDebugAccountSelectTraceRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcMembershipV2GetPortalLinkResponse{Error: &pb.RpcMembershipV2GetPortalLinkResponseError{Code: pb.RpcMembershipV2GetPortalLinkResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcDebugAccountSelectTraceResponse{Error: &pb.RpcDebugAccountSelectTraceResponseError{Code: pb.RpcDebugAccountSelectTraceResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.MembershipV2GetPortalLink(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.DebugAccountSelectTrace(context.Background(), in).Marshal()
 	return resp
 }
 
-func MembershipV2AnyNameIsValid(b []byte) (resp []byte) {
+func DebugAnystoreObjectChanges(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcMembershipV2AnyNameIsValidResponse{Error: &pb.RpcMembershipV2AnyNameIsValidResponseError{Code: pb.RpcMembershipV2AnyNameIsValidResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcDebugAnystoreObjectChangesResponse{Error: &pb.RpcDebugAnystoreObjectChangesResponseError{Code: pb.RpcDebugAnystoreObjectChangesResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcMembershipV2AnyNameIsValidRequest)
+	in := new(pb.RpcDebugAnystoreObjectChangesRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcMembershipV2AnyNameIsValidResponse{Error: &pb.RpcMembershipV2AnyNameIsValidResponseError{Code: pb.RpcMembershipV2AnyNameIsValidResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcDebugAnystoreObjectChangesResponse{Error: &pb.RpcDebugAnystoreObjectChangesResponseError{Code: pb.RpcDebugAnystoreObjectChangesResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.MembershipV2AnyNameIsValid(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.DebugAnystoreObjectChanges(context.Background(), in).Marshal()
 	return resp
 }
 
-func MembershipV2AnyNameAllocate(b []byte) (resp []byte) {
+func DebugNetCheck(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcMembershipV2AnyNameAllocateResponse{Error: &pb.RpcMembershipV2AnyNameAllocateResponseError{Code: pb.RpcMembershipV2AnyNameAllocateResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcDebugNetCheckResponse{Error: &pb.RpcDebugNetCheckResponseError{Code: pb.RpcDebugNetCheckResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcMembershipV2AnyNameAllocateRequest)
+	in := new(pb.RpcDebugNetCheckRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcMembershipV2AnyNameAllocateResponse{Error: &pb.RpcMembershipV2AnyNameAllocateResponseError{Code: pb.RpcMembershipV2AnyNameAllocateResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcDebugNetCheckResponse{Error: &pb.RpcDebugNetCheckResponseError{Code: pb.RpcDebugNetCheckResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.MembershipV2AnyNameAllocate(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.DebugNetCheck(context.Background(), in).Marshal()
 	return resp
 }
 
-func MembershipV2CartGet(b []byte) (resp []byte) {
+func DebugExportLog(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcMembershipV2CartGetResponse{Error: &pb.RpcMembershipV2CartGetResponseError{Code: pb.RpcMembershipV2CartGetResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcDebugExportLogResponse{Error: &pb.RpcDebugExportLogResponseError{Code: pb.RpcDebugExportLogResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcMembershipV2CartGetRequest)
+	in := new(pb.RpcDebugExportLogRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcMembershipV2CartGetResponse{Error: &pb.RpcMembershipV2CartGetResponseError{Code: pb.RpcMembershipV2CartGetResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcDebugExportLogResponse{Error: &pb.RpcDebugExportLogResponseError{Code: pb.RpcDebugExportLogResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.MembershipV2CartGet(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.DebugExportLog(context.Background(), in).Marshal()
 	return resp
 }
 
-func MembershipV2CartUpdate(b []byte) (resp []byte) {
+func InitialSetParameters(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcMembershipV2CartUpdateResponse{Error: &pb.RpcMembershipV2CartUpdateResponseError{Code: pb.RpcMembershipV2CartUpdateResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcInitialSetParametersResponse{Error: &pb.RpcInitialSetParametersResponseError{Code: pb.RpcInitialSetParametersResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcMembershipV2CartUpdateRequest)
+	in := new(pb.RpcInitialSetParametersRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcMembershipV2CartUpdateResponse{Error: &pb.RpcMembershipV2CartUpdateResponseError{Code: pb.RpcMembershipV2CartUpdateResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcInitialSetParametersResponse{Error: &pb.RpcInitialSetParametersResponseError{Code: pb.RpcInitialSetParametersResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.MembershipV2CartUpdate(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.InitialSetParameters(context.Background(), in).Marshal()
 	return resp
 }
 
-func NameServiceUserAccountGet(b []byte) (resp []byte) {
+func NotificationList(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcNameServiceUserAccountGetResponse{Error: &pb.RpcNameServiceUserAccountGetResponseError{Code: pb.RpcNameServiceUserAccountGetResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcNotificationListResponse{Error: &pb.RpcNotificationListResponseError{Code: pb.RpcNotificationListResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcNameServiceUserAccountGetRequest)
+	in := new(pb.RpcNotificationListRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcNameServiceUserAccountGetResponse{Error: &pb.RpcNameServiceUserAccountGetResponseError{Code: pb.RpcNameServiceUserAccountGetResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcNotificationListResponse{Error: &pb.RpcNotificationListResponseError{Code: pb.RpcNotificationListResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.NameServiceUserAccountGet(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.NotificationList(context.Background(), in).Marshal()
 	return resp
 }
 
-func NameServiceResolveName(b []byte) (resp []byte) {
+func NotificationReply(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcNameServiceResolveNameResponse{Error: &pb.RpcNameServiceResolveNameResponseError{Code: pb.RpcNameServiceResolveNameResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcNotificationReplyResponse{Error: &pb.RpcNotificationReplyResponseError{Code: pb.RpcNotificationReplyResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcNameServiceResolveNameRequest)
+	in := new(pb.RpcNotificationReplyRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcNameServiceResolveNameResponse{Error: &pb.RpcNameServiceResolveNameResponseError{Code: pb.RpcNameServiceResolveNameResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcNotificationReplyResponse{Error: &pb.RpcNotificationReplyResponseError{Code: pb.RpcNotificationReplyResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.NameServiceResolveName(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.NotificationReply(context.Background(), in).Marshal()
 	return resp
 }
 
-func NameServiceResolveAnyId(b []byte) (resp []byte) {
+func NotificationTest(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcNameServiceResolveAnyIdResponse{Error: &pb.RpcNameServiceResolveAnyIdResponseError{Code: pb.RpcNameServiceResolveAnyIdResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcNotificationTestResponse{Error: &pb.RpcNotificationTestResponseError{Code: pb.RpcNotificationTestResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcNameServiceResolveAnyIdRequest)
+	in := new(pb.RpcNotificationTestRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcNameServiceResolveAnyIdResponse{Error: &pb.RpcNameServiceResolveAnyIdResponseError{Code: pb.RpcNameServiceResolveAnyIdResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcNotificationTestResponse{Error: &pb.RpcNotificationTestResponseError{Code: pb.RpcNotificationTestResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.NameServiceResolveAnyId(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.NotificationTest(context.Background(), in).Marshal()
 	return resp
 }
 
-func BroadcastPayloadEvent(b []byte) (resp []byte) {
+func MembershipGetStatus(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcBroadcastPayloadEventResponse{Error: &pb.RpcBroadcastPayloadEventResponseError{Code: pb.RpcBroadcastPayloadEventResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcMembershipGetStatusResponse{Error: &pb.RpcMembershipGetStatusResponseError{Code: pb.RpcMembershipGetStatusResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcBroadcastPayloadEventRequest)
+	in := new(pb.RpcMembershipGetStatusRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcBroadcastPayloadEventResponse{Error: &pb.RpcBroadcastPayloadEventResponseError{Code: pb.RpcBroadcastPayloadEventResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcMembershipGetStatusResponse{Error: &pb.RpcMembershipGetStatusResponseError{Code: pb.RpcMembershipGetStatusResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.BroadcastPayloadEvent(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.MembershipGetStatus(context.Background(), in).Marshal()
 	return resp
 }
 
-func DeviceSetName(b []byte) (resp []byte) {
+func MembershipIsNameValid(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcDeviceSetNameResponse{Error: &pb.RpcDeviceSetNameResponseError{Code: pb.RpcDeviceSetNameResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcMembershipIsNameValidResponse{Error: &pb.RpcMembershipIsNameValidResponseError{Code: pb.RpcMembershipIsNameValidResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcDeviceSetNameRequest)
+	in := new(pb.RpcMembershipIsNameValidRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcDeviceSetNameResponse{Error: &pb.RpcDeviceSetNameResponseError{Code: pb.RpcDeviceSetNameResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcMembershipIsNameValidResponse{Error: &pb.RpcMembershipIsNameValidResponseError{Code: pb.RpcMembershipIsNameValidResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.DeviceSetName(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.MembershipIsNameValid(context.Background(), in).Marshal()
 	return resp
 }
 
-func DeviceList(b []byte) (resp []byte) {
+func MembershipRegisterPaymentRequest(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcDeviceListResponse{Error: &pb.RpcDeviceListResponseError{Code: pb.RpcDeviceListResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcMembershipRegisterPaymentRequestResponse{Error: &pb.RpcMembershipRegisterPaymentRequestResponseError{Code: pb.RpcMembershipRegisterPaymentRequestResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcDeviceListRequest)
+	in := new(pb.RpcMembershipRegisterPaymentRequestRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcDeviceListResponse{Error: &pb.RpcDeviceListResponseError{Code: pb.RpcDeviceListResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcMembershipRegisterPaymentRequestResponse{Error: &pb.RpcMembershipRegisterPaymentRequestResponseError{Code: pb.RpcMembershipRegisterPaymentRequestResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.DeviceList(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.MembershipRegisterPaymentRequest(context.Background(), in).Marshal()
 	return resp
 }
 
-func DeviceNetworkStateSet(b []byte) (resp []byte) {
+func MembershipGetPortalLinkUrl(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcDeviceNetworkStateSetResponse{Error: &pb.RpcDeviceNetworkStateSetResponseError{Code: pb.RpcDeviceNetworkStateSetResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcMembershipGetPortalLinkUrlResponse{Error: &pb.RpcMembershipGetPortalLinkUrlResponseError{Code: pb.RpcMembershipGetPortalLinkUrlResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcDeviceNetworkStateSetRequest)
+	in := new(pb.RpcMembershipGetPortalLinkUrlRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcDeviceNetworkStateSetResponse{Error: &pb.RpcDeviceNetworkStateSetResponseError{Code: pb.RpcDeviceNetworkStateSetResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcMembershipGetPortalLinkUrlResponse{Error: &pb.RpcMembershipGetPortalLinkUrlResponseError{Code: pb.RpcMembershipGetPortalLinkUrlResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.DeviceNetworkStateSet(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.MembershipGetPortalLinkUrl(context.Background(), in).Marshal()
 	return resp
 }
 
-func ChatAddMessage(b []byte) (resp []byte) {
+func MembershipGetVerificationEmailStatus(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcChatAddMessageResponse{Error: &pb.RpcChatAddMessageResponseError{Code: pb.RpcChatAddMessageResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcMembershipGetVerificationEmailStatusResponse{Error: &pb.RpcMembershipGetVerificationEmailStatusResponseError{Code: pb.RpcMembershipGetVerificationEmailStatusResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcChatAddMessageRequest)
+	in := new(pb.RpcMembershipGetVerificationEmailStatusRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcChatAddMessageResponse{Error: &pb.RpcChatAddMessageResponseError{Code: pb.RpcChatAddMessageResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcMembershipGetVerificationEmailStatusResponse{Error: &pb.RpcMembershipGetVerificationEmailStatusResponseError{Code: pb.RpcMembershipGetVerificationEmailStatusResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.ChatAddMessage(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.MembershipGetVerificationEmailStatus(context.Background(), in).Marshal()
 	return resp
 }
 
-func ChatEditMessageContent(b []byte) (resp []byte) {
+func MembershipGetVerificationEmail(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcChatEditMessageContentResponse{Error: &pb.RpcChatEditMessageContentResponseError{Code: pb.RpcChatEditMessageContentResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcMembershipGetVerificationEmailResponse{Error: &pb.RpcMembershipGetVerificationEmailResponseError{Code: pb.RpcMembershipGetVerificationEmailResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcChatEditMessageContentRequest)
+	in := new(pb.RpcMembershipGetVerificationEmailRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcChatEditMessageContentResponse{Error: &pb.RpcChatEditMessageContentResponseError{Code: pb.RpcChatEditMessageContentResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcMembershipGetVerificationEmailResponse{Error: &pb.RpcMembershipGetVerificationEmailResponseError{Code: pb.RpcMembershipGetVerificationEmailResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.ChatEditMessageContent(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.MembershipGetVerificationEmail(context.Background(), in).Marshal()
 	return resp
 }
 
-func ChatToggleMessageReaction(b []byte) (resp []byte) {
+func MembershipVerifyEmailCode(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcChatToggleMessageReactionResponse{Error: &pb.RpcChatToggleMessageReactionResponseError{Code: pb.RpcChatToggleMessageReactionResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcMembershipVerifyEmailCodeResponse{Error: &pb.RpcMembershipVerifyEmailCodeResponseError{Code: pb.RpcMembershipVerifyEmailCodeResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcChatToggleMessageReactionRequest)
+	in := new(pb.RpcMembershipVerifyEmailCodeRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcChatToggleMessageReactionResponse{Error: &pb.RpcChatToggleMessageReactionResponseError{Code: pb.RpcChatToggleMessageReactionResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcMembershipVerifyEmailCodeResponse{Error: &pb.RpcMembershipVerifyEmailCodeResponseError{Code: pb.RpcMembershipVerifyEmailCodeResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.ChatToggleMessageReaction(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.MembershipVerifyEmailCode(context.Background(), in).Marshal()
 	return resp
 }
 
-func ChatDeleteMessage(b []byte) (resp []byte) {
+func MembershipFinalize(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcChatDeleteMessageResponse{Error: &pb.RpcChatDeleteMessageResponseError{Code: pb.RpcChatDeleteMessageResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcMembershipFinalizeResponse{Error: &pb.RpcMembershipFinalizeResponseError{Code: pb.RpcMembershipFinalizeResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcChatDeleteMessageRequest)
+	in := new(pb.RpcMembershipFinalizeRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcChatDeleteMessageResponse{Error: &pb.RpcChatDeleteMessageResponseError{Code: pb.RpcChatDeleteMessageResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcMembershipFinalizeResponse{Error: &pb.RpcMembershipFinalizeResponseError{Code: pb.RpcMembershipFinalizeResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.ChatDeleteMessage(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.MembershipFinalize(context.Background(), in).Marshal()
 	return resp
 }
 
-func ChatGetMessages(b []byte) (resp []byte) {
+func MembershipGetTiers(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcChatGetMessagesResponse{Error: &pb.RpcChatGetMessagesResponseError{Code: pb.RpcChatGetMessagesResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcMembershipGetTiersResponse{Error: &pb.RpcMembershipGetTiersResponseError{Code: pb.RpcMembershipGetTiersResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcChatGetMessagesRequest)
+	in := new(pb.RpcMembershipGetTiersRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcChatGetMessagesResponse{Error: &pb.RpcChatGetMessagesResponseError{Code: pb.RpcChatGetMessagesResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcMembershipGetTiersResponse{Error: &pb.RpcMembershipGetTiersResponseError{Code: pb.RpcMembershipGetTiersResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.ChatGetMessages(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.MembershipGetTiers(context.Background(), in).Marshal()
 	return resp
 }
 
-func ChatGetMessagesByIds(b []byte) (resp []byte) {
+func MembershipVerifyAppStoreReceipt(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcChatGetMessagesByIdsResponse{Error: &pb.RpcChatGetMessagesByIdsResponseError{Code: pb.RpcChatGetMessagesByIdsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcMembershipVerifyAppStoreReceiptResponse{Error: &pb.RpcMembershipVerifyAppStoreReceiptResponseError{Code: pb.RpcMembershipVerifyAppStoreReceiptResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcChatGetMessagesByIdsRequest)
+	in := new(pb.RpcMembershipVerifyAppStoreReceiptRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcChatGetMessagesByIdsResponse{Error: &pb.RpcChatGetMessagesByIdsResponseError{Code: pb.RpcChatGetMessagesByIdsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcMembershipVerifyAppStoreReceiptResponse{Error: &pb.RpcMembershipVerifyAppStoreReceiptResponseError{Code: pb.RpcMembershipVerifyAppStoreReceiptResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.ChatGetMessagesByIds(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.MembershipVerifyAppStoreReceipt(context.Background(), in).Marshal()
 	return resp
 }
 
-func ChatSubscribeLastMessages(b []byte) (resp []byte) {
+func MembershipCodeGetInfo(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcChatSubscribeLastMessagesResponse{Error: &pb.RpcChatSubscribeLastMessagesResponseError{Code: pb.RpcChatSubscribeLastMessagesResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcMembershipCodeGetInfoResponse{Error: &pb.RpcMembershipCodeGetInfoResponseError{Code: pb.RpcMembershipCodeGetInfoResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcChatSubscribeLastMessagesRequest)
+	in := new(pb.RpcMembershipCodeGetInfoRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcChatSubscribeLastMessagesResponse{Error: &pb.RpcChatSubscribeLastMessagesResponseError{Code: pb.RpcChatSubscribeLastMessagesResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcMembershipCodeGetInfoResponse{Error: &pb.RpcMembershipCodeGetInfoResponseError{Code: pb.RpcMembershipCodeGetInfoResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.ChatSubscribeLastMessages(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.MembershipCodeGetInfo(context.Background(), in).Marshal()
 	return resp
 }
 
-func ChatUnsubscribe(b []byte) (resp []byte) {
+func MembershipCodeRedeem(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcChatUnsubscribeResponse{Error: &pb.RpcChatUnsubscribeResponseError{Code: pb.RpcChatUnsubscribeResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcMembershipCodeRedeemResponse{Error: &pb.RpcMembershipCodeRedeemResponseError{Code: pb.RpcMembershipCodeRedeemResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcChatUnsubscribeRequest)
+	in := new(pb.RpcMembershipCodeRedeemRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcChatUnsubscribeResponse{Error: &pb.RpcChatUnsubscribeResponseError{Code: pb.RpcChatUnsubscribeResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcMembershipCodeRedeemResponse{Error: &pb.RpcMembershipCodeRedeemResponseError{Code: pb.RpcMembershipCodeRedeemResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.ChatUnsubscribe(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.MembershipCodeRedeem(context.Background(), in).Marshal()
 	return resp
 }
 
-func ChatReadMessages(b []byte) (resp []byte) {
+func MembershipV2GetProducts(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcChatReadMessagesResponse{Error: &pb.RpcChatReadMessagesResponseError{Code: pb.RpcChatReadMessagesResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcMembershipV2GetProductsResponse{Error: &pb.RpcMembershipV2GetProductsResponseError{Code: pb.RpcMembershipV2GetProductsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcChatReadMessagesRequest)
+	in := new(pb.RpcMembershipV2GetProductsRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcChatReadMessagesResponse{Error: &pb.RpcChatReadMessagesResponseError{Code: pb.RpcChatReadMessagesResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcMembershipV2GetProductsResponse{Error: &pb.RpcMembershipV2GetProductsResponseError{Code: pb.RpcMembershipV2GetProductsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.ChatReadMessages(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.MembershipV2GetProducts(context.Background(), in).Marshal()
 	return resp
 }
 
-func ChatUnreadMessages(b []byte) (resp []byte) {
+func MembershipV2GetStatus(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcChatUnreadResponse{Error: &pb.RpcChatUnreadResponseError{Code: pb.RpcChatUnreadResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcMembershipV2GetStatusResponse{Error: &pb.RpcMembershipV2GetStatusResponseError{Code: pb.RpcMembershipV2GetStatusResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcChatUnreadRequest)
+	in := new(pb.RpcMembershipV2GetStatusRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcChatUnreadResponse{Error: &pb.RpcChatUnreadResponseError{Code: pb.RpcChatUnreadResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcMembershipV2GetStatusResponse{Error: &pb.RpcMembershipV2GetStatusResponseError{Code: pb.RpcMembershipV2GetStatusResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.ChatUnreadMessages(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.MembershipV2GetStatus(context.Background(), in).Marshal()
 	return resp
 }
 
-func ChatSubscribeToMessagePreviews(b []byte) (resp []byte) {
+func MembershipV2GetPortalLink(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcChatSubscribeToMessagePreviewsResponse{Error: &pb.RpcChatSubscribeToMessagePreviewsResponseError{Code: pb.RpcChatSubscribeToMessagePreviewsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcMembershipV2GetPortalLinkResponse{Error: &pb.RpcMembershipV2GetPortalLinkResponseError{Code: pb.RpcMembershipV2GetPortalLinkResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcChatSubscribeToMessagePreviewsRequest)
+	in := new(pb.RpcMembershipV2GetPortalLinkRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcChatSubscribeToMessagePreviewsResponse{Error: &pb.RpcChatSubscribeToMessagePreviewsResponseError{Code: pb.RpcChatSubscribeToMessagePreviewsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcMembershipV2GetPortalLinkResponse{Error: &pb.RpcMembershipV2GetPortalLinkResponseError{Code: pb.RpcMembershipV2GetPortalLinkResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.ChatSubscribeToMessagePreviews(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.MembershipV2GetPortalLink(context.Background(), in).Marshal()
 	return resp
 }
 
-func ChatUnsubscribeFromMessagePreviews(b []byte) (resp []byte) {
+func MembershipV2AnyNameIsValid(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcChatUnsubscribeFromMessagePreviewsResponse{Error: &pb.RpcChatUnsubscribeFromMessagePreviewsResponseError{Code: pb.RpcChatUnsubscribeFromMessagePreviewsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcMembershipV2AnyNameIsValidResponse{Error: &pb.RpcMembershipV2AnyNameIsValidResponseError{Code: pb.RpcMembershipV2AnyNameIsValidResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcChatUnsubscribeFromMessagePreviewsRequest)
+	in := new(pb.RpcMembershipV2AnyNameIsValidRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcChatUnsubscribeFromMessagePreviewsResponse{Error: &pb.RpcChatUnsubscribeFromMessagePreviewsResponseError{Code: pb.RpcChatUnsubscribeFromMessagePreviewsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcMembershipV2AnyNameIsValidResponse{Error: &pb.RpcMembershipV2AnyNameIsValidResponseError{Code: pb.RpcMembershipV2AnyNameIsValidResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.ChatUnsubscribeFromMessagePreviews(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.MembershipV2AnyNameIsValid(context.Background(), in).Marshal()
 	return resp
 }
 
-func ObjectChatAdd(b []byte) (resp []byte) {
+func MembershipV2AnyNameAllocate(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcObjectChatAddResponse{Error: &pb.RpcObjectChatAddResponseError{Code: pb.RpcObjectChatAddResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcMembershipV2AnyNameAllocateResponse{Error: &pb.RpcMembershipV2AnyNameAllocateResponseError{Code: pb.RpcMembershipV2AnyNameAllocateResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcObjectChatAddRequest)
+	in := new(pb.RpcMembershipV2AnyNameAllocateRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcObjectChatAddResponse{Error: &pb.RpcObjectChatAddResponseError{Code: pb.RpcObjectChatAddResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcMembershipV2AnyNameAllocateResponse{Error: &pb.RpcMembershipV2AnyNameAllocateResponseError{Code: pb.RpcMembershipV2AnyNameAllocateResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.ObjectChatAdd(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.MembershipV2AnyNameAllocate(context.Background(), in).Marshal()
 	return resp
 }
 
-func ChatReadAll(b []byte) (resp []byte) {
+func MembershipV2CartGet(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcChatReadAllResponse{Error: &pb.RpcChatReadAllResponseError{Code: pb.RpcChatReadAllResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcMembershipV2CartGetResponse{Error: &pb.RpcMembershipV2CartGetResponseError{Code: pb.RpcMembershipV2CartGetResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcChatReadAllRequest)
+	in := new(pb.RpcMembershipV2CartGetRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcChatReadAllResponse{Error: &pb.RpcChatReadAllResponseError{Code: pb.RpcChatReadAllResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcMembershipV2CartGetResponse{Error: &pb.RpcMembershipV2CartGetResponseError{Code: pb.RpcMembershipV2CartGetResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.ChatReadAll(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.MembershipV2CartGet(context.Background(), in).Marshal()
 	return resp
 }
 
-func AIWritingTools(b []byte) (resp []byte) {
+func MembershipV2CartUpdate(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcAIWritingToolsResponse{Error: &pb.RpcAIWritingToolsResponseError{Code: pb.RpcAIWritingToolsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcMembershipV2CartUpdateResponse{Error: &pb.RpcMembershipV2CartUpdateResponseError{Code: pb.RpcMembershipV2CartUpdateResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcAIWritingToolsRequest)
+	in := new(pb.RpcMembershipV2CartUpdateRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcAIWritingToolsResponse{Error: &pb.RpcAIWritingToolsResponseError{Code: pb.RpcAIWritingToolsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcMembershipV2CartUpdateResponse{Error: &pb.RpcMembershipV2CartUpdateResponseError{Code: pb.RpcMembershipV2CartUpdateResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.AIWritingTools(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.MembershipV2CartUpdate(context.Background(), in).Marshal()
 	return resp
 }
 
-func AIAutofill(b []byte) (resp []byte) {
+func NameServiceUserAccountGet(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcAIAutofillResponse{Error: &pb.RpcAIAutofillResponseError{Code: pb.RpcAIAutofillResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcNameServiceUserAccountGetResponse{Error: &pb.RpcNameServiceUserAccountGetResponseError{Code: pb.RpcNameServiceUserAccountGetResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcAIAutofillRequest)
+	in := new(pb.RpcNameServiceUserAccountGetRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcAIAutofillResponse{Error: &pb.RpcAIAutofillResponseError{Code: pb.RpcAIAutofillResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcNameServiceUserAccountGetResponse{Error: &pb.RpcNameServiceUserAccountGetResponseError{Code: pb.RpcNameServiceUserAccountGetResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.AIAutofill(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.NameServiceUserAccountGet(context.Background(), in).Marshal()
 	return resp
 }
 
-func AIListSummary(b []byte) (resp []byte) {
+func NameServiceResolveName(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcAIListSummaryResponse{Error: &pb.RpcAIListSummaryResponseError{Code: pb.RpcAIListSummaryResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcNameServiceResolveNameResponse{Error: &pb.RpcNameServiceResolveNameResponseError{Code: pb.RpcNameServiceResolveNameResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcAIListSummaryRequest)
+	in := new(pb.RpcNameServiceResolveNameRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcAIListSummaryResponse{Error: &pb.RpcAIListSummaryResponseError{Code: pb.RpcAIListSummaryResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcNameServiceResolveNameResponse{Error: &pb.RpcNameServiceResolveNameResponseError{Code: pb.RpcNameServiceResolveNameResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.AIListSummary(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.NameServiceResolveName(context.Background(), in).Marshal()
 	return resp
 }
 
-func AIObjectCreateFromUrl(b []byte) (resp []byte) {
+func NameServiceResolveAnyId(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcAIObjectCreateFromUrlResponse{Error: &pb.RpcAIObjectCreateFromUrlResponseError{Code: pb.RpcAIObjectCreateFromUrlResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcNameServiceResolveAnyIdResponse{Error: &pb.RpcNameServiceResolveAnyIdResponseError{Code: pb.RpcNameServiceResolveAnyIdResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcAIObjectCreateFromUrlRequest)
+	in := new(pb.RpcNameServiceResolveAnyIdRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcAIObjectCreateFromUrlResponse{Error: &pb.RpcAIObjectCreateFromUrlResponseError{Code: pb.RpcAIObjectCreateFromUrlResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcNameServiceResolveAnyIdResponse{Error: &pb.RpcNameServiceResolveAnyIdResponseError{Code: pb.RpcNameServiceResolveAnyIdResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.AIObjectCreateFromUrl(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.NameServiceResolveAnyId(context.Background(), in).Marshal()
 	return resp
 }
 
-func PushNotificationRegisterToken(b []byte) (resp []byte) {
+func BroadcastPayloadEvent(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcPushNotificationRegisterTokenResponse{Error: &pb.RpcPushNotificationRegisterTokenResponseError{Code: pb.RpcPushNotificationRegisterTokenResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcBroadcastPayloadEventResponse{Error: &pb.RpcBroadcastPayloadEventResponseError{Code: pb.RpcBroadcastPayloadEventResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcPushNotificationRegisterTokenRequest)
+	in := new(pb.RpcBroadcastPayloadEventRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcPushNotificationRegisterTokenResponse{Error: &pb.RpcPushNotificationRegisterTokenResponseError{Code: pb.RpcPushNotificationRegisterTokenResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcBroadcastPayloadEventResponse{Error: &pb.RpcBroadcastPayloadEventResponseError{Code: pb.RpcBroadcastPayloadEventResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.PushNotificationRegisterToken(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.BroadcastPayloadEvent(context.Background(), in).Marshal()
 	return resp
 }
 
-func PushNotificationSetSpaceMode(b []byte) (resp []byte) {
+func DeviceSetName(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcPushNotificationSetSpaceModeResponse{Error: &pb.RpcPushNotificationSetSpaceModeResponseError{Code: pb.RpcPushNotificationSetSpaceModeResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcDeviceSetNameResponse{Error: &pb.RpcDeviceSetNameResponseError{Code: pb.RpcDeviceSetNameResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcPushNotificationSetSpaceModeRequest)
+	in := new(pb.RpcDeviceSetNameRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcPushNotificationSetSpaceModeResponse{Error: &pb.RpcPushNotificationSetSpaceModeResponseError{Code: pb.RpcPushNotificationSetSpaceModeResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcDeviceSetNameResponse{Error: &pb.RpcDeviceSetNameResponseError{Code: pb.RpcDeviceSetNameResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.PushNotificationSetSpaceMode(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.DeviceSetName(context.Background(), in).Marshal()
 	return resp
 }
 
-func PushNotificationSetForceModeIds(b []byte) (resp []byte) {
+func DeviceList(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcPushNotificationSetForceModeIdsResponse{Error: &pb.RpcPushNotificationSetForceModeIdsResponseError{Code: pb.RpcPushNotificationSetForceModeIdsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcDeviceListResponse{Error: &pb.RpcDeviceListResponseError{Code: pb.RpcDeviceListResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcPushNotificationSetForceModeIdsRequest)
+	in := new(pb.RpcDeviceListRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcPushNotificationSetForceModeIdsResponse{Error: &pb.RpcPushNotificationSetForceModeIdsResponseError{Code: pb.RpcPushNotificationSetForceModeIdsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcDeviceListResponse{Error: &pb.RpcDeviceListResponseError{Code: pb.RpcDeviceListResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.PushNotificationSetForceModeIds(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.DeviceList(context.Background(), in).Marshal()
 	return resp
 }
 
-func PushNotificationResetIds(b []byte) (resp []byte) {
+func DeviceNetworkStateSet(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
 			if r := recover(); r != nil {
-				resp, _ = (&pb.RpcPushNotificationResetIdsResponse{Error: &pb.RpcPushNotificationResetIdsResponseError{Code: pb.RpcPushNotificationResetIdsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				resp, _ = (&pb.RpcDeviceNetworkStateSetResponse{Error: &pb.RpcDeviceNetworkStateSetResponseError{Code: pb.RpcDeviceNetworkStateSetResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
 				PanicHandler(r)
 			}
 		}
 	}()
 
-	in := new(pb.RpcPushNotificationResetIdsRequest)
+	in := new(pb.RpcDeviceNetworkStateSetRequest)
 	if err := in.Unmarshal(b); err != nil {
-		resp, _ = (&pb.RpcPushNotificationResetIdsResponse{Error: &pb.RpcPushNotificationResetIdsResponseError{Code: pb.RpcPushNotificationResetIdsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		resp, _ = (&pb.RpcDeviceNetworkStateSetResponse{Error: &pb.RpcDeviceNetworkStateSetResponseError{Code: pb.RpcDeviceNetworkStateSetResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
 		return resp
 	}
 
-	resp, _ = clientCommandsHandler.PushNotificationResetIds(context.Background(), in).Marshal()
+	resp, _ = clientCommandsHandler.DeviceNetworkStateSet(context.Background(), in).Marshal()
 	return resp
 }
 
-var PanicHandler func(v interface{})
+func ChatAddMessage(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcChatAddMessageResponse{Error: &pb.RpcChatAddMessageResponseError{Code: pb.RpcChatAddMessageResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
 
-func CommandAsync(cmd string, data []byte, callback func(data []byte)) {
-	go func() {
-		var cd []byte
-		switch cmd {
-		case "AppGetVersion":
-			cd = AppGetVersion(data)
-		case "AppSetDeviceState":
-			cd = AppSetDeviceState(data)
-		case "AppShutdown":
-			cd = AppShutdown(data)
-		case "WalletCreate":
-			cd = WalletCreate(data)
-		case "WalletRecover":
-			cd = WalletRecover(data)
-		case "WalletConvert":
-			cd = WalletConvert(data)
-		case "AccountLocalLinkNewChallenge":
-			cd = AccountLocalLinkNewChallenge(data)
-		case "AccountLocalLinkSolveChallenge":
-			cd = AccountLocalLinkSolveChallenge(data)
-		case "AccountLocalLinkCreateApp":
-			cd = AccountLocalLinkCreateApp(data)
-		case "AccountLocalLinkListApps":
-			cd = AccountLocalLinkListApps(data)
-		case "AccountLocalLinkRevokeApp":
-			cd = AccountLocalLinkRevokeApp(data)
-		case "WalletCreateSession":
-			cd = WalletCreateSession(data)
-		case "WalletCloseSession":
-			cd = WalletCloseSession(data)
-		case "WorkspaceCreate":
-			cd = WorkspaceCreate(data)
-		case "WorkspaceOpen":
-			cd = WorkspaceOpen(data)
-		case "WorkspaceObjectAdd":
-			cd = WorkspaceObjectAdd(data)
-		case "WorkspaceObjectListAdd":
-			cd = WorkspaceObjectListAdd(data)
-		case "WorkspaceObjectListRemove":
-			cd = WorkspaceObjectListRemove(data)
-		case "WorkspaceSelect":
-			cd = WorkspaceSelect(data)
-		case "WorkspaceGetCurrent":
-			cd = WorkspaceGetCurrent(data)
-		case "WorkspaceGetAll":
-			cd = WorkspaceGetAll(data)
-		case "WorkspaceSetInfo":
-			cd = WorkspaceSetInfo(data)
-		case "WorkspaceExport":
-			cd = WorkspaceExport(data)
-		case "AccountRecover":
-			cd = AccountRecover(data)
-		case "AccountMigrate":
-			cd = AccountMigrate(data)
-		case "AccountMigrateCancel":
-			cd = AccountMigrateCancel(data)
-		case "AccountCreate":
-			cd = AccountCreate(data)
-		case "AccountDelete":
-			cd = AccountDelete(data)
-		case "AccountRevertDeletion":
-			cd = AccountRevertDeletion(data)
-		case "AccountSelect":
-			cd = AccountSelect(data)
-		case "AccountEnableLocalNetworkSync":
-			cd = AccountEnableLocalNetworkSync(data)
-		case "AccountChangeJsonApiAddr":
-			cd = AccountChangeJsonApiAddr(data)
-		case "AccountStop":
-			cd = AccountStop(data)
-		case "AccountMove":
-			cd = AccountMove(data)
-		case "AccountConfigUpdate":
-			cd = AccountConfigUpdate(data)
-		case "AccountRecoverFromLegacyExport":
-			cd = AccountRecoverFromLegacyExport(data)
-		case "AccountChangeNetworkConfigAndRestart":
-			cd = AccountChangeNetworkConfigAndRestart(data)
-		case "SpaceDelete":
-			cd = SpaceDelete(data)
-		case "SpaceInviteGenerate":
-			cd = SpaceInviteGenerate(data)
-		case "SpaceInviteChange":
-			cd = SpaceInviteChange(data)
-		case "SpaceInviteGetCurrent":
-			cd = SpaceInviteGetCurrent(data)
-		case "SpaceInviteGetGuest":
-			cd = SpaceInviteGetGuest(data)
-		case "SpaceInviteRevoke":
-			cd = SpaceInviteRevoke(data)
-		case "SpaceInviteView":
-			cd = SpaceInviteView(data)
-		case "SpaceJoin":
-			cd = SpaceJoin(data)
-		case "SpaceJoinCancel":
-			cd = SpaceJoinCancel(data)
-		case "SpaceStopSharing":
-			cd = SpaceStopSharing(data)
-		case "SpaceRequestApprove":
-			cd = SpaceRequestApprove(data)
-		case "SpaceRequestDecline":
-			cd = SpaceRequestDecline(data)
-		case "SpaceLeaveApprove":
-			cd = SpaceLeaveApprove(data)
-		case "SpaceMakeShareable":
-			cd = SpaceMakeShareable(data)
-		case "SpaceParticipantRemove":
-			cd = SpaceParticipantRemove(data)
-		case "SpaceParticipantPermissionsChange":
-			cd = SpaceParticipantPermissionsChange(data)
-		case "SpaceSetOrder":
-			cd = SpaceSetOrder(data)
-		case "SpaceUnsetOrder":
-			cd = SpaceUnsetOrder(data)
-		case "PublishingCreate":
-			cd = PublishingCreate(data)
-		case "PublishingRemove":
-			cd = PublishingRemove(data)
-		case "PublishingList":
-			cd = PublishingList(data)
-		case "PublishingResolveUri":
-			cd = PublishingResolveUri(data)
-		case "PublishingGetStatus":
-			cd = PublishingGetStatus(data)
-		case "ObjectOpen":
-			cd = ObjectOpen(data)
-		case "ObjectRefresh":
-			cd = ObjectRefresh(data)
-		case "ObjectClose":
-			cd = ObjectClose(data)
-		case "ObjectShow":
-			cd = ObjectShow(data)
-		case "ObjectCreate":
-			cd = ObjectCreate(data)
-		case "ObjectCreateBookmark":
-			cd = ObjectCreateBookmark(data)
-		case "ObjectCreateFromUrl":
-			cd = ObjectCreateFromUrl(data)
-		case "ObjectCreateSet":
-			cd = ObjectCreateSet(data)
-		case "ObjectGraph":
-			cd = ObjectGraph(data)
-		case "ObjectSearch":
-			cd = ObjectSearch(data)
-		case "ObjectSearchWithMeta":
-			cd = ObjectSearchWithMeta(data)
-		case "ObjectSearchSubscribe":
-			cd = ObjectSearchSubscribe(data)
-		case "ObjectCrossSpaceSearchSubscribe":
-			cd = ObjectCrossSpaceSearchSubscribe(data)
-		case "ObjectCrossSpaceSearchUnsubscribe":
-			cd = ObjectCrossSpaceSearchUnsubscribe(data)
-		case "ObjectSubscribeIds":
-			cd = ObjectSubscribeIds(data)
-		case "ObjectGroupsSubscribe":
-			cd = ObjectGroupsSubscribe(data)
-		case "ObjectSearchUnsubscribe":
-			cd = ObjectSearchUnsubscribe(data)
-		case "ObjectSetDetails":
-			cd = ObjectSetDetails(data)
-		case "ObjectDuplicate":
-			cd = ObjectDuplicate(data)
-		case "ObjectSetObjectType":
-			cd = ObjectSetObjectType(data)
-		case "ObjectSetLayout":
-			cd = ObjectSetLayout(data)
-		case "ObjectSetInternalFlags":
-			cd = ObjectSetInternalFlags(data)
-		case "ObjectSetIsFavorite":
-			cd = ObjectSetIsFavorite(data)
-		case "ObjectSetIsArchived":
-			cd = ObjectSetIsArchived(data)
-		case "ObjectSetSource":
-			cd = ObjectSetSource(data)
-		case "ObjectWorkspaceSetDashboard":
-			cd = ObjectWorkspaceSetDashboard(data)
-		case "ObjectListDuplicate":
-			cd = ObjectListDuplicate(data)
-		case "ObjectListDelete":
-			cd = ObjectListDelete(data)
-		case "ObjectListSetIsArchived":
-			cd = ObjectListSetIsArchived(data)
-		case "ObjectListSetIsFavorite":
-			cd = ObjectListSetIsFavorite(data)
-		case "ObjectListSetObjectType":
-			cd = ObjectListSetObjectType(data)
-		case "ObjectListSetDetails":
-			cd = ObjectListSetDetails(data)
-		case "ObjectListModifyDetailValues":
-			cd = ObjectListModifyDetailValues(data)
-		case "ObjectApplyTemplate":
-			cd = ObjectApplyTemplate(data)
-		case "ObjectToSet":
-			cd = ObjectToSet(data)
-		case "ObjectToCollection":
-			cd = ObjectToCollection(data)
-		case "ObjectShareByLink":
-			cd = ObjectShareByLink(data)
-		case "ObjectUndo":
-			cd = ObjectUndo(data)
-		case "ObjectRedo":
-			cd = ObjectRedo(data)
-		case "ObjectListExport":
-			cd = ObjectListExport(data)
-		case "ObjectExport":
-			cd = ObjectExport(data)
-		case "ObjectBookmarkFetch":
-			cd = ObjectBookmarkFetch(data)
-		case "ObjectImport":
-			cd = ObjectImport(data)
-		case "ObjectImportList":
-			cd = ObjectImportList(data)
-		case "ObjectImportNotionValidateToken":
-			cd = ObjectImportNotionValidateToken(data)
-		case "ObjectImportUseCase":
-			cd = ObjectImportUseCase(data)
-		case "ObjectImportExperience":
-			cd = ObjectImportExperience(data)
-		case "ObjectDateByTimestamp":
-			cd = ObjectDateByTimestamp(data)
-		case "ObjectCollectionAdd":
-			cd = ObjectCollectionAdd(data)
-		case "ObjectCollectionRemove":
-			cd = ObjectCollectionRemove(data)
-		case "ObjectCollectionSort":
-			cd = ObjectCollectionSort(data)
-		case "ObjectCreateRelation":
-			cd = ObjectCreateRelation(data)
-		case "ObjectCreateRelationOption":
-			cd = ObjectCreateRelationOption(data)
-		case "RelationListRemoveOption":
-			cd = RelationListRemoveOption(data)
-		case "RelationOptions":
-			cd = RelationOptions(data)
-		case "RelationOptionSetOrder":
-			cd = RelationOptionSetOrder(data)
-		case "RelationListWithValue":
-			cd = RelationListWithValue(data)
-		case "ObjectRelationAdd":
-			cd = ObjectRelationAdd(data)
-		case "ObjectRelationDelete":
-			cd = ObjectRelationDelete(data)
-		case "ObjectRelationAddFeatured":
+	in := new(pb.RpcChatAddMessageRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcChatAddMessageResponse{Error: &pb.RpcChatAddMessageResponseError{Code: pb.RpcChatAddMessageResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.ChatAddMessage(context.Background(), in).Marshal()
+	return resp
+}
+
+func ChatEditMessageContent(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcChatEditMessageContentResponse{Error: &pb.RpcChatEditMessageContentResponseError{Code: pb.RpcChatEditMessageContentResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcChatEditMessageContentRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcChatEditMessageContentResponse{Error: &pb.RpcChatEditMessageContentResponseError{Code: pb.RpcChatEditMessageContentResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.ChatEditMessageContent(context.Background(), in).Marshal()
+	return resp
+}
+
+func ChatToggleMessageReaction(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcChatToggleMessageReactionResponse{Error: &pb.RpcChatToggleMessageReactionResponseError{Code: pb.RpcChatToggleMessageReactionResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcChatToggleMessageReactionRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcChatToggleMessageReactionResponse{Error: &pb.RpcChatToggleMessageReactionResponseError{Code: pb.RpcChatToggleMessageReactionResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.ChatToggleMessageReaction(context.Background(), in).Marshal()
+	return resp
+}
+
+func ChatDeleteMessage(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcChatDeleteMessageResponse{Error: &pb.RpcChatDeleteMessageResponseError{Code: pb.RpcChatDeleteMessageResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcChatDeleteMessageRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcChatDeleteMessageResponse{Error: &pb.RpcChatDeleteMessageResponseError{Code: pb.RpcChatDeleteMessageResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.ChatDeleteMessage(context.Background(), in).Marshal()
+	return resp
+}
+
+func ChatGetMessages(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcChatGetMessagesResponse{Error: &pb.RpcChatGetMessagesResponseError{Code: pb.RpcChatGetMessagesResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcChatGetMessagesRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcChatGetMessagesResponse{Error: &pb.RpcChatGetMessagesResponseError{Code: pb.RpcChatGetMessagesResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.ChatGetMessages(context.Background(), in).Marshal()
+	return resp
+}
+
+func ChatGetMessagesByIds(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcChatGetMessagesByIdsResponse{Error: &pb.RpcChatGetMessagesByIdsResponseError{Code: pb.RpcChatGetMessagesByIdsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcChatGetMessagesByIdsRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcChatGetMessagesByIdsResponse{Error: &pb.RpcChatGetMessagesByIdsResponseError{Code: pb.RpcChatGetMessagesByIdsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.ChatGetMessagesByIds(context.Background(), in).Marshal()
+	return resp
+}
+
+func ChatSubscribeLastMessages(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcChatSubscribeLastMessagesResponse{Error: &pb.RpcChatSubscribeLastMessagesResponseError{Code: pb.RpcChatSubscribeLastMessagesResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcChatSubscribeLastMessagesRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcChatSubscribeLastMessagesResponse{Error: &pb.RpcChatSubscribeLastMessagesResponseError{Code: pb.RpcChatSubscribeLastMessagesResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.ChatSubscribeLastMessages(context.Background(), in).Marshal()
+	return resp
+}
+
+func ChatUnsubscribe(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcChatUnsubscribeResponse{Error: &pb.RpcChatUnsubscribeResponseError{Code: pb.RpcChatUnsubscribeResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcChatUnsubscribeRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcChatUnsubscribeResponse{Error: &pb.RpcChatUnsubscribeResponseError{Code: pb.RpcChatUnsubscribeResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.ChatUnsubscribe(context.Background(), in).Marshal()
+	return resp
+}
+
+func ChatReadMessages(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcChatReadMessagesResponse{Error: &pb.RpcChatReadMessagesResponseError{Code: pb.RpcChatReadMessagesResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcChatReadMessagesRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcChatReadMessagesResponse{Error: &pb.RpcChatReadMessagesResponseError{Code: pb.RpcChatReadMessagesResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.ChatReadMessages(context.Background(), in).Marshal()
+	return resp
+}
+
+func ChatUnreadMessages(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcChatUnreadResponse{Error: &pb.RpcChatUnreadResponseError{Code: pb.RpcChatUnreadResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcChatUnreadRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcChatUnreadResponse{Error: &pb.RpcChatUnreadResponseError{Code: pb.RpcChatUnreadResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.ChatUnreadMessages(context.Background(), in).Marshal()
+	return resp
+}
+
+func ChatSubscribeToMessagePreviews(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcChatSubscribeToMessagePreviewsResponse{Error: &pb.RpcChatSubscribeToMessagePreviewsResponseError{Code: pb.RpcChatSubscribeToMessagePreviewsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcChatSubscribeToMessagePreviewsRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcChatSubscribeToMessagePreviewsResponse{Error: &pb.RpcChatSubscribeToMessagePreviewsResponseError{Code: pb.RpcChatSubscribeToMessagePreviewsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.ChatSubscribeToMessagePreviews(context.Background(), in).Marshal()
+	return resp
+}
+
+func ChatUnsubscribeFromMessagePreviews(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcChatUnsubscribeFromMessagePreviewsResponse{Error: &pb.RpcChatUnsubscribeFromMessagePreviewsResponseError{Code: pb.RpcChatUnsubscribeFromMessagePreviewsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcChatUnsubscribeFromMessagePreviewsRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcChatUnsubscribeFromMessagePreviewsResponse{Error: &pb.RpcChatUnsubscribeFromMessagePreviewsResponseError{Code: pb.RpcChatUnsubscribeFromMessagePreviewsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.ChatUnsubscribeFromMessagePreviews(context.Background(), in).Marshal()
+	return resp
+}
+
+func ObjectChatAdd(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcObjectChatAddResponse{Error: &pb.RpcObjectChatAddResponseError{Code: pb.RpcObjectChatAddResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcObjectChatAddRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcObjectChatAddResponse{Error: &pb.RpcObjectChatAddResponseError{Code: pb.RpcObjectChatAddResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.ObjectChatAdd(context.Background(), in).Marshal()
+	return resp
+}
+
+func ChatReadAll(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcChatReadAllResponse{Error: &pb.RpcChatReadAllResponseError{Code: pb.RpcChatReadAllResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcChatReadAllRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcChatReadAllResponse{Error: &pb.RpcChatReadAllResponseError{Code: pb.RpcChatReadAllResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.ChatReadAll(context.Background(), in).Marshal()
+	return resp
+}
+
+func AIWritingTools(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcAIWritingToolsResponse{Error: &pb.RpcAIWritingToolsResponseError{Code: pb.RpcAIWritingToolsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcAIWritingToolsRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcAIWritingToolsResponse{Error: &pb.RpcAIWritingToolsResponseError{Code: pb.RpcAIWritingToolsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.AIWritingTools(context.Background(), in).Marshal()
+	return resp
+}
+
+func AIAutofill(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcAIAutofillResponse{Error: &pb.RpcAIAutofillResponseError{Code: pb.RpcAIAutofillResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcAIAutofillRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcAIAutofillResponse{Error: &pb.RpcAIAutofillResponseError{Code: pb.RpcAIAutofillResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.AIAutofill(context.Background(), in).Marshal()
+	return resp
+}
+
+func AIListSummary(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcAIListSummaryResponse{Error: &pb.RpcAIListSummaryResponseError{Code: pb.RpcAIListSummaryResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcAIListSummaryRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcAIListSummaryResponse{Error: &pb.RpcAIListSummaryResponseError{Code: pb.RpcAIListSummaryResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.AIListSummary(context.Background(), in).Marshal()
+	return resp
+}
+
+func AIObjectCreateFromUrl(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcAIObjectCreateFromUrlResponse{Error: &pb.RpcAIObjectCreateFromUrlResponseError{Code: pb.RpcAIObjectCreateFromUrlResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcAIObjectCreateFromUrlRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcAIObjectCreateFromUrlResponse{Error: &pb.RpcAIObjectCreateFromUrlResponseError{Code: pb.RpcAIObjectCreateFromUrlResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.AIObjectCreateFromUrl(context.Background(), in).Marshal()
+	return resp
+}
+
+func PushNotificationRegisterToken(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcPushNotificationRegisterTokenResponse{Error: &pb.RpcPushNotificationRegisterTokenResponseError{Code: pb.RpcPushNotificationRegisterTokenResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcPushNotificationRegisterTokenRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcPushNotificationRegisterTokenResponse{Error: &pb.RpcPushNotificationRegisterTokenResponseError{Code: pb.RpcPushNotificationRegisterTokenResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.PushNotificationRegisterToken(context.Background(), in).Marshal()
+	return resp
+}
+
+func PushNotificationSetSpaceMode(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcPushNotificationSetSpaceModeResponse{Error: &pb.RpcPushNotificationSetSpaceModeResponseError{Code: pb.RpcPushNotificationSetSpaceModeResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcPushNotificationSetSpaceModeRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcPushNotificationSetSpaceModeResponse{Error: &pb.RpcPushNotificationSetSpaceModeResponseError{Code: pb.RpcPushNotificationSetSpaceModeResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.PushNotificationSetSpaceMode(context.Background(), in).Marshal()
+	return resp
+}
+
+func PushNotificationSetForceModeIds(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcPushNotificationSetForceModeIdsResponse{Error: &pb.RpcPushNotificationSetForceModeIdsResponseError{Code: pb.RpcPushNotificationSetForceModeIdsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcPushNotificationSetForceModeIdsRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcPushNotificationSetForceModeIdsResponse{Error: &pb.RpcPushNotificationSetForceModeIdsResponseError{Code: pb.RpcPushNotificationSetForceModeIdsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.PushNotificationSetForceModeIds(context.Background(), in).Marshal()
+	return resp
+}
+
+func PushNotificationResetIds(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcPushNotificationResetIdsResponse{Error: &pb.RpcPushNotificationResetIdsResponseError{Code: pb.RpcPushNotificationResetIdsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcPushNotificationResetIdsRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcPushNotificationResetIdsResponse{Error: &pb.RpcPushNotificationResetIdsResponseError{Code: pb.RpcPushNotificationResetIdsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.PushNotificationResetIds(context.Background(), in).Marshal()
+	return resp
+}
+
+var PanicHandler func(v interface{})
+
+func CommandAsync(cmd string, data []byte, callback func(data []byte)) {
+	go func() {
+		var cd []byte
+		switch cmd {
+		case "AppGetVersion":
+			cd = AppGetVersion(data)
+		case "AppSetDeviceState":
+			cd = AppSetDeviceState(data)
+		case "AppShutdown":
+			cd = AppShutdown(data)
+		case "WalletCreate":
+			cd = WalletCreate(data)
+		case "WalletRecover":
+			cd = WalletRecover(data)
+		case "WalletConvert":
+			cd = WalletConvert(data)
+		case "AccountLocalLinkNewChallenge":
+			cd = AccountLocalLinkNewChallenge(data)
+		case "AccountLocalLinkSolveChallenge":
+			cd = AccountLocalLinkSolveChallenge(data)
+		case "AccountLocalLinkCreateApp":
+			cd = AccountLocalLinkCreateApp(data)
+		case "AccountLocalLinkListApps":
+			cd = AccountLocalLinkListApps(data)
+		case "AccountLocalLinkRevokeApp":
+			cd = AccountLocalLinkRevokeApp(data)
+		case "WalletCreateSession":
+			cd = WalletCreateSession(data)
+		case "WalletCloseSession":
+			cd = WalletCloseSession(data)
+		case "WorkspaceCreate":
+			cd = WorkspaceCreate(data)
+		case "WorkspaceOpen":
+			cd = WorkspaceOpen(data)
+		case "WorkspaceObjectAdd":
+			cd = WorkspaceObjectAdd(data)
+		case "WorkspaceObjectListAdd":
+			cd = WorkspaceObjectListAdd(data)
+		case "WorkspaceObjectListRemove":
+			cd = WorkspaceObjectListRemove(data)
+		case "WorkspaceSelect":
+			cd = WorkspaceSelect(data)
+		case "WorkspaceGetCurrent":
+			cd = WorkspaceGetCurrent(data)
+		case "WorkspaceGetAll":
+			cd = WorkspaceGetAll(data)
+		case "WorkspaceSetInfo":
+			cd = WorkspaceSetInfo(data)
+		case "WorkspaceExport":
+			cd = WorkspaceExport(data)
+		case "AccountRecover":
+			cd = AccountRecover(data)
+		case "AccountMigrate":
+			cd = AccountMigrate(data)
+		case "AccountMigrateCancel":
+			cd = AccountMigrateCancel(data)
+		case "AccountCreate":
+			cd = AccountCreate(data)
+		case "AccountDelete":
+			cd = AccountDelete(data)
+		case "AccountRevertDeletion":
+			cd = AccountRevertDeletion(data)
+		case "AccountSelect":
+			cd = AccountSelect(data)
+		case "AccountEnableLocalNetworkSync":
+			cd = AccountEnableLocalNetworkSync(data)
+		case "AccountChangeJsonApiAddr":
+			cd = AccountChangeJsonApiAddr(data)
+		case "AccountStop":
+			cd = AccountStop(data)
+		case "AccountMove":
+			cd = AccountMove(data)
+		case "AccountConfigUpdate":
+			cd = AccountConfigUpdate(data)
+		case "AccountRecoverFromLegacyExport":
+			cd = AccountRecoverFromLegacyExport(data)
+		case "AccountChangeNetworkConfigAndRestart":
+			cd = AccountChangeNetworkConfigAndRestart(data)
+		case "SpaceDelete":
+			cd = SpaceDelete(data)
+		case "SpaceInviteGenerate":
+			cd = SpaceInviteGenerate(data)
+		case "SpaceInviteChange":
+			cd = SpaceInviteChange(data)
+		case "SpaceInviteGetCurrent":
+			cd = SpaceInviteGetCurrent(data)
+		case "SpaceInviteGetGuest":
+			cd = SpaceInviteGetGuest(data)
+		case "SpaceInviteRevoke":
+			cd = SpaceInviteRevoke(data)
+		case "SpaceInviteView":
+			cd = SpaceInviteView(data)
+		case "SpaceJoin":
+			cd = SpaceJoin(data)
+		case "SpaceJoinCancel":
+			cd = SpaceJoinCancel(data)
+		case "SpaceStopSharing":
+			cd = SpaceStopSharing(data)
+		case "SpaceRequestApprove":
+			cd = SpaceRequestApprove(data)
+		case "SpaceRequestDecline":
+			cd = SpaceRequestDecline(data)
+		case "SpaceLeaveApprove":
+			cd = SpaceLeaveApprove(data)
+		case "SpaceMakeShareable":
+			cd = SpaceMakeShareable(data)
+		case "SpaceParticipantRemove":
+			cd = SpaceParticipantRemove(data)
+		case "SpaceParticipantPermissionsChange":
+			cd = SpaceParticipantPermissionsChange(data)
+		case "SpaceParticipantRoleSet":
+			cd = SpaceParticipantRoleSet(data)
+		case "SpaceSetOrder":
+			cd = SpaceSetOrder(data)
+		case "SpaceUnsetOrder":
+			cd = SpaceUnsetOrder(data)
+		case "SpaceActivityList":
+			cd = SpaceActivityList(data)
+		case "SpaceActivitySubscribe":
+			cd = SpaceActivitySubscribe(data)
+		case "SpaceActivityUnsubscribe":
+			cd = SpaceActivityUnsubscribe(data)
+		case "SpaceActivityMarkSeen":
+			cd = SpaceActivityMarkSeen(data)
+		case "SpaceAuditLogExport":
+			cd = SpaceAuditLogExport(data)
+		case "PublishingCreate":
+			cd = PublishingCreate(data)
+		case "PublishingRemove":
+			cd = PublishingRemove(data)
+		case "PublishingList":
+			cd = PublishingList(data)
+		case "PublishingResolveUri":
+			cd = PublishingResolveUri(data)
+		case "PublishingGetStatus":
+			cd = PublishingGetStatus(data)
+		case "ObjectOpen":
+			cd = ObjectOpen(data)
+		case "ObjectRefresh":
+			cd = ObjectRefresh(data)
+		case "ObjectSyncDiagnostics":
+			cd = ObjectSyncDiagnostics(data)
+		case "ObjectForceResync":
+			cd = ObjectForceResync(data)
+		case "ObjectClose":
+			cd = ObjectClose(data)
+		case "ObjectShow":
+			cd = ObjectShow(data)
+		case "ObjectCreate":
+			cd = ObjectCreate(data)
+		case "ObjectCreateBookmark":
+			cd = ObjectCreateBookmark(data)
+		case "ObjectCreateFromUrl":
+			cd = ObjectCreateFromUrl(data)
+		case "ObjectCreateSet":
+			cd = ObjectCreateSet(data)
+		case "ObjectGraph":
+			cd = ObjectGraph(data)
+		case "ObjectSearch":
+			cd = ObjectSearch(data)
+		case "ObjectSearchWithMeta":
+			cd = ObjectSearchWithMeta(data)
+		case "ObjectFindReplacePreview":
+			cd = ObjectFindReplacePreview(data)
+		case "ObjectFindReplaceApply":
+			cd = ObjectFindReplaceApply(data)
+		case "ObjectSearchSubscribe":
+			cd = ObjectSearchSubscribe(data)
+		case "ObjectCrossSpaceSearchSubscribe":
+			cd = ObjectCrossSpaceSearchSubscribe(data)
+		case "ObjectCrossSpaceSearchUnsubscribe":
+			cd = ObjectCrossSpaceSearchUnsubscribe(data)
+		case "ObjectSubscribeIds":
+			cd = ObjectSubscribeIds(data)
+		case "ObjectGroupsSubscribe":
+			cd = ObjectGroupsSubscribe(data)
+		case "ObjectSearchUnsubscribe":
+			cd = ObjectSearchUnsubscribe(data)
+		case "ObjectSetDetails":
+			cd = ObjectSetDetails(data)
+		case "ObjectDuplicate":
+			cd = ObjectDuplicate(data)
+		case "ObjectTransferToSpace":
+			cd = ObjectTransferToSpace(data)
+		case "ObjectSetObjectType":
+			cd = ObjectSetObjectType(data)
+		case "ObjectSetLayout":
+			cd = ObjectSetLayout(data)
+		case "ObjectSetInternalFlags":
+			cd = ObjectSetInternalFlags(data)
+		case "ObjectSetIsFavorite":
+			cd = ObjectSetIsFavorite(data)
+		case "ObjectSetIsArchived":
+			cd = ObjectSetIsArchived(data)
+		case "ObjectSetSource":
+			cd = ObjectSetSource(data)
+		case "ObjectWorkspaceSetDashboard":
+			cd = ObjectWorkspaceSetDashboard(data)
+		case "ObjectListDuplicate":
+			cd = ObjectListDuplicate(data)
+		case "ObjectListDelete":
+			cd = ObjectListDelete(data)
+		case "ObjectListSetIsArchived":
+			cd = ObjectListSetIsArchived(data)
+		case "ObjectListSetIsFavorite":
+			cd = ObjectListSetIsFavorite(data)
+		case "ObjectListSetObjectType":
+			cd = ObjectListSetObjectType(data)
+		case "ObjectListSetDetails":
+			cd = ObjectListSetDetails(data)
+		case "ObjectListModifyDetailValues":
+			cd = ObjectListModifyDetailValues(data)
+		case "ObjectApplyTemplate":
+			cd = ObjectApplyTemplate(data)
+		case "ObjectToSet":
+			cd = ObjectToSet(data)
+		case "ObjectToCollection":
+			cd = ObjectToCollection(data)
+		case "ObjectShareByLink":
+			cd = ObjectShareByLink(data)
+		case "ObjectUndo":
+			cd = ObjectUndo(data)
+		case "ObjectRedo":
+			cd = ObjectRedo(data)
+		case "ObjectListExport":
+			cd = ObjectListExport(data)
+		case "ObjectExport":
+			cd = ObjectExport(data)
+		case "ObjectBookmarkFetch":
+			cd = ObjectBookmarkFetch(data)
+		case "ObjectImport":
+			cd = ObjectImport(data)
+		case "ObjectImportList":
+			cd = ObjectImportList(data)
+		case "ObjectImportNotionValidateToken":
+			cd = ObjectImportNotionValidateToken(data)
+		case "ObjectImportUseCase":
+			cd = ObjectImportUseCase(data)
+		case "ObjectImportExperience":
+			cd = ObjectImportExperience(data)
+		case "ObjectDateByTimestamp":
+			cd = ObjectDateByTimestamp(data)
+		case "ObjectDateParse":
+			cd = ObjectDateParse(data)
+		case "ObjectCollectionAdd":
+			cd = ObjectCollectionAdd(data)
+		case "ObjectCollectionRemove":
+			cd = ObjectCollectionRemove(data)
+		case "ObjectCollectionSort":
+			cd = ObjectCollectionSort(data)
+		case "ObjectCreateRelation":
+			cd = ObjectCreateRelation(data)
+		case "ObjectCreateRelationOption":
+			cd = ObjectCreateRelationOption(data)
+		case "RelationListRemoveOption":
+			cd = RelationListRemoveOption(data)
+		case "RelationOptions":
+			cd = RelationOptions(data)
+		case "RelationOptionSetOrder":
+			cd = RelationOptionSetOrder(data)
+		case "RelationListWithValue":
+			cd = RelationListWithValue(data)
+		case "ObjectRelationAdd":
+			cd = ObjectRelationAdd(data)
+		case "ObjectRelationDelete":
+			cd = ObjectRelationDelete(data)
+		case "ObjectRelationAddFeatured":
 			cd = ObjectRelationAddFeatured(data)
 		case "ObjectRelationRemoveFeatured":
 			cd = ObjectRelationRemoveFeatured(data)
@@ -7406,6 +8290,10 @@ func CommandAsync(cmd string, data []byte, callback func(data []byte)) {
 			cd = HistorySetVersion(data)
 		case "HistoryDiffVersions":
 			cd = HistoryDiffVersions(data)
+		case "HistoryGetBlockVersions":
+			cd = HistoryGetBlockVersions(data)
+		case "HistoryRestoreBlocks":
+			cd = HistoryRestoreBlocks(data)
 		case "FileSpaceOffload":
 			cd = FileSpaceOffload(data)
 		case "FileReconcile":
@@ -7418,6 +8306,8 @@ func CommandAsync(cmd string, data []byte, callback func(data []byte)) {
 			cd = FileDownload(data)
 		case "FileDiscardPreload":
 			cd = FileDiscardPreload(data)
+		case "FileImageTransform":
+			cd = FileImageTransform(data)
 		case "FileDrop":
 			cd = FileDrop(data)
 		case "FileSpaceUsage":
@@ -7430,6 +8320,10 @@ func CommandAsync(cmd string, data []byte, callback func(data []byte)) {
 			cd = FileCacheDownload(data)
 		case "FileCacheCancelDownload":
 			cd = FileCacheCancelDownload(data)
+		case "FileSetAutoOffload":
+			cd = FileSetAutoOffload(data)
+		case "FileAutoOffloadStatus":
+			cd = FileAutoOffloadStatus(data)
 		case "NavigationListObjects":
 			cd = NavigationListObjects(data)
 		case "NavigationGetObjectInfoWithLinks":
@@ -7440,6 +8334,40 @@ func CommandAsync(cmd string, data []byte, callback func(data []byte)) {
 			cd = TemplateClone(data)
 		case "TemplateExportAll":
 			cd = TemplateExportAll(data)
+		case "TemplateGetVariables":
+			cd = TemplateGetVariables(data)
+		case "TemplateIncludeSyncPreview":
+			cd = TemplateIncludeSyncPreview(data)
+		case "TemplateIncludeSyncApply":
+			cd = TemplateIncludeSyncApply(data)
+		case "JournalOpenNote":
+			cd = JournalOpenNote(data)
+		case "JournalDateSection":
+			cd = JournalDateSection(data)
+		case "JournalGetSettings":
+			cd = JournalGetSettings(data)
+		case "JournalSetSettings":
+			cd = JournalSetSettings(data)
+		case "CalendarExportView":
+			cd = CalendarExportView(data)
+		case "CalendarImport":
+			cd = CalendarImport(data)
+		case "CalendarFeedCreate":
+			cd = CalendarFeedCreate(data)
+		case "CalendarFeedList":
+			cd = CalendarFeedList(data)
+		case "CalendarFeedRemove":
+			cd = CalendarFeedRemove(data)
+		case "CalendarFeedGet":
+			cd = CalendarFeedGet(data)
+		case "WebhookCreate":
+			cd = WebhookCreate(data)
+		case "WebhookList":
+			cd = WebhookList(data)
+		case "WebhookRemove":
+			cd = WebhookRemove(data)
+		case "WebhookDeliveryList":
+			cd = WebhookDeliveryList(data)
 		case "LinkPreview":
 			cd = LinkPreview(data)
 		case "UnsplashSearch":
@@ -7450,6 +8378,12 @@ func CommandAsync(cmd string, data []byte, callback func(data []byte)) {
 			cd = GalleryDownloadManifest(data)
 		case "GalleryDownloadIndex":
 			cd = GalleryDownloadIndex(data)
+		case "WebdavStart":
+			cd = WebdavStart(data)
+		case "WebdavStop":
+			cd = WebdavStop(data)
+		case "WebdavInfo":
+			cd = WebdavInfo(data)
 		case "BlockUpload":
 			cd = BlockUpload(data)
 		case "BlockReplace":
@@ -7646,6 +8580,10 @@ func CommandAsync(cmd string, data []byte, callback func(data []byte)) {
 			cd = DebugTreeHeads(data)
 		case "DebugSpaceSummary":
 			cd = DebugSpaceSummary(data)
+		case "DebugSpaceVerify":
+			cd = DebugSpaceVerify(data)
+		case "DebugSpaceRepair":
+			cd = DebugSpaceRepair(data)
 		case "DebugStackGoroutines":
 			cd = DebugStackGoroutines(data)
 		case "DebugExportLocalstore":
@@ -7773,2138 +8711,2614 @@ func CommandAsync(cmd string, data []byte, callback func(data []byte)) {
 		default:
 			log.Errorf("unknown command type: %s\n", cmd)
 		}
-		if callback != nil {
-			callback(cd)
+		if callback != nil {
+			callback(cd)
+		}
+	}()
+}
+
+type MessageHandler interface {
+	Handle(b []byte)
+}
+
+func CommandMobile(cmd string, data []byte, callback MessageHandler) {
+	CommandAsync(cmd, data, callback.Handle)
+}
+
+type ClientCommandsHandlerProxy struct {
+	client       ClientCommandsHandler
+	interceptors []func(ctx context.Context, req any, methodName string, actualCall func(ctx context.Context, req any) (any, error)) (any, error)
+}
+
+func (h *ClientCommandsHandlerProxy) AppGetVersion(ctx context.Context, req *pb.RpcAppGetVersionRequest) *pb.RpcAppGetVersionResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.AppGetVersion(ctx, req.(*pb.RpcAppGetVersionRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "AppGetVersion", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcAppGetVersionResponse)
+}
+func (h *ClientCommandsHandlerProxy) AppSetDeviceState(ctx context.Context, req *pb.RpcAppSetDeviceStateRequest) *pb.RpcAppSetDeviceStateResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.AppSetDeviceState(ctx, req.(*pb.RpcAppSetDeviceStateRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "AppSetDeviceState", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcAppSetDeviceStateResponse)
+}
+func (h *ClientCommandsHandlerProxy) AppShutdown(ctx context.Context, req *pb.RpcAppShutdownRequest) *pb.RpcAppShutdownResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.AppShutdown(ctx, req.(*pb.RpcAppShutdownRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "AppShutdown", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcAppShutdownResponse)
+}
+func (h *ClientCommandsHandlerProxy) WalletCreate(ctx context.Context, req *pb.RpcWalletCreateRequest) *pb.RpcWalletCreateResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.WalletCreate(ctx, req.(*pb.RpcWalletCreateRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "WalletCreate", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcWalletCreateResponse)
+}
+func (h *ClientCommandsHandlerProxy) WalletRecover(ctx context.Context, req *pb.RpcWalletRecoverRequest) *pb.RpcWalletRecoverResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.WalletRecover(ctx, req.(*pb.RpcWalletRecoverRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "WalletRecover", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcWalletRecoverResponse)
+}
+func (h *ClientCommandsHandlerProxy) WalletConvert(ctx context.Context, req *pb.RpcWalletConvertRequest) *pb.RpcWalletConvertResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.WalletConvert(ctx, req.(*pb.RpcWalletConvertRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "WalletConvert", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcWalletConvertResponse)
+}
+func (h *ClientCommandsHandlerProxy) AccountLocalLinkNewChallenge(ctx context.Context, req *pb.RpcAccountLocalLinkNewChallengeRequest) *pb.RpcAccountLocalLinkNewChallengeResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.AccountLocalLinkNewChallenge(ctx, req.(*pb.RpcAccountLocalLinkNewChallengeRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "AccountLocalLinkNewChallenge", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcAccountLocalLinkNewChallengeResponse)
+}
+func (h *ClientCommandsHandlerProxy) AccountLocalLinkSolveChallenge(ctx context.Context, req *pb.RpcAccountLocalLinkSolveChallengeRequest) *pb.RpcAccountLocalLinkSolveChallengeResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.AccountLocalLinkSolveChallenge(ctx, req.(*pb.RpcAccountLocalLinkSolveChallengeRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "AccountLocalLinkSolveChallenge", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcAccountLocalLinkSolveChallengeResponse)
+}
+func (h *ClientCommandsHandlerProxy) AccountLocalLinkCreateApp(ctx context.Context, req *pb.RpcAccountLocalLinkCreateAppRequest) *pb.RpcAccountLocalLinkCreateAppResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.AccountLocalLinkCreateApp(ctx, req.(*pb.RpcAccountLocalLinkCreateAppRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "AccountLocalLinkCreateApp", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcAccountLocalLinkCreateAppResponse)
+}
+func (h *ClientCommandsHandlerProxy) AccountLocalLinkListApps(ctx context.Context, req *pb.RpcAccountLocalLinkListAppsRequest) *pb.RpcAccountLocalLinkListAppsResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.AccountLocalLinkListApps(ctx, req.(*pb.RpcAccountLocalLinkListAppsRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "AccountLocalLinkListApps", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcAccountLocalLinkListAppsResponse)
+}
+func (h *ClientCommandsHandlerProxy) AccountLocalLinkRevokeApp(ctx context.Context, req *pb.RpcAccountLocalLinkRevokeAppRequest) *pb.RpcAccountLocalLinkRevokeAppResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.AccountLocalLinkRevokeApp(ctx, req.(*pb.RpcAccountLocalLinkRevokeAppRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "AccountLocalLinkRevokeApp", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcAccountLocalLinkRevokeAppResponse)
+}
+func (h *ClientCommandsHandlerProxy) WalletCreateSession(ctx context.Context, req *pb.RpcWalletCreateSessionRequest) *pb.RpcWalletCreateSessionResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.WalletCreateSession(ctx, req.(*pb.RpcWalletCreateSessionRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "WalletCreateSession", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcWalletCreateSessionResponse)
+}
+func (h *ClientCommandsHandlerProxy) WalletCloseSession(ctx context.Context, req *pb.RpcWalletCloseSessionRequest) *pb.RpcWalletCloseSessionResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.WalletCloseSession(ctx, req.(*pb.RpcWalletCloseSessionRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "WalletCloseSession", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcWalletCloseSessionResponse)
+}
+func (h *ClientCommandsHandlerProxy) WorkspaceCreate(ctx context.Context, req *pb.RpcWorkspaceCreateRequest) *pb.RpcWorkspaceCreateResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.WorkspaceCreate(ctx, req.(*pb.RpcWorkspaceCreateRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "WorkspaceCreate", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcWorkspaceCreateResponse)
+}
+func (h *ClientCommandsHandlerProxy) WorkspaceOpen(ctx context.Context, req *pb.RpcWorkspaceOpenRequest) *pb.RpcWorkspaceOpenResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.WorkspaceOpen(ctx, req.(*pb.RpcWorkspaceOpenRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "WorkspaceOpen", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcWorkspaceOpenResponse)
+}
+func (h *ClientCommandsHandlerProxy) WorkspaceObjectAdd(ctx context.Context, req *pb.RpcWorkspaceObjectAddRequest) *pb.RpcWorkspaceObjectAddResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.WorkspaceObjectAdd(ctx, req.(*pb.RpcWorkspaceObjectAddRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "WorkspaceObjectAdd", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcWorkspaceObjectAddResponse)
+}
+func (h *ClientCommandsHandlerProxy) WorkspaceObjectListAdd(ctx context.Context, req *pb.RpcWorkspaceObjectListAddRequest) *pb.RpcWorkspaceObjectListAddResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.WorkspaceObjectListAdd(ctx, req.(*pb.RpcWorkspaceObjectListAddRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "WorkspaceObjectListAdd", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcWorkspaceObjectListAddResponse)
+}
+func (h *ClientCommandsHandlerProxy) WorkspaceObjectListRemove(ctx context.Context, req *pb.RpcWorkspaceObjectListRemoveRequest) *pb.RpcWorkspaceObjectListRemoveResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.WorkspaceObjectListRemove(ctx, req.(*pb.RpcWorkspaceObjectListRemoveRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "WorkspaceObjectListRemove", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcWorkspaceObjectListRemoveResponse)
+}
+func (h *ClientCommandsHandlerProxy) WorkspaceSelect(ctx context.Context, req *pb.RpcWorkspaceSelectRequest) *pb.RpcWorkspaceSelectResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.WorkspaceSelect(ctx, req.(*pb.RpcWorkspaceSelectRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "WorkspaceSelect", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcWorkspaceSelectResponse)
+}
+func (h *ClientCommandsHandlerProxy) WorkspaceGetCurrent(ctx context.Context, req *pb.RpcWorkspaceGetCurrentRequest) *pb.RpcWorkspaceGetCurrentResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.WorkspaceGetCurrent(ctx, req.(*pb.RpcWorkspaceGetCurrentRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "WorkspaceGetCurrent", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcWorkspaceGetCurrentResponse)
+}
+func (h *ClientCommandsHandlerProxy) WorkspaceGetAll(ctx context.Context, req *pb.RpcWorkspaceGetAllRequest) *pb.RpcWorkspaceGetAllResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.WorkspaceGetAll(ctx, req.(*pb.RpcWorkspaceGetAllRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "WorkspaceGetAll", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcWorkspaceGetAllResponse)
+}
+func (h *ClientCommandsHandlerProxy) WorkspaceSetInfo(ctx context.Context, req *pb.RpcWorkspaceSetInfoRequest) *pb.RpcWorkspaceSetInfoResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.WorkspaceSetInfo(ctx, req.(*pb.RpcWorkspaceSetInfoRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "WorkspaceSetInfo", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcWorkspaceSetInfoResponse)
+}
+func (h *ClientCommandsHandlerProxy) WorkspaceExport(ctx context.Context, req *pb.RpcWorkspaceExportRequest) *pb.RpcWorkspaceExportResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.WorkspaceExport(ctx, req.(*pb.RpcWorkspaceExportRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "WorkspaceExport", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcWorkspaceExportResponse)
+}
+func (h *ClientCommandsHandlerProxy) AccountRecover(ctx context.Context, req *pb.RpcAccountRecoverRequest) *pb.RpcAccountRecoverResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.AccountRecover(ctx, req.(*pb.RpcAccountRecoverRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "AccountRecover", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcAccountRecoverResponse)
+}
+func (h *ClientCommandsHandlerProxy) AccountMigrate(ctx context.Context, req *pb.RpcAccountMigrateRequest) *pb.RpcAccountMigrateResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.AccountMigrate(ctx, req.(*pb.RpcAccountMigrateRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "AccountMigrate", toCall)
 		}
-	}()
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcAccountMigrateResponse)
 }
-
-type MessageHandler interface {
-	Handle(b []byte)
+func (h *ClientCommandsHandlerProxy) AccountMigrateCancel(ctx context.Context, req *pb.RpcAccountMigrateCancelRequest) *pb.RpcAccountMigrateCancelResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.AccountMigrateCancel(ctx, req.(*pb.RpcAccountMigrateCancelRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "AccountMigrateCancel", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcAccountMigrateCancelResponse)
 }
-
-func CommandMobile(cmd string, data []byte, callback MessageHandler) {
-	CommandAsync(cmd, data, callback.Handle)
+func (h *ClientCommandsHandlerProxy) AccountCreate(ctx context.Context, req *pb.RpcAccountCreateRequest) *pb.RpcAccountCreateResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.AccountCreate(ctx, req.(*pb.RpcAccountCreateRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "AccountCreate", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcAccountCreateResponse)
 }
-
-type ClientCommandsHandlerProxy struct {
-	client       ClientCommandsHandler
-	interceptors []func(ctx context.Context, req any, methodName string, actualCall func(ctx context.Context, req any) (any, error)) (any, error)
+func (h *ClientCommandsHandlerProxy) AccountDelete(ctx context.Context, req *pb.RpcAccountDeleteRequest) *pb.RpcAccountDeleteResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.AccountDelete(ctx, req.(*pb.RpcAccountDeleteRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "AccountDelete", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcAccountDeleteResponse)
 }
-
-func (h *ClientCommandsHandlerProxy) AppGetVersion(ctx context.Context, req *pb.RpcAppGetVersionRequest) *pb.RpcAppGetVersionResponse {
+func (h *ClientCommandsHandlerProxy) AccountRevertDeletion(ctx context.Context, req *pb.RpcAccountRevertDeletionRequest) *pb.RpcAccountRevertDeletionResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.AppGetVersion(ctx, req.(*pb.RpcAppGetVersionRequest)), nil
+		return h.client.AccountRevertDeletion(ctx, req.(*pb.RpcAccountRevertDeletionRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "AppGetVersion", toCall)
+			return currentInterceptor(ctx, req, "AccountRevertDeletion", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcAppGetVersionResponse)
+	return call.(*pb.RpcAccountRevertDeletionResponse)
 }
-func (h *ClientCommandsHandlerProxy) AppSetDeviceState(ctx context.Context, req *pb.RpcAppSetDeviceStateRequest) *pb.RpcAppSetDeviceStateResponse {
+func (h *ClientCommandsHandlerProxy) AccountSelect(ctx context.Context, req *pb.RpcAccountSelectRequest) *pb.RpcAccountSelectResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.AppSetDeviceState(ctx, req.(*pb.RpcAppSetDeviceStateRequest)), nil
+		return h.client.AccountSelect(ctx, req.(*pb.RpcAccountSelectRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "AppSetDeviceState", toCall)
+			return currentInterceptor(ctx, req, "AccountSelect", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcAppSetDeviceStateResponse)
+	return call.(*pb.RpcAccountSelectResponse)
 }
-func (h *ClientCommandsHandlerProxy) AppShutdown(ctx context.Context, req *pb.RpcAppShutdownRequest) *pb.RpcAppShutdownResponse {
+func (h *ClientCommandsHandlerProxy) AccountEnableLocalNetworkSync(ctx context.Context, req *pb.RpcAccountEnableLocalNetworkSyncRequest) *pb.RpcAccountEnableLocalNetworkSyncResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.AppShutdown(ctx, req.(*pb.RpcAppShutdownRequest)), nil
+		return h.client.AccountEnableLocalNetworkSync(ctx, req.(*pb.RpcAccountEnableLocalNetworkSyncRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "AppShutdown", toCall)
+			return currentInterceptor(ctx, req, "AccountEnableLocalNetworkSync", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcAppShutdownResponse)
+	return call.(*pb.RpcAccountEnableLocalNetworkSyncResponse)
 }
-func (h *ClientCommandsHandlerProxy) WalletCreate(ctx context.Context, req *pb.RpcWalletCreateRequest) *pb.RpcWalletCreateResponse {
+func (h *ClientCommandsHandlerProxy) AccountChangeJsonApiAddr(ctx context.Context, req *pb.RpcAccountChangeJsonApiAddrRequest) *pb.RpcAccountChangeJsonApiAddrResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.WalletCreate(ctx, req.(*pb.RpcWalletCreateRequest)), nil
+		return h.client.AccountChangeJsonApiAddr(ctx, req.(*pb.RpcAccountChangeJsonApiAddrRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "WalletCreate", toCall)
+			return currentInterceptor(ctx, req, "AccountChangeJsonApiAddr", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcWalletCreateResponse)
+	return call.(*pb.RpcAccountChangeJsonApiAddrResponse)
 }
-func (h *ClientCommandsHandlerProxy) WalletRecover(ctx context.Context, req *pb.RpcWalletRecoverRequest) *pb.RpcWalletRecoverResponse {
+func (h *ClientCommandsHandlerProxy) AccountStop(ctx context.Context, req *pb.RpcAccountStopRequest) *pb.RpcAccountStopResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.WalletRecover(ctx, req.(*pb.RpcWalletRecoverRequest)), nil
+		return h.client.AccountStop(ctx, req.(*pb.RpcAccountStopRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "WalletRecover", toCall)
+			return currentInterceptor(ctx, req, "AccountStop", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcWalletRecoverResponse)
+	return call.(*pb.RpcAccountStopResponse)
 }
-func (h *ClientCommandsHandlerProxy) WalletConvert(ctx context.Context, req *pb.RpcWalletConvertRequest) *pb.RpcWalletConvertResponse {
+func (h *ClientCommandsHandlerProxy) AccountMove(ctx context.Context, req *pb.RpcAccountMoveRequest) *pb.RpcAccountMoveResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.WalletConvert(ctx, req.(*pb.RpcWalletConvertRequest)), nil
+		return h.client.AccountMove(ctx, req.(*pb.RpcAccountMoveRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "WalletConvert", toCall)
+			return currentInterceptor(ctx, req, "AccountMove", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcWalletConvertResponse)
+	return call.(*pb.RpcAccountMoveResponse)
 }
-func (h *ClientCommandsHandlerProxy) AccountLocalLinkNewChallenge(ctx context.Context, req *pb.RpcAccountLocalLinkNewChallengeRequest) *pb.RpcAccountLocalLinkNewChallengeResponse {
+func (h *ClientCommandsHandlerProxy) AccountConfigUpdate(ctx context.Context, req *pb.RpcAccountConfigUpdateRequest) *pb.RpcAccountConfigUpdateResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.AccountLocalLinkNewChallenge(ctx, req.(*pb.RpcAccountLocalLinkNewChallengeRequest)), nil
+		return h.client.AccountConfigUpdate(ctx, req.(*pb.RpcAccountConfigUpdateRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "AccountLocalLinkNewChallenge", toCall)
+			return currentInterceptor(ctx, req, "AccountConfigUpdate", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcAccountLocalLinkNewChallengeResponse)
+	return call.(*pb.RpcAccountConfigUpdateResponse)
 }
-func (h *ClientCommandsHandlerProxy) AccountLocalLinkSolveChallenge(ctx context.Context, req *pb.RpcAccountLocalLinkSolveChallengeRequest) *pb.RpcAccountLocalLinkSolveChallengeResponse {
+func (h *ClientCommandsHandlerProxy) AccountRecoverFromLegacyExport(ctx context.Context, req *pb.RpcAccountRecoverFromLegacyExportRequest) *pb.RpcAccountRecoverFromLegacyExportResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.AccountLocalLinkSolveChallenge(ctx, req.(*pb.RpcAccountLocalLinkSolveChallengeRequest)), nil
+		return h.client.AccountRecoverFromLegacyExport(ctx, req.(*pb.RpcAccountRecoverFromLegacyExportRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "AccountLocalLinkSolveChallenge", toCall)
+			return currentInterceptor(ctx, req, "AccountRecoverFromLegacyExport", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcAccountLocalLinkSolveChallengeResponse)
+	return call.(*pb.RpcAccountRecoverFromLegacyExportResponse)
 }
-func (h *ClientCommandsHandlerProxy) AccountLocalLinkCreateApp(ctx context.Context, req *pb.RpcAccountLocalLinkCreateAppRequest) *pb.RpcAccountLocalLinkCreateAppResponse {
+func (h *ClientCommandsHandlerProxy) AccountChangeNetworkConfigAndRestart(ctx context.Context, req *pb.RpcAccountChangeNetworkConfigAndRestartRequest) *pb.RpcAccountChangeNetworkConfigAndRestartResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.AccountLocalLinkCreateApp(ctx, req.(*pb.RpcAccountLocalLinkCreateAppRequest)), nil
+		return h.client.AccountChangeNetworkConfigAndRestart(ctx, req.(*pb.RpcAccountChangeNetworkConfigAndRestartRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "AccountLocalLinkCreateApp", toCall)
+			return currentInterceptor(ctx, req, "AccountChangeNetworkConfigAndRestart", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcAccountLocalLinkCreateAppResponse)
+	return call.(*pb.RpcAccountChangeNetworkConfigAndRestartResponse)
 }
-func (h *ClientCommandsHandlerProxy) AccountLocalLinkListApps(ctx context.Context, req *pb.RpcAccountLocalLinkListAppsRequest) *pb.RpcAccountLocalLinkListAppsResponse {
+func (h *ClientCommandsHandlerProxy) SpaceDelete(ctx context.Context, req *pb.RpcSpaceDeleteRequest) *pb.RpcSpaceDeleteResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.AccountLocalLinkListApps(ctx, req.(*pb.RpcAccountLocalLinkListAppsRequest)), nil
+		return h.client.SpaceDelete(ctx, req.(*pb.RpcSpaceDeleteRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "AccountLocalLinkListApps", toCall)
+			return currentInterceptor(ctx, req, "SpaceDelete", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcAccountLocalLinkListAppsResponse)
+	return call.(*pb.RpcSpaceDeleteResponse)
 }
-func (h *ClientCommandsHandlerProxy) AccountLocalLinkRevokeApp(ctx context.Context, req *pb.RpcAccountLocalLinkRevokeAppRequest) *pb.RpcAccountLocalLinkRevokeAppResponse {
+func (h *ClientCommandsHandlerProxy) SpaceInviteGenerate(ctx context.Context, req *pb.RpcSpaceInviteGenerateRequest) *pb.RpcSpaceInviteGenerateResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.AccountLocalLinkRevokeApp(ctx, req.(*pb.RpcAccountLocalLinkRevokeAppRequest)), nil
+		return h.client.SpaceInviteGenerate(ctx, req.(*pb.RpcSpaceInviteGenerateRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "AccountLocalLinkRevokeApp", toCall)
+			return currentInterceptor(ctx, req, "SpaceInviteGenerate", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcAccountLocalLinkRevokeAppResponse)
+	return call.(*pb.RpcSpaceInviteGenerateResponse)
 }
-func (h *ClientCommandsHandlerProxy) WalletCreateSession(ctx context.Context, req *pb.RpcWalletCreateSessionRequest) *pb.RpcWalletCreateSessionResponse {
+func (h *ClientCommandsHandlerProxy) SpaceInviteChange(ctx context.Context, req *pb.RpcSpaceInviteChangeRequest) *pb.RpcSpaceInviteChangeResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.WalletCreateSession(ctx, req.(*pb.RpcWalletCreateSessionRequest)), nil
+		return h.client.SpaceInviteChange(ctx, req.(*pb.RpcSpaceInviteChangeRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "WalletCreateSession", toCall)
+			return currentInterceptor(ctx, req, "SpaceInviteChange", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcWalletCreateSessionResponse)
+	return call.(*pb.RpcSpaceInviteChangeResponse)
 }
-func (h *ClientCommandsHandlerProxy) WalletCloseSession(ctx context.Context, req *pb.RpcWalletCloseSessionRequest) *pb.RpcWalletCloseSessionResponse {
+func (h *ClientCommandsHandlerProxy) SpaceInviteGetCurrent(ctx context.Context, req *pb.RpcSpaceInviteGetCurrentRequest) *pb.RpcSpaceInviteGetCurrentResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.SpaceInviteGetCurrent(ctx, req.(*pb.RpcSpaceInviteGetCurrentRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "SpaceInviteGetCurrent", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcSpaceInviteGetCurrentResponse)
+}
+func (h *ClientCommandsHandlerProxy) SpaceInviteGetGuest(ctx context.Context, req *pb.RpcSpaceInviteGetGuestRequest) *pb.RpcSpaceInviteGetGuestResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.SpaceInviteGetGuest(ctx, req.(*pb.RpcSpaceInviteGetGuestRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "SpaceInviteGetGuest", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcSpaceInviteGetGuestResponse)
+}
+func (h *ClientCommandsHandlerProxy) SpaceInviteRevoke(ctx context.Context, req *pb.RpcSpaceInviteRevokeRequest) *pb.RpcSpaceInviteRevokeResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.SpaceInviteRevoke(ctx, req.(*pb.RpcSpaceInviteRevokeRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "SpaceInviteRevoke", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcSpaceInviteRevokeResponse)
+}
+func (h *ClientCommandsHandlerProxy) SpaceInviteView(ctx context.Context, req *pb.RpcSpaceInviteViewRequest) *pb.RpcSpaceInviteViewResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.SpaceInviteView(ctx, req.(*pb.RpcSpaceInviteViewRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "SpaceInviteView", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcSpaceInviteViewResponse)
+}
+func (h *ClientCommandsHandlerProxy) SpaceJoin(ctx context.Context, req *pb.RpcSpaceJoinRequest) *pb.RpcSpaceJoinResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.SpaceJoin(ctx, req.(*pb.RpcSpaceJoinRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "SpaceJoin", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcSpaceJoinResponse)
+}
+func (h *ClientCommandsHandlerProxy) SpaceJoinCancel(ctx context.Context, req *pb.RpcSpaceJoinCancelRequest) *pb.RpcSpaceJoinCancelResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.SpaceJoinCancel(ctx, req.(*pb.RpcSpaceJoinCancelRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "SpaceJoinCancel", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcSpaceJoinCancelResponse)
+}
+func (h *ClientCommandsHandlerProxy) SpaceStopSharing(ctx context.Context, req *pb.RpcSpaceStopSharingRequest) *pb.RpcSpaceStopSharingResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.WalletCloseSession(ctx, req.(*pb.RpcWalletCloseSessionRequest)), nil
+		return h.client.SpaceStopSharing(ctx, req.(*pb.RpcSpaceStopSharingRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "WalletCloseSession", toCall)
+			return currentInterceptor(ctx, req, "SpaceStopSharing", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcWalletCloseSessionResponse)
+	return call.(*pb.RpcSpaceStopSharingResponse)
 }
-func (h *ClientCommandsHandlerProxy) WorkspaceCreate(ctx context.Context, req *pb.RpcWorkspaceCreateRequest) *pb.RpcWorkspaceCreateResponse {
+func (h *ClientCommandsHandlerProxy) SpaceRequestApprove(ctx context.Context, req *pb.RpcSpaceRequestApproveRequest) *pb.RpcSpaceRequestApproveResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.WorkspaceCreate(ctx, req.(*pb.RpcWorkspaceCreateRequest)), nil
+		return h.client.SpaceRequestApprove(ctx, req.(*pb.RpcSpaceRequestApproveRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "WorkspaceCreate", toCall)
+			return currentInterceptor(ctx, req, "SpaceRequestApprove", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcWorkspaceCreateResponse)
+	return call.(*pb.RpcSpaceRequestApproveResponse)
 }
-func (h *ClientCommandsHandlerProxy) WorkspaceOpen(ctx context.Context, req *pb.RpcWorkspaceOpenRequest) *pb.RpcWorkspaceOpenResponse {
+func (h *ClientCommandsHandlerProxy) SpaceRequestDecline(ctx context.Context, req *pb.RpcSpaceRequestDeclineRequest) *pb.RpcSpaceRequestDeclineResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.WorkspaceOpen(ctx, req.(*pb.RpcWorkspaceOpenRequest)), nil
+		return h.client.SpaceRequestDecline(ctx, req.(*pb.RpcSpaceRequestDeclineRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "WorkspaceOpen", toCall)
+			return currentInterceptor(ctx, req, "SpaceRequestDecline", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcWorkspaceOpenResponse)
+	return call.(*pb.RpcSpaceRequestDeclineResponse)
 }
-func (h *ClientCommandsHandlerProxy) WorkspaceObjectAdd(ctx context.Context, req *pb.RpcWorkspaceObjectAddRequest) *pb.RpcWorkspaceObjectAddResponse {
+func (h *ClientCommandsHandlerProxy) SpaceLeaveApprove(ctx context.Context, req *pb.RpcSpaceLeaveApproveRequest) *pb.RpcSpaceLeaveApproveResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.WorkspaceObjectAdd(ctx, req.(*pb.RpcWorkspaceObjectAddRequest)), nil
+		return h.client.SpaceLeaveApprove(ctx, req.(*pb.RpcSpaceLeaveApproveRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "WorkspaceObjectAdd", toCall)
+			return currentInterceptor(ctx, req, "SpaceLeaveApprove", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcWorkspaceObjectAddResponse)
+	return call.(*pb.RpcSpaceLeaveApproveResponse)
 }
-func (h *ClientCommandsHandlerProxy) WorkspaceObjectListAdd(ctx context.Context, req *pb.RpcWorkspaceObjectListAddRequest) *pb.RpcWorkspaceObjectListAddResponse {
+func (h *ClientCommandsHandlerProxy) SpaceMakeShareable(ctx context.Context, req *pb.RpcSpaceMakeShareableRequest) *pb.RpcSpaceMakeShareableResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.WorkspaceObjectListAdd(ctx, req.(*pb.RpcWorkspaceObjectListAddRequest)), nil
+		return h.client.SpaceMakeShareable(ctx, req.(*pb.RpcSpaceMakeShareableRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "WorkspaceObjectListAdd", toCall)
+			return currentInterceptor(ctx, req, "SpaceMakeShareable", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcWorkspaceObjectListAddResponse)
+	return call.(*pb.RpcSpaceMakeShareableResponse)
 }
-func (h *ClientCommandsHandlerProxy) WorkspaceObjectListRemove(ctx context.Context, req *pb.RpcWorkspaceObjectListRemoveRequest) *pb.RpcWorkspaceObjectListRemoveResponse {
+func (h *ClientCommandsHandlerProxy) SpaceParticipantRemove(ctx context.Context, req *pb.RpcSpaceParticipantRemoveRequest) *pb.RpcSpaceParticipantRemoveResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.WorkspaceObjectListRemove(ctx, req.(*pb.RpcWorkspaceObjectListRemoveRequest)), nil
+		return h.client.SpaceParticipantRemove(ctx, req.(*pb.RpcSpaceParticipantRemoveRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "WorkspaceObjectListRemove", toCall)
+			return currentInterceptor(ctx, req, "SpaceParticipantRemove", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcWorkspaceObjectListRemoveResponse)
+	return call.(*pb.RpcSpaceParticipantRemoveResponse)
 }
-func (h *ClientCommandsHandlerProxy) WorkspaceSelect(ctx context.Context, req *pb.RpcWorkspaceSelectRequest) *pb.RpcWorkspaceSelectResponse {
+func (h *ClientCommandsHandlerProxy) SpaceParticipantPermissionsChange(ctx context.Context, req *pb.RpcSpaceParticipantPermissionsChangeRequest) *pb.RpcSpaceParticipantPermissionsChangeResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.WorkspaceSelect(ctx, req.(*pb.RpcWorkspaceSelectRequest)), nil
+		return h.client.SpaceParticipantPermissionsChange(ctx, req.(*pb.RpcSpaceParticipantPermissionsChangeRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "WorkspaceSelect", toCall)
+			return currentInterceptor(ctx, req, "SpaceParticipantPermissionsChange", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcWorkspaceSelectResponse)
+	return call.(*pb.RpcSpaceParticipantPermissionsChangeResponse)
 }
-func (h *ClientCommandsHandlerProxy) WorkspaceGetCurrent(ctx context.Context, req *pb.RpcWorkspaceGetCurrentRequest) *pb.RpcWorkspaceGetCurrentResponse {
+func (h *ClientCommandsHandlerProxy) SpaceParticipantRoleSet(ctx context.Context, req *pb.RpcSpaceParticipantRoleSetRequest) *pb.RpcSpaceParticipantRoleSetResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.WorkspaceGetCurrent(ctx, req.(*pb.RpcWorkspaceGetCurrentRequest)), nil
+		return h.client.SpaceParticipantRoleSet(ctx, req.(*pb.RpcSpaceParticipantRoleSetRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "WorkspaceGetCurrent", toCall)
+			return currentInterceptor(ctx, req, "SpaceParticipantRoleSet", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcWorkspaceGetCurrentResponse)
+	return call.(*pb.RpcSpaceParticipantRoleSetResponse)
 }
-func (h *ClientCommandsHandlerProxy) WorkspaceGetAll(ctx context.Context, req *pb.RpcWorkspaceGetAllRequest) *pb.RpcWorkspaceGetAllResponse {
+func (h *ClientCommandsHandlerProxy) SpaceSetOrder(ctx context.Context, req *pb.RpcSpaceSetOrderRequest) *pb.RpcSpaceSetOrderResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.WorkspaceGetAll(ctx, req.(*pb.RpcWorkspaceGetAllRequest)), nil
+		return h.client.SpaceSetOrder(ctx, req.(*pb.RpcSpaceSetOrderRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "WorkspaceGetAll", toCall)
+			return currentInterceptor(ctx, req, "SpaceSetOrder", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcWorkspaceGetAllResponse)
+	return call.(*pb.RpcSpaceSetOrderResponse)
 }
-func (h *ClientCommandsHandlerProxy) WorkspaceSetInfo(ctx context.Context, req *pb.RpcWorkspaceSetInfoRequest) *pb.RpcWorkspaceSetInfoResponse {
+func (h *ClientCommandsHandlerProxy) SpaceUnsetOrder(ctx context.Context, req *pb.RpcSpaceUnsetOrderRequest) *pb.RpcSpaceUnsetOrderResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.WorkspaceSetInfo(ctx, req.(*pb.RpcWorkspaceSetInfoRequest)), nil
+		return h.client.SpaceUnsetOrder(ctx, req.(*pb.RpcSpaceUnsetOrderRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "WorkspaceSetInfo", toCall)
+			return currentInterceptor(ctx, req, "SpaceUnsetOrder", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcWorkspaceSetInfoResponse)
+	return call.(*pb.RpcSpaceUnsetOrderResponse)
 }
-func (h *ClientCommandsHandlerProxy) WorkspaceExport(ctx context.Context, req *pb.RpcWorkspaceExportRequest) *pb.RpcWorkspaceExportResponse {
+func (h *ClientCommandsHandlerProxy) SpaceActivityList(ctx context.Context, req *pb.RpcSpaceActivityListRequest) *pb.RpcSpaceActivityListResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.WorkspaceExport(ctx, req.(*pb.RpcWorkspaceExportRequest)), nil
+		return h.client.SpaceActivityList(ctx, req.(*pb.RpcSpaceActivityListRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "WorkspaceExport", toCall)
+			return currentInterceptor(ctx, req, "SpaceActivityList", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcWorkspaceExportResponse)
+	return call.(*pb.RpcSpaceActivityListResponse)
 }
-func (h *ClientCommandsHandlerProxy) AccountRecover(ctx context.Context, req *pb.RpcAccountRecoverRequest) *pb.RpcAccountRecoverResponse {
+func (h *ClientCommandsHandlerProxy) SpaceActivitySubscribe(ctx context.Context, req *pb.RpcSpaceActivitySubscribeRequest) *pb.RpcSpaceActivitySubscribeResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.AccountRecover(ctx, req.(*pb.RpcAccountRecoverRequest)), nil
+		return h.client.SpaceActivitySubscribe(ctx, req.(*pb.RpcSpaceActivitySubscribeRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "AccountRecover", toCall)
+			return currentInterceptor(ctx, req, "SpaceActivitySubscribe", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcAccountRecoverResponse)
+	return call.(*pb.RpcSpaceActivitySubscribeResponse)
 }
-func (h *ClientCommandsHandlerProxy) AccountMigrate(ctx context.Context, req *pb.RpcAccountMigrateRequest) *pb.RpcAccountMigrateResponse {
+func (h *ClientCommandsHandlerProxy) SpaceActivityUnsubscribe(ctx context.Context, req *pb.RpcSpaceActivityUnsubscribeRequest) *pb.RpcSpaceActivityUnsubscribeResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.AccountMigrate(ctx, req.(*pb.RpcAccountMigrateRequest)), nil
+		return h.client.SpaceActivityUnsubscribe(ctx, req.(*pb.RpcSpaceActivityUnsubscribeRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "AccountMigrate", toCall)
+			return currentInterceptor(ctx, req, "SpaceActivityUnsubscribe", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcAccountMigrateResponse)
+	return call.(*pb.RpcSpaceActivityUnsubscribeResponse)
 }
-func (h *ClientCommandsHandlerProxy) AccountMigrateCancel(ctx context.Context, req *pb.RpcAccountMigrateCancelRequest) *pb.RpcAccountMigrateCancelResponse {
+func (h *ClientCommandsHandlerProxy) SpaceActivityMarkSeen(ctx context.Context, req *pb.RpcSpaceActivityMarkSeenRequest) *pb.RpcSpaceActivityMarkSeenResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.AccountMigrateCancel(ctx, req.(*pb.RpcAccountMigrateCancelRequest)), nil
+		return h.client.SpaceActivityMarkSeen(ctx, req.(*pb.RpcSpaceActivityMarkSeenRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "AccountMigrateCancel", toCall)
+			return currentInterceptor(ctx, req, "SpaceActivityMarkSeen", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcAccountMigrateCancelResponse)
+	return call.(*pb.RpcSpaceActivityMarkSeenResponse)
 }
-func (h *ClientCommandsHandlerProxy) AccountCreate(ctx context.Context, req *pb.RpcAccountCreateRequest) *pb.RpcAccountCreateResponse {
+func (h *ClientCommandsHandlerProxy) SpaceAuditLogExport(ctx context.Context, req *pb.RpcSpaceAuditLogExportRequest) *pb.RpcSpaceAuditLogExportResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.AccountCreate(ctx, req.(*pb.RpcAccountCreateRequest)), nil
+		return h.client.SpaceAuditLogExport(ctx, req.(*pb.RpcSpaceAuditLogExportRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "AccountCreate", toCall)
+			return currentInterceptor(ctx, req, "SpaceAuditLogExport", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcAccountCreateResponse)
+	return call.(*pb.RpcSpaceAuditLogExportResponse)
 }
-func (h *ClientCommandsHandlerProxy) AccountDelete(ctx context.Context, req *pb.RpcAccountDeleteRequest) *pb.RpcAccountDeleteResponse {
+func (h *ClientCommandsHandlerProxy) PublishingCreate(ctx context.Context, req *pb.RpcPublishingCreateRequest) *pb.RpcPublishingCreateResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.AccountDelete(ctx, req.(*pb.RpcAccountDeleteRequest)), nil
+		return h.client.PublishingCreate(ctx, req.(*pb.RpcPublishingCreateRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "AccountDelete", toCall)
+			return currentInterceptor(ctx, req, "PublishingCreate", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcAccountDeleteResponse)
+	return call.(*pb.RpcPublishingCreateResponse)
 }
-func (h *ClientCommandsHandlerProxy) AccountRevertDeletion(ctx context.Context, req *pb.RpcAccountRevertDeletionRequest) *pb.RpcAccountRevertDeletionResponse {
+func (h *ClientCommandsHandlerProxy) PublishingRemove(ctx context.Context, req *pb.RpcPublishingRemoveRequest) *pb.RpcPublishingRemoveResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.AccountRevertDeletion(ctx, req.(*pb.RpcAccountRevertDeletionRequest)), nil
+		return h.client.PublishingRemove(ctx, req.(*pb.RpcPublishingRemoveRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "AccountRevertDeletion", toCall)
+			return currentInterceptor(ctx, req, "PublishingRemove", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcAccountRevertDeletionResponse)
+	return call.(*pb.RpcPublishingRemoveResponse)
 }
-func (h *ClientCommandsHandlerProxy) AccountSelect(ctx context.Context, req *pb.RpcAccountSelectRequest) *pb.RpcAccountSelectResponse {
+func (h *ClientCommandsHandlerProxy) PublishingList(ctx context.Context, req *pb.RpcPublishingListRequest) *pb.RpcPublishingListResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.AccountSelect(ctx, req.(*pb.RpcAccountSelectRequest)), nil
+		return h.client.PublishingList(ctx, req.(*pb.RpcPublishingListRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "AccountSelect", toCall)
+			return currentInterceptor(ctx, req, "PublishingList", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcAccountSelectResponse)
+	return call.(*pb.RpcPublishingListResponse)
 }
-func (h *ClientCommandsHandlerProxy) AccountEnableLocalNetworkSync(ctx context.Context, req *pb.RpcAccountEnableLocalNetworkSyncRequest) *pb.RpcAccountEnableLocalNetworkSyncResponse {
+func (h *ClientCommandsHandlerProxy) PublishingResolveUri(ctx context.Context, req *pb.RpcPublishingResolveUriRequest) *pb.RpcPublishingResolveUriResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.AccountEnableLocalNetworkSync(ctx, req.(*pb.RpcAccountEnableLocalNetworkSyncRequest)), nil
+		return h.client.PublishingResolveUri(ctx, req.(*pb.RpcPublishingResolveUriRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "AccountEnableLocalNetworkSync", toCall)
+			return currentInterceptor(ctx, req, "PublishingResolveUri", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcAccountEnableLocalNetworkSyncResponse)
+	return call.(*pb.RpcPublishingResolveUriResponse)
 }
-func (h *ClientCommandsHandlerProxy) AccountChangeJsonApiAddr(ctx context.Context, req *pb.RpcAccountChangeJsonApiAddrRequest) *pb.RpcAccountChangeJsonApiAddrResponse {
+func (h *ClientCommandsHandlerProxy) PublishingGetStatus(ctx context.Context, req *pb.RpcPublishingGetStatusRequest) *pb.RpcPublishingGetStatusResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.AccountChangeJsonApiAddr(ctx, req.(*pb.RpcAccountChangeJsonApiAddrRequest)), nil
+		return h.client.PublishingGetStatus(ctx, req.(*pb.RpcPublishingGetStatusRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "AccountChangeJsonApiAddr", toCall)
+			return currentInterceptor(ctx, req, "PublishingGetStatus", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcAccountChangeJsonApiAddrResponse)
+	return call.(*pb.RpcPublishingGetStatusResponse)
 }
-func (h *ClientCommandsHandlerProxy) AccountStop(ctx context.Context, req *pb.RpcAccountStopRequest) *pb.RpcAccountStopResponse {
+func (h *ClientCommandsHandlerProxy) ObjectOpen(ctx context.Context, req *pb.RpcObjectOpenRequest) *pb.RpcObjectOpenResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.AccountStop(ctx, req.(*pb.RpcAccountStopRequest)), nil
+		return h.client.ObjectOpen(ctx, req.(*pb.RpcObjectOpenRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "AccountStop", toCall)
+			return currentInterceptor(ctx, req, "ObjectOpen", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcAccountStopResponse)
+	return call.(*pb.RpcObjectOpenResponse)
 }
-func (h *ClientCommandsHandlerProxy) AccountMove(ctx context.Context, req *pb.RpcAccountMoveRequest) *pb.RpcAccountMoveResponse {
+func (h *ClientCommandsHandlerProxy) ObjectRefresh(ctx context.Context, req *pb.RpcObjectRefreshRequest) *pb.RpcObjectRefreshResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.AccountMove(ctx, req.(*pb.RpcAccountMoveRequest)), nil
+		return h.client.ObjectRefresh(ctx, req.(*pb.RpcObjectRefreshRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "AccountMove", toCall)
+			return currentInterceptor(ctx, req, "ObjectRefresh", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcAccountMoveResponse)
+	return call.(*pb.RpcObjectRefreshResponse)
 }
-func (h *ClientCommandsHandlerProxy) AccountConfigUpdate(ctx context.Context, req *pb.RpcAccountConfigUpdateRequest) *pb.RpcAccountConfigUpdateResponse {
+func (h *ClientCommandsHandlerProxy) ObjectSyncDiagnostics(ctx context.Context, req *pb.RpcObjectSyncDiagnosticsRequest) *pb.RpcObjectSyncDiagnosticsResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.AccountConfigUpdate(ctx, req.(*pb.RpcAccountConfigUpdateRequest)), nil
+		return h.client.ObjectSyncDiagnostics(ctx, req.(*pb.RpcObjectSyncDiagnosticsRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "AccountConfigUpdate", toCall)
+			return currentInterceptor(ctx, req, "ObjectSyncDiagnostics", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcAccountConfigUpdateResponse)
+	return call.(*pb.RpcObjectSyncDiagnosticsResponse)
 }
-func (h *ClientCommandsHandlerProxy) AccountRecoverFromLegacyExport(ctx context.Context, req *pb.RpcAccountRecoverFromLegacyExportRequest) *pb.RpcAccountRecoverFromLegacyExportResponse {
+func (h *ClientCommandsHandlerProxy) ObjectForceResync(ctx context.Context, req *pb.RpcObjectForceResyncRequest) *pb.RpcObjectForceResyncResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.AccountRecoverFromLegacyExport(ctx, req.(*pb.RpcAccountRecoverFromLegacyExportRequest)), nil
+		return h.client.ObjectForceResync(ctx, req.(*pb.RpcObjectForceResyncRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "AccountRecoverFromLegacyExport", toCall)
+			return currentInterceptor(ctx, req, "ObjectForceResync", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcAccountRecoverFromLegacyExportResponse)
+	return call.(*pb.RpcObjectForceResyncResponse)
 }
-func (h *ClientCommandsHandlerProxy) AccountChangeNetworkConfigAndRestart(ctx context.Context, req *pb.RpcAccountChangeNetworkConfigAndRestartRequest) *pb.RpcAccountChangeNetworkConfigAndRestartResponse {
+func (h *ClientCommandsHandlerProxy) ObjectClose(ctx context.Context, req *pb.RpcObjectCloseRequest) *pb.RpcObjectCloseResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.AccountChangeNetworkConfigAndRestart(ctx, req.(*pb.RpcAccountChangeNetworkConfigAndRestartRequest)), nil
+		return h.client.ObjectClose(ctx, req.(*pb.RpcObjectCloseRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "AccountChangeNetworkConfigAndRestart", toCall)
+			return currentInterceptor(ctx, req, "ObjectClose", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcAccountChangeNetworkConfigAndRestartResponse)
+	return call.(*pb.RpcObjectCloseResponse)
 }
-func (h *ClientCommandsHandlerProxy) SpaceDelete(ctx context.Context, req *pb.RpcSpaceDeleteRequest) *pb.RpcSpaceDeleteResponse {
+func (h *ClientCommandsHandlerProxy) ObjectShow(ctx context.Context, req *pb.RpcObjectShowRequest) *pb.RpcObjectShowResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.SpaceDelete(ctx, req.(*pb.RpcSpaceDeleteRequest)), nil
+		return h.client.ObjectShow(ctx, req.(*pb.RpcObjectShowRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "SpaceDelete", toCall)
+			return currentInterceptor(ctx, req, "ObjectShow", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcSpaceDeleteResponse)
+	return call.(*pb.RpcObjectShowResponse)
 }
-func (h *ClientCommandsHandlerProxy) SpaceInviteGenerate(ctx context.Context, req *pb.RpcSpaceInviteGenerateRequest) *pb.RpcSpaceInviteGenerateResponse {
+func (h *ClientCommandsHandlerProxy) ObjectCreate(ctx context.Context, req *pb.RpcObjectCreateRequest) *pb.RpcObjectCreateResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.SpaceInviteGenerate(ctx, req.(*pb.RpcSpaceInviteGenerateRequest)), nil
+		return h.client.ObjectCreate(ctx, req.(*pb.RpcObjectCreateRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "SpaceInviteGenerate", toCall)
+			return currentInterceptor(ctx, req, "ObjectCreate", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcSpaceInviteGenerateResponse)
+	return call.(*pb.RpcObjectCreateResponse)
 }
-func (h *ClientCommandsHandlerProxy) SpaceInviteChange(ctx context.Context, req *pb.RpcSpaceInviteChangeRequest) *pb.RpcSpaceInviteChangeResponse {
+func (h *ClientCommandsHandlerProxy) ObjectCreateBookmark(ctx context.Context, req *pb.RpcObjectCreateBookmarkRequest) *pb.RpcObjectCreateBookmarkResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.SpaceInviteChange(ctx, req.(*pb.RpcSpaceInviteChangeRequest)), nil
+		return h.client.ObjectCreateBookmark(ctx, req.(*pb.RpcObjectCreateBookmarkRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "SpaceInviteChange", toCall)
+			return currentInterceptor(ctx, req, "ObjectCreateBookmark", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcSpaceInviteChangeResponse)
+	return call.(*pb.RpcObjectCreateBookmarkResponse)
 }
-func (h *ClientCommandsHandlerProxy) SpaceInviteGetCurrent(ctx context.Context, req *pb.RpcSpaceInviteGetCurrentRequest) *pb.RpcSpaceInviteGetCurrentResponse {
+func (h *ClientCommandsHandlerProxy) ObjectCreateFromUrl(ctx context.Context, req *pb.RpcObjectCreateFromUrlRequest) *pb.RpcObjectCreateFromUrlResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.SpaceInviteGetCurrent(ctx, req.(*pb.RpcSpaceInviteGetCurrentRequest)), nil
+		return h.client.ObjectCreateFromUrl(ctx, req.(*pb.RpcObjectCreateFromUrlRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "SpaceInviteGetCurrent", toCall)
+			return currentInterceptor(ctx, req, "ObjectCreateFromUrl", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcSpaceInviteGetCurrentResponse)
+	return call.(*pb.RpcObjectCreateFromUrlResponse)
 }
-func (h *ClientCommandsHandlerProxy) SpaceInviteGetGuest(ctx context.Context, req *pb.RpcSpaceInviteGetGuestRequest) *pb.RpcSpaceInviteGetGuestResponse {
+func (h *ClientCommandsHandlerProxy) ObjectCreateSet(ctx context.Context, req *pb.RpcObjectCreateSetRequest) *pb.RpcObjectCreateSetResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.SpaceInviteGetGuest(ctx, req.(*pb.RpcSpaceInviteGetGuestRequest)), nil
+		return h.client.ObjectCreateSet(ctx, req.(*pb.RpcObjectCreateSetRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "SpaceInviteGetGuest", toCall)
+			return currentInterceptor(ctx, req, "ObjectCreateSet", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcSpaceInviteGetGuestResponse)
+	return call.(*pb.RpcObjectCreateSetResponse)
 }
-func (h *ClientCommandsHandlerProxy) SpaceInviteRevoke(ctx context.Context, req *pb.RpcSpaceInviteRevokeRequest) *pb.RpcSpaceInviteRevokeResponse {
+func (h *ClientCommandsHandlerProxy) ObjectGraph(ctx context.Context, req *pb.RpcObjectGraphRequest) *pb.RpcObjectGraphResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.SpaceInviteRevoke(ctx, req.(*pb.RpcSpaceInviteRevokeRequest)), nil
+		return h.client.ObjectGraph(ctx, req.(*pb.RpcObjectGraphRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "SpaceInviteRevoke", toCall)
+			return currentInterceptor(ctx, req, "ObjectGraph", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcSpaceInviteRevokeResponse)
+	return call.(*pb.RpcObjectGraphResponse)
 }
-func (h *ClientCommandsHandlerProxy) SpaceInviteView(ctx context.Context, req *pb.RpcSpaceInviteViewRequest) *pb.RpcSpaceInviteViewResponse {
+func (h *ClientCommandsHandlerProxy) ObjectSearch(ctx context.Context, req *pb.RpcObjectSearchRequest) *pb.RpcObjectSearchResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.SpaceInviteView(ctx, req.(*pb.RpcSpaceInviteViewRequest)), nil
+		return h.client.ObjectSearch(ctx, req.(*pb.RpcObjectSearchRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "SpaceInviteView", toCall)
+			return currentInterceptor(ctx, req, "ObjectSearch", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcSpaceInviteViewResponse)
+	return call.(*pb.RpcObjectSearchResponse)
 }
-func (h *ClientCommandsHandlerProxy) SpaceJoin(ctx context.Context, req *pb.RpcSpaceJoinRequest) *pb.RpcSpaceJoinResponse {
+func (h *ClientCommandsHandlerProxy) ObjectSearchWithMeta(ctx context.Context, req *pb.RpcObjectSearchWithMetaRequest) *pb.RpcObjectSearchWithMetaResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.SpaceJoin(ctx, req.(*pb.RpcSpaceJoinRequest)), nil
+		return h.client.ObjectSearchWithMeta(ctx, req.(*pb.RpcObjectSearchWithMetaRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "SpaceJoin", toCall)
+			return currentInterceptor(ctx, req, "ObjectSearchWithMeta", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcSpaceJoinResponse)
+	return call.(*pb.RpcObjectSearchWithMetaResponse)
 }
-func (h *ClientCommandsHandlerProxy) SpaceJoinCancel(ctx context.Context, req *pb.RpcSpaceJoinCancelRequest) *pb.RpcSpaceJoinCancelResponse {
+func (h *ClientCommandsHandlerProxy) ObjectFindReplacePreview(ctx context.Context, req *pb.RpcObjectFindReplacePreviewRequest) *pb.RpcObjectFindReplacePreviewResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.SpaceJoinCancel(ctx, req.(*pb.RpcSpaceJoinCancelRequest)), nil
+		return h.client.ObjectFindReplacePreview(ctx, req.(*pb.RpcObjectFindReplacePreviewRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "SpaceJoinCancel", toCall)
+			return currentInterceptor(ctx, req, "ObjectFindReplacePreview", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcSpaceJoinCancelResponse)
+	return call.(*pb.RpcObjectFindReplacePreviewResponse)
 }
-func (h *ClientCommandsHandlerProxy) SpaceStopSharing(ctx context.Context, req *pb.RpcSpaceStopSharingRequest) *pb.RpcSpaceStopSharingResponse {
+func (h *ClientCommandsHandlerProxy) ObjectFindReplaceApply(ctx context.Context, req *pb.RpcObjectFindReplaceApplyRequest) *pb.RpcObjectFindReplaceApplyResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.SpaceStopSharing(ctx, req.(*pb.RpcSpaceStopSharingRequest)), nil
+		return h.client.ObjectFindReplaceApply(ctx, req.(*pb.RpcObjectFindReplaceApplyRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "SpaceStopSharing", toCall)
+			return currentInterceptor(ctx, req, "ObjectFindReplaceApply", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcSpaceStopSharingResponse)
+	return call.(*pb.RpcObjectFindReplaceApplyResponse)
 }
-func (h *ClientCommandsHandlerProxy) SpaceRequestApprove(ctx context.Context, req *pb.RpcSpaceRequestApproveRequest) *pb.RpcSpaceRequestApproveResponse {
+func (h *ClientCommandsHandlerProxy) ObjectSearchSubscribe(ctx context.Context, req *pb.RpcObjectSearchSubscribeRequest) *pb.RpcObjectSearchSubscribeResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.SpaceRequestApprove(ctx, req.(*pb.RpcSpaceRequestApproveRequest)), nil
+		return h.client.ObjectSearchSubscribe(ctx, req.(*pb.RpcObjectSearchSubscribeRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "SpaceRequestApprove", toCall)
+			return currentInterceptor(ctx, req, "ObjectSearchSubscribe", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcSpaceRequestApproveResponse)
+	return call.(*pb.RpcObjectSearchSubscribeResponse)
 }
-func (h *ClientCommandsHandlerProxy) SpaceRequestDecline(ctx context.Context, req *pb.RpcSpaceRequestDeclineRequest) *pb.RpcSpaceRequestDeclineResponse {
+func (h *ClientCommandsHandlerProxy) ObjectCrossSpaceSearchSubscribe(ctx context.Context, req *pb.RpcObjectCrossSpaceSearchSubscribeRequest) *pb.RpcObjectCrossSpaceSearchSubscribeResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.SpaceRequestDecline(ctx, req.(*pb.RpcSpaceRequestDeclineRequest)), nil
+		return h.client.ObjectCrossSpaceSearchSubscribe(ctx, req.(*pb.RpcObjectCrossSpaceSearchSubscribeRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "SpaceRequestDecline", toCall)
+			return currentInterceptor(ctx, req, "ObjectCrossSpaceSearchSubscribe", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcSpaceRequestDeclineResponse)
+	return call.(*pb.RpcObjectCrossSpaceSearchSubscribeResponse)
 }
-func (h *ClientCommandsHandlerProxy) SpaceLeaveApprove(ctx context.Context, req *pb.RpcSpaceLeaveApproveRequest) *pb.RpcSpaceLeaveApproveResponse {
+func (h *ClientCommandsHandlerProxy) ObjectCrossSpaceSearchUnsubscribe(ctx context.Context, req *pb.RpcObjectCrossSpaceSearchUnsubscribeRequest) *pb.RpcObjectCrossSpaceSearchUnsubscribeResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.SpaceLeaveApprove(ctx, req.(*pb.RpcSpaceLeaveApproveRequest)), nil
+		return h.client.ObjectCrossSpaceSearchUnsubscribe(ctx, req.(*pb.RpcObjectCrossSpaceSearchUnsubscribeRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "SpaceLeaveApprove", toCall)
+			return currentInterceptor(ctx, req, "ObjectCrossSpaceSearchUnsubscribe", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcSpaceLeaveApproveResponse)
+	return call.(*pb.RpcObjectCrossSpaceSearchUnsubscribeResponse)
 }
-func (h *ClientCommandsHandlerProxy) SpaceMakeShareable(ctx context.Context, req *pb.RpcSpaceMakeShareableRequest) *pb.RpcSpaceMakeShareableResponse {
+func (h *ClientCommandsHandlerProxy) ObjectSubscribeIds(ctx context.Context, req *pb.RpcObjectSubscribeIdsRequest) *pb.RpcObjectSubscribeIdsResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.SpaceMakeShareable(ctx, req.(*pb.RpcSpaceMakeShareableRequest)), nil
+		return h.client.ObjectSubscribeIds(ctx, req.(*pb.RpcObjectSubscribeIdsRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "SpaceMakeShareable", toCall)
+			return currentInterceptor(ctx, req, "ObjectSubscribeIds", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcSpaceMakeShareableResponse)
+	return call.(*pb.RpcObjectSubscribeIdsResponse)
 }
-func (h *ClientCommandsHandlerProxy) SpaceParticipantRemove(ctx context.Context, req *pb.RpcSpaceParticipantRemoveRequest) *pb.RpcSpaceParticipantRemoveResponse {
+func (h *ClientCommandsHandlerProxy) ObjectGroupsSubscribe(ctx context.Context, req *pb.RpcObjectGroupsSubscribeRequest) *pb.RpcObjectGroupsSubscribeResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.SpaceParticipantRemove(ctx, req.(*pb.RpcSpaceParticipantRemoveRequest)), nil
+		return h.client.ObjectGroupsSubscribe(ctx, req.(*pb.RpcObjectGroupsSubscribeRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "SpaceParticipantRemove", toCall)
+			return currentInterceptor(ctx, req, "ObjectGroupsSubscribe", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcSpaceParticipantRemoveResponse)
+	return call.(*pb.RpcObjectGroupsSubscribeResponse)
 }
-func (h *ClientCommandsHandlerProxy) SpaceParticipantPermissionsChange(ctx context.Context, req *pb.RpcSpaceParticipantPermissionsChangeRequest) *pb.RpcSpaceParticipantPermissionsChangeResponse {
+func (h *ClientCommandsHandlerProxy) ObjectSearchUnsubscribe(ctx context.Context, req *pb.RpcObjectSearchUnsubscribeRequest) *pb.RpcObjectSearchUnsubscribeResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.SpaceParticipantPermissionsChange(ctx, req.(*pb.RpcSpaceParticipantPermissionsChangeRequest)), nil
+		return h.client.ObjectSearchUnsubscribe(ctx, req.(*pb.RpcObjectSearchUnsubscribeRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "SpaceParticipantPermissionsChange", toCall)
+			return currentInterceptor(ctx, req, "ObjectSearchUnsubscribe", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcSpaceParticipantPermissionsChangeResponse)
+	return call.(*pb.RpcObjectSearchUnsubscribeResponse)
 }
-func (h *ClientCommandsHandlerProxy) SpaceSetOrder(ctx context.Context, req *pb.RpcSpaceSetOrderRequest) *pb.RpcSpaceSetOrderResponse {
+func (h *ClientCommandsHandlerProxy) ObjectSetDetails(ctx context.Context, req *pb.RpcObjectSetDetailsRequest) *pb.RpcObjectSetDetailsResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.SpaceSetOrder(ctx, req.(*pb.RpcSpaceSetOrderRequest)), nil
+		return h.client.ObjectSetDetails(ctx, req.(*pb.RpcObjectSetDetailsRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "SpaceSetOrder", toCall)
+			return currentInterceptor(ctx, req, "ObjectSetDetails", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcSpaceSetOrderResponse)
+	return call.(*pb.RpcObjectSetDetailsResponse)
 }
-func (h *ClientCommandsHandlerProxy) SpaceUnsetOrder(ctx context.Context, req *pb.RpcSpaceUnsetOrderRequest) *pb.RpcSpaceUnsetOrderResponse {
+func (h *ClientCommandsHandlerProxy) ObjectDuplicate(ctx context.Context, req *pb.RpcObjectDuplicateRequest) *pb.RpcObjectDuplicateResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.SpaceUnsetOrder(ctx, req.(*pb.RpcSpaceUnsetOrderRequest)), nil
+		return h.client.ObjectDuplicate(ctx, req.(*pb.RpcObjectDuplicateRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "SpaceUnsetOrder", toCall)
+			return currentInterceptor(ctx, req, "ObjectDuplicate", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcSpaceUnsetOrderResponse)
+	return call.(*pb.RpcObjectDuplicateResponse)
 }
-func (h *ClientCommandsHandlerProxy) PublishingCreate(ctx context.Context, req *pb.RpcPublishingCreateRequest) *pb.RpcPublishingCreateResponse {
+func (h *ClientCommandsHandlerProxy) ObjectTransferToSpace(ctx context.Context, req *pb.RpcObjectTransferToSpaceRequest) *pb.RpcObjectTransferToSpaceResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.PublishingCreate(ctx, req.(*pb.RpcPublishingCreateRequest)), nil
+		return h.client.ObjectTransferToSpace(ctx, req.(*pb.RpcObjectTransferToSpaceRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "PublishingCreate", toCall)
+			return currentInterceptor(ctx, req, "ObjectTransferToSpace", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcPublishingCreateResponse)
+	return call.(*pb.RpcObjectTransferToSpaceResponse)
 }
-func (h *ClientCommandsHandlerProxy) PublishingRemove(ctx context.Context, req *pb.RpcPublishingRemoveRequest) *pb.RpcPublishingRemoveResponse {
+func (h *ClientCommandsHandlerProxy) ObjectSetObjectType(ctx context.Context, req *pb.RpcObjectSetObjectTypeRequest) *pb.RpcObjectSetObjectTypeResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.PublishingRemove(ctx, req.(*pb.RpcPublishingRemoveRequest)), nil
+		return h.client.ObjectSetObjectType(ctx, req.(*pb.RpcObjectSetObjectTypeRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "PublishingRemove", toCall)
+			return currentInterceptor(ctx, req, "ObjectSetObjectType", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcPublishingRemoveResponse)
+	return call.(*pb.RpcObjectSetObjectTypeResponse)
 }
-func (h *ClientCommandsHandlerProxy) PublishingList(ctx context.Context, req *pb.RpcPublishingListRequest) *pb.RpcPublishingListResponse {
+func (h *ClientCommandsHandlerProxy) ObjectSetLayout(ctx context.Context, req *pb.RpcObjectSetLayoutRequest) *pb.RpcObjectSetLayoutResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.PublishingList(ctx, req.(*pb.RpcPublishingListRequest)), nil
+		return h.client.ObjectSetLayout(ctx, req.(*pb.RpcObjectSetLayoutRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "PublishingList", toCall)
+			return currentInterceptor(ctx, req, "ObjectSetLayout", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcPublishingListResponse)
+	return call.(*pb.RpcObjectSetLayoutResponse)
 }
-func (h *ClientCommandsHandlerProxy) PublishingResolveUri(ctx context.Context, req *pb.RpcPublishingResolveUriRequest) *pb.RpcPublishingResolveUriResponse {
+func (h *ClientCommandsHandlerProxy) ObjectSetInternalFlags(ctx context.Context, req *pb.RpcObjectSetInternalFlagsRequest) *pb.RpcObjectSetInternalFlagsResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.PublishingResolveUri(ctx, req.(*pb.RpcPublishingResolveUriRequest)), nil
+		return h.client.ObjectSetInternalFlags(ctx, req.(*pb.RpcObjectSetInternalFlagsRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "PublishingResolveUri", toCall)
+			return currentInterceptor(ctx, req, "ObjectSetInternalFlags", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcPublishingResolveUriResponse)
+	return call.(*pb.RpcObjectSetInternalFlagsResponse)
 }
-func (h *ClientCommandsHandlerProxy) PublishingGetStatus(ctx context.Context, req *pb.RpcPublishingGetStatusRequest) *pb.RpcPublishingGetStatusResponse {
+func (h *ClientCommandsHandlerProxy) ObjectSetIsFavorite(ctx context.Context, req *pb.RpcObjectSetIsFavoriteRequest) *pb.RpcObjectSetIsFavoriteResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.PublishingGetStatus(ctx, req.(*pb.RpcPublishingGetStatusRequest)), nil
+		return h.client.ObjectSetIsFavorite(ctx, req.(*pb.RpcObjectSetIsFavoriteRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "PublishingGetStatus", toCall)
+			return currentInterceptor(ctx, req, "ObjectSetIsFavorite", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcPublishingGetStatusResponse)
+	return call.(*pb.RpcObjectSetIsFavoriteResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectOpen(ctx context.Context, req *pb.RpcObjectOpenRequest) *pb.RpcObjectOpenResponse {
+func (h *ClientCommandsHandlerProxy) ObjectSetIsArchived(ctx context.Context, req *pb.RpcObjectSetIsArchivedRequest) *pb.RpcObjectSetIsArchivedResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectOpen(ctx, req.(*pb.RpcObjectOpenRequest)), nil
+		return h.client.ObjectSetIsArchived(ctx, req.(*pb.RpcObjectSetIsArchivedRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectOpen", toCall)
+			return currentInterceptor(ctx, req, "ObjectSetIsArchived", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectOpenResponse)
+	return call.(*pb.RpcObjectSetIsArchivedResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectRefresh(ctx context.Context, req *pb.RpcObjectRefreshRequest) *pb.RpcObjectRefreshResponse {
+func (h *ClientCommandsHandlerProxy) ObjectSetSource(ctx context.Context, req *pb.RpcObjectSetSourceRequest) *pb.RpcObjectSetSourceResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectRefresh(ctx, req.(*pb.RpcObjectRefreshRequest)), nil
+		return h.client.ObjectSetSource(ctx, req.(*pb.RpcObjectSetSourceRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectRefresh", toCall)
+			return currentInterceptor(ctx, req, "ObjectSetSource", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectRefreshResponse)
+	return call.(*pb.RpcObjectSetSourceResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectClose(ctx context.Context, req *pb.RpcObjectCloseRequest) *pb.RpcObjectCloseResponse {
+func (h *ClientCommandsHandlerProxy) ObjectWorkspaceSetDashboard(ctx context.Context, req *pb.RpcObjectWorkspaceSetDashboardRequest) *pb.RpcObjectWorkspaceSetDashboardResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectClose(ctx, req.(*pb.RpcObjectCloseRequest)), nil
+		return h.client.ObjectWorkspaceSetDashboard(ctx, req.(*pb.RpcObjectWorkspaceSetDashboardRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectClose", toCall)
+			return currentInterceptor(ctx, req, "ObjectWorkspaceSetDashboard", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectCloseResponse)
+	return call.(*pb.RpcObjectWorkspaceSetDashboardResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectShow(ctx context.Context, req *pb.RpcObjectShowRequest) *pb.RpcObjectShowResponse {
+func (h *ClientCommandsHandlerProxy) ObjectListDuplicate(ctx context.Context, req *pb.RpcObjectListDuplicateRequest) *pb.RpcObjectListDuplicateResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectShow(ctx, req.(*pb.RpcObjectShowRequest)), nil
+		return h.client.ObjectListDuplicate(ctx, req.(*pb.RpcObjectListDuplicateRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectShow", toCall)
+			return currentInterceptor(ctx, req, "ObjectListDuplicate", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectShowResponse)
+	return call.(*pb.RpcObjectListDuplicateResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectCreate(ctx context.Context, req *pb.RpcObjectCreateRequest) *pb.RpcObjectCreateResponse {
+func (h *ClientCommandsHandlerProxy) ObjectListDelete(ctx context.Context, req *pb.RpcObjectListDeleteRequest) *pb.RpcObjectListDeleteResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectCreate(ctx, req.(*pb.RpcObjectCreateRequest)), nil
+		return h.client.ObjectListDelete(ctx, req.(*pb.RpcObjectListDeleteRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectCreate", toCall)
+			return currentInterceptor(ctx, req, "ObjectListDelete", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectCreateResponse)
+	return call.(*pb.RpcObjectListDeleteResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectCreateBookmark(ctx context.Context, req *pb.RpcObjectCreateBookmarkRequest) *pb.RpcObjectCreateBookmarkResponse {
+func (h *ClientCommandsHandlerProxy) ObjectListSetIsArchived(ctx context.Context, req *pb.RpcObjectListSetIsArchivedRequest) *pb.RpcObjectListSetIsArchivedResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectCreateBookmark(ctx, req.(*pb.RpcObjectCreateBookmarkRequest)), nil
+		return h.client.ObjectListSetIsArchived(ctx, req.(*pb.RpcObjectListSetIsArchivedRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectCreateBookmark", toCall)
+			return currentInterceptor(ctx, req, "ObjectListSetIsArchived", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectCreateBookmarkResponse)
+	return call.(*pb.RpcObjectListSetIsArchivedResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectCreateFromUrl(ctx context.Context, req *pb.RpcObjectCreateFromUrlRequest) *pb.RpcObjectCreateFromUrlResponse {
+func (h *ClientCommandsHandlerProxy) ObjectListSetIsFavorite(ctx context.Context, req *pb.RpcObjectListSetIsFavoriteRequest) *pb.RpcObjectListSetIsFavoriteResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectCreateFromUrl(ctx, req.(*pb.RpcObjectCreateFromUrlRequest)), nil
+		return h.client.ObjectListSetIsFavorite(ctx, req.(*pb.RpcObjectListSetIsFavoriteRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectCreateFromUrl", toCall)
+			return currentInterceptor(ctx, req, "ObjectListSetIsFavorite", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectCreateFromUrlResponse)
+	return call.(*pb.RpcObjectListSetIsFavoriteResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectCreateSet(ctx context.Context, req *pb.RpcObjectCreateSetRequest) *pb.RpcObjectCreateSetResponse {
+func (h *ClientCommandsHandlerProxy) ObjectListSetObjectType(ctx context.Context, req *pb.RpcObjectListSetObjectTypeRequest) *pb.RpcObjectListSetObjectTypeResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectCreateSet(ctx, req.(*pb.RpcObjectCreateSetRequest)), nil
+		return h.client.ObjectListSetObjectType(ctx, req.(*pb.RpcObjectListSetObjectTypeRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectCreateSet", toCall)
+			return currentInterceptor(ctx, req, "ObjectListSetObjectType", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectCreateSetResponse)
+	return call.(*pb.RpcObjectListSetObjectTypeResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectGraph(ctx context.Context, req *pb.RpcObjectGraphRequest) *pb.RpcObjectGraphResponse {
+func (h *ClientCommandsHandlerProxy) ObjectListSetDetails(ctx context.Context, req *pb.RpcObjectListSetDetailsRequest) *pb.RpcObjectListSetDetailsResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectGraph(ctx, req.(*pb.RpcObjectGraphRequest)), nil
+		return h.client.ObjectListSetDetails(ctx, req.(*pb.RpcObjectListSetDetailsRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectGraph", toCall)
+			return currentInterceptor(ctx, req, "ObjectListSetDetails", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectGraphResponse)
+	return call.(*pb.RpcObjectListSetDetailsResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectSearch(ctx context.Context, req *pb.RpcObjectSearchRequest) *pb.RpcObjectSearchResponse {
+func (h *ClientCommandsHandlerProxy) ObjectListModifyDetailValues(ctx context.Context, req *pb.RpcObjectListModifyDetailValuesRequest) *pb.RpcObjectListModifyDetailValuesResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectSearch(ctx, req.(*pb.RpcObjectSearchRequest)), nil
+		return h.client.ObjectListModifyDetailValues(ctx, req.(*pb.RpcObjectListModifyDetailValuesRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectSearch", toCall)
+			return currentInterceptor(ctx, req, "ObjectListModifyDetailValues", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectSearchResponse)
+	return call.(*pb.RpcObjectListModifyDetailValuesResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectSearchWithMeta(ctx context.Context, req *pb.RpcObjectSearchWithMetaRequest) *pb.RpcObjectSearchWithMetaResponse {
+func (h *ClientCommandsHandlerProxy) ObjectApplyTemplate(ctx context.Context, req *pb.RpcObjectApplyTemplateRequest) *pb.RpcObjectApplyTemplateResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectSearchWithMeta(ctx, req.(*pb.RpcObjectSearchWithMetaRequest)), nil
+		return h.client.ObjectApplyTemplate(ctx, req.(*pb.RpcObjectApplyTemplateRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectSearchWithMeta", toCall)
+			return currentInterceptor(ctx, req, "ObjectApplyTemplate", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectSearchWithMetaResponse)
+	return call.(*pb.RpcObjectApplyTemplateResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectSearchSubscribe(ctx context.Context, req *pb.RpcObjectSearchSubscribeRequest) *pb.RpcObjectSearchSubscribeResponse {
+func (h *ClientCommandsHandlerProxy) ObjectToSet(ctx context.Context, req *pb.RpcObjectToSetRequest) *pb.RpcObjectToSetResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectSearchSubscribe(ctx, req.(*pb.RpcObjectSearchSubscribeRequest)), nil
+		return h.client.ObjectToSet(ctx, req.(*pb.RpcObjectToSetRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectSearchSubscribe", toCall)
+			return currentInterceptor(ctx, req, "ObjectToSet", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectSearchSubscribeResponse)
+	return call.(*pb.RpcObjectToSetResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectCrossSpaceSearchSubscribe(ctx context.Context, req *pb.RpcObjectCrossSpaceSearchSubscribeRequest) *pb.RpcObjectCrossSpaceSearchSubscribeResponse {
+func (h *ClientCommandsHandlerProxy) ObjectToCollection(ctx context.Context, req *pb.RpcObjectToCollectionRequest) *pb.RpcObjectToCollectionResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectCrossSpaceSearchSubscribe(ctx, req.(*pb.RpcObjectCrossSpaceSearchSubscribeRequest)), nil
+		return h.client.ObjectToCollection(ctx, req.(*pb.RpcObjectToCollectionRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectCrossSpaceSearchSubscribe", toCall)
+			return currentInterceptor(ctx, req, "ObjectToCollection", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectCrossSpaceSearchSubscribeResponse)
+	return call.(*pb.RpcObjectToCollectionResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectCrossSpaceSearchUnsubscribe(ctx context.Context, req *pb.RpcObjectCrossSpaceSearchUnsubscribeRequest) *pb.RpcObjectCrossSpaceSearchUnsubscribeResponse {
+func (h *ClientCommandsHandlerProxy) ObjectShareByLink(ctx context.Context, req *pb.RpcObjectShareByLinkRequest) *pb.RpcObjectShareByLinkResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectCrossSpaceSearchUnsubscribe(ctx, req.(*pb.RpcObjectCrossSpaceSearchUnsubscribeRequest)), nil
+		return h.client.ObjectShareByLink(ctx, req.(*pb.RpcObjectShareByLinkRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectCrossSpaceSearchUnsubscribe", toCall)
+			return currentInterceptor(ctx, req, "ObjectShareByLink", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectCrossSpaceSearchUnsubscribeResponse)
+	return call.(*pb.RpcObjectShareByLinkResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectSubscribeIds(ctx context.Context, req *pb.RpcObjectSubscribeIdsRequest) *pb.RpcObjectSubscribeIdsResponse {
+func (h *ClientCommandsHandlerProxy) ObjectUndo(ctx context.Context, req *pb.RpcObjectUndoRequest) *pb.RpcObjectUndoResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectSubscribeIds(ctx, req.(*pb.RpcObjectSubscribeIdsRequest)), nil
+		return h.client.ObjectUndo(ctx, req.(*pb.RpcObjectUndoRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectSubscribeIds", toCall)
+			return currentInterceptor(ctx, req, "ObjectUndo", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectSubscribeIdsResponse)
+	return call.(*pb.RpcObjectUndoResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectGroupsSubscribe(ctx context.Context, req *pb.RpcObjectGroupsSubscribeRequest) *pb.RpcObjectGroupsSubscribeResponse {
+func (h *ClientCommandsHandlerProxy) ObjectRedo(ctx context.Context, req *pb.RpcObjectRedoRequest) *pb.RpcObjectRedoResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectGroupsSubscribe(ctx, req.(*pb.RpcObjectGroupsSubscribeRequest)), nil
+		return h.client.ObjectRedo(ctx, req.(*pb.RpcObjectRedoRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectGroupsSubscribe", toCall)
+			return currentInterceptor(ctx, req, "ObjectRedo", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectGroupsSubscribeResponse)
+	return call.(*pb.RpcObjectRedoResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectSearchUnsubscribe(ctx context.Context, req *pb.RpcObjectSearchUnsubscribeRequest) *pb.RpcObjectSearchUnsubscribeResponse {
+func (h *ClientCommandsHandlerProxy) ObjectListExport(ctx context.Context, req *pb.RpcObjectListExportRequest) *pb.RpcObjectListExportResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectSearchUnsubscribe(ctx, req.(*pb.RpcObjectSearchUnsubscribeRequest)), nil
+		return h.client.ObjectListExport(ctx, req.(*pb.RpcObjectListExportRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectSearchUnsubscribe", toCall)
+			return currentInterceptor(ctx, req, "ObjectListExport", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectSearchUnsubscribeResponse)
+	return call.(*pb.RpcObjectListExportResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectSetDetails(ctx context.Context, req *pb.RpcObjectSetDetailsRequest) *pb.RpcObjectSetDetailsResponse {
+func (h *ClientCommandsHandlerProxy) ObjectExport(ctx context.Context, req *pb.RpcObjectExportRequest) *pb.RpcObjectExportResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectSetDetails(ctx, req.(*pb.RpcObjectSetDetailsRequest)), nil
+		return h.client.ObjectExport(ctx, req.(*pb.RpcObjectExportRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectSetDetails", toCall)
+			return currentInterceptor(ctx, req, "ObjectExport", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectSetDetailsResponse)
+	return call.(*pb.RpcObjectExportResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectDuplicate(ctx context.Context, req *pb.RpcObjectDuplicateRequest) *pb.RpcObjectDuplicateResponse {
+func (h *ClientCommandsHandlerProxy) ObjectBookmarkFetch(ctx context.Context, req *pb.RpcObjectBookmarkFetchRequest) *pb.RpcObjectBookmarkFetchResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectDuplicate(ctx, req.(*pb.RpcObjectDuplicateRequest)), nil
+		return h.client.ObjectBookmarkFetch(ctx, req.(*pb.RpcObjectBookmarkFetchRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectDuplicate", toCall)
+			return currentInterceptor(ctx, req, "ObjectBookmarkFetch", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectDuplicateResponse)
+	return call.(*pb.RpcObjectBookmarkFetchResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectSetObjectType(ctx context.Context, req *pb.RpcObjectSetObjectTypeRequest) *pb.RpcObjectSetObjectTypeResponse {
+func (h *ClientCommandsHandlerProxy) ObjectImport(ctx context.Context, req *pb.RpcObjectImportRequest) *pb.RpcObjectImportResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectSetObjectType(ctx, req.(*pb.RpcObjectSetObjectTypeRequest)), nil
+		return h.client.ObjectImport(ctx, req.(*pb.RpcObjectImportRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectSetObjectType", toCall)
+			return currentInterceptor(ctx, req, "ObjectImport", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectSetObjectTypeResponse)
+	return call.(*pb.RpcObjectImportResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectSetLayout(ctx context.Context, req *pb.RpcObjectSetLayoutRequest) *pb.RpcObjectSetLayoutResponse {
+func (h *ClientCommandsHandlerProxy) ObjectImportList(ctx context.Context, req *pb.RpcObjectImportListRequest) *pb.RpcObjectImportListResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectSetLayout(ctx, req.(*pb.RpcObjectSetLayoutRequest)), nil
+		return h.client.ObjectImportList(ctx, req.(*pb.RpcObjectImportListRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectSetLayout", toCall)
+			return currentInterceptor(ctx, req, "ObjectImportList", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectSetLayoutResponse)
+	return call.(*pb.RpcObjectImportListResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectSetInternalFlags(ctx context.Context, req *pb.RpcObjectSetInternalFlagsRequest) *pb.RpcObjectSetInternalFlagsResponse {
+func (h *ClientCommandsHandlerProxy) ObjectImportNotionValidateToken(ctx context.Context, req *pb.RpcObjectImportNotionValidateTokenRequest) *pb.RpcObjectImportNotionValidateTokenResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectSetInternalFlags(ctx, req.(*pb.RpcObjectSetInternalFlagsRequest)), nil
+		return h.client.ObjectImportNotionValidateToken(ctx, req.(*pb.RpcObjectImportNotionValidateTokenRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectSetInternalFlags", toCall)
+			return currentInterceptor(ctx, req, "ObjectImportNotionValidateToken", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectSetInternalFlagsResponse)
+	return call.(*pb.RpcObjectImportNotionValidateTokenResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectSetIsFavorite(ctx context.Context, req *pb.RpcObjectSetIsFavoriteRequest) *pb.RpcObjectSetIsFavoriteResponse {
+func (h *ClientCommandsHandlerProxy) ObjectImportUseCase(ctx context.Context, req *pb.RpcObjectImportUseCaseRequest) *pb.RpcObjectImportUseCaseResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectSetIsFavorite(ctx, req.(*pb.RpcObjectSetIsFavoriteRequest)), nil
+		return h.client.ObjectImportUseCase(ctx, req.(*pb.RpcObjectImportUseCaseRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectSetIsFavorite", toCall)
+			return currentInterceptor(ctx, req, "ObjectImportUseCase", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectSetIsFavoriteResponse)
+	return call.(*pb.RpcObjectImportUseCaseResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectSetIsArchived(ctx context.Context, req *pb.RpcObjectSetIsArchivedRequest) *pb.RpcObjectSetIsArchivedResponse {
+func (h *ClientCommandsHandlerProxy) ObjectImportExperience(ctx context.Context, req *pb.RpcObjectImportExperienceRequest) *pb.RpcObjectImportExperienceResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectSetIsArchived(ctx, req.(*pb.RpcObjectSetIsArchivedRequest)), nil
+		return h.client.ObjectImportExperience(ctx, req.(*pb.RpcObjectImportExperienceRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectSetIsArchived", toCall)
+			return currentInterceptor(ctx, req, "ObjectImportExperience", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectSetIsArchivedResponse)
+	return call.(*pb.RpcObjectImportExperienceResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectSetSource(ctx context.Context, req *pb.RpcObjectSetSourceRequest) *pb.RpcObjectSetSourceResponse {
+func (h *ClientCommandsHandlerProxy) ObjectDateByTimestamp(ctx context.Context, req *pb.RpcObjectDateByTimestampRequest) *pb.RpcObjectDateByTimestampResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectSetSource(ctx, req.(*pb.RpcObjectSetSourceRequest)), nil
+		return h.client.ObjectDateByTimestamp(ctx, req.(*pb.RpcObjectDateByTimestampRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectSetSource", toCall)
+			return currentInterceptor(ctx, req, "ObjectDateByTimestamp", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectSetSourceResponse)
+	return call.(*pb.RpcObjectDateByTimestampResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectWorkspaceSetDashboard(ctx context.Context, req *pb.RpcObjectWorkspaceSetDashboardRequest) *pb.RpcObjectWorkspaceSetDashboardResponse {
+func (h *ClientCommandsHandlerProxy) ObjectDateParse(ctx context.Context, req *pb.RpcObjectDateParseRequest) *pb.RpcObjectDateParseResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectWorkspaceSetDashboard(ctx, req.(*pb.RpcObjectWorkspaceSetDashboardRequest)), nil
+		return h.client.ObjectDateParse(ctx, req.(*pb.RpcObjectDateParseRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectWorkspaceSetDashboard", toCall)
+			return currentInterceptor(ctx, req, "ObjectDateParse", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectWorkspaceSetDashboardResponse)
+	return call.(*pb.RpcObjectDateParseResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectListDuplicate(ctx context.Context, req *pb.RpcObjectListDuplicateRequest) *pb.RpcObjectListDuplicateResponse {
+func (h *ClientCommandsHandlerProxy) ObjectCollectionAdd(ctx context.Context, req *pb.RpcObjectCollectionAddRequest) *pb.RpcObjectCollectionAddResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectListDuplicate(ctx, req.(*pb.RpcObjectListDuplicateRequest)), nil
+		return h.client.ObjectCollectionAdd(ctx, req.(*pb.RpcObjectCollectionAddRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectListDuplicate", toCall)
+			return currentInterceptor(ctx, req, "ObjectCollectionAdd", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectListDuplicateResponse)
+	return call.(*pb.RpcObjectCollectionAddResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectListDelete(ctx context.Context, req *pb.RpcObjectListDeleteRequest) *pb.RpcObjectListDeleteResponse {
+func (h *ClientCommandsHandlerProxy) ObjectCollectionRemove(ctx context.Context, req *pb.RpcObjectCollectionRemoveRequest) *pb.RpcObjectCollectionRemoveResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectListDelete(ctx, req.(*pb.RpcObjectListDeleteRequest)), nil
+		return h.client.ObjectCollectionRemove(ctx, req.(*pb.RpcObjectCollectionRemoveRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectListDelete", toCall)
+			return currentInterceptor(ctx, req, "ObjectCollectionRemove", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectListDeleteResponse)
+	return call.(*pb.RpcObjectCollectionRemoveResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectListSetIsArchived(ctx context.Context, req *pb.RpcObjectListSetIsArchivedRequest) *pb.RpcObjectListSetIsArchivedResponse {
+func (h *ClientCommandsHandlerProxy) ObjectCollectionSort(ctx context.Context, req *pb.RpcObjectCollectionSortRequest) *pb.RpcObjectCollectionSortResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectListSetIsArchived(ctx, req.(*pb.RpcObjectListSetIsArchivedRequest)), nil
+		return h.client.ObjectCollectionSort(ctx, req.(*pb.RpcObjectCollectionSortRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectListSetIsArchived", toCall)
+			return currentInterceptor(ctx, req, "ObjectCollectionSort", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectListSetIsArchivedResponse)
+	return call.(*pb.RpcObjectCollectionSortResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectListSetIsFavorite(ctx context.Context, req *pb.RpcObjectListSetIsFavoriteRequest) *pb.RpcObjectListSetIsFavoriteResponse {
+func (h *ClientCommandsHandlerProxy) ObjectCreateRelation(ctx context.Context, req *pb.RpcObjectCreateRelationRequest) *pb.RpcObjectCreateRelationResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectListSetIsFavorite(ctx, req.(*pb.RpcObjectListSetIsFavoriteRequest)), nil
+		return h.client.ObjectCreateRelation(ctx, req.(*pb.RpcObjectCreateRelationRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectListSetIsFavorite", toCall)
+			return currentInterceptor(ctx, req, "ObjectCreateRelation", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectListSetIsFavoriteResponse)
+	return call.(*pb.RpcObjectCreateRelationResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectListSetObjectType(ctx context.Context, req *pb.RpcObjectListSetObjectTypeRequest) *pb.RpcObjectListSetObjectTypeResponse {
+func (h *ClientCommandsHandlerProxy) ObjectCreateRelationOption(ctx context.Context, req *pb.RpcObjectCreateRelationOptionRequest) *pb.RpcObjectCreateRelationOptionResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectListSetObjectType(ctx, req.(*pb.RpcObjectListSetObjectTypeRequest)), nil
+		return h.client.ObjectCreateRelationOption(ctx, req.(*pb.RpcObjectCreateRelationOptionRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectListSetObjectType", toCall)
+			return currentInterceptor(ctx, req, "ObjectCreateRelationOption", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectListSetObjectTypeResponse)
+	return call.(*pb.RpcObjectCreateRelationOptionResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectListSetDetails(ctx context.Context, req *pb.RpcObjectListSetDetailsRequest) *pb.RpcObjectListSetDetailsResponse {
+func (h *ClientCommandsHandlerProxy) RelationListRemoveOption(ctx context.Context, req *pb.RpcRelationListRemoveOptionRequest) *pb.RpcRelationListRemoveOptionResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectListSetDetails(ctx, req.(*pb.RpcObjectListSetDetailsRequest)), nil
+		return h.client.RelationListRemoveOption(ctx, req.(*pb.RpcRelationListRemoveOptionRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectListSetDetails", toCall)
+			return currentInterceptor(ctx, req, "RelationListRemoveOption", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectListSetDetailsResponse)
+	return call.(*pb.RpcRelationListRemoveOptionResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectListModifyDetailValues(ctx context.Context, req *pb.RpcObjectListModifyDetailValuesRequest) *pb.RpcObjectListModifyDetailValuesResponse {
+func (h *ClientCommandsHandlerProxy) RelationOptions(ctx context.Context, req *pb.RpcRelationOptionsRequest) *pb.RpcRelationOptionsResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectListModifyDetailValues(ctx, req.(*pb.RpcObjectListModifyDetailValuesRequest)), nil
+		return h.client.RelationOptions(ctx, req.(*pb.RpcRelationOptionsRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectListModifyDetailValues", toCall)
+			return currentInterceptor(ctx, req, "RelationOptions", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectListModifyDetailValuesResponse)
+	return call.(*pb.RpcRelationOptionsResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectApplyTemplate(ctx context.Context, req *pb.RpcObjectApplyTemplateRequest) *pb.RpcObjectApplyTemplateResponse {
+func (h *ClientCommandsHandlerProxy) RelationOptionSetOrder(ctx context.Context, req *pb.RpcRelationOptionSetOrderRequest) *pb.RpcRelationOptionSetOrderResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectApplyTemplate(ctx, req.(*pb.RpcObjectApplyTemplateRequest)), nil
+		return h.client.RelationOptionSetOrder(ctx, req.(*pb.RpcRelationOptionSetOrderRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectApplyTemplate", toCall)
+			return currentInterceptor(ctx, req, "RelationOptionSetOrder", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectApplyTemplateResponse)
+	return call.(*pb.RpcRelationOptionSetOrderResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectToSet(ctx context.Context, req *pb.RpcObjectToSetRequest) *pb.RpcObjectToSetResponse {
+func (h *ClientCommandsHandlerProxy) RelationListWithValue(ctx context.Context, req *pb.RpcRelationListWithValueRequest) *pb.RpcRelationListWithValueResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectToSet(ctx, req.(*pb.RpcObjectToSetRequest)), nil
+		return h.client.RelationListWithValue(ctx, req.(*pb.RpcRelationListWithValueRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectToSet", toCall)
+			return currentInterceptor(ctx, req, "RelationListWithValue", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectToSetResponse)
+	return call.(*pb.RpcRelationListWithValueResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectToCollection(ctx context.Context, req *pb.RpcObjectToCollectionRequest) *pb.RpcObjectToCollectionResponse {
+func (h *ClientCommandsHandlerProxy) ObjectRelationAdd(ctx context.Context, req *pb.RpcObjectRelationAddRequest) *pb.RpcObjectRelationAddResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectToCollection(ctx, req.(*pb.RpcObjectToCollectionRequest)), nil
+		return h.client.ObjectRelationAdd(ctx, req.(*pb.RpcObjectRelationAddRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectToCollection", toCall)
+			return currentInterceptor(ctx, req, "ObjectRelationAdd", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectToCollectionResponse)
+	return call.(*pb.RpcObjectRelationAddResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectShareByLink(ctx context.Context, req *pb.RpcObjectShareByLinkRequest) *pb.RpcObjectShareByLinkResponse {
+func (h *ClientCommandsHandlerProxy) ObjectRelationDelete(ctx context.Context, req *pb.RpcObjectRelationDeleteRequest) *pb.RpcObjectRelationDeleteResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectShareByLink(ctx, req.(*pb.RpcObjectShareByLinkRequest)), nil
+		return h.client.ObjectRelationDelete(ctx, req.(*pb.RpcObjectRelationDeleteRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectShareByLink", toCall)
+			return currentInterceptor(ctx, req, "ObjectRelationDelete", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectShareByLinkResponse)
+	return call.(*pb.RpcObjectRelationDeleteResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectUndo(ctx context.Context, req *pb.RpcObjectUndoRequest) *pb.RpcObjectUndoResponse {
+func (h *ClientCommandsHandlerProxy) ObjectRelationAddFeatured(ctx context.Context, req *pb.RpcObjectRelationAddFeaturedRequest) *pb.RpcObjectRelationAddFeaturedResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectUndo(ctx, req.(*pb.RpcObjectUndoRequest)), nil
+		return h.client.ObjectRelationAddFeatured(ctx, req.(*pb.RpcObjectRelationAddFeaturedRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectUndo", toCall)
+			return currentInterceptor(ctx, req, "ObjectRelationAddFeatured", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectUndoResponse)
+	return call.(*pb.RpcObjectRelationAddFeaturedResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectRedo(ctx context.Context, req *pb.RpcObjectRedoRequest) *pb.RpcObjectRedoResponse {
+func (h *ClientCommandsHandlerProxy) ObjectRelationRemoveFeatured(ctx context.Context, req *pb.RpcObjectRelationRemoveFeaturedRequest) *pb.RpcObjectRelationRemoveFeaturedResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectRedo(ctx, req.(*pb.RpcObjectRedoRequest)), nil
+		return h.client.ObjectRelationRemoveFeatured(ctx, req.(*pb.RpcObjectRelationRemoveFeaturedRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectRedo", toCall)
+			return currentInterceptor(ctx, req, "ObjectRelationRemoveFeatured", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectRedoResponse)
+	return call.(*pb.RpcObjectRelationRemoveFeaturedResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectListExport(ctx context.Context, req *pb.RpcObjectListExportRequest) *pb.RpcObjectListExportResponse {
+func (h *ClientCommandsHandlerProxy) ObjectRelationListAvailable(ctx context.Context, req *pb.RpcObjectRelationListAvailableRequest) *pb.RpcObjectRelationListAvailableResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectListExport(ctx, req.(*pb.RpcObjectListExportRequest)), nil
+		return h.client.ObjectRelationListAvailable(ctx, req.(*pb.RpcObjectRelationListAvailableRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectListExport", toCall)
+			return currentInterceptor(ctx, req, "ObjectRelationListAvailable", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectListExportResponse)
+	return call.(*pb.RpcObjectRelationListAvailableResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectExport(ctx context.Context, req *pb.RpcObjectExportRequest) *pb.RpcObjectExportResponse {
+func (h *ClientCommandsHandlerProxy) ObjectCreateObjectType(ctx context.Context, req *pb.RpcObjectCreateObjectTypeRequest) *pb.RpcObjectCreateObjectTypeResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectExport(ctx, req.(*pb.RpcObjectExportRequest)), nil
+		return h.client.ObjectCreateObjectType(ctx, req.(*pb.RpcObjectCreateObjectTypeRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectExport", toCall)
+			return currentInterceptor(ctx, req, "ObjectCreateObjectType", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectExportResponse)
+	return call.(*pb.RpcObjectCreateObjectTypeResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectBookmarkFetch(ctx context.Context, req *pb.RpcObjectBookmarkFetchRequest) *pb.RpcObjectBookmarkFetchResponse {
+func (h *ClientCommandsHandlerProxy) ObjectTypeRelationAdd(ctx context.Context, req *pb.RpcObjectTypeRelationAddRequest) *pb.RpcObjectTypeRelationAddResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectBookmarkFetch(ctx, req.(*pb.RpcObjectBookmarkFetchRequest)), nil
+		return h.client.ObjectTypeRelationAdd(ctx, req.(*pb.RpcObjectTypeRelationAddRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectBookmarkFetch", toCall)
+			return currentInterceptor(ctx, req, "ObjectTypeRelationAdd", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectBookmarkFetchResponse)
+	return call.(*pb.RpcObjectTypeRelationAddResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectImport(ctx context.Context, req *pb.RpcObjectImportRequest) *pb.RpcObjectImportResponse {
+func (h *ClientCommandsHandlerProxy) ObjectTypeRelationRemove(ctx context.Context, req *pb.RpcObjectTypeRelationRemoveRequest) *pb.RpcObjectTypeRelationRemoveResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectImport(ctx, req.(*pb.RpcObjectImportRequest)), nil
+		return h.client.ObjectTypeRelationRemove(ctx, req.(*pb.RpcObjectTypeRelationRemoveRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectImport", toCall)
+			return currentInterceptor(ctx, req, "ObjectTypeRelationRemove", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectImportResponse)
+	return call.(*pb.RpcObjectTypeRelationRemoveResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectImportList(ctx context.Context, req *pb.RpcObjectImportListRequest) *pb.RpcObjectImportListResponse {
+func (h *ClientCommandsHandlerProxy) ObjectTypeRecommendedRelationsSet(ctx context.Context, req *pb.RpcObjectTypeRecommendedRelationsSetRequest) *pb.RpcObjectTypeRecommendedRelationsSetResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectImportList(ctx, req.(*pb.RpcObjectImportListRequest)), nil
+		return h.client.ObjectTypeRecommendedRelationsSet(ctx, req.(*pb.RpcObjectTypeRecommendedRelationsSetRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectImportList", toCall)
+			return currentInterceptor(ctx, req, "ObjectTypeRecommendedRelationsSet", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectImportListResponse)
+	return call.(*pb.RpcObjectTypeRecommendedRelationsSetResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectImportNotionValidateToken(ctx context.Context, req *pb.RpcObjectImportNotionValidateTokenRequest) *pb.RpcObjectImportNotionValidateTokenResponse {
+func (h *ClientCommandsHandlerProxy) ObjectTypeRecommendedFeaturedRelationsSet(ctx context.Context, req *pb.RpcObjectTypeRecommendedFeaturedRelationsSetRequest) *pb.RpcObjectTypeRecommendedFeaturedRelationsSetResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectImportNotionValidateToken(ctx, req.(*pb.RpcObjectImportNotionValidateTokenRequest)), nil
+		return h.client.ObjectTypeRecommendedFeaturedRelationsSet(ctx, req.(*pb.RpcObjectTypeRecommendedFeaturedRelationsSetRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectImportNotionValidateToken", toCall)
+			return currentInterceptor(ctx, req, "ObjectTypeRecommendedFeaturedRelationsSet", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectImportNotionValidateTokenResponse)
+	return call.(*pb.RpcObjectTypeRecommendedFeaturedRelationsSetResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectImportUseCase(ctx context.Context, req *pb.RpcObjectImportUseCaseRequest) *pb.RpcObjectImportUseCaseResponse {
+func (h *ClientCommandsHandlerProxy) ObjectTypeListConflictingRelations(ctx context.Context, req *pb.RpcObjectTypeListConflictingRelationsRequest) *pb.RpcObjectTypeListConflictingRelationsResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectImportUseCase(ctx, req.(*pb.RpcObjectImportUseCaseRequest)), nil
+		return h.client.ObjectTypeListConflictingRelations(ctx, req.(*pb.RpcObjectTypeListConflictingRelationsRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectImportUseCase", toCall)
+			return currentInterceptor(ctx, req, "ObjectTypeListConflictingRelations", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectImportUseCaseResponse)
+	return call.(*pb.RpcObjectTypeListConflictingRelationsResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectImportExperience(ctx context.Context, req *pb.RpcObjectImportExperienceRequest) *pb.RpcObjectImportExperienceResponse {
+func (h *ClientCommandsHandlerProxy) ObjectTypeResolveLayoutConflicts(ctx context.Context, req *pb.RpcObjectTypeResolveLayoutConflictsRequest) *pb.RpcObjectTypeResolveLayoutConflictsResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectImportExperience(ctx, req.(*pb.RpcObjectImportExperienceRequest)), nil
+		return h.client.ObjectTypeResolveLayoutConflicts(ctx, req.(*pb.RpcObjectTypeResolveLayoutConflictsRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectImportExperience", toCall)
+			return currentInterceptor(ctx, req, "ObjectTypeResolveLayoutConflicts", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectImportExperienceResponse)
+	return call.(*pb.RpcObjectTypeResolveLayoutConflictsResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectDateByTimestamp(ctx context.Context, req *pb.RpcObjectDateByTimestampRequest) *pb.RpcObjectDateByTimestampResponse {
+func (h *ClientCommandsHandlerProxy) ObjectTypeSetOrder(ctx context.Context, req *pb.RpcObjectTypeSetOrderRequest) *pb.RpcObjectTypeSetOrderResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectDateByTimestamp(ctx, req.(*pb.RpcObjectDateByTimestampRequest)), nil
+		return h.client.ObjectTypeSetOrder(ctx, req.(*pb.RpcObjectTypeSetOrderRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectDateByTimestamp", toCall)
+			return currentInterceptor(ctx, req, "ObjectTypeSetOrder", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectDateByTimestampResponse)
+	return call.(*pb.RpcObjectTypeSetOrderResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectCollectionAdd(ctx context.Context, req *pb.RpcObjectCollectionAddRequest) *pb.RpcObjectCollectionAddResponse {
+func (h *ClientCommandsHandlerProxy) HistoryShowVersion(ctx context.Context, req *pb.RpcHistoryShowVersionRequest) *pb.RpcHistoryShowVersionResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectCollectionAdd(ctx, req.(*pb.RpcObjectCollectionAddRequest)), nil
+		return h.client.HistoryShowVersion(ctx, req.(*pb.RpcHistoryShowVersionRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectCollectionAdd", toCall)
+			return currentInterceptor(ctx, req, "HistoryShowVersion", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectCollectionAddResponse)
+	return call.(*pb.RpcHistoryShowVersionResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectCollectionRemove(ctx context.Context, req *pb.RpcObjectCollectionRemoveRequest) *pb.RpcObjectCollectionRemoveResponse {
+func (h *ClientCommandsHandlerProxy) HistoryGetVersions(ctx context.Context, req *pb.RpcHistoryGetVersionsRequest) *pb.RpcHistoryGetVersionsResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectCollectionRemove(ctx, req.(*pb.RpcObjectCollectionRemoveRequest)), nil
+		return h.client.HistoryGetVersions(ctx, req.(*pb.RpcHistoryGetVersionsRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectCollectionRemove", toCall)
+			return currentInterceptor(ctx, req, "HistoryGetVersions", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectCollectionRemoveResponse)
+	return call.(*pb.RpcHistoryGetVersionsResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectCollectionSort(ctx context.Context, req *pb.RpcObjectCollectionSortRequest) *pb.RpcObjectCollectionSortResponse {
+func (h *ClientCommandsHandlerProxy) HistorySetVersion(ctx context.Context, req *pb.RpcHistorySetVersionRequest) *pb.RpcHistorySetVersionResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectCollectionSort(ctx, req.(*pb.RpcObjectCollectionSortRequest)), nil
+		return h.client.HistorySetVersion(ctx, req.(*pb.RpcHistorySetVersionRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectCollectionSort", toCall)
+			return currentInterceptor(ctx, req, "HistorySetVersion", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectCollectionSortResponse)
+	return call.(*pb.RpcHistorySetVersionResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectCreateRelation(ctx context.Context, req *pb.RpcObjectCreateRelationRequest) *pb.RpcObjectCreateRelationResponse {
+func (h *ClientCommandsHandlerProxy) HistoryDiffVersions(ctx context.Context, req *pb.RpcHistoryDiffVersionsRequest) *pb.RpcHistoryDiffVersionsResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectCreateRelation(ctx, req.(*pb.RpcObjectCreateRelationRequest)), nil
+		return h.client.HistoryDiffVersions(ctx, req.(*pb.RpcHistoryDiffVersionsRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectCreateRelation", toCall)
+			return currentInterceptor(ctx, req, "HistoryDiffVersions", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectCreateRelationResponse)
+	return call.(*pb.RpcHistoryDiffVersionsResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectCreateRelationOption(ctx context.Context, req *pb.RpcObjectCreateRelationOptionRequest) *pb.RpcObjectCreateRelationOptionResponse {
+func (h *ClientCommandsHandlerProxy) HistoryGetBlockVersions(ctx context.Context, req *pb.RpcHistoryGetBlockVersionsRequest) *pb.RpcHistoryGetBlockVersionsResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectCreateRelationOption(ctx, req.(*pb.RpcObjectCreateRelationOptionRequest)), nil
+		return h.client.HistoryGetBlockVersions(ctx, req.(*pb.RpcHistoryGetBlockVersionsRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectCreateRelationOption", toCall)
+			return currentInterceptor(ctx, req, "HistoryGetBlockVersions", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectCreateRelationOptionResponse)
+	return call.(*pb.RpcHistoryGetBlockVersionsResponse)
 }
-func (h *ClientCommandsHandlerProxy) RelationListRemoveOption(ctx context.Context, req *pb.RpcRelationListRemoveOptionRequest) *pb.RpcRelationListRemoveOptionResponse {
+func (h *ClientCommandsHandlerProxy) HistoryRestoreBlocks(ctx context.Context, req *pb.RpcHistoryRestoreBlocksRequest) *pb.RpcHistoryRestoreBlocksResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.RelationListRemoveOption(ctx, req.(*pb.RpcRelationListRemoveOptionRequest)), nil
+		return h.client.HistoryRestoreBlocks(ctx, req.(*pb.RpcHistoryRestoreBlocksRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "RelationListRemoveOption", toCall)
+			return currentInterceptor(ctx, req, "HistoryRestoreBlocks", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcRelationListRemoveOptionResponse)
+	return call.(*pb.RpcHistoryRestoreBlocksResponse)
 }
-func (h *ClientCommandsHandlerProxy) RelationOptions(ctx context.Context, req *pb.RpcRelationOptionsRequest) *pb.RpcRelationOptionsResponse {
+func (h *ClientCommandsHandlerProxy) FileSpaceOffload(ctx context.Context, req *pb.RpcFileSpaceOffloadRequest) *pb.RpcFileSpaceOffloadResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.RelationOptions(ctx, req.(*pb.RpcRelationOptionsRequest)), nil
+		return h.client.FileSpaceOffload(ctx, req.(*pb.RpcFileSpaceOffloadRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "RelationOptions", toCall)
+			return currentInterceptor(ctx, req, "FileSpaceOffload", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcRelationOptionsResponse)
+	return call.(*pb.RpcFileSpaceOffloadResponse)
 }
-func (h *ClientCommandsHandlerProxy) RelationOptionSetOrder(ctx context.Context, req *pb.RpcRelationOptionSetOrderRequest) *pb.RpcRelationOptionSetOrderResponse {
+func (h *ClientCommandsHandlerProxy) FileReconcile(ctx context.Context, req *pb.RpcFileReconcileRequest) *pb.RpcFileReconcileResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.RelationOptionSetOrder(ctx, req.(*pb.RpcRelationOptionSetOrderRequest)), nil
+		return h.client.FileReconcile(ctx, req.(*pb.RpcFileReconcileRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "RelationOptionSetOrder", toCall)
+			return currentInterceptor(ctx, req, "FileReconcile", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcRelationOptionSetOrderResponse)
+	return call.(*pb.RpcFileReconcileResponse)
 }
-func (h *ClientCommandsHandlerProxy) RelationListWithValue(ctx context.Context, req *pb.RpcRelationListWithValueRequest) *pb.RpcRelationListWithValueResponse {
+func (h *ClientCommandsHandlerProxy) FileListOffload(ctx context.Context, req *pb.RpcFileListOffloadRequest) *pb.RpcFileListOffloadResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.RelationListWithValue(ctx, req.(*pb.RpcRelationListWithValueRequest)), nil
+		return h.client.FileListOffload(ctx, req.(*pb.RpcFileListOffloadRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "RelationListWithValue", toCall)
+			return currentInterceptor(ctx, req, "FileListOffload", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcRelationListWithValueResponse)
+	return call.(*pb.RpcFileListOffloadResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectRelationAdd(ctx context.Context, req *pb.RpcObjectRelationAddRequest) *pb.RpcObjectRelationAddResponse {
+func (h *ClientCommandsHandlerProxy) FileUpload(ctx context.Context, req *pb.RpcFileUploadRequest) *pb.RpcFileUploadResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectRelationAdd(ctx, req.(*pb.RpcObjectRelationAddRequest)), nil
+		return h.client.FileUpload(ctx, req.(*pb.RpcFileUploadRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectRelationAdd", toCall)
+			return currentInterceptor(ctx, req, "FileUpload", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectRelationAddResponse)
+	return call.(*pb.RpcFileUploadResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectRelationDelete(ctx context.Context, req *pb.RpcObjectRelationDeleteRequest) *pb.RpcObjectRelationDeleteResponse {
+func (h *ClientCommandsHandlerProxy) FileDownload(ctx context.Context, req *pb.RpcFileDownloadRequest) *pb.RpcFileDownloadResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectRelationDelete(ctx, req.(*pb.RpcObjectRelationDeleteRequest)), nil
+		return h.client.FileDownload(ctx, req.(*pb.RpcFileDownloadRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectRelationDelete", toCall)
+			return currentInterceptor(ctx, req, "FileDownload", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectRelationDeleteResponse)
+	return call.(*pb.RpcFileDownloadResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectRelationAddFeatured(ctx context.Context, req *pb.RpcObjectRelationAddFeaturedRequest) *pb.RpcObjectRelationAddFeaturedResponse {
+func (h *ClientCommandsHandlerProxy) FileDiscardPreload(ctx context.Context, req *pb.RpcFileDiscardPreloadRequest) *pb.RpcFileDiscardPreloadResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectRelationAddFeatured(ctx, req.(*pb.RpcObjectRelationAddFeaturedRequest)), nil
+		return h.client.FileDiscardPreload(ctx, req.(*pb.RpcFileDiscardPreloadRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectRelationAddFeatured", toCall)
+			return currentInterceptor(ctx, req, "FileDiscardPreload", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectRelationAddFeaturedResponse)
+	return call.(*pb.RpcFileDiscardPreloadResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectRelationRemoveFeatured(ctx context.Context, req *pb.RpcObjectRelationRemoveFeaturedRequest) *pb.RpcObjectRelationRemoveFeaturedResponse {
+func (h *ClientCommandsHandlerProxy) FileImageTransform(ctx context.Context, req *pb.RpcFileImageTransformRequest) *pb.RpcFileImageTransformResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectRelationRemoveFeatured(ctx, req.(*pb.RpcObjectRelationRemoveFeaturedRequest)), nil
+		return h.client.FileImageTransform(ctx, req.(*pb.RpcFileImageTransformRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectRelationRemoveFeatured", toCall)
+			return currentInterceptor(ctx, req, "FileImageTransform", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectRelationRemoveFeaturedResponse)
+	return call.(*pb.RpcFileImageTransformResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectRelationListAvailable(ctx context.Context, req *pb.RpcObjectRelationListAvailableRequest) *pb.RpcObjectRelationListAvailableResponse {
+func (h *ClientCommandsHandlerProxy) FileDrop(ctx context.Context, req *pb.RpcFileDropRequest) *pb.RpcFileDropResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectRelationListAvailable(ctx, req.(*pb.RpcObjectRelationListAvailableRequest)), nil
+		return h.client.FileDrop(ctx, req.(*pb.RpcFileDropRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectRelationListAvailable", toCall)
+			return currentInterceptor(ctx, req, "FileDrop", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectRelationListAvailableResponse)
+	return call.(*pb.RpcFileDropResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectCreateObjectType(ctx context.Context, req *pb.RpcObjectCreateObjectTypeRequest) *pb.RpcObjectCreateObjectTypeResponse {
+func (h *ClientCommandsHandlerProxy) FileSpaceUsage(ctx context.Context, req *pb.RpcFileSpaceUsageRequest) *pb.RpcFileSpaceUsageResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectCreateObjectType(ctx, req.(*pb.RpcObjectCreateObjectTypeRequest)), nil
+		return h.client.FileSpaceUsage(ctx, req.(*pb.RpcFileSpaceUsageRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectCreateObjectType", toCall)
+			return currentInterceptor(ctx, req, "FileSpaceUsage", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectCreateObjectTypeResponse)
+	return call.(*pb.RpcFileSpaceUsageResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectTypeRelationAdd(ctx context.Context, req *pb.RpcObjectTypeRelationAddRequest) *pb.RpcObjectTypeRelationAddResponse {
+func (h *ClientCommandsHandlerProxy) FileNodeUsage(ctx context.Context, req *pb.RpcFileNodeUsageRequest) *pb.RpcFileNodeUsageResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectTypeRelationAdd(ctx, req.(*pb.RpcObjectTypeRelationAddRequest)), nil
+		return h.client.FileNodeUsage(ctx, req.(*pb.RpcFileNodeUsageRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectTypeRelationAdd", toCall)
+			return currentInterceptor(ctx, req, "FileNodeUsage", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectTypeRelationAddResponse)
+	return call.(*pb.RpcFileNodeUsageResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectTypeRelationRemove(ctx context.Context, req *pb.RpcObjectTypeRelationRemoveRequest) *pb.RpcObjectTypeRelationRemoveResponse {
+func (h *ClientCommandsHandlerProxy) FileSetAutoDownload(ctx context.Context, req *pb.RpcFileSetAutoDownloadRequest) *pb.RpcFileSetAutoDownloadResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectTypeRelationRemove(ctx, req.(*pb.RpcObjectTypeRelationRemoveRequest)), nil
+		return h.client.FileSetAutoDownload(ctx, req.(*pb.RpcFileSetAutoDownloadRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectTypeRelationRemove", toCall)
+			return currentInterceptor(ctx, req, "FileSetAutoDownload", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectTypeRelationRemoveResponse)
+	return call.(*pb.RpcFileSetAutoDownloadResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectTypeRecommendedRelationsSet(ctx context.Context, req *pb.RpcObjectTypeRecommendedRelationsSetRequest) *pb.RpcObjectTypeRecommendedRelationsSetResponse {
+func (h *ClientCommandsHandlerProxy) FileCacheDownload(ctx context.Context, req *pb.RpcFileCacheDownloadRequest) *pb.RpcFileCacheDownloadResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectTypeRecommendedRelationsSet(ctx, req.(*pb.RpcObjectTypeRecommendedRelationsSetRequest)), nil
+		return h.client.FileCacheDownload(ctx, req.(*pb.RpcFileCacheDownloadRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectTypeRecommendedRelationsSet", toCall)
+			return currentInterceptor(ctx, req, "FileCacheDownload", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectTypeRecommendedRelationsSetResponse)
+	return call.(*pb.RpcFileCacheDownloadResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectTypeRecommendedFeaturedRelationsSet(ctx context.Context, req *pb.RpcObjectTypeRecommendedFeaturedRelationsSetRequest) *pb.RpcObjectTypeRecommendedFeaturedRelationsSetResponse {
+func (h *ClientCommandsHandlerProxy) FileCacheCancelDownload(ctx context.Context, req *pb.RpcFileCacheCancelDownloadRequest) *pb.RpcFileCacheCancelDownloadResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectTypeRecommendedFeaturedRelationsSet(ctx, req.(*pb.RpcObjectTypeRecommendedFeaturedRelationsSetRequest)), nil
+		return h.client.FileCacheCancelDownload(ctx, req.(*pb.RpcFileCacheCancelDownloadRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectTypeRecommendedFeaturedRelationsSet", toCall)
+			return currentInterceptor(ctx, req, "FileCacheCancelDownload", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectTypeRecommendedFeaturedRelationsSetResponse)
+	return call.(*pb.RpcFileCacheCancelDownloadResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectTypeListConflictingRelations(ctx context.Context, req *pb.RpcObjectTypeListConflictingRelationsRequest) *pb.RpcObjectTypeListConflictingRelationsResponse {
+func (h *ClientCommandsHandlerProxy) FileSetAutoOffload(ctx context.Context, req *pb.RpcFileSetAutoOffloadRequest) *pb.RpcFileSetAutoOffloadResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectTypeListConflictingRelations(ctx, req.(*pb.RpcObjectTypeListConflictingRelationsRequest)), nil
+		return h.client.FileSetAutoOffload(ctx, req.(*pb.RpcFileSetAutoOffloadRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectTypeListConflictingRelations", toCall)
+			return currentInterceptor(ctx, req, "FileSetAutoOffload", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectTypeListConflictingRelationsResponse)
+	return call.(*pb.RpcFileSetAutoOffloadResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectTypeResolveLayoutConflicts(ctx context.Context, req *pb.RpcObjectTypeResolveLayoutConflictsRequest) *pb.RpcObjectTypeResolveLayoutConflictsResponse {
+func (h *ClientCommandsHandlerProxy) FileAutoOffloadStatus(ctx context.Context, req *pb.RpcFileAutoOffloadStatusRequest) *pb.RpcFileAutoOffloadStatusResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectTypeResolveLayoutConflicts(ctx, req.(*pb.RpcObjectTypeResolveLayoutConflictsRequest)), nil
+		return h.client.FileAutoOffloadStatus(ctx, req.(*pb.RpcFileAutoOffloadStatusRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectTypeResolveLayoutConflicts", toCall)
+			return currentInterceptor(ctx, req, "FileAutoOffloadStatus", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectTypeResolveLayoutConflictsResponse)
+	return call.(*pb.RpcFileAutoOffloadStatusResponse)
 }
-func (h *ClientCommandsHandlerProxy) ObjectTypeSetOrder(ctx context.Context, req *pb.RpcObjectTypeSetOrderRequest) *pb.RpcObjectTypeSetOrderResponse {
+func (h *ClientCommandsHandlerProxy) NavigationListObjects(ctx context.Context, req *pb.RpcNavigationListObjectsRequest) *pb.RpcNavigationListObjectsResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.ObjectTypeSetOrder(ctx, req.(*pb.RpcObjectTypeSetOrderRequest)), nil
+		return h.client.NavigationListObjects(ctx, req.(*pb.RpcNavigationListObjectsRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "ObjectTypeSetOrder", toCall)
+			return currentInterceptor(ctx, req, "NavigationListObjects", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcObjectTypeSetOrderResponse)
+	return call.(*pb.RpcNavigationListObjectsResponse)
 }
-func (h *ClientCommandsHandlerProxy) HistoryShowVersion(ctx context.Context, req *pb.RpcHistoryShowVersionRequest) *pb.RpcHistoryShowVersionResponse {
+func (h *ClientCommandsHandlerProxy) NavigationGetObjectInfoWithLinks(ctx context.Context, req *pb.RpcNavigationGetObjectInfoWithLinksRequest) *pb.RpcNavigationGetObjectInfoWithLinksResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.HistoryShowVersion(ctx, req.(*pb.RpcHistoryShowVersionRequest)), nil
+		return h.client.NavigationGetObjectInfoWithLinks(ctx, req.(*pb.RpcNavigationGetObjectInfoWithLinksRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "HistoryShowVersion", toCall)
+			return currentInterceptor(ctx, req, "NavigationGetObjectInfoWithLinks", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcHistoryShowVersionResponse)
+	return call.(*pb.RpcNavigationGetObjectInfoWithLinksResponse)
 }
-func (h *ClientCommandsHandlerProxy) HistoryGetVersions(ctx context.Context, req *pb.RpcHistoryGetVersionsRequest) *pb.RpcHistoryGetVersionsResponse {
+func (h *ClientCommandsHandlerProxy) TemplateCreateFromObject(ctx context.Context, req *pb.RpcTemplateCreateFromObjectRequest) *pb.RpcTemplateCreateFromObjectResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.HistoryGetVersions(ctx, req.(*pb.RpcHistoryGetVersionsRequest)), nil
+		return h.client.TemplateCreateFromObject(ctx, req.(*pb.RpcTemplateCreateFromObjectRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "HistoryGetVersions", toCall)
+			return currentInterceptor(ctx, req, "TemplateCreateFromObject", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcHistoryGetVersionsResponse)
+	return call.(*pb.RpcTemplateCreateFromObjectResponse)
 }
-func (h *ClientCommandsHandlerProxy) HistorySetVersion(ctx context.Context, req *pb.RpcHistorySetVersionRequest) *pb.RpcHistorySetVersionResponse {
+func (h *ClientCommandsHandlerProxy) TemplateClone(ctx context.Context, req *pb.RpcTemplateCloneRequest) *pb.RpcTemplateCloneResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.HistorySetVersion(ctx, req.(*pb.RpcHistorySetVersionRequest)), nil
+		return h.client.TemplateClone(ctx, req.(*pb.RpcTemplateCloneRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "HistorySetVersion", toCall)
+			return currentInterceptor(ctx, req, "TemplateClone", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcHistorySetVersionResponse)
+	return call.(*pb.RpcTemplateCloneResponse)
 }
-func (h *ClientCommandsHandlerProxy) HistoryDiffVersions(ctx context.Context, req *pb.RpcHistoryDiffVersionsRequest) *pb.RpcHistoryDiffVersionsResponse {
+func (h *ClientCommandsHandlerProxy) TemplateExportAll(ctx context.Context, req *pb.RpcTemplateExportAllRequest) *pb.RpcTemplateExportAllResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.HistoryDiffVersions(ctx, req.(*pb.RpcHistoryDiffVersionsRequest)), nil
+		return h.client.TemplateExportAll(ctx, req.(*pb.RpcTemplateExportAllRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "HistoryDiffVersions", toCall)
+			return currentInterceptor(ctx, req, "TemplateExportAll", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcHistoryDiffVersionsResponse)
+	return call.(*pb.RpcTemplateExportAllResponse)
 }
-func (h *ClientCommandsHandlerProxy) FileSpaceOffload(ctx context.Context, req *pb.RpcFileSpaceOffloadRequest) *pb.RpcFileSpaceOffloadResponse {
+func (h *ClientCommandsHandlerProxy) TemplateGetVariables(ctx context.Context, req *pb.RpcTemplateGetVariablesRequest) *pb.RpcTemplateGetVariablesResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.FileSpaceOffload(ctx, req.(*pb.RpcFileSpaceOffloadRequest)), nil
+		return h.client.TemplateGetVariables(ctx, req.(*pb.RpcTemplateGetVariablesRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "FileSpaceOffload", toCall)
+			return currentInterceptor(ctx, req, "TemplateGetVariables", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcFileSpaceOffloadResponse)
+	return call.(*pb.RpcTemplateGetVariablesResponse)
 }
-func (h *ClientCommandsHandlerProxy) FileReconcile(ctx context.Context, req *pb.RpcFileReconcileRequest) *pb.RpcFileReconcileResponse {
+func (h *ClientCommandsHandlerProxy) TemplateIncludeSyncPreview(ctx context.Context, req *pb.RpcTemplateIncludeSyncPreviewRequest) *pb.RpcTemplateIncludeSyncPreviewResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.FileReconcile(ctx, req.(*pb.RpcFileReconcileRequest)), nil
+		return h.client.TemplateIncludeSyncPreview(ctx, req.(*pb.RpcTemplateIncludeSyncPreviewRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "FileReconcile", toCall)
+			return currentInterceptor(ctx, req, "TemplateIncludeSyncPreview", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcFileReconcileResponse)
+	return call.(*pb.RpcTemplateIncludeSyncPreviewResponse)
 }
-func (h *ClientCommandsHandlerProxy) FileListOffload(ctx context.Context, req *pb.RpcFileListOffloadRequest) *pb.RpcFileListOffloadResponse {
+func (h *ClientCommandsHandlerProxy) TemplateIncludeSyncApply(ctx context.Context, req *pb.RpcTemplateIncludeSyncApplyRequest) *pb.RpcTemplateIncludeSyncApplyResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.FileListOffload(ctx, req.(*pb.RpcFileListOffloadRequest)), nil
+		return h.client.TemplateIncludeSyncApply(ctx, req.(*pb.RpcTemplateIncludeSyncApplyRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "FileListOffload", toCall)
+			return currentInterceptor(ctx, req, "TemplateIncludeSyncApply", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcFileListOffloadResponse)
+	return call.(*pb.RpcTemplateIncludeSyncApplyResponse)
 }
-func (h *ClientCommandsHandlerProxy) FileUpload(ctx context.Context, req *pb.RpcFileUploadRequest) *pb.RpcFileUploadResponse {
+func (h *ClientCommandsHandlerProxy) JournalOpenNote(ctx context.Context, req *pb.RpcJournalOpenNoteRequest) *pb.RpcJournalOpenNoteResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.FileUpload(ctx, req.(*pb.RpcFileUploadRequest)), nil
+		return h.client.JournalOpenNote(ctx, req.(*pb.RpcJournalOpenNoteRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "FileUpload", toCall)
+			return currentInterceptor(ctx, req, "JournalOpenNote", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcFileUploadResponse)
+	return call.(*pb.RpcJournalOpenNoteResponse)
 }
-func (h *ClientCommandsHandlerProxy) FileDownload(ctx context.Context, req *pb.RpcFileDownloadRequest) *pb.RpcFileDownloadResponse {
+func (h *ClientCommandsHandlerProxy) JournalDateSection(ctx context.Context, req *pb.RpcJournalDateSectionRequest) *pb.RpcJournalDateSectionResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.FileDownload(ctx, req.(*pb.RpcFileDownloadRequest)), nil
+		return h.client.JournalDateSection(ctx, req.(*pb.RpcJournalDateSectionRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "FileDownload", toCall)
+			return currentInterceptor(ctx, req, "JournalDateSection", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcFileDownloadResponse)
+	return call.(*pb.RpcJournalDateSectionResponse)
 }
-func (h *ClientCommandsHandlerProxy) FileDiscardPreload(ctx context.Context, req *pb.RpcFileDiscardPreloadRequest) *pb.RpcFileDiscardPreloadResponse {
+func (h *ClientCommandsHandlerProxy) JournalGetSettings(ctx context.Context, req *pb.RpcJournalGetSettingsRequest) *pb.RpcJournalGetSettingsResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.FileDiscardPreload(ctx, req.(*pb.RpcFileDiscardPreloadRequest)), nil
+		return h.client.JournalGetSettings(ctx, req.(*pb.RpcJournalGetSettingsRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "FileDiscardPreload", toCall)
+			return currentInterceptor(ctx, req, "JournalGetSettings", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcFileDiscardPreloadResponse)
+	return call.(*pb.RpcJournalGetSettingsResponse)
 }
-func (h *ClientCommandsHandlerProxy) FileDrop(ctx context.Context, req *pb.RpcFileDropRequest) *pb.RpcFileDropResponse {
+func (h *ClientCommandsHandlerProxy) JournalSetSettings(ctx context.Context, req *pb.RpcJournalSetSettingsRequest) *pb.RpcJournalSetSettingsResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.FileDrop(ctx, req.(*pb.RpcFileDropRequest)), nil
+		return h.client.JournalSetSettings(ctx, req.(*pb.RpcJournalSetSettingsRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "FileDrop", toCall)
+			return currentInterceptor(ctx, req, "JournalSetSettings", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcFileDropResponse)
+	return call.(*pb.RpcJournalSetSettingsResponse)
 }
-func (h *ClientCommandsHandlerProxy) FileSpaceUsage(ctx context.Context, req *pb.RpcFileSpaceUsageRequest) *pb.RpcFileSpaceUsageResponse {
+func (h *ClientCommandsHandlerProxy) CalendarExportView(ctx context.Context, req *pb.RpcCalendarExportViewRequest) *pb.RpcCalendarExportViewResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.FileSpaceUsage(ctx, req.(*pb.RpcFileSpaceUsageRequest)), nil
+		return h.client.CalendarExportView(ctx, req.(*pb.RpcCalendarExportViewRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "FileSpaceUsage", toCall)
+			return currentInterceptor(ctx, req, "CalendarExportView", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcFileSpaceUsageResponse)
+	return call.(*pb.RpcCalendarExportViewResponse)
 }
-func (h *ClientCommandsHandlerProxy) FileNodeUsage(ctx context.Context, req *pb.RpcFileNodeUsageRequest) *pb.RpcFileNodeUsageResponse {
+func (h *ClientCommandsHandlerProxy) CalendarImport(ctx context.Context, req *pb.RpcCalendarImportRequest) *pb.RpcCalendarImportResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.FileNodeUsage(ctx, req.(*pb.RpcFileNodeUsageRequest)), nil
+		return h.client.CalendarImport(ctx, req.(*pb.RpcCalendarImportRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "FileNodeUsage", toCall)
+			return currentInterceptor(ctx, req, "CalendarImport", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcFileNodeUsageResponse)
+	return call.(*pb.RpcCalendarImportResponse)
 }
-func (h *ClientCommandsHandlerProxy) FileSetAutoDownload(ctx context.Context, req *pb.RpcFileSetAutoDownloadRequest) *pb.RpcFileSetAutoDownloadResponse {
+func (h *ClientCommandsHandlerProxy) CalendarFeedCreate(ctx context.Context, req *pb.RpcCalendarFeedCreateRequest) *pb.RpcCalendarFeedCreateResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.FileSetAutoDownload(ctx, req.(*pb.RpcFileSetAutoDownloadRequest)), nil
+		return h.client.CalendarFeedCreate(ctx, req.(*pb.RpcCalendarFeedCreateRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "FileSetAutoDownload", toCall)
+			return currentInterceptor(ctx, req, "CalendarFeedCreate", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcFileSetAutoDownloadResponse)
+	return call.(*pb.RpcCalendarFeedCreateResponse)
 }
-func (h *ClientCommandsHandlerProxy) FileCacheDownload(ctx context.Context, req *pb.RpcFileCacheDownloadRequest) *pb.RpcFileCacheDownloadResponse {
+func (h *ClientCommandsHandlerProxy) CalendarFeedList(ctx context.Context, req *pb.RpcCalendarFeedListRequest) *pb.RpcCalendarFeedListResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.FileCacheDownload(ctx, req.(*pb.RpcFileCacheDownloadRequest)), nil
+		return h.client.CalendarFeedList(ctx, req.(*pb.RpcCalendarFeedListRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "FileCacheDownload", toCall)
+			return currentInterceptor(ctx, req, "CalendarFeedList", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcFileCacheDownloadResponse)
+	return call.(*pb.RpcCalendarFeedListResponse)
 }
-func (h *ClientCommandsHandlerProxy) FileCacheCancelDownload(ctx context.Context, req *pb.RpcFileCacheCancelDownloadRequest) *pb.RpcFileCacheCancelDownloadResponse {
+func (h *ClientCommandsHandlerProxy) CalendarFeedRemove(ctx context.Context, req *pb.RpcCalendarFeedRemoveRequest) *pb.RpcCalendarFeedRemoveResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.FileCacheCancelDownload(ctx, req.(*pb.RpcFileCacheCancelDownloadRequest)), nil
+		return h.client.CalendarFeedRemove(ctx, req.(*pb.RpcCalendarFeedRemoveRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "FileCacheCancelDownload", toCall)
+			return currentInterceptor(ctx, req, "CalendarFeedRemove", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcFileCacheCancelDownloadResponse)
+	return call.(*pb.RpcCalendarFeedRemoveResponse)
 }
-func (h *ClientCommandsHandlerProxy) NavigationListObjects(ctx context.Context, req *pb.RpcNavigationListObjectsRequest) *pb.RpcNavigationListObjectsResponse {
+func (h *ClientCommandsHandlerProxy) CalendarFeedGet(ctx context.Context, req *pb.RpcCalendarFeedGetRequest) *pb.RpcCalendarFeedGetResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.NavigationListObjects(ctx, req.(*pb.RpcNavigationListObjectsRequest)), nil
+		return h.client.CalendarFeedGet(ctx, req.(*pb.RpcCalendarFeedGetRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "NavigationListObjects", toCall)
+			return currentInterceptor(ctx, req, "CalendarFeedGet", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcNavigationListObjectsResponse)
+	return call.(*pb.RpcCalendarFeedGetResponse)
 }
-func (h *ClientCommandsHandlerProxy) NavigationGetObjectInfoWithLinks(ctx context.Context, req *pb.RpcNavigationGetObjectInfoWithLinksRequest) *pb.RpcNavigationGetObjectInfoWithLinksResponse {
+func (h *ClientCommandsHandlerProxy) WebhookCreate(ctx context.Context, req *pb.RpcWebhookCreateRequest) *pb.RpcWebhookCreateResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.NavigationGetObjectInfoWithLinks(ctx, req.(*pb.RpcNavigationGetObjectInfoWithLinksRequest)), nil
+		return h.client.WebhookCreate(ctx, req.(*pb.RpcWebhookCreateRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "NavigationGetObjectInfoWithLinks", toCall)
+			return currentInterceptor(ctx, req, "WebhookCreate", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcNavigationGetObjectInfoWithLinksResponse)
+	return call.(*pb.RpcWebhookCreateResponse)
 }
-func (h *ClientCommandsHandlerProxy) TemplateCreateFromObject(ctx context.Context, req *pb.RpcTemplateCreateFromObjectRequest) *pb.RpcTemplateCreateFromObjectResponse {
+func (h *ClientCommandsHandlerProxy) WebhookList(ctx context.Context, req *pb.RpcWebhookListRequest) *pb.RpcWebhookListResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.TemplateCreateFromObject(ctx, req.(*pb.RpcTemplateCreateFromObjectRequest)), nil
+		return h.client.WebhookList(ctx, req.(*pb.RpcWebhookListRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "TemplateCreateFromObject", toCall)
+			return currentInterceptor(ctx, req, "WebhookList", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcTemplateCreateFromObjectResponse)
+	return call.(*pb.RpcWebhookListResponse)
 }
-func (h *ClientCommandsHandlerProxy) TemplateClone(ctx context.Context, req *pb.RpcTemplateCloneRequest) *pb.RpcTemplateCloneResponse {
+func (h *ClientCommandsHandlerProxy) WebhookRemove(ctx context.Context, req *pb.RpcWebhookRemoveRequest) *pb.RpcWebhookRemoveResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.TemplateClone(ctx, req.(*pb.RpcTemplateCloneRequest)), nil
+		return h.client.WebhookRemove(ctx, req.(*pb.RpcWebhookRemoveRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "TemplateClone", toCall)
+			return currentInterceptor(ctx, req, "WebhookRemove", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcTemplateCloneResponse)
+	return call.(*pb.RpcWebhookRemoveResponse)
 }
-func (h *ClientCommandsHandlerProxy) TemplateExportAll(ctx context.Context, req *pb.RpcTemplateExportAllRequest) *pb.RpcTemplateExportAllResponse {
+func (h *ClientCommandsHandlerProxy) WebhookDeliveryList(ctx context.Context, req *pb.RpcWebhookDeliveryListRequest) *pb.RpcWebhookDeliveryListResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
-		return h.client.TemplateExportAll(ctx, req.(*pb.RpcTemplateExportAllRequest)), nil
+		return h.client.WebhookDeliveryList(ctx, req.(*pb.RpcWebhookDeliveryListRequest)), nil
 	}
 	for _, interceptor := range h.interceptors {
 		toCall := actualCall
 		currentInterceptor := interceptor
 		actualCall = func(ctx context.Context, req any) (any, error) {
-			return currentInterceptor(ctx, req, "TemplateExportAll", toCall)
+			return currentInterceptor(ctx, req, "WebhookDeliveryList", toCall)
 		}
 	}
 	call, _ := actualCall(ctx, req)
-	return call.(*pb.RpcTemplateExportAllResponse)
+	return call.(*pb.RpcWebhookDeliveryListResponse)
 }
 func (h *ClientCommandsHandlerProxy) LinkPreview(ctx context.Context, req *pb.RpcLinkPreviewRequest) *pb.RpcLinkPreviewResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
@@ -9976,6 +11390,48 @@ func (h *ClientCommandsHandlerProxy) GalleryDownloadIndex(ctx context.Context, r
 	call, _ := actualCall(ctx, req)
 	return call.(*pb.RpcGalleryDownloadIndexResponse)
 }
+func (h *ClientCommandsHandlerProxy) WebdavStart(ctx context.Context, req *pb.RpcWebdavStartRequest) *pb.RpcWebdavStartResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.WebdavStart(ctx, req.(*pb.RpcWebdavStartRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "WebdavStart", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcWebdavStartResponse)
+}
+func (h *ClientCommandsHandlerProxy) WebdavStop(ctx context.Context, req *pb.RpcWebdavStopRequest) *pb.RpcWebdavStopResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.WebdavStop(ctx, req.(*pb.RpcWebdavStopRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "WebdavStop", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcWebdavStopResponse)
+}
+func (h *ClientCommandsHandlerProxy) WebdavInfo(ctx context.Context, req *pb.RpcWebdavInfoRequest) *pb.RpcWebdavInfoResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.WebdavInfo(ctx, req.(*pb.RpcWebdavInfoRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "WebdavInfo", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcWebdavInfoResponse)
+}
 func (h *ClientCommandsHandlerProxy) BlockUpload(ctx context.Context, req *pb.RpcBlockUploadRequest) *pb.RpcBlockUploadResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
 		return h.client.BlockUpload(ctx, req.(*pb.RpcBlockUploadRequest)), nil
@@ -11348,6 +12804,34 @@ func (h *ClientCommandsHandlerProxy) DebugSpaceSummary(ctx context.Context, req
 	call, _ := actualCall(ctx, req)
 	return call.(*pb.RpcDebugSpaceSummaryResponse)
 }
+func (h *ClientCommandsHandlerProxy) DebugSpaceVerify(ctx context.Context, req *pb.RpcDebugSpaceVerifyRequest) *pb.RpcDebugSpaceVerifyResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.DebugSpaceVerify(ctx, req.(*pb.RpcDebugSpaceVerifyRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "DebugSpaceVerify", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcDebugSpaceVerifyResponse)
+}
+func (h *ClientCommandsHandlerProxy) DebugSpaceRepair(ctx context.Context, req *pb.RpcDebugSpaceRepairRequest) *pb.RpcDebugSpaceRepairResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.DebugSpaceRepair(ctx, req.(*pb.RpcDebugSpaceRepairRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "DebugSpaceRepair", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcDebugSpaceRepairResponse)
+}
 func (h *ClientCommandsHandlerProxy) DebugStackGoroutines(ctx context.Context, req *pb.RpcDebugStackGoroutinesRequest) *pb.RpcDebugStackGoroutinesResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
 		return h.client.DebugStackGoroutines(ctx, req.(*pb.RpcDebugStackGoroutinesRequest)), nil
@@ -0,0 +1,241 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/anyproto/anytype-heart/core"
+	"github.com/anyproto/anytype-heart/core/event"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pb/service"
+	"github.com/anyproto/anytype-heart/util/vcs"
+)
+
+const dialTimeout = 5 * time.Second
+
+type options struct {
+	addr      string
+	token     string
+	appKey    string
+	embedded  bool
+	rootPath  string
+	accountId string
+	mnemonic  string
+}
+
+// client is a session of the gRPC API, either of a running server or of an embedded middleware that is served on
+// a loopback address for the lifetime of the client
+type client struct {
+	service.ClientCommandsClient
+	conn  *grpc.ClientConn
+	token string
+	stop  func()
+}
+
+func connect(ctx context.Context, opts options) (*client, error) {
+	if opts.embedded {
+		return startEmbedded(ctx, opts)
+	}
+
+	c, err := dial(ctx, opts.addr)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case opts.token != "":
+		c.token = opts.token
+	case opts.appKey != "":
+		resp, err := c.WalletCreateSession(ctx, &pb.RpcWalletCreateSessionRequest{
+			Auth: &pb.RpcWalletCreateSessionRequestAuthOfAppKey{AppKey: opts.appKey},
+		})
+		if err = rpcError("create session", resp.GetError(), err); err != nil {
+			c.Close()
+			return nil, err
+		}
+		c.token = resp.Token
+	default:
+		c.Close()
+		return nil, fmt.Errorf("either -token, -app-key or -embedded is required")
+	}
+	return c, nil
+}
+
+// readMnemonic reads the mnemonic from the first line of r
+func readMnemonic(r io.Reader) (string, error) {
+	if f, ok := r.(*os.File); ok {
+		if stat, err := f.Stat(); err == nil && stat.Mode()&os.ModeCharDevice != 0 {
+			fmt.Fprint(os.Stderr, "mnemonic: ")
+		}
+	}
+	line, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("read mnemonic: %w", err)
+	}
+	mnemonic := strings.TrimSpace(line)
+	if mnemonic == "" {
+		return "", fmt.Errorf("mnemonic is required with -embedded: set ANYTYPE_MNEMONIC or pass it on stdin")
+	}
+	return mnemonic, nil
+}
+
+func dial(ctx context.Context, addr string) (*client, error) {
+	dialCtx, cancel := context.WithTimeout(ctx, dialTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(dialCtx, addr, grpc.WithBlock(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("connect to %s: %w", addr, err)
+	}
+	return &client{ClientCommandsClient: service.NewClientCommandsClient(conn), conn: conn}, nil
+}
+
+// startEmbedded starts the middleware in-process, serves it on a random loopback port and selects the account
+func startEmbedded(ctx context.Context, opts options) (*client, error) {
+	if opts.rootPath == "" || opts.accountId == "" {
+		return nil, fmt.Errorf("-root and -account are required with -embedded")
+	}
+	if opts.mnemonic == "" {
+		mnemonic, err := readMnemonic(os.Stdin)
+		if err != nil {
+			return nil, err
+		}
+		opts.mnemonic = mnemonic
+	}
+
+	mw := core.New()
+	mw.SetEventSender(event.NewGrpcSender())
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("listen: %w", err)
+	}
+	server := grpc.NewServer(grpc.MaxRecvMsgSize(20*1024*1024), grpc.UnaryInterceptor(mw.Authorize))
+	service.RegisterClientCommandsServer(server, mw)
+	go server.Serve(lis)
+	shutdown := func() {
+		server.Stop()
+		mw.AppShutdown(context.Background(), &pb.RpcAppShutdownRequest{})
+	}
+
+	c, err := dial(ctx, lis.Addr().String())
+	if err != nil {
+		shutdown()
+		return nil, err
+	}
+	c.stop = shutdown
+	if err = c.selectAccount(ctx, opts); err != nil {
+		c.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *client) selectAccount(ctx context.Context, opts options) error {
+	initResp, err := c.InitialSetParameters(ctx, &pb.RpcInitialSetParametersRequest{
+		Platform: "anyctl",
+		Version:  vcs.GetVCSInfo().Version(),
+		Workdir:  opts.rootPath,
+	})
+	if err = rpcError("set initial parameters", initResp.GetError(), err); err != nil {
+		return err
+	}
+
+	recoverResp, err := c.WalletRecover(ctx, &pb.RpcWalletRecoverRequest{
+		RootPath: opts.rootPath,
+		Mnemonic: opts.mnemonic,
+	})
+	if err = rpcError("recover wallet", recoverResp.GetError(), err); err != nil {
+		return err
+	}
+
+	sessionResp, err := c.WalletCreateSession(ctx, &pb.RpcWalletCreateSessionRequest{
+		Auth: &pb.RpcWalletCreateSessionRequestAuthOfMnemonic{Mnemonic: opts.mnemonic},
+	})
+	if err = rpcError("create session", sessionResp.GetError(), err); err != nil {
+		return err
+	}
+	c.token = sessionResp.Token
+
+	selectResp, err := c.AccountSelect(c.ctx(ctx), &pb.RpcAccountSelectRequest{
+		Id:       opts.accountId,
+		RootPath: opts.rootPath,
+	})
+	return rpcError("select account", selectResp.GetError(), err)
+}
+
+// ctx returns a context that authorizes calls with the session token
+func (c *client) ctx(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "token", c.token)
+}
+
+// events streams the event messages of the session until the context is done
+func (c *client) events(ctx context.Context) (<-chan *pb.EventMessage, error) {
+	stream, err := c.ListenSessionEvents(c.ctx(ctx), &pb.StreamRequest{Token: c.token})
+	if err != nil {
+		return nil, fmt.Errorf("listen session events: %w", err)
+	}
+	messages := make(chan *pb.EventMessage)
+	go func() {
+		defer close(messages)
+		for {
+			ev, err := stream.Recv()
+			if err != nil {
+				if !errors.Is(err, io.EOF) && ctx.Err() == nil {
+					fmt.Fprintln(os.Stderr, "anyctl: receive event:", err)
+				}
+				return
+			}
+			for _, msg := range ev.Messages {
+				select {
+				case messages <- msg:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return messages, nil
+}
+
+func (c *client) Close() {
+	if c.stop != nil {
+		c.AccountStop(c.ctx(context.Background()), &pb.RpcAccountStopRequest{})
+	}
+	c.conn.Close()
+	if c.stop != nil {
+		c.stop()
+	}
+}
+
+// rpcResponseError is implemented by the errors of all rpc responses; NULL code of zero means success
+type rpcResponseError[C ~int32] interface {
+	GetCode() C
+	GetDescription() string
+}
+
+// rpcError returns the transport error of a call or the error of its response, if any
+func rpcError[C interface {
+	~int32
+	fmt.Stringer
+}](op string, respErr rpcResponseError[C], err error) error {
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	code := respErr.GetCode()
+	if code == 0 {
+		return nil
+	}
+	if desc := respErr.GetDescription(); desc != "" {
+		return fmt.Errorf("%s: %s: %s", op, code, desc)
+	}
+	return fmt.Errorf("%s: %s", op, code)
+}
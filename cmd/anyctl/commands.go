@@ -0,0 +1,510 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const chatSubId = "anyctl-chat"
+
+func newFlagSet(name string) *flag.FlagSet {
+	return flag.NewFlagSet("anyctl "+name, flag.ContinueOnError)
+}
+
+type spaceResult struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func runSpaces(ctx context.Context, c *client, out *printer, args []string) error {
+	if err := newFlagSet("spaces").Parse(args); err != nil {
+		return err
+	}
+	ctx = c.ctx(ctx)
+
+	allResp, err := c.WorkspaceGetAll(ctx, &pb.RpcWorkspaceGetAllRequest{})
+	if err = rpcError("list spaces", allResp.GetError(), err); err != nil {
+		return err
+	}
+	spaces := make([]spaceResult, 0, len(allResp.WorkspaceIds))
+	if len(allResp.WorkspaceIds) == 0 {
+		return out.result(spaces, []string{"ID", "NAME"}, nil)
+	}
+
+	// space names are stored in the space views of the tech space, which is the same for all spaces of the account
+	openResp, err := c.WorkspaceOpen(ctx, &pb.RpcWorkspaceOpenRequest{SpaceId: allResp.WorkspaceIds[0]})
+	if err = rpcError("open space", openResp.GetError(), err); err != nil {
+		return err
+	}
+	views, err := search(ctx, c, &pb.RpcObjectSearchRequest{
+		SpaceId: openResp.Info.TechSpaceId,
+		Filters: []*model.BlockContentDataviewFilter{
+			{
+				RelationKey: bundle.RelationKeyResolvedLayout.String(),
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       pbtypes.Int64(int64(model.ObjectType_spaceView)),
+			},
+		},
+		Keys: []string{bundle.RelationKeyTargetSpaceId.String(), bundle.RelationKeyName.String()},
+	})
+	if err != nil {
+		return err
+	}
+	names := make(map[string]string, len(views))
+	for _, view := range views {
+		names[pbtypes.GetString(view, bundle.RelationKeyTargetSpaceId.String())] = pbtypes.GetString(view, bundle.RelationKeyName.String())
+	}
+
+	rows := make([][]string, 0, len(allResp.WorkspaceIds))
+	for _, id := range allResp.WorkspaceIds {
+		spaces = append(spaces, spaceResult{Id: id, Name: names[id]})
+		rows = append(rows, []string{id, orDash(names[id])})
+	}
+	return out.result(spaces, []string{"ID", "NAME"}, rows)
+}
+
+func runSearch(ctx context.Context, c *client, out *printer, args []string) error {
+	fs := newFlagSet("search")
+	spaceId := fs.String("space", "", "id of the space to search in (required)")
+	limit := fs.Int("limit", 100, "maximum number of objects to return")
+	archived := fs.Bool("archived", false, "include archived objects")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: anyctl search -space <id> [flags] [query]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *spaceId == "" {
+		return fmt.Errorf("search: -space is required")
+	}
+
+	filters := []*model.BlockContentDataviewFilter{
+		{
+			RelationKey: bundle.RelationKeyIsHidden.String(),
+			Condition:   model.BlockContentDataviewFilter_NotEqual,
+			Value:       pbtypes.Bool(true),
+		},
+	}
+	if !*archived {
+		filters = append(filters, &model.BlockContentDataviewFilter{
+			RelationKey: bundle.RelationKeyIsArchived.String(),
+			Condition:   model.BlockContentDataviewFilter_NotEqual,
+			Value:       pbtypes.Bool(true),
+		})
+	}
+	records, err := search(c.ctx(ctx), c, &pb.RpcObjectSearchRequest{
+		SpaceId:  *spaceId,
+		Filters:  filters,
+		FullText: strings.Join(fs.Args(), " "),
+		Limit:    int32(*limit),
+		Sorts: []*model.BlockContentDataviewSort{
+			{
+				RelationKey: bundle.RelationKeyLastModifiedDate.String(),
+				Type:        model.BlockContentDataviewSort_Desc,
+			},
+		},
+		Keys: []string{
+			bundle.RelationKeyId.String(),
+			bundle.RelationKeyName.String(),
+			bundle.RelationKeyType.String(),
+			bundle.RelationKeyLastModifiedDate.String(),
+			bundle.RelationKeyIsArchived.String(),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	objects := make([]map[string]any, 0, len(records))
+	rows := make([][]string, 0, len(records))
+	for _, record := range records {
+		objects = append(objects, pbtypes.StructToMap(record))
+		rows = append(rows, []string{
+			pbtypes.GetString(record, bundle.RelationKeyId.String()),
+			orDash(pbtypes.GetString(record, bundle.RelationKeyName.String())),
+			formatTime(pbtypes.GetInt64(record, bundle.RelationKeyLastModifiedDate.String())),
+		})
+	}
+	return out.result(objects, []string{"ID", "NAME", "MODIFIED"}, rows)
+}
+
+type createResult struct {
+	File     string `json:"file"`
+	ObjectId string `json:"object_id"`
+	Name     string `json:"name"`
+}
+
+func runCreate(ctx context.Context, c *client, out *printer, args []string) error {
+	fs := newFlagSet("create")
+	spaceId := fs.String("space", "", "id of the space to create the objects in (required)")
+	typeKey := fs.String("type", bundle.TypeKeyPage.String(), "unique key of the type of the objects")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: anyctl create -space <id> [flags] <file.md>...")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *spaceId == "" || fs.NArg() == 0 {
+		fs.Usage()
+		return fmt.Errorf("create: -space and at least one file are required")
+	}
+	ctx = c.ctx(ctx)
+
+	results := make([]createResult, 0, fs.NArg())
+	rows := make([][]string, 0, fs.NArg())
+	for _, path := range fs.Args() {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("create: %w", err)
+		}
+		name, body := splitMarkdownTitle(string(data))
+		if name == "" {
+			name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		}
+
+		objectId, err := createObject(ctx, c, *spaceId, *typeKey, name, body)
+		if err != nil {
+			return fmt.Errorf("create %s: %w", path, err)
+		}
+		results = append(results, createResult{File: path, ObjectId: objectId, Name: name})
+		rows = append(rows, []string{path, objectId, name})
+	}
+	return out.result(results, []string{"FILE", "ID", "NAME"}, rows)
+}
+
+func createObject(ctx context.Context, c *client, spaceId, typeKey, name, body string) (string, error) {
+	createResp, err := c.ObjectCreate(ctx, &pb.RpcObjectCreateRequest{
+		SpaceId:             spaceId,
+		ObjectTypeUniqueKey: typeKey,
+		Details: &types.Struct{Fields: map[string]*types.Value{
+			bundle.RelationKeyName.String(): pbtypes.String(name),
+		}},
+	})
+	if err = rpcError("create object", createResp.GetError(), err); err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(body) == "" {
+		return createResp.ObjectId, nil
+	}
+
+	blockResp, err := c.BlockCreate(ctx, &pb.RpcBlockCreateRequest{
+		ContextId: createResp.ObjectId,
+		Block: &model.Block{
+			Content: &model.BlockContentOfText{Text: &model.BlockContentText{}},
+		},
+		Position: model.Block_Bottom,
+	})
+	if err = rpcError("create block", blockResp.GetError(), err); err != nil {
+		return createResp.ObjectId, err
+	}
+	pasteResp, err := c.BlockPaste(ctx, &pb.RpcBlockPasteRequest{
+		ContextId:      createResp.ObjectId,
+		FocusedBlockId: blockResp.BlockId,
+		TextSlot:       body,
+	})
+	if err = rpcError("paste body", pasteResp.GetError(), err); err != nil {
+		return createResp.ObjectId, err
+	}
+	return createResp.ObjectId, nil
+}
+
+// splitMarkdownTitle returns the text of a leading level one heading and the rest of the document
+func splitMarkdownTitle(doc string) (title string, body string) {
+	rest := doc
+	for {
+		line, next, found := strings.Cut(rest, "\n")
+		line = strings.TrimSpace(line)
+		if line == "" && found {
+			rest = next
+			continue
+		}
+		if heading, ok := strings.CutPrefix(line, "# "); ok {
+			return strings.TrimSpace(heading), strings.TrimLeft(next, "\r\n")
+		}
+		return "", doc
+	}
+}
+
+type exportResult struct {
+	Path     string `json:"path"`
+	Exported int32  `json:"exported"`
+}
+
+func runExport(ctx context.Context, c *client, out *printer, args []string) error {
+	fs := newFlagSet("export")
+	spaceId := fs.String("space", "", "id of the space to export (required)")
+	path := fs.String("out", "", "directory to export to (required)")
+	format := fs.String("format", "markdown", "export format: markdown, protobuf or json")
+	zip := fs.Bool("zip", false, "export as a zip archive")
+	files := fs.Bool("files", true, "include files")
+	nested := fs.Bool("nested", true, "include linked objects")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: anyctl export -space <id> -out <dir> [flags] [object id]...")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *spaceId == "" || *path == "" {
+		fs.Usage()
+		return fmt.Errorf("export: -space and -out are required")
+	}
+	exportFormat, ok := parseExportFormat(*format)
+	if !ok {
+		return fmt.Errorf("export: unknown format %q", *format)
+	}
+
+	resp, err := c.ObjectListExport(c.ctx(ctx), &pb.RpcObjectListExportRequest{
+		SpaceId:       *spaceId,
+		Path:          *path,
+		ObjectIds:     fs.Args(),
+		Format:        exportFormat,
+		Zip:           *zip,
+		IncludeNested: *nested,
+		IncludeFiles:  *files,
+		IsJson:        exportFormat == model.Export_JSON,
+		NoProgress:    true,
+	})
+	if err = rpcError("export", resp.GetError(), err); err != nil {
+		return err
+	}
+	result := exportResult{Path: resp.Path, Exported: resp.Succeed}
+	return out.result(result, []string{"PATH", "EXPORTED"}, [][]string{{result.Path, fmt.Sprint(result.Exported)}})
+}
+
+func parseExportFormat(format string) (model.ExportFormat, bool) {
+	switch strings.ToLower(format) {
+	case "markdown", "md":
+		return model.Export_Markdown, true
+	case "protobuf", "pb":
+		return model.Export_Protobuf, true
+	case "json":
+		return model.Export_JSON, true
+	}
+	return 0, false
+}
+
+type importResult struct {
+	Paths []string `json:"paths"`
+}
+
+func runImport(ctx context.Context, c *client, out *printer, args []string) error {
+	fs := newFlagSet("import")
+	spaceId := fs.String("space", "", "id of the space to import into (required)")
+	noCollection := fs.Bool("no-collection", false, "don't create a collection with the imported objects")
+	ignoreErrors := fs.Bool("ignore-errors", false, "import the remaining files when some of them fail")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: anyctl import -space <id> [flags] <path>...")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *spaceId == "" || fs.NArg() == 0 {
+		fs.Usage()
+		return fmt.Errorf("import: -space and at least one path are required")
+	}
+
+	paths := make([]string, 0, fs.NArg())
+	for _, path := range fs.Args() {
+		abs, err := filepath.Abs(path)
+		if err != nil {
+			return fmt.Errorf("import: %w", err)
+		}
+		paths = append(paths, abs)
+	}
+	mode := pb.RpcObjectImportRequest_ALL_OR_NOTHING
+	if *ignoreErrors {
+		mode = pb.RpcObjectImportRequest_IGNORE_ERRORS
+	}
+	resp, err := c.ObjectImport(c.ctx(ctx), &pb.RpcObjectImportRequest{
+		SpaceId: *spaceId,
+		Params: &pb.RpcObjectImportRequestParamsOfMarkdownParams{
+			MarkdownParams: &pb.RpcObjectImportRequestMarkdownParams{
+				Path:         paths,
+				NoCollection: *noCollection,
+			},
+		},
+		Type:       model.Import_Markdown,
+		Mode:       mode,
+		NoProgress: true,
+	})
+	if err = rpcError("import", resp.GetError(), err); err != nil {
+		return err
+	}
+	rows := make([][]string, 0, len(paths))
+	for _, path := range paths {
+		rows = append(rows, []string{path})
+	}
+	return out.result(importResult{Paths: paths}, []string{"IMPORTED"}, rows)
+}
+
+func runChat(ctx context.Context, c *client, out *printer, args []string) error {
+	fs := newFlagSet("chat")
+	chatId := fs.String("chat", "", "id of the chat object (required)")
+	limit := fs.Int("n", 20, "number of last messages to print")
+	follow := fs.Bool("follow", false, "keep printing new messages until interrupted")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *chatId == "" {
+		fs.Usage()
+		return fmt.Errorf("chat: -chat is required")
+	}
+
+	// listen before subscribing so that no message is lost between the two
+	var events <-chan *pb.EventMessage
+	if *follow {
+		var err error
+		if events, err = c.events(ctx); err != nil {
+			return err
+		}
+	}
+
+	resp, err := c.ChatSubscribeLastMessages(c.ctx(ctx), &pb.RpcChatSubscribeLastMessagesRequest{
+		ChatObjectId: *chatId,
+		Limit:        int32(*limit),
+		SubId:        chatSubId,
+	})
+	if err = rpcError("subscribe to chat", resp.GetError(), err); err != nil {
+		return err
+	}
+	defer c.ChatUnsubscribe(c.ctx(context.Background()), &pb.RpcChatUnsubscribeRequest{ChatObjectId: *chatId, SubId: chatSubId})
+
+	for _, msg := range resp.Messages {
+		if err := printChatMessage(out, msg); err != nil {
+			return err
+		}
+	}
+	if !*follow {
+		return nil
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev, ok := <-events:
+			if !ok {
+				return fmt.Errorf("chat: event stream closed")
+			}
+			add := ev.GetChatAdd()
+			if add == nil || !slices.Contains(add.SubIds, chatSubId) {
+				continue
+			}
+			if err := printChatMessage(out, add.Message); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func printChatMessage(out *printer, msg *model.ChatMessage) error {
+	return out.result(msg, nil, [][]string{{
+		formatTime(msg.CreatedAt),
+		msg.Creator,
+		msg.GetMessage().GetText(),
+	}})
+}
+
+type syncStatusResult struct {
+	SpaceId string             `json:"space_id"`
+	Counts  map[string]int     `json:"counts"`
+	Objects []syncStatusObject `json:"objects"`
+}
+
+type syncStatusObject struct {
+	Id       string `json:"id"`
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	Error    string `json:"error,omitempty"`
+	SyncDate int64  `json:"sync_date,omitempty"`
+}
+
+func runSyncStatus(ctx context.Context, c *client, out *printer, args []string) error {
+	fs := newFlagSet("sync-status")
+	spaceId := fs.String("space", "", "id of the space (required)")
+	all := fs.Bool("all", false, "list all objects instead of only those that are not synced")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *spaceId == "" {
+		fs.Usage()
+		return fmt.Errorf("sync-status: -space is required")
+	}
+
+	records, err := search(c.ctx(ctx), c, &pb.RpcObjectSearchRequest{
+		SpaceId: *spaceId,
+		Keys: []string{
+			bundle.RelationKeyId.String(),
+			bundle.RelationKeyName.String(),
+			bundle.RelationKeySyncStatus.String(),
+			bundle.RelationKeySyncError.String(),
+			bundle.RelationKeySyncDate.String(),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	result := syncStatusResult{SpaceId: *spaceId, Counts: map[string]int{}, Objects: []syncStatusObject{}}
+	var rows [][]string
+	for _, record := range records {
+		status := model.SyncStatus(pbtypes.GetInt64(record, bundle.RelationKeySyncStatus.String()))
+		statusName := strings.ToLower(strings.TrimPrefix(status.String(), "SyncStatus"))
+		result.Counts[statusName]++
+		if status == model.SyncStatus_SyncStatusSynced && !*all {
+			continue
+		}
+		obj := syncStatusObject{
+			Id:       pbtypes.GetString(record, bundle.RelationKeyId.String()),
+			Name:     pbtypes.GetString(record, bundle.RelationKeyName.String()),
+			Status:   statusName,
+			SyncDate: pbtypes.GetInt64(record, bundle.RelationKeySyncDate.String()),
+		}
+		if syncErr := model.SyncError(pbtypes.GetInt64(record, bundle.RelationKeySyncError.String())); syncErr != model.SyncError_SyncErrorNull {
+			obj.Error = strings.TrimPrefix(syncErr.String(), "SyncError")
+		}
+		result.Objects = append(result.Objects, obj)
+		rows = append(rows, []string{obj.Id, orDash(obj.Name), obj.Status, orDash(obj.Error), formatTime(obj.SyncDate)})
+	}
+
+	if !out.json {
+		for _, status := range []model.SyncStatus{
+			model.SyncStatus_SyncStatusSynced,
+			model.SyncStatus_SyncStatusSyncing,
+			model.SyncStatus_SyncStatusQueued,
+			model.SyncStatus_SyncStatusError,
+		} {
+			name := strings.ToLower(strings.TrimPrefix(status.String(), "SyncStatus"))
+			fmt.Fprintf(out.w, "%s: %d\n", name, result.Counts[name])
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		fmt.Fprintln(out.w)
+	}
+	return out.result(result, []string{"ID", "NAME", "STATUS", "ERROR", "SYNCED"}, rows)
+}
+
+func search(ctx context.Context, c *client, req *pb.RpcObjectSearchRequest) ([]*types.Struct, error) {
+	resp, err := c.ObjectSearch(ctx, req)
+	if err = rpcError("search objects", resp.GetError(), err); err != nil {
+		return nil, err
+	}
+	return resp.Records, nil
+}
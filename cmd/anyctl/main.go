@@ -0,0 +1,102 @@
+// anyctl is a headless command-line client for scripting against the middleware. It connects to a running
+// cmd/grpcserver or starts an embedded instance for an account, and prints its results as text or, with -json,
+// as JSON for use in shell pipelines.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"syscall"
+)
+
+const defaultAddr = "127.0.0.1:31007"
+
+type command struct {
+	usage string
+	run   func(ctx context.Context, c *client, out *printer, args []string) error
+}
+
+var commands = map[string]command{
+	"spaces":      {usage: "list the spaces of the account", run: runSpaces},
+	"search":      {usage: "search objects in a space", run: runSearch},
+	"create":      {usage: "create objects from markdown files", run: runCreate},
+	"export":      {usage: "export objects of a space to a directory", run: runExport},
+	"import":      {usage: "import markdown files or directories into a space", run: runImport},
+	"chat":        {usage: "print the last messages of a chat and optionally follow new ones", run: runChat},
+	"sync-status": {usage: "print the sync status of the objects in a space", run: runSyncStatus},
+}
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, "anyctl:", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	var opts options
+	fs := flag.NewFlagSet("anyctl", flag.ContinueOnError)
+	fs.Usage = func() { usage(fs) }
+	fs.StringVar(&opts.addr, "addr", envOr("ANYTYPE_GRPC_ADDR", defaultAddr), "address of the gRPC server")
+	fs.StringVar(&opts.token, "token", os.Getenv("ANYTYPE_TOKEN"), "session token")
+	fs.StringVar(&opts.appKey, "app-key", os.Getenv("ANYTYPE_APP_KEY"), "app key to create a session with, when no token is given")
+	fs.BoolVar(&opts.embedded, "embedded", false, "start an embedded middleware instead of connecting to a running one")
+	fs.StringVar(&opts.rootPath, "root", os.Getenv("ANYTYPE_ROOT"), "root path of the account data, for -embedded")
+	fs.StringVar(&opts.accountId, "account", os.Getenv("ANYTYPE_ACCOUNT"), "id of the account to select, for -embedded")
+	jsonOutput := fs.Bool("json", false, "print results as JSON")
+	if err := fs.Parse(os.Args[1:]); err != nil {
+		return err
+	}
+	// the mnemonic is never taken from flags, so it doesn't show up in the process list and shell history
+	opts.mnemonic = os.Getenv("ANYTYPE_MNEMONIC")
+
+	if fs.NArg() == 0 {
+		usage(fs)
+		return fmt.Errorf("no command given")
+	}
+	name := fs.Arg(0)
+	cmd, ok := commands[name]
+	if !ok {
+		usage(fs)
+		return fmt.Errorf("unknown command %q", name)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	c, err := connect(ctx, opts)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	return cmd.run(ctx, c, newPrinter(os.Stdout, *jsonOutput), fs.Args()[1:])
+}
+
+func usage(fs *flag.FlagSet) {
+	out := fs.Output()
+	fmt.Fprintln(out, "Usage: anyctl [flags] <command> [command flags]")
+	fmt.Fprintln(out, "\nCommands:")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(out, "  %-12s %s\n", name, commands[name].usage)
+	}
+	fmt.Fprintln(out, "\nFlags:")
+	fs.PrintDefaults()
+	fmt.Fprintln(out, "\nWith -embedded, the mnemonic of the account is read from ANYTYPE_MNEMONIC or, if it's not set, from stdin.")
+}
+
+func envOr(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
@@ -250,7 +250,11 @@ func (mw *Middleware) AccountChangeJsonApiAddr(ctx context.Context, req *pb.RpcA
 func (mw *Middleware) AccountLocalLinkNewChallenge(ctx context.Context, request *pb.RpcAccountLocalLinkNewChallengeRequest) *pb.RpcAccountLocalLinkNewChallengeResponse {
 	info := getClientInfo(ctx)
 	info.Name = request.AppName
-	challengeId, err := mw.applicationService.LinkLocalStartNewChallenge(request.Scope, &info)
+	challengeId, err := mw.applicationService.LinkLocalStartNewChallenge(request.Scope, &info, walletComp.AppLinkAccess{
+		SpaceIds: request.SpaceIds,
+		ReadOnly: request.ReadOnly,
+		ExpireAt: request.ExpireAt,
+	})
 	code := mapErrorCode(err,
 		errToCode(session.ErrTooManyChallengeRequests, pb.RpcAccountLocalLinkNewChallengeResponseError_TOO_MANY_REQUESTS),
 		errToCode(application.ErrApplicationIsNotRunning, pb.RpcAccountLocalLinkNewChallengeResponseError_ACCOUNT_IS_NOT_RUNNING),
@@ -298,13 +302,14 @@ func (mw *Middleware) AccountLocalLinkCreateApp(_ context.Context, req *pb.RpcAc
 }
 
 func (mw *Middleware) AccountLocalLinkListApps(_ context.Context, req *pb.RpcAccountLocalLinkListAppsRequest) *pb.RpcAccountLocalLinkListAppsResponse {
-	apps, err := mw.applicationService.LinkLocalListApps()
+	apps, access, err := mw.applicationService.LinkLocalListApps()
 	code := mapErrorCode(err,
 		errToCode(application.ErrApplicationIsNotRunning, pb.RpcAccountLocalLinkListAppsResponseError_ACCOUNT_IS_NOT_RUNNING),
 	)
 
 	return &pb.RpcAccountLocalLinkListAppsResponse{
-		App: apps,
+		App:    apps,
+		Access: access,
 		Error: &pb.RpcAccountLocalLinkListAppsResponseError{
 			Code:        code,
 			Description: getErrorDescription(err),
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/anyproto/any-sync/app"
@@ -51,7 +52,7 @@ type AccountPermissions struct {
 
 type AclService interface {
 	app.Component
-	GenerateInvite(ctx context.Context, spaceId string, inviteType model.InviteType, permissions model.ParticipantPermissions) (domain.InviteInfo, error)
+	GenerateInvite(ctx context.Context, spaceId string, inviteType model.InviteType, permissions model.ParticipantPermissions, limits domain.InviteLimits) (domain.InviteInfo, error)
 	ChangeInvite(ctx context.Context, spaceId string, permissions model.ParticipantPermissions) error
 	RevokeInvite(ctx context.Context, spaceId string) error
 	GetCurrentInvite(ctx context.Context, spaceId string) (domain.InviteInfo, error)
@@ -92,9 +93,13 @@ type aclService struct {
 	recordVerifier   recordverifier.AcceptorVerifier
 	updater          *aclUpdater
 	getter           *aclGetter
+	limitsChecker    *inviteLimitsChecker
+	// inviteUsesLock serializes accepts of join requests, so uses of invites are not exceeded
+	inviteUsesLock sync.Mutex
 
-	ctx       context.Context
-	ctxCancel context.CancelFunc
+	ctx           context.Context
+	ctxCancel     context.CancelFunc
+	limitsChecked chan struct{}
 }
 
 func (a *aclService) Init(ap *app.App) (err error) {
@@ -122,6 +127,9 @@ func (a *aclService) Init(ap *app.App) (err error) {
 		return err
 	}
 
+	a.limitsChecker = newInviteLimitsChecker(wlt.Account().SignKey.GetPublic().Account(), crossSub)
+	a.limitsChecked = make(chan struct{})
+
 	a.ctx, a.ctxCancel = context.WithCancel(context.Background())
 	a.recordVerifier = recordverifier.New()
 	return nil
@@ -136,11 +144,13 @@ func (a *aclService) Run(_ context.Context) (err error) {
 			}
 		}()
 	}
+	go a.runInviteLimitsChecker()
 	return nil
 }
 
 func (a *aclService) Close(ctx context.Context) (err error) {
 	a.ctxCancel()
+	<-a.limitsChecked
 	if a.updater != nil {
 		// Close always waiting for Run to finish under the hood
 		return a.updater.Close()
@@ -441,6 +451,9 @@ func (a *aclService) Join(ctx context.Context, spaceId, networkId string, invite
 	if err != nil {
 		return convertedOrInternalError("get invite payload", err)
 	}
+	if inviteservice.LimitsFromPayload(invitePayload).IsExpired(time.Now()) {
+		return inviteservice.ErrInviteExpired
+	}
 	onJoinError := func(err error) error {
 		if errors.Is(err, coordinatorproto.ErrSpaceIsDeleted) {
 			return space.ErrSpaceDeleted
@@ -564,6 +577,9 @@ func (a *aclService) ViewInvite(ctx context.Context, inviteCid cid.Cid, inviteFi
 	}
 	for _, inv := range lst.AclState().Invites() {
 		if inviteKey.GetPublic().Equals(inv.Key) {
+			if err = checkPayloadLimits(lst, inv.Key, res.Limits); err != nil {
+				return domain.InviteView{}, err
+			}
 			return res, nil
 		}
 	}
@@ -605,18 +621,20 @@ func (a *aclService) Accept(ctx context.Context, spaceId string, identity crypto
 	case model.ParticipantPermissions_Writer:
 		aclPerms = list.AclPermissionsWriter
 	}
-	err = cl.AcceptRequest(ctx, list.RequestAcceptPayload{
-		RequestRecordId: recId,
-		Permissions:     aclPerms,
+	return a.acceptWithinLimits(ctx, spaceId, identity, func() error {
+		err := cl.AcceptRequest(ctx, list.RequestAcceptPayload{
+			RequestRecordId: recId,
+			Permissions:     aclPerms,
+		})
+		if err != nil {
+			return convertedOrAclRequestError(err)
+		}
+		return nil
 	})
-	if err != nil {
-		return convertedOrAclRequestError(err)
-	}
-	return nil
 }
 
 func (a *aclService) GetCurrentInvite(ctx context.Context, spaceId string) (domain.InviteInfo, error) {
-	return a.inviteService.GetCurrent(ctx, spaceId)
+	return a.currentInviteWithUses(ctx, spaceId)
 }
 
 func (a *aclService) ChangeInvite(ctx context.Context, spaceId string, permissions model.ParticipantPermissions) (err error) {
@@ -661,15 +679,19 @@ func (a *aclService) ChangeInvite(ctx context.Context, spaceId string, permissio
 	return nil
 }
 
-func (a *aclService) GenerateInvite(ctx context.Context, spaceId string, invType model.InviteType, permissions model.ParticipantPermissions) (result domain.InviteInfo, err error) {
+func (a *aclService) GenerateInvite(ctx context.Context, spaceId string, invType model.InviteType, permissions model.ParticipantPermissions, limits domain.InviteLimits) (result domain.InviteInfo, err error) {
 	if spaceId == a.accountService.PersonalSpaceID() {
 		err = ErrPersonalSpace
 		return
 	}
+	if limits.MaxUses < 0 || limits.Uses != 0 || limits.IsExpired(time.Now()) {
+		err = ErrIncorrectInviteLimits
+		return
+	}
 	inviteType := domain.InviteType(invType)
-	current, err := a.inviteService.GetCurrent(ctx, spaceId)
+	current, err := a.currentInviteWithUses(ctx, spaceId)
 	if err == nil {
-		if current.InviteType == inviteType {
+		if current.InviteType == inviteType && current.Limits.SameAs(limits) && inviteservice.CheckLimits(current.Limits) == nil {
 			return current, nil
 		}
 	}
@@ -693,6 +715,7 @@ func (a *aclService) GenerateInvite(ctx context.Context, spaceId string, invType
 		Key:         res.InviteKey,
 		InviteType:  inviteType,
 		Permissions: aclPermissions,
+		Limits:      limits,
 	}
 	return a.inviteService.Generate(ctx, params, func() error {
 		err := aclClient.AddRecord(ctx, res.InviteRec)
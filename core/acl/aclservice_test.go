@@ -137,6 +137,7 @@ func newFixture(t *testing.T) *fixture {
 		Output:  events,
 	}, nil)
 	fx.mockJoiningClient.EXPECT().AclGetRecords(ctx, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("no acl found")).AnyTimes()
+	fx.mockInviteService.EXPECT().ListLimitedSpaces(mock.Anything).Return(nil, nil).Maybe()
 	require.NoError(t, fx.a.Start(ctx))
 	// Give async goroutines time to start
 	time.Sleep(10 * time.Millisecond)
@@ -509,7 +510,7 @@ func TestService_GenerateInvite(t *testing.T) {
 					InviteFileCid: "testCid",
 				}, f()
 			})
-		info, err := fx.GenerateInvite(ctx, spaceId, model.InviteType_Member, model.ParticipantPermissions_Reader)
+		info, err := fx.GenerateInvite(ctx, spaceId, model.InviteType_Member, model.ParticipantPermissions_Reader, domain.InviteLimits{})
 		require.NoError(t, err)
 		require.Equal(t, "testCid", info.InviteFileCid)
 	})
@@ -548,7 +549,7 @@ func TestService_GenerateInvite(t *testing.T) {
 					InviteFileCid: "testCid",
 				}, f()
 			})
-		info, err := fx.GenerateInvite(ctx, spaceId, model.InviteType_WithoutApprove, model.ParticipantPermissions_Reader)
+		info, err := fx.GenerateInvite(ctx, spaceId, model.InviteType_WithoutApprove, model.ParticipantPermissions_Reader, domain.InviteLimits{})
 		require.NoError(t, err)
 		require.Equal(t, "testCid", info.InviteFileCid)
 	})
@@ -589,7 +590,7 @@ func TestService_GenerateInvite(t *testing.T) {
 					InviteFileCid: "testCid",
 				}, f()
 			})
-		info, err := fx.GenerateInvite(ctx, spaceId, model.InviteType_WithoutApprove, model.ParticipantPermissions_Reader)
+		info, err := fx.GenerateInvite(ctx, spaceId, model.InviteType_WithoutApprove, model.ParticipantPermissions_Reader, domain.InviteLimits{})
 		require.NoError(t, err)
 		require.Equal(t, "testCid", info.InviteFileCid)
 	})
@@ -602,10 +603,68 @@ func TestService_GenerateInvite(t *testing.T) {
 			InviteType:    domain.InviteTypeAnyone,
 			InviteFileCid: "testCid",
 		}, nil)
-		info, err := fx.GenerateInvite(ctx, spaceId, model.InviteType_WithoutApprove, model.ParticipantPermissions_Reader)
+		info, err := fx.GenerateInvite(ctx, spaceId, model.InviteType_WithoutApprove, model.ParticipantPermissions_Reader, domain.InviteLimits{})
 		require.NoError(t, err)
 		require.Equal(t, "testCid", info.InviteFileCid)
 	})
+	t.Run("invite already exists with other limits", func(t *testing.T) {
+		fx := newFixture(t)
+		defer fx.finish(t)
+		spaceId := "spaceId"
+		keys, err := accountdata.NewRandom()
+		require.NoError(t, err)
+		limits := domain.InviteLimits{
+			ExpiresAt: time.Now().Add(time.Hour),
+			MaxUses:   5,
+		}
+		fx.mockAccountService.EXPECT().PersonalSpaceID().Return("personal")
+		fx.mockInviteService.EXPECT().GetCurrent(ctx, spaceId).Return(domain.InviteInfo{
+			InviteType:    domain.InviteTypeAnyone,
+			InviteFileCid: "oldCid",
+		}, nil)
+		mockSpace := mock_clientspace.NewMockSpace(t)
+		mockCommonSpace := mock_commonspace.NewMockSpace(fx.ctrl)
+		mockAclClient := mock_aclclient.NewMockAclSpaceClient(fx.ctrl)
+		mockSpace.EXPECT().CommonSpace().Return(mockCommonSpace)
+		mockCommonSpace.EXPECT().AclClient().Return(mockAclClient)
+		fx.mockSpaceService.EXPECT().Get(ctx, spaceId).Return(mockSpace, nil)
+		rec := &consensusproto.RawRecord{
+			Payload: []byte("test"),
+		}
+		mockAclClient.EXPECT().ReplaceInvite(gomock.Any(), gomock.Any()).
+			Return(list.InviteResult{
+				InviteRec: rec,
+				InviteKey: keys.SignKey,
+			}, nil)
+		params := inviteservice.GenerateInviteParams{
+			SpaceId:     spaceId,
+			InviteType:  domain.InviteTypeAnyone,
+			Key:         keys.SignKey,
+			Permissions: list.AclPermissionsReader,
+			Limits:      limits,
+		}
+		mockAclClient.EXPECT().AddRecord(ctx, rec).Return(nil)
+		fx.mockInviteService.EXPECT().Generate(ctx, params, mock.Anything).
+			RunAndReturn(func(ctx2 context.Context, params inviteservice.GenerateInviteParams, f func() error) (domain.InviteInfo, error) {
+				return domain.InviteInfo{
+					InviteFileCid: "testCid",
+					Limits:        params.Limits,
+				}, f()
+			})
+		info, err := fx.GenerateInvite(ctx, spaceId, model.InviteType_WithoutApprove, model.ParticipantPermissions_Reader, limits)
+		require.NoError(t, err)
+		require.Equal(t, "testCid", info.InviteFileCid)
+		require.Equal(t, limits, info.Limits)
+	})
+	t.Run("expired limits", func(t *testing.T) {
+		fx := newFixture(t)
+		defer fx.finish(t)
+		fx.mockAccountService.EXPECT().PersonalSpaceID().Return("personal")
+		_, err := fx.GenerateInvite(ctx, "spaceId", model.InviteType_Member, model.ParticipantPermissions_Reader, domain.InviteLimits{
+			ExpiresAt: time.Now().Add(-time.Hour),
+		})
+		require.ErrorIs(t, err, ErrIncorrectInviteLimits)
+	})
 }
 
 func TestService_Join(t *testing.T) {
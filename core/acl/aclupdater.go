@@ -15,6 +15,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/core/subscription"
 	"github.com/anyproto/anytype-heart/core/subscription/crossspacesub"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 )
 
 type participantGetter interface {
@@ -99,6 +100,7 @@ func newAclUpdater(
 		id,
 		ownIdentity,
 		crossSpaceSubService,
+		model.ParticipantStatus_Removing,
 		func(identity crypto.PubKey, spaceId string) error {
 			id := domain.NewParticipantId(spaceId, identity.Account())
 			scheduler.Remove(id)
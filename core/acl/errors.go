@@ -12,15 +12,16 @@ import (
 )
 
 var (
-	ErrRequestNotExists     = errors.New("request doesn't exist")
-	ErrPersonalSpace        = errors.New("sharing of personal space is forbidden")
-	ErrIncorrectPermissions = errors.New("incorrect permissions")
-	ErrNoSuchAccount        = errors.New("no such user")
-	ErrAclRequestFailed     = errors.New("acl request failed")
-	ErrNotShareable         = errors.New("space is not shareable")
-	ErrLimitReached         = errors.New("limit reached")
-	ErrDifferentNetwork     = errors.New("different network")
-	ErrInternal             = errors.New("internal error")
+	ErrRequestNotExists      = errors.New("request doesn't exist")
+	ErrPersonalSpace         = errors.New("sharing of personal space is forbidden")
+	ErrIncorrectPermissions  = errors.New("incorrect permissions")
+	ErrNoSuchAccount         = errors.New("no such user")
+	ErrAclRequestFailed      = errors.New("acl request failed")
+	ErrNotShareable          = errors.New("space is not shareable")
+	ErrLimitReached          = errors.New("limit reached")
+	ErrDifferentNetwork      = errors.New("different network")
+	ErrInternal              = errors.New("internal error")
+	ErrIncorrectInviteLimits = errors.New("incorrect invite limits")
 )
 
 var passthroughErrors = []error{
@@ -30,6 +31,8 @@ var passthroughErrors = []error{
 	inviteservice.ErrInviteGenerate,
 	inviteservice.ErrInviteRemove,
 	inviteservice.ErrInviteBadContent,
+	inviteservice.ErrInviteExpired,
+	inviteservice.ErrInviteUsedUp,
 }
 
 func convertErrorOrReturn(err, otherErr error) error {
@@ -54,6 +57,8 @@ func convertErrorOrReturn(err, otherErr error) error {
 		return wrapError("acl service error", err, ErrRequestNotExists)
 	case errors.Is(err, list.ErrNoSuchAccount):
 		return wrapError("acl service error", err, ErrNoSuchAccount)
+	case errors.Is(err, list.ErrNoSuchInvite):
+		return wrapError("acl service error", err, inviteservice.ErrInviteNotExists)
 	default:
 		return otherErr
 	}
@@ -22,6 +22,8 @@ func TestConvertError(t *testing.T) {
 			inviteservice.ErrInviteGenerate,
 			inviteservice.ErrInviteRemove,
 			inviteservice.ErrInviteBadContent,
+			inviteservice.ErrInviteExpired,
+			inviteservice.ErrInviteUsedUp,
 		}
 		for _, err := range passthroughErrors {
 			newErr := convertErrorOrReturn(err, ErrInternal)
@@ -60,6 +62,10 @@ func TestConvertError(t *testing.T) {
 		err = list.ErrNoSuchAccount
 		err = convertErrorOrReturn(err, ErrInternal)
 		require.True(t, errors.Is(err, ErrNoSuchAccount))
+
+		err = list.ErrNoSuchInvite
+		err = convertErrorOrReturn(err, ErrInternal)
+		require.True(t, errors.Is(err, inviteservice.ErrInviteNotExists))
 	})
 	t.Run("other error for converted", func(t *testing.T) {
 		err := fmt.Errorf("test")
@@ -0,0 +1,279 @@
+package acl
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/anyproto/any-sync/commonspace/object/acl/aclrecordproto"
+	"github.com/anyproto/any-sync/commonspace/object/acl/list"
+	"github.com/anyproto/any-sync/util/crypto"
+	"github.com/ipfs/go-cid"
+	"go.uber.org/zap"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/inviteservice"
+	"github.com/anyproto/anytype-heart/core/subscription/crossspacesub"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/encode"
+)
+
+const inviteLimitsSubId = "acl-invite-limits"
+
+var inviteLimitsCheckPeriod = time.Minute
+
+// inviteLimitsChecker revokes invites that are expired or used up. Expiration is checked periodically,
+// and uses are checked as soon as new participants appear in spaces, because joins without approval
+// are not seen by the invite creator in advance
+type inviteLimitsChecker struct {
+	participantGetter participantGetter
+
+	lock    sync.Mutex
+	pending map[string]struct{}
+	notify  chan struct{}
+}
+
+func newInviteLimitsChecker(ownIdentity string, crossSpaceSubService crossspacesub.Service) *inviteLimitsChecker {
+	c := &inviteLimitsChecker{
+		pending: map[string]struct{}{},
+		notify:  make(chan struct{}, 1),
+	}
+	c.participantGetter = newParticipantGetter(
+		inviteLimitsSubId,
+		ownIdentity,
+		crossSpaceSubService,
+		model.ParticipantStatus_Active,
+		func(identity crypto.PubKey, spaceId string) error {
+			return nil
+		},
+		func(identity crypto.PubKey, spaceId string) error {
+			c.push(spaceId)
+			return nil
+		},
+	)
+	return c
+}
+
+func (c *inviteLimitsChecker) push(spaceId string) {
+	c.lock.Lock()
+	c.pending[spaceId] = struct{}{}
+	c.lock.Unlock()
+	select {
+	case c.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (c *inviteLimitsChecker) takePending() []string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	spaceIds := make([]string, 0, len(c.pending))
+	for spaceId := range c.pending {
+		spaceIds = append(spaceIds, spaceId)
+	}
+	c.pending = map[string]struct{}{}
+	return spaceIds
+}
+
+func (a *aclService) runInviteLimitsChecker() {
+	defer close(a.limitsChecked)
+	if err := a.limitsChecker.participantGetter.Run(a.ctx); err != nil {
+		log.Warn("run invite limits participant subscription", zap.Error(err))
+	}
+	defer func() {
+		if err := a.limitsChecker.participantGetter.Close(); err != nil {
+			log.Warn("close invite limits participant subscription", zap.Error(err))
+		}
+	}()
+	ticker := time.NewTicker(inviteLimitsCheckPeriod)
+	defer ticker.Stop()
+	a.checkInviteLimits(a.ctx, nil)
+	for {
+		select {
+		case <-a.ctx.Done():
+			return
+		case <-ticker.C:
+			a.checkInviteLimits(a.ctx, nil)
+		case <-a.limitsChecker.notify:
+			a.checkInviteLimits(a.ctx, a.limitsChecker.takePending())
+		}
+	}
+}
+
+// checkInviteLimits checks limits of invites in given spaces, or in all spaces with limited invites if nil is passed
+func (a *aclService) checkInviteLimits(ctx context.Context, spaceIds []string) {
+	limitedSpaceIds, err := a.inviteService.ListLimitedSpaces(ctx)
+	if err != nil {
+		log.Warn("list spaces with limited invites", zap.Error(err))
+		return
+	}
+	for _, spaceId := range limitedSpaceIds {
+		if spaceIds != nil && !slices.Contains(spaceIds, spaceId) {
+			continue
+		}
+		if err = a.checkSpaceInviteLimits(ctx, spaceId); err != nil {
+			log.Warn("check invite limits", zap.String("spaceId", spaceId), zap.Error(err))
+		}
+	}
+}
+
+func (a *aclService) checkSpaceInviteLimits(ctx context.Context, spaceId string) error {
+	current, err := a.currentInviteWithUses(ctx, spaceId)
+	if errors.Is(err, inviteservice.ErrInviteNotExists) {
+		return a.inviteService.RemoveExisting(ctx, spaceId)
+	}
+	if err != nil {
+		return err
+	}
+	if inviteservice.CheckLimits(current.Limits) == nil {
+		return nil
+	}
+	sp, err := a.spaceService.Get(ctx, spaceId)
+	if err != nil {
+		return convertedOrSpaceErr(err)
+	}
+	acl := sp.CommonSpace().Acl()
+	acl.RLock()
+	requests, err := acl.AclState().JoinRecords(false)
+	acl.RUnlock()
+	if err != nil {
+		return convertedOrInternalError("join records get error", err)
+	}
+	for _, req := range requests {
+		if err = a.Decline(ctx, spaceId, req.RequestIdentity); err != nil {
+			log.Warn("decline request by expired invite", zap.String("spaceId", spaceId), zap.Error(err))
+		}
+	}
+	return a.RevokeInvite(ctx, spaceId)
+}
+
+// currentInviteWithUses returns the current invite of the space with uses counted from the ACL,
+// so all devices of the invite creator agree on them
+func (a *aclService) currentInviteWithUses(ctx context.Context, spaceId string) (domain.InviteInfo, error) {
+	current, err := a.inviteService.GetCurrent(ctx, spaceId)
+	if err != nil {
+		return domain.InviteInfo{}, err
+	}
+	if current.Limits.MaxUses == 0 {
+		return current, nil
+	}
+	inviteKey, err := a.inviteKey(ctx, current)
+	if err != nil {
+		return domain.InviteInfo{}, err
+	}
+	sp, err := a.spaceService.Get(ctx, spaceId)
+	if err != nil {
+		return domain.InviteInfo{}, convertedOrSpaceErr(err)
+	}
+	acl := sp.CommonSpace().Acl()
+	acl.RLock()
+	defer acl.RUnlock()
+	current.Limits.Uses = countInviteUses(acl, inviteKey)
+	return current, nil
+}
+
+// inviteKey returns the public key of the ACL invite record the invite was generated for
+func (a *aclService) inviteKey(ctx context.Context, info domain.InviteInfo) (crypto.PubKey, error) {
+	inviteCid, err := cid.Decode(info.InviteFileCid)
+	if err != nil {
+		return nil, convertedOrInternalError("decode invite cid", err)
+	}
+	inviteFileKey, err := encode.DecodeKeyFromBase58(info.InviteFileKey)
+	if err != nil {
+		return nil, convertedOrInternalError("decode invite file key", err)
+	}
+	payload, err := a.inviteService.GetPayload(ctx, inviteCid, inviteFileKey)
+	if err != nil {
+		return nil, convertedOrInternalError("get invite payload", err)
+	}
+	key, err := crypto.UnmarshalEd25519PrivateKeyProto(payload.AclKey)
+	if err != nil {
+		return nil, convertedOrInternalError("unmarshal invite key", err)
+	}
+	return key.GetPublic(), nil
+}
+
+// countInviteUses returns the number of joins by the invite with the given key: joins without approval
+// and accepted requests made with it. Joins by other invites are not counted. ACL must be locked by the caller
+func countInviteUses(acl list.AclList, inviteKey crypto.PubKey) (count int) {
+	var inviteId string
+	for _, invite := range acl.AclState().Invites() {
+		if invite.Key.Equals(inviteKey) {
+			inviteId = invite.Id
+			break
+		}
+	}
+	if inviteId == "" {
+		return 0
+	}
+	requestIds := map[string]struct{}{}
+	acl.IterateFrom(acl.Root().Id, func(record *list.AclRecord) bool {
+		data, ok := record.Model.(*aclrecordproto.AclData)
+		if !ok {
+			return true
+		}
+		for _, content := range data.GetAclContent() {
+			if join := content.GetInviteJoin(); join != nil && join.InviteRecordId == inviteId {
+				count++
+			}
+			if join := content.GetRequestJoin(); join != nil && join.InviteRecordId == inviteId {
+				requestIds[record.Id] = struct{}{}
+			}
+			if accept := content.GetRequestAccept(); accept != nil {
+				if _, ok := requestIds[accept.RequestRecordId]; ok {
+					count++
+				}
+			}
+		}
+		return true
+	})
+	return count
+}
+
+// acceptWithinLimits accepts the join request only if the current invite of the space is not expired or used up.
+// Uses are counted and the request is accepted under the lock, so concurrent accepts could not exceed the limit
+func (a *aclService) acceptWithinLimits(ctx context.Context, spaceId string, identity crypto.PubKey, accept func() error) error {
+	a.inviteUsesLock.Lock()
+	current, err := a.currentInviteWithUses(ctx, spaceId)
+	if errors.Is(err, inviteservice.ErrInviteNotExists) {
+		a.inviteUsesLock.Unlock()
+		return accept()
+	}
+	if err != nil {
+		a.inviteUsesLock.Unlock()
+		return convertedOrInternalError("get current invite", err)
+	}
+	if current.Limits == (domain.InviteLimits{}) {
+		a.inviteUsesLock.Unlock()
+		return accept()
+	}
+	if err = inviteservice.CheckLimits(current.Limits); err != nil {
+		a.inviteUsesLock.Unlock()
+		if declineErr := a.Decline(ctx, spaceId, identity); declineErr != nil {
+			log.Warn("decline request by expired invite", zap.String("spaceId", spaceId), zap.Error(declineErr))
+		}
+		return err
+	}
+	err = accept()
+	a.inviteUsesLock.Unlock()
+	if err != nil {
+		return err
+	}
+	if err = a.checkSpaceInviteLimits(ctx, spaceId); err != nil {
+		log.Warn("check invite limits after accept", zap.String("spaceId", spaceId), zap.Error(err))
+	}
+	return nil
+}
+
+// checkPayloadLimits refuses invites which could not be used anymore on the side of the joining user
+func checkPayloadLimits(acl list.AclList, inviteKey crypto.PubKey, limits domain.InviteLimits) error {
+	if limits == (domain.InviteLimits{}) {
+		return nil
+	}
+	if limits.MaxUses > 0 {
+		limits.Uses = countInviteUses(acl, inviteKey)
+	}
+	return inviteservice.CheckLimits(limits)
+}
@@ -0,0 +1,47 @@
+package acl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/anyproto/any-sync/commonspace/object/acl/aclrecordproto"
+	"github.com/anyproto/any-sync/commonspace/object/acl/list"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/inviteservice"
+)
+
+func TestCountInviteUses(t *testing.T) {
+	exec := list.NewAclExecutor("spaceId")
+	for _, cmd := range []string{
+		"a.init::a",
+		"a.invite::invId",
+		"b.join::invId",
+		"a.approve::b,r",
+		"c.join::invId",
+		"a.invite_anyone::anyoneId,r",
+		"d.invite_join::anyoneId",
+		"e.invite_join::anyoneId",
+	} {
+		require.NoError(t, exec.Execute(cmd), cmd)
+	}
+	acl := exec.ActualAccounts()["a"].Acl
+	requestInviteKey := acl.AclState().Invites(aclrecordproto.AclInviteType_RequestToJoin)[0].Key
+	anyoneInviteKey := acl.AclState().Invites(aclrecordproto.AclInviteType_AnyoneCanJoin)[0].Key
+
+	t.Run("approved requests and joins without approval are counted per invite", func(t *testing.T) {
+		require.Equal(t, 1, countInviteUses(acl, requestInviteKey))
+		require.Equal(t, 2, countInviteUses(acl, anyoneInviteKey))
+	})
+	t.Run("used up invite is refused by the joining user", func(t *testing.T) {
+		require.NoError(t, checkPayloadLimits(acl, anyoneInviteKey, domain.InviteLimits{MaxUses: 3}))
+		require.ErrorIs(t, checkPayloadLimits(acl, anyoneInviteKey, domain.InviteLimits{MaxUses: 2}), inviteservice.ErrInviteUsedUp)
+		require.ErrorIs(t, checkPayloadLimits(acl, anyoneInviteKey, domain.InviteLimits{ExpiresAt: time.Now().Add(-time.Minute)}), inviteservice.ErrInviteExpired)
+	})
+	t.Run("joins by revoked invites are not counted", func(t *testing.T) {
+		require.NoError(t, exec.Execute("a.revoke::anyoneId"))
+		require.Equal(t, 0, countInviteUses(acl, anyoneInviteKey))
+		require.Equal(t, 1, countInviteUses(acl, requestInviteKey))
+	})
+}
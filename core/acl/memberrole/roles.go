@@ -0,0 +1,229 @@
+package memberrole
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/subscription"
+	"github.com/anyproto/anytype-heart/core/subscription/objectsubscription"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const (
+	rolesSubscriptionId    = "memberRoles"
+	contentsSubscriptionId = "memberRoleCollection"
+)
+
+var roleKeys = []domain.RelationKey{
+	bundle.RelationKeyId,
+	bundle.RelationKeySpaceCommenterIds,
+	bundle.RelationKeySpaceCollectionEditorIds,
+	bundle.RelationKeyCollectionEditorIds,
+}
+
+// spaceRoles keeps details of the workspace and collections with editors in memory,
+// along with the contents of these collections, as roles are checked on every apply of objects
+type spaceRoles struct {
+	spaceId     string
+	workspaceId string
+	subService  subscription.Service
+	sub         *objectsubscription.ObjectSubscription[*domain.Details]
+
+	lock   sync.Mutex
+	closed bool
+	// contents are subscriptions to objects of collections, nil value means that the subscription is being created
+	contents map[string]*objectsubscription.ObjectSubscription[struct{}]
+}
+
+func newSpaceRoles(subService subscription.Service, spaceId, workspaceId string) (*spaceRoles, error) {
+	r := &spaceRoles{
+		spaceId:     spaceId,
+		workspaceId: workspaceId,
+		subService:  subService,
+		contents:    map[string]*objectsubscription.ObjectSubscription[struct{}]{},
+	}
+	keys := make([]string, 0, len(roleKeys))
+	for _, key := range roleKeys {
+		keys = append(keys, key.String())
+	}
+	r.sub = objectsubscription.New(subService, subscription.SubscribeRequest{
+		SpaceId:           spaceId,
+		SubId:             r.rolesSubId(),
+		Keys:              keys,
+		NoDepSubscription: true,
+		Internal:          true,
+		Filters: []database.FilterRequest{{
+			Operator: model.BlockContentDataviewFilter_Or,
+			NestedFilters: []database.FilterRequest{
+				{
+					RelationKey: bundle.RelationKeyId,
+					Condition:   model.BlockContentDataviewFilter_Equal,
+					Value:       domain.String(workspaceId),
+				},
+				{
+					RelationKey: bundle.RelationKeyCollectionEditorIds,
+					Condition:   model.BlockContentDataviewFilter_NotEmpty,
+				},
+			},
+		}},
+	}, r.subscriptionParams())
+	if err := r.sub.Run(); err != nil {
+		return nil, fmt.Errorf("run member roles subscription: %w", err)
+	}
+	// subscriptions to collections could open them, so they are never created under the lock of the object
+	// that requested the role, as it could be one of these collections
+	r.sub.Iterate(func(id string, _ *domain.Details) bool {
+		if id != workspaceId {
+			go r.subscribeContents(id)
+		}
+		return true
+	})
+	return r, nil
+}
+
+func (r *spaceRoles) subscriptionParams() objectsubscription.SubscriptionParams[*domain.Details] {
+	return objectsubscription.SubscriptionParams[*domain.Details]{
+		SetDetails: func(details *domain.Details) (string, *domain.Details) {
+			return details.GetString(bundle.RelationKeyId), details.CopyOnlyKeys(roleKeys...)
+		},
+		UpdateKeys: func(keyValues []objectsubscription.RelationKeyValue, details *domain.Details) *domain.Details {
+			details = details.Copy()
+			for _, kv := range keyValues {
+				details.Set(domain.RelationKey(kv.Key), kv.Value)
+			}
+			return details
+		},
+		RemoveKeys: func(keys []string, details *domain.Details) *domain.Details {
+			details = details.Copy()
+			for _, key := range keys {
+				details.Delete(domain.RelationKey(key))
+			}
+			return details
+		},
+		OnAdded: func(id string, _ *domain.Details) {
+			if id != r.workspaceId {
+				go r.subscribeContents(id)
+			}
+		},
+		OnRemoved: func(id string, _ *domain.Details) {
+			go r.unsubscribeContents(id)
+		},
+	}
+}
+
+func (r *spaceRoles) role(participantId string) domain.MemberRole {
+	workspace, ok := r.sub.Get(r.workspaceId)
+	if !ok {
+		return domain.MemberRole{}
+	}
+	if slices.Contains(workspace.GetStringList(bundle.RelationKeySpaceCommenterIds), participantId) {
+		return domain.MemberRole{Type: domain.MemberRoleCommenter}
+	}
+	if !slices.Contains(workspace.GetStringList(bundle.RelationKeySpaceCollectionEditorIds), participantId) {
+		return domain.MemberRole{}
+	}
+	role := domain.MemberRole{Type: domain.MemberRoleCollectionEditor}
+	r.sub.Iterate(func(id string, details *domain.Details) bool {
+		if id != r.workspaceId && slices.Contains(details.GetStringList(bundle.RelationKeyCollectionEditorIds), participantId) {
+			role.CollectionIds = append(role.CollectionIds, id)
+		}
+		return true
+	})
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for _, collectionId := range role.CollectionIds {
+		contents := r.contents[collectionId]
+		if contents == nil {
+			continue
+		}
+		contents.Iterate(func(id string, _ struct{}) bool {
+			role.ObjectIds = append(role.ObjectIds, id)
+			return true
+		})
+	}
+	return role
+}
+
+func (r *spaceRoles) subscribeContents(collectionId string) {
+	r.lock.Lock()
+	if _, ok := r.contents[collectionId]; ok || r.closed {
+		r.lock.Unlock()
+		return
+	}
+	r.contents[collectionId] = nil
+	r.lock.Unlock()
+
+	contents := objectsubscription.NewIdSubscription(r.subService, subscription.SubscribeRequest{
+		SpaceId:           r.spaceId,
+		SubId:             r.contentsSubId(collectionId),
+		Keys:              []string{bundle.RelationKeyId.String()},
+		CollectionId:      collectionId,
+		NoDepSubscription: true,
+		Internal:          true,
+	})
+	err := contents.Run()
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if err != nil {
+		delete(r.contents, collectionId)
+		log.Warn("failed to subscribe to collection contents", zap.String("collectionId", collectionId), zap.Error(err))
+		return
+	}
+	if _, ok := r.contents[collectionId]; !ok || r.closed {
+		// collection was removed while the subscription was being created
+		r.closeContents(collectionId, contents)
+		return
+	}
+	r.contents[collectionId] = contents
+}
+
+func (r *spaceRoles) unsubscribeContents(collectionId string) {
+	r.lock.Lock()
+	contents := r.contents[collectionId]
+	delete(r.contents, collectionId)
+	r.lock.Unlock()
+	if contents != nil {
+		r.closeContents(collectionId, contents)
+	}
+}
+
+func (r *spaceRoles) closeContents(collectionId string, contents *objectsubscription.ObjectSubscription[struct{}]) {
+	contents.Close()
+	if err := r.subService.Unsubscribe(r.contentsSubId(collectionId)); err != nil {
+		log.Warn("failed to unsubscribe from collection contents", zap.String("collectionId", collectionId), zap.Error(err))
+	}
+}
+
+func (r *spaceRoles) close() {
+	r.lock.Lock()
+	r.closed = true
+	contents := r.contents
+	r.contents = map[string]*objectsubscription.ObjectSubscription[struct{}]{}
+	r.lock.Unlock()
+
+	for collectionId, sub := range contents {
+		if sub != nil {
+			r.closeContents(collectionId, sub)
+		}
+	}
+	r.sub.Close()
+	if err := r.subService.Unsubscribe(r.rolesSubId()); err != nil {
+		log.Warn("failed to unsubscribe from member roles", zap.String("spaceId", r.spaceId), zap.Error(err))
+	}
+}
+
+func (r *spaceRoles) rolesSubId() string {
+	return fmt.Sprintf("%s-%s", rolesSubscriptionId, r.spaceId)
+}
+
+func (r *spaceRoles) contentsSubId(collectionId string) string {
+	return fmt.Sprintf("%s-%s-%s", contentsSubscriptionId, r.spaceId, collectionId)
+}
@@ -0,0 +1,93 @@
+package memberrole
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cheggaaa/mb/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/subscription"
+	"github.com/anyproto/anytype-heart/core/subscription/mock_subscription"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/util/futures"
+)
+
+const (
+	testSpaceId     = "space1"
+	testWorkspaceId = "workspace"
+)
+
+func newTestService(t *testing.T) *service {
+	subService := mock_subscription.NewMockService(t)
+	subService.EXPECT().Search(mock.MatchedBy(func(req subscription.SubscribeRequest) bool {
+		return req.CollectionId == ""
+	})).Return(&subscription.SubscribeResponse{
+		Records: []*domain.Details{
+			domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+				bundle.RelationKeyId:                       domain.String(testWorkspaceId),
+				bundle.RelationKeySpaceCommenterIds:        domain.StringList([]string{"commenter"}),
+				bundle.RelationKeySpaceCollectionEditorIds: domain.StringList([]string{"editor"}),
+			}),
+			domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+				bundle.RelationKeyId:                  domain.String("collection"),
+				bundle.RelationKeyCollectionEditorIds: domain.StringList([]string{"editor"}),
+			}),
+		},
+		Output: mb.New[*pb.EventMessage](0),
+	}, nil).Once()
+	subService.EXPECT().Search(mock.MatchedBy(func(req subscription.SubscribeRequest) bool {
+		return req.CollectionId == "collection"
+	})).Return(&subscription.SubscribeResponse{
+		Records: []*domain.Details{
+			domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+				bundle.RelationKeyId: domain.String("object"),
+			}),
+		},
+		Output: mb.New[*pb.EventMessage](0),
+	}, nil).Once()
+	subService.EXPECT().Unsubscribe(mock.Anything).Return(nil).Maybe()
+
+	s := &service{
+		subscriptionService: subService,
+		roles:               map[string]*futures.Future[*spaceRoles]{},
+	}
+	t.Cleanup(func() {
+		require.NoError(t, s.Close(context.Background()))
+	})
+	return s
+}
+
+func TestService_MemberRole(t *testing.T) {
+	s := newTestService(t)
+
+	t.Run("commenter", func(t *testing.T) {
+		role := s.MemberRole(testSpaceId, testWorkspaceId, "commenter")
+		assert.Equal(t, domain.MemberRole{Type: domain.MemberRoleCommenter}, role)
+	})
+
+	t.Run("collection editor could edit objects of collections", func(t *testing.T) {
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			role := s.MemberRole(testSpaceId, testWorkspaceId, "editor")
+			assert.Equal(c, domain.MemberRole{
+				Type:          domain.MemberRoleCollectionEditor,
+				CollectionIds: []string{"collection"},
+				ObjectIds:     []string{"object"},
+			}, role)
+		}, time.Second, 10*time.Millisecond)
+
+		role := s.MemberRole(testSpaceId, testWorkspaceId, "editor")
+		assert.True(t, role.CanEdit("object"))
+		assert.False(t, role.CanEdit("other"))
+	})
+
+	t.Run("writer", func(t *testing.T) {
+		role := s.MemberRole(testSpaceId, testWorkspaceId, "writer")
+		assert.Equal(t, domain.MemberRole{}, role)
+	})
+}
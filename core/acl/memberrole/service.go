@@ -0,0 +1,226 @@
+package memberrole
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/anyproto/any-sync/app/logger"
+	"github.com/anyproto/any-sync/commonspace/object/acl/list"
+	"github.com/anyproto/any-sync/util/crypto"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/anyproto/anytype-heart/core/acl"
+	"github.com/anyproto/anytype-heart/core/block/cache"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/subscription"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/space"
+	"github.com/anyproto/anytype-heart/util/futures"
+)
+
+const CName = "common.acl.memberrole"
+
+var log = logger.NewNamed(CName)
+
+var (
+	ErrNoCollections = errors.New("collection editor role requires at least one collection")
+	ErrNotCollection = errors.New("object is not a collection")
+	ErrNotWriter     = errors.New("only writers could get a narrowed role")
+)
+
+type Service interface {
+	app.ComponentRunnable
+	smartblock.MemberRoleProvider
+
+	// SetRole records the role of the participant in details of the workspace and collections, so all members apply it.
+	// Roles only narrow permissions of writers, ACL permissions of the participant are never changed
+	SetRole(ctx context.Context, spaceId, identity string, role domain.MemberRole) error
+}
+
+type service struct {
+	spaceService        space.Service
+	objectGetter        cache.ObjectGetter
+	objectStore         objectstore.ObjectStore
+	subscriptionService subscription.Service
+
+	lock  sync.Mutex
+	roles map[string]*futures.Future[*spaceRoles]
+}
+
+func New() Service {
+	return &service{}
+}
+
+func (s *service) Init(a *app.App) error {
+	s.spaceService = app.MustComponent[space.Service](a)
+	s.objectGetter = app.MustComponent[cache.ObjectGetter](a)
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	s.subscriptionService = app.MustComponent[subscription.Service](a)
+	s.roles = make(map[string]*futures.Future[*spaceRoles])
+	return nil
+}
+
+func (s *service) Name() string {
+	return CName
+}
+
+func (s *service) Run(_ context.Context) error {
+	return nil
+}
+
+func (s *service) Close(_ context.Context) error {
+	s.lock.Lock()
+	roles := s.roles
+	s.roles = make(map[string]*futures.Future[*spaceRoles])
+	s.lock.Unlock()
+	for _, future := range roles {
+		if spaceRoles, err := future.Wait(); err == nil {
+			spaceRoles.close()
+		}
+	}
+	return nil
+}
+
+// MemberRole returns the role of the participant from the in-memory subscriptions of the space
+func (s *service) MemberRole(spaceId, workspaceId, participantId string) domain.MemberRole {
+	roles, err := s.getSpaceRoles(spaceId, workspaceId)
+	if err != nil {
+		log.Warn("failed to get member roles subscription", zap.String("spaceId", spaceId), zap.Error(err))
+		return domain.MemberRole{}
+	}
+	return roles.role(participantId)
+}
+
+func (s *service) getSpaceRoles(spaceId, workspaceId string) (*spaceRoles, error) {
+	s.lock.Lock()
+	future, ok := s.roles[spaceId]
+	if ok {
+		s.lock.Unlock()
+		return future.Wait()
+	}
+	future = futures.New[*spaceRoles]()
+	s.roles[spaceId] = future
+	s.lock.Unlock()
+
+	future.Resolve(newSpaceRoles(s.subscriptionService, spaceId, workspaceId))
+	return future.Wait()
+}
+
+func (s *service) SetRole(ctx context.Context, spaceId, identity string, role domain.MemberRole) error {
+	if role.Type == domain.MemberRoleCollectionEditor && len(role.CollectionIds) == 0 {
+		return ErrNoCollections
+	}
+	key, err := crypto.DecodeAccountAddress(identity)
+	if err != nil {
+		return fmt.Errorf("decode identity: %w", err)
+	}
+	spc, err := s.spaceService.Get(ctx, spaceId)
+	if err != nil {
+		return err
+	}
+	if spc.IsPersonal() {
+		return acl.ErrPersonalSpace
+	}
+	canWrite, err := s.checkPermissions(spc.CommonSpace().Acl(), key)
+	if err != nil {
+		return err
+	}
+	if role.Type != domain.MemberRoleDefault && !canWrite {
+		return ErrNotWriter
+	}
+	spaceIndex := s.objectStore.SpaceIndex(spaceId)
+	for _, collectionId := range role.CollectionIds {
+		details, err := spaceIndex.GetDetails(collectionId)
+		if err != nil || model.ObjectTypeLayout(details.GetInt64(bundle.RelationKeyResolvedLayout)) != model.ObjectType_collection {
+			return fmt.Errorf("%w: %s", ErrNotCollection, collectionId)
+		}
+	}
+
+	participantId := domain.NewParticipantId(spaceId, identity)
+	err = cache.DoContextFullID(s.objectGetter, ctx, domain.FullID{SpaceID: spaceId, ObjectID: spc.DerivedIDs().Workspace}, func(sb smartblock.SmartBlock) error {
+		st := sb.NewState()
+		details := st.Details()
+		st.SetDetailAndBundledRelation(bundle.RelationKeySpaceCommenterIds, domain.StringList(
+			toggle(details.GetStringList(bundle.RelationKeySpaceCommenterIds), participantId, role.Type == domain.MemberRoleCommenter),
+		))
+		st.SetDetailAndBundledRelation(bundle.RelationKeySpaceCollectionEditorIds, domain.StringList(
+			toggle(details.GetStringList(bundle.RelationKeySpaceCollectionEditorIds), participantId, role.Type == domain.MemberRoleCollectionEditor),
+		))
+		return sb.Apply(st)
+	})
+	if err != nil {
+		return fmt.Errorf("update workspace: %w", err)
+	}
+	return s.updateCollections(ctx, spaceId, participantId, role.CollectionIds)
+}
+
+// checkPermissions checks that the current account manages accounts of the space and that the participant
+// is neither an owner nor an admin, as their permissions could not be narrowed
+func (s *service) checkPermissions(aclList list.AclList, key crypto.PubKey) (canWrite bool, err error) {
+	aclList.RLock()
+	defer aclList.RUnlock()
+	aclState := aclList.AclState()
+	if !aclState.Permissions(aclState.Identity()).CanManageAccounts() {
+		return false, acl.ErrIncorrectPermissions
+	}
+	perms := aclState.Permissions(key)
+	if perms.NoPermissions() {
+		return false, acl.ErrNoSuchAccount
+	}
+	if perms.CanManageAccounts() {
+		return false, acl.ErrIncorrectPermissions
+	}
+	return perms.CanWrite(), nil
+}
+
+// updateCollections lists the participant as an editor in given collections and removes it from the rest
+func (s *service) updateCollections(ctx context.Context, spaceId, participantId string, collectionIds []string) error {
+	currentIds, _, err := s.objectStore.SpaceIndex(spaceId).QueryObjectIds(database.Query{
+		Filters: []database.FilterRequest{
+			{
+				RelationKey: bundle.RelationKeyCollectionEditorIds,
+				Condition:   model.BlockContentDataviewFilter_In,
+				Value:       domain.StringList([]string{participantId}),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("query collections: %w", err)
+	}
+	var errs []error
+	for _, id := range lo.Uniq(slices.Concat(currentIds, collectionIds)) {
+		isEditor := slices.Contains(collectionIds, id)
+		if isEditor == slices.Contains(currentIds, id) {
+			continue
+		}
+		err = cache.DoContextFullID(s.objectGetter, ctx, domain.FullID{SpaceID: spaceId, ObjectID: id}, func(sb smartblock.SmartBlock) error {
+			st := sb.NewState()
+			editorIds := toggle(st.Details().GetStringList(bundle.RelationKeyCollectionEditorIds), participantId, isEditor)
+			st.SetDetailAndBundledRelation(bundle.RelationKeyCollectionEditorIds, domain.StringList(editorIds))
+			return sb.Apply(st)
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("update collection %s: %w", id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func toggle(ids []string, id string, present bool) []string {
+	ids = slices.DeleteFunc(slices.Clone(ids), func(existing string) bool {
+		return existing == id
+	})
+	if present {
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -25,6 +25,7 @@ func newParticipantGetter(
 	id string,
 	ownIdentity string,
 	crossSpaceSubService crossspacesub.Service,
+	status model.ParticipantStatus,
 	onRemove identityUpdateFunc,
 	onAdd identityUpdateFunc,
 ) participantGetter {
@@ -37,6 +38,7 @@ func newParticipantGetter(
 		cancel:               cancel,
 		id:                   id,
 		crossSpaceSubService: crossSpaceSubService,
+		status:               status,
 		onRemove:             onRemove,
 		onAdd:                onAdd,
 	}
@@ -46,6 +48,7 @@ type participantSub struct {
 	id                   string
 	ownIdentity          string
 	crossSpaceSubService crossspacesub.Service
+	status               model.ParticipantStatus
 	waiter               chan struct{}
 	internalQueue        *mb.MB[*pb.EventMessage]
 	ctx                  context.Context
@@ -70,7 +73,7 @@ func (s *participantSub) Run(ctx context.Context) error {
 			{
 				RelationKey: bundle.RelationKeyParticipantStatus,
 				Condition:   model.BlockContentDataviewFilter_Equal,
-				Value:       domain.Int64(model.ParticipantStatus_Removing),
+				Value:       domain.Int64(s.status),
 			},
 		},
 	}, newSubPredicate(s.ownIdentity))
@@ -0,0 +1,72 @@
+package core
+
+import (
+	"context"
+
+	"github.com/anyproto/anytype-heart/core/activityfeed"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+func (mw *Middleware) SpaceActivityList(cctx context.Context, req *pb.RpcSpaceActivityListRequest) *pb.RpcSpaceActivityListResponse {
+	entries, unreadCount, err := mustService[activityfeed.Service](mw).List(cctx, req.SpaceId, req.BeforeOrderId, int(req.Limit))
+	code := mapErrorCode(err,
+		errToCode(activityfeed.ErrEmptySpaceId, pb.RpcSpaceActivityListResponseError_BAD_INPUT),
+	)
+	return &pb.RpcSpaceActivityListResponse{
+		Entries:     activityEntriesToProto(entries),
+		UnreadCount: int32(unreadCount),
+		Error: &pb.RpcSpaceActivityListResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
+
+func (mw *Middleware) SpaceActivitySubscribe(cctx context.Context, req *pb.RpcSpaceActivitySubscribeRequest) *pb.RpcSpaceActivitySubscribeResponse {
+	entries, unreadCount, err := mustService[activityfeed.Service](mw).Subscribe(cctx, req.SpaceId, req.SubId, int(req.Limit))
+	code := mapErrorCode(err,
+		errToCode(activityfeed.ErrEmptySpaceId, pb.RpcSpaceActivitySubscribeResponseError_BAD_INPUT),
+		errToCode(activityfeed.ErrEmptySubId, pb.RpcSpaceActivitySubscribeResponseError_BAD_INPUT),
+	)
+	return &pb.RpcSpaceActivitySubscribeResponse{
+		Entries:     activityEntriesToProto(entries),
+		UnreadCount: int32(unreadCount),
+		Error: &pb.RpcSpaceActivitySubscribeResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
+
+func (mw *Middleware) SpaceActivityUnsubscribe(_ context.Context, req *pb.RpcSpaceActivityUnsubscribeRequest) *pb.RpcSpaceActivityUnsubscribeResponse {
+	err := mustService[activityfeed.Service](mw).Unsubscribe(req.SubId)
+	code := mapErrorCode[pb.RpcSpaceActivityUnsubscribeResponseErrorCode](err)
+	return &pb.RpcSpaceActivityUnsubscribeResponse{
+		Error: &pb.RpcSpaceActivityUnsubscribeResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
+
+func (mw *Middleware) SpaceActivityMarkSeen(cctx context.Context, req *pb.RpcSpaceActivityMarkSeenRequest) *pb.RpcSpaceActivityMarkSeenResponse {
+	unreadCount, err := mustService[activityfeed.Service](mw).MarkSeen(cctx, req.SpaceId, req.SeenUpTo)
+	code := mapErrorCode(err,
+		errToCode(activityfeed.ErrEmptySpaceId, pb.RpcSpaceActivityMarkSeenResponseError_BAD_INPUT),
+	)
+	return &pb.RpcSpaceActivityMarkSeenResponse{
+		UnreadCount: int32(unreadCount),
+		Error: &pb.RpcSpaceActivityMarkSeenResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
+
+func activityEntriesToProto(entries []activityfeed.Entry) []*pb.EventSpaceActivityEntry {
+	res := make([]*pb.EventSpaceActivityEntry, 0, len(entries))
+	for _, entry := range entries {
+		res = append(res, entry.ToProto())
+	}
+	return res
+}
@@ -0,0 +1,287 @@
+package activityfeed
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/anyproto/any-sync/commonspace/object/acl/aclrecordproto"
+	"github.com/anyproto/any-sync/commonspace/object/acl/list"
+	"github.com/anyproto/any-sync/commonspace/object/tree/objecttree"
+	"github.com/anyproto/any-sync/commonspace/objecttreebuilder"
+	"github.com/anyproto/any-sync/util/crypto"
+	"go.uber.org/zap"
+
+	"github.com/anyproto/anytype-heart/core/block/source/sourceimpl"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/space/clientspace"
+)
+
+// trackedLayouts are layouts of objects which changes are shown in the feed
+var trackedLayouts = []model.ObjectTypeLayout{
+	model.ObjectType_basic,
+	model.ObjectType_profile,
+	model.ObjectType_todo,
+	model.ObjectType_note,
+	model.ObjectType_bookmark,
+	model.ObjectType_set,
+	model.ObjectType_collection,
+	model.ObjectType_file,
+	model.ObjectType_image,
+	model.ObjectType_audio,
+	model.ObjectType_video,
+	model.ObjectType_pdf,
+	model.ObjectType_chatDerived,
+}
+
+// skipContent is used for trees which changes are counted without reading their content
+func skipContent(_ *objecttree.Change, _ []byte) (any, error) {
+	return nil, nil
+}
+
+// scanResult contains changes that are not converted to entries yet, along with heads of objects and the ACL
+// that should be saved once the entries are stored
+type scanResult struct {
+	changes   []change
+	heads     map[string][]string
+	aclHeadId string
+}
+
+// collectChanges returns changes of the space that are not converted to entries yet. Heads of objects are compared
+// with the heads seen by the previous scan, so changes made offline and synced later are found regardless of their time
+func (s *service) collectChanges(ctx context.Context, spc clientspace.Space, state spaceState) (*scanResult, error) {
+	spaceId := spc.Id()
+	records, err := s.objectStore.SpaceIndex(spaceId).Query(database.Query{
+		Filters: []database.FilterRequest{
+			{
+				RelationKey: bundle.RelationKeyResolvedLayout,
+				Condition:   model.BlockContentDataviewFilter_In,
+				Value:       domain.Int64List(trackedLayouts),
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query objects: %w", err)
+	}
+
+	res := &scanResult{heads: map[string][]string{}}
+	for _, rec := range records {
+		layout := model.ObjectTypeLayout(rec.Details.GetInt64(bundle.RelationKeyResolvedLayout))
+		objectId := rec.Details.GetString(bundle.RelationKeyId)
+		lastHeads, err := s.store.getHeads(ctx, spaceId, objectId)
+		if err != nil {
+			return nil, fmt.Errorf("get heads: %w", err)
+		}
+		if lastHeads == nil && rec.Details.GetInt64(bundle.RelationKeyLastModifiedDate) <= state.ScanFrom {
+			continue
+		}
+		if !s.hasNewHeads(ctx, spc, objectId, lastHeads) {
+			continue
+		}
+		objectChanges, heads, err := s.collectObjectChanges(ctx, spc, objectId, layout, lastHeads, state.ScanFrom)
+		if err != nil {
+			log.Warn("collect object changes", zap.String("objectId", objectId), zap.Error(err))
+			continue
+		}
+		res.changes = append(res.changes, objectChanges...)
+		res.heads[objectId] = heads
+	}
+
+	if err = s.collectArchivedChanges(ctx, spc, state.ScanFrom, res); err != nil {
+		log.Warn("collect archive changes", zap.String("spaceId", spaceId), zap.Error(err))
+	}
+	joins, aclHeadId := collectJoins(spc.CommonSpace().Acl(), spaceId, state.AclHeadId, state.ScanFrom)
+	res.changes = append(res.changes, joins...)
+	res.aclHeadId = aclHeadId
+	return res, nil
+}
+
+// hasNewHeads compares heads of the object in the space storage with heads seen by the previous scan
+func (s *service) hasNewHeads(ctx context.Context, spc clientspace.Space, objectId string, lastHeads []string) bool {
+	if lastHeads == nil {
+		return true
+	}
+	entry, err := spc.Storage().HeadStorage().GetEntry(ctx, objectId)
+	if err != nil {
+		return true
+	}
+	return !sameHeads(entry.Heads, lastHeads)
+}
+
+func sameHeads(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
+
+// seenChanges returns ids of changes reachable from the heads seen by the previous scan
+func seenChanges(tree objecttree.ReadableObjectTree, lastHeads []string) map[string]struct{} {
+	seen := map[string]struct{}{}
+	queue := slices.Clone(lastHeads)
+	for len(queue) > 0 {
+		id := queue[len(queue)-1]
+		queue = queue[:len(queue)-1]
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		c, err := tree.GetChange(id)
+		if err != nil {
+			continue
+		}
+		seen[id] = struct{}{}
+		queue = append(queue, c.PreviousIds...)
+	}
+	return seen
+}
+
+// isNewChange reports whether the change should be converted to an entry. Objects that were not scanned yet
+// are read since the first scan of the space only
+func isNewChange(c *objecttree.Change, seen map[string]struct{}, lastHeads []string, scanFrom int64) bool {
+	if _, ok := seen[c.Id]; ok || c.Identity == nil {
+		return false
+	}
+	// derived objects have zero timestamp in the root change
+	return lastHeads != nil || c.Timestamp > scanFrom
+}
+
+func (s *service) collectObjectChanges(
+	ctx context.Context, spc clientspace.Space, objectId string, layout model.ObjectTypeLayout, lastHeads []string, scanFrom int64,
+) ([]change, []string, error) {
+	tree, err := spc.TreeBuilder().BuildHistoryTree(ctx, objectId, objecttreebuilder.HistoryTreeOpts{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("build history tree: %w", err)
+	}
+	seen := seenChanges(tree, lastHeads)
+	var changes []change
+	err = tree.IterateFrom(tree.Root().Id, skipContent, func(c *objecttree.Change) (isContinue bool) {
+		if !isNewChange(c, seen, lastHeads, scanFrom) {
+			return true
+		}
+		ch := change{
+			Id:            c.Id,
+			Type:          EntryTypeObjectEdited,
+			ParticipantId: domain.NewParticipantId(spc.Id(), c.Identity.Account()),
+			ObjectIds:     []string{objectId},
+			Time:          c.Timestamp,
+		}
+		switch {
+		case c.Id == tree.Id():
+			ch.Type = EntryTypeObjectCreated
+		case layout == model.ObjectType_chatDerived:
+			ch.Type = EntryTypeCommentAdded
+		}
+		changes = append(changes, ch)
+		return true
+	})
+	return changes, tree.Heads(), err
+}
+
+// collectArchivedChanges finds objects moved to the bin. Archiving adds a link block to the archive object
+func (s *service) collectArchivedChanges(ctx context.Context, spc clientspace.Space, scanFrom int64, res *scanResult) error {
+	archiveId := spc.DerivedIDs().Archive
+	if archiveId == "" {
+		return nil
+	}
+	lastHeads, err := s.store.getHeads(ctx, spc.Id(), archiveId)
+	if err != nil {
+		return fmt.Errorf("get heads: %w", err)
+	}
+	if !s.hasNewHeads(ctx, spc, archiveId, lastHeads) {
+		return nil
+	}
+	tree, err := spc.TreeBuilder().BuildHistoryTree(ctx, archiveId, objecttreebuilder.HistoryTreeOpts{})
+	if err != nil {
+		return fmt.Errorf("build history tree: %w", err)
+	}
+	seen := seenChanges(tree, lastHeads)
+	err = tree.IterateFrom(tree.Root().Id, sourceimpl.UnmarshalChange, func(c *objecttree.Change) (isContinue bool) {
+		if !isNewChange(c, seen, lastHeads, scanFrom) || c.Id == tree.Id() {
+			return true
+		}
+		changeModel, ok := c.Model.(*pb.Change)
+		if !ok {
+			return true
+		}
+		var objectIds []string
+		for _, content := range changeModel.Content {
+			for _, block := range content.GetBlockCreate().GetBlocks() {
+				if targetId := block.GetLink().GetTargetBlockId(); targetId != "" {
+					objectIds = append(objectIds, targetId)
+				}
+			}
+		}
+		if len(objectIds) == 0 {
+			return true
+		}
+		res.changes = append(res.changes, change{
+			Id:            c.Id,
+			Type:          EntryTypeObjectArchived,
+			ParticipantId: domain.NewParticipantId(spc.Id(), c.Identity.Account()),
+			ObjectIds:     objectIds,
+			Time:          c.Timestamp,
+		})
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	res.heads[archiveId] = tree.Heads()
+	return nil
+}
+
+// collectJoins finds members joined the space by an invite or by an approved request after the last seen ACL record.
+// It returns the id of the ACL head to be saved as the last seen record
+func collectJoins(acl list.AclList, spaceId, lastHeadId string, scanFrom int64) ([]change, string) {
+	acl.RLock()
+	defer acl.RUnlock()
+	startId := lastHeadId
+	if _, err := acl.Get(lastHeadId); err != nil {
+		startId = acl.Root().Id
+		lastHeadId = ""
+	}
+	var changes []change
+	acl.IterateFrom(startId, func(rec *list.AclRecord) bool {
+		if rec.Id == lastHeadId || (lastHeadId == "" && rec.Timestamp <= scanFrom) {
+			return true
+		}
+		data, ok := rec.Model.(*aclrecordproto.AclData)
+		if !ok {
+			return true
+		}
+		for i, content := range data.GetAclContent() {
+			var identity []byte
+			switch {
+			case content.GetInviteJoin() != nil:
+				identity = content.GetInviteJoin().Identity
+			case content.GetRequestAccept() != nil:
+				identity = content.GetRequestAccept().Identity
+			default:
+				continue
+			}
+			pubKey, err := crypto.UnmarshalEd25519PublicKeyProto(identity)
+			if err != nil {
+				log.Warn("parse joined identity", zap.String("spaceId", spaceId), zap.Error(err))
+				continue
+			}
+			id := rec.Id
+			if i > 0 {
+				id = fmt.Sprintf("%s.%d", rec.Id, i)
+			}
+			participantId := domain.NewParticipantId(spaceId, pubKey.Account())
+			changes = append(changes, change{
+				Id:            id,
+				Type:          EntryTypeMemberJoined,
+				ParticipantId: participantId,
+				ObjectIds:     []string{participantId},
+				Time:          rec.Timestamp,
+			})
+		}
+		return true
+	})
+	return changes, acl.Head().Id
+}
@@ -0,0 +1,42 @@
+package activityfeed
+
+import (
+	"testing"
+
+	"github.com/anyproto/any-sync/commonspace/object/acl/list"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectJoins(t *testing.T) {
+	exec := list.NewAclExecutor("spaceId")
+	for _, cmd := range []string{
+		"a.init::a",
+		"a.invite::invId",
+		"b.join::invId",
+		"a.approve::b,r",
+	} {
+		require.NoError(t, exec.Execute(cmd), cmd)
+	}
+	acl := exec.ActualAccounts()["a"].Acl
+
+	joins, headId := collectJoins(acl, "spaceId", "", 0)
+	require.Len(t, joins, 1)
+	assert.Equal(t, EntryTypeMemberJoined, joins[0].Type)
+	assert.Equal(t, acl.Head().Id, headId)
+
+	t.Run("records before the last seen head are skipped", func(t *testing.T) {
+		joins, _ := collectJoins(acl, "spaceId", headId, 0)
+		assert.Empty(t, joins)
+
+		require.NoError(t, exec.Execute("a.invite_anyone::anyoneId,r"))
+		require.NoError(t, exec.Execute("c.invite_join::anyoneId"))
+		joins, _ = collectJoins(acl, "spaceId", headId, 0)
+		assert.Len(t, joins, 1)
+	})
+}
+
+func TestSameHeads(t *testing.T) {
+	assert.True(t, sameHeads([]string{"b", "a"}, []string{"a", "b"}))
+	assert.False(t, sameHeads([]string{"a"}, []string{"a", "b"}))
+}
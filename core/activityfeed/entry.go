@@ -0,0 +1,126 @@
+package activityfeed
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+type EntryType int
+
+const (
+	EntryTypeObjectCreated EntryType = iota
+	EntryTypeObjectEdited
+	EntryTypeObjectArchived
+	EntryTypeMemberJoined
+	EntryTypeCommentAdded
+)
+
+// Entry is an item of the space activity feed. Consecutive edits of the same participant are grouped in one entry
+type Entry struct {
+	// Id is the id of the first change of the entry, it doesn't change when newer changes are grouped into the entry
+	Id string
+	// OrderId is built from the time of the last change, so updated entries move to the top of the feed
+	OrderId       string
+	Type          EntryType
+	ParticipantId string
+	ObjectIds     []string
+	// Time and LastTime are unix timestamps of the first and the last change of the entry
+	Time     int64
+	LastTime int64
+	Count    int
+}
+
+func (e Entry) ToProto() *pb.EventSpaceActivityEntry {
+	return &pb.EventSpaceActivityEntry{
+		Id:            e.Id,
+		OrderId:       e.OrderId,
+		Type:          pb.EventSpaceActivityType(e.Type),
+		ParticipantId: e.ParticipantId,
+		ObjectIds:     e.ObjectIds,
+		Time:          e.Time,
+		LastTime:      e.LastTime,
+		Count:         int32(e.Count),
+	}
+}
+
+func buildOrderId(lastTime int64, id string) string {
+	return fmt.Sprintf("%012d/%s", lastTime, id)
+}
+
+// change is a single event collected from an object tree or the space ACL
+type change struct {
+	Id            string
+	Type          EntryType
+	ParticipantId string
+	ObjectIds     []string
+	Time          int64
+}
+
+// canGroup reports whether the change could be added to the entry. First edits of a new object are added
+// to the entry of its creation
+func (e Entry) canGroup(c change, interval int64) bool {
+	if c.Type != EntryTypeObjectEdited || e.ParticipantId != c.ParticipantId || c.Time-e.LastTime > interval {
+		return false
+	}
+	switch e.Type {
+	case EntryTypeObjectEdited:
+		return true
+	case EntryTypeObjectCreated:
+		return slices.Equal(e.ObjectIds, c.ObjectIds)
+	default:
+		return false
+	}
+}
+
+func (e *Entry) add(c change) {
+	for _, objectId := range c.ObjectIds {
+		if !slices.Contains(e.ObjectIds, objectId) {
+			e.ObjectIds = append(e.ObjectIds, objectId)
+		}
+	}
+	if c.Time > e.LastTime {
+		e.LastTime = c.Time
+	}
+	e.Count++
+	e.OrderId = buildOrderId(e.LastTime, e.Id)
+}
+
+func newEntry(c change) Entry {
+	return Entry{
+		Id:            c.Id,
+		OrderId:       buildOrderId(c.Time, c.Id),
+		Type:          c.Type,
+		ParticipantId: c.ParticipantId,
+		ObjectIds:     slices.Clone(c.ObjectIds),
+		Time:          c.Time,
+		LastTime:      c.Time,
+		Count:         1,
+	}
+}
+
+// groupChanges sorts changes by time and converts them to entries. Edits of the same participant, that follow each other
+// within the interval, are grouped in one entry. If last is not nil, changes could be grouped into it as well.
+// Returned entries are new or updated ones in ascending order
+func groupChanges(last *Entry, changes []change, interval int64) []Entry {
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Time < changes[j].Time
+	})
+	var entries []Entry
+	for _, c := range changes {
+		if len(entries) > 0 && entries[len(entries)-1].canGroup(c, interval) {
+			entries[len(entries)-1].add(c)
+			continue
+		}
+		if len(entries) == 0 && last != nil && last.canGroup(c, interval) {
+			entries = append(entries, *last)
+			entries[0].ObjectIds = slices.Clone(last.ObjectIds)
+			entries[0].add(c)
+			continue
+		}
+		entries = append(entries, newEntry(c))
+	}
+	return entries
+}
@@ -0,0 +1,94 @@
+package activityfeed
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupChanges(t *testing.T) {
+	const interval = 600
+
+	t.Run("consecutive edits of the same participant are grouped", func(t *testing.T) {
+		changes := []change{
+			{Id: "c2", Type: EntryTypeObjectEdited, ParticipantId: "alice", ObjectIds: []string{"obj2"}, Time: 200},
+			{Id: "c1", Type: EntryTypeObjectEdited, ParticipantId: "alice", ObjectIds: []string{"obj1"}, Time: 100},
+			{Id: "c3", Type: EntryTypeObjectEdited, ParticipantId: "alice", ObjectIds: []string{"obj1"}, Time: 300},
+		}
+
+		entries := groupChanges(nil, changes, interval)
+
+		require.Len(t, entries, 1)
+		assert.Equal(t, Entry{
+			Id:            "c1",
+			OrderId:       buildOrderId(300, "c1"),
+			Type:          EntryTypeObjectEdited,
+			ParticipantId: "alice",
+			ObjectIds:     []string{"obj1", "obj2"},
+			Time:          100,
+			LastTime:      300,
+			Count:         3,
+		}, entries[0])
+	})
+
+	t.Run("edits are split by other participants, other types and interval", func(t *testing.T) {
+		changes := []change{
+			{Id: "c1", Type: EntryTypeObjectEdited, ParticipantId: "alice", ObjectIds: []string{"obj1"}, Time: 100},
+			{Id: "c2", Type: EntryTypeObjectEdited, ParticipantId: "bob", ObjectIds: []string{"obj1"}, Time: 200},
+			{Id: "c3", Type: EntryTypeObjectEdited, ParticipantId: "alice", ObjectIds: []string{"obj1"}, Time: 300},
+			{Id: "c4", Type: EntryTypeCommentAdded, ParticipantId: "alice", ObjectIds: []string{"chat"}, Time: 400},
+			{Id: "c5", Type: EntryTypeCommentAdded, ParticipantId: "alice", ObjectIds: []string{"chat"}, Time: 500},
+			{Id: "c6", Type: EntryTypeObjectEdited, ParticipantId: "alice", ObjectIds: []string{"obj1"}, Time: 600},
+			{Id: "c7", Type: EntryTypeObjectEdited, ParticipantId: "alice", ObjectIds: []string{"obj1"}, Time: 1300},
+		}
+
+		entries := groupChanges(nil, changes, interval)
+
+		ids := make([]string, 0, len(entries))
+		for _, entry := range entries {
+			ids = append(ids, entry.Id)
+		}
+		assert.Equal(t, []string{"c1", "c2", "c3", "c4", "c5", "c6", "c7"}, ids)
+	})
+
+	t.Run("edits are grouped into the last stored entry", func(t *testing.T) {
+		last := newEntry(change{Id: "c1", Type: EntryTypeObjectEdited, ParticipantId: "alice", ObjectIds: []string{"obj1"}, Time: 100})
+		changes := []change{
+			{Id: "c2", Type: EntryTypeObjectEdited, ParticipantId: "alice", ObjectIds: []string{"obj2"}, Time: 200},
+			{Id: "c3", Type: EntryTypeObjectCreated, ParticipantId: "alice", ObjectIds: []string{"obj3"}, Time: 300},
+		}
+
+		entries := groupChanges(&last, changes, interval)
+
+		require.Len(t, entries, 2)
+		assert.Equal(t, "c1", entries[0].Id)
+		assert.Equal(t, buildOrderId(200, "c1"), entries[0].OrderId)
+		assert.Equal(t, []string{"obj1", "obj2"}, entries[0].ObjectIds)
+		assert.Equal(t, 2, entries[0].Count)
+		assert.Equal(t, []string{"obj1"}, last.ObjectIds)
+		assert.Equal(t, EntryTypeObjectCreated, entries[1].Type)
+	})
+
+	t.Run("first edits of a new object are grouped into its creation", func(t *testing.T) {
+		changes := []change{
+			{Id: "c1", Type: EntryTypeObjectCreated, ParticipantId: "alice", ObjectIds: []string{"obj1"}, Time: 100},
+			{Id: "c2", Type: EntryTypeObjectEdited, ParticipantId: "alice", ObjectIds: []string{"obj1"}, Time: 200},
+			{Id: "c3", Type: EntryTypeObjectEdited, ParticipantId: "alice", ObjectIds: []string{"obj1"}, Time: 300},
+			{Id: "c4", Type: EntryTypeObjectEdited, ParticipantId: "alice", ObjectIds: []string{"obj2"}, Time: 400},
+		}
+
+		entries := groupChanges(nil, changes, interval)
+
+		require.Len(t, entries, 2)
+		assert.Equal(t, EntryTypeObjectCreated, entries[0].Type)
+		assert.Equal(t, []string{"obj1"}, entries[0].ObjectIds)
+		assert.Equal(t, int64(300), entries[0].LastTime)
+		assert.Equal(t, 3, entries[0].Count)
+		assert.Equal(t, "c4", entries[1].Id)
+	})
+
+	t.Run("order ids follow time", func(t *testing.T) {
+		assert.Less(t, buildOrderId(99, "b"), buildOrderId(100, "a"))
+	})
+}
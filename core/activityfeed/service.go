@@ -0,0 +1,375 @@
+package activityfeed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/cheggaaa/mb/v3"
+	"go.uber.org/zap"
+
+	"github.com/anyproto/anytype-heart/core/anytype/account"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/event"
+	"github.com/anyproto/anytype-heart/core/subscription"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/datastore/anystoreprovider"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/space"
+)
+
+const (
+	CName = "core.activityfeed"
+
+	defaultLimit = 50
+	// groupInterval is the max interval between edits of the same participant to be shown as one entry
+	groupInterval = int64(10 * time.Minute / time.Second)
+	// initialScanPeriod limits the history read when the feed of the space is built for the first time
+	initialScanPeriod = 30 * 24 * time.Hour
+)
+
+// refreshDelay is used to collect object changes in batches while the feed is subscribed
+var refreshDelay = 2 * time.Second
+
+var log = logging.Logger(CName).Desugar()
+
+var (
+	ErrEmptySpaceId = errors.New("space id is empty")
+	ErrEmptySubId   = errors.New("subscription id is empty")
+)
+
+type Service interface {
+	app.ComponentRunnable
+
+	// List returns entries of the space feed, newest first. Use the order id of the last returned entry as beforeOrderId
+	// to get the next page
+	List(ctx context.Context, spaceId, beforeOrderId string, limit int) (entries []Entry, unreadCount int, err error)
+	// Subscribe returns the newest entries of the space feed, new and updated entries are sent in events to subId
+	Subscribe(ctx context.Context, spaceId, subId string, limit int) (entries []Entry, unreadCount int, err error)
+	Unsubscribe(subId string) error
+	// MarkSeen marks entries without changes after seenUpTo as read. If seenUpTo is zero, all entries are marked as read
+	MarkSeen(ctx context.Context, spaceId string, seenUpTo int64) (unreadCount int, err error)
+}
+
+type spaceSub struct {
+	subIds map[string]struct{}
+	cancel context.CancelFunc
+}
+
+type service struct {
+	objectStore         objectstore.ObjectStore
+	spaceService        space.Service
+	accountService      account.Service
+	eventSender         event.Sender
+	subscriptionService subscription.Service
+	store               *store
+
+	componentCtx       context.Context
+	componentCtxCancel context.CancelFunc
+
+	// refreshLock guards the feed building, so changes are not converted to entries twice
+	refreshLock sync.Mutex
+
+	subsLock  sync.Mutex
+	spaceSubs map[string]*spaceSub
+	// subSpaces maps subscription ids to space ids
+	subSpaces map[string]string
+}
+
+func New() Service {
+	return &service{
+		spaceSubs: map[string]*spaceSub{},
+		subSpaces: map[string]string{},
+	}
+}
+
+func (s *service) Init(a *app.App) (err error) {
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	s.spaceService = app.MustComponent[space.Service](a)
+	s.accountService = app.MustComponent[account.Service](a)
+	s.eventSender = app.MustComponent[event.Sender](a)
+	s.subscriptionService = app.MustComponent[subscription.Service](a)
+	s.componentCtx, s.componentCtxCancel = context.WithCancel(context.Background())
+
+	provider := app.MustComponent[anystoreprovider.Provider](a)
+	s.store, err = newStore(s.componentCtx, provider.GetCommonDb())
+	if err != nil {
+		return fmt.Errorf("init store: %w", err)
+	}
+	return nil
+}
+
+func (s *service) Name() string {
+	return CName
+}
+
+func (s *service) Run(_ context.Context) error {
+	return nil
+}
+
+func (s *service) Close(_ context.Context) error {
+	if s.componentCtxCancel != nil {
+		s.componentCtxCancel()
+	}
+	s.subsLock.Lock()
+	defer s.subsLock.Unlock()
+	for spaceId, sub := range s.spaceSubs {
+		sub.cancel()
+		if err := s.subscriptionService.Unsubscribe(internalSubId(spaceId)); err != nil {
+			log.Warn("unsubscribe from space objects", zap.String("spaceId", spaceId), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func (s *service) List(ctx context.Context, spaceId, beforeOrderId string, limit int) ([]Entry, int, error) {
+	if spaceId == "" {
+		return nil, 0, ErrEmptySpaceId
+	}
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	if beforeOrderId == "" {
+		if _, err := s.refresh(ctx, spaceId); err != nil {
+			return nil, 0, err
+		}
+	}
+	entries, err := s.store.listEntries(ctx, spaceId, beforeOrderId, limit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("list entries: %w", err)
+	}
+	unreadCount, err := s.unreadCount(ctx, spaceId)
+	if err != nil {
+		return nil, 0, err
+	}
+	return entries, unreadCount, nil
+}
+
+func (s *service) Subscribe(ctx context.Context, spaceId, subId string, limit int) ([]Entry, int, error) {
+	if subId == "" {
+		return nil, 0, ErrEmptySubId
+	}
+	entries, unreadCount, err := s.List(ctx, spaceId, "", limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err = s.addSubscriber(spaceId, subId); err != nil {
+		return nil, 0, err
+	}
+	return entries, unreadCount, nil
+}
+
+func (s *service) Unsubscribe(subId string) error {
+	s.subsLock.Lock()
+	defer s.subsLock.Unlock()
+	spaceId, ok := s.subSpaces[subId]
+	if !ok {
+		return nil
+	}
+	delete(s.subSpaces, subId)
+	sub := s.spaceSubs[spaceId]
+	delete(sub.subIds, subId)
+	if len(sub.subIds) > 0 {
+		return nil
+	}
+	sub.cancel()
+	delete(s.spaceSubs, spaceId)
+	return s.subscriptionService.Unsubscribe(internalSubId(spaceId))
+}
+
+func (s *service) MarkSeen(ctx context.Context, spaceId string, seenUpTo int64) (int, error) {
+	if spaceId == "" {
+		return 0, ErrEmptySpaceId
+	}
+	if seenUpTo == 0 {
+		seenUpTo = time.Now().Unix()
+	}
+	s.refreshLock.Lock()
+	state, err := s.store.getState(ctx, spaceId)
+	if err == nil && seenUpTo > state.SeenUpTo {
+		state.SeenUpTo = seenUpTo
+		err = s.store.setState(ctx, spaceId, state)
+	}
+	s.refreshLock.Unlock()
+	if err != nil {
+		return 0, fmt.Errorf("save seen marker: %w", err)
+	}
+	unreadCount, err := s.unreadCount(ctx, spaceId)
+	if err != nil {
+		return 0, err
+	}
+	s.sendUpdate(spaceId, nil, unreadCount)
+	return unreadCount, nil
+}
+
+func (s *service) unreadCount(ctx context.Context, spaceId string) (int, error) {
+	state, err := s.store.getState(ctx, spaceId)
+	if err != nil {
+		return 0, fmt.Errorf("get state: %w", err)
+	}
+	count, err := s.store.countUnread(ctx, spaceId, s.accountService.MyParticipantId(spaceId), state.SeenUpTo)
+	if err != nil {
+		return 0, fmt.Errorf("count unread: %w", err)
+	}
+	return count, nil
+}
+
+// refresh converts changes made since the previous refresh to entries and returns new or updated entries
+func (s *service) refresh(ctx context.Context, spaceId string) ([]Entry, error) {
+	s.refreshLock.Lock()
+	defer s.refreshLock.Unlock()
+
+	spc, err := s.spaceService.Get(ctx, spaceId)
+	if err != nil {
+		return nil, fmt.Errorf("get space: %w", err)
+	}
+	state, err := s.store.getState(ctx, spaceId)
+	if err != nil {
+		return nil, fmt.Errorf("get state: %w", err)
+	}
+	now := time.Now().Unix()
+	if state.ScanFrom == 0 {
+		state.ScanFrom = now - int64(initialScanPeriod/time.Second)
+	}
+	res, err := s.collectChanges(ctx, spc, state)
+	if err != nil {
+		return nil, err
+	}
+	// changes from the future are shown as made now, as their heads are already seen
+	for i := range res.changes {
+		res.changes[i].Time = min(res.changes[i].Time, now)
+	}
+
+	last, err := s.store.lastEntry(ctx, spaceId)
+	if err != nil {
+		return nil, fmt.Errorf("get last entry: %w", err)
+	}
+	entries := groupChanges(last, res.changes, groupInterval)
+	if err = s.store.upsertEntries(ctx, spaceId, entries); err != nil {
+		return nil, fmt.Errorf("save entries: %w", err)
+	}
+	if err = s.store.setHeads(ctx, spaceId, res.heads); err != nil {
+		return nil, fmt.Errorf("save heads: %w", err)
+	}
+	state.AclHeadId = res.aclHeadId
+	if err = s.store.setState(ctx, spaceId, state); err != nil {
+		return nil, fmt.Errorf("save state: %w", err)
+	}
+	return entries, nil
+}
+
+func (s *service) addSubscriber(spaceId, subId string) error {
+	s.subsLock.Lock()
+	defer s.subsLock.Unlock()
+	if prevSpaceId, ok := s.subSpaces[subId]; ok && prevSpaceId != spaceId {
+		return fmt.Errorf("subscription %s is already used for another space", subId)
+	}
+	s.subSpaces[subId] = spaceId
+	if sub, ok := s.spaceSubs[spaceId]; ok {
+		sub.subIds[subId] = struct{}{}
+		return nil
+	}
+
+	queue := mb.New[*pb.EventMessage](0)
+	// syncDate is updated when changes made offline are received, even if they don't update lastModifiedDate
+	keys := []string{bundle.RelationKeyId.String(), bundle.RelationKeyLastModifiedDate.String(), bundle.RelationKeySyncDate.String()}
+	_, err := s.subscriptionService.Search(subscription.SubscribeRequest{
+		SpaceId:           spaceId,
+		SubId:             internalSubId(spaceId),
+		Keys:              keys,
+		NoDepSubscription: true,
+		Internal:          true,
+		InternalQueue:     queue,
+		Filters: []database.FilterRequest{
+			{
+				RelationKey: bundle.RelationKeyResolvedLayout,
+				Condition:   model.BlockContentDataviewFilter_In,
+				Value:       domain.Int64List(trackedLayouts),
+			},
+		},
+	})
+	if err != nil {
+		delete(s.subSpaces, subId)
+		return fmt.Errorf("subscribe to space objects: %w", err)
+	}
+	ctx, cancel := context.WithCancel(s.componentCtx)
+	s.spaceSubs[spaceId] = &spaceSub{
+		subIds: map[string]struct{}{subId: {}},
+		cancel: cancel,
+	}
+	go s.watchSpace(ctx, spaceId, queue)
+	return nil
+}
+
+// watchSpace refreshes the feed when objects of the space are modified
+func (s *service) watchSpace(ctx context.Context, spaceId string, queue *mb.MB[*pb.EventMessage]) {
+	defer queue.Close()
+	for {
+		if _, err := queue.Wait(ctx); err != nil {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(refreshDelay):
+		}
+		// changes that came during the delay are collected by this refresh
+		queue.GetAll()
+
+		entries, err := s.refresh(ctx, spaceId)
+		if err != nil {
+			log.Warn("refresh activity feed", zap.String("spaceId", spaceId), zap.Error(err))
+			continue
+		}
+		if len(entries) == 0 {
+			continue
+		}
+		unreadCount, err := s.unreadCount(ctx, spaceId)
+		if err != nil {
+			log.Warn("count unread activity", zap.String("spaceId", spaceId), zap.Error(err))
+			continue
+		}
+		s.sendUpdate(spaceId, entries, unreadCount)
+	}
+}
+
+func (s *service) sendUpdate(spaceId string, entries []Entry, unreadCount int) {
+	s.subsLock.Lock()
+	sub, ok := s.spaceSubs[spaceId]
+	var subIds []string
+	if ok {
+		for subId := range sub.subIds {
+			subIds = append(subIds, subId)
+		}
+	}
+	s.subsLock.Unlock()
+	if len(subIds) == 0 {
+		return
+	}
+
+	update := &pb.EventSpaceActivityUpdate{
+		SpaceId:     spaceId,
+		SubIds:      subIds,
+		UnreadCount: int32(unreadCount),
+	}
+	// the newest entry goes first, as in the list
+	for i := len(entries) - 1; i >= 0; i-- {
+		update.Entries = append(update.Entries, entries[i].ToProto())
+	}
+	s.eventSender.Broadcast(&pb.Event{
+		Messages: []*pb.EventMessage{
+			event.NewMessage(spaceId, &pb.EventMessageValueOfSpaceActivityUpdate{SpaceActivityUpdate: update}),
+		},
+	})
+}
+
+func internalSubId(spaceId string) string {
+	return "activityfeed-" + spaceId
+}
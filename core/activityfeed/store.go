@@ -0,0 +1,208 @@
+package activityfeed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	anystore "github.com/anyproto/any-store"
+	"github.com/anyproto/any-store/anyenc"
+	"github.com/anyproto/any-store/query"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore/anystorehelper"
+	"github.com/anyproto/anytype-heart/util/keyvaluestore"
+)
+
+const (
+	entriesCollectionName = "activity_feed"
+	statesCollectionName  = "activity_feed_state"
+	headsCollectionName   = "activity_feed_heads"
+
+	spaceIdKey       = "spaceId"
+	orderIdKey       = "orderId"
+	typeKey          = "type"
+	participantIdKey = "participantId"
+	objectIdsKey     = "objectIds"
+	timeKey          = "time"
+	lastTimeKey      = "lastTime"
+	countKey         = "count"
+
+	descOrder = "-" + orderIdKey
+)
+
+// spaceState is stored per space
+type spaceState struct {
+	// ScanFrom is a unix timestamp set when the feed of the space is built for the first time. Changes before it
+	// are not converted to entries for objects that were not scanned yet
+	ScanFrom int64 `json:"scanFrom"`
+	// AclHeadId is the last ACL record converted to entries
+	AclHeadId string `json:"aclHeadId,omitempty"`
+	// SeenUpTo is a unix timestamp, entries that have no changes after it are read by the user
+	SeenUpTo int64 `json:"seenUpTo,omitempty"`
+}
+
+type store struct {
+	entries anystore.Collection
+	states  keyvaluestore.Store[spaceState]
+	// heads are heads of objects which changes are already converted to entries
+	heads keyvaluestore.Store[[]string]
+	arena *anyenc.Arena
+}
+
+func newStore(ctx context.Context, db anystore.DB) (*store, error) {
+	entries, err := db.Collection(ctx, entriesCollectionName)
+	if err != nil {
+		return nil, fmt.Errorf("open entries collection: %w", err)
+	}
+	err = anystorehelper.AddIndexes(ctx, entries, []anystore.IndexInfo{
+		{
+			Fields: []string{spaceIdKey, orderIdKey},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("add indexes to entries collection: %w", err)
+	}
+	states, err := keyvaluestore.NewJson[spaceState](db, statesCollectionName)
+	if err != nil {
+		return nil, fmt.Errorf("open states collection: %w", err)
+	}
+	heads, err := keyvaluestore.NewJson[[]string](db, headsCollectionName)
+	if err != nil {
+		return nil, fmt.Errorf("open heads collection: %w", err)
+	}
+	return &store{
+		entries: entries,
+		states:  states,
+		heads:   heads,
+		arena:   &anyenc.Arena{},
+	}, nil
+}
+
+func (s *store) getState(ctx context.Context, spaceId string) (spaceState, error) {
+	state, err := s.states.Get(ctx, spaceId)
+	if errors.Is(err, anystore.ErrDocNotFound) {
+		return spaceState{}, nil
+	}
+	return state, err
+}
+
+func (s *store) setState(ctx context.Context, spaceId string, state spaceState) error {
+	return s.states.Set(ctx, spaceId, state)
+}
+
+// getHeads returns nil if the object was not scanned yet
+func (s *store) getHeads(ctx context.Context, spaceId, objectId string) ([]string, error) {
+	heads, err := s.heads.Get(ctx, headsKey(spaceId, objectId))
+	if errors.Is(err, anystore.ErrDocNotFound) {
+		return nil, nil
+	}
+	return heads, err
+}
+
+func (s *store) setHeads(ctx context.Context, spaceId string, heads map[string][]string) error {
+	for objectId, objectHeads := range heads {
+		if err := s.heads.Set(ctx, headsKey(spaceId, objectId), objectHeads); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func headsKey(spaceId, objectId string) string {
+	return spaceId + "/" + objectId
+}
+
+// upsertEntries should be called under the service lock, as the arena is shared
+func (s *store) upsertEntries(ctx context.Context, spaceId string, entries []Entry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	tx, err := s.entries.WriteTx(ctx)
+	if err != nil {
+		return fmt.Errorf("start tx: %w", err)
+	}
+	for _, entry := range entries {
+		s.arena.Reset()
+		if err = s.entries.UpsertOne(tx.Context(), s.marshalEntry(spaceId, entry)); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("upsert entry: %w", err)
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *store) marshalEntry(spaceId string, entry Entry) *anyenc.Value {
+	doc := s.arena.NewObject()
+	doc.Set("id", s.arena.NewString(entry.Id))
+	doc.Set(spaceIdKey, s.arena.NewString(spaceId))
+	doc.Set(orderIdKey, s.arena.NewString(entry.OrderId))
+	doc.Set(typeKey, s.arena.NewNumberInt(int(entry.Type)))
+	doc.Set(participantIdKey, s.arena.NewString(entry.ParticipantId))
+	objectIds := s.arena.NewArray()
+	for i, objectId := range entry.ObjectIds {
+		objectIds.SetArrayItem(i, s.arena.NewString(objectId))
+	}
+	doc.Set(objectIdsKey, objectIds)
+	doc.Set(timeKey, s.arena.NewNumberInt(int(entry.Time)))
+	doc.Set(lastTimeKey, s.arena.NewNumberInt(int(entry.LastTime)))
+	doc.Set(countKey, s.arena.NewNumberInt(entry.Count))
+	return doc
+}
+
+func unmarshalEntry(val *anyenc.Value) Entry {
+	entry := Entry{
+		Id:            val.GetString("id"),
+		OrderId:       val.GetString(orderIdKey),
+		Type:          EntryType(val.GetInt(typeKey)),
+		ParticipantId: val.GetString(participantIdKey),
+		Time:          int64(val.GetInt(timeKey)),
+		LastTime:      int64(val.GetInt(lastTimeKey)),
+		Count:         val.GetInt(countKey),
+	}
+	for _, objectId := range val.GetArray(objectIdsKey) {
+		entry.ObjectIds = append(entry.ObjectIds, string(objectId.GetStringBytes()))
+	}
+	return entry
+}
+
+// listEntries returns entries of the space in descending order, starting from the entry before beforeOrderId
+func (s *store) listEntries(ctx context.Context, spaceId, beforeOrderId string, limit int) ([]Entry, error) {
+	filter := query.And{
+		query.Key{Path: []string{spaceIdKey}, Filter: query.NewComp(query.CompOpEq, spaceId)},
+	}
+	if beforeOrderId != "" {
+		filter = append(filter, query.Key{Path: []string{orderIdKey}, Filter: query.NewComp(query.CompOpLt, beforeOrderId)})
+	}
+	iter, err := s.entries.Find(filter).Sort(descOrder).Limit(uint(limit)).Iter(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("init iterator: %w", err)
+	}
+	defer iter.Close()
+
+	var entries []Entry
+	for iter.Next() {
+		doc, err := iter.Doc()
+		if err != nil {
+			return nil, fmt.Errorf("read doc: %w", err)
+		}
+		entries = append(entries, unmarshalEntry(doc.Value()))
+	}
+	return entries, iter.Err()
+}
+
+func (s *store) lastEntry(ctx context.Context, spaceId string) (*Entry, error) {
+	entries, err := s.listEntries(ctx, spaceId, "", 1)
+	if err != nil || len(entries) == 0 {
+		return nil, err
+	}
+	return &entries[0], nil
+}
+
+// countUnread returns the number of entries changed after seenUpTo by other participants
+func (s *store) countUnread(ctx context.Context, spaceId, myParticipantId string, seenUpTo int64) (int, error) {
+	return s.entries.Find(query.And{
+		query.Key{Path: []string{spaceIdKey}, Filter: query.NewComp(query.CompOpEq, spaceId)},
+		query.Key{Path: []string{lastTimeKey}, Filter: query.NewComp(query.CompOpGt, seenUpTo)},
+		query.Key{Path: []string{participantIdKey}, Filter: query.NewComp(query.CompOpNe, myParticipantId)},
+	}).Count(ctx)
+}
@@ -37,9 +37,12 @@ import (
 	"github.com/anyproto/any-sync/paymentservice/paymentserviceclient2"
 
 	"github.com/anyproto/anytype-heart/core/acl"
+	"github.com/anyproto/anytype-heart/core/acl/memberrole"
+	"github.com/anyproto/anytype-heart/core/activityfeed"
 	"github.com/anyproto/anytype-heart/core/anytype/account"
 	"github.com/anyproto/anytype-heart/core/anytype/config"
 	"github.com/anyproto/anytype-heart/core/api"
+	"github.com/anyproto/anytype-heart/core/auditlog"
 	"github.com/anyproto/anytype-heart/core/block"
 	"github.com/anyproto/anytype-heart/core/block/backlinks"
 	"github.com/anyproto/anytype-heart/core/block/bookmark"
@@ -53,15 +56,18 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/editor"
 	"github.com/anyproto/anytype-heart/core/block/editor/converter"
 	"github.com/anyproto/anytype-heart/core/block/export"
+	"github.com/anyproto/anytype-heart/core/block/findreplace"
 	importer "github.com/anyproto/anytype-heart/core/block/import"
 	"github.com/anyproto/anytype-heart/core/block/object/idderiver/idderiverimpl"
 	"github.com/anyproto/anytype-heart/core/block/object/idresolver"
 	"github.com/anyproto/anytype-heart/core/block/object/objectcreator"
 	"github.com/anyproto/anytype-heart/core/block/object/objectgraph"
+	"github.com/anyproto/anytype-heart/core/block/object/objecttransfer"
 	"github.com/anyproto/anytype-heart/core/block/object/treemanager"
 	"github.com/anyproto/anytype-heart/core/block/process"
 	"github.com/anyproto/anytype-heart/core/block/source/sourceimpl"
 	"github.com/anyproto/anytype-heart/core/block/template/templateimpl"
+	"github.com/anyproto/anytype-heart/core/calendar"
 	"github.com/anyproto/anytype-heart/core/configfetcher"
 	"github.com/anyproto/anytype-heart/core/debug"
 	"github.com/anyproto/anytype-heart/core/debug/profiler"
@@ -77,6 +83,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/files/filestorage/rpcstore"
 	"github.com/anyproto/anytype-heart/core/files/filesync"
 	"github.com/anyproto/anytype-heart/core/files/fileuploader"
+	"github.com/anyproto/anytype-heart/core/files/imagetransform"
 	"github.com/anyproto/anytype-heart/core/files/reconciler"
 	"github.com/anyproto/anytype-heart/core/gallery"
 	"github.com/anyproto/anytype-heart/core/history"
@@ -85,6 +92,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/indexer"
 	"github.com/anyproto/anytype-heart/core/inviteservice"
 	"github.com/anyproto/anytype-heart/core/invitestore"
+	"github.com/anyproto/anytype-heart/core/journal"
 	"github.com/anyproto/anytype-heart/core/kanban"
 	"github.com/anyproto/anytype-heart/core/nameservice"
 	"github.com/anyproto/anytype-heart/core/notifications"
@@ -99,14 +107,18 @@ import (
 	"github.com/anyproto/anytype-heart/core/pushnotification/pushclient"
 	"github.com/anyproto/anytype-heart/core/relationutils/formatfetcher"
 	"github.com/anyproto/anytype-heart/core/session"
+	"github.com/anyproto/anytype-heart/core/spaceintegrity"
 	"github.com/anyproto/anytype-heart/core/subscription"
 	"github.com/anyproto/anytype-heart/core/subscription/crossspacesub"
 	"github.com/anyproto/anytype-heart/core/syncstatus"
 	"github.com/anyproto/anytype-heart/core/syncstatus/detailsupdater"
 	"github.com/anyproto/anytype-heart/core/syncstatus/nodestatus"
 	"github.com/anyproto/anytype-heart/core/syncstatus/spacesyncstatus"
+	"github.com/anyproto/anytype-heart/core/syncstatus/syncdiagnostics"
 	"github.com/anyproto/anytype-heart/core/syncstatus/syncsubscriptions"
 	"github.com/anyproto/anytype-heart/core/wallet"
+	"github.com/anyproto/anytype-heart/core/webdav"
+	"github.com/anyproto/anytype-heart/core/webhook"
 	"github.com/anyproto/anytype-heart/metrics"
 	"github.com/anyproto/anytype-heart/pkg/lib/core"
 	"github.com/anyproto/anytype-heart/pkg/lib/datastore/anystoreprovider"
@@ -288,10 +300,12 @@ func Bootstrap(a *app.App, components ...app.Component) {
 		Register(filesync.New()).
 		Register(reconciler.New()).
 		Register(fileobject.New()).
+		Register(imagetransform.New()).
 		Register(inviteservice.New()).
 		Register(publish.New()).
 		Register(publishclient.New()).
 		Register(acl.New()).
+		Register(memberrole.New()).
 		Register(builtintemplate.New()).
 		Register(converter.NewLayoutConverter()).
 		Register(configfetcher.New()).
@@ -309,6 +323,7 @@ func Bootstrap(a *app.App, components ...app.Component) {
 		Register(syncstatus.New()).
 		Register(history.New()).
 		Register(gateway.New()).
+		Register(webdav.New()).
 		Register(export.New()).
 		Register(linkpreview.NewWithCache()).
 		Register(unsplash.New()).
@@ -324,6 +339,15 @@ func Bootstrap(a *app.App, components ...app.Component) {
 		Register(device.NewDevices()).
 		Register(editor.NewObjectFactory()).
 		Register(objectgraph.NewBuilder()).
+		Register(findreplace.New()).
+		Register(objecttransfer.New()).
+		Register(activityfeed.New()).
+		Register(auditlog.New()).
+		Register(spaceintegrity.New()).
+		Register(syncdiagnostics.New()).
+		Register(journal.New()).
+		Register(calendar.New()).
+		Register(webhook.New()).
 		Register(account.New()).
 		Register(profiler.New()).
 		Register(identity.New(5*time.Minute, 10*time.Second)).
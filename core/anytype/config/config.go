@@ -64,6 +64,8 @@ type ConfigRequired struct {
 	NetworkId              string `json:""` // in case this account was at least once connected to the network on this device, this field will be set to the network id
 	AutoDownloadFiles      bool   `json:",omitempty"`
 	AutoDownloadOnWifiOnly bool   `json:",omitempty"`
+	AutoOffload            AutoOffloadPolicy
+	WebDAV                 WebDAVConfig
 }
 
 // Use separate structure as trick for legacy config management
@@ -72,6 +74,31 @@ type ConfigAutoDownloadFiles struct {
 	AutoDownloadOnWifiOnly bool
 }
 
+// Use separate structure as trick for legacy config management
+type ConfigAutoOffload struct {
+	AutoOffload AutoOffloadPolicy
+}
+
+// AutoOffloadPolicy describes when locally cached files are removed automatically.
+// Only files already synced to the file node are offloaded, favorite file objects and files pinned to widgets are always kept
+type AutoOffloadPolicy struct {
+	Enabled            bool   `json:",omitempty"`
+	MaxLocalCacheBytes uint64 `json:",omitempty"` // 0 means local cache size is not limited
+	NotOpenedDays      int    `json:",omitempty"` // 0 means files are not offloaded by the last open date
+}
+
+// Use separate structure as trick for legacy config management
+type ConfigWebDAV struct {
+	WebDAV WebDAVConfig
+}
+
+// WebDAVConfig describes the local WebDAV endpoint
+type WebDAVConfig struct {
+	Enabled  bool   `json:",omitempty"`
+	Addr     string `json:",omitempty"`
+	Password string `json:",omitempty"` // password for the basic auth, generated on the first start
+}
+
 type Config struct {
 	ConfigRequired `json:",inline"`
 
@@ -169,51 +169,688 @@ func (_c *MockClientCommands_BlockCreate_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// BlockDataviewFilterAdd provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommands) BlockDataviewFilterAdd(_a0 context.Context, _a1 *pb.RpcBlockDataviewFilterAddRequest) *pb.RpcBlockDataviewFilterAddResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockDataviewFilterAdd")
+	}
+
+	var r0 *pb.RpcBlockDataviewFilterAddResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBlockDataviewFilterAddRequest) *pb.RpcBlockDataviewFilterAddResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBlockDataviewFilterAddResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommands_BlockDataviewFilterAdd_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockDataviewFilterAdd'
+type MockClientCommands_BlockDataviewFilterAdd_Call struct {
+	*mock.Call
+}
+
+// BlockDataviewFilterAdd is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBlockDataviewFilterAddRequest
+func (_e *MockClientCommands_Expecter) BlockDataviewFilterAdd(_a0 interface{}, _a1 interface{}) *MockClientCommands_BlockDataviewFilterAdd_Call {
+	return &MockClientCommands_BlockDataviewFilterAdd_Call{Call: _e.mock.On("BlockDataviewFilterAdd", _a0, _a1)}
+}
+
+func (_c *MockClientCommands_BlockDataviewFilterAdd_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBlockDataviewFilterAddRequest)) *MockClientCommands_BlockDataviewFilterAdd_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBlockDataviewFilterAddRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommands_BlockDataviewFilterAdd_Call) Return(_a0 *pb.RpcBlockDataviewFilterAddResponse) *MockClientCommands_BlockDataviewFilterAdd_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommands_BlockDataviewFilterAdd_Call) RunAndReturn(run func(context.Context, *pb.RpcBlockDataviewFilterAddRequest) *pb.RpcBlockDataviewFilterAddResponse) *MockClientCommands_BlockDataviewFilterAdd_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BlockDataviewFilterRemove provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommands) BlockDataviewFilterRemove(_a0 context.Context, _a1 *pb.RpcBlockDataviewFilterRemoveRequest) *pb.RpcBlockDataviewFilterRemoveResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockDataviewFilterRemove")
+	}
+
+	var r0 *pb.RpcBlockDataviewFilterRemoveResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBlockDataviewFilterRemoveRequest) *pb.RpcBlockDataviewFilterRemoveResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBlockDataviewFilterRemoveResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommands_BlockDataviewFilterRemove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockDataviewFilterRemove'
+type MockClientCommands_BlockDataviewFilterRemove_Call struct {
+	*mock.Call
+}
+
+// BlockDataviewFilterRemove is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBlockDataviewFilterRemoveRequest
+func (_e *MockClientCommands_Expecter) BlockDataviewFilterRemove(_a0 interface{}, _a1 interface{}) *MockClientCommands_BlockDataviewFilterRemove_Call {
+	return &MockClientCommands_BlockDataviewFilterRemove_Call{Call: _e.mock.On("BlockDataviewFilterRemove", _a0, _a1)}
+}
+
+func (_c *MockClientCommands_BlockDataviewFilterRemove_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBlockDataviewFilterRemoveRequest)) *MockClientCommands_BlockDataviewFilterRemove_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBlockDataviewFilterRemoveRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommands_BlockDataviewFilterRemove_Call) Return(_a0 *pb.RpcBlockDataviewFilterRemoveResponse) *MockClientCommands_BlockDataviewFilterRemove_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommands_BlockDataviewFilterRemove_Call) RunAndReturn(run func(context.Context, *pb.RpcBlockDataviewFilterRemoveRequest) *pb.RpcBlockDataviewFilterRemoveResponse) *MockClientCommands_BlockDataviewFilterRemove_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BlockDataviewRelationAdd provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommands) BlockDataviewRelationAdd(_a0 context.Context, _a1 *pb.RpcBlockDataviewRelationAddRequest) *pb.RpcBlockDataviewRelationAddResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockDataviewRelationAdd")
+	}
+
+	var r0 *pb.RpcBlockDataviewRelationAddResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBlockDataviewRelationAddRequest) *pb.RpcBlockDataviewRelationAddResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBlockDataviewRelationAddResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommands_BlockDataviewRelationAdd_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockDataviewRelationAdd'
+type MockClientCommands_BlockDataviewRelationAdd_Call struct {
+	*mock.Call
+}
+
+// BlockDataviewRelationAdd is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBlockDataviewRelationAddRequest
+func (_e *MockClientCommands_Expecter) BlockDataviewRelationAdd(_a0 interface{}, _a1 interface{}) *MockClientCommands_BlockDataviewRelationAdd_Call {
+	return &MockClientCommands_BlockDataviewRelationAdd_Call{Call: _e.mock.On("BlockDataviewRelationAdd", _a0, _a1)}
+}
+
+func (_c *MockClientCommands_BlockDataviewRelationAdd_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBlockDataviewRelationAddRequest)) *MockClientCommands_BlockDataviewRelationAdd_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBlockDataviewRelationAddRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommands_BlockDataviewRelationAdd_Call) Return(_a0 *pb.RpcBlockDataviewRelationAddResponse) *MockClientCommands_BlockDataviewRelationAdd_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommands_BlockDataviewRelationAdd_Call) RunAndReturn(run func(context.Context, *pb.RpcBlockDataviewRelationAddRequest) *pb.RpcBlockDataviewRelationAddResponse) *MockClientCommands_BlockDataviewRelationAdd_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BlockDataviewSortAdd provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommands) BlockDataviewSortAdd(_a0 context.Context, _a1 *pb.RpcBlockDataviewSortAddRequest) *pb.RpcBlockDataviewSortAddResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockDataviewSortAdd")
+	}
+
+	var r0 *pb.RpcBlockDataviewSortAddResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBlockDataviewSortAddRequest) *pb.RpcBlockDataviewSortAddResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBlockDataviewSortAddResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommands_BlockDataviewSortAdd_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockDataviewSortAdd'
+type MockClientCommands_BlockDataviewSortAdd_Call struct {
+	*mock.Call
+}
+
+// BlockDataviewSortAdd is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBlockDataviewSortAddRequest
+func (_e *MockClientCommands_Expecter) BlockDataviewSortAdd(_a0 interface{}, _a1 interface{}) *MockClientCommands_BlockDataviewSortAdd_Call {
+	return &MockClientCommands_BlockDataviewSortAdd_Call{Call: _e.mock.On("BlockDataviewSortAdd", _a0, _a1)}
+}
+
+func (_c *MockClientCommands_BlockDataviewSortAdd_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBlockDataviewSortAddRequest)) *MockClientCommands_BlockDataviewSortAdd_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBlockDataviewSortAddRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommands_BlockDataviewSortAdd_Call) Return(_a0 *pb.RpcBlockDataviewSortAddResponse) *MockClientCommands_BlockDataviewSortAdd_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommands_BlockDataviewSortAdd_Call) RunAndReturn(run func(context.Context, *pb.RpcBlockDataviewSortAddRequest) *pb.RpcBlockDataviewSortAddResponse) *MockClientCommands_BlockDataviewSortAdd_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BlockDataviewSortRemove provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommands) BlockDataviewSortRemove(_a0 context.Context, _a1 *pb.RpcBlockDataviewSortRemoveRequest) *pb.RpcBlockDataviewSortRemoveResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockDataviewSortRemove")
+	}
+
+	var r0 *pb.RpcBlockDataviewSortRemoveResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBlockDataviewSortRemoveRequest) *pb.RpcBlockDataviewSortRemoveResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBlockDataviewSortRemoveResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommands_BlockDataviewSortRemove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockDataviewSortRemove'
+type MockClientCommands_BlockDataviewSortRemove_Call struct {
+	*mock.Call
+}
+
+// BlockDataviewSortRemove is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBlockDataviewSortRemoveRequest
+func (_e *MockClientCommands_Expecter) BlockDataviewSortRemove(_a0 interface{}, _a1 interface{}) *MockClientCommands_BlockDataviewSortRemove_Call {
+	return &MockClientCommands_BlockDataviewSortRemove_Call{Call: _e.mock.On("BlockDataviewSortRemove", _a0, _a1)}
+}
+
+func (_c *MockClientCommands_BlockDataviewSortRemove_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBlockDataviewSortRemoveRequest)) *MockClientCommands_BlockDataviewSortRemove_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBlockDataviewSortRemoveRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommands_BlockDataviewSortRemove_Call) Return(_a0 *pb.RpcBlockDataviewSortRemoveResponse) *MockClientCommands_BlockDataviewSortRemove_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommands_BlockDataviewSortRemove_Call) RunAndReturn(run func(context.Context, *pb.RpcBlockDataviewSortRemoveRequest) *pb.RpcBlockDataviewSortRemoveResponse) *MockClientCommands_BlockDataviewSortRemove_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BlockDataviewViewCreate provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommands) BlockDataviewViewCreate(_a0 context.Context, _a1 *pb.RpcBlockDataviewViewCreateRequest) *pb.RpcBlockDataviewViewCreateResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockDataviewViewCreate")
+	}
+
+	var r0 *pb.RpcBlockDataviewViewCreateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBlockDataviewViewCreateRequest) *pb.RpcBlockDataviewViewCreateResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBlockDataviewViewCreateResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommands_BlockDataviewViewCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockDataviewViewCreate'
+type MockClientCommands_BlockDataviewViewCreate_Call struct {
+	*mock.Call
+}
+
+// BlockDataviewViewCreate is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBlockDataviewViewCreateRequest
+func (_e *MockClientCommands_Expecter) BlockDataviewViewCreate(_a0 interface{}, _a1 interface{}) *MockClientCommands_BlockDataviewViewCreate_Call {
+	return &MockClientCommands_BlockDataviewViewCreate_Call{Call: _e.mock.On("BlockDataviewViewCreate", _a0, _a1)}
+}
+
+func (_c *MockClientCommands_BlockDataviewViewCreate_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBlockDataviewViewCreateRequest)) *MockClientCommands_BlockDataviewViewCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBlockDataviewViewCreateRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommands_BlockDataviewViewCreate_Call) Return(_a0 *pb.RpcBlockDataviewViewCreateResponse) *MockClientCommands_BlockDataviewViewCreate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommands_BlockDataviewViewCreate_Call) RunAndReturn(run func(context.Context, *pb.RpcBlockDataviewViewCreateRequest) *pb.RpcBlockDataviewViewCreateResponse) *MockClientCommands_BlockDataviewViewCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BlockDataviewViewDelete provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommands) BlockDataviewViewDelete(_a0 context.Context, _a1 *pb.RpcBlockDataviewViewDeleteRequest) *pb.RpcBlockDataviewViewDeleteResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockDataviewViewDelete")
+	}
+
+	var r0 *pb.RpcBlockDataviewViewDeleteResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBlockDataviewViewDeleteRequest) *pb.RpcBlockDataviewViewDeleteResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBlockDataviewViewDeleteResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommands_BlockDataviewViewDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockDataviewViewDelete'
+type MockClientCommands_BlockDataviewViewDelete_Call struct {
+	*mock.Call
+}
+
+// BlockDataviewViewDelete is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBlockDataviewViewDeleteRequest
+func (_e *MockClientCommands_Expecter) BlockDataviewViewDelete(_a0 interface{}, _a1 interface{}) *MockClientCommands_BlockDataviewViewDelete_Call {
+	return &MockClientCommands_BlockDataviewViewDelete_Call{Call: _e.mock.On("BlockDataviewViewDelete", _a0, _a1)}
+}
+
+func (_c *MockClientCommands_BlockDataviewViewDelete_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBlockDataviewViewDeleteRequest)) *MockClientCommands_BlockDataviewViewDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBlockDataviewViewDeleteRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommands_BlockDataviewViewDelete_Call) Return(_a0 *pb.RpcBlockDataviewViewDeleteResponse) *MockClientCommands_BlockDataviewViewDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommands_BlockDataviewViewDelete_Call) RunAndReturn(run func(context.Context, *pb.RpcBlockDataviewViewDeleteRequest) *pb.RpcBlockDataviewViewDeleteResponse) *MockClientCommands_BlockDataviewViewDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BlockDataviewViewRelationReplace provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommands) BlockDataviewViewRelationReplace(_a0 context.Context, _a1 *pb.RpcBlockDataviewViewRelationReplaceRequest) *pb.RpcBlockDataviewViewRelationReplaceResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockDataviewViewRelationReplace")
+	}
+
+	var r0 *pb.RpcBlockDataviewViewRelationReplaceResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBlockDataviewViewRelationReplaceRequest) *pb.RpcBlockDataviewViewRelationReplaceResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBlockDataviewViewRelationReplaceResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommands_BlockDataviewViewRelationReplace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockDataviewViewRelationReplace'
+type MockClientCommands_BlockDataviewViewRelationReplace_Call struct {
+	*mock.Call
+}
+
+// BlockDataviewViewRelationReplace is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBlockDataviewViewRelationReplaceRequest
+func (_e *MockClientCommands_Expecter) BlockDataviewViewRelationReplace(_a0 interface{}, _a1 interface{}) *MockClientCommands_BlockDataviewViewRelationReplace_Call {
+	return &MockClientCommands_BlockDataviewViewRelationReplace_Call{Call: _e.mock.On("BlockDataviewViewRelationReplace", _a0, _a1)}
+}
+
+func (_c *MockClientCommands_BlockDataviewViewRelationReplace_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBlockDataviewViewRelationReplaceRequest)) *MockClientCommands_BlockDataviewViewRelationReplace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBlockDataviewViewRelationReplaceRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommands_BlockDataviewViewRelationReplace_Call) Return(_a0 *pb.RpcBlockDataviewViewRelationReplaceResponse) *MockClientCommands_BlockDataviewViewRelationReplace_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommands_BlockDataviewViewRelationReplace_Call) RunAndReturn(run func(context.Context, *pb.RpcBlockDataviewViewRelationReplaceRequest) *pb.RpcBlockDataviewViewRelationReplaceResponse) *MockClientCommands_BlockDataviewViewRelationReplace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BlockDataviewViewRelationSort provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommands) BlockDataviewViewRelationSort(_a0 context.Context, _a1 *pb.RpcBlockDataviewViewRelationSortRequest) *pb.RpcBlockDataviewViewRelationSortResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockDataviewViewRelationSort")
+	}
+
+	var r0 *pb.RpcBlockDataviewViewRelationSortResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBlockDataviewViewRelationSortRequest) *pb.RpcBlockDataviewViewRelationSortResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBlockDataviewViewRelationSortResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommands_BlockDataviewViewRelationSort_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockDataviewViewRelationSort'
+type MockClientCommands_BlockDataviewViewRelationSort_Call struct {
+	*mock.Call
+}
+
+// BlockDataviewViewRelationSort is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBlockDataviewViewRelationSortRequest
+func (_e *MockClientCommands_Expecter) BlockDataviewViewRelationSort(_a0 interface{}, _a1 interface{}) *MockClientCommands_BlockDataviewViewRelationSort_Call {
+	return &MockClientCommands_BlockDataviewViewRelationSort_Call{Call: _e.mock.On("BlockDataviewViewRelationSort", _a0, _a1)}
+}
+
+func (_c *MockClientCommands_BlockDataviewViewRelationSort_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBlockDataviewViewRelationSortRequest)) *MockClientCommands_BlockDataviewViewRelationSort_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBlockDataviewViewRelationSortRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommands_BlockDataviewViewRelationSort_Call) Return(_a0 *pb.RpcBlockDataviewViewRelationSortResponse) *MockClientCommands_BlockDataviewViewRelationSort_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommands_BlockDataviewViewRelationSort_Call) RunAndReturn(run func(context.Context, *pb.RpcBlockDataviewViewRelationSortRequest) *pb.RpcBlockDataviewViewRelationSortResponse) *MockClientCommands_BlockDataviewViewRelationSort_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BlockDataviewViewUpdate provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommands) BlockDataviewViewUpdate(_a0 context.Context, _a1 *pb.RpcBlockDataviewViewUpdateRequest) *pb.RpcBlockDataviewViewUpdateResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockDataviewViewUpdate")
+	}
+
+	var r0 *pb.RpcBlockDataviewViewUpdateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBlockDataviewViewUpdateRequest) *pb.RpcBlockDataviewViewUpdateResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBlockDataviewViewUpdateResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommands_BlockDataviewViewUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockDataviewViewUpdate'
+type MockClientCommands_BlockDataviewViewUpdate_Call struct {
+	*mock.Call
+}
+
+// BlockDataviewViewUpdate is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBlockDataviewViewUpdateRequest
+func (_e *MockClientCommands_Expecter) BlockDataviewViewUpdate(_a0 interface{}, _a1 interface{}) *MockClientCommands_BlockDataviewViewUpdate_Call {
+	return &MockClientCommands_BlockDataviewViewUpdate_Call{Call: _e.mock.On("BlockDataviewViewUpdate", _a0, _a1)}
+}
+
+func (_c *MockClientCommands_BlockDataviewViewUpdate_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBlockDataviewViewUpdateRequest)) *MockClientCommands_BlockDataviewViewUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBlockDataviewViewUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommands_BlockDataviewViewUpdate_Call) Return(_a0 *pb.RpcBlockDataviewViewUpdateResponse) *MockClientCommands_BlockDataviewViewUpdate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommands_BlockDataviewViewUpdate_Call) RunAndReturn(run func(context.Context, *pb.RpcBlockDataviewViewUpdateRequest) *pb.RpcBlockDataviewViewUpdateResponse) *MockClientCommands_BlockDataviewViewUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BlockListDelete provides a mock function with given fields: _a0, _a1
 func (_m *MockClientCommands) BlockListDelete(_a0 context.Context, _a1 *pb.RpcBlockListDeleteRequest) *pb.RpcBlockListDeleteResponse {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for BlockListDelete")
+		panic("no return value specified for BlockListDelete")
+	}
+
+	var r0 *pb.RpcBlockListDeleteResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBlockListDeleteRequest) *pb.RpcBlockListDeleteResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBlockListDeleteResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommands_BlockListDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockListDelete'
+type MockClientCommands_BlockListDelete_Call struct {
+	*mock.Call
+}
+
+// BlockListDelete is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBlockListDeleteRequest
+func (_e *MockClientCommands_Expecter) BlockListDelete(_a0 interface{}, _a1 interface{}) *MockClientCommands_BlockListDelete_Call {
+	return &MockClientCommands_BlockListDelete_Call{Call: _e.mock.On("BlockListDelete", _a0, _a1)}
+}
+
+func (_c *MockClientCommands_BlockListDelete_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBlockListDeleteRequest)) *MockClientCommands_BlockListDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBlockListDeleteRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommands_BlockListDelete_Call) Return(_a0 *pb.RpcBlockListDeleteResponse) *MockClientCommands_BlockListDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommands_BlockListDelete_Call) RunAndReturn(run func(context.Context, *pb.RpcBlockListDeleteRequest) *pb.RpcBlockListDeleteResponse) *MockClientCommands_BlockListDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BlockListMoveToExistingObject provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommands) BlockListMoveToExistingObject(_a0 context.Context, _a1 *pb.RpcBlockListMoveToExistingObjectRequest) *pb.RpcBlockListMoveToExistingObjectResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockListMoveToExistingObject")
+	}
+
+	var r0 *pb.RpcBlockListMoveToExistingObjectResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBlockListMoveToExistingObjectRequest) *pb.RpcBlockListMoveToExistingObjectResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBlockListMoveToExistingObjectResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommands_BlockListMoveToExistingObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockListMoveToExistingObject'
+type MockClientCommands_BlockListMoveToExistingObject_Call struct {
+	*mock.Call
+}
+
+// BlockListMoveToExistingObject is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBlockListMoveToExistingObjectRequest
+func (_e *MockClientCommands_Expecter) BlockListMoveToExistingObject(_a0 interface{}, _a1 interface{}) *MockClientCommands_BlockListMoveToExistingObject_Call {
+	return &MockClientCommands_BlockListMoveToExistingObject_Call{Call: _e.mock.On("BlockListMoveToExistingObject", _a0, _a1)}
+}
+
+func (_c *MockClientCommands_BlockListMoveToExistingObject_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBlockListMoveToExistingObjectRequest)) *MockClientCommands_BlockListMoveToExistingObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBlockListMoveToExistingObjectRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommands_BlockListMoveToExistingObject_Call) Return(_a0 *pb.RpcBlockListMoveToExistingObjectResponse) *MockClientCommands_BlockListMoveToExistingObject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommands_BlockListMoveToExistingObject_Call) RunAndReturn(run func(context.Context, *pb.RpcBlockListMoveToExistingObjectRequest) *pb.RpcBlockListMoveToExistingObjectResponse) *MockClientCommands_BlockListMoveToExistingObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BlockListSetAlign provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommands) BlockListSetAlign(_a0 context.Context, _a1 *pb.RpcBlockListSetAlignRequest) *pb.RpcBlockListSetAlignResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockListSetAlign")
+	}
+
+	var r0 *pb.RpcBlockListSetAlignResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBlockListSetAlignRequest) *pb.RpcBlockListSetAlignResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBlockListSetAlignResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommands_BlockListSetAlign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockListSetAlign'
+type MockClientCommands_BlockListSetAlign_Call struct {
+	*mock.Call
+}
+
+// BlockListSetAlign is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBlockListSetAlignRequest
+func (_e *MockClientCommands_Expecter) BlockListSetAlign(_a0 interface{}, _a1 interface{}) *MockClientCommands_BlockListSetAlign_Call {
+	return &MockClientCommands_BlockListSetAlign_Call{Call: _e.mock.On("BlockListSetAlign", _a0, _a1)}
+}
+
+func (_c *MockClientCommands_BlockListSetAlign_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBlockListSetAlignRequest)) *MockClientCommands_BlockListSetAlign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBlockListSetAlignRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommands_BlockListSetAlign_Call) Return(_a0 *pb.RpcBlockListSetAlignResponse) *MockClientCommands_BlockListSetAlign_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommands_BlockListSetAlign_Call) RunAndReturn(run func(context.Context, *pb.RpcBlockListSetAlignRequest) *pb.RpcBlockListSetAlignResponse) *MockClientCommands_BlockListSetAlign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BlockListSetBackgroundColor provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommands) BlockListSetBackgroundColor(_a0 context.Context, _a1 *pb.RpcBlockListSetBackgroundColorRequest) *pb.RpcBlockListSetBackgroundColorResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockListSetBackgroundColor")
 	}
 
-	var r0 *pb.RpcBlockListDeleteResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBlockListDeleteRequest) *pb.RpcBlockListDeleteResponse); ok {
+	var r0 *pb.RpcBlockListSetBackgroundColorResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBlockListSetBackgroundColorRequest) *pb.RpcBlockListSetBackgroundColorResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pb.RpcBlockListDeleteResponse)
+			r0 = ret.Get(0).(*pb.RpcBlockListSetBackgroundColorResponse)
 		}
 	}
 
 	return r0
 }
 
-// MockClientCommands_BlockListDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockListDelete'
-type MockClientCommands_BlockListDelete_Call struct {
+// MockClientCommands_BlockListSetBackgroundColor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockListSetBackgroundColor'
+type MockClientCommands_BlockListSetBackgroundColor_Call struct {
 	*mock.Call
 }
 
-// BlockListDelete is a helper method to define mock.On call
+// BlockListSetBackgroundColor is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *pb.RpcBlockListDeleteRequest
-func (_e *MockClientCommands_Expecter) BlockListDelete(_a0 interface{}, _a1 interface{}) *MockClientCommands_BlockListDelete_Call {
-	return &MockClientCommands_BlockListDelete_Call{Call: _e.mock.On("BlockListDelete", _a0, _a1)}
+//   - _a1 *pb.RpcBlockListSetBackgroundColorRequest
+func (_e *MockClientCommands_Expecter) BlockListSetBackgroundColor(_a0 interface{}, _a1 interface{}) *MockClientCommands_BlockListSetBackgroundColor_Call {
+	return &MockClientCommands_BlockListSetBackgroundColor_Call{Call: _e.mock.On("BlockListSetBackgroundColor", _a0, _a1)}
 }
 
-func (_c *MockClientCommands_BlockListDelete_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBlockListDeleteRequest)) *MockClientCommands_BlockListDelete_Call {
+func (_c *MockClientCommands_BlockListSetBackgroundColor_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBlockListSetBackgroundColorRequest)) *MockClientCommands_BlockListSetBackgroundColor_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*pb.RpcBlockListDeleteRequest))
+		run(args[0].(context.Context), args[1].(*pb.RpcBlockListSetBackgroundColorRequest))
 	})
 	return _c
 }
 
-func (_c *MockClientCommands_BlockListDelete_Call) Return(_a0 *pb.RpcBlockListDeleteResponse) *MockClientCommands_BlockListDelete_Call {
+func (_c *MockClientCommands_BlockListSetBackgroundColor_Call) Return(_a0 *pb.RpcBlockListSetBackgroundColorResponse) *MockClientCommands_BlockListSetBackgroundColor_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockClientCommands_BlockListDelete_Call) RunAndReturn(run func(context.Context, *pb.RpcBlockListDeleteRequest) *pb.RpcBlockListDeleteResponse) *MockClientCommands_BlockListDelete_Call {
+func (_c *MockClientCommands_BlockListSetBackgroundColor_Call) RunAndReturn(run func(context.Context, *pb.RpcBlockListSetBackgroundColorRequest) *pb.RpcBlockListSetBackgroundColorResponse) *MockClientCommands_BlockListSetBackgroundColor_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -267,6 +904,349 @@ func (_c *MockClientCommands_BlockPaste_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// BlockTableCreate provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommands) BlockTableCreate(_a0 context.Context, _a1 *pb.RpcBlockTableCreateRequest) *pb.RpcBlockTableCreateResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockTableCreate")
+	}
+
+	var r0 *pb.RpcBlockTableCreateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBlockTableCreateRequest) *pb.RpcBlockTableCreateResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBlockTableCreateResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommands_BlockTableCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockTableCreate'
+type MockClientCommands_BlockTableCreate_Call struct {
+	*mock.Call
+}
+
+// BlockTableCreate is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBlockTableCreateRequest
+func (_e *MockClientCommands_Expecter) BlockTableCreate(_a0 interface{}, _a1 interface{}) *MockClientCommands_BlockTableCreate_Call {
+	return &MockClientCommands_BlockTableCreate_Call{Call: _e.mock.On("BlockTableCreate", _a0, _a1)}
+}
+
+func (_c *MockClientCommands_BlockTableCreate_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBlockTableCreateRequest)) *MockClientCommands_BlockTableCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBlockTableCreateRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommands_BlockTableCreate_Call) Return(_a0 *pb.RpcBlockTableCreateResponse) *MockClientCommands_BlockTableCreate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommands_BlockTableCreate_Call) RunAndReturn(run func(context.Context, *pb.RpcBlockTableCreateRequest) *pb.RpcBlockTableCreateResponse) *MockClientCommands_BlockTableCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BlockTextSetChecked provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommands) BlockTextSetChecked(_a0 context.Context, _a1 *pb.RpcBlockTextSetCheckedRequest) *pb.RpcBlockTextSetCheckedResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockTextSetChecked")
+	}
+
+	var r0 *pb.RpcBlockTextSetCheckedResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBlockTextSetCheckedRequest) *pb.RpcBlockTextSetCheckedResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBlockTextSetCheckedResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommands_BlockTextSetChecked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockTextSetChecked'
+type MockClientCommands_BlockTextSetChecked_Call struct {
+	*mock.Call
+}
+
+// BlockTextSetChecked is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBlockTextSetCheckedRequest
+func (_e *MockClientCommands_Expecter) BlockTextSetChecked(_a0 interface{}, _a1 interface{}) *MockClientCommands_BlockTextSetChecked_Call {
+	return &MockClientCommands_BlockTextSetChecked_Call{Call: _e.mock.On("BlockTextSetChecked", _a0, _a1)}
+}
+
+func (_c *MockClientCommands_BlockTextSetChecked_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBlockTextSetCheckedRequest)) *MockClientCommands_BlockTextSetChecked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBlockTextSetCheckedRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommands_BlockTextSetChecked_Call) Return(_a0 *pb.RpcBlockTextSetCheckedResponse) *MockClientCommands_BlockTextSetChecked_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommands_BlockTextSetChecked_Call) RunAndReturn(run func(context.Context, *pb.RpcBlockTextSetCheckedRequest) *pb.RpcBlockTextSetCheckedResponse) *MockClientCommands_BlockTextSetChecked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BlockTextSetColor provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommands) BlockTextSetColor(_a0 context.Context, _a1 *pb.RpcBlockTextSetColorRequest) *pb.RpcBlockTextSetColorResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockTextSetColor")
+	}
+
+	var r0 *pb.RpcBlockTextSetColorResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBlockTextSetColorRequest) *pb.RpcBlockTextSetColorResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBlockTextSetColorResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommands_BlockTextSetColor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockTextSetColor'
+type MockClientCommands_BlockTextSetColor_Call struct {
+	*mock.Call
+}
+
+// BlockTextSetColor is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBlockTextSetColorRequest
+func (_e *MockClientCommands_Expecter) BlockTextSetColor(_a0 interface{}, _a1 interface{}) *MockClientCommands_BlockTextSetColor_Call {
+	return &MockClientCommands_BlockTextSetColor_Call{Call: _e.mock.On("BlockTextSetColor", _a0, _a1)}
+}
+
+func (_c *MockClientCommands_BlockTextSetColor_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBlockTextSetColorRequest)) *MockClientCommands_BlockTextSetColor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBlockTextSetColorRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommands_BlockTextSetColor_Call) Return(_a0 *pb.RpcBlockTextSetColorResponse) *MockClientCommands_BlockTextSetColor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommands_BlockTextSetColor_Call) RunAndReturn(run func(context.Context, *pb.RpcBlockTextSetColorRequest) *pb.RpcBlockTextSetColorResponse) *MockClientCommands_BlockTextSetColor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BlockTextSetStyle provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommands) BlockTextSetStyle(_a0 context.Context, _a1 *pb.RpcBlockTextSetStyleRequest) *pb.RpcBlockTextSetStyleResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockTextSetStyle")
+	}
+
+	var r0 *pb.RpcBlockTextSetStyleResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBlockTextSetStyleRequest) *pb.RpcBlockTextSetStyleResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBlockTextSetStyleResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommands_BlockTextSetStyle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockTextSetStyle'
+type MockClientCommands_BlockTextSetStyle_Call struct {
+	*mock.Call
+}
+
+// BlockTextSetStyle is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBlockTextSetStyleRequest
+func (_e *MockClientCommands_Expecter) BlockTextSetStyle(_a0 interface{}, _a1 interface{}) *MockClientCommands_BlockTextSetStyle_Call {
+	return &MockClientCommands_BlockTextSetStyle_Call{Call: _e.mock.On("BlockTextSetStyle", _a0, _a1)}
+}
+
+func (_c *MockClientCommands_BlockTextSetStyle_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBlockTextSetStyleRequest)) *MockClientCommands_BlockTextSetStyle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBlockTextSetStyleRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommands_BlockTextSetStyle_Call) Return(_a0 *pb.RpcBlockTextSetStyleResponse) *MockClientCommands_BlockTextSetStyle_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommands_BlockTextSetStyle_Call) RunAndReturn(run func(context.Context, *pb.RpcBlockTextSetStyleRequest) *pb.RpcBlockTextSetStyleResponse) *MockClientCommands_BlockTextSetStyle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BlockTextSetText provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommands) BlockTextSetText(_a0 context.Context, _a1 *pb.RpcBlockTextSetTextRequest) *pb.RpcBlockTextSetTextResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockTextSetText")
+	}
+
+	var r0 *pb.RpcBlockTextSetTextResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBlockTextSetTextRequest) *pb.RpcBlockTextSetTextResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBlockTextSetTextResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommands_BlockTextSetText_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockTextSetText'
+type MockClientCommands_BlockTextSetText_Call struct {
+	*mock.Call
+}
+
+// BlockTextSetText is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBlockTextSetTextRequest
+func (_e *MockClientCommands_Expecter) BlockTextSetText(_a0 interface{}, _a1 interface{}) *MockClientCommands_BlockTextSetText_Call {
+	return &MockClientCommands_BlockTextSetText_Call{Call: _e.mock.On("BlockTextSetText", _a0, _a1)}
+}
+
+func (_c *MockClientCommands_BlockTextSetText_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBlockTextSetTextRequest)) *MockClientCommands_BlockTextSetText_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBlockTextSetTextRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommands_BlockTextSetText_Call) Return(_a0 *pb.RpcBlockTextSetTextResponse) *MockClientCommands_BlockTextSetText_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommands_BlockTextSetText_Call) RunAndReturn(run func(context.Context, *pb.RpcBlockTextSetTextRequest) *pb.RpcBlockTextSetTextResponse) *MockClientCommands_BlockTextSetText_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CalendarFeedGet provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommands) CalendarFeedGet(_a0 context.Context, _a1 *pb.RpcCalendarFeedGetRequest) *pb.RpcCalendarFeedGetResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CalendarFeedGet")
+	}
+
+	var r0 *pb.RpcCalendarFeedGetResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcCalendarFeedGetRequest) *pb.RpcCalendarFeedGetResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcCalendarFeedGetResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommands_CalendarFeedGet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CalendarFeedGet'
+type MockClientCommands_CalendarFeedGet_Call struct {
+	*mock.Call
+}
+
+// CalendarFeedGet is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcCalendarFeedGetRequest
+func (_e *MockClientCommands_Expecter) CalendarFeedGet(_a0 interface{}, _a1 interface{}) *MockClientCommands_CalendarFeedGet_Call {
+	return &MockClientCommands_CalendarFeedGet_Call{Call: _e.mock.On("CalendarFeedGet", _a0, _a1)}
+}
+
+func (_c *MockClientCommands_CalendarFeedGet_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcCalendarFeedGetRequest)) *MockClientCommands_CalendarFeedGet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcCalendarFeedGetRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommands_CalendarFeedGet_Call) Return(_a0 *pb.RpcCalendarFeedGetResponse) *MockClientCommands_CalendarFeedGet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommands_CalendarFeedGet_Call) RunAndReturn(run func(context.Context, *pb.RpcCalendarFeedGetRequest) *pb.RpcCalendarFeedGetResponse) *MockClientCommands_CalendarFeedGet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FileUpload provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommands) FileUpload(_a0 context.Context, _a1 *pb.RpcFileUploadRequest) *pb.RpcFileUploadResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FileUpload")
+	}
+
+	var r0 *pb.RpcFileUploadResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcFileUploadRequest) *pb.RpcFileUploadResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcFileUploadResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommands_FileUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FileUpload'
+type MockClientCommands_FileUpload_Call struct {
+	*mock.Call
+}
+
+// FileUpload is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcFileUploadRequest
+func (_e *MockClientCommands_Expecter) FileUpload(_a0 interface{}, _a1 interface{}) *MockClientCommands_FileUpload_Call {
+	return &MockClientCommands_FileUpload_Call{Call: _e.mock.On("FileUpload", _a0, _a1)}
+}
+
+func (_c *MockClientCommands_FileUpload_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcFileUploadRequest)) *MockClientCommands_FileUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcFileUploadRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommands_FileUpload_Call) Return(_a0 *pb.RpcFileUploadResponse) *MockClientCommands_FileUpload_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommands_FileUpload_Call) RunAndReturn(run func(context.Context, *pb.RpcFileUploadRequest) *pb.RpcFileUploadResponse) *MockClientCommands_FileUpload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ObjectCollectionAdd provides a mock function with given fields: _a0, _a1
 func (_m *MockClientCommands) ObjectCollectionAdd(_a0 context.Context, _a1 *pb.RpcObjectCollectionAddRequest) *pb.RpcObjectCollectionAddResponse {
 	ret := _m.Called(_a0, _a1)
@@ -13,7 +13,7 @@ import (
 // CreateChallengeHandler creates a new challenge for API key generation
 //
 //	@Summary		Create Challenge
-//	@Description	Generates a one-time authentication challenge for granting API access to the user's vault. Upon providing a valid `app_name`, the server issues a unique `challenge_id` and displays a 4-digit code within the Anytype Desktop. The `challenge_id` must then be used with the `/v1/auth/api_keys` endpoint to solve the challenge and retrieve an authentication token. This mechanism ensures that only trusted applications and authorized users gain access.
+//	@Description	Generates a one-time authentication challenge for granting API access to the user's vault. Upon providing a valid `app_name`, the server issues a unique `challenge_id` and displays a 4-digit code within the Anytype Desktop. The `challenge_id` must then be used with the `/v1/auth/api_keys` endpoint to solve the challenge and retrieve an authentication token. This mechanism ensures that only trusted applications and authorized users gain access. Optionally, `space_ids`, `read_only` and `expires_at` restrict the issued key to a list of spaces, to reading data and to an expiration date; the restrictions are shown to the user along with the code and are enforced on every request.
 //	@ID				create_auth_challenge
 //	@Tags			Auth
 //	@Accept			json
@@ -33,9 +33,10 @@ func CreateChallengeHandler(s *service.Service) gin.HandlerFunc {
 			return
 		}
 
-		challengeId, err := s.CreateChallenge(c.Request.Context(), req.AppName)
+		challengeId, err := s.CreateChallenge(c.Request.Context(), req)
 		code := util.MapErrorCode(err,
 			util.ErrToCode(service.ErrMissingAppName, http.StatusBadRequest),
+			util.ErrToCode(util.ErrBad, http.StatusBadRequest),
 			util.ErrToCode(service.ErrFailedCreateNewChallenge, http.StatusInternalServerError),
 		)
 
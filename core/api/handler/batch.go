@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	apimodel "github.com/anyproto/anytype-heart/core/api/model"
+	"github.com/anyproto/anytype-heart/core/api/service"
+	"github.com/anyproto/anytype-heart/core/api/util"
+)
+
+// ExecuteBatchHandler executes a list of object operations in a space
+//
+//	@Summary		Execute batch
+//	@Description	Executes up to 1000 create, update, delete and add_to_list operations in a single request, in the given order. A create operation may set a temp_id, which later operations can use in place of the object id in object_id, list_id, objects and in the values of objects properties. The whole request is rejected before anything is executed when an operation is missing the fields it needs or a temp_id is used twice. The response contains a result per operation with the status of the operation and, for failed operations, the HTTP status and message the operation would have failed with on its own. Operations referring to the temp_id of a failed create fail as well. When rollback_on_failure is set, execution stops at the first failed operation, the remaining operations are skipped and the objects created by the batch are archived.
+//	@Id				execute_batch
+//	@Tags			Objects
+//	@Accept			json
+//	@Produce		json
+//	@Param			Anytype-Version	header		string					true	"The version of the API to use"	default(2025-11-08)
+//	@Param			space_id		path		string					true	"The ID of the space in which to execute the operations; must be retrieved from ListSpaces endpoint"
+//	@Param			batch			body		apimodel.BatchRequest	true	"The operations to execute"
+//	@Success		200				{object}	apimodel.BatchResponse	"The results of the operations"
+//	@Failure		400				{object}	util.ValidationError	"Bad request"
+//	@Failure		401				{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure		429				{object}	util.RateLimitError		"Rate limit exceeded"
+//	@Failure		500				{object}	util.ServerError		"Internal server error"
+//	@Security		bearerauth
+//	@Router			/v1/spaces/{space_id}/batch [post]
+func ExecuteBatchHandler(s *service.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+
+		request := apimodel.BatchRequest{}
+		if err := c.BindJSON(&request); err != nil {
+			apiErr := util.CodeToApiError(http.StatusBadRequest, err.Error())
+			c.JSON(http.StatusBadRequest, apiErr)
+			return
+		}
+
+		response, errs, err := s.ExecuteBatch(c.Request.Context(), spaceId, request)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(util.ErrBad, http.StatusBadRequest),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToApiError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		for i, opErr := range errs {
+			if opErr == nil {
+				continue
+			}
+			response.Results[i].Error = &apimodel.BatchOperationError{
+				Status:  batchOperationErrorCode(opErr),
+				Message: opErr.Error(),
+			}
+		}
+
+		c.JSON(http.StatusOK, response)
+	}
+}
+
+// batchOperationErrorCode maps the error of a batch operation to the code of the corresponding single-object endpoint
+func batchOperationErrorCode(err error) int {
+	return util.MapErrorCode(err,
+		util.ErrToCode(util.ErrBad, http.StatusBadRequest),
+		util.ErrToCode(service.ErrObjectNotFound, http.StatusNotFound),
+		util.ErrToCode(service.ErrObjectDeleted, http.StatusGone),
+		util.ErrToCode(service.ErrFailedDeleteObject, http.StatusForbidden),
+		util.ErrToCode(service.ErrFailedCreateBookmark, http.StatusInternalServerError),
+		util.ErrToCode(service.ErrFailedCreateObject, http.StatusInternalServerError),
+		util.ErrToCode(service.ErrFailedSetPropertyFeatured, http.StatusInternalServerError),
+		util.ErrToCode(service.ErrFailedCreateBlock, http.StatusInternalServerError),
+		util.ErrToCode(service.ErrFailedPasteBody, http.StatusInternalServerError),
+		util.ErrToCode(service.ErrFailedUpdateObject, http.StatusInternalServerError),
+		util.ErrToCode(service.ErrFailedReplaceBlocks, http.StatusInternalServerError),
+		util.ErrToCode(service.ErrFailedAddObjectsToList, http.StatusInternalServerError),
+		util.ErrToCode(service.ErrFailedRetrieveObject, http.StatusInternalServerError),
+	)
+}
@@ -0,0 +1,359 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	apimodel "github.com/anyproto/anytype-heart/core/api/model"
+	"github.com/anyproto/anytype-heart/core/api/pagination"
+	"github.com/anyproto/anytype-heart/core/api/service"
+	"github.com/anyproto/anytype-heart/core/api/util"
+)
+
+// ListBlocksHandler retrieves a list of blocks of an object
+//
+//	@Summary		List blocks
+//	@Description	Returns the blocks of an object as a flat, paginated list in document order. Each block includes the ID of its parent block (or the object ID for top-level blocks) and the IDs of its children, so clients can rebuild the block tree. The header with the title, description and featured properties is not included, as it is managed via object properties.
+//	@Id				list_blocks
+//	@Tags			Blocks
+//	@Produce		json
+//	@Param			Anytype-Version	header		string											true	"The version of the API to use"	default(2025-11-08)
+//	@Param			space_id		path		string											true	"The ID of the space in which the object exists; must be retrieved from ListSpaces endpoint"
+//	@Param			object_id		path		string											true	"The ID of the object to list blocks of; must be retrieved from ListObjects, SearchSpace or GlobalSearch endpoints or obtained from response context"
+//	@Param			offset			query		int												false	"The number of items to skip before starting to collect the result set"	default(0)
+//	@Param			limit			query		int												false	"The number of items to return"											default(100)	maximum(1000)
+//	@Success		200				{object}	pagination.PaginatedResponse[apimodel.Block]	"The list of blocks of the object"
+//	@Failure		401				{object}	util.UnauthorizedError							"Unauthorized"
+//	@Failure		404				{object}	util.NotFoundError								"Resource not found"
+//	@Failure		410				{object}	util.GoneError									"Resource deleted"
+//	@Failure		500				{object}	util.ServerError								"Internal server error"
+//	@Security		bearerauth
+//	@Router			/v1/spaces/{space_id}/objects/{object_id}/blocks [get]
+func ListBlocksHandler(s *service.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		objectId := c.Param("object_id")
+		offset := c.GetInt(pagination.QueryParamOffset)
+		limit := c.GetInt(pagination.QueryParamLimit)
+
+		blocks, total, hasMore, err := s.ListBlocks(c.Request.Context(), spaceId, objectId, offset, limit)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(service.ErrObjectNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrObjectDeleted, http.StatusGone),
+			util.ErrToCode(service.ErrFailedRetrieveObject, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToApiError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		pagination.RespondWithPagination(c, http.StatusOK, blocks, total, offset, limit, hasMore)
+	}
+}
+
+// GetBlockHandler retrieves a block of an object
+//
+//	@Summary		Get block
+//	@Description	Fetches a single block of an object, including its content, style, marks and the IDs of its children.
+//	@Id				get_block
+//	@Tags			Blocks
+//	@Produce		json
+//	@Param			Anytype-Version	header		string					true	"The version of the API to use"	default(2025-11-08)
+//	@Param			space_id		path		string					true	"The ID of the space in which the object exists; must be retrieved from ListSpaces endpoint"
+//	@Param			object_id		path		string					true	"The ID of the object containing the block; must be retrieved from ListObjects, SearchSpace or GlobalSearch endpoints or obtained from response context"
+//	@Param			block_id		path		string					true	"The ID of the block to retrieve; must be retrieved from ListBlocks endpoint"
+//	@Success		200				{object}	apimodel.BlockResponse	"The retrieved block"
+//	@Failure		401				{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure		404				{object}	util.NotFoundError		"Resource not found"
+//	@Failure		410				{object}	util.GoneError			"Resource deleted"
+//	@Failure		500				{object}	util.ServerError		"Internal server error"
+//	@Security		bearerauth
+//	@Router			/v1/spaces/{space_id}/objects/{object_id}/blocks/{block_id} [get]
+func GetBlockHandler(s *service.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		objectId := c.Param("object_id")
+		blockId := c.Param("block_id")
+
+		block, err := s.GetBlock(c.Request.Context(), spaceId, objectId, blockId)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(service.ErrObjectNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrBlockNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrObjectDeleted, http.StatusGone),
+			util.ErrToCode(service.ErrFailedRetrieveObject, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToApiError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, apimodel.BlockResponse{Block: *block})
+	}
+}
+
+// CreateBlockHandler creates a block in an object
+//
+//	@Summary		Create block
+//	@Description	Creates a single text, file, link or table block relative to the target block. Exactly one of the content fields must be set. When no target is given, the block is appended to the end of the object, which makes it possible to add lines to a page without rewriting its body.
+//	@Id				create_block
+//	@Tags			Blocks
+//	@Accept			json
+//	@Produce		json
+//	@Param			Anytype-Version	header		string						true	"The version of the API to use"	default(2025-11-08)
+//	@Param			space_id		path		string						true	"The ID of the space in which the object exists; must be retrieved from ListSpaces endpoint"
+//	@Param			object_id		path		string						true	"The ID of the object to create the block in; must be retrieved from ListObjects, SearchSpace or GlobalSearch endpoints or obtained from response context"
+//	@Param			block			body		apimodel.CreateBlockRequest	true	"The block to create"
+//	@Success		201				{object}	apimodel.BlockResponse		"The created block"
+//	@Failure		400				{object}	util.ValidationError		"Bad request"
+//	@Failure		401				{object}	util.UnauthorizedError		"Unauthorized"
+//	@Failure		404				{object}	util.NotFoundError			"Resource not found"
+//	@Failure		410				{object}	util.GoneError				"Resource deleted"
+//	@Failure		429				{object}	util.RateLimitError			"Rate limit exceeded"
+//	@Failure		500				{object}	util.ServerError			"Internal server error"
+//	@Security		bearerauth
+//	@Router			/v1/spaces/{space_id}/objects/{object_id}/blocks [post]
+func CreateBlockHandler(s *service.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		objectId := c.Param("object_id")
+
+		request := apimodel.CreateBlockRequest{}
+		if err := c.BindJSON(&request); err != nil {
+			apiErr := util.CodeToApiError(http.StatusBadRequest, err.Error())
+			c.JSON(http.StatusBadRequest, apiErr)
+			return
+		}
+
+		block, err := s.CreateBlock(c.Request.Context(), spaceId, objectId, request)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(util.ErrBad, http.StatusBadRequest),
+			util.ErrToCode(service.ErrObjectNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrBlockNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrObjectDeleted, http.StatusGone),
+			util.ErrToCode(service.ErrFailedCreateBlock, http.StatusInternalServerError),
+			util.ErrToCode(service.ErrFailedCreateTable, http.StatusInternalServerError),
+			util.ErrToCode(service.ErrFailedRetrieveObject, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToApiError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusCreated, apimodel.BlockResponse{Block: *block})
+	}
+}
+
+// InsertMarkdownHandler inserts markdown into an object
+//
+//	@Summary		Insert markdown
+//	@Description	Converts the markdown to blocks and inserts them relative to the target block, or appends them to the end of the object when no target is given. Returns the top-level blocks created from the markdown.
+//	@Id				insert_markdown
+//	@Tags			Blocks
+//	@Accept			json
+//	@Produce		json
+//	@Param			Anytype-Version	header		string							true	"The version of the API to use"	default(2025-11-08)
+//	@Param			space_id		path		string							true	"The ID of the space in which the object exists; must be retrieved from ListSpaces endpoint"
+//	@Param			object_id		path		string							true	"The ID of the object to insert the markdown into; must be retrieved from ListObjects, SearchSpace or GlobalSearch endpoints or obtained from response context"
+//	@Param			markdown		body		apimodel.InsertMarkdownRequest	true	"The markdown to insert"
+//	@Success		200				{object}	apimodel.BlocksResponse			"The inserted blocks"
+//	@Failure		400				{object}	util.ValidationError			"Bad request"
+//	@Failure		401				{object}	util.UnauthorizedError			"Unauthorized"
+//	@Failure		404				{object}	util.NotFoundError				"Resource not found"
+//	@Failure		410				{object}	util.GoneError					"Resource deleted"
+//	@Failure		429				{object}	util.RateLimitError				"Rate limit exceeded"
+//	@Failure		500				{object}	util.ServerError				"Internal server error"
+//	@Security		bearerauth
+//	@Router			/v1/spaces/{space_id}/objects/{object_id}/blocks/markdown [post]
+func InsertMarkdownHandler(s *service.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		objectId := c.Param("object_id")
+
+		request := apimodel.InsertMarkdownRequest{}
+		if err := c.BindJSON(&request); err != nil {
+			apiErr := util.CodeToApiError(http.StatusBadRequest, err.Error())
+			c.JSON(http.StatusBadRequest, apiErr)
+			return
+		}
+
+		blocks, err := s.InsertMarkdown(c.Request.Context(), spaceId, objectId, request)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(util.ErrBad, http.StatusBadRequest),
+			util.ErrToCode(service.ErrObjectNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrBlockNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrObjectDeleted, http.StatusGone),
+			util.ErrToCode(service.ErrFailedCreateBlock, http.StatusInternalServerError),
+			util.ErrToCode(service.ErrFailedInsertBlocks, http.StatusInternalServerError),
+			util.ErrToCode(service.ErrFailedRetrieveObject, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToApiError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, apimodel.BlocksResponse{Blocks: blocks})
+	}
+}
+
+// UpdateBlockHandler updates a block of an object
+//
+//	@Summary		Update block
+//	@Description	Updates the text, marks, style, checkbox state, colors or alignment of a block. Only the fields present in the payload are changed. Text-related fields can only be set for text blocks; when the text is set without marks, the existing marks are removed.
+//	@Id				update_block
+//	@Tags			Blocks
+//	@Accept			json
+//	@Produce		json
+//	@Param			Anytype-Version	header		string						true	"The version of the API to use"	default(2025-11-08)
+//	@Param			space_id		path		string						true	"The ID of the space in which the object exists; must be retrieved from ListSpaces endpoint"
+//	@Param			object_id		path		string						true	"The ID of the object containing the block; must be retrieved from ListObjects, SearchSpace or GlobalSearch endpoints or obtained from response context"
+//	@Param			block_id		path		string						true	"The ID of the block to update; must be retrieved from ListBlocks endpoint"
+//	@Param			block			body		apimodel.UpdateBlockRequest	true	"The fields of the block to update"
+//	@Success		200				{object}	apimodel.BlockResponse		"The updated block"
+//	@Failure		400				{object}	util.ValidationError			"Bad request"
+//	@Failure		401				{object}	util.UnauthorizedError		"Unauthorized"
+//	@Failure		404				{object}	util.NotFoundError			"Resource not found"
+//	@Failure		410				{object}	util.GoneError				"Resource deleted"
+//	@Failure		429				{object}	util.RateLimitError			"Rate limit exceeded"
+//	@Failure		500				{object}	util.ServerError			"Internal server error"
+//	@Security		bearerauth
+//	@Router			/v1/spaces/{space_id}/objects/{object_id}/blocks/{block_id} [patch]
+func UpdateBlockHandler(s *service.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		objectId := c.Param("object_id")
+		blockId := c.Param("block_id")
+
+		request := apimodel.UpdateBlockRequest{}
+		if err := c.BindJSON(&request); err != nil {
+			apiErr := util.CodeToApiError(http.StatusBadRequest, err.Error())
+			c.JSON(http.StatusBadRequest, apiErr)
+			return
+		}
+
+		block, err := s.UpdateBlock(c.Request.Context(), spaceId, objectId, blockId, request)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(util.ErrBad, http.StatusBadRequest),
+			util.ErrToCode(service.ErrObjectNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrBlockNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrObjectDeleted, http.StatusGone),
+			util.ErrToCode(service.ErrFailedUpdateBlock, http.StatusInternalServerError),
+			util.ErrToCode(service.ErrFailedRetrieveObject, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToApiError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, apimodel.BlockResponse{Block: *block})
+	}
+}
+
+// MoveBlockHandler moves a block within an object or to another object
+//
+//	@Summary		Move block
+//	@Description	Moves a block together with its children relative to the target block. The target block may belong to another object of the same space, in which case the block is moved to that object.
+//	@Id				move_block
+//	@Tags			Blocks
+//	@Accept			json
+//	@Produce		json
+//	@Param			Anytype-Version	header		string						true	"The version of the API to use"	default(2025-11-08)
+//	@Param			space_id		path		string						true	"The ID of the space in which the object exists; must be retrieved from ListSpaces endpoint"
+//	@Param			object_id		path		string						true	"The ID of the object containing the block; must be retrieved from ListObjects, SearchSpace or GlobalSearch endpoints or obtained from response context"
+//	@Param			block_id		path		string						true	"The ID of the block to move; must be retrieved from ListBlocks endpoint"
+//	@Param			move			body		apimodel.MoveBlockRequest	true	"The target to move the block to"
+//	@Success		200				{object}	apimodel.BlockResponse		"The moved block"
+//	@Failure		400				{object}	util.ValidationError		"Bad request"
+//	@Failure		401				{object}	util.UnauthorizedError		"Unauthorized"
+//	@Failure		404				{object}	util.NotFoundError			"Resource not found"
+//	@Failure		410				{object}	util.GoneError				"Resource deleted"
+//	@Failure		429				{object}	util.RateLimitError			"Rate limit exceeded"
+//	@Failure		500				{object}	util.ServerError			"Internal server error"
+//	@Security		bearerauth
+//	@Router			/v1/spaces/{space_id}/objects/{object_id}/blocks/{block_id}/move [post]
+func MoveBlockHandler(s *service.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		objectId := c.Param("object_id")
+		blockId := c.Param("block_id")
+
+		request := apimodel.MoveBlockRequest{}
+		if err := c.BindJSON(&request); err != nil {
+			apiErr := util.CodeToApiError(http.StatusBadRequest, err.Error())
+			c.JSON(http.StatusBadRequest, apiErr)
+			return
+		}
+
+		block, err := s.MoveBlock(c.Request.Context(), spaceId, objectId, blockId, request)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(util.ErrBad, http.StatusBadRequest),
+			util.ErrToCode(service.ErrObjectNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrBlockNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrObjectDeleted, http.StatusGone),
+			util.ErrToCode(service.ErrFailedMoveBlock, http.StatusInternalServerError),
+			util.ErrToCode(service.ErrFailedRetrieveObject, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToApiError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, apimodel.BlockResponse{Block: *block})
+	}
+}
+
+// DeleteBlockHandler deletes a block of an object
+//
+//	@Summary		Delete block
+//	@Description	Permanently removes a block together with its children from the object. Returns the deleted block.
+//	@Id				delete_block
+//	@Tags			Blocks
+//	@Produce		json
+//	@Param			Anytype-Version	header		string					true	"The version of the API to use"	default(2025-11-08)
+//	@Param			space_id		path		string					true	"The ID of the space in which the object exists; must be retrieved from ListSpaces endpoint"
+//	@Param			object_id		path		string					true	"The ID of the object containing the block; must be retrieved from ListObjects, SearchSpace or GlobalSearch endpoints or obtained from response context"
+//	@Param			block_id		path		string					true	"The ID of the block to delete; must be retrieved from ListBlocks endpoint"
+//	@Success		200				{object}	apimodel.BlockResponse	"The deleted block"
+//	@Failure		401				{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure		404				{object}	util.NotFoundError		"Resource not found"
+//	@Failure		410				{object}	util.GoneError			"Resource deleted"
+//	@Failure		429				{object}	util.RateLimitError		"Rate limit exceeded"
+//	@Failure		500				{object}	util.ServerError		"Internal server error"
+//	@Security		bearerauth
+//	@Router			/v1/spaces/{space_id}/objects/{object_id}/blocks/{block_id} [delete]
+func DeleteBlockHandler(s *service.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		objectId := c.Param("object_id")
+		blockId := c.Param("block_id")
+
+		block, err := s.DeleteBlock(c.Request.Context(), spaceId, objectId, blockId)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(service.ErrObjectNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrBlockNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrObjectDeleted, http.StatusGone),
+			util.ErrToCode(service.ErrFailedDeleteBlock, http.StatusInternalServerError),
+			util.ErrToCode(service.ErrFailedRetrieveObject, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToApiError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, apimodel.BlockResponse{Block: *block})
+	}
+}
@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anyproto/anytype-heart/core/api/service"
+	"github.com/anyproto/anytype-heart/core/api/util"
+)
+
+// GetCalendarFeedHandler serves the iCalendar feed of a view
+//
+//	@Summary		Get calendar feed
+//	@Description	Returns the events of a set or collection view as an iCalendar (.ics) document. Feeds are created in the app and are identified by a secret token that is part of the URL, so calendar applications can subscribe to the feed without an API key. Each object with the date property of the view set becomes an event linking back to the object.
+//	@Id				get_calendar_feed
+//	@Tags			Calendar
+//	@Produce		text/calendar
+//	@Param			feed	path		string				true	"The token of the feed, optionally followed by the .ics extension"
+//	@Success		200		{string}	string				"The iCalendar document"
+//	@Failure		404		{object}	util.NotFoundError	"Feed not found"
+//	@Failure		500		{object}	util.ServerError	"Internal server error"
+//	@Router			/v1/calendar/feeds/{feed} [get]
+func GetCalendarFeedHandler(s *service.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := strings.TrimSuffix(c.Param("feed"), ".ics")
+
+		data, err := s.GetCalendarFeed(c.Request.Context(), token)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(service.ErrCalendarFeedNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrFailedGetCalendarFeed, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToApiError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.Data(http.StatusOK, "text/calendar; charset=utf-8", []byte(data))
+	}
+}
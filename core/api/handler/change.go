@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anyproto/anytype-heart/core/api/service"
+	"github.com/anyproto/anytype-heart/core/api/util"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// changeStreamHeartbeat is the interval of the comments that keep idle change streams open through proxies
+const changeStreamHeartbeat = 15 * time.Second
+
+// StreamChangesHandler streams changes of objects in a space
+//
+//	@Summary		Stream changes
+//	@Description	Streams changes of the objects in a space as server-sent events. Each event has the type add, change or remove, the id of the object and, except for remove, the object as it is after the change. The stream supports the same query parameter filters as the ListObjects endpoint (e.g., ?done=false, ?tags[in]=urgent), and only objects matching them are reported; an object that stops matching is reported as removed.
+//	@Description	A new stream starts with a ready event. Every event carries a cursor as its SSE id; after a reconnect the stream is resumed after the event given by the Last-Event-ID header or the cursor query parameter, which must be used with the same filters. Streams can be resumed for 5 minutes after a disconnect and for the last 1000 events. When a stream can't be resumed, a new one is opened and starts with a reset event, and the client should re-query the objects it tracks.
+//	@Id				stream_changes
+//	@Tags			Objects
+//	@Produce		text/event-stream
+//	@Param			Anytype-Version	header		string					true	"The version of the API to use"	default(2025-11-08)
+//	@Param			Last-Event-ID	header		string					false	"The cursor of the last received event to resume the stream after"
+//	@Param			space_id		path		string					true	"The ID of the space to stream changes of; must be retrieved from ListSpaces endpoint"
+//	@Param			cursor			query		string					false	"The cursor of the last received event to resume the stream after; the Last-Event-ID header takes precedence"
+//	@Success		200				{object}	apimodel.ChangeEvent	"The stream of change events"
+//	@Failure		400				{object}	util.ValidationError	"Bad request"
+//	@Failure		401				{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure		500				{object}	util.ServerError		"Internal server error"
+//	@Security		bearerauth
+//	@Router			/v1/spaces/{space_id}/changes [get]
+func StreamChangesHandler(s *service.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		cursor := c.GetHeader("Last-Event-ID")
+		if cursor == "" {
+			cursor = c.Query("cursor")
+		}
+
+		filtersAny, _ := c.Get("filters")
+		filters := filtersAny.([]*model.BlockContentDataviewFilter)
+
+		events, err := s.StreamChanges(c.Request.Context(), spaceId, filters, cursor)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(service.ErrFailedSubscribeChanges, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToApiError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.Header("Content-Type", "text/event-stream")
+		c.Header("Cache-Control", "no-cache")
+		c.Header("Connection", "keep-alive")
+		c.Header("X-Accel-Buffering", "no")
+		c.Status(http.StatusOK)
+		c.Writer.Flush()
+
+		heartbeat := time.NewTicker(changeStreamHeartbeat)
+		defer heartbeat.Stop()
+		for {
+			select {
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				data, err := json.Marshal(event)
+				if err != nil {
+					continue
+				}
+				if _, err := fmt.Fprintf(c.Writer, "id: %s\nevent: %s\ndata: %s\n\n", event.Cursor, event.Type, data); err != nil {
+					return
+				}
+			case <-heartbeat.C:
+				if _, err := fmt.Fprint(c.Writer, ": keep-alive\n\n"); err != nil {
+					return
+				}
+			}
+			c.Writer.Flush()
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package handler
+
+import (
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	apimodel "github.com/anyproto/anytype-heart/core/api/model"
+	"github.com/anyproto/anytype-heart/core/api/pagination"
+	"github.com/anyproto/anytype-heart/core/api/service"
+	"github.com/anyproto/anytype-heart/core/api/util"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// maxUploadSize limits the size of the multipart body of file uploads
+const maxUploadSize = 1 << 30
+
+// ListFilesHandler retrieves a list of files in a space
+//
+//	@Summary		List files
+//	@Description	Retrieves a paginated list of file objects in the given space, most recently added first. Each file includes its name, extension, type, mime type, size and, for images, dimensions.
+//	@Description	Supports dynamic filtering via query parameters on file properties (e.g., ?file_ext=png, ?size_in_bytes[gt]=1048576). See FilterCondition enum for available conditions.
+//	@Id				list_files
+//	@Tags			Files
+//	@Produce		json
+//	@Param			Anytype-Version	header		string										true	"The version of the API to use"	default(2025-11-08)
+//	@Param			space_id		path		string										true	"The ID of the space in which to list files; must be retrieved from ListSpaces endpoint"
+//	@Param			offset			query		int											false	"The number of items to skip before starting to collect the result set"	default(0)
+//	@Param			limit			query		int											false	"The number of items to return"											default(100)	maximum(1000)
+//	@Success		200				{object}	pagination.PaginatedResponse[apimodel.File]	"The list of files in the specified space"
+//	@Failure		400				{object}	util.ValidationError						"Bad request"
+//	@Failure		401				{object}	util.UnauthorizedError						"Unauthorized"
+//	@Failure		500				{object}	util.ServerError							"Internal server error"
+//	@Security		bearerauth
+//	@Router			/v1/spaces/{space_id}/files [get]
+func ListFilesHandler(s *service.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		offset := c.GetInt(pagination.QueryParamOffset)
+		limit := c.GetInt(pagination.QueryParamLimit)
+
+		filtersAny, _ := c.Get("filters")
+		filters := filtersAny.([]*model.BlockContentDataviewFilter)
+
+		files, total, hasMore, err := s.ListFiles(c.Request.Context(), spaceId, filters, offset, limit)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(service.ErrFailedRetrieveFiles, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToApiError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		pagination.RespondWithPagination(c, http.StatusOK, files, total, offset, limit, hasMore)
+	}
+}
+
+// GetFileHandler retrieves the metadata of a file
+//
+//	@Summary		Get file
+//	@Description	Fetches the metadata of a single file object identified by its ID within the specified space. Use the DownloadFile endpoint to get the file content.
+//	@Id				get_file
+//	@Tags			Files
+//	@Produce		json
+//	@Param			Anytype-Version	header		string					true	"The version of the API to use"	default(2025-11-08)
+//	@Param			space_id		path		string					true	"The ID of the space in which the file exists; must be retrieved from ListSpaces endpoint"
+//	@Param			file_id			path		string					true	"The ID of the file object; must be retrieved from ListFiles endpoint or obtained from response context"
+//	@Success		200				{object}	apimodel.FileResponse	"The file"
+//	@Failure		401				{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure		404				{object}	util.NotFoundError		"Resource not found"
+//	@Failure		410				{object}	util.GoneError			"Resource deleted"
+//	@Failure		500				{object}	util.ServerError		"Internal server error"
+//	@Security		bearerauth
+//	@Router			/v1/spaces/{space_id}/files/{file_id} [get]
+func GetFileHandler(s *service.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		fileId := c.Param("file_id")
+
+		file, err := s.GetFile(c.Request.Context(), spaceId, fileId)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(service.ErrFileNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrObjectDeleted, http.StatusGone),
+			util.ErrToCode(service.ErrFailedRetrieveObject, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToApiError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, apimodel.FileResponse{File: *file})
+	}
+}
+
+// UploadFileHandler uploads a file to a space
+//
+//	@Summary		Upload file
+//	@Description	Uploads a file to the specified space as a multipart form and creates a file object for it. The type of the file object (file, image, video, audio or pdf) is detected from the content. When object_id is set, a file block pointing to the new file is also inserted into that object, relative to target_id, and returned along with the file.
+//	@Id				upload_file
+//	@Tags			Files
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			Anytype-Version	header		string					true	"The version of the API to use"	default(2025-11-08)
+//	@Param			space_id		path		string					true	"The ID of the space to upload the file to; must be retrieved from ListSpaces endpoint"
+//	@Param			file			formData	file					true	"The file to upload"
+//	@Param			name			formData	string					false	"The name to set for the file object; the name of the uploaded file is used if empty"
+//	@Param			object_id		formData	string					false	"The ID of the object to add a file block to; no block is created if empty"
+//	@Param			target_id		formData	string					false	"The ID of the block to insert the file block relative to; the block is appended to the end of the object if empty"
+//	@Param			position		formData	string					false	"The position relative to the target block"	Enums(top, bottom, inner, inner_first, replace)	default(bottom)
+//	@Success		201				{object}	apimodel.FileResponse	"The uploaded file"
+//	@Failure		400				{object}	util.ValidationError	"Bad request"
+//	@Failure		401				{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure		404				{object}	util.NotFoundError		"Resource not found"
+//	@Failure		429				{object}	util.RateLimitError		"Rate limit exceeded"
+//	@Failure		500				{object}	util.ServerError		"Internal server error"
+//	@Security		bearerauth
+//	@Router			/v1/spaces/{space_id}/files [post]
+func UploadFileHandler(s *service.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxUploadSize)
+
+		request := apimodel.UploadFileRequest{}
+		if err := c.ShouldBind(&request); err != nil {
+			apiErr := util.CodeToApiError(http.StatusBadRequest, err.Error())
+			c.JSON(http.StatusBadRequest, apiErr)
+			return
+		}
+		fileHeader, err := c.FormFile("file")
+		if err != nil {
+			apiErr := util.CodeToApiError(http.StatusBadRequest, "invalid file: "+err.Error())
+			c.JSON(http.StatusBadRequest, apiErr)
+			return
+		}
+		content, err := fileHeader.Open()
+		if err != nil {
+			apiErr := util.CodeToApiError(http.StatusBadRequest, "invalid file: "+err.Error())
+			c.JSON(http.StatusBadRequest, apiErr)
+			return
+		}
+		defer content.Close()
+
+		file, block, err := s.UploadFile(c.Request.Context(), spaceId, fileHeader.Filename, content, request)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(util.ErrBad, http.StatusBadRequest),
+			util.ErrToCode(service.ErrObjectNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrBlockNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrFileNotFound, http.StatusInternalServerError),
+			util.ErrToCode(service.ErrObjectDeleted, http.StatusGone),
+			util.ErrToCode(service.ErrFailedUploadFile, http.StatusInternalServerError),
+			util.ErrToCode(service.ErrFailedCreateBlock, http.StatusInternalServerError),
+			util.ErrToCode(service.ErrFailedRetrieveObject, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToApiError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusCreated, apimodel.FileResponse{File: *file, Block: block})
+	}
+}
+
+// DownloadFileHandler streams the content of a file
+//
+//	@Summary		Download file
+//	@Description	Streams the content of a file object. Range requests are supported. For images, the width query parameter selects the smallest stored variant not narrower than the given width, which is useful for thumbnails; the original image is returned by default.
+//	@Id				download_file
+//	@Tags			Files
+//	@Produce		octet-stream
+//	@Param			Anytype-Version	header		string					true	"The version of the API to use"	default(2025-11-08)
+//	@Param			space_id		path		string					true	"The ID of the space in which the file exists; must be retrieved from ListSpaces endpoint"
+//	@Param			file_id			path		string					true	"The ID of the file object to download; must be retrieved from ListFiles endpoint or obtained from response context"
+//	@Param			width			query		int						false	"The width of the image variant to return, in pixels; only for images"
+//	@Success		200				{file}		file					"The file content"
+//	@Failure		400				{object}	util.ValidationError	"Bad request"
+//	@Failure		401				{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure		404				{object}	util.NotFoundError		"Resource not found"
+//	@Failure		410				{object}	util.GoneError			"Resource deleted"
+//	@Failure		500				{object}	util.ServerError		"Internal server error"
+//	@Security		bearerauth
+//	@Router			/v1/spaces/{space_id}/files/{file_id}/download [get]
+func DownloadFileHandler(s *service.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		fileId := c.Param("file_id")
+
+		var width int
+		if widthStr := c.Query("width"); widthStr != "" {
+			var err error
+			width, err = strconv.Atoi(widthStr)
+			if err != nil {
+				apiErr := util.CodeToApiError(http.StatusBadRequest, "invalid width: "+err.Error())
+				c.JSON(http.StatusBadRequest, apiErr)
+				return
+			}
+		}
+
+		file, content, err := s.GetFileContent(c.Request.Context(), spaceId, fileId, width)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(util.ErrBad, http.StatusBadRequest),
+			util.ErrToCode(service.ErrFileNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrObjectDeleted, http.StatusGone),
+			util.ErrToCode(service.ErrFailedReadFile, http.StatusInternalServerError),
+			util.ErrToCode(service.ErrFailedRetrieveObject, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToApiError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		fileName := content.Name
+		if fileName == "" {
+			fileName = file.Name
+		}
+		c.Header("Content-Type", content.MimeType)
+		c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+		http.ServeContent(c.Writer, c.Request, fileName, content.Added, content.Reader)
+	}
+}
@@ -0,0 +1,199 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	apimodel "github.com/anyproto/anytype-heart/core/api/model"
+	"github.com/anyproto/anytype-heart/core/api/service"
+	"github.com/anyproto/anytype-heart/core/api/util"
+)
+
+// GetListViewHandler retrieves a view of a list
+//
+//	@Summary		Get list view
+//	@Description	Fetches a single view of a list (query or collection) with its layout, filters, sorts, visible properties and grouping.
+//	@Id				get_list_view
+//	@Tags			Lists
+//	@Produce		json
+//	@Param			Anytype-Version	header		string					true	"The version of the API to use"	default(2025-11-08)
+//	@Param			space_id		path		string					true	"The ID of the space to which the list belongs; must be retrieved from ListSpaces endpoint"
+//	@Param			list_id			path		string					true	"The ID of the list; must be retrieved from SearchSpace endpoint with types: ['collection', 'set']"
+//	@Param			view_id			path		string					true	"The ID of the view; must be retrieved from ListViews endpoint"
+//	@Success		200				{object}	apimodel.ViewResponse	"The view"
+//	@Failure		401				{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure		404				{object}	util.NotFoundError		"Not found"
+//	@Failure		500				{object}	util.ServerError		"Internal server error"
+//	@Security		bearerauth
+//	@Router			/v1/spaces/{space_id}/lists/{list_id}/views/{view_id} [get]
+func GetListViewHandler(s *service.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		listId := c.Param("list_id")
+		viewId := c.Param("view_id")
+
+		view, err := s.GetListView(c.Request.Context(), spaceId, listId, viewId)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(service.ErrFailedGetList, http.StatusNotFound),
+			util.ErrToCode(service.ErrViewNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrFailedGetListDataview, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToApiError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, apimodel.ViewResponse{View: *view})
+	}
+}
+
+// CreateListViewHandler creates a view of a list
+//
+//	@Summary		Create list view
+//	@Description	Creates a view in a list (query or collection). Filters are validated against the conditions supported by the format of each property, e.g. gt is only valid for number and date properties. Kanban views can be grouped by a select, multi_select or checkbox property and calendar views by a date property. When no properties are given, only the name is shown.
+//	@Id				create_list_view
+//	@Tags			Lists
+//	@Accept			json
+//	@Produce		json
+//	@Param			Anytype-Version	header		string						true	"The version of the API to use"	default(2025-11-08)
+//	@Param			space_id		path		string						true	"The ID of the space to which the list belongs; must be retrieved from ListSpaces endpoint"
+//	@Param			list_id			path		string						true	"The ID of the list to create the view in; must be retrieved from SearchSpace endpoint with types: ['collection', 'set']"
+//	@Param			view			body		apimodel.CreateViewRequest	true	"The view to create"
+//	@Success		201				{object}	apimodel.ViewResponse		"The created view"
+//	@Failure		400				{object}	util.ValidationError		"Bad request"
+//	@Failure		401				{object}	util.UnauthorizedError		"Unauthorized"
+//	@Failure		404				{object}	util.NotFoundError			"Not found"
+//	@Failure		429				{object}	util.RateLimitError			"Rate limit exceeded"
+//	@Failure		500				{object}	util.ServerError			"Internal server error"
+//	@Security		bearerauth
+//	@Router			/v1/spaces/{space_id}/lists/{list_id}/views [post]
+func CreateListViewHandler(s *service.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		listId := c.Param("list_id")
+
+		request := apimodel.CreateViewRequest{}
+		if err := c.BindJSON(&request); err != nil {
+			apiErr := util.CodeToApiError(http.StatusBadRequest, err.Error())
+			c.JSON(http.StatusBadRequest, apiErr)
+			return
+		}
+
+		view, err := s.CreateListView(c.Request.Context(), spaceId, listId, request)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(util.ErrBad, http.StatusBadRequest),
+			util.ErrToCode(service.ErrFailedGetList, http.StatusNotFound),
+			util.ErrToCode(service.ErrViewNotFound, http.StatusInternalServerError),
+			util.ErrToCode(service.ErrFailedGetListDataview, http.StatusInternalServerError),
+			util.ErrToCode(service.ErrFailedCreateView, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToApiError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusCreated, apimodel.ViewResponse{View: *view})
+	}
+}
+
+// UpdateListViewHandler updates a view of a list
+//
+//	@Summary		Update list view
+//	@Description	Updates the name, layout, grouping, filters, sorts or visible properties of a view. Only the fields present in the request are changed; filters and sorts, when given, replace the existing ones, and properties, when given, become the visible properties of the view in the given order while all others are hidden.
+//	@Id				update_list_view
+//	@Tags			Lists
+//	@Accept			json
+//	@Produce		json
+//	@Param			Anytype-Version	header		string						true	"The version of the API to use"	default(2025-11-08)
+//	@Param			space_id		path		string						true	"The ID of the space to which the list belongs; must be retrieved from ListSpaces endpoint"
+//	@Param			list_id			path		string						true	"The ID of the list; must be retrieved from SearchSpace endpoint with types: ['collection', 'set']"
+//	@Param			view_id			path		string						true	"The ID of the view to update; must be retrieved from ListViews endpoint"
+//	@Param			view			body		apimodel.UpdateViewRequest	true	"The view fields to update"
+//	@Success		200				{object}	apimodel.ViewResponse		"The updated view"
+//	@Failure		400				{object}	util.ValidationError		"Bad request"
+//	@Failure		401				{object}	util.UnauthorizedError		"Unauthorized"
+//	@Failure		404				{object}	util.NotFoundError			"Not found"
+//	@Failure		429				{object}	util.RateLimitError			"Rate limit exceeded"
+//	@Failure		500				{object}	util.ServerError			"Internal server error"
+//	@Security		bearerauth
+//	@Router			/v1/spaces/{space_id}/lists/{list_id}/views/{view_id} [patch]
+func UpdateListViewHandler(s *service.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		listId := c.Param("list_id")
+		viewId := c.Param("view_id")
+
+		request := apimodel.UpdateViewRequest{}
+		if err := c.BindJSON(&request); err != nil {
+			apiErr := util.CodeToApiError(http.StatusBadRequest, err.Error())
+			c.JSON(http.StatusBadRequest, apiErr)
+			return
+		}
+
+		view, err := s.UpdateListView(c.Request.Context(), spaceId, listId, viewId, request)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(util.ErrBad, http.StatusBadRequest),
+			util.ErrToCode(service.ErrFailedGetList, http.StatusNotFound),
+			util.ErrToCode(service.ErrViewNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrFailedGetListDataview, http.StatusInternalServerError),
+			util.ErrToCode(service.ErrFailedUpdateView, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToApiError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, apimodel.ViewResponse{View: *view})
+	}
+}
+
+// DeleteListViewHandler deletes a view of a list
+//
+//	@Summary		Delete list view
+//	@Description	Deletes a view of a list and returns it as it was before the deletion. The last remaining view of a list can't be deleted.
+//	@Id				delete_list_view
+//	@Tags			Lists
+//	@Produce		json
+//	@Param			Anytype-Version	header		string					true	"The version of the API to use"	default(2025-11-08)
+//	@Param			space_id		path		string					true	"The ID of the space to which the list belongs; must be retrieved from ListSpaces endpoint"
+//	@Param			list_id			path		string					true	"The ID of the list; must be retrieved from SearchSpace endpoint with types: ['collection', 'set']"
+//	@Param			view_id			path		string					true	"The ID of the view to delete; must be retrieved from ListViews endpoint"
+//	@Success		200				{object}	apimodel.ViewResponse	"The deleted view"
+//	@Failure		400				{object}	util.ValidationError	"Bad request"
+//	@Failure		401				{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure		404				{object}	util.NotFoundError		"Not found"
+//	@Failure		429				{object}	util.RateLimitError		"Rate limit exceeded"
+//	@Failure		500				{object}	util.ServerError		"Internal server error"
+//	@Security		bearerauth
+//	@Router			/v1/spaces/{space_id}/lists/{list_id}/views/{view_id} [delete]
+func DeleteListViewHandler(s *service.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		listId := c.Param("list_id")
+		viewId := c.Param("view_id")
+
+		view, err := s.DeleteListView(c.Request.Context(), spaceId, listId, viewId)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(util.ErrBad, http.StatusBadRequest),
+			util.ErrToCode(service.ErrFailedGetList, http.StatusNotFound),
+			util.ErrToCode(service.ErrViewNotFound, http.StatusNotFound),
+			util.ErrToCode(service.ErrFailedGetListDataview, http.StatusInternalServerError),
+			util.ErrToCode(service.ErrFailedDeleteView, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToApiError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, apimodel.ViewResponse{View: *view})
+	}
+}
@@ -11,7 +11,10 @@ type TokenResponse struct {
 }
 
 type CreateChallengeRequest struct {
-	AppName string `json:"app_name" example:"anytype_mcp"` // The name of the app that is requesting the challenge
+	AppName   string   `json:"app_name" example:"anytype_mcp"`                                                                          // The name of the app that is requesting the challenge
+	SpaceIds  []string `json:"space_ids,omitempty" example:"bafyreigyfkt6rbv24sbv5aq2hko3bhmv5xxlf22b4bypdu6j7hnphm3psq.23me69r569oi1"` // The spaces the api key is restricted to; all spaces if omitted
+	ReadOnly  bool     `json:"read_only,omitempty" example:"false"`                                                                     // Whether the api key is restricted to reading data
+	ExpiresAt int64    `json:"expires_at,omitempty" example:"1767225600"`                                                               // The unix time the api key expires at; never if omitted
 }
 
 type CreateChallengeResponse struct {
@@ -0,0 +1,74 @@
+package apimodel
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/anyproto/anytype-heart/core/api/util"
+)
+
+type BatchOperationType string
+
+const (
+	BatchOperationCreate    BatchOperationType = "create"
+	BatchOperationUpdate    BatchOperationType = "update"
+	BatchOperationDelete    BatchOperationType = "delete"
+	BatchOperationAddToList BatchOperationType = "add_to_list"
+)
+
+func (bt *BatchOperationType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	switch BatchOperationType(s) {
+	case BatchOperationCreate, BatchOperationUpdate, BatchOperationDelete, BatchOperationAddToList:
+		*bt = BatchOperationType(s)
+		return nil
+	default:
+		return util.ErrBadInput(fmt.Sprintf("invalid batch operation: %q", s))
+	}
+}
+
+type BatchOperationStatus string
+
+const (
+	BatchOperationStatusSucceeded  BatchOperationStatus = "succeeded"
+	BatchOperationStatusFailed     BatchOperationStatus = "failed"
+	BatchOperationStatusSkipped    BatchOperationStatus = "skipped"
+	BatchOperationStatusRolledBack BatchOperationStatus = "rolled_back"
+)
+
+type BatchRequest struct {
+	Operations        []BatchOperation `json:"operations" binding:"required"`      // The operations to execute, in order
+	RollbackOnFailure bool             `json:"rollback_on_failure" example:"true"` // Whether to stop at the first failed operation and archive the objects created by the batch
+}
+
+type BatchOperation struct {
+	Op       BatchOperationType   `json:"op" binding:"required" example:"create" enums:"create,update,delete,add_to_list"`                // The type of the operation
+	TempId   string               `json:"temp_id" example:"task-1"`                                                                       // A client-side id for the object to create; later operations can use it in place of the object id
+	ObjectId string               `json:"object_id" example:"bafyreie6n5l5nkbjal37su54cha4coy7qzuhrnajluzv5qd5jvtsrxkequ"`                // The id or temp id of the object to update or delete
+	ListId   string               `json:"list_id" example:"bafyreie6n5l5nkbjal37su54cha4coy7qzuhrnajluzv5qd5jvtsrxkequ"`                  // The id or temp id of the collection to add objects to
+	Objects  []string             `json:"objects" example:"[\"task-1\",\"bafyreie6n5l5nkbjal37su54cha4coy7qzuhrnajluzv5qd5jvtsrxkequ\"]"` // The ids or temp ids of the objects to add to the collection
+	Create   *CreateObjectRequest `json:"create"`                                                                                         // The object to create; for create operations
+	Update   *UpdateObjectRequest `json:"update"`                                                                                         // The fields to update; for update operations
+}
+
+type BatchResponse struct {
+	Results    []BatchOperationResult `json:"results"`                     // The results of the operations, in the order of the request
+	RolledBack bool                   `json:"rolled_back" example:"false"` // Whether the objects created by the batch were archived after a failure
+}
+
+type BatchOperationResult struct {
+	Index    int                  `json:"index" example:"0"`                                                                         // The index of the operation in the request
+	Op       BatchOperationType   `json:"op" example:"create" enums:"create,update,delete,add_to_list"`                              // The type of the operation
+	Status   BatchOperationStatus `json:"status" example:"succeeded" enums:"succeeded,failed,skipped,rolled_back"`                   // The outcome of the operation
+	TempId   string               `json:"temp_id,omitempty" example:"task-1"`                                                        // The temp id of the created object
+	ObjectId string               `json:"object_id,omitempty" example:"bafyreie6n5l5nkbjal37su54cha4coy7qzuhrnajluzv5qd5jvtsrxkequ"` // The id of the object the operation was applied to
+	Error    *BatchOperationError `json:"error,omitempty"`                                                                           // The error of a failed operation
+}
+
+type BatchOperationError struct {
+	Status  int    `json:"status" example:"404"`               // The HTTP status the operation would have failed with on its own
+	Message string `json:"message" example:"object not found"` // The error message
+}
@@ -0,0 +1,247 @@
+package apimodel
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/anyproto/anytype-heart/core/api/util"
+)
+
+type BlockType string
+
+const (
+	BlockTypeText        BlockType = "text"
+	BlockTypeFile        BlockType = "file"
+	BlockTypeLink        BlockType = "link"
+	BlockTypeBookmark    BlockType = "bookmark"
+	BlockTypeTable       BlockType = "table"
+	BlockTypeTableRow    BlockType = "table_row"
+	BlockTypeTableColumn BlockType = "table_column"
+	BlockTypeLayout      BlockType = "layout"
+	BlockTypeDivider     BlockType = "divider"
+	BlockTypeProperty    BlockType = "property"
+	BlockTypeDataview    BlockType = "dataview"
+	BlockTypeLatex       BlockType = "latex"
+	BlockTypeOther       BlockType = "other"
+)
+
+type TextStyle string
+
+const (
+	TextStyleParagraph   TextStyle = "paragraph"
+	TextStyleHeader1     TextStyle = "header1"
+	TextStyleHeader2     TextStyle = "header2"
+	TextStyleHeader3     TextStyle = "header3"
+	TextStyleHeader4     TextStyle = "header4"
+	TextStyleQuote       TextStyle = "quote"
+	TextStyleCode        TextStyle = "code"
+	TextStyleCheckbox    TextStyle = "checkbox"
+	TextStyleBulleted    TextStyle = "bulleted"
+	TextStyleNumbered    TextStyle = "numbered"
+	TextStyleToggle      TextStyle = "toggle"
+	TextStyleCallout     TextStyle = "callout"
+	TextStyleTitle       TextStyle = "title"
+	TextStyleDescription TextStyle = "description"
+)
+
+func (ts *TextStyle) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	switch TextStyle(s) {
+	case TextStyleParagraph, TextStyleHeader1, TextStyleHeader2, TextStyleHeader3, TextStyleHeader4, TextStyleQuote, TextStyleCode, TextStyleCheckbox, TextStyleBulleted, TextStyleNumbered, TextStyleToggle, TextStyleCallout:
+		*ts = TextStyle(s)
+		return nil
+	default:
+		return util.ErrBadInput(fmt.Sprintf("invalid text style: %q", s))
+	}
+}
+
+type MarkType string
+
+const (
+	MarkTypeStrikethrough   MarkType = "strikethrough"
+	MarkTypeKeyboard        MarkType = "keyboard"
+	MarkTypeItalic          MarkType = "italic"
+	MarkTypeBold            MarkType = "bold"
+	MarkTypeUnderscored     MarkType = "underscored"
+	MarkTypeLink            MarkType = "link"
+	MarkTypeTextColor       MarkType = "text_color"
+	MarkTypeBackgroundColor MarkType = "background_color"
+	MarkTypeMention         MarkType = "mention"
+	MarkTypeEmoji           MarkType = "emoji"
+	MarkTypeObject          MarkType = "object"
+)
+
+func (mt *MarkType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	switch MarkType(s) {
+	case MarkTypeStrikethrough, MarkTypeKeyboard, MarkTypeItalic, MarkTypeBold, MarkTypeUnderscored, MarkTypeLink, MarkTypeTextColor, MarkTypeBackgroundColor, MarkTypeMention, MarkTypeEmoji, MarkTypeObject:
+		*mt = MarkType(s)
+		return nil
+	default:
+		return util.ErrBadInput(fmt.Sprintf("invalid mark type: %q", s))
+	}
+}
+
+type BlockAlign string
+
+const (
+	BlockAlignLeft    BlockAlign = "left"
+	BlockAlignCenter  BlockAlign = "center"
+	BlockAlignRight   BlockAlign = "right"
+	BlockAlignJustify BlockAlign = "justify"
+)
+
+func (ba *BlockAlign) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	switch BlockAlign(s) {
+	case BlockAlignLeft, BlockAlignCenter, BlockAlignRight, BlockAlignJustify:
+		*ba = BlockAlign(s)
+		return nil
+	default:
+		return util.ErrBadInput(fmt.Sprintf("invalid block align: %q", s))
+	}
+}
+
+type BlockPosition string
+
+const (
+	BlockPositionTop        BlockPosition = "top"
+	BlockPositionBottom     BlockPosition = "bottom"
+	BlockPositionInner      BlockPosition = "inner"
+	BlockPositionInnerFirst BlockPosition = "inner_first"
+	BlockPositionReplace    BlockPosition = "replace"
+)
+
+func (bp *BlockPosition) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	switch BlockPosition(s) {
+	case BlockPositionTop, BlockPositionBottom, BlockPositionInner, BlockPositionInnerFirst, BlockPositionReplace:
+		*bp = BlockPosition(s)
+		return nil
+	default:
+		return util.ErrBadInput(fmt.Sprintf("invalid block position: %q", s))
+	}
+}
+
+type BlockResponse struct {
+	Block Block `json:"block"` // The block
+}
+
+type BlocksResponse struct {
+	Blocks []Block `json:"blocks"` // The blocks
+}
+
+type Block struct {
+	Object          string           `json:"object" example:"block"`                                                                                                        // The data model of the object
+	Id              string           `json:"id" example:"64394517de52ad5acb89c66c"`                                                                                         // The id of the block
+	ParentId        string           `json:"parent_id" example:"bafyreie6n5l5nkbjal37su54cha4coy7qzuhrnajluzv5qd5jvtsrxkequ"`                                               // The id of the parent block, the id of the object for top-level blocks
+	ChildrenIds     []string         `json:"children_ids" example:"[\"6797ce8ecda913cde14b02dc\"]"`                                                                         // The ids of the block's children
+	Type            BlockType        `json:"type" example:"text" enums:"text,file,link,bookmark,table,table_row,table_column,layout,divider,property,dataview,latex,other"` // The type of the block
+	BackgroundColor string           `json:"background_color" example:"red"`                                                                                                // The background color of the block
+	Align           BlockAlign       `json:"align" example:"left" enums:"left,center,right,justify"`                                                                        // The alignment of the block
+	Text            *TextContent     `json:"text,omitempty"`                                                                                                                // The text of the block, if applicable
+	File            *FileContent     `json:"file,omitempty"`                                                                                                                // The file of the block, if applicable
+	Link            *LinkContent     `json:"link,omitempty"`                                                                                                                // The link of the block, if applicable
+	Bookmark        *BookmarkContent `json:"bookmark,omitempty"`                                                                                                            // The bookmark of the block, if applicable
+	TableRow        *TableRowContent `json:"table_row,omitempty"`                                                                                                           // The table row of the block, if applicable
+	PropertyKey     string           `json:"property_key,omitempty" example:"description"`                                                                                  // The key of the property shown by the block, if applicable
+}
+
+type TextContent struct {
+	Text    string     `json:"text" example:"Some text..."`                                                                                                                        // The text
+	Style   TextStyle  `json:"style" example:"paragraph" enums:"paragraph,header1,header2,header3,header4,quote,code,checkbox,bulleted,numbered,toggle,callout,title,description"` // The style of the text
+	Checked bool       `json:"checked" example:"false"`                                                                                                                            // Whether the checkbox is checked
+	Color   string     `json:"color" example:"red"`                                                                                                                                // The color of the text
+	Marks   []TextMark `json:"marks"`                                                                                                                                              // The marks of the text
+}
+
+type TextMark struct {
+	From  int      `json:"from" example:"0"`                                                                                                                 // The start of the marked range, in characters
+	To    int      `json:"to" example:"4"`                                                                                                                   // The end of the marked range, in characters
+	Type  MarkType `json:"type" example:"bold" enums:"strikethrough,keyboard,italic,bold,underscored,link,text_color,background_color,mention,emoji,object"` // The type of the mark
+	Param string   `json:"param" example:""`                                                                                                                 // The url for links, the color for colors, the object id for mentions and objects, the emoji for emojis
+}
+
+type FileContent struct {
+	ObjectId string `json:"object_id" example:"bafyreie6n5l5nkbjal37su54cha4coy7qzuhrnajluzv5qd5jvtsrxkequ"` // The id of the file object
+	Name     string `json:"name" example:"report.pdf"`                                                       // The name of the file
+	Type     string `json:"type" example:"pdf" enums:"file,image,video,audio,pdf"`                           // The type of the file
+	Mime     string `json:"mime" example:"application/pdf"`                                                  // The mime type of the file
+	Size     int64  `json:"size" example:"1024"`                                                             // The size of the file in bytes
+}
+
+type LinkContent struct {
+	ObjectId string `json:"object_id" example:"bafyreie6n5l5nkbjal37su54cha4coy7qzuhrnajluzv5qd5jvtsrxkequ"` // The id of the linked object
+}
+
+type BookmarkContent struct {
+	Url      string `json:"url" example:"https://anytype.io"`                                                // The url of the bookmark
+	Title    string `json:"title" example:"Anytype"`                                                         // The title of the bookmark
+	ObjectId string `json:"object_id" example:"bafyreie6n5l5nkbjal37su54cha4coy7qzuhrnajluzv5qd5jvtsrxkequ"` // The id of the bookmark object
+}
+
+type TableRowContent struct {
+	IsHeader bool `json:"is_header" example:"false"` // Whether the row is the header of the table
+}
+
+type CreateBlockRequest struct {
+	TargetId string               `json:"target_id" example:"64394517de52ad5acb89c66c"`                           // The id of the block to insert the new block relative to; the block is appended to the end of the object if empty
+	Position BlockPosition        `json:"position" example:"bottom" enums:"top,bottom,inner,inner_first,replace"` // The position relative to the target block; bottom by default
+	Text     *CreateTextContent   `json:"text"`                                                                   // The text block to create
+	File     *CreateObjectContent `json:"file"`                                                                   // The file block to create, pointing to an existing file object
+	Link     *CreateObjectContent `json:"link"`                                                                   // The link block to create
+	Table    *CreateTableContent  `json:"table"`                                                                  // The table to create; its cells are text blocks which can be updated afterwards
+}
+
+type CreateTextContent struct {
+	Text            string     `json:"text" example:"Some text..."`                                                                                                      // The text
+	Style           TextStyle  `json:"style" example:"paragraph" enums:"paragraph,header1,header2,header3,header4,quote,code,checkbox,bulleted,numbered,toggle,callout"` // The style of the text
+	Checked         bool       `json:"checked" example:"false"`                                                                                                          // Whether the checkbox is checked
+	Color           string     `json:"color" example:"red"`                                                                                                              // The color of the text
+	BackgroundColor string     `json:"background_color" example:"yellow"`                                                                                                // The background color of the block
+	Marks           []TextMark `json:"marks"`                                                                                                                            // The marks of the text
+}
+
+type CreateObjectContent struct {
+	ObjectId string `json:"object_id" binding:"required" example:"bafyreie6n5l5nkbjal37su54cha4coy7qzuhrnajluzv5qd5jvtsrxkequ"` // The id of the object
+}
+
+type CreateTableContent struct {
+	Rows          int  `json:"rows" example:"3"`               // The number of rows
+	Columns       int  `json:"columns" example:"3"`            // The number of columns
+	WithHeaderRow bool `json:"with_header_row" example:"true"` // Whether the first row is the header
+}
+
+type InsertMarkdownRequest struct {
+	Markdown string        `json:"markdown" binding:"required" example:"- [ ] Check the logs"`             // The markdown to insert
+	TargetId string        `json:"target_id" example:"64394517de52ad5acb89c66c"`                           // The id of the block to insert the markdown relative to; the markdown is appended to the end of the object if empty
+	Position BlockPosition `json:"position" example:"bottom" enums:"top,bottom,inner,inner_first,replace"` // The position relative to the target block; bottom by default
+}
+
+type UpdateBlockRequest struct {
+	Text            *string     `json:"text" example:"Updated text"`                                                                                                    // The text to set; marks are removed if the text is set without marks
+	Marks           *[]TextMark `json:"marks"`                                                                                                                          // The marks to set
+	Style           *TextStyle  `json:"style" example:"header1" enums:"paragraph,header1,header2,header3,header4,quote,code,checkbox,bulleted,numbered,toggle,callout"` // The style to set
+	Checked         *bool       `json:"checked" example:"true"`                                                                                                         // Whether the checkbox is checked
+	Color           *string     `json:"color" example:"red"`                                                                                                            // The color of the text to set
+	BackgroundColor *string     `json:"background_color" example:"yellow"`                                                                                              // The background color of the block to set
+	Align           *BlockAlign `json:"align" example:"center" enums:"left,center,right,justify"`                                                                       // The alignment of the block to set
+}
+
+type MoveBlockRequest struct {
+	TargetId string        `json:"target_id" binding:"required" example:"64394517de52ad5acb89c66c"`                 // The id of the block to move the block relative to
+	Position BlockPosition `json:"position" example:"bottom" enums:"top,bottom,inner,inner_first,replace"`          // The position relative to the target block; bottom by default
+	ObjectId string        `json:"object_id" example:"bafyreie6n5l5nkbjal37su54cha4coy7qzuhrnajluzv5qd5jvtsrxkequ"` // The id of the object in the same space to move the block to; the block is moved within its object if empty
+}
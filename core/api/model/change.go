@@ -0,0 +1,18 @@
+package apimodel
+
+type ChangeEventType string
+
+const (
+	ChangeEventReady  ChangeEventType = "ready"
+	ChangeEventReset  ChangeEventType = "reset"
+	ChangeEventAdd    ChangeEventType = "add"
+	ChangeEventChange ChangeEventType = "change"
+	ChangeEventRemove ChangeEventType = "remove"
+)
+
+type ChangeEvent struct {
+	Cursor   string          `json:"cursor" example:"J5QGKZ3BNFXWI4TPMVZXIYLSOQ-42"`                                            // The cursor to resume the feed after this event
+	Type     ChangeEventType `json:"type" example:"change" enums:"ready,reset,add,change,remove"`                               // The type of the event; ready is sent when a new feed is opened and reset when the feed couldn't be resumed and changes may have been missed
+	ObjectId string          `json:"object_id,omitempty" example:"bafyreie6n5l5nkbjal37su54cha4coy7qzuhrnajluzv5qd5jvtsrxkequ"` // The id of the object that was added, changed or removed
+	Object   *Object         `json:"object,omitempty"`                                                                          // The object as it is after the change; not set for remove events
+}
@@ -0,0 +1,27 @@
+package apimodel
+
+type FileResponse struct {
+	File  File   `json:"file"`            // The file
+	Block *Block `json:"block,omitempty"` // The file block created in the target object, if requested
+}
+
+type File struct {
+	Object      string `json:"object" example:"file"`                                                                        // The data model of the object
+	Id          string `json:"id" example:"bafyreie6n5l5nkbjal37su54cha4coy7qzuhrnajluzv5qd5jvtsrxkequ"`                     // The id of the file object
+	SpaceId     string `json:"space_id" example:"bafyreigyfkt6rbv24sbv5aq2hko3bhmv5xxlf22b4bypdu6j7hnphm3psq.23me69r569oi1"` // The id of the space the file belongs to
+	Name        string `json:"name" example:"screenshot"`                                                                    // The name of the file without the extension
+	Extension   string `json:"extension" example:"png"`                                                                      // The extension of the file
+	Type        string `json:"type" example:"image" enums:"file,image,video,audio,pdf"`                                      // The type of the file
+	Mime        string `json:"mime" example:"image/png"`                                                                     // The mime type of the file
+	Size        int64  `json:"size" example:"1024"`                                                                          // The size of the file in bytes
+	Width       int64  `json:"width,omitempty" example:"1920"`                                                               // The width of the image in pixels, if applicable
+	Height      int64  `json:"height,omitempty" example:"1080"`                                                              // The height of the image in pixels, if applicable
+	CreatedDate string `json:"created_date" example:"2025-01-01T12:00:00Z"`                                                  // The date the file was added
+}
+
+type UploadFileRequest struct {
+	Name     string        `form:"name" example:"screenshot"`                                                       // The name to set for the file object; the name of the uploaded file is used if empty
+	ObjectId string        `form:"object_id" example:"bafyreie6n5l5nkbjal37su54cha4coy7qzuhrnajluzv5qd5jvtsrxkequ"` // The id of the object to add a file block to; no block is created if empty
+	TargetId string        `form:"target_id" example:"64394517de52ad5acb89c66c"`                                    // The id of the block to insert the file block relative to; the block is appended to the end of the object if empty
+	Position BlockPosition `form:"position" example:"bottom" enums:"top,bottom,inner,inner_first,replace"`          // The position relative to the target block; bottom by default
+}
@@ -1,15 +1,103 @@
 package apimodel
 
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/anyproto/anytype-heart/core/api/util"
+)
+
+type ViewLayout string
+
+const (
+	ViewLayoutGrid     ViewLayout = "grid"
+	ViewLayoutList     ViewLayout = "list"
+	ViewLayoutGallery  ViewLayout = "gallery"
+	ViewLayoutKanban   ViewLayout = "kanban"
+	ViewLayoutCalendar ViewLayout = "calendar"
+	ViewLayoutGraph    ViewLayout = "graph"
+)
+
+func (vl *ViewLayout) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	switch ViewLayout(s) {
+	case ViewLayoutGrid, ViewLayoutList, ViewLayoutGallery, ViewLayoutKanban, ViewLayoutCalendar, ViewLayoutGraph:
+		*vl = ViewLayout(s)
+		return nil
+	default:
+		return util.ErrBadInput(fmt.Sprintf("invalid view layout: %q", s))
+	}
+}
+
+type SortType string
+
+const (
+	SortTypeAsc  SortType = "asc"
+	SortTypeDesc SortType = "desc"
+)
+
+func (st *SortType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	switch SortType(s) {
+	case SortTypeAsc, SortTypeDesc:
+		*st = SortType(s)
+		return nil
+	default:
+		return util.ErrBadInput(fmt.Sprintf("invalid sort type: %q", s))
+	}
+}
+
 type AddObjectsToListRequest struct {
 	Objects []string `json:"objects" example:"[\"bafyreie6n5l5nkbjal37su54cha4coy7qzuhrnajluzv5qd5jvtsrxkequ\"]"` // The list of object IDs to add to the list
 }
 
+type ViewResponse struct {
+	View View `json:"view"` // The view
+}
+
 type View struct {
-	Id      string   `json:"id" example:"67bf3f21cda9134102e2422c"`    // The id of the view
-	Name    string   `json:"name" example:"All"`                       // The name of the view
-	Layout  string   `json:"layout" example:"grid" enums:"grid,table"` // The layout of the view
-	Filters []Filter `json:"filters"`                                  // The list of filters
-	Sorts   []Sort   `json:"sorts"`                                    // The list of sorts
+	Id         string     `json:"id" example:"67bf3f21cda9134102e2422c"`                                 // The id of the view
+	Name       string     `json:"name" example:"All"`                                                    // The name of the view
+	Layout     ViewLayout `json:"layout" example:"grid" enums:"grid,list,gallery,kanban,calendar,graph"` // The layout of the view
+	Filters    []Filter   `json:"filters"`                                                               // The list of filters
+	Sorts      []Sort     `json:"sorts"`                                                                 // The list of sorts
+	Properties []string   `json:"properties,omitempty" example:"[\"name\",\"done\"]"`                    // The keys of the properties shown in the view, in display order
+	GroupBy    string     `json:"group_by,omitempty" example:"status"`                                   // The key of the property the view is grouped by; only for kanban and calendar layouts
+}
+
+type CreateViewRequest struct {
+	Name       string              `json:"name" example:"Open tasks"`                                                            // The name of the view
+	Layout     ViewLayout          `json:"layout" example:"grid" enums:"grid,list,gallery,kanban,calendar,graph" default:"grid"` // The layout of the view
+	Filters    []ViewFilterRequest `json:"filters"`                                                                              // The filters of the view
+	Sorts      []ViewSortRequest   `json:"sorts"`                                                                                // The sorts of the view, in order of priority
+	Properties []string            `json:"properties" example:"[\"name\",\"done\"]"`                                             // The keys of the properties to show in the view, in display order; only the name is shown if omitted
+	GroupBy    string              `json:"group_by" example:"status"`                                                            // The key of the property to group by; a select, multi_select or checkbox property for kanban, a date property for calendar layouts
+}
+
+type UpdateViewRequest struct {
+	Name       *string              `json:"name" example:"Open tasks"`                                               // The name to set for the view
+	Layout     *ViewLayout          `json:"layout" example:"kanban" enums:"grid,list,gallery,kanban,calendar,graph"` // The layout to set for the view
+	Filters    *[]ViewFilterRequest `json:"filters"`                                                                 // The filters to set for the view; replaces all existing filters
+	Sorts      *[]ViewSortRequest   `json:"sorts"`                                                                   // The sorts to set for the view; replaces all existing sorts
+	Properties *[]string            `json:"properties" example:"[\"name\",\"done\"]"`                                // The keys of the properties to show in the view, in display order; other properties are hidden
+	GroupBy    *string              `json:"group_by" example:"status"`                                               // The key of the property to group by; empty to remove the grouping
+}
+
+type ViewFilterRequest struct {
+	PropertyKey string          `json:"property_key" example:"done"`                                                                   // The key of the property to filter by
+	Condition   FilterCondition `json:"condition" example:"eq" enums:"eq,ne,gt,gte,lt,lte,contains,ncontains,in,nin,all,empty,nempty"` // The filter condition; must be valid for the format of the property
+	Value       interface{}     `json:"value" example:"false"`                                                                         // The value to filter by; not needed for empty and nempty conditions
+}
+
+type ViewSortRequest struct {
+	PropertyKey string   `json:"property_key" example:"due_date"`                        // The key of the property to sort by
+	SortType    SortType `json:"sort_type" example:"asc" enums:"asc,desc" default:"asc"` // The sort direction
 }
 
 type Filter struct {
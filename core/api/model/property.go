@@ -46,10 +46,11 @@ type PropertyResponse struct {
 }
 
 type CreatePropertyRequest struct {
-	Key    string             `json:"key" example:"some_user_defined_property_key"`                                                                  // The key of the property; should always be snake_case, otherwise it will be converted to snake_case
-	Name   string             `json:"name" binding:"required" example:"Last modified date"`                                                          // The name of the property
-	Format PropertyFormat     `json:"format" binding:"required" enums:"text,number,select,multi_select,date,files,checkbox,url,email,phone,objects"` // The format of the property
-	Tags   []CreateTagRequest `json:"tags"`                                                                                                          // Tags to create for select/multi_select properties
+	Key         string             `json:"key" example:"some_user_defined_property_key"`                                                                  // The key of the property; should always be snake_case, otherwise it will be converted to snake_case
+	Name        string             `json:"name" binding:"required" example:"Last modified date"`                                                          // The name of the property
+	Format      PropertyFormat     `json:"format" binding:"required" enums:"text,number,select,multi_select,date,files,checkbox,url,email,phone,objects"` // The format of the property
+	Tags        []CreateTagRequest `json:"tags"`                                                                                                          // Tags to create for select/multi_select properties
+	IncludeTime bool               `json:"include_time" example:"true"`                                                                                   // Whether date values of the property include time; only for date properties
 }
 
 type UpdatePropertyRequest struct {
@@ -58,11 +59,12 @@ type UpdatePropertyRequest struct {
 }
 
 type Property struct {
-	Object string         `json:"object" example:"property"`                                                                  // The data model of the object
-	Id     string         `json:"id" example:"bafyreids36kpw5ppuwm3ce2p4ezb3ab7cihhkq6yfbwzwpp4mln7rcgw7a"`                   // The id of the property
-	Key    string         `json:"key" example:"last_modified_date"`                                                           // The key of the property
-	Name   string         `json:"name" example:"Last modified date"`                                                          // The name of the property
-	Format PropertyFormat `json:"format" enums:"text,number,select,multi_select,date,files,checkbox,url,email,phone,objects"` // The format of the property
+	Object      string         `json:"object" example:"property"`                                                                  // The data model of the object
+	Id          string         `json:"id" example:"bafyreids36kpw5ppuwm3ce2p4ezb3ab7cihhkq6yfbwzwpp4mln7rcgw7a"`                   // The id of the property
+	Key         string         `json:"key" example:"last_modified_date"`                                                           // The key of the property
+	Name        string         `json:"name" example:"Last modified date"`                                                          // The name of the property
+	Format      PropertyFormat `json:"format" enums:"text,number,select,multi_select,date,files,checkbox,url,email,phone,objects"` // The format of the property
+	IncludeTime bool           `json:"include_time,omitempty" example:"true"`                                                      // Whether date values of the property include time; only for date properties
 	// Rk is internal-only to simplify lookup on entry, won't be serialized to property responses
 	RelationKey string `json:"-" swaggerignore:"true"`
 }
@@ -211,10 +213,11 @@ func (MultiSelectPropertyValue) isPropertyWithValue() {}
 
 type DatePropertyValue struct {
 	PropertyBase
-	Key    string         `json:"key" example:"last_modified_date"`    // The key of the property
-	Name   string         `json:"name" example:"Last modified date"`   // The name of the property
-	Format PropertyFormat `json:"format" enums:"date"`                 // The format of the property
-	Date   string         `json:"date" example:"2006-01-02T15:04:05Z"` // The date value of the property. Returns dates in RFC3339 format (2006-01-02T15:04:05Z)
+	Key         string         `json:"key" example:"last_modified_date"`    // The key of the property
+	Name        string         `json:"name" example:"Last modified date"`   // The name of the property
+	Format      PropertyFormat `json:"format" enums:"date"`                 // The format of the property
+	Date        string         `json:"date" example:"2006-01-02T15:04:05Z"` // The date value of the property. Returns dates in RFC3339 format (2006-01-02T15:04:05Z)
+	IncludeTime bool           `json:"include_time" example:"true"`         // Whether the time of the date is meaningful, otherwise the date is the start of the day in UTC
 }
 
 func (DatePropertyValue) isPropertyWithValue() {}
@@ -449,7 +452,7 @@ func (v MultiSelectPropertyLinkValue) GetValue() interface{} {
 
 type DatePropertyLinkValue struct {
 	Key  string  `json:"key" example:"last_modified_date"`
-	Date *string `json:"date" example:"2006-01-02T15:04:05Z"` // The date value of the property. Accepts dates in RFC3339 format (2006-01-02T15:04:05Z) or date-only format (2006-01-02)
+	Date *string `json:"date" example:"2006-01-02T15:04:05Z"` // The date value of the property. Accepts dates in RFC3339 format (2006-01-02T15:04:05Z), date-only format (2006-01-02) or natural language (next friday 3pm, in 2 weeks, end of month)
 }
 
 func (DatePropertyLinkValue) isPropertyLinkWithValue() {}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/didip/tollbooth/v8"
 	"github.com/didip/tollbooth/v8/limiter"
@@ -22,12 +24,25 @@ const ApiVersion = "2025-11-08"
 
 var log = logging.Logger("api-server")
 
+// apiSessionRevalidationInterval is how often a cached api key is checked again, so revoked and expired keys stop
+// working and the last usage of keys stays up to date
+const apiSessionRevalidationInterval = time.Minute
+
 var (
 	ErrMissingAuthorizationHeader = errors.New("missing authorization header")
 	ErrInvalidAuthorizationHeader = errors.New("invalid authorization header format")
 	ErrInvalidApiKey              = errors.New("invalid api key")
+	ErrExpiredApiKey              = errors.New("api key is expired")
+	ErrSpaceAccessDenied          = errors.New("api key has no access to this space")
+	ErrReadOnlyApiKey             = errors.New("api key is read-only")
 )
 
+// readOnlyRoutes are routes that don't modify data despite not being GET requests
+var readOnlyRoutes = map[string]bool{
+	"/v1/search":                  true,
+	"/v1/spaces/:space_id/search": true,
+}
+
 // ensureMetadataHeader is a middleware that ensures the metadata header is set.
 func ensureMetadataHeader() gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -53,37 +68,73 @@ func (srv *Server) ensureAuthenticated(mw apicore.ClientCommands) gin.HandlerFun
 		}
 		key := strings.TrimPrefix(authHeader, "Bearer ")
 
-		// Validate the key - if the key exists in the KeyToToken map, it is considered valid.
+		// Validate the key - if the key exists in the KeyToToken map and was validated recently, it is considered valid.
 		// Otherwise, attempt to create a new session using the key and add it to the map upon successful validation.
 		srv.mu.Lock()
 		apiSession, exists := srv.KeyToToken[key]
 		srv.mu.Unlock()
 
-		if !exists {
+		if !exists || time.Since(apiSession.ValidatedAt) > apiSessionRevalidationInterval {
 			response := mw.WalletCreateSession(context.Background(), &pb.RpcWalletCreateSessionRequest{Auth: &pb.RpcWalletCreateSessionRequestAuthOfAppKey{AppKey: key}})
 			if response.Error.Code != pb.RpcWalletCreateSessionResponseError_NULL {
+				srv.mu.Lock()
+				delete(srv.KeyToToken, key)
+				srv.mu.Unlock()
+
 				apiErr := util.CodeToApiError(http.StatusUnauthorized, ErrInvalidApiKey.Error())
+				if response.Error.Code == pb.RpcWalletCreateSessionResponseError_APP_TOKEN_EXPIRED {
+					apiErr = util.CodeToApiError(http.StatusUnauthorized, ErrExpiredApiKey.Error())
+				}
 				c.AbortWithStatusJSON(http.StatusUnauthorized, apiErr)
 				return
 			}
-			apiSession = ApiSessionEntry{
-				Token: response.Token,
-				// TODO: enable once app name is returned
-				// AppName: response.AppName,
-			}
+			apiSession = newApiSessionEntry(response)
 
 			srv.mu.Lock()
 			srv.KeyToToken[key] = apiSession
 			srv.mu.Unlock()
 		}
 
+		if apiSession.ExpireAt > 0 && time.Now().Unix() >= apiSession.ExpireAt {
+			apiErr := util.CodeToApiError(http.StatusUnauthorized, ErrExpiredApiKey.Error())
+			c.AbortWithStatusJSON(http.StatusUnauthorized, apiErr)
+			return
+		}
+
+		if err := apiSession.checkAccess(c); err != nil {
+			apiErr := util.CodeToApiError(http.StatusForbidden, err.Error())
+			c.AbortWithStatusJSON(http.StatusForbidden, apiErr)
+			return
+		}
+
 		// Add token to request context for downstream services (subscriptions, events, etc.)
 		c.Set("token", apiSession.Token)
 		c.Set("apiAppName", apiSession.AppName)
+		if len(apiSession.SpaceIds) > 0 {
+			c.Request = c.Request.WithContext(util.ContextWithAllowedSpaceIds(c.Request.Context(), apiSession.SpaceIds))
+		}
 		c.Next()
 	}
 }
 
+// checkAccess verifies that the request is within the spaces and the permission level of the api key
+func (e ApiSessionEntry) checkAccess(c *gin.Context) error {
+	if len(e.SpaceIds) > 0 {
+		spaceId := c.Param("space_id")
+		if spaceId == "" && c.Request.Method != http.MethodGet && !readOnlyRoutes[c.FullPath()] {
+			// space-wide operations like creating a space are not restricted to any space
+			return ErrSpaceAccessDenied
+		}
+		if spaceId != "" && !slices.Contains(e.SpaceIds, spaceId) {
+			return ErrSpaceAccessDenied
+		}
+	}
+	if e.ReadOnly && c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead && !readOnlyRoutes[c.FullPath()] {
+		return ErrReadOnlyApiKey
+	}
+	return nil
+}
+
 // ensureAnalyticsEvent is a middleware that ensures broadcasting an analytics event after a successful request.
 func ensureAnalyticsEvent(code string, eventService apicore.EventService) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/mock"
@@ -138,6 +139,129 @@ func TestEnsureAuthenticated(t *testing.T) {
 	})
 }
 
+func TestEnsureAuthenticated_Access(t *testing.T) {
+	newRouter := func(fx *fixture) *gin.Engine {
+		router := gin.New()
+		router.Use(fx.ensureAuthenticated(fx.mwMock))
+		ok := func(c *gin.Context) {
+			c.String(http.StatusOK, "OK")
+		}
+		router.GET("/v1/spaces", ok)
+		router.POST("/v1/spaces", ok)
+		router.POST("/v1/search", ok)
+		router.GET("/v1/spaces/:space_id/objects", ok)
+		router.POST("/v1/spaces/:space_id/objects", ok)
+		return router
+	}
+	serve := func(router *gin.Engine, method, path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set("Authorization", "Bearer someAppKey")
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("space restricted key", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.KeyToToken = map[string]ApiSessionEntry{"someAppKey": {Token: "token", SpaceIds: []string{"space1"}, ValidatedAt: time.Now()}}
+		router := newRouter(fx)
+
+		// when / then
+		require.Equal(t, http.StatusOK, serve(router, "GET", "/v1/spaces/space1/objects").Code)
+		require.Equal(t, http.StatusOK, serve(router, "POST", "/v1/spaces/space1/objects").Code)
+		require.Equal(t, http.StatusOK, serve(router, "GET", "/v1/spaces").Code)
+		require.Equal(t, http.StatusOK, serve(router, "POST", "/v1/search").Code)
+		require.Equal(t, http.StatusForbidden, serve(router, "GET", "/v1/spaces/space2/objects").Code)
+		require.Equal(t, http.StatusForbidden, serve(router, "POST", "/v1/spaces").Code)
+	})
+
+	t.Run("read-only key", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.KeyToToken = map[string]ApiSessionEntry{"someAppKey": {Token: "token", ReadOnly: true, ValidatedAt: time.Now()}}
+		router := newRouter(fx)
+
+		// when / then
+		require.Equal(t, http.StatusOK, serve(router, "GET", "/v1/spaces/space1/objects").Code)
+		require.Equal(t, http.StatusOK, serve(router, "POST", "/v1/search").Code)
+		w := serve(router, "POST", "/v1/spaces/space1/objects")
+		require.Equal(t, http.StatusForbidden, w.Code)
+		expectedJSON, err := json.Marshal(util.CodeToApiError(http.StatusForbidden, ErrReadOnlyApiKey.Error()))
+		require.NoError(t, err)
+		require.JSONEq(t, string(expectedJSON), w.Body.String())
+	})
+
+	t.Run("expired key", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.KeyToToken = map[string]ApiSessionEntry{"someAppKey": {Token: "token", ExpireAt: time.Now().Add(-time.Minute).Unix(), ValidatedAt: time.Now()}}
+		router := newRouter(fx)
+
+		// when
+		w := serve(router, "GET", "/v1/spaces")
+
+		// then
+		require.Equal(t, http.StatusUnauthorized, w.Code)
+		expectedJSON, err := json.Marshal(util.CodeToApiError(http.StatusUnauthorized, ErrExpiredApiKey.Error()))
+		require.NoError(t, err)
+		require.JSONEq(t, string(expectedJSON), w.Body.String())
+	})
+
+	t.Run("stale session is revalidated", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.KeyToToken = map[string]ApiSessionEntry{"someAppKey": {Token: "token", ValidatedAt: time.Now().Add(-2 * apiSessionRevalidationInterval)}}
+		fx.mwMock.
+			On("WalletCreateSession", mock.Anything, &pb.RpcWalletCreateSessionRequest{
+				Auth: &pb.RpcWalletCreateSessionRequestAuthOfAppKey{AppKey: "someAppKey"},
+			}).
+			Return(&pb.RpcWalletCreateSessionResponse{
+				Token:   "token",
+				AppName: "ci-bot",
+				AppAccess: &pb.RpcAccountLocalLinkAppAccess{
+					SpaceIds: []string{"space1"},
+					ReadOnly: true,
+				},
+				Error: &pb.RpcWalletCreateSessionResponseError{
+					Code: pb.RpcWalletCreateSessionResponseError_NULL,
+				},
+			}, nil).Once()
+		router := newRouter(fx)
+
+		// when
+		w := serve(router, "POST", "/v1/spaces/space1/objects")
+
+		// then
+		require.Equal(t, http.StatusForbidden, w.Code)
+		entry := fx.KeyToToken["someAppKey"]
+		require.Equal(t, "ci-bot", entry.AppName)
+		require.Equal(t, []string{"space1"}, entry.SpaceIds)
+		require.True(t, entry.ReadOnly)
+	})
+
+	t.Run("revoked key is removed from cache", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.KeyToToken = map[string]ApiSessionEntry{"someAppKey": {Token: "token"}}
+		fx.mwMock.
+			On("WalletCreateSession", mock.Anything, mock.Anything).
+			Return(&pb.RpcWalletCreateSessionResponse{
+				Error: &pb.RpcWalletCreateSessionResponseError{
+					Code: pb.RpcWalletCreateSessionResponseError_APP_TOKEN_NOT_FOUND_IN_THE_CURRENT_ACCOUNT,
+				},
+			}, nil).Once()
+		router := newRouter(fx)
+
+		// when
+		w := serve(router, "GET", "/v1/spaces")
+
+		// then
+		require.Equal(t, http.StatusUnauthorized, w.Code)
+		require.NotContains(t, fx.KeyToToken, "someAppKey")
+	})
+}
+
 func TestEnsureAnalyticsEvent(t *testing.T) {
 	t.Run("broadcasts analytics event after successful request", func(t *testing.T) {
 		// given
@@ -27,6 +27,7 @@ func (srv *Server) NewRouter(mw apicore.ClientCommands, eventService apicore.Eve
 
 	srv.registerDocumentationRoutes(router, openapiYAML, openapiJSON)
 	srv.registerAuthRoutes(router)
+	srv.registerCalendarRoutes(router)
 
 	paginator := createPaginationMiddleware()
 	writeRateLimitMW := createRateLimitMiddleware()
@@ -36,6 +37,8 @@ func (srv *Server) NewRouter(mw apicore.ClientCommands, eventService apicore.Eve
 	v1.Use(srv.ensureCacheInitialized())
 	v1.Use(srv.ensureAuthenticated(mw))
 
+	srv.registerBlockRoutes(v1, eventService, writeRateLimitMW)
+	srv.registerFileRoutes(v1, eventService, writeRateLimitMW)
 	srv.registerListRoutes(v1, eventService, writeRateLimitMW)
 	srv.registerMemberRoutes(v1, eventService)
 	srv.registerObjectRoutes(v1, eventService, writeRateLimitMW)
@@ -108,12 +111,95 @@ func (srv *Server) registerAuthRoutes(router *gin.Engine) {
 	}
 }
 
+// registerBlockRoutes registers block-related routes
+func (srv *Server) registerBlockRoutes(v1 *gin.RouterGroup, eventService apicore.EventService, writeRateLimitMW gin.HandlerFunc) {
+	v1.GET("/spaces/:space_id/objects/:object_id/blocks",
+		ensureAnalyticsEvent("ListBlocks", eventService),
+		handler.ListBlocksHandler(srv.service),
+	)
+	v1.GET("/spaces/:space_id/objects/:object_id/blocks/:block_id",
+		ensureAnalyticsEvent("GetBlock", eventService),
+		handler.GetBlockHandler(srv.service),
+	)
+	v1.POST("/spaces/:space_id/objects/:object_id/blocks",
+		writeRateLimitMW,
+		ensureAnalyticsEvent("CreateBlock", eventService),
+		handler.CreateBlockHandler(srv.service),
+	)
+	v1.POST("/spaces/:space_id/objects/:object_id/blocks/markdown",
+		writeRateLimitMW,
+		ensureAnalyticsEvent("InsertMarkdown", eventService),
+		handler.InsertMarkdownHandler(srv.service),
+	)
+	v1.PATCH("/spaces/:space_id/objects/:object_id/blocks/:block_id",
+		writeRateLimitMW,
+		ensureAnalyticsEvent("UpdateBlock", eventService),
+		handler.UpdateBlockHandler(srv.service),
+	)
+	v1.POST("/spaces/:space_id/objects/:object_id/blocks/:block_id/move",
+		writeRateLimitMW,
+		ensureAnalyticsEvent("MoveBlock", eventService),
+		handler.MoveBlockHandler(srv.service),
+	)
+	v1.DELETE("/spaces/:space_id/objects/:object_id/blocks/:block_id",
+		writeRateLimitMW,
+		ensureAnalyticsEvent("DeleteBlock", eventService),
+		handler.DeleteBlockHandler(srv.service),
+	)
+}
+
+// registerCalendarRoutes registers calendar feed routes (no auth required, the feed token is the credential)
+func (srv *Server) registerCalendarRoutes(router *gin.Engine) {
+	router.GET("/v1/calendar/feeds/:feed", handler.GetCalendarFeedHandler(srv.service))
+}
+
+// registerFileRoutes registers file-related routes
+func (srv *Server) registerFileRoutes(v1 *gin.RouterGroup, eventService apicore.EventService, writeRateLimitMW gin.HandlerFunc) {
+	v1.GET("/spaces/:space_id/files",
+		srv.ensureFilters(),
+		ensureAnalyticsEvent("ListFiles", eventService),
+		handler.ListFilesHandler(srv.service),
+	)
+	v1.GET("/spaces/:space_id/files/:file_id",
+		ensureAnalyticsEvent("GetFile", eventService),
+		handler.GetFileHandler(srv.service),
+	)
+	v1.GET("/spaces/:space_id/files/:file_id/download",
+		ensureAnalyticsEvent("DownloadFile", eventService),
+		handler.DownloadFileHandler(srv.service),
+	)
+	v1.POST("/spaces/:space_id/files",
+		writeRateLimitMW,
+		ensureAnalyticsEvent("UploadFile", eventService),
+		handler.UploadFileHandler(srv.service),
+	)
+}
+
 // registerListRoutes registers list-related routes
 func (srv *Server) registerListRoutes(v1 *gin.RouterGroup, eventService apicore.EventService, writeRateLimitMW gin.HandlerFunc) {
 	v1.GET("/spaces/:space_id/lists/:list_id/views",
 		ensureAnalyticsEvent("GetListViews", eventService),
 		handler.GetListViewsHandler(srv.service),
 	)
+	v1.GET("/spaces/:space_id/lists/:list_id/views/:view_id",
+		ensureAnalyticsEvent("GetListView", eventService),
+		handler.GetListViewHandler(srv.service),
+	)
+	v1.POST("/spaces/:space_id/lists/:list_id/views",
+		writeRateLimitMW,
+		ensureAnalyticsEvent("CreateListView", eventService),
+		handler.CreateListViewHandler(srv.service),
+	)
+	v1.PATCH("/spaces/:space_id/lists/:list_id/views/:view_id",
+		writeRateLimitMW,
+		ensureAnalyticsEvent("UpdateListView", eventService),
+		handler.UpdateListViewHandler(srv.service),
+	)
+	v1.DELETE("/spaces/:space_id/lists/:list_id/views/:view_id",
+		writeRateLimitMW,
+		ensureAnalyticsEvent("DeleteListView", eventService),
+		handler.DeleteListViewHandler(srv.service),
+	)
 	v1.GET("/spaces/:space_id/lists/:list_id/views/:view_id/objects",
 		srv.ensureFilters(),
 		ensureAnalyticsEvent("GetListObjects", eventService),
@@ -176,6 +262,16 @@ func (srv *Server) registerObjectRoutes(v1 *gin.RouterGroup, eventService apicor
 		ensureAnalyticsEvent("DeleteObject", eventService),
 		handler.DeleteObjectHandler(srv.service),
 	)
+	v1.GET("/spaces/:space_id/changes",
+		srv.ensureFilters(),
+		ensureAnalyticsEvent("StreamChanges", eventService),
+		handler.StreamChangesHandler(srv.service),
+	)
+	v1.POST("/spaces/:space_id/batch",
+		writeRateLimitMW,
+		ensureAnalyticsEvent("ExecuteBatch", eventService),
+		handler.ExecuteBatchHandler(srv.service),
+	)
 }
 
 // registerPropertyRoutes registers property-related routes
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/mock"
@@ -49,7 +50,7 @@ func TestRouter_MetadataHeader(t *testing.T) {
 		// given
 		fx := newFixture(t)
 		engine := fx.NewRouter(fx.mwMock, fx.eventMock, []byte{}, []byte{})
-		fx.KeyToToken = map[string]ApiSessionEntry{"validKey": {Token: "dummyToken", AppName: "dummyApp"}}
+		fx.KeyToToken = map[string]ApiSessionEntry{"validKey": {Token: "dummyToken", AppName: "dummyApp", ValidatedAt: time.Now()}}
 		fx.mwMock.On("ObjectSearch", mock.Anything, mock.Anything).
 			Return(&pb.RpcObjectSearchResponse{
 				Records: []*types.Struct{},
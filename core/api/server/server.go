@@ -3,16 +3,37 @@ package server
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
 	apicore "github.com/anyproto/anytype-heart/core/api/core"
 	"github.com/anyproto/anytype-heart/core/api/service"
+	"github.com/anyproto/anytype-heart/pb"
 )
 
 type ApiSessionEntry struct {
-	Token   string `json:"token"`
-	AppName string `json:"appName"`
+	Token    string   `json:"token"`
+	AppName  string   `json:"appName"`
+	SpaceIds []string `json:"spaceIds,omitempty"` // spaces the api key can access, all spaces if empty
+	ReadOnly bool     `json:"readOnly,omitempty"`
+	ExpireAt int64    `json:"expireAt,omitempty"`
+
+	ValidatedAt time.Time `json:"-"`
+}
+
+func newApiSessionEntry(resp *pb.RpcWalletCreateSessionResponse) ApiSessionEntry {
+	entry := ApiSessionEntry{
+		Token:       resp.Token,
+		AppName:     resp.AppName,
+		ValidatedAt: time.Now(),
+	}
+	if access := resp.AppAccess; access != nil {
+		entry.SpaceIds = access.SpaceIds
+		entry.ReadOnly = access.ReadOnly
+		entry.ExpireAt = access.ExpireAt
+	}
+	return entry
 }
 
 // Server wraps the HTTP server and service logic.
@@ -27,13 +48,13 @@ type Server struct {
 }
 
 // NewServer constructs a new Server with the default config and sets up the routes.
-func NewServer(mw apicore.ClientCommands, accountService apicore.AccountService, eventService apicore.EventService, crossSpaceSubService apicore.CrossSpaceSubscriptionService, openapiYAML []byte, openapiJSON []byte) *Server {
+func NewServer(mw apicore.ClientCommands, accountService apicore.AccountService, eventService apicore.EventService, crossSpaceSubService apicore.CrossSpaceSubscriptionService, subService apicore.SubscriptionService, fileReader apicore.FileReader, openapiYAML []byte, openapiJSON []byte) *Server {
 	gatewayUrl, techSpaceId, err := getAccountInfo(accountService)
 	if err != nil {
 		panic(err)
 	}
 
-	s := &Server{service: service.NewService(mw, gatewayUrl, techSpaceId, crossSpaceSubService)}
+	s := &Server{service: service.NewService(mw, gatewayUrl, techSpaceId, crossSpaceSubService, subService, fileReader)}
 	s.engine = s.NewRouter(mw, eventService, openapiYAML, openapiJSON)
 	s.KeyToToken = make(map[string]ApiSessionEntry)
 
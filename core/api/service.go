@@ -16,7 +16,9 @@ import (
 	apicore "github.com/anyproto/anytype-heart/core/api/core"
 	"github.com/anyproto/anytype-heart/core/api/server"
 	"github.com/anyproto/anytype-heart/core/event"
+	"github.com/anyproto/anytype-heart/core/subscription"
 	"github.com/anyproto/anytype-heart/core/subscription/crossspacesub"
+	"github.com/anyproto/anytype-heart/pkg/lib/gateway"
 	"github.com/anyproto/anytype-heart/pkg/lib/logging"
 )
 
@@ -48,6 +50,8 @@ type apiService struct {
 	accountService       apicore.AccountService
 	eventService         apicore.EventService
 	crossSpaceSubService apicore.CrossSpaceSubscriptionService
+	subService           apicore.SubscriptionService
+	fileReader           apicore.FileReader
 
 	listenAddr string
 
@@ -85,6 +89,8 @@ func (s *apiService) Init(a *app.App) error {
 	s.accountService = a.MustComponent(account.CName).(account.Service)
 	s.eventService = a.MustComponent(event.CName).(apicore.EventService)
 	s.crossSpaceSubService = a.MustComponent(crossspacesub.CName).(apicore.CrossSpaceSubscriptionService)
+	s.subService = a.MustComponent(subscription.CName).(apicore.SubscriptionService)
+	s.fileReader = a.MustComponent(gateway.CName).(apicore.FileReader)
 	return nil
 }
 
@@ -117,6 +123,8 @@ func (s *apiService) startServer() error {
 		s.accountService,
 		s.eventService,
 		s.crossSpaceSubService,
+		s.subService,
+		s.fileReader,
 		openapiYAML,
 		openapiJSON,
 	)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	apimodel "github.com/anyproto/anytype-heart/core/api/model"
 	"github.com/anyproto/anytype-heart/core/api/util"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
@@ -15,15 +16,22 @@ var (
 	ErrFailedAuthenticate       = errors.New("failed to authenticate user")
 )
 
-// CreateChallenge calls AccountLocalLinkNewChallenge and returns the challenge ID
-func (s *Service) CreateChallenge(ctx context.Context, appName string) (string, error) {
-	if appName == "" {
+// CreateChallenge calls AccountLocalLinkNewChallenge and returns the challenge ID. The requested space and permission
+// restrictions are shown to the user along with the challenge and apply to the api key once the challenge is solved
+func (s *Service) CreateChallenge(ctx context.Context, request apimodel.CreateChallengeRequest) (string, error) {
+	if request.AppName == "" {
 		return "", ErrMissingAppName
 	}
+	if request.ExpiresAt < 0 {
+		return "", util.ErrBadInput("expires_at must be a unix time")
+	}
 
 	resp := s.mw.AccountLocalLinkNewChallenge(ctx, &pb.RpcAccountLocalLinkNewChallengeRequest{
-		AppName: appName,
-		Scope:   model.AccountAuth_JsonAPI,
+		AppName:  request.AppName,
+		Scope:    model.AccountAuth_JsonAPI,
+		SpaceIds: request.SpaceIds,
+		ReadOnly: request.ReadOnly,
+		ExpireAt: request.ExpiresAt,
 	})
 
 	if resp.Error != nil && resp.Error.Code != pb.RpcAccountLocalLinkNewChallengeResponseError_NULL {
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	apimodel "github.com/anyproto/anytype-heart/core/api/model"
 	"github.com/anyproto/anytype-heart/core/api/util"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
@@ -35,7 +36,37 @@ func TestAuthService_GenerateNewChallenge(t *testing.T) {
 			}).Once()
 
 		// when
-		challengeId, err := fx.service.CreateChallenge(ctx, mockedAppName)
+		challengeId, err := fx.service.CreateChallenge(ctx, apimodel.CreateChallengeRequest{AppName: mockedAppName})
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, mockedChallengeId, challengeId)
+	})
+
+	t.Run("successful challenge creation with restricted access", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("AccountLocalLinkNewChallenge", mock.Anything, &pb.RpcAccountLocalLinkNewChallengeRequest{
+			AppName:  mockedAppName,
+			Scope:    model.AccountAuth_JsonAPI,
+			SpaceIds: []string{"space-id"},
+			ReadOnly: true,
+			ExpireAt: 1767225600,
+		}).
+			Return(&pb.RpcAccountLocalLinkNewChallengeResponse{
+				ChallengeId: mockedChallengeId,
+				Error:       &pb.RpcAccountLocalLinkNewChallengeResponseError{Code: pb.RpcAccountLocalLinkNewChallengeResponseError_NULL},
+			}).Once()
+
+		// when
+		challengeId, err := fx.service.CreateChallenge(ctx, apimodel.CreateChallengeRequest{
+			AppName:   mockedAppName,
+			SpaceIds:  []string{"space-id"},
+			ReadOnly:  true,
+			ExpiresAt: 1767225600,
+		})
 
 		// then
 		require.NoError(t, err)
@@ -48,7 +79,7 @@ func TestAuthService_GenerateNewChallenge(t *testing.T) {
 		fx := newFixture(t)
 
 		// when
-		challengeId, err := fx.service.CreateChallenge(ctx, "")
+		challengeId, err := fx.service.CreateChallenge(ctx, apimodel.CreateChallengeRequest{})
 
 		// then
 		require.Error(t, err)
@@ -70,7 +101,7 @@ func TestAuthService_GenerateNewChallenge(t *testing.T) {
 			}).Once()
 
 		// when
-		challengeId, err := fx.service.CreateChallenge(ctx, mockedAppName)
+		challengeId, err := fx.service.CreateChallenge(ctx, apimodel.CreateChallengeRequest{AppName: mockedAppName})
 
 		// then
 		require.Error(t, err)
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	apimodel "github.com/anyproto/anytype-heart/core/api/model"
+	"github.com/anyproto/anytype-heart/core/api/util"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+// maxBatchOperations limits the number of operations of a single batch request
+const maxBatchOperations = 1000
+
+// ExecuteBatch executes the operations in order and returns a result per operation, along with the error of each
+// failed operation at the same index. Temp ids of created objects are replaced with the real ids in later
+// operations. When the batch is to be rolled back, execution stops at the first failure and the objects created by
+// the batch are archived.
+func (s *Service) ExecuteBatch(ctx context.Context, spaceId string, request apimodel.BatchRequest) (*apimodel.BatchResponse, []error, error) {
+	if err := validateBatch(request); err != nil {
+		return nil, nil, err
+	}
+
+	b := &batch{
+		ids:       make(map[string]string),
+		failedOps: make(map[string]int),
+	}
+	response := &apimodel.BatchResponse{Results: make([]apimodel.BatchOperationResult, len(request.Operations))}
+	errs := make([]error, len(request.Operations))
+	var created []int
+	failed := false
+	for i, op := range request.Operations {
+		result := &response.Results[i]
+		result.Index = i
+		result.Op = op.Op
+		result.TempId = op.TempId
+		if failed && request.RollbackOnFailure {
+			result.Status = apimodel.BatchOperationStatusSkipped
+			continue
+		}
+
+		objectId, err := s.executeBatchOperation(ctx, spaceId, b, op)
+		result.ObjectId = objectId
+		if op.Op == apimodel.BatchOperationCreate && objectId != "" {
+			created = append(created, i)
+			if op.TempId != "" {
+				b.ids[op.TempId] = objectId
+			}
+		}
+		if err != nil {
+			result.Status = apimodel.BatchOperationStatusFailed
+			errs[i] = err
+			failed = true
+			if op.TempId != "" {
+				b.failedOps[op.TempId] = i
+			}
+			continue
+		}
+		result.Status = apimodel.BatchOperationStatusSucceeded
+	}
+
+	if failed && request.RollbackOnFailure {
+		response.RolledBack = true
+		for _, i := range slices.Backward(created) {
+			result := &response.Results[i]
+			if err := s.archiveObject(ctx, result.ObjectId); err != nil {
+				log.Errorf("failed to roll back object %s created by batch: %v", result.ObjectId, err)
+				response.RolledBack = false
+				continue
+			}
+			if result.Status == apimodel.BatchOperationStatusSucceeded {
+				result.Status = apimodel.BatchOperationStatusRolledBack
+			}
+		}
+	}
+
+	return response, errs, nil
+}
+
+// batch keeps the temp ids of the objects created by the batch so far
+type batch struct {
+	ids       map[string]string
+	failedOps map[string]int
+}
+
+// resolve replaces a temp id of a created object with its id
+func (b *batch) resolve(id string) (string, error) {
+	if index, ok := b.failedOps[id]; ok {
+		return "", util.ErrBadInput(fmt.Sprintf("object %q was not created by operation %d", id, index))
+	}
+	if objectId, ok := b.ids[id]; ok {
+		return objectId, nil
+	}
+	return id, nil
+}
+
+func (b *batch) resolveAll(ids []string) ([]string, error) {
+	resolved := make([]string, 0, len(ids))
+	for _, id := range ids {
+		objectId, err := b.resolve(id)
+		if err != nil {
+			return nil, err
+		}
+		resolved = append(resolved, objectId)
+	}
+	return resolved, nil
+}
+
+// resolveProperties replaces temp ids in the values of object properties
+func (b *batch) resolveProperties(properties []apimodel.PropertyLinkWithValue) ([]apimodel.PropertyLinkWithValue, error) {
+	resolved := make([]apimodel.PropertyLinkWithValue, 0, len(properties))
+	for _, property := range properties {
+		if value, ok := property.WrappedPropertyLinkWithValue.(apimodel.ObjectsPropertyLinkValue); ok && value.Objects != nil {
+			objects, err := b.resolveAll(*value.Objects)
+			if err != nil {
+				return nil, err
+			}
+			value.Objects = &objects
+			property = apimodel.PropertyLinkWithValue{WrappedPropertyLinkWithValue: value}
+		}
+		resolved = append(resolved, property)
+	}
+	return resolved, nil
+}
+
+// executeBatchOperation executes a single operation and returns the id of the object it was applied to
+func (s *Service) executeBatchOperation(ctx context.Context, spaceId string, b *batch, op apimodel.BatchOperation) (string, error) {
+	switch op.Op {
+	case apimodel.BatchOperationCreate:
+		request := *op.Create
+		properties, err := b.resolveProperties(request.Properties)
+		if err != nil {
+			return "", err
+		}
+		request.Properties = properties
+		object, err := s.CreateObject(ctx, spaceId, request)
+		if object != nil {
+			return object.Id, err
+		}
+		return "", err
+	case apimodel.BatchOperationUpdate:
+		objectId, err := b.resolve(op.ObjectId)
+		if err != nil {
+			return "", err
+		}
+		request := *op.Update
+		if request.Properties != nil {
+			properties, err := b.resolveProperties(*request.Properties)
+			if err != nil {
+				return objectId, err
+			}
+			request.Properties = &properties
+		}
+		_, err = s.UpdateObject(ctx, spaceId, objectId, request)
+		return objectId, err
+	case apimodel.BatchOperationDelete:
+		objectId, err := b.resolve(op.ObjectId)
+		if err != nil {
+			return "", err
+		}
+		_, err = s.DeleteObject(ctx, spaceId, objectId)
+		return objectId, err
+	case apimodel.BatchOperationAddToList:
+		listId, err := b.resolve(op.ListId)
+		if err != nil {
+			return "", err
+		}
+		objects, err := b.resolveAll(op.Objects)
+		if err != nil {
+			return listId, err
+		}
+		return listId, s.AddObjectsToList(ctx, spaceId, listId, apimodel.AddObjectsToListRequest{Objects: objects})
+	default:
+		return "", util.ErrBadInput(fmt.Sprintf("invalid batch operation: %q", op.Op))
+	}
+}
+
+// validateBatch checks that every operation has the fields it needs before anything is executed
+func validateBatch(request apimodel.BatchRequest) error {
+	if len(request.Operations) == 0 {
+		return util.ErrBadInput("operations must not be empty")
+	}
+	if len(request.Operations) > maxBatchOperations {
+		return util.ErrBadInput(fmt.Sprintf("a batch can contain at most %d operations", maxBatchOperations))
+	}
+
+	tempIds := make(map[string]int)
+	for i, op := range request.Operations {
+		var err error
+		switch op.Op {
+		case apimodel.BatchOperationCreate:
+			if op.Create == nil {
+				err = util.ErrBadInput("create must be set")
+			} else if first, ok := tempIds[op.TempId]; ok && op.TempId != "" {
+				err = util.ErrBadInput(fmt.Sprintf("temp_id %q is already used by operation %d", op.TempId, first))
+			}
+			tempIds[op.TempId] = i
+		case apimodel.BatchOperationUpdate:
+			if op.ObjectId == "" || op.Update == nil {
+				err = util.ErrBadInput("object_id and update must be set")
+			}
+		case apimodel.BatchOperationDelete:
+			if op.ObjectId == "" {
+				err = util.ErrBadInput("object_id must be set")
+			}
+		case apimodel.BatchOperationAddToList:
+			if op.ListId == "" || len(op.Objects) == 0 {
+				err = util.ErrBadInput("list_id and objects must be set")
+			}
+		default:
+			err = util.ErrBadInput(fmt.Sprintf("invalid batch operation: %q", op.Op))
+		}
+		if err == nil && op.TempId != "" && op.Op != apimodel.BatchOperationCreate {
+			err = util.ErrBadInput("temp_id can only be set for create operations")
+		}
+		if err != nil {
+			return fmt.Errorf("invalid operation at index %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// archiveObject moves an object to the bin
+func (s *Service) archiveObject(ctx context.Context, objectId string) error {
+	resp := s.mw.ObjectSetIsArchived(ctx, &pb.RpcObjectSetIsArchivedRequest{
+		ContextId:  objectId,
+		IsArchived: true,
+	})
+	if resp.Error != nil && resp.Error.Code != pb.RpcObjectSetIsArchivedResponseError_NULL {
+		return ErrFailedDeleteObject
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	apimodel "github.com/anyproto/anytype-heart/core/api/model"
+	"github.com/anyproto/anytype-heart/core/api/util"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestBatchService_ExecuteBatch(t *testing.T) {
+	t.Run("successful batch with temp id reference", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		fx.populateCache(mockedSpaceId)
+		mockBatchObjectCreate(fx, mockedNewObjectId)
+
+		fx.mwMock.On("ObjectCollectionAdd", mock.Anything, &pb.RpcObjectCollectionAddRequest{
+			ContextId: mockedListId,
+			ObjectIds: []string{mockedNewObjectId, mockedObjectId},
+		}).Return(&pb.RpcObjectCollectionAddResponse{
+			Error: &pb.RpcObjectCollectionAddResponseError{Code: pb.RpcObjectCollectionAddResponseError_NULL},
+		}).Once()
+
+		// when
+		response, errs, err := fx.service.ExecuteBatch(ctx, mockedSpaceId, apimodel.BatchRequest{
+			Operations: []apimodel.BatchOperation{
+				{Op: apimodel.BatchOperationCreate, TempId: "task-1", Create: &apimodel.CreateObjectRequest{Name: mockedObjectName, TypeKey: mockedTypeKey}},
+				{Op: apimodel.BatchOperationAddToList, ListId: mockedListId, Objects: []string{"task-1", mockedObjectId}},
+			},
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, []error{nil, nil}, errs)
+		require.False(t, response.RolledBack)
+		require.Len(t, response.Results, 2)
+		require.Equal(t, apimodel.BatchOperationStatusSucceeded, response.Results[0].Status)
+		require.Equal(t, "task-1", response.Results[0].TempId)
+		require.Equal(t, mockedNewObjectId, response.Results[0].ObjectId)
+		require.Equal(t, apimodel.BatchOperationStatusSucceeded, response.Results[1].Status)
+		require.Equal(t, mockedListId, response.Results[1].ObjectId)
+	})
+
+	t.Run("failure without rollback continues and fails dependent operations", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		fx.populateCache(mockedSpaceId)
+
+		fx.mwMock.On("ObjectCreate", mock.Anything, mock.Anything).
+			Return(&pb.RpcObjectCreateResponse{
+				Error: &pb.RpcObjectCreateResponseError{Code: pb.RpcObjectCreateResponseError_UNKNOWN_ERROR},
+			}).Once()
+
+		fx.mwMock.On("ObjectCollectionAdd", mock.Anything, &pb.RpcObjectCollectionAddRequest{
+			ContextId: mockedListId,
+			ObjectIds: []string{mockedObjectId},
+		}).Return(&pb.RpcObjectCollectionAddResponse{
+			Error: &pb.RpcObjectCollectionAddResponseError{Code: pb.RpcObjectCollectionAddResponseError_NULL},
+		}).Once()
+
+		// when
+		response, errs, err := fx.service.ExecuteBatch(ctx, mockedSpaceId, apimodel.BatchRequest{
+			Operations: []apimodel.BatchOperation{
+				{Op: apimodel.BatchOperationCreate, TempId: "task-1", Create: &apimodel.CreateObjectRequest{Name: mockedObjectName, TypeKey: mockedTypeKey}},
+				{Op: apimodel.BatchOperationDelete, ObjectId: "task-1"},
+				{Op: apimodel.BatchOperationAddToList, ListId: mockedListId, Objects: []string{mockedObjectId}},
+			},
+		})
+
+		// then
+		require.NoError(t, err)
+		require.False(t, response.RolledBack)
+		require.Equal(t, apimodel.BatchOperationStatusFailed, response.Results[0].Status)
+		require.ErrorIs(t, errs[0], ErrFailedCreateObject)
+		require.Equal(t, apimodel.BatchOperationStatusFailed, response.Results[1].Status)
+		require.ErrorIs(t, errs[1], util.ErrBad)
+		require.Equal(t, apimodel.BatchOperationStatusSucceeded, response.Results[2].Status)
+		require.NoError(t, errs[2])
+	})
+
+	t.Run("failure with rollback archives created objects and skips the rest", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		fx.populateCache(mockedSpaceId)
+		mockBatchObjectCreate(fx, mockedNewObjectId)
+
+		fx.mwMock.On("ObjectCollectionAdd", mock.Anything, mock.Anything).
+			Return(&pb.RpcObjectCollectionAddResponse{
+				Error: &pb.RpcObjectCollectionAddResponseError{Code: pb.RpcObjectCollectionAddResponseError_UNKNOWN_ERROR},
+			}).Once()
+
+		fx.mwMock.On("ObjectSetIsArchived", mock.Anything, &pb.RpcObjectSetIsArchivedRequest{
+			ContextId:  mockedNewObjectId,
+			IsArchived: true,
+		}).Return(&pb.RpcObjectSetIsArchivedResponse{
+			Error: &pb.RpcObjectSetIsArchivedResponseError{Code: pb.RpcObjectSetIsArchivedResponseError_NULL},
+		}).Once()
+
+		// when
+		response, errs, err := fx.service.ExecuteBatch(ctx, mockedSpaceId, apimodel.BatchRequest{
+			Operations: []apimodel.BatchOperation{
+				{Op: apimodel.BatchOperationCreate, TempId: "task-1", Create: &apimodel.CreateObjectRequest{Name: mockedObjectName, TypeKey: mockedTypeKey}},
+				{Op: apimodel.BatchOperationAddToList, ListId: mockedListId, Objects: []string{"task-1"}},
+				{Op: apimodel.BatchOperationDelete, ObjectId: mockedObjectId},
+			},
+			RollbackOnFailure: true,
+		})
+
+		// then
+		require.NoError(t, err)
+		require.True(t, response.RolledBack)
+		require.Equal(t, apimodel.BatchOperationStatusRolledBack, response.Results[0].Status)
+		require.Equal(t, apimodel.BatchOperationStatusFailed, response.Results[1].Status)
+		require.ErrorIs(t, errs[1], ErrFailedAddObjectsToList)
+		require.Equal(t, apimodel.BatchOperationStatusSkipped, response.Results[2].Status)
+		require.NoError(t, errs[2])
+	})
+
+	t.Run("invalid operations are rejected before execution", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		// when
+		_, _, err := fx.service.ExecuteBatch(ctx, mockedSpaceId, apimodel.BatchRequest{
+			Operations: []apimodel.BatchOperation{
+				{Op: apimodel.BatchOperationCreate, TempId: "task-1", Create: &apimodel.CreateObjectRequest{Name: mockedObjectName}},
+				{Op: apimodel.BatchOperationCreate, TempId: "task-1", Create: &apimodel.CreateObjectRequest{Name: mockedObjectName}},
+			},
+		})
+
+		// then
+		require.ErrorIs(t, err, util.ErrBad)
+	})
+}
+
+func mockBatchObjectCreate(fx *fixture, objectId string) {
+	fx.mwMock.On("ObjectCreate", mock.Anything, mock.Anything).Return(&pb.RpcObjectCreateResponse{
+		ObjectId: objectId,
+		Details: &types.Struct{
+			Fields: map[string]*types.Value{
+				bundle.RelationKeyId.String():      pbtypes.String(objectId),
+				bundle.RelationKeySpaceId.String(): pbtypes.String(mockedSpaceId),
+			},
+		},
+		Error: &pb.RpcObjectCreateResponseError{Code: pb.RpcObjectCreateResponseError_NULL},
+	}).Once()
+
+	fx.mwMock.On("ObjectShow", mock.Anything, &pb.RpcObjectShowRequest{
+		SpaceId:  mockedSpaceId,
+		ObjectId: objectId,
+	}).Return(&pb.RpcObjectShowResponse{
+		ObjectView: &model.ObjectView{
+			RootId: objectId,
+			Details: []*model.ObjectViewDetailsSet{
+				{
+					Id: objectId,
+					Details: &types.Struct{
+						Fields: map[string]*types.Value{
+							bundle.RelationKeyId.String():             pbtypes.String(objectId),
+							bundle.RelationKeyName.String():           pbtypes.String(mockedObjectName),
+							bundle.RelationKeyResolvedLayout.String(): pbtypes.Float64(float64(model.ObjectType_basic)),
+							bundle.RelationKeyType.String():           pbtypes.String(mockedTypeId),
+							bundle.RelationKeySpaceId.String():        pbtypes.String(mockedSpaceId),
+						},
+					},
+				},
+			},
+		},
+		Error: &pb.RpcObjectShowResponseError{Code: pb.RpcObjectShowResponseError_NULL},
+	}).Once()
+
+	fx.mwMock.On("ObjectExport", mock.Anything, mock.Anything).Return(&pb.RpcObjectExportResponse{
+		Result: "",
+		Error:  &pb.RpcObjectExportResponseError{Code: pb.RpcObjectExportResponseError_NULL},
+	}, nil).Once()
+}
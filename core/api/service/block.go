@@ -0,0 +1,608 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	apimodel "github.com/anyproto/anytype-heart/core/api/model"
+	"github.com/anyproto/anytype-heart/core/api/pagination"
+	"github.com/anyproto/anytype-heart/core/api/util"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+var (
+	ErrBlockNotFound      = errors.New("block not found")
+	ErrFailedUpdateBlock  = errors.New("failed to update block")
+	ErrFailedMoveBlock    = errors.New("failed to move block")
+	ErrFailedDeleteBlock  = errors.New("failed to delete block")
+	ErrFailedCreateTable  = errors.New("failed to create table")
+	ErrFailedInsertBlocks = errors.New("failed to insert markdown")
+)
+
+// headerBlockId is the id of the block with the title, description and featured properties. Its content is
+// managed by the object properties, so it is not listed with other blocks
+const headerBlockId = "header"
+
+const maxTableSize = 100
+
+var textStyles = map[apimodel.TextStyle]model.BlockContentTextStyle{
+	apimodel.TextStyleParagraph:   model.BlockContentText_Paragraph,
+	apimodel.TextStyleHeader1:     model.BlockContentText_Header1,
+	apimodel.TextStyleHeader2:     model.BlockContentText_Header2,
+	apimodel.TextStyleHeader3:     model.BlockContentText_Header3,
+	apimodel.TextStyleHeader4:     model.BlockContentText_Header4,
+	apimodel.TextStyleQuote:       model.BlockContentText_Quote,
+	apimodel.TextStyleCode:        model.BlockContentText_Code,
+	apimodel.TextStyleCheckbox:    model.BlockContentText_Checkbox,
+	apimodel.TextStyleBulleted:    model.BlockContentText_Marked,
+	apimodel.TextStyleNumbered:    model.BlockContentText_Numbered,
+	apimodel.TextStyleToggle:      model.BlockContentText_Toggle,
+	apimodel.TextStyleCallout:     model.BlockContentText_Callout,
+	apimodel.TextStyleTitle:       model.BlockContentText_Title,
+	apimodel.TextStyleDescription: model.BlockContentText_Description,
+}
+
+var markTypes = map[apimodel.MarkType]model.BlockContentTextMarkType{
+	apimodel.MarkTypeStrikethrough:   model.BlockContentTextMark_Strikethrough,
+	apimodel.MarkTypeKeyboard:        model.BlockContentTextMark_Keyboard,
+	apimodel.MarkTypeItalic:          model.BlockContentTextMark_Italic,
+	apimodel.MarkTypeBold:            model.BlockContentTextMark_Bold,
+	apimodel.MarkTypeUnderscored:     model.BlockContentTextMark_Underscored,
+	apimodel.MarkTypeLink:            model.BlockContentTextMark_Link,
+	apimodel.MarkTypeTextColor:       model.BlockContentTextMark_TextColor,
+	apimodel.MarkTypeBackgroundColor: model.BlockContentTextMark_BackgroundColor,
+	apimodel.MarkTypeMention:         model.BlockContentTextMark_Mention,
+	apimodel.MarkTypeEmoji:           model.BlockContentTextMark_Emoji,
+	apimodel.MarkTypeObject:          model.BlockContentTextMark_Object,
+}
+
+var blockAligns = map[apimodel.BlockAlign]model.BlockAlign{
+	apimodel.BlockAlignLeft:    model.Block_AlignLeft,
+	apimodel.BlockAlignCenter:  model.Block_AlignCenter,
+	apimodel.BlockAlignRight:   model.Block_AlignRight,
+	apimodel.BlockAlignJustify: model.Block_AlignJustify,
+}
+
+var blockPositions = map[apimodel.BlockPosition]model.BlockPosition{
+	apimodel.BlockPositionTop:        model.Block_Top,
+	apimodel.BlockPositionBottom:     model.Block_Bottom,
+	apimodel.BlockPositionInner:      model.Block_Inner,
+	apimodel.BlockPositionInnerFirst: model.Block_InnerFirst,
+	apimodel.BlockPositionReplace:    model.Block_Replace,
+}
+
+var fileTypes = map[model.BlockContentFileType]string{
+	model.BlockContentFile_File:  "file",
+	model.BlockContentFile_Image: "image",
+	model.BlockContentFile_Video: "video",
+	model.BlockContentFile_Audio: "audio",
+	model.BlockContentFile_PDF:   "pdf",
+}
+
+var fileLayoutTypes = map[model.ObjectTypeLayout]model.BlockContentFileType{
+	model.ObjectType_file:  model.BlockContentFile_File,
+	model.ObjectType_image: model.BlockContentFile_Image,
+	model.ObjectType_video: model.BlockContentFile_Video,
+	model.ObjectType_audio: model.BlockContentFile_Audio,
+	model.ObjectType_pdf:   model.BlockContentFile_PDF,
+}
+
+// ListBlocks returns blocks of the object in the order of the block tree, excluding the header.
+func (s *Service) ListBlocks(ctx context.Context, spaceId string, objectId string, offset int, limit int) (blocks []apimodel.Block, total int, hasMore bool, err error) {
+	blocks, err = s.getObjectBlocks(ctx, spaceId, objectId)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	total = len(blocks)
+	paginatedBlocks, hasMore := pagination.Paginate(blocks, offset, limit)
+	return paginatedBlocks, total, hasMore, nil
+}
+
+// GetBlock returns a single block of the object.
+func (s *Service) GetBlock(ctx context.Context, spaceId string, objectId string, blockId string) (*apimodel.Block, error) {
+	blocks, err := s.getObjectBlocks(ctx, spaceId, objectId)
+	if err != nil {
+		return nil, err
+	}
+	return findBlock(blocks, blockId)
+}
+
+// CreateBlock creates a text, file, link or table block relative to the target block.
+func (s *Service) CreateBlock(ctx context.Context, spaceId string, objectId string, request apimodel.CreateBlockRequest) (*apimodel.Block, error) {
+	targetId, position, err := s.resolveTarget(ctx, spaceId, objectId, request.TargetId, request.Position)
+	if err != nil {
+		return nil, err
+	}
+
+	var contents int
+	for _, set := range []bool{request.Text != nil, request.File != nil, request.Link != nil, request.Table != nil} {
+		if set {
+			contents++
+		}
+	}
+	if contents != 1 {
+		return nil, util.ErrBadInput("exactly one of text, file, link or table must be set")
+	}
+
+	var blockId string
+	if request.Table != nil {
+		blockId, err = s.createTable(ctx, objectId, targetId, position, *request.Table)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		block := &model.Block{
+			Align:         model.Block_AlignLeft,
+			VerticalAlign: model.Block_VerticalAlignTop,
+		}
+		switch {
+		case request.Text != nil:
+			block.BackgroundColor = request.Text.BackgroundColor
+			block.Content, err = textContentToModel(*request.Text)
+		case request.File != nil:
+			block.Content, err = s.fileContentToModel(ctx, spaceId, request.File.ObjectId)
+		case request.Link != nil:
+			block.Content = &model.BlockContentOfLink{Link: &model.BlockContentLink{TargetBlockId: request.Link.ObjectId}}
+		}
+		if err != nil {
+			return nil, err
+		}
+		resp := s.mw.BlockCreate(ctx, &pb.RpcBlockCreateRequest{
+			ContextId: objectId,
+			TargetId:  targetId,
+			Block:     block,
+			Position:  position,
+		})
+		if resp.Error != nil && resp.Error.Code != pb.RpcBlockCreateResponseError_NULL {
+			return nil, ErrFailedCreateBlock
+		}
+		blockId = resp.BlockId
+	}
+
+	return s.GetBlock(ctx, spaceId, objectId, blockId)
+}
+
+// InsertMarkdown converts the markdown to blocks and inserts them relative to the target block.
+func (s *Service) InsertMarkdown(ctx context.Context, spaceId string, objectId string, request apimodel.InsertMarkdownRequest) ([]apimodel.Block, error) {
+	if request.Markdown == "" {
+		return nil, util.ErrBadInput("markdown must not be empty")
+	}
+	targetId, position, err := s.resolveTarget(ctx, spaceId, objectId, request.TargetId, request.Position)
+	if err != nil {
+		return nil, err
+	}
+
+	createResp := s.mw.BlockCreate(ctx, &pb.RpcBlockCreateRequest{
+		ContextId: objectId,
+		TargetId:  targetId,
+		Block: &model.Block{
+			Align:         model.Block_AlignLeft,
+			VerticalAlign: model.Block_VerticalAlignTop,
+			Content:       &model.BlockContentOfText{Text: &model.BlockContentText{}},
+		},
+		Position: position,
+	})
+	if createResp.Error != nil && createResp.Error.Code != pb.RpcBlockCreateResponseError_NULL {
+		return nil, ErrFailedCreateBlock
+	}
+
+	pasteResp := s.mw.BlockPaste(ctx, &pb.RpcBlockPasteRequest{
+		ContextId:      objectId,
+		FocusedBlockId: createResp.BlockId,
+		TextSlot:       request.Markdown,
+	})
+	if pasteResp.Error != nil && pasteResp.Error.Code != pb.RpcBlockPasteResponseError_NULL {
+		return nil, ErrFailedInsertBlocks
+	}
+
+	blocks, err := s.getObjectBlocks(ctx, spaceId, objectId)
+	if err != nil {
+		return nil, err
+	}
+	inserted := make([]apimodel.Block, 0, len(pasteResp.BlockIds))
+	for _, id := range pasteResp.BlockIds {
+		if block, err := findBlock(blocks, id); err == nil {
+			inserted = append(inserted, *block)
+		}
+	}
+	return inserted, nil
+}
+
+// UpdateBlock updates the text, style, colors and alignment of the block.
+func (s *Service) UpdateBlock(ctx context.Context, spaceId string, objectId string, blockId string, request apimodel.UpdateBlockRequest) (*apimodel.Block, error) {
+	blocks, err := s.getObjectBlocks(ctx, spaceId, objectId)
+	if err != nil {
+		return nil, err
+	}
+	block, err := findBlock(blocks, blockId)
+	if err != nil {
+		return nil, err
+	}
+
+	textChanged := request.Text != nil || request.Marks != nil || request.Style != nil || request.Checked != nil || request.Color != nil
+	if textChanged && block.Text == nil {
+		return nil, util.ErrBadInput("text, marks, style, checked and color can only be set for text blocks")
+	}
+
+	if request.Text != nil || request.Marks != nil {
+		text := block.Text.Text
+		if request.Text != nil {
+			text = *request.Text
+		}
+		var marks []apimodel.TextMark
+		if request.Marks != nil {
+			marks = *request.Marks
+		} else if request.Text == nil {
+			marks = block.Text.Marks
+		}
+		modelMarks, err := marksToModel(marks)
+		if err != nil {
+			return nil, err
+		}
+		resp := s.mw.BlockTextSetText(ctx, &pb.RpcBlockTextSetTextRequest{
+			ContextId: objectId,
+			BlockId:   blockId,
+			Text:      text,
+			Marks:     modelMarks,
+		})
+		if resp.Error != nil && resp.Error.Code != pb.RpcBlockTextSetTextResponseError_NULL {
+			return nil, ErrFailedUpdateBlock
+		}
+	}
+
+	if request.Style != nil {
+		resp := s.mw.BlockTextSetStyle(ctx, &pb.RpcBlockTextSetStyleRequest{
+			ContextId: objectId,
+			BlockId:   blockId,
+			Style:     textStyles[*request.Style],
+		})
+		if resp.Error != nil && resp.Error.Code != pb.RpcBlockTextSetStyleResponseError_NULL {
+			return nil, ErrFailedUpdateBlock
+		}
+	}
+
+	if request.Checked != nil {
+		resp := s.mw.BlockTextSetChecked(ctx, &pb.RpcBlockTextSetCheckedRequest{
+			ContextId: objectId,
+			BlockId:   blockId,
+			Checked:   *request.Checked,
+		})
+		if resp.Error != nil && resp.Error.Code != pb.RpcBlockTextSetCheckedResponseError_NULL {
+			return nil, ErrFailedUpdateBlock
+		}
+	}
+
+	if request.Color != nil {
+		resp := s.mw.BlockTextSetColor(ctx, &pb.RpcBlockTextSetColorRequest{
+			ContextId: objectId,
+			BlockId:   blockId,
+			Color:     *request.Color,
+		})
+		if resp.Error != nil && resp.Error.Code != pb.RpcBlockTextSetColorResponseError_NULL {
+			return nil, ErrFailedUpdateBlock
+		}
+	}
+
+	if request.BackgroundColor != nil {
+		resp := s.mw.BlockListSetBackgroundColor(ctx, &pb.RpcBlockListSetBackgroundColorRequest{
+			ContextId: objectId,
+			BlockIds:  []string{blockId},
+			Color:     *request.BackgroundColor,
+		})
+		if resp.Error != nil && resp.Error.Code != pb.RpcBlockListSetBackgroundColorResponseError_NULL {
+			return nil, ErrFailedUpdateBlock
+		}
+	}
+
+	if request.Align != nil {
+		resp := s.mw.BlockListSetAlign(ctx, &pb.RpcBlockListSetAlignRequest{
+			ContextId: objectId,
+			BlockIds:  []string{blockId},
+			Align:     blockAligns[*request.Align],
+		})
+		if resp.Error != nil && resp.Error.Code != pb.RpcBlockListSetAlignResponseError_NULL {
+			return nil, ErrFailedUpdateBlock
+		}
+	}
+
+	return s.GetBlock(ctx, spaceId, objectId, blockId)
+}
+
+// MoveBlock moves the block with its children relative to the target block, in the same or another object of the space.
+func (s *Service) MoveBlock(ctx context.Context, spaceId string, objectId string, blockId string, request apimodel.MoveBlockRequest) (*apimodel.Block, error) {
+	if request.TargetId == "" {
+		return nil, util.ErrBadInput("target_id must be set")
+	}
+	if _, err := s.GetBlock(ctx, spaceId, objectId, blockId); err != nil {
+		return nil, err
+	}
+	if request.TargetId == blockId {
+		return nil, util.ErrBadInput("block cannot be moved relative to itself")
+	}
+	targetObjectId := request.ObjectId
+	if targetObjectId == "" {
+		targetObjectId = objectId
+	}
+	targetId, position, err := s.resolveTarget(ctx, spaceId, targetObjectId, request.TargetId, request.Position)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := s.mw.BlockListMoveToExistingObject(ctx, &pb.RpcBlockListMoveToExistingObjectRequest{
+		ContextId:       objectId,
+		BlockIds:        []string{blockId},
+		TargetContextId: targetObjectId,
+		DropTargetId:    targetId,
+		Position:        position,
+	})
+	if resp.Error != nil && resp.Error.Code != pb.RpcBlockListMoveToExistingObjectResponseError_NULL {
+		return nil, ErrFailedMoveBlock
+	}
+
+	return s.GetBlock(ctx, spaceId, targetObjectId, blockId)
+}
+
+// DeleteBlock deletes the block with its children and returns the deleted block.
+func (s *Service) DeleteBlock(ctx context.Context, spaceId string, objectId string, blockId string) (*apimodel.Block, error) {
+	block, err := s.GetBlock(ctx, spaceId, objectId, blockId)
+	if err != nil {
+		return nil, err
+	}
+	resp := s.mw.BlockListDelete(ctx, &pb.RpcBlockListDeleteRequest{
+		ContextId: objectId,
+		BlockIds:  []string{blockId},
+	})
+	if resp.Error != nil && resp.Error.Code != pb.RpcBlockListDeleteResponseError_NULL {
+		return nil, ErrFailedDeleteBlock
+	}
+	return block, nil
+}
+
+// getObjectBlocks opens the object and flattens its block tree, skipping the root and the header.
+func (s *Service) getObjectBlocks(ctx context.Context, spaceId string, objectId string) ([]apimodel.Block, error) {
+	resp := s.mw.ObjectShow(ctx, &pb.RpcObjectShowRequest{
+		SpaceId:  spaceId,
+		ObjectId: objectId,
+	})
+
+	if resp.Error != nil {
+		if resp.Error.Code == pb.RpcObjectShowResponseError_NOT_FOUND {
+			return nil, ErrObjectNotFound
+		}
+
+		if resp.Error.Code == pb.RpcObjectShowResponseError_OBJECT_DELETED {
+			return nil, ErrObjectDeleted
+		}
+
+		if resp.Error.Code != pb.RpcObjectShowResponseError_NULL {
+			return nil, ErrFailedRetrieveObject
+		}
+	}
+
+	rootId := resp.ObjectView.RootId
+	if rootId == "" {
+		rootId = objectId
+	}
+	byId := make(map[string]*model.Block, len(resp.ObjectView.Blocks))
+	for _, block := range resp.ObjectView.Blocks {
+		byId[block.Id] = block
+	}
+	root, ok := byId[rootId]
+	if !ok {
+		return nil, ErrFailedRetrieveObject
+	}
+
+	blocks := make([]apimodel.Block, 0, len(resp.ObjectView.Blocks))
+	var walk func(parentId string, childrenIds []string)
+	walk = func(parentId string, childrenIds []string) {
+		for _, id := range childrenIds {
+			block, ok := byId[id]
+			if !ok || id == headerBlockId {
+				continue
+			}
+			blocks = append(blocks, blockFromModel(block, parentId))
+			walk(id, block.ChildrenIds)
+		}
+	}
+	walk(objectId, root.ChildrenIds)
+	return blocks, nil
+}
+
+// resolveTarget checks that the target block belongs to the object and returns the position to insert blocks at.
+// Blocks are appended to the end of the object if the target is empty
+func (s *Service) resolveTarget(ctx context.Context, spaceId string, objectId string, targetId string, position apimodel.BlockPosition) (string, model.BlockPosition, error) {
+	blocks, err := s.getObjectBlocks(ctx, spaceId, objectId)
+	if err != nil {
+		return "", model.Block_None, err
+	}
+	if targetId == "" {
+		return "", model.Block_Bottom, nil
+	}
+	if _, err = findBlock(blocks, targetId); err != nil {
+		return "", model.Block_None, err
+	}
+	if position == "" {
+		return targetId, model.Block_Bottom, nil
+	}
+	return targetId, blockPositions[position], nil
+}
+
+func (s *Service) createTable(ctx context.Context, objectId string, targetId string, position model.BlockPosition, table apimodel.CreateTableContent) (string, error) {
+	if table.Rows <= 0 || table.Columns <= 0 || table.Rows > maxTableSize || table.Columns > maxTableSize {
+		return "", util.ErrBadInput(fmt.Sprintf("table must have from 1 to %d rows and columns", maxTableSize))
+	}
+	resp := s.mw.BlockTableCreate(ctx, &pb.RpcBlockTableCreateRequest{
+		ContextId:     objectId,
+		TargetId:      targetId,
+		Position:      position,
+		Rows:          uint32(table.Rows),
+		Columns:       uint32(table.Columns),
+		WithHeaderRow: table.WithHeaderRow,
+	})
+	if resp.Error != nil && resp.Error.Code != pb.RpcBlockTableCreateResponseError_NULL {
+		return "", ErrFailedCreateTable
+	}
+	return resp.BlockId, nil
+}
+
+// fileContentToModel builds the content of a block pointing to an existing file object of the space.
+func (s *Service) fileContentToModel(ctx context.Context, spaceId string, fileObjectId string) (model.IsBlockContent, error) {
+	resp := s.mw.ObjectShow(ctx, &pb.RpcObjectShowRequest{
+		SpaceId:  spaceId,
+		ObjectId: fileObjectId,
+	})
+	if resp.Error != nil && resp.Error.Code != pb.RpcObjectShowResponseError_NULL {
+		if resp.Error.Code == pb.RpcObjectShowResponseError_NOT_FOUND || resp.Error.Code == pb.RpcObjectShowResponseError_OBJECT_DELETED {
+			return nil, util.ErrBadInput(fmt.Sprintf("file object %q not found", fileObjectId))
+		}
+		return nil, ErrFailedRetrieveObject
+	}
+	details := resp.ObjectView.Details[0].Details
+	layout := model.ObjectTypeLayout(details.Fields[bundle.RelationKeyResolvedLayout.String()].GetNumberValue())
+	fileType, ok := fileLayoutTypes[layout]
+	if !ok {
+		return nil, util.ErrBadInput(fmt.Sprintf("object %q is not a file", fileObjectId))
+	}
+	return &model.BlockContentOfFile{File: &model.BlockContentFile{
+		TargetObjectId: fileObjectId,
+		Name:           details.Fields[bundle.RelationKeyName.String()].GetStringValue(),
+		Type:           fileType,
+		State:          model.BlockContentFile_Done,
+	}}, nil
+}
+
+func textContentToModel(text apimodel.CreateTextContent) (model.IsBlockContent, error) {
+	marks, err := marksToModel(text.Marks)
+	if err != nil {
+		return nil, err
+	}
+	style := model.BlockContentText_Paragraph
+	if text.Style != "" {
+		style = textStyles[text.Style]
+	}
+	return &model.BlockContentOfText{Text: &model.BlockContentText{
+		Text:    text.Text,
+		Style:   style,
+		Checked: text.Checked,
+		Color:   text.Color,
+		Marks:   marks,
+	}}, nil
+}
+
+func marksToModel(marks []apimodel.TextMark) (*model.BlockContentTextMarks, error) {
+	result := &model.BlockContentTextMarks{}
+	for _, mark := range marks {
+		if mark.From < 0 || mark.To < mark.From {
+			return nil, util.ErrBadInput(fmt.Sprintf("invalid mark range: %d-%d", mark.From, mark.To))
+		}
+		result.Marks = append(result.Marks, &model.BlockContentTextMark{
+			Range: &model.Range{From: int32(mark.From), To: int32(mark.To)},
+			Type:  markTypes[mark.Type],
+			Param: mark.Param,
+		})
+	}
+	return result, nil
+}
+
+func findBlock(blocks []apimodel.Block, blockId string) (*apimodel.Block, error) {
+	idx := slices.IndexFunc(blocks, func(b apimodel.Block) bool {
+		return b.Id == blockId
+	})
+	if idx < 0 {
+		return nil, ErrBlockNotFound
+	}
+	return &blocks[idx], nil
+}
+
+// blockFromModel converts the block to the API representation.
+func blockFromModel(block *model.Block, parentId string) apimodel.Block {
+	result := apimodel.Block{
+		Object:          "block",
+		Id:              block.Id,
+		ParentId:        parentId,
+		ChildrenIds:     block.ChildrenIds,
+		Type:            apimodel.BlockTypeOther,
+		BackgroundColor: block.BackgroundColor,
+		Align:           apimodel.BlockAlignLeft,
+	}
+	if result.ChildrenIds == nil {
+		result.ChildrenIds = []string{}
+	}
+	for align, modelAlign := range blockAligns {
+		if modelAlign == block.Align {
+			result.Align = align
+		}
+	}
+
+	switch content := block.Content.(type) {
+	case *model.BlockContentOfText:
+		result.Type = apimodel.BlockTypeText
+		result.Text = &apimodel.TextContent{
+			Text:    content.Text.Text,
+			Style:   apimodel.TextStyleParagraph,
+			Checked: content.Text.Checked,
+			Color:   content.Text.Color,
+			Marks:   []apimodel.TextMark{},
+		}
+		for style, modelStyle := range textStyles {
+			if modelStyle == content.Text.Style {
+				result.Text.Style = style
+			}
+		}
+		for _, mark := range content.Text.GetMarks().GetMarks() {
+			textMark := apimodel.TextMark{
+				From:  int(mark.GetRange().GetFrom()),
+				To:    int(mark.GetRange().GetTo()),
+				Param: mark.Param,
+			}
+			for markType, modelType := range markTypes {
+				if modelType == mark.Type {
+					textMark.Type = markType
+				}
+			}
+			result.Text.Marks = append(result.Text.Marks, textMark)
+		}
+	case *model.BlockContentOfFile:
+		result.Type = apimodel.BlockTypeFile
+		result.File = &apimodel.FileContent{
+			ObjectId: content.File.TargetObjectId,
+			Name:     content.File.Name,
+			Type:     fileTypes[content.File.Type],
+			Mime:     content.File.Mime,
+			Size:     content.File.Size_,
+		}
+	case *model.BlockContentOfLink:
+		result.Type = apimodel.BlockTypeLink
+		result.Link = &apimodel.LinkContent{ObjectId: content.Link.TargetBlockId}
+	case *model.BlockContentOfBookmark:
+		result.Type = apimodel.BlockTypeBookmark
+		result.Bookmark = &apimodel.BookmarkContent{
+			Url:      content.Bookmark.Url,
+			Title:    content.Bookmark.Title,
+			ObjectId: content.Bookmark.TargetObjectId,
+		}
+	case *model.BlockContentOfTable:
+		result.Type = apimodel.BlockTypeTable
+	case *model.BlockContentOfTableRow:
+		result.Type = apimodel.BlockTypeTableRow
+		result.TableRow = &apimodel.TableRowContent{IsHeader: content.TableRow.IsHeader}
+	case *model.BlockContentOfTableColumn:
+		result.Type = apimodel.BlockTypeTableColumn
+	case *model.BlockContentOfLayout:
+		result.Type = apimodel.BlockTypeLayout
+	case *model.BlockContentOfDiv:
+		result.Type = apimodel.BlockTypeDivider
+	case *model.BlockContentOfRelation:
+		result.Type = apimodel.BlockTypeProperty
+		result.PropertyKey = content.Relation.Key
+	case *model.BlockContentOfDataview:
+		result.Type = apimodel.BlockTypeDataview
+	case *model.BlockContentOfLatex:
+		result.Type = apimodel.BlockTypeLatex
+	}
+	return result
+}
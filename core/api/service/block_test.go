@@ -0,0 +1,396 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	apimodel "github.com/anyproto/anytype-heart/core/api/model"
+	"github.com/anyproto/anytype-heart/core/api/util"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const (
+	mockedBlockId      = "block-1"
+	mockedChildBlockId = "block-2"
+	mockedNewBlockId   = "block-3"
+)
+
+func mockedObjectView(blocks ...*model.Block) *pb.RpcObjectShowResponse {
+	rootIds := []string{"header"}
+	for _, block := range blocks {
+		rootIds = append(rootIds, block.Id)
+	}
+	return &pb.RpcObjectShowResponse{
+		ObjectView: &model.ObjectView{
+			RootId: mockedObjectId,
+			Blocks: append([]*model.Block{
+				{Id: mockedObjectId, ChildrenIds: rootIds},
+				{Id: "header", ChildrenIds: []string{"title"}},
+				{Id: "title", Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: mockedObjectName, Style: model.BlockContentText_Title}}},
+			}, blocks...),
+		},
+	}
+}
+
+func mockedTextBlock(id string, text string, childrenIds ...string) *model.Block {
+	return &model.Block{
+		Id:          id,
+		ChildrenIds: childrenIds,
+		Content:     &model.BlockContentOfText{Text: &model.BlockContentText{Text: text}},
+	}
+}
+
+func TestBlockService_ListBlocks(t *testing.T) {
+	t.Run("blocks are listed in document order without header", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		fx.populateCache(mockedSpaceId)
+
+		parent := mockedTextBlock(mockedBlockId, "Parent", mockedChildBlockId)
+		parent.Content.(*model.BlockContentOfText).Text.Style = model.BlockContentText_Toggle
+		child := mockedTextBlock(mockedChildBlockId, "Child")
+		child.Content.(*model.BlockContentOfText).Text.Marks = &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{
+			{Range: &model.Range{From: 0, To: 5}, Type: model.BlockContentTextMark_Bold},
+		}}
+		link := &model.Block{Id: mockedNewBlockId, Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{TargetBlockId: mockedNewObjectId}}}
+
+		fx.mwMock.On("ObjectShow", mock.Anything, &pb.RpcObjectShowRequest{
+			SpaceId:  mockedSpaceId,
+			ObjectId: mockedObjectId,
+		}).Return(mockedObjectView(parent, link, child), nil).Once()
+
+		// when
+		blocks, total, hasMore, err := fx.service.ListBlocks(ctx, mockedSpaceId, mockedObjectId, 0, 10)
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, 3, total)
+		require.False(t, hasMore)
+		require.Len(t, blocks, 3)
+
+		require.Equal(t, mockedBlockId, blocks[0].Id)
+		require.Equal(t, mockedObjectId, blocks[0].ParentId)
+		require.Equal(t, []string{mockedChildBlockId}, blocks[0].ChildrenIds)
+		require.Equal(t, apimodel.TextStyleToggle, blocks[0].Text.Style)
+
+		require.Equal(t, mockedChildBlockId, blocks[1].Id)
+		require.Equal(t, mockedBlockId, blocks[1].ParentId)
+		require.Equal(t, []apimodel.TextMark{{From: 0, To: 5, Type: apimodel.MarkTypeBold}}, blocks[1].Text.Marks)
+
+		require.Equal(t, apimodel.BlockTypeLink, blocks[2].Type)
+		require.Equal(t, mockedNewObjectId, blocks[2].Link.ObjectId)
+	})
+
+	t.Run("object not found", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		fx.populateCache(mockedSpaceId)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).
+			Return(&pb.RpcObjectShowResponse{
+				Error: &pb.RpcObjectShowResponseError{Code: pb.RpcObjectShowResponseError_NOT_FOUND},
+			}, nil).Once()
+
+		// when
+		blocks, _, _, err := fx.service.ListBlocks(ctx, mockedSpaceId, "missing-obj", 0, 10)
+
+		// then
+		require.ErrorIs(t, err, ErrObjectNotFound)
+		require.Empty(t, blocks)
+	})
+}
+
+func TestBlockService_CreateBlock(t *testing.T) {
+	t.Run("text block is appended to the end of the object", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		fx.populateCache(mockedSpaceId)
+
+		existing := mockedTextBlock(mockedBlockId, "Existing")
+		created := mockedTextBlock(mockedNewBlockId, "Log line")
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(mockedObjectView(existing), nil).Once()
+		fx.mwMock.On("BlockCreate", mock.Anything, &pb.RpcBlockCreateRequest{
+			ContextId: mockedObjectId,
+			Block: &model.Block{
+				Align:         model.Block_AlignLeft,
+				VerticalAlign: model.Block_VerticalAlignTop,
+				Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+					Text:  "Log line",
+					Style: model.BlockContentText_Checkbox,
+					Marks: &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{
+						{Range: &model.Range{From: 0, To: 3}, Type: model.BlockContentTextMark_Italic},
+					}},
+				}},
+			},
+			Position: model.Block_Bottom,
+		}).Return(&pb.RpcBlockCreateResponse{
+			Error:   &pb.RpcBlockCreateResponseError{Code: pb.RpcBlockCreateResponseError_NULL},
+			BlockId: mockedNewBlockId,
+		}).Once()
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(mockedObjectView(existing, created), nil).Once()
+
+		// when
+		block, err := fx.service.CreateBlock(ctx, mockedSpaceId, mockedObjectId, apimodel.CreateBlockRequest{
+			Text: &apimodel.CreateTextContent{
+				Text:  "Log line",
+				Style: apimodel.TextStyleCheckbox,
+				Marks: []apimodel.TextMark{{From: 0, To: 3, Type: apimodel.MarkTypeItalic}},
+			},
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, mockedNewBlockId, block.Id)
+		require.Equal(t, "Log line", block.Text.Text)
+	})
+
+	t.Run("table is created after the target block", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		fx.populateCache(mockedSpaceId)
+
+		existing := mockedTextBlock(mockedBlockId, "Existing")
+		table := &model.Block{Id: mockedNewBlockId, Content: &model.BlockContentOfTable{Table: &model.BlockContentTable{}}}
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(mockedObjectView(existing), nil).Once()
+		fx.mwMock.On("BlockTableCreate", mock.Anything, &pb.RpcBlockTableCreateRequest{
+			ContextId:     mockedObjectId,
+			TargetId:      mockedBlockId,
+			Position:      model.Block_Bottom,
+			Rows:          2,
+			Columns:       3,
+			WithHeaderRow: true,
+		}).Return(&pb.RpcBlockTableCreateResponse{
+			Error:   &pb.RpcBlockTableCreateResponseError{Code: pb.RpcBlockTableCreateResponseError_NULL},
+			BlockId: mockedNewBlockId,
+		}).Once()
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(mockedObjectView(existing, table), nil).Once()
+
+		// when
+		block, err := fx.service.CreateBlock(ctx, mockedSpaceId, mockedObjectId, apimodel.CreateBlockRequest{
+			TargetId: mockedBlockId,
+			Table:    &apimodel.CreateTableContent{Rows: 2, Columns: 3, WithHeaderRow: true},
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, apimodel.BlockTypeTable, block.Type)
+	})
+
+	t.Run("content is required", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		fx.populateCache(mockedSpaceId)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(mockedObjectView(), nil).Once()
+
+		// when
+		block, err := fx.service.CreateBlock(ctx, mockedSpaceId, mockedObjectId, apimodel.CreateBlockRequest{})
+
+		// then
+		require.ErrorIs(t, err, util.ErrBad)
+		require.Nil(t, block)
+	})
+
+	t.Run("target block must belong to the object", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		fx.populateCache(mockedSpaceId)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(mockedObjectView(), nil).Once()
+
+		// when
+		block, err := fx.service.CreateBlock(ctx, mockedSpaceId, mockedObjectId, apimodel.CreateBlockRequest{
+			TargetId: "header",
+			Text:     &apimodel.CreateTextContent{Text: "text"},
+		})
+
+		// then
+		require.ErrorIs(t, err, ErrBlockNotFound)
+		require.Nil(t, block)
+	})
+}
+
+func TestBlockService_InsertMarkdown(t *testing.T) {
+	t.Run("markdown is pasted into a new block", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		fx.populateCache(mockedSpaceId)
+
+		pasted := mockedTextBlock(mockedChildBlockId, "Check the logs")
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(mockedObjectView(), nil).Once()
+		fx.mwMock.On("BlockCreate", mock.Anything, mock.Anything).Return(&pb.RpcBlockCreateResponse{
+			Error:   &pb.RpcBlockCreateResponseError{Code: pb.RpcBlockCreateResponseError_NULL},
+			BlockId: mockedNewBlockId,
+		}).Once()
+		fx.mwMock.On("BlockPaste", mock.Anything, &pb.RpcBlockPasteRequest{
+			ContextId:      mockedObjectId,
+			FocusedBlockId: mockedNewBlockId,
+			TextSlot:       "- [ ] Check the logs",
+		}).Return(&pb.RpcBlockPasteResponse{
+			Error:    &pb.RpcBlockPasteResponseError{Code: pb.RpcBlockPasteResponseError_NULL},
+			BlockIds: []string{mockedChildBlockId},
+		}).Once()
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(mockedObjectView(pasted), nil).Once()
+
+		// when
+		blocks, err := fx.service.InsertMarkdown(ctx, mockedSpaceId, mockedObjectId, apimodel.InsertMarkdownRequest{
+			Markdown: "- [ ] Check the logs",
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, blocks, 1)
+		require.Equal(t, mockedChildBlockId, blocks[0].Id)
+	})
+}
+
+func TestBlockService_UpdateBlock(t *testing.T) {
+	t.Run("text and checkbox are updated", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		fx.populateCache(mockedSpaceId)
+
+		block := mockedTextBlock(mockedBlockId, "Old")
+		updated := mockedTextBlock(mockedBlockId, "New")
+		updated.Content.(*model.BlockContentOfText).Text.Checked = true
+		text := "New"
+		checked := true
+
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(mockedObjectView(block), nil).Once()
+		fx.mwMock.On("BlockTextSetText", mock.Anything, &pb.RpcBlockTextSetTextRequest{
+			ContextId: mockedObjectId,
+			BlockId:   mockedBlockId,
+			Text:      "New",
+			Marks:     &model.BlockContentTextMarks{},
+		}).Return(&pb.RpcBlockTextSetTextResponse{
+			Error: &pb.RpcBlockTextSetTextResponseError{Code: pb.RpcBlockTextSetTextResponseError_NULL},
+		}).Once()
+		fx.mwMock.On("BlockTextSetChecked", mock.Anything, &pb.RpcBlockTextSetCheckedRequest{
+			ContextId: mockedObjectId,
+			BlockId:   mockedBlockId,
+			Checked:   true,
+		}).Return(&pb.RpcBlockTextSetCheckedResponse{
+			Error: &pb.RpcBlockTextSetCheckedResponseError{Code: pb.RpcBlockTextSetCheckedResponseError_NULL},
+		}).Once()
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(mockedObjectView(updated), nil).Once()
+
+		// when
+		result, err := fx.service.UpdateBlock(ctx, mockedSpaceId, mockedObjectId, mockedBlockId, apimodel.UpdateBlockRequest{
+			Text:    &text,
+			Checked: &checked,
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, "New", result.Text.Text)
+		require.True(t, result.Text.Checked)
+	})
+
+	t.Run("text fields can't be set for other blocks", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		fx.populateCache(mockedSpaceId)
+
+		divider := &model.Block{Id: mockedBlockId, Content: &model.BlockContentOfDiv{Div: &model.BlockContentDiv{}}}
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(mockedObjectView(divider), nil).Once()
+		text := "text"
+
+		// when
+		result, err := fx.service.UpdateBlock(ctx, mockedSpaceId, mockedObjectId, mockedBlockId, apimodel.UpdateBlockRequest{Text: &text})
+
+		// then
+		require.ErrorIs(t, err, util.ErrBad)
+		require.Nil(t, result)
+	})
+}
+
+func TestBlockService_MoveBlock(t *testing.T) {
+	t.Run("block is moved to another object", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		fx.populateCache(mockedSpaceId)
+
+		block := mockedTextBlock(mockedBlockId, "Moved")
+		target := mockedTextBlock(mockedChildBlockId, "Target")
+		fx.mwMock.On("ObjectShow", mock.Anything, &pb.RpcObjectShowRequest{SpaceId: mockedSpaceId, ObjectId: mockedObjectId}).
+			Return(mockedObjectView(block), nil).Once()
+		fx.mwMock.On("ObjectShow", mock.Anything, &pb.RpcObjectShowRequest{SpaceId: mockedSpaceId, ObjectId: mockedNewObjectId}).
+			Return(mockedObjectView(target), nil).Once()
+		fx.mwMock.On("BlockListMoveToExistingObject", mock.Anything, &pb.RpcBlockListMoveToExistingObjectRequest{
+			ContextId:       mockedObjectId,
+			BlockIds:        []string{mockedBlockId},
+			TargetContextId: mockedNewObjectId,
+			DropTargetId:    mockedChildBlockId,
+			Position:        model.Block_Top,
+		}).Return(&pb.RpcBlockListMoveToExistingObjectResponse{
+			Error: &pb.RpcBlockListMoveToExistingObjectResponseError{Code: pb.RpcBlockListMoveToExistingObjectResponseError_NULL},
+		}).Once()
+		fx.mwMock.On("ObjectShow", mock.Anything, &pb.RpcObjectShowRequest{SpaceId: mockedSpaceId, ObjectId: mockedNewObjectId}).
+			Return(mockedObjectView(block, target), nil).Once()
+
+		// when
+		result, err := fx.service.MoveBlock(ctx, mockedSpaceId, mockedObjectId, mockedBlockId, apimodel.MoveBlockRequest{
+			TargetId: mockedChildBlockId,
+			Position: apimodel.BlockPositionTop,
+			ObjectId: mockedNewObjectId,
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, mockedBlockId, result.Id)
+	})
+}
+
+func TestBlockService_DeleteBlock(t *testing.T) {
+	t.Run("block is deleted", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		fx.populateCache(mockedSpaceId)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(mockedObjectView(mockedTextBlock(mockedBlockId, "Text")), nil).Once()
+		fx.mwMock.On("BlockListDelete", mock.Anything, &pb.RpcBlockListDeleteRequest{
+			ContextId: mockedObjectId,
+			BlockIds:  []string{mockedBlockId},
+		}).Return(&pb.RpcBlockListDeleteResponse{
+			Error: &pb.RpcBlockListDeleteResponseError{Code: pb.RpcBlockListDeleteResponseError_NULL},
+		}).Once()
+
+		// when
+		block, err := fx.service.DeleteBlock(ctx, mockedSpaceId, mockedObjectId, mockedBlockId)
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, mockedBlockId, block.Id)
+	})
+
+	t.Run("block not found", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		fx.populateCache(mockedSpaceId)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(mockedObjectView(), nil).Once()
+
+		// when
+		block, err := fx.service.DeleteBlock(ctx, mockedSpaceId, mockedObjectId, "missing")
+
+		// then
+		require.ErrorIs(t, err, ErrBlockNotFound)
+		require.Nil(t, block)
+	})
+}
@@ -63,6 +63,7 @@ func (s *Service) subscribeToCrossSpaceProperties() error {
 			bundle.RelationKeyApiObjectKey.String(),
 			bundle.RelationKeyName.String(),
 			bundle.RelationKeyRelationFormat.String(),
+			bundle.RelationKeyRelationFormatIncludeTime.String(),
 			bundle.RelationKeySpaceId.String(),
 		},
 		NoDepSubscription: true,
@@ -350,6 +351,7 @@ func (s *Service) Stop() {
 
 	// Close all subscriptions
 	s.subscriptions.close()
+	s.closeChangeFeeds()
 
 	// Unsubscribe from cross-space subscriptions
 	if s.subscriptions.properties.subId != "" {
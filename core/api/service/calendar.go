@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+var (
+	ErrCalendarFeedNotFound  = errors.New("calendar feed not found")
+	ErrFailedGetCalendarFeed = errors.New("failed to retrieve calendar feed")
+)
+
+// GetCalendarFeed returns the iCalendar content of the feed with the given token.
+func (s *Service) GetCalendarFeed(ctx context.Context, token string) (string, error) {
+	resp := s.mw.CalendarFeedGet(ctx, &pb.RpcCalendarFeedGetRequest{Token: token})
+
+	if resp.Error != nil && resp.Error.Code == pb.RpcCalendarFeedGetResponseError_NOT_FOUND {
+		return "", ErrCalendarFeedNotFound
+	}
+
+	if resp.Error != nil && resp.Error.Code != pb.RpcCalendarFeedGetResponseError_NULL {
+		return "", ErrFailedGetCalendarFeed
+	}
+
+	return resp.Data, nil
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+func TestCalendarService_GetCalendarFeed(t *testing.T) {
+	t.Run("successful retrieval of feed", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+
+		fx.mwMock.On("CalendarFeedGet", mock.Anything, &pb.RpcCalendarFeedGetRequest{Token: "token"}).
+			Return(&pb.RpcCalendarFeedGetResponse{
+				Data:  "BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n",
+				Error: &pb.RpcCalendarFeedGetResponseError{Code: pb.RpcCalendarFeedGetResponseError_NULL},
+			}).Once()
+
+		// when
+		data, err := fx.service.GetCalendarFeed(context.Background(), "token")
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, "BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n", data)
+	})
+
+	t.Run("feed not found", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+
+		fx.mwMock.On("CalendarFeedGet", mock.Anything, &pb.RpcCalendarFeedGetRequest{Token: "missing"}).
+			Return(&pb.RpcCalendarFeedGetResponse{
+				Error: &pb.RpcCalendarFeedGetResponseError{Code: pb.RpcCalendarFeedGetResponseError_NOT_FOUND},
+			}).Once()
+
+		// when
+		data, err := fx.service.GetCalendarFeed(context.Background(), "missing")
+
+		// then
+		require.ErrorIs(t, err, ErrCalendarFeedNotFound)
+		require.Empty(t, data)
+	})
+
+	t.Run("failed to build feed", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+
+		fx.mwMock.On("CalendarFeedGet", mock.Anything, &pb.RpcCalendarFeedGetRequest{Token: "token"}).
+			Return(&pb.RpcCalendarFeedGetResponse{
+				Error: &pb.RpcCalendarFeedGetResponseError{Code: pb.RpcCalendarFeedGetResponseError_UNKNOWN_ERROR},
+			}).Once()
+
+		// when
+		_, err := fx.service.GetCalendarFeed(context.Background(), "token")
+
+		// then
+		require.ErrorIs(t, err, ErrFailedGetCalendarFeed)
+	})
+}
@@ -0,0 +1,441 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cheggaaa/mb/v3"
+	"github.com/gogo/protobuf/types"
+
+	apimodel "github.com/anyproto/anytype-heart/core/api/model"
+	"github.com/anyproto/anytype-heart/core/subscription"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const (
+	// changeFeedBufferSize is the number of events kept per feed for resuming after a reconnect
+	changeFeedBufferSize = 1000
+	// changeFeedRetention is how long a feed without readers is kept alive for resuming
+	changeFeedRetention = 5 * time.Minute
+	// maxChangeFeeds is the number of open feeds; the oldest feed is closed when a new one is opened over the limit
+	maxChangeFeeds = 16
+)
+
+var (
+	ErrFailedSubscribeChanges = errors.New("failed to subscribe to changes")
+)
+
+// changeFeedKeys are the details kept for every object of a feed, in addition to the properties of the space
+var changeFeedKeys = []string{
+	bundle.RelationKeyId.String(),
+	bundle.RelationKeyName.String(),
+	bundle.RelationKeyIconEmoji.String(),
+	bundle.RelationKeyIconImage.String(),
+	bundle.RelationKeyIconName.String(),
+	bundle.RelationKeyIconOption.String(),
+	bundle.RelationKeyIsArchived.String(),
+	bundle.RelationKeySpaceId.String(),
+	bundle.RelationKeySnippet.String(),
+	bundle.RelationKeyResolvedLayout.String(),
+	bundle.RelationKeyType.String(),
+}
+
+// changeFeeds keeps the open feeds, so that clients can resume them after a reconnect
+type changeFeeds struct {
+	mu    sync.Mutex
+	feeds map[string]*changeFeed
+}
+
+func newChangeFeeds() *changeFeeds {
+	return &changeFeeds{feeds: make(map[string]*changeFeed)}
+}
+
+// changeFeed turns the events of an internal subscription into a buffer of change events
+type changeFeed struct {
+	id        string
+	spaceId   string
+	filterKey string
+	subId     string
+	keys      map[string]struct{}
+	queue     *mb.MB[*pb.EventMessage]
+	toObject  func(details *types.Struct) apimodel.Object
+	ctx       context.Context
+	cancel    context.CancelFunc
+	done      chan struct{}
+
+	// readers, expire and releasedAt are guarded by changeFeeds.mu
+	readers    int
+	expire     *time.Timer
+	openedAt   time.Time
+	releasedAt time.Time
+
+	mu      sync.Mutex
+	objects map[string]*types.Struct
+	events  []apimodel.ChangeEvent
+	seq     int
+	notify  chan struct{}
+}
+
+// StreamChanges streams add, change and remove events for the objects of a space that match the filters. A cursor of
+// an earlier event resumes its feed from that event; when the feed is gone, a new one is opened and starts with a
+// reset event. The returned channel is closed when the context is done or the service stops.
+func (s *Service) StreamChanges(ctx context.Context, spaceId string, additionalFilters []*model.BlockContentDataviewFilter, cursor string) (<-chan apimodel.ChangeEvent, error) {
+	filterKey := changeFilterKey(additionalFilters)
+
+	var start apimodel.ChangeEvent
+	feed, seq, ok := s.resumeChangeFeed(spaceId, filterKey, cursor)
+	if !ok {
+		var err error
+		feed, err = s.openChangeFeed(spaceId, filterKey, additionalFilters)
+		if err != nil {
+			return nil, err
+		}
+		start = apimodel.ChangeEvent{Cursor: feed.cursor(0), Type: apimodel.ChangeEventReady}
+		if cursor != "" {
+			start.Type = apimodel.ChangeEventReset
+		}
+	}
+
+	events := make(chan apimodel.ChangeEvent)
+	go func() {
+		defer close(events)
+		defer s.releaseChangeFeed(feed)
+		send := func(event apimodel.ChangeEvent) bool {
+			select {
+			case events <- event:
+				return true
+			case <-ctx.Done():
+				return false
+			case <-feed.done:
+				return false
+			}
+		}
+
+		if start.Type != "" && !send(start) {
+			return
+		}
+		for {
+			buffered, last, ok, notify := feed.eventsAfter(seq)
+			if !ok {
+				// The reader fell behind the buffer
+				buffered = []apimodel.ChangeEvent{{Cursor: feed.cursor(last), Type: apimodel.ChangeEventReset}}
+			}
+			for _, event := range buffered {
+				if !send(event) {
+					return
+				}
+			}
+			seq = last
+
+			select {
+			case <-notify:
+			case <-ctx.Done():
+				return
+			case <-feed.done:
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// resumeChangeFeed attaches a reader to the feed of the cursor and returns the sequence number to continue after
+func (s *Service) resumeChangeFeed(spaceId string, filterKey string, cursor string) (*changeFeed, int, bool) {
+	feedId, seqStr, found := strings.Cut(cursor, "-")
+	if !found {
+		return nil, 0, false
+	}
+	seq, err := strconv.Atoi(seqStr)
+	if err != nil || seq < 0 {
+		return nil, 0, false
+	}
+
+	s.changeFeeds.mu.Lock()
+	defer s.changeFeeds.mu.Unlock()
+	feed, ok := s.changeFeeds.feeds[feedId]
+	if !ok || feed.spaceId != spaceId || feed.filterKey != filterKey {
+		return nil, 0, false
+	}
+	if _, _, ok, _ := feed.eventsAfter(seq); !ok {
+		return nil, 0, false
+	}
+	feed.readers++
+	if feed.expire != nil {
+		feed.expire.Stop()
+		feed.expire = nil
+	}
+	return feed, seq, true
+}
+
+// openChangeFeed subscribes to the objects of a space that match the filters and registers a new feed with one reader
+func (s *Service) openChangeFeed(spaceId string, filterKey string, additionalFilters []*model.BlockContentDataviewFilter) (*changeFeed, error) {
+	filters := s.combineFilters(model.BlockContentDataviewFilter_And, s.prepareBaseFilters(), s.prepareTemplateFilter(), additionalFilters)
+
+	keys := changeFeedSubscriptionKeys(s.cache.getProperties(spaceId))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	feed := &changeFeed{
+		id:        rand.Text(),
+		spaceId:   spaceId,
+		filterKey: filterKey,
+		keys:      make(map[string]struct{}, len(keys)),
+		queue:     mb.New[*pb.EventMessage](0),
+		toObject:  s.getObjectFromStruct,
+		ctx:       ctx,
+		cancel:    cancel,
+		done:      make(chan struct{}),
+		readers:   1,
+		openedAt:  time.Now(),
+		objects:   make(map[string]*types.Struct),
+		notify:    make(chan struct{}),
+	}
+	for _, key := range keys {
+		feed.keys[key] = struct{}{}
+	}
+
+	resp, err := s.subService.Search(subscription.SubscribeRequest{
+		SpaceId:           spaceId,
+		SubId:             "api.changes." + feed.id,
+		Filters:           database.FiltersFromProto(filters),
+		Keys:              keys,
+		NoDepSubscription: true,
+		Internal:          true,
+		InternalQueue:     feed.queue,
+	})
+	if err != nil {
+		cancel()
+		feed.queue.Close()
+		log.Errorf("failed to subscribe to changes in space %s: %v", spaceId, err)
+		return nil, ErrFailedSubscribeChanges
+	}
+	feed.subId = resp.SubId
+	for _, record := range resp.Records {
+		feed.objects[record.GetString(bundle.RelationKeyId)] = record.ToProto()
+	}
+
+	s.changeFeeds.mu.Lock()
+	evicted := s.changeFeeds.evictOldest()
+	s.changeFeeds.feeds[feed.id] = feed
+	s.changeFeeds.mu.Unlock()
+	if evicted != nil {
+		s.closeChangeFeed(evicted)
+	}
+
+	go feed.run()
+	return feed, nil
+}
+
+// changeFeedSubscriptionKeys returns the keys the API serializes: the object fields and the properties of the space
+func changeFeedSubscriptionKeys(properties map[string]*apimodel.Property) []string {
+	keys := slices.Clone(changeFeedKeys)
+	for _, prop := range properties {
+		if excludedSystemProperties[prop.RelationKey] || slices.Contains(keys, prop.RelationKey) {
+			continue
+		}
+		keys = append(keys, prop.RelationKey)
+	}
+	return keys
+}
+
+// evictOldest removes the oldest feed when the limit of feeds is reached: the feed that has had no readers for the
+// longest time, or the oldest opened one if all feeds have readers; must be called with mu held
+func (c *changeFeeds) evictOldest() *changeFeed {
+	if len(c.feeds) < maxChangeFeeds {
+		return nil
+	}
+	var oldest *changeFeed
+	for _, feed := range c.feeds {
+		if oldest == nil || changeFeedOlder(feed, oldest) {
+			oldest = feed
+		}
+	}
+	if oldest.expire != nil {
+		oldest.expire.Stop()
+	}
+	delete(c.feeds, oldest.id)
+	return oldest
+}
+
+func changeFeedOlder(a, b *changeFeed) bool {
+	if (a.readers == 0) != (b.readers == 0) {
+		return a.readers == 0
+	}
+	if a.readers == 0 {
+		return a.releasedAt.Before(b.releasedAt)
+	}
+	return a.openedAt.Before(b.openedAt)
+}
+
+// releaseChangeFeed detaches a reader and closes the feed once it has had no readers for the retention period
+func (s *Service) releaseChangeFeed(feed *changeFeed) {
+	s.changeFeeds.mu.Lock()
+	defer s.changeFeeds.mu.Unlock()
+	feed.readers--
+	if feed.readers > 0 {
+		return
+	}
+	feed.releasedAt = time.Now()
+	feed.expire = time.AfterFunc(changeFeedRetention, func() {
+		s.changeFeeds.mu.Lock()
+		if feed.readers > 0 || s.changeFeeds.feeds[feed.id] != feed {
+			s.changeFeeds.mu.Unlock()
+			return
+		}
+		delete(s.changeFeeds.feeds, feed.id)
+		s.changeFeeds.mu.Unlock()
+		s.closeChangeFeed(feed)
+	})
+}
+
+func (s *Service) closeChangeFeed(feed *changeFeed) {
+	feed.cancel()
+	<-feed.done
+	if err := s.subService.Unsubscribe(feed.subId); err != nil {
+		log.Errorf("failed to unsubscribe from changes %s: %v", feed.subId, err)
+	}
+	if err := feed.queue.Close(); err != nil {
+		log.Errorf("failed to close change queue %s: %v", feed.subId, err)
+	}
+}
+
+// closeChangeFeeds closes all feeds and disconnects their readers
+func (s *Service) closeChangeFeeds() {
+	s.changeFeeds.mu.Lock()
+	feeds := make([]*changeFeed, 0, len(s.changeFeeds.feeds))
+	for id, feed := range s.changeFeeds.feeds {
+		if feed.expire != nil {
+			feed.expire.Stop()
+		}
+		feeds = append(feeds, feed)
+		delete(s.changeFeeds.feeds, id)
+	}
+	s.changeFeeds.mu.Unlock()
+
+	for _, feed := range feeds {
+		s.closeChangeFeed(feed)
+	}
+}
+
+func (f *changeFeed) cursor(seq int) string {
+	return f.id + "-" + strconv.Itoa(seq)
+}
+
+// eventsAfter returns the buffered events after seq along with the sequence number of the last event, or false when
+// seq is no longer covered by the buffer. The returned channel is closed when the next event is published.
+func (f *changeFeed) eventsAfter(seq int) (events []apimodel.ChangeEvent, last int, ok bool, notify <-chan struct{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	first := f.seq - len(f.events) + 1
+	if seq > f.seq || seq+1 < first {
+		return nil, f.seq, false, f.notify
+	}
+	return slices.Clone(f.events[seq+1-first:]), f.seq, true, f.notify
+}
+
+func (f *changeFeed) run() {
+	defer close(f.done)
+	for {
+		msg, err := f.queue.WaitOne(f.ctx)
+		if err != nil {
+			return
+		}
+		f.handle(msg)
+	}
+}
+
+func (f *changeFeed) handle(msg *pb.EventMessage) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch v := msg.Value.(type) {
+	case *pb.EventMessageValueOfObjectDetailsSet:
+		id := v.ObjectDetailsSet.Id
+		details := pbtypes.CopyStruct(v.ObjectDetailsSet.Details, false)
+		if details == nil || details.Fields == nil {
+			details = &types.Struct{Fields: make(map[string]*types.Value)}
+		}
+		eventType := apimodel.ChangeEventChange
+		if _, ok := f.objects[id]; !ok {
+			eventType = apimodel.ChangeEventAdd
+		}
+		f.objects[id] = details
+		f.publish(eventType, id, details)
+	case *pb.EventMessageValueOfObjectDetailsAmend:
+		id := v.ObjectDetailsAmend.Id
+		details, ok := f.objects[id]
+		if !ok {
+			return
+		}
+		changed := false
+		for _, detail := range v.ObjectDetailsAmend.Details {
+			if _, ok := f.keys[detail.Key]; ok {
+				details.Fields[detail.Key] = detail.Value
+				changed = true
+			}
+		}
+		if changed {
+			f.publish(apimodel.ChangeEventChange, id, details)
+		}
+	case *pb.EventMessageValueOfObjectDetailsUnset:
+		id := v.ObjectDetailsUnset.Id
+		details, ok := f.objects[id]
+		if !ok {
+			return
+		}
+		for _, key := range v.ObjectDetailsUnset.Keys {
+			delete(details.Fields, key)
+		}
+		f.publish(apimodel.ChangeEventChange, id, details)
+	case *pb.EventMessageValueOfSubscriptionRemove:
+		id := v.SubscriptionRemove.Id
+		if _, ok := f.objects[id]; !ok {
+			return
+		}
+		delete(f.objects, id)
+		f.publish(apimodel.ChangeEventRemove, id, nil)
+	}
+}
+
+// publish appends an event to the buffer and wakes up the readers; must be called with f.mu held
+func (f *changeFeed) publish(eventType apimodel.ChangeEventType, objectId string, details *types.Struct) {
+	f.seq++
+	event := apimodel.ChangeEvent{
+		Cursor:   f.cursor(f.seq),
+		Type:     eventType,
+		ObjectId: objectId,
+	}
+	if details != nil {
+		object := f.toObject(details)
+		event.Object = &object
+	}
+	f.events = append(f.events, event)
+	if len(f.events) > changeFeedBufferSize {
+		f.events = slices.Delete(f.events, 0, len(f.events)-changeFeedBufferSize)
+	}
+	close(f.notify)
+	f.notify = make(chan struct{})
+}
+
+// changeFilterKey identifies a set of filters, so that a feed is only resumed with the filters it was opened with
+func changeFilterKey(filters []*model.BlockContentDataviewFilter) string {
+	parts := make([]string, 0, len(filters))
+	for _, filter := range filters {
+		data, err := filter.Marshal()
+		if err != nil {
+			continue
+		}
+		parts = append(parts, string(data))
+	}
+	slices.Sort(parts)
+	return strings.Join(parts, "\x00")
+}
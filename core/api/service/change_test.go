@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cheggaaa/mb/v3"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	apimodel "github.com/anyproto/anytype-heart/core/api/model"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/subscription"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestChangeService_StreamChanges(t *testing.T) {
+	t.Run("streams add, change and remove events", func(t *testing.T) {
+		// given
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		fx := newFixture(t)
+		fx.populateCache(mockedSpaceId)
+		queue := fx.expectChangeSubscription(t, mockedObjectId)
+
+		// when
+		events, err := fx.service.StreamChanges(ctx, mockedSpaceId, nil, "")
+		require.NoError(t, err)
+		ready := receiveChangeEvent(t, events)
+		addChangeMessages(t, *queue,
+			objectDetailsSetMessage(mockedNewObjectId, mockedObjectName),
+			&pb.EventMessage{Value: &pb.EventMessageValueOfObjectDetailsAmend{ObjectDetailsAmend: &pb.EventObjectDetailsAmend{
+				Id:      mockedObjectId,
+				Details: []*pb.EventObjectDetailsAmendKeyValue{{Key: bundle.RelationKeyName.String(), Value: pbtypes.String("Renamed")}},
+			}}},
+			&pb.EventMessage{Value: &pb.EventMessageValueOfSubscriptionRemove{SubscriptionRemove: &pb.EventObjectSubscriptionRemove{Id: mockedObjectId}}},
+		)
+
+		// then
+		require.Equal(t, apimodel.ChangeEventReady, ready.Type)
+
+		added := receiveChangeEvent(t, events)
+		require.Equal(t, apimodel.ChangeEventAdd, added.Type)
+		require.Equal(t, mockedNewObjectId, added.ObjectId)
+		require.Equal(t, mockedObjectName, added.Object.Name)
+
+		changed := receiveChangeEvent(t, events)
+		require.Equal(t, apimodel.ChangeEventChange, changed.Type)
+		require.Equal(t, mockedObjectId, changed.ObjectId)
+		require.Equal(t, "Renamed", changed.Object.Name)
+
+		removed := receiveChangeEvent(t, events)
+		require.Equal(t, apimodel.ChangeEventRemove, removed.Type)
+		require.Equal(t, mockedObjectId, removed.ObjectId)
+		require.Nil(t, removed.Object)
+	})
+
+	t.Run("resumes after the cursor of a received event", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.populateCache(mockedSpaceId)
+		queue := fx.expectChangeSubscription(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		events, err := fx.service.StreamChanges(ctx, mockedSpaceId, nil, "")
+		require.NoError(t, err)
+		receiveChangeEvent(t, events)
+		addChangeMessages(t, *queue, objectDetailsSetMessage("first", "First"))
+		first := receiveChangeEvent(t, events)
+		cancel()
+		for range events {
+		}
+		addChangeMessages(t, *queue, objectDetailsSetMessage("second", "Second"))
+
+		// when
+		ctx, cancel = context.WithCancel(context.Background())
+		defer cancel()
+		events, err = fx.service.StreamChanges(ctx, mockedSpaceId, nil, first.Cursor)
+		require.NoError(t, err)
+
+		// then
+		second := receiveChangeEvent(t, events)
+		require.Equal(t, apimodel.ChangeEventAdd, second.Type)
+		require.Equal(t, "second", second.ObjectId)
+	})
+
+	t.Run("unknown cursor opens a new feed with a reset event", func(t *testing.T) {
+		// given
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		fx := newFixture(t)
+		fx.populateCache(mockedSpaceId)
+		fx.expectChangeSubscription(t)
+
+		// when
+		events, err := fx.service.StreamChanges(ctx, mockedSpaceId, nil, "UNKNOWN-3")
+		require.NoError(t, err)
+
+		// then
+		reset := receiveChangeEvent(t, events)
+		require.Equal(t, apimodel.ChangeEventReset, reset.Type)
+		require.NotEqual(t, "UNKNOWN-3", reset.Cursor)
+	})
+}
+
+func TestChangeFeeds_evictOldest(t *testing.T) {
+	now := time.Now()
+	fill := func(feeds ...*changeFeed) *changeFeeds {
+		c := newChangeFeeds()
+		for i := 0; i < maxChangeFeeds; i++ {
+			id := fmt.Sprintf("feed%d", i)
+			c.feeds[id] = &changeFeed{id: id, readers: 1, openedAt: now}
+		}
+		for _, feed := range feeds {
+			c.feeds[feed.id] = feed
+		}
+		return c
+	}
+
+	t.Run("no eviction under the limit", func(t *testing.T) {
+		c := newChangeFeeds()
+		c.feeds["feed"] = &changeFeed{id: "feed", readers: 1, openedAt: now}
+
+		require.Nil(t, c.evictOldest())
+		require.Len(t, c.feeds, 1)
+	})
+	t.Run("feed released first is evicted before feeds with readers", func(t *testing.T) {
+		released := &changeFeed{id: "feed1", openedAt: now, releasedAt: now.Add(-time.Minute)}
+		c := fill(
+			&changeFeed{id: "feed0", readers: 1, openedAt: now.Add(-time.Hour)},
+			released,
+			&changeFeed{id: "feed2", openedAt: now.Add(-time.Hour), releasedAt: now},
+		)
+
+		require.Equal(t, released, c.evictOldest())
+		require.Len(t, c.feeds, maxChangeFeeds-1)
+		require.NotContains(t, c.feeds, "feed1")
+	})
+	t.Run("oldest opened feed is evicted when all feeds have readers", func(t *testing.T) {
+		oldest := &changeFeed{id: "feed3", readers: 2, openedAt: now.Add(-time.Hour)}
+		c := fill(oldest)
+
+		require.Equal(t, oldest, c.evictOldest())
+		require.NotContains(t, c.feeds, "feed3")
+	})
+}
+
+func TestChangeFeedSubscriptionKeys(t *testing.T) {
+	keys := changeFeedSubscriptionKeys(map[string]*apimodel.Property{
+		"status":  {RelationKey: "status"},
+		"creator": {RelationKey: bundle.RelationKeyCreator.String()},
+		"name":    {RelationKey: bundle.RelationKeyName.String()},
+		"layout":  {RelationKey: bundle.RelationKeyLayout.String()},
+	})
+
+	require.Contains(t, keys, "status")
+	require.Contains(t, keys, bundle.RelationKeyCreator.String())
+	require.NotContains(t, keys, bundle.RelationKeyLayout.String())
+	for _, key := range changeFeedKeys {
+		require.Contains(t, keys, key)
+	}
+	require.Len(t, keys, len(changeFeedKeys)+2)
+}
+
+// expectChangeSubscription expects a single change subscription with the given objects and stops the service at
+// the end of the test; the returned pointer is set to the queue of the subscription once it's created
+func (fx *fixture) expectChangeSubscription(t *testing.T, objectIds ...string) **mb.MB[*pb.EventMessage] {
+	queue := new(*mb.MB[*pb.EventMessage])
+	records := make([]*domain.Details, 0, len(objectIds))
+	for _, id := range objectIds {
+		records = append(records, domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyId:      domain.String(id),
+			bundle.RelationKeySpaceId: domain.String(mockedSpaceId),
+		}))
+	}
+	fx.subService.EXPECT().Search(mock.MatchedBy(func(req subscription.SubscribeRequest) bool {
+		return req.SpaceId == mockedSpaceId && req.Internal && req.InternalQueue != nil
+	})).RunAndReturn(func(req subscription.SubscribeRequest) (*subscription.SubscribeResponse, error) {
+		*queue = req.InternalQueue
+		return &subscription.SubscribeResponse{SubId: req.SubId, Records: records}, nil
+	}).Once()
+	fx.subService.EXPECT().Unsubscribe(mock.Anything).Return(nil).Once()
+	t.Cleanup(fx.service.Stop)
+	return queue
+}
+
+func objectDetailsSetMessage(id string, name string) *pb.EventMessage {
+	return &pb.EventMessage{Value: &pb.EventMessageValueOfObjectDetailsSet{ObjectDetailsSet: &pb.EventObjectDetailsSet{
+		Id: id,
+		Details: &types.Struct{Fields: map[string]*types.Value{
+			bundle.RelationKeyId.String():      pbtypes.String(id),
+			bundle.RelationKeyName.String():    pbtypes.String(name),
+			bundle.RelationKeySpaceId.String(): pbtypes.String(mockedSpaceId),
+		}},
+	}}}
+}
+
+func addChangeMessages(t *testing.T, queue *mb.MB[*pb.EventMessage], msgs ...*pb.EventMessage) {
+	require.NoError(t, queue.Add(context.Background(), msgs...))
+}
+
+func receiveChangeEvent(t *testing.T, events <-chan apimodel.ChangeEvent) apimodel.ChangeEvent {
+	select {
+	case event, ok := <-events:
+		require.True(t, ok, "change stream closed")
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("no change event received")
+		return apimodel.ChangeEvent{}
+	}
+}
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	apimodel "github.com/anyproto/anytype-heart/core/api/model"
+	"github.com/anyproto/anytype-heart/core/api/pagination"
+	"github.com/anyproto/anytype-heart/core/api/util"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/gateway"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+var (
+	ErrFileNotFound        = errors.New("file not found")
+	ErrFailedRetrieveFiles = errors.New("failed to retrieve files")
+	ErrFailedUploadFile    = errors.New("failed to upload file")
+	ErrFailedReadFile      = errors.New("failed to read file")
+)
+
+// ListFiles returns the file objects of the space, most recently added first.
+func (s *Service) ListFiles(ctx context.Context, spaceId string, additionalFilters []*model.BlockContentDataviewFilter, offset int, limit int) (files []apimodel.File, total int, hasMore bool, err error) {
+	filters := append([]*model.BlockContentDataviewFilter{
+		{
+			RelationKey: bundle.RelationKeyResolvedLayout.String(),
+			Condition:   model.BlockContentDataviewFilter_In,
+			Value:       pbtypes.IntList(util.LayoutsToIntArgs(domain.FileLayouts)...),
+		},
+		{
+			RelationKey: bundle.RelationKeyIsHidden.String(),
+			Condition:   model.BlockContentDataviewFilter_NotEqual,
+			Value:       pbtypes.Bool(true),
+		},
+	}, additionalFilters...)
+
+	resp := s.mw.ObjectSearch(ctx, &pb.RpcObjectSearchRequest{
+		SpaceId: spaceId,
+		Filters: filters,
+		Sorts: []*model.BlockContentDataviewSort{{
+			RelationKey: bundle.RelationKeyCreatedDate.String(),
+			Type:        model.BlockContentDataviewSort_Desc,
+			IncludeTime: true,
+		}},
+	})
+
+	if resp.Error != nil && resp.Error.Code != pb.RpcObjectSearchResponseError_NULL {
+		return nil, 0, false, ErrFailedRetrieveFiles
+	}
+
+	total = len(resp.Records)
+	paginatedRecords, hasMore := pagination.Paginate(resp.Records, offset, limit)
+	files = make([]apimodel.File, 0, len(paginatedRecords))
+	for _, record := range paginatedRecords {
+		files = append(files, getFileFromStruct(record))
+	}
+	return files, total, hasMore, nil
+}
+
+// GetFile returns the metadata of a file object of the space.
+func (s *Service) GetFile(ctx context.Context, spaceId string, fileId string) (*apimodel.File, error) {
+	resp := s.mw.ObjectShow(ctx, &pb.RpcObjectShowRequest{
+		SpaceId:  spaceId,
+		ObjectId: fileId,
+	})
+
+	if resp.Error != nil {
+		if resp.Error.Code == pb.RpcObjectShowResponseError_NOT_FOUND {
+			return nil, ErrFileNotFound
+		}
+
+		if resp.Error.Code == pb.RpcObjectShowResponseError_OBJECT_DELETED {
+			return nil, ErrObjectDeleted
+		}
+
+		if resp.Error.Code != pb.RpcObjectShowResponseError_NULL {
+			return nil, ErrFailedRetrieveObject
+		}
+	}
+
+	details := resp.ObjectView.Details[0].Details
+	if !util.IsFileLayout(model.ObjectTypeLayout(details.Fields[bundle.RelationKeyResolvedLayout.String()].GetNumberValue())) {
+		return nil, ErrFileNotFound
+	}
+	file := getFileFromStruct(details)
+	return &file, nil
+}
+
+// UploadFile adds the file to the space and optionally creates a file block pointing to it in the target object.
+func (s *Service) UploadFile(ctx context.Context, spaceId string, fileName string, content io.Reader, request apimodel.UploadFileRequest) (*apimodel.File, *apimodel.Block, error) {
+	fileName = filepath.Base(fileName)
+	if fileName == "." || fileName == string(filepath.Separator) {
+		return nil, nil, util.ErrBadInput("file name must not be empty")
+	}
+	if request.Position != "" {
+		if _, ok := blockPositions[request.Position]; !ok {
+			return nil, nil, util.ErrBadInput(fmt.Sprintf("invalid block position: %q", request.Position))
+		}
+	}
+	if request.ObjectId == "" && (request.TargetId != "" || request.Position != "") {
+		return nil, nil, util.ErrBadInput("object_id must be set to insert a file block")
+	}
+
+	// the uploader reads files from disk, the original name is kept so the name and the type are detected from it
+	dir, err := os.MkdirTemp("", "anytype-api-upload-")
+	if err != nil {
+		return nil, nil, ErrFailedUploadFile
+	}
+	defer os.RemoveAll(dir)
+	localPath := filepath.Join(dir, fileName)
+	if err = writeFile(localPath, content); err != nil {
+		log.Errorf("failed to save uploaded file: %v", err)
+		return nil, nil, ErrFailedUploadFile
+	}
+
+	uploadRequest := &pb.RpcFileUploadRequest{
+		SpaceId:   spaceId,
+		LocalPath: localPath,
+		Origin:    model.ObjectOrigin_api,
+	}
+	if request.Name != "" {
+		uploadRequest.Details = &types.Struct{Fields: map[string]*types.Value{
+			bundle.RelationKeyName.String(): pbtypes.String(request.Name),
+		}}
+	}
+	resp := s.mw.FileUpload(ctx, uploadRequest)
+	if resp.Error != nil && resp.Error.Code != pb.RpcFileUploadResponseError_NULL {
+		if resp.Error.Code == pb.RpcFileUploadResponseError_BAD_INPUT {
+			return nil, nil, util.ErrBadInput(resp.Error.Description)
+		}
+		return nil, nil, ErrFailedUploadFile
+	}
+
+	file, err := s.GetFile(ctx, spaceId, resp.ObjectId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if request.ObjectId == "" {
+		return file, nil, nil
+	}
+
+	block, err := s.CreateBlock(ctx, spaceId, request.ObjectId, apimodel.CreateBlockRequest{
+		TargetId: request.TargetId,
+		Position: request.Position,
+		File:     &apimodel.CreateObjectContent{ObjectId: file.Id},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return file, block, nil
+}
+
+// GetFileContent returns the metadata and the content of the file object. Images are returned in the smallest
+// variant not narrower than the width if it's positive.
+func (s *Service) GetFileContent(ctx context.Context, spaceId string, fileId string, width int) (*apimodel.File, *gateway.Content, error) {
+	if width < 0 {
+		return nil, nil, util.ErrBadInput("width must not be negative")
+	}
+	file, err := s.GetFile(ctx, spaceId, fileId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var content *gateway.Content
+	switch {
+	case file.Type == fileTypes[model.BlockContentFile_Image]:
+		content, err = s.fileReader.ImageContent(ctx, fileId, width)
+	case width > 0:
+		return nil, nil, util.ErrBadInput("width can only be set for images")
+	default:
+		content, err = s.fileReader.FileContent(ctx, fileId)
+	}
+	if err != nil {
+		log.Errorf("failed to read file %s: %v", fileId, err)
+		return nil, nil, ErrFailedReadFile
+	}
+	return file, content, nil
+}
+
+func writeFile(path string, content io.Reader) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(f, content); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// getFileFromStruct converts the details of a file object to the API representation.
+func getFileFromStruct(details *types.Struct) apimodel.File {
+	layout := model.ObjectTypeLayout(details.Fields[bundle.RelationKeyResolvedLayout.String()].GetNumberValue())
+	return apimodel.File{
+		Object:      "file",
+		Id:          details.Fields[bundle.RelationKeyId.String()].GetStringValue(),
+		SpaceId:     details.Fields[bundle.RelationKeySpaceId.String()].GetStringValue(),
+		Name:        details.Fields[bundle.RelationKeyName.String()].GetStringValue(),
+		Extension:   details.Fields[bundle.RelationKeyFileExt.String()].GetStringValue(),
+		Type:        fileTypes[fileLayoutTypes[layout]],
+		Mime:        details.Fields[bundle.RelationKeyFileMimeType.String()].GetStringValue(),
+		Size:        int64(details.Fields[bundle.RelationKeySizeInBytes.String()].GetNumberValue()),
+		Width:       int64(details.Fields[bundle.RelationKeyWidthInPixels.String()].GetNumberValue()),
+		Height:      int64(details.Fields[bundle.RelationKeyHeightInPixels.String()].GetNumberValue()),
+		CreatedDate: time.Unix(int64(details.Fields[bundle.RelationKeyCreatedDate.String()].GetNumberValue()), 0).UTC().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	apimodel "github.com/anyproto/anytype-heart/core/api/model"
+	"github.com/anyproto/anytype-heart/core/api/util"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/gateway"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const (
+	mockedFileId   = "mocked-file-id"
+	mockedFileName = "screenshot"
+)
+
+func mockedFileDetails(layout model.ObjectTypeLayout) *types.Struct {
+	return &types.Struct{Fields: map[string]*types.Value{
+		bundle.RelationKeyId.String():             pbtypes.String(mockedFileId),
+		bundle.RelationKeySpaceId.String():        pbtypes.String(mockedSpaceId),
+		bundle.RelationKeyName.String():           pbtypes.String(mockedFileName),
+		bundle.RelationKeyFileExt.String():        pbtypes.String("png"),
+		bundle.RelationKeyFileMimeType.String():   pbtypes.String("image/png"),
+		bundle.RelationKeySizeInBytes.String():    pbtypes.Int64(2048),
+		bundle.RelationKeyWidthInPixels.String():  pbtypes.Int64(640),
+		bundle.RelationKeyHeightInPixels.String(): pbtypes.Int64(480),
+		bundle.RelationKeyCreatedDate.String():    pbtypes.Int64(1735732800),
+		bundle.RelationKeyResolvedLayout.String(): pbtypes.Int64(int64(layout)),
+	}}
+}
+
+func mockedFileShowResponse(layout model.ObjectTypeLayout) *pb.RpcObjectShowResponse {
+	return &pb.RpcObjectShowResponse{
+		ObjectView: &model.ObjectView{
+			Details: []*model.ObjectViewDetailsSet{{Id: mockedFileId, Details: mockedFileDetails(layout)}},
+		},
+	}
+}
+
+func TestFileService_ListFiles(t *testing.T) {
+	t.Run("files are listed with metadata", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("ObjectSearch", mock.Anything, mock.Anything).Return(&pb.RpcObjectSearchResponse{
+			Error:   &pb.RpcObjectSearchResponseError{Code: pb.RpcObjectSearchResponseError_NULL},
+			Records: []*types.Struct{mockedFileDetails(model.ObjectType_image)},
+		}).Once()
+
+		// when
+		files, total, hasMore, err := fx.service.ListFiles(ctx, mockedSpaceId, nil, offset, limit)
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, 1, total)
+		require.False(t, hasMore)
+		require.Equal(t, []apimodel.File{{
+			Object:      "file",
+			Id:          mockedFileId,
+			SpaceId:     mockedSpaceId,
+			Name:        mockedFileName,
+			Extension:   "png",
+			Type:        "image",
+			Mime:        "image/png",
+			Size:        2048,
+			Width:       640,
+			Height:      480,
+			CreatedDate: "2025-01-01T12:00:00Z",
+		}}, files)
+	})
+
+	t.Run("search error", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("ObjectSearch", mock.Anything, mock.Anything).Return(&pb.RpcObjectSearchResponse{
+			Error: &pb.RpcObjectSearchResponseError{Code: pb.RpcObjectSearchResponseError_UNKNOWN_ERROR},
+		}).Once()
+
+		// when
+		_, _, _, err := fx.service.ListFiles(ctx, mockedSpaceId, nil, offset, limit)
+
+		// then
+		require.ErrorIs(t, err, ErrFailedRetrieveFiles)
+	})
+}
+
+func TestFileService_GetFile(t *testing.T) {
+	t.Run("object is not a file", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, &pb.RpcObjectShowRequest{
+			SpaceId:  mockedSpaceId,
+			ObjectId: mockedFileId,
+		}).Return(mockedFileShowResponse(model.ObjectType_basic)).Once()
+
+		// when
+		file, err := fx.service.GetFile(ctx, mockedSpaceId, mockedFileId)
+
+		// then
+		require.ErrorIs(t, err, ErrFileNotFound)
+		require.Nil(t, file)
+	})
+}
+
+func TestFileService_UploadFile(t *testing.T) {
+	t.Run("file is uploaded and a block is created", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		fx.populateCache(mockedSpaceId)
+
+		var uploadedPath string
+		fx.mwMock.On("FileUpload", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			req := args.Get(1).(*pb.RpcFileUploadRequest)
+			require.Equal(t, mockedSpaceId, req.SpaceId)
+			require.Equal(t, model.ObjectOrigin_api, req.Origin)
+			require.Equal(t, "Renamed", req.Details.Fields[bundle.RelationKeyName.String()].GetStringValue())
+			data, err := os.ReadFile(req.LocalPath)
+			require.NoError(t, err)
+			require.Equal(t, "png data", string(data))
+			require.True(t, strings.HasSuffix(req.LocalPath, "screenshot.png"))
+			uploadedPath = req.LocalPath
+		}).Return(&pb.RpcFileUploadResponse{
+			Error:    &pb.RpcFileUploadResponseError{Code: pb.RpcFileUploadResponseError_NULL},
+			ObjectId: mockedFileId,
+		}).Once()
+		fx.mwMock.On("ObjectShow", mock.Anything, &pb.RpcObjectShowRequest{SpaceId: mockedSpaceId, ObjectId: mockedFileId}).
+			Return(mockedFileShowResponse(model.ObjectType_image)).Twice()
+
+		fileBlock := &model.Block{Id: mockedNewBlockId, Content: &model.BlockContentOfFile{File: &model.BlockContentFile{
+			TargetObjectId: mockedFileId,
+			Type:           model.BlockContentFile_Image,
+		}}}
+		fx.mwMock.On("ObjectShow", mock.Anything, &pb.RpcObjectShowRequest{SpaceId: mockedSpaceId, ObjectId: mockedObjectId}).
+			Return(mockedObjectView()).Once()
+		fx.mwMock.On("BlockCreate", mock.Anything, mock.Anything).Return(&pb.RpcBlockCreateResponse{
+			Error:   &pb.RpcBlockCreateResponseError{Code: pb.RpcBlockCreateResponseError_NULL},
+			BlockId: mockedNewBlockId,
+		}).Once()
+		fx.mwMock.On("ObjectShow", mock.Anything, &pb.RpcObjectShowRequest{SpaceId: mockedSpaceId, ObjectId: mockedObjectId}).
+			Return(mockedObjectView(fileBlock)).Once()
+
+		// when
+		file, block, err := fx.service.UploadFile(ctx, mockedSpaceId, "../screenshot.png", strings.NewReader("png data"), apimodel.UploadFileRequest{
+			Name:     "Renamed",
+			ObjectId: mockedObjectId,
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, mockedFileId, file.Id)
+		require.Equal(t, mockedNewBlockId, block.Id)
+		require.Equal(t, mockedFileId, block.File.ObjectId)
+		_, err = os.Stat(uploadedPath)
+		require.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("position requires target object", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		// when
+		file, block, err := fx.service.UploadFile(ctx, mockedSpaceId, "file.txt", strings.NewReader("data"), apimodel.UploadFileRequest{
+			Position: apimodel.BlockPositionTop,
+		})
+
+		// then
+		require.ErrorIs(t, err, util.ErrBad)
+		require.Nil(t, file)
+		require.Nil(t, block)
+	})
+}
+
+func TestFileService_GetFileContent(t *testing.T) {
+	t.Run("image variant of the width", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(mockedFileShowResponse(model.ObjectType_image)).Once()
+		content := &gateway.Content{
+			Name:     "screenshot.png",
+			MimeType: "image/png",
+			Added:    time.Now(),
+			Reader:   strings.NewReader("small png"),
+		}
+		fx.fileReader.EXPECT().ImageContent(mock.Anything, mockedFileId, 160).Return(content, nil).Once()
+
+		// when
+		file, result, err := fx.service.GetFileContent(ctx, mockedSpaceId, mockedFileId, 160)
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, mockedFileId, file.Id)
+		require.Equal(t, content, result)
+	})
+
+	t.Run("width is not supported for other files", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(mockedFileShowResponse(model.ObjectType_pdf)).Once()
+
+		// when
+		_, _, err := fx.service.GetFileContent(ctx, mockedSpaceId, mockedFileId, 160)
+
+		// then
+		require.ErrorIs(t, err, util.ErrBad)
+	})
+}
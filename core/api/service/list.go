@@ -9,7 +9,6 @@ import (
 
 	"github.com/anyproto/anytype-heart/pb"
 
-	"github.com/anyproto/anytype-heart/core/api/filter"
 	apimodel "github.com/anyproto/anytype-heart/core/api/model"
 	"github.com/anyproto/anytype-heart/core/api/pagination"
 	"github.com/anyproto/anytype-heart/core/api/util"
@@ -33,64 +32,14 @@ var (
 
 // GetListViews retrieves views of a list
 func (s *Service) GetListViews(ctx context.Context, spaceId string, listId string, offset, limit int) ([]apimodel.View, int, bool, error) {
-	resp := s.mw.ObjectShow(ctx, &pb.RpcObjectShowRequest{
-		SpaceId:  spaceId,
-		ObjectId: listId,
-	})
-
-	if resp.Error != nil && resp.Error.Code != pb.RpcObjectShowResponseError_NULL {
-		return nil, 0, false, ErrFailedGetList
-	}
-
-	var dataviewBlock *model.Block
-	for _, block := range resp.ObjectView.Blocks {
-		if block.Id == "dataview" {
-			dataviewBlock = block
-			break
-		}
-	}
-
-	if dataviewBlock == nil {
-		return nil, 0, false, ErrFailedGetListDataview
+	dataview, err := s.getListDataview(ctx, spaceId, listId)
+	if err != nil {
+		return nil, 0, false, err
 	}
 
-	var views []apimodel.View
-	switch content := dataviewBlock.Content.(type) {
-	case *model.BlockContentOfDataview:
-		for _, view := range content.Dataview.Views {
-			var filters []apimodel.Filter
-			for _, f := range view.Filters {
-				if f.Condition == model.BlockContentDataviewFilter_None {
-					continue
-				}
-				apiCond, _ := filter.ToApiCondition(f.Condition)
-				filters = append(filters, apimodel.Filter{
-					Id:          f.Id,
-					PropertyKey: f.RelationKey,
-					Format:      RelationFormatToPropertyFormat[f.Format],
-					Condition:   apiCond,
-					Value:       f.Value.GetStringValue(),
-				})
-			}
-			var sorts []apimodel.Sort
-			for _, srt := range view.Sorts {
-				sorts = append(sorts, apimodel.Sort{
-					Id:          srt.Id,
-					PropertyKey: srt.RelationKey,
-					Format:      RelationFormatToPropertyFormat[srt.Format],
-					SortType:    strcase.ToSnake(model.BlockContentDataviewSortType_name[int32(srt.Type)]),
-				})
-			}
-			views = append(views, apimodel.View{
-				Id:      view.Id,
-				Name:    view.Name,
-				Layout:  s.mapDataviewTypeName(view.Type),
-				Filters: filters,
-				Sorts:   sorts,
-			})
-		}
-	default:
-		return nil, 0, false, ErrFailedGetListDataview
+	views := make([]apimodel.View, 0, len(dataview.Views))
+	for _, view := range dataview.Views {
+		views = append(views, s.getViewFromModel(view))
 	}
 
 	total := len(views)
@@ -112,7 +61,7 @@ func (s *Service) GetObjectsInList(ctx context.Context, spaceId string, listId s
 
 	var dataviewBlock *model.Block
 	for _, block := range resp.ObjectView.Blocks {
-		if block.Id == "dataview" {
+		if block.Id == dataviewBlockId {
 			dataviewBlock = block
 			break
 		}
@@ -241,11 +190,11 @@ func (s *Service) RemoveObjectsFromList(ctx context.Context, spaceId string, lis
 }
 
 // mapDataviewTypeName maps the dataview type to a string.
-func (s *Service) mapDataviewTypeName(dataviewType model.BlockContentDataviewViewType) string {
+func (s *Service) mapDataviewTypeName(dataviewType model.BlockContentDataviewViewType) apimodel.ViewLayout {
 	switch dataviewType {
 	case model.BlockContentDataviewView_Table:
-		return "grid"
+		return apimodel.ViewLayoutGrid
 	default:
-		return strcase.ToSnake(model.BlockContentDataviewViewType_name[int32(dataviewType)])
+		return apimodel.ViewLayout(strcase.ToSnake(model.BlockContentDataviewViewType_name[int32(dataviewType)]))
 	}
 }
@@ -14,6 +14,7 @@ import (
 	apimodel "github.com/anyproto/anytype-heart/core/api/model"
 	"github.com/anyproto/anytype-heart/core/api/pagination"
 	"github.com/anyproto/anytype-heart/core/api/util"
+	"github.com/anyproto/anytype-heart/core/date"
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
@@ -135,6 +136,7 @@ func (s *Service) ListProperties(ctx context.Context, spaceId string, additional
 			bundle.RelationKeyApiObjectKey.String(),
 			bundle.RelationKeyName.String(),
 			bundle.RelationKeyRelationFormat.String(),
+			bundle.RelationKeyRelationFormatIncludeTime.String(),
 		},
 	})
 
@@ -201,6 +203,10 @@ func (s *Service) CreateProperty(ctx context.Context, spaceId string, request ap
 		},
 	}
 
+	if request.Format == apimodel.PropertyFormatDate && request.IncludeTime {
+		details.Fields[bundle.RelationKeyRelationFormatIncludeTime.String()] = pbtypes.Bool(true)
+	}
+
 	if request.Key != "" {
 		apiKey := strcase.ToSnake(s.sanitizedString(request.Key))
 		if s.cache.getProperties(spaceId)[apiKey] != nil {
@@ -405,16 +411,13 @@ func (s *Service) SanitizeAndValidatePropertyValue(spaceId string, key string, v
 	case apimodel.PropertyFormatDate:
 		dateStr, ok := value.(string)
 		if !ok {
-			return nil, util.ErrBadInput(fmt.Sprintf("property %q must be a string containing a date in one of these formats: RFC3339 (2006-01-02T15:04:05Z) or date-only (2006-01-02)", key))
+			return nil, util.ErrBadInput(fmt.Sprintf("property %q must be a string containing a date in one of these formats: RFC3339 (2006-01-02T15:04:05Z), date-only (2006-01-02) or natural language (next friday 3pm)", key))
 		}
-		dateStr = s.sanitizedString(dateStr)
-		layouts := []string{time.RFC3339, time.DateOnly}
-		for _, layout := range layouts {
-			if t, err := time.Parse(layout, dateStr); err == nil {
-				return t.Unix(), nil
-			}
+		parsed, err := parseDateValue(s.sanitizedString(dateStr), time.Now().UTC())
+		if err != nil {
+			return nil, util.ErrBadInput(fmt.Sprintf("invalid date format for %q: %s", key, dateStr))
 		}
-		return nil, util.ErrBadInput(fmt.Sprintf("invalid date format for %q: %s", key, dateStr))
+		return parsed.Time.Unix(), nil
 	case apimodel.PropertyFormatCheckbox:
 		b, ok := value.(bool)
 		if !ok {
@@ -525,10 +528,23 @@ func (s *Service) getPropertyFromStruct(details *types.Struct) (string, string,
 		Key:         apiKey,
 		Name:        details.Fields[bundle.RelationKeyName.String()].GetStringValue(),
 		Format:      RelationFormatToPropertyFormat[model.RelationFormat(details.Fields[bundle.RelationKeyRelationFormat.String()].GetNumberValue())],
+		IncludeTime: details.Fields[bundle.RelationKeyRelationFormatIncludeTime.String()].GetBoolValue(),
 		RelationKey: rk, // internal-only for simplified lookup
 	}
 }
 
+// parseDateValue parses dates in RFC3339, date-only or natural language formats. Dates without time and relative
+// dates are resolved in UTC, the same timezone dates are returned in, so all formats agree on the start of the day
+func parseDateValue(dateStr string, now time.Time) (date.ParsedDate, error) {
+	if t, err := time.Parse(time.RFC3339, dateStr); err == nil {
+		return date.ParsedDate{Time: t, IncludeTime: true}, nil
+	}
+	if t, err := time.Parse(time.DateOnly, dateStr); err == nil {
+		return date.ParsedDate{Time: t}, nil
+	}
+	return date.ParseNaturalDate(dateStr, now.UTC(), "")
+}
+
 // getPropertiesFromStruct retrieves the properties from the details.
 func (s *Service) getPropertiesFromStruct(details *types.Struct) []apimodel.PropertyWithValue {
 	spaceId := details.Fields[bundle.RelationKeySpaceId.String()].GetStringValue()
@@ -554,7 +570,7 @@ func (s *Service) getPropertiesFromStruct(details *types.Struct) []apimodel.Prop
 
 		id := prop.Id
 		name := prop.Name
-		if pwv := s.buildPropertyWithValue(id, key, name, format, convertedVal); pwv != nil {
+		if pwv := s.buildPropertyWithValue(id, key, name, format, prop.IncludeTime, convertedVal); pwv != nil {
 			properties = append(properties, *pwv)
 		}
 	}
@@ -628,7 +644,7 @@ func (s *Service) convertPropertyValue(key string, value *types.Value, format ap
 }
 
 // buildPropertyWithValue creates a Property based on the format and converted value.
-func (s *Service) buildPropertyWithValue(id string, key string, name string, format apimodel.PropertyFormat, val interface{}) *apimodel.PropertyWithValue {
+func (s *Service) buildPropertyWithValue(id string, key string, name string, format apimodel.PropertyFormat, includeTime bool, val interface{}) *apimodel.PropertyWithValue {
 	base := apimodel.PropertyBase{
 		Object: "property",
 		Id:     id,
@@ -667,7 +683,7 @@ func (s *Service) buildPropertyWithValue(id string, key string, name string, for
 		if dateStr, ok := val.(string); ok {
 			return &apimodel.PropertyWithValue{WrappedPropertyWithValue: apimodel.DatePropertyValue{
 				PropertyBase: base, Key: key, Name: name, Format: format,
-				Date: dateStr,
+				Date: dateStr, IncludeTime: includeTime,
 			}}
 		}
 	case apimodel.PropertyFormatFiles:
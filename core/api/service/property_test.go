@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/assert"
@@ -359,6 +360,19 @@ func TestProcessProperties(t *testing.T) {
 			assert.Greater(t, result["date_prop"].GetNumberValue(), float64(0))
 		})
 
+		t.Run("valid natural language date", func(t *testing.T) {
+			dateStr := "tomorrow"
+			entries := []apimodel.PropertyLinkWithValue{
+				{WrappedPropertyLinkWithValue: apimodel.DatePropertyLinkValue{
+					Key:  "date_prop",
+					Date: &dateStr,
+				}},
+			}
+			result, err := fx.service.processProperties(ctx, mockedSpaceId, entries)
+			require.NoError(t, err)
+			assert.Greater(t, result["date_prop"].GetNumberValue(), float64(time.Now().Unix()))
+		})
+
 		t.Run("nil value", func(t *testing.T) {
 			entries := []apimodel.PropertyLinkWithValue{
 				{WrappedPropertyLinkWithValue: apimodel.DatePropertyLinkValue{
@@ -929,3 +943,34 @@ func TestProcessProperties(t *testing.T) {
 		})
 	})
 }
+
+func TestParseDateValue(t *testing.T) {
+	now := time.Date(2024, time.May, 15, 22, 30, 0, 0, time.FixedZone("UTC+3", 3*60*60))
+
+	t.Run("date-only and natural dates agree on the start of the day", func(t *testing.T) {
+		dateOnly, err := parseDateValue("2024-05-16", now)
+		require.NoError(t, err)
+		natural, err := parseDateValue("tomorrow", now)
+		require.NoError(t, err)
+
+		assert.Equal(t, dateOnly.Time.Unix(), natural.Time.Unix())
+		assert.False(t, dateOnly.IncludeTime)
+		assert.False(t, natural.IncludeTime)
+	})
+
+	t.Run("time is included", func(t *testing.T) {
+		rfc, err := parseDateValue("2024-05-16T15:00:00Z", now)
+		require.NoError(t, err)
+		natural, err := parseDateValue("tomorrow 3pm", now)
+		require.NoError(t, err)
+
+		assert.Equal(t, rfc.Time.Unix(), natural.Time.Unix())
+		assert.True(t, rfc.IncludeTime)
+		assert.True(t, natural.IncludeTime)
+	})
+
+	t.Run("invalid date", func(t *testing.T) {
+		_, err := parseDateValue("not a date", now)
+		require.Error(t, err)
+	})
+}
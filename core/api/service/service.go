@@ -15,22 +15,28 @@ type Service struct {
 	techSpaceId string
 
 	crossSpaceSubService apicore.CrossSpaceSubscriptionService
+	subService           apicore.SubscriptionService
+	fileReader           apicore.FileReader
 	componentCtxCancel   context.CancelFunc
 
 	cache         *cacheManager
 	subscriptions *subscriptions
+	changeFeeds   *changeFeeds
 }
 
-func NewService(mw apicore.ClientCommands, gatewayUrl string, techspaceId string, crossSpaceSubService apicore.CrossSpaceSubscriptionService) *Service {
+func NewService(mw apicore.ClientCommands, gatewayUrl string, techspaceId string, crossSpaceSubService apicore.CrossSpaceSubscriptionService, subService apicore.SubscriptionService, fileReader apicore.FileReader) *Service {
 	_, cancel := context.WithCancel(context.Background())
 	s := &Service{
 		mw:                   mw,
 		gatewayUrl:           gatewayUrl,
 		techSpaceId:          techspaceId,
 		crossSpaceSubService: crossSpaceSubService,
+		subService:           subService,
+		fileReader:           fileReader,
 		componentCtxCancel:   cancel,
 		cache:                newCacheManager(),
 		subscriptions:        newSubscriptions(),
+		changeFeeds:          newChangeFeeds(),
 	}
 
 	return s
@@ -33,17 +33,23 @@ type fixture struct {
 	service              *Service
 	mwMock               *mock_apicore.MockClientCommands
 	crossSpaceSubService *mock_apicore.MockCrossSpaceSubscriptionService
+	subService           *mock_apicore.MockSubscriptionService
+	fileReader           *mock_apicore.MockFileReader
 }
 
 func newFixture(t *testing.T) *fixture {
 	mwMock := mock_apicore.NewMockClientCommands(t)
 	crossSpaceSubService := mock_apicore.NewMockCrossSpaceSubscriptionService(t)
-	service := NewService(mwMock, gatewayUrl, techSpaceId, crossSpaceSubService)
+	subService := mock_apicore.NewMockSubscriptionService(t)
+	fileReader := mock_apicore.NewMockFileReader(t)
+	service := NewService(mwMock, gatewayUrl, techSpaceId, crossSpaceSubService, subService, fileReader)
 
 	return &fixture{
 		service:              service,
 		mwMock:               mwMock,
 		crossSpaceSubService: crossSpaceSubService,
+		subService:           subService,
+		fileReader:           fileReader,
 	}
 }
 
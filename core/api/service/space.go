@@ -5,11 +5,13 @@ import (
 	"crypto/rand"
 	"errors"
 	"math/big"
+	"slices"
 
 	"github.com/gogo/protobuf/types"
 
 	apimodel "github.com/anyproto/anytype-heart/core/api/model"
 	"github.com/anyproto/anytype-heart/core/api/pagination"
+	"github.com/anyproto/anytype-heart/core/api/util"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
@@ -78,8 +80,11 @@ func (s *Service) ListSpaces(ctx context.Context, additionalFilters []*model.Blo
 		return nil, 0, false, ErrFailedListSpaces
 	}
 
-	total = len(resp.Records)
-	paginatedRecords, hasMore := pagination.Paginate(resp.Records, offset, limit)
+	records := slices.DeleteFunc(resp.Records, func(record *types.Struct) bool {
+		return !util.IsSpaceAllowed(ctx, record.Fields[bundle.RelationKeyTargetSpaceId.String()].GetStringValue())
+	})
+	total = len(records)
+	paginatedRecords, hasMore := pagination.Paginate(records, offset, limit)
 	spaces = make([]apimodel.Space, 0, len(paginatedRecords))
 
 	for _, record := range paginatedRecords {
@@ -270,7 +275,7 @@ func (s *Service) GetAllSpaceIds(ctx context.Context) ([]string, error) {
 		}
 	}
 
-	return spaceIds, nil
+	return util.FilterAllowedSpaceIds(ctx, spaceIds), nil
 }
 
 func (s *Service) spaceUxTypeToSpaceType(uxType model.SpaceUxType) string {
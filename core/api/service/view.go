@@ -0,0 +1,556 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/iancoleman/strcase"
+
+	"github.com/anyproto/anytype-heart/core/api/filter"
+	apimodel "github.com/anyproto/anytype-heart/core/api/model"
+	"github.com/anyproto/anytype-heart/core/api/util"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// dataviewBlockId is the id of the dataview block of sets and collections
+const dataviewBlockId = "dataview"
+
+var (
+	ErrViewNotFound     = errors.New("view not found")
+	ErrFailedCreateView = errors.New("failed to create view")
+	ErrFailedUpdateView = errors.New("failed to update view")
+	ErrFailedDeleteView = errors.New("failed to delete view")
+)
+
+var viewLayouts = map[apimodel.ViewLayout]model.BlockContentDataviewViewType{
+	apimodel.ViewLayoutGrid:     model.BlockContentDataviewView_Table,
+	apimodel.ViewLayoutList:     model.BlockContentDataviewView_List,
+	apimodel.ViewLayoutGallery:  model.BlockContentDataviewView_Gallery,
+	apimodel.ViewLayoutKanban:   model.BlockContentDataviewView_Kanban,
+	apimodel.ViewLayoutCalendar: model.BlockContentDataviewView_Calendar,
+	apimodel.ViewLayoutGraph:    model.BlockContentDataviewView_Graph,
+}
+
+var sortTypes = map[apimodel.SortType]model.BlockContentDataviewSortType{
+	apimodel.SortTypeAsc:  model.BlockContentDataviewSort_Asc,
+	apimodel.SortTypeDesc: model.BlockContentDataviewSort_Desc,
+}
+
+// groupByFormats lists the property formats a view can be grouped by, per layout
+var groupByFormats = map[model.BlockContentDataviewViewType][]apimodel.PropertyFormat{
+	model.BlockContentDataviewView_Kanban:   {apimodel.PropertyFormatSelect, apimodel.PropertyFormatMultiSelect, apimodel.PropertyFormatCheckbox},
+	model.BlockContentDataviewView_Calendar: {apimodel.PropertyFormatDate},
+}
+
+// GetListView retrieves a single view of a list
+func (s *Service) GetListView(ctx context.Context, spaceId string, listId string, viewId string) (*apimodel.View, error) {
+	dataview, err := s.getListDataview(ctx, spaceId, listId)
+	if err != nil {
+		return nil, err
+	}
+
+	view, err := findView(dataview, viewId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := s.getViewFromModel(view)
+	return &result, nil
+}
+
+// CreateListView creates a view in the dataview of a list
+func (s *Service) CreateListView(ctx context.Context, spaceId string, listId string, request apimodel.CreateViewRequest) (*apimodel.View, error) {
+	dataview, err := s.getListDataview(ctx, spaceId, listId)
+	if err != nil {
+		return nil, err
+	}
+
+	layout := request.Layout
+	if layout == "" {
+		layout = apimodel.ViewLayoutGrid
+	}
+	view := &model.BlockContentDataviewView{
+		Name:     request.Name,
+		Type:     viewLayouts[layout],
+		CardSize: model.BlockContentDataviewView_Medium,
+	}
+
+	if view.Filters, err = s.buildViewFilters(spaceId, request.Filters); err != nil {
+		return nil, err
+	}
+	if view.Sorts, err = s.buildViewSorts(spaceId, request.Sorts); err != nil {
+		return nil, err
+	}
+	if view.GroupRelationKey, err = s.resolveGroupBy(spaceId, view.Type, request.GroupBy); err != nil {
+		return nil, err
+	}
+
+	if request.Properties != nil {
+		visible, err := s.resolveViewProperties(spaceId, request.Properties)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.ensureDataviewRelations(ctx, listId, dataview, visible); err != nil {
+			return nil, ErrFailedCreateView
+		}
+		keys := make([]string, 0, len(dataview.RelationLinks))
+		for _, link := range dataview.RelationLinks {
+			keys = append(keys, link.Key)
+		}
+		view.Relations = arrangeViewRelations(nil, keys, visible)
+	}
+
+	resp := s.mw.BlockDataviewViewCreate(ctx, &pb.RpcBlockDataviewViewCreateRequest{
+		ContextId: listId,
+		BlockId:   dataviewBlockId,
+		View:      view,
+	})
+	if resp.Error != nil && resp.Error.Code != pb.RpcBlockDataviewViewCreateResponseError_NULL {
+		return nil, ErrFailedCreateView
+	}
+
+	return s.GetListView(ctx, spaceId, listId, resp.ViewId)
+}
+
+// UpdateListView updates the layout, grouping, filters, sorts and visible properties of a view. Only the fields set in the request are changed.
+func (s *Service) UpdateListView(ctx context.Context, spaceId string, listId string, viewId string, request apimodel.UpdateViewRequest) (*apimodel.View, error) {
+	dataview, err := s.getListDataview(ctx, spaceId, listId)
+	if err != nil {
+		return nil, err
+	}
+
+	view, err := findView(dataview, viewId)
+	if err != nil {
+		return nil, err
+	}
+
+	// validate the whole request before changing anything
+	updated := *view
+	if request.Name != nil {
+		updated.Name = *request.Name
+	}
+	if request.Layout != nil {
+		updated.Type = viewLayouts[*request.Layout]
+	}
+	if request.GroupBy != nil {
+		if updated.GroupRelationKey, err = s.resolveGroupBy(spaceId, updated.Type, *request.GroupBy); err != nil {
+			return nil, err
+		}
+	}
+
+	var filters []*model.BlockContentDataviewFilter
+	if request.Filters != nil {
+		if filters, err = s.buildViewFilters(spaceId, *request.Filters); err != nil {
+			return nil, err
+		}
+	}
+	var sorts []*model.BlockContentDataviewSort
+	if request.Sorts != nil {
+		if sorts, err = s.buildViewSorts(spaceId, *request.Sorts); err != nil {
+			return nil, err
+		}
+	}
+	var visible []string
+	if request.Properties != nil {
+		if visible, err = s.resolveViewProperties(spaceId, *request.Properties); err != nil {
+			return nil, err
+		}
+	}
+
+	if request.Name != nil || request.Layout != nil || request.GroupBy != nil {
+		resp := s.mw.BlockDataviewViewUpdate(ctx, &pb.RpcBlockDataviewViewUpdateRequest{
+			ContextId: listId,
+			BlockId:   dataviewBlockId,
+			ViewId:    viewId,
+			View:      &updated,
+		})
+		if resp.Error != nil && resp.Error.Code != pb.RpcBlockDataviewViewUpdateResponseError_NULL {
+			return nil, ErrFailedUpdateView
+		}
+	}
+
+	if request.Filters != nil {
+		if err := s.replaceViewFilters(ctx, listId, view, filters); err != nil {
+			return nil, err
+		}
+	}
+
+	if request.Sorts != nil {
+		if err := s.replaceViewSorts(ctx, listId, view, sorts); err != nil {
+			return nil, err
+		}
+	}
+
+	if request.Properties != nil {
+		if err := s.ensureDataviewRelations(ctx, listId, dataview, visible); err != nil {
+			return nil, ErrFailedUpdateView
+		}
+		if err := s.setViewRelations(ctx, listId, view, visible); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.GetListView(ctx, spaceId, listId, viewId)
+}
+
+// DeleteListView deletes a view of a list and returns it as it was before the deletion
+func (s *Service) DeleteListView(ctx context.Context, spaceId string, listId string, viewId string) (*apimodel.View, error) {
+	dataview, err := s.getListDataview(ctx, spaceId, listId)
+	if err != nil {
+		return nil, err
+	}
+
+	view, err := findView(dataview, viewId)
+	if err != nil {
+		return nil, err
+	}
+	if len(dataview.Views) == 1 {
+		return nil, util.ErrBadInput("the last view of a list cannot be deleted")
+	}
+
+	resp := s.mw.BlockDataviewViewDelete(ctx, &pb.RpcBlockDataviewViewDeleteRequest{
+		ContextId: listId,
+		BlockId:   dataviewBlockId,
+		ViewId:    viewId,
+	})
+	if resp.Error != nil && resp.Error.Code != pb.RpcBlockDataviewViewDeleteResponseError_NULL {
+		return nil, ErrFailedDeleteView
+	}
+
+	result := s.getViewFromModel(view)
+	return &result, nil
+}
+
+// getListDataview returns the dataview content of a set or collection
+func (s *Service) getListDataview(ctx context.Context, spaceId string, listId string) (*model.BlockContentDataview, error) {
+	resp := s.mw.ObjectShow(ctx, &pb.RpcObjectShowRequest{
+		SpaceId:  spaceId,
+		ObjectId: listId,
+	})
+
+	if resp.Error != nil && resp.Error.Code != pb.RpcObjectShowResponseError_NULL {
+		return nil, ErrFailedGetList
+	}
+
+	for _, block := range resp.ObjectView.Blocks {
+		if block.Id == dataviewBlockId {
+			if dataview := block.GetDataview(); dataview != nil {
+				return dataview, nil
+			}
+			break
+		}
+	}
+	return nil, ErrFailedGetListDataview
+}
+
+func findView(dataview *model.BlockContentDataview, viewId string) (*model.BlockContentDataviewView, error) {
+	for _, view := range dataview.Views {
+		if view.Id == viewId {
+			return view, nil
+		}
+	}
+	return nil, ErrViewNotFound
+}
+
+// buildViewFilters validates the filters against the condition tables of the property formats and converts them to dataview filters
+func (s *Service) buildViewFilters(spaceId string, requests []apimodel.ViewFilterRequest) ([]*model.BlockContentDataviewFilter, error) {
+	parsed := &filter.ParsedFilters{Filters: make([]filter.Filter, 0, len(requests))}
+	for _, request := range requests {
+		if request.PropertyKey == "" {
+			return nil, util.ErrBadInput("filter property_key must not be empty")
+		}
+		condition, ok := filter.ToInternalCondition(request.Condition)
+		if !ok {
+			return nil, util.ErrBadInput(fmt.Sprintf("unsupported filter condition: %q", request.Condition))
+		}
+		parsed.Filters = append(parsed.Filters, filter.Filter{
+			PropertyKey: request.PropertyKey,
+			Condition:   condition,
+			Value:       request.Value,
+		})
+	}
+
+	if err := filter.NewValidator(s).ValidateFilters(spaceId, parsed); err != nil {
+		return nil, err
+	}
+
+	filters := make([]*model.BlockContentDataviewFilter, 0, len(parsed.Filters))
+	for _, f := range parsed.Filters {
+		dataviewFilter := &model.BlockContentDataviewFilter{
+			RelationKey: f.PropertyKey,
+			Condition:   f.Condition,
+		}
+		if f.Condition != model.BlockContentDataviewFilter_Empty && f.Condition != model.BlockContentDataviewFilter_NotEmpty {
+			dataviewFilter.Value = pbtypes.ToValue(f.Value)
+		}
+		filters = append(filters, dataviewFilter)
+	}
+	return filters, nil
+}
+
+func (s *Service) buildViewSorts(spaceId string, requests []apimodel.ViewSortRequest) ([]*model.BlockContentDataviewSort, error) {
+	sorts := make([]*model.BlockContentDataviewSort, 0, len(requests))
+	for _, request := range requests {
+		property, err := s.resolveViewProperty(spaceId, request.PropertyKey)
+		if err != nil {
+			return nil, err
+		}
+		sortType := request.SortType
+		if sortType == "" {
+			sortType = apimodel.SortTypeAsc
+		}
+		sorts = append(sorts, &model.BlockContentDataviewSort{
+			RelationKey: property.RelationKey,
+			Type:        sortTypes[sortType],
+			IncludeTime: property.Format == apimodel.PropertyFormatDate,
+		})
+	}
+	return sorts, nil
+}
+
+// resolveGroupBy checks that the view of the layout can be grouped by the property and returns its relation key
+func (s *Service) resolveGroupBy(spaceId string, viewType model.BlockContentDataviewViewType, key string) (string, error) {
+	if key == "" {
+		return "", nil
+	}
+	formats, ok := groupByFormats[viewType]
+	if !ok {
+		return "", util.ErrBadInput("group_by is only supported for kanban and calendar layouts")
+	}
+	property, err := s.resolveViewProperty(spaceId, key)
+	if err != nil {
+		return "", err
+	}
+	if !slices.Contains(formats, property.Format) {
+		return "", util.ErrBadInput(fmt.Sprintf("the view can't be grouped by property %q of format %q", key, property.Format))
+	}
+	return property.RelationKey, nil
+}
+
+// resolveViewProperties resolves the property keys to relation keys, dropping duplicates
+func (s *Service) resolveViewProperties(spaceId string, keys []string) ([]string, error) {
+	relationKeys := make([]string, 0, len(keys))
+	for _, key := range keys {
+		property, err := s.resolveViewProperty(spaceId, key)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(relationKeys, property.RelationKey) {
+			relationKeys = append(relationKeys, property.RelationKey)
+		}
+	}
+	return relationKeys, nil
+}
+
+func (s *Service) resolveViewProperty(spaceId string, key string) (*apimodel.Property, error) {
+	propertyMap := s.GetCachedProperties(spaceId)
+	rk, found := s.ResolvePropertyApiKey(propertyMap, key)
+	if !found {
+		return nil, util.ErrBadInput(fmt.Sprintf("property %q not found", key))
+	}
+	return propertyMap[rk], nil
+}
+
+// ensureDataviewRelations adds the relations missing from the dataview, so they can be shown in its views
+func (s *Service) ensureDataviewRelations(ctx context.Context, listId string, dataview *model.BlockContentDataview, relationKeys []string) error {
+	var missing []string
+	for _, key := range relationKeys {
+		if !pbtypes.RelationLinks(dataview.RelationLinks).Has(key) {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	resp := s.mw.BlockDataviewRelationAdd(ctx, &pb.RpcBlockDataviewRelationAddRequest{
+		ContextId:    listId,
+		BlockId:      dataviewBlockId,
+		RelationKeys: missing,
+	})
+	if resp.Error != nil && resp.Error.Code != pb.RpcBlockDataviewRelationAddResponseError_NULL {
+		return fmt.Errorf("add relations to dataview: %s", resp.Error.Description)
+	}
+	return nil
+}
+
+func (s *Service) replaceViewFilters(ctx context.Context, listId string, view *model.BlockContentDataviewView, filters []*model.BlockContentDataviewFilter) error {
+	if len(view.Filters) > 0 {
+		ids := make([]string, 0, len(view.Filters))
+		for _, f := range view.Filters {
+			ids = append(ids, f.Id)
+		}
+		resp := s.mw.BlockDataviewFilterRemove(ctx, &pb.RpcBlockDataviewFilterRemoveRequest{
+			ContextId: listId,
+			BlockId:   dataviewBlockId,
+			ViewId:    view.Id,
+			Ids:       ids,
+		})
+		if resp.Error != nil && resp.Error.Code != pb.RpcBlockDataviewFilterRemoveResponseError_NULL {
+			return ErrFailedUpdateView
+		}
+	}
+
+	for _, f := range filters {
+		resp := s.mw.BlockDataviewFilterAdd(ctx, &pb.RpcBlockDataviewFilterAddRequest{
+			ContextId: listId,
+			BlockId:   dataviewBlockId,
+			ViewId:    view.Id,
+			Filter:    f,
+		})
+		if resp.Error != nil && resp.Error.Code != pb.RpcBlockDataviewFilterAddResponseError_NULL {
+			return ErrFailedUpdateView
+		}
+	}
+	return nil
+}
+
+func (s *Service) replaceViewSorts(ctx context.Context, listId string, view *model.BlockContentDataviewView, sorts []*model.BlockContentDataviewSort) error {
+	if len(view.Sorts) > 0 {
+		ids := make([]string, 0, len(view.Sorts))
+		for _, srt := range view.Sorts {
+			ids = append(ids, srt.Id)
+		}
+		resp := s.mw.BlockDataviewSortRemove(ctx, &pb.RpcBlockDataviewSortRemoveRequest{
+			ContextId: listId,
+			BlockId:   dataviewBlockId,
+			ViewId:    view.Id,
+			Ids:       ids,
+		})
+		if resp.Error != nil && resp.Error.Code != pb.RpcBlockDataviewSortRemoveResponseError_NULL {
+			return ErrFailedUpdateView
+		}
+	}
+
+	for _, srt := range sorts {
+		resp := s.mw.BlockDataviewSortAdd(ctx, &pb.RpcBlockDataviewSortAddRequest{
+			ContextId: listId,
+			BlockId:   dataviewBlockId,
+			ViewId:    view.Id,
+			Sort:      srt,
+		})
+		if resp.Error != nil && resp.Error.Code != pb.RpcBlockDataviewSortAddResponseError_NULL {
+			return ErrFailedUpdateView
+		}
+	}
+	return nil
+}
+
+// setViewRelations shows the visible relations in the given order and hides all others
+func (s *Service) setViewRelations(ctx context.Context, listId string, view *model.BlockContentDataviewView, visible []string) error {
+	keys := make([]string, 0, len(view.Relations))
+	current := make(map[string]*model.BlockContentDataviewRelation, len(view.Relations))
+	for _, relation := range view.Relations {
+		keys = append(keys, relation.Key)
+		current[relation.Key] = relation
+	}
+
+	relations := arrangeViewRelations(view.Relations, keys, visible)
+	order := make([]string, 0, len(relations))
+	for _, relation := range relations {
+		order = append(order, relation.Key)
+		if existing, ok := current[relation.Key]; ok && existing.IsVisible == relation.IsVisible {
+			continue
+		}
+		resp := s.mw.BlockDataviewViewRelationReplace(ctx, &pb.RpcBlockDataviewViewRelationReplaceRequest{
+			ContextId:   listId,
+			BlockId:     dataviewBlockId,
+			ViewId:      view.Id,
+			RelationKey: relation.Key,
+			Relation:    relation,
+		})
+		if resp.Error != nil && resp.Error.Code != pb.RpcBlockDataviewViewRelationReplaceResponseError_NULL {
+			return ErrFailedUpdateView
+		}
+	}
+
+	resp := s.mw.BlockDataviewViewRelationSort(ctx, &pb.RpcBlockDataviewViewRelationSortRequest{
+		ContextId:    listId,
+		BlockId:      dataviewBlockId,
+		ViewId:       view.Id,
+		RelationKeys: order,
+	})
+	if resp.Error != nil && resp.Error.Code != pb.RpcBlockDataviewViewRelationSortResponseError_NULL {
+		return ErrFailedUpdateView
+	}
+	return nil
+}
+
+// arrangeViewRelations returns the view relations with the visible keys first, in the given order, followed by
+// the remaining keys as hidden relations. Settings of the current relations, such as the width, are preserved.
+func arrangeViewRelations(current []*model.BlockContentDataviewRelation, keys []string, visible []string) []*model.BlockContentDataviewRelation {
+	byKey := make(map[string]*model.BlockContentDataviewRelation, len(current))
+	for _, relation := range current {
+		byKey[relation.Key] = relation
+	}
+	relation := func(key string, isVisible bool) *model.BlockContentDataviewRelation {
+		var r model.BlockContentDataviewRelation
+		if existing, ok := byKey[key]; ok {
+			r = *existing
+		}
+		r.Key = key
+		r.IsVisible = isVisible
+		return &r
+	}
+
+	relations := make([]*model.BlockContentDataviewRelation, 0, len(keys)+len(visible))
+	for _, key := range visible {
+		relations = append(relations, relation(key, true))
+	}
+	for _, key := range keys {
+		if !slices.Contains(visible, key) {
+			relations = append(relations, relation(key, false))
+		}
+	}
+	return relations
+}
+
+// getViewFromModel converts a dataview view to the API representation
+func (s *Service) getViewFromModel(view *model.BlockContentDataviewView) apimodel.View {
+	var filters []apimodel.Filter
+	for _, f := range view.Filters {
+		if f.Condition == model.BlockContentDataviewFilter_None {
+			continue
+		}
+		apiCond, _ := filter.ToApiCondition(f.Condition)
+		filters = append(filters, apimodel.Filter{
+			Id:          f.Id,
+			PropertyKey: f.RelationKey,
+			Format:      RelationFormatToPropertyFormat[f.Format],
+			Condition:   apiCond,
+			Value:       f.Value.GetStringValue(),
+		})
+	}
+	var sorts []apimodel.Sort
+	for _, srt := range view.Sorts {
+		sorts = append(sorts, apimodel.Sort{
+			Id:          srt.Id,
+			PropertyKey: srt.RelationKey,
+			Format:      RelationFormatToPropertyFormat[srt.Format],
+			SortType:    strcase.ToSnake(model.BlockContentDataviewSortType_name[int32(srt.Type)]),
+		})
+	}
+	var properties []string
+	for _, relation := range view.Relations {
+		if relation.IsVisible {
+			properties = append(properties, relation.Key)
+		}
+	}
+	var groupBy string
+	if _, ok := groupByFormats[view.Type]; ok {
+		groupBy = view.GroupRelationKey
+	}
+	return apimodel.View{
+		Id:         view.Id,
+		Name:       view.Name,
+		Layout:     s.mapDataviewTypeName(view.Type),
+		Filters:    filters,
+		Sorts:      sorts,
+		Properties: properties,
+		GroupBy:    groupBy,
+	}
+}
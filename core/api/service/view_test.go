@@ -0,0 +1,299 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	apimodel "github.com/anyproto/anytype-heart/core/api/model"
+	"github.com/anyproto/anytype-heart/core/api/util"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const mockedNewViewId = "mocked-new-view-id"
+
+func mockedListView(views ...*model.BlockContentDataviewView) *pb.RpcObjectShowResponse {
+	return &pb.RpcObjectShowResponse{
+		Error: &pb.RpcObjectShowResponseError{Code: pb.RpcObjectShowResponseError_NULL},
+		ObjectView: &model.ObjectView{
+			Blocks: []*model.Block{{
+				Id: dataviewBlockId,
+				Content: &model.BlockContentOfDataview{Dataview: &model.BlockContentDataview{
+					Views: views,
+					RelationLinks: []*model.RelationLink{
+						{Key: bundle.RelationKeyName.String()},
+						{Key: "checkbox_prop"},
+					},
+				}},
+			}},
+		},
+	}
+}
+
+func TestViewService_CreateListView(t *testing.T) {
+	t.Run("view is created with validated filters, sorts and properties", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		fx.populateCache(mockedSpaceId)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(mockedListView()).Once()
+		fx.mwMock.On("BlockDataviewRelationAdd", mock.Anything, &pb.RpcBlockDataviewRelationAddRequest{
+			ContextId:    mockedListId,
+			BlockId:      dataviewBlockId,
+			RelationKeys: []string{"select_prop"},
+		}).Return(&pb.RpcBlockDataviewRelationAddResponse{
+			Error: &pb.RpcBlockDataviewRelationAddResponseError{Code: pb.RpcBlockDataviewRelationAddResponseError_NULL},
+		}).Once()
+
+		var created *model.BlockContentDataviewView
+		fx.mwMock.On("BlockDataviewViewCreate", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			req := args.Get(1).(*pb.RpcBlockDataviewViewCreateRequest)
+			require.Equal(t, mockedListId, req.ContextId)
+			created = req.View
+			created.Id = mockedNewViewId
+		}).Return(&pb.RpcBlockDataviewViewCreateResponse{
+			Error:  &pb.RpcBlockDataviewViewCreateResponseError{Code: pb.RpcBlockDataviewViewCreateResponseError_NULL},
+			ViewId: mockedNewViewId,
+		}).Once()
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(func(context.Context, *pb.RpcObjectShowRequest) *pb.RpcObjectShowResponse {
+			return mockedListView(created)
+		}).Once()
+
+		// when
+		view, err := fx.service.CreateListView(ctx, mockedSpaceId, mockedListId, apimodel.CreateViewRequest{
+			Name:   "Board",
+			Layout: apimodel.ViewLayoutKanban,
+			Filters: []apimodel.ViewFilterRequest{
+				{PropertyKey: "checkbox_prop", Condition: apimodel.FilterConditionEq, Value: false},
+				{PropertyKey: "text_prop", Condition: apimodel.FilterConditionEmpty},
+			},
+			Sorts:      []apimodel.ViewSortRequest{{PropertyKey: "date_prop", SortType: apimodel.SortTypeDesc}},
+			Properties: []string{"select_prop", "name"},
+			GroupBy:    "select_prop",
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, model.BlockContentDataviewView_Kanban, created.Type)
+		require.Equal(t, []*model.BlockContentDataviewFilter{
+			{RelationKey: "checkbox_prop", Condition: model.BlockContentDataviewFilter_Equal, Value: pbtypes.Bool(false)},
+			{RelationKey: "text_prop", Condition: model.BlockContentDataviewFilter_Empty},
+		}, created.Filters)
+		require.Equal(t, []*model.BlockContentDataviewSort{
+			{RelationKey: "date_prop", Type: model.BlockContentDataviewSort_Desc, IncludeTime: true},
+		}, created.Sorts)
+		require.Equal(t, []*model.BlockContentDataviewRelation{
+			{Key: "select_prop", IsVisible: true},
+			{Key: bundle.RelationKeyName.String(), IsVisible: true},
+			{Key: "checkbox_prop"},
+		}, created.Relations)
+
+		require.Equal(t, mockedNewViewId, view.Id)
+		require.Equal(t, apimodel.ViewLayoutKanban, view.Layout)
+		require.Equal(t, "select_prop", view.GroupBy)
+		require.Equal(t, []string{"select_prop", bundle.RelationKeyName.String()}, view.Properties)
+	})
+
+	t.Run("condition not valid for the property format", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		fx.populateCache(mockedSpaceId)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(mockedListView()).Once()
+
+		// when
+		view, err := fx.service.CreateListView(ctx, mockedSpaceId, mockedListId, apimodel.CreateViewRequest{
+			Filters: []apimodel.ViewFilterRequest{
+				{PropertyKey: "text_prop", Condition: apimodel.FilterConditionGt, Value: "a"},
+			},
+		})
+
+		// then
+		require.ErrorIs(t, err, util.ErrBad)
+		require.Nil(t, view)
+	})
+
+	t.Run("group by is not supported for grid", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		fx.populateCache(mockedSpaceId)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(mockedListView()).Once()
+
+		// when
+		_, err := fx.service.CreateListView(ctx, mockedSpaceId, mockedListId, apimodel.CreateViewRequest{
+			GroupBy: "select_prop",
+		})
+
+		// then
+		require.ErrorIs(t, err, util.ErrBad)
+	})
+}
+
+func TestViewService_UpdateListView(t *testing.T) {
+	t.Run("filters are replaced and properties are rearranged", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		fx.populateCache(mockedSpaceId)
+
+		view := &model.BlockContentDataviewView{
+			Id:      mockedViewId,
+			Type:    model.BlockContentDataviewView_Table,
+			Filters: []*model.BlockContentDataviewFilter{{Id: "filter-1", RelationKey: "text_prop", Condition: model.BlockContentDataviewFilter_NotEmpty}},
+			Relations: []*model.BlockContentDataviewRelation{
+				{Key: bundle.RelationKeyName.String(), IsVisible: true, Width: 200},
+				{Key: "checkbox_prop"},
+			},
+		}
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(mockedListView(view)).Twice()
+		fx.mwMock.On("BlockDataviewFilterRemove", mock.Anything, &pb.RpcBlockDataviewFilterRemoveRequest{
+			ContextId: mockedListId,
+			BlockId:   dataviewBlockId,
+			ViewId:    mockedViewId,
+			Ids:       []string{"filter-1"},
+		}).Return(&pb.RpcBlockDataviewFilterRemoveResponse{
+			Error: &pb.RpcBlockDataviewFilterRemoveResponseError{Code: pb.RpcBlockDataviewFilterRemoveResponseError_NULL},
+		}).Once()
+		fx.mwMock.On("BlockDataviewFilterAdd", mock.Anything, &pb.RpcBlockDataviewFilterAddRequest{
+			ContextId: mockedListId,
+			BlockId:   dataviewBlockId,
+			ViewId:    mockedViewId,
+			Filter:    &model.BlockContentDataviewFilter{RelationKey: "number_prop", Condition: model.BlockContentDataviewFilter_Greater, Value: pbtypes.Float64(5)},
+		}).Return(&pb.RpcBlockDataviewFilterAddResponse{
+			Error: &pb.RpcBlockDataviewFilterAddResponseError{Code: pb.RpcBlockDataviewFilterAddResponseError_NULL},
+		}).Once()
+		fx.mwMock.On("BlockDataviewViewRelationReplace", mock.Anything, &pb.RpcBlockDataviewViewRelationReplaceRequest{
+			ContextId:   mockedListId,
+			BlockId:     dataviewBlockId,
+			ViewId:      mockedViewId,
+			RelationKey: bundle.RelationKeyName.String(),
+			Relation:    &model.BlockContentDataviewRelation{Key: bundle.RelationKeyName.String(), Width: 200},
+		}).Return(&pb.RpcBlockDataviewViewRelationReplaceResponse{
+			Error: &pb.RpcBlockDataviewViewRelationReplaceResponseError{Code: pb.RpcBlockDataviewViewRelationReplaceResponseError_NULL},
+		}).Once()
+		fx.mwMock.On("BlockDataviewViewRelationReplace", mock.Anything, &pb.RpcBlockDataviewViewRelationReplaceRequest{
+			ContextId:   mockedListId,
+			BlockId:     dataviewBlockId,
+			ViewId:      mockedViewId,
+			RelationKey: "checkbox_prop",
+			Relation:    &model.BlockContentDataviewRelation{Key: "checkbox_prop", IsVisible: true},
+		}).Return(&pb.RpcBlockDataviewViewRelationReplaceResponse{
+			Error: &pb.RpcBlockDataviewViewRelationReplaceResponseError{Code: pb.RpcBlockDataviewViewRelationReplaceResponseError_NULL},
+		}).Once()
+		fx.mwMock.On("BlockDataviewViewRelationSort", mock.Anything, &pb.RpcBlockDataviewViewRelationSortRequest{
+			ContextId:    mockedListId,
+			BlockId:      dataviewBlockId,
+			ViewId:       mockedViewId,
+			RelationKeys: []string{"checkbox_prop", bundle.RelationKeyName.String()},
+		}).Return(&pb.RpcBlockDataviewViewRelationSortResponse{
+			Error: &pb.RpcBlockDataviewViewRelationSortResponseError{Code: pb.RpcBlockDataviewViewRelationSortResponseError_NULL},
+		}).Once()
+
+		// when
+		filters := []apimodel.ViewFilterRequest{{PropertyKey: "number_prop", Condition: apimodel.FilterConditionGt, Value: float64(5)}}
+		properties := []string{"checkbox_prop"}
+		result, err := fx.service.UpdateListView(ctx, mockedSpaceId, mockedListId, mockedViewId, apimodel.UpdateViewRequest{
+			Filters:    &filters,
+			Properties: &properties,
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, mockedViewId, result.Id)
+	})
+
+	t.Run("layout and grouping are updated", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		fx.populateCache(mockedSpaceId)
+
+		view := &model.BlockContentDataviewView{Id: mockedViewId, Name: "All", Type: model.BlockContentDataviewView_Table}
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(mockedListView(view)).Twice()
+		fx.mwMock.On("BlockDataviewViewUpdate", mock.Anything, &pb.RpcBlockDataviewViewUpdateRequest{
+			ContextId: mockedListId,
+			BlockId:   dataviewBlockId,
+			ViewId:    mockedViewId,
+			View:      &model.BlockContentDataviewView{Id: mockedViewId, Name: "All", Type: model.BlockContentDataviewView_Calendar, GroupRelationKey: "date_prop"},
+		}).Return(&pb.RpcBlockDataviewViewUpdateResponse{
+			Error: &pb.RpcBlockDataviewViewUpdateResponseError{Code: pb.RpcBlockDataviewViewUpdateResponseError_NULL},
+		}).Once()
+
+		// when
+		layout := apimodel.ViewLayoutCalendar
+		groupBy := "date_prop"
+		_, err := fx.service.UpdateListView(ctx, mockedSpaceId, mockedListId, mockedViewId, apimodel.UpdateViewRequest{
+			Layout:  &layout,
+			GroupBy: &groupBy,
+		})
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("view not found", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(mockedListView()).Once()
+
+		// when
+		_, err := fx.service.UpdateListView(ctx, mockedSpaceId, mockedListId, mockedViewId, apimodel.UpdateViewRequest{})
+
+		// then
+		require.ErrorIs(t, err, ErrViewNotFound)
+	})
+}
+
+func TestViewService_DeleteListView(t *testing.T) {
+	t.Run("view is deleted", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(mockedListView(
+			&model.BlockContentDataviewView{Id: "other-view-id"},
+			&model.BlockContentDataviewView{Id: mockedViewId, Name: "Old"},
+		)).Once()
+		fx.mwMock.On("BlockDataviewViewDelete", mock.Anything, &pb.RpcBlockDataviewViewDeleteRequest{
+			ContextId: mockedListId,
+			BlockId:   dataviewBlockId,
+			ViewId:    mockedViewId,
+		}).Return(&pb.RpcBlockDataviewViewDeleteResponse{
+			Error: &pb.RpcBlockDataviewViewDeleteResponseError{Code: pb.RpcBlockDataviewViewDeleteResponseError_NULL},
+		}).Once()
+
+		// when
+		view, err := fx.service.DeleteListView(ctx, mockedSpaceId, mockedListId, mockedViewId)
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, "Old", view.Name)
+	})
+
+	t.Run("last view can't be deleted", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(mockedListView(
+			&model.BlockContentDataviewView{Id: mockedViewId},
+		)).Once()
+
+		// when
+		_, err := fx.service.DeleteListView(ctx, mockedSpaceId, mockedListId, mockedViewId)
+
+		// then
+		require.ErrorIs(t, err, util.ErrBad)
+	})
+}
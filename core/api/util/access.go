@@ -0,0 +1,30 @@
+package util
+
+import (
+	"context"
+	"slices"
+)
+
+type allowedSpaceIdsKey struct{}
+
+// ContextWithAllowedSpaceIds restricts requests made with the returned context to the given spaces.
+// A nil list means that all spaces are allowed
+func ContextWithAllowedSpaceIds(ctx context.Context, spaceIds []string) context.Context {
+	return context.WithValue(ctx, allowedSpaceIdsKey{}, spaceIds)
+}
+
+// FilterAllowedSpaceIds returns the subset of spaceIds the context is allowed to access
+func FilterAllowedSpaceIds(ctx context.Context, spaceIds []string) []string {
+	allowed, _ := ctx.Value(allowedSpaceIdsKey{}).([]string)
+	if len(allowed) == 0 {
+		return spaceIds
+	}
+	return slices.DeleteFunc(slices.Clone(spaceIds), func(spaceId string) bool {
+		return !slices.Contains(allowed, spaceId)
+	})
+}
+
+// IsSpaceAllowed reports whether the context is allowed to access the space
+func IsSpaceAllowed(ctx context.Context, spaceId string) bool {
+	return len(FilterAllowedSpaceIds(ctx, []string{spaceId})) == 1
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/core/event"
 	"github.com/anyproto/anytype-heart/core/session"
+	walletComp "github.com/anyproto/anytype-heart/core/wallet"
 	"github.com/anyproto/anytype-heart/pkg/lib/logging"
 )
 
@@ -28,6 +29,8 @@ type Service struct {
 	// session signing key for session tokens
 	sessionSigningKey []byte
 	sessionsByAppHash map[string]string
+	// access requested along with a challenge, applied to the app link once the challenge is solved
+	challengeAccess map[string]walletComp.AppLinkAccess
 
 	rootPath                string
 	fulltextPrimaryLanguage string
@@ -46,6 +49,7 @@ func New() *Service {
 		sessions:          session.New(),
 		traceRecorder:     &traceRecorder{},
 		sessionsByAppHash: make(map[string]string),
+		challengeAccess:   make(map[string]walletComp.AppLinkAccess),
 	}
 	m := newMigrationManager(s)
 	s.migrationManager = m
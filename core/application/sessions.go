@@ -14,7 +14,9 @@ import (
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 )
 
-func (s *Service) CreateSession(req *pb.RpcWalletCreateSessionRequest) (token string, accountId string, err error) {
+// CreateSession starts a new session. In case of appKey auth the app link is returned as well and the active session
+// of the app is restored instead of starting a new one
+func (s *Service) CreateSession(req *pb.RpcWalletCreateSessionRequest) (token string, accountId string, appLink *walletComp.AppLinkInfo, err error) {
 	// test if mnemonic is correct
 	mnemonic := req.GetMnemonic()
 	appKey := req.GetAppKey()
@@ -24,36 +26,36 @@ func (s *Service) CreateSession(req *pb.RpcWalletCreateSessionRequest) (token st
 	if appKey != "" {
 		app := s.GetApp()
 		if app == nil {
-			return "", "", ErrApplicationIsNotRunning
+			return "", "", nil, ErrApplicationIsNotRunning
 		}
 		wallet := app.Component(walletComp.CName)
 		if wallet == nil {
-			return "", "", fmt.Errorf("appToken auth not yet supported for the main app")
+			return "", "", nil, fmt.Errorf("appToken auth not yet supported for the main app")
 		}
 		w := wallet.(walletComp.Wallet)
 		appLink, err := w.ReadAppLink(appKey)
 		if err != nil {
-			return "", "", err
+			return "", "", nil, err
 		}
 		log.Infof("appLink auth %s", appLink.AppName)
-		token, err := s.sessions.StartSession(s.sessionSigningKey, model.AccountAuthLocalApiScope(appLink.Scope)) // nolint:gosec
+		if err = w.TouchAppLink(appLink.AppHash); err != nil {
+			log.Warnf("failed to record app link usage: %v", err)
+		}
+		token, err := s.appLinkSession(appLink)
 		if err != nil {
-			return "", "", err
+			return "", "", nil, err
 		}
-		s.lock.Lock()
-		defer s.lock.Unlock()
-		s.sessionsByAppHash[appLink.AppHash] = token
 
-		return token, w.Account().SignKey.GetPublic().Account(), nil
+		return token, w.Account().SignKey.GetPublic().Account(), appLink, nil
 	}
 
 	if providedToken != "" {
 		scope, err := s.sessions.ValidateToken(s.sessionSigningKey, providedToken)
 		if err != nil {
-			return "", "", err
+			return "", "", nil, err
 		}
 		token, err = s.sessions.StartSession(s.sessionSigningKey, scope) // nolint:gosec
-		return token, "", err
+		return token, "", nil, err
 	}
 
 	var derived crypto.DerivationResult
@@ -61,30 +63,48 @@ func (s *Service) CreateSession(req *pb.RpcWalletCreateSessionRequest) (token st
 	if accountKey != "" {
 		derived, err = core.WalletDeriveFromAccountMasterNode(accountKey)
 		if err != nil {
-			return "", "", errors.Join(ErrBadInput, fmt.Errorf("invalid account key: %w", err))
+			return "", "", nil, errors.Join(ErrBadInput, fmt.Errorf("invalid account key: %w", err))
 		}
 	} else {
 		if s.derivedKeys == nil {
-			return "", "", ErrWalletNotInitialized
+			return "", "", nil, ErrWalletNotInitialized
 		}
 
 		// Derive keys from provided mnemonic to verify it's correct
 		derived, err = core.WalletAccountAt(mnemonic, 0)
 		if err != nil {
-			return "", "", errors.Join(ErrBadInput, fmt.Errorf("invalid mnemonic"))
+			return "", "", nil, errors.Join(ErrBadInput, fmt.Errorf("invalid mnemonic"))
 		}
 	}
 
 	// Compare account IDs to verify we are at the same account
 	if derived.Identity.GetPublic().Account() != s.derivedKeys.Identity.GetPublic().Account() {
-		return "", "", errors.Join(ErrBadInput, fmt.Errorf("incorrect mnemonic"))
+		return "", "", nil, errors.Join(ErrBadInput, fmt.Errorf("incorrect mnemonic"))
 	}
 	token, err = s.sessions.StartSession(s.sessionSigningKey, model.AccountAuth_Full)
 	if err != nil {
-		return "", "", err
+		return "", "", nil, err
 	}
 	// todo: account is empty, to be implemented with GO-1854
-	return token, "", nil
+	return token, "", nil, nil
+}
+
+// appLinkSession returns the active session of the app link, so clients that re-validate their app key
+// periodically don't pile up sessions. A new session is started if there is no valid one
+func (s *Service) appLinkSession(appLink *walletComp.AppLinkInfo) (string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if token, ok := s.sessionsByAppHash[appLink.AppHash]; ok {
+		if _, err := s.sessions.ValidateToken(s.sessionSigningKey, token); err == nil {
+			return token, nil
+		}
+	}
+	token, err := s.sessions.StartSession(s.sessionSigningKey, model.AccountAuthLocalApiScope(appLink.Scope)) // nolint:gosec
+	if err != nil {
+		return "", err
+	}
+	s.sessionsByAppHash[appLink.AppHash] = token
+	return token, nil
 }
 
 func (s *Service) CloseSession(req *pb.RpcWalletCloseSessionRequest) error {
@@ -98,7 +118,7 @@ func (s *Service) ValidateSessionToken(token string) (model.AccountAuthLocalApiS
 	return s.sessions.ValidateToken(s.sessionSigningKey, token)
 }
 
-func (s *Service) LinkLocalStartNewChallenge(scope model.AccountAuthLocalApiScope, clientInfo *pb.EventAccountLinkChallengeClientInfo) (id string, err error) {
+func (s *Service) LinkLocalStartNewChallenge(scope model.AccountAuthLocalApiScope, clientInfo *pb.EventAccountLinkChallengeClientInfo, access walletComp.AppLinkAccess) (id string, err error) {
 	if s.app == nil {
 		return "", ErrApplicationIsNotRunning
 	}
@@ -107,11 +127,17 @@ func (s *Service) LinkLocalStartNewChallenge(scope model.AccountAuthLocalApiScop
 	if err != nil {
 		return "", err
 	}
+	s.lock.Lock()
+	s.challengeAccess[id] = access
+	s.lock.Unlock()
 	s.eventSender.Broadcast(event.NewEventSingleMessage("", &pb.EventMessageValueOfAccountLinkChallenge{
 		AccountLinkChallenge: &pb.EventAccountLinkChallenge{
 			Challenge:  value,
 			ClientInfo: clientInfo,
 			Scope:      scope,
+			SpaceIds:   access.SpaceIds,
+			ReadOnly:   access.ReadOnly,
+			ExpireAt:   access.ExpireAt,
 		},
 	}))
 	return id, nil
@@ -131,7 +157,11 @@ func (s *Service) LinkLocalSolveChallenge(req *pb.RpcAccountLocalLinkSolveChalle
 	if name == "" {
 		name = clientInfo.ProcessName
 	}
-	appInfo, err := wallet.PersistAppLink(name, scope)
+	s.lock.Lock()
+	access := s.challengeAccess[req.ChallengeId]
+	delete(s.challengeAccess, req.ChallengeId)
+	s.lock.Unlock()
+	appInfo, err := wallet.PersistAppLink(name, scope, access)
 	if err != nil {
 		return token, appKey, err
 	}
@@ -154,21 +184,30 @@ func (s *Service) LinkLocalCreateApp(req *pb.RpcAccountLocalLinkCreateAppRequest
 	}
 
 	wallet := s.app.Component(walletComp.CName).(walletComp.Wallet)
-	appInfo, err := wallet.PersistAppLink(req.App.AppName, req.App.Scope)
-	return appInfo.AppKey, err
+	appInfo, err := wallet.PersistAppLink(req.App.AppName, req.App.Scope, walletComp.AppLinkAccess{
+		SpaceIds: req.SpaceIds,
+		ReadOnly: req.ReadOnly,
+		ExpireAt: req.App.ExpireAt,
+	})
+	if err != nil {
+		return "", err
+	}
+	return appInfo.AppKey, nil
 }
 
-func (s *Service) LinkLocalListApps() ([]*model.AccountAuthAppInfo, error) {
+// LinkLocalListApps returns the apps along with their access, in the same order
+func (s *Service) LinkLocalListApps() ([]*model.AccountAuthAppInfo, []*pb.RpcAccountLocalLinkAppAccess, error) {
 	if s.app == nil {
-		return nil, ErrApplicationIsNotRunning
+		return nil, nil, ErrApplicationIsNotRunning
 	}
 
 	wallet := s.app.Component(walletComp.CName).(walletComp.Wallet)
 	links, err := wallet.ListAppLinks()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	appsList := make([]*model.AccountAuthAppInfo, len(links))
+	accessList := make([]*pb.RpcAccountLocalLinkAppAccess, len(links))
 	s.lock.RLock()
 	defer s.lock.RUnlock()
 
@@ -186,8 +225,19 @@ func (s *Service) LinkLocalListApps() ([]*model.AccountAuthAppInfo, error) {
 			Scope:     model.AccountAuthLocalApiScope(app.Scope),
 			IsActive:  isActive,
 		}
+		accessList[i] = AppLinkAccessToProto(app)
+	}
+	return appsList, accessList, nil
+}
+
+func AppLinkAccessToProto(app *walletComp.AppLinkInfo) *pb.RpcAccountLocalLinkAppAccess {
+	return &pb.RpcAccountLocalLinkAppAccess{
+		AppHash:    app.AppHash,
+		SpaceIds:   app.SpaceIds,
+		ReadOnly:   app.ReadOnly,
+		ExpireAt:   app.ExpireAt,
+		LastUsedAt: app.LastUsedAt,
 	}
-	return appsList, nil
 }
 
 func (s *Service) LinkLocalRevokeApp(req *pb.RpcAccountLocalLinkRevokeAppRequest) error {
@@ -13,7 +13,7 @@ func TestCreateSession(t *testing.T) {
 		t.Run("with not initialized app expect error", func(t *testing.T) {
 			s := New()
 
-			_, _, err := s.CreateSession(&pb.RpcWalletCreateSessionRequest{
+			_, _, _, err := s.CreateSession(&pb.RpcWalletCreateSessionRequest{
 				Auth: &pb.RpcWalletCreateSessionRequestAuthOfAppKey{
 					AppKey: "appKey",
 				},
@@ -0,0 +1,38 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/anyproto/anytype-heart/core/auditlog"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+func (mw *Middleware) SpaceAuditLogExport(cctx context.Context, req *pb.RpcSpaceAuditLogExportRequest) *pb.RpcSpaceAuditLogExportResponse {
+	exportReq := auditlog.Request{
+		SpaceId:        req.SpaceId,
+		Path:           req.Path,
+		Format:         auditlog.Format(req.Format),
+		ParticipantIds: req.ParticipantIds,
+	}
+	if req.DateFrom > 0 {
+		exportReq.From = time.Unix(req.DateFrom, 0)
+	}
+	if req.DateTo > 0 {
+		exportReq.To = time.Unix(req.DateTo, 0)
+	}
+	processId, path, err := mustService[auditlog.Service](mw).Export(cctx, exportReq)
+	code := mapErrorCode(err,
+		errToCode(auditlog.ErrEmptySpaceId, pb.RpcSpaceAuditLogExportResponseError_BAD_INPUT),
+		errToCode(auditlog.ErrEmptyPath, pb.RpcSpaceAuditLogExportResponseError_BAD_INPUT),
+		errToCode(auditlog.ErrInvalidPeriod, pb.RpcSpaceAuditLogExportResponseError_BAD_INPUT),
+	)
+	return &pb.RpcSpaceAuditLogExportResponse{
+		ProcessId: processId,
+		Path:      path,
+		Error: &pb.RpcSpaceAuditLogExportResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
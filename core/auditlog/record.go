@@ -0,0 +1,175 @@
+package auditlog
+
+import (
+	"slices"
+	"time"
+
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+// Record describes a single change of an object
+type Record struct {
+	Time          time.Time `json:"time"`
+	SpaceId       string    `json:"spaceId"`
+	ObjectId      string    `json:"objectId"`
+	ChangeId      string    `json:"changeId"`
+	Identity      string    `json:"identity"`
+	ParticipantId string    `json:"participantId"`
+	// ChangeTypes are names of change contents, e.g. blockCreate or detailsSet
+	ChangeTypes  []string `json:"changeTypes"`
+	BlockIds     []string `json:"blockIds,omitempty"`
+	RelationKeys []string `json:"relationKeys,omitempty"`
+}
+
+const changeTypeObjectCreate = "objectCreate"
+
+// summarizeChange fills change types and affected blocks and relations from the change content
+func summarizeChange(rec *Record, change *pb.Change) {
+	if change == nil {
+		return
+	}
+	for _, content := range change.Content {
+		changeType, blockIds, relationKeys := summarizeContent(content)
+		if changeType == "" {
+			continue
+		}
+		rec.ChangeTypes = appendUnique(rec.ChangeTypes, changeType)
+		rec.BlockIds = appendUnique(rec.BlockIds, blockIds...)
+		rec.RelationKeys = appendUnique(rec.RelationKeys, relationKeys...)
+	}
+}
+
+func summarizeContent(content *pb.ChangeContent) (changeType string, blockIds, relationKeys []string) {
+	switch v := content.GetValue().(type) {
+	case *pb.ChangeContentValueOfBlockCreate:
+		for _, block := range v.BlockCreate.GetBlocks() {
+			blockIds = append(blockIds, block.Id)
+		}
+		return "blockCreate", blockIds, nil
+	case *pb.ChangeContentValueOfBlockUpdate:
+		for _, event := range v.BlockUpdate.GetEvents() {
+			blockIds = append(blockIds, eventBlockIds(event)...)
+		}
+		return "blockUpdate", blockIds, nil
+	case *pb.ChangeContentValueOfBlockRemove:
+		return "blockRemove", v.BlockRemove.GetIds(), nil
+	case *pb.ChangeContentValueOfBlockMove:
+		return "blockMove", v.BlockMove.GetIds(), nil
+	case *pb.ChangeContentValueOfBlockDuplicate:
+		return "blockDuplicate", v.BlockDuplicate.GetIds(), nil
+	case *pb.ChangeContentValueOfRelationAdd:
+		for _, link := range v.RelationAdd.GetRelationLinks() {
+			relationKeys = append(relationKeys, link.Key)
+		}
+		return "relationAdd", nil, relationKeys
+	case *pb.ChangeContentValueOfRelationRemove:
+		return "relationRemove", nil, v.RelationRemove.GetRelationKey()
+	case *pb.ChangeContentValueOfDetailsSet:
+		return "detailsSet", nil, []string{v.DetailsSet.GetKey()}
+	case *pb.ChangeContentValueOfDetailsUnset:
+		return "detailsUnset", nil, []string{v.DetailsUnset.GetKey()}
+	case *pb.ChangeContentValueOfObjectTypeAdd:
+		return "objectTypeAdd", nil, nil
+	case *pb.ChangeContentValueOfObjectTypeRemove:
+		return "objectTypeRemove", nil, nil
+	case *pb.ChangeContentValueOfStoreKeySet:
+		return "storeKeySet", nil, nil
+	case *pb.ChangeContentValueOfStoreKeyUnset:
+		return "storeKeyUnset", nil, nil
+	case *pb.ChangeContentValueOfStoreSliceUpdate:
+		return "storeSliceUpdate", nil, nil
+	case *pb.ChangeContentValueOfOriginalCreatedTimestampSet:
+		return "originalCreatedTimestampSet", nil, nil
+	case *pb.ChangeContentValueOfSetFileInfo:
+		return "setFileInfo", nil, nil
+	case *pb.ChangeContentValueOfNotificationCreate:
+		return "notificationCreate", nil, nil
+	case *pb.ChangeContentValueOfNotificationUpdate:
+		return "notificationUpdate", nil, nil
+	case *pb.ChangeContentValueOfDeviceAdd:
+		return "deviceAdd", nil, nil
+	case *pb.ChangeContentValueOfDeviceUpdate:
+		return "deviceUpdate", nil, nil
+	}
+	return "", nil, nil
+}
+
+// eventBlockIds returns ids of blocks changed by the event
+func eventBlockIds(event *pb.EventMessage) []string {
+	switch v := event.GetValue().(type) {
+	case *pb.EventMessageValueOfBlockAdd:
+		ids := make([]string, 0, len(v.BlockAdd.GetBlocks()))
+		for _, block := range v.BlockAdd.GetBlocks() {
+			ids = append(ids, block.Id)
+		}
+		return ids
+	case *pb.EventMessageValueOfBlockDelete:
+		return v.BlockDelete.GetBlockIds()
+	case *pb.EventMessageValueOfBlockSetFields:
+		return []string{v.BlockSetFields.GetId()}
+	case *pb.EventMessageValueOfBlockSetChildrenIds:
+		return []string{v.BlockSetChildrenIds.GetId()}
+	case *pb.EventMessageValueOfBlockSetRestrictions:
+		return []string{v.BlockSetRestrictions.GetId()}
+	case *pb.EventMessageValueOfBlockSetBackgroundColor:
+		return []string{v.BlockSetBackgroundColor.GetId()}
+	case *pb.EventMessageValueOfBlockSetText:
+		return []string{v.BlockSetText.GetId()}
+	case *pb.EventMessageValueOfBlockSetFile:
+		return []string{v.BlockSetFile.GetId()}
+	case *pb.EventMessageValueOfBlockSetLink:
+		return []string{v.BlockSetLink.GetId()}
+	case *pb.EventMessageValueOfBlockSetBookmark:
+		return []string{v.BlockSetBookmark.GetId()}
+	case *pb.EventMessageValueOfBlockSetAlign:
+		return []string{v.BlockSetAlign.GetId()}
+	case *pb.EventMessageValueOfBlockSetDiv:
+		return []string{v.BlockSetDiv.GetId()}
+	case *pb.EventMessageValueOfBlockSetRelation:
+		return []string{v.BlockSetRelation.GetId()}
+	case *pb.EventMessageValueOfBlockSetLatex:
+		return []string{v.BlockSetLatex.GetId()}
+	case *pb.EventMessageValueOfBlockSetVerticalAlign:
+		return []string{v.BlockSetVerticalAlign.GetId()}
+	case *pb.EventMessageValueOfBlockSetTableRow:
+		return []string{v.BlockSetTableRow.GetId()}
+	case *pb.EventMessageValueOfBlockSetWidget:
+		return []string{v.BlockSetWidget.GetId()}
+	case *pb.EventMessageValueOfBlockDataviewViewSet:
+		return []string{v.BlockDataviewViewSet.GetId()}
+	case *pb.EventMessageValueOfBlockDataviewViewDelete:
+		return []string{v.BlockDataviewViewDelete.GetId()}
+	case *pb.EventMessageValueOfBlockDataviewViewOrder:
+		return []string{v.BlockDataviewViewOrder.GetId()}
+	case *pb.EventMessageValueOfBlockDataviewSourceSet:
+		return []string{v.BlockDataviewSourceSet.GetId()}
+	case *pb.EventMessageValueOfBlockDataViewGroupOrderUpdate:
+		return []string{v.BlockDataViewGroupOrderUpdate.GetId()}
+	case *pb.EventMessageValueOfBlockDataViewObjectOrderUpdate:
+		return []string{v.BlockDataViewObjectOrderUpdate.GetId()}
+	case *pb.EventMessageValueOfBlockDataviewRelationDelete:
+		return []string{v.BlockDataviewRelationDelete.GetId()}
+	case *pb.EventMessageValueOfBlockDataviewRelationSet:
+		return []string{v.BlockDataviewRelationSet.GetId()}
+	case *pb.EventMessageValueOfBlockDataviewViewUpdate:
+		return []string{v.BlockDataviewViewUpdate.GetId()}
+	case *pb.EventMessageValueOfBlockDataviewTargetObjectIdSet:
+		return []string{v.BlockDataviewTargetObjectIdSet.GetId()}
+	case *pb.EventMessageValueOfBlockDataviewIsCollectionSet:
+		return []string{v.BlockDataviewIsCollectionSet.GetId()}
+	case *pb.EventMessageValueOfBlockDataviewOldRelationDelete:
+		return []string{v.BlockDataviewOldRelationDelete.GetId()}
+	case *pb.EventMessageValueOfBlockDataviewOldRelationSet:
+		return []string{v.BlockDataviewOldRelationSet.GetId()}
+	}
+	return nil
+}
+
+func appendUnique(list []string, items ...string) []string {
+	for _, item := range items {
+		if item != "" && !slices.Contains(list, item) {
+			list = append(list, item)
+		}
+	}
+	return list
+}
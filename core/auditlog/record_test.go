@@ -0,0 +1,49 @@
+package auditlog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func TestSummarizeChange(t *testing.T) {
+	change := &pb.Change{
+		Content: []*pb.ChangeContent{
+			{Value: &pb.ChangeContentValueOfBlockCreate{BlockCreate: &pb.ChangeBlockCreate{
+				Blocks: []*model.Block{{Id: "b1"}, {Id: "b2"}},
+			}}},
+			{Value: &pb.ChangeContentValueOfBlockUpdate{BlockUpdate: &pb.ChangeBlockUpdate{
+				Events: []*pb.EventMessage{
+					{Value: &pb.EventMessageValueOfBlockSetText{BlockSetText: &pb.EventBlockSetText{Id: "b1"}}},
+					{Value: &pb.EventMessageValueOfBlockSetText{BlockSetText: &pb.EventBlockSetText{Id: "b3"}}},
+				},
+			}}},
+			{Value: &pb.ChangeContentValueOfDetailsSet{DetailsSet: &pb.ChangeDetailsSet{Key: "name"}}},
+			{Value: &pb.ChangeContentValueOfDetailsSet{DetailsSet: &pb.ChangeDetailsSet{Key: "done"}}},
+			{Value: &pb.ChangeContentValueOfRelationRemove{RelationRemove: &pb.ChangeRelationRemove{RelationKey: []string{"tag"}}}},
+		},
+	}
+	rec := Record{}
+
+	summarizeChange(&rec, change)
+
+	assert.Equal(t, []string{"blockCreate", "blockUpdate", "detailsSet", "relationRemove"}, rec.ChangeTypes)
+	assert.Equal(t, []string{"b1", "b2", "b3"}, rec.BlockIds)
+	assert.Equal(t, []string{"name", "done", "tag"}, rec.RelationKeys)
+}
+
+func TestEventBlockIds(t *testing.T) {
+	assert.Equal(t, []string{"b1"}, eventBlockIds(&pb.EventMessage{
+		Value: &pb.EventMessageValueOfBlockSetText{BlockSetText: &pb.EventBlockSetText{Id: "b1"}},
+	}))
+	assert.Equal(t, []string{"dv"}, eventBlockIds(&pb.EventMessage{
+		Value: &pb.EventMessageValueOfBlockDataviewViewSet{BlockDataviewViewSet: &pb.EventBlockDataviewViewSet{Id: "dv", ViewId: "view"}},
+	}))
+	assert.Equal(t, []string{"b1", "b2"}, eventBlockIds(&pb.EventMessage{
+		Value: &pb.EventMessageValueOfBlockAdd{BlockAdd: &pb.EventBlockAdd{Blocks: []*model.Block{{Id: "b1"}, {Id: "b2"}}}},
+	}))
+	assert.Empty(t, eventBlockIds(&pb.EventMessage{}))
+}
@@ -0,0 +1,240 @@
+package auditlog
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/anyproto/any-sync/commonspace/object/tree/objecttree"
+	"github.com/anyproto/any-sync/commonspace/objecttreebuilder"
+	"go.uber.org/zap"
+
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/block/source/sourceimpl"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/space"
+	"github.com/anyproto/anytype-heart/space/clientspace"
+)
+
+const CName = "core.auditlog"
+
+var log = logging.Logger(CName).Desugar()
+
+var (
+	ErrEmptySpaceId  = errors.New("space id is empty")
+	ErrEmptyPath     = errors.New("path is empty")
+	ErrInvalidPeriod = errors.New("date from is after date to")
+	ErrCanceled      = errors.New("export is canceled")
+	// ErrObjectsNotExported is returned when the log is written without changes of some objects
+	ErrObjectsNotExported = errors.New("changes of objects are not exported")
+)
+
+type Request struct {
+	SpaceId string
+	// Path is a directory to write the log file to
+	Path   string
+	Format Format
+	// From and To limit the time of changes, zero value means no limit
+	From time.Time
+	To   time.Time
+	// ParticipantIds limits authors of changes, empty value means all participants
+	ParticipantIds []string
+}
+
+func (r Request) validate() error {
+	if r.SpaceId == "" {
+		return ErrEmptySpaceId
+	}
+	if r.Path == "" {
+		return ErrEmptyPath
+	}
+	if !r.From.IsZero() && !r.To.IsZero() && r.From.After(r.To) {
+		return ErrInvalidPeriod
+	}
+	return nil
+}
+
+func (r Request) match(rec Record) bool {
+	if !r.From.IsZero() && rec.Time.Before(r.From) {
+		return false
+	}
+	if !r.To.IsZero() && rec.Time.After(r.To) {
+		return false
+	}
+	return len(r.ParticipantIds) == 0 || slices.Contains(r.ParticipantIds, rec.ParticipantId)
+}
+
+type Service interface {
+	app.ComponentRunnable
+
+	// Export starts a background process that writes the change log of all objects of the space to a file.
+	// The file is complete when the process is done
+	Export(ctx context.Context, req Request) (processId, path string, err error)
+}
+
+type service struct {
+	spaceService   space.Service
+	processService process.Service
+
+	componentCtx       context.Context
+	componentCtxCancel context.CancelFunc
+}
+
+func New() Service {
+	return &service{}
+}
+
+func (s *service) Init(a *app.App) error {
+	s.spaceService = app.MustComponent[space.Service](a)
+	s.processService = app.MustComponent[process.Service](a)
+	s.componentCtx, s.componentCtxCancel = context.WithCancel(context.Background())
+	return nil
+}
+
+func (s *service) Name() string {
+	return CName
+}
+
+func (s *service) Run(_ context.Context) error {
+	return nil
+}
+
+func (s *service) Close(_ context.Context) error {
+	if s.componentCtxCancel != nil {
+		s.componentCtxCancel()
+	}
+	return nil
+}
+
+func (s *service) Export(ctx context.Context, req Request) (processId, path string, err error) {
+	if err = req.validate(); err != nil {
+		return "", "", err
+	}
+	spc, err := s.spaceService.Get(ctx, req.SpaceId)
+	if err != nil {
+		return "", "", fmt.Errorf("get space: %w", err)
+	}
+	path = filepath.Join(req.Path, fmt.Sprintf("audit-log.%s.%s.%s", req.SpaceId, time.Now().Format("20060102.150405"), req.Format.extension()))
+
+	progress := process.NewProgress(&pb.ModelProcessMessageOfAuditLogExport{AuditLogExport: &pb.ModelProcessAuditLogExport{}})
+	if err = s.processService.Add(progress); err != nil {
+		return "", "", fmt.Errorf("add process: %w", err)
+	}
+	go func() {
+		progress.Finish(s.export(spc, req, path, progress))
+	}()
+	return progress.Id(), path, nil
+}
+
+func (s *service) export(spc clientspace.Space, req Request, path string, progress process.Progress) (err error) {
+	ctx, cancel := context.WithCancel(s.componentCtx)
+	defer cancel()
+	go func() {
+		select {
+		case <-progress.Canceled():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	// the file is written under a temporary name, so an incomplete log is never left at the path
+	tmpPath := path + ".tmp"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return fmt.Errorf("create file: %w", err)
+	}
+	var renamed bool
+	defer func() {
+		if err != nil && !renamed {
+			_ = f.Close()
+			_ = os.Remove(tmpPath)
+		}
+	}()
+	buf := bufio.NewWriter(f)
+	writer, err := newRecordWriter(req.Format, buf)
+	if err != nil {
+		return fmt.Errorf("write header: %w", err)
+	}
+
+	ids := spc.StoredIds()
+	slices.Sort(ids)
+	progress.SetTotal(int64(len(ids)))
+	progress.SetProgressMessage("reading object changes")
+	var failedIds []string
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			return ErrCanceled
+		}
+		if err = s.exportObject(ctx, spc, id, req, writer); err != nil {
+			if ctx.Err() != nil {
+				return ErrCanceled
+			}
+			log.Warn("export object changes", zap.String("objectId", id), zap.Error(err))
+			failedIds = append(failedIds, id)
+		}
+		progress.AddDone(1)
+	}
+
+	if err = writer.Flush(); err != nil {
+		return fmt.Errorf("write records: %w", err)
+	}
+	if err = buf.Flush(); err != nil {
+		return fmt.Errorf("write file: %w", err)
+	}
+	if err = f.Close(); err != nil {
+		return fmt.Errorf("close file: %w", err)
+	}
+	if err = os.Rename(tmpPath, path); err != nil {
+		return err
+	}
+	renamed = true
+	// the log of other objects is kept, and the process is finished with the error listing objects missing in it
+	if len(failedIds) > 0 {
+		return fmt.Errorf("%w: %s", ErrObjectsNotExported, strings.Join(failedIds, ", "))
+	}
+	return nil
+}
+
+func (s *service) exportObject(ctx context.Context, spc clientspace.Space, objectId string, req Request, writer recordWriter) error {
+	tree, err := spc.TreeBuilder().BuildHistoryTree(ctx, objectId, objecttreebuilder.HistoryTreeOpts{})
+	if err != nil {
+		return fmt.Errorf("build history tree: %w", err)
+	}
+	var writeErr error
+	err = tree.IterateFrom(tree.Root().Id, sourceimpl.NewUnmarshalTreeChange(), func(c *objecttree.Change) (isContinue bool) {
+		rec := Record{
+			Time:     time.Unix(c.Timestamp, 0),
+			SpaceId:  req.SpaceId,
+			ObjectId: objectId,
+			ChangeId: c.Id,
+		}
+		if c.Identity != nil {
+			rec.Identity = c.Identity.Account()
+			rec.ParticipantId = domain.NewParticipantId(req.SpaceId, rec.Identity)
+		}
+		if !req.match(rec) {
+			return true
+		}
+		if c.Id == tree.Id() {
+			rec.ChangeTypes = append(rec.ChangeTypes, changeTypeObjectCreate)
+		}
+		if model, ok := c.Model.(*pb.Change); ok {
+			summarizeChange(&rec, model)
+		}
+		writeErr = writer.Write(rec)
+		return writeErr == nil
+	})
+	if err != nil {
+		return fmt.Errorf("iterate changes: %w", err)
+	}
+	return writeErr
+}
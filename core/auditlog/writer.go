@@ -0,0 +1,79 @@
+package auditlog
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+)
+
+type Format int
+
+const (
+	FormatCSV Format = iota
+	FormatJSONL
+)
+
+func (f Format) extension() string {
+	if f == FormatJSONL {
+		return "jsonl"
+	}
+	return "csv"
+}
+
+type recordWriter interface {
+	Write(rec Record) error
+	Flush() error
+}
+
+func newRecordWriter(format Format, w io.Writer) (recordWriter, error) {
+	if format == FormatJSONL {
+		return &jsonlWriter{encoder: json.NewEncoder(w)}, nil
+	}
+	writer := &csvWriter{writer: csv.NewWriter(w)}
+	if err := writer.writer.Write(csvHeader); err != nil {
+		return nil, err
+	}
+	return writer, nil
+}
+
+type jsonlWriter struct {
+	encoder *json.Encoder
+}
+
+func (w *jsonlWriter) Write(rec Record) error {
+	return w.encoder.Encode(rec)
+}
+
+func (w *jsonlWriter) Flush() error {
+	return nil
+}
+
+// csvListSeparator joins list values in a single column
+const csvListSeparator = ";"
+
+var csvHeader = []string{"time", "spaceId", "objectId", "changeId", "identity", "participantId", "changeTypes", "blockIds", "relationKeys"}
+
+type csvWriter struct {
+	writer *csv.Writer
+}
+
+func (w *csvWriter) Write(rec Record) error {
+	return w.writer.Write([]string{
+		rec.Time.UTC().Format(time.RFC3339),
+		rec.SpaceId,
+		rec.ObjectId,
+		rec.ChangeId,
+		rec.Identity,
+		rec.ParticipantId,
+		strings.Join(rec.ChangeTypes, csvListSeparator),
+		strings.Join(rec.BlockIds, csvListSeparator),
+		strings.Join(rec.RelationKeys, csvListSeparator),
+	})
+}
+
+func (w *csvWriter) Flush() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
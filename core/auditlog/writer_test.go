@@ -0,0 +1,70 @@
+package auditlog
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordWriter(t *testing.T) {
+	rec := Record{
+		Time:          time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+		SpaceId:       "space1",
+		ObjectId:      "obj1",
+		ChangeId:      "change1",
+		Identity:      "identity1",
+		ParticipantId: "participant1",
+		ChangeTypes:   []string{"blockCreate", "detailsSet"},
+		BlockIds:      []string{"b1"},
+		RelationKeys:  []string{"name", "tag"},
+	}
+
+	t.Run("csv", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		w, err := newRecordWriter(FormatCSV, buf)
+		require.NoError(t, err)
+
+		require.NoError(t, w.Write(rec))
+		require.NoError(t, w.Flush())
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		require.Len(t, lines, 2)
+		assert.Equal(t, strings.Join(csvHeader, ","), lines[0])
+		assert.Equal(t, "2024-05-01T10:00:00Z,space1,obj1,change1,identity1,participant1,blockCreate;detailsSet,b1,name;tag", lines[1])
+	})
+
+	t.Run("jsonl", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		w, err := newRecordWriter(FormatJSONL, buf)
+		require.NoError(t, err)
+
+		require.NoError(t, w.Write(rec))
+		require.NoError(t, w.Write(rec))
+		require.NoError(t, w.Flush())
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		require.Len(t, lines, 2)
+		var got Record
+		require.NoError(t, json.Unmarshal([]byte(lines[0]), &got))
+		assert.Equal(t, rec, got)
+	})
+}
+
+func TestRequestMatch(t *testing.T) {
+	req := Request{
+		From:           time.Unix(100, 0),
+		To:             time.Unix(200, 0),
+		ParticipantIds: []string{"alice"},
+	}
+
+	assert.True(t, req.match(Record{Time: time.Unix(150, 0), ParticipantId: "alice"}))
+	assert.False(t, req.match(Record{Time: time.Unix(150, 0), ParticipantId: "bob"}))
+	assert.False(t, req.match(Record{Time: time.Unix(50, 0), ParticipantId: "alice"}))
+	assert.False(t, req.match(Record{Time: time.Unix(250, 0), ParticipantId: "alice"}))
+	assert.True(t, Request{}.match(Record{Time: time.Unix(250, 0), ParticipantId: "bob"}))
+}
@@ -215,7 +215,9 @@ func (w *watcher) updateAccumulatedBacklinks() {
 	w.accumulatedBacklinks = make(map[domain.FullID]*backLinksUpdate)
 }
 
-func shouldIndexBacklinks(ids threads.DerivedSmartblockIds, id string) bool {
+// ShouldIndexBacklinks reports whether backlinks are maintained for the object and whether the object is listed
+// in backlinks of other objects; system objects and date objects are excluded in both directions
+func ShouldIndexBacklinks(ids threads.DerivedSmartblockIds, id string) bool {
 	if _, parseDateErr := dateutil.BuildDateObjectFromId(id); parseDateErr == nil {
 		return false
 	}
@@ -252,14 +254,14 @@ func (w *watcher) updateBackLinksInObject(id domain.FullID, backlinksUpdate *bac
 
 		backlinks = slice.Filter(backlinks, func(s string) bool {
 			// filter-out backlinks to system objects
-			return shouldIndexBacklinks(spaceDerivedIds, s)
+			return ShouldIndexBacklinks(spaceDerivedIds, s)
 		})
 
 		current.SetStringList(bundle.RelationKeyBacklinks, backlinks)
 		return current, true, nil
 	}
 
-	if shouldIndexBacklinks(spaceDerivedIds, id.ObjectID) {
+	if ShouldIndexBacklinks(spaceDerivedIds, id.ObjectID) {
 		// filter-out backlinks in system objects
 		err = spc.DoLockedIfNotExists(id.ObjectID, func() error {
 			return w.store.SpaceIndex(id.SpaceID).ModifyObjectDetails(id.ObjectID, func(details *domain.Details) (*domain.Details, bool, error) {
@@ -89,6 +89,7 @@ type ObjectFactory struct {
 	statService             debugstat.StatService
 	backlinksUpdater        backlinks.UpdateWatcher
 	formatFetcher           relationutils.RelationFormatFetcher
+	memberRoles             smartblock.MemberRoleProvider
 }
 
 func NewObjectFactory() *ObjectFactory {
@@ -129,6 +130,7 @@ func (f *ObjectFactory) Init(a *app.App) (err error) {
 		f.statService = debugstat.NewNoOp()
 	}
 	f.formatFetcher = app.MustComponent[relationutils.RelationFormatFetcher](a)
+	f.memberRoles = app.MustComponent[smartblock.MemberRoleProvider](a)
 	return nil
 }
 
@@ -198,6 +200,7 @@ func (f *ObjectFactory) produceSmartblock(space smartblock.Space) (smartblock.Sm
 		f.eventSender,
 		f.spaceIdResolver,
 		f.formatFetcher,
+		f.memberRoles,
 	), store
 }
 
@@ -0,0 +1,24 @@
+package smartblock
+
+import (
+	"github.com/anyproto/anytype-heart/core/domain"
+)
+
+// MemberRoleProvider keeps roles of participants of shared spaces in memory,
+// as they are checked on every apply of the object
+type MemberRoleProvider interface {
+	MemberRole(spaceId, workspaceId, participantId string) domain.MemberRole
+}
+
+// MemberRole returns the role of the current participant in the shared space.
+// It narrows the ACL permissions, so restrictions of the object are calculated according to it
+func (sb *smartBlock) MemberRole() domain.MemberRole {
+	if sb.memberRoles == nil || sb.space == nil || sb.currentParticipantId == "" || sb.space.IsPersonal() {
+		return domain.MemberRole{}
+	}
+	workspaceId := sb.space.DerivedIDs().Workspace
+	if workspaceId == "" {
+		return domain.MemberRole{}
+	}
+	return sb.memberRoles.MemberRole(sb.SpaceID(), workspaceId, sb.currentParticipantId)
+}
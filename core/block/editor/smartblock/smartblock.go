@@ -97,6 +97,7 @@ func New(
 	eventSender event.Sender,
 	spaceIdResolver idresolver.Resolver,
 	formatFetcher relationutils.RelationFormatFetcher,
+	memberRoles MemberRoleProvider,
 ) SmartBlock {
 	s := &smartBlock{
 		currentParticipantId: currentParticipantId,
@@ -112,6 +113,7 @@ func New(
 		objectStore:     objectStore,
 		spaceIdResolver: spaceIdResolver,
 		formatFetcher:   formatFetcher,
+		memberRoles:     memberRoles,
 		lastDepDetails:  map[string]*domain.Details{},
 	}
 	return s
@@ -249,6 +251,7 @@ type smartBlock struct {
 	eventSender     event.Sender
 	spaceIdResolver idresolver.Resolver
 	formatFetcher   relationutils.RelationFormatFetcher
+	memberRoles     MemberRoleProvider
 }
 
 func (sb *smartBlock) SetLocker(locker Locker) {
@@ -707,6 +710,16 @@ func (sb *smartBlock) Apply(s *state.State, flags ...ApplyFlag) (err error) {
 		migrationVersionUpdated = s.MigrationVersion() != parent.MigrationVersion()
 	}
 
+	isNewObject := parent != nil && parent.IsTheHeaderChange()
+	if changeType == domain.ChangeTypeUserChange && !notPushChanges && !isNewObject && restriction.IsRestrictedByMemberRole(sb) {
+		// the same as ACL rejects changes of readers, but for the narrower role of the participant
+		if !ignoreNoPermissions {
+			return fmt.Errorf("%w: %w", restriction.ErrRestricted, list.ErrInsufficientPermissions)
+		}
+		// like for readers, the state is applied locally, but changes are not pushed
+		notPushChanges = true
+	}
+
 	msgs, act, err := state.ApplyState(sb.SpaceID(), s, sb.enableLayouts)
 	if err != nil {
 		return
@@ -0,0 +1,415 @@
+package template
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// Include is a link block of a template that points to another (base) template.
+// When the template is applied, the include block is replaced with copies of blocks of the base template
+const (
+	// IncludeFieldName marks the link block as include of the target template
+	IncludeFieldName = "templateInclude"
+	// IncludeBlocksFieldName limits included blocks of the base template. All blocks except header are included if empty
+	IncludeBlocksFieldName = "templateIncludeBlocks"
+	// IncludeSyncFieldName opts objects created from the template in to updates of the base template
+	IncludeSyncFieldName = "templateIncludeSync"
+
+	// IncludeSourceFieldName is the id of the base template, set to every block copied from it
+	IncludeSourceFieldName = "templateIncludeSource"
+	// IncludeIdFieldName is the id of the include block, so several includes of the same template could be distinguished
+	IncludeIdFieldName = "templateIncludeId"
+	// IncludeSourceBlockFieldName is the id of the block in the base template the block was copied from
+	IncludeSourceBlockFieldName = "templateIncludeSourceBlock"
+
+	maxIncludeDepth = 5
+)
+
+var (
+	ErrIncludeCycle = errors.New("template includes itself")
+	ErrIncludeDepth = fmt.Errorf("templates are included deeper than %d levels", maxIncludeDepth)
+)
+
+var includeFieldNames = []string{IncludeSourceFieldName, IncludeIdFieldName, IncludeSourceBlockFieldName, IncludeSyncFieldName, IncludeBlocksFieldName}
+
+// IncludeGetter returns a copy of the template state, which is safe to modify
+type IncludeGetter func(templateId string) (*state.State, error)
+
+type Include struct {
+	BlockId    string
+	TemplateId string
+	BlockIds   []string
+	Sync       bool
+}
+
+// FindIncludes returns include blocks of the state
+func FindIncludes(st *state.State) (includes []Include) {
+	_ = st.Iterate(func(b simple.Block) (isContinue bool) {
+		if inc, ok := parseInclude(b.Model()); ok {
+			includes = append(includes, inc)
+		}
+		return true
+	})
+	return includes
+}
+
+func parseInclude(b *model.Block) (Include, bool) {
+	targetId := b.GetLink().GetTargetBlockId()
+	if targetId == "" || !pbtypes.GetBool(b.GetFields(), IncludeFieldName) {
+		return Include{}, false
+	}
+	return Include{
+		BlockId:    b.Id,
+		TemplateId: targetId,
+		BlockIds:   pbtypes.GetStringList(b.GetFields(), IncludeBlocksFieldName),
+		Sync:       pbtypes.GetBool(b.GetFields(), IncludeSyncFieldName),
+	}, true
+}
+
+// ResolveIncludes replaces include blocks of the template state with blocks of base templates.
+// Includes that failed to resolve are kept as links to base templates
+func ResolveIncludes(st *state.State, getTemplate IncludeGetter) error {
+	return resolveIncludes(st, getTemplate, []string{st.RootId()})
+}
+
+func resolveIncludes(st *state.State, getTemplate IncludeGetter, chain []string) error {
+	var errs []error
+	for _, inc := range FindIncludes(st) {
+		base, err := includedState(inc.TemplateId, getTemplate, chain)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("include %s: %w", inc.TemplateId, err))
+			continue
+		}
+		if err = replaceInclude(st, inc, base); err != nil {
+			errs = append(errs, fmt.Errorf("include %s: %w", inc.TemplateId, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func includedState(templateId string, getTemplate IncludeGetter, chain []string) (*state.State, error) {
+	if slices.Contains(chain, templateId) {
+		return nil, ErrIncludeCycle
+	}
+	if len(chain) > maxIncludeDepth {
+		return nil, ErrIncludeDepth
+	}
+	base, err := getTemplate(templateId)
+	if err != nil {
+		return nil, err
+	}
+	if err = resolveIncludes(base, getTemplate, append(slices.Clone(chain), templateId)); err != nil {
+		return nil, err
+	}
+	return base, nil
+}
+
+func replaceInclude(st *state.State, inc Include, base *state.State) error {
+	rootIds, blocks := copyIncludedBlocks(inc, base)
+	for _, b := range blocks {
+		st.Add(b)
+	}
+	if len(rootIds) > 0 {
+		if err := st.InsertTo(inc.BlockId, model.Block_Top, rootIds...); err != nil {
+			return fmt.Errorf("insert blocks: %w", err)
+		}
+	}
+	st.Unlink(inc.BlockId)
+	return nil
+}
+
+// includedRootIds returns ids of top-level blocks of the base template to include
+func includedRootIds(inc Include, base *state.State) []string {
+	if len(inc.BlockIds) > 0 {
+		ids := make([]string, 0, len(inc.BlockIds))
+		for _, id := range inc.BlockIds {
+			if base.Exists(id) {
+				ids = append(ids, id)
+			}
+		}
+		return base.SelectRoots(ids)
+	}
+	root := base.Pick(base.RootId())
+	if root == nil {
+		return nil
+	}
+	ids := make([]string, 0, len(root.Model().ChildrenIds))
+	for _, id := range root.Model().ChildrenIds {
+		switch id {
+		case HeaderLayoutId, TitleBlockId, DescriptionBlockId, FeaturedRelationsId:
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// copyIncludedBlocks copies included blocks of the base template with new ids. Copies are marked with include fields,
+// so they could be found and updated later
+func copyIncludedBlocks(inc Include, base *state.State) (rootIds []string, blocks []simple.Block) {
+	roots := includedRootIds(inc, base)
+	ids := map[string]string{}
+	var models []*model.Block
+	var visit func(id string)
+	visit = func(id string) {
+		b := base.Pick(id)
+		if b == nil {
+			return
+		}
+		if _, visited := ids[id]; visited {
+			return
+		}
+		m := b.Copy().Model()
+		ids[id] = ""
+		models = append(models, m)
+		for _, childId := range m.ChildrenIds {
+			visit(childId)
+		}
+	}
+	for _, id := range roots {
+		visit(id)
+	}
+
+	// cell ids of tables are built from ids of the row and the column, so they are mapped after other blocks
+	for _, m := range models {
+		if !isTableCell(base, m.Id) {
+			ids[m.Id] = bson.NewObjectId().Hex()
+		}
+	}
+	for _, m := range models {
+		if parent := base.PickParentOf(m.Id); parent != nil && parent.Model().GetTableRow() != nil {
+			if colId, ok := strings.CutPrefix(m.Id, parent.Model().Id+"-"); ok && ids[colId] != "" {
+				ids[m.Id] = ids[parent.Model().Id] + "-" + ids[colId]
+			}
+		}
+	}
+
+	for _, m := range models {
+		sourceBlockId := includeKey(m)
+		newId := ids[m.Id]
+		if newId == "" {
+			continue
+		}
+		m.Id = newId
+		children := m.ChildrenIds[:0]
+		for _, childId := range m.ChildrenIds {
+			if newChildId := ids[childId]; newChildId != "" {
+				children = append(children, newChildId)
+			}
+		}
+		m.ChildrenIds = children
+		markIncluded(m, inc, sourceBlockId)
+		blocks = append(blocks, simple.New(m))
+	}
+	for _, id := range roots {
+		if newId := ids[id]; newId != "" {
+			rootIds = append(rootIds, newId)
+		}
+	}
+	if len(inc.BlockIds) > 0 {
+		for _, b := range blocks {
+			if slices.Contains(rootIds, b.Model().Id) {
+				b.Model().Fields.Fields[IncludeBlocksFieldName] = pbtypes.StringList(inc.BlockIds)
+			}
+		}
+	}
+	return rootIds, blocks
+}
+
+func isTableCell(st *state.State, id string) bool {
+	parent := st.PickParentOf(id)
+	return parent != nil && parent.Model().GetTableRow() != nil && strings.HasPrefix(id, parent.Model().Id+"-")
+}
+
+// includeKey returns the stable id of the block of the base template. Blocks that were included into the base template
+// from another template get new ids on every resolve, so their key is built from the include they came from
+func includeKey(b *model.Block) string {
+	if includeId := pbtypes.GetString(b.GetFields(), IncludeIdFieldName); includeId != "" {
+		return includeId + "/" + pbtypes.GetString(b.GetFields(), IncludeSourceBlockFieldName)
+	}
+	return b.Id
+}
+
+func markIncluded(b *model.Block, inc Include, sourceBlockId string) {
+	if b.Fields == nil || b.Fields.Fields == nil {
+		b.Fields = &types.Struct{Fields: map[string]*types.Value{}}
+	}
+	for _, key := range includeFieldNames {
+		delete(b.Fields.Fields, key)
+	}
+	b.Fields.Fields[IncludeSourceFieldName] = pbtypes.String(inc.TemplateId)
+	b.Fields.Fields[IncludeIdFieldName] = pbtypes.String(inc.BlockId)
+	b.Fields.Fields[IncludeSourceBlockFieldName] = pbtypes.String(sourceBlockId)
+	if inc.Sync {
+		b.Fields.Fields[IncludeSyncFieldName] = pbtypes.Bool(true)
+	}
+}
+
+// IncludeSection is a group of blocks of an object that were copied from the same include of a base template
+type IncludeSection struct {
+	TemplateId string
+	IncludeId  string
+	// BlockIds are ids of all blocks of the section in the object
+	BlockIds []string
+	// RootIds are ids of top-level blocks of the section in the object
+	RootIds []string
+	// IncludeBlockIds are included blocks of the base template, empty value means all blocks
+	IncludeBlockIds []string
+}
+
+func (s IncludeSection) include() Include {
+	return Include{BlockId: s.IncludeId, TemplateId: s.TemplateId, BlockIds: s.IncludeBlockIds, Sync: true}
+}
+
+// FindIncludeSections returns sections of the object that opted in to updates of base templates
+func FindIncludeSections(st *state.State) (sections []IncludeSection) {
+	index := map[[2]string]int{}
+	_ = st.Iterate(func(b simple.Block) (isContinue bool) {
+		fields := b.Model().GetFields()
+		if !pbtypes.GetBool(fields, IncludeSyncFieldName) {
+			return true
+		}
+		key := [2]string{pbtypes.GetString(fields, IncludeSourceFieldName), pbtypes.GetString(fields, IncludeIdFieldName)}
+		if key[0] == "" || key[1] == "" {
+			return true
+		}
+		i, ok := index[key]
+		if !ok {
+			i = len(sections)
+			index[key] = i
+			sections = append(sections, IncludeSection{TemplateId: key[0], IncludeId: key[1]})
+		}
+		sections[i].BlockIds = append(sections[i].BlockIds, b.Model().Id)
+		if blockIds := pbtypes.GetStringList(fields, IncludeBlocksFieldName); len(blockIds) > 0 {
+			sections[i].IncludeBlockIds = blockIds
+		}
+		return true
+	})
+	for i := range sections {
+		sections[i].RootIds = st.SelectRoots(sections[i].BlockIds)
+	}
+	return sections
+}
+
+// IncludeDiff describes changes that an update of the base template makes in the section of the object
+type IncludeDiff struct {
+	// Added are ids of blocks of the base template that are missing in the object
+	Added []string
+	// Changed are ids of blocks of the object that differ from the base template
+	Changed []string
+	// Removed are ids of blocks of the object that are removed from the base template
+	Removed []string
+}
+
+func (d IncludeDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Changed) == 0 && len(d.Removed) == 0
+}
+
+// VariablesEvaluator replaces variables in the copy of the base template, as it is done on the creation of objects
+type VariablesEvaluator func(st *state.State)
+
+// freshIncludedBlocks copies blocks of the section from the base template with variables evaluated
+func freshIncludedBlocks(section IncludeSection, base *state.State, evaluate VariablesEvaluator) ([]string, []simple.Block) {
+	if evaluate != nil {
+		base = base.Copy()
+		evaluate(base)
+	}
+	return copyIncludedBlocks(section.include(), base)
+}
+
+// DiffIncludeSection compares the section of the object with the current state of the base template.
+// Base is expected to have includes resolved
+func DiffIncludeSection(st *state.State, section IncludeSection, base *state.State, evaluate VariablesEvaluator) (diff IncludeDiff) {
+	_, blocks := freshIncludedBlocks(section, base, evaluate)
+	fresh := make(map[string]*model.Block, len(blocks))
+	freshKeys := make(map[string]string, len(blocks))
+	for _, b := range blocks {
+		freshKeys[b.Model().Id] = includeKey(b.Model())
+	}
+	for _, b := range blocks {
+		fresh[includeKey(b.Model())] = normalizeIncluded(b.Model(), freshKeys)
+	}
+
+	current := make(map[string]*model.Block, len(section.BlockIds))
+	currentKeys := make(map[string]string, len(section.BlockIds))
+	for _, id := range section.BlockIds {
+		if b := st.Pick(id); b != nil {
+			currentKeys[id] = includeKey(b.Model())
+		}
+	}
+	for _, id := range section.BlockIds {
+		b := st.Pick(id)
+		if b == nil {
+			continue
+		}
+		key := currentKeys[id]
+		current[key] = b.Model()
+		freshBlock, ok := fresh[key]
+		if !ok {
+			diff.Removed = append(diff.Removed, id)
+			continue
+		}
+		if !proto.Equal(normalizeIncluded(b.Model(), currentKeys), freshBlock) {
+			diff.Changed = append(diff.Changed, id)
+		}
+	}
+	for _, b := range blocks {
+		key := includeKey(b.Model())
+		if _, ok := current[key]; !ok {
+			diff.Added = append(diff.Added, pbtypes.GetString(b.Model().GetFields(), IncludeSourceBlockFieldName))
+		}
+	}
+	return diff
+}
+
+// normalizeIncluded returns a copy of the block without ids and include fields, children are replaced with their keys
+func normalizeIncluded(b *model.Block, keys map[string]string) *model.Block {
+	m := pbtypes.CopyBlock(b)
+	m.Id = ""
+	for i, childId := range m.ChildrenIds {
+		if key, ok := keys[childId]; ok {
+			m.ChildrenIds[i] = key
+		}
+	}
+	if m.Fields != nil {
+		for _, key := range includeFieldNames {
+			delete(m.Fields.Fields, key)
+		}
+		if len(m.Fields.Fields) == 0 {
+			m.Fields = nil
+		}
+	}
+	return m
+}
+
+// SyncIncludeSection replaces blocks of the section with the current blocks of the base template.
+// Base is expected to have includes resolved
+func SyncIncludeSection(st *state.State, section IncludeSection, base *state.State, evaluate VariablesEvaluator) error {
+	if len(section.RootIds) == 0 {
+		return nil
+	}
+	rootIds, blocks := freshIncludedBlocks(section, base, evaluate)
+	for _, b := range blocks {
+		st.Add(b)
+	}
+	if len(rootIds) > 0 {
+		if err := st.InsertTo(section.RootIds[0], model.Block_Top, rootIds...); err != nil {
+			return fmt.Errorf("insert blocks: %w", err)
+		}
+	}
+	for _, id := range section.RootIds {
+		st.Unlink(id)
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+package template
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func newTextBlock(id, txt string, childrenIds ...string) simple.Block {
+	return simple.New(&model.Block{
+		Id:          id,
+		ChildrenIds: childrenIds,
+		Content:     &model.BlockContentOfText{Text: &model.BlockContentText{Text: txt}},
+	})
+}
+
+func newIncludeBlock(id, templateId string, sync bool, blockIds ...string) simple.Block {
+	fields := &types.Struct{Fields: map[string]*types.Value{
+		IncludeFieldName:     pbtypes.Bool(true),
+		IncludeSyncFieldName: pbtypes.Bool(sync),
+	}}
+	if len(blockIds) > 0 {
+		fields.Fields[IncludeBlocksFieldName] = pbtypes.StringList(blockIds)
+	}
+	return simple.New(&model.Block{
+		Id:      id,
+		Fields:  fields,
+		Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{TargetBlockId: templateId}},
+	})
+}
+
+func newIncludeState(id string, blocks ...simple.Block) *state.State {
+	st := state.NewDoc(id, nil).NewState()
+	root := &model.Block{Id: id}
+	st.Add(simple.New(root))
+	children := map[string]bool{}
+	for _, b := range blocks {
+		st.Add(b)
+		for _, childId := range b.Model().ChildrenIds {
+			children[childId] = true
+		}
+	}
+	for _, b := range blocks {
+		if !children[b.Model().Id] {
+			root.ChildrenIds = append(root.ChildrenIds, b.Model().Id)
+		}
+	}
+	return st
+}
+
+func newBaseTemplate() *state.State {
+	return newIncludeState("base",
+		simple.New(&model.Block{Id: HeaderLayoutId}),
+		newTextBlock("agenda", "Agenda", "item"),
+		newTextBlock("item", "Item"),
+		newTextBlock("notes", "Notes"),
+	)
+}
+
+func getterOf(states ...*state.State) IncludeGetter {
+	return func(templateId string) (*state.State, error) {
+		for _, st := range states {
+			if st.RootId() == templateId {
+				return st.Copy(), nil
+			}
+		}
+		return nil, assert.AnError
+	}
+}
+
+func rootTexts(st *state.State) (texts []string) {
+	for _, id := range st.Pick(st.RootId()).Model().ChildrenIds {
+		texts = append(texts, st.Pick(id).Model().GetText().GetText())
+	}
+	return texts
+}
+
+func TestResolveIncludes(t *testing.T) {
+	t.Run("include is replaced with blocks of base template", func(t *testing.T) {
+		// given
+		st := newIncludeState("meeting", newTextBlock("intro", "Intro"), newIncludeBlock("inc", "base", false), newTextBlock("outro", "Outro"))
+
+		// when
+		err := ResolveIncludes(st, getterOf(newBaseTemplate()))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Intro", "Agenda", "Notes", "Outro"}, rootTexts(st))
+		assert.Nil(t, st.PickParentOf("inc"))
+		agenda := st.Pick(st.Pick(st.RootId()).Model().ChildrenIds[1]).Model()
+		require.Len(t, agenda.ChildrenIds, 1)
+		assert.Equal(t, "Item", st.Pick(agenda.ChildrenIds[0]).Model().GetText().GetText())
+		assert.Equal(t, "base", pbtypes.GetString(agenda.Fields, IncludeSourceFieldName))
+		assert.Equal(t, "agenda", pbtypes.GetString(agenda.Fields, IncludeSourceBlockFieldName))
+		assert.False(t, pbtypes.GetBool(agenda.Fields, IncludeSyncFieldName))
+	})
+
+	t.Run("only selected blocks are included", func(t *testing.T) {
+		// given
+		st := newIncludeState("meeting", newIncludeBlock("inc", "base", true, "notes"))
+
+		// when
+		err := ResolveIncludes(st, getterOf(newBaseTemplate()))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Notes"}, rootTexts(st))
+	})
+
+	t.Run("nested includes", func(t *testing.T) {
+		// given
+		middle := newIncludeState("middle", newTextBlock("title", "Middle"), newIncludeBlock("incBase", "base", false))
+		st := newIncludeState("meeting", newIncludeBlock("incMiddle", "middle", true))
+
+		// when
+		err := ResolveIncludes(st, getterOf(newBaseTemplate(), middle))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Agenda", "Notes"}, rootTexts(st))
+		notes := st.Pick(st.Pick(st.RootId()).Model().ChildrenIds[1]).Model()
+		assert.Equal(t, "middle", pbtypes.GetString(notes.Fields, IncludeSourceFieldName))
+		assert.Equal(t, "incBase/notes", pbtypes.GetString(notes.Fields, IncludeSourceBlockFieldName))
+	})
+
+	t.Run("cycle is not resolved", func(t *testing.T) {
+		// given
+		base := newIncludeState("base", newIncludeBlock("incMeeting", "meeting", false))
+		st := newIncludeState("meeting", newIncludeBlock("incBase", "base", false))
+
+		// when
+		err := ResolveIncludes(st, getterOf(base, st.Copy()))
+
+		// then
+		require.ErrorIs(t, err, ErrIncludeCycle)
+		assert.Equal(t, []string{"incBase"}, st.Pick(st.RootId()).Model().ChildrenIds)
+	})
+}
+
+func TestIncludeSections(t *testing.T) {
+	newObject := func(t *testing.T) *state.State {
+		st := newIncludeState("object", newTextBlock("own", "Own text"), newIncludeBlock("inc", "base", true))
+		require.NoError(t, ResolveIncludes(st, getterOf(newBaseTemplate())))
+		return st
+	}
+
+	t.Run("no changes", func(t *testing.T) {
+		// given
+		st := newObject(t)
+
+		// when
+		sections := FindIncludeSections(st)
+
+		// then
+		require.Len(t, sections, 1)
+		assert.Equal(t, "base", sections[0].TemplateId)
+		assert.Equal(t, "inc", sections[0].IncludeId)
+		assert.Len(t, sections[0].BlockIds, 3)
+		assert.Len(t, sections[0].RootIds, 2)
+		assert.True(t, DiffIncludeSection(st, sections[0], newBaseTemplate(), nil).IsEmpty())
+	})
+
+	t.Run("diff and sync", func(t *testing.T) {
+		// given
+		st := newObject(t)
+		base := newBaseTemplate()
+		base.Get("notes").Model().GetText().Text = "Notes and decisions"
+		base.Unlink("item")
+		base.Add(newTextBlock("actions", "Action items"))
+		base.Get(base.RootId()).Model().ChildrenIds = append(base.Pick(base.RootId()).Model().ChildrenIds, "actions")
+		section := FindIncludeSections(st)[0]
+
+		// when
+		diff := DiffIncludeSection(st, section, base, nil)
+
+		// then
+		assert.Equal(t, []string{"actions"}, diff.Added)
+		assert.Len(t, diff.Changed, 2) // agenda lost its child, notes text is changed
+		assert.Len(t, diff.Removed, 1)
+
+		// when
+		require.NoError(t, SyncIncludeSection(st, section, base, nil))
+
+		// then
+		assert.Equal(t, []string{"Own text", "Agenda", "Notes and decisions", "Action items"}, rootTexts(st))
+		synced := FindIncludeSections(st)
+		require.Len(t, synced, 1)
+		assert.True(t, DiffIncludeSection(st, synced[0], base, nil).IsEmpty())
+	})
+
+	t.Run("variables of base template are evaluated before diff", func(t *testing.T) {
+		// given
+		st := newObject(t)
+		base := newBaseTemplate()
+		base.Get("notes").Model().GetText().Text = "{{date}}"
+		section := FindIncludeSections(st)[0]
+		require.NoError(t, SyncIncludeSection(st, section, base, nil))
+		evaluate := func(fresh *state.State) {
+			fresh.Get("notes").Model().GetText().Text = "2026-10-19"
+		}
+		st.Iterate(func(b simple.Block) bool {
+			if text := b.Model().GetText(); text != nil && text.Text == "{{date}}" {
+				st.Get(b.Model().Id).Model().GetText().Text = "2026-10-19"
+			}
+			return true
+		})
+		section = FindIncludeSections(st)[0]
+
+		// when
+		diff := DiffIncludeSection(st, section, base, evaluate)
+
+		// then
+		assert.True(t, diff.IsEmpty())
+		assert.Equal(t, "{{date}}", base.Pick("notes").Model().GetText().Text)
+	})
+
+	t.Run("sections without sync are ignored", func(t *testing.T) {
+		// given
+		st := newIncludeState("object", newIncludeBlock("inc", "base", false))
+		require.NoError(t, ResolveIncludes(st, getterOf(newBaseTemplate())))
+
+		// when
+		sections := FindIncludeSections(st)
+
+		// then
+		assert.Empty(t, sections)
+	})
+}
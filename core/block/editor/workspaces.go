@@ -1,6 +1,8 @@
 package editor
 
 import (
+	"time"
+
 	"github.com/anyproto/any-sync/commonspace/object/acl/list"
 
 	"github.com/anyproto/anytype-heart/core/anytype/config"
@@ -176,6 +178,16 @@ func (w *Workspaces) SetInviteFileInfo(info domain.InviteInfo) (err error) {
 	st.SetDetailAndBundledRelation(bundle.RelationKeySpaceInviteType, domain.Int64(info.InviteType))
 	st.SetDetailAndBundledRelation(bundle.RelationKeySpaceInviteFileCid, domain.String(info.InviteFileCid))
 	st.SetDetailAndBundledRelation(bundle.RelationKeySpaceInviteFileKey, domain.String(info.InviteFileKey))
+	if info.Limits.ExpiresAt.IsZero() {
+		st.RemoveDetail(bundle.RelationKeySpaceInviteExpiresAt)
+	} else {
+		st.SetDetailAndBundledRelation(bundle.RelationKeySpaceInviteExpiresAt, domain.Int64(info.Limits.ExpiresAt.Unix()))
+	}
+	if info.Limits.MaxUses <= 0 {
+		st.RemoveDetail(bundle.RelationKeySpaceInviteMaxUses)
+	} else {
+		st.SetDetailAndBundledRelation(bundle.RelationKeySpaceInviteMaxUses, domain.Int64(info.Limits.MaxUses))
+	}
 	return w.Apply(st)
 }
 
@@ -186,6 +198,10 @@ func (w *Workspaces) GetExistingInviteInfo() (inviteInfo domain.InviteInfo) {
 	inviteInfo.Permissions = domain.ConvertParticipantPermissions(model.ParticipantPermissions(details.GetInt64(bundle.RelationKeySpaceInvitePermissions)))
 	inviteInfo.InviteFileCid = details.GetString(bundle.RelationKeySpaceInviteFileCid)
 	inviteInfo.InviteFileKey = details.GetString(bundle.RelationKeySpaceInviteFileKey)
+	if expiresAt := details.GetInt64(bundle.RelationKeySpaceInviteExpiresAt); expiresAt > 0 {
+		inviteInfo.Limits.ExpiresAt = time.Unix(expiresAt, 0)
+	}
+	inviteInfo.Limits.MaxUses = int(details.GetInt64(bundle.RelationKeySpaceInviteMaxUses))
 	if inviteInfo.InviteType == domain.InviteTypeDefault {
 		inviteInfo.Permissions = list.AclPermissionsNone
 	}
@@ -199,7 +215,9 @@ func (w *Workspaces) RemoveExistingInviteInfo() (info domain.InviteInfo, err err
 		bundle.RelationKeySpaceInviteFileCid,
 		bundle.RelationKeySpaceInviteFileKey,
 		bundle.RelationKeySpaceInvitePermissions,
-		bundle.RelationKeySpaceInviteType)
+		bundle.RelationKeySpaceInviteType,
+		bundle.RelationKeySpaceInviteExpiresAt,
+		bundle.RelationKeySpaceInviteMaxUses)
 	return info, w.Apply(newState)
 }
 
@@ -0,0 +1,372 @@
+package findreplace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/anyproto/any-sync/app"
+	"go.uber.org/zap"
+
+	"github.com/anyproto/anytype-heart/core/block/cache"
+	"github.com/anyproto/anytype-heart/core/block/editor/collection"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/text"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	textutil "github.com/anyproto/anytype-heart/util/text"
+)
+
+const (
+	CName = "core.block.findreplace"
+
+	defaultPreviewLimit = 100
+)
+
+var log = logging.Logger(CName).Desugar()
+
+var (
+	ErrEmptyQuery   = errors.New("query is empty")
+	ErrInvalidScope = errors.New("scope should be a set or a collection")
+)
+
+// searchableLayouts are layouts of objects that contain editable text
+var searchableLayouts = []model.ObjectTypeLayout{
+	model.ObjectType_basic,
+	model.ObjectType_profile,
+	model.ObjectType_todo,
+	model.ObjectType_note,
+	model.ObjectType_bookmark,
+	model.ObjectType_set,
+	model.ObjectType_collection,
+}
+
+type Request struct {
+	SpaceId string
+	// ScopeId is an optional id of set or collection to search in
+	ScopeId       string
+	Query         string
+	CaseSensitive bool
+	WholeWord     bool
+	// IncludeRelations enables search in text relations in addition to text blocks
+	IncludeRelations bool
+}
+
+type Match struct {
+	// BlockId is set for matches in text blocks
+	BlockId string
+	// RelationKey is set for matches in relations
+	RelationKey domain.RelationKey
+	Text        string
+	Ranges      []*model.Range
+}
+
+type ObjectMatches struct {
+	ObjectId string
+	Name     string
+	Matches  []Match
+}
+
+type ReplaceResult struct {
+	ObjectsChanged int
+	Replacements   int
+}
+
+type Service interface {
+	app.Component
+
+	// Preview returns matches grouped by object. Candidates are narrowed using the full-text index
+	Preview(ctx context.Context, req Request, limit int) ([]ObjectMatches, error)
+	// Replace replaces all matches in candidate objects, or only in objectIds if they are set. objectIds outside
+	// of the space and the scope are skipped. Every object is changed with a separate state change, so it can be undone in the object
+	Replace(ctx context.Context, req Request, replacement string, objectIds []string) (ReplaceResult, error)
+}
+
+type service struct {
+	picker      cache.ObjectGetter
+	objectStore objectstore.ObjectStore
+}
+
+func New() Service {
+	return &service{}
+}
+
+func (s *service) Init(a *app.App) error {
+	s.picker = app.MustComponent[cache.ObjectGetter](a)
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	return nil
+}
+
+func (s *service) Name() string {
+	return CName
+}
+
+func (s *service) Preview(ctx context.Context, req Request, limit int) ([]ObjectMatches, error) {
+	if req.Query == "" {
+		return nil, ErrEmptyQuery
+	}
+	if limit <= 0 {
+		limit = defaultPreviewLimit
+	}
+	candidates, err := s.candidates(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []ObjectMatches
+	for _, id := range candidates {
+		if len(result) >= limit {
+			break
+		}
+		var objectMatches ObjectMatches
+		err = cache.DoContextFullID(s.picker, ctx, domain.FullID{SpaceID: req.SpaceId, ObjectID: id}, func(sb smartblock.SmartBlock) error {
+			st := sb.NewState()
+			objectMatches = ObjectMatches{
+				ObjectId: id,
+				Name:     st.Details().GetString(bundle.RelationKeyName),
+				Matches:  s.findInState(req, st),
+			}
+			return nil
+		})
+		if err != nil {
+			log.Warn("failed to search in object", zap.String("objectId", id), zap.Error(err))
+			continue
+		}
+		if len(objectMatches.Matches) > 0 {
+			result = append(result, objectMatches)
+		}
+	}
+	return result, nil
+}
+
+func (s *service) Replace(ctx context.Context, req Request, replacement string, objectIds []string) (ReplaceResult, error) {
+	if req.Query == "" {
+		return ReplaceResult{}, ErrEmptyQuery
+	}
+	var err error
+	if len(objectIds) == 0 {
+		objectIds, err = s.candidates(ctx, req)
+	} else {
+		objectIds, err = s.objectsInScope(ctx, req, objectIds)
+	}
+	if err != nil {
+		return ReplaceResult{}, err
+	}
+
+	var (
+		result ReplaceResult
+		errs   []error
+	)
+	for _, id := range objectIds {
+		var replacements int
+		err := cache.DoContextFullID(s.picker, ctx, domain.FullID{SpaceID: req.SpaceId, ObjectID: id}, func(sb smartblock.SmartBlock) error {
+			st := sb.NewState()
+			replacements = s.replaceInState(req, st, replacement)
+			if replacements == 0 {
+				return nil
+			}
+			return sb.Apply(st)
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("replace in object %s: %w", id, err))
+			continue
+		}
+		if replacements > 0 {
+			result.ObjectsChanged++
+			result.Replacements += replacements
+		}
+	}
+	return result, errors.Join(errs...)
+}
+
+// candidates returns ids of objects that could contain the query according to the full-text index
+func (s *service) candidates(ctx context.Context, req Request) ([]string, error) {
+	filters, err := s.searchFilters(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return s.queryIds(req.SpaceId, req.Query, filters)
+}
+
+// objectsInScope returns the objects requested by the client that belong to the space and the scope of the search.
+// The full-text index is not used here, because it may not have the latest changes of the objects yet
+func (s *service) objectsInScope(ctx context.Context, req Request, objectIds []string) ([]string, error) {
+	filters, err := s.searchFilters(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	filters = append(filters, database.FilterRequest{
+		RelationKey: bundle.RelationKeyId,
+		Condition:   model.BlockContentDataviewFilter_In,
+		Value:       domain.StringList(objectIds),
+	})
+	return s.queryIds(req.SpaceId, "", filters)
+}
+
+func (s *service) searchFilters(ctx context.Context, req Request) ([]database.FilterRequest, error) {
+	filters := []database.FilterRequest{
+		{
+			RelationKey: bundle.RelationKeyResolvedLayout,
+			Condition:   model.BlockContentDataviewFilter_In,
+			Value:       domain.Int64List(searchableLayouts),
+		},
+	}
+	if req.ScopeId != "" {
+		scopeFilter, err := s.scopeFilter(ctx, req.SpaceId, req.ScopeId)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, scopeFilter)
+	}
+	return filters, nil
+}
+
+func (s *service) queryIds(spaceId, textQuery string, filters []database.FilterRequest) ([]string, error) {
+	records, err := s.objectStore.SpaceIndex(spaceId).Query(database.Query{
+		SpaceId:   spaceId,
+		TextQuery: textQuery,
+		Filters:   filters,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	ids := make([]string, 0, len(records))
+	for _, rec := range records {
+		ids = append(ids, rec.Details.GetString(bundle.RelationKeyId))
+	}
+	return ids, nil
+}
+
+func (s *service) scopeFilter(ctx context.Context, spaceId, scopeId string) (database.FilterRequest, error) {
+	details, err := s.objectStore.SpaceIndex(spaceId).GetDetails(scopeId)
+	if err != nil {
+		return database.FilterRequest{}, fmt.Errorf("get scope details: %w", err)
+	}
+	switch model.ObjectTypeLayout(details.GetInt64(bundle.RelationKeyResolvedLayout)) {
+	case model.ObjectType_collection:
+		var ids []string
+		err = cache.DoContextFullID(s.picker, ctx, domain.FullID{SpaceID: spaceId, ObjectID: scopeId}, func(c collection.Collection) error {
+			ids = c.ListIdsFromCollection()
+			return nil
+		})
+		if err != nil {
+			return database.FilterRequest{}, fmt.Errorf("list collection: %w", err)
+		}
+		return database.FilterRequest{
+			RelationKey: bundle.RelationKeyId,
+			Condition:   model.BlockContentDataviewFilter_In,
+			Value:       domain.StringList(ids),
+		}, nil
+	case model.ObjectType_set:
+		return database.FilterRequest{
+			RelationKey: bundle.RelationKeyType,
+			Condition:   model.BlockContentDataviewFilter_In,
+			Value:       domain.StringList(details.GetStringList(bundle.RelationKeySetOf)),
+		}, nil
+	default:
+		return database.FilterRequest{}, ErrInvalidScope
+	}
+}
+
+func (s *service) findInState(req Request, st *state.State) []Match {
+	opts := text.FindOptions{CaseSensitive: req.CaseSensitive, WholeWord: req.WholeWord}
+	var (
+		matches   []Match
+		boundKeys []domain.RelationKey
+	)
+	_ = st.Iterate(func(b simple.Block) (isContinue bool) {
+		tb, ok := b.(text.Block)
+		if !ok {
+			return true
+		}
+		// title and description blocks are bound to relations
+		if keys := pbtypes.GetStringList(b.Model().GetFields(), text.DetailsKeyFieldName); len(keys) > 0 {
+			boundKeys = append(boundKeys, domain.RelationKey(keys[0]))
+		}
+		if ranges := text.FindAll(tb.GetText(), req.Query, opts); len(ranges) > 0 {
+			matches = append(matches, Match{
+				BlockId: b.Model().Id,
+				Text:    tb.GetText(),
+				Ranges:  ranges,
+			})
+		}
+		return true
+	})
+	if !req.IncludeRelations {
+		return matches
+	}
+
+	for key, value := range st.Details().IterateSorted() {
+		str, ok := value.TryString()
+		if !ok || slices.Contains(boundKeys, key) || !s.isReplaceableRelation(req.SpaceId, key) {
+			continue
+		}
+		if ranges := text.FindAll(str, req.Query, opts); len(ranges) > 0 {
+			matches = append(matches, Match{
+				RelationKey: key,
+				Text:        str,
+				Ranges:      ranges,
+			})
+		}
+	}
+	return matches
+}
+
+func (s *service) replaceInState(req Request, st *state.State, replacement string) (replacements int) {
+	for _, match := range s.findInState(req, st) {
+		if match.BlockId != "" {
+			tb, ok := st.Get(match.BlockId).(text.Block)
+			if !ok {
+				continue
+			}
+			if err := tb.ReplaceRanges(match.Ranges, replacement); err != nil {
+				log.Warn("failed to replace text in block", zap.String("blockId", match.BlockId), zap.Error(err))
+				continue
+			}
+		} else {
+			st.SetDetail(match.RelationKey, domain.String(replaceRanges(match.Text, match.Ranges, replacement)))
+		}
+		replacements += len(match.Ranges)
+	}
+	return replacements
+}
+
+// isReplaceableRelation checks that relation is a text relation that could be changed by user
+func (s *service) isReplaceableRelation(spaceId string, key domain.RelationKey) bool {
+	if slices.Contains(bundle.LocalAndDerivedRelationKeys, key) {
+		return false
+	}
+	if rel, err := bundle.GetRelation(key); err == nil && rel.ReadOnly {
+		return false
+	}
+	format, err := s.objectStore.SpaceIndex(spaceId).GetRelationFormatByKey(key)
+	if err != nil {
+		return false
+	}
+	return format == model.RelationFormat_shorttext || format == model.RelationFormat_longtext
+}
+
+// replaceRanges replaces sorted non-overlapping UTF-16 ranges of the string, like text.Block.ReplaceRanges does for blocks
+func replaceRanges(s string, ranges []*model.Range, replacement string) string {
+	runes := textutil.StrToUTF16(s)
+	newRunes := textutil.StrToUTF16(replacement)
+	var (
+		result []uint16
+		prev   int32
+	)
+	for _, r := range ranges {
+		result = append(result, runes[prev:r.From]...)
+		result = append(result, newRunes...)
+		prev = r.To
+	}
+	result = append(result, runes[prev:]...)
+	return textutil.UTF16ToStr(result)
+}
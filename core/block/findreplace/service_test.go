@@ -0,0 +1,158 @@
+package findreplace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/cache/mock_cache"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock/smarttest"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/text"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const spaceId = "space1"
+
+type fixture struct {
+	*service
+	sb          *smarttest.SmartTest
+	objectStore *objectstore.StoreFixture
+}
+
+func newFixture(t *testing.T) *fixture {
+	sb := smarttest.New("obj1")
+	sb.AddBlock(simple.New(&model.Block{Id: "obj1", ChildrenIds: []string{"title", "text"}}))
+	sb.AddBlock(text.NewDetails(&model.Block{
+		Id:      "title",
+		Content: &model.BlockContentOfText{Text: &model.BlockContentText{}},
+		Fields: &types.Struct{Fields: map[string]*types.Value{
+			text.DetailsKeyFieldName: pbtypes.String(bundle.RelationKeyName.String()),
+		}},
+	}, text.DetailsKeys{Text: bundle.RelationKeyName}))
+	sb.AddBlock(simple.New(&model.Block{
+		Id: "text",
+		Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+			Text: "Acme sells acme products",
+			Marks: &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{
+				{Type: model.BlockContentTextMark_Bold, Range: &model.Range{From: 0, To: 4}},
+			}},
+		}},
+	}))
+	st := sb.Doc.(*state.State)
+	st.SetDetails(domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+		bundle.RelationKeyName:        domain.String("About Acme"),
+		bundle.RelationKeyDescription: domain.String("Acme description"),
+	}))
+	st.BlocksInit(st)
+
+	picker := mock_cache.NewMockObjectGetter(t)
+	picker.EXPECT().GetObjectByFullID(mock.Anything, domain.FullID{SpaceID: spaceId, ObjectID: "obj1"}).Return(sb, nil).Maybe()
+
+	objectStore := objectstore.NewStoreFixture(t)
+	objectStore.AddObjects(t, spaceId, []objectstore.TestObject{
+		{
+			bundle.RelationKeyId:             domain.String("obj1"),
+			bundle.RelationKeyResolvedLayout: domain.Int64(model.ObjectType_basic),
+		},
+		{
+			bundle.RelationKeyId:             domain.String("file1"),
+			bundle.RelationKeyResolvedLayout: domain.Int64(model.ObjectType_file),
+		},
+	})
+
+	return &fixture{
+		service: &service{
+			picker:      picker,
+			objectStore: objectStore,
+		},
+		sb:          sb,
+		objectStore: objectStore,
+	}
+}
+
+func TestService_Replace(t *testing.T) {
+	t.Run("empty query", func(t *testing.T) {
+		fx := newFixture(t)
+
+		_, err := fx.Replace(context.Background(), Request{SpaceId: spaceId}, "x", []string{"obj1"})
+		assert.ErrorIs(t, err, ErrEmptyQuery)
+	})
+
+	t.Run("replace in blocks", func(t *testing.T) {
+		fx := newFixture(t)
+
+		res, err := fx.Replace(context.Background(), Request{SpaceId: spaceId, Query: "acme"}, "Zenith", []string{"obj1"})
+		require.NoError(t, err)
+
+		assert.Equal(t, ReplaceResult{ObjectsChanged: 1, Replacements: 3}, res)
+		st := fx.sb.NewState()
+		textBlock := st.Pick("text").Model().GetText()
+		assert.Equal(t, "Zenith sells Zenith products", textBlock.Text)
+		assert.Equal(t, &model.Range{From: 0, To: 6}, textBlock.Marks.Marks[0].Range)
+		assert.Equal(t, "About Zenith", st.Details().GetString(bundle.RelationKeyName))
+		assert.Equal(t, "Acme description", st.Details().GetString(bundle.RelationKeyDescription))
+		// all replacements in the object are undone at once
+		undo, _ := fx.sb.History().Counters()
+		assert.Equal(t, int32(1), undo)
+	})
+
+	t.Run("replace in relations", func(t *testing.T) {
+		fx := newFixture(t)
+
+		res, err := fx.Replace(context.Background(), Request{
+			SpaceId:          spaceId,
+			Query:            "Acme",
+			CaseSensitive:    true,
+			WholeWord:        true,
+			IncludeRelations: true,
+		}, "Zenith", []string{"obj1"})
+		require.NoError(t, err)
+
+		assert.Equal(t, ReplaceResult{ObjectsChanged: 1, Replacements: 3}, res)
+		st := fx.sb.NewState()
+		assert.Equal(t, "Zenith sells acme products", st.Pick("text").Model().GetText().Text)
+		assert.Equal(t, "About Zenith", st.Details().GetString(bundle.RelationKeyName))
+		assert.Equal(t, "Zenith description", st.Details().GetString(bundle.RelationKeyDescription))
+	})
+
+	t.Run("objects out of scope are skipped", func(t *testing.T) {
+		fx := newFixture(t)
+		fx.objectStore.AddObjects(t, spaceId, []objectstore.TestObject{
+			{
+				bundle.RelationKeyId:             domain.String("set1"),
+				bundle.RelationKeyResolvedLayout: domain.Int64(model.ObjectType_set),
+				bundle.RelationKeySetOf:          domain.StringList([]string{"otherType"}),
+			},
+		})
+
+		res, err := fx.Replace(context.Background(), Request{SpaceId: spaceId, Query: "acme"}, "Zenith", []string{"file1", "otherSpaceObject"})
+		require.NoError(t, err)
+		assert.Equal(t, ReplaceResult{}, res)
+
+		res, err = fx.Replace(context.Background(), Request{SpaceId: spaceId, ScopeId: "set1", Query: "acme"}, "Zenith", []string{"obj1"})
+		require.NoError(t, err)
+		assert.Equal(t, ReplaceResult{}, res)
+		assert.Equal(t, "Acme sells acme products", fx.sb.NewState().Pick("text").Model().GetText().Text)
+	})
+
+	t.Run("nothing found", func(t *testing.T) {
+		fx := newFixture(t)
+
+		res, err := fx.Replace(context.Background(), Request{SpaceId: spaceId, Query: "missing"}, "x", []string{"obj1"})
+		require.NoError(t, err)
+
+		assert.Equal(t, ReplaceResult{}, res)
+		undo, _ := fx.sb.History().Counters()
+		assert.Equal(t, int32(0), undo)
+	})
+}
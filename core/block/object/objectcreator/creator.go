@@ -8,6 +8,7 @@ import (
 	"github.com/anyproto/any-sync/app"
 	"github.com/pkg/errors"
 
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
 	"github.com/anyproto/anytype-heart/core/block/editor/state"
 	"github.com/anyproto/anytype-heart/core/block/restriction"
 	"github.com/anyproto/anytype-heart/core/block/source/sourceimpl"
@@ -34,6 +35,10 @@ type (
 	objectArchiver interface {
 		SetIsArchived(ctx context.Context, objectId string, isArchived bool) error
 	}
+
+	accountService interface {
+		MyParticipantId(spaceId string) string
+	}
 )
 
 const CName = "objectCreator"
@@ -58,6 +63,8 @@ type service struct {
 	spaceService    space.Service
 	templateService template.Service
 	archiver        objectArchiver
+	accountService  accountService
+	memberRoles     smartblock.MemberRoleProvider
 }
 
 func NewCreator() Service {
@@ -70,6 +77,8 @@ func (s *service) Init(a *app.App) (err error) {
 	s.spaceService = app.MustComponent[space.Service](a)
 	s.templateService = app.MustComponent[template.Service](a)
 	s.archiver = app.MustComponent[objectArchiver](a)
+	s.accountService = app.MustComponent[accountService](a)
+	s.memberRoles = app.MustComponent[smartblock.MemberRoleProvider](a)
 	return nil
 }
 
@@ -84,6 +93,8 @@ type CreateObjectRequest struct {
 	TemplateId    string
 	ObjectTypeKey domain.TypeKey
 	UniqueKey     domain.UniqueKey
+	// TemplateVariables are used to evaluate variables of the template
+	TemplateVariables template.Variables
 }
 
 // CreateObject is high-level method for creating new objects
@@ -92,6 +103,9 @@ func (s *service) CreateObject(ctx context.Context, spaceID string, req CreateOb
 	if err != nil {
 		return "", nil, fmt.Errorf("get space: %w", err)
 	}
+	if err = s.checkMemberCanCreate(space); err != nil {
+		return "", nil, err
+	}
 	return s.createObjectInSpace(ctx, space, req)
 }
 
@@ -120,7 +134,6 @@ func (s *service) createObjectInSpace(
 	if t, e := bundle.GetType(req.ObjectTypeKey); e == nil && t.RestrictObjectCreation && req.ObjectTypeKey != bundle.TypeKeyTemplate {
 		return "", nil, errors.Wrap(restriction.ErrRestricted, "creation of this object type is restricted")
 	}
-
 	switch req.ObjectTypeKey {
 	case bundle.TypeKeyBookmark:
 		return s.bookmarkService.CreateObjectAndFetch(ctx, space.Id(), req.TemplateId, details)
@@ -198,6 +211,7 @@ func (s *service) createCommonObject(
 		Layout:                 layout,
 		Details:                details,
 		WithTemplateValidation: true,
+		Variables:              req.TemplateVariables,
 	})
 	if err != nil {
 		return
@@ -246,3 +260,15 @@ func setOriginalCreatedTimestamp(state *state.State, details *domain.Details) {
 		state.SetOriginalCreatedTimestamp(createDate)
 	}
 }
+
+// checkMemberCanCreate rejects creation of objects by participants with commenter role, they could only write to chats
+func (s *service) checkMemberCanCreate(space clientspace.Space) error {
+	if space.IsPersonal() {
+		return nil
+	}
+	role := s.memberRoles.MemberRole(space.Id(), space.DerivedIDs().Workspace, s.accountService.MyParticipantId(space.Id()))
+	if role.Type == domain.MemberRoleCommenter {
+		return errors.Wrap(restriction.ErrRestricted, "commenters can't create objects")
+	}
+	return nil
+}
@@ -35,6 +35,7 @@ type fixture struct {
 func newFixture(t *testing.T) *fixture {
 	spaceService := mock_space.NewMockService(t)
 	spc := mock_clientspace.NewMockSpace(t)
+	spc.EXPECT().IsPersonal().Return(true).Maybe()
 
 	templateSvc := mock_template.NewMockService(t)
 	store := objectstore.NewStoreFixture(t)
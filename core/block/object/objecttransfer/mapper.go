@@ -0,0 +1,344 @@
+package objecttransfer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/anyproto/anytype-heart/core/block/object/objectcreator"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/domain/objectorigin"
+	"github.com/anyproto/anytype-heart/core/files"
+	"github.com/anyproto/anytype-heart/core/files/fileobject"
+	"github.com/anyproto/anytype-heart/core/files/filestorage/rpcstore"
+	"github.com/anyproto/anytype-heart/core/files/fileuploader"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	coresb "github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/space/clientspace"
+)
+
+var (
+	typeKeysToCopy = []domain.RelationKey{
+		bundle.RelationKeyUniqueKey,
+		bundle.RelationKeyName,
+		bundle.RelationKeyPluralName,
+		bundle.RelationKeyDescription,
+		bundle.RelationKeyIconEmoji,
+		bundle.RelationKeyIconName,
+		bundle.RelationKeyIconOption,
+		bundle.RelationKeyRecommendedLayout,
+		bundle.RelationKeyLayoutAlign,
+	}
+	// typeRelationListKeys contain ids of relations, so they are mapped as well
+	typeRelationListKeys = []domain.RelationKey{
+		bundle.RelationKeyRecommendedRelations,
+		bundle.RelationKeyRecommendedFeaturedRelations,
+		bundle.RelationKeyRecommendedHiddenRelations,
+		bundle.RelationKeyRecommendedFileRelations,
+	}
+	relationKeysToCopy = []domain.RelationKey{
+		bundle.RelationKeyRelationKey,
+		bundle.RelationKeyName,
+		bundle.RelationKeyDescription,
+		bundle.RelationKeyRelationFormat,
+		bundle.RelationKeyRelationMaxCount,
+	}
+	optionKeysToCopy = []domain.RelationKey{
+		bundle.RelationKeyUniqueKey,
+		bundle.RelationKeyRelationKey,
+		bundle.RelationKeyName,
+		bundle.RelationKeyRelationOptionColor,
+	}
+)
+
+// idMapper maps ids of types, relations, options and files of the source space to their equivalents in the target space.
+// Equivalents are looked up by unique key, missing ones are installed from the library or created as copies
+type idMapper struct {
+	sourceSpaceId     string
+	target            clientspace.Space
+	objectStore       objectstore.ObjectStore
+	objectCreator     objectcreator.Service
+	fileObjectService fileobject.Service
+	fileUploader      fileuploader.Service
+	tempDirProvider   core.TempDirProvider
+	startedAt         time.Time
+
+	ids map[string]string
+	// created contains ids of objects created in the target space, so they are removed if the transfer fails
+	created []string
+}
+
+func newIdMapper(s *service, sourceSpaceId string, target clientspace.Space) *idMapper {
+	return &idMapper{
+		sourceSpaceId:     sourceSpaceId,
+		target:            target,
+		objectStore:       s.objectStore,
+		objectCreator:     s.objectCreator,
+		fileObjectService: s.fileObjectService,
+		fileUploader:      s.fileUploader,
+		tempDirProvider:   s.tempDirProvider,
+		startedAt:         time.Now(),
+		ids:               make(map[string]string),
+	}
+}
+
+// mapId returns id of the equivalent object in the target space. ok is false for objects that are not
+// types, relations, options or files, such objects should be transferred themselves or kept as is
+func (m *idMapper) mapId(ctx context.Context, id string) (newId string, ok bool, err error) {
+	if newId, ok = m.ids[id]; ok {
+		return newId, true, nil
+	}
+	details, err := m.objectStore.SpaceIndex(m.sourceSpaceId).GetDetails(id)
+	if err != nil {
+		return "", false, fmt.Errorf("get details: %w", err)
+	}
+	layout := model.ObjectTypeLayout(details.GetInt64(bundle.RelationKeyResolvedLayout))
+	switch {
+	case layout == model.ObjectType_objectType:
+		newId, err = m.ensureType(ctx, details)
+	case layout == model.ObjectType_relation:
+		newId, err = m.ensureRelation(ctx, domain.RelationKey(details.GetString(bundle.RelationKeyRelationKey)))
+	case layout == model.ObjectType_relationOption:
+		newId, err = m.ensureOption(ctx, details)
+	case slices.Contains(domain.FileLayouts, layout):
+		newId, err = m.uploadFile(ctx, id, layout)
+	default:
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	m.ids[id] = newId
+	return newId, true, nil
+}
+
+func (m *idMapper) ensureTypeByKey(ctx context.Context, key domain.TypeKey) (string, error) {
+	uk, err := domain.NewUniqueKey(coresb.SmartBlockTypeObjectType, key.String())
+	if err != nil {
+		return "", err
+	}
+	details, err := m.objectStore.SpaceIndex(m.sourceSpaceId).GetObjectByUniqueKey(uk)
+	if err != nil {
+		if !bundle.HasObjectTypeByKey(key) {
+			return "", fmt.Errorf("get type %s: %w", key, err)
+		}
+		details = domain.NewDetails()
+		details.SetString(bundle.RelationKeyUniqueKey, uk.Marshal())
+	}
+	return m.ensureType(ctx, details)
+}
+
+func (m *idMapper) ensureType(ctx context.Context, details *domain.Details) (string, error) {
+	uk, err := domain.UnmarshalUniqueKey(details.GetString(bundle.RelationKeyUniqueKey))
+	if err != nil {
+		return "", fmt.Errorf("unmarshal unique key: %w", err)
+	}
+	if id, ok := m.findByUniqueKey(uk); ok {
+		return id, nil
+	}
+	key := domain.TypeKey(uk.InternalKey())
+	if bundle.HasObjectTypeByKey(key) {
+		return m.installBundled(ctx, key.BundledURL())
+	}
+
+	newDetails := details.CopyOnlyKeys(typeKeysToCopy...)
+	for _, listKey := range typeRelationListKeys {
+		relationIds := details.GetStringList(listKey)
+		if len(relationIds) == 0 {
+			continue
+		}
+		newIds := make([]string, 0, len(relationIds))
+		for _, relationId := range relationIds {
+			newId, ok, err := m.mapId(ctx, relationId)
+			if err != nil {
+				return "", fmt.Errorf("map recommended relation: %w", err)
+			}
+			if ok {
+				newIds = append(newIds, newId)
+			}
+		}
+		newDetails.SetStringList(listKey, newIds)
+	}
+	id, _, err := m.objectCreator.CreateObject(ctx, m.target.Id(), objectcreator.CreateObjectRequest{
+		ObjectTypeKey: bundle.TypeKeyObjectType,
+		Details:       newDetails,
+	})
+	if err != nil {
+		return "", fmt.Errorf("create type %s: %w", key, err)
+	}
+	m.created = append(m.created, id)
+	return id, nil
+}
+
+func (m *idMapper) ensureRelation(ctx context.Context, key domain.RelationKey) (string, error) {
+	uk, err := domain.NewUniqueKey(coresb.SmartBlockTypeRelation, key.String())
+	if err != nil {
+		return "", err
+	}
+	if id, ok := m.findByUniqueKey(uk); ok {
+		return id, nil
+	}
+	if bundle.HasRelation(key) {
+		return m.installBundled(ctx, key.BundledURL())
+	}
+	details, err := m.objectStore.SpaceIndex(m.sourceSpaceId).GetObjectByUniqueKey(uk)
+	if err != nil {
+		return "", fmt.Errorf("get relation %s: %w", key, err)
+	}
+	id, _, err := m.objectCreator.CreateObject(ctx, m.target.Id(), objectcreator.CreateObjectRequest{
+		ObjectTypeKey: bundle.TypeKeyRelation,
+		Details:       details.CopyOnlyKeys(relationKeysToCopy...),
+	})
+	if err != nil {
+		return "", fmt.Errorf("create relation %s: %w", key, err)
+	}
+	m.created = append(m.created, id)
+	return id, nil
+}
+
+func (m *idMapper) ensureOption(ctx context.Context, details *domain.Details) (string, error) {
+	uk, err := domain.UnmarshalUniqueKey(details.GetString(bundle.RelationKeyUniqueKey))
+	if err == nil {
+		if id, ok := m.findByUniqueKey(uk); ok {
+			return id, nil
+		}
+	}
+	relationKey := domain.RelationKey(details.GetString(bundle.RelationKeyRelationKey))
+	if _, err = m.ensureRelation(ctx, relationKey); err != nil {
+		return "", err
+	}
+
+	// options with the same name are considered equal, so we don't create duplicates in the target space
+	ids, _, err := m.objectStore.SpaceIndex(m.target.Id()).QueryObjectIds(database.Query{
+		Filters: []database.FilterRequest{
+			{
+				RelationKey: bundle.RelationKeyResolvedLayout,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       domain.Int64(model.ObjectType_relationOption),
+			},
+			{
+				RelationKey: bundle.RelationKeyRelationKey,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       domain.String(relationKey.String()),
+			},
+			{
+				RelationKey: bundle.RelationKeyName,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       domain.String(details.GetString(bundle.RelationKeyName)),
+			},
+		},
+	})
+	if err == nil && len(ids) > 0 {
+		return ids[0], nil
+	}
+
+	id, _, err := m.objectCreator.CreateObject(ctx, m.target.Id(), objectcreator.CreateObjectRequest{
+		ObjectTypeKey: bundle.TypeKeyRelationOption,
+		Details:       details.CopyOnlyKeys(optionKeysToCopy...),
+	})
+	if err != nil {
+		return "", fmt.Errorf("create option: %w", err)
+	}
+	m.created = append(m.created, id)
+	return id, nil
+}
+
+// uploadFile uploads the content of the file object to the target space. The data is read from the source space,
+// because the file node stores files per space and the target space has no access to them
+func (m *idMapper) uploadFile(ctx context.Context, fileObjectId string, layout model.ObjectTypeLayout) (string, error) {
+	ctx = rpcstore.ContextWithWaitAvailable(ctx)
+	var (
+		file files.File
+		err  error
+	)
+	if layout == model.ObjectType_image {
+		var img files.Image
+		img, err = m.fileObjectService.GetImageData(ctx, fileObjectId)
+		if err == nil {
+			file, err = img.GetOriginalFile()
+		}
+	} else {
+		file, err = m.fileObjectService.GetFileData(ctx, fileObjectId)
+	}
+	if err != nil {
+		return "", fmt.Errorf("get file data: %w", err)
+	}
+	path, err := m.copyToTempFile(ctx, file)
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(path)
+
+	res := m.fileUploader.NewUploader(m.target.Id(), objectorigin.None()).
+		SetName(file.Name()).
+		SetFile(path).
+		Upload(ctx)
+	if res.Err != nil {
+		return "", fmt.Errorf("upload file: %w", res.Err)
+	}
+	// uploader returns the existing file object if the target space already has the same file
+	if m.isNewObject(res.FileObjectId) {
+		m.created = append(m.created, res.FileObjectId)
+	}
+	return res.FileObjectId, nil
+}
+
+// copyToTempFile streams the file content to a temporary file, so big files are not loaded into memory
+func (m *idMapper) copyToTempFile(ctx context.Context, file files.File) (string, error) {
+	reader, err := file.Reader(ctx)
+	if err != nil {
+		return "", fmt.Errorf("get file reader: %w", err)
+	}
+	if closer, ok := reader.(io.Closer); ok {
+		defer closer.Close()
+	}
+	tmpFile, err := os.CreateTemp(m.tempDirProvider.TempDir(), "anytype_transferred_file_*")
+	if err != nil {
+		return "", fmt.Errorf("create temp file: %w", err)
+	}
+	_, err = io.Copy(tmpFile, reader)
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpFile.Name())
+		return "", fmt.Errorf("copy file to temp file: %w", err)
+	}
+	return tmpFile.Name(), nil
+}
+
+// isNewObject reports whether the object of the target space was created after the transfer started
+func (m *idMapper) isNewObject(id string) bool {
+	details, err := m.objectStore.SpaceIndex(m.target.Id()).GetDetails(id)
+	if err != nil {
+		return true
+	}
+	return details.GetInt64(bundle.RelationKeyCreatedDate) >= m.startedAt.Unix()
+}
+
+func (m *idMapper) findByUniqueKey(uk domain.UniqueKey) (string, bool) {
+	details, err := m.objectStore.SpaceIndex(m.target.Id()).GetObjectByUniqueKey(uk)
+	if err != nil || details.GetBool(bundle.RelationKeyIsDeleted) {
+		return "", false
+	}
+	return details.GetString(bundle.RelationKeyId), true
+}
+
+func (m *idMapper) installBundled(ctx context.Context, sourceId string) (string, error) {
+	ids, _, err := m.objectCreator.InstallBundledObjects(ctx, m.target, []string{sourceId})
+	if err != nil {
+		return "", fmt.Errorf("install %s: %w", sourceId, err)
+	}
+	if len(ids) == 0 {
+		return "", fmt.Errorf("install %s: no objects installed", sourceId)
+	}
+	m.created = append(m.created, ids[0])
+	return ids[0], nil
+}
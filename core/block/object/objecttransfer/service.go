@@ -0,0 +1,427 @@
+package objecttransfer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/anyproto/any-sync/app"
+	"go.uber.org/zap"
+
+	"github.com/anyproto/anytype-heart/core/block/cache"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/object/objectcreator"
+	"github.com/anyproto/anytype-heart/core/block/object/objectlink"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/files/fileobject"
+	"github.com/anyproto/anytype-heart/core/files/fileuploader"
+	"github.com/anyproto/anytype-heart/core/relationutils"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/space"
+	"github.com/anyproto/anytype-heart/space/clientspace"
+)
+
+const CName = "core.block.object.objecttransfer"
+
+var log = logging.Logger(CName).Desugar()
+
+var (
+	ErrSameSpace       = errors.New("source and target spaces are the same")
+	ErrNoObjects       = errors.New("no objects to transfer")
+	ErrNotTransferable = errors.New("object can't be transferred to another space")
+	ErrCanceled        = errors.New("transfer is canceled")
+)
+
+// transferableLayouts are layouts of objects that could be recreated in another space.
+// Types, relations, options and files are not transferred, but mapped to their equivalents in the target space
+var transferableLayouts = []model.ObjectTypeLayout{
+	model.ObjectType_basic,
+	model.ObjectType_profile,
+	model.ObjectType_todo,
+	model.ObjectType_note,
+	model.ObjectType_bookmark,
+	model.ObjectType_set,
+	model.ObjectType_collection,
+}
+
+type Request struct {
+	SpaceId       string
+	TargetSpaceId string
+	ObjectIds     []string
+	// IncludeLinked adds objects linked from transferred objects, recursively
+	IncludeLinked bool
+	// Move rewrites links in objects of the source space to new objects and archives the originals
+	Move bool
+}
+
+type Service interface {
+	app.Component
+
+	// Transfer recreates objects in the target space and returns map of old ids to new ones.
+	// Transfer runs as a process that could be canceled, in this case created objects are removed
+	Transfer(ctx context.Context, req Request) (map[string]string, error)
+}
+
+type objectDeleter interface {
+	DeleteObjectByFullID(id domain.FullID) error
+}
+
+type objectArchiver interface {
+	SetIsArchived(ctx context.Context, objectId string, isArchived bool) error
+}
+
+type service struct {
+	picker            cache.ObjectGetter
+	objectStore       objectstore.ObjectStore
+	spaceService      space.Service
+	objectCreator     objectcreator.Service
+	fileObjectService fileobject.Service
+	fileUploader      fileuploader.Service
+	tempDirProvider   core.TempDirProvider
+	formatFetcher     relationutils.RelationFormatFetcher
+	processService    process.Service
+	deleter           objectDeleter
+	archiver          objectArchiver
+}
+
+func New() Service {
+	return &service{}
+}
+
+func (s *service) Init(a *app.App) error {
+	s.picker = app.MustComponent[cache.ObjectGetter](a)
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	s.spaceService = app.MustComponent[space.Service](a)
+	s.objectCreator = app.MustComponent[objectcreator.Service](a)
+	s.fileObjectService = app.MustComponent[fileobject.Service](a)
+	s.fileUploader = app.MustComponent[fileuploader.Service](a)
+	s.tempDirProvider = app.MustComponent[core.TempDirProvider](a)
+	s.formatFetcher = app.MustComponent[relationutils.RelationFormatFetcher](a)
+	s.processService = app.MustComponent[process.Service](a)
+	s.deleter = app.MustComponent[objectDeleter](a)
+	s.archiver = app.MustComponent[objectArchiver](a)
+	return nil
+}
+
+func (s *service) Name() string {
+	return CName
+}
+
+func (s *service) Transfer(ctx context.Context, req Request) (idsMap map[string]string, err error) {
+	if req.SpaceId == req.TargetSpaceId {
+		return nil, ErrSameSpace
+	}
+	if len(req.ObjectIds) == 0 {
+		return nil, ErrNoObjects
+	}
+	target, err := s.spaceService.Get(ctx, req.TargetSpaceId)
+	if err != nil {
+		return nil, fmt.Errorf("get target space: %w", err)
+	}
+
+	progress := process.NewProgress(&pb.ModelProcessMessageOfObjectTransfer{ObjectTransfer: &pb.ModelProcessObjectTransfer{}})
+	if err = s.processService.Add(progress); err != nil {
+		return nil, fmt.Errorf("add process: %w", err)
+	}
+	defer func() {
+		progress.Finish(err)
+	}()
+
+	progress.SetProgressMessage("collecting objects")
+	ids, err := s.collectObjects(req)
+	if err != nil {
+		return nil, err
+	}
+	// every object is created, then links in it are updated; for moves, referring objects are updated as well
+	total := int64(len(ids) * 2)
+	if req.Move {
+		total += int64(len(ids))
+	}
+	progress.SetTotal(total)
+
+	t := &transfer{
+		service:  s,
+		req:      req,
+		target:   target,
+		mapper:   newIdMapper(s, req.SpaceId, target),
+		progress: progress,
+		idsMap:   make(map[string]string, len(ids)),
+	}
+	if err = t.run(ctx, ids); err != nil {
+		t.rollback()
+		return nil, err
+	}
+	return t.idsMap, nil
+}
+
+// collectObjects returns requested objects and, if needed, objects linked from them in the source space
+func (s *service) collectObjects(req Request) ([]string, error) {
+	spaceIndex := s.objectStore.SpaceIndex(req.SpaceId)
+	var (
+		result  []string
+		visited = make(map[string]struct{})
+		queue   = slices.Clone(req.ObjectIds)
+	)
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if _, ok := visited[id]; ok {
+			continue
+		}
+		visited[id] = struct{}{}
+
+		details, err := spaceIndex.GetDetails(id)
+		if err != nil {
+			return nil, fmt.Errorf("get details of %s: %w", id, err)
+		}
+		layout := model.ObjectTypeLayout(details.GetInt64(bundle.RelationKeyResolvedLayout))
+		isRequested := slices.Contains(req.ObjectIds, id)
+		if !slices.Contains(transferableLayouts, layout) || details.GetString(bundle.RelationKeySpaceId) != req.SpaceId {
+			if isRequested {
+				return nil, fmt.Errorf("%w: %s", ErrNotTransferable, id)
+			}
+			continue
+		}
+		result = append(result, id)
+
+		if !req.IncludeLinked {
+			continue
+		}
+		links, err := spaceIndex.GetOutboundLinksById(id)
+		if err != nil {
+			return nil, fmt.Errorf("get links of %s: %w", id, err)
+		}
+		queue = append(queue, links...)
+	}
+	return result, nil
+}
+
+type transfer struct {
+	*service
+	req      Request
+	target   clientspace.Space
+	mapper   *idMapper
+	progress process.Progress
+
+	// idsMap contains ids of transferred objects
+	idsMap map[string]string
+}
+
+func (t *transfer) run(ctx context.Context, ids []string) error {
+	t.progress.SetProgressMessage("creating objects")
+	for _, id := range ids {
+		if err := t.step(); err != nil {
+			return err
+		}
+		newId, err := t.createCopy(ctx, id)
+		if err != nil {
+			return fmt.Errorf("copy object %s: %w", id, err)
+		}
+		t.idsMap[id] = newId
+	}
+
+	// links between transferred objects could be updated only after all of them are created
+	t.progress.SetProgressMessage("updating links")
+	for _, newId := range t.idsMap {
+		if err := t.step(); err != nil {
+			return err
+		}
+		err := cache.DoContextFullID(t.picker, ctx, domain.FullID{SpaceID: t.req.TargetSpaceId, ObjectID: newId}, func(sb smartblock.SmartBlock) error {
+			st := sb.NewState()
+			if err := t.rewriteIds(st, t.target.Id(), t.idsMap); err != nil {
+				return err
+			}
+			return sb.Apply(st)
+		})
+		if err != nil {
+			return fmt.Errorf("update links in %s: %w", newId, err)
+		}
+	}
+
+	if !t.req.Move {
+		return nil
+	}
+	t.progress.SetProgressMessage("moving objects")
+	referrers := make(map[string][]string, len(ids))
+	for _, id := range ids {
+		objectReferrers, err := t.referrers(id)
+		if err != nil {
+			return err
+		}
+		referrers[id] = objectReferrers
+	}
+	// rewriting links in objects of the source space and archiving are the last steps. They are not rolled back,
+	// so they are not canceled either: otherwise referring objects would point to removed copies
+	for _, id := range ids {
+		t.moveLinks(ctx, id, referrers[id])
+		t.progress.AddDone(1)
+	}
+	for _, id := range ids {
+		if err := t.archiver.SetIsArchived(ctx, id, true); err != nil {
+			log.Warn("failed to archive moved object", zap.String("objectId", id), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func (t *transfer) step() error {
+	if err := t.progress.TryStep(1); err != nil {
+		return ErrCanceled
+	}
+	return nil
+}
+
+// createCopy creates object in the target space from the state of source object.
+// Types, relations, options and files are mapped right away, links to other transferred objects are updated later
+func (t *transfer) createCopy(ctx context.Context, id string) (string, error) {
+	var (
+		st       *state.State
+		typeKeys []domain.TypeKey
+	)
+	err := cache.DoContextFullID(t.picker, ctx, domain.FullID{SpaceID: t.req.SpaceId, ObjectID: id}, func(sb smartblock.SmartBlock) error {
+		typeKeys = sb.ObjectTypeKeys()
+		st = sb.NewState().Copy()
+		st.SetLocalDetails(nil)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	for _, key := range typeKeys {
+		if _, err = t.mapper.ensureTypeByKey(ctx, key); err != nil {
+			return "", fmt.Errorf("ensure type: %w", err)
+		}
+	}
+	for _, key := range st.AllRelationKeys() {
+		if bundle.HasRelation(key) && bundle.IsSystemRelation(key) {
+			continue
+		}
+		if _, err = t.mapper.ensureRelation(ctx, key); err != nil {
+			return "", fmt.Errorf("ensure relation: %w", err)
+		}
+	}
+
+	mapped := make(map[string]string)
+	for _, depId := range t.dependentIds(st, t.req.SpaceId) {
+		newId, ok, err := t.mapper.mapId(ctx, depId)
+		if err != nil {
+			return "", fmt.Errorf("map %s: %w", depId, err)
+		}
+		if ok {
+			mapped[depId] = newId
+		}
+	}
+	if err = t.rewriteIds(st, t.req.SpaceId, mapped); err != nil {
+		return "", err
+	}
+
+	newId, _, err := t.objectCreator.CreateSmartBlockFromState(ctx, t.req.TargetSpaceId, typeKeys, st)
+	if err != nil {
+		return "", fmt.Errorf("create object: %w", err)
+	}
+	return newId, nil
+}
+
+// referrers returns objects of the source space that link to the moved object and are not moved themselves
+func (t *transfer) referrers(id string) ([]string, error) {
+	backlinks, err := t.objectStore.SpaceIndex(t.req.SpaceId).GetInboundLinksById(id)
+	if err != nil {
+		return nil, fmt.Errorf("get backlinks of %s: %w", id, err)
+	}
+	referrers := make([]string, 0, len(backlinks))
+	for _, referrerId := range backlinks {
+		if _, ok := t.idsMap[referrerId]; !ok {
+			referrers = append(referrers, referrerId)
+		}
+	}
+	return referrers, nil
+}
+
+// moveLinks replaces links to the moved object in referring objects of the source space
+func (t *transfer) moveLinks(ctx context.Context, id string, referrers []string) {
+	for _, referrerId := range referrers {
+		err := cache.DoContextFullID(t.picker, ctx, domain.FullID{SpaceID: t.req.SpaceId, ObjectID: referrerId}, func(sb smartblock.SmartBlock) error {
+			st := sb.NewState()
+			if err := t.rewriteIds(st, t.req.SpaceId, map[string]string{id: t.idsMap[id]}); err != nil {
+				return err
+			}
+			return sb.Apply(st)
+		})
+		if err != nil {
+			log.Warn("failed to update links in referring object", zap.String("objectId", referrerId), zap.Error(err))
+		}
+	}
+}
+
+// rewriteIds replaces ids in blocks, relations and collection of the state. Ids that are not in idsMap are kept as is
+func (t *transfer) rewriteIds(st *state.State, spaceId string, idsMap map[string]string) error {
+	fullMap := make(map[string]string)
+	for _, id := range t.dependentIds(st, spaceId) {
+		fullMap[id] = id
+	}
+	maps.Copy(fullMap, idsMap)
+
+	if err := common.UpdateLinksToObjects(st, fullMap); err != nil {
+		return fmt.Errorf("update links: %w", err)
+	}
+	formats := make(map[domain.RelationKey]int32)
+	for _, key := range st.AllRelationKeys() {
+		if format, err := t.formatFetcher.GetRelationFormatByKey(spaceId, key); err == nil {
+			formats[key] = int32(format)
+		}
+	}
+	common.UpdateObjectIDsInRelations(st, fullMap, formats)
+
+	if objectIds := st.GetStoreSlice(template.CollectionStoreKey); len(objectIds) > 0 {
+		newIds := make([]string, 0, len(objectIds))
+		for _, id := range objectIds {
+			if newId, ok := fullMap[id]; ok {
+				id = newId
+			}
+			newIds = append(newIds, id)
+		}
+		st.UpdateStoreSlice(template.CollectionStoreKey, newIds)
+	}
+	return nil
+}
+
+func (t *transfer) dependentIds(st *state.State, spaceId string) []string {
+	converter, err := t.spaceService.Get(context.Background(), spaceId)
+	if err != nil {
+		log.Warn("failed to get space", zap.String("spaceId", spaceId), zap.Error(err))
+		return nil
+	}
+	return objectlink.DependentObjectIDs(st, converter, t.formatFetcher, objectlink.Flags{
+		Blocks:     true,
+		Details:    true,
+		Collection: true,
+	})
+}
+
+// rollback removes objects created in the target space: transferred objects first, then types, relations,
+// options and files created for them
+func (t *transfer) rollback() {
+	ids := slices.Collect(maps.Values(t.idsMap))
+	if t.mapper != nil {
+		created := slices.Clone(t.mapper.created)
+		slices.Reverse(created)
+		ids = append(ids, created...)
+	}
+	for _, newId := range ids {
+		if err := t.deleter.DeleteObjectByFullID(domain.FullID{SpaceID: t.req.TargetSpaceId, ObjectID: newId}); err != nil {
+			log.Warn("failed to remove transferred object", zap.String("objectId", newId), zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package objecttransfer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/cache/mock_cache"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock/smarttest"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/relationutils/mock_relationutils"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore/spaceindex"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/space/clientspace/mock_clientspace"
+	"github.com/anyproto/anytype-heart/space/mock_space"
+)
+
+const spaceId = "space1"
+
+func newTestService(t *testing.T) (*service, *objectstore.StoreFixture) {
+	store := objectstore.NewStoreFixture(t)
+	store.AddObjects(t, spaceId, []spaceindex.TestObject{
+		testObject("page1", model.ObjectType_basic),
+		testObject("page2", model.ObjectType_note),
+		testObject("page3", model.ObjectType_collection),
+		testObject("type1", model.ObjectType_objectType),
+		testObject("file1", model.ObjectType_image),
+	})
+	ctx := context.Background()
+	index := store.SpaceIndex(spaceId)
+	require.NoError(t, index.UpdateObjectLinks(ctx, "page1", []string{"page2", "type1", "file1"}))
+	require.NoError(t, index.UpdateObjectLinks(ctx, "page2", []string{"page3", "page1"}))
+	return &service{objectStore: store}, store
+}
+
+func testObject(id string, layout model.ObjectTypeLayout) spaceindex.TestObject {
+	return spaceindex.TestObject{
+		bundle.RelationKeyId:             domain.String(id),
+		bundle.RelationKeySpaceId:        domain.String(spaceId),
+		bundle.RelationKeyResolvedLayout: domain.Int64(layout),
+	}
+}
+
+func TestService_collectObjects(t *testing.T) {
+	t.Run("only requested objects", func(t *testing.T) {
+		s, _ := newTestService(t)
+
+		ids, err := s.collectObjects(Request{SpaceId: spaceId, ObjectIds: []string{"page1"}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"page1"}, ids)
+	})
+
+	t.Run("include linked objects, skipping types and files", func(t *testing.T) {
+		s, _ := newTestService(t)
+
+		ids, err := s.collectObjects(Request{SpaceId: spaceId, ObjectIds: []string{"page1"}, IncludeLinked: true})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"page1", "page2", "page3"}, ids)
+	})
+
+	t.Run("requested object is not transferable", func(t *testing.T) {
+		s, _ := newTestService(t)
+
+		_, err := s.collectObjects(Request{SpaceId: spaceId, ObjectIds: []string{"page1", "type1"}})
+		assert.ErrorIs(t, err, ErrNotTransferable)
+	})
+}
+
+func TestService_Transfer(t *testing.T) {
+	t.Run("same space", func(t *testing.T) {
+		s, _ := newTestService(t)
+
+		_, err := s.Transfer(context.Background(), Request{SpaceId: spaceId, TargetSpaceId: spaceId, ObjectIds: []string{"page1"}})
+		assert.ErrorIs(t, err, ErrSameSpace)
+	})
+
+	t.Run("no objects", func(t *testing.T) {
+		s, _ := newTestService(t)
+
+		_, err := s.Transfer(context.Background(), Request{SpaceId: spaceId, TargetSpaceId: "space2"})
+		assert.ErrorIs(t, err, ErrNoObjects)
+	})
+}
+
+const assigneeKey domain.RelationKey = "assignee"
+
+func newTestTransfer(t *testing.T, store *objectstore.StoreFixture, idsMap map[string]string) *transfer {
+	spc := mock_clientspace.NewMockSpace(t)
+	spc.EXPECT().Id().Return(spaceId).Maybe()
+	spaceService := mock_space.NewMockService(t)
+	spaceService.EXPECT().Get(mock.Anything, spaceId).Return(spc, nil).Maybe()
+	fetcher := mock_relationutils.NewMockRelationFormatFetcher(t)
+	fetcher.EXPECT().GetRelationFormatByKey(spaceId, mock.Anything).RunAndReturn(func(_ string, key domain.RelationKey) (model.RelationFormat, error) {
+		if key == assigneeKey {
+			return model.RelationFormat_object, nil
+		}
+		return model.RelationFormat_longtext, nil
+	}).Maybe()
+	return &transfer{
+		service: &service{
+			objectStore:   store,
+			spaceService:  spaceService,
+			formatFetcher: fetcher,
+		},
+		req:    Request{SpaceId: spaceId, TargetSpaceId: "space2", Move: true},
+		idsMap: idsMap,
+	}
+}
+
+// newTestObject creates an object with a text, a link to page2, an object relation and a collection
+func newTestObject(id string) *smarttest.SmartTest {
+	sb := smarttest.New(id)
+	sb.AddBlock(simple.New(&model.Block{Id: id, ChildrenIds: []string{"text", "link"}}))
+	sb.AddBlock(simple.New(&model.Block{
+		Id:      "text",
+		Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: "Meeting notes"}},
+	}))
+	sb.AddBlock(simple.New(&model.Block{
+		Id:      "link",
+		Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{TargetBlockId: "page2"}},
+	}))
+	st := sb.Doc.(*state.State)
+	st.SetDetails(domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+		bundle.RelationKeyName: domain.String("Notes"),
+		assigneeKey:            domain.StringList([]string{"page2", "person1"}),
+	}))
+	st.UpdateStoreSlice(template.CollectionStoreKey, []string{"page2", "page3"})
+	return sb
+}
+
+func TestTransfer_rewriteIds(t *testing.T) {
+	t.Run("links are rewritten and content is kept", func(t *testing.T) {
+		tr := newTestTransfer(t, objectstore.NewStoreFixture(t), nil)
+		st := newTestObject("page1").NewState()
+
+		require.NoError(t, tr.rewriteIds(st, spaceId, map[string]string{"page2": "newPage2"}))
+
+		assert.Equal(t, "Meeting notes", st.Pick("text").Model().GetText().Text)
+		assert.Equal(t, "newPage2", st.Pick("link").Model().GetLink().TargetBlockId)
+		assert.Equal(t, "Notes", st.Details().GetString(bundle.RelationKeyName))
+		assert.Equal(t, []string{"newPage2", "person1"}, st.Details().GetStringList(assigneeKey))
+		assert.Equal(t, []string{"newPage2", "page3"}, st.GetStoreSlice(template.CollectionStoreKey))
+	})
+
+	t.Run("collection keeps ids that are not dependencies", func(t *testing.T) {
+		tr := newTestTransfer(t, objectstore.NewStoreFixture(t), nil)
+		spaceService := mock_space.NewMockService(t)
+		spaceService.EXPECT().Get(mock.Anything, spaceId).Return(nil, errors.New("space is not loaded"))
+		tr.spaceService = spaceService
+		st := newTestObject("page1").NewState()
+
+		require.NoError(t, tr.rewriteIds(st, spaceId, map[string]string{"page2": "newPage2"}))
+
+		assert.Equal(t, []string{"newPage2", "page3"}, st.GetStoreSlice(template.CollectionStoreKey))
+	})
+}
+
+func TestTransfer_moveLinks(t *testing.T) {
+	store := objectstore.NewStoreFixture(t)
+	store.AddObjects(t, spaceId, []spaceindex.TestObject{
+		testObject("page1", model.ObjectType_basic),
+		testObject("page2", model.ObjectType_basic),
+		testObject("page3", model.ObjectType_basic),
+	})
+	index := store.SpaceIndex(spaceId)
+	// page1 is not moved and links to the moved page2, page3 is moved along with page2
+	require.NoError(t, index.UpdateObjectLinks(context.Background(), "page1", []string{"page2"}))
+	require.NoError(t, index.UpdateObjectLinks(context.Background(), "page3", []string{"page2"}))
+
+	tr := newTestTransfer(t, store, map[string]string{"page2": "newPage2", "page3": "newPage3"})
+	referrer := newTestObject("page1")
+	picker := mock_cache.NewMockObjectGetter(t)
+	picker.EXPECT().GetObjectByFullID(mock.Anything, domain.FullID{SpaceID: spaceId, ObjectID: "page1"}).Return(referrer, nil)
+	tr.picker = picker
+
+	referrers, err := tr.referrers("page2")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"page1"}, referrers)
+
+	tr.moveLinks(context.Background(), "page2", referrers)
+
+	st := referrer.NewState()
+	assert.Equal(t, "Meeting notes", st.Pick("text").Model().GetText().Text)
+	assert.Equal(t, "newPage2", st.Pick("link").Model().GetLink().TargetBlockId)
+	assert.Equal(t, []string{"newPage2", "person1"}, st.Details().GetStringList(assigneeKey))
+	assert.Equal(t, []string{"newPage2", "page3"}, st.GetStoreSlice(template.CollectionStoreKey))
+}
+
+type testDeleter struct {
+	deleted []string
+}
+
+func (d *testDeleter) DeleteObjectByFullID(id domain.FullID) error {
+	d.deleted = append(d.deleted, id.ObjectID)
+	return nil
+}
+
+func TestTransfer_rollback(t *testing.T) {
+	store := objectstore.NewStoreFixture(t)
+	tr := newTestTransfer(t, store, map[string]string{"page1": "newPage1"})
+	deleter := &testDeleter{}
+	tr.deleter = deleter
+	tr.mapper = &idMapper{created: []string{"newRelation", "newType", "newFile"}}
+
+	tr.rollback()
+
+	assert.Equal(t, []string{"newPage1", "newFile", "newType", "newRelation"}, deleter.deleted)
+}
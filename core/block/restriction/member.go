@@ -0,0 +1,68 @@
+package restriction
+
+import (
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// MemberRoleHolder could be implemented by RestrictionHolder to narrow restrictions
+// according to the role of the current participant in the shared space
+type MemberRoleHolder interface {
+	Id() string
+	MemberRole() domain.MemberRole
+}
+
+// chats are the place for comments and reactions, so they are not restricted by member roles
+var memberRoleFreeSBTypes = map[smartblock.SmartBlockType]struct{}{
+	smartblock.SmartBlockTypeChatDerivedObject:    {},
+	smartblock.SmartBlockTypeChatObjectDeprecated: {},
+	smartblock.SmartBlockTypeParticipant:          {},
+	smartblock.SmartBlockTypeSpaceView:            {},
+}
+
+var dvRestrictMember = []model.RestrictionsDataviewRestriction{
+	model.Restrictions_DVRelation,
+	model.Restrictions_DVViews,
+	model.Restrictions_DVCreateObject,
+}
+
+// IsRestrictedByMemberRole reports whether the role of the current participant doesn't allow to edit the object
+func IsRestrictedByMemberRole(rh RestrictionHolder) bool {
+	mh, ok := rh.(MemberRoleHolder)
+	if !ok {
+		return false
+	}
+	if _, free := memberRoleFreeSBTypes[rh.Type()]; free {
+		return false
+	}
+	return !mh.MemberRole().CanEdit(mh.Id())
+}
+
+func applyMemberObjectRestrictions(rh RestrictionHolder, r ObjectRestrictions) ObjectRestrictions {
+	if !IsRestrictedByMemberRole(rh) {
+		return r
+	}
+	r = r.Copy().Add(objRestrictAll.ToProto()...)
+	if rh.Type() == smartblock.SmartBlockTypeObjectType && rh.(MemberRoleHolder).MemberRole().Type == domain.MemberRoleCommenter {
+		r.Add(model.Restrictions_CreateObjectOfThisType)
+	}
+	return r
+}
+
+func applyMemberDataviewRestrictions(rh RestrictionHolder, dr DataviewRestrictions) DataviewRestrictions {
+	if !IsRestrictedByMemberRole(rh) {
+		return dr
+	}
+	dr = dr.Copy()
+	for i := range dr {
+		if dr[i].BlockId == DataviewBlockId {
+			dr[i].Restrictions = dvRestrictMember
+			return dr
+		}
+	}
+	return append(dr, model.RestrictionsDataviewRestrictions{
+		BlockId:      DataviewBlockId,
+		Restrictions: dvRestrictMember,
+	})
+}
@@ -0,0 +1,94 @@
+package restriction
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+type memberRoleHolder struct {
+	restrictionHolder
+	id   string
+	role domain.MemberRole
+}
+
+func (h *memberRoleHolder) Id() string {
+	return h.id
+}
+
+func (h *memberRoleHolder) MemberRole() domain.MemberRole {
+	return h.role
+}
+
+func givenMemberObject(sbType smartblock.SmartBlockType, role domain.MemberRole) *memberRoleHolder {
+	return &memberRoleHolder{
+		restrictionHolder: restrictionHolder{
+			sbType: sbType,
+			layout: model.ObjectType_basic,
+		},
+		id:   "object",
+		role: role,
+	}
+}
+
+func TestGetRestrictions_MemberRole(t *testing.T) {
+	t.Run("default role", func(t *testing.T) {
+		rh := givenMemberObject(smartblock.SmartBlockTypePage, domain.MemberRole{})
+
+		r := GetRestrictions(rh)
+		assert.NoError(t, r.Object.Check(model.Restrictions_Blocks, model.Restrictions_Details))
+	})
+
+	t.Run("commenter can't edit objects", func(t *testing.T) {
+		rh := givenMemberObject(smartblock.SmartBlockTypePage, domain.MemberRole{Type: domain.MemberRoleCommenter})
+
+		r := GetRestrictions(rh)
+		assert.ErrorIs(t, r.Object.Check(model.Restrictions_Blocks), ErrRestricted)
+		assert.ErrorIs(t, r.Object.Check(model.Restrictions_Details), ErrRestricted)
+		assert.ErrorIs(t, r.Dataview.Check(DataviewBlockId, model.Restrictions_DVCreateObject), ErrRestricted)
+		assert.ErrorIs(t, CheckRestrictions(rh, model.Restrictions_Delete), ErrRestricted)
+	})
+
+	t.Run("commenter can write to chats", func(t *testing.T) {
+		rh := givenMemberObject(smartblock.SmartBlockTypeChatDerivedObject, domain.MemberRole{Type: domain.MemberRoleCommenter})
+
+		assert.False(t, IsRestrictedByMemberRole(rh))
+	})
+
+	t.Run("commenter can't create objects of type", func(t *testing.T) {
+		rh := givenMemberObject(smartblock.SmartBlockTypeObjectType, domain.MemberRole{Type: domain.MemberRoleCommenter})
+
+		r := GetRestrictions(rh)
+		assert.ErrorIs(t, r.Object.Check(model.Restrictions_CreateObjectOfThisType), ErrRestricted)
+	})
+
+	t.Run("collection editor", func(t *testing.T) {
+		role := domain.MemberRole{
+			Type:          domain.MemberRoleCollectionEditor,
+			CollectionIds: []string{"collection"},
+			ObjectIds:     []string{"object"},
+		}
+
+		inCollection := givenMemberObject(smartblock.SmartBlockTypePage, role)
+		assert.NoError(t, GetRestrictions(inCollection).Object.Check(model.Restrictions_Blocks))
+
+		outside := givenMemberObject(smartblock.SmartBlockTypePage, role)
+		outside.id = "other"
+		assert.ErrorIs(t, GetRestrictions(outside).Object.Check(model.Restrictions_Blocks), ErrRestricted)
+
+		collection := givenMemberObject(smartblock.SmartBlockTypePage, role)
+		collection.id = "collection"
+		assert.NoError(t, GetRestrictions(collection).Object.Check(model.Restrictions_Blocks))
+	})
+
+	t.Run("shared restrictions are not modified", func(t *testing.T) {
+		rh := givenMemberObject(smartblock.SmartBlockTypeTemplate, domain.MemberRole{Type: domain.MemberRoleCommenter})
+
+		GetRestrictions(rh)
+		assert.NoError(t, objectRestrictionsBySBType[smartblock.SmartBlockTypeTemplate].Check(model.Restrictions_Blocks))
+	})
+}
@@ -19,13 +19,13 @@ type RestrictionHolder interface {
 
 func GetRestrictions(rh RestrictionHolder) (r Restrictions) {
 	return Restrictions{
-		Object:   getObjectRestrictions(rh),
-		Dataview: getDataviewRestrictions(rh),
+		Object:   applyMemberObjectRestrictions(rh, getObjectRestrictions(rh)),
+		Dataview: applyMemberDataviewRestrictions(rh, getDataviewRestrictions(rh)),
 	}
 }
 
 func CheckRestrictions(rh RestrictionHolder, cr ...model.RestrictionsObjectRestriction) error {
-	r := getObjectRestrictions(rh)
+	r := applyMemberObjectRestrictions(rh, getObjectRestrictions(rh))
 	if err := r.Check(cr...); err != nil {
 		return err
 	}
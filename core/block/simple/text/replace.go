@@ -0,0 +1,141 @@
+package text
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf16"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	textutil "github.com/anyproto/anytype-heart/util/text"
+)
+
+type FindOptions struct {
+	CaseSensitive bool
+	WholeWord     bool
+}
+
+// FindAll returns ranges of all non-overlapping occurrences of query in s.
+// Ranges are in UTF-16 code units, the same way as ranges of marks
+func FindAll(s, query string, opts FindOptions) []*model.Range {
+	queryRunes := []rune(query)
+	if len(queryRunes) == 0 {
+		return nil
+	}
+	runes := []rune(s)
+	// offsets[i] is the UTF-16 offset of the i-th rune
+	offsets := make([]int32, len(runes)+1)
+	for i, r := range runes {
+		offsets[i+1] = offsets[i] + int32(utf16.RuneLen(r))
+	}
+
+	var ranges []*model.Range
+	for i := 0; i+len(queryRunes) <= len(runes); {
+		end := i + len(queryRunes)
+		if matchRunes(runes[i:end], queryRunes, opts.CaseSensitive) &&
+			(!opts.WholeWord || isWordBoundary(runes, i) && isWordBoundary(runes, end)) {
+			ranges = append(ranges, &model.Range{From: offsets[i], To: offsets[end]})
+			i = end
+			continue
+		}
+		i++
+	}
+	return ranges
+}
+
+func matchRunes(a, b []rune, caseSensitive bool) bool {
+	for i := range a {
+		if a[i] == b[i] {
+			continue
+		}
+		if caseSensitive || !equalFold(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func equalFold(a, b rune) bool {
+	for r := unicode.SimpleFold(a); r != a; r = unicode.SimpleFold(r) {
+		if r == b {
+			return true
+		}
+	}
+	return false
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// isWordBoundary checks that position pos doesn't split a word
+func isWordBoundary(runes []rune, pos int) bool {
+	if pos == 0 || pos == len(runes) {
+		return true
+	}
+	return !isWordRune(runes[pos-1]) || !isWordRune(runes[pos])
+}
+
+// ReplaceRanges replaces text in the given ranges with newText. Ranges must be sorted and must not overlap.
+// Marks are preserved: marks covering a range are stretched or shrunk to the new text, marks after it are shifted
+func (t *Text) ReplaceRanges(ranges []*model.Range, newText string) error {
+	if len(ranges) == 0 {
+		return nil
+	}
+	runes := textutil.StrToUTF16(t.content.Text)
+	newRunes := textutil.StrToUTF16(newText)
+	newLen := int32(len(newRunes))
+
+	var (
+		result []uint16
+		prev   int32
+	)
+	for _, r := range ranges {
+		if r.From < prev || r.From > r.To || int(r.To) > len(runes) {
+			return fmt.Errorf("%w: invalid range %d-%d", ErrOutOfRange, r.From, r.To)
+		}
+		result = append(result, runes[prev:r.From]...)
+		result = append(result, newRunes...)
+		prev = r.To
+	}
+	result = append(result, runes[prev:]...)
+
+	mapPos := func(pos int32, isEnd bool) int32 {
+		var shift int32
+		for _, r := range ranges {
+			if pos >= r.To {
+				shift += newLen - (r.To - r.From)
+				continue
+			}
+			if pos > r.From {
+				// position inside the replaced text
+				if isEnd {
+					return r.From + shift + newLen
+				}
+				return r.From + shift
+			}
+			break
+		}
+		return pos + shift
+	}
+
+	if t.content.Marks == nil {
+		t.content.Marks = &model.BlockContentTextMarks{}
+	}
+	marks := make([]*model.BlockContentTextMark, 0, len(t.content.Marks.Marks))
+	for _, m := range t.content.Marks.Marks {
+		if m.Range == nil {
+			continue
+		}
+		m.Range = &model.Range{
+			From: mapPos(m.Range.From, false),
+			To:   mapPos(m.Range.To, true),
+		}
+		if m.Range.From >= m.Range.To {
+			continue
+		}
+		marks = append(marks, m)
+	}
+	t.content.Text = textutil.UTF16ToStr(result)
+	t.content.Marks.Marks = t.normalizeMarksPure(marks)
+	return nil
+}
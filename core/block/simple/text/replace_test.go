@@ -0,0 +1,101 @@
+package text
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func TestFindAll(t *testing.T) {
+	t.Run("case insensitive", func(t *testing.T) {
+		ranges := FindAll("Acme and ACME and acmes", "acme", FindOptions{})
+		assert.Equal(t, []*model.Range{{From: 0, To: 4}, {From: 9, To: 13}, {From: 18, To: 22}}, ranges)
+	})
+	t.Run("case sensitive", func(t *testing.T) {
+		ranges := FindAll("Acme and ACME and acmes", "acme", FindOptions{CaseSensitive: true})
+		assert.Equal(t, []*model.Range{{From: 18, To: 22}}, ranges)
+	})
+	t.Run("whole word", func(t *testing.T) {
+		ranges := FindAll("Acme and ACME and acmes", "acme", FindOptions{WholeWord: true})
+		assert.Equal(t, []*model.Range{{From: 0, To: 4}, {From: 9, To: 13}}, ranges)
+	})
+	t.Run("utf-16 offsets", func(t *testing.T) {
+		ranges := FindAll("😀 Привет привет", "привет", FindOptions{})
+		assert.Equal(t, []*model.Range{{From: 3, To: 9}, {From: 10, To: 16}}, ranges)
+	})
+	t.Run("no overlapping matches", func(t *testing.T) {
+		ranges := FindAll("aaaa", "aa", FindOptions{})
+		assert.Equal(t, []*model.Range{{From: 0, To: 2}, {From: 2, To: 4}}, ranges)
+	})
+	t.Run("empty query", func(t *testing.T) {
+		assert.Empty(t, FindAll("text", "", FindOptions{}))
+	})
+}
+
+func TestText_ReplaceRanges(t *testing.T) {
+	newText := func(text string, marks ...*model.BlockContentTextMark) *Text {
+		return NewText(&model.Block{
+			Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+				Text:  text,
+				Marks: &model.BlockContentTextMarks{Marks: marks},
+			}},
+		}).(*Text)
+	}
+	mark := func(tp model.BlockContentTextMarkType, from, to int32) *model.BlockContentTextMark {
+		return &model.BlockContentTextMark{Type: tp, Range: &model.Range{From: from, To: to}}
+	}
+
+	t.Run("marks around and after replaced text are kept", func(t *testing.T) {
+		// <b>Buy Acme</b> now, <i>Acme</i> is <u>great</u>
+		tb := newText("Buy Acme now, Acme is great",
+			mark(model.BlockContentTextMark_Bold, 0, 8),
+			mark(model.BlockContentTextMark_Italic, 14, 18),
+			mark(model.BlockContentTextMark_Underscored, 22, 27),
+		)
+
+		err := tb.ReplaceRanges(FindAll(tb.GetText(), "Acme", FindOptions{}), "Zenith")
+		require.NoError(t, err)
+
+		assert.Equal(t, "Buy Zenith now, Zenith is great", tb.GetText())
+		// marks are sorted by type after normalization
+		assert.Equal(t, []*model.BlockContentTextMark{
+			mark(model.BlockContentTextMark_Italic, 16, 22),
+			mark(model.BlockContentTextMark_Bold, 0, 10),
+			mark(model.BlockContentTextMark_Underscored, 26, 31),
+		}, tb.content.Marks.Marks)
+	})
+
+	t.Run("partially overlapping marks", func(t *testing.T) {
+		// Ac<b>me inc</b>
+		tb := newText("Acme inc", mark(model.BlockContentTextMark_Bold, 2, 8))
+
+		err := tb.ReplaceRanges([]*model.Range{{From: 0, To: 4}}, "Co")
+		require.NoError(t, err)
+
+		assert.Equal(t, "Co inc", tb.GetText())
+		assert.Equal(t, []*model.BlockContentTextMark{mark(model.BlockContentTextMark_Bold, 0, 6)}, tb.content.Marks.Marks)
+	})
+
+	t.Run("replace with empty text removes marks inside", func(t *testing.T) {
+		tb := newText("a typo here", mark(model.BlockContentTextMark_Bold, 2, 6))
+
+		err := tb.ReplaceRanges([]*model.Range{{From: 2, To: 7}}, "")
+		require.NoError(t, err)
+
+		assert.Equal(t, "a here", tb.GetText())
+		assert.Empty(t, tb.content.Marks.Marks)
+	})
+
+	t.Run("invalid ranges", func(t *testing.T) {
+		tb := newText("short")
+
+		err := tb.ReplaceRanges([]*model.Range{{From: 2, To: 10}}, "x")
+		assert.ErrorIs(t, err, ErrOutOfRange)
+		err = tb.ReplaceRanges([]*model.Range{{From: 3, To: 4}, {From: 1, To: 2}}, "x")
+		assert.ErrorIs(t, err, ErrOutOfRange)
+		assert.Equal(t, "short", tb.GetText())
+	})
+}
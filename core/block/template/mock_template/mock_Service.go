@@ -228,17 +228,17 @@ func (_c *MockService_Name_Call) RunAndReturn(run func() string) *MockService_Na
 	return _c
 }
 
-// ObjectApplyTemplate provides a mock function with given fields: contextId, templateId
-func (_m *MockService) ObjectApplyTemplate(contextId string, templateId string) error {
-	ret := _m.Called(contextId, templateId)
+// ObjectApplyTemplate provides a mock function with given fields: contextId, templateId, variables
+func (_m *MockService) ObjectApplyTemplate(contextId string, templateId string, variables template.Variables) error {
+	ret := _m.Called(contextId, templateId, variables)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ObjectApplyTemplate")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string) error); ok {
-		r0 = rf(contextId, templateId)
+	if rf, ok := ret.Get(0).(func(string, string, template.Variables) error); ok {
+		r0 = rf(contextId, templateId, variables)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -254,13 +254,14 @@ type MockService_ObjectApplyTemplate_Call struct {
 // ObjectApplyTemplate is a helper method to define mock.On call
 //   - contextId string
 //   - templateId string
-func (_e *MockService_Expecter) ObjectApplyTemplate(contextId interface{}, templateId interface{}) *MockService_ObjectApplyTemplate_Call {
-	return &MockService_ObjectApplyTemplate_Call{Call: _e.mock.On("ObjectApplyTemplate", contextId, templateId)}
+//   - variables template.Variables
+func (_e *MockService_Expecter) ObjectApplyTemplate(contextId interface{}, templateId interface{}, variables interface{}) *MockService_ObjectApplyTemplate_Call {
+	return &MockService_ObjectApplyTemplate_Call{Call: _e.mock.On("ObjectApplyTemplate", contextId, templateId, variables)}
 }
 
-func (_c *MockService_ObjectApplyTemplate_Call) Run(run func(contextId string, templateId string)) *MockService_ObjectApplyTemplate_Call {
+func (_c *MockService_ObjectApplyTemplate_Call) Run(run func(contextId string, templateId string, variables template.Variables)) *MockService_ObjectApplyTemplate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		run(args[0].(string), args[1].(string), args[2].(template.Variables))
 	})
 	return _c
 }
@@ -270,7 +271,7 @@ func (_c *MockService_ObjectApplyTemplate_Call) Return(_a0 error) *MockService_O
 	return _c
 }
 
-func (_c *MockService_ObjectApplyTemplate_Call) RunAndReturn(run func(string, string) error) *MockService_ObjectApplyTemplate_Call {
+func (_c *MockService_ObjectApplyTemplate_Call) RunAndReturn(run func(string, string, template.Variables) error) *MockService_ObjectApplyTemplate_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -503,6 +504,171 @@ func (_c *MockService_TemplateExportAll_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// TemplateIncludeSyncApply provides a mock function with given fields: ctx, templateId, objectIds
+func (_m *MockService) TemplateIncludeSyncApply(ctx context.Context, templateId string, objectIds []string) error {
+	ret := _m.Called(ctx, templateId, objectIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TemplateIncludeSyncApply")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
+		r0 = rf(ctx, templateId, objectIds)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_TemplateIncludeSyncApply_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TemplateIncludeSyncApply'
+type MockService_TemplateIncludeSyncApply_Call struct {
+	*mock.Call
+}
+
+// TemplateIncludeSyncApply is a helper method to define mock.On call
+//   - ctx context.Context
+//   - templateId string
+//   - objectIds []string
+func (_e *MockService_Expecter) TemplateIncludeSyncApply(ctx interface{}, templateId interface{}, objectIds interface{}) *MockService_TemplateIncludeSyncApply_Call {
+	return &MockService_TemplateIncludeSyncApply_Call{Call: _e.mock.On("TemplateIncludeSyncApply", ctx, templateId, objectIds)}
+}
+
+func (_c *MockService_TemplateIncludeSyncApply_Call) Run(run func(ctx context.Context, templateId string, objectIds []string)) *MockService_TemplateIncludeSyncApply_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockService_TemplateIncludeSyncApply_Call) Return(_a0 error) *MockService_TemplateIncludeSyncApply_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_TemplateIncludeSyncApply_Call) RunAndReturn(run func(context.Context, string, []string) error) *MockService_TemplateIncludeSyncApply_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TemplateIncludeSyncPreview provides a mock function with given fields: ctx, templateId
+func (_m *MockService) TemplateIncludeSyncPreview(ctx context.Context, templateId string) ([]template.IncludeSyncDiff, error) {
+	ret := _m.Called(ctx, templateId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TemplateIncludeSyncPreview")
+	}
+
+	var r0 []template.IncludeSyncDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]template.IncludeSyncDiff, error)); ok {
+		return rf(ctx, templateId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []template.IncludeSyncDiff); ok {
+		r0 = rf(ctx, templateId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]template.IncludeSyncDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, templateId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_TemplateIncludeSyncPreview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TemplateIncludeSyncPreview'
+type MockService_TemplateIncludeSyncPreview_Call struct {
+	*mock.Call
+}
+
+// TemplateIncludeSyncPreview is a helper method to define mock.On call
+//   - ctx context.Context
+//   - templateId string
+func (_e *MockService_Expecter) TemplateIncludeSyncPreview(ctx interface{}, templateId interface{}) *MockService_TemplateIncludeSyncPreview_Call {
+	return &MockService_TemplateIncludeSyncPreview_Call{Call: _e.mock.On("TemplateIncludeSyncPreview", ctx, templateId)}
+}
+
+func (_c *MockService_TemplateIncludeSyncPreview_Call) Run(run func(ctx context.Context, templateId string)) *MockService_TemplateIncludeSyncPreview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_TemplateIncludeSyncPreview_Call) Return(_a0 []template.IncludeSyncDiff, _a1 error) *MockService_TemplateIncludeSyncPreview_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_TemplateIncludeSyncPreview_Call) RunAndReturn(run func(context.Context, string) ([]template.IncludeSyncDiff, error)) *MockService_TemplateIncludeSyncPreview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TemplateVariablePrompts provides a mock function with given fields: templateId
+func (_m *MockService) TemplateVariablePrompts(templateId string) ([]template.VariablePrompt, error) {
+	ret := _m.Called(templateId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TemplateVariablePrompts")
+	}
+
+	var r0 []template.VariablePrompt
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]template.VariablePrompt, error)); ok {
+		return rf(templateId)
+	}
+	if rf, ok := ret.Get(0).(func(string) []template.VariablePrompt); ok {
+		r0 = rf(templateId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]template.VariablePrompt)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(templateId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_TemplateVariablePrompts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TemplateVariablePrompts'
+type MockService_TemplateVariablePrompts_Call struct {
+	*mock.Call
+}
+
+// TemplateVariablePrompts is a helper method to define mock.On call
+//   - templateId string
+func (_e *MockService_Expecter) TemplateVariablePrompts(templateId interface{}) *MockService_TemplateVariablePrompts_Call {
+	return &MockService_TemplateVariablePrompts_Call{Call: _e.mock.On("TemplateVariablePrompts", templateId)}
+}
+
+func (_c *MockService_TemplateVariablePrompts_Call) Run(run func(templateId string)) *MockService_TemplateVariablePrompts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_TemplateVariablePrompts_Call) Return(_a0 []template.VariablePrompt, _a1 error) *MockService_TemplateVariablePrompts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_TemplateVariablePrompts_Call) RunAndReturn(run func(string) ([]template.VariablePrompt, error)) *MockService_TemplateVariablePrompts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockService(t interface {
@@ -18,6 +18,32 @@ type CreateTemplateRequest struct {
 	Layout                      model.ObjectTypeLayout
 	Details                     *domain.Details
 	WithTemplateValidation      bool
+	Variables                   Variables
+}
+
+// Variables are used to evaluate {{variable}} placeholders in text blocks and text relations of the template
+type Variables struct {
+	// SourceObjectId is the id of the object which title is used for {{source}}
+	SourceObjectId string
+	// Values are user-supplied values of {{ask:Name}} prompts, keyed by prompt name
+	Values map[string]string
+}
+
+// VariablePrompt describes a value that should be asked from the user before the template is applied
+type VariablePrompt struct {
+	Name    string
+	Default string
+}
+
+// IncludeSyncDiff describes changes that the update of the base template makes in the object
+type IncludeSyncDiff struct {
+	ObjectId string
+	// Added are ids of blocks of the base template that are missing in the object
+	Added []string
+	// Changed are ids of blocks of the object that differ from the base template
+	Changed []string
+	// Removed are ids of blocks of the object that are removed from the base template
+	Removed []string
 }
 
 func (r CreateTemplateRequest) IsValid() error {
@@ -30,7 +56,9 @@ func (r CreateTemplateRequest) IsValid() error {
 type Service interface {
 	CreateTemplateStateWithDetails(req CreateTemplateRequest) (st *state.State, err error)
 	CreateTemplateStateFromSmartBlock(sb smartblock.SmartBlock, req CreateTemplateRequest) *state.State
-	ObjectApplyTemplate(contextId string, templateId string) error
+	ObjectApplyTemplate(contextId string, templateId string, variables Variables) error
+	// TemplateVariablePrompts returns prompts of the template, so the client could ask for their values before creation
+	TemplateVariablePrompts(templateId string) ([]VariablePrompt, error)
 	TemplateCreateFromObject(ctx context.Context, id string) (templateId string, err error)
 
 	TemplateCloneInSpace(space clientspace.Space, id string) (templateId string, err error)
@@ -38,5 +66,10 @@ type Service interface {
 
 	TemplateExportAll(ctx context.Context, path string) (string, error)
 
+	// TemplateIncludeSyncPreview returns changes that the update of the base template makes in objects opted in to its updates
+	TemplateIncludeSyncPreview(ctx context.Context, templateId string) ([]IncludeSyncDiff, error)
+	// TemplateIncludeSyncApply updates blocks included from the base template in the objects
+	TemplateIncludeSyncApply(ctx context.Context, templateId string, objectIds []string) error
+
 	app.Component
 }
@@ -4,8 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/anyproto/any-sync/app"
+	"github.com/anyproto/any-sync/commonspace/objecttreebuilder"
 	"github.com/anyproto/any-sync/commonspace/spacestorage"
 	"github.com/samber/lo"
 	"golang.org/x/exp/slices"
@@ -18,6 +21,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/export"
 	"github.com/anyproto/anytype-heart/core/block/object/idresolver"
 	"github.com/anyproto/anytype-heart/core/block/object/objectcreator"
+	"github.com/anyproto/anytype-heart/core/block/source/sourceimpl"
 	templateSvc "github.com/anyproto/anytype-heart/core/block/template"
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/pb"
@@ -102,6 +106,7 @@ func (s *service) CreateTemplateStateWithDetails(req templateSvc.CreateTemplateR
 	}
 
 	addDetailsToTemplateState(targetState, req.Details)
+	s.evaluateTemplateVariables(req.SpaceId, targetState, req.Variables, time.Now())
 	return targetState, nil
 }
 
@@ -170,8 +175,12 @@ func (s *service) CreateTemplateStateFromSmartBlock(sb smartblock.SmartBlock, re
 	st, err := s.buildState(sb)
 	if err != nil {
 		st = s.createBlankTemplateState(domain.FullID{SpaceID: req.SpaceId, ObjectID: req.TypeId}, req.Layout)
+	} else {
+		// the template is locked by the caller, so base templates are read from their trees without taking their locks
+		s.resolveIncludesWith(st, s.storedTemplateState(sb.SpaceID()))
 	}
 	addDetailsToTemplateState(st, req.Details)
+	s.evaluateTemplateVariables(req.SpaceId, st, req.Variables, time.Now())
 	return st
 }
 
@@ -190,9 +199,74 @@ func (s *service) createCustomTemplateState(templateId string) (targetState *sta
 	if errors.Is(err, spacestorage.ErrTreeStorageAlreadyDeleted) {
 		return s.createBlankTemplateState(domain.FullID{}, model.ObjectType_basic), nil
 	}
+	if err == nil {
+		s.resolveIncludes(targetState)
+	}
 	return
 }
 
+// resolveIncludes replaces include blocks of the template state with blocks of base templates.
+// It must be called outside the lock of the template, as base templates are locked one by one
+func (s *service) resolveIncludes(st *state.State) {
+	s.resolveIncludesWith(st, s.includedTemplateState)
+}
+
+func (s *service) resolveIncludesWith(st *state.State, getTemplate template.IncludeGetter) {
+	if err := template.ResolveIncludes(st, getTemplate); err != nil {
+		log.Warnf("failed to resolve includes of template %s: %v", st.RootId(), err)
+	}
+}
+
+// storedTemplateState returns the getter of base templates that builds their states from trees in the storage,
+// so it could be used under the lock of another object
+func (s *service) storedTemplateState(spaceId string) template.IncludeGetter {
+	return func(templateId string) (*state.State, error) {
+		if s.spaceService == nil {
+			return nil, fmt.Errorf("space service is not available")
+		}
+		ctx := context.Background()
+		spc, err := s.spaceService.Get(ctx, spaceId)
+		if err != nil {
+			return nil, fmt.Errorf("get space: %w", err)
+		}
+		tree, err := spc.TreeBuilder().BuildHistoryTree(ctx, templateId, objecttreebuilder.HistoryTreeOpts{})
+		if err != nil {
+			return nil, fmt.Errorf("build tree: %w", err)
+		}
+		st, _, _, err := sourceimpl.BuildState(spaceId, nil, tree, true)
+		if err != nil {
+			return nil, fmt.Errorf("build state: %w", err)
+		}
+		if !lo.Contains(st.ObjectTypeKeys(), bundle.TypeKeyTemplate) {
+			return nil, fmt.Errorf("object '%s' is not a template", templateId)
+		}
+		// archived flag is a local detail, so it is taken from the index
+		details, err := s.store.SpaceIndex(spaceId).GetDetails(templateId)
+		if err != nil {
+			return nil, fmt.Errorf("get details: %w", err)
+		}
+		if st.Details().GetBool(bundle.RelationKeyIsDeleted) || details.GetBool(bundle.RelationKeyIsArchived) {
+			return nil, spacestorage.ErrTreeStorageAlreadyDeleted
+		}
+		return st, nil
+	}
+}
+
+// includedTemplateState returns the copy of the state of the template to be included into another template
+func (s *service) includedTemplateState(templateId string) (st *state.State, err error) {
+	err = cache.Do(s.picker, templateId, func(sb smartblock.SmartBlock) error {
+		if !lo.Contains(sb.ObjectTypeKeys(), bundle.TypeKeyTemplate) {
+			return fmt.Errorf("object '%s' is not a template", sb.Id())
+		}
+		st = sb.NewState().Copy()
+		if st.Details().GetBool(bundle.RelationKeyIsDeleted) || st.LocalDetails().GetBool(bundle.RelationKeyIsArchived) {
+			return spacestorage.ErrTreeStorageAlreadyDeleted
+		}
+		return nil
+	})
+	return st, err
+}
+
 func (s *service) buildState(sb smartblock.SmartBlock) (st *state.State, err error) {
 	if sb == nil {
 		return nil, fmt.Errorf("smartblock is nil")
@@ -217,8 +291,11 @@ func (s *service) buildState(sb smartblock.SmartBlock) (st *state.State, err err
 		bundle.RelationKeyOrigin,
 		bundle.RelationKeyAddedDate,
 		bundle.RelationKeyFeaturedRelations,
-		bundle.RelationKeyName,
 	)
+	// name of the template is kept only if it has variables, e.g. "Meeting {{today}}"
+	if placeholders, _ := findVariables(st.Details().GetString(bundle.RelationKeyName)); len(placeholders) == 0 {
+		st.RemoveDetail(bundle.RelationKeyName)
+	}
 	st.SetDetailAndBundledRelation(bundle.RelationKeySourceObject, domain.String(sb.Id()))
 	// original created timestamp is used to set creationDate for imported objects, not for template-based objects
 	st.SetOriginalCreatedTimestamp(0)
@@ -226,7 +303,10 @@ func (s *service) buildState(sb smartblock.SmartBlock) (st *state.State, err err
 	return
 }
 
-func (s *service) ObjectApplyTemplate(contextId, templateId string) error {
+func (s *service) ObjectApplyTemplate(contextId, templateId string, variables templateSvc.Variables) error {
+	if variables.SourceObjectId == "" {
+		variables.SourceObjectId = contextId
+	}
 	return cache.Do(s.picker, contextId, func(b smartblock.SmartBlock) error {
 		orig := b.NewState().ParentState()
 		spaceId := orig.LocalDetails().GetString(bundle.RelationKeySpaceId)
@@ -237,6 +317,7 @@ func (s *service) ObjectApplyTemplate(contextId, templateId string) error {
 			Layout:                 model.ObjectTypeLayout(orig.LocalDetails().GetInt64(bundle.RelationKeyResolvedLayout)), // nolint:gosec
 			Details:                s.collectOriginalDetails(spaceId, orig),
 			WithTemplateValidation: false,
+			Variables:              variables,
 		})
 		if err != nil {
 			return err
@@ -257,6 +338,112 @@ func (s *service) ObjectApplyTemplate(contextId, templateId string) error {
 	})
 }
 
+func (s *service) TemplateVariablePrompts(templateId string) (prompts []templateSvc.VariablePrompt, err error) {
+	err = cache.Do(s.picker, templateId, func(sb smartblock.SmartBlock) error {
+		if !lo.Contains(sb.ObjectTypeKeys(), bundle.TypeKeyTemplate) {
+			return fmt.Errorf("object '%s' is not a template", sb.Id())
+		}
+		prompts = collectPrompts(sb.NewState())
+		return nil
+	})
+	return prompts, err
+}
+
+// evaluateTemplateVariables replaces {{variable}} placeholders of the template state with their values.
+// Date variables are evaluated for the given time
+func (s *service) evaluateTemplateVariables(spaceId string, st *state.State, variables templateSvc.Variables, now time.Time) {
+	vars := &variableContext{
+		now:     now,
+		details: st.Details(),
+		values:  variables.Values,
+		creator: func() string {
+			return s.participantName(spaceId)
+		},
+		spaceName: func() string {
+			return s.spaceName(spaceId)
+		},
+		source: func() string {
+			if variables.SourceObjectId == "" {
+				return ""
+			}
+			return s.objectName(spaceId, variables.SourceObjectId)
+		},
+		relationValue: func(key domain.RelationKey, value domain.Value) string {
+			return s.formatRelationValue(spaceId, key, value)
+		},
+	}
+	evaluateVariables(st, vars.resolver())
+	st.BlocksInit(st)
+}
+
+func (s *service) objectName(spaceId, objectId string) string {
+	if s.store == nil {
+		return ""
+	}
+	details, err := s.store.SpaceIndex(spaceId).GetDetails(objectId)
+	if err != nil {
+		log.Warnf("failed to get details of %s for template variable: %v", objectId, err)
+		return ""
+	}
+	return details.GetString(bundle.RelationKeyName)
+}
+
+func (s *service) participantName(spaceId string) string {
+	if s.spaceService == nil {
+		return ""
+	}
+	spc, err := s.spaceService.Get(context.Background(), spaceId)
+	if err != nil {
+		log.Warnf("failed to get space %s for template variable: %v", spaceId, err)
+		return ""
+	}
+	identity := spc.GetAclIdentity()
+	if identity == nil {
+		return ""
+	}
+	return s.objectName(spaceId, domain.NewParticipantId(spaceId, identity.Account()))
+}
+
+func (s *service) spaceName(spaceId string) string {
+	if s.spaceService == nil {
+		return ""
+	}
+	spc, err := s.spaceService.Get(context.Background(), spaceId)
+	if err != nil {
+		log.Warnf("failed to get space %s for template variable: %v", spaceId, err)
+		return ""
+	}
+	return s.objectName(spaceId, spc.DerivedIDs().Workspace)
+}
+
+// formatRelationValue converts the relation value to text, dates are formatted and ids of objects are replaced with their names
+func (s *service) formatRelationValue(spaceId string, key domain.RelationKey, value domain.Value) string {
+	if s.store == nil {
+		return formatValue(value)
+	}
+	relation, err := s.store.SpaceIndex(spaceId).GetRelationByKey(key.String())
+	if err != nil {
+		return formatValue(value)
+	}
+	switch relation.Format {
+	case model.RelationFormat_date:
+		if ts, ok := value.TryInt64(); ok && ts != 0 {
+			return time.Unix(ts, 0).Format(variableDateLayout)
+		}
+		return ""
+	case model.RelationFormat_object, model.RelationFormat_tag, model.RelationFormat_status, model.RelationFormat_file:
+		ids := value.WrapToStringList()
+		names := make([]string, 0, len(ids))
+		for _, id := range ids {
+			if name := s.objectName(spaceId, id); name != "" {
+				names = append(names, name)
+			}
+		}
+		return strings.Join(names, ", ")
+	}
+	return formatValue(value)
+}
+
 func (s *service) collectOriginalDetails(spaceId string, st *state.State) *domain.Details {
 	details := st.Details().Copy()
 	sourceObject := details.GetString(bundle.RelationKeySourceObject)
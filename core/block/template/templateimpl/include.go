@@ -0,0 +1,163 @@
+package templateimpl
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/anyproto/anytype-heart/core/block/cache"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	templateSvc "github.com/anyproto/anytype-heart/core/block/template"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func (s *service) TemplateIncludeSyncPreview(ctx context.Context, templateId string) ([]templateSvc.IncludeSyncDiff, error) {
+	return s.syncIncludes(ctx, templateId, nil, false)
+}
+
+func (s *service) TemplateIncludeSyncApply(ctx context.Context, templateId string, objectIds []string) error {
+	_, err := s.syncIncludes(ctx, templateId, objectIds, true)
+	return err
+}
+
+// syncIncludes compares sections of objects included from the template with its current state. Objects are found
+// by their source template, which includes the base template directly or via other templates
+func (s *service) syncIncludes(ctx context.Context, templateId string, objectIds []string, apply bool) ([]templateSvc.IncludeSyncDiff, error) {
+	spaceId, err := s.resolver.ResolveSpaceID(templateId)
+	if err != nil {
+		return nil, fmt.Errorf("resolve spaceId: %w", err)
+	}
+	templateIds, err := s.includingTemplates(ctx, spaceId, templateId)
+	if err != nil {
+		return nil, err
+	}
+
+	bases := make(map[string]*state.State, len(templateIds))
+	for _, id := range templateIds {
+		base, err := s.includedTemplateState(id)
+		if err != nil {
+			log.Warnf("failed to get state of base template %s: %v", id, err)
+			continue
+		}
+		if err = template.ResolveIncludes(base, s.includedTemplateState); err != nil {
+			log.Warnf("failed to resolve includes of base template %s: %v", id, err)
+			continue
+		}
+		bases[id] = base
+	}
+
+	records, err := s.store.SpaceIndex(spaceId).Query(database.Query{
+		Filters: []database.FilterRequest{
+			{
+				RelationKey: bundle.RelationKeySourceObject,
+				Condition:   model.BlockContentDataviewFilter_In,
+				Value:       domain.StringList(templateIds),
+			},
+			{
+				RelationKey: bundle.RelationKeyIsArchived,
+				Condition:   model.BlockContentDataviewFilter_NotEqual,
+				Value:       domain.Bool(true),
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query objects: %w", err)
+	}
+
+	var diffs []templateSvc.IncludeSyncDiff
+	for _, rec := range records {
+		id := rec.Details.GetString(bundle.RelationKeyId)
+		if apply && !slices.Contains(objectIds, id) {
+			continue
+		}
+		diff, err := s.syncObjectIncludes(id, bases, apply)
+		if err != nil {
+			if apply {
+				return nil, fmt.Errorf("sync object %s: %w", id, err)
+			}
+			log.Warnf("failed to compare object %s with base templates: %v", id, err)
+			continue
+		}
+		if len(diff.Added) > 0 || len(diff.Changed) > 0 || len(diff.Removed) > 0 {
+			diffs = append(diffs, diff)
+		}
+	}
+	return diffs, nil
+}
+
+func (s *service) syncObjectIncludes(objectId string, bases map[string]*state.State, apply bool) (diff templateSvc.IncludeSyncDiff, err error) {
+	diff.ObjectId = objectId
+	err = cache.Do(s.picker, objectId, func(sb smartblock.SmartBlock) error {
+		st := sb.NewState()
+		// variables of base templates are evaluated the same way as on the creation of the object,
+		// so evaluated placeholders are not treated as changes
+		evaluate := func(fresh *state.State) {
+			fresh.SetDetails(st.CombinedDetails().Copy())
+			created := time.Unix(st.CombinedDetails().GetInt64(bundle.RelationKeyCreatedDate), 0)
+			s.evaluateTemplateVariables(sb.SpaceID(), fresh, templateSvc.Variables{SourceObjectId: objectId}, created)
+		}
+		var changed bool
+		for _, section := range template.FindIncludeSections(st) {
+			base, ok := bases[section.TemplateId]
+			if !ok {
+				continue
+			}
+			sectionDiff := template.DiffIncludeSection(st, section, base, evaluate)
+			if sectionDiff.IsEmpty() {
+				continue
+			}
+			diff.Added = append(diff.Added, sectionDiff.Added...)
+			diff.Changed = append(diff.Changed, sectionDiff.Changed...)
+			diff.Removed = append(diff.Removed, sectionDiff.Removed...)
+			if apply {
+				if err := template.SyncIncludeSection(st, section, base, evaluate); err != nil {
+					return err
+				}
+				changed = true
+			}
+		}
+		if !changed {
+			return nil
+		}
+		return sb.Apply(st)
+	})
+	return diff, err
+}
+
+// includingTemplates returns the template and all templates that include it directly or via other templates
+func (s *service) includingTemplates(ctx context.Context, spaceId, templateId string) ([]string, error) {
+	spc, err := s.spaceService.Get(ctx, spaceId)
+	if err != nil {
+		return nil, fmt.Errorf("get space: %w", err)
+	}
+	templateTypeId, err := spc.GetTypeIdByKey(ctx, bundle.TypeKeyTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("get template type id: %w", err)
+	}
+	index := s.store.SpaceIndex(spaceId)
+
+	result := []string{templateId}
+	for i := 0; i < len(result); i++ {
+		inbound, err := index.GetInboundLinksById(result[i])
+		if err != nil {
+			return nil, fmt.Errorf("get inbound links: %w", err)
+		}
+		for _, id := range inbound {
+			if slices.Contains(result, id) {
+				continue
+			}
+			details, err := index.GetDetails(id)
+			if err != nil || details.GetString(bundle.RelationKeyType) != templateTypeId {
+				continue
+			}
+			result = append(result, id)
+		}
+	}
+	return result, nil
+}
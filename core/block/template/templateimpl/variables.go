@@ -0,0 +1,247 @@
+package templateimpl
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/text"
+	templateSvc "github.com/anyproto/anytype-heart/core/block/template"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// built-in template variables
+const (
+	variableToday   = "today"
+	variableDate    = "date"
+	variableNow     = "now"
+	variableCreator = "creator"
+	variableSpace   = "space"
+	variableSource  = "source"
+
+	// variableRelationPrefix is used to insert the value of a relation of the new object, e.g. {{relation:status}}
+	variableRelationPrefix = "relation:"
+	// variablePromptPrefix is used for values supplied by the user, e.g. {{ask:Client name}} or {{ask:Client name=ACME}}
+	variablePromptPrefix   = "ask:"
+	promptDefaultSeparator = "="
+
+	variableDateLayout     = "2006-01-02"
+	variableDateTimeLayout = "2006-01-02 15:04"
+)
+
+var variablePattern = regexp.MustCompile(`\{\{\s*([^{}]+?)\s*\}\}`)
+
+// variableResolver returns the value of the variable expression, ok is false for unknown variables
+type variableResolver func(expr string) (value string, ok bool)
+
+// findVariables returns placeholders of the string with their expressions
+func findVariables(s string) (placeholders, exprs []string) {
+	if !strings.Contains(s, "{{") {
+		return nil, nil
+	}
+	for _, match := range variablePattern.FindAllStringSubmatch(s, -1) {
+		placeholders = append(placeholders, match[0])
+		exprs = append(exprs, match[1])
+	}
+	return placeholders, exprs
+}
+
+// evaluateString replaces placeholders of known variables in the string. Unknown placeholders are kept as is
+func evaluateString(s string, resolve variableResolver) string {
+	if !strings.Contains(s, "{{") {
+		return s
+	}
+	return variablePattern.ReplaceAllStringFunc(s, func(placeholder string) string {
+		expr := variablePattern.FindStringSubmatch(placeholder)[1]
+		if value, ok := resolve(expr); ok {
+			return value
+		}
+		return placeholder
+	})
+}
+
+// evaluateVariables replaces placeholders in text blocks and string details of the state.
+// Blocks bound to details, like title, are evaluated via details
+func evaluateVariables(st *state.State, resolve variableResolver) {
+	_ = st.Iterate(func(b simple.Block) (isContinue bool) {
+		tb, ok := b.(text.Block)
+		if !ok || len(pbtypes.GetStringList(b.Model().GetFields(), text.DetailsKeyFieldName)) > 0 {
+			return true
+		}
+		placeholders, exprs := findVariables(tb.GetText())
+		if len(placeholders) == 0 {
+			return true
+		}
+		tb, ok = st.Get(b.Model().Id).(text.Block)
+		if !ok {
+			return true
+		}
+		replaced := map[string]struct{}{}
+		for i, placeholder := range placeholders {
+			if _, done := replaced[placeholder]; done {
+				continue
+			}
+			replaced[placeholder] = struct{}{}
+			value, ok := resolve(exprs[i])
+			if !ok {
+				continue
+			}
+			ranges := text.FindAll(tb.GetText(), placeholder, text.FindOptions{CaseSensitive: true})
+			if err := tb.ReplaceRanges(ranges, value); err != nil {
+				log.Warnf("failed to evaluate template variable in block %s: %v", b.Model().Id, err)
+			}
+		}
+		return true
+	})
+
+	evaluated := map[domain.RelationKey]string{}
+	for key, value := range st.Details().Iterate() {
+		str, ok := value.TryString()
+		if !ok || key == bundle.RelationKeyId {
+			continue
+		}
+		if newStr := evaluateString(str, resolve); newStr != str {
+			evaluated[key] = newStr
+		}
+	}
+	for key, str := range evaluated {
+		st.SetDetail(key, domain.String(str))
+	}
+}
+
+// collectPrompts returns unique prompts from text blocks and string details of the state
+func collectPrompts(st *state.State) []templateSvc.VariablePrompt {
+	var (
+		prompts []templateSvc.VariablePrompt
+		seen    = map[string]struct{}{}
+	)
+	addFrom := func(s string) {
+		_, exprs := findVariables(s)
+		for _, expr := range exprs {
+			name, def, ok := parsePrompt(expr)
+			if !ok {
+				continue
+			}
+			if _, exists := seen[name]; exists {
+				continue
+			}
+			seen[name] = struct{}{}
+			prompts = append(prompts, templateSvc.VariablePrompt{Name: name, Default: def})
+		}
+	}
+	_ = st.Iterate(func(b simple.Block) (isContinue bool) {
+		if tb, ok := b.(text.Block); ok {
+			addFrom(tb.GetText())
+		}
+		return true
+	})
+	for _, value := range st.Details().IterateSorted() {
+		if str, ok := value.TryString(); ok {
+			addFrom(str)
+		}
+	}
+	return prompts
+}
+
+func parsePrompt(expr string) (name, def string, ok bool) {
+	rest, ok := strings.CutPrefix(expr, variablePromptPrefix)
+	if !ok {
+		return "", "", false
+	}
+	name, def, _ = strings.Cut(rest, promptDefaultSeparator)
+	name = strings.TrimSpace(name)
+	return name, strings.TrimSpace(def), name != ""
+}
+
+// variableContext provides values of built-in variables. Values that need store lookups are requested lazily,
+// so templates without variables don't pay for them
+type variableContext struct {
+	now       time.Time
+	details   *domain.Details
+	values    map[string]string
+	creator   func() string
+	spaceName func() string
+	source    func() string
+	// relationValue formats the value of the relation, e.g. resolves names of linked objects
+	relationValue func(key domain.RelationKey, value domain.Value) string
+}
+
+func (c *variableContext) resolver() variableResolver {
+	cache := map[string]string{}
+	return func(expr string) (string, bool) {
+		if value, ok := cache[expr]; ok {
+			return value, true
+		}
+		value, ok := c.resolve(expr)
+		if ok {
+			cache[expr] = value
+		}
+		return value, ok
+	}
+}
+
+func (c *variableContext) resolve(expr string) (string, bool) {
+	switch expr {
+	case variableToday, variableDate:
+		return c.now.Format(variableDateLayout), true
+	case variableNow:
+		return c.now.Format(variableDateTimeLayout), true
+	case variableCreator:
+		return callOrEmpty(c.creator), true
+	case variableSpace:
+		return callOrEmpty(c.spaceName), true
+	case variableSource:
+		return callOrEmpty(c.source), true
+	}
+	if key, ok := strings.CutPrefix(expr, variableRelationPrefix); ok {
+		key = strings.TrimSpace(key)
+		if key == "" || c.details == nil {
+			return "", true
+		}
+		value := c.details.Get(domain.RelationKey(key))
+		if c.relationValue != nil {
+			return c.relationValue(domain.RelationKey(key), value), true
+		}
+		return formatValue(value), true
+	}
+	if name, def, ok := parsePrompt(expr); ok {
+		if value, exists := c.values[name]; exists {
+			return value, true
+		}
+		return def, true
+	}
+	return "", false
+}
+
+func callOrEmpty(f func() string) string {
+	if f == nil {
+		return ""
+	}
+	return f()
+}
+
+// formatValue converts the relation value to text
+func formatValue(value domain.Value) string {
+	if str, ok := value.TryString(); ok {
+		return str
+	}
+	if list, ok := value.TryStringList(); ok {
+		return strings.Join(list, ", ")
+	}
+	if b, ok := value.TryBool(); ok {
+		return strconv.FormatBool(b)
+	}
+	if f, ok := value.TryFloat64(); ok {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	if value.IsNull() {
+		return ""
+	}
+	return fmt.Sprint(value.Raw())
+}
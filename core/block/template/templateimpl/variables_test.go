@@ -0,0 +1,149 @@
+package templateimpl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/text"
+	templateSvc "github.com/anyproto/anytype-heart/core/block/template"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func newVariablesState(texts ...string) *state.State {
+	st := state.NewDoc("root", nil).NewState()
+	root := &model.Block{Id: "root"}
+	st.Add(simple.New(root))
+	for i, txt := range texts {
+		id := string(rune('a' + i))
+		st.Add(text.NewText(&model.Block{Id: id, Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: txt}}}))
+		root.ChildrenIds = append(root.ChildrenIds, id)
+	}
+	return st
+}
+
+func TestEvaluateString(t *testing.T) {
+	resolve := func(expr string) (string, bool) {
+		if expr == "known" {
+			return "value", true
+		}
+		return "", false
+	}
+
+	assert.Equal(t, "plain text", evaluateString("plain text", resolve))
+	assert.Equal(t, "value and value", evaluateString("{{known}} and {{ known }}", resolve))
+	assert.Equal(t, "value {{unknown}}", evaluateString("{{known}} {{unknown}}", resolve))
+}
+
+func TestParsePrompt(t *testing.T) {
+	for _, tc := range []struct {
+		expr, name, def string
+		ok              bool
+	}{
+		{"ask:Client name", "Client name", "", true},
+		{"ask: Client = ACME ", "Client", "ACME", true},
+		{"ask:", "", "", false},
+		{"today", "", "", false},
+	} {
+		name, def, ok := parsePrompt(tc.expr)
+		assert.Equal(t, tc.name, name, tc.expr)
+		assert.Equal(t, tc.def, def, tc.expr)
+		assert.Equal(t, tc.ok, ok, tc.expr)
+	}
+}
+
+func TestCollectPrompts(t *testing.T) {
+	// given
+	st := newVariablesState("Meeting with {{ask:Client}}", "{{ask:Topic=Planning}} for {{ask:Client}}", "{{today}}")
+	st.SetDetail(bundle.RelationKeyDescription, domain.String("{{ask:Budget}}"))
+
+	// when
+	prompts := collectPrompts(st)
+
+	// then
+	assert.Equal(t, []templateSvc.VariablePrompt{
+		{Name: "Client"},
+		{Name: "Topic", Default: "Planning"},
+		{Name: "Budget"},
+	}, prompts)
+}
+
+func TestEvaluateVariables(t *testing.T) {
+	now := time.Date(2024, 3, 5, 14, 30, 0, 0, time.UTC)
+
+	t.Run("built-in variables, prompts and relations", func(t *testing.T) {
+		// given
+		st := newVariablesState("{{today}} by {{creator}} in {{space}}", "Client: {{ask:Client=ACME}}, {{ask:Topic}}", "Status: {{relation:status}}, {{unknown}}")
+		st.SetDetail(bundle.RelationKeyName, domain.String("Meeting {{now}}"))
+		st.SetDetail("status", domain.String("done"))
+		vars := &variableContext{
+			now:       now,
+			details:   st.Details(),
+			values:    map[string]string{"Topic": "Roadmap"},
+			creator:   func() string { return "Alice" },
+			spaceName: func() string { return "Work" },
+		}
+
+		// when
+		evaluateVariables(st, vars.resolver())
+
+		// then
+		assert.Equal(t, "2024-03-05 by Alice in Work", st.Get("a").Model().GetText().Text)
+		assert.Equal(t, "Client: ACME, Roadmap", st.Get("b").Model().GetText().Text)
+		assert.Equal(t, "Status: done, {{unknown}}", st.Get("c").Model().GetText().Text)
+		assert.Equal(t, "Meeting 2024-03-05 14:30", st.Details().GetString(bundle.RelationKeyName))
+	})
+
+	t.Run("title block is evaluated via details", func(t *testing.T) {
+		// given
+		st := newVariablesState()
+		template.InitTemplate(st, template.WithTitle)
+		st.SetDetail(bundle.RelationKeyName, domain.String("{{source}} notes"))
+		vars := &variableContext{now: now, details: st.Details(), source: func() string { return "Project" }}
+
+		// when
+		evaluateVariables(st, vars.resolver())
+		st.BlocksInit(st)
+
+		// then
+		assert.Equal(t, "Project notes", st.Details().GetString(bundle.RelationKeyName))
+		title := st.Get(template.TitleBlockId)
+		require.NotNil(t, title)
+		assert.Equal(t, "Project notes", title.Model().GetText().Text)
+	})
+
+	t.Run("marks are shifted after replacement", func(t *testing.T) {
+		// given
+		st := newVariablesState("{{ask:Name}} bold")
+		st.Get("a").Model().GetText().Marks = &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{{
+			Range: &model.Range{From: 13, To: 17},
+			Type:  model.BlockContentTextMark_Bold,
+		}}}
+		vars := &variableContext{now: now, values: map[string]string{"Name": "Bob"}}
+
+		// when
+		evaluateVariables(st, vars.resolver())
+
+		// then
+		txt := st.Get("a").Model().GetText()
+		assert.Equal(t, "Bob bold", txt.Text)
+		require.Len(t, txt.Marks.Marks, 1)
+		assert.Equal(t, &model.Range{From: 4, To: 8}, txt.Marks.Marks[0].Range)
+	})
+}
+
+func TestFormatValue(t *testing.T) {
+	assert.Equal(t, "text", formatValue(domain.String("text")))
+	assert.Equal(t, "a, b", formatValue(domain.StringList([]string{"a", "b"})))
+	assert.Equal(t, "true", formatValue(domain.Bool(true)))
+	assert.Equal(t, "3", formatValue(domain.Int64(3)))
+	assert.Equal(t, "1.5", formatValue(domain.Float64(1.5)))
+	assert.Equal(t, "", formatValue(domain.Null()))
+}
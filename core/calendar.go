@@ -0,0 +1,151 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/anyproto/anytype-heart/core/calendar"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+func (mw *Middleware) CalendarExportView(cctx context.Context, req *pb.RpcCalendarExportViewRequest) *pb.RpcCalendarExportViewResponse {
+	path, err := mustService[calendar.Service](mw).ExportView(cctx, calendar.ViewRequest{
+		ObjectId:        req.ObjectId,
+		ViewId:          req.ViewId,
+		DateRelationKey: domain.RelationKey(req.DateRelationKey),
+		EndRelationKey:  domain.RelationKey(req.EndRelationKey),
+	}, req.Path)
+	code := mapErrorCode(err,
+		errToCode(calendar.ErrEmptyObjectId, pb.RpcCalendarExportViewResponseError_BAD_INPUT),
+		errToCode(calendar.ErrEmptyPath, pb.RpcCalendarExportViewResponseError_BAD_INPUT),
+		errToCode(calendar.ErrNoDataview, pb.RpcCalendarExportViewResponseError_BAD_INPUT),
+		errToCode(calendar.ErrUnsupportedListType, pb.RpcCalendarExportViewResponseError_BAD_INPUT),
+		errToCode(calendar.ErrNotDateRelation, pb.RpcCalendarExportViewResponseError_BAD_INPUT),
+		errToCode(calendar.ErrNoDateRelation, pb.RpcCalendarExportViewResponseError_NO_DATE_RELATION),
+		errToCode(calendar.ErrViewNotFound, pb.RpcCalendarExportViewResponseError_VIEW_NOT_FOUND),
+	)
+	return &pb.RpcCalendarExportViewResponse{
+		Path: path,
+		Error: &pb.RpcCalendarExportViewResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
+
+func (mw *Middleware) CalendarImport(cctx context.Context, req *pb.RpcCalendarImportRequest) *pb.RpcCalendarImportResponse {
+	response := func(ids []string, code pb.RpcCalendarImportResponseErrorCode, err error) *pb.RpcCalendarImportResponse {
+		return &pb.RpcCalendarImportResponse{
+			ObjectIds: ids,
+			Error: &pb.RpcCalendarImportResponseError{
+				Code:        code,
+				Description: getErrorDescription(err),
+			},
+		}
+	}
+
+	typeKey, err := domain.GetTypeKeyFromRawUniqueKey(req.ObjectTypeUniqueKey)
+	if err != nil {
+		return response(nil, pb.RpcCalendarImportResponseError_BAD_INPUT, fmt.Errorf("invalid object type unique key: %w", err))
+	}
+	ids, err := mustService[calendar.Service](mw).Import(cctx, calendar.ImportRequest{
+		SpaceId:         req.SpaceId,
+		Path:            req.Path,
+		TypeKey:         typeKey,
+		DateRelationKey: domain.RelationKey(req.DateRelationKey),
+		EndRelationKey:  domain.RelationKey(req.EndRelationKey),
+	})
+	code := mapErrorCode(err,
+		errToCode(calendar.ErrEmptySpaceId, pb.RpcCalendarImportResponseError_BAD_INPUT),
+		errToCode(calendar.ErrEmptyPath, pb.RpcCalendarImportResponseError_BAD_INPUT),
+		errToCode(calendar.ErrEmptyType, pb.RpcCalendarImportResponseError_BAD_INPUT),
+		errToCode(calendar.ErrNotDateRelation, pb.RpcCalendarImportResponseError_NOT_DATE_RELATION),
+	)
+	return response(ids, code, err)
+}
+
+func (mw *Middleware) CalendarFeedCreate(cctx context.Context, req *pb.RpcCalendarFeedCreateRequest) *pb.RpcCalendarFeedCreateResponse {
+	feed, err := mustService[calendar.Service](mw).CreateFeed(cctx, calendar.ViewRequest{
+		ObjectId:        req.ObjectId,
+		ViewId:          req.ViewId,
+		DateRelationKey: domain.RelationKey(req.DateRelationKey),
+		EndRelationKey:  domain.RelationKey(req.EndRelationKey),
+	})
+	code := mapErrorCode(err,
+		errToCode(calendar.ErrEmptyObjectId, pb.RpcCalendarFeedCreateResponseError_BAD_INPUT),
+		errToCode(calendar.ErrNoDataview, pb.RpcCalendarFeedCreateResponseError_BAD_INPUT),
+		errToCode(calendar.ErrUnsupportedListType, pb.RpcCalendarFeedCreateResponseError_BAD_INPUT),
+		errToCode(calendar.ErrNotDateRelation, pb.RpcCalendarFeedCreateResponseError_BAD_INPUT),
+		errToCode(calendar.ErrNoDateRelation, pb.RpcCalendarFeedCreateResponseError_NO_DATE_RELATION),
+		errToCode(calendar.ErrViewNotFound, pb.RpcCalendarFeedCreateResponseError_VIEW_NOT_FOUND),
+		errToCode(calendar.ErrFeedsDisabled, pb.RpcCalendarFeedCreateResponseError_FEEDS_DISABLED),
+	)
+	resp := &pb.RpcCalendarFeedCreateResponse{
+		Error: &pb.RpcCalendarFeedCreateResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+	if err == nil {
+		resp.Feed = feedToProto(feed)
+	}
+	return resp
+}
+
+func (mw *Middleware) CalendarFeedList(cctx context.Context, req *pb.RpcCalendarFeedListRequest) *pb.RpcCalendarFeedListResponse {
+	feeds, err := mustService[calendar.Service](mw).ListFeeds(cctx, req.SpaceId)
+	code := mapErrorCode(err,
+		errToCode(calendar.ErrEmptySpaceId, pb.RpcCalendarFeedListResponseError_BAD_INPUT),
+	)
+	resp := &pb.RpcCalendarFeedListResponse{
+		Error: &pb.RpcCalendarFeedListResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+	for _, feed := range feeds {
+		resp.Feeds = append(resp.Feeds, feedToProto(feed))
+	}
+	return resp
+}
+
+func (mw *Middleware) CalendarFeedRemove(cctx context.Context, req *pb.RpcCalendarFeedRemoveRequest) *pb.RpcCalendarFeedRemoveResponse {
+	err := mustService[calendar.Service](mw).RemoveFeed(cctx, req.Token)
+	code := mapErrorCode(err,
+		errToCode(calendar.ErrFeedNotFound, pb.RpcCalendarFeedRemoveResponseError_NOT_FOUND),
+	)
+	return &pb.RpcCalendarFeedRemoveResponse{
+		Error: &pb.RpcCalendarFeedRemoveResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
+
+func (mw *Middleware) CalendarFeedGet(cctx context.Context, req *pb.RpcCalendarFeedGetRequest) *pb.RpcCalendarFeedGetResponse {
+	data, err := mustService[calendar.Service](mw).FeedContent(cctx, req.Token)
+	code := mapErrorCode(err,
+		errToCode(calendar.ErrFeedNotFound, pb.RpcCalendarFeedGetResponseError_NOT_FOUND),
+	)
+	return &pb.RpcCalendarFeedGetResponse{
+		Data: string(data),
+		Error: &pb.RpcCalendarFeedGetResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
+
+func feedToProto(feed calendar.Feed) *pb.RpcCalendarFeed {
+	return &pb.RpcCalendarFeed{
+		Token:           feed.Token,
+		Url:             feed.Url,
+		SpaceId:         feed.SpaceId,
+		ObjectId:        feed.ObjectId,
+		ViewId:          feed.ViewId,
+		DateRelationKey: feed.DateRelationKey.String(),
+		EndRelationKey:  feed.EndRelationKey.String(),
+		CreatedDate:     feed.CreatedDate,
+	}
+}
@@ -0,0 +1,158 @@
+package calendar
+
+import (
+	"cmp"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	anystore "github.com/anyproto/any-store"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/util/keyvaluestore"
+)
+
+const (
+	feedsCollectionName = "calendar_feeds"
+	feedTokenLength     = 32
+
+	// FeedPathPrefix is the path of feeds in the local API server
+	FeedPathPrefix = "/v1/calendar/feeds/"
+)
+
+// Feed is a read-only iCalendar feed of a view. The token is the only credential of the feed, so calendar apps
+// can subscribe to it by the URL
+type Feed struct {
+	Token           string             `json:"token"`
+	SpaceId         string             `json:"spaceId"`
+	ObjectId        string             `json:"objectId"`
+	ViewId          string             `json:"viewId,omitempty"`
+	DateRelationKey domain.RelationKey `json:"dateRelationKey,omitempty"`
+	EndRelationKey  domain.RelationKey `json:"endRelationKey,omitempty"`
+	CreatedDate     int64              `json:"createdDate"`
+	// Url is filled on read, as the address of the API server can change
+	Url string `json:"-"`
+}
+
+func (f Feed) viewRequest() ViewRequest {
+	return ViewRequest{
+		ObjectId:        f.ObjectId,
+		ViewId:          f.ViewId,
+		DateRelationKey: f.DateRelationKey,
+		EndRelationKey:  f.EndRelationKey,
+	}
+}
+
+type feedStore struct {
+	feeds keyvaluestore.Store[Feed]
+}
+
+func newFeedStore(db anystore.DB) (*feedStore, error) {
+	feeds, err := keyvaluestore.NewJson[Feed](db, feedsCollectionName)
+	if err != nil {
+		return nil, fmt.Errorf("open feeds collection: %w", err)
+	}
+	return &feedStore{feeds: feeds}, nil
+}
+
+func (s *service) CreateFeed(ctx context.Context, req ViewRequest) (Feed, error) {
+	if s.apiListenAddr == "" {
+		return Feed{}, ErrFeedsDisabled
+	}
+	// the view is checked before the feed is created, so broken feeds are not stored
+	if _, err := s.encodeView(req); err != nil {
+		return Feed{}, err
+	}
+	spaceId, err := s.resolver.ResolveSpaceID(req.ObjectId)
+	if err != nil {
+		return Feed{}, fmt.Errorf("resolve spaceId: %w", err)
+	}
+	token, err := newFeedToken()
+	if err != nil {
+		return Feed{}, err
+	}
+	feed := Feed{
+		Token:           token,
+		SpaceId:         spaceId,
+		ObjectId:        req.ObjectId,
+		ViewId:          req.ViewId,
+		DateRelationKey: req.DateRelationKey,
+		EndRelationKey:  req.EndRelationKey,
+		CreatedDate:     time.Now().Unix(),
+	}
+	if err = s.feeds.feeds.Set(ctx, token, feed); err != nil {
+		return Feed{}, fmt.Errorf("save feed: %w", err)
+	}
+	return s.withUrl(feed), nil
+}
+
+func (s *service) ListFeeds(ctx context.Context, spaceId string) ([]Feed, error) {
+	if spaceId == "" {
+		return nil, ErrEmptySpaceId
+	}
+	all, err := s.feeds.feeds.ListAllValues(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list feeds: %w", err)
+	}
+	var feeds []Feed
+	for _, feed := range all {
+		if feed.SpaceId == spaceId {
+			feeds = append(feeds, s.withUrl(feed))
+		}
+	}
+	slices.SortFunc(feeds, func(a, b Feed) int {
+		return cmp.Compare(a.CreatedDate, b.CreatedDate)
+	})
+	return feeds, nil
+}
+
+func (s *service) RemoveFeed(ctx context.Context, token string) error {
+	if _, err := s.getFeed(ctx, token); err != nil {
+		return err
+	}
+	return s.feeds.feeds.Delete(ctx, token)
+}
+
+func (s *service) FeedContent(ctx context.Context, token string) ([]byte, error) {
+	feed, err := s.getFeed(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return s.encodeView(feed.viewRequest())
+}
+
+func (s *service) getFeed(ctx context.Context, token string) (Feed, error) {
+	if token == "" {
+		return Feed{}, ErrFeedNotFound
+	}
+	feed, err := s.feeds.feeds.Get(ctx, token)
+	if errors.Is(err, anystore.ErrDocNotFound) {
+		return Feed{}, ErrFeedNotFound
+	}
+	if err != nil {
+		return Feed{}, fmt.Errorf("get feed: %w", err)
+	}
+	return feed, nil
+}
+
+func (s *service) withUrl(feed Feed) Feed {
+	host := s.apiListenAddr
+	if strings.HasPrefix(host, ":") {
+		host = "127.0.0.1" + host
+	}
+	feed.Url = "http://" + host + FeedPathPrefix + feed.Token + ".ics"
+	return feed
+}
+
+func newFeedToken() (string, error) {
+	b := make([]byte, feedTokenLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,266 @@
+// Package ical encodes and decodes the subset of iCalendar (RFC 5545) used to exchange events with calendar apps
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	prodId = "-//Anytype//Anytype//EN"
+
+	dateLayout     = "20060102"
+	dateTimeLayout = "20060102T150405"
+	utcLayout      = "20060102T150405Z"
+
+	// maxLineLength is the maximum length of a content line in octets, longer lines are folded
+	maxLineLength = 75
+)
+
+var ErrNoCalendar = errors.New("no calendar in the input")
+
+type Event struct {
+	UID         string
+	Summary     string
+	Description string
+	URL         string
+	Start       time.Time
+	// End is exclusive, zero value means the event has no duration
+	End time.Time
+	// AllDay events have only dates, Start and End are starts of the days
+	AllDay       bool
+	LastModified time.Time
+}
+
+type Calendar struct {
+	Name   string
+	Events []Event
+}
+
+// Encode writes the calendar in iCalendar format. Stamp is the time the calendar is created at
+func Encode(w io.Writer, cal Calendar, stamp time.Time) error {
+	e := &encoder{w: bufio.NewWriter(w)}
+	e.line("BEGIN", "VCALENDAR")
+	e.line("VERSION", "2.0")
+	e.line("PRODID", prodId)
+	e.line("CALSCALE", "GREGORIAN")
+	e.line("METHOD", "PUBLISH")
+	if cal.Name != "" {
+		e.line("X-WR-CALNAME", escapeText(cal.Name))
+	}
+	for _, ev := range cal.Events {
+		e.line("BEGIN", "VEVENT")
+		e.line("UID", escapeText(ev.UID))
+		e.line("DTSTAMP", stamp.UTC().Format(utcLayout))
+		if ev.AllDay {
+			end := ev.End
+			if end.IsZero() || !end.After(ev.Start) {
+				end = ev.Start.AddDate(0, 0, 1)
+			}
+			e.line("DTSTART;VALUE=DATE", ev.Start.Format(dateLayout))
+			e.line("DTEND;VALUE=DATE", end.Format(dateLayout))
+		} else {
+			e.line("DTSTART", ev.Start.UTC().Format(utcLayout))
+			if ev.End.After(ev.Start) {
+				e.line("DTEND", ev.End.UTC().Format(utcLayout))
+			}
+		}
+		e.line("SUMMARY", escapeText(ev.Summary))
+		if ev.Description != "" {
+			e.line("DESCRIPTION", escapeText(ev.Description))
+		}
+		if ev.URL != "" {
+			e.line("URL", ev.URL)
+		}
+		if !ev.LastModified.IsZero() {
+			e.line("LAST-MODIFIED", ev.LastModified.UTC().Format(utcLayout))
+		}
+		e.line("END", "VEVENT")
+	}
+	e.line("END", "VCALENDAR")
+	if e.err != nil {
+		return e.err
+	}
+	return e.w.Flush()
+}
+
+type encoder struct {
+	w   *bufio.Writer
+	err error
+}
+
+// line writes the content line, folding it by octets without splitting UTF-8 characters
+func (e *encoder) line(name, value string) {
+	if e.err != nil {
+		return
+	}
+	line := name + ":" + value
+	var b strings.Builder
+	length := 0
+	for _, r := range line {
+		size := len(string(r))
+		if length+size > maxLineLength {
+			b.WriteString("\r\n ")
+			length = 1
+		}
+		b.WriteRune(r)
+		length += size
+	}
+	b.WriteString("\r\n")
+	_, e.err = e.w.WriteString(b.String())
+}
+
+// Decode reads events of the first calendar in the input. Events without start are skipped
+func Decode(r io.Reader) (Calendar, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return Calendar{}, err
+	}
+
+	var (
+		cal      Calendar
+		found    bool
+		inEvent  bool
+		ev       Event
+		hasStart bool
+	)
+	for _, line := range lines {
+		prop, ok := parseProperty(line)
+		if !ok {
+			continue
+		}
+		switch {
+		case prop.name == "BEGIN" && strings.EqualFold(prop.value, "VCALENDAR"):
+			found = true
+		case prop.name == "END" && strings.EqualFold(prop.value, "VCALENDAR"):
+			return cal, nil
+		case prop.name == "BEGIN" && strings.EqualFold(prop.value, "VEVENT"):
+			inEvent, ev, hasStart = true, Event{}, false
+		case prop.name == "END" && strings.EqualFold(prop.value, "VEVENT"):
+			if inEvent && hasStart {
+				if ev.AllDay && ev.End.IsZero() {
+					ev.End = ev.Start.AddDate(0, 0, 1)
+				}
+				cal.Events = append(cal.Events, ev)
+			}
+			inEvent = false
+		case prop.name == "X-WR-CALNAME" && !inEvent:
+			cal.Name = unescapeText(prop.value)
+		case inEvent:
+			switch prop.name {
+			case "UID":
+				ev.UID = unescapeText(prop.value)
+			case "SUMMARY":
+				ev.Summary = unescapeText(prop.value)
+			case "DESCRIPTION":
+				ev.Description = unescapeText(prop.value)
+			case "URL":
+				ev.URL = prop.value
+			case "DTSTART":
+				if ev.Start, ev.AllDay, err = prop.time(); err == nil {
+					hasStart = true
+				}
+			case "DTEND":
+				if end, _, err := prop.time(); err == nil {
+					ev.End = end
+				}
+			case "LAST-MODIFIED":
+				if modified, _, err := prop.time(); err == nil {
+					ev.LastModified = modified
+				}
+			}
+		}
+	}
+	if !found {
+		return Calendar{}, ErrNoCalendar
+	}
+	return cal, nil
+}
+
+// unfold joins folded content lines
+func unfold(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read calendar: %w", err)
+	}
+	return lines, nil
+}
+
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+func parseProperty(line string) (property, bool) {
+	// the value starts after the first colon that is not inside a quoted parameter value
+	inQuotes := false
+	colon := -1
+	for i, r := range line {
+		if r == '"' {
+			inQuotes = !inQuotes
+		}
+		if r == ':' && !inQuotes {
+			colon = i
+			break
+		}
+	}
+	if colon <= 0 {
+		return property{}, false
+	}
+	parts := strings.Split(line[:colon], ";")
+	prop := property{name: strings.ToUpper(parts[0]), value: line[colon+1:], params: map[string]string{}}
+	for _, param := range parts[1:] {
+		if key, value, ok := strings.Cut(param, "="); ok {
+			prop.params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+		}
+	}
+	return prop, true
+}
+
+// time parses date and date-time values. Floating times and times with unknown time zones are read in the local time zone
+func (p property) time() (t time.Time, isDate bool, err error) {
+	if strings.EqualFold(p.params["VALUE"], "DATE") || len(p.value) == len(dateLayout) {
+		t, err = time.ParseInLocation(dateLayout, p.value, time.Local)
+		return t, true, err
+	}
+	if strings.HasSuffix(p.value, "Z") {
+		t, err = time.Parse(utcLayout, p.value)
+		return t, false, err
+	}
+	loc := time.Local
+	if tzid := p.params["TZID"]; tzid != "" {
+		if l, err := time.LoadLocation(tzid); err == nil {
+			loc = l
+		}
+	}
+	t, err = time.ParseInLocation(dateTimeLayout, p.value, loc)
+	return t, false, err
+}
+
+var (
+	textEscaper   = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+	textUnescaper = strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n")
+)
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+func unescapeText(s string) string {
+	return textUnescaper.Replace(s)
+}
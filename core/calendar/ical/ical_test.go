@@ -0,0 +1,124 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncode(t *testing.T) {
+	stamp := time.Date(2024, 5, 15, 10, 0, 0, 0, time.UTC)
+
+	t.Run("events", func(t *testing.T) {
+		// given
+		cal := Calendar{
+			Name: "Deadlines",
+			Events: []Event{
+				{
+					UID:         "obj1",
+					Summary:     "Release; v1, final",
+					Description: "First line\nSecond line",
+					URL:         "anytype://object?objectId=obj1&spaceId=space1",
+					Start:       time.Date(2024, 5, 17, 0, 0, 0, 0, time.Local),
+					AllDay:      true,
+				},
+				{
+					UID:     "obj2",
+					Summary: "Review",
+					Start:   time.Date(2024, 5, 20, 13, 0, 0, 0, time.UTC),
+					End:     time.Date(2024, 5, 20, 14, 30, 0, 0, time.UTC),
+				},
+			},
+		}
+		buf := &bytes.Buffer{}
+
+		// when
+		err := Encode(buf, cal, stamp)
+
+		// then
+		require.NoError(t, err)
+		out := buf.String()
+		assert.True(t, strings.HasPrefix(out, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+		assert.True(t, strings.HasSuffix(out, "END:VCALENDAR\r\n"))
+		assert.Contains(t, out, "X-WR-CALNAME:Deadlines\r\n")
+		assert.Contains(t, out, "DTSTART;VALUE=DATE:20240517\r\nDTEND;VALUE=DATE:20240518\r\n")
+		assert.Contains(t, out, `SUMMARY:Release\; v1\, final`)
+		assert.Contains(t, out, `DESCRIPTION:First line\nSecond line`)
+		assert.Contains(t, out, "DTSTART:20240520T130000Z\r\nDTEND:20240520T143000Z\r\n")
+		assert.Contains(t, out, "DTSTAMP:20240515T100000Z\r\n")
+		assert.Equal(t, 2, strings.Count(out, "BEGIN:VEVENT"))
+	})
+
+	t.Run("long lines are folded", func(t *testing.T) {
+		// given
+		cal := Calendar{Events: []Event{{UID: "obj1", Summary: strings.Repeat("Ж", 100), Start: stamp}}}
+		buf := &bytes.Buffer{}
+
+		// when
+		err := Encode(buf, cal, stamp)
+
+		// then
+		require.NoError(t, err)
+		for _, line := range strings.Split(buf.String(), "\r\n") {
+			assert.LessOrEqual(t, len(line), maxLineLength)
+		}
+		decoded, err := Decode(buf)
+		require.NoError(t, err)
+		require.Len(t, decoded.Events, 1)
+		assert.Equal(t, strings.Repeat("Ж", 100), decoded.Events[0].Summary)
+	})
+}
+
+func TestDecode(t *testing.T) {
+	t.Run("encoded calendar", func(t *testing.T) {
+		// given
+		cal := Calendar{
+			Name: "Team, deadlines",
+			Events: []Event{
+				{UID: "obj1", Summary: "Release", Description: "Notes;\nmore", Start: time.Date(2024, 5, 17, 0, 0, 0, 0, time.Local), End: time.Date(2024, 5, 19, 0, 0, 0, 0, time.Local), AllDay: true},
+				{UID: "obj2", Summary: "Review", Start: time.Date(2024, 5, 20, 13, 0, 0, 0, time.UTC)},
+			},
+		}
+		buf := &bytes.Buffer{}
+		require.NoError(t, Encode(buf, cal, time.Now()))
+
+		// when
+		decoded, err := Decode(buf)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, cal.Name, decoded.Name)
+		require.Len(t, decoded.Events, 2)
+		assert.Equal(t, cal.Events[0], decoded.Events[0])
+		assert.True(t, cal.Events[1].Start.Equal(decoded.Events[1].Start))
+		assert.False(t, decoded.Events[1].AllDay)
+		assert.True(t, decoded.Events[1].End.IsZero())
+	})
+
+	t.Run("time zones and folded lines", func(t *testing.T) {
+		// given
+		input := "BEGIN:VCALENDAR\nVERSION:2.0\nBEGIN:VEVENT\nUID:1\nSUMMARY:Long\n  summary\nDTSTART;TZID=\"Europe/Berlin\":20240520T130000\nDTEND;VALUE=DATE-TIME;TZID=Europe/Berlin:20240520T140000\nEND:VEVENT\nBEGIN:VEVENT\nSUMMARY:No start\nEND:VEVENT\nEND:VCALENDAR\n"
+
+		// when
+		decoded, err := Decode(strings.NewReader(input))
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, decoded.Events, 1)
+		ev := decoded.Events[0]
+		assert.Equal(t, "Long summary", ev.Summary)
+		berlin, err := time.LoadLocation("Europe/Berlin")
+		require.NoError(t, err)
+		assert.True(t, time.Date(2024, 5, 20, 13, 0, 0, 0, berlin).Equal(ev.Start))
+		assert.Equal(t, time.Hour, ev.End.Sub(ev.Start))
+	})
+
+	t.Run("no calendar", func(t *testing.T) {
+		_, err := Decode(strings.NewReader("BEGIN:VCARD\nEND:VCARD\n"))
+		assert.ErrorIs(t, err, ErrNoCalendar)
+	})
+}
@@ -0,0 +1,81 @@
+package calendar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/anyproto/anytype-heart/core/block/object/objectcreator"
+	"github.com/anyproto/anytype-heart/core/calendar/ical"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+var ErrEmptyType = errors.New("object type is empty")
+
+func (s *service) Import(ctx context.Context, req ImportRequest) ([]string, error) {
+	if req.SpaceId == "" {
+		return nil, ErrEmptySpaceId
+	}
+	if req.Path == "" {
+		return nil, ErrEmptyPath
+	}
+	if req.TypeKey == "" {
+		return nil, ErrEmptyType
+	}
+	if req.DateRelationKey == "" {
+		req.DateRelationKey = bundle.RelationKeyDueDate
+	}
+	index := s.objectStore.SpaceIndex(req.SpaceId)
+	for _, key := range []domain.RelationKey{req.DateRelationKey, req.EndRelationKey} {
+		if key == "" {
+			continue
+		}
+		if format, err := index.GetRelationFormatByKey(key); err != nil || format != model.RelationFormat_date {
+			return nil, fmt.Errorf("%w: %s", ErrNotDateRelation, key)
+		}
+	}
+
+	f, err := os.Open(req.Path)
+	if err != nil {
+		return nil, fmt.Errorf("open file: %w", err)
+	}
+	defer f.Close()
+	cal, err := ical.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("decode calendar: %w", err)
+	}
+
+	ids := make([]string, 0, len(cal.Events))
+	for _, ev := range cal.Events {
+		id, _, err := s.objectCreator.CreateObject(ctx, req.SpaceId, objectcreator.CreateObjectRequest{
+			ObjectTypeKey: req.TypeKey,
+			Details:       eventDetails(ev, req),
+		})
+		if err != nil {
+			return ids, fmt.Errorf("create object of event %s: %w", ev.UID, err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func eventDetails(ev ical.Event, req ImportRequest) *domain.Details {
+	details := domain.NewDetails()
+	details.SetString(bundle.RelationKeyName, ev.Summary)
+	if ev.Description != "" {
+		details.SetString(bundle.RelationKeyDescription, ev.Description)
+	}
+	details.SetInt64(req.DateRelationKey, ev.Start.Unix())
+	if req.EndRelationKey != "" && !ev.End.IsZero() {
+		end := ev.End
+		if ev.AllDay {
+			// the end of all-day events is exclusive, while date relations hold the last day
+			end = end.AddDate(0, 0, -1)
+		}
+		details.SetInt64(req.EndRelationKey, end.Unix())
+	}
+	return details
+}
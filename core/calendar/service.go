@@ -0,0 +1,144 @@
+package calendar
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/anyproto/any-sync/app"
+
+	"github.com/anyproto/anytype-heart/core/anytype/config"
+	"github.com/anyproto/anytype-heart/core/block/cache"
+	"github.com/anyproto/anytype-heart/core/block/object/idresolver"
+	"github.com/anyproto/anytype-heart/core/block/object/objectcreator"
+	"github.com/anyproto/anytype-heart/core/calendar/ical"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/subscription"
+	"github.com/anyproto/anytype-heart/pkg/lib/datastore/anystoreprovider"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+)
+
+const CName = "core.calendar"
+
+var log = logging.Logger(CName)
+
+var (
+	ErrEmptyObjectId       = errors.New("object id is empty")
+	ErrEmptySpaceId        = errors.New("space id is empty")
+	ErrEmptyPath           = errors.New("path is empty")
+	ErrNoDataview          = errors.New("object has no dataview")
+	ErrViewNotFound        = errors.New("view is not found")
+	ErrNoDateRelation      = errors.New("view has no date relation")
+	ErrNotDateRelation     = errors.New("relation is not a date relation")
+	ErrFeedNotFound        = errors.New("feed is not found")
+	ErrFeedsDisabled       = errors.New("feeds are served by the local API server, which is disabled")
+	ErrUnsupportedListType = errors.New("object is neither a set nor a collection")
+)
+
+// ViewRequest selects objects of a set or collection view as calendar events
+type ViewRequest struct {
+	ObjectId string
+	// ViewId is the id of the view, the first view is used if empty
+	ViewId string
+	// DateRelationKey is the start of events, the date relation of the calendar view or the first date relation
+	// of the view is used if empty
+	DateRelationKey domain.RelationKey
+	// EndRelationKey is the end of events, the end relation of the calendar view is used if empty
+	EndRelationKey domain.RelationKey
+}
+
+type ImportRequest struct {
+	SpaceId string
+	// Path is the .ics file to import
+	Path    string
+	TypeKey domain.TypeKey
+	// DateRelationKey receives the start of events, dueDate is used if empty
+	DateRelationKey domain.RelationKey
+	// EndRelationKey receives the end of events, the end is not imported if empty
+	EndRelationKey domain.RelationKey
+}
+
+type Service interface {
+	app.Component
+
+	// ExportView writes objects of the view as events to an .ics file in the directory and returns the file path
+	ExportView(ctx context.Context, req ViewRequest, dir string) (path string, err error)
+	// Import creates objects of the type from events of the .ics file
+	Import(ctx context.Context, req ImportRequest) (objectIds []string, err error)
+
+	// CreateFeed creates a read-only iCalendar feed of the view, served by the local API server while the feed exists
+	CreateFeed(ctx context.Context, req ViewRequest) (Feed, error)
+	ListFeeds(ctx context.Context, spaceId string) ([]Feed, error)
+	RemoveFeed(ctx context.Context, token string) error
+	// FeedContent returns the current content of the feed in iCalendar format
+	FeedContent(ctx context.Context, token string) ([]byte, error)
+}
+
+type service struct {
+	objectStore   objectstore.ObjectStore
+	resolver      idresolver.Resolver
+	objectGetter  cache.ObjectGetter
+	subscriptions subscription.Service
+	objectCreator objectcreator.Service
+	feeds         *feedStore
+	apiListenAddr string
+}
+
+func New() Service {
+	return &service{}
+}
+
+func (s *service) Init(a *app.App) (err error) {
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	s.resolver = app.MustComponent[idresolver.Resolver](a)
+	s.objectGetter = app.MustComponent[cache.ObjectGetter](a)
+	s.subscriptions = app.MustComponent[subscription.Service](a)
+	s.objectCreator = app.MustComponent[objectcreator.Service](a)
+	s.apiListenAddr = a.MustComponent(config.CName).(*config.Config).JsonApiListenAddr
+
+	provider := app.MustComponent[anystoreprovider.Provider](a)
+	s.feeds, err = newFeedStore(provider.GetCommonDb())
+	if err != nil {
+		return fmt.Errorf("init feed store: %w", err)
+	}
+	return nil
+}
+
+func (s *service) Name() string {
+	return CName
+}
+
+func (s *service) ExportView(ctx context.Context, req ViewRequest, dir string) (string, error) {
+	if dir == "" {
+		return "", ErrEmptyPath
+	}
+	data, err := s.encodeView(req)
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, fmt.Sprintf("calendar.%s.%s.ics", req.ObjectId, time.Now().Format("20060102.150405")))
+	if err = os.WriteFile(path, data, 0o600); err != nil {
+		return "", fmt.Errorf("write file: %w", err)
+	}
+	return path, nil
+}
+
+func (s *service) encodeView(req ViewRequest) ([]byte, error) {
+	if req.ObjectId == "" {
+		return nil, ErrEmptyObjectId
+	}
+	cal, err := s.viewCalendar(req)
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	if err = ical.Encode(buf, cal, time.Now()); err != nil {
+		return nil, fmt.Errorf("encode calendar: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,216 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/anyproto/anytype-heart/core/block/cache"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/calendar/ical"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/subscription"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore/spaceindex"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// listView is a view of a set or collection with everything needed to query its objects
+type listView struct {
+	spaceId      string
+	name         string
+	view         *model.BlockContentDataviewView
+	setOf        []string
+	collectionId string
+}
+
+func (s *service) viewCalendar(req ViewRequest) (ical.Calendar, error) {
+	lv, err := s.getListView(req.ObjectId, req.ViewId)
+	if err != nil {
+		return ical.Calendar{}, err
+	}
+	index := s.objectStore.SpaceIndex(lv.spaceId)
+	dateKey, endKey, err := resolveDateRelations(index, lv.view, req.DateRelationKey, req.EndRelationKey)
+	if err != nil {
+		return ical.Calendar{}, err
+	}
+	includeTime := relationIncludesTime(index, dateKey)
+
+	records, err := s.queryView(index, lv, dateKey, endKey)
+	if err != nil {
+		return ical.Calendar{}, err
+	}
+
+	cal := ical.Calendar{Name: lv.name}
+	for _, details := range records {
+		ev := ical.Event{
+			UID:          details.GetString(bundle.RelationKeyId),
+			Summary:      details.GetString(bundle.RelationKeyName),
+			Description:  details.GetString(bundle.RelationKeySnippet),
+			URL:          objectURL(lv.spaceId, details.GetString(bundle.RelationKeyId)),
+			Start:        time.Unix(details.GetInt64(dateKey), 0),
+			AllDay:       !includeTime,
+			LastModified: time.Unix(details.GetInt64(bundle.RelationKeyLastModifiedDate), 0),
+		}
+		if ev.Description == "" {
+			ev.Description = details.GetString(bundle.RelationKeyDescription)
+		}
+		if endKey != "" && details.Has(endKey) {
+			ev.End = time.Unix(details.GetInt64(endKey), 0)
+		}
+		if ev.AllDay {
+			ev.Start = startOfDay(ev.Start)
+			if !ev.End.IsZero() {
+				// the end of all-day events is exclusive
+				ev.End = startOfDay(ev.End).AddDate(0, 0, 1)
+			}
+		}
+		cal.Events = append(cal.Events, ev)
+	}
+	return cal, nil
+}
+
+func (s *service) getListView(objectId, viewId string) (*listView, error) {
+	spaceId, err := s.resolver.ResolveSpaceID(objectId)
+	if err != nil {
+		return nil, fmt.Errorf("resolve spaceId: %w", err)
+	}
+	lv := &listView{spaceId: spaceId}
+	err = cache.Do(s.objectGetter, objectId, func(sb smartblock.SmartBlock) error {
+		st := sb.NewState()
+		var dataview *model.BlockContentDataview
+		st.Iterate(func(b simple.Block) (isContinue bool) {
+			dataview = b.Model().GetDataview()
+			return dataview == nil
+		})
+		if dataview == nil || len(dataview.Views) == 0 {
+			return ErrNoDataview
+		}
+		for _, view := range dataview.Views {
+			if viewId == "" || view.Id == viewId {
+				lv.view = view
+				break
+			}
+		}
+		if lv.view == nil {
+			return ErrViewNotFound
+		}
+
+		details := st.CombinedDetails()
+		lv.name = details.GetString(bundle.RelationKeyName)
+		if lv.view.Name != "" {
+			lv.name += " - " + lv.view.Name
+		}
+		switch model.ObjectTypeLayout(details.GetInt64(bundle.RelationKeyResolvedLayout)) {
+		case model.ObjectType_set, model.ObjectType_objectType:
+			lv.setOf = details.GetStringList(bundle.RelationKeySetOf)
+		case model.ObjectType_collection:
+			lv.collectionId = objectId
+		default:
+			return ErrUnsupportedListType
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return lv, nil
+}
+
+// resolveDateRelations returns the relations of the start and the end of events. Relations set in the request
+// take precedence over relations of the calendar view, the first date relation of the view is used otherwise
+func resolveDateRelations(index spaceindex.Store, view *model.BlockContentDataviewView, dateKey, endKey domain.RelationKey) (domain.RelationKey, domain.RelationKey, error) {
+	if view.Type == model.BlockContentDataviewView_Calendar {
+		if dateKey == "" {
+			dateKey = domain.RelationKey(view.GroupRelationKey)
+		}
+		if endKey == "" {
+			endKey = domain.RelationKey(view.EndRelationKey)
+		}
+	}
+	if dateKey == "" {
+		for _, rel := range view.Relations {
+			if !rel.IsVisible {
+				continue
+			}
+			if format, err := index.GetRelationFormatByKey(domain.RelationKey(rel.Key)); err == nil && format == model.RelationFormat_date {
+				dateKey = domain.RelationKey(rel.Key)
+				break
+			}
+		}
+	}
+	if dateKey == "" {
+		return "", "", ErrNoDateRelation
+	}
+	for _, key := range []domain.RelationKey{dateKey, endKey} {
+		if key == "" {
+			continue
+		}
+		if format, err := index.GetRelationFormatByKey(key); err != nil || format != model.RelationFormat_date {
+			return "", "", fmt.Errorf("%w: %s", ErrNotDateRelation, key)
+		}
+	}
+	return dateKey, endKey, nil
+}
+
+// queryView returns objects of the view that have the date set, filtered and sorted as in the view
+func (s *service) queryView(index spaceindex.Store, lv *listView, dateKey, endKey domain.RelationKey) ([]*domain.Details, error) {
+	var sources []string
+	for _, id := range lv.setOf {
+		uk, err := index.GetUniqueKeyById(id)
+		if err != nil {
+			return nil, fmt.Errorf("get unique key of set source: %w", err)
+		}
+		sources = append(sources, uk.Marshal())
+	}
+
+	filters := append(database.FiltersFromProto(lv.view.Filters), database.FilterRequest{
+		RelationKey: dateKey,
+		Condition:   model.BlockContentDataviewFilter_NotEmpty,
+	})
+	keys := []string{
+		bundle.RelationKeyId.String(),
+		bundle.RelationKeyName.String(),
+		bundle.RelationKeySnippet.String(),
+		bundle.RelationKeyDescription.String(),
+		bundle.RelationKeyLastModifiedDate.String(),
+		dateKey.String(),
+	}
+	if endKey != "" {
+		keys = append(keys, endKey.String())
+	}
+
+	resp, err := s.subscriptions.Search(subscription.SubscribeRequest{
+		SpaceId:           lv.spaceId,
+		Filters:           filters,
+		Sorts:             database.SortsFromProto(lv.view.Sorts),
+		Keys:              keys,
+		Source:            sources,
+		CollectionId:      lv.collectionId,
+		NoDepSubscription: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query view objects: %w", err)
+	}
+	if err = s.subscriptions.Unsubscribe(resp.SubId); err != nil {
+		log.Warnf("failed to unsubscribe from view objects: %v", err)
+	}
+	return resp.Records, nil
+}
+
+func relationIncludesTime(index spaceindex.Store, key domain.RelationKey) bool {
+	rel, err := index.GetRelationByKey(key.String())
+	if err != nil {
+		return false
+	}
+	return rel.IncludeTime
+}
+
+func objectURL(spaceId, objectId string) string {
+	return "anytype://object?objectId=" + objectId + "&spaceId=" + spaceId
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/anyproto/anytype-heart/core/block"
 	"github.com/anyproto/anytype-heart/core/block/object/objectcreator"
+	"github.com/anyproto/anytype-heart/core/block/template"
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
@@ -32,6 +33,10 @@ func (mw *Middleware) ObjectCreate(cctx context.Context, req *pb.RpcObjectCreate
 		Details:       domain.NewDetailsFromProto(req.Details),
 		InternalFlags: req.InternalFlags,
 		TemplateId:    req.TemplateId,
+		TemplateVariables: template.Variables{
+			SourceObjectId: req.SourceObjectId,
+			Values:         req.TemplateVariables,
+		},
 	}
 	id, newDetails, err := creator.CreateObjectUsingObjectUniqueTypeKey(cctx, req.SpaceId, req.ObjectTypeUniqueKey, createReq)
 	if err != nil {
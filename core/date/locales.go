@@ -0,0 +1,411 @@
+package date
+
+import (
+	"strings"
+	"time"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// maxPhraseLength is the maximum number of words in localized phrases
+const maxPhraseLength = 4
+
+var weekdays = map[string]time.Weekday{
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+	"sunday":    time.Sunday,
+}
+
+var months = map[string]time.Month{
+	"january":   time.January,
+	"february":  time.February,
+	"march":     time.March,
+	"april":     time.April,
+	"may":       time.May,
+	"june":      time.June,
+	"july":      time.July,
+	"august":    time.August,
+	"september": time.September,
+	"october":   time.October,
+	"november":  time.November,
+	"december":  time.December,
+}
+
+type dateLocale struct {
+	// monthFirst is the order of numeric dates with slashes and dashes, dates with dots are always read day first
+	monthFirst bool
+	// words maps localized words and phrases to canonical tokens, empty tokens are skipped
+	words map[string]string
+}
+
+// translate splits the input into words and translates them to canonical tokens. Words with digits are kept as is,
+// the input is not recognized in the locale if it has unknown words
+func (l *dateLocale) translate(raw string) ([]string, bool) {
+	words := l.splitWords(raw)
+	var tokens []string
+	for i := 0; i < len(words); {
+		n := min(maxPhraseLength, len(words)-i)
+		for ; n > 0; n-- {
+			if canonical, ok := l.words[strings.Join(words[i:i+n], " ")]; ok {
+				tokens = append(tokens, strings.Fields(canonical)...)
+				break
+			}
+		}
+		if n > 0 {
+			i += n
+			continue
+		}
+		if !strings.ContainsFunc(words[i], unicode.IsDigit) {
+			return nil, false
+		}
+		tokens = append(tokens, words[i])
+		i++
+	}
+	return tokens, true
+}
+
+// splitWords splits the input into words, elisions like "l'année" are split into two words unless the whole word is known
+func (l *dateLocale) splitWords(raw string) []string {
+	var words []string
+	for _, word := range strings.Fields(strings.NewReplacer(",", " ", "’", "'").Replace(foldWord(raw))) {
+		if prefix, rest, ok := strings.Cut(word, "'"); ok && rest != "" {
+			if _, known := l.words[word]; !known {
+				words = append(words, prefix+"'", rest)
+				continue
+			}
+		}
+		words = append(words, word)
+	}
+	return words
+}
+
+// foldWord lowercases the text and removes diacritics, so "Mañana" and "manana" are the same word
+func foldWord(s string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	folded, _, err := transform.String(t, s)
+	if err != nil {
+		folded = s
+	}
+	return strings.ToLower(folded)
+}
+
+func newDateLocale(monthFirst bool, groups ...map[string][]string) *dateLocale {
+	l := &dateLocale{monthFirst: monthFirst, words: map[string]string{}}
+	for _, group := range groups {
+		for canonical, words := range group {
+			for _, word := range words {
+				l.words[foldWord(word)] = canonical
+			}
+		}
+	}
+	return l
+}
+
+var englishWords = map[string][]string{
+	"":                 {"at", "on", "the", "of", "by"},
+	"1":                {"a", "an", "one"},
+	"2":                {"two"},
+	"3":                {"three"},
+	"4":                {"four"},
+	"5":                {"five"},
+	"6":                {"six"},
+	"7":                {"seven"},
+	"8":                {"eight"},
+	"9":                {"nine"},
+	"10":               {"ten"},
+	tokenNow:           {"now", "right now"},
+	tokenToday:         {"today"},
+	tokenTomorrow:      {"tomorrow", "tmrw"},
+	tokenYesterday:     {"yesterday"},
+	tokenOvermorrow:    {"day after tomorrow", "the day after tomorrow"},
+	tokenOveryesterday: {"day before yesterday", "the day before yesterday"},
+	tokenNoon:          {"noon", "midday"},
+	tokenMidnight:      {"midnight"},
+	tokenNext:          {"next", "coming"},
+	tokenLast:          {"last", "previous", "past"},
+	tokenThis:          {"this"},
+	tokenIn:            {"in", "within"},
+	tokenAgo:           {"ago"},
+	tokenFromNow:       {"from now", "later"},
+	tokenEndOf:         {"end of", "end"},
+	tokenStartOf:       {"start of", "beginning of", "start"},
+	tokenAm:            {"am", "a.m."},
+	tokenPm:            {"pm", "p.m."},
+	tokenOClock:        {"o'clock", "oclock"},
+	tokenMinute:        {"minute", "minutes", "min", "mins"},
+	tokenHour:          {"hour", "hours", "hr", "hrs"},
+	tokenDay:           {"day", "days"},
+	tokenWeek:          {"week", "weeks", "wk"},
+	tokenMonth:         {"month", "months"},
+	tokenYear:          {"year", "years", "yr"},
+	"monday":           {"monday", "mon"},
+	"tuesday":          {"tuesday", "tue", "tues"},
+	"wednesday":        {"wednesday", "wed"},
+	"thursday":         {"thursday", "thu", "thur", "thurs"},
+	"friday":           {"friday", "fri"},
+	"saturday":         {"saturday", "sat"},
+	"sunday":           {"sunday", "sun"},
+	"january":          {"january", "jan"},
+	"february":         {"february", "feb"},
+	"march":            {"march", "mar"},
+	"april":            {"april", "apr"},
+	"may":              {"may"},
+	"june":             {"june", "jun"},
+	"july":             {"july", "jul"},
+	"august":           {"august", "aug"},
+	"september":        {"september", "sep", "sept"},
+	"october":          {"october", "oct"},
+	"november":         {"november", "nov"},
+	"december":         {"december", "dec"},
+}
+
+var germanWords = map[string][]string{
+	"":                 {"am", "um", "im", "an", "der", "die", "das", "des", "den", "dem", "zum"},
+	"1":                {"ein", "eine", "einem", "einer", "einen", "eins"},
+	"2":                {"zwei"},
+	"3":                {"drei"},
+	"4":                {"vier"},
+	"5":                {"fünf"},
+	"6":                {"sechs"},
+	"7":                {"sieben"},
+	"8":                {"acht"},
+	"9":                {"neun"},
+	"10":               {"zehn"},
+	tokenNow:           {"jetzt", "sofort"},
+	tokenToday:         {"heute"},
+	tokenTomorrow:      {"morgen"},
+	tokenYesterday:     {"gestern"},
+	tokenOvermorrow:    {"übermorgen"},
+	tokenOveryesterday: {"vorgestern"},
+	tokenNoon:          {"mittag"},
+	tokenMidnight:      {"mitternacht"},
+	tokenNext:          {"nächste", "nächsten", "nächster", "nächstes", "kommende", "kommenden", "kommender", "kommendes"},
+	tokenLast:          {"letzte", "letzten", "letzter", "letztes", "vergangene", "vergangenen", "vergangener", "vergangenes"},
+	tokenThis:          {"diese", "diesen", "dieser", "dieses"},
+	tokenIn:            {"in"},
+	tokenAgo:           {"vor"},
+	tokenEndOf:         {"ende", "ende des", "ende der"},
+	tokenStartOf:       {"anfang", "anfang des", "anfang der", "beginn", "beginn des", "beginn der"},
+	tokenOClock:        {"uhr"},
+	tokenMinute:        {"minute", "minuten"},
+	tokenHour:          {"stunde", "stunden"},
+	tokenDay:           {"tag", "tage", "tagen"},
+	tokenWeek:          {"woche", "wochen"},
+	tokenMonth:         {"monat", "monate", "monaten", "monats"},
+	tokenYear:          {"jahr", "jahre", "jahren", "jahres"},
+	"monday":           {"montag", "mo"},
+	"tuesday":          {"dienstag", "di"},
+	"wednesday":        {"mittwoch", "mi"},
+	"thursday":         {"donnerstag", "do"},
+	"friday":           {"freitag", "fr"},
+	"saturday":         {"samstag", "sonnabend", "sa"},
+	"sunday":           {"sonntag", "so"},
+	"january":          {"januar", "jänner", "jan"},
+	"february":         {"februar", "feb"},
+	"march":            {"märz", "mär"},
+	"april":            {"april", "apr"},
+	"may":              {"mai"},
+	"june":             {"juni", "jun"},
+	"july":             {"juli", "jul"},
+	"august":           {"august", "aug"},
+	"september":        {"september", "sep", "sept"},
+	"october":          {"oktober", "okt"},
+	"november":         {"november", "nov"},
+	"december":         {"dezember", "dez"},
+}
+
+var frenchWords = map[string][]string{
+	"":                 {"à", "a", "le", "la", "l'", "les", "du", "de", "des", "ce", "cette"},
+	"1":                {"un", "une"},
+	"2":                {"deux"},
+	"3":                {"trois"},
+	"4":                {"quatre"},
+	"5":                {"cinq"},
+	"6":                {"six"},
+	"7":                {"sept"},
+	"8":                {"huit"},
+	"9":                {"neuf"},
+	"10":               {"dix"},
+	tokenNow:           {"maintenant"},
+	tokenToday:         {"aujourd'hui"},
+	tokenTomorrow:      {"demain"},
+	tokenYesterday:     {"hier"},
+	tokenOvermorrow:    {"après-demain", "après demain"},
+	tokenOveryesterday: {"avant-hier", "avant hier"},
+	tokenNoon:          {"midi"},
+	tokenMidnight:      {"minuit"},
+	tokenNext:          {"prochain", "prochaine", "suivant", "suivante"},
+	tokenLast:          {"dernier", "dernière", "passé", "passée"},
+	tokenIn:            {"dans"},
+	tokenAgo:           {"il y a"},
+	tokenEndOf:         {"fin", "fin du", "fin de", "fin de la", "fin de l'"},
+	tokenStartOf:       {"début", "début du", "début de", "début de la", "début de l'"},
+	tokenMinute:        {"minute", "minutes"},
+	tokenHour:          {"heure", "heures"},
+	tokenDay:           {"jour", "jours"},
+	tokenWeek:          {"semaine", "semaines"},
+	tokenMonth:         {"mois"},
+	tokenYear:          {"an", "ans", "année", "années"},
+	"monday":           {"lundi"},
+	"tuesday":          {"mardi"},
+	"wednesday":        {"mercredi"},
+	"thursday":         {"jeudi"},
+	"friday":           {"vendredi"},
+	"saturday":         {"samedi"},
+	"sunday":           {"dimanche"},
+	"january":          {"janvier", "janv"},
+	"february":         {"février", "févr"},
+	"march":            {"mars"},
+	"april":            {"avril", "avr"},
+	"may":              {"mai"},
+	"june":             {"juin"},
+	"july":             {"juillet", "juil"},
+	"august":           {"août"},
+	"september":        {"septembre"},
+	"october":          {"octobre", "oct"},
+	"november":         {"novembre", "nov"},
+	"december":         {"décembre", "déc"},
+}
+
+var spanishWords = map[string][]string{
+	"":                 {"a", "a las", "a la", "el", "la", "los", "las", "de", "del"},
+	"1":                {"un", "una", "uno"},
+	"2":                {"dos"},
+	"3":                {"tres"},
+	"4":                {"cuatro"},
+	"5":                {"cinco"},
+	"6":                {"seis"},
+	"7":                {"siete"},
+	"8":                {"ocho"},
+	"9":                {"nueve"},
+	"10":               {"diez"},
+	tokenNow:           {"ahora"},
+	tokenToday:         {"hoy"},
+	tokenTomorrow:      {"mañana"},
+	tokenYesterday:     {"ayer"},
+	tokenOvermorrow:    {"pasado mañana"},
+	tokenOveryesterday: {"anteayer", "antes de ayer"},
+	tokenNoon:          {"mediodía"},
+	tokenMidnight:      {"medianoche"},
+	tokenNext:          {"próximo", "próxima", "siguiente", "que viene"},
+	tokenLast:          {"pasado", "pasada", "anterior"},
+	tokenThis:          {"este", "esta"},
+	tokenIn:            {"en", "dentro de"},
+	tokenAgo:           {"hace"},
+	tokenEndOf:         {"fin de", "fin del", "final de", "final del", "fin"},
+	tokenStartOf:       {"inicio de", "inicio del", "principio de", "principio del", "comienzo de", "comienzo del"},
+	tokenMinute:        {"minuto", "minutos"},
+	tokenHour:          {"hora", "horas"},
+	tokenDay:           {"día", "días"},
+	tokenWeek:          {"semana", "semanas"},
+	tokenMonth:         {"mes", "meses"},
+	tokenYear:          {"año", "años"},
+	"monday":           {"lunes"},
+	"tuesday":          {"martes"},
+	"wednesday":        {"miércoles"},
+	"thursday":         {"jueves"},
+	"friday":           {"viernes"},
+	"saturday":         {"sábado"},
+	"sunday":           {"domingo"},
+	"january":          {"enero", "ene"},
+	"february":         {"febrero", "feb"},
+	"march":            {"marzo", "mar"},
+	"april":            {"abril", "abr"},
+	"may":              {"mayo"},
+	"june":             {"junio", "jun"},
+	"july":             {"julio", "jul"},
+	"august":           {"agosto", "ago"},
+	"september":        {"septiembre", "setiembre", "sep"},
+	"october":          {"octubre", "oct"},
+	"november":         {"noviembre", "nov"},
+	"december":         {"diciembre", "dic"},
+}
+
+var russianWords = map[string][]string{
+	"":                 {"в", "во", "на", "к"},
+	"1":                {"один", "одна", "одну", "одно"},
+	"2":                {"два", "две"},
+	"3":                {"три"},
+	"4":                {"четыре"},
+	"5":                {"пять"},
+	"6":                {"шесть"},
+	"7":                {"семь"},
+	"8":                {"восемь"},
+	"9":                {"девять"},
+	"10":               {"десять"},
+	tokenNow:           {"сейчас"},
+	tokenToday:         {"сегодня"},
+	tokenTomorrow:      {"завтра"},
+	tokenYesterday:     {"вчера"},
+	tokenOvermorrow:    {"послезавтра"},
+	tokenOveryesterday: {"позавчера"},
+	tokenNoon:          {"полдень"},
+	tokenMidnight:      {"полночь"},
+	tokenNext:          {"следующий", "следующая", "следующую", "следующее", "следующей", "следующем"},
+	tokenLast:          {"прошлый", "прошлая", "прошлую", "прошлое", "прошлой", "прошлом"},
+	tokenThis:          {"этот", "эта", "эту", "это", "этой", "этом"},
+	tokenIn:            {"через"},
+	tokenAgo:           {"назад"},
+	tokenEndOf:         {"конец", "конце"},
+	tokenStartOf:       {"начало", "начале"},
+	tokenMinute:        {"минута", "минуту", "минуты", "минут"},
+	tokenHour:          {"час", "часа", "часов"},
+	tokenDay:           {"день", "дня", "дней"},
+	tokenWeek:          {"неделя", "неделю", "недели", "недель", "неделе"},
+	tokenMonth:         {"месяц", "месяца", "месяцев", "месяце"},
+	tokenYear:          {"год", "года", "лет", "году"},
+	"monday":           {"понедельник"},
+	"tuesday":          {"вторник"},
+	"wednesday":        {"среда", "среду"},
+	"thursday":         {"четверг"},
+	"friday":           {"пятница", "пятницу"},
+	"saturday":         {"суббота", "субботу"},
+	"sunday":           {"воскресенье"},
+	"january":          {"январь", "января"},
+	"february":         {"февраль", "февраля"},
+	"march":            {"март", "марта"},
+	"april":            {"апрель", "апреля"},
+	"may":              {"май", "мая"},
+	"june":             {"июнь", "июня"},
+	"july":             {"июль", "июля"},
+	"august":           {"август", "августа"},
+	"september":        {"сентябрь", "сентября"},
+	"october":          {"октябрь", "октября"},
+	"november":         {"ноябрь", "ноября"},
+	"december":         {"декабрь", "декабря"},
+}
+
+var (
+	locales = map[string]*dateLocale{
+		"en":    newDateLocale(true, englishWords),
+		"en-gb": newDateLocale(false, englishWords),
+		"de":    newDateLocale(false, germanWords),
+		"fr":    newDateLocale(false, frenchWords),
+		"es":    newDateLocale(false, spanishWords),
+		"ru":    newDateLocale(false, russianWords),
+	}
+	localesOrder = []*dateLocale{locales["en"], locales["de"], locales["fr"], locales["es"], locales["ru"]}
+)
+
+// SupportedDateLocales returns languages of natural language dates
+func SupportedDateLocales() []string {
+	return []string{"en", "de", "fr", "es", "ru"}
+}
+
+// findLocale finds the locale by a language tag like "en-GB" or "de_AT", falling back to the language
+func findLocale(tag string) (*dateLocale, bool) {
+	tag = strings.ReplaceAll(strings.ToLower(strings.TrimSpace(tag)), "_", "-")
+	if l, ok := locales[tag]; ok {
+		return l, true
+	}
+	lang, _, _ := strings.Cut(tag, "-")
+	l, ok := locales[lang]
+	return l, ok
+}
@@ -0,0 +1,568 @@
+package date
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrUnrecognizedDate  = errors.New("unrecognized date")
+	ErrUnsupportedLocale = errors.New("unsupported locale")
+)
+
+// ParsedDate is a date parsed from the user input. Time is the start of the day if IncludeTime is false
+type ParsedDate struct {
+	Time        time.Time
+	IncludeTime bool
+}
+
+// canonical tokens, localized words and phrases are translated to them before parsing
+const (
+	tokenNow           = "now"
+	tokenToday         = "today"
+	tokenTomorrow      = "tomorrow"
+	tokenYesterday     = "yesterday"
+	tokenOvermorrow    = "overmorrow"
+	tokenOveryesterday = "overyesterday"
+	tokenNoon          = "noon"
+	tokenMidnight      = "midnight"
+	tokenNext          = "next"
+	tokenLast          = "last"
+	tokenThis          = "this"
+	tokenIn            = "in"
+	tokenAgo           = "ago"
+	tokenFromNow       = "fromnow"
+	tokenEndOf         = "endof"
+	tokenStartOf       = "startof"
+	tokenAm            = "am"
+	tokenPm            = "pm"
+	tokenOClock        = "oclock"
+	tokenMinute        = "minute"
+	tokenHour          = "hour"
+	tokenDay           = "day"
+	tokenWeek          = "week"
+	tokenMonth         = "month"
+	tokenYear          = "year"
+)
+
+var (
+	isoDateRe     = regexp.MustCompile(`^(\d{4})-(\d{1,2})-(\d{1,2})$`)
+	numericDateRe = regexp.MustCompile(`^(\d{1,2})([./-])(\d{1,2})(?:[./-](\d{2}|\d{4}))?$`)
+	clockRe       = regexp.MustCompile(`^(\d{1,2})(?:([:h])(\d{2})?)?(am|pm)?$`)
+	ordinalRe     = regexp.MustCompile(`^(\d{1,2})(?:st|nd|rd|th|er|e|\.)$`)
+)
+
+// ParseNaturalDate parses dates written in natural language, like "next friday 3pm", "in 2 weeks" or "end of month",
+// relative to now. Locale is a language tag like "en-US" or "de", all supported locales are tried in order if it is empty.
+//
+// Ambiguous inputs are resolved the same way for all locales:
+//   - a weekday is today or the closest day after today, "next friday" is the closest one after today,
+//     "last friday" is the closest one before today and "this friday" is the one of the current ISO week
+//   - a date without a year is in the current year
+//   - numeric dates like 05/02 are read month first for en and en-US and day first for other locales,
+//     numeric dates with dots like 05.02 are always read day first
+//   - a time without a date is today
+func ParseNaturalDate(raw string, now time.Time, locale string) (ParsedDate, error) {
+	if parsed, err := time.Parse(time.RFC3339, strings.TrimSpace(raw)); err == nil {
+		return ParsedDate{Time: parsed.In(now.Location()), IncludeTime: true}, nil
+	}
+
+	if locale == "" {
+		for _, l := range localesOrder {
+			if res, ok := parseInLocale(raw, now, l); ok {
+				return res, nil
+			}
+		}
+		return ParsedDate{}, ErrUnrecognizedDate
+	}
+
+	l, ok := findLocale(locale)
+	if !ok {
+		return ParsedDate{}, ErrUnsupportedLocale
+	}
+	if res, ok := parseInLocale(raw, now, l); ok {
+		return res, nil
+	}
+	return ParsedDate{}, ErrUnrecognizedDate
+}
+
+func parseInLocale(raw string, now time.Time, l *dateLocale) (ParsedDate, bool) {
+	tokens, ok := l.translate(raw)
+	if !ok || len(tokens) == 0 {
+		return ParsedDate{}, false
+	}
+	p := &dateParser{now: now, monthFirst: l.monthFirst}
+	return p.parse(tokens)
+}
+
+type dateParser struct {
+	now        time.Time
+	monthFirst bool
+
+	day     *time.Time
+	exact   *time.Time
+	hasTime bool
+	hour    int
+	minute  int
+}
+
+func (p *dateParser) parse(tokens []string) (ParsedDate, bool) {
+	for i := 0; i < len(tokens); {
+		var n int
+		if exact, day, consumed := p.matchRelative(tokens[i:]); consumed > 0 {
+			if !p.setDay(day) || !p.setExact(exact) {
+				return ParsedDate{}, false
+			}
+			n = consumed
+		} else if hour, minute, consumed := matchClock(tokens[i:]); consumed > 0 {
+			if p.hasTime {
+				return ParsedDate{}, false
+			}
+			p.hasTime, p.hour, p.minute = true, hour, minute
+			n = consumed
+		} else if day, consumed := p.matchDate(tokens[i:]); consumed > 0 {
+			if !p.setDay(&day) {
+				return ParsedDate{}, false
+			}
+			n = consumed
+		} else {
+			return ParsedDate{}, false
+		}
+		i += n
+	}
+
+	if p.exact != nil {
+		if p.day != nil || p.hasTime {
+			return ParsedDate{}, false
+		}
+		return ParsedDate{Time: *p.exact, IncludeTime: true}, true
+	}
+	day := startOfDay(p.now)
+	if p.day != nil {
+		day = *p.day
+	}
+	if p.hasTime {
+		return ParsedDate{Time: time.Date(day.Year(), day.Month(), day.Day(), p.hour, p.minute, 0, 0, day.Location()), IncludeTime: true}, true
+	}
+	return ParsedDate{Time: day}, true
+}
+
+func (p *dateParser) setDay(day *time.Time) bool {
+	if day == nil {
+		return true
+	}
+	if p.day != nil || p.exact != nil {
+		return false
+	}
+	p.day = day
+	return true
+}
+
+func (p *dateParser) setExact(exact *time.Time) bool {
+	if exact == nil {
+		return true
+	}
+	if p.day != nil || p.exact != nil {
+		return false
+	}
+	p.exact = exact
+	return true
+}
+
+// matchRelative matches "now", "in 2 weeks", "2 weeks ago" and "3 days from now". Shifts by minutes and hours
+// return the exact time, other shifts return the day
+func (p *dateParser) matchRelative(tokens []string) (exact, day *time.Time, consumed int) {
+	if tokens[0] == tokenNow {
+		t := startOfMinute(p.now)
+		return &t, nil, 1
+	}
+
+	sign := 1
+	var amount int
+	var unit string
+	switch {
+	case len(tokens) >= 3 && (tokens[0] == tokenIn || tokens[0] == tokenAgo):
+		n, err := strconv.Atoi(tokens[1])
+		if err != nil || !isUnit(tokens[2]) {
+			return nil, nil, 0
+		}
+		if tokens[0] == tokenAgo {
+			sign = -1
+		}
+		amount, unit, consumed = n, tokens[2], 3
+	case len(tokens) >= 3 && (tokens[2] == tokenAgo || tokens[2] == tokenFromNow):
+		n, err := strconv.Atoi(tokens[0])
+		if err != nil || !isUnit(tokens[1]) {
+			return nil, nil, 0
+		}
+		if tokens[2] == tokenAgo {
+			sign = -1
+		}
+		amount, unit, consumed = n, tokens[1], 3
+	default:
+		return nil, nil, 0
+	}
+
+	amount *= sign
+	switch unit {
+	case tokenMinute:
+		t := startOfMinute(p.now).Add(time.Duration(amount) * time.Minute)
+		return &t, nil, consumed
+	case tokenHour:
+		t := startOfMinute(p.now).Add(time.Duration(amount) * time.Hour)
+		return &t, nil, consumed
+	default:
+		t := shiftDay(startOfDay(p.now), unit, amount)
+		return nil, &t, consumed
+	}
+}
+
+// matchClock matches "15:30", "3pm", "3:30 pm", "15h30", "15 oclock", "noon" and "midnight"
+func matchClock(tokens []string) (hour, minute, consumed int) {
+	switch tokens[0] {
+	case tokenNoon:
+		return 12, 0, 1
+	case tokenMidnight:
+		return 0, 0, 1
+	}
+
+	m := clockRe.FindStringSubmatch(tokens[0])
+	if m == nil {
+		return 0, 0, 0
+	}
+	hour, _ = strconv.Atoi(m[1])
+	if m[3] != "" {
+		minute, _ = strconv.Atoi(m[3])
+	}
+	suffix := m[4]
+	consumed = 1
+	if suffix == "" && len(tokens) > 1 {
+		switch tokens[1] {
+		case tokenAm, tokenPm:
+			suffix = tokens[1]
+			consumed = 2
+		case tokenOClock:
+			if m[2] == "" {
+				consumed = 2
+			}
+		}
+	}
+	// a number alone is not a time
+	if m[2] == "" && suffix == "" && consumed == 1 {
+		return 0, 0, 0
+	}
+
+	if (m[2] == ":" && m[3] == "") || minute > 59 {
+		return 0, 0, 0
+	}
+	switch suffix {
+	case tokenAm, tokenPm:
+		if hour < 1 || hour > 12 {
+			return 0, 0, 0
+		}
+		hour %= 12
+		if suffix == tokenPm {
+			hour += 12
+		}
+	default:
+		if hour > 23 {
+			return 0, 0, 0
+		}
+	}
+	return hour, minute, consumed
+}
+
+func (p *dateParser) matchDate(tokens []string) (day time.Time, consumed int) {
+	today := startOfDay(p.now)
+	switch tokens[0] {
+	case tokenToday:
+		return today, 1
+	case tokenTomorrow:
+		return today.AddDate(0, 0, 1), 1
+	case tokenYesterday:
+		return today.AddDate(0, 0, -1), 1
+	case tokenOvermorrow:
+		return today.AddDate(0, 0, 2), 1
+	case tokenOveryesterday:
+		return today.AddDate(0, 0, -2), 1
+	case tokenEndOf, tokenStartOf:
+		return p.matchPeriodBound(tokens)
+	}
+
+	if day, consumed = p.matchModified(tokens); consumed > 0 {
+		return day, consumed
+	}
+	if day, consumed = p.matchNumericDate(tokens[0]); consumed > 0 {
+		return day, consumed
+	}
+	return p.matchMonthDate(tokens)
+}
+
+// matchModified matches weekdays and units with optional modifiers before or after them, like "next friday",
+// "friday" or "semaine prochaine"
+func (p *dateParser) matchModified(tokens []string) (day time.Time, consumed int) {
+	modifier := ""
+	if isModifier(tokens[0]) {
+		if len(tokens) < 2 {
+			return time.Time{}, 0
+		}
+		modifier = tokens[0]
+		tokens = tokens[1:]
+		consumed = 1
+	}
+	target := tokens[0]
+	consumed++
+	if modifier == "" && len(tokens) > 1 && isModifier(tokens[1]) {
+		modifier = tokens[1]
+		consumed++
+	}
+
+	today := startOfDay(p.now)
+	if weekday, ok := weekdays[target]; ok {
+		return weekdayDate(today, weekday, modifier), consumed
+	}
+	if modifier == "" || !isDayUnit(target) {
+		return time.Time{}, 0
+	}
+	switch modifier {
+	case tokenNext:
+		return shiftDay(today, target, 1), consumed
+	case tokenLast:
+		return shiftDay(today, target, -1), consumed
+	default:
+		return today, consumed
+	}
+}
+
+// matchPeriodBound matches "end of month", "start of next week" and so on
+func (p *dateParser) matchPeriodBound(tokens []string) (day time.Time, consumed int) {
+	bound := tokens[0]
+	tokens = tokens[1:]
+	consumed = 1
+	if len(tokens) == 0 {
+		return time.Time{}, 0
+	}
+
+	modifier := ""
+	if isModifier(tokens[0]) {
+		modifier = tokens[0]
+		tokens = tokens[1:]
+		consumed++
+	}
+	if len(tokens) == 0 {
+		return time.Time{}, 0
+	}
+	unit := tokens[0]
+	consumed++
+	if modifier == "" && len(tokens) > 1 && isModifier(tokens[1]) {
+		modifier = tokens[1]
+		consumed++
+	}
+	if unit != tokenWeek && unit != tokenMonth && unit != tokenYear {
+		return time.Time{}, 0
+	}
+
+	date := startOfDay(p.now)
+	switch modifier {
+	case tokenNext:
+		date = shiftDay(date, unit, 1)
+	case tokenLast:
+		date = shiftDay(date, unit, -1)
+	}
+
+	var start time.Time
+	switch unit {
+	case tokenWeek:
+		start = date.AddDate(0, 0, -((int(date.Weekday()) + 6) % 7))
+	case tokenMonth:
+		start = time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, date.Location())
+	case tokenYear:
+		start = time.Date(date.Year(), time.January, 1, 0, 0, 0, 0, date.Location())
+	}
+	if bound == tokenStartOf {
+		return start, consumed
+	}
+	return shiftDay(start, unit, 1).AddDate(0, 0, -1), consumed
+}
+
+// matchNumericDate matches "2024-05-17", "17.05.2024", "05/17/2024" and "17.05"
+func (p *dateParser) matchNumericDate(token string) (day time.Time, consumed int) {
+	if m := isoDateRe.FindStringSubmatch(token); m != nil {
+		year, _ := strconv.Atoi(m[1])
+		month, _ := strconv.Atoi(m[2])
+		d, _ := strconv.Atoi(m[3])
+		return p.dateToken(year, month, d)
+	}
+
+	m := numericDateRe.FindStringSubmatch(token)
+	if m == nil {
+		return time.Time{}, 0
+	}
+	first, _ := strconv.Atoi(m[1])
+	second, _ := strconv.Atoi(m[3])
+	d, month := first, second
+	if p.monthFirst && m[2] != "." {
+		d, month = second, first
+	}
+	year := p.now.Year()
+	if m[4] != "" {
+		year, _ = strconv.Atoi(m[4])
+		if len(m[4]) == 2 {
+			year += 2000
+		}
+	}
+	return p.dateToken(year, month, d)
+}
+
+func (p *dateParser) dateToken(year, month, d int) (day time.Time, consumed int) {
+	day, ok := p.makeDate(year, month, d)
+	if !ok {
+		return time.Time{}, 0
+	}
+	return day, 1
+}
+
+// matchMonthDate matches "may 17", "17 may", "may 17 2024", "17 may 2024" and "may 2024"
+func (p *dateParser) matchMonthDate(tokens []string) (day time.Time, consumed int) {
+	var d, year int
+	var month time.Month
+	switch {
+	case isMonth(tokens[0]):
+		month = months[tokens[0]]
+		consumed = 1
+		if len(tokens) > 1 {
+			if n, ok := parseDayNumber(tokens[1]); ok {
+				d = n
+				consumed++
+			} else if n, ok := parseYear(tokens[1]); ok {
+				day, ok = p.makeDate(n, int(month), 1)
+				if !ok {
+					return time.Time{}, 0
+				}
+				return day, 2
+			}
+		}
+		if d == 0 {
+			return time.Time{}, 0
+		}
+	case len(tokens) > 1 && isMonth(tokens[1]):
+		n, ok := parseDayNumber(tokens[0])
+		if !ok {
+			return time.Time{}, 0
+		}
+		d, month = n, months[tokens[1]]
+		consumed = 2
+	default:
+		return time.Time{}, 0
+	}
+
+	year = p.now.Year()
+	if len(tokens) > consumed {
+		if n, ok := parseYear(tokens[consumed]); ok {
+			year = n
+			consumed++
+		}
+	}
+	day, ok := p.makeDate(year, int(month), d)
+	if !ok {
+		return time.Time{}, 0
+	}
+	return day, consumed
+}
+
+func (p *dateParser) makeDate(year, month, day int) (time.Time, bool) {
+	if month < 1 || month > 12 || day < 1 || day > daysIn(year, time.Month(month)) {
+		return time.Time{}, false
+	}
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, p.now.Location()), true
+}
+
+func weekdayDate(today time.Time, weekday time.Weekday, modifier string) time.Time {
+	diff := (int(weekday) - int(today.Weekday()) + 7) % 7
+	switch modifier {
+	case tokenNext:
+		if diff == 0 {
+			diff = 7
+		}
+	case tokenLast:
+		diff -= 7
+	case tokenThis:
+		monday := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+		return monday.AddDate(0, 0, (int(weekday)+6)%7)
+	}
+	return today.AddDate(0, 0, diff)
+}
+
+// shiftDay shifts the day by the amount of units. Shifts by months and years keep the day within the target month,
+// so a month after January 31 is February 28 or 29
+func shiftDay(day time.Time, unit string, amount int) time.Time {
+	switch unit {
+	case tokenDay:
+		return day.AddDate(0, 0, amount)
+	case tokenWeek:
+		return day.AddDate(0, 0, 7*amount)
+	case tokenMonth, tokenYear:
+		months := amount
+		if unit == tokenYear {
+			months = 12 * amount
+		}
+		first := time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, day.Location()).AddDate(0, months, 0)
+		d := min(day.Day(), daysIn(first.Year(), first.Month()))
+		return first.AddDate(0, 0, d-1)
+	}
+	return day
+}
+
+func parseDayNumber(token string) (int, bool) {
+	if m := ordinalRe.FindStringSubmatch(token); m != nil {
+		token = m[1]
+	}
+	if len(token) > 2 {
+		return 0, false
+	}
+	n, err := strconv.Atoi(token)
+	if err != nil || n < 1 || n > 31 {
+		return 0, false
+	}
+	return n, true
+}
+
+func parseYear(token string) (int, bool) {
+	if len(token) != 4 {
+		return 0, false
+	}
+	n, err := strconv.Atoi(token)
+	return n, err == nil
+}
+
+func isUnit(token string) bool {
+	return token == tokenMinute || token == tokenHour || isDayUnit(token)
+}
+
+func isDayUnit(token string) bool {
+	return token == tokenDay || token == tokenWeek || token == tokenMonth || token == tokenYear
+}
+
+func isModifier(token string) bool {
+	return token == tokenNext || token == tokenLast || token == tokenThis
+}
+
+func isMonth(token string) bool {
+	_, ok := months[token]
+	return ok
+}
+
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+func startOfMinute(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, t.Location())
+}
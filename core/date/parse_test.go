@@ -0,0 +1,135 @@
+package date
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNaturalDate(t *testing.T) {
+	loc := time.FixedZone("Berlin", 2*60*60)
+	// Wednesday
+	now := time.Date(2024, 5, 15, 14, 30, 45, 0, loc)
+	day := func(year int, month time.Month, d int) time.Time {
+		return time.Date(year, month, d, 0, 0, 0, 0, loc)
+	}
+	dayTime := func(year int, month time.Month, d, hour, minute int) time.Time {
+		return time.Date(year, month, d, hour, minute, 0, 0, loc)
+	}
+
+	tests := []struct {
+		raw         string
+		locale      string
+		want        time.Time
+		includeTime bool
+	}{
+		{raw: "today", want: day(2024, 5, 15)},
+		{raw: "Tomorrow", want: day(2024, 5, 16)},
+		{raw: "yesterday", want: day(2024, 5, 14)},
+		{raw: "day after tomorrow", want: day(2024, 5, 17)},
+		{raw: "now", want: dayTime(2024, 5, 15, 14, 30), includeTime: true},
+		{raw: "next friday 3pm", want: dayTime(2024, 5, 17, 15, 0), includeTime: true},
+		{raw: "at 3:30 pm next friday", want: dayTime(2024, 5, 17, 15, 30), includeTime: true},
+		{raw: "friday", want: day(2024, 5, 17)},
+		{raw: "wednesday", want: day(2024, 5, 15)},
+		{raw: "next wednesday", want: day(2024, 5, 22)},
+		{raw: "last wednesday", want: day(2024, 5, 8)},
+		{raw: "last friday", want: day(2024, 5, 10)},
+		{raw: "this monday", want: day(2024, 5, 13)},
+		{raw: "in 2 weeks", want: day(2024, 5, 29)},
+		{raw: "in a month", want: day(2024, 6, 15)},
+		{raw: "3 days ago", want: day(2024, 5, 12)},
+		{raw: "in 2 hours", want: dayTime(2024, 5, 15, 16, 30), includeTime: true},
+		{raw: "10 minutes from now", want: dayTime(2024, 5, 15, 14, 40), includeTime: true},
+		{raw: "next week", want: day(2024, 5, 22)},
+		{raw: "end of month", want: day(2024, 5, 31)},
+		{raw: "end of the week", want: day(2024, 5, 19)},
+		{raw: "start of next month", want: day(2024, 6, 1)},
+		{raw: "end of year", want: day(2024, 12, 31)},
+		{raw: "noon", want: dayTime(2024, 5, 15, 12, 0), includeTime: true},
+		{raw: "15:45", want: dayTime(2024, 5, 15, 15, 45), includeTime: true},
+		{raw: "12am", want: dayTime(2024, 5, 15, 0, 0), includeTime: true},
+		{raw: "may 17th", want: day(2024, 5, 17)},
+		{raw: "17 May 2025", want: day(2025, 5, 17)},
+		{raw: "December 2024", want: day(2024, 12, 1)},
+		{raw: "2024-02-29", want: day(2024, 2, 29)},
+		{raw: "2024-05-17T10:00:00+02:00", want: dayTime(2024, 5, 17, 10, 0), includeTime: true},
+
+		// numeric dates are read according to the locale, dates with dots are always read day first
+		{raw: "05/02/2024", want: day(2024, 5, 2)},
+		{raw: "05/02/2024", locale: "en-US", want: day(2024, 5, 2)},
+		{raw: "05/02/2024", locale: "en_GB", want: day(2024, 2, 5)},
+		{raw: "05.02.2024", want: day(2024, 2, 5)},
+		{raw: "05/02/24", locale: "de", want: day(2024, 2, 5)},
+
+		// month is shifted within the target month
+		{raw: "in 1 month", want: day(2024, 6, 15)},
+
+		{raw: "nächsten Freitag um 15 Uhr", locale: "de", want: dayTime(2024, 5, 17, 15, 0), includeTime: true},
+		{raw: "übermorgen", locale: "de", want: day(2024, 5, 17)},
+		{raw: "in 2 Wochen", locale: "de", want: day(2024, 5, 29)},
+		{raw: "vor 3 Tagen", locale: "de", want: day(2024, 5, 12)},
+		{raw: "Ende des Monats", locale: "de", want: day(2024, 5, 31)},
+		{raw: "17. Mai", locale: "de", want: day(2024, 5, 17)},
+
+		{raw: "vendredi prochain à 15h", locale: "fr", want: dayTime(2024, 5, 17, 15, 0), includeTime: true},
+		{raw: "dans 2 semaines", locale: "fr", want: day(2024, 5, 29)},
+		{raw: "il y a 3 jours", locale: "fr", want: day(2024, 5, 12)},
+		{raw: "fin du mois", locale: "fr", want: day(2024, 5, 31)},
+		{raw: "fin de l'année", locale: "fr", want: day(2024, 12, 31)},
+		{raw: "1er juin", locale: "fr", want: day(2024, 6, 1)},
+
+		{raw: "el próximo viernes a las 15:00", locale: "es", want: dayTime(2024, 5, 17, 15, 0), includeTime: true},
+		{raw: "pasado manana", locale: "es", want: day(2024, 5, 17)},
+		{raw: "la semana que viene", locale: "es", want: day(2024, 5, 22)},
+		{raw: "hace 2 días", locale: "es", want: day(2024, 5, 13)},
+		{raw: "17 de mayo de 2025", locale: "es", want: day(2025, 5, 17)},
+
+		{raw: "в следующую пятницу в 15:00", locale: "ru", want: dayTime(2024, 5, 17, 15, 0), includeTime: true},
+		{raw: "через 2 недели", locale: "ru", want: day(2024, 5, 29)},
+		{raw: "2 дня назад", locale: "ru", want: day(2024, 5, 13)},
+		{raw: "в конце месяца", locale: "ru", want: day(2024, 5, 31)},
+		{raw: "17 мая", locale: "ru", want: day(2024, 5, 17)},
+
+		// localized inputs are recognized without locale
+		{raw: "morgen", want: day(2024, 5, 16)},
+		{raw: "demain", want: day(2024, 5, 16)},
+		{raw: "завтра", want: day(2024, 5, 16)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.raw+" "+tc.locale, func(t *testing.T) {
+			// when
+			got, err := ParseNaturalDate(tc.raw, now, tc.locale)
+
+			// then
+			require.NoError(t, err)
+			assert.True(t, tc.want.Equal(got.Time), "want %s, got %s", tc.want, got.Time)
+			assert.Equal(t, tc.includeTime, got.IncludeTime)
+		})
+	}
+
+	t.Run("month is clamped to its last day", func(t *testing.T) {
+		got, err := ParseNaturalDate("in 1 month", time.Date(2024, 1, 31, 10, 0, 0, 0, loc), "en")
+		require.NoError(t, err)
+		assert.True(t, day(2024, 2, 29).Equal(got.Time))
+	})
+
+	for _, raw := range []string{"", "foobar", "17", "1994", "25:00", "13pm", "2024-02-30", "31/02", "next", "friday friday", "now tomorrow", "invalid-date"} {
+		t.Run("unrecognized "+raw, func(t *testing.T) {
+			_, err := ParseNaturalDate(raw, now, "")
+			assert.ErrorIs(t, err, ErrUnrecognizedDate)
+		})
+	}
+
+	t.Run("unsupported locale", func(t *testing.T) {
+		_, err := ParseNaturalDate("today", now, "ja")
+		assert.ErrorIs(t, err, ErrUnsupportedLocale)
+	})
+
+	t.Run("input in other language is not recognized with locale", func(t *testing.T) {
+		_, err := ParseNaturalDate("morgen", now, "en")
+		assert.ErrorIs(t, err, ErrUnrecognizedDate)
+	})
+}
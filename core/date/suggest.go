@@ -146,6 +146,14 @@ func suggestDateForSearch(now time.Time, raw string) time.Time {
 			}
 			return t
 		},
+		func() time.Time {
+			// localized and more complex inputs like "next friday 3pm" or "fin du mois"
+			parsed, err := ParseNaturalDate(raw, now, "")
+			if err != nil {
+				return time.Time{}
+			}
+			return parsed.Time
+		},
 	}
 
 	var t time.Time
@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"time"
+
 	"github.com/anyproto/any-sync/commonspace/object/acl/aclrecordproto"
 	"github.com/anyproto/any-sync/commonspace/object/acl/list"
 
@@ -18,6 +20,8 @@ type InviteView struct {
 	AclKey          []byte
 	GuestKey        []byte
 	InviteType      InviteType
+	// Limits of the invite from its payload, uses are not counted there
+	Limits InviteLimits
 }
 
 func (i InviteView) IsGuestUserInvite() bool {
@@ -40,6 +44,36 @@ type InviteInfo struct {
 	InviteFileKey string
 	InviteType    InviteType
 	Permissions   list.AclPermissions
+	Limits        InviteLimits
+}
+
+// InviteLimits restricts the time during which the invite could be used and the number of joins by it.
+// Zero values mean no limit
+type InviteLimits struct {
+	ExpiresAt time.Time
+	MaxUses   int
+	Uses      int
+}
+
+func (l InviteLimits) IsExpired(now time.Time) bool {
+	return !l.ExpiresAt.IsZero() && !now.Before(l.ExpiresAt)
+}
+
+func (l InviteLimits) IsUsedUp() bool {
+	return l.MaxUses > 0 && l.Uses >= l.MaxUses
+}
+
+// UsesLeft returns the number of joins left, or -1 if the number of joins is not limited
+func (l InviteLimits) UsesLeft() int {
+	if l.MaxUses <= 0 {
+		return -1
+	}
+	return max(l.MaxUses-l.Uses, 0)
+}
+
+// SameAs reports whether limits were requested with the same parameters, regardless of the uses
+func (l InviteLimits) SameAs(other InviteLimits) bool {
+	return l.ExpiresAt.Equal(other.ExpiresAt) && l.MaxUses == other.MaxUses
 }
 
 type InviteObject interface {
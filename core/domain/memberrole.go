@@ -0,0 +1,36 @@
+package domain
+
+import "slices"
+
+// MemberRoleType narrows write permissions of the participant granted by ACL.
+// Roles are stored in details of the workspace and collection objects, so all members of the space see them
+type MemberRoleType int
+
+const (
+	// MemberRoleDefault means that permissions are defined by ACL only
+	MemberRoleDefault MemberRoleType = iota
+	// MemberRoleCommenter can only write to chats, i.e. add comments and reactions
+	MemberRoleCommenter
+	// MemberRoleCollectionEditor can edit only collections where the participant is listed as an editor and their objects
+	MemberRoleCollectionEditor
+)
+
+type MemberRole struct {
+	Type MemberRoleType
+	// CollectionIds are collections editable by MemberRoleCollectionEditor
+	CollectionIds []string
+	// ObjectIds are objects added to CollectionIds, they are editable by MemberRoleCollectionEditor too
+	ObjectIds []string
+}
+
+// CanEdit reports whether the object with given id could be edited with this role
+func (r MemberRole) CanEdit(objectId string) bool {
+	switch r.Type {
+	case MemberRoleCommenter:
+		return false
+	case MemberRoleCollectionEditor:
+		return slices.Contains(r.CollectionIds, objectId) || slices.Contains(r.ObjectIds, objectId)
+	default:
+		return true
+	}
+}
@@ -3,9 +3,11 @@ package core
 import (
 	"context"
 	"fmt"
+	"image"
 
 	"github.com/gogo/protobuf/types"
 
+	"github.com/anyproto/anytype-heart/core/anytype/config"
 	"github.com/anyproto/anytype-heart/core/block"
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/core/domain/objectorigin"
@@ -13,8 +15,10 @@ import (
 	"github.com/anyproto/anytype-heart/core/files/fileobject"
 	"github.com/anyproto/anytype-heart/core/files/fileoffloader"
 	"github.com/anyproto/anytype-heart/core/files/filespaceusage"
+	"github.com/anyproto/anytype-heart/core/files/imagetransform"
 	"github.com/anyproto/anytype-heart/core/files/reconciler"
 	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/mill"
 )
 
 func (mw *Middleware) FileDownload(cctx context.Context, req *pb.RpcFileDownloadRequest) *pb.RpcFileDownloadResponse {
@@ -158,6 +162,49 @@ func (mw *Middleware) FileUpload(cctx context.Context, req *pb.RpcFileUploadRequ
 	return response(objectId, preloadFileId, detailsProto, pb.RpcFileUploadResponseError_NULL, nil)
 }
 
+func (mw *Middleware) FileImageTransform(cctx context.Context, req *pb.RpcFileImageTransformRequest) *pb.RpcFileImageTransformResponse {
+	transformReq := imagetransform.Request{
+		ObjectId: req.ObjectId,
+		Opts: mill.ImageTransformOpts{
+			Rotate:         int(req.Rotate),
+			FlipHorizontal: req.FlipHorizontal,
+			FlipVertical:   req.FlipVertical,
+			Quality:        int(req.Quality),
+		},
+		Replace: req.Replace,
+	}
+	if req.Crop != nil {
+		transformReq.Opts.Crop = image.Rect(int(req.Crop.X), int(req.Crop.Y), int(req.Crop.X+req.Crop.Width), int(req.Crop.Y+req.Crop.Height))
+	}
+	switch req.Format {
+	case pb.RpcFileImageTransformRequest_Jpeg:
+		transformReq.Opts.Format = mill.JPEG
+	case pb.RpcFileImageTransformRequest_Png:
+		transformReq.Opts.Format = mill.PNG
+	case pb.RpcFileImageTransformRequest_Webp:
+		transformReq.Opts.Format = mill.WEBP
+	}
+
+	objectId, details, err := mustService[imagetransform.Service](mw).Transform(cctx, transformReq)
+	if err != nil {
+		return &pb.RpcFileImageTransformResponse{
+			Error: &pb.RpcFileImageTransformResponseError{
+				Code: mapErrorCode(err,
+					errToCode(imagetransform.ErrNotImage, pb.RpcFileImageTransformResponseError_NOT_IMAGE),
+					errToCode(mill.ErrInvalidCrop, pb.RpcFileImageTransformResponseError_BAD_INPUT),
+					errToCode(mill.ErrInvalidRotation, pb.RpcFileImageTransformResponseError_BAD_INPUT),
+					errToCode(mill.ErrFormatSupportNotEnabled, pb.RpcFileImageTransformResponseError_BAD_INPUT),
+				),
+				Description: getErrorDescription(err),
+			},
+		}
+	}
+	return &pb.RpcFileImageTransformResponse{
+		ObjectId: objectId,
+		Details:  details.ToProto(),
+	}
+}
+
 func (mw *Middleware) FileDiscardPreload(cctx context.Context, req *pb.RpcFileDiscardPreloadRequest) *pb.RpcFileDiscardPreloadResponse {
 	response := func(code pb.RpcFileDiscardPreloadResponseErrorCode, err error) *pb.RpcFileDiscardPreloadResponse {
 		m := &pb.RpcFileDiscardPreloadResponse{Error: &pb.RpcFileDiscardPreloadResponseError{Code: code}}
@@ -297,3 +344,35 @@ func (mw *Middleware) FileCacheCancelDownload(ctx context.Context, req *pb.RpcFi
 	}
 	return &pb.RpcFileCacheCancelDownloadResponse{}
 }
+
+func (mw *Middleware) FileSetAutoOffload(ctx context.Context, req *pb.RpcFileSetAutoOffloadRequest) *pb.RpcFileSetAutoOffloadResponse {
+	err := mustService[fileoffloader.Service](mw).SetAutoOffloadPolicy(config.AutoOffloadPolicy{
+		Enabled:            req.Enabled,
+		MaxLocalCacheBytes: req.MaxLocalCacheBytes,
+		NotOpenedDays:      int(req.NotOpenedDays),
+	})
+	if err != nil {
+		return &pb.RpcFileSetAutoOffloadResponse{
+			Error: &pb.RpcFileSetAutoOffloadResponseError{
+				Code:        mapErrorCode[pb.RpcFileSetAutoOffloadResponseErrorCode](err),
+				Description: getErrorDescription(err),
+			},
+		}
+	}
+	return &pb.RpcFileSetAutoOffloadResponse{}
+}
+
+func (mw *Middleware) FileAutoOffloadStatus(ctx context.Context, req *pb.RpcFileAutoOffloadStatusRequest) *pb.RpcFileAutoOffloadStatusResponse {
+	policy, report := mustService[fileoffloader.Service](mw).AutoOffloadStatus()
+	resp := &pb.RpcFileAutoOffloadStatusResponse{
+		Enabled:            policy.Enabled,
+		MaxLocalCacheBytes: policy.MaxLocalCacheBytes,
+		NotOpenedDays:      int32(policy.NotOpenedDays),
+		FilesOffloaded:     int32(report.FilesOffloaded),
+		BytesOffloaded:     report.BytesOffloaded,
+	}
+	if !report.RunDate.IsZero() {
+		resp.LastRunDate = report.RunDate.Unix()
+	}
+	return resp
+}
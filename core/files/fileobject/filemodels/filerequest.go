@@ -23,6 +23,8 @@ type CreateRequest struct {
 	ObjectOrigin      objectorigin.ObjectOrigin
 	ImageKind         model.ImageKind
 	AdditionalDetails *domain.Details
+	// ObjectTypeKey overrides the type detected from the file content. It applies only when metadata is indexed on create
+	ObjectTypeKey domain.TypeKey
 
 	FileVariants          []*storage.FileInfo
 	AsyncMetadataIndexing bool
@@ -923,6 +923,66 @@ func (_c *MockService_Name_Call) RunAndReturn(run func() string) *MockService_Na
 	return _c
 }
 
+// ReplaceContent provides a mock function with given fields: ctx, objectId, req
+func (_m *MockService) ReplaceContent(ctx context.Context, objectId string, req filemodels.CreateRequest) (*domain.Details, error) {
+	ret := _m.Called(ctx, objectId, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceContent")
+	}
+
+	var r0 *domain.Details
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, filemodels.CreateRequest) (*domain.Details, error)); ok {
+		return rf(ctx, objectId, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, filemodels.CreateRequest) *domain.Details); ok {
+		r0 = rf(ctx, objectId, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Details)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, filemodels.CreateRequest) error); ok {
+		r1 = rf(ctx, objectId, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ReplaceContent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceContent'
+type MockService_ReplaceContent_Call struct {
+	*mock.Call
+}
+
+// ReplaceContent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - objectId string
+//   - req filemodels.CreateRequest
+func (_e *MockService_Expecter) ReplaceContent(ctx interface{}, objectId interface{}, req interface{}) *MockService_ReplaceContent_Call {
+	return &MockService_ReplaceContent_Call{Call: _e.mock.On("ReplaceContent", ctx, objectId, req)}
+}
+
+func (_c *MockService_ReplaceContent_Call) Run(run func(ctx context.Context, objectId string, req filemodels.CreateRequest)) *MockService_ReplaceContent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(filemodels.CreateRequest))
+	})
+	return _c
+}
+
+func (_c *MockService_ReplaceContent_Call) Return(_a0 *domain.Details, _a1 error) *MockService_ReplaceContent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ReplaceContent_Call) RunAndReturn(run func(context.Context, string, filemodels.CreateRequest) (*domain.Details, error)) *MockService_ReplaceContent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Run provides a mock function with given fields: ctx
 func (_m *MockService) Run(ctx context.Context) error {
 	ret := _m.Called(ctx)
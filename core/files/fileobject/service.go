@@ -56,6 +56,7 @@ type Service interface {
 	DeleteFileData(spaceId string, objectId string) error
 	Create(ctx context.Context, spaceId string, req filemodels.CreateRequest) (id string, object *domain.Details, err error)
 	CreateFromImport(fileId domain.FullFileId, origin objectorigin.ObjectOrigin) (string, error)
+	ReplaceContent(ctx context.Context, objectId string, req filemodels.CreateRequest) (*domain.Details, error)
 	GetFileIdFromObject(objectId string) (domain.FullFileId, error)
 
 	DoFileWaitLoad(ctx context.Context, objectId string, proc func(object fileobject.FileObject) error) error
@@ -348,6 +349,74 @@ func (s *service) Create(ctx context.Context, spaceId string, req filemodels.Cre
 	return id, object, nil
 }
 
+// ReplaceContent points the existing file object to the new file content. Object id is kept, so all links and
+// file blocks referencing the object show the new content. The change is synced as a regular object change.
+// The previous content is kept, because object history and other devices still reference it
+func (s *service) ReplaceContent(ctx context.Context, objectId string, req filemodels.CreateRequest) (*domain.Details, error) {
+	if req.FileId == "" {
+		return nil, filemodels.ErrEmptyFileId
+	}
+	if len(req.FileVariants) == 0 {
+		return nil, fmt.Errorf("file variants are not provided")
+	}
+	spaceId, err := s.resolveSpaceIdWithRetry(ctx, objectId)
+	if err != nil {
+		return nil, fmt.Errorf("resolve space id: %w", err)
+	}
+	spc, err := s.spaceService.Get(ctx, spaceId)
+	if err != nil {
+		return nil, fmt.Errorf("get space: %w", err)
+	}
+
+	var details *domain.Details
+	err = spc.Do(objectId, func(sb smartblock.SmartBlock) error {
+		if _, ok := sb.(fileobject.FileObject); !ok {
+			return fmt.Errorf("object is not a fileobject")
+		}
+		st := sb.NewState()
+		st.SetFileInfo(state.FileInfo{
+			FileId:         req.FileId,
+			EncryptionKeys: req.EncryptionKeys,
+		})
+		st.SetDetailAndBundledRelation(bundle.RelationKeyFileId, domain.String(req.FileId.String()))
+		st.SetDetailAndBundledRelation(bundle.RelationKeySyncStatus, domain.Int64(domain.ObjectSyncStatusQueued))
+		st.SetDetailAndBundledRelation(bundle.RelationKeySyncError, domain.Int64(domain.SyncErrorNull))
+		st.SetDetailAndBundledRelation(bundle.RelationKeyFileBackupStatus, domain.Int64(filesyncstatus.Queued))
+
+		fullFileId := domain.FullFileId{SpaceId: spaceId, FileId: req.FileId}
+		fullObjectId := domain.FullID{SpaceID: spaceId, ObjectID: objectId}
+		err := s.indexer.injectMetadataToState(ctx, st, req.FileVariants, fullFileId, fullObjectId)
+		if err != nil {
+			return fmt.Errorf("inject metadata to state: %w", err)
+		}
+		err = sb.Apply(st)
+		if err != nil {
+			return err
+		}
+		details = sb.CombinedDetails()
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("replace file content: %w", err)
+	}
+
+	added, err := s.addImageToSyncQueue(req, objectId, spaceId)
+	if err != nil {
+		return nil, fmt.Errorf("add image variants to sync queue: %w", err)
+	}
+	if !added {
+		err = s.addToSyncQueue(filesync.AddFileRequest{
+			FileObjectId:   objectId,
+			FileId:         domain.FullFileId{SpaceId: spaceId, FileId: req.FileId},
+			UploadedByUser: true,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("add to sync queue: %w", err)
+		}
+	}
+	return details, nil
+}
+
 func (s *service) addImageToSyncQueue(req filemodels.CreateRequest, id string, spaceId string) (bool, error) {
 	var imageVariants []imageVariant
 	for _, variant := range req.FileVariants {
@@ -418,6 +487,9 @@ func (s *service) createInSpace(ctx context.Context, space clientspace.Space, re
 			createState.SetDetailAndBundledRelation(k, v)
 		}
 	}
+	if req.ObjectTypeKey != "" && !req.AsyncMetadataIndexing {
+		createState.SetObjectTypeKey(req.ObjectTypeKey)
+	}
 
 	// Type will be changed after indexing, just use general type File for now
 	id, object, err = s.objectCreator.CreateSmartBlockFromStateInSpaceWithOptions(ctx, space, []domain.TypeKey{bundle.TypeKeyFile}, createState, objectcreator.WithPayload(&payload))
@@ -0,0 +1,229 @@
+package fileoffloader
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/anyproto/anytype-heart/core/anytype/config"
+	"github.com/anyproto/anytype-heart/core/block/cache"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/syncstatus/filesyncstatus"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	coresb "github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const (
+	autoOffloadInterval = time.Hour
+	autoOffloadTimeout  = 30 * time.Minute
+	autoOffloadKickWait = 10 * time.Second
+)
+
+// AutoOffloadReport describes the result of the last automatic offload run
+type AutoOffloadReport struct {
+	RunDate        time.Time
+	FilesOffloaded int
+	BytesOffloaded uint64
+}
+
+func (s *service) SetAutoOffloadPolicy(policy config.AutoOffloadPolicy) error {
+	if policy.NotOpenedDays < 0 {
+		return fmt.Errorf("not opened days should be non-negative")
+	}
+	s.lock.Lock()
+	if s.policy == policy {
+		s.lock.Unlock()
+		return nil
+	}
+	// the policy is applied only once it's persisted, so it isn't lost or silently reverted on restart
+	err := config.WriteJsonConfig(s.config.GetConfigPath(), config.ConfigAutoOffload{AutoOffload: policy})
+	if err != nil {
+		s.lock.Unlock()
+		return fmt.Errorf("write config: %w", err)
+	}
+	s.policy = policy
+	s.lock.Unlock()
+
+	if policy.Enabled {
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), autoOffloadKickWait)
+			defer cancel()
+			if err := s.periodicAutoOffload.Kick(ctx); err != nil {
+				log.Warn("auto offload: kick", zap.Error(err))
+			}
+		}()
+	}
+	return nil
+}
+
+func (s *service) AutoOffloadStatus() (config.AutoOffloadPolicy, AutoOffloadReport) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.policy, s.lastReport
+}
+
+func (s *service) autoOffload(ctx context.Context) error {
+	s.lock.Lock()
+	policy := s.policy
+	s.lock.Unlock()
+	if !policy.Enabled {
+		return nil
+	}
+	_, err := s.RunAutoOffload(ctx)
+	return err
+}
+
+// RunAutoOffload offloads files selected by the current policy regardless of whether the policy is enabled
+func (s *service) RunAutoOffload(ctx context.Context) (AutoOffloadReport, error) {
+	s.lock.Lock()
+	policy := s.policy
+	s.lock.Unlock()
+
+	report := AutoOffloadReport{RunDate: time.Now()}
+	localUsage, err := s.fileStorage.LocalDiskUsage(ctx)
+	if err != nil {
+		return report, fmt.Errorf("get local disk usage: %w", err)
+	}
+	records, err := s.objectStore.QueryCrossSpace(database.Query{
+		Filters: []database.FilterRequest{
+			{
+				RelationKey: bundle.RelationKeyFileId,
+				Condition:   model.BlockContentDataviewFilter_NotEmpty,
+			},
+			{
+				RelationKey: bundle.RelationKeyFileBackupStatus,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       domain.Int64(filesyncstatus.Synced),
+			},
+		},
+	})
+	if err != nil {
+		return report, fmt.Errorf("query synced files: %w", err)
+	}
+	pinnedIds, err := s.pinnedObjectIds(ctx)
+	if err != nil {
+		return report, fmt.Errorf("get pinned objects: %w", err)
+	}
+
+	candidates := autoOffloadCandidates(records, pinnedIds)
+	offloadUntilPolicyMet(ctx, candidates, policy, localUsage, report.RunDate, func(record database.Record) uint64 {
+		spaceId := record.Details.GetString(bundle.RelationKeySpaceId)
+		fileId := record.Details.GetString(bundle.RelationKeyFileId)
+		size, err := s.offloadFileSafe(ctx, spaceId, fileId, record, false)
+		if err != nil {
+			log.Error("auto offload: failed to offload file", zap.String("fileId", fileId), zap.Error(err))
+			return 0
+		}
+		if size > 0 {
+			report.FilesOffloaded++
+			report.BytesOffloaded += size
+		}
+		return size
+	})
+
+	s.lock.Lock()
+	s.lastReport = report
+	s.lock.Unlock()
+	log.Info("auto offload finished", zap.Int("files", report.FilesOffloaded), zap.Uint64("bytes", report.BytesOffloaded))
+	return report, nil
+}
+
+// pinnedObjectIds returns the objects pinned to the sidebar widgets of all spaces
+func (s *service) pinnedObjectIds(ctx context.Context) (map[string]struct{}, error) {
+	records, err := s.objectStore.QueryCrossSpace(database.Query{
+		Filters: []database.FilterRequest{
+			{
+				RelationKey: bundle.RelationKeyResolvedLayout,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       domain.Int64(model.ObjectType_dashboard),
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query widget objects: %w", err)
+	}
+	pinnedIds := map[string]struct{}{}
+	for _, record := range records {
+		id := domain.FullID{
+			SpaceID:  record.Details.GetString(bundle.RelationKeySpaceId),
+			ObjectID: record.Details.GetString(bundle.RelationKeyId),
+		}
+		err = cache.DoContextFullID(s.objectGetter, ctx, id, func(sb smartblock.SmartBlock) error {
+			if sb.Type() != coresb.SmartBlockTypeWidget {
+				return nil
+			}
+			return sb.Iterate(func(b simple.Block) bool {
+				if target := b.Model().GetLink().GetTargetBlockId(); target != "" {
+					pinnedIds[target] = struct{}{}
+				}
+				return true
+			})
+		})
+		if err != nil {
+			log.Warn("auto offload: failed to get widget object", zap.String("objectId", id.ObjectID), zap.Error(err))
+		}
+	}
+	return pinnedIds, nil
+}
+
+// autoOffloadCandidates returns file records that could be offloaded automatically, the least recently opened files go first.
+// Favorite files and files pinned to widgets are never selected
+func autoOffloadCandidates(records []database.Record, pinnedIds map[string]struct{}) []database.Record {
+	candidates := make([]database.Record, 0, len(records))
+	for _, record := range records {
+		if record.Details.GetBool(bundle.RelationKeyIsFavorite) {
+			continue
+		}
+		if _, ok := pinnedIds[record.Details.GetString(bundle.RelationKeyId)]; ok {
+			continue
+		}
+		if filesyncstatus.Status(record.Details.GetInt64(bundle.RelationKeyFileBackupStatus)) != filesyncstatus.Synced {
+			continue
+		}
+		candidates = append(candidates, record)
+	}
+	slices.SortStableFunc(candidates, func(a, b database.Record) int {
+		return lastAccessDate(a.Details).Compare(lastAccessDate(b.Details))
+	})
+	return candidates
+}
+
+// offloadUntilPolicyMet calls offload for candidates until the policy is met. offload returns the number of bytes
+// actually freed, so files that are already offloaded don't count towards the local cache limit
+func offloadUntilPolicyMet(ctx context.Context, candidates []database.Record, policy config.AutoOffloadPolicy, localUsage uint64, now time.Time, offload func(record database.Record) uint64) {
+	var notOpenedSince time.Time
+	if policy.NotOpenedDays > 0 {
+		notOpenedSince = now.AddDate(0, 0, -policy.NotOpenedDays)
+	}
+
+	for _, record := range candidates {
+		if ctx.Err() != nil {
+			return
+		}
+		expired := !notOpenedSince.IsZero() && lastAccessDate(record.Details).Before(notOpenedSince)
+		overLimit := policy.MaxLocalCacheBytes > 0 && localUsage > policy.MaxLocalCacheBytes
+		if !expired && !overLimit {
+			return
+		}
+		localUsage -= min(offload(record), localUsage)
+	}
+}
+
+func lastAccessDate(details *domain.Details) time.Time {
+	var last int64
+	for _, key := range []domain.RelationKey{
+		bundle.RelationKeyLastOpenedDate,
+		bundle.RelationKeyAddedDate,
+		bundle.RelationKeyCreatedDate,
+	} {
+		last = max(last, details.GetInt64(key))
+	}
+	return time.Unix(last, 0)
+}
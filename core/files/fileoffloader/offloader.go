@@ -3,15 +3,20 @@ package fileoffloader
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/anyproto/any-sync/app"
+	"github.com/anyproto/any-sync/app/logger"
 	"github.com/anyproto/any-sync/commonfile/fileblockstore"
 	"github.com/anyproto/any-sync/commonfile/fileservice"
+	"github.com/anyproto/any-sync/util/periodicsync"
 	"github.com/ipfs/go-cid"
 	ipld "github.com/ipfs/go-ipld-format"
 	"go.uber.org/zap"
 
+	"github.com/anyproto/anytype-heart/core/anytype/config"
+	"github.com/anyproto/anytype-heart/core/block/cache"
 	"github.com/anyproto/anytype-heart/core/block/object/idresolver"
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/core/files/filehelper"
@@ -29,13 +34,17 @@ const CName = "core.files.fileoffloader"
 var log = logging.Logger(CName).Desugar()
 
 type Service interface {
-	app.Component
+	app.ComponentRunnable
 
 	FileOffload(ctx context.Context, objectId string, includeNotPinned bool) (totalSize uint64, err error)
 	FileOffloadFullId(ctx context.Context, id domain.FullID, includeNotPinned bool) (totalSize uint64, err error)
 	FilesOffload(ctx context.Context, objectIds []string, includeNotPinned bool) (err error)
 	FileSpaceOffload(ctx context.Context, spaceId string, includeNotPinned bool) (filesOffloaded int, totalSize uint64, err error)
 	FileOffloadRaw(ctx context.Context, id domain.FullFileId) (totalSize uint64, err error)
+
+	SetAutoOffloadPolicy(policy config.AutoOffloadPolicy) error
+	AutoOffloadStatus() (policy config.AutoOffloadPolicy, lastReport AutoOffloadReport)
+	RunAutoOffload(ctx context.Context) (AutoOffloadReport, error)
 }
 
 type service struct {
@@ -44,6 +53,14 @@ type service struct {
 	commonFile      fileservice.FileService
 	fileStorage     filestorage2.FileStorage
 	spaceIdResolver idresolver.Resolver
+	objectGetter    cache.ObjectGetter
+	config          *config.Config
+
+	periodicAutoOffload periodicsync.PeriodicSync
+
+	lock       sync.Mutex
+	policy     config.AutoOffloadPolicy
+	lastReport AutoOffloadReport
 }
 
 func New() Service {
@@ -56,6 +73,10 @@ func (s *service) Init(a *app.App) error {
 	s.dagService = s.commonFile.DAGService()
 	s.fileStorage = app.MustComponent[filestorage2.FileStorage](a)
 	s.spaceIdResolver = app.MustComponent[idresolver.Resolver](a)
+	s.objectGetter = app.MustComponent[cache.ObjectGetter](a)
+	s.config = app.MustComponent[*config.Config](a)
+	s.policy = s.config.AutoOffload
+	s.periodicAutoOffload = periodicsync.NewPeriodicSyncDuration(autoOffloadInterval, autoOffloadTimeout, s.autoOffload, logger.CtxLogger{Logger: log})
 	return nil
 }
 
@@ -63,6 +84,18 @@ func (s *service) Name() string {
 	return CName
 }
 
+func (s *service) Run(ctx context.Context) error {
+	s.periodicAutoOffload.Run()
+	return nil
+}
+
+func (s *service) Close(ctx context.Context) error {
+	if s.periodicAutoOffload != nil {
+		s.periodicAutoOffload.Close()
+	}
+	return nil
+}
+
 func (s *service) FileOffload(ctx context.Context, objectId string, includeNotPinned bool) (totalSize uint64, err error) {
 	spaceId, err := s.spaceIdResolver.ResolveSpaceID(objectId)
 	if err != nil {
@@ -5,18 +5,24 @@ import (
 	"context"
 	"crypto/rand"
 	"io"
+	"os"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/anyproto/any-sync/app"
 	"github.com/anyproto/any-sync/commonfile/fileservice"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/anyproto/anytype-heart/core/anytype/config"
+	"github.com/anyproto/anytype-heart/core/block/cache/mock_cache"
 	"github.com/anyproto/anytype-heart/core/block/object/idresolver/mock_idresolver"
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/core/files/filestorage"
 	"github.com/anyproto/anytype-heart/core/syncstatus/filesyncstatus"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
 	"github.com/anyproto/anytype-heart/pkg/lib/datastore"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
 	"github.com/anyproto/anytype-heart/tests/testutil"
@@ -27,6 +33,7 @@ type fixture struct {
 
 	commonFile  fileservice.FileService
 	objectStore *objectstore.StoreFixture
+	config      *config.Config
 }
 
 func newFixture(t *testing.T) *fixture {
@@ -35,6 +42,8 @@ func newFixture(t *testing.T) *fixture {
 	objectStore := objectstore.NewStoreFixture(t)
 	dataStoreProvider, err := datastore.NewInMemory()
 	spaceIdResolver := mock_idresolver.NewMockResolver(t)
+	objectGetter := mock_cache.NewMockObjectGetterComponent(t)
+	cfg := &config.Config{DisableFileConfig: true, RepoPath: t.TempDir()}
 
 	require.NoError(t, err)
 	offloader := New()
@@ -45,8 +54,10 @@ func newFixture(t *testing.T) *fixture {
 	a.Register(blockStorage)
 	a.Register(commonFileService)
 	a.Register(objectStore)
+	a.Register(cfg)
 	a.Register(offloader)
 	a.Register(testutil.PrepareMock(ctx, a, spaceIdResolver))
+	a.Register(testutil.PrepareMock(ctx, a, objectGetter))
 
 	err = a.Start(ctx)
 	require.NoError(t, err)
@@ -55,6 +66,7 @@ func newFixture(t *testing.T) *fixture {
 		Service:     offloader,
 		commonFile:  commonFileService,
 		objectStore: objectStore,
+		config:      cfg,
 	}
 }
 
@@ -135,6 +147,148 @@ func TestSpaceOffload(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestRunAutoOffload(t *testing.T) {
+	fx := newFixture(t)
+
+	ctx := context.Background()
+	fileNode1, err := fx.commonFile.AddFile(ctx, generateTestFileData(t, 2*1024*1024))
+	require.NoError(t, err)
+
+	fileNode2, err := fx.commonFile.AddFile(ctx, generateTestFileData(t, 2*1024*1024))
+	require.NoError(t, err)
+
+	fileNode3, err := fx.commonFile.AddFile(ctx, generateTestFileData(t, 2*1024*1024))
+	require.NoError(t, err)
+
+	now := time.Now()
+	fx.objectStore.AddObjects(t, "space1", []objectstore.TestObject{
+		{
+			bundle.RelationKeyId:               domain.String("oldFile"),
+			bundle.RelationKeySpaceId:          domain.String("space1"),
+			bundle.RelationKeyFileId:           domain.String(fileNode1.Cid().String()),
+			bundle.RelationKeyFileBackupStatus: domain.Int64(int64(filesyncstatus.Synced)),
+			bundle.RelationKeyLastOpenedDate:   domain.Int64(now.AddDate(0, 0, -40).Unix()),
+		},
+		{
+			bundle.RelationKeyId:               domain.String("oldFavoriteFile"),
+			bundle.RelationKeySpaceId:          domain.String("space1"),
+			bundle.RelationKeyFileId:           domain.String(fileNode2.Cid().String()),
+			bundle.RelationKeyFileBackupStatus: domain.Int64(int64(filesyncstatus.Synced)),
+			bundle.RelationKeyLastOpenedDate:   domain.Int64(now.AddDate(0, 0, -40).Unix()),
+			bundle.RelationKeyIsFavorite:       domain.Bool(true),
+		},
+		{
+			bundle.RelationKeyId:               domain.String("recentFile"),
+			bundle.RelationKeySpaceId:          domain.String("space1"),
+			bundle.RelationKeyFileId:           domain.String(fileNode3.Cid().String()),
+			bundle.RelationKeyFileBackupStatus: domain.Int64(int64(filesyncstatus.Synced)),
+			bundle.RelationKeyLastOpenedDate:   domain.Int64(now.AddDate(0, 0, -1).Unix()),
+		},
+	})
+
+	err = fx.SetAutoOffloadPolicy(config.AutoOffloadPolicy{NotOpenedDays: 30})
+	require.NoError(t, err)
+
+	report, err := fx.RunAutoOffload(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.FilesOffloaded)
+	assert.NotZero(t, report.BytesOffloaded)
+
+	_, lastReport := fx.AutoOffloadStatus()
+	assert.Equal(t, report, lastReport)
+
+	_, err = fx.commonFile.GetFile(ctx, fileNode1.Cid())
+	require.Error(t, err)
+
+	_, err = fx.commonFile.GetFile(ctx, fileNode2.Cid())
+	require.NoError(t, err)
+
+	_, err = fx.commonFile.GetFile(ctx, fileNode3.Cid())
+	require.NoError(t, err)
+}
+
+func TestOffloadUntilPolicyMet(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	newRecord := func(id string, daysAgo int, size int64, status filesyncstatus.Status) database.Record {
+		return database.Record{Details: domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyId:               domain.String(id),
+			bundle.RelationKeyLastOpenedDate:   domain.Int64(now.AddDate(0, 0, -daysAgo).Unix()),
+			bundle.RelationKeySizeInBytes:      domain.Int64(size),
+			bundle.RelationKeyFileBackupStatus: domain.Int64(int64(status)),
+		})}
+	}
+	records := []database.Record{
+		newRecord("recent", 1, 100, filesyncstatus.Synced),
+		newRecord("old", 20, 100, filesyncstatus.Synced),
+		newRecord("notSynced", 50, 100, filesyncstatus.Syncing),
+		newRecord("oldest", 40, 100, filesyncstatus.Synced),
+	}
+	run := func(pinnedIds map[string]struct{}, policy config.AutoOffloadPolicy, localUsage uint64, notLocal ...string) []string {
+		var offloaded []string
+		offloadUntilPolicyMet(ctx, autoOffloadCandidates(records, pinnedIds), policy, localUsage, now, func(record database.Record) uint64 {
+			id := record.Details.GetString(bundle.RelationKeyId)
+			offloaded = append(offloaded, id)
+			if slices.Contains(notLocal, id) {
+				return 0
+			}
+			return uint64(record.Details.GetInt64(bundle.RelationKeySizeInBytes))
+		})
+		return offloaded
+	}
+
+	t.Run("nothing to offload with empty policy", func(t *testing.T) {
+		assert.Empty(t, run(nil, config.AutoOffloadPolicy{}, 1000))
+	})
+
+	t.Run("offload by last opened date", func(t *testing.T) {
+		assert.Equal(t, []string{"oldest", "old"}, run(nil, config.AutoOffloadPolicy{NotOpenedDays: 10}, 1000))
+	})
+
+	t.Run("offload least recently opened files until cache fits the limit", func(t *testing.T) {
+		assert.Equal(t, []string{"oldest", "old"}, run(nil, config.AutoOffloadPolicy{MaxLocalCacheBytes: 250}, 400))
+	})
+
+	t.Run("files that are not stored locally don't free the cache", func(t *testing.T) {
+		assert.Equal(t, []string{"oldest", "old", "recent"}, run(nil, config.AutoOffloadPolicy{MaxLocalCacheBytes: 250}, 400, "oldest"))
+	})
+
+	t.Run("cache is within the limit", func(t *testing.T) {
+		assert.Empty(t, run(nil, config.AutoOffloadPolicy{MaxLocalCacheBytes: 500}, 400))
+	})
+
+	t.Run("pinned files are never offloaded", func(t *testing.T) {
+		pinnedIds := map[string]struct{}{"oldest": {}}
+		assert.Equal(t, []string{"old"}, run(pinnedIds, config.AutoOffloadPolicy{NotOpenedDays: 10}, 1000))
+	})
+}
+
+func TestSetAutoOffloadPolicy(t *testing.T) {
+	t.Run("policy is saved", func(t *testing.T) {
+		fx := newFixture(t)
+		policy := config.AutoOffloadPolicy{NotOpenedDays: 30}
+
+		require.NoError(t, fx.SetAutoOffloadPolicy(policy))
+
+		current, _ := fx.AutoOffloadStatus()
+		assert.Equal(t, policy, current)
+		var saved config.ConfigAutoOffload
+		require.NoError(t, config.GetFileConfig(fx.config.GetConfigPath(), &saved))
+		assert.Equal(t, policy, saved.AutoOffload)
+	})
+
+	t.Run("policy isn't applied if it can't be saved", func(t *testing.T) {
+		fx := newFixture(t)
+		require.NoError(t, os.RemoveAll(fx.config.RepoPath))
+
+		err := fx.SetAutoOffloadPolicy(config.AutoOffloadPolicy{NotOpenedDays: 30})
+		require.Error(t, err)
+
+		current, _ := fx.AutoOffloadStatus()
+		assert.Equal(t, config.AutoOffloadPolicy{}, current)
+	})
+}
+
 func generateTestFileData(t *testing.T, size int) io.Reader {
 	buf := make([]byte, size)
 	_, err := rand.Read(buf)
@@ -690,6 +690,11 @@ func (q *Queue[T]) Release(id string) error {
 	}
 }
 
+// Peek returns a stored item without locking it, so the item could be changed concurrently. Use it only for reading
+func (q *Queue[T]) Peek(objectId string) (T, error) {
+	return q.store.get(q.ctx, objectId)
+}
+
 func (q *Queue[T]) List() ([]T, error) {
 	return q.store.listAll(q.ctx)
 }
@@ -106,6 +106,30 @@ func TestGetById(t *testing.T) {
 	})
 }
 
+func TestPeek(t *testing.T) {
+	q := newTestQueue(t)
+	defer q.close()
+
+	_, err := q.Peek("obj1")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	want := fileInfo{
+		ObjectId: "obj1",
+		State:    fileStatePendingDeletion,
+	}
+	insertToQueue(t, q, want)
+
+	// Lock the object, peeking must not wait for it
+	_, err = q.GetById("obj1")
+	require.NoError(t, err)
+
+	got, err := q.Peek("obj1")
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	require.NoError(t, q.Release("obj1"))
+}
+
 func TestQueue(t *testing.T) {
 	q := newTestQueue(t)
 	defer q.close()
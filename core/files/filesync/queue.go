@@ -31,6 +31,12 @@ func (s *fileSync) process(id string, proc func(exists bool, info FileInfo) (Fil
 	return release(id, update, next)
 }
 
+// QueueItem returns the state of the file object in the sync queue. It returns filequeue.ErrNotFound if the file object
+// was never queued
+func (s *fileSync) QueueItem(objectId string) (FileInfo, error) {
+	return s.queue.Peek(objectId)
+}
+
 func filterByFileId(fileId string) query.Key {
 	return query.Key{
 		Path:   []string{"fileId"},
@@ -60,6 +60,18 @@ func (s *fileSync) AddFile(req AddFileRequest) error {
 	})
 }
 
+// RetryUpload reschedules a pending or limited upload of the file object to start right away
+func (s *fileSync) RetryUpload(objectId string) error {
+	return s.process(objectId, func(exists bool, info FileInfo) (FileInfo, bool, error) {
+		if !exists || (info.State != FileStatePendingUpload && info.State != FileStateLimited) {
+			return info, false, nil
+		}
+		info.State = FileStatePendingUpload
+		info.ScheduledAt = time.Now()
+		return info, true, nil
+	})
+}
+
 func (s *fileSync) SendImportEvents() {
 	s.importEventsMutex.Lock()
 	defer s.importEventsMutex.Unlock()
@@ -209,6 +209,42 @@ func TestFileSync_AddFile(t *testing.T) {
 	})
 }
 
+func TestFileSync_RetryUpload(t *testing.T) {
+	t.Run("not queued file", func(t *testing.T) {
+		fx := newFixture(t, 1024)
+		defer fx.Finish(t)
+
+		require.NoError(t, fx.RetryUpload("objectId1"))
+
+		_, err := fx.QueueItem("objectId1")
+		require.ErrorIs(t, err, filequeue.ErrNotFound)
+	})
+
+	t.Run("uploaded file is not rescheduled", func(t *testing.T) {
+		fx := newFixture(t, 1024)
+		defer fx.Finish(t)
+
+		want := FileInfo{
+			FileId:      "fileId1",
+			SpaceId:     "space1",
+			ObjectId:    "objectId1",
+			State:       FileStateDone,
+			ScheduledAt: time.Unix(1700000000, 0),
+		}
+		err := fx.queue.Upsert(want.ObjectId, func(_ bool, _ FileInfo) FileInfo {
+			return want
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, fx.RetryUpload("objectId1"))
+
+		got, err := fx.QueueItem("objectId1")
+		require.NoError(t, err)
+		assert.Equal(t, FileStateDone, got.State)
+		assert.True(t, want.ScheduledAt.Equal(got.ScheduledAt))
+	})
+}
+
 func (fx *fixture) assertFileUploadedToRemoteNode(t *testing.T, fileNode ipld.Node, wantSize int) []cid.Cid {
 	var gotSize int
 	var wantCids []cid.Cid
@@ -626,6 +626,54 @@ func (_c *MockUploader_SetName_Call) RunAndReturn(run func(string) fileuploader.
 	return _c
 }
 
+// SetObjectTypeKey provides a mock function with given fields: typeKey
+func (_m *MockUploader) SetObjectTypeKey(typeKey domain.TypeKey) fileuploader.Uploader {
+	ret := _m.Called(typeKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetObjectTypeKey")
+	}
+
+	var r0 fileuploader.Uploader
+	if rf, ok := ret.Get(0).(func(domain.TypeKey) fileuploader.Uploader); ok {
+		r0 = rf(typeKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(fileuploader.Uploader)
+		}
+	}
+
+	return r0
+}
+
+// MockUploader_SetObjectTypeKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetObjectTypeKey'
+type MockUploader_SetObjectTypeKey_Call struct {
+	*mock.Call
+}
+
+// SetObjectTypeKey is a helper method to define mock.On call
+//   - typeKey domain.TypeKey
+func (_e *MockUploader_Expecter) SetObjectTypeKey(typeKey interface{}) *MockUploader_SetObjectTypeKey_Call {
+	return &MockUploader_SetObjectTypeKey_Call{Call: _e.mock.On("SetObjectTypeKey", typeKey)}
+}
+
+func (_c *MockUploader_SetObjectTypeKey_Call) Run(run func(typeKey domain.TypeKey)) *MockUploader_SetObjectTypeKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.TypeKey))
+	})
+	return _c
+}
+
+func (_c *MockUploader_SetObjectTypeKey_Call) Return(_a0 fileuploader.Uploader) *MockUploader_SetObjectTypeKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUploader_SetObjectTypeKey_Call) RunAndReturn(run func(domain.TypeKey) fileuploader.Uploader) *MockUploader_SetObjectTypeKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetPreloadId provides a mock function with given fields: preloadId
 func (_m *MockUploader) SetPreloadId(preloadId string) fileuploader.Uploader {
 	ret := _m.Called(preloadId)
@@ -674,6 +722,54 @@ func (_c *MockUploader_SetPreloadId_Call) RunAndReturn(run func(string) fileuplo
 	return _c
 }
 
+// SetReplaceObjectId provides a mock function with given fields: objectId
+func (_m *MockUploader) SetReplaceObjectId(objectId string) fileuploader.Uploader {
+	ret := _m.Called(objectId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetReplaceObjectId")
+	}
+
+	var r0 fileuploader.Uploader
+	if rf, ok := ret.Get(0).(func(string) fileuploader.Uploader); ok {
+		r0 = rf(objectId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(fileuploader.Uploader)
+		}
+	}
+
+	return r0
+}
+
+// MockUploader_SetReplaceObjectId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetReplaceObjectId'
+type MockUploader_SetReplaceObjectId_Call struct {
+	*mock.Call
+}
+
+// SetReplaceObjectId is a helper method to define mock.On call
+//   - objectId string
+func (_e *MockUploader_Expecter) SetReplaceObjectId(objectId interface{}) *MockUploader_SetReplaceObjectId_Call {
+	return &MockUploader_SetReplaceObjectId_Call{Call: _e.mock.On("SetReplaceObjectId", objectId)}
+}
+
+func (_c *MockUploader_SetReplaceObjectId_Call) Run(run func(objectId string)) *MockUploader_SetReplaceObjectId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockUploader_SetReplaceObjectId_Call) Return(_a0 fileuploader.Uploader) *MockUploader_SetReplaceObjectId_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUploader_SetReplaceObjectId_Call) RunAndReturn(run func(string) fileuploader.Uploader) *MockUploader_SetReplaceObjectId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetStyle provides a mock function with given fields: tp
 func (_m *MockUploader) SetStyle(tp model.BlockContentFileStyle) fileuploader.Uploader {
 	ret := _m.Called(tp)
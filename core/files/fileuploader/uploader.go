@@ -224,6 +224,10 @@ type Uploader interface {
 	SetCustomEncryptionKeys(keys map[string]string) Uploader
 	SetImageKind(imageKind model.ImageKind) Uploader
 	SetPreloadId(preloadId string) Uploader
+	// SetReplaceObjectId makes uploader replace the content of the existing file object instead of creating a new one
+	SetReplaceObjectId(objectId string) Uploader
+	// SetObjectTypeKey makes uploader create the file object with the given type instead of the type detected from the content
+	SetObjectTypeKey(typeKey domain.TypeKey) Uploader
 
 	AddOptions(options ...files.AddOption) Uploader
 	AsyncUpdates(smartBlockId string) Uploader
@@ -270,6 +274,7 @@ func (ur UploadResult) ToBlock() file.Block {
 type FileObjectService interface {
 	GetObjectDetailsByFileId(fileId domain.FullFileId) (string, *domain.Details, error)
 	Create(ctx context.Context, spaceId string, req filemodels.CreateRequest) (id string, object *domain.Details, err error)
+	ReplaceContent(ctx context.Context, objectId string, req filemodels.CreateRequest) (*domain.Details, error)
 }
 
 type uploader struct {
@@ -297,6 +302,8 @@ type uploader struct {
 	additionalDetails    *domain.Details
 	customEncryptionKeys map[string]string
 	preloadId            string
+	replaceObjectId      string
+	objectTypeKey        domain.TypeKey
 
 	serviceCtx context.Context // used to cancel async operations
 }
@@ -398,6 +405,16 @@ func (u *uploader) SetImageKind(imageKind model.ImageKind) Uploader {
 	return u
 }
 
+func (u *uploader) SetReplaceObjectId(objectId string) Uploader {
+	u.replaceObjectId = objectId
+	return u
+}
+
+func (u *uploader) SetObjectTypeKey(typeKey domain.TypeKey) Uploader {
+	u.objectTypeKey = typeKey
+	return u
+}
+
 func (u *uploader) AddOptions(options ...files.AddOption) Uploader {
 	u.opts = append(u.opts, options...)
 	return u
@@ -726,6 +743,18 @@ func (u *uploader) Preload(ctx context.Context) (preloadId string, err error) {
 }
 
 func (u *uploader) getOrCreateFileObject(ctx context.Context, addResult *files.AddResult) (string, *domain.Details, error) {
+	if u.replaceObjectId != "" {
+		details, err := u.fileObjectService.ReplaceContent(ctx, u.replaceObjectId, filemodels.CreateRequest{
+			FileId:         addResult.FileId,
+			EncryptionKeys: addResult.EncryptionKeys.EncryptionKeys,
+			ObjectOrigin:   u.origin,
+			FileVariants:   addResult.Variants,
+		})
+		if err != nil {
+			return "", nil, fmt.Errorf("replace file object content: %w", err)
+		}
+		return u.replaceObjectId, details, nil
+	}
 	if addResult.IsExisting {
 		id, details, err := u.fileObjectService.GetObjectDetailsByFileId(domain.FullFileId{
 			SpaceId: u.spaceId,
@@ -748,6 +777,7 @@ func (u *uploader) getOrCreateFileObject(ctx context.Context, addResult *files.A
 		ObjectOrigin:      u.origin,
 		ImageKind:         u.imageKind,
 		AdditionalDetails: u.additionalDetails,
+		ObjectTypeKey:     u.objectTypeKey,
 		FileVariants:      addResult.Variants,
 	})
 	if err != nil {
@@ -0,0 +1,120 @@
+package imagetransform
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/anyproto/any-sync/app"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/domain/objectorigin"
+	"github.com/anyproto/anytype-heart/core/files/fileobject"
+	"github.com/anyproto/anytype-heart/core/files/filestorage/rpcstore"
+	"github.com/anyproto/anytype-heart/core/files/fileuploader"
+	"github.com/anyproto/anytype-heart/pkg/lib/mill"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const CName = "core.files.imagetransform"
+
+var ErrNotImage = errors.New("file is not an image")
+
+type Request struct {
+	ObjectId string
+	Opts     mill.ImageTransformOpts
+	// Replace the content of the source file object instead of creating a new file object
+	Replace bool
+}
+
+type Service interface {
+	app.Component
+
+	// Transform applies image transformations to the original of the image file object and uploads the result.
+	// It returns the id of the file object with the result, which is the source object id when Replace is set
+	Transform(ctx context.Context, req Request) (objectId string, details *domain.Details, err error)
+}
+
+type service struct {
+	fileObjectService fileobject.Service
+	fileUploader      fileuploader.Service
+}
+
+func New() Service {
+	return &service{}
+}
+
+func (s *service) Init(a *app.App) error {
+	s.fileObjectService = app.MustComponent[fileobject.Service](a)
+	s.fileUploader = app.MustComponent[fileuploader.Service](a)
+	return nil
+}
+
+func (s *service) Name() string {
+	return CName
+}
+
+func (s *service) Transform(ctx context.Context, req Request) (string, *domain.Details, error) {
+	img, err := s.fileObjectService.GetImageData(ctx, req.ObjectId)
+	if err != nil {
+		return "", nil, fmt.Errorf("get image data: %w", err)
+	}
+	original, err := img.GetOriginalFile()
+	if err != nil {
+		return "", nil, fmt.Errorf("get original file: %w", err)
+	}
+	if !mill.IsImage(original.MimeType()) {
+		return "", nil, ErrNotImage
+	}
+
+	reader, err := original.Reader(rpcstore.ContextWithWaitAvailable(ctx))
+	if err != nil {
+		return "", nil, fmt.Errorf("get file reader: %w", err)
+	}
+	res, err := mill.TransformImage(reader, req.Opts)
+	if err != nil {
+		return "", nil, fmt.Errorf("transform image: %w", err)
+	}
+	defer res.File.Close()
+	data, err := io.ReadAll(res.File)
+	if err != nil {
+		return "", nil, fmt.Errorf("read transformed image: %w", err)
+	}
+
+	format, _ := res.Meta["format"].(string)
+	uploader := s.fileUploader.NewUploader(img.SpaceId(), objectorigin.None()).
+		SetBytes(data).
+		SetName(fileNameWithFormat(original.Name(), mill.Format(format))).
+		SetType(model.BlockContentFile_Image)
+	if req.Replace {
+		uploader.SetReplaceObjectId(req.ObjectId)
+	}
+	result := uploader.Upload(ctx)
+	if result.Err != nil {
+		return "", nil, fmt.Errorf("upload transformed image: %w", result.Err)
+	}
+	return result.FileObjectId, result.FileObjectDetails, nil
+}
+
+func fileNameWithFormat(name string, format mill.Format) string {
+	if name == "" {
+		name = "image"
+	}
+	var ext string
+	switch format {
+	case mill.JPEG:
+		ext = ".jpg"
+	case "":
+		return name
+	default:
+		ext = "." + string(format)
+	}
+	currentExt := filepath.Ext(name)
+	if strings.EqualFold(currentExt, ext) || (format == mill.JPEG && strings.EqualFold(currentExt, ".jpeg")) {
+		return name
+	}
+	return strings.TrimSuffix(name, currentExt) + ext
+}
@@ -0,0 +1,119 @@
+package imagetransform
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/domain/objectorigin"
+	"github.com/anyproto/anytype-heart/core/files/fileobject/mock_fileobject"
+	"github.com/anyproto/anytype-heart/core/files/fileuploader"
+	"github.com/anyproto/anytype-heart/core/files/fileuploader/mock_fileuploader"
+	"github.com/anyproto/anytype-heart/core/files/mock_files"
+	"github.com/anyproto/anytype-heart/pkg/lib/mill"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+type fixture struct {
+	*service
+	fileObjectService *mock_fileobject.MockService
+	fileUploader      *mock_fileuploader.MockService
+}
+
+func newFixture(t *testing.T) *fixture {
+	fileObjectService := mock_fileobject.NewMockService(t)
+	fileUploader := mock_fileuploader.NewMockService(t)
+	return &fixture{
+		service: &service{
+			fileObjectService: fileObjectService,
+			fileUploader:      fileUploader,
+		},
+		fileObjectService: fileObjectService,
+		fileUploader:      fileUploader,
+	}
+}
+
+func (fx *fixture) expectOriginal(t *testing.T, mimeType string, data []byte) {
+	file := mock_files.NewMockFile(t)
+	file.EXPECT().MimeType().Return(mimeType)
+	if mill.IsImage(mimeType) {
+		file.EXPECT().Name().Return("photo.png").Maybe()
+		file.EXPECT().Reader(mock.Anything).Return(bytes.NewReader(data), nil)
+	}
+	img := mock_files.NewMockImage(t)
+	img.EXPECT().GetOriginalFile().Return(file, nil)
+	img.EXPECT().SpaceId().Return("space1").Maybe()
+	fx.fileObjectService.EXPECT().GetImageData(mock.Anything, "objectId").Return(img, nil)
+}
+
+func (fx *fixture) expectUpload(t *testing.T, replace bool, resultId string) *[]byte {
+	var uploaded []byte
+	uploader := mock_fileuploader.NewMockUploader(t)
+	uploader.EXPECT().SetBytes(mock.Anything).RunAndReturn(func(b []byte) fileuploader.Uploader {
+		uploaded = b
+		return uploader
+	})
+	uploader.EXPECT().SetName("photo.jpg").Return(uploader)
+	uploader.EXPECT().SetType(model.BlockContentFile_Image).Return(uploader)
+	if replace {
+		uploader.EXPECT().SetReplaceObjectId("objectId").Return(uploader)
+	}
+	uploader.EXPECT().Upload(mock.Anything).Return(fileuploader.UploadResult{FileObjectId: resultId})
+	fx.fileUploader.EXPECT().NewUploader("space1", objectorigin.None()).Return(uploader)
+	return &uploaded
+}
+
+func testPNG(t *testing.T, width, height int) []byte {
+	buf := &bytes.Buffer{}
+	require.NoError(t, png.Encode(buf, image.NewRGBA(image.Rect(0, 0, width, height))))
+	return buf.Bytes()
+}
+
+func TestTransform(t *testing.T) {
+	ctx := context.Background()
+	opts := mill.ImageTransformOpts{
+		Crop:   image.Rect(0, 0, 20, 10),
+		Rotate: 90,
+		Format: mill.JPEG,
+	}
+
+	t.Run("new file object", func(t *testing.T) {
+		fx := newFixture(t)
+		fx.expectOriginal(t, "image/png", testPNG(t, 40, 30))
+		uploaded := fx.expectUpload(t, false, "newObjectId")
+
+		objectId, _, err := fx.Transform(ctx, Request{ObjectId: "objectId", Opts: opts})
+		require.NoError(t, err)
+		assert.Equal(t, "newObjectId", objectId)
+
+		cfg, format, err := image.DecodeConfig(bytes.NewReader(*uploaded))
+		require.NoError(t, err)
+		assert.Equal(t, "jpeg", format)
+		assert.Equal(t, 10, cfg.Width)
+		assert.Equal(t, 20, cfg.Height)
+	})
+
+	t.Run("replace content", func(t *testing.T) {
+		fx := newFixture(t)
+		fx.expectOriginal(t, "image/png", testPNG(t, 40, 30))
+		fx.expectUpload(t, true, "objectId")
+
+		objectId, _, err := fx.Transform(ctx, Request{ObjectId: "objectId", Opts: opts, Replace: true})
+		require.NoError(t, err)
+		assert.Equal(t, "objectId", objectId)
+	})
+
+	t.Run("not an image", func(t *testing.T) {
+		fx := newFixture(t)
+		fx.expectOriginal(t, "application/pdf", nil)
+
+		_, _, err := fx.Transform(ctx, Request{ObjectId: "objectId", Opts: opts})
+		require.ErrorIs(t, err, ErrNotImage)
+	})
+}
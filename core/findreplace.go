@@ -0,0 +1,71 @@
+package core
+
+import (
+	"context"
+
+	"github.com/anyproto/anytype-heart/core/block/findreplace"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+func (mw *Middleware) ObjectFindReplacePreview(cctx context.Context, req *pb.RpcObjectFindReplacePreviewRequest) *pb.RpcObjectFindReplacePreviewResponse {
+	objects, err := mustService[findreplace.Service](mw).Preview(cctx, findReplaceRequest(
+		req.SpaceId, req.ScopeId, req.Query, req.CaseSensitive, req.WholeWord, req.IncludeRelations,
+	), int(req.Limit))
+	code := mapErrorCode(err,
+		errToCode(findreplace.ErrEmptyQuery, pb.RpcObjectFindReplacePreviewResponseError_BAD_INPUT),
+		errToCode(findreplace.ErrInvalidScope, pb.RpcObjectFindReplacePreviewResponseError_BAD_INPUT),
+	)
+
+	resp := &pb.RpcObjectFindReplacePreviewResponse{
+		Error: &pb.RpcObjectFindReplacePreviewResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+	for _, object := range objects {
+		matches := make([]*pb.RpcObjectFindReplacePreviewResponseMatch, 0, len(object.Matches))
+		for _, match := range object.Matches {
+			matches = append(matches, &pb.RpcObjectFindReplacePreviewResponseMatch{
+				BlockId:     match.BlockId,
+				RelationKey: match.RelationKey.String(),
+				Text:        match.Text,
+				Ranges:      match.Ranges,
+			})
+		}
+		resp.Objects = append(resp.Objects, &pb.RpcObjectFindReplacePreviewResponseObjectMatches{
+			ObjectId: object.ObjectId,
+			Name:     object.Name,
+			Matches:  matches,
+		})
+	}
+	return resp
+}
+
+func (mw *Middleware) ObjectFindReplaceApply(cctx context.Context, req *pb.RpcObjectFindReplaceApplyRequest) *pb.RpcObjectFindReplaceApplyResponse {
+	res, err := mustService[findreplace.Service](mw).Replace(cctx, findReplaceRequest(
+		req.SpaceId, req.ScopeId, req.Query, req.CaseSensitive, req.WholeWord, req.IncludeRelations,
+	), req.Replacement, req.ObjectIds)
+	code := mapErrorCode(err,
+		errToCode(findreplace.ErrEmptyQuery, pb.RpcObjectFindReplaceApplyResponseError_BAD_INPUT),
+		errToCode(findreplace.ErrInvalidScope, pb.RpcObjectFindReplaceApplyResponseError_BAD_INPUT),
+	)
+	return &pb.RpcObjectFindReplaceApplyResponse{
+		ObjectsChanged: int32(res.ObjectsChanged),
+		Replacements:   int32(res.Replacements),
+		Error: &pb.RpcObjectFindReplaceApplyResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
+
+func findReplaceRequest(spaceId, scopeId, query string, caseSensitive, wholeWord, includeRelations bool) findreplace.Request {
+	return findreplace.Request{
+		SpaceId:          spaceId,
+		ScopeId:          scopeId,
+		Query:            query,
+		CaseSensitive:    caseSensitive,
+		WholeWord:        wholeWord,
+		IncludeRelations: includeRelations,
+	}
+}
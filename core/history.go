@@ -144,3 +144,63 @@ func (mw *Middleware) HistoryDiffVersions(cctx context.Context, req *pb.RpcHisto
 	}
 	return response(versionDiff, objectView, nil)
 }
+
+func (mw *Middleware) HistoryGetBlockVersions(cctx context.Context, req *pb.RpcHistoryGetBlockVersionsRequest) *pb.RpcHistoryGetBlockVersionsResponse {
+	response := func(vers []*pb.RpcHistoryBlockVersion, err error) (res *pb.RpcHistoryGetBlockVersionsResponse) {
+		res = &pb.RpcHistoryGetBlockVersionsResponse{
+			Error: &pb.RpcHistoryGetBlockVersionsResponseError{
+				Code: pb.RpcHistoryGetBlockVersionsResponseError_NULL,
+			},
+		}
+		if err != nil {
+			res.Error.Code = pb.RpcHistoryGetBlockVersionsResponseError_UNKNOWN_ERROR
+			res.Error.Description = getErrorDescription(err)
+			return
+		}
+		res.Versions = vers
+		return res
+	}
+	if req.BlockId == "" {
+		return &pb.RpcHistoryGetBlockVersionsResponse{
+			Error: &pb.RpcHistoryGetBlockVersionsResponseError{
+				Code:        pb.RpcHistoryGetBlockVersionsResponseError_BAD_INPUT,
+				Description: "blockId is empty",
+			},
+		}
+	}
+	hs := mw.applicationService.GetApp().MustComponent(history.CName).(history.History)
+	res := mw.applicationService.GetApp().MustComponent(idresolver.CName).(idresolver.Resolver)
+	spaceID, err := res.ResolveSpaceID(req.ObjectId)
+	if err != nil {
+		return response(nil, fmt.Errorf("resolve spaceID: %w", err))
+	}
+	return response(hs.BlockVersions(domain.FullID{
+		SpaceID:  spaceID,
+		ObjectID: req.ObjectId,
+	}, req.BlockId, int(req.Limit)))
+}
+
+func (mw *Middleware) HistoryRestoreBlocks(cctx context.Context, req *pb.RpcHistoryRestoreBlocksRequest) *pb.RpcHistoryRestoreBlocksResponse {
+	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		hs := mw.applicationService.GetApp().MustComponent(history.CName).(history.History)
+		res := mw.applicationService.GetApp().MustComponent(idresolver.CName).(idresolver.Resolver)
+		spaceID, err := res.ResolveSpaceID(req.ObjectId)
+		if err != nil {
+			return fmt.Errorf("resolve spaceID: %w", err)
+		}
+		return hs.RestoreBlocks(domain.FullID{
+			SpaceID:  spaceID,
+			ObjectID: req.ObjectId,
+		}, req.VersionId, req.BlockIds)
+	})
+	code := mapErrorCode(err,
+		errToCode(history.ErrNoBlocksToRestore, pb.RpcHistoryRestoreBlocksResponseError_BAD_INPUT),
+		errToCode(history.ErrBlockNotInVersion, pb.RpcHistoryRestoreBlocksResponseError_BAD_INPUT),
+	)
+	return &pb.RpcHistoryRestoreBlocksResponse{
+		Error: &pb.RpcHistoryRestoreBlocksResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
@@ -0,0 +1,226 @@
+package history
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/anyproto/any-sync/commonspace/object/tree/objecttree"
+
+	"github.com/anyproto/anytype-heart/core/block/cache"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/text"
+	"github.com/anyproto/anytype-heart/core/block/source/sourceimpl"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// BlockVersions returns successive versions of the block, starting from the latest one.
+// Version is added only for changes that modified the block
+func (h *history) BlockVersions(id domain.FullID, blockId string, limit int) (resp []*pb.RpcHistoryBlockVersion, err error) {
+	if limit <= 0 {
+		limit = 100
+	}
+	var (
+		lastVersionId string
+		includeLastId = true
+		reachedRoot   bool
+	)
+	for len(resp) < limit && !reachedRoot {
+		tree, _, e := h.treeWithId(id, lastVersionId, includeLastId)
+		if e != nil {
+			return nil, e
+		}
+		versions, e := h.blockVersionsInTree(id.SpaceID, tree, blockId)
+		if e != nil {
+			return nil, e
+		}
+		slices.Reverse(versions)
+		resp = append(resp, versions...)
+
+		// history tree is built from the nearest snapshot, so we go deeper until we reach the root of the object
+		reachedRoot = tree.Root().Id == tree.Id()
+		lastVersionId = tree.Root().Id
+		includeLastId = false
+	}
+	if len(resp) > limit {
+		resp = resp[:limit]
+	}
+	return resp, nil
+}
+
+func (h *history) blockVersionsInTree(spaceId string, tree objecttree.HistoryTree, blockId string) ([]*pb.RpcHistoryBlockVersion, error) {
+	var (
+		st       *state.State
+		versions []*pb.RpcHistoryBlockVersion
+		iterErr  error
+	)
+	err := tree.IterateFrom(tree.Root().Id, sourceimpl.UnmarshalChange, func(c *objecttree.Change) (isContinue bool) {
+		if c.Id == tree.Id() {
+			st = state.NewDoc(tree.Id(), nil).(*state.State)
+			return true
+		}
+		changeModel, ok := c.Model.(*pb.Change)
+		if !ok {
+			return true
+		}
+		if st == nil {
+			if changeModel.Snapshot == nil {
+				iterErr = fmt.Errorf("history tree doesn't start with snapshot")
+				return false
+			}
+			st, iterErr = state.NewDocFromSnapshot(tree.Id(), changeModel.Snapshot)
+			return iterErr == nil
+		}
+		st.ApplyChangeIgnoreErr(changeModel.Content...)
+
+		b := st.Pick(blockId)
+		if b == nil || !h.isBlockChanged(b.Model(), changeModel.Content) {
+			return true
+		}
+		bc := b.Copy()
+		// text of title and description blocks is stored in details
+		if db, ok := bc.(simple.DetailsHandler); ok {
+			db.DetailsInit(st)
+		}
+		versions = append(versions, &pb.RpcHistoryBlockVersion{
+			Id:       c.Id,
+			AuthorId: domain.NewParticipantId(spaceId, c.Identity.Account()),
+			Time:     c.Timestamp,
+			Block:    bc.Model(),
+		})
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if iterErr != nil {
+		return nil, fmt.Errorf("iterate changes: %w", iterErr)
+	}
+	return versions, nil
+}
+
+func (h *history) isBlockChanged(b *model.Block, changeList []*pb.ChangeContent) bool {
+	if slices.Contains(h.getChangedBlockIds(changeList), b.Id) {
+		return true
+	}
+	detailKeys := pbtypes.GetStringList(b.GetFields(), text.DetailsKeyFieldName)
+	if len(detailKeys) == 0 {
+		return false
+	}
+	for _, content := range changeList {
+		if set := content.GetDetailsSet(); set != nil && slices.Contains(detailKeys, set.Key) {
+			return true
+		}
+		if unset := content.GetDetailsUnset(); unset != nil && slices.Contains(detailKeys, unset.Key) {
+			return true
+		}
+	}
+	return false
+}
+
+// RestoreBlocks restores given blocks from the version, leaving the rest of the object untouched.
+// Blocks that were removed since the version are inserted back next to their former siblings
+func (h *history) RestoreBlocks(id domain.FullID, versionId string, blockIds []string) error {
+	if len(blockIds) == 0 {
+		return ErrNoBlocksToRestore
+	}
+	versionState, _, _, err := h.buildState(id, versionId)
+	if err != nil {
+		return fmt.Errorf("build state of version: %w", err)
+	}
+	return cache.Do(h.picker, id.ObjectID, func(sb smartblock.SmartBlock) error {
+		st := sb.NewState()
+		if err := restoreBlocks(st, versionState, blockIds); err != nil {
+			return err
+		}
+		return sb.Apply(st)
+	})
+}
+
+func restoreBlocks(st, versionState *state.State, blockIds []string) error {
+	for _, blockId := range blockIds {
+		if !versionState.Exists(blockId) {
+			return fmt.Errorf("%w: %s", ErrBlockNotInVersion, blockId)
+		}
+	}
+
+	var (
+		added   = make(map[string]struct{})
+		restErr error
+	)
+	// iterate in the order of the version, so parents are restored before their children
+	err := versionState.Iterate(func(b simple.Block) (isContinue bool) {
+		blockId := b.Model().Id
+		if !slices.Contains(blockIds, blockId) {
+			return true
+		}
+		restored := b.Copy()
+		m := restored.Model()
+
+		// text of title and description blocks is stored in details
+		for _, key := range pbtypes.GetStringList(m.GetFields(), text.DetailsKeyFieldName) {
+			if value := versionState.Details().Get(domain.RelationKey(key)); value.Ok() {
+				st.SetDetail(domain.RelationKey(key), value)
+			} else {
+				st.RemoveDetail(domain.RelationKey(key))
+			}
+		}
+
+		if current := st.Pick(blockId); current != nil {
+			// structure of the object could be changed by others, so we keep current children
+			m.ChildrenIds = slices.Clone(current.Model().ChildrenIds)
+			st.Set(restored)
+			return true
+		}
+
+		m.ChildrenIds = slices.DeleteFunc(slices.Clone(m.ChildrenIds), func(childId string) bool {
+			return !slices.Contains(blockIds, childId) || st.Exists(childId)
+		})
+		st.Add(restored)
+		added[blockId] = struct{}{}
+
+		parent := versionState.PickParentOf(blockId)
+		if parent != nil {
+			if _, ok := added[parent.Model().Id]; ok {
+				// already listed in children of the restored parent
+				return true
+			}
+		}
+		if restErr = insertRestored(st, parent, blockId); restErr != nil {
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	return restErr
+}
+
+// insertRestored inserts the block after the nearest previous sibling that still exists,
+// or before the nearest next one, or to the end of the former parent or the root
+func insertRestored(st *state.State, versionParent simple.Block, blockId string) error {
+	if versionParent == nil {
+		return st.InsertTo("", model.Block_Inner, blockId)
+	}
+	siblings := versionParent.Model().ChildrenIds
+	pos := slices.Index(siblings, blockId)
+	for i := pos - 1; i >= 0; i-- {
+		if st.Exists(siblings[i]) && st.PickParentOf(siblings[i]) != nil {
+			return st.InsertTo(siblings[i], model.Block_Bottom, blockId)
+		}
+	}
+	for i := pos + 1; i < len(siblings); i++ {
+		if st.Exists(siblings[i]) && st.PickParentOf(siblings[i]) != nil {
+			return st.InsertTo(siblings[i], model.Block_Top, blockId)
+		}
+	}
+	if st.Exists(versionParent.Model().Id) {
+		return st.InsertTo(versionParent.Model().Id, model.Block_Inner, blockId)
+	}
+	return st.InsertTo("", model.Block_Inner, blockId)
+}
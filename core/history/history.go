@@ -43,6 +43,11 @@ const versionGroupInterval = time.Minute * 5
 
 var log = logging.Logger("anytype-mw-history")
 
+var (
+	ErrNoBlocksToRestore = errors.New("no blocks to restore")
+	ErrBlockNotInVersion = errors.New("block is not found in the version")
+)
+
 var hashersPool = &sync.Pool{
 	New: func() any {
 		return blake3.New()
@@ -59,6 +64,8 @@ type History interface {
 	SetVersion(id domain.FullID, versionId string) (err error)
 	DiffVersions(req *pb.RpcHistoryDiffVersionsRequest) ([]*pb.EventMessage, *model.ObjectView, error)
 	GetBlocksParticipants(id domain.FullID, versionId string, blocks []*model.Block) ([]*model.ObjectViewBlockParticipant, error)
+	BlockVersions(id domain.FullID, blockId string, limit int) ([]*pb.RpcHistoryBlockVersion, error)
+	RestoreBlocks(id domain.FullID, versionId string, blockIds []string) error
 	app.Component
 }
 
@@ -21,6 +21,7 @@ import (
 
 	"github.com/anyproto/anytype-heart/core/block/editor/state"
 	"github.com/anyproto/anytype-heart/core/block/object/idresolver/mock_idresolver"
+	"github.com/anyproto/anytype-heart/core/block/simple"
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/core/relationutils/mock_relationutils"
 	"github.com/anyproto/anytype-heart/pb"
@@ -1164,6 +1165,123 @@ type historyFixture struct {
 	treeBuilder *mock_objecttreebuilder.MockTreeBuilder
 }
 
+func TestHistory_BlockVersions(t *testing.T) {
+	objectId := "objectId"
+	spaceID := "spaceId"
+	blockId := "blockId"
+
+	t.Run("only changes of the block are returned, latest first", func(t *testing.T) {
+		// given
+		keys, _ := accountdata.NewRandom()
+		account := keys.SignKey.GetPublic()
+		participantId := domain.NewParticipantId(spaceID, account.Account())
+		bl := &model.Block{Id: blockId, Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: "text"}}}
+		other := &model.Block{Id: "otherId", Content: &model.BlockContentOfText{Text: &model.BlockContentText{}}}
+		changes := []*objecttree.Change{
+			provideBlockEmptyChange(objectId, account),
+			provideBlockCreateChange(bl, account),
+			provideBlockCreateChange(other, account),
+			provideBlockSetTextChange(bl, account),
+			provideBlockSetTextChange(other, account),
+		}
+		for i, ch := range changes[1:] {
+			ch.Id = fmt.Sprintf("change%d", i+1)
+			ch.Timestamp = int64(i + 1)
+		}
+		history := newFixture(t, changes, objectId, spaceID, "")
+
+		// when
+		versions, err := history.BlockVersions(domain.FullID{ObjectID: objectId, SpaceID: spaceID}, blockId, 0)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, versions, 2)
+		assert.Equal(t, "change3", versions[0].Id)
+		assert.Equal(t, int64(3), versions[0].Time)
+		assert.Equal(t, participantId, versions[0].AuthorId)
+		assert.Equal(t, "new text", versions[0].Block.GetText().Text)
+		assert.Equal(t, "change1", versions[1].Id)
+		assert.Equal(t, "text", versions[1].Block.GetText().Text)
+	})
+	t.Run("limit", func(t *testing.T) {
+		// given
+		keys, _ := accountdata.NewRandom()
+		account := keys.SignKey.GetPublic()
+		bl := &model.Block{Id: blockId, Content: &model.BlockContentOfText{Text: &model.BlockContentText{}}}
+		changes := []*objecttree.Change{
+			provideBlockEmptyChange(objectId, account),
+			provideBlockCreateChange(bl, account),
+			provideBlockSetTextChange(bl, account),
+			provideBlockSetTextChange(bl, account),
+		}
+		history := newFixture(t, changes, objectId, spaceID, "")
+
+		// when
+		versions, err := history.BlockVersions(domain.FullID{ObjectID: objectId, SpaceID: spaceID}, blockId, 2)
+
+		// then
+		require.NoError(t, err)
+		assert.Len(t, versions, 2)
+	})
+}
+
+func TestRestoreBlocks(t *testing.T) {
+	newText := func(id, text string) simple.Block {
+		return simple.New(&model.Block{Id: id, Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: text}}})
+	}
+	newVersionState := func() *state.State {
+		return state.NewDoc("root", map[string]simple.Block{
+			"root": simple.New(&model.Block{Id: "root", ChildrenIds: []string{"a", "b", "c"}}),
+			"a":    newText("a", "old a"),
+			"b":    newText("b", "old b"),
+			"c":    newText("c", "old c"),
+		}).(*state.State)
+	}
+	newCurrentState := func() *state.State {
+		return state.NewDoc("root", map[string]simple.Block{
+			"root": simple.New(&model.Block{Id: "root", ChildrenIds: []string{"a", "c", "d"}}),
+			"a":    newText("a", "new a"),
+			"c":    newText("c", "new c"),
+			"d":    newText("d", "d"),
+		}).(*state.State).NewState()
+	}
+
+	t.Run("changed and removed blocks are restored, others are kept", func(t *testing.T) {
+		// given
+		st := newCurrentState()
+
+		// when
+		err := restoreBlocks(st, newVersionState(), []string{"b", "a"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b", "c", "d"}, st.Pick("root").Model().ChildrenIds)
+		assert.Equal(t, "old a", st.Pick("a").Model().GetText().Text)
+		assert.Equal(t, "old b", st.Pick("b").Model().GetText().Text)
+		assert.Equal(t, "new c", st.Pick("c").Model().GetText().Text)
+	})
+	t.Run("removed block is inserted before the next sibling", func(t *testing.T) {
+		// given
+		st := newCurrentState()
+		st.Unlink("a")
+		st.CleanupBlock("a")
+
+		// when
+		err := restoreBlocks(st, newVersionState(), []string{"b"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"b", "c", "d"}, st.Pick("root").Model().ChildrenIds)
+	})
+	t.Run("block is not in the version", func(t *testing.T) {
+		// when
+		err := restoreBlocks(newCurrentState(), newVersionState(), []string{"d"})
+
+		// then
+		assert.ErrorIs(t, err, ErrBlockNotInVersion)
+	})
+}
+
 func newFixture(t *testing.T, expectedChanges []*objecttree.Change, objectId, spaceID, versionId string) *historyFixture {
 	spaceService := mock_space.NewMockService(t)
 	space := mock_clientspace.NewMockSpace(t)
@@ -17,6 +17,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/core/files/fileacl"
 	"github.com/anyproto/anytype-heart/core/invitestore"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/space"
 	"github.com/anyproto/anytype-heart/space/spaceinfo"
@@ -38,6 +39,7 @@ type InviteService interface {
 	GetCurrent(ctx context.Context, spaceId string) (domain.InviteInfo, error)
 	GetExistingGuestUserInvite(ctx context.Context, spaceId string) (domain.InviteInfo, error)
 	GenerateGuestUserInvite(ctx context.Context, spaceId string, guestKey crypto.PrivKey) (domain.InviteInfo, error)
+	ListLimitedSpaces(ctx context.Context) ([]string, error)
 }
 
 var _ InviteService = (*inviteService)(nil)
@@ -49,6 +51,7 @@ type GenerateInviteParams struct {
 	Key         crypto.PrivKey
 	InviteType  domain.InviteType
 	Permissions list.AclPermissions
+	Limits      domain.InviteLimits
 }
 
 type inviteService struct {
@@ -56,6 +59,7 @@ type inviteService struct {
 	fileAcl        fileacl.Service
 	accountService account.Service
 	spaceService   space.Service
+	objectStore    objectstore.ObjectStore
 }
 
 func New() InviteService {
@@ -67,6 +71,7 @@ func (i *inviteService) Init(a *app.App) (err error) {
 	i.fileAcl = app.MustComponent[fileacl.Service](a)
 	i.accountService = app.MustComponent[account.Service](a)
 	i.spaceService = app.MustComponent[space.Service](a)
+	i.objectStore = app.MustComponent[objectstore.ObjectStore](a)
 	return
 }
 
@@ -98,6 +103,7 @@ func (i *inviteService) View(ctx context.Context, inviteCid cid.Cid, inviteFileK
 		AclKey:          invitePayload.AclKey,
 		GuestKey:        invitePayload.GuestKey,
 		InviteType:      domain.InviteType(invitePayload.InviteType),
+		Limits:          LimitsFromPayload(invitePayload),
 	}, nil
 }
 
@@ -179,7 +185,9 @@ func (i *inviteService) Generate(ctx context.Context, params GenerateInviteParam
 		return domain.InviteInfo{}, generateInviteError("get existing invite info", err)
 	}
 	if result.InviteFileCid != "" && result.InviteType == params.InviteType {
-		return result, nil
+		if result.Limits.SameAs(params.Limits) && CheckLimits(result.Limits) == nil {
+			return result, nil
+		}
 	}
 	invite, err := i.buildInvite(ctx, params)
 	if err != nil {
@@ -205,6 +213,7 @@ func (i *inviteService) Generate(ctx context.Context, params GenerateInviteParam
 		InviteFileKey: inviteFileKeyRaw,
 		InviteType:    params.InviteType,
 		Permissions:   params.Permissions,
+		Limits:        params.Limits,
 	}
 	err = i.doInviteObject(ctx, spaceId, func(obj domain.InviteObject) error {
 		return obj.SetInviteFileInfo(inviteInfo)
@@ -336,6 +345,11 @@ func (i *inviteService) buildInvitePayload(ctx context.Context, params GenerateI
 		SpaceId:         params.SpaceId,
 		CreatorIdentity: i.accountService.AccountID(),
 		CreatorName:     profile.Name,
+		// nolint: gosec
+		MaxUses: int32(params.Limits.MaxUses),
+	}
+	if !params.Limits.ExpiresAt.IsZero() {
+		invitePayload.ExpiresAt = params.Limits.ExpiresAt.Unix()
 	}
 	rawKey, err := params.Key.Marshall()
 	if err != nil {
@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/anyproto/any-sync/app"
 	"github.com/anyproto/any-sync/commonspace/object/accountdata"
 	"github.com/anyproto/any-sync/commonspace/object/acl/list"
 	"github.com/anyproto/any-sync/util/cidutil"
 	"github.com/anyproto/any-sync/util/crypto"
+	"github.com/gogo/protobuf/proto"
 	"github.com/ipfs/go-cid"
 	mh "github.com/multiformats/go-multihash"
 	"github.com/stretchr/testify/mock"
@@ -24,6 +26,8 @@ import (
 	"github.com/anyproto/anytype-heart/core/domain/mock_domain"
 	"github.com/anyproto/anytype-heart/core/files/fileacl/mock_fileacl"
 	"github.com/anyproto/anytype-heart/core/invitestore/mock_invitestore"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/pkg/lib/threads"
 	"github.com/anyproto/anytype-heart/space/clientspace"
@@ -75,6 +79,26 @@ func TestInviteService_GetCurrent(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, returnedInfo, info)
 	})
+	t.Run("get current with limits", func(t *testing.T) {
+		fx := newFixture(t)
+		defer fx.ctrl.Finish()
+		fx.expectInviteObject()
+		returnedInfo := domain.InviteInfo{
+			InviteFileCid: "fileCid",
+			InviteFileKey: "fileKey",
+			InviteType:    domain.InviteTypeDefault,
+			Permissions:   list.AclPermissionsReader,
+			Limits: domain.InviteLimits{
+				ExpiresAt: time.Unix(1700000000, 0),
+				MaxUses:   5,
+			},
+		}
+		fx.mockInviteObject.EXPECT().GetExistingInviteInfo().Return(returnedInfo)
+		info, err := fx.GetCurrent(ctx, "spaceId")
+		require.NoError(t, err)
+		require.Equal(t, returnedInfo, info)
+		require.Equal(t, 5, info.Limits.UsesLeft())
+	})
 }
 
 func TestInviteService_RemoveExisting(t *testing.T) {
@@ -333,6 +357,89 @@ func TestInviteService_Generate(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, returnedInfo, info)
 	})
+	t.Run("generate invite with limits, invite exists with other limits", func(t *testing.T) {
+		fx := newFixture(t)
+		defer fx.ctrl.Finish()
+		fx.expectInviteObject()
+		returnedInfo := domain.InviteInfo{
+			InviteFileCid: "fileCid",
+			InviteFileKey: "fileKey",
+			InviteType:    domain.InviteTypeAnyone,
+			Permissions:   list.AclPermissionsReader,
+			Limits:        domain.InviteLimits{MaxUses: 10},
+		}
+		fx.mockInviteObject.EXPECT().GetExistingInviteInfo().Return(returnedInfo)
+		acc, err := accountdata.NewRandom()
+		require.NoError(t, err)
+		fx.mockAccountService.EXPECT().AccountID().Return(acc.SignKey.GetPublic().Account())
+		fx.mockAccountService.EXPECT().PersonalSpaceID().Return("personal")
+		fx.mockSpaceView.EXPECT().GetSpaceDescription().Return(spaceinfo.SpaceDescription{Name: "space"})
+		fx.mockSpaceService.EXPECT().TechSpace().Return(&clientspace.TechSpace{TechSpace: fx.mockTechSpace})
+		fx.mockTechSpace.EXPECT().DoSpaceView(ctx, "spaceId", mock.Anything).RunAndReturn(
+			func(ctx context.Context, spaceId string, f func(view techspace.SpaceView) error) error {
+				return f(fx.mockSpaceView)
+			})
+		fx.mockAccountService.EXPECT().ProfileInfo().Return(account.Profile{Name: "Misha"}, nil)
+		fx.mockAccountService.EXPECT().SignData(mock.Anything).Return([]byte("signature"), nil)
+		inviteCid, err := newCidFromBytes([]byte("newFileCid"))
+		require.NoError(t, err)
+		limits := domain.InviteLimits{
+			ExpiresAt: time.Unix(time.Now().Add(time.Hour).Unix(), 0),
+			MaxUses:   3,
+		}
+		fx.mockInviteStore.EXPECT().StoreInvite(ctx, mock.MatchedBy(func(invite *model.Invite) bool {
+			payload := &model.InvitePayload{}
+			if err := proto.Unmarshal(invite.Payload, payload); err != nil {
+				return false
+			}
+			return payload.ExpiresAt == limits.ExpiresAt.Unix() && payload.MaxUses == 3
+		})).Return(inviteCid, crypto.NewAES(), nil)
+		fx.mockInviteObject.EXPECT().SetInviteFileInfo(mock.MatchedBy(func(info domain.InviteInfo) bool {
+			return info.Limits == limits
+		})).Return(nil)
+		info, err := fx.inviteService.Generate(ctx, GenerateInviteParams{
+			SpaceId:     "spaceId",
+			Key:         acc.PeerKey,
+			InviteType:  domain.InviteTypeAnyone,
+			Permissions: list.AclPermissionsReader,
+			Limits:      limits,
+		}, func() error {
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, inviteCid.String(), info.InviteFileCid)
+		require.Equal(t, limits, info.Limits)
+	})
+}
+
+func TestInviteService_ListLimitedSpaces(t *testing.T) {
+	fx := newFixture(t)
+	fx.objectStore.AddObjects(t, "space1", []objectstore.TestObject{{
+		bundle.RelationKeyId:                 domain.String("workspace1"),
+		bundle.RelationKeySpaceId:            domain.String("space1"),
+		bundle.RelationKeySpaceInviteMaxUses: domain.Int64(3),
+	}})
+	fx.objectStore.AddObjects(t, "space2", []objectstore.TestObject{{
+		bundle.RelationKeyId:                   domain.String("workspace2"),
+		bundle.RelationKeySpaceId:              domain.String("space2"),
+		bundle.RelationKeySpaceInviteExpiresAt: domain.Int64(time.Now().Add(time.Hour).Unix()),
+	}})
+	fx.objectStore.AddObjects(t, "space3", []objectstore.TestObject{{
+		bundle.RelationKeyId:      domain.String("workspace3"),
+		bundle.RelationKeySpaceId: domain.String("space3"),
+	}})
+
+	spaceIds, err := fx.ListLimitedSpaces(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"space1", "space2"}, spaceIds)
+}
+
+func TestCheckLimits(t *testing.T) {
+	now := time.Now()
+	require.NoError(t, checkLimits(domain.InviteLimits{}, now))
+	require.NoError(t, checkLimits(domain.InviteLimits{ExpiresAt: now.Add(time.Hour), MaxUses: 2, Uses: 1}, now))
+	require.ErrorIs(t, checkLimits(domain.InviteLimits{ExpiresAt: now.Add(-time.Hour)}, now), ErrInviteExpired)
+	require.ErrorIs(t, checkLimits(domain.InviteLimits{MaxUses: 2, Uses: 2}, now), ErrInviteUsedUp)
 }
 
 func TestInviteService_InviteView(t *testing.T) {
@@ -393,6 +500,7 @@ type fixture struct {
 	mockSpaceView      *mock_techspace.MockSpaceView
 	mockSpace          *mock_clientspace.MockSpace
 	mockInviteObject   *mock_domain.MockInviteObject
+	objectStore        *objectstore.StoreFixture
 }
 
 func newFixture(t *testing.T) *fixture {
@@ -417,11 +525,13 @@ func newFixture(t *testing.T) *fixture {
 		mockSpaceView:      mockSpaceView,
 		mockSpace:          mockSpace,
 		mockInviteObject:   mockInviteObject,
+		objectStore:        objectstore.NewStoreFixture(t),
 	}
 	fx.a.Register(testutil.PrepareMock(ctx, fx.a, fx.mockInviteStore)).
 		Register(testutil.PrepareMock(ctx, fx.a, fx.mockFileAcl)).
 		Register(testutil.PrepareMock(ctx, fx.a, fx.mockAccountService)).
 		Register(testutil.PrepareMock(ctx, fx.a, fx.mockSpaceService)).
+		Register(fx.objectStore).
 		Register(fx)
 	require.NoError(t, fx.a.Start(ctx))
 	return fx
@@ -0,0 +1,72 @@
+package inviteservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+var (
+	ErrInviteExpired = errors.New("invite is expired")
+	ErrInviteUsedUp  = errors.New("invite has no uses left")
+)
+
+// ListLimitedSpaces returns spaces whose current invites have limits. Limits are stored in the workspace
+// along with other invite info, so all devices of the invite creator see them
+func (i *inviteService) ListLimitedSpaces(_ context.Context) ([]string, error) {
+	records, err := i.objectStore.QueryCrossSpace(database.Query{
+		Filters: []database.FilterRequest{{
+			Operator: model.BlockContentDataviewFilter_Or,
+			NestedFilters: []database.FilterRequest{
+				{
+					RelationKey: bundle.RelationKeySpaceInviteExpiresAt,
+					Condition:   model.BlockContentDataviewFilter_Greater,
+					Value:       domain.Int64(0),
+				},
+				{
+					RelationKey: bundle.RelationKeySpaceInviteMaxUses,
+					Condition:   model.BlockContentDataviewFilter_Greater,
+					Value:       domain.Int64(0),
+				},
+			},
+		}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query workspaces: %w", err)
+	}
+	spaceIds := make([]string, 0, len(records))
+	for _, rec := range records {
+		spaceIds = append(spaceIds, rec.Details.GetString(bundle.RelationKeySpaceId))
+	}
+	return spaceIds, nil
+}
+
+// CheckLimits returns an error if the invite could not be used anymore
+func CheckLimits(limits domain.InviteLimits) error {
+	return checkLimits(limits, time.Now())
+}
+
+func checkLimits(limits domain.InviteLimits, now time.Time) error {
+	if limits.IsExpired(now) {
+		return ErrInviteExpired
+	}
+	if limits.IsUsedUp() {
+		return ErrInviteUsedUp
+	}
+	return nil
+}
+
+// LimitsFromPayload returns limits of the invite signed by its creator
+func LimitsFromPayload(payload *model.InvitePayload) domain.InviteLimits {
+	limits := domain.InviteLimits{MaxUses: int(payload.MaxUses)}
+	if payload.ExpiresAt > 0 {
+		limits.ExpiresAt = time.Unix(payload.ExpiresAt, 0)
+	}
+	return limits
+}
@@ -464,6 +464,64 @@ func (_c *MockInviteService_Init_Call) RunAndReturn(run func(*app.App) error) *M
 	return _c
 }
 
+// ListLimitedSpaces provides a mock function with given fields: ctx
+func (_m *MockInviteService) ListLimitedSpaces(ctx context.Context) ([]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLimitedSpaces")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInviteService_ListLimitedSpaces_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLimitedSpaces'
+type MockInviteService_ListLimitedSpaces_Call struct {
+	*mock.Call
+}
+
+// ListLimitedSpaces is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockInviteService_Expecter) ListLimitedSpaces(ctx interface{}) *MockInviteService_ListLimitedSpaces_Call {
+	return &MockInviteService_ListLimitedSpaces_Call{Call: _e.mock.On("ListLimitedSpaces", ctx)}
+}
+
+func (_c *MockInviteService_ListLimitedSpaces_Call) Run(run func(ctx context.Context)) *MockInviteService_ListLimitedSpaces_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockInviteService_ListLimitedSpaces_Call) Return(_a0 []string, _a1 error) *MockInviteService_ListLimitedSpaces_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInviteService_ListLimitedSpaces_Call) RunAndReturn(run func(context.Context) ([]string, error)) *MockInviteService_ListLimitedSpaces_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Name provides a mock function with given fields:
 func (_m *MockInviteService) Name() string {
 	ret := _m.Called()
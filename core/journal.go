@@ -0,0 +1,80 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/journal"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+func (mw *Middleware) JournalOpenNote(cctx context.Context, req *pb.RpcJournalOpenNoteRequest) *pb.RpcJournalOpenNoteResponse {
+	id, created, err := mustService[journal.Service](mw).OpenNote(cctx, req.SpaceId, time.Unix(req.Timestamp, 0), journal.Period(req.Period))
+	code := mapErrorCode(err,
+		errToCode(journal.ErrEmptySpaceId, pb.RpcJournalOpenNoteResponseError_BAD_INPUT),
+		errToCode(journal.ErrInvalidPeriod, pb.RpcJournalOpenNoteResponseError_BAD_INPUT),
+		errToCode(journal.ErrTypeNotFound, pb.RpcJournalOpenNoteResponseError_TYPE_NOT_FOUND),
+	)
+	return &pb.RpcJournalOpenNoteResponse{
+		ObjectId: id,
+		Created:  created,
+		Error: &pb.RpcJournalOpenNoteResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
+
+func (mw *Middleware) JournalDateSection(cctx context.Context, req *pb.RpcJournalDateSectionRequest) *pb.RpcJournalDateSectionResponse {
+	section, err := mustService[journal.Service](mw).DateSection(cctx, req.SpaceId, time.Unix(req.Timestamp, 0))
+	code := mapErrorCode(err,
+		errToCode(journal.ErrEmptySpaceId, pb.RpcJournalDateSectionResponseError_BAD_INPUT),
+	)
+	return &pb.RpcJournalDateSectionResponse{
+		NoteIds:     section.NoteIds,
+		CreatedIds:  section.CreatedIds,
+		ModifiedIds: section.ModifiedIds,
+		Error: &pb.RpcJournalDateSectionResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
+
+func (mw *Middleware) JournalGetSettings(cctx context.Context, req *pb.RpcJournalGetSettingsRequest) *pb.RpcJournalGetSettingsResponse {
+	settings, err := mustService[journal.Service](mw).GetSettings(cctx, req.SpaceId)
+	code := mapErrorCode(err,
+		errToCode(journal.ErrEmptySpaceId, pb.RpcJournalGetSettingsResponseError_BAD_INPUT),
+	)
+	return &pb.RpcJournalGetSettingsResponse{
+		Settings: &pb.RpcJournalSettings{
+			TypeKey:           settings.TypeKey.String(),
+			DailyTemplateId:   settings.DailyTemplateId,
+			WeeklyTemplateId:  settings.WeeklyTemplateId,
+			MonthlyTemplateId: settings.MonthlyTemplateId,
+		},
+		Error: &pb.RpcJournalGetSettingsResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
+
+func (mw *Middleware) JournalSetSettings(cctx context.Context, req *pb.RpcJournalSetSettingsRequest) *pb.RpcJournalSetSettingsResponse {
+	err := mustService[journal.Service](mw).SetSettings(cctx, req.SpaceId, journal.Settings{
+		TypeKey:           domain.TypeKey(req.GetSettings().GetTypeKey()),
+		DailyTemplateId:   req.GetSettings().GetDailyTemplateId(),
+		WeeklyTemplateId:  req.GetSettings().GetWeeklyTemplateId(),
+		MonthlyTemplateId: req.GetSettings().GetMonthlyTemplateId(),
+	})
+	code := mapErrorCode(err,
+		errToCode(journal.ErrEmptySpaceId, pb.RpcJournalSetSettingsResponseError_BAD_INPUT),
+	)
+	return &pb.RpcJournalSetSettingsResponse{
+		Error: &pb.RpcJournalSetSettingsResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
@@ -0,0 +1,75 @@
+package journal
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/anyproto/anytype-heart/util/dateutil"
+)
+
+type Period int
+
+const (
+	PeriodDay Period = iota
+	PeriodWeek
+	PeriodMonth
+)
+
+func (p Period) valid() bool {
+	return p >= PeriodDay && p <= PeriodMonth
+}
+
+// bounds returns the start of the period containing the date and the start of the next period.
+// Weeks start on Monday, as in ISO 8601
+func (p Period) bounds(date time.Time) (start, end time.Time) {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	switch p {
+	case PeriodWeek:
+		offset := (int(day.Weekday()) + 6) % 7
+		start = day.AddDate(0, 0, -offset)
+		return start, start.AddDate(0, 0, 7)
+	case PeriodMonth:
+		start = time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, day.Location())
+		return start, start.AddDate(0, 1, 0)
+	default:
+		return day, day.AddDate(0, 0, 1)
+	}
+}
+
+// noteKey returns the key of the note of the period. Notes are found by their keys, so they are found on all devices
+// even if they are renamed
+func (p Period) noteKey(date time.Time) string {
+	start, _ := p.bounds(date)
+	switch p {
+	case PeriodWeek:
+		year, week := start.ISOWeek()
+		return fmt.Sprintf("week:%d-W%02d", year, week)
+	case PeriodMonth:
+		return start.Format("month:2006-01")
+	default:
+		return start.Format("day:2006-01-02")
+	}
+}
+
+// noteName returns the initial name of the note of the period
+func (p Period) noteName(date time.Time) string {
+	start, _ := p.bounds(date)
+	switch p {
+	case PeriodWeek:
+		year, week := start.ISOWeek()
+		return fmt.Sprintf("Week %d, %d", week, year)
+	case PeriodMonth:
+		return start.Format("January 2006")
+	default:
+		return dateutil.NewDateObject(start, false).Name()
+	}
+}
+
+// days returns starts of days of the period containing the date
+func (p Period) days(date time.Time) (days []time.Time) {
+	start, end := p.bounds(date)
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		days = append(days, day)
+	}
+	return days
+}
@@ -0,0 +1,69 @@
+package journal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeriod_bounds(t *testing.T) {
+	// Wednesday
+	date := time.Date(2024, time.May, 15, 13, 30, 0, 0, time.UTC)
+
+	t.Run("day", func(t *testing.T) {
+		start, end := PeriodDay.bounds(date)
+		assert.Equal(t, time.Date(2024, time.May, 15, 0, 0, 0, 0, time.UTC), start)
+		assert.Equal(t, time.Date(2024, time.May, 16, 0, 0, 0, 0, time.UTC), end)
+	})
+
+	t.Run("week starts on Monday", func(t *testing.T) {
+		start, end := PeriodWeek.bounds(date)
+		assert.Equal(t, time.Date(2024, time.May, 13, 0, 0, 0, 0, time.UTC), start)
+		assert.Equal(t, time.Date(2024, time.May, 20, 0, 0, 0, 0, time.UTC), end)
+	})
+
+	t.Run("week of Sunday", func(t *testing.T) {
+		start, _ := PeriodWeek.bounds(time.Date(2024, time.May, 19, 23, 0, 0, 0, time.UTC))
+		assert.Equal(t, time.Date(2024, time.May, 13, 0, 0, 0, 0, time.UTC), start)
+	})
+
+	t.Run("month", func(t *testing.T) {
+		start, end := PeriodMonth.bounds(date)
+		assert.Equal(t, time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC), start)
+		assert.Equal(t, time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC), end)
+	})
+}
+
+func TestPeriod_noteName(t *testing.T) {
+	date := time.Date(2024, time.December, 31, 10, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "Week 1, 2025", PeriodWeek.noteName(date))
+	assert.Equal(t, "December 2024", PeriodMonth.noteName(date))
+	assert.NotEmpty(t, PeriodDay.noteName(date))
+}
+
+func TestPeriod_noteKey(t *testing.T) {
+	date := time.Date(2024, time.December, 31, 10, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "day:2024-12-31", PeriodDay.noteKey(date))
+	assert.Equal(t, "week:2025-W01", PeriodWeek.noteKey(date))
+	assert.Equal(t, "month:2024-12", PeriodMonth.noteKey(date))
+	assert.Equal(t, PeriodWeek.noteKey(date), PeriodWeek.noteKey(date.AddDate(0, 0, -1)))
+}
+
+func TestPeriod_days(t *testing.T) {
+	date := time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC)
+
+	assert.Len(t, PeriodDay.days(date), 1)
+	assert.Len(t, PeriodWeek.days(date), 7)
+	assert.Len(t, PeriodMonth.days(date), 29)
+}
+
+func TestSettings_templateId(t *testing.T) {
+	settings := Settings{DailyTemplateId: "daily", WeeklyTemplateId: "weekly", MonthlyTemplateId: "monthly"}
+
+	assert.Equal(t, "daily", settings.templateId(PeriodDay))
+	assert.Equal(t, "weekly", settings.templateId(PeriodWeek))
+	assert.Equal(t, "monthly", settings.templateId(PeriodMonth))
+}
@@ -0,0 +1,414 @@
+package journal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/anyproto/any-sync/app"
+
+	"github.com/anyproto/anytype-heart/core/block/cache"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/object/objectcreator"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	coresb "github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/space"
+	"github.com/anyproto/anytype-heart/util/dateutil"
+)
+
+const (
+	CName = "core.journal"
+
+	// journalTypeKey is the key of the type that is created in the space for journal notes by default
+	journalTypeKey  domain.TypeKey = "journal"
+	journalTypeName                = "Journal"
+)
+
+var (
+	ErrEmptySpaceId  = errors.New("space id is empty")
+	ErrInvalidPeriod = errors.New("invalid period")
+	ErrTypeNotFound  = errors.New("journal type is not found in the space")
+)
+
+// dateObjectLayouts are layouts of objects shown in the date section
+var dateObjectLayouts = []model.ObjectTypeLayout{
+	model.ObjectType_basic,
+	model.ObjectType_profile,
+	model.ObjectType_todo,
+	model.ObjectType_note,
+	model.ObjectType_bookmark,
+	model.ObjectType_set,
+	model.ObjectType_collection,
+	model.ObjectType_file,
+	model.ObjectType_image,
+	model.ObjectType_audio,
+	model.ObjectType_video,
+	model.ObjectType_pdf,
+}
+
+// DateSection is a part of the date object view with journal notes and objects created or modified on the date
+type DateSection struct {
+	// NoteIds are ids of existing notes of the day, the week and the month
+	NoteIds     []string
+	CreatedIds  []string
+	ModifiedIds []string
+}
+
+type Service interface {
+	app.Component
+
+	// OpenNote returns the note of the period containing the date, the note is created if it does not exist.
+	// The daily note links to the date object, weekly and monthly notes link to daily notes of the period
+	OpenNote(ctx context.Context, spaceId string, date time.Time, period Period) (id string, created bool, err error)
+	// DateSection returns journal notes and objects created or modified on the date
+	DateSection(ctx context.Context, spaceId string, date time.Time) (DateSection, error)
+
+	GetSettings(ctx context.Context, spaceId string) (Settings, error)
+	SetSettings(ctx context.Context, spaceId string, settings Settings) error
+}
+
+type service struct {
+	objectStore   objectstore.ObjectStore
+	spaceService  space.Service
+	objectCreator objectcreator.Service
+	objectGetter  cache.ObjectGetter
+
+	// lock prevents creation of duplicated notes
+	lock sync.Mutex
+}
+
+func New() Service {
+	return &service{}
+}
+
+func (s *service) Init(a *app.App) (err error) {
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	s.spaceService = app.MustComponent[space.Service](a)
+	s.objectCreator = app.MustComponent[objectcreator.Service](a)
+	s.objectGetter = app.MustComponent[cache.ObjectGetter](a)
+	return nil
+}
+
+func (s *service) Name() string {
+	return CName
+}
+
+func (s *service) GetSettings(ctx context.Context, spaceId string) (Settings, error) {
+	if spaceId == "" {
+		return Settings{}, ErrEmptySpaceId
+	}
+	return s.getSettings(ctx, spaceId)
+}
+
+func (s *service) SetSettings(ctx context.Context, spaceId string, settings Settings) error {
+	if spaceId == "" {
+		return ErrEmptySpaceId
+	}
+	return s.setSettings(ctx, spaceId, settings)
+}
+
+func (s *service) OpenNote(ctx context.Context, spaceId string, date time.Time, period Period) (id string, created bool, err error) {
+	if spaceId == "" {
+		return "", false, ErrEmptySpaceId
+	}
+	if !period.valid() {
+		return "", false, ErrInvalidPeriod
+	}
+	settings, err := s.getSettings(ctx, spaceId)
+	if err != nil {
+		return "", false, fmt.Errorf("get settings: %w", err)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	typeKey, typeId, err := s.noteType(ctx, spaceId, settings)
+	if err != nil {
+		return "", false, err
+	}
+	id, err = s.findNote(spaceId, period.noteKey(date))
+	if err != nil {
+		return "", false, err
+	}
+	if id == "" {
+		id, err = s.createNote(ctx, spaceId, typeKey, typeId, settings.templateId(period), date, period)
+		if err != nil {
+			return "", false, err
+		}
+		created = true
+	}
+
+	links, err := s.noteLinks(spaceId, date, period)
+	if err != nil {
+		return "", false, err
+	}
+	if err = s.addLinks(id, links, period == PeriodDay); err != nil {
+		return "", false, fmt.Errorf("add links: %w", err)
+	}
+	if period == PeriodDay {
+		// weekly and monthly notes could be created before the daily note, so the daily note is added to them
+		if err = s.linkFromPeriodNotes(spaceId, id, date); err != nil {
+			return "", false, fmt.Errorf("link from period notes: %w", err)
+		}
+	}
+	return id, created, nil
+}
+
+// noteType returns the type of journal notes. The default Journal type is created in the space on the first use
+func (s *service) noteType(ctx context.Context, spaceId string, settings Settings) (typeKey domain.TypeKey, typeId string, err error) {
+	typeKey = settings.TypeKey
+	if typeKey == "" {
+		typeKey = journalTypeKey
+	}
+	spc, err := s.spaceService.Get(ctx, spaceId)
+	if err != nil {
+		return "", "", fmt.Errorf("get space: %w", err)
+	}
+	typeId, err = spc.GetTypeIdByKey(ctx, typeKey)
+	if err != nil {
+		return "", "", fmt.Errorf("get type id: %w", err)
+	}
+	details, err := s.objectStore.SpaceIndex(spaceId).GetDetails(typeId)
+	if err != nil {
+		return "", "", fmt.Errorf("get type details: %w", err)
+	}
+	if details.GetString(bundle.RelationKeyId) != "" && !details.GetBool(bundle.RelationKeyIsArchived) {
+		return typeKey, typeId, nil
+	}
+	if typeKey != journalTypeKey {
+		return "", "", ErrTypeNotFound
+	}
+
+	typeDetails := domain.NewDetails()
+	typeDetails.SetString(bundle.RelationKeyName, journalTypeName)
+	typeDetails.SetString(bundle.RelationKeyUniqueKey, domain.MustUniqueKey(coresb.SmartBlockTypeObjectType, journalTypeKey.String()).Marshal())
+	typeDetails.SetInt64(bundle.RelationKeyRecommendedLayout, int64(model.ObjectType_basic))
+	typeId, _, err = s.objectCreator.CreateObject(ctx, spaceId, objectcreator.CreateObjectRequest{
+		ObjectTypeKey: bundle.TypeKeyObjectType,
+		Details:       typeDetails,
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("create journal type: %w", err)
+	}
+	return typeKey, typeId, nil
+}
+
+// findNote returns the id of the note with the journal key. If notes of the period were created on several devices
+// at the same time, the earliest one is returned
+func (s *service) findNote(spaceId, key string) (string, error) {
+	records, err := s.objectStore.SpaceIndex(spaceId).Query(database.Query{
+		Filters: []database.FilterRequest{
+			{
+				RelationKey: bundle.RelationKeyJournalKey,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       domain.String(key),
+			},
+			{
+				RelationKey: bundle.RelationKeyIsArchived,
+				Condition:   model.BlockContentDataviewFilter_NotEqual,
+				Value:       domain.Bool(true),
+			},
+		},
+		Sorts: []database.SortRequest{{
+			RelationKey: bundle.RelationKeyCreatedDate,
+			Type:        model.BlockContentDataviewSort_Asc,
+		}},
+		Limit: 1,
+	})
+	if err != nil {
+		return "", fmt.Errorf("query notes: %w", err)
+	}
+	if len(records) == 0 {
+		return "", nil
+	}
+	return records[0].Details.GetString(bundle.RelationKeyId), nil
+}
+
+func (s *service) createNote(ctx context.Context, spaceId string, typeKey domain.TypeKey, typeId, templateId string, date time.Time, period Period) (string, error) {
+	if templateId == "" {
+		typeDetails, err := s.objectStore.SpaceIndex(spaceId).GetDetails(typeId)
+		if err != nil {
+			return "", fmt.Errorf("get type details: %w", err)
+		}
+		templateId = typeDetails.GetString(bundle.RelationKeyDefaultTemplateId)
+	}
+	details := domain.NewDetails()
+	details.SetString(bundle.RelationKeyName, period.noteName(date))
+	details.SetString(bundle.RelationKeyJournalKey, period.noteKey(date))
+	id, _, err := s.objectCreator.CreateObject(ctx, spaceId, objectcreator.CreateObjectRequest{
+		ObjectTypeKey: typeKey,
+		TemplateId:    templateId,
+		Details:       details,
+	})
+	if err != nil {
+		return "", fmt.Errorf("create note: %w", err)
+	}
+	return id, nil
+}
+
+// noteLinks returns ids of objects the note links to: the date object for daily notes and daily notes of the period
+// for weekly and monthly notes
+func (s *service) noteLinks(spaceId string, date time.Time, period Period) ([]string, error) {
+	if period == PeriodDay {
+		start, _ := period.bounds(date)
+		return []string{dateutil.NewDateObject(start, false).Id()}, nil
+	}
+	var ids []string
+	for _, day := range period.days(date) {
+		id, err := s.findNote(spaceId, PeriodDay.noteKey(day))
+		if err != nil {
+			return nil, err
+		}
+		if id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// linkFromPeriodNotes adds the link to the daily note to existing weekly and monthly notes of the date
+func (s *service) linkFromPeriodNotes(spaceId, dailyId string, date time.Time) error {
+	for _, period := range []Period{PeriodWeek, PeriodMonth} {
+		id, err := s.findNote(spaceId, period.noteKey(date))
+		if err != nil {
+			return err
+		}
+		if id == "" {
+			continue
+		}
+		if err = s.addLinks(id, []string{dailyId}, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addLinks adds link blocks to objects that are not linked from the note yet. Links are added on top of the note
+// or to its end, so the manual content of the note is kept
+func (s *service) addLinks(id string, targetIds []string, onTop bool) error {
+	if len(targetIds) == 0 {
+		return nil
+	}
+	return cache.Do(s.objectGetter, id, func(sb smartblock.SmartBlock) error {
+		st := sb.NewState()
+		_ = st.Iterate(func(b simple.Block) (isContinue bool) {
+			if target := b.Model().GetLink().GetTargetBlockId(); target != "" {
+				targetIds = slices.DeleteFunc(targetIds, func(id string) bool { return id == target })
+			}
+			return true
+		})
+		if len(targetIds) == 0 {
+			return nil
+		}
+		blockIds := make([]string, 0, len(targetIds))
+		for _, targetId := range targetIds {
+			b := simple.New(&model.Block{
+				Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{
+					TargetBlockId: targetId,
+					CardStyle:     model.BlockContentLink_Inline,
+				}},
+			})
+			st.Add(b)
+			blockIds = append(blockIds, b.Model().Id)
+		}
+		var err error
+		switch {
+		case onTop && st.Exists(template.HeaderLayoutId):
+			err = st.InsertTo(template.HeaderLayoutId, model.Block_Bottom, blockIds...)
+		case onTop:
+			err = st.InsertTo("", model.Block_InnerFirst, blockIds...)
+		default:
+			root := st.Get(st.RootId())
+			root.Model().ChildrenIds = append(root.Model().ChildrenIds, blockIds...)
+		}
+		if err != nil {
+			return fmt.Errorf("insert links: %w", err)
+		}
+		return sb.Apply(st)
+	})
+}
+
+func (s *service) DateSection(ctx context.Context, spaceId string, date time.Time) (section DateSection, err error) {
+	if spaceId == "" {
+		return section, ErrEmptySpaceId
+	}
+	for _, period := range []Period{PeriodDay, PeriodWeek, PeriodMonth} {
+		id, err := s.findNote(spaceId, period.noteKey(date))
+		if err != nil {
+			return section, err
+		}
+		if id != "" {
+			section.NoteIds = append(section.NoteIds, id)
+		}
+	}
+
+	start, end := PeriodDay.bounds(date)
+	section.CreatedIds, err = s.queryByDate(spaceId, bundle.RelationKeyCreatedDate, start, end)
+	if err != nil {
+		return section, err
+	}
+	modifiedIds, err := s.queryByDate(spaceId, bundle.RelationKeyLastModifiedDate, start, end)
+	if err != nil {
+		return section, err
+	}
+	for _, id := range modifiedIds {
+		if !slices.Contains(section.CreatedIds, id) {
+			section.ModifiedIds = append(section.ModifiedIds, id)
+		}
+	}
+	return section, nil
+}
+
+// queryByDate returns ids of objects with the date relation in [start, end), latest first
+func (s *service) queryByDate(spaceId string, key domain.RelationKey, start, end time.Time) ([]string, error) {
+	records, err := s.objectStore.SpaceIndex(spaceId).Query(database.Query{
+		Filters: []database.FilterRequest{
+			{
+				RelationKey: key,
+				Condition:   model.BlockContentDataviewFilter_GreaterOrEqual,
+				Value:       domain.Int64(start.Unix()),
+			},
+			{
+				RelationKey: key,
+				Condition:   model.BlockContentDataviewFilter_Less,
+				Value:       domain.Int64(end.Unix()),
+			},
+			{
+				RelationKey: bundle.RelationKeyResolvedLayout,
+				Condition:   model.BlockContentDataviewFilter_In,
+				Value:       domain.Int64List(dateObjectLayouts),
+			},
+			{
+				RelationKey: bundle.RelationKeyIsHidden,
+				Condition:   model.BlockContentDataviewFilter_NotEqual,
+				Value:       domain.Bool(true),
+			},
+			{
+				RelationKey: bundle.RelationKeyIsArchived,
+				Condition:   model.BlockContentDataviewFilter_NotEqual,
+				Value:       domain.Bool(true),
+			},
+		},
+		Sorts: []database.SortRequest{{
+			RelationKey: key,
+			Type:        model.BlockContentDataviewSort_Desc,
+		}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query objects by %s: %w", key, err)
+	}
+	ids := make([]string, 0, len(records))
+	for _, rec := range records {
+		ids = append(ids, rec.Details.GetString(bundle.RelationKeyId))
+	}
+	return ids, nil
+}
@@ -0,0 +1,178 @@
+package journal
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/cache/mock_cache"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock/smarttest"
+	"github.com/anyproto/anytype-heart/core/block/object/objectcreator"
+	"github.com/anyproto/anytype-heart/core/block/object/objectcreator/mock_objectcreator"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/pkg/lib/threads"
+	"github.com/anyproto/anytype-heart/space/clientspace/mock_clientspace"
+	"github.com/anyproto/anytype-heart/space/mock_space"
+	"github.com/anyproto/anytype-heart/util/dateutil"
+)
+
+const (
+	testSpaceId = "space1"
+	testTypeId  = "journalType"
+)
+
+type fixture struct {
+	*service
+	store   *objectstore.StoreFixture
+	objects map[string]*smarttest.SmartTest
+}
+
+func newFixture(t *testing.T) *fixture {
+	fx := &fixture{
+		store:   objectstore.NewStoreFixture(t),
+		objects: map[string]*smarttest.SmartTest{},
+	}
+	fx.store.AddObjects(t, testSpaceId, []objectstore.TestObject{{
+		bundle.RelationKeyId:      domain.String(testTypeId),
+		bundle.RelationKeySpaceId: domain.String(testSpaceId),
+	}})
+	fx.addObject("workspace")
+
+	spc := mock_clientspace.NewMockSpace(t)
+	spc.EXPECT().GetTypeIdByKey(mock.Anything, journalTypeKey).Return(testTypeId, nil).Maybe()
+	spc.EXPECT().DerivedIDs().Return(threads.DerivedSmartblockIds{Workspace: "workspace"}).Maybe()
+	spc.EXPECT().Do("workspace", mock.Anything).RunAndReturn(func(id string, apply func(smartblock.SmartBlock) error) error {
+		return apply(fx.objects[id])
+	}).Maybe()
+	spaceService := mock_space.NewMockService(t)
+	spaceService.EXPECT().Get(mock.Anything, testSpaceId).Return(spc, nil).Maybe()
+
+	creator := mock_objectcreator.NewMockService(t)
+	creator.EXPECT().CreateObject(mock.Anything, testSpaceId, mock.Anything).RunAndReturn(
+		func(ctx context.Context, spaceId string, req objectcreator.CreateObjectRequest) (string, *domain.Details, error) {
+			id := fmt.Sprintf("note%d", len(fx.objects))
+			fx.addObject(id)
+			fx.store.AddObjects(t, spaceId, []objectstore.TestObject{{
+				bundle.RelationKeyId:         domain.String(id),
+				bundle.RelationKeySpaceId:    domain.String(spaceId),
+				bundle.RelationKeyType:       domain.String(testTypeId),
+				bundle.RelationKeyName:       domain.String(req.Details.GetString(bundle.RelationKeyName)),
+				bundle.RelationKeyJournalKey: domain.String(req.Details.GetString(bundle.RelationKeyJournalKey)),
+			}})
+			return id, req.Details, nil
+		},
+	).Maybe()
+	getter := mock_cache.NewMockObjectGetter(t)
+	getter.EXPECT().GetObject(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, id string) (smartblock.SmartBlock, error) {
+		return fx.objects[id], nil
+	}).Maybe()
+
+	fx.service = &service{
+		objectStore:   fx.store,
+		spaceService:  spaceService,
+		objectCreator: creator,
+		objectGetter:  getter,
+	}
+	return fx
+}
+
+func (fx *fixture) addObject(id string) {
+	fx.objects[id] = smarttest.New(id)
+	fx.objects[id].AddBlock(simple.New(&model.Block{Id: id}))
+}
+
+func (fx *fixture) linkTargets(id string) (targets []string) {
+	for _, b := range fx.objects[id].Blocks() {
+		if target := b.GetLink().GetTargetBlockId(); target != "" {
+			targets = append(targets, target)
+		}
+	}
+	return targets
+}
+
+func TestService_OpenNote(t *testing.T) {
+	ctx := context.Background()
+	date := time.Date(2024, time.May, 15, 13, 30, 0, 0, time.UTC)
+
+	t.Run("note is created once and found by its key after rename", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+
+		// when
+		id, created, err := fx.OpenNote(ctx, testSpaceId, date, PeriodDay)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, created)
+		start, _ := PeriodDay.bounds(date)
+		assert.Equal(t, []string{dateutil.NewDateObject(start, false).Id()}, fx.linkTargets(id))
+
+		// when
+		fx.store.AddObjects(t, testSpaceId, []objectstore.TestObject{{
+			bundle.RelationKeyId:         domain.String(id),
+			bundle.RelationKeySpaceId:    domain.String(testSpaceId),
+			bundle.RelationKeyName:       domain.String("Renamed"),
+			bundle.RelationKeyJournalKey: domain.String(PeriodDay.noteKey(date)),
+		}})
+		sameId, created, err := fx.OpenNote(ctx, testSpaceId, date.Add(time.Hour), PeriodDay)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, created)
+		assert.Equal(t, id, sameId)
+		assert.Len(t, fx.linkTargets(id), 1)
+	})
+
+	t.Run("weekly and monthly notes link daily notes created before and after them", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		firstDayId, _, err := fx.OpenNote(ctx, testSpaceId, date, PeriodDay)
+		require.NoError(t, err)
+
+		// when
+		weekId, _, err := fx.OpenNote(ctx, testSpaceId, date, PeriodWeek)
+		require.NoError(t, err)
+		monthId, _, err := fx.OpenNote(ctx, testSpaceId, date, PeriodMonth)
+		require.NoError(t, err)
+		secondDayId, _, err := fx.OpenNote(ctx, testSpaceId, date.AddDate(0, 0, 1), PeriodDay)
+		require.NoError(t, err)
+		nextMonthDayId, _, err := fx.OpenNote(ctx, testSpaceId, time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC), PeriodDay)
+		require.NoError(t, err)
+
+		// then
+		assert.ElementsMatch(t, []string{firstDayId, secondDayId}, fx.linkTargets(weekId))
+		assert.ElementsMatch(t, []string{firstDayId, secondDayId}, fx.linkTargets(monthId))
+		assert.NotContains(t, fx.linkTargets(monthId), nextMonthDayId)
+	})
+
+	t.Run("invalid period", func(t *testing.T) {
+		fx := newFixture(t)
+
+		_, _, err := fx.OpenNote(ctx, testSpaceId, date, Period(10))
+
+		require.ErrorIs(t, err, ErrInvalidPeriod)
+	})
+}
+
+func TestService_Settings(t *testing.T) {
+	ctx := context.Background()
+	fx := newFixture(t)
+	settings := Settings{TypeKey: "diary", DailyTemplateId: "daily", MonthlyTemplateId: "monthly"}
+
+	require.NoError(t, fx.SetSettings(ctx, testSpaceId, settings))
+
+	got, err := fx.GetSettings(ctx, testSpaceId)
+	require.NoError(t, err)
+	assert.Equal(t, settings, got)
+	assert.Equal(t, "diary", fx.objects["workspace"].Details().GetString(bundle.RelationKeySpaceJournalTypeKey))
+}
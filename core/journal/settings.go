@@ -0,0 +1,80 @@
+package journal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+)
+
+// Settings are stored in details of the workspace object, so they are synced between devices and members of the space
+type Settings struct {
+	// TypeKey is the type of journal notes, the Journal type is created in the space if empty
+	TypeKey domain.TypeKey
+	// DailyTemplateId, WeeklyTemplateId and MonthlyTemplateId are templates of notes, default template of the type is used if empty
+	DailyTemplateId   string
+	WeeklyTemplateId  string
+	MonthlyTemplateId string
+}
+
+func (s Settings) templateId(period Period) string {
+	switch period {
+	case PeriodWeek:
+		return s.WeeklyTemplateId
+	case PeriodMonth:
+		return s.MonthlyTemplateId
+	default:
+		return s.DailyTemplateId
+	}
+}
+
+func settingsFromDetails(details *domain.Details) Settings {
+	return Settings{
+		TypeKey:           domain.TypeKey(details.GetString(bundle.RelationKeySpaceJournalTypeKey)),
+		DailyTemplateId:   details.GetString(bundle.RelationKeySpaceJournalDailyTemplateId),
+		WeeklyTemplateId:  details.GetString(bundle.RelationKeySpaceJournalWeeklyTemplateId),
+		MonthlyTemplateId: details.GetString(bundle.RelationKeySpaceJournalMonthlyTemplateId),
+	}
+}
+
+func setSettingsDetails(st *state.State, settings Settings) {
+	for key, value := range map[domain.RelationKey]string{
+		bundle.RelationKeySpaceJournalTypeKey:           settings.TypeKey.String(),
+		bundle.RelationKeySpaceJournalDailyTemplateId:   settings.DailyTemplateId,
+		bundle.RelationKeySpaceJournalWeeklyTemplateId:  settings.WeeklyTemplateId,
+		bundle.RelationKeySpaceJournalMonthlyTemplateId: settings.MonthlyTemplateId,
+	} {
+		if value == "" {
+			st.RemoveDetail(key)
+		} else {
+			st.SetDetailAndBundledRelation(key, domain.String(value))
+		}
+	}
+}
+
+func (s *service) getSettings(ctx context.Context, spaceId string) (settings Settings, err error) {
+	err = s.doWorkspace(ctx, spaceId, func(sb smartblock.SmartBlock) error {
+		settings = settingsFromDetails(sb.Details())
+		return nil
+	})
+	return settings, err
+}
+
+func (s *service) setSettings(ctx context.Context, spaceId string, settings Settings) error {
+	return s.doWorkspace(ctx, spaceId, func(sb smartblock.SmartBlock) error {
+		st := sb.NewState()
+		setSettingsDetails(st, settings)
+		return sb.Apply(st)
+	})
+}
+
+func (s *service) doWorkspace(ctx context.Context, spaceId string, f func(sb smartblock.SmartBlock) error) error {
+	spc, err := s.spaceService.Get(ctx, spaceId)
+	if err != nil {
+		return fmt.Errorf("get space: %w", err)
+	}
+	return spc.Do(spc.DerivedIDs().Workspace, f)
+}
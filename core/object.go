@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	"github.com/hashicorp/go-multierror"
@@ -13,6 +14,7 @@ import (
 	importer "github.com/anyproto/anytype-heart/core/block/import"
 	"github.com/anyproto/anytype-heart/core/block/import/common"
 	"github.com/anyproto/anytype-heart/core/block/object/objectgraph"
+	"github.com/anyproto/anytype-heart/core/block/object/objecttransfer"
 	"github.com/anyproto/anytype-heart/core/date"
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/core/domain/objectorigin"
@@ -26,6 +28,7 @@ import (
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/space"
 	"github.com/anyproto/anytype-heart/util/builtinobjects"
+	"github.com/anyproto/anytype-heart/util/dateutil"
 	"github.com/anyproto/anytype-heart/util/slice"
 )
 
@@ -72,6 +75,29 @@ func (mw *Middleware) ObjectListDuplicate(cctx context.Context, req *pb.RpcObjec
 	return response(objectIds, err)
 }
 
+func (mw *Middleware) ObjectTransferToSpace(cctx context.Context, req *pb.RpcObjectTransferToSpaceRequest) *pb.RpcObjectTransferToSpaceResponse {
+	idsMap, err := mustService[objecttransfer.Service](mw).Transfer(cctx, objecttransfer.Request{
+		SpaceId:       req.SpaceId,
+		TargetSpaceId: req.TargetSpaceId,
+		ObjectIds:     req.ObjectIds,
+		IncludeLinked: req.IncludeLinked,
+		Move:          req.Move,
+	})
+	code := mapErrorCode(err,
+		errToCode(objecttransfer.ErrSameSpace, pb.RpcObjectTransferToSpaceResponseError_BAD_INPUT),
+		errToCode(objecttransfer.ErrNoObjects, pb.RpcObjectTransferToSpaceResponseError_BAD_INPUT),
+		errToCode(objecttransfer.ErrNotTransferable, pb.RpcObjectTransferToSpaceResponseError_BAD_INPUT),
+		errToCode(objecttransfer.ErrCanceled, pb.RpcObjectTransferToSpaceResponseError_CANCELED),
+	)
+	return &pb.RpcObjectTransferToSpaceResponse{
+		IdsMap: idsMap,
+		Error: &pb.RpcObjectTransferToSpaceResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
+
 func (mw *Middleware) ObjectSearch(cctx context.Context, req *pb.RpcObjectSearchRequest) *pb.RpcObjectSearchResponse {
 	response := func(code pb.RpcObjectSearchResponseErrorCode, records []*types.Struct, err error) *pb.RpcObjectSearchResponse {
 		m := &pb.RpcObjectSearchResponse{Error: &pb.RpcObjectSearchResponseError{Code: code}, Records: records}
@@ -726,3 +752,30 @@ func (mw *Middleware) ObjectDateByTimestamp(ctx context.Context, req *pb.RpcObje
 		Details: details.ToProto(),
 	}
 }
+
+func (mw *Middleware) ObjectDateParse(_ context.Context, req *pb.RpcObjectDateParseRequest) *pb.RpcObjectDateParseResponse {
+	response := func(parsed date.ParsedDate, err error) *pb.RpcObjectDateParseResponse {
+		if err != nil {
+			return &pb.RpcObjectDateParseResponse{
+				Error: &pb.RpcObjectDateParseResponseError{
+					Code: mapErrorCode(err,
+						errToCode(date.ErrUnrecognizedDate, pb.RpcObjectDateParseResponseError_UNRECOGNIZED_DATE),
+						errToCode(date.ErrUnsupportedLocale, pb.RpcObjectDateParseResponseError_UNSUPPORTED_LOCALE),
+					),
+					Description: getErrorDescription(err),
+				},
+			}
+		}
+		return &pb.RpcObjectDateParseResponse{
+			Timestamp:    parsed.Time.Unix(),
+			IncludeTime:  parsed.IncludeTime,
+			DateObjectId: dateutil.NewDateObject(parsed.Time, parsed.IncludeTime).Id(),
+		}
+	}
+
+	now := time.Now()
+	if req.Timestamp != 0 {
+		now = time.Unix(req.Timestamp, 0)
+	}
+	return response(date.ParseNaturalDate(req.Text, now, req.Locale))
+}
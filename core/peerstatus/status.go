@@ -58,6 +58,8 @@ type PeerToPeerStatus interface {
 	app.ComponentRunnable
 	RegisterSpace(spaceId string)
 	UnregisterSpace(spaceId string)
+	// SpaceStatus returns the last reported local network status of the space and the count of connected devices
+	SpaceStatus(spaceId string) (status Status, connectionsCount int64)
 }
 
 type spaceStatus struct {
@@ -208,6 +210,15 @@ func (p *p2pStatus) refreshSpaces(spaceIds []string) error {
 	return nil
 }
 
+func (p *p2pStatus) SpaceStatus(spaceId string) (status Status, connectionsCount int64) {
+	p.Lock()
+	defer p.Unlock()
+	if currentStatus, ok := p.spaceIds[spaceId]; ok {
+		return currentStatus.status, currentStatus.connectionsCount
+	}
+	return Unknown, 0
+}
+
 // updateSpaceP2PStatus updates status for specific spaceId and sends event if status changed
 func (p *p2pStatus) processSpaceStatusUpdate(spaceId string) {
 	p.Lock()
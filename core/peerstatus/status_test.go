@@ -393,6 +393,34 @@ func TestP2pStatus_UnregisterSpace(t *testing.T) {
 	})
 }
 
+func TestP2pStatus_SpaceStatus(t *testing.T) {
+	t.Run("known space", func(t *testing.T) {
+		// given
+		f := newFixture(t, "spaceId", pb.EventP2PStatus_NotConnected, 1)
+		f.p2pStatus.Lock()
+		f.spaceIds["spaceId1"] = &spaceStatus{status: Connected, connectionsCount: 2}
+		f.p2pStatus.Unlock()
+
+		// when
+		status, count := f.SpaceStatus("spaceId1")
+
+		// then
+		assert.Equal(t, Connected, status)
+		assert.Equal(t, int64(2), count)
+	})
+	t.Run("unknown space", func(t *testing.T) {
+		// given
+		f := newFixture(t, "spaceId", pb.EventP2PStatus_NotConnected, 1)
+
+		// when
+		status, count := f.SpaceStatus("spaceId1")
+
+		// then
+		assert.Equal(t, Unknown, status)
+		assert.Zero(t, count)
+	})
+}
+
 func newFixture(t *testing.T, spaceId string, initialStatus pb.EventP2PStatusStatus, deviceCount int) *fixture {
 	ctrl := gomock.NewController(t)
 	sender := mock_event.NewMockSender(t)
@@ -3,12 +3,14 @@ package core
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/anyproto/any-sync/app"
 	"github.com/anyproto/any-sync/util/crypto"
 	"github.com/ipfs/go-cid"
 
 	"github.com/anyproto/anytype-heart/core/acl"
+	"github.com/anyproto/anytype-heart/core/acl/memberrole"
 	"github.com/anyproto/anytype-heart/core/block"
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/core/inviteservice"
@@ -71,12 +73,13 @@ func (mw *Middleware) SpaceMakeShareable(cctx context.Context, req *pb.RpcSpaceM
 
 func (mw *Middleware) SpaceInviteGenerate(cctx context.Context, req *pb.RpcSpaceInviteGenerateRequest) *pb.RpcSpaceInviteGenerateResponse {
 	aclService := mustService[acl.AclService](mw)
-	inviteInfo, err := aclService.GenerateInvite(cctx, req.SpaceId, req.InviteType, req.Permissions)
+	inviteInfo, err := aclService.GenerateInvite(cctx, req.SpaceId, req.InviteType, req.Permissions, convertInviteLimits(req.ExpiresAt, req.MaxUses))
 	if err != nil {
 		code := mapErrorCode(err,
 			errToCode(space.ErrSpaceDeleted, pb.RpcSpaceInviteGenerateResponseError_SPACE_IS_DELETED),
 			errToCode(space.ErrSpaceNotExists, pb.RpcSpaceInviteGenerateResponseError_NO_SUCH_SPACE),
 			errToCode(acl.ErrPersonalSpace, pb.RpcSpaceInviteGenerateResponseError_BAD_INPUT),
+			errToCode(acl.ErrIncorrectInviteLimits, pb.RpcSpaceInviteGenerateResponseError_BAD_INPUT),
 			errToCode(acl.ErrAclRequestFailed, pb.RpcSpaceInviteGenerateResponseError_REQUEST_FAILED),
 			errToCode(acl.ErrLimitReached, pb.RpcSpaceInviteGenerateResponseError_LIMIT_REACHED),
 			errToCode(acl.ErrNotShareable, pb.RpcSpaceInviteGenerateResponseError_NOT_SHAREABLE),
@@ -88,15 +91,38 @@ func (mw *Middleware) SpaceInviteGenerate(cctx context.Context, req *pb.RpcSpace
 			},
 		}
 	}
+	expiresAt, maxUses, usesLeft := inviteLimitsToProto(inviteInfo.Limits)
 	return &pb.RpcSpaceInviteGenerateResponse{
 		InviteCid:     inviteInfo.InviteFileCid,
 		InviteFileKey: inviteInfo.InviteFileKey,
 		// nolint: gosec
 		InviteType:  model.InviteType(inviteInfo.InviteType),
 		Permissions: domain.ConvertAclPermissions(inviteInfo.Permissions),
+		ExpiresAt:   expiresAt,
+		MaxUses:     maxUses,
+		UsesLeft:    usesLeft,
 	}
 }
 
+func convertInviteLimits(expiresAt int64, maxUses int32) domain.InviteLimits {
+	limits := domain.InviteLimits{MaxUses: int(maxUses)}
+	if expiresAt > 0 {
+		limits.ExpiresAt = time.Unix(expiresAt, 0)
+	}
+	return limits
+}
+
+func inviteLimitsToProto(limits domain.InviteLimits) (expiresAt int64, maxUses, usesLeft int32) {
+	if !limits.ExpiresAt.IsZero() {
+		expiresAt = limits.ExpiresAt.Unix()
+	}
+	if limits.MaxUses > 0 {
+		// nolint: gosec
+		maxUses, usesLeft = int32(limits.MaxUses), int32(limits.UsesLeft())
+	}
+	return
+}
+
 func (mw *Middleware) SpaceInviteChange(cctx context.Context, req *pb.RpcSpaceInviteChangeRequest) *pb.RpcSpaceInviteChangeResponse {
 	aclService := mustService[acl.AclService](mw)
 	err := aclService.ChangeInvite(cctx, req.SpaceId, req.Permissions)
@@ -132,12 +158,16 @@ func (mw *Middleware) SpaceInviteGetCurrent(cctx context.Context, req *pb.RpcSpa
 			},
 		}
 	}
+	expiresAt, maxUses, usesLeft := inviteLimitsToProto(inviteInfo.Limits)
 	return &pb.RpcSpaceInviteGetCurrentResponse{
 		InviteCid:     inviteInfo.InviteFileCid,
 		InviteFileKey: inviteInfo.InviteFileKey,
 		// nolint: gosec
 		InviteType:  model.InviteType(inviteInfo.InviteType),
 		Permissions: domain.ConvertAclPermissions(inviteInfo.Permissions),
+		ExpiresAt:   expiresAt,
+		MaxUses:     maxUses,
+		UsesLeft:    usesLeft,
 	}
 }
 
@@ -291,6 +321,8 @@ func (mw *Middleware) SpaceRequestApprove(cctx context.Context, req *pb.RpcSpace
 		errToCode(acl.ErrAclRequestFailed, pb.RpcSpaceRequestApproveResponseError_REQUEST_FAILED),
 		errToCode(acl.ErrLimitReached, pb.RpcSpaceRequestApproveResponseError_LIMIT_REACHED),
 		errToCode(acl.ErrNotShareable, pb.RpcSpaceRequestApproveResponseError_NOT_SHAREABLE),
+		errToCode(inviteservice.ErrInviteExpired, pb.RpcSpaceRequestApproveResponseError_INVITE_EXPIRED),
+		errToCode(inviteservice.ErrInviteUsedUp, pb.RpcSpaceRequestApproveResponseError_INVITE_USED_UP),
 	)
 	return &pb.RpcSpaceRequestApproveResponse{
 		Error: &pb.RpcSpaceRequestApproveResponseError{
@@ -358,6 +390,30 @@ func (mw *Middleware) SpaceParticipantPermissionsChange(cctx context.Context, re
 	}
 }
 
+func (mw *Middleware) SpaceParticipantRoleSet(cctx context.Context, req *pb.RpcSpaceParticipantRoleSetRequest) *pb.RpcSpaceParticipantRoleSetResponse {
+	err := mustService[memberrole.Service](mw).SetRole(cctx, req.SpaceId, req.Identity, domain.MemberRole{
+		Type:          convertMemberRole(req.Role),
+		CollectionIds: req.CollectionIds,
+	})
+	code := mapErrorCode(err,
+		errToCode(memberrole.ErrNoCollections, pb.RpcSpaceParticipantRoleSetResponseError_BAD_INPUT),
+		errToCode(memberrole.ErrNotCollection, pb.RpcSpaceParticipantRoleSetResponseError_BAD_INPUT),
+		errToCode(memberrole.ErrNotWriter, pb.RpcSpaceParticipantRoleSetResponseError_BAD_INPUT),
+		errToCode(space.ErrSpaceDeleted, pb.RpcSpaceParticipantRoleSetResponseError_SPACE_IS_DELETED),
+		errToCode(space.ErrSpaceNotExists, pb.RpcSpaceParticipantRoleSetResponseError_NO_SUCH_SPACE),
+		errToCode(acl.ErrAclRequestFailed, pb.RpcSpaceParticipantRoleSetResponseError_REQUEST_FAILED),
+		errToCode(acl.ErrNoSuchAccount, pb.RpcSpaceParticipantRoleSetResponseError_PARTICIPANT_NOT_FOUND),
+		errToCode(acl.ErrIncorrectPermissions, pb.RpcSpaceParticipantRoleSetResponseError_INCORRECT_PERMISSIONS),
+		errToCode(acl.ErrPersonalSpace, pb.RpcSpaceParticipantRoleSetResponseError_NOT_SHAREABLE),
+	)
+	return &pb.RpcSpaceParticipantRoleSetResponse{
+		Error: &pb.RpcSpaceParticipantRoleSetResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
+
 func (mw *Middleware) SpaceLeaveApprove(cctx context.Context, req *pb.RpcSpaceLeaveApproveRequest) *pb.RpcSpaceLeaveApproveResponse {
 	aclService := mw.applicationService.GetApp().MustComponent(acl.CName).(acl.AclService)
 	err := approveLeave(cctx, req.SpaceId, req.Identities, aclService)
@@ -475,3 +531,14 @@ func permissionsChange(ctx context.Context, spaceId string, changes []*model.Par
 	}
 	return aclService.ChangePermissions(ctx, spaceId, accPermissions)
 }
+
+func convertMemberRole(role pb.RpcSpaceParticipantRoleSetRole) domain.MemberRoleType {
+	switch role {
+	case pb.RpcSpaceParticipantRoleSet_Commenter:
+		return domain.MemberRoleCommenter
+	case pb.RpcSpaceParticipantRoleSet_CollectionEditor:
+		return domain.MemberRoleCollectionEditor
+	default:
+		return domain.MemberRoleDefault
+	}
+}
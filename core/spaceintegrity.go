@@ -0,0 +1,64 @@
+package core
+
+import (
+	"context"
+
+	"github.com/anyproto/anytype-heart/core/spaceintegrity"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+func (mw *Middleware) DebugSpaceVerify(cctx context.Context, req *pb.RpcDebugSpaceVerifyRequest) *pb.RpcDebugSpaceVerifyResponse {
+	report, err := mustService[spaceintegrity.Service](mw).Verify(cctx, req.SpaceId)
+	code := mapErrorCode(err,
+		errToCode(spaceintegrity.ErrEmptySpaceId, pb.RpcDebugSpaceVerifyResponseError_BAD_INPUT),
+	)
+	resp := &pb.RpcDebugSpaceVerifyResponse{
+		Error: &pb.RpcDebugSpaceVerifyResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+	if err != nil {
+		return resp
+	}
+	resp.SpaceId = report.SpaceId
+	resp.ObjectsChecked = int32(report.ObjectsChecked)
+	resp.Issues = make([]*pb.RpcDebugSpaceVerifyIssue, 0, len(report.Issues))
+	for _, issue := range report.Issues {
+		resp.Issues = append(resp.Issues, &pb.RpcDebugSpaceVerifyIssue{
+			Category:     pb.RpcDebugSpaceVerifyCategory(issue.Category),
+			ObjectId:     issue.ObjectId,
+			TargetId:     issue.TargetId,
+			FileId:       issue.FileId,
+			Description:  issue.Description,
+			RepairAction: pb.RpcDebugSpaceVerifyRepairAction(issue.RepairAction),
+		})
+	}
+	return resp
+}
+
+func (mw *Middleware) DebugSpaceRepair(cctx context.Context, req *pb.RpcDebugSpaceRepairRequest) *pb.RpcDebugSpaceRepairResponse {
+	issues := make([]spaceintegrity.Issue, 0, len(req.Issues))
+	for _, issue := range req.Issues {
+		issues = append(issues, spaceintegrity.Issue{
+			Category:     spaceintegrity.Category(issue.Category),
+			ObjectId:     issue.ObjectId,
+			TargetId:     issue.TargetId,
+			FileId:       issue.FileId,
+			Description:  issue.Description,
+			RepairAction: spaceintegrity.RepairAction(issue.RepairAction),
+		})
+	}
+	processId, err := mustService[spaceintegrity.Service](mw).Repair(cctx, req.SpaceId, issues)
+	code := mapErrorCode(err,
+		errToCode(spaceintegrity.ErrEmptySpaceId, pb.RpcDebugSpaceRepairResponseError_BAD_INPUT),
+		errToCode(spaceintegrity.ErrNothingToRepair, pb.RpcDebugSpaceRepairResponseError_BAD_INPUT),
+	)
+	return &pb.RpcDebugSpaceRepairResponse{
+		ProcessId: processId,
+		Error: &pb.RpcDebugSpaceRepairResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
@@ -0,0 +1,177 @@
+package spaceintegrity
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/block/cache"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/dataview"
+	"github.com/anyproto/anytype-heart/core/block/simple/file"
+	"github.com/anyproto/anytype-heart/core/block/simple/text"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/domain/objectorigin"
+	"github.com/anyproto/anytype-heart/core/relationutils"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/space/clientspace"
+)
+
+type linkSource interface {
+	FillSmartIds(ids []string) []string
+	HasSmartIds() bool
+}
+
+// reindex indexes the object from scratch. Links are dropped first, so the indexer reports all of them as added
+// and the backlinks of the targets are restored. Both happen under the lock of the object, so its changes
+// could not be indexed in between
+func (s *service) reindex(ctx context.Context, spaceId, objectId string) error {
+	return cache.DoContextFullID(s.picker, ctx, domain.FullID{SpaceID: spaceId, ObjectID: objectId}, func(sb smartblock.SmartBlock) error {
+		if err := s.objectStore.SpaceIndex(spaceId).DeleteLinks([]string{objectId}); err != nil {
+			return fmt.Errorf("delete links: %w", err)
+		}
+		return s.indexer.Index(sb.GetDocInfo())
+	})
+}
+
+func (s *service) recreateFileObject(ctx context.Context, spaceId string, issue Issue) error {
+	if issue.FileId == "" {
+		return ErrUnknownFile
+	}
+	fileObjectId, err := s.fileObjectService.CreateFromImport(domain.FullFileId{SpaceId: spaceId, FileId: domain.FileId(issue.FileId)}, objectorigin.None())
+	if err != nil {
+		return fmt.Errorf("create file object: %w", err)
+	}
+	return cache.DoContextFullID(s.picker, ctx, domain.FullID{SpaceID: spaceId, ObjectID: issue.ObjectId}, func(sb smartblock.SmartBlock) error {
+		st := sb.NewState()
+		if !replaceFileTarget(st, issue.TargetId, issue.FileId, fileObjectId) {
+			return nil
+		}
+		return sb.Apply(st)
+	})
+}
+
+// dropDanglingLink drops the links to the target if it is still missing, as it could have been synced or restored
+// since the verification
+func (s *service) dropDanglingLink(ctx context.Context, spaceId string, issue Issue) error {
+	spc, err := s.spaceService.Get(ctx, spaceId)
+	if err != nil {
+		return fmt.Errorf("get space: %w", err)
+	}
+	return cache.DoContextFullID(s.picker, ctx, domain.FullID{SpaceID: spaceId, ObjectID: issue.ObjectId}, func(sb smartblock.SmartBlock) error {
+		missing, err := s.isMissing(ctx, spc, issue.TargetId)
+		if err != nil || !missing {
+			return err
+		}
+		st := sb.NewState()
+		if !dropLink(st, issue.TargetId, s.formatFetcher) {
+			return nil
+		}
+		return sb.Apply(st)
+	})
+}
+
+// isMissing reports whether the object is neither virtual, nor stored in the space, nor indexed in any space
+func (s *service) isMissing(ctx context.Context, spc clientspace.Space, id string) (bool, error) {
+	if strings.HasPrefix(id, virtualIdPrefix) || isIndexed(s.objectStore, id) {
+		return false, nil
+	}
+	existing, err := s.objectStore.SpaceIndex(spc.Id()).HasIds([]string{id})
+	if err != nil {
+		return false, fmt.Errorf("check index: %w", err)
+	}
+	if len(existing) > 0 {
+		return false, nil
+	}
+	stored, err := spc.Storage().HasTree(ctx, id)
+	if err != nil {
+		return false, fmt.Errorf("check tree: %w", err)
+	}
+	return !stored, nil
+}
+
+// replaceFileTarget points the file blocks with the missing target, or without a target but with the file, to the file object
+func replaceFileTarget(st *state.State, targetId, fileId, fileObjectId string) (changed bool) {
+	var blockIds []string
+	_ = st.Iterate(func(b simple.Block) bool {
+		fb, ok := b.(file.Block)
+		if !ok {
+			return true
+		}
+		target := fb.TargetObjectId()
+		if (targetId != "" && target == targetId) || (target == "" && fb.Model().GetFile().GetHash() == fileId) {
+			blockIds = append(blockIds, b.Model().Id)
+		}
+		return true
+	})
+	for _, id := range blockIds {
+		if fb, ok := st.Get(id).(file.Block); ok {
+			fb.SetTargetObjectId(fileObjectId)
+			changed = true
+		}
+	}
+	return changed
+}
+
+// dropLink removes mentions, links, dataview sources, relation values and collection items pointing to the target
+func dropLink(st *state.State, targetId string, fetcher relationutils.RelationFormatFetcher) (changed bool) {
+	var blockIds []string
+	_ = st.Iterate(func(b simple.Block) bool {
+		if ls, ok := b.(linkSource); ok && ls.HasSmartIds() && slices.Contains(ls.FillSmartIds(nil), targetId) {
+			blockIds = append(blockIds, b.Model().Id)
+		}
+		return true
+	})
+	for _, id := range blockIds {
+		switch b := st.Get(id).(type) {
+		case text.Block:
+			if marks := b.Model().GetText().GetMarks(); marks != nil {
+				marks.Marks = slices.DeleteFunc(marks.Marks, func(m *model.BlockContentTextMark) bool {
+					return (m.Type == model.BlockContentTextMark_Mention || m.Type == model.BlockContentTextMark_Object) && m.Param == targetId
+				})
+			}
+		case dataview.Block:
+			if b.Model().GetDataview().GetTargetObjectId() == targetId {
+				b.SetTargetObjectID("")
+			}
+			for _, view := range b.ListViews() {
+				if view.DefaultObjectTypeId == targetId {
+					view.DefaultObjectTypeId = ""
+				}
+				if view.DefaultTemplateId == targetId {
+					view.DefaultTemplateId = ""
+				}
+			}
+		default:
+			st.Unlink(id)
+		}
+		changed = true
+	}
+
+	details := st.Details()
+	var keys []domain.RelationKey
+	for key := range details.IterateKeys() {
+		if !bundle.IsSystemRelation(key) && slices.Contains(details.WrapToStringList(key), targetId) {
+			keys = append(keys, key)
+		}
+	}
+	for _, key := range keys {
+		if format, err := fetcher.GetRelationFormatByKey(st.SpaceID(), key); err != nil || format != model.RelationFormat_object {
+			continue
+		}
+		ids := slices.DeleteFunc(slices.Clone(details.WrapToStringList(key)), func(id string) bool { return id == targetId })
+		st.SetDetail(key, domain.StringList(ids))
+		changed = true
+	}
+
+	if ids := st.GetStoreSlice(template.CollectionStoreKey); slices.Contains(ids, targetId) {
+		st.UpdateStoreSlice(template.CollectionStoreKey, slices.DeleteFunc(slices.Clone(ids), func(id string) bool { return id == targetId }))
+		changed = true
+	}
+	return changed
+}
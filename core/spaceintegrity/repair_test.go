@@ -0,0 +1,115 @@
+package spaceintegrity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock/smarttest"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/file"
+	"github.com/anyproto/anytype-heart/core/block/simple/link"
+	"github.com/anyproto/anytype-heart/core/block/simple/text"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/relationutils/mock_relationutils"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore/spaceindex"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/space/clientspace/mock_clientspace"
+	"github.com/anyproto/anytype-heart/space/spacecore/storage/anystorage/mock_anystorage"
+)
+
+func TestDropLink(t *testing.T) {
+	sb := smarttest.New("obj1")
+	sb.AddBlock(simple.New(&model.Block{Id: "obj1", ChildrenIds: []string{"text", "link", "other"}}))
+	sb.AddBlock(text.NewText(&model.Block{Id: "text", Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+		Text: "see missing and existing",
+		Marks: &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{
+			{Type: model.BlockContentTextMark_Mention, Param: "missing", Range: &model.Range{From: 4, To: 11}},
+			{Type: model.BlockContentTextMark_Mention, Param: "existing", Range: &model.Range{From: 16, To: 24}},
+		}},
+	}}}))
+	sb.AddBlock(link.NewLink(&model.Block{Id: "link", Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{TargetBlockId: "missing"}}}))
+	sb.AddBlock(link.NewLink(&model.Block{Id: "other", Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{TargetBlockId: "existing"}}}))
+	st := sb.Doc.(*state.State)
+	st.SetDetails(domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+		"assignee":                domain.StringList([]string{"missing", "existing"}),
+		"comment":                 domain.String("missing"),
+		bundle.RelationKeyCreator: domain.String("missing"),
+	}))
+	st.UpdateStoreSlice(template.CollectionStoreKey, []string{"existing", "missing"})
+
+	fetcher := mock_relationutils.NewMockRelationFormatFetcher(t)
+	fetcher.EXPECT().GetRelationFormatByKey(mock.Anything, mock.Anything).RunAndReturn(func(_ string, key domain.RelationKey) (model.RelationFormat, error) {
+		if key == "assignee" {
+			return model.RelationFormat_object, nil
+		}
+		return model.RelationFormat_longtext, nil
+	})
+
+	st = sb.NewState()
+	assert.True(t, dropLink(st, "missing", fetcher))
+
+	marks := st.Pick("text").Model().GetText().GetMarks().GetMarks()
+	if assert.Len(t, marks, 1) {
+		assert.Equal(t, "existing", marks[0].Param)
+	}
+	assert.Equal(t, []string{"text", "other"}, st.Pick("obj1").Model().ChildrenIds)
+	assert.Equal(t, []string{"existing"}, st.Details().GetStringList("assignee"))
+	assert.Equal(t, "missing", st.Details().GetString("comment"))
+	assert.Equal(t, "missing", st.Details().GetString(bundle.RelationKeyCreator))
+	assert.Equal(t, []string{"existing"}, st.GetStoreSlice(template.CollectionStoreKey))
+
+	assert.False(t, dropLink(sb.NewState(), "unknown", fetcher))
+}
+
+func TestReplaceFileTarget(t *testing.T) {
+	sb := smarttest.New("obj1")
+	sb.AddBlock(simple.New(&model.Block{Id: "obj1", ChildrenIds: []string{"file1", "file2", "file3"}}))
+	sb.AddBlock(file.NewFile(&model.Block{Id: "file1", Content: &model.BlockContentOfFile{File: &model.BlockContentFile{
+		Hash:           fileId,
+		TargetObjectId: "missing",
+	}}}))
+	sb.AddBlock(file.NewFile(&model.Block{Id: "file2", Content: &model.BlockContentOfFile{File: &model.BlockContentFile{
+		Hash: fileId,
+	}}}))
+	sb.AddBlock(file.NewFile(&model.Block{Id: "file3", Content: &model.BlockContentOfFile{File: &model.BlockContentFile{
+		TargetObjectId: "existing",
+	}}}))
+
+	st := sb.NewState()
+	assert.True(t, replaceFileTarget(st, "missing", fileId, "fileObject1"))
+
+	assert.Equal(t, "fileObject1", st.Pick("file1").Model().GetFile().TargetObjectId)
+	assert.Equal(t, "fileObject1", st.Pick("file2").Model().GetFile().TargetObjectId)
+	assert.Equal(t, "existing", st.Pick("file3").Model().GetFile().TargetObjectId)
+}
+
+func TestService_isMissing(t *testing.T) {
+	store := objectstore.NewStoreFixture(t)
+	store.AddObjects(t, spaceId, []spaceindex.TestObject{object("indexed", "")})
+	storage := mock_anystorage.NewMockClientSpaceStorage(t)
+	storage.EXPECT().HasTree(mock.Anything, "stored").Return(true, nil).Maybe()
+	storage.EXPECT().HasTree(mock.Anything, "missing").Return(false, nil).Maybe()
+	spc := mock_clientspace.NewMockSpace(t)
+	spc.EXPECT().Id().Return(spaceId).Maybe()
+	spc.EXPECT().Storage().Return(storage).Maybe()
+	s := &service{objectStore: store}
+
+	for id, missing := range map[string]bool{
+		"indexed":          false,
+		"stored":           false,
+		"_date_2024-01-01": false,
+		"missing":          true,
+	} {
+		got, err := s.isMissing(context.Background(), spc, id)
+		require.NoError(t, err)
+		assert.Equal(t, missing, got, id)
+	}
+}
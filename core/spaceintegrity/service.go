@@ -0,0 +1,301 @@
+package spaceintegrity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/anyproto/any-sync/commonspace/objecttreebuilder"
+	"go.uber.org/zap"
+
+	"github.com/anyproto/anytype-heart/core/block/backlinks"
+	"github.com/anyproto/anytype-heart/core/block/cache"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/block/source/sourceimpl"
+	"github.com/anyproto/anytype-heart/core/files/fileobject"
+	"github.com/anyproto/anytype-heart/core/relationutils"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/addr"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/ftsearch"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/space"
+	"github.com/anyproto/anytype-heart/space/clientspace"
+	"github.com/anyproto/anytype-heart/space/spacecore/typeprovider"
+)
+
+const CName = "core.spaceintegrity"
+
+var log = logging.Logger(CName).Desugar()
+
+var (
+	ErrEmptySpaceId     = errors.New("space id is empty")
+	ErrNothingToRepair  = errors.New("no issues with a repair action")
+	ErrUnknownFile      = errors.New("file id of the missing file object is unknown")
+	ErrCanceled         = errors.New("process is canceled")
+	errUnexpectedAction = errors.New("unexpected repair action")
+)
+
+// Category is a kind of inconsistency between the object trees of a space and its indexes
+type Category int32
+
+const (
+	// CategoryMissingIndex means that the object tree is stored, but the object is missing in the object store
+	CategoryMissingIndex Category = iota
+	// CategoryStaleIndex means that the object is in the object store, but its tree is missing
+	CategoryStaleIndex
+	// CategoryMissingFulltext means that the object has a name, but it is neither in the full-text index nor in its queue
+	CategoryMissingFulltext
+	// CategoryMissingFileObject means that a file block points to a missing file object
+	CategoryMissingFileObject
+	// CategoryDanglingLink means that a link, mention or relation value points to a missing object
+	CategoryDanglingLink
+	// CategoryMissingBacklink means that the link target doesn't list the object in its backlinks
+	CategoryMissingBacklink
+	// CategoryOrphanRelationOption means that the relation of the option is missing
+	CategoryOrphanRelationOption
+)
+
+type RepairAction int32
+
+const (
+	RepairNone RepairAction = iota
+	// RepairReindex indexes the object and its links from scratch
+	RepairReindex
+	// RepairRecreateFileObject creates a file object for the file and points the file blocks of the object to it
+	RepairRecreateFileObject
+	// RepairDropDanglingLink removes the links, mentions and relation values pointing to the target from the object
+	RepairDropDanglingLink
+)
+
+type Issue struct {
+	Category Category
+	// ObjectId is the object to repair
+	ObjectId string
+	// TargetId is the missing link target, file object or relation option
+	TargetId string
+	// FileId is the file to recreate a missing file object from, if known
+	FileId       string
+	Description  string
+	RepairAction RepairAction
+}
+
+type Report struct {
+	SpaceId        string
+	ObjectsChecked int
+	Issues         []Issue
+}
+
+type Service interface {
+	app.ComponentRunnable
+
+	// Verify cross-checks the object trees of the space against the object store, the full-text index, file objects,
+	// backlinks and relation options. The check runs as a process that reports its progress and can be canceled
+	Verify(ctx context.Context, spaceId string) (*Report, error)
+	// Repair starts a background process that applies the repair actions of the issues
+	Repair(ctx context.Context, spaceId string, issues []Issue) (processId string, err error)
+}
+
+type service struct {
+	spaceService      space.Service
+	objectStore       objectstore.ObjectStore
+	fulltext          ftsearch.FTSearch
+	sbtProvider       typeprovider.SmartBlockTypeProvider
+	picker            cache.ObjectGetter
+	indexer           smartblock.Indexer
+	fileObjectService fileobject.Service
+	formatFetcher     relationutils.RelationFormatFetcher
+	processService    process.Service
+	backlinksWatcher  backlinks.UpdateWatcher
+
+	componentCtx       context.Context
+	componentCtxCancel context.CancelFunc
+}
+
+func New() Service {
+	return &service{}
+}
+
+func (s *service) Init(a *app.App) error {
+	s.spaceService = app.MustComponent[space.Service](a)
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	s.fulltext = app.MustComponent[ftsearch.FTSearch](a)
+	s.sbtProvider = app.MustComponent[typeprovider.SmartBlockTypeProvider](a)
+	s.picker = app.MustComponent[cache.ObjectGetter](a)
+	s.indexer = app.MustComponent[smartblock.Indexer](a)
+	s.fileObjectService = app.MustComponent[fileobject.Service](a)
+	s.formatFetcher = app.MustComponent[relationutils.RelationFormatFetcher](a)
+	s.processService = app.MustComponent[process.Service](a)
+	s.backlinksWatcher = app.MustComponent[backlinks.UpdateWatcher](a)
+	s.componentCtx, s.componentCtxCancel = context.WithCancel(context.Background())
+	return nil
+}
+
+func (s *service) Name() string {
+	return CName
+}
+
+func (s *service) Run(_ context.Context) error {
+	return nil
+}
+
+func (s *service) Close(_ context.Context) error {
+	if s.componentCtxCancel != nil {
+		s.componentCtxCancel()
+	}
+	return nil
+}
+
+func (s *service) Verify(ctx context.Context, spaceId string) (*Report, error) {
+	if spaceId == "" {
+		return nil, ErrEmptySpaceId
+	}
+	spc, err := s.spaceService.Get(ctx, spaceId)
+	if err != nil {
+		return nil, fmt.Errorf("get space: %w", err)
+	}
+	storedIds := spc.StoredIds()
+	idsByType, err := s.sbtProvider.PartitionIDsByType(spaceId, storedIds)
+	if err != nil {
+		return nil, fmt.Errorf("get smartblock types: %w", err)
+	}
+
+	v := newVerifier(spaceId, s.objectStore, s.storedState(spc), storedIds, idsByType, spc.DerivedIDs())
+	// the full-text index isn't maintained for the tech space and the marketplace
+	if spaceId != s.spaceService.TechSpaceId() && spaceId != addr.AnytypeMarketplaceWorkspace {
+		v.fulltext = s.fulltext
+	}
+
+	progress := process.NewProgress(&pb.ModelProcessMessageOfSpaceVerify{SpaceVerify: &pb.ModelProcessSpaceVerify{}})
+	if err = s.processService.Add(progress); err != nil {
+		return nil, fmt.Errorf("add process: %w", err)
+	}
+	ctx, cancel := s.processContext(ctx, progress)
+	defer cancel()
+	// backlinks are compared with the index, so the accumulated updates are applied first
+	s.backlinksWatcher.FlushUpdates()
+	report, err := v.verify(ctx, progress)
+	if err != nil && ctx.Err() != nil {
+		err = ErrCanceled
+	}
+	progress.Finish(err)
+	return report, err
+}
+
+// storedState builds the state of the object from its stored tree, without opening the object
+func (s *service) storedState(spc clientspace.Space) stateBuilder {
+	return func(ctx context.Context, id string) (*state.State, error) {
+		tree, err := spc.TreeBuilder().BuildHistoryTree(ctx, id, objecttreebuilder.HistoryTreeOpts{})
+		if err != nil {
+			return nil, fmt.Errorf("build tree: %w", err)
+		}
+		st, _, _, err := sourceimpl.BuildState(spc.Id(), nil, tree, true)
+		if err != nil {
+			return nil, fmt.Errorf("build state: %w", err)
+		}
+		return st, nil
+	}
+}
+
+// processContext returns a context that is canceled with the parent context, on the cancel of the process
+// or on the close of the component
+func (s *service) processContext(parent context.Context, progress process.Progress) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(parent)
+	go func() {
+		select {
+		case <-progress.Canceled():
+		case <-s.componentCtx.Done():
+		case <-ctx.Done():
+		}
+		cancel()
+	}()
+	return ctx, cancel
+}
+
+func (s *service) Repair(ctx context.Context, spaceId string, issues []Issue) (processId string, err error) {
+	if spaceId == "" {
+		return "", ErrEmptySpaceId
+	}
+	issues = repairableIssues(issues)
+	if len(issues) == 0 {
+		return "", ErrNothingToRepair
+	}
+	if _, err = s.spaceService.Get(ctx, spaceId); err != nil {
+		return "", fmt.Errorf("get space: %w", err)
+	}
+
+	progress := process.NewProgress(&pb.ModelProcessMessageOfSpaceRepair{SpaceRepair: &pb.ModelProcessSpaceRepair{}})
+	if err = s.processService.Add(progress); err != nil {
+		return "", fmt.Errorf("add process: %w", err)
+	}
+	go func() {
+		progress.Finish(s.repair(spaceId, issues, progress))
+	}()
+	return progress.Id(), nil
+}
+
+func (s *service) repair(spaceId string, issues []Issue, progress process.Progress) error {
+	ctx, cancel := s.processContext(s.componentCtx, progress)
+	defer cancel()
+
+	progress.SetTotal(int64(len(issues)))
+	progress.SetProgressMessage("repairing space")
+	var errs []error
+	for _, issue := range issues {
+		if ctx.Err() != nil {
+			return ErrCanceled
+		}
+		if err := s.repairIssue(ctx, spaceId, issue); err != nil {
+			if ctx.Err() != nil {
+				return ErrCanceled
+			}
+			log.Warn("repair issue", zap.String("objectId", issue.ObjectId), zap.String("targetId", issue.TargetId), zap.Error(err))
+			errs = append(errs, fmt.Errorf("repair %s: %w", issue.ObjectId, err))
+		}
+		progress.AddDone(1)
+	}
+	return errors.Join(errs...)
+}
+
+func (s *service) repairIssue(ctx context.Context, spaceId string, issue Issue) error {
+	switch issue.RepairAction {
+	case RepairReindex:
+		return s.reindex(ctx, spaceId, issue.ObjectId)
+	case RepairRecreateFileObject:
+		return s.recreateFileObject(ctx, spaceId, issue)
+	case RepairDropDanglingLink:
+		return s.dropDanglingLink(ctx, spaceId, issue)
+	default:
+		return errUnexpectedAction
+	}
+}
+
+// repairableIssues returns the issues with a repair action, without issues that would repeat the same repair
+func repairableIssues(issues []Issue) []Issue {
+	type repairKey struct {
+		action   RepairAction
+		objectId string
+		targetId string
+	}
+	seen := make(map[repairKey]struct{}, len(issues))
+	result := make([]Issue, 0, len(issues))
+	for _, issue := range issues {
+		if issue.RepairAction == RepairNone || issue.ObjectId == "" {
+			continue
+		}
+		key := repairKey{action: issue.RepairAction, objectId: issue.ObjectId, targetId: issue.TargetId}
+		// the object is reindexed as a whole
+		if issue.RepairAction == RepairReindex {
+			key.targetId = ""
+		}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		result = append(result, issue)
+	}
+	return result
+}
@@ -0,0 +1,397 @@
+package spaceintegrity
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/anyproto/any-store/anyenc"
+	"go.uber.org/zap"
+
+	"github.com/anyproto/anytype-heart/core/block/backlinks"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/file"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	coresb "github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/ftsearch"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore/spaceindex"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/pkg/lib/threads"
+)
+
+// stateBuilder returns the state of the object without opening it
+type stateBuilder func(ctx context.Context, id string) (*state.State, error)
+
+// virtualIdPrefix is the prefix of bundled and virtual objects, e.g. dates, that have no tree in the space
+const virtualIdPrefix = "_"
+
+type verifier struct {
+	spaceId     string
+	objectStore objectstore.ObjectStore
+	store       spaceindex.Store
+	buildState  stateBuilder
+	// fulltext is nil for spaces without a full-text index
+	fulltext ftsearch.FTSearch
+
+	storedIds  []string
+	stored     map[string]struct{}
+	typeById   map[string]coresb.SmartBlockType
+	derivedIds threads.DerivedSmartblockIds
+	records    map[string]*domain.Details
+
+	// missingFileTargets are the targets already reported as missing file objects by object id
+	missingFileTargets map[string]map[string]struct{}
+	issues             []Issue
+}
+
+func newVerifier(
+	spaceId string,
+	objectStore objectstore.ObjectStore,
+	buildState stateBuilder,
+	storedIds []string,
+	idsByType map[coresb.SmartBlockType][]string,
+	derivedIds threads.DerivedSmartblockIds,
+) *verifier {
+	v := &verifier{
+		spaceId:            spaceId,
+		objectStore:        objectStore,
+		store:              objectStore.SpaceIndex(spaceId),
+		buildState:         buildState,
+		storedIds:          storedIds,
+		stored:             make(map[string]struct{}, len(storedIds)),
+		typeById:           make(map[string]coresb.SmartBlockType, len(storedIds)),
+		derivedIds:         derivedIds,
+		missingFileTargets: map[string]map[string]struct{}{},
+	}
+	for _, id := range storedIds {
+		v.stored[id] = struct{}{}
+	}
+	for sbType, ids := range idsByType {
+		for _, id := range ids {
+			v.typeById[id] = sbType
+		}
+	}
+	return v
+}
+
+func (v *verifier) verify(ctx context.Context, progress process.Progress) (*Report, error) {
+	checks := []struct {
+		message string
+		check   func(ctx context.Context) error
+	}{
+		{"checking index", v.checkIndex},
+		{"checking full-text index", v.checkFulltext},
+		{"checking file blocks", v.checkFileBlocks},
+		{"checking links", v.checkLinks},
+		{"checking relation options", v.checkRelationOptions},
+	}
+	progress.SetTotal(int64(len(checks)))
+	progress.SetProgressMessage("loading index")
+	if err := v.loadRecords(); err != nil {
+		return nil, err
+	}
+	for _, c := range checks {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		progress.SetProgressMessage(c.message)
+		if err := c.check(ctx); err != nil {
+			return nil, err
+		}
+		progress.AddDone(1)
+	}
+	slices.SortFunc(v.issues, func(a, b Issue) int {
+		if a.Category != b.Category {
+			return int(a.Category - b.Category)
+		}
+		if c := strings.Compare(a.ObjectId, b.ObjectId); c != 0 {
+			return c
+		}
+		return strings.Compare(a.TargetId, b.TargetId)
+	})
+	return &Report{
+		SpaceId:        v.spaceId,
+		ObjectsChecked: len(v.storedIds),
+		Issues:         v.issues,
+	}, nil
+}
+
+func (v *verifier) loadRecords() error {
+	v.records = map[string]*domain.Details{}
+	err := v.store.IterateAll(func(doc *anyenc.Value) error {
+		details, err := domain.NewDetailsFromAnyEnc(doc)
+		if err != nil {
+			return err
+		}
+		v.records[details.GetString(bundle.RelationKeyId)] = details
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("load records: %w", err)
+	}
+	return nil
+}
+
+func (v *verifier) addIssue(issue Issue) {
+	v.issues = append(v.issues, issue)
+}
+
+func (v *verifier) isDeleted(id string) bool {
+	details, ok := v.records[id]
+	return ok && details.GetBool(bundle.RelationKeyIsDeleted)
+}
+
+// checkIndex compares the stored trees with the records of the object store
+func (v *verifier) checkIndex(_ context.Context) error {
+	for _, id := range v.storedIds {
+		if _, indexDetails, _ := v.typeById[id].Indexable(); !indexDetails {
+			continue
+		}
+		if _, ok := v.records[id]; !ok {
+			v.addIssue(Issue{
+				Category:     CategoryMissingIndex,
+				ObjectId:     id,
+				Description:  "object is stored in the space, but it is missing in the object store",
+				RepairAction: RepairReindex,
+			})
+		}
+	}
+	for id := range v.records {
+		if _, ok := v.stored[id]; ok || strings.HasPrefix(id, virtualIdPrefix) || v.isDeleted(id) {
+			continue
+		}
+		v.addIssue(Issue{
+			Category:    CategoryStaleIndex,
+			ObjectId:    id,
+			Description: "object is in the object store, but its tree is missing in the space",
+		})
+	}
+	return nil
+}
+
+// checkFulltext reports named objects that are neither in the full-text index nor waiting in its queue
+func (v *verifier) checkFulltext(ctx context.Context) error {
+	if v.fulltext == nil {
+		return nil
+	}
+	queued, err := v.objectStore.ListIdsFromFullTextQueue([]string{v.spaceId}, 0)
+	if err != nil {
+		return fmt.Errorf("list full-text queue: %w", err)
+	}
+	inQueue := make(map[string]struct{}, len(queued))
+	for _, id := range queued {
+		inQueue[id.ObjectID] = struct{}{}
+	}
+
+	for _, id := range v.storedIds {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if !v.isFulltextIndexable(id) {
+			continue
+		}
+		details := v.records[id]
+		if details.GetString(bundle.RelationKeyName) == "" {
+			continue
+		}
+		if _, ok := inQueue[id]; ok {
+			continue
+		}
+		var found bool
+		err = v.fulltext.Iterate(id, nil, func(_ *ftsearch.SearchDoc) bool {
+			found = true
+			return false
+		})
+		if err != nil {
+			return fmt.Errorf("iterate full-text docs of %s: %w", id, err)
+		}
+		if !found {
+			v.addIssue(Issue{
+				Category:     CategoryMissingFulltext,
+				ObjectId:     id,
+				Description:  "object has a name, but it is missing in the full-text index",
+				RepairAction: RepairReindex,
+			})
+		}
+	}
+	return nil
+}
+
+// isFulltextIndexable reports whether the object is expected to have full-text docs and its blocks are worth checking
+func (v *verifier) isFulltextIndexable(id string) bool {
+	if indexFulltext, _, _ := v.typeById[id].Indexable(); !indexFulltext {
+		return false
+	}
+	_, ok := v.records[id]
+	return ok && !v.isDeleted(id)
+}
+
+// checkFileBlocks reads objects from their trees to find file blocks pointing to missing file objects
+func (v *verifier) checkFileBlocks(ctx context.Context) error {
+	for _, id := range v.storedIds {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if !v.isFulltextIndexable(id) {
+			continue
+		}
+		st, err := v.buildState(ctx, id)
+		if err != nil {
+			// an object that can't be built is already reported by other checks or will be after a reindex
+			log.Warn("verify file blocks", zap.String("objectId", id), zap.Error(err))
+			continue
+		}
+		_ = st.Iterate(func(b simple.Block) bool {
+			if fb, ok := b.(file.Block); ok {
+				v.checkFileBlock(id, fb)
+			}
+			return true
+		})
+	}
+	return nil
+}
+
+func (v *verifier) checkFileBlock(objectId string, fb file.Block) {
+	target := fb.TargetObjectId()
+	if target == "" {
+		return
+	}
+	if v.exists(target) {
+		return
+	}
+	var fileId string
+	if hash := fb.Model().GetFile().GetHash(); domain.IsFileId(hash) {
+		fileId = hash
+	} else if domain.IsFileId(target) {
+		fileId = target
+	}
+
+	issue := Issue{
+		Category:    CategoryMissingFileObject,
+		ObjectId:    objectId,
+		TargetId:    target,
+		FileId:      fileId,
+		Description: fmt.Sprintf("file block %s points to a missing file object", fb.Model().Id),
+	}
+	if fileId != "" {
+		issue.RepairAction = RepairRecreateFileObject
+	} else {
+		issue.RepairAction = RepairDropDanglingLink
+	}
+
+	targets := v.missingFileTargets[objectId]
+	if targets == nil {
+		targets = map[string]struct{}{}
+		v.missingFileTargets[objectId] = targets
+	}
+	if _, ok := targets[target]; ok {
+		return
+	}
+	targets[target] = struct{}{}
+	v.addIssue(issue)
+}
+
+// checkLinks reports links to missing objects and links that are missing in the backlinks of their targets
+func (v *verifier) checkLinks(ctx context.Context) error {
+	for id := range v.records {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if v.isDeleted(id) {
+			continue
+		}
+		targets, err := v.store.GetOutboundLinksById(id)
+		if err != nil {
+			return fmt.Errorf("get outbound links of %s: %w", id, err)
+		}
+		for _, target := range targets {
+			if target == id {
+				continue
+			}
+			if !v.exists(target) {
+				if _, ok := v.missingFileTargets[id][target]; ok {
+					continue
+				}
+				v.addIssue(Issue{
+					Category:     CategoryDanglingLink,
+					ObjectId:     id,
+					TargetId:     target,
+					Description:  "object links to a missing object",
+					RepairAction: RepairDropDanglingLink,
+				})
+				continue
+			}
+			v.checkBacklink(id, target)
+		}
+	}
+	return nil
+}
+
+func (v *verifier) checkBacklink(source, target string) {
+	targetDetails, ok := v.records[target]
+	if !ok || v.isDeleted(target) {
+		return
+	}
+	if !backlinks.ShouldIndexBacklinks(v.derivedIds, source) || !backlinks.ShouldIndexBacklinks(v.derivedIds, target) {
+		return
+	}
+	if slices.Contains(targetDetails.GetStringList(bundle.RelationKeyBacklinks), source) {
+		return
+	}
+	v.addIssue(Issue{
+		Category:     CategoryMissingBacklink,
+		ObjectId:     source,
+		TargetId:     target,
+		Description:  "link target doesn't list the object in its backlinks",
+		RepairAction: RepairReindex,
+	})
+}
+
+// exists reports whether the link target is a virtual object or exists in this or another space
+func (v *verifier) exists(id string) bool {
+	if strings.HasPrefix(id, virtualIdPrefix) {
+		return true
+	}
+	if _, ok := v.records[id]; ok {
+		return true
+	}
+	if _, ok := v.stored[id]; ok {
+		return true
+	}
+	return isIndexed(v.objectStore, id)
+}
+
+// isIndexed reports whether the object is in the object store of any space
+func isIndexed(objectStore objectstore.ObjectStore, id string) bool {
+	spaceId, err := objectStore.GetSpaceId(id)
+	if err != nil || spaceId == "" {
+		return false
+	}
+	existing, err := objectStore.SpaceIndex(spaceId).HasIds([]string{id})
+	return err == nil && len(existing) > 0
+}
+
+// checkRelationOptions reports options of relations that are missing in the space
+func (v *verifier) checkRelationOptions(_ context.Context) error {
+	for id, details := range v.records {
+		if details.GetInt64(bundle.RelationKeyResolvedLayout) != int64(model.ObjectType_relationOption) || v.isDeleted(id) {
+			continue
+		}
+		relationKey := details.GetString(bundle.RelationKeyRelationKey)
+		if _, err := v.store.GetRelationByKey(relationKey); err == nil {
+			continue
+		}
+		v.addIssue(Issue{
+			Category:    CategoryOrphanRelationOption,
+			ObjectId:    id,
+			TargetId:    relationKey,
+			Description: fmt.Sprintf("option belongs to the missing relation %q", relationKey),
+		})
+	}
+	return nil
+}
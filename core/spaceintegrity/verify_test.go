@@ -0,0 +1,237 @@
+package spaceintegrity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock/smarttest"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/file"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	coresb "github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore/spaceindex"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/pkg/lib/threads"
+)
+
+const (
+	spaceId = "space1"
+	fileId  = "bafybeihdwdcefgh4dqkjv67uzcmw7ojee6xedzdetojuzjevtenxquvyku"
+)
+
+type fixture struct {
+	store     *objectstore.StoreFixture
+	objects   map[string]*smarttest.SmartTest
+	storedIds []string
+	idsByType map[coresb.SmartBlockType][]string
+}
+
+func newFixture(t *testing.T) *fixture {
+	return &fixture{
+		store:     objectstore.NewStoreFixture(t),
+		objects:   map[string]*smarttest.SmartTest{},
+		idsByType: map[coresb.SmartBlockType][]string{},
+	}
+}
+
+func (fx *fixture) buildState(_ context.Context, id string) (*state.State, error) {
+	if sb, ok := fx.objects[id]; ok {
+		return sb.NewState(), nil
+	}
+	return smarttest.New(id).NewState(), nil
+}
+
+// addStored adds object trees of the type to the space
+func (fx *fixture) addStored(sbType coresb.SmartBlockType, ids ...string) {
+	fx.storedIds = append(fx.storedIds, ids...)
+	fx.idsByType[sbType] = append(fx.idsByType[sbType], ids...)
+}
+
+func (fx *fixture) verify(t *testing.T) *Report {
+	v := newVerifier(spaceId, fx.store, fx.buildState, fx.storedIds, fx.idsByType, threads.DerivedSmartblockIds{})
+	report, err := v.verify(context.Background(), process.NewNoOp())
+	require.NoError(t, err)
+	return report
+}
+
+func object(id string, name string) spaceindex.TestObject {
+	return spaceindex.TestObject{
+		bundle.RelationKeyId:   domain.String(id),
+		bundle.RelationKeyName: domain.String(name),
+	}
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	t.Run("consistent space", func(t *testing.T) {
+		fx := newFixture(t)
+		fx.addStored(coresb.SmartBlockTypePage, "page1", "page2")
+		fx.store.AddObjects(t, spaceId, []spaceindex.TestObject{
+			object("page1", "Page 1"),
+			{
+				bundle.RelationKeyId:        domain.String("page2"),
+				bundle.RelationKeyBacklinks: domain.StringList([]string{"page1"}),
+			},
+		})
+		require.NoError(t, fx.store.SpaceIndex(spaceId).UpdateObjectLinks(context.Background(), "page1", []string{"page2", "_date_2024-01-01"}))
+
+		report := fx.verify(t)
+
+		assert.Equal(t, spaceId, report.SpaceId)
+		assert.Equal(t, 2, report.ObjectsChecked)
+		assert.Empty(t, report.Issues)
+	})
+
+	t.Run("index mismatch", func(t *testing.T) {
+		fx := newFixture(t)
+		fx.addStored(coresb.SmartBlockTypePage, "page1", "page2")
+		fx.addStored(coresb.SmartBlockTypeDate, "date1")
+		fx.store.AddObjects(t, spaceId, []spaceindex.TestObject{
+			object("page1", ""),
+			object("removed", ""),
+			{
+				bundle.RelationKeyId:        domain.String("deleted"),
+				bundle.RelationKeyIsDeleted: domain.Bool(true),
+			},
+		})
+
+		report := fx.verify(t)
+
+		assert.Equal(t, []Issue{
+			{
+				Category:     CategoryMissingIndex,
+				ObjectId:     "page2",
+				Description:  "object is stored in the space, but it is missing in the object store",
+				RepairAction: RepairReindex,
+			},
+			{
+				Category:    CategoryStaleIndex,
+				ObjectId:    "removed",
+				Description: "object is in the object store, but its tree is missing in the space",
+			},
+		}, report.Issues)
+	})
+
+	t.Run("dangling link and missing backlink", func(t *testing.T) {
+		fx := newFixture(t)
+		fx.addStored(coresb.SmartBlockTypePage, "page1", "page2")
+		fx.store.AddObjects(t, spaceId, []spaceindex.TestObject{
+			object("page1", ""),
+			object("page2", ""),
+		})
+		require.NoError(t, fx.store.SpaceIndex(spaceId).UpdateObjectLinks(context.Background(), "page1", []string{"page2", "missing"}))
+
+		report := fx.verify(t)
+
+		require.Len(t, report.Issues, 2)
+		assert.Equal(t, CategoryDanglingLink, report.Issues[0].Category)
+		assert.Equal(t, "page1", report.Issues[0].ObjectId)
+		assert.Equal(t, "missing", report.Issues[0].TargetId)
+		assert.Equal(t, RepairDropDanglingLink, report.Issues[0].RepairAction)
+		assert.Equal(t, CategoryMissingBacklink, report.Issues[1].Category)
+		assert.Equal(t, "page1", report.Issues[1].ObjectId)
+		assert.Equal(t, "page2", report.Issues[1].TargetId)
+		assert.Equal(t, RepairReindex, report.Issues[1].RepairAction)
+	})
+
+	t.Run("missing file object", func(t *testing.T) {
+		fx := newFixture(t)
+		fx.addStored(coresb.SmartBlockTypePage, "page1")
+		fx.store.AddObjects(t, spaceId, []spaceindex.TestObject{object("page1", "")})
+		require.NoError(t, fx.store.SpaceIndex(spaceId).UpdateObjectLinks(context.Background(), "page1", []string{"fileObject1", "fileObject2"}))
+
+		sb := smarttest.New("page1")
+		sb.AddBlock(simple.New(&model.Block{Id: "page1", ChildrenIds: []string{"file1", "file2"}}))
+		sb.AddBlock(file.NewFile(&model.Block{Id: "file1", Content: &model.BlockContentOfFile{File: &model.BlockContentFile{
+			Hash:           fileId,
+			TargetObjectId: "fileObject1",
+		}}}))
+		sb.AddBlock(file.NewFile(&model.Block{Id: "file2", Content: &model.BlockContentOfFile{File: &model.BlockContentFile{
+			TargetObjectId: "fileObject2",
+		}}}))
+		fx.objects["page1"] = sb
+
+		report := fx.verify(t)
+
+		// links to the missing file objects are not reported as dangling links twice
+		require.Len(t, report.Issues, 2)
+		assert.Equal(t, Issue{
+			Category:     CategoryMissingFileObject,
+			ObjectId:     "page1",
+			TargetId:     "fileObject1",
+			FileId:       fileId,
+			Description:  "file block file1 points to a missing file object",
+			RepairAction: RepairRecreateFileObject,
+		}, report.Issues[0])
+		assert.Equal(t, "fileObject2", report.Issues[1].TargetId)
+		assert.Empty(t, report.Issues[1].FileId)
+		assert.Equal(t, RepairDropDanglingLink, report.Issues[1].RepairAction)
+	})
+
+	t.Run("orphan relation option", func(t *testing.T) {
+		fx := newFixture(t)
+		fx.addStored(coresb.SmartBlockTypeRelation, "rel1")
+		fx.addStored(coresb.SmartBlockTypeRelationOption, "opt1", "opt2")
+		fx.store.AddObjects(t, spaceId, []spaceindex.TestObject{
+			{
+				bundle.RelationKeyId:             domain.String("rel1"),
+				bundle.RelationKeyRelationKey:    domain.String("status"),
+				bundle.RelationKeyResolvedLayout: domain.Int64(model.ObjectType_relation),
+			},
+			{
+				bundle.RelationKeyId:             domain.String("opt1"),
+				bundle.RelationKeyRelationKey:    domain.String("status"),
+				bundle.RelationKeyResolvedLayout: domain.Int64(model.ObjectType_relationOption),
+			},
+			{
+				bundle.RelationKeyId:             domain.String("opt2"),
+				bundle.RelationKeyRelationKey:    domain.String("removedRelation"),
+				bundle.RelationKeyResolvedLayout: domain.Int64(model.ObjectType_relationOption),
+			},
+		})
+
+		report := fx.verify(t)
+
+		assert.Equal(t, []Issue{{
+			Category:    CategoryOrphanRelationOption,
+			ObjectId:    "opt2",
+			TargetId:    "removedRelation",
+			Description: `option belongs to the missing relation "removedRelation"`,
+		}}, report.Issues)
+	})
+
+	t.Run("canceled verification", func(t *testing.T) {
+		fx := newFixture(t)
+		fx.addStored(coresb.SmartBlockTypePage, "page1")
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		v := newVerifier(spaceId, fx.store, fx.buildState, fx.storedIds, fx.idsByType, threads.DerivedSmartblockIds{})
+		_, err := v.verify(ctx, process.NewNoOp())
+
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestRepairableIssues(t *testing.T) {
+	issues := repairableIssues([]Issue{
+		{Category: CategoryStaleIndex, ObjectId: "obj1"},
+		{Category: CategoryMissingIndex, ObjectId: "obj1", RepairAction: RepairReindex},
+		{Category: CategoryMissingBacklink, ObjectId: "obj1", TargetId: "obj2", RepairAction: RepairReindex},
+		{Category: CategoryDanglingLink, ObjectId: "obj1", TargetId: "obj3", RepairAction: RepairDropDanglingLink},
+		{Category: CategoryDanglingLink, ObjectId: "obj1", TargetId: "obj3", RepairAction: RepairDropDanglingLink},
+		{Category: CategoryDanglingLink, ObjectId: "obj1", TargetId: "obj4", RepairAction: RepairDropDanglingLink},
+	})
+
+	assert.Equal(t, []Issue{
+		{Category: CategoryMissingIndex, ObjectId: "obj1", RepairAction: RepairReindex},
+		{Category: CategoryDanglingLink, ObjectId: "obj1", TargetId: "obj3", RepairAction: RepairDropDanglingLink},
+		{Category: CategoryDanglingLink, ObjectId: "obj1", TargetId: "obj4", RepairAction: RepairDropDanglingLink},
+	}, issues)
+}
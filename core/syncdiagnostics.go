@@ -0,0 +1,73 @@
+package core
+
+import (
+	"context"
+
+	"github.com/anyproto/anytype-heart/core/syncstatus/syncdiagnostics"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func (mw *Middleware) ObjectSyncDiagnostics(cctx context.Context, req *pb.RpcObjectSyncDiagnosticsRequest) *pb.RpcObjectSyncDiagnosticsResponse {
+	d, err := mustService[syncdiagnostics.Service](mw).Diagnose(cctx, req.SpaceId, req.ObjectId)
+	code := mapErrorCode(err,
+		errToCode(syncdiagnostics.ErrEmptySpaceId, pb.RpcObjectSyncDiagnosticsResponseError_BAD_INPUT),
+		errToCode(syncdiagnostics.ErrEmptyObjectId, pb.RpcObjectSyncDiagnosticsResponseError_BAD_INPUT),
+	)
+	resp := &pb.RpcObjectSyncDiagnosticsResponse{
+		Error: &pb.RpcObjectSyncDiagnosticsResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+	if err != nil {
+		return resp
+	}
+	files := make([]*pb.RpcObjectSyncDiagnosticsFile, 0, len(d.Files))
+	for _, file := range d.Files {
+		var scheduledAt int64
+		if !file.ScheduledAt.IsZero() {
+			scheduledAt = file.ScheduledAt.Unix()
+		}
+		files = append(files, &pb.RpcObjectSyncDiagnosticsFile{
+			ObjectId:      file.ObjectId,
+			FileId:        file.FileId,
+			State:         pb.RpcObjectSyncDiagnosticsFileState(file.State),
+			ScheduledAt:   scheduledAt,
+			BytesToUpload: int64(file.BytesToUpload),
+		})
+	}
+	resp.Diagnostics = &pb.RpcObjectSyncDiagnosticsDiagnostics{
+		SpaceId:           d.SpaceId,
+		ObjectId:          d.ObjectId,
+		LocalHeads:        d.LocalHeads,
+		NodeHeads:         d.NodeHeads,
+		PendingHeads:      d.PendingHeads,
+		PendingChanges:    int32(d.PendingChanges),
+		SyncStatus:        model.SyncStatus(d.SyncStatus),
+		SyncError:         model.SyncError(d.SyncError),
+		SyncDate:          d.SyncDate,
+		Files:             files,
+		NodeStatus:        pb.RpcObjectSyncDiagnosticsNodeStatus(d.NodeStatus),
+		P2PStatus:         d.P2PStatus.ToPb(),
+		P2PDevicesCounter: d.P2PDevicesCounter,
+		SpaceSyncStatus:   d.SpaceSyncStatus,
+		SpaceSyncError:    d.SpaceSyncError,
+		QuotaExceeded:     d.QuotaExceeded,
+	}
+	return resp
+}
+
+func (mw *Middleware) ObjectForceResync(cctx context.Context, req *pb.RpcObjectForceResyncRequest) *pb.RpcObjectForceResyncResponse {
+	err := mustService[syncdiagnostics.Service](mw).ForceResync(cctx, req.SpaceId, req.ObjectId)
+	code := mapErrorCode(err,
+		errToCode(syncdiagnostics.ErrEmptySpaceId, pb.RpcObjectForceResyncResponseError_BAD_INPUT),
+		errToCode(syncdiagnostics.ErrEmptyObjectId, pb.RpcObjectForceResyncResponseError_BAD_INPUT),
+	)
+	return &pb.RpcObjectForceResyncResponse{
+		Error: &pb.RpcObjectForceResyncResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
@@ -42,6 +42,16 @@ type StatusUpdater interface {
 type StatusService interface {
 	app.ComponentRunnable
 	StatusUpdater
+	TreeStatus(treeId string) TreeStatus
+}
+
+// TreeStatus is the sync state of a tree as seen from the sync protocol since the space was loaded
+type TreeStatus struct {
+	Status SyncStatus
+	// PendingHeads are the local heads that are not confirmed by a responsible node yet
+	PendingHeads []string
+	// NodeHeads are the last heads received from a responsible node, nil if nothing was received
+	NodeHeads []string
 }
 
 type treeStatus struct {
@@ -61,6 +71,7 @@ type syncStatusService struct {
 	spaceSettingsId string
 	tempSynced      map[string]struct{}
 	treeHeads       map[string]treeHeadsEntry
+	nodeHeads       map[string][]string
 
 	updateIntervalSecs int
 	updateTimeout      time.Duration
@@ -74,6 +85,7 @@ func NewSyncStatusService() StatusService {
 	return &syncStatusService{
 		tempSynced: map[string]struct{}{},
 		treeHeads:  map[string]treeHeadsEntry{},
+		nodeHeads:  map[string][]string{},
 	}
 }
 
@@ -111,6 +123,7 @@ func (s *syncStatusService) ObjectReceive(senderId, treeId string, heads []strin
 		s.tempSynced[treeId] = struct{}{}
 		return
 	}
+	s.nodeHeads[treeId] = slice.Copy(heads)
 
 	s.updateDetails(treeId, domain.ObjectSyncStatusSynced)
 }
@@ -124,6 +137,7 @@ func (s *syncStatusService) HeadsApply(senderId, treeId string, heads []string,
 		}
 		return
 	}
+	s.nodeHeads[treeId] = slice.Copy(heads)
 	if !allAdded {
 		return
 	}
@@ -138,6 +152,7 @@ func (s *syncStatusService) HeadsApply(senderId, treeId string, heads []string,
 		}
 		if len(curTreeHeads.heads) == 0 {
 			curTreeHeads.syncStatus = StatusSynced
+			delete(s.nodeHeads, treeId)
 		}
 		s.treeHeads[treeId] = curTreeHeads
 	}
@@ -165,6 +180,12 @@ func (s *syncStatusService) RemoveAllExcept(senderId string, differentRemoteIds
 	defer s.Unlock()
 
 	slices.Sort(differentRemoteIds)
+	// heads of the trees that are the same on the node are not needed anymore
+	for treeId := range s.nodeHeads {
+		if _, found := slices.BinarySearch(differentRemoteIds, treeId); !found {
+			delete(s.nodeHeads, treeId)
+		}
+	}
 	for treeId, entry := range s.treeHeads {
 		if _, found := slices.BinarySearch(differentRemoteIds, treeId); !found {
 			if entry.syncStatus != StatusSynced {
@@ -181,6 +202,22 @@ func (s *syncStatusService) RemoveAllExcept(senderId string, differentRemoteIds
 	}
 }
 
+func (s *syncStatusService) TreeStatus(treeId string) TreeStatus {
+	s.Lock()
+	defer s.Unlock()
+	status := TreeStatus{
+		Status:    StatusUnknown,
+		NodeHeads: slices.Clone(s.nodeHeads[treeId]),
+	}
+	if entry, ok := s.treeHeads[treeId]; ok {
+		status.Status = entry.syncStatus
+		if entry.syncStatus != StatusSynced {
+			status.PendingHeads = slice.Copy(entry.heads)
+		}
+	}
+	return status
+}
+
 func (s *syncStatusService) Close(ctx context.Context) error {
 	return nil
 }
@@ -129,6 +129,16 @@ func TestSyncStatusService_RemoveAllExcept(t *testing.T) {
 
 		assert.Equal(t, StatusNotSynced, f.treeHeads["id"].syncStatus)
 	})
+	t.Run("node heads of same trees are pruned", func(t *testing.T) {
+		f := newFixture(t, "spaceId")
+		f.nodeHeads["same"] = []string{"head1"}
+		f.nodeHeads["different"] = []string{"head2"}
+
+		f.nodeConfService.EXPECT().NodeIds(f.spaceId).Return([]string{"peerId"})
+		f.RemoveAllExcept("peerId", []string{"different"})
+
+		assert.Equal(t, map[string][]string{"different": {"head2"}}, f.nodeHeads)
+	})
 	t.Run("sender not responsible", func(t *testing.T) {
 		f := newFixture(t, "spaceId")
 		f.treeHeads["id"] = treeHeadsEntry{syncStatus: StatusNotSynced, heads: []string{"heads"}}
@@ -159,6 +169,42 @@ func TestHeadsChange(t *testing.T) {
 
 }
 
+func TestTreeStatus(t *testing.T) {
+	t.Run("unknown tree", func(t *testing.T) {
+		fx := newFixture(t, "space1")
+
+		assert.Equal(t, TreeStatus{Status: StatusUnknown}, fx.TreeStatus("obj1"))
+	})
+	t.Run("pending heads and node heads", func(t *testing.T) {
+		fx := newFixture(t, "space1")
+		fx.syncDetailsUpdater.EXPECT().UpdateDetails("obj1", domain.ObjectSyncStatusSyncing, "space1")
+		fx.syncDetailsUpdater.EXPECT().UpdateDetails("obj1", domain.ObjectSyncStatusSynced, "space1")
+		fx.nodeConfService.EXPECT().NodeIds("space1").Return([]string{"node1"}).AnyTimes()
+
+		fx.HeadsChange("obj1", []string{"b", "a"})
+		fx.HeadsApply("node1", "obj1", []string{"a"}, true)
+
+		assert.Equal(t, TreeStatus{
+			Status:       StatusNotSynced,
+			PendingHeads: []string{"b"},
+			NodeHeads:    []string{"a"},
+		}, fx.TreeStatus("obj1"))
+
+		fx.HeadsApply("node1", "obj1", []string{"b"}, true)
+
+		// node heads of synced trees are pruned
+		assert.Equal(t, TreeStatus{Status: StatusSynced}, fx.TreeStatus("obj1"))
+	})
+	t.Run("heads from not responsible peer are ignored", func(t *testing.T) {
+		fx := newFixture(t, "space1")
+		fx.nodeConfService.EXPECT().NodeIds("space1").Return([]string{"node1"})
+
+		fx.ObjectReceive("peer1", "obj1", []string{"a"})
+
+		assert.Nil(t, fx.TreeStatus("obj1").NodeHeads)
+	})
+}
+
 type fixture struct {
 	*syncStatusService
 	nodeConfService    *mock_nodeconf.MockService
@@ -150,16 +150,8 @@ func (s *spaceSyncStatus) sendEventToSession(spaceId, token string) {
 		s.sendLocalOnlyEventToSession(spaceId, token)
 		return
 	}
-	params := syncParams{
-		bytesLeftPercentage: s.getBytesLeftPercentage(spaceId),
-		connectionStatus:    s.nodeStatus.GetNodeStatus(spaceId),
-		compatibility:       s.nodeConf.NetworkCompatibilityStatus(),
-		objectsSyncingCount: s.getObjectSyncingObjectsCount(spaceId, s.getMissingIds(spaceId)),
-		notSyncedFilesCount: s.getNotSyncedFilesCount(spaceId),
-		uploadingFilesCount: s.getUploadingFilesCount(spaceId),
-	}
 	s.eventSender.SendToSession(token, event.NewEventSingleMessage(spaceId, &pb.EventMessageValueOfSpaceSyncStatusUpdate{
-		SpaceSyncStatusUpdate: s.makeSyncEvent(spaceId, params),
+		SpaceSyncStatusUpdate: s.makeSyncEvent(spaceId, s.getSyncParams(spaceId)),
 	}))
 }
 
@@ -258,18 +250,32 @@ func (s *spaceSyncStatus) updateSpaceSyncStatus(spaceId string) {
 		s.sendLocalOnlyEvent(spaceId)
 		return
 	}
-	missingObjects := s.getMissingIds(spaceId)
-	params := syncParams{
+	s.broadcast(event.NewEventSingleMessage(spaceId, &pb.EventMessageValueOfSpaceSyncStatusUpdate{
+		SpaceSyncStatusUpdate: s.makeSyncEvent(spaceId, s.getSyncParams(spaceId)),
+	}))
+}
+
+// SpaceStatus calculates the current sync status of the space without sending it to clients
+func (s *spaceSyncStatus) SpaceStatus(spaceId string) *pb.EventSpaceSyncStatusUpdate {
+	if s.isLocal {
+		return &pb.EventSpaceSyncStatusUpdate{
+			Id:      spaceId,
+			Status:  pb.EventSpace_Offline,
+			Network: pb.EventSpace_LocalOnly,
+		}
+	}
+	return s.makeSyncEvent(spaceId, s.getSyncParams(spaceId))
+}
+
+func (s *spaceSyncStatus) getSyncParams(spaceId string) syncParams {
+	return syncParams{
 		bytesLeftPercentage: s.getBytesLeftPercentage(spaceId),
 		connectionStatus:    s.nodeStatus.GetNodeStatus(spaceId),
 		compatibility:       s.nodeConf.NetworkCompatibilityStatus(),
-		objectsSyncingCount: s.getObjectSyncingObjectsCount(spaceId, missingObjects),
+		objectsSyncingCount: s.getObjectSyncingObjectsCount(spaceId, s.getMissingIds(spaceId)),
 		notSyncedFilesCount: s.getNotSyncedFilesCount(spaceId),
 		uploadingFilesCount: s.getUploadingFilesCount(spaceId),
 	}
-	s.broadcast(event.NewEventSingleMessage(spaceId, &pb.EventMessageValueOfSpaceSyncStatusUpdate{
-		SpaceSyncStatusUpdate: s.makeSyncEvent(spaceId, params),
-	}))
 }
 
 func (s *spaceSyncStatus) Close(ctx context.Context) (err error) {
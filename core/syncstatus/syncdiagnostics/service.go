@@ -0,0 +1,318 @@
+package syncdiagnostics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/anyproto/any-sync/commonspace/object/tree/objecttree"
+	"go.uber.org/zap"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/files/filesync"
+	"github.com/anyproto/anytype-heart/core/files/filesync/filequeue"
+	"github.com/anyproto/anytype-heart/core/peerstatus"
+	"github.com/anyproto/anytype-heart/core/syncstatus/nodestatus"
+	"github.com/anyproto/anytype-heart/core/syncstatus/objectsyncstatus"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/space"
+	"github.com/anyproto/anytype-heart/space/clientspace"
+)
+
+const CName = "core.syncstatus.syncdiagnostics"
+
+var log = logging.Logger(CName).Desugar()
+
+var (
+	ErrEmptySpaceId  = errors.New("space id is empty")
+	ErrEmptyObjectId = errors.New("object id is empty")
+)
+
+// FileState is the state of a file in the upload queue
+type FileState int32
+
+const (
+	FileStateNotQueued FileState = iota
+	FileStatePendingUpload
+	FileStateUploading
+	// FileStateLimited means that the file doesn't fit into the space limits
+	FileStateLimited
+	FileStatePendingDeletion
+	FileStateDone
+	FileStateDeleted
+)
+
+type File struct {
+	ObjectId      string
+	FileId        string
+	State         FileState
+	ScheduledAt   time.Time
+	BytesToUpload int
+}
+
+type Diagnostics struct {
+	SpaceId  string
+	ObjectId string
+
+	LocalHeads []string
+	// NodeHeads are the last heads received from a responsible node since the space was loaded
+	NodeHeads []string
+	// PendingHeads are the local heads that are not confirmed by a responsible node yet
+	PendingHeads []string
+	// PendingChanges is the count of local changes that the node doesn't have, -1 if unknown
+	PendingChanges int
+
+	SyncStatus domain.ObjectSyncStatus
+	SyncError  domain.SyncError
+	SyncDate   int64
+
+	// Files are the object itself, if it's a file, and the files it links to
+	Files []File
+
+	NodeStatus        nodestatus.ConnectionStatus
+	P2PStatus         peerstatus.Status
+	P2PDevicesCounter int64
+	SpaceSyncStatus   pb.EventSpaceStatus
+	SpaceSyncError    pb.EventSpaceSyncError
+	QuotaExceeded     bool
+}
+
+type Service interface {
+	app.Component
+
+	// Diagnose collects the sync state of the object: heads, pending changes, related file uploads, connectivity and errors
+	Diagnose(ctx context.Context, spaceId, objectId string) (*Diagnostics, error)
+	// ForceResync requests the object tree from the nodes and reschedules pending uploads of its files
+	ForceResync(ctx context.Context, spaceId, objectId string) error
+}
+
+type fileQueue interface {
+	app.Component
+	QueueItem(objectId string) (filesync.FileInfo, error)
+	RetryUpload(objectId string) error
+}
+
+type spaceSyncStatus interface {
+	app.Component
+	SpaceStatus(spaceId string) *pb.EventSpaceSyncStatusUpdate
+	Refresh(spaceId string)
+}
+
+type treeStatusGetter interface {
+	TreeStatus(treeId string) objectsyncstatus.TreeStatus
+}
+
+type service struct {
+	spaceService    space.Service
+	objectStore     objectstore.ObjectStore
+	fileQueue       fileQueue
+	spaceSyncStatus spaceSyncStatus
+	nodeStatus      nodestatus.NodeStatus
+	peerStatus      peerstatus.PeerToPeerStatus
+}
+
+func New() Service {
+	return &service{}
+}
+
+func (s *service) Init(a *app.App) error {
+	s.spaceService = app.MustComponent[space.Service](a)
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	s.fileQueue = app.MustComponent[fileQueue](a)
+	s.spaceSyncStatus = app.MustComponent[spaceSyncStatus](a)
+	s.nodeStatus = app.MustComponent[nodestatus.NodeStatus](a)
+	s.peerStatus = app.MustComponent[peerstatus.PeerToPeerStatus](a)
+	return nil
+}
+
+func (s *service) Name() string {
+	return CName
+}
+
+func (s *service) Diagnose(ctx context.Context, spaceId, objectId string) (*Diagnostics, error) {
+	if err := validate(spaceId, objectId); err != nil {
+		return nil, err
+	}
+	spc, err := s.spaceService.Get(ctx, spaceId)
+	if err != nil {
+		return nil, fmt.Errorf("get space: %w", err)
+	}
+	d := &Diagnostics{
+		SpaceId:        spaceId,
+		ObjectId:       objectId,
+		PendingChanges: -1,
+		NodeStatus:     s.nodeStatus.GetNodeStatus(spaceId),
+	}
+	if err = s.fillHeads(ctx, spc, d); err != nil {
+		return nil, err
+	}
+
+	details, err := s.objectStore.SpaceIndex(spaceId).GetDetails(objectId)
+	if err != nil {
+		return nil, fmt.Errorf("get details: %w", err)
+	}
+	d.SyncStatus = domain.ObjectSyncStatus(details.GetInt64(bundle.RelationKeySyncStatus))
+	d.SyncError = domain.SyncError(details.GetInt64(bundle.RelationKeySyncError))
+	d.SyncDate = details.GetInt64(bundle.RelationKeySyncDate)
+
+	d.Files = s.files(spaceId, objectId, details)
+	for _, file := range d.Files {
+		if file.State == FileStateLimited {
+			d.QuotaExceeded = true
+		}
+	}
+
+	d.P2PStatus, d.P2PDevicesCounter = s.peerStatus.SpaceStatus(spaceId)
+	if status := s.spaceSyncStatus.SpaceStatus(spaceId); status != nil {
+		d.SpaceSyncStatus = status.Status
+		d.SpaceSyncError = status.Error
+		if status.Error == pb.EventSpace_StorageLimitExceed {
+			d.QuotaExceeded = true
+		}
+	}
+	return d, nil
+}
+
+func (s *service) fillHeads(ctx context.Context, spc clientspace.Space, d *Diagnostics) error {
+	treeStorage, err := spc.Storage().TreeStorage(ctx, d.ObjectId)
+	if err != nil {
+		return fmt.Errorf("get tree storage: %w", err)
+	}
+	d.LocalHeads, err = treeStorage.Heads(ctx)
+	if err != nil {
+		return fmt.Errorf("get heads: %w", err)
+	}
+
+	var status objectsyncstatus.TreeStatus
+	if getter, ok := spc.CommonSpace().SyncStatus().(treeStatusGetter); ok {
+		status = getter.TreeStatus(d.ObjectId)
+	}
+	d.NodeHeads = status.NodeHeads
+	d.PendingHeads = status.PendingHeads
+
+	if d.NodeHeads == nil {
+		if status.Status == objectsyncstatus.StatusSynced {
+			d.PendingChanges = 0
+		}
+		return nil
+	}
+	prevIds := map[string][]string{}
+	err = treeStorage.GetAfterOrder(ctx, "", func(ctx context.Context, change objecttree.StorageChange) (shouldContinue bool, err error) {
+		prevIds[change.Id] = change.PrevIds
+		return true, nil
+	})
+	if err != nil {
+		return fmt.Errorf("iterate changes: %w", err)
+	}
+	d.PendingChanges = countPendingChanges(prevIds, d.NodeHeads)
+	return nil
+}
+
+// countPendingChanges counts the changes that are not reachable from the node heads. If the node has heads that
+// are missing locally, the count is unknown
+func countPendingChanges(prevIds map[string][]string, nodeHeads []string) int {
+	seen := make(map[string]struct{}, len(prevIds))
+	queue := make([]string, 0, len(nodeHeads))
+	for _, head := range nodeHeads {
+		if _, ok := prevIds[head]; !ok {
+			return -1
+		}
+		queue = append(queue, head)
+	}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		queue = append(queue, prevIds[id]...)
+	}
+	return len(prevIds) - len(seen)
+}
+
+// files returns the upload states of the object, if it's a file, and of the file objects it links to
+func (s *service) files(spaceId, objectId string, details *domain.Details) []File {
+	var files []File
+	if fileId := details.GetString(bundle.RelationKeyFileId); fileId != "" {
+		files = append(files, s.file(objectId, fileId))
+	}
+
+	store := s.objectStore.SpaceIndex(spaceId)
+	targets, err := store.GetOutboundLinksById(objectId)
+	if err != nil {
+		log.Warn("get outbound links", zap.String("objectId", objectId), zap.Error(err))
+		return files
+	}
+	for _, target := range targets {
+		if target == objectId {
+			continue
+		}
+		targetDetails, err := store.GetDetails(target)
+		if err != nil {
+			continue
+		}
+		if fileId := targetDetails.GetString(bundle.RelationKeyFileId); fileId != "" {
+			files = append(files, s.file(target, fileId))
+		}
+	}
+	return files
+}
+
+func (s *service) file(objectId, fileId string) File {
+	file := File{
+		ObjectId: objectId,
+		FileId:   fileId,
+	}
+	info, err := s.fileQueue.QueueItem(objectId)
+	if err != nil {
+		if !errors.Is(err, filequeue.ErrNotFound) {
+			log.Warn("get file queue item", zap.String("objectId", objectId), zap.Error(err))
+		}
+		return file
+	}
+	file.State = FileState(info.State) + 1
+	file.ScheduledAt = info.ScheduledAt
+	file.BytesToUpload = info.BytesToUploadOrBind
+	return file
+}
+
+func (s *service) ForceResync(ctx context.Context, spaceId, objectId string) error {
+	if err := validate(spaceId, objectId); err != nil {
+		return err
+	}
+	spc, err := s.spaceService.Get(ctx, spaceId)
+	if err != nil {
+		return fmt.Errorf("get space: %w", err)
+	}
+	if err = spc.RefreshObjects([]string{objectId}); err != nil {
+		return fmt.Errorf("refresh object: %w", err)
+	}
+
+	details, err := s.objectStore.SpaceIndex(spaceId).GetDetails(objectId)
+	if err != nil {
+		return fmt.Errorf("get details: %w", err)
+	}
+	for _, file := range s.files(spaceId, objectId, details) {
+		if err = s.fileQueue.RetryUpload(file.ObjectId); err != nil {
+			return fmt.Errorf("retry upload of %s: %w", file.ObjectId, err)
+		}
+	}
+	s.spaceSyncStatus.Refresh(spaceId)
+	return nil
+}
+
+func validate(spaceId, objectId string) error {
+	if spaceId == "" {
+		return ErrEmptySpaceId
+	}
+	if objectId == "" {
+		return ErrEmptyObjectId
+	}
+	return nil
+}
@@ -0,0 +1,252 @@
+package syncdiagnostics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/anyproto/any-sync/commonspace/mock_commonspace"
+	"github.com/anyproto/any-sync/commonspace/object/tree/objecttree"
+	"github.com/anyproto/any-sync/commonspace/object/tree/objecttree/mock_objecttree"
+	"github.com/anyproto/any-sync/commonspace/syncstatus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/files/filesync"
+	"github.com/anyproto/anytype-heart/core/files/filesync/filequeue"
+	"github.com/anyproto/anytype-heart/core/peerstatus"
+	"github.com/anyproto/anytype-heart/core/syncstatus/nodestatus"
+	"github.com/anyproto/anytype-heart/core/syncstatus/nodestatus/mock_nodestatus"
+	"github.com/anyproto/anytype-heart/core/syncstatus/objectsyncstatus"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/space/clientspace/mock_clientspace"
+	"github.com/anyproto/anytype-heart/space/mock_space"
+	"github.com/anyproto/anytype-heart/space/spacecore/storage/anystorage/mock_anystorage"
+)
+
+const (
+	testSpaceId      = "space1"
+	testObjectId     = "obj1"
+	testFileObjectId = "fileObj1"
+)
+
+func TestCountPendingChanges(t *testing.T) {
+	// root <- a <- b <- c
+	//           \
+	//            <- d
+	prevIds := map[string][]string{
+		"root": nil,
+		"a":    {"root"},
+		"b":    {"a"},
+		"c":    {"b"},
+		"d":    {"a"},
+	}
+
+	t.Run("node has everything", func(t *testing.T) {
+		assert.Equal(t, 0, countPendingChanges(prevIds, []string{"c", "d"}))
+	})
+	t.Run("node is behind", func(t *testing.T) {
+		assert.Equal(t, 3, countPendingChanges(prevIds, []string{"a"}))
+	})
+	t.Run("node has another branch", func(t *testing.T) {
+		assert.Equal(t, 1, countPendingChanges(prevIds, []string{"c"}))
+	})
+	t.Run("node has unknown heads", func(t *testing.T) {
+		assert.Equal(t, -1, countPendingChanges(prevIds, []string{"c", "e"}))
+	})
+}
+
+type testFileQueue struct {
+	app.Component
+	items   map[string]filesync.FileInfo
+	retried []string
+}
+
+func (q *testFileQueue) QueueItem(objectId string) (filesync.FileInfo, error) {
+	if info, ok := q.items[objectId]; ok {
+		return info, nil
+	}
+	return filesync.FileInfo{}, filequeue.ErrNotFound
+}
+
+func (q *testFileQueue) RetryUpload(objectId string) error {
+	q.retried = append(q.retried, objectId)
+	return nil
+}
+
+type testSpaceSyncStatus struct {
+	app.Component
+	status    *pb.EventSpaceSyncStatusUpdate
+	refreshed []string
+}
+
+func (s *testSpaceSyncStatus) SpaceStatus(string) *pb.EventSpaceSyncStatusUpdate {
+	return s.status
+}
+
+func (s *testSpaceSyncStatus) Refresh(spaceId string) {
+	s.refreshed = append(s.refreshed, spaceId)
+}
+
+type testPeerStatus struct {
+	peerstatus.PeerToPeerStatus
+}
+
+func (testPeerStatus) SpaceStatus(string) (peerstatus.Status, int64) {
+	return peerstatus.Connected, 2
+}
+
+type testTreeStatus struct {
+	syncstatus.StatusUpdater
+	status objectsyncstatus.TreeStatus
+}
+
+func (s testTreeStatus) TreeStatus(string) objectsyncstatus.TreeStatus {
+	return s.status
+}
+
+type fixture struct {
+	*service
+	store           *objectstore.StoreFixture
+	space           *mock_clientspace.MockSpace
+	fileQueue       *testFileQueue
+	spaceSyncStatus *testSpaceSyncStatus
+}
+
+// newFixture creates a space with the object linking to the file object; the object has the changes
+// root <- a <- b, and the node has the heads of the tree status
+func newFixture(t *testing.T, treeStatus objectsyncstatus.TreeStatus) *fixture {
+	ctrl := gomock.NewController(t)
+	fx := &fixture{
+		store:           objectstore.NewStoreFixture(t),
+		space:           mock_clientspace.NewMockSpace(t),
+		fileQueue:       &testFileQueue{items: map[string]filesync.FileInfo{}},
+		spaceSyncStatus: &testSpaceSyncStatus{},
+	}
+	fx.store.AddObjects(t, testSpaceId, []objectstore.TestObject{
+		{
+			bundle.RelationKeyId:         domain.String(testObjectId),
+			bundle.RelationKeySyncStatus: domain.Int64(int64(domain.ObjectSyncStatusSyncing)),
+			bundle.RelationKeySyncError:  domain.Int64(int64(domain.SyncErrorNull)),
+		},
+		{
+			bundle.RelationKeyId:     domain.String(testFileObjectId),
+			bundle.RelationKeyFileId: domain.String("file"),
+		},
+	})
+	require.NoError(t, fx.store.SpaceIndex(testSpaceId).UpdateObjectLinks(context.Background(), testObjectId, []string{testFileObjectId}))
+
+	treeStorage := mock_objecttree.NewMockStorage(ctrl)
+	treeStorage.EXPECT().Heads(gomock.Any()).Return([]string{"b"}, nil).AnyTimes()
+	treeStorage.EXPECT().GetAfterOrder(gomock.Any(), "", gomock.Any()).DoAndReturn(
+		func(ctx context.Context, _ string, iter objecttree.StorageIterator) error {
+			for _, change := range []objecttree.StorageChange{
+				{Id: "root"},
+				{Id: "a", PrevIds: []string{"root"}},
+				{Id: "b", PrevIds: []string{"a"}},
+			} {
+				if ok, err := iter(ctx, change); !ok || err != nil {
+					return err
+				}
+			}
+			return nil
+		}).AnyTimes()
+	storage := mock_anystorage.NewMockClientSpaceStorage(t)
+	storage.EXPECT().TreeStorage(mock.Anything, testObjectId).Return(treeStorage, nil).Maybe()
+	commonSpace := mock_commonspace.NewMockSpace(ctrl)
+	commonSpace.EXPECT().SyncStatus().Return(testTreeStatus{status: treeStatus}).AnyTimes()
+	fx.space.EXPECT().Storage().Return(storage).Maybe()
+	fx.space.EXPECT().CommonSpace().Return(commonSpace).Maybe()
+
+	spaceService := mock_space.NewMockService(t)
+	spaceService.EXPECT().Get(mock.Anything, testSpaceId).Return(fx.space, nil).Maybe()
+	nodeStatus := mock_nodestatus.NewMockNodeStatus(t)
+	nodeStatus.EXPECT().GetNodeStatus(testSpaceId).Return(nodestatus.Online).Maybe()
+
+	fx.service = &service{
+		spaceService:    spaceService,
+		objectStore:     fx.store,
+		fileQueue:       fx.fileQueue,
+		spaceSyncStatus: fx.spaceSyncStatus,
+		nodeStatus:      nodeStatus,
+		peerStatus:      testPeerStatus{},
+	}
+	return fx
+}
+
+func TestService_Diagnose(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("pending changes, files and space status", func(t *testing.T) {
+		// given
+		fx := newFixture(t, objectsyncstatus.TreeStatus{
+			Status:       objectsyncstatus.StatusNotSynced,
+			PendingHeads: []string{"b"},
+			NodeHeads:    []string{"a"},
+		})
+		fx.fileQueue.items[testFileObjectId] = filesync.FileInfo{State: filesync.FileStateLimited, BytesToUploadOrBind: 100}
+		fx.spaceSyncStatus.status = &pb.EventSpaceSyncStatusUpdate{Status: pb.EventSpace_Syncing}
+
+		// when
+		d, err := fx.Diagnose(ctx, testSpaceId, testObjectId)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"b"}, d.LocalHeads)
+		assert.Equal(t, []string{"a"}, d.NodeHeads)
+		assert.Equal(t, []string{"b"}, d.PendingHeads)
+		assert.Equal(t, 1, d.PendingChanges)
+		assert.Equal(t, domain.ObjectSyncStatusSyncing, d.SyncStatus)
+		assert.Equal(t, []File{{ObjectId: testFileObjectId, FileId: "file", State: FileStateLimited, BytesToUpload: 100}}, d.Files)
+		assert.True(t, d.QuotaExceeded)
+		assert.Equal(t, nodestatus.Online, d.NodeStatus)
+		assert.Equal(t, peerstatus.Connected, d.P2PStatus)
+		assert.Equal(t, int64(2), d.P2PDevicesCounter)
+		assert.Equal(t, pb.EventSpace_Syncing, d.SpaceSyncStatus)
+	})
+	t.Run("synced tree without node heads has no pending changes", func(t *testing.T) {
+		fx := newFixture(t, objectsyncstatus.TreeStatus{Status: objectsyncstatus.StatusSynced})
+
+		d, err := fx.Diagnose(ctx, testSpaceId, testObjectId)
+
+		require.NoError(t, err)
+		assert.Zero(t, d.PendingChanges)
+		assert.Equal(t, []File{{ObjectId: testFileObjectId, FileId: "file", State: FileStateNotQueued}}, d.Files)
+		assert.False(t, d.QuotaExceeded)
+	})
+	t.Run("unknown tree status", func(t *testing.T) {
+		fx := newFixture(t, objectsyncstatus.TreeStatus{Status: objectsyncstatus.StatusUnknown})
+
+		d, err := fx.Diagnose(ctx, testSpaceId, testObjectId)
+
+		require.NoError(t, err)
+		assert.Equal(t, -1, d.PendingChanges)
+	})
+	t.Run("empty ids", func(t *testing.T) {
+		fx := newFixture(t, objectsyncstatus.TreeStatus{})
+
+		_, err := fx.Diagnose(ctx, "", testObjectId)
+		require.ErrorIs(t, err, ErrEmptySpaceId)
+		_, err = fx.Diagnose(ctx, testSpaceId, "")
+		require.ErrorIs(t, err, ErrEmptyObjectId)
+	})
+}
+
+func TestService_ForceResync(t *testing.T) {
+	// given
+	fx := newFixture(t, objectsyncstatus.TreeStatus{})
+	fx.space.EXPECT().RefreshObjects([]string{testObjectId}).Return(nil).Once()
+
+	// when
+	err := fx.ForceResync(context.Background(), testSpaceId, testObjectId)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{testFileObjectId}, fx.fileQueue.retried)
+	assert.Equal(t, []string{testSpaceId}, fx.spaceSyncStatus.refreshed)
+}
@@ -50,10 +50,32 @@ func (mw *Middleware) ObjectApplyTemplate(_ context.Context, req *pb.RpcObjectAp
 		}
 		return m
 	}
-	err := mustService[template.Service](mw).ObjectApplyTemplate(req.ContextId, req.TemplateId)
+	err := mustService[template.Service](mw).ObjectApplyTemplate(req.ContextId, req.TemplateId, template.Variables{
+		SourceObjectId: req.SourceObjectId,
+		Values:         req.TemplateVariables,
+	})
 	return response(err)
 }
 
+func (mw *Middleware) TemplateGetVariables(_ context.Context, req *pb.RpcTemplateGetVariablesRequest) *pb.RpcTemplateGetVariablesResponse {
+	response := func(prompts []template.VariablePrompt, err error) *pb.RpcTemplateGetVariablesResponse {
+		m := &pb.RpcTemplateGetVariablesResponse{
+			Error: &pb.RpcTemplateGetVariablesResponseError{Code: pb.RpcTemplateGetVariablesResponseError_NULL},
+		}
+		if err != nil {
+			m.Error.Code = pb.RpcTemplateGetVariablesResponseError_UNKNOWN_ERROR
+			m.Error.Description = getErrorDescription(err)
+			return m
+		}
+		for _, prompt := range prompts {
+			m.Prompts = append(m.Prompts, &pb.RpcTemplateGetVariablesPrompt{Name: prompt.Name, Default: prompt.Default})
+		}
+		return m
+	}
+	prompts, err := mustService[template.Service](mw).TemplateVariablePrompts(req.TemplateId)
+	return response(prompts, err)
+}
+
 func (mw *Middleware) TemplateExportAll(ctx context.Context, req *pb.RpcTemplateExportAllRequest) *pb.RpcTemplateExportAllResponse {
 	response := func(path string, err error) (res *pb.RpcTemplateExportAllResponse) {
 		res = &pb.RpcTemplateExportAllResponse{
@@ -73,3 +95,42 @@ func (mw *Middleware) TemplateExportAll(ctx context.Context, req *pb.RpcTemplate
 	path, err := mustService[template.Service](mw).TemplateExportAll(ctx, req.Path)
 	return response(path, err)
 }
+
+func (mw *Middleware) TemplateIncludeSyncPreview(cctx context.Context, req *pb.RpcTemplateIncludeSyncPreviewRequest) *pb.RpcTemplateIncludeSyncPreviewResponse {
+	response := func(diffs []template.IncludeSyncDiff, err error) *pb.RpcTemplateIncludeSyncPreviewResponse {
+		m := &pb.RpcTemplateIncludeSyncPreviewResponse{
+			Error: &pb.RpcTemplateIncludeSyncPreviewResponseError{Code: pb.RpcTemplateIncludeSyncPreviewResponseError_NULL},
+		}
+		if err != nil {
+			m.Error.Code = pb.RpcTemplateIncludeSyncPreviewResponseError_UNKNOWN_ERROR
+			m.Error.Description = getErrorDescription(err)
+			return m
+		}
+		for _, diff := range diffs {
+			m.Diffs = append(m.Diffs, &pb.RpcTemplateIncludeSyncPreviewObjectDiff{
+				ObjectId:        diff.ObjectId,
+				AddedBlockIds:   diff.Added,
+				ChangedBlockIds: diff.Changed,
+				RemovedBlockIds: diff.Removed,
+			})
+		}
+		return m
+	}
+	diffs, err := mustService[template.Service](mw).TemplateIncludeSyncPreview(cctx, req.TemplateId)
+	return response(diffs, err)
+}
+
+func (mw *Middleware) TemplateIncludeSyncApply(cctx context.Context, req *pb.RpcTemplateIncludeSyncApplyRequest) *pb.RpcTemplateIncludeSyncApplyResponse {
+	response := func(err error) *pb.RpcTemplateIncludeSyncApplyResponse {
+		m := &pb.RpcTemplateIncludeSyncApplyResponse{
+			Error: &pb.RpcTemplateIncludeSyncApplyResponseError{Code: pb.RpcTemplateIncludeSyncApplyResponseError_NULL},
+		}
+		if err != nil {
+			m.Error.Code = pb.RpcTemplateIncludeSyncApplyResponseError_UNKNOWN_ERROR
+			m.Error.Description = getErrorDescription(err)
+		}
+		return m
+	}
+	err := mustService[template.Service](mw).TemplateIncludeSyncApply(cctx, req.TemplateId, req.ObjectIds)
+	return response(err)
+}
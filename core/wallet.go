@@ -53,13 +53,14 @@ func (mw *Middleware) WalletConvert(cctx context.Context, req *pb.RpcWalletConve
 }
 
 func (mw *Middleware) WalletCreateSession(cctx context.Context, req *pb.RpcWalletCreateSessionRequest) *pb.RpcWalletCreateSessionResponse {
-	token, accountId, err := mw.applicationService.CreateSession(req)
+	token, accountId, appLink, err := mw.applicationService.CreateSession(req)
 	code := mapErrorCode(err,
 		errToCode(application.ErrBadInput, pb.RpcWalletCreateSessionResponseError_BAD_INPUT),
 		errToCode(wallet.ErrAppLinkNotFound, pb.RpcWalletCreateSessionResponseError_APP_TOKEN_NOT_FOUND_IN_THE_CURRENT_ACCOUNT),
+		errToCode(wallet.ErrAppLinkExpired, pb.RpcWalletCreateSessionResponseError_APP_TOKEN_EXPIRED),
 		errToCode(application.ErrApplicationIsNotRunning, pb.RpcWalletCreateSessionResponseError_UNKNOWN_ERROR),
 	)
-	return &pb.RpcWalletCreateSessionResponse{
+	resp := &pb.RpcWalletCreateSessionResponse{
 		Token:     token,
 		AccountId: accountId,
 		Error: &pb.RpcWalletCreateSessionResponseError{
@@ -67,6 +68,11 @@ func (mw *Middleware) WalletCreateSession(cctx context.Context, req *pb.RpcWalle
 			Description: getErrorDescription(err),
 		},
 	}
+	if appLink != nil {
+		resp.AppName = appLink.AppName
+		resp.AppAccess = application.AppLinkAccessToProto(appLink)
+	}
+	return resp
 }
 
 func (mw *Middleware) WalletCloseSession(cctx context.Context, req *pb.RpcWalletCloseSessionRequest) *pb.RpcWalletCloseSessionResponse {
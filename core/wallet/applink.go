@@ -12,6 +12,8 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -24,18 +26,42 @@ import (
 
 const (
 	appLinkKeysDirectory = "auth"
+	appLinkUsageSuffix   = ".used"
 	ver1                 = 1
 )
 
-var ErrAppLinkNotFound = fmt.Errorf("app link file not found in the account directory")
+var (
+	ErrAppLinkNotFound = fmt.Errorf("app link file not found in the account directory")
+	ErrAppLinkExpired  = fmt.Errorf("app link is expired")
+)
 
 type AppLinkInfo struct {
-	AppHash   string `json:"-"` // filled at read time
-	AppKey    string `json:"app_key"`
-	AppName   string `json:"app_name"`
-	CreatedAt int64  `json:"created_at"`
-	ExpireAt  int64  `json:"expire_at"`
-	Scope     int    `json:"scope"`
+	AppHash    string   `json:"-"` // filled at read time
+	AppKey     string   `json:"app_key"`
+	AppName    string   `json:"app_name"`
+	CreatedAt  int64    `json:"created_at"`
+	ExpireAt   int64    `json:"expire_at"`
+	Scope      int      `json:"scope"`
+	SpaceIds   []string `json:"space_ids,omitempty"`
+	ReadOnly   bool     `json:"read_only,omitempty"`
+	LastUsedAt int64    `json:"-"` // filled at read time
+}
+
+// AppLinkAccess restricts what an app link grants access to. The zero value grants read-write access to all spaces
+// without expiration
+type AppLinkAccess struct {
+	SpaceIds []string // empty means all spaces
+	ReadOnly bool
+	ExpireAt int64 // unix time, zero means never
+}
+
+func (i *AppLinkInfo) IsExpired(now time.Time) bool {
+	return i.ExpireAt > 0 && now.Unix() >= i.ExpireAt
+}
+
+// HasSpaceAccess reports whether the app link is allowed to access the space
+func (i *AppLinkInfo) HasSpaceAccess(spaceId string) bool {
+	return len(i.SpaceIds) == 0 || slices.Contains(i.SpaceIds, spaceId)
 }
 
 func (r *wallet) ReadAppLink(appKey string) (*AppLinkInfo, error) {
@@ -46,11 +72,27 @@ func (r *wallet) ReadAppLink(appKey string) (*AppLinkInfo, error) {
 		return nil, fmt.Errorf("account is not set")
 	}
 
-	return load(filepath.Join(r.repoPath, appLinkKeysDirectory), appKey, r.accountKey)
+	info, err := load(filepath.Join(r.repoPath, appLinkKeysDirectory), appKey, r.accountKey)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsExpired(time.Now()) {
+		return nil, ErrAppLinkExpired
+	}
+	return info, nil
 }
 
-func (r *wallet) PersistAppLink(name string, scope model.AccountAuthLocalApiScope) (app *AppLinkInfo, err error) {
-	return generate(filepath.Join(r.repoPath, appLinkKeysDirectory), r.accountKey, name, scope)
+func (r *wallet) PersistAppLink(name string, scope model.AccountAuthLocalApiScope, access AppLinkAccess) (app *AppLinkInfo, err error) {
+	return generate(filepath.Join(r.repoPath, appLinkKeysDirectory), r.accountKey, name, scope, access)
+}
+
+// TouchAppLink records the current time as the last usage of the app link
+func (r *wallet) TouchAppLink(appHash string) error {
+	if r.repoPath == "" {
+		return fmt.Errorf("repo path is not set")
+	}
+
+	return touch(filepath.Join(r.repoPath, appLinkKeysDirectory), appHash, time.Now())
 }
 
 // ListAppLinks returns a list of all app links for this repo directory
@@ -74,7 +116,7 @@ func (r *wallet) RevokeAppLink(appHash string) error {
 	return revoke(filepath.Join(r.repoPath, appLinkKeysDirectory), appHash)
 }
 
-func generate(dir string, accountPriv crypto.PrivKey, appName string, scope model.AccountAuthLocalApiScope) (info *AppLinkInfo, _ error) {
+func generate(dir string, accountPriv crypto.PrivKey, appName string, scope model.AccountAuthLocalApiScope, access AppLinkAccess) (info *AppLinkInfo, _ error) {
 	if err := os.MkdirAll(dir, 0o700); err != nil && !os.IsExist(err) {
 		return nil, err
 	}
@@ -88,7 +130,10 @@ func generate(dir string, accountPriv crypto.PrivKey, appName string, scope mode
 		AppKey:    appKey,
 		AppName:   appName,
 		CreatedAt: time.Now().Unix(),
+		ExpireAt:  access.ExpireAt,
 		Scope:     int(scope),
+		SpaceIds:  access.SpaceIds,
+		ReadOnly:  access.ReadOnly,
 	}
 	file, err := buildV1(key.Bytes(), accountPriv, info)
 	if err != nil {
@@ -109,7 +154,8 @@ func load(dir, appKey string, accountPriv crypto.PrivKey) (*AppLinkInfo, error)
 	if err != nil {
 		return nil, err
 	}
-	path := filepath.Join(dir, fmt.Sprintf("%x.json", sha256.Sum256(key)))
+	appHash := fmt.Sprintf("%x", sha256.Sum256(key))
+	path := filepath.Join(dir, appHash+".json")
 	fp, err := os.Open(path)
 	if errors.Is(err, os.ErrNotExist) {
 		return nil, ErrAppLinkNotFound
@@ -128,24 +174,31 @@ func load(dir, appKey string, accountPriv crypto.PrivKey) (*AppLinkInfo, error)
 	}
 	_, _ = fp.Seek(0, io.SeekStart)
 
+	var info *AppLinkInfo
 	switch peek.Version {
 	case 0 /* field missing */ :
 		var v0 fileV0
 		if err = json.NewDecoder(fp).Decode(&v0); err != nil {
 			return nil, err
 		}
-		return verifyAndOpenV0(key, accountPriv, &v0)
+		info, err = verifyAndOpenV0(key, accountPriv, &v0)
 
 	case 1:
 		var v1 fileV1
 		if err = json.NewDecoder(fp).Decode(&v1); err != nil {
 			return nil, err
 		}
-		return verifyAndOpenV1(key, accountPriv, &v1)
+		info, err = verifyAndOpenV1(key, accountPriv, &v1)
 
 	default:
 		return nil, fmt.Errorf("unsupported version %d", peek.Version)
 	}
+	if err != nil {
+		return nil, err
+	}
+	info.AppHash = appHash
+	info.LastUsedAt = lastUsed(dir, appHash)
+	return info, nil
 }
 
 // List reads all app link files in the directory
@@ -202,6 +255,9 @@ func list(dir string, accountPriv crypto.PrivKey) ([]*AppLinkInfo, error) {
 		}
 
 		fp.Close()
+		// AppHash and LastUsedAt are not part of the encrypted info, so they are set after decoding
+		info.AppHash = appHash
+		info.LastUsedAt = lastUsed(dir, appHash)
 		result = append(result, info)
 	}
 
@@ -219,7 +275,32 @@ func revoke(dir, appHash string) error {
 	}
 
 	// Delete the file
-	return os.Remove(filePath)
+	if err := os.Remove(filePath); err != nil {
+		return err
+	}
+	if err := os.Remove(filepath.Join(dir, appHash+appLinkUsageSuffix)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// touch stores the time of the last usage next to the app link file. It is kept out of the signed file, as
+// rewriting the file requires the app key
+func touch(dir, appHash string, at time.Time) error {
+	if _, err := os.Stat(filepath.Join(dir, appHash+".json")); os.IsNotExist(err) {
+		return ErrAppLinkNotFound
+	}
+	return os.WriteFile(filepath.Join(dir, appHash+appLinkUsageSuffix), []byte(strconv.FormatInt(at.Unix(), 10)), 0o600)
+}
+
+// lastUsed returns the time of the last usage of the app link or zero if it was never used
+func lastUsed(dir, appHash string) int64 {
+	data, err := os.ReadFile(filepath.Join(dir, appHash+appLinkUsageSuffix))
+	if err != nil {
+		return 0
+	}
+	ts, _ := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	return ts
 }
 
 type fileV0 struct {
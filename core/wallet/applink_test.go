@@ -53,7 +53,7 @@ func TestGenerateLoad_RoundTrip_V1(t *testing.T) {
 	// payload for v1
 	want := testInfo()
 
-	info, err := generate(dir, pk, want.AppName, model.AccountAuthLocalApiScope(want.Scope))
+	info, err := generate(dir, pk, want.AppName, model.AccountAuthLocalApiScope(want.Scope), AppLinkAccess{})
 	if err != nil {
 		t.Fatalf("Generate(v1): %v", err)
 	}
@@ -111,7 +111,7 @@ func TestNoDuplicateKeys_V0_V1(t *testing.T) {
 	want := testInfo()
 
 	// Generate keys for both v0 and v1
-	appKeyV1, err := generate(dir, pk, want.AppName, model.AccountAuthLocalApiScope(want.Scope))
+	appKeyV1, err := generate(dir, pk, want.AppName, model.AccountAuthLocalApiScope(want.Scope), AppLinkAccess{})
 	require.NoError(t, err)
 
 	appKeyV0, err := writeAppLinkFileV0(pk, dir, want)
@@ -142,7 +142,7 @@ func TestList(t *testing.T) {
 		ExpireAt:  time.Now().Unix() + 3600,
 		Scope:     1,
 	}
-	info, err := generate(dir, pk, info1.AppName, model.AccountAuthLocalApiScope(info1.Scope))
+	info, err := generate(dir, pk, info1.AppName, model.AccountAuthLocalApiScope(info1.Scope), AppLinkAccess{})
 	require.NoError(t, err)
 
 	// Create v0 entry
@@ -231,7 +231,7 @@ func TestRevoke(t *testing.T) {
 	}
 
 	// Generate the app link file
-	_, err = generate(dir, pk, info.AppName, model.AccountAuthLocalApiScope(info.Scope))
+	_, err = generate(dir, pk, info.AppName, model.AccountAuthLocalApiScope(info.Scope), AppLinkAccess{})
 	require.NoError(t, err)
 
 	// List entries to get the app hash
@@ -264,6 +264,72 @@ func TestRevoke(t *testing.T) {
 	require.Equal(t, ErrAppLinkNotFound, err, "should return ErrAppLinkNotFound for empty hash")
 }
 
+func TestGenerateLoad_Access(t *testing.T) {
+	tmp := t.TempDir()
+	dir := filepath.Join(tmp, appLinkKeysDirectory)
+
+	pk, _, err := crypto.GenerateRandomEd25519KeyPair()
+	require.NoError(t, err)
+
+	access := AppLinkAccess{
+		SpaceIds: []string{"space1", "space2"},
+		ReadOnly: true,
+		ExpireAt: time.Now().Add(time.Hour).Unix(),
+	}
+	info, err := generate(dir, pk, "ci-bot", model.AccountAuth_JsonAPI, access)
+	require.NoError(t, err)
+
+	loaded, err := load(dir, info.AppKey, pk)
+	require.NoError(t, err)
+	require.Equal(t, info.AppHash, loaded.AppHash)
+	require.Equal(t, access.SpaceIds, loaded.SpaceIds)
+	require.True(t, loaded.ReadOnly)
+	require.Equal(t, access.ExpireAt, loaded.ExpireAt)
+
+	require.True(t, loaded.HasSpaceAccess("space2"))
+	require.False(t, loaded.HasSpaceAccess("space3"))
+	require.False(t, loaded.IsExpired(time.Now()))
+	require.True(t, loaded.IsExpired(time.Now().Add(2*time.Hour)))
+
+	unrestricted := &AppLinkInfo{}
+	require.True(t, unrestricted.HasSpaceAccess("space3"))
+	require.False(t, unrestricted.IsExpired(time.Now()))
+}
+
+func TestTouch(t *testing.T) {
+	tmp := t.TempDir()
+	dir := filepath.Join(tmp, appLinkKeysDirectory)
+
+	pk, _, err := crypto.GenerateRandomEd25519KeyPair()
+	require.NoError(t, err)
+
+	info, err := generate(dir, pk, "app", model.AccountAuth_JsonAPI, AppLinkAccess{})
+	require.NoError(t, err)
+
+	entries, err := list(dir, pk)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Zero(t, entries[0].LastUsedAt)
+
+	usedAt := time.Unix(1700000000, 0)
+	require.NoError(t, touch(dir, info.AppHash, usedAt))
+
+	entries, err = list(dir, pk)
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "usage file should not be listed as an app link")
+	require.Equal(t, usedAt.Unix(), entries[0].LastUsedAt)
+
+	loaded, err := load(dir, info.AppKey, pk)
+	require.NoError(t, err)
+	require.Equal(t, usedAt.Unix(), loaded.LastUsedAt)
+
+	require.Equal(t, ErrAppLinkNotFound, touch(dir, "nonexistent-hash", usedAt))
+
+	require.NoError(t, revoke(dir, info.AppHash))
+	_, err = os.Stat(filepath.Join(dir, info.AppHash+appLinkUsageSuffix))
+	require.True(t, os.IsNotExist(err), "usage file should be removed on revocation")
+}
+
 // writeAppLinkFileV0: legacy support for v0 files. Used for tests
 func writeAppLinkFileV0(accountPk crypto.PrivKey, dir string, payload *AppLinkInfo) (appKey string, err error) {
 	key, err := symmetric.NewRandom()
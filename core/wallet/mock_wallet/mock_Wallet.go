@@ -550,9 +550,9 @@ func (_c *MockWallet_Name_Call) RunAndReturn(run func() string) *MockWallet_Name
 	return _c
 }
 
-// PersistAppLink provides a mock function with given fields: name, scope
-func (_m *MockWallet) PersistAppLink(name string, scope model.AccountAuthLocalApiScope) (*wallet.AppLinkInfo, error) {
-	ret := _m.Called(name, scope)
+// PersistAppLink provides a mock function with given fields: name, scope, access
+func (_m *MockWallet) PersistAppLink(name string, scope model.AccountAuthLocalApiScope, access wallet.AppLinkAccess) (*wallet.AppLinkInfo, error) {
+	ret := _m.Called(name, scope, access)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PersistAppLink")
@@ -560,19 +560,19 @@ func (_m *MockWallet) PersistAppLink(name string, scope model.AccountAuthLocalAp
 
 	var r0 *wallet.AppLinkInfo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, model.AccountAuthLocalApiScope) (*wallet.AppLinkInfo, error)); ok {
-		return rf(name, scope)
+	if rf, ok := ret.Get(0).(func(string, model.AccountAuthLocalApiScope, wallet.AppLinkAccess) (*wallet.AppLinkInfo, error)); ok {
+		return rf(name, scope, access)
 	}
-	if rf, ok := ret.Get(0).(func(string, model.AccountAuthLocalApiScope) *wallet.AppLinkInfo); ok {
-		r0 = rf(name, scope)
+	if rf, ok := ret.Get(0).(func(string, model.AccountAuthLocalApiScope, wallet.AppLinkAccess) *wallet.AppLinkInfo); ok {
+		r0 = rf(name, scope, access)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*wallet.AppLinkInfo)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, model.AccountAuthLocalApiScope) error); ok {
-		r1 = rf(name, scope)
+	if rf, ok := ret.Get(1).(func(string, model.AccountAuthLocalApiScope, wallet.AppLinkAccess) error); ok {
+		r1 = rf(name, scope, access)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -588,13 +588,14 @@ type MockWallet_PersistAppLink_Call struct {
 // PersistAppLink is a helper method to define mock.On call
 //   - name string
 //   - scope model.AccountAuthLocalApiScope
-func (_e *MockWallet_Expecter) PersistAppLink(name interface{}, scope interface{}) *MockWallet_PersistAppLink_Call {
-	return &MockWallet_PersistAppLink_Call{Call: _e.mock.On("PersistAppLink", name, scope)}
+//   - access wallet.AppLinkAccess
+func (_e *MockWallet_Expecter) PersistAppLink(name interface{}, scope interface{}, access interface{}) *MockWallet_PersistAppLink_Call {
+	return &MockWallet_PersistAppLink_Call{Call: _e.mock.On("PersistAppLink", name, scope, access)}
 }
 
-func (_c *MockWallet_PersistAppLink_Call) Run(run func(name string, scope model.AccountAuthLocalApiScope)) *MockWallet_PersistAppLink_Call {
+func (_c *MockWallet_PersistAppLink_Call) Run(run func(name string, scope model.AccountAuthLocalApiScope, access wallet.AppLinkAccess)) *MockWallet_PersistAppLink_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(model.AccountAuthLocalApiScope))
+		run(args[0].(string), args[1].(model.AccountAuthLocalApiScope), args[2].(wallet.AppLinkAccess))
 	})
 	return _c
 }
@@ -604,7 +605,7 @@ func (_c *MockWallet_PersistAppLink_Call) Return(appInfo *wallet.AppLinkInfo, er
 	return _c
 }
 
-func (_c *MockWallet_PersistAppLink_Call) RunAndReturn(run func(string, model.AccountAuthLocalApiScope) (*wallet.AppLinkInfo, error)) *MockWallet_PersistAppLink_Call {
+func (_c *MockWallet_PersistAppLink_Call) RunAndReturn(run func(string, model.AccountAuthLocalApiScope, wallet.AppLinkAccess) (*wallet.AppLinkInfo, error)) *MockWallet_PersistAppLink_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -803,6 +804,52 @@ func (_c *MockWallet_RootPath_Call) RunAndReturn(run func() string) *MockWallet_
 	return _c
 }
 
+// TouchAppLink provides a mock function with given fields: appHash
+func (_m *MockWallet) TouchAppLink(appHash string) error {
+	ret := _m.Called(appHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TouchAppLink")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(appHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWallet_TouchAppLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TouchAppLink'
+type MockWallet_TouchAppLink_Call struct {
+	*mock.Call
+}
+
+// TouchAppLink is a helper method to define mock.On call
+//   - appHash string
+func (_e *MockWallet_Expecter) TouchAppLink(appHash interface{}) *MockWallet_TouchAppLink_Call {
+	return &MockWallet_TouchAppLink_Call{Call: _e.mock.On("TouchAppLink", appHash)}
+}
+
+func (_c *MockWallet_TouchAppLink_Call) Run(run func(appHash string)) *MockWallet_TouchAppLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockWallet_TouchAppLink_Call) Return(_a0 error) *MockWallet_TouchAppLink_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWallet_TouchAppLink_Call) RunAndReturn(run func(string) error) *MockWallet_TouchAppLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockWallet creates a new instance of MockWallet. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockWallet(t interface {
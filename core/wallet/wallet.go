@@ -184,9 +184,10 @@ type Wallet interface {
 	GetAccountEthAddress() EthAddress
 
 	ReadAppLink(appKey string) (*AppLinkInfo, error)
-	PersistAppLink(name string, scope model.AccountAuthLocalApiScope) (appInfo *AppLinkInfo, err error)
+	PersistAppLink(name string, scope model.AccountAuthLocalApiScope, access AppLinkAccess) (appInfo *AppLinkInfo, err error)
 	ListAppLinks() ([]*AppLinkInfo, error)
 	RevokeAppLink(appHash string) error
+	TouchAppLink(appHash string) error
 
 	accountservice.Service
 	app.Component
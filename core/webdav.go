@@ -0,0 +1,51 @@
+package core
+
+import (
+	"context"
+
+	"github.com/anyproto/anytype-heart/core/webdav"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+func (mw *Middleware) WebdavStart(cctx context.Context, req *pb.RpcWebdavStartRequest) *pb.RpcWebdavStartResponse {
+	info, err := mustService[webdav.Service](mw).Start(req.Addr)
+	code := mapErrorCode(err,
+		errToCode(webdav.ErrNotLocalAddr, pb.RpcWebdavStartResponseError_NOT_LOCAL_ADDR),
+	)
+	return &pb.RpcWebdavStartResponse{
+		Url:      info.Url,
+		Username: info.Username,
+		Password: info.Password,
+		Error: &pb.RpcWebdavStartResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
+
+func (mw *Middleware) WebdavStop(cctx context.Context, req *pb.RpcWebdavStopRequest) *pb.RpcWebdavStopResponse {
+	err := mustService[webdav.Service](mw).Stop()
+	code := mapErrorCode[pb.RpcWebdavStopResponseErrorCode](err)
+	return &pb.RpcWebdavStopResponse{
+		Error: &pb.RpcWebdavStopResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
+
+func (mw *Middleware) WebdavInfo(cctx context.Context, req *pb.RpcWebdavInfoRequest) *pb.RpcWebdavInfoResponse {
+	info, err := mustService[webdav.Service](mw).Info()
+	code := mapErrorCode(err,
+		errToCode(webdav.ErrNotRunning, pb.RpcWebdavInfoResponseError_NOT_RUNNING),
+	)
+	return &pb.RpcWebdavInfoResponse{
+		Url:      info.Url,
+		Username: info.Username,
+		Password: info.Password,
+		Error: &pb.RpcWebdavInfoResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
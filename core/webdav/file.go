@@ -0,0 +1,270 @@
+package webdav
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"golang.org/x/net/webdav"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/domain/objectorigin"
+	"github.com/anyproto/anytype-heart/core/files/filestorage/rpcstore"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+type fileInfo struct {
+	name     string
+	size     int64
+	modTime  time.Time
+	isDir    bool
+	mimeType string
+}
+
+func (fi *fileInfo) Name() string       { return fi.name }
+func (fi *fileInfo) Size() int64        { return fi.size }
+func (fi *fileInfo) ModTime() time.Time { return fi.modTime }
+func (fi *fileInfo) IsDir() bool        { return fi.isDir }
+func (fi *fileInfo) Sys() interface{}   { return nil }
+
+func (fi *fileInfo) Mode() os.FileMode {
+	if fi.isDir {
+		return os.ModeDir | 0o755
+	}
+	return 0o644
+}
+
+// ContentType implements webdav.ContentTyper, so the server doesn't read the content to detect its type
+func (fi *fileInfo) ContentType(_ context.Context) (string, error) {
+	if fi.mimeType == "" {
+		return "application/octet-stream", nil
+	}
+	return fi.mimeType, nil
+}
+
+// readOnlyFile contains common methods of files opened for reading
+type readOnlyFile struct {
+	info *fileInfo
+}
+
+func (f *readOnlyFile) Write(_ []byte) (int, error) {
+	return 0, errPermission
+}
+
+func (f *readOnlyFile) Readdir(_ int) ([]os.FileInfo, error) {
+	return nil, errNotDir
+}
+
+func (f *readOnlyFile) Stat() (os.FileInfo, error) {
+	return f.info, nil
+}
+
+// dirFile lists children of the folder
+type dirFile struct {
+	ctx      context.Context
+	fs       *fileSystem
+	node     *node
+	info     *fileInfo
+	children []os.FileInfo
+	listed   bool
+	pos      int
+}
+
+func (f *dirFile) Close() error {
+	return nil
+}
+
+func (f *dirFile) Read(_ []byte) (int, error) {
+	return 0, errIsDir
+}
+
+func (f *dirFile) Seek(_ int64, _ int) (int64, error) {
+	return 0, errIsDir
+}
+
+func (f *dirFile) Write(_ []byte) (int, error) {
+	return 0, errIsDir
+}
+
+func (f *dirFile) Stat() (os.FileInfo, error) {
+	return f.info, nil
+}
+
+func (f *dirFile) Readdir(count int) ([]os.FileInfo, error) {
+	if !f.listed {
+		children, err := f.fs.service.children(f.ctx, f.node)
+		if err != nil {
+			return nil, err
+		}
+		for _, child := range children {
+			f.children = append(f.children, f.fs.stat(child))
+		}
+		f.listed = true
+	}
+	rest := f.children[f.pos:]
+	if count <= 0 {
+		f.pos = len(f.children)
+		return rest, nil
+	}
+	if len(rest) == 0 {
+		return nil, io.EOF
+	}
+	if count > len(rest) {
+		count = len(rest)
+	}
+	f.pos += count
+	return rest[:count], nil
+}
+
+// objectFile reads the content of the file object. The content is fetched lazily, because
+// clients often open files only to get their properties
+type objectFile struct {
+	readOnlyFile
+	ctx      context.Context
+	service  *service
+	objectId string
+	reader   io.ReadSeeker
+}
+
+func (f *objectFile) getReader() (io.ReadSeeker, error) {
+	if f.reader != nil {
+		return f.reader, nil
+	}
+	ctx := rpcstore.ContextWithWaitAvailable(f.ctx)
+	file, err := f.service.fileObjectService.GetFileData(ctx, f.objectId)
+	if err != nil {
+		return nil, fmt.Errorf("get file data: %w", err)
+	}
+	reader, err := file.Reader(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get reader: %w", err)
+	}
+	f.reader = reader
+	return reader, nil
+}
+
+func (f *objectFile) Read(p []byte) (int, error) {
+	reader, err := f.getReader()
+	if err != nil {
+		return 0, err
+	}
+	return reader.Read(p)
+}
+
+func (f *objectFile) Seek(offset int64, whence int) (int64, error) {
+	reader, err := f.getReader()
+	if err != nil {
+		return 0, err
+	}
+	return reader.Seek(offset, whence)
+}
+
+func (f *objectFile) Close() error {
+	if closer, ok := f.reader.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// pageFile serves the markdown rendered from the object
+type pageFile struct {
+	*bytes.Reader
+	readOnlyFile
+}
+
+func (f *pageFile) Close() error {
+	return nil
+}
+
+// uploadFile buffers written content in a temporary file and uploads it on close
+type uploadFile struct {
+	*os.File
+	ctx     context.Context
+	service *service
+	name    string
+	spaceId string
+	// replaceObjectId is set when the content of the existing file object is overwritten
+	replaceObjectId string
+	// collectionId is set when a new file is created in the collection folder
+	collectionId string
+	// typeKey is set when a new file is created in the type folder
+	typeKey domain.TypeKey
+	written int64
+}
+
+func (s *service) newUploadFile(ctx context.Context, spaceId, name, replaceObjectId, collectionId string, typeKey domain.TypeKey) (webdav.File, error) {
+	tmp, err := os.CreateTemp(s.tempDirProvider.TempDir(), "webdav-*")
+	if err != nil {
+		return nil, fmt.Errorf("create temp file: %w", err)
+	}
+	return &uploadFile{
+		File:            tmp,
+		ctx:             ctx,
+		service:         s,
+		name:            name,
+		spaceId:         spaceId,
+		replaceObjectId: replaceObjectId,
+		collectionId:    collectionId,
+		typeKey:         typeKey,
+	}, nil
+}
+
+func (f *uploadFile) Write(p []byte) (int, error) {
+	n, err := f.File.Write(p)
+	f.written += int64(n)
+	return n, err
+}
+
+func (f *uploadFile) Readdir(_ int) ([]os.FileInfo, error) {
+	return nil, errNotDir
+}
+
+func (f *uploadFile) Stat() (os.FileInfo, error) {
+	return &fileInfo{
+		name:    f.name,
+		size:    f.written,
+		modTime: time.Now(),
+	}, nil
+}
+
+func (f *uploadFile) Close() error {
+	tmpPath := f.File.Name()
+	defer os.Remove(tmpPath)
+	if err := f.File.Close(); err != nil {
+		return err
+	}
+	// clients lock and create an empty file before the actual upload, there is nothing to upload yet
+	if f.written == 0 {
+		return nil
+	}
+
+	uploader := f.service.fileUploader.NewUploader(f.spaceId, objectorigin.DragAndDrop()).
+		SetFile(tmpPath).
+		SetName(f.name).
+		SetLastModifiedDate()
+	if f.replaceObjectId != "" {
+		uploader.SetReplaceObjectId(f.replaceObjectId)
+	}
+	if f.typeKey != "" {
+		uploader.SetObjectTypeKey(f.typeKey)
+	}
+	res := uploader.Upload(f.ctx)
+	if res.Err != nil {
+		return fmt.Errorf("upload: %w", res.Err)
+	}
+	// the new file should be visible to the next lookups of the request
+	resetTreeCache(f.ctx)
+	if f.collectionId != "" {
+		err := f.service.collectionService.Add(nil, &pb.RpcObjectCollectionAddRequest{
+			ContextId: f.collectionId,
+			ObjectIds: []string{res.FileObjectId},
+		})
+		if err != nil {
+			return fmt.Errorf("add to collection: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+package webdav
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/net/webdav"
+
+	"github.com/anyproto/anytype-heart/core/block/cache"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/converter/md"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const mdMimeType = "text/markdown; charset=utf-8"
+
+var (
+	errNotExist   = os.ErrNotExist
+	errPermission = os.ErrPermission
+	errIsDir      = errors.New("is a directory")
+	errNotDir     = errors.New("not a directory")
+)
+
+// fileSystem implements webdav.FileSystem on top of the object store. The tree is read-only except for
+// file objects: existing files can be overwritten and new files can be added to collection folders and folders of file types
+type fileSystem struct {
+	service *service
+}
+
+var _ webdav.FileSystem = (*fileSystem)(nil)
+
+func (fs *fileSystem) Mkdir(_ context.Context, _ string, _ os.FileMode) error {
+	return errPermission
+}
+
+func (fs *fileSystem) RemoveAll(_ context.Context, _ string) error {
+	return errPermission
+}
+
+func (fs *fileSystem) Rename(_ context.Context, _, _ string) error {
+	return errPermission
+}
+
+func (fs *fileSystem) Stat(ctx context.Context, name string) (os.FileInfo, error) {
+	n, err := fs.service.resolve(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return fs.stat(n), nil
+}
+
+func (fs *fileSystem) OpenFile(ctx context.Context, name string, flag int, _ os.FileMode) (webdav.File, error) {
+	if flag&(os.O_WRONLY|os.O_RDWR|os.O_CREATE|os.O_TRUNC|os.O_APPEND) != 0 {
+		return fs.openForWrite(ctx, name, flag)
+	}
+	n, err := fs.service.resolve(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	info := fs.stat(n)
+	switch n.kind {
+	case nodeFile:
+		return &objectFile{readOnlyFile: readOnlyFile{info: info}, ctx: ctx, service: fs.service, objectId: n.id}, nil
+	case nodePage:
+		data, err := fs.service.renderPage(ctx, n)
+		if err != nil {
+			return nil, err
+		}
+		info.size = int64(len(data))
+		return &pageFile{Reader: bytes.NewReader(data), readOnlyFile: readOnlyFile{info: info}}, nil
+	default:
+		return &dirFile{ctx: ctx, fs: fs, node: n, info: info}, nil
+	}
+}
+
+func (fs *fileSystem) openForWrite(ctx context.Context, name string, flag int) (webdav.File, error) {
+	if flag&os.O_APPEND != 0 {
+		return nil, errPermission
+	}
+	n, err := fs.service.resolve(ctx, name)
+	if err == nil {
+		if n.kind != nodeFile {
+			return nil, errPermission
+		}
+		return fs.service.newUploadFile(ctx, n.spaceId, n.name, n.id, "", "")
+	}
+	if !errors.Is(err, errNotExist) || flag&os.O_CREATE == 0 {
+		return nil, err
+	}
+
+	fileName := path.Base(name)
+	if strings.HasPrefix(fileName, ".") {
+		// don't upload service files of file managers like .DS_Store
+		return nil, errPermission
+	}
+	parent, err := fs.service.resolve(ctx, path.Dir(name))
+	if err != nil {
+		return nil, err
+	}
+	if parent.kind != nodeFolder {
+		return nil, errPermission
+	}
+	var (
+		collectionId string
+		typeKey      domain.TypeKey
+	)
+	switch parent.group {
+	case collectionsFolder:
+		collectionId = parent.folderId
+	case typesFolder:
+		typeKey, err = fileTypeKey(parent.details)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return fs.service.newUploadFile(ctx, parent.spaceId, fileName, "", collectionId, typeKey)
+}
+
+// fileTypeKey returns the key of the type folder, so the uploaded file stays in the folder it was put into.
+// Files can't have types of other layouts, so such folders don't accept uploads
+func fileTypeKey(typeDetails *domain.Details) (domain.TypeKey, error) {
+	layout := model.ObjectTypeLayout(typeDetails.GetInt64(bundle.RelationKeyRecommendedLayout))
+	if !slices.Contains(domain.FileLayouts, layout) {
+		return "", errPermission
+	}
+	typeKey, err := domain.GetTypeKeyFromRawUniqueKey(typeDetails.GetString(bundle.RelationKeyUniqueKey))
+	if err != nil {
+		return "", fmt.Errorf("get type key: %w", err)
+	}
+	return typeKey, nil
+}
+
+// stat doesn't render pages, so listing a folder stays cheap. The size of a page is known only once it was rendered,
+// until then it's reported as zero. Clients read pages till EOF, so the size is only informational
+func (fs *fileSystem) stat(n *node) *fileInfo {
+	info := &fileInfo{
+		name:  n.name,
+		isDir: n.isDir(),
+	}
+	if n.details != nil {
+		info.modTime = time.Unix(n.details.GetInt64(bundle.RelationKeyLastModifiedDate), 0)
+	}
+	switch n.kind {
+	case nodeFile:
+		info.size = n.details.GetInt64(bundle.RelationKeySizeInBytes)
+		info.mimeType = n.details.GetString(bundle.RelationKeyFileMimeType)
+	case nodePage:
+		info.size = fs.service.renderedPageSize(n)
+		info.mimeType = mdMimeType
+	}
+	return info
+}
+
+type renderedPage struct {
+	lastModifiedDate int64
+	data             []byte
+}
+
+// renderPage renders the object to markdown. Results are cached until the object is modified
+func (s *service) renderPage(ctx context.Context, n *node) ([]byte, error) {
+	lastModifiedDate := n.details.GetInt64(bundle.RelationKeyLastModifiedDate)
+	if page, ok := s.renderedPages.Get(n.id); ok && page.lastModifiedDate == lastModifiedDate {
+		return page.data, nil
+	}
+	var data []byte
+	err := cache.DoContextFullID(s.picker, ctx, domain.FullID{SpaceID: n.spaceId, ObjectID: n.id}, func(sb smartblock.SmartBlock) error {
+		conv := md.NewMDConverter(sb.NewState(), mdFileNamer{}, false)
+		data = conv.Convert(sb.Type().ToProto())
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("render markdown: %w", err)
+	}
+	s.renderedPages.Add(n.id, renderedPage{lastModifiedDate: lastModifiedDate, data: data})
+	return data, nil
+}
+
+// renderedPageSize returns the size of the cached markdown of the object or zero if it wasn't rendered since last modification
+func (s *service) renderedPageSize(n *node) int64 {
+	page, ok := s.renderedPages.Peek(n.id)
+	if !ok || page.lastModifiedDate != n.details.GetInt64(bundle.RelationKeyLastModifiedDate) {
+		return 0
+	}
+	return int64(len(page.data))
+}
+
+// mdFileNamer makes links between objects relative to the folder of the rendered page
+type mdFileNamer struct{}
+
+func (mdFileNamer) Get(_, _, title, ext string) string {
+	return sanitizeName(strings.TrimSuffix(title, ext)) + ext
+}
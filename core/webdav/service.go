@@ -0,0 +1,293 @@
+package webdav
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/anyproto/any-sync/app"
+	lru "github.com/hashicorp/golang-lru/v2"
+	"go.uber.org/zap"
+	"golang.org/x/net/webdav"
+
+	"github.com/anyproto/anytype-heart/core/anytype/config"
+	"github.com/anyproto/anytype-heart/core/block/cache"
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/files/fileobject"
+	"github.com/anyproto/anytype-heart/core/files/fileuploader"
+	"github.com/anyproto/anytype-heart/core/session"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+)
+
+const (
+	CName = "core.webdav"
+
+	DefaultAddr = "127.0.0.1:47900"
+	Username    = "anytype"
+
+	renderedPagesCacheSize = 256
+)
+
+var log = logging.Logger(CName).Desugar()
+
+var (
+	ErrNotLocalAddr = errors.New("webdav server can listen only on the loopback interface")
+	ErrNotRunning   = errors.New("webdav server is not running")
+)
+
+// Info contains everything needed to mount the WebDAV endpoint
+type Info struct {
+	Url      string
+	Username string
+	Password string
+}
+
+// Service serves spaces as a WebDAV folder tree. Each space contains two folders: Types and Collections.
+// File objects are served as is and can be overwritten, other objects are rendered as read-only markdown files
+type Service interface {
+	app.ComponentRunnable
+
+	// Start starts the server and remembers the setting, so the server is started again on the next launch.
+	// Empty addr means the last used address or DefaultAddr
+	Start(addr string) (Info, error)
+	// Stop stops the server and disables it for the next launches
+	Stop() error
+	// Info returns connection info of the running server
+	Info() (Info, error)
+}
+
+type service struct {
+	config              *config.Config
+	objectStore         objectstore.ObjectStore
+	techSpaceIdProvider objectstore.TechSpaceIdProvider
+	picker              cache.ObjectGetter
+	fileObjectService   fileobject.Service
+	fileUploader        fileuploader.Service
+	collectionService   collectionService
+	tempDirProvider     core.TempDirProvider
+
+	renderedPages *lru.Cache[string, renderedPage]
+
+	lock     sync.Mutex
+	settings config.WebDAVConfig
+	server   *http.Server
+}
+
+type collectionService interface {
+	Add(ctx session.Context, req *pb.RpcObjectCollectionAddRequest) error
+}
+
+func New() Service {
+	return &service{}
+}
+
+func (s *service) Init(a *app.App) (err error) {
+	s.config = app.MustComponent[*config.Config](a)
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	s.techSpaceIdProvider = app.MustComponent[objectstore.TechSpaceIdProvider](a)
+	s.picker = app.MustComponent[cache.ObjectGetter](a)
+	s.fileObjectService = app.MustComponent[fileobject.Service](a)
+	s.fileUploader = app.MustComponent[fileuploader.Service](a)
+	s.collectionService = app.MustComponent[*collection.Service](a)
+	s.tempDirProvider = app.MustComponent[core.TempDirProvider](a)
+	s.settings = s.config.WebDAV
+	s.renderedPages, err = lru.New[string, renderedPage](renderedPagesCacheSize)
+	return err
+}
+
+func (s *service) Name() string {
+	return CName
+}
+
+func (s *service) Run(_ context.Context) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.settings.Enabled {
+		return nil
+	}
+	// the server is optional, so don't fail the whole application if the port is busy
+	if err := s.startServer(); err != nil {
+		log.Error("failed to start webdav server", zap.Error(err))
+	}
+	return nil
+}
+
+func (s *service) Close(_ context.Context) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.stopServer()
+}
+
+func (s *service) Start(addr string) (Info, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	settings := s.settings
+	if addr != "" {
+		settings.Addr = addr
+	}
+	if settings.Addr == "" {
+		settings.Addr = DefaultAddr
+	}
+	if err := checkLocalAddr(settings.Addr); err != nil {
+		return Info{}, err
+	}
+	if settings.Password == "" {
+		password, err := generatePassword()
+		if err != nil {
+			return Info{}, fmt.Errorf("generate password: %w", err)
+		}
+		settings.Password = password
+	}
+	settings.Enabled = true
+
+	if s.server != nil && s.server.Addr != settings.Addr {
+		if err := s.stopServer(); err != nil {
+			return Info{}, err
+		}
+	}
+	s.settings = settings
+	if s.server == nil {
+		if err := s.startServer(); err != nil {
+			return Info{}, err
+		}
+	}
+	if err := s.saveSettings(); err != nil {
+		return Info{}, err
+	}
+	return s.info(), nil
+}
+
+func (s *service) Stop() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.settings.Enabled = false
+	if err := s.saveSettings(); err != nil {
+		return err
+	}
+	return s.stopServer()
+}
+
+func (s *service) Info() (Info, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.server == nil {
+		return Info{}, ErrNotRunning
+	}
+	return s.info(), nil
+}
+
+func (s *service) info() Info {
+	return Info{
+		Url:      "http://" + s.settings.Addr + "/",
+		Username: Username,
+		Password: s.settings.Password,
+	}
+}
+
+func (s *service) saveSettings() error {
+	err := config.WriteJsonConfig(s.config.GetConfigPath(), config.ConfigWebDAV{WebDAV: s.settings})
+	if err != nil {
+		return fmt.Errorf("write config: %w", err)
+	}
+	return nil
+}
+
+func (s *service) startServer() error {
+	listener, err := net.Listen("tcp", s.settings.Addr)
+	if err != nil {
+		return fmt.Errorf("listen: %w", err)
+	}
+	handler := &webdav.Handler{
+		FileSystem: &fileSystem{service: s},
+		LockSystem: webdav.NewMemLS(),
+		Logger: func(r *http.Request, err error) {
+			if err != nil {
+				log.Debug("webdav request failed", zap.String("method", r.Method), zap.Error(err))
+			}
+		},
+	}
+	s.server = &http.Server{
+		Addr:              s.settings.Addr,
+		Handler:           basicAuth(withRequestTreeCache(handler), s.settings.Password),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func(srv *http.Server) {
+		err := srv.Serve(listener)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("webdav server error", zap.Error(err))
+			return
+		}
+		log.Info("webdav server was shutdown")
+	}(s.server)
+	log.Info("webdav server listening", zap.String("addr", s.settings.Addr))
+	return nil
+}
+
+func (s *service) stopServer() error {
+	if s.server == nil {
+		return nil
+	}
+	srv := s.server
+	s.server = nil
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil && !errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("shutdown: %w", err)
+	}
+	return nil
+}
+
+func basicAuth(next http.Handler, password string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		if !ok ||
+			subtle.ConstantTimeCompare([]byte(user), []byte(Username)) != 1 ||
+			subtle.ConstantTimeCompare([]byte(pass), []byte(password)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Basic realm="Anytype"`)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func withRequestTreeCache(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(withTreeCache(r.Context())))
+	})
+}
+
+func checkLocalAddr(addr string) error {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return fmt.Errorf("parse addr: %w", err)
+	}
+	if host == "localhost" {
+		return nil
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !ip.IsLoopback() {
+		return ErrNotLocalAddr
+	}
+	return nil
+}
+
+func generatePassword() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,399 @@
+package webdav
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/anyproto/anytype-heart/core/block/cache"
+	"github.com/anyproto/anytype-heart/core/block/editor/collection"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const (
+	typesFolder       = "Types"
+	collectionsFolder = "Collections"
+
+	untitledName = "Untitled"
+	mdExt        = ".md"
+	shortIdLen   = 6
+)
+
+// pageLayouts are rendered as markdown files
+var pageLayouts = []model.ObjectTypeLayout{
+	model.ObjectType_basic,
+	model.ObjectType_profile,
+	model.ObjectType_todo,
+	model.ObjectType_note,
+	model.ObjectType_bookmark,
+}
+
+var listedLayouts = append(append([]model.ObjectTypeLayout{}, pageLayouts...), domain.FileLayouts...)
+
+type nodeKind int
+
+const (
+	nodeRoot nodeKind = iota
+	nodeSpace
+	nodeGroup
+	nodeFolder
+	nodeFile
+	nodePage
+)
+
+// node is a resolved path of the tree: /<space>/<group>/<folder>/<object>
+type node struct {
+	kind     nodeKind
+	name     string
+	spaceId  string
+	group    string
+	folderId string
+	// id is the object id for files and pages, and the id of type or collection for folders
+	id      string
+	details *domain.Details
+}
+
+func (n *node) isDir() bool {
+	return n.kind != nodeFile && n.kind != nodePage
+}
+
+func (s *service) resolve(ctx context.Context, name string) (*node, error) {
+	parts := splitPath(name)
+	current := &node{kind: nodeRoot, name: "/"}
+	for _, part := range parts {
+		if !current.isDir() {
+			return nil, errNotExist
+		}
+		children, err := s.children(ctx, current)
+		if err != nil {
+			return nil, err
+		}
+		next, ok := findChild(children, part)
+		if !ok {
+			return nil, errNotExist
+		}
+		current = next
+	}
+	return current, nil
+}
+
+// treeCache keeps folder listings during one request, because a single request resolves the same path
+// several times: webdav handlers stat and open the file and its parents separately
+type treeCache struct {
+	lock     sync.Mutex
+	children map[nodeKey][]*node
+}
+
+type nodeKey struct {
+	kind     nodeKind
+	spaceId  string
+	group    string
+	folderId string
+}
+
+type treeCacheCtxKey struct{}
+
+func withTreeCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, treeCacheCtxKey{}, &treeCache{children: map[nodeKey][]*node{}})
+}
+
+func treeCacheFromContext(ctx context.Context) *treeCache {
+	cache, _ := ctx.Value(treeCacheCtxKey{}).(*treeCache)
+	return cache
+}
+
+func resetTreeCache(ctx context.Context) {
+	if cache := treeCacheFromContext(ctx); cache != nil {
+		cache.lock.Lock()
+		clear(cache.children)
+		cache.lock.Unlock()
+	}
+}
+
+func (s *service) children(ctx context.Context, parent *node) ([]*node, error) {
+	cache := treeCacheFromContext(ctx)
+	if cache == nil {
+		return s.listChildren(ctx, parent)
+	}
+	key := nodeKey{kind: parent.kind, spaceId: parent.spaceId, group: parent.group, folderId: parent.folderId}
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	if nodes, ok := cache.children[key]; ok {
+		return nodes, nil
+	}
+	nodes, err := s.listChildren(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+	cache.children[key] = nodes
+	return nodes, nil
+}
+
+func (s *service) listChildren(ctx context.Context, parent *node) ([]*node, error) {
+	switch parent.kind {
+	case nodeRoot:
+		return s.listSpaces()
+	case nodeSpace:
+		return []*node{
+			{kind: nodeGroup, name: typesFolder, spaceId: parent.spaceId, group: typesFolder},
+			{kind: nodeGroup, name: collectionsFolder, spaceId: parent.spaceId, group: collectionsFolder},
+		}, nil
+	case nodeGroup:
+		return s.listFolders(parent.spaceId, parent.group)
+	case nodeFolder:
+		return s.listObjects(ctx, parent)
+	default:
+		return nil, nil
+	}
+}
+
+func (s *service) listSpaces() ([]*node, error) {
+	records, err := s.objectStore.SpaceIndex(s.techSpaceIdProvider.TechSpaceId()).Query(database.Query{
+		Filters: []database.FilterRequest{
+			{
+				RelationKey: bundle.RelationKeyResolvedLayout,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       domain.Int64(model.ObjectType_spaceView),
+			},
+			{
+				RelationKey: bundle.RelationKeySpaceLocalStatus,
+				Condition:   model.BlockContentDataviewFilter_In,
+				Value:       domain.Int64List([]model.SpaceStatus{model.SpaceStatus_Unknown, model.SpaceStatus_Ok}),
+			},
+			{
+				RelationKey: bundle.RelationKeySpaceAccountStatus,
+				Condition:   model.BlockContentDataviewFilter_In,
+				Value:       domain.Int64List([]model.SpaceStatus{model.SpaceStatus_Unknown, model.SpaceStatus_SpaceActive}),
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query spaces: %w", err)
+	}
+	nodes := make([]*node, 0, len(records))
+	for _, rec := range records {
+		spaceId := rec.Details.GetString(bundle.RelationKeyTargetSpaceId)
+		nodes = append(nodes, &node{
+			kind:    nodeSpace,
+			name:    sanitizeName(rec.Details.GetString(bundle.RelationKeyName)),
+			spaceId: spaceId,
+			id:      spaceId,
+			details: rec.Details,
+		})
+	}
+	return assignUniqueNames(nodes), nil
+}
+
+func (s *service) listFolders(spaceId string, group string) ([]*node, error) {
+	var filters []database.FilterRequest
+	switch group {
+	case typesFolder:
+		filters = []database.FilterRequest{
+			{
+				RelationKey: bundle.RelationKeyResolvedLayout,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       domain.Int64(model.ObjectType_objectType),
+			},
+			{
+				RelationKey: bundle.RelationKeyRecommendedLayout,
+				Condition:   model.BlockContentDataviewFilter_In,
+				Value:       domain.Int64List(listedLayouts),
+			},
+			{
+				RelationKey: bundle.RelationKeyIsHidden,
+				Condition:   model.BlockContentDataviewFilter_NotEqual,
+				Value:       domain.Bool(true),
+			},
+		}
+	case collectionsFolder:
+		filters = []database.FilterRequest{
+			{
+				RelationKey: bundle.RelationKeyResolvedLayout,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       domain.Int64(model.ObjectType_collection),
+			},
+		}
+	default:
+		return nil, errNotExist
+	}
+
+	records, err := s.objectStore.SpaceIndex(spaceId).Query(database.Query{Filters: filters})
+	if err != nil {
+		return nil, fmt.Errorf("query folders: %w", err)
+	}
+	nodes := make([]*node, 0, len(records))
+	for _, rec := range records {
+		nodes = append(nodes, &node{
+			kind:     nodeFolder,
+			name:     sanitizeName(rec.Details.GetString(bundle.RelationKeyName)),
+			spaceId:  spaceId,
+			group:    group,
+			folderId: rec.Details.GetString(bundle.RelationKeyId),
+			id:       rec.Details.GetString(bundle.RelationKeyId),
+			details:  rec.Details,
+		})
+	}
+	return assignUniqueNames(nodes), nil
+}
+
+func (s *service) listObjects(ctx context.Context, folder *node) ([]*node, error) {
+	filters := []database.FilterRequest{
+		{
+			RelationKey: bundle.RelationKeyResolvedLayout,
+			Condition:   model.BlockContentDataviewFilter_In,
+			Value:       domain.Int64List(listedLayouts),
+		},
+	}
+	switch folder.group {
+	case typesFolder:
+		filters = append(filters, database.FilterRequest{
+			RelationKey: bundle.RelationKeyType,
+			Condition:   model.BlockContentDataviewFilter_Equal,
+			Value:       domain.String(folder.folderId),
+		})
+	case collectionsFolder:
+		var ids []string
+		err := cache.DoContextFullID(s.picker, ctx, domain.FullID{SpaceID: folder.spaceId, ObjectID: folder.folderId}, func(c collection.Collection) error {
+			ids = c.ListIdsFromCollection()
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list collection: %w", err)
+		}
+		if len(ids) == 0 {
+			return nil, nil
+		}
+		filters = append(filters, database.FilterRequest{
+			RelationKey: bundle.RelationKeyId,
+			Condition:   model.BlockContentDataviewFilter_In,
+			Value:       domain.StringList(ids),
+		})
+	}
+
+	records, err := s.objectStore.SpaceIndex(folder.spaceId).Query(database.Query{Filters: filters})
+	if err != nil {
+		return nil, fmt.Errorf("query objects: %w", err)
+	}
+	nodes := make([]*node, 0, len(records))
+	for _, rec := range records {
+		n := &node{
+			kind:     nodePage,
+			spaceId:  folder.spaceId,
+			group:    folder.group,
+			folderId: folder.folderId,
+			id:       rec.Details.GetString(bundle.RelationKeyId),
+			details:  rec.Details,
+		}
+		if isFileLayout(rec.Details) {
+			n.kind = nodeFile
+		}
+		n.name = objectFileName(n.kind, rec.Details)
+		nodes = append(nodes, n)
+	}
+	return assignUniqueNames(nodes), nil
+}
+
+func isFileLayout(details *domain.Details) bool {
+	return slices.Contains(domain.FileLayouts, model.ObjectTypeLayout(details.GetInt64(bundle.RelationKeyResolvedLayout)))
+}
+
+// objectFileName returns the name of the object in the tree. File objects keep their extension, pages get .md
+func objectFileName(kind nodeKind, details *domain.Details) string {
+	name := sanitizeName(details.GetString(bundle.RelationKeyName))
+	if kind == nodePage {
+		return name + mdExt
+	}
+	ext := details.GetString(bundle.RelationKeyFileExt)
+	if ext == "" || strings.EqualFold(path.Ext(name), "."+ext) {
+		return name
+	}
+	return name + "." + ext
+}
+
+// sanitizeName makes the name safe to use as a path segment on common file systems
+func sanitizeName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsControl(r):
+			return -1
+		case strings.ContainsRune(`/\:*?"<>|`, r):
+			return '_'
+		}
+		return r
+	}, name)
+	name = strings.TrimSpace(name)
+	// hidden files are not expected by users and are skipped by file managers
+	name = strings.TrimLeft(name, ".")
+	if name == "" {
+		return untitledName
+	}
+	return name
+}
+
+// assignUniqueNames makes names unique in case-insensitive manner. The oldest object keeps its name,
+// others get a suffix derived from the object id, so names are stable between listings
+func assignUniqueNames(nodes []*node) []*node {
+	sort.SliceStable(nodes, func(i, j int) bool {
+		ci, cj := nodes[i].details.GetInt64(bundle.RelationKeyCreatedDate), nodes[j].details.GetInt64(bundle.RelationKeyCreatedDate)
+		if ci != cj {
+			return ci < cj
+		}
+		return nodes[i].id < nodes[j].id
+	})
+	taken := make(map[string]struct{}, len(nodes))
+	for _, n := range nodes {
+		key := strings.ToLower(n.name)
+		if _, ok := taken[key]; ok {
+			n.name = withIdSuffix(n.name, n.id, n.isDir())
+			key = strings.ToLower(n.name)
+		}
+		taken[key] = struct{}{}
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].name < nodes[j].name
+	})
+	return nodes
+}
+
+func withIdSuffix(name string, id string, isDir bool) string {
+	shortId := id
+	if len(shortId) > shortIdLen {
+		shortId = shortId[len(shortId)-shortIdLen:]
+	}
+	var ext string
+	if !isDir {
+		ext = path.Ext(name)
+	}
+	return fmt.Sprintf("%s (%s)%s", strings.TrimSuffix(name, ext), shortId, ext)
+}
+
+func findChild(children []*node, name string) (*node, bool) {
+	for _, child := range children {
+		if child.name == name {
+			return child, true
+		}
+	}
+	// file managers on case-insensitive file systems may change the case of the name
+	for _, child := range children {
+		if strings.EqualFold(child.name, name) {
+			return child, true
+		}
+	}
+	return nil, false
+}
+
+func splitPath(name string) []string {
+	name = strings.Trim(path.Clean("/"+name), "/")
+	if name == "" {
+		return nil
+	}
+	return strings.Split(name, "/")
+}
@@ -0,0 +1,139 @@
+package webdav
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func TestSanitizeName(t *testing.T) {
+	for _, tc := range []struct {
+		name, expected string
+	}{
+		{"Report", "Report"},
+		{"  a/b\\c:d  ", "a_b_c_d"},
+		{".hidden", "hidden"},
+		{"line\nbreak", "linebreak"},
+		{"", untitledName},
+		{"...", untitledName},
+	} {
+		assert.Equal(t, tc.expected, sanitizeName(tc.name))
+	}
+}
+
+func TestObjectFileName(t *testing.T) {
+	t.Run("page", func(t *testing.T) {
+		details := domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyName: domain.String("Meeting notes"),
+		})
+		assert.Equal(t, "Meeting notes.md", objectFileName(nodePage, details))
+	})
+	t.Run("file without extension in name", func(t *testing.T) {
+		details := domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyName:           domain.String("photo"),
+			bundle.RelationKeyFileExt:        domain.String("jpg"),
+			bundle.RelationKeyResolvedLayout: domain.Int64(model.ObjectType_image),
+		})
+		assert.Equal(t, "photo.jpg", objectFileName(nodeFile, details))
+	})
+	t.Run("file with extension in name", func(t *testing.T) {
+		details := domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyName:    domain.String("photo.JPG"),
+			bundle.RelationKeyFileExt: domain.String("jpg"),
+		})
+		assert.Equal(t, "photo.JPG", objectFileName(nodeFile, details))
+	})
+}
+
+func TestAssignUniqueNames(t *testing.T) {
+	newNode := func(id, name string, createdDate int64) *node {
+		return &node{
+			kind: nodePage,
+			id:   id,
+			name: name,
+			details: domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+				bundle.RelationKeyCreatedDate: domain.Int64(createdDate),
+			}),
+		}
+	}
+
+	nodes := assignUniqueNames([]*node{
+		newNode("bafyreinewer", "Notes.md", 20),
+		newNode("bafyreiolder", "notes.md", 10),
+		newNode("bafyreiother", "Other.md", 5),
+	})
+
+	var names []string
+	for _, n := range nodes {
+		names = append(names, n.name)
+	}
+	assert.Equal(t, []string{"Notes (inewer).md", "Other.md", "notes.md"}, names)
+}
+
+func TestSplitPath(t *testing.T) {
+	assert.Empty(t, splitPath("/"))
+	assert.Empty(t, splitPath(""))
+	assert.Equal(t, []string{"Space", "Types", "Page"}, splitPath("/Space/Types/Page/"))
+	assert.Equal(t, []string{"Space"}, splitPath("/Space/Types/../"))
+}
+
+func TestCheckLocalAddr(t *testing.T) {
+	assert.NoError(t, checkLocalAddr("127.0.0.1:47900"))
+	assert.NoError(t, checkLocalAddr("localhost:47900"))
+	assert.NoError(t, checkLocalAddr("[::1]:47900"))
+	assert.ErrorIs(t, checkLocalAddr("0.0.0.0:47900"), ErrNotLocalAddr)
+	assert.ErrorIs(t, checkLocalAddr("192.168.1.10:47900"), ErrNotLocalAddr)
+	assert.Error(t, checkLocalAddr("47900"))
+}
+
+func TestTreeCache(t *testing.T) {
+	s := &service{}
+	spaceNode := &node{kind: nodeSpace, spaceId: "space1"}
+
+	t.Run("without cache", func(t *testing.T) {
+		first, err := s.children(context.Background(), spaceNode)
+		require.NoError(t, err)
+		second, err := s.children(context.Background(), spaceNode)
+		require.NoError(t, err)
+		assert.NotSame(t, first[0], second[0])
+	})
+	t.Run("listing is reused during the request", func(t *testing.T) {
+		ctx := withTreeCache(context.Background())
+		first, err := s.children(ctx, spaceNode)
+		require.NoError(t, err)
+		second, err := s.children(ctx, spaceNode)
+		require.NoError(t, err)
+		assert.Same(t, first[0], second[0])
+
+		resetTreeCache(ctx)
+		third, err := s.children(ctx, spaceNode)
+		require.NoError(t, err)
+		assert.NotSame(t, first[0], third[0])
+	})
+}
+
+func TestFileTypeKey(t *testing.T) {
+	t.Run("file type", func(t *testing.T) {
+		details := domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyUniqueKey:         domain.String(bundle.TypeKeyImage.URL()),
+			bundle.RelationKeyRecommendedLayout: domain.Int64(model.ObjectType_image),
+		})
+		typeKey, err := fileTypeKey(details)
+		require.NoError(t, err)
+		assert.Equal(t, bundle.TypeKeyImage, typeKey)
+	})
+	t.Run("page type", func(t *testing.T) {
+		details := domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyUniqueKey:         domain.String(bundle.TypeKeyPage.URL()),
+			bundle.RelationKeyRecommendedLayout: domain.Int64(model.ObjectType_basic),
+		})
+		_, err := fileTypeKey(details)
+		assert.ErrorIs(t, err, errPermission)
+	})
+}
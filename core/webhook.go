@@ -0,0 +1,132 @@
+package core
+
+import (
+	"context"
+
+	"github.com/anyproto/anytype-heart/core/webhook"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+var webhookEvents = map[pb.RpcWebhookEvent]webhook.EventKind{
+	pb.RpcWebhook_ObjectCreated:  webhook.EventObjectCreated,
+	pb.RpcWebhook_ObjectUpdated:  webhook.EventObjectUpdated,
+	pb.RpcWebhook_ObjectArchived: webhook.EventObjectArchived,
+	pb.RpcWebhook_MessagePosted:  webhook.EventMessagePosted,
+}
+
+var webhookDeliveryStatuses = map[webhook.DeliveryStatus]pb.RpcWebhookDeliveryStatus{
+	webhook.DeliveryPending:   pb.RpcWebhookDelivery_Pending,
+	webhook.DeliveryDelivered: pb.RpcWebhookDelivery_Delivered,
+	webhook.DeliveryFailed:    pb.RpcWebhookDelivery_Failed,
+}
+
+func (mw *Middleware) WebhookCreate(cctx context.Context, req *pb.RpcWebhookCreateRequest) *pb.RpcWebhookCreateResponse {
+	events := make([]webhook.EventKind, 0, len(req.Events))
+	for _, ev := range req.Events {
+		events = append(events, webhookEvents[ev])
+	}
+	hook, err := mustService[webhook.Service](mw).Create(cctx, webhook.CreateRequest{
+		SpaceId:       req.SpaceId,
+		Url:           req.Url,
+		Events:        events,
+		ObjectTypeIds: req.ObjectTypeIds,
+	})
+	code := mapErrorCode(err,
+		errToCode(webhook.ErrEmptySpaceId, pb.RpcWebhookCreateResponseError_BAD_INPUT),
+		errToCode(webhook.ErrInvalidUrl, pb.RpcWebhookCreateResponseError_BAD_INPUT),
+		errToCode(webhook.ErrUnknownEvent, pb.RpcWebhookCreateResponseError_BAD_INPUT),
+		errToCode(webhook.ErrNotLocalUrl, pb.RpcWebhookCreateResponseError_NOT_LOCAL_URL),
+	)
+	resp := &pb.RpcWebhookCreateResponse{
+		Error: &pb.RpcWebhookCreateResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+	if err == nil {
+		resp.Webhook = webhookToProto(hook)
+		resp.Secret = hook.Secret
+	}
+	return resp
+}
+
+func (mw *Middleware) WebhookList(cctx context.Context, req *pb.RpcWebhookListRequest) *pb.RpcWebhookListResponse {
+	hooks, err := mustService[webhook.Service](mw).List(cctx, req.SpaceId)
+	code := mapErrorCode(err,
+		errToCode(webhook.ErrEmptySpaceId, pb.RpcWebhookListResponseError_BAD_INPUT),
+	)
+	resp := &pb.RpcWebhookListResponse{
+		Error: &pb.RpcWebhookListResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+	for _, hook := range hooks {
+		resp.Webhooks = append(resp.Webhooks, webhookToProto(hook))
+	}
+	return resp
+}
+
+func (mw *Middleware) WebhookRemove(cctx context.Context, req *pb.RpcWebhookRemoveRequest) *pb.RpcWebhookRemoveResponse {
+	err := mustService[webhook.Service](mw).Remove(cctx, req.Id)
+	code := mapErrorCode(err,
+		errToCode(webhook.ErrNotFound, pb.RpcWebhookRemoveResponseError_NOT_FOUND),
+	)
+	return &pb.RpcWebhookRemoveResponse{
+		Error: &pb.RpcWebhookRemoveResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
+
+func (mw *Middleware) WebhookDeliveryList(cctx context.Context, req *pb.RpcWebhookDeliveryListRequest) *pb.RpcWebhookDeliveryListResponse {
+	deliveries, err := mustService[webhook.Service](mw).Deliveries(cctx, req.WebhookId, int(req.Limit))
+	code := mapErrorCode(err,
+		errToCode(webhook.ErrNotFound, pb.RpcWebhookDeliveryListResponseError_NOT_FOUND),
+	)
+	resp := &pb.RpcWebhookDeliveryListResponse{
+		Error: &pb.RpcWebhookDeliveryListResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+	for _, delivery := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, &pb.RpcWebhookDelivery{
+			Id:              delivery.Id,
+			WebhookId:       delivery.WebhookId,
+			Event:           webhookEventToProto(delivery.Event),
+			Status:          webhookDeliveryStatuses[delivery.Status],
+			Attempts:        int32(delivery.Attempts),
+			ResponseCode:    int32(delivery.ResponseCode),
+			Error:           delivery.Error,
+			CreatedDate:     delivery.CreatedDate,
+			LastAttemptDate: delivery.LastAttemptDate,
+			NextAttemptDate: delivery.NextAttemptDate,
+		})
+	}
+	return resp
+}
+
+func webhookToProto(hook webhook.Webhook) *pb.RpcWebhookInfo {
+	info := &pb.RpcWebhookInfo{
+		Id:            hook.Id,
+		SpaceId:       hook.SpaceId,
+		Url:           hook.Url,
+		ObjectTypeIds: hook.ObjectTypeIds,
+		CreatedDate:   hook.CreatedDate,
+	}
+	for _, kind := range hook.Events {
+		info.Events = append(info.Events, webhookEventToProto(kind))
+	}
+	return info
+}
+
+func webhookEventToProto(kind webhook.EventKind) pb.RpcWebhookEvent {
+	for ev, k := range webhookEvents {
+		if k == kind {
+			return ev
+		}
+	}
+	return pb.RpcWebhook_ObjectUpdated
+}
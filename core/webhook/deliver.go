@@ -0,0 +1,150 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	SignatureHeader = "X-Anytype-Signature"
+	TimestampHeader = "X-Anytype-Timestamp"
+	EventHeader     = "X-Anytype-Event"
+	DeliveryHeader  = "X-Anytype-Delivery"
+
+	signaturePrefix = "sha256="
+	requestTimeout  = 10 * time.Second
+	// maxErrorBodySize limits the part of the response body saved to the delivery log
+	maxErrorBodySize = 256
+)
+
+// Payload is the JSON body of webhook requests
+type Payload struct {
+	// Id is the id of the delivery, it is the same for all attempts, so receivers can skip duplicates
+	Id        string          `json:"id"`
+	Event     EventKind       `json:"event"`
+	WebhookId string          `json:"webhookId"`
+	SpaceId   string          `json:"spaceId"`
+	Time      int64           `json:"time"`
+	Object    *ObjectPayload  `json:"object,omitempty"`
+	Message   *MessagePayload `json:"message,omitempty"`
+}
+
+type ObjectPayload struct {
+	Id               string `json:"id"`
+	Name             string `json:"name,omitempty"`
+	TypeId           string `json:"typeId,omitempty"`
+	CreatedDate      int64  `json:"createdDate,omitempty"`
+	LastModifiedDate int64  `json:"lastModifiedDate,omitempty"`
+}
+
+type MessagePayload struct {
+	Id        string `json:"id"`
+	Creator   string `json:"creator"`
+	Text      string `json:"text,omitempty"`
+	CreatedAt int64  `json:"createdAt"`
+}
+
+// deliveryItem is a pending delivery stored in the persistent queue
+type deliveryItem struct {
+	Id        string    `json:"id"`
+	WebhookId string    `json:"webhookId"`
+	Event     EventKind `json:"event"`
+	// Body is kept as a string, so every attempt sends and signs the same bytes
+	Body     string `json:"body"`
+	Attempts int    `json:"attempts"`
+	// timestamps are stored as strings, as the queue storage reads large numbers back in exponent notation
+	CreatedDate   int64 `json:"createdDate,string"`
+	NextAttemptAt int64 `json:"nextAttemptAt,string"`
+}
+
+func makeDeliveryItem() *deliveryItem {
+	return &deliveryItem{}
+}
+
+func (it *deliveryItem) Key() string {
+	return it.Id
+}
+
+func nextAttemptLess(one, other *deliveryItem) bool {
+	return one.NextAttemptAt < other.NextAttemptAt
+}
+
+// Sign returns the signature of the request body sent at the timestamp. Receivers compute it with the secret of the
+// webhook and compare with the X-Anytype-Signature header
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// newHttpClient returns the client that connects only to local addresses, as host names of webhooks
+// could be resolved to addresses in the internet. Redirects are not followed for the same reason
+func newHttpClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: requestTimeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !isLocalIp(ip) {
+				return ErrNotLocalUrl
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Transport: transport,
+		Timeout:   requestTimeout,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// send makes one delivery attempt. The response code is returned along with the error when the receiver
+// doesn't respond with 2xx
+func send(ctx context.Context, client *http.Client, hook Webhook, item *deliveryItem, now time.Time) (int, error) {
+	body := []byte(item.Body)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("create request: %w", err)
+	}
+	timestamp := now.Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Anytype-Webhook")
+	req.Header.Set(EventHeader, string(item.Event))
+	req.Header.Set(DeliveryHeader, item.Id)
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, Sign(hook.Secret, timestamp, body))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return resp.StatusCode, nil
+	}
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	if len(respBody) > 0 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, respBody)
+	}
+	return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
+}
@@ -0,0 +1,225 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	anystore "github.com/anyproto/any-store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/util/persistentqueue"
+)
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+// newReceiver starts a local HTTP server that responds with the given status codes in turn
+func newReceiver(t *testing.T, codes ...int) (*httptest.Server, chan receivedRequest) {
+	requests := make(chan receivedRequest, 10)
+	var n int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- receivedRequest{header: r.Header, body: body}
+		code := http.StatusOK
+		if n < len(codes) {
+			code = codes[n]
+		}
+		n++
+		w.WriteHeader(code)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, requests
+}
+
+type fixture struct {
+	*service
+}
+
+func newFixture(t *testing.T) *fixture {
+	ctx := context.Background()
+	db, err := anystore.Open(ctx, filepath.Join(t.TempDir(), "store.db"), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+	s := &service{client: newHttpClient()}
+	s.store, err = newStore(db)
+	require.NoError(t, err)
+	s.queueStorage, err = persistentqueue.NewAnystoreStorage(db, queueCollectionName, makeDeliveryItem)
+	require.NoError(t, err)
+	return &fixture{service: s}
+}
+
+func (fx *fixture) addWebhook(t *testing.T, url string) Webhook {
+	hook := Webhook{Id: "hook1", SpaceId: "space1", Url: url, Secret: "secret"}
+	require.NoError(t, fx.store.webhooks.Set(context.Background(), hook.Id, hook))
+	return hook
+}
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"id":"1"}`)
+
+	signature := Sign("secret", 1700000000, body)
+
+	assert.Equal(t, "sha256=", signature[:7])
+	assert.Len(t, signature, 7+64)
+	assert.Equal(t, signature, Sign("secret", 1700000000, body))
+	assert.NotEqual(t, signature, Sign("other", 1700000000, body))
+	assert.NotEqual(t, signature, Sign("secret", 1700000001, body))
+}
+
+func TestSend(t *testing.T) {
+	t.Run("request is signed", func(t *testing.T) {
+		srv, requests := newReceiver(t)
+		hook := Webhook{Url: srv.URL, Secret: "secret"}
+		item := &deliveryItem{Id: "hook1.d1", Event: EventObjectCreated, Body: `{"id":"hook1.d1"}`}
+		now := time.Unix(1700000000, 0)
+
+		code, err := send(context.Background(), newHttpClient(), hook, item, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		req := <-requests
+		assert.Equal(t, item.Body, string(req.body))
+		assert.Equal(t, "application/json", req.header.Get("Content-Type"))
+		assert.Equal(t, string(EventObjectCreated), req.header.Get(EventHeader))
+		assert.Equal(t, item.Id, req.header.Get(DeliveryHeader))
+		assert.Equal(t, strconv.FormatInt(now.Unix(), 10), req.header.Get(TimestampHeader))
+		assert.Equal(t, Sign("secret", now.Unix(), req.body), req.header.Get(SignatureHeader))
+	})
+
+	t.Run("non 2xx response is an error", func(t *testing.T) {
+		srv, _ := newReceiver(t, http.StatusInternalServerError)
+
+		code, err := send(context.Background(), newHttpClient(), Webhook{Url: srv.URL}, &deliveryItem{Body: "{}"}, time.Now())
+
+		require.Error(t, err)
+		assert.Equal(t, http.StatusInternalServerError, code)
+	})
+
+	t.Run("redirects are not followed", func(t *testing.T) {
+		srv := httptest.NewServer(http.RedirectHandler("https://example.com", http.StatusFound))
+		defer srv.Close()
+
+		code, err := send(context.Background(), newHttpClient(), Webhook{Url: srv.URL}, &deliveryItem{Body: "{}"}, time.Now())
+
+		require.Error(t, err)
+		assert.Equal(t, http.StatusFound, code)
+	})
+}
+
+func TestDeliver(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("delivered", func(t *testing.T) {
+		fx := newFixture(t)
+		srv, requests := newReceiver(t)
+		fx.addWebhook(t, srv.URL)
+		item := &deliveryItem{Id: "hook1.d1", WebhookId: "hook1", Event: EventObjectUpdated, Body: "{}", CreatedDate: 100}
+
+		action, err := fx.deliver(ctx, item)
+
+		require.NoError(t, err)
+		assert.Equal(t, persistentqueue.ActionDone, action)
+		<-requests
+		deliveries, err := fx.store.listDeliveries(ctx, "hook1", 0)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, DeliveryDelivered, deliveries[0].Status)
+		assert.Equal(t, 1, deliveries[0].Attempts)
+		assert.Equal(t, http.StatusOK, deliveries[0].ResponseCode)
+	})
+
+	t.Run("failed attempt is retried with backoff", func(t *testing.T) {
+		fx := newFixture(t)
+		srv, _ := newReceiver(t, http.StatusServiceUnavailable)
+		fx.addWebhook(t, srv.URL)
+		item := &deliveryItem{Id: "hook1.d1", WebhookId: "hook1", Event: EventObjectUpdated, Body: "{}"}
+		require.NoError(t, fx.queueStorage.Put(item))
+		before := time.Now().Unix()
+
+		action, err := fx.deliver(ctx, item)
+
+		require.NoError(t, err)
+		assert.Equal(t, persistentqueue.ActionRetry, action)
+		assert.Equal(t, 1, item.Attempts)
+		assert.GreaterOrEqual(t, item.NextAttemptAt, before+int64(baseRetryDelay/time.Second))
+
+		stored, err := fx.queueStorage.List()
+		require.NoError(t, err)
+		require.Len(t, stored, 1)
+		assert.Equal(t, 1, stored[0].Attempts)
+		assert.Equal(t, item.NextAttemptAt, stored[0].NextAttemptAt)
+
+		deliveries, err := fx.store.listDeliveries(ctx, "hook1", 0)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, DeliveryPending, deliveries[0].Status)
+		assert.Equal(t, http.StatusServiceUnavailable, deliveries[0].ResponseCode)
+		assert.NotEmpty(t, deliveries[0].Error)
+
+		t.Run("not due item is not sent", func(t *testing.T) {
+			action, err := fx.deliver(ctx, item)
+
+			require.NoError(t, err)
+			assert.Equal(t, persistentqueue.ActionRetry, action)
+			assert.Equal(t, 1, item.Attempts)
+		})
+	})
+
+	t.Run("delivery fails after max attempts", func(t *testing.T) {
+		fx := newFixture(t)
+		srv, _ := newReceiver(t, http.StatusBadRequest)
+		fx.addWebhook(t, srv.URL)
+		item := &deliveryItem{Id: "hook1.d1", WebhookId: "hook1", Body: "{}", Attempts: maxAttempts - 1}
+
+		action, err := fx.deliver(ctx, item)
+
+		require.NoError(t, err)
+		assert.Equal(t, persistentqueue.ActionDone, action)
+		deliveries, err := fx.store.listDeliveries(ctx, "hook1", 0)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, DeliveryFailed, deliveries[0].Status)
+		assert.Equal(t, maxAttempts, deliveries[0].Attempts)
+	})
+
+	t.Run("deliveries of removed webhooks are dropped", func(t *testing.T) {
+		fx := newFixture(t)
+
+		action, err := fx.deliver(ctx, &deliveryItem{Id: "hook1.d1", WebhookId: "hook1", Body: "{}"})
+
+		require.NoError(t, err)
+		assert.Equal(t, persistentqueue.ActionDone, action)
+	})
+}
+
+func TestDeliveryLog(t *testing.T) {
+	ctx := context.Background()
+	fx := newFixture(t)
+
+	for i := range deliveryLogSize + 5 {
+		require.NoError(t, fx.store.saveDelivery(ctx, Delivery{Id: strconv.Itoa(i), WebhookId: "hook1", Status: DeliveryPending}))
+	}
+	require.NoError(t, fx.store.saveDelivery(ctx, Delivery{Id: "104", WebhookId: "hook1", Status: DeliveryDelivered}))
+
+	deliveries, err := fx.store.listDeliveries(ctx, "hook1", 0)
+	require.NoError(t, err)
+	require.Len(t, deliveries, deliveryLogSize)
+	assert.Equal(t, "104", deliveries[0].Id)
+	assert.Equal(t, DeliveryDelivered, deliveries[0].Status)
+	assert.Equal(t, "5", deliveries[len(deliveries)-1].Id)
+
+	deliveries, err = fx.store.listDeliveries(ctx, "hook1", 3)
+	require.NoError(t, err)
+	assert.Len(t, deliveries, 3)
+}
@@ -0,0 +1,352 @@
+package webhook
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anyproto/any-sync/app"
+	"go.uber.org/zap"
+
+	"github.com/anyproto/anytype-heart/core/block/chats"
+	"github.com/anyproto/anytype-heart/core/subscription"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/datastore/anystoreprovider"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/util/persistentqueue"
+)
+
+const (
+	CName = "core.webhook"
+
+	defaultDeliveriesLimit = 50
+	deliveryWorkers        = 4
+)
+
+// retryPause is the pause of a queue worker when the next delivery is not due yet
+var retryPause = time.Second
+
+var log = logging.Logger(CName).Desugar()
+
+var (
+	ErrEmptySpaceId = errors.New("space id is empty")
+	ErrInvalidUrl   = errors.New("url must be an http or https url")
+	ErrNotLocalUrl  = errors.New("url must point to the local machine or the local network")
+	ErrUnknownEvent = errors.New("unknown event")
+	ErrNotFound     = errors.New("webhook not found")
+)
+
+type Service interface {
+	app.ComponentRunnable
+
+	// Create registers the webhook. The returned webhook contains the secret used to sign requests
+	Create(ctx context.Context, req CreateRequest) (Webhook, error)
+	List(ctx context.Context, spaceId string) ([]Webhook, error)
+	// Remove removes the webhook, its pending deliveries are dropped
+	Remove(ctx context.Context, id string) error
+	// Deliveries returns the latest deliveries of the webhook, newest first
+	Deliveries(ctx context.Context, webhookId string, limit int) ([]Delivery, error)
+}
+
+type service struct {
+	objectStore         objectstore.ObjectStore
+	subscriptionService subscription.Service
+	chatService         chats.Service
+	store               *store
+	queueStorage        persistentqueue.Storage[*deliveryItem]
+	queue               *persistentqueue.Queue[*deliveryItem]
+	client              *http.Client
+
+	componentCtx       context.Context
+	componentCtxCancel context.CancelFunc
+
+	watchersLock sync.Mutex
+	// watchers are started for spaces with webhooks
+	watchers map[string]*spaceWatcher
+}
+
+func New() Service {
+	return &service{
+		watchers: map[string]*spaceWatcher{},
+	}
+}
+
+func (s *service) Init(a *app.App) (err error) {
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	s.subscriptionService = app.MustComponent[subscription.Service](a)
+	s.chatService = app.MustComponent[chats.Service](a)
+	s.componentCtx, s.componentCtxCancel = context.WithCancel(context.Background())
+	s.client = newHttpClient()
+
+	provider := app.MustComponent[anystoreprovider.Provider](a)
+	db := provider.GetCommonDb()
+	s.store, err = newStore(db)
+	if err != nil {
+		return fmt.Errorf("init store: %w", err)
+	}
+	s.queueStorage, err = persistentqueue.NewAnystoreStorage(db, queueCollectionName, makeDeliveryItem)
+	if err != nil {
+		return fmt.Errorf("init queue storage: %w", err)
+	}
+	s.queue = persistentqueue.New(s.queueStorage, log, s.deliver, nextAttemptLess,
+		persistentqueue.WithContext(s.componentCtx),
+		persistentqueue.WithRetryPause(retryPause),
+		persistentqueue.WithWorkersNumber(deliveryWorkers),
+	)
+	return nil
+}
+
+func (s *service) Name() string {
+	return CName
+}
+
+func (s *service) Run(ctx context.Context) error {
+	s.queue.Run()
+	hooks, err := s.store.webhooks.ListAllValues(ctx)
+	if err != nil {
+		return fmt.Errorf("list webhooks: %w", err)
+	}
+	for _, hook := range hooks {
+		if err = s.startWatcher(hook.SpaceId); err != nil {
+			log.Warn("start space watcher", zap.String("spaceId", hook.SpaceId), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func (s *service) Close(_ context.Context) error {
+	if s.componentCtxCancel != nil {
+		s.componentCtxCancel()
+	}
+	s.watchersLock.Lock()
+	spaceIds := make([]string, 0, len(s.watchers))
+	for spaceId := range s.watchers {
+		spaceIds = append(spaceIds, spaceId)
+	}
+	s.watchersLock.Unlock()
+	for _, spaceId := range spaceIds {
+		s.stopWatcher(spaceId)
+	}
+	if s.queue != nil {
+		return s.queue.Close()
+	}
+	return nil
+}
+
+func (s *service) Create(ctx context.Context, req CreateRequest) (Webhook, error) {
+	if err := req.validate(); err != nil {
+		return Webhook{}, err
+	}
+	id, err := randomHex(idLength)
+	if err != nil {
+		return Webhook{}, err
+	}
+	secret, err := randomHex(secretLength)
+	if err != nil {
+		return Webhook{}, err
+	}
+	hook := Webhook{
+		Id:            id,
+		SpaceId:       req.SpaceId,
+		Url:           req.Url,
+		Secret:        secret,
+		Events:        req.Events,
+		ObjectTypeIds: req.ObjectTypeIds,
+		CreatedDate:   time.Now().Unix(),
+	}
+	if err = s.store.webhooks.Set(ctx, id, hook); err != nil {
+		return Webhook{}, fmt.Errorf("save webhook: %w", err)
+	}
+	if err = s.startWatcher(req.SpaceId); err != nil {
+		return Webhook{}, err
+	}
+	return hook, nil
+}
+
+func (s *service) List(ctx context.Context, spaceId string) ([]Webhook, error) {
+	if spaceId == "" {
+		return nil, ErrEmptySpaceId
+	}
+	return s.spaceWebhooks(ctx, spaceId)
+}
+
+func (s *service) Remove(ctx context.Context, id string) error {
+	hook, err := s.store.getWebhook(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err = s.store.removeWebhook(ctx, id); err != nil {
+		return err
+	}
+	err = s.queue.RemoveBy(func(key string) bool {
+		return strings.HasPrefix(key, deliveryIdPrefix(id))
+	})
+	if err != nil {
+		log.Warn("remove pending deliveries", zap.String("webhookId", id), zap.Error(err))
+	}
+	rest, err := s.spaceWebhooks(ctx, hook.SpaceId)
+	if err != nil {
+		return err
+	}
+	if len(rest) == 0 {
+		s.stopWatcher(hook.SpaceId)
+		if err = s.store.cursors.Delete(ctx, hook.SpaceId); err != nil {
+			log.Warn("remove webhook cursor", zap.String("spaceId", hook.SpaceId), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func (s *service) Deliveries(ctx context.Context, webhookId string, limit int) ([]Delivery, error) {
+	if _, err := s.store.getWebhook(ctx, webhookId); err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = defaultDeliveriesLimit
+	}
+	deliveries, err := s.store.listDeliveries(ctx, webhookId, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+func (s *service) spaceWebhooks(ctx context.Context, spaceId string) ([]Webhook, error) {
+	all, err := s.store.webhooks.ListAllValues(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list webhooks: %w", err)
+	}
+	var hooks []Webhook
+	for _, hook := range all {
+		if hook.SpaceId == spaceId {
+			hooks = append(hooks, hook)
+		}
+	}
+	slices.SortFunc(hooks, func(a, b Webhook) int {
+		return cmp.Compare(a.CreatedDate, b.CreatedDate)
+	})
+	return hooks, nil
+}
+
+// enqueue adds deliveries of the event to webhooks of the space that match it
+func (s *service) enqueue(ctx context.Context, spaceId string, ev objectEvent) error {
+	hooks, err := s.spaceWebhooks(ctx, spaceId)
+	if err != nil {
+		return err
+	}
+	typeId := ev.details.GetString(bundle.RelationKeyType)
+	now := time.Now().Unix()
+	for _, hook := range hooks {
+		if !hook.Matches(ev.kind, typeId) {
+			continue
+		}
+		suffix, err := randomHex(idLength)
+		if err != nil {
+			return err
+		}
+		payload := Payload{
+			Id:        deliveryIdPrefix(hook.Id) + suffix,
+			Event:     ev.kind,
+			WebhookId: hook.Id,
+			SpaceId:   spaceId,
+			Time:      now,
+			Object: &ObjectPayload{
+				Id:               ev.details.GetString(bundle.RelationKeyId),
+				Name:             ev.details.GetString(bundle.RelationKeyName),
+				TypeId:           typeId,
+				CreatedDate:      ev.details.GetInt64(bundle.RelationKeyCreatedDate),
+				LastModifiedDate: ev.details.GetInt64(bundle.RelationKeyLastModifiedDate),
+			},
+			Message: ev.message,
+		}
+		body, err := json.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("marshal payload: %w", err)
+		}
+		item := &deliveryItem{
+			Id:            payload.Id,
+			WebhookId:     hook.Id,
+			Event:         ev.kind,
+			Body:          string(body),
+			CreatedDate:   now,
+			NextAttemptAt: now,
+		}
+		err = s.store.saveDelivery(ctx, Delivery{
+			Id:          item.Id,
+			WebhookId:   hook.Id,
+			Event:       ev.kind,
+			Status:      DeliveryPending,
+			CreatedDate: now,
+		})
+		if err != nil {
+			return fmt.Errorf("save delivery: %w", err)
+		}
+		if err = s.queue.Add(item); err != nil {
+			return fmt.Errorf("add delivery to queue: %w", err)
+		}
+	}
+	return nil
+}
+
+// deliver is the handler of the delivery queue. Failed deliveries are retried with exponential backoff,
+// the attempt counter is saved to the queue storage, so the backoff survives restarts
+func (s *service) deliver(ctx context.Context, item *deliveryItem) (persistentqueue.Action, error) {
+	now := time.Now()
+	if item.NextAttemptAt > now.Unix() {
+		return persistentqueue.ActionRetry, nil
+	}
+	hook, err := s.store.getWebhook(ctx, item.WebhookId)
+	if errors.Is(err, ErrNotFound) {
+		return persistentqueue.ActionDone, nil
+	}
+	if err != nil {
+		return persistentqueue.ActionRetry, err
+	}
+
+	code, sendErr := send(ctx, s.client, hook, item, now)
+	if ctx.Err() != nil {
+		// the attempt is interrupted by closing of the service, it is not counted
+		return persistentqueue.ActionRetry, nil
+	}
+	item.Attempts++
+	delivery := Delivery{
+		Id:              item.Id,
+		WebhookId:       item.WebhookId,
+		Event:           item.Event,
+		Status:          DeliveryDelivered,
+		Attempts:        item.Attempts,
+		ResponseCode:    code,
+		CreatedDate:     item.CreatedDate,
+		LastAttemptDate: now.Unix(),
+	}
+	action := persistentqueue.ActionDone
+	if sendErr != nil {
+		delivery.Error = sendErr.Error()
+		delivery.Status = DeliveryFailed
+		if item.Attempts < maxAttempts {
+			item.NextAttemptAt = now.Add(retryDelay(item.Attempts)).Unix()
+			delivery.Status = DeliveryPending
+			delivery.NextAttemptDate = item.NextAttemptAt
+			if err = s.queueStorage.Put(item); err != nil {
+				log.Warn("save delivery attempt", zap.String("deliveryId", item.Id), zap.Error(err))
+			}
+			action = persistentqueue.ActionRetry
+		}
+	}
+	if err = s.store.saveDelivery(ctx, delivery); err != nil {
+		return action, fmt.Errorf("save delivery: %w", err)
+	}
+	return action, nil
+}
+
+func deliveryIdPrefix(webhookId string) string {
+	return webhookId + "."
+}
@@ -0,0 +1,156 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	anystore "github.com/anyproto/any-store"
+
+	"github.com/anyproto/anytype-heart/util/keyvaluestore"
+)
+
+const (
+	webhooksCollectionName   = "webhooks"
+	deliveriesCollectionName = "webhook_deliveries"
+	queueCollectionName      = "queue/webhook_deliveries"
+	cursorsCollectionName    = "webhook_cursors"
+
+	// deliveryLogSize is the number of the latest deliveries kept per webhook
+	deliveryLogSize = 100
+)
+
+type DeliveryStatus string
+
+const (
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliveryDelivered DeliveryStatus = "delivered"
+	DeliveryFailed    DeliveryStatus = "failed"
+)
+
+// Delivery is an entry of the delivery log. It is updated after every attempt
+type Delivery struct {
+	Id           string         `json:"id"`
+	WebhookId    string         `json:"webhookId"`
+	Event        EventKind      `json:"event"`
+	Status       DeliveryStatus `json:"status"`
+	Attempts     int            `json:"attempts"`
+	ResponseCode int            `json:"responseCode,omitempty"`
+	// Error of the last attempt
+	Error           string `json:"error,omitempty"`
+	CreatedDate     int64  `json:"createdDate"`
+	LastAttemptDate int64  `json:"lastAttemptDate,omitempty"`
+	NextAttemptDate int64  `json:"nextAttemptDate,omitempty"`
+}
+
+// spaceCursor is the progress of the watcher of the space. It is saved after every batch of events,
+// so changes made while the app is closed are delivered on the next start
+type spaceCursor struct {
+	// Since is the last modified date of the latest delivered change
+	Since int64 `json:"since"`
+	// ChatCursors keeps order ids of the last delivered messages of chats
+	ChatCursors map[string]string `json:"chatCursors,omitempty"`
+}
+
+type store struct {
+	webhooks keyvaluestore.Store[Webhook]
+	cursors  keyvaluestore.Store[spaceCursor]
+	// deliveries keeps the delivery log of every webhook under its id, the oldest delivery goes first
+	deliveries keyvaluestore.Store[[]Delivery]
+	// deliveriesLock guards read-modify-write of the delivery log
+	deliveriesLock sync.Mutex
+}
+
+func newStore(db anystore.DB) (*store, error) {
+	webhooks, err := keyvaluestore.NewJson[Webhook](db, webhooksCollectionName)
+	if err != nil {
+		return nil, fmt.Errorf("open webhooks collection: %w", err)
+	}
+	deliveries, err := keyvaluestore.NewJson[[]Delivery](db, deliveriesCollectionName)
+	if err != nil {
+		return nil, fmt.Errorf("open deliveries collection: %w", err)
+	}
+	cursors, err := keyvaluestore.NewJson[spaceCursor](db, cursorsCollectionName)
+	if err != nil {
+		return nil, fmt.Errorf("open cursors collection: %w", err)
+	}
+	return &store{webhooks: webhooks, deliveries: deliveries, cursors: cursors}, nil
+}
+
+// getCursor returns the cursor of the space, ok is false if changes of the space were never watched
+func (s *store) getCursor(ctx context.Context, spaceId string) (cursor spaceCursor, ok bool, err error) {
+	cursor, err = s.cursors.Get(ctx, spaceId)
+	if errors.Is(err, anystore.ErrDocNotFound) {
+		return spaceCursor{}, false, nil
+	}
+	if err != nil {
+		return spaceCursor{}, false, fmt.Errorf("get cursor: %w", err)
+	}
+	return cursor, true, nil
+}
+
+func (s *store) getWebhook(ctx context.Context, id string) (Webhook, error) {
+	if id == "" {
+		return Webhook{}, ErrNotFound
+	}
+	hook, err := s.webhooks.Get(ctx, id)
+	if errors.Is(err, anystore.ErrDocNotFound) {
+		return Webhook{}, ErrNotFound
+	}
+	if err != nil {
+		return Webhook{}, fmt.Errorf("get webhook: %w", err)
+	}
+	return hook, nil
+}
+
+// saveDelivery adds the delivery to the log or replaces the entry with the same id
+func (s *store) saveDelivery(ctx context.Context, delivery Delivery) error {
+	s.deliveriesLock.Lock()
+	defer s.deliveriesLock.Unlock()
+	entries, err := s.deliveries.Get(ctx, delivery.WebhookId)
+	if err != nil && !errors.Is(err, anystore.ErrDocNotFound) {
+		return err
+	}
+	idx := slices.IndexFunc(entries, func(d Delivery) bool {
+		return d.Id == delivery.Id
+	})
+	if idx >= 0 {
+		entries[idx] = delivery
+	} else {
+		entries = append(entries, delivery)
+	}
+	if len(entries) > deliveryLogSize {
+		entries = entries[len(entries)-deliveryLogSize:]
+	}
+	return s.deliveries.Set(ctx, delivery.WebhookId, entries)
+}
+
+// listDeliveries returns the latest deliveries of the webhook, newest first
+func (s *store) listDeliveries(ctx context.Context, webhookId string, limit int) ([]Delivery, error) {
+	entries, err := s.deliveries.Get(ctx, webhookId)
+	if errors.Is(err, anystore.ErrDocNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	slices.Reverse(entries)
+	if limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return entries, nil
+}
+
+func (s *store) removeWebhook(ctx context.Context, id string) error {
+	if err := s.webhooks.Delete(ctx, id); err != nil {
+		return fmt.Errorf("delete webhook: %w", err)
+	}
+	s.deliveriesLock.Lock()
+	defer s.deliveriesLock.Unlock()
+	if err := s.deliveries.Delete(ctx, id); err != nil {
+		return fmt.Errorf("delete deliveries: %w", err)
+	}
+	return nil
+}
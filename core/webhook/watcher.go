@@ -0,0 +1,334 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cheggaaa/mb/v3"
+	"go.uber.org/zap"
+
+	"github.com/anyproto/anytype-heart/core/block/chats/chatrepository"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/subscription"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// maxMessagesPerEvent limits the number of chat messages delivered for one change of the chat
+const maxMessagesPerEvent = 50
+
+// batchDelay is used to collect object changes in batches, so typing in an object produces one update event
+var batchDelay = 2 * time.Second
+
+// The subscription of the watcher includes objects modified within the window. The window is moved periodically,
+// so the subscription and the details kept by the watcher do not grow with the number of modified objects
+var (
+	windowSize       = time.Hour
+	windowMovePeriod = 10 * time.Minute
+)
+
+var watchedKeys = []string{
+	bundle.RelationKeyId.String(),
+	bundle.RelationKeyName.String(),
+	bundle.RelationKeyType.String(),
+	bundle.RelationKeyResolvedLayout.String(),
+	bundle.RelationKeyCreatedDate.String(),
+	bundle.RelationKeyLastModifiedDate.String(),
+	bundle.RelationKeyLastMessageDate.String(),
+}
+
+// spaceWatcher follows objects of the space modified after the cursor
+type spaceWatcher struct {
+	spaceId string
+	cancel  context.CancelFunc
+	done    chan struct{}
+	queue   *mb.MB[*pb.EventMessage]
+	// cursor is persisted after every batch of events
+	cursor spaceCursor
+	// movedAt is the time the subscription window was moved last time
+	movedAt time.Time
+	// objects keeps details of objects in the subscription
+	objects map[string]*domain.Details
+}
+
+// objectEvent is an event of the object before it is delivered to webhooks of the space
+type objectEvent struct {
+	kind    EventKind
+	details *domain.Details
+	message *MessagePayload
+}
+
+func (s *service) startWatcher(spaceId string) error {
+	s.watchersLock.Lock()
+	defer s.watchersLock.Unlock()
+	if _, ok := s.watchers[spaceId]; ok {
+		return nil
+	}
+	cursor, ok, err := s.store.getCursor(s.componentCtx, spaceId)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		// changes made before the first webhook of the space are not delivered
+		cursor = spaceCursor{Since: time.Now().Unix()}
+		if err = s.store.cursors.Set(s.componentCtx, spaceId, cursor); err != nil {
+			return fmt.Errorf("save cursor: %w", err)
+		}
+	}
+	if cursor.ChatCursors == nil {
+		cursor.ChatCursors = map[string]string{}
+	}
+	ctx, cancel := context.WithCancel(s.componentCtx)
+	w := &spaceWatcher{
+		spaceId: spaceId,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+		queue:   mb.New[*pb.EventMessage](0),
+		cursor:  cursor,
+		objects: map[string]*domain.Details{},
+	}
+	s.watchers[spaceId] = w
+	go s.watch(ctx, w)
+	return nil
+}
+
+func (s *service) stopWatcher(spaceId string) {
+	s.watchersLock.Lock()
+	w, ok := s.watchers[spaceId]
+	delete(s.watchers, spaceId)
+	s.watchersLock.Unlock()
+	if !ok {
+		return
+	}
+	w.cancel()
+	<-w.done
+}
+
+func (s *service) watch(ctx context.Context, w *spaceWatcher) {
+	defer close(w.done)
+	defer func() {
+		if err := s.subscriptionService.Unsubscribe(internalSubId(w.spaceId)); err != nil {
+			log.Warn("unsubscribe from space objects", zap.String("spaceId", w.spaceId), zap.Error(err))
+		}
+		_ = w.queue.Close()
+	}()
+	if err := s.moveWindow(ctx, w); err != nil {
+		log.Warn("subscribe to space objects", zap.String("spaceId", w.spaceId), zap.Error(err))
+	}
+	for {
+		waitCtx, cancel := context.WithDeadline(ctx, w.movedAt.Add(windowMovePeriod))
+		msgs, err := w.queue.Wait(waitCtx)
+		cancel()
+		if ctx.Err() != nil {
+			return
+		}
+		if err == nil {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(batchDelay):
+			}
+			msgs = append(msgs, w.queue.GetAll()...)
+			s.process(ctx, w, msgs)
+		}
+		if time.Since(w.movedAt) >= windowMovePeriod {
+			if err = s.moveWindow(ctx, w); err != nil {
+				log.Warn("move subscription window", zap.String("spaceId", w.spaceId), zap.Error(err))
+			}
+		}
+	}
+}
+
+// moveWindow subscribes to objects modified within the window or after the cursor, if the cursor is older.
+// Objects modified after the cursor that are not delivered yet are processed as changes: these are changes made
+// while the app was closed or between subscriptions
+func (s *service) moveWindow(ctx context.Context, w *spaceWatcher) error {
+	now := time.Now()
+	since := min(w.cursor.Since, now.Add(-windowSize).Unix())
+	resp, err := s.subscriptionService.Search(subscription.SubscribeRequest{
+		SpaceId:           w.spaceId,
+		SubId:             internalSubId(w.spaceId),
+		Keys:              watchedKeys,
+		NoDepSubscription: true,
+		Internal:          true,
+		InternalQueue:     w.queue,
+		Filters: []database.FilterRequest{
+			{
+				RelationKey: bundle.RelationKeyLastModifiedDate,
+				Condition:   model.BlockContentDataviewFilter_Greater,
+				Value:       domain.Int64(since),
+			},
+		},
+	})
+	if err != nil {
+		// the window is moved on the next period
+		w.movedAt = now
+		return fmt.Errorf("subscribe to space objects: %w", err)
+	}
+	w.movedAt = now
+	var missed []*pb.EventMessage
+	w.objects, missed = missedChanges(w.objects, resp.Records, w.cursor.Since)
+	if len(missed) > 0 {
+		s.process(ctx, w, missed)
+	}
+	return nil
+}
+
+// missedChanges returns details of objects in the new subscription and changes of objects that are modified
+// after the cursor and are not known with the same details. Details of objects that left the window are dropped
+func missedChanges(known map[string]*domain.Details, records []*domain.Details, since int64) (map[string]*domain.Details, []*pb.EventMessage) {
+	objects := make(map[string]*domain.Details, len(records))
+	var missed []*pb.EventMessage
+	for _, rec := range records {
+		id := rec.GetString(bundle.RelationKeyId)
+		prev, isKnown := known[id]
+		lastModified := rec.GetInt64(bundle.RelationKeyLastModifiedDate)
+		if lastModified < since || (isKnown && prev.GetInt64(bundle.RelationKeyLastModifiedDate) >= lastModified) {
+			objects[id] = rec
+			continue
+		}
+		if isKnown {
+			objects[id] = prev
+		}
+		missed = append(missed, &pb.EventMessage{Value: &pb.EventMessageValueOfObjectDetailsSet{
+			ObjectDetailsSet: &pb.EventObjectDetailsSet{Id: id, Details: rec.ToProto()},
+		}})
+	}
+	return objects, missed
+}
+
+// process delivers events of the batch and moves the cursor to the latest delivered change
+func (s *service) process(ctx context.Context, w *spaceWatcher, msgs []*pb.EventMessage) {
+	events := s.collectEvents(ctx, w, msgs)
+	for _, ev := range events {
+		if err := s.enqueue(ctx, w.spaceId, ev); err != nil {
+			log.Warn("enqueue webhook event", zap.String("spaceId", w.spaceId), zap.Error(err))
+		}
+		w.cursor.Since = max(w.cursor.Since, ev.details.GetInt64(bundle.RelationKeyLastModifiedDate))
+	}
+	if len(events) == 0 {
+		return
+	}
+	if err := s.store.cursors.Set(ctx, w.spaceId, w.cursor); err != nil {
+		log.Warn("save webhook cursor", zap.String("spaceId", w.spaceId), zap.Error(err))
+	}
+}
+
+// collectEvents converts subscription messages to events. Several changes of the object in the batch
+// are reported as one event
+func (s *service) collectEvents(ctx context.Context, w *spaceWatcher, msgs []*pb.EventMessage) []objectEvent {
+	var (
+		events  []objectEvent
+		changed = map[string]EventKind{}
+		order   []string
+	)
+	markChanged := func(id string, kind EventKind) {
+		prev, ok := changed[id]
+		if !ok {
+			order = append(order, id)
+		}
+		// creation and archiving are more important than updates made in the same batch
+		if !ok || prev == EventObjectUpdated {
+			changed[id] = kind
+		}
+	}
+	for _, msg := range msgs {
+		switch {
+		case msg.GetObjectDetailsSet() != nil:
+			set := msg.GetObjectDetailsSet()
+			details := domain.NewDetailsFromProto(set.Details)
+			if _, known := w.objects[set.Id]; !known && details.GetInt64(bundle.RelationKeyCreatedDate) >= w.cursor.Since {
+				markChanged(set.Id, EventObjectCreated)
+			} else {
+				markChanged(set.Id, EventObjectUpdated)
+			}
+			w.objects[set.Id] = details
+		case msg.GetObjectDetailsAmend() != nil:
+			amend := msg.GetObjectDetailsAmend()
+			details, ok := w.objects[amend.Id]
+			if !ok {
+				continue
+			}
+			details = details.Copy()
+			for _, kv := range amend.Details {
+				details.Set(domain.RelationKey(kv.Key), domain.ValueFromProto(kv.Value))
+			}
+			w.objects[amend.Id] = details
+			markChanged(amend.Id, EventObjectUpdated)
+		case msg.GetSubscriptionRemove() != nil:
+			id := msg.GetSubscriptionRemove().Id
+			delete(w.objects, id)
+			details, err := s.objectStore.SpaceIndex(w.spaceId).GetDetails(id)
+			if err != nil {
+				log.Warn("get details of removed object", zap.String("objectId", id), zap.Error(err))
+				continue
+			}
+			// objects leave the subscription when they are moved to the bin
+			if details.GetBool(bundle.RelationKeyIsArchived) {
+				w.objects[id] = details
+				markChanged(id, EventObjectArchived)
+			}
+		}
+	}
+
+	for _, id := range order {
+		details := w.objects[id]
+		kind := changed[id]
+		if kind == EventObjectArchived {
+			delete(w.objects, id)
+		}
+		if details == nil {
+			continue
+		}
+		if kind != EventObjectArchived && model.ObjectTypeLayout(details.GetInt64(bundle.RelationKeyResolvedLayout)) == model.ObjectType_chatDerived {
+			events = append(events, s.messageEvents(ctx, w, details)...)
+			if kind == EventObjectUpdated {
+				// changes of chats are reported as posted messages
+				continue
+			}
+		}
+		events = append(events, objectEvent{kind: kind, details: details})
+	}
+	return events
+}
+
+// messageEvents returns messages posted to the chat since the last delivered one
+func (s *service) messageEvents(ctx context.Context, w *spaceWatcher, details *domain.Details) []objectEvent {
+	chatId := details.GetString(bundle.RelationKeyId)
+	req := chatrepository.GetMessagesRequest{Limit: maxMessagesPerEvent}
+	cursor, hasCursor := w.cursor.ChatCursors[chatId]
+	if hasCursor {
+		req.AfterOrderId = cursor
+	}
+	resp, err := s.chatService.GetMessages(ctx, chatId, req)
+	if err != nil {
+		log.Warn("get chat messages", zap.String("chatId", chatId), zap.Error(err))
+		return nil
+	}
+	var events []objectEvent
+	for _, msg := range resp.Messages {
+		w.cursor.ChatCursors[chatId] = msg.OrderId
+		// without a cursor the latest messages are read, older ones are posted before the cursor
+		if !hasCursor && msg.CreatedAt < w.cursor.Since {
+			continue
+		}
+		events = append(events, objectEvent{
+			kind:    EventMessagePosted,
+			details: details,
+			message: &MessagePayload{
+				Id:        msg.Id,
+				Creator:   msg.Creator,
+				Text:      msg.GetMessage().GetText(),
+				CreatedAt: msg.CreatedAt,
+			},
+		})
+	}
+	return events
+}
+
+func internalSubId(spaceId string) string {
+	return "webhook-" + spaceId
+}
@@ -0,0 +1,69 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+)
+
+func testDetails(id string, lastModified int64) *domain.Details {
+	details := domain.NewDetails()
+	details.SetString(bundle.RelationKeyId, id)
+	details.SetInt64(bundle.RelationKeyLastModifiedDate, lastModified)
+	return details
+}
+
+func TestMissedChanges(t *testing.T) {
+	known := map[string]*domain.Details{
+		"delivered": testDetails("delivered", 150),
+		"changed":   testDetails("changed", 120),
+		"old":       testDetails("old", 50),
+	}
+	records := []*domain.Details{
+		testDetails("delivered", 150),
+		testDetails("changed", 160),
+		testDetails("offline", 170),
+		testDetails("beforeCursor", 90),
+	}
+
+	objects, missed := missedChanges(known, records, 100)
+
+	var missedIds []string
+	for _, msg := range missed {
+		missedIds = append(missedIds, msg.GetObjectDetailsSet().Id)
+	}
+	assert.Equal(t, []string{"changed", "offline"}, missedIds)
+	// objects that left the window are dropped, missed objects are added by processing of their changes
+	assert.ElementsMatch(t, []string{"delivered", "changed", "beforeCursor"}, mapKeys(objects))
+	assert.Equal(t, int64(120), objects["changed"].GetInt64(bundle.RelationKeyLastModifiedDate))
+}
+
+func TestCursors(t *testing.T) {
+	ctx := context.Background()
+	fx := newFixture(t)
+
+	_, ok, err := fx.store.getCursor(ctx, "space1")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	cursor := spaceCursor{Since: 100, ChatCursors: map[string]string{"chat1": "order1"}}
+	require.NoError(t, fx.store.cursors.Set(ctx, "space1", cursor))
+
+	got, ok, err := fx.store.getCursor(ctx, "space1")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, cursor, got)
+}
+
+func mapKeys(m map[string]*domain.Details) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
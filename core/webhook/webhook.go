@@ -0,0 +1,140 @@
+package webhook
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+type EventKind string
+
+const (
+	EventObjectCreated  EventKind = "object.created"
+	EventObjectUpdated  EventKind = "object.updated"
+	EventObjectArchived EventKind = "object.archived"
+	EventMessagePosted  EventKind = "chat.message_posted"
+)
+
+var AllEvents = []EventKind{EventObjectCreated, EventObjectUpdated, EventObjectArchived, EventMessagePosted}
+
+const (
+	secretLength = 32
+	idLength     = 16
+
+	// maxAttempts is the number of delivery attempts after which the delivery is marked as failed
+	maxAttempts    = 8
+	baseRetryDelay = 10 * time.Second
+	maxRetryDelay  = time.Hour
+)
+
+// localHostSuffixes are domains resolved only in the local network
+var localHostSuffixes = []string{".localhost", ".local", ".lan", ".internal", ".home.arpa"}
+
+// Webhook is a registration of an endpoint that receives events of the space
+type Webhook struct {
+	Id      string `json:"id"`
+	SpaceId string `json:"spaceId"`
+	Url     string `json:"url"`
+	// Secret is used to sign request bodies, so the receiver can check that the request is sent by the middleware
+	Secret string `json:"secret"`
+	// Events the webhook is subscribed to, all events are delivered if empty
+	Events []EventKind `json:"events,omitempty"`
+	// ObjectTypeIds filters events by the type of the object, for chat messages it is the type of the chat
+	ObjectTypeIds []string `json:"objectTypeIds,omitempty"`
+	CreatedDate   int64    `json:"createdDate"`
+}
+
+// Matches reports whether the event of the object of the type should be delivered to the webhook
+func (w Webhook) Matches(kind EventKind, typeId string) bool {
+	if len(w.Events) > 0 && !slices.Contains(w.Events, kind) {
+		return false
+	}
+	if len(w.ObjectTypeIds) > 0 && !slices.Contains(w.ObjectTypeIds, typeId) {
+		return false
+	}
+	return true
+}
+
+type CreateRequest struct {
+	SpaceId       string
+	Url           string
+	Events        []EventKind
+	ObjectTypeIds []string
+}
+
+func (r CreateRequest) validate() error {
+	if r.SpaceId == "" {
+		return ErrEmptySpaceId
+	}
+	for _, kind := range r.Events {
+		if !slices.Contains(AllEvents, kind) {
+			return fmt.Errorf("%w: %s", ErrUnknownEvent, kind)
+		}
+	}
+	return ValidateUrl(r.Url)
+}
+
+// ValidateUrl checks that the url is an http url of the local machine or the local network. Webhooks are not sent
+// to the internet, as objects of the space could leak to third parties
+func ValidateUrl(rawUrl string) error {
+	u, err := url.Parse(rawUrl)
+	if err != nil || u.Host == "" {
+		return ErrInvalidUrl
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return ErrInvalidUrl
+	}
+	if !isLocalHost(u.Hostname()) {
+		return ErrNotLocalUrl
+	}
+	return nil
+}
+
+func isLocalHost(host string) bool {
+	if ip := net.ParseIP(host); ip != nil {
+		return isLocalIp(ip)
+	}
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if host == "localhost" {
+		return true
+	}
+	// single label names are resolved in the local network only
+	if !strings.Contains(host, ".") {
+		return host != ""
+	}
+	for _, suffix := range localHostSuffixes {
+		if strings.HasSuffix(host, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+func isLocalIp(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast()
+}
+
+// retryDelay returns the pause before the next attempt, it doubles with every failed attempt
+func retryDelay(attempts int) time.Duration {
+	delay := baseRetryDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= maxRetryDelay {
+			return maxRetryDelay
+		}
+	}
+	return delay
+}
+
+func randomHex(length int) (string, error) {
+	buf := make([]byte, length)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate random bytes: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
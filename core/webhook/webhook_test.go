@@ -0,0 +1,79 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateUrl(t *testing.T) {
+	for _, rawUrl := range []string{
+		"http://127.0.0.1:8080/hook",
+		"http://localhost/hook",
+		"https://[::1]:9000",
+		"http://192.168.1.20/hook",
+		"http://10.0.0.5:3000",
+		"http://nas.local/hook",
+		"http://homeserver:8123/api/webhook/anytype",
+	} {
+		assert.NoError(t, ValidateUrl(rawUrl), rawUrl)
+	}
+
+	for _, rawUrl := range []string{
+		"",
+		"ftp://127.0.0.1/hook",
+		"127.0.0.1:8080",
+		"http:///hook",
+	} {
+		assert.ErrorIs(t, ValidateUrl(rawUrl), ErrInvalidUrl, rawUrl)
+	}
+
+	for _, rawUrl := range []string{
+		"https://example.com/hook",
+		"http://8.8.8.8/hook",
+		"http://hooks.example.local.com",
+	} {
+		assert.ErrorIs(t, ValidateUrl(rawUrl), ErrNotLocalUrl, rawUrl)
+	}
+}
+
+func TestWebhookMatches(t *testing.T) {
+	t.Run("without filters all events match", func(t *testing.T) {
+		hook := Webhook{}
+
+		for _, kind := range AllEvents {
+			assert.True(t, hook.Matches(kind, "type1"))
+		}
+	})
+
+	t.Run("events and types are filtered", func(t *testing.T) {
+		hook := Webhook{
+			Events:        []EventKind{EventObjectCreated, EventMessagePosted},
+			ObjectTypeIds: []string{"task", "chat"},
+		}
+
+		assert.True(t, hook.Matches(EventObjectCreated, "task"))
+		assert.True(t, hook.Matches(EventMessagePosted, "chat"))
+		assert.False(t, hook.Matches(EventObjectUpdated, "task"))
+		assert.False(t, hook.Matches(EventObjectCreated, "page"))
+	})
+}
+
+func TestCreateRequestValidate(t *testing.T) {
+	req := CreateRequest{SpaceId: "space1", Url: "http://127.0.0.1/hook"}
+	assert.NoError(t, req.validate())
+
+	req.Events = []EventKind{EventObjectArchived, "object.deleted"}
+	assert.ErrorIs(t, req.validate(), ErrUnknownEvent)
+
+	req = CreateRequest{Url: "http://127.0.0.1/hook"}
+	assert.ErrorIs(t, req.validate(), ErrEmptySpaceId)
+}
+
+func TestRetryDelay(t *testing.T) {
+	assert.Equal(t, 10*time.Second, retryDelay(1))
+	assert.Equal(t, 20*time.Second, retryDelay(2))
+	assert.Equal(t, 80*time.Second, retryDelay(4))
+	assert.Equal(t, time.Hour, retryDelay(20))
+}
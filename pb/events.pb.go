@@ -191,6 +191,40 @@ func (EventSpaceSyncError) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_a966342d378ae5f5, []int{0, 14, 2}
 }
 
+type EventSpaceActivityType int32
+
+const (
+	EventSpaceActivity_ObjectCreated  EventSpaceActivityType = 0
+	EventSpaceActivity_ObjectEdited   EventSpaceActivityType = 1
+	EventSpaceActivity_ObjectArchived EventSpaceActivityType = 2
+	EventSpaceActivity_MemberJoined   EventSpaceActivityType = 3
+	EventSpaceActivity_CommentAdded   EventSpaceActivityType = 4
+)
+
+var EventSpaceActivityType_name = map[int32]string{
+	0: "ObjectCreated",
+	1: "ObjectEdited",
+	2: "ObjectArchived",
+	3: "MemberJoined",
+	4: "CommentAdded",
+}
+
+var EventSpaceActivityType_value = map[string]int32{
+	"ObjectCreated":  0,
+	"ObjectEdited":   1,
+	"ObjectArchived": 2,
+	"MemberJoined":   3,
+	"CommentAdded":   4,
+}
+
+func (x EventSpaceActivityType) String() string {
+	return proto.EnumName(EventSpaceActivityType_name, int32(x))
+}
+
+func (EventSpaceActivityType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 14, 0, 0}
+}
+
 type EventP2PStatusStatus int32
 
 const (
@@ -411,6 +445,7 @@ type EventMessage struct {
 	//	*EventMessageValueOfChatStateUpdate
 	//	*EventMessageValueOfMembershipV2Update
 	//	*EventMessageValueOfMembershipV2ProductsUpdate
+	//	*EventMessageValueOfSpaceActivityUpdate
 	Value IsEventMessageValue `protobuf_oneof:"value"`
 }
 
@@ -699,6 +734,9 @@ type EventMessageValueOfMembershipV2Update struct {
 type EventMessageValueOfMembershipV2ProductsUpdate struct {
 	MembershipV2ProductsUpdate *EventMembershipV2ProductsUpdate `protobuf:"bytes,139,opt,name=membershipV2ProductsUpdate,proto3,oneof" json:"membershipV2ProductsUpdate,omitempty"`
 }
+type EventMessageValueOfSpaceActivityUpdate struct {
+	SpaceActivityUpdate *EventSpaceActivityUpdate `protobuf:"bytes,140,opt,name=spaceActivityUpdate,proto3,oneof" json:"spaceActivityUpdate,omitempty"`
+}
 
 func (*EventMessageValueOfAccountShow) IsEventMessageValue()                    {}
 func (*EventMessageValueOfAccountDetails) IsEventMessageValue()                 {}
@@ -782,6 +820,7 @@ func (*EventMessageValueOfChatDelete) IsEventMessageValue()
 func (*EventMessageValueOfChatStateUpdate) IsEventMessageValue()                {}
 func (*EventMessageValueOfMembershipV2Update) IsEventMessageValue()             {}
 func (*EventMessageValueOfMembershipV2ProductsUpdate) IsEventMessageValue()     {}
+func (*EventMessageValueOfSpaceActivityUpdate) IsEventMessageValue()            {}
 
 func (m *EventMessage) GetValue() IsEventMessageValue {
 	if m != nil {
@@ -1371,6 +1410,13 @@ func (m *EventMessage) GetMembershipV2ProductsUpdate() *EventMembershipV2Product
 	return nil
 }
 
+func (m *EventMessage) GetSpaceActivityUpdate() *EventSpaceActivityUpdate {
+	if x, ok := m.GetValue().(*EventMessageValueOfSpaceActivityUpdate); ok {
+		return x.SpaceActivityUpdate
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*EventMessage) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1456,6 +1502,7 @@ func (*EventMessage) XXX_OneofWrappers() []interface{} {
 		(*EventMessageValueOfChatStateUpdate)(nil),
 		(*EventMessageValueOfMembershipV2Update)(nil),
 		(*EventMessageValueOfMembershipV2ProductsUpdate)(nil),
+		(*EventMessageValueOfSpaceActivityUpdate)(nil),
 	}
 }
 
@@ -2270,6 +2317,9 @@ type EventAccountLinkChallenge struct {
 	Challenge  string                               `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
 	ClientInfo *EventAccountLinkChallengeClientInfo `protobuf:"bytes,2,opt,name=clientInfo,proto3" json:"clientInfo,omitempty"`
 	Scope      model.AccountAuthLocalApiScope       `protobuf:"varint,3,opt,name=scope,proto3,enum=anytype.model.AccountAuthLocalApiScope" json:"scope,omitempty"`
+	SpaceIds   []string                             `protobuf:"bytes,4,rep,name=spaceIds,proto3" json:"spaceIds,omitempty"`
+	ReadOnly   bool                                 `protobuf:"varint,5,opt,name=readOnly,proto3" json:"readOnly,omitempty"`
+	ExpireAt   int64                                `protobuf:"varint,6,opt,name=expireAt,proto3" json:"expireAt,omitempty"`
 }
 
 func (m *EventAccountLinkChallenge) Reset()         { *m = EventAccountLinkChallenge{} }
@@ -2326,6 +2376,27 @@ func (m *EventAccountLinkChallenge) GetScope() model.AccountAuthLocalApiScope {
 	return model.AccountAuth_Limited
 }
 
+func (m *EventAccountLinkChallenge) GetSpaceIds() []string {
+	if m != nil {
+		return m.SpaceIds
+	}
+	return nil
+}
+
+func (m *EventAccountLinkChallenge) GetReadOnly() bool {
+	if m != nil {
+		return m.ReadOnly
+	}
+	return false
+}
+
+func (m *EventAccountLinkChallenge) GetExpireAt() int64 {
+	if m != nil {
+		return m.ExpireAt
+	}
+	return 0
+}
+
 type EventAccountLinkChallengeClientInfo struct {
 	ProcessName       string `protobuf:"bytes,1,opt,name=processName,proto3" json:"processName,omitempty"`
 	ProcessPath       string `protobuf:"bytes,2,opt,name=processPath,proto3" json:"processPath,omitempty"`
@@ -11875,6 +11946,210 @@ func (m *EventSpace) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventSpace proto.InternalMessageInfo
 
+type EventSpaceActivity struct {
+}
+
+func (m *EventSpaceActivity) Reset()         { *m = EventSpaceActivity{} }
+func (m *EventSpaceActivity) String() string { return proto.CompactTextString(m) }
+func (*EventSpaceActivity) ProtoMessage()    {}
+func (*EventSpaceActivity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 14, 0}
+}
+func (m *EventSpaceActivity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSpaceActivity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSpaceActivity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSpaceActivity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSpaceActivity.Merge(m, src)
+}
+func (m *EventSpaceActivity) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSpaceActivity) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSpaceActivity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSpaceActivity proto.InternalMessageInfo
+
+type EventSpaceActivityEntry struct {
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Type          EventSpaceActivityType `protobuf:"varint,3,opt,name=type,proto3,enum=anytype.EventSpaceActivityType" json:"type,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,4,opt,name=participantId,proto3" json:"participantId,omitempty"`
+	ObjectIds     []string               `protobuf:"bytes,5,rep,name=objectIds,proto3" json:"objectIds,omitempty"`
+	Time          int64                  `protobuf:"varint,6,opt,name=time,proto3" json:"time,omitempty"`
+	LastTime      int64                  `protobuf:"varint,7,opt,name=lastTime,proto3" json:"lastTime,omitempty"`
+	Count         int32                  `protobuf:"varint,8,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *EventSpaceActivityEntry) Reset()         { *m = EventSpaceActivityEntry{} }
+func (m *EventSpaceActivityEntry) String() string { return proto.CompactTextString(m) }
+func (*EventSpaceActivityEntry) ProtoMessage()    {}
+func (*EventSpaceActivityEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 14, 0, 0}
+}
+func (m *EventSpaceActivityEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSpaceActivityEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSpaceActivityEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSpaceActivityEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSpaceActivityEntry.Merge(m, src)
+}
+func (m *EventSpaceActivityEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSpaceActivityEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSpaceActivityEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSpaceActivityEntry proto.InternalMessageInfo
+
+func (m *EventSpaceActivityEntry) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *EventSpaceActivityEntry) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *EventSpaceActivityEntry) GetType() EventSpaceActivityType {
+	if m != nil {
+		return m.Type
+	}
+	return EventSpaceActivity_ObjectCreated
+}
+
+func (m *EventSpaceActivityEntry) GetParticipantId() string {
+	if m != nil {
+		return m.ParticipantId
+	}
+	return ""
+}
+
+func (m *EventSpaceActivityEntry) GetObjectIds() []string {
+	if m != nil {
+		return m.ObjectIds
+	}
+	return nil
+}
+
+func (m *EventSpaceActivityEntry) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *EventSpaceActivityEntry) GetLastTime() int64 {
+	if m != nil {
+		return m.LastTime
+	}
+	return 0
+}
+
+func (m *EventSpaceActivityEntry) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type EventSpaceActivityUpdate struct {
+	SpaceId     string                     `protobuf:"bytes,1,opt,name=spaceId,proto3" json:"spaceId,omitempty"`
+	SubIds      []string                   `protobuf:"bytes,2,rep,name=subIds,proto3" json:"subIds,omitempty"`
+	Entries     []*EventSpaceActivityEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+	UnreadCount int32                      `protobuf:"varint,4,opt,name=unreadCount,proto3" json:"unreadCount,omitempty"`
+}
+
+func (m *EventSpaceActivityUpdate) Reset()         { *m = EventSpaceActivityUpdate{} }
+func (m *EventSpaceActivityUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventSpaceActivityUpdate) ProtoMessage()    {}
+func (*EventSpaceActivityUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 14, 0, 1}
+}
+func (m *EventSpaceActivityUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSpaceActivityUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSpaceActivityUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSpaceActivityUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSpaceActivityUpdate.Merge(m, src)
+}
+func (m *EventSpaceActivityUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSpaceActivityUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSpaceActivityUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSpaceActivityUpdate proto.InternalMessageInfo
+
+func (m *EventSpaceActivityUpdate) GetSpaceId() string {
+	if m != nil {
+		return m.SpaceId
+	}
+	return ""
+}
+
+func (m *EventSpaceActivityUpdate) GetSubIds() []string {
+	if m != nil {
+		return m.SubIds
+	}
+	return nil
+}
+
+func (m *EventSpaceActivityUpdate) GetEntries() []*EventSpaceActivityEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *EventSpaceActivityUpdate) GetUnreadCount() int32 {
+	if m != nil {
+		return m.UnreadCount
+	}
+	return 0
+}
+
 type EventSpaceSyncStatus struct {
 }
 
@@ -11882,7 +12157,7 @@ func (m *EventSpaceSyncStatus) Reset()         { *m = EventSpaceSyncStatus{} }
 func (m *EventSpaceSyncStatus) String() string { return proto.CompactTextString(m) }
 func (*EventSpaceSyncStatus) ProtoMessage()    {}
 func (*EventSpaceSyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 14, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 14, 1}
 }
 func (m *EventSpaceSyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11925,7 +12200,7 @@ func (m *EventSpaceSyncStatusUpdate) Reset()         { *m = EventSpaceSyncStatus
 func (m *EventSpaceSyncStatusUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventSpaceSyncStatusUpdate) ProtoMessage()    {}
 func (*EventSpaceSyncStatusUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 14, 0, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 14, 1, 0}
 }
 func (m *EventSpaceSyncStatusUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -12304,6 +12579,10 @@ type ModelProcess struct {
 	//	*ModelProcessMessageOfSaveFile
 	//	*ModelProcessMessageOfMigration
 	//	*ModelProcessMessageOfPreloadFile
+	//	*ModelProcessMessageOfObjectTransfer
+	//	*ModelProcessMessageOfAuditLogExport
+	//	*ModelProcessMessageOfSpaceRepair
+	//	*ModelProcessMessageOfSpaceVerify
 	Message IsModelProcessMessage `protobuf_oneof:"message"`
 	Error   string                `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`
 }
@@ -12365,13 +12644,29 @@ type ModelProcessMessageOfMigration struct {
 type ModelProcessMessageOfPreloadFile struct {
 	PreloadFile *ModelProcessPreloadFile `protobuf:"bytes,12,opt,name=preloadFile,proto3,oneof" json:"preloadFile,omitempty"`
 }
+type ModelProcessMessageOfObjectTransfer struct {
+	ObjectTransfer *ModelProcessObjectTransfer `protobuf:"bytes,13,opt,name=objectTransfer,proto3,oneof" json:"objectTransfer,omitempty"`
+}
+type ModelProcessMessageOfAuditLogExport struct {
+	AuditLogExport *ModelProcessAuditLogExport `protobuf:"bytes,14,opt,name=auditLogExport,proto3,oneof" json:"auditLogExport,omitempty"`
+}
+type ModelProcessMessageOfSpaceRepair struct {
+	SpaceRepair *ModelProcessSpaceRepair `protobuf:"bytes,15,opt,name=spaceRepair,proto3,oneof" json:"spaceRepair,omitempty"`
+}
+type ModelProcessMessageOfSpaceVerify struct {
+	SpaceVerify *ModelProcessSpaceVerify `protobuf:"bytes,16,opt,name=spaceVerify,proto3,oneof" json:"spaceVerify,omitempty"`
+}
 
-func (*ModelProcessMessageOfDropFiles) IsModelProcessMessage()   {}
-func (*ModelProcessMessageOfImport) IsModelProcessMessage()      {}
-func (*ModelProcessMessageOfExport) IsModelProcessMessage()      {}
-func (*ModelProcessMessageOfSaveFile) IsModelProcessMessage()    {}
-func (*ModelProcessMessageOfMigration) IsModelProcessMessage()   {}
-func (*ModelProcessMessageOfPreloadFile) IsModelProcessMessage() {}
+func (*ModelProcessMessageOfDropFiles) IsModelProcessMessage()      {}
+func (*ModelProcessMessageOfImport) IsModelProcessMessage()         {}
+func (*ModelProcessMessageOfExport) IsModelProcessMessage()         {}
+func (*ModelProcessMessageOfSaveFile) IsModelProcessMessage()       {}
+func (*ModelProcessMessageOfMigration) IsModelProcessMessage()      {}
+func (*ModelProcessMessageOfPreloadFile) IsModelProcessMessage()    {}
+func (*ModelProcessMessageOfObjectTransfer) IsModelProcessMessage() {}
+func (*ModelProcessMessageOfAuditLogExport) IsModelProcessMessage() {}
+func (*ModelProcessMessageOfSpaceRepair) IsModelProcessMessage()    {}
+func (*ModelProcessMessageOfSpaceVerify) IsModelProcessMessage()    {}
 
 func (m *ModelProcess) GetMessage() IsModelProcessMessage {
 	if m != nil {
@@ -12450,6 +12745,34 @@ func (m *ModelProcess) GetPreloadFile() *ModelProcessPreloadFile {
 	return nil
 }
 
+func (m *ModelProcess) GetObjectTransfer() *ModelProcessObjectTransfer {
+	if x, ok := m.GetMessage().(*ModelProcessMessageOfObjectTransfer); ok {
+		return x.ObjectTransfer
+	}
+	return nil
+}
+
+func (m *ModelProcess) GetAuditLogExport() *ModelProcessAuditLogExport {
+	if x, ok := m.GetMessage().(*ModelProcessMessageOfAuditLogExport); ok {
+		return x.AuditLogExport
+	}
+	return nil
+}
+
+func (m *ModelProcess) GetSpaceRepair() *ModelProcessSpaceRepair {
+	if x, ok := m.GetMessage().(*ModelProcessMessageOfSpaceRepair); ok {
+		return x.SpaceRepair
+	}
+	return nil
+}
+
+func (m *ModelProcess) GetSpaceVerify() *ModelProcessSpaceVerify {
+	if x, ok := m.GetMessage().(*ModelProcessMessageOfSpaceVerify); ok {
+		return x.SpaceVerify
+	}
+	return nil
+}
+
 func (m *ModelProcess) GetError() string {
 	if m != nil {
 		return m.Error
@@ -12466,6 +12789,10 @@ func (*ModelProcess) XXX_OneofWrappers() []interface{} {
 		(*ModelProcessMessageOfSaveFile)(nil),
 		(*ModelProcessMessageOfMigration)(nil),
 		(*ModelProcessMessageOfPreloadFile)(nil),
+		(*ModelProcessMessageOfObjectTransfer)(nil),
+		(*ModelProcessMessageOfAuditLogExport)(nil),
+		(*ModelProcessMessageOfSpaceRepair)(nil),
+		(*ModelProcessMessageOfSpaceVerify)(nil),
 	}
 }
 
@@ -12685,6 +13012,150 @@ func (m *ModelProcessPreloadFile) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ModelProcessPreloadFile proto.InternalMessageInfo
 
+type ModelProcessObjectTransfer struct {
+}
+
+func (m *ModelProcessObjectTransfer) Reset()         { *m = ModelProcessObjectTransfer{} }
+func (m *ModelProcessObjectTransfer) String() string { return proto.CompactTextString(m) }
+func (*ModelProcessObjectTransfer) ProtoMessage()    {}
+func (*ModelProcessObjectTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{2, 0, 6}
+}
+func (m *ModelProcessObjectTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModelProcessObjectTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModelProcessObjectTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModelProcessObjectTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModelProcessObjectTransfer.Merge(m, src)
+}
+func (m *ModelProcessObjectTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModelProcessObjectTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModelProcessObjectTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModelProcessObjectTransfer proto.InternalMessageInfo
+
+type ModelProcessAuditLogExport struct {
+}
+
+func (m *ModelProcessAuditLogExport) Reset()         { *m = ModelProcessAuditLogExport{} }
+func (m *ModelProcessAuditLogExport) String() string { return proto.CompactTextString(m) }
+func (*ModelProcessAuditLogExport) ProtoMessage()    {}
+func (*ModelProcessAuditLogExport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{2, 0, 7}
+}
+func (m *ModelProcessAuditLogExport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModelProcessAuditLogExport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModelProcessAuditLogExport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModelProcessAuditLogExport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModelProcessAuditLogExport.Merge(m, src)
+}
+func (m *ModelProcessAuditLogExport) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModelProcessAuditLogExport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModelProcessAuditLogExport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModelProcessAuditLogExport proto.InternalMessageInfo
+
+type ModelProcessSpaceRepair struct {
+}
+
+func (m *ModelProcessSpaceRepair) Reset()         { *m = ModelProcessSpaceRepair{} }
+func (m *ModelProcessSpaceRepair) String() string { return proto.CompactTextString(m) }
+func (*ModelProcessSpaceRepair) ProtoMessage()    {}
+func (*ModelProcessSpaceRepair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{2, 0, 8}
+}
+func (m *ModelProcessSpaceRepair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModelProcessSpaceRepair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModelProcessSpaceRepair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModelProcessSpaceRepair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModelProcessSpaceRepair.Merge(m, src)
+}
+func (m *ModelProcessSpaceRepair) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModelProcessSpaceRepair) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModelProcessSpaceRepair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModelProcessSpaceRepair proto.InternalMessageInfo
+
+type ModelProcessSpaceVerify struct {
+}
+
+func (m *ModelProcessSpaceVerify) Reset()         { *m = ModelProcessSpaceVerify{} }
+func (m *ModelProcessSpaceVerify) String() string { return proto.CompactTextString(m) }
+func (*ModelProcessSpaceVerify) ProtoMessage()    {}
+func (*ModelProcessSpaceVerify) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{2, 0, 9}
+}
+func (m *ModelProcessSpaceVerify) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModelProcessSpaceVerify) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModelProcessSpaceVerify.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModelProcessSpaceVerify) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModelProcessSpaceVerify.Merge(m, src)
+}
+func (m *ModelProcessSpaceVerify) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModelProcessSpaceVerify) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModelProcessSpaceVerify.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModelProcessSpaceVerify proto.InternalMessageInfo
+
 type ModelProcessProgress struct {
 	Total   int64  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
 	Done    int64  `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
@@ -12695,7 +13166,7 @@ func (m *ModelProcessProgress) Reset()         { *m = ModelProcessProgress{} }
 func (m *ModelProcessProgress) String() string { return proto.CompactTextString(m) }
 func (*ModelProcessProgress) ProtoMessage()    {}
 func (*ModelProcessProgress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{2, 0, 6}
+	return fileDescriptor_a966342d378ae5f5, []int{2, 0, 10}
 }
 func (m *ModelProcessProgress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -12751,6 +13222,7 @@ func init() {
 	proto.RegisterEnum("anytype.EventSpaceStatus", EventSpaceStatus_name, EventSpaceStatus_value)
 	proto.RegisterEnum("anytype.EventSpaceNetwork", EventSpaceNetwork_name, EventSpaceNetwork_value)
 	proto.RegisterEnum("anytype.EventSpaceSyncError", EventSpaceSyncError_name, EventSpaceSyncError_value)
+	proto.RegisterEnum("anytype.EventSpaceActivityType", EventSpaceActivityType_name, EventSpaceActivityType_value)
 	proto.RegisterEnum("anytype.EventP2PStatusStatus", EventP2PStatusStatus_name, EventP2PStatusStatus_value)
 	proto.RegisterEnum("anytype.ModelProcessState", ModelProcessState_name, ModelProcessState_value)
 	proto.RegisterType((*Event)(nil), "anytype.Event")
@@ -12955,6 +13427,9 @@ func init() {
 	proto.RegisterType((*EventPayload)(nil), "anytype.Event.Payload")
 	proto.RegisterType((*EventPayloadBroadcast)(nil), "anytype.Event.Payload.Broadcast")
 	proto.RegisterType((*EventSpace)(nil), "anytype.Event.Space")
+	proto.RegisterType((*EventSpaceActivity)(nil), "anytype.Event.Space.Activity")
+	proto.RegisterType((*EventSpaceActivityEntry)(nil), "anytype.Event.Space.Activity.Entry")
+	proto.RegisterType((*EventSpaceActivityUpdate)(nil), "anytype.Event.Space.Activity.Update")
 	proto.RegisterType((*EventSpaceSyncStatus)(nil), "anytype.Event.Space.SyncStatus")
 	proto.RegisterType((*EventSpaceSyncStatusUpdate)(nil), "anytype.Event.Space.SyncStatus.Update")
 	proto.RegisterType((*EventP2PStatus)(nil), "anytype.Event.P2PStatus")
@@ -12970,428 +13445,452 @@ func init() {
 	proto.RegisterType((*ModelProcessSaveFile)(nil), "anytype.Model.Process.SaveFile")
 	proto.RegisterType((*ModelProcessMigration)(nil), "anytype.Model.Process.Migration")
 	proto.RegisterType((*ModelProcessPreloadFile)(nil), "anytype.Model.Process.PreloadFile")
+	proto.RegisterType((*ModelProcessObjectTransfer)(nil), "anytype.Model.Process.ObjectTransfer")
+	proto.RegisterType((*ModelProcessAuditLogExport)(nil), "anytype.Model.Process.AuditLogExport")
+	proto.RegisterType((*ModelProcessSpaceRepair)(nil), "anytype.Model.Process.SpaceRepair")
+	proto.RegisterType((*ModelProcessSpaceVerify)(nil), "anytype.Model.Process.SpaceVerify")
 	proto.RegisterType((*ModelProcessProgress)(nil), "anytype.Model.Process.Progress")
 }
 
 func init() { proto.RegisterFile("pb/protos/events.proto", fileDescriptor_a966342d378ae5f5) }
 
 var fileDescriptor_a966342d378ae5f5 = []byte{
-	// 6640 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5b, 0x8c, 0x1d, 0xc9,
-	0x59, 0xff, 0xb9, 0x5f, 0xbe, 0x19, 0x8f, 0x8f, 0x6b, 0xd7, 0x76, 0x6f, 0xaf, 0xd7, 0xeb, 0x1d,
-	0x5f, 0xd6, 0xf1, 0x7a, 0x8f, 0xbd, 0xb6, 0xd7, 0xb3, 0xf1, 0xae, 0x2f, 0x73, 0xb1, 0xf7, 0x8c,
-	0x2f, 0xe3, 0x49, 0x8d, 0xed, 0x6c, 0x36, 0xd1, 0xff, 0x9f, 0x9e, 0xd3, 0x35, 0x67, 0x3a, 0x3e,
-	0xd3, 0x7d, 0xd2, 0xdd, 0x33, 0xf6, 0xe4, 0x02, 0x21, 0x17, 0x42, 0x80, 0x08, 0x04, 0x08, 0x78,
-	0x02, 0x81, 0x40, 0xe2, 0x01, 0x21, 0x24, 0x5e, 0x00, 0x01, 0x42, 0x42, 0x48, 0xdc, 0x22, 0x05,
-	0x89, 0x87, 0xbc, 0x84, 0x44, 0x1b, 0x1e, 0x78, 0x80, 0x07, 0xf2, 0x80, 0x78, 0x44, 0x75, 0xe9,
-	0xea, 0xaa, 0xbe, 0x9c, 0x3e, 0x93, 0xdd, 0x70, 0x11, 0x79, 0x9a, 0x53, 0xd5, 0xdf, 0xef, 0x57,
-	0xb7, 0xaf, 0xbe, 0xaa, 0xfa, 0xea, 0x32, 0x70, 0x68, 0xb4, 0x7e, 0x6e, 0xe4, 0x7b, 0xa1, 0x17,
-	0x9c, 0x23, 0x3b, 0xc4, 0x0d, 0x83, 0x2e, 0x0b, 0xa1, 0xa6, 0xe5, 0xee, 0x86, 0xbb, 0x23, 0x62,
-	0x9e, 0x18, 0x3d, 0x1e, 0x9c, 0x1b, 0x3a, 0xeb, 0xe7, 0x46, 0xeb, 0xe7, 0xb6, 0x3c, 0x9b, 0x0c,
-	0x23, 0x71, 0x16, 0x10, 0xe2, 0xe6, 0x91, 0x81, 0xe7, 0x0d, 0x86, 0x84, 0x7f, 0x5b, 0xdf, 0xde,
-	0x38, 0x17, 0x84, 0xfe, 0x76, 0x3f, 0xe4, 0x5f, 0x67, 0xbf, 0xf9, 0x8d, 0x32, 0xd4, 0x6f, 0x52,
-	0x7a, 0x74, 0x01, 0x5a, 0x5b, 0x24, 0x08, 0xac, 0x01, 0x09, 0x8c, 0xf2, 0xb1, 0xea, 0xe9, 0xa9,
-	0x0b, 0x87, 0xba, 0x22, 0xa9, 0x2e, 0x93, 0xe8, 0xde, 0xe3, 0x9f, 0xb1, 0x94, 0x43, 0x47, 0xa0,
-	0xdd, 0xf7, 0xdc, 0x90, 0x3c, 0x0d, 0x97, 0x6d, 0xa3, 0x72, 0xac, 0x7c, 0xba, 0x8d, 0xe3, 0x08,
-	0x74, 0x09, 0xda, 0x8e, 0xeb, 0x84, 0x8e, 0x15, 0x7a, 0xbe, 0x51, 0x3d, 0x56, 0xd6, 0x28, 0x59,
-	0x26, 0xbb, 0xf3, 0xfd, 0xbe, 0xb7, 0xed, 0x86, 0x38, 0x16, 0x44, 0x06, 0x34, 0x43, 0xdf, 0xea,
-	0x93, 0x65, 0xdb, 0xa8, 0x31, 0xc6, 0x28, 0x68, 0xfe, 0xe2, 0x1c, 0x34, 0x45, 0x1e, 0xd0, 0x73,
-	0xd0, 0x0c, 0x46, 0x5c, 0xea, 0xcb, 0x65, 0x2e, 0x26, 0xc2, 0xe8, 0x3a, 0x4c, 0x59, 0x9c, 0x76,
-	0x6d, 0xd3, 0x7b, 0x62, 0x94, 0x59, 0xc2, 0xcf, 0x27, 0xca, 0x22, 0x12, 0xee, 0x52, 0x91, 0x5e,
-	0x09, 0xab, 0x08, 0xb4, 0x0c, 0x33, 0x22, 0xb8, 0x44, 0x42, 0xcb, 0x19, 0x06, 0xc6, 0x5f, 0x73,
-	0x92, 0xa3, 0x39, 0x24, 0x42, 0xac, 0x57, 0xc2, 0x09, 0x20, 0xfa, 0x18, 0x3c, 0x23, 0x62, 0x16,
-	0x3d, 0x77, 0xc3, 0x19, 0x3c, 0x1c, 0xd9, 0x56, 0x48, 0x8c, 0xbf, 0xe1, 0x7c, 0x27, 0x72, 0xf8,
-	0xb8, 0x6c, 0x97, 0x0b, 0xf7, 0x4a, 0x38, 0x8b, 0x03, 0xdd, 0x82, 0x7d, 0x22, 0x5a, 0x90, 0xfe,
-	0x2d, 0x27, 0x7d, 0x21, 0x87, 0x54, 0xb2, 0xe9, 0x30, 0xf4, 0x71, 0x78, 0x56, 0x44, 0xdc, 0x75,
-	0xdc, 0xc7, 0x8b, 0x9b, 0xd6, 0x70, 0x48, 0xdc, 0x01, 0x31, 0xfe, 0x6e, 0x7c, 0x1e, 0x35, 0xe1,
-	0x5e, 0x09, 0x67, 0x92, 0xa0, 0x01, 0x18, 0x59, 0xf1, 0x3d, 0xc7, 0x26, 0xc6, 0x37, 0x78, 0x02,
-	0xa7, 0x27, 0x4a, 0xc0, 0xb1, 0x69, 0x22, 0xb9, 0x64, 0xe8, 0x3e, 0x74, 0xbc, 0xf5, 0x4f, 0x91,
-	0x7e, 0x54, 0xf3, 0x6b, 0x24, 0x34, 0x3a, 0x8c, 0xff, 0xa5, 0x04, 0xff, 0x7d, 0x26, 0x16, 0xb5,
-	0x59, 0x77, 0x8d, 0x84, 0xbd, 0x12, 0x4e, 0x81, 0xd1, 0x43, 0x40, 0x5a, 0xdc, 0xfc, 0x16, 0x71,
-	0x6d, 0xe3, 0x02, 0xa3, 0x3c, 0x3e, 0x9e, 0x92, 0x89, 0xf6, 0x4a, 0x38, 0x83, 0x20, 0x45, 0xfb,
-	0xd0, 0x0d, 0x48, 0x68, 0x5c, 0x9c, 0x84, 0x96, 0x89, 0xa6, 0x68, 0x59, 0x2c, 0x6d, 0x44, 0x1e,
-	0x8b, 0xc9, 0xd0, 0x0a, 0x1d, 0xcf, 0x15, 0xf9, 0xbd, 0xc4, 0x88, 0x4f, 0x66, 0x13, 0x4b, 0x59,
-	0x99, 0xe3, 0x4c, 0x12, 0xf4, 0xff, 0xe0, 0x60, 0x22, 0x1e, 0x93, 0x2d, 0x6f, 0x87, 0x18, 0xaf,
-	0x33, 0xf6, 0x53, 0x45, 0xec, 0x5c, 0xba, 0x57, 0xc2, 0xd9, 0x34, 0x68, 0x01, 0xa6, 0xa3, 0x0f,
-	0x8c, 0xf6, 0x32, 0xa3, 0x3d, 0x92, 0x47, 0x2b, 0xc8, 0x34, 0x0c, 0xed, 0xf4, 0x3c, 0xbc, 0x38,
-	0xf4, 0x02, 0x62, 0xcc, 0x67, 0x76, 0x7a, 0x41, 0xc1, 0x44, 0x68, 0xa7, 0x57, 0x10, 0x6a, 0x21,
-	0x83, 0xd0, 0x77, 0xfa, 0x2c, 0x83, 0x54, 0x8b, 0xe6, 0xc6, 0x17, 0x32, 0x16, 0x16, 0xaa, 0x94,
-	0x4d, 0x83, 0x30, 0xec, 0x0f, 0xb6, 0xd7, 0x83, 0xbe, 0xef, 0x8c, 0x68, 0xdc, 0xbc, 0x6d, 0x1b,
-	0x6f, 0x8d, 0x63, 0x5e, 0x53, 0x84, 0xbb, 0xf3, 0x36, 0x6d, 0x9d, 0x24, 0x01, 0xfa, 0x38, 0x20,
-	0x35, 0x4a, 0x54, 0xdf, 0x55, 0x46, 0xfb, 0xa1, 0x09, 0x68, 0x65, 0x5d, 0x66, 0xd0, 0x20, 0x0b,
-	0x9e, 0x55, 0x63, 0x57, 0xbd, 0xc0, 0xa1, 0x7f, 0x8d, 0x6b, 0x8c, 0xfe, 0x95, 0x09, 0xe8, 0x23,
-	0x08, 0x55, 0xac, 0x2c, 0xaa, 0x64, 0x12, 0x8b, 0xb4, 0x6b, 0x13, 0x3f, 0x30, 0xae, 0x4f, 0x9c,
-	0x44, 0x04, 0x49, 0x26, 0x11, 0xc5, 0x27, 0xab, 0xe8, 0x6d, 0xdf, 0xdb, 0x1e, 0x05, 0xc6, 0x8d,
-	0x89, 0xab, 0x88, 0x03, 0x92, 0x55, 0xc4, 0x63, 0xd1, 0x65, 0x68, 0xad, 0x0f, 0xbd, 0xfe, 0x63,
-	0xda, 0x98, 0x15, 0x46, 0x69, 0x24, 0x28, 0x17, 0xe8, 0x67, 0xd1, 0x7c, 0x52, 0x96, 0x2a, 0x2b,
-	0xfb, 0xbd, 0x44, 0x86, 0x24, 0x24, 0x62, 0x68, 0x7c, 0x3e, 0x13, 0xca, 0x45, 0xa8, 0xb2, 0x2a,
-	0x08, 0xb4, 0x04, 0x53, 0x1b, 0xce, 0x90, 0x04, 0x0f, 0x47, 0x43, 0xcf, 0xe2, 0xe3, 0xe4, 0xd4,
-	0x85, 0x63, 0x99, 0x04, 0xb7, 0x62, 0x39, 0xca, 0xa2, 0xc0, 0xd0, 0x35, 0x68, 0x6f, 0x59, 0xfe,
-	0xe3, 0x60, 0xd9, 0xdd, 0xf0, 0x8c, 0x7a, 0xe6, 0x08, 0xc7, 0x39, 0xee, 0x45, 0x52, 0xbd, 0x12,
-	0x8e, 0x21, 0x74, 0x9c, 0x64, 0x99, 0x5a, 0x23, 0xe1, 0x2d, 0x87, 0x0c, 0xed, 0xc0, 0x68, 0x30,
-	0x92, 0x17, 0x33, 0x49, 0xd6, 0x48, 0xd8, 0xe5, 0x62, 0x74, 0x9c, 0xd4, 0x81, 0xe8, 0x1d, 0x78,
-	0x26, 0x8a, 0x59, 0xdc, 0x74, 0x86, 0xb6, 0x4f, 0xdc, 0x65, 0x3b, 0x30, 0x9a, 0x99, 0x43, 0x50,
-	0xcc, 0xa7, 0xc8, 0xd2, 0x61, 0x32, 0x83, 0x82, 0x5a, 0xc6, 0x28, 0x5a, 0xed, 0x92, 0x46, 0x2b,
-	0xd3, 0x32, 0xc6, 0xd4, 0xaa, 0x30, 0xd5, 0xae, 0x2c, 0x12, 0x64, 0xc3, 0xe1, 0x28, 0x7e, 0xc1,
-	0xea, 0x3f, 0x1e, 0xf8, 0xde, 0xb6, 0x6b, 0x2f, 0x7a, 0x43, 0xcf, 0x37, 0xda, 0x99, 0x83, 0x5b,
-	0xcc, 0x9f, 0x90, 0xef, 0x95, 0x70, 0x1e, 0x15, 0x5a, 0x84, 0xe9, 0xe8, 0xd3, 0x03, 0xf2, 0x34,
-	0x34, 0x20, 0x73, 0x9c, 0x8f, 0xa9, 0xa9, 0x10, 0x35, 0x90, 0x2a, 0x48, 0x25, 0xa1, 0x2a, 0x61,
-	0x4c, 0x15, 0x90, 0x50, 0x21, 0x95, 0x84, 0x86, 0x55, 0x12, 0x3a, 0x04, 0x1b, 0xfb, 0x0a, 0x48,
-	0xa8, 0x90, 0x4a, 0x42, 0xc3, 0x74, 0xa8, 0x96, 0x25, 0xf5, 0xbc, 0xc7, 0x54, 0x9f, 0x8c, 0x99,
-	0xcc, 0xa1, 0x5a, 0xa9, 0x2d, 0x21, 0x48, 0x87, 0xea, 0x24, 0x98, 0xce, 0x84, 0xa2, 0xb8, 0xf9,
-	0xa1, 0x33, 0x70, 0x8d, 0xfd, 0x63, 0x74, 0x99, 0xb2, 0x31, 0x29, 0x3a, 0x13, 0xd2, 0x60, 0xe8,
-	0x86, 0xe8, 0x96, 0x6b, 0x24, 0x5c, 0x72, 0x76, 0x8c, 0x03, 0x99, 0xc3, 0x50, 0xcc, 0xb2, 0xe4,
-	0xec, 0xc8, 0x7e, 0xc9, 0x21, 0x6a, 0xd1, 0xa2, 0x41, 0xce, 0x38, 0x58, 0x50, 0xb4, 0x48, 0x50,
-	0x2d, 0x5a, 0x14, 0xa7, 0x16, 0xed, 0xae, 0x15, 0x92, 0xa7, 0xc6, 0x73, 0x05, 0x45, 0x63, 0x52,
-	0x6a, 0xd1, 0x58, 0x04, 0x1d, 0xdd, 0xa2, 0x88, 0x47, 0xc4, 0x0f, 0x9d, 0xbe, 0x35, 0xe4, 0x55,
-	0x75, 0x22, 0x73, 0x0c, 0x8a, 0xf9, 0x34, 0x69, 0x3a, 0xba, 0x65, 0xd2, 0xa8, 0x05, 0x7f, 0x60,
-	0xad, 0x0f, 0x09, 0xf6, 0x9e, 0x18, 0x27, 0x0b, 0x0a, 0x1e, 0x09, 0xaa, 0x05, 0x8f, 0xe2, 0x54,
-	0xdb, 0xf2, 0x51, 0xc7, 0x1e, 0x90, 0xd0, 0x38, 0x5d, 0x60, 0x5b, 0xb8, 0x98, 0x6a, 0x5b, 0x78,
-	0x8c, 0xb4, 0x00, 0x4b, 0x56, 0x68, 0xed, 0x38, 0xe4, 0xc9, 0x23, 0x87, 0x3c, 0xa1, 0x03, 0xfb,
-	0x33, 0x63, 0x2c, 0x40, 0x24, 0xdb, 0x15, 0xc2, 0xd2, 0x02, 0x24, 0x48, 0xa4, 0x05, 0x50, 0xe3,
-	0x85, 0x59, 0x7f, 0x76, 0x8c, 0x05, 0xd0, 0xf8, 0xa5, 0x8d, 0xcf, 0xa3, 0x42, 0x16, 0x1c, 0x4a,
-	0x7d, 0xba, 0xef, 0xdb, 0xc4, 0x37, 0x5e, 0x60, 0x89, 0xbc, 0x5c, 0x9c, 0x08, 0x13, 0xef, 0x95,
-	0x70, 0x0e, 0x51, 0x2a, 0x89, 0x35, 0x6f, 0xdb, 0xef, 0x13, 0x5a, 0x4f, 0xc7, 0x27, 0x49, 0x42,
-	0x8a, 0xa7, 0x92, 0x90, 0x5f, 0xd0, 0x0e, 0xbc, 0x20, 0xbf, 0xd0, 0x84, 0xd9, 0x28, 0xca, 0x52,
-	0x17, 0x2b, 0x98, 0x53, 0x2c, 0xa5, 0xee, 0xf8, 0x94, 0x92, 0xa8, 0x5e, 0x09, 0x8f, 0xa7, 0x45,
-	0xbb, 0x70, 0x54, 0x13, 0xe0, 0xe3, 0xbc, 0x9a, 0xf0, 0xcb, 0x2c, 0xe1, 0x73, 0xe3, 0x13, 0x4e,
-	0xc1, 0x7a, 0x25, 0x5c, 0x40, 0x8c, 0x46, 0xf0, 0xbc, 0x56, 0x19, 0x51, 0xc7, 0x16, 0x2a, 0xf2,
-	0x39, 0x96, 0xee, 0xd9, 0xf1, 0xe9, 0xea, 0x98, 0x5e, 0x09, 0x8f, 0xa3, 0xa4, 0x2b, 0xae, 0xcc,
-	0xcf, 0xb4, 0x25, 0x3f, 0x9b, 0x39, 0xed, 0xc9, 0x49, 0x8e, 0xb7, 0x65, 0x2e, 0x59, 0xa6, 0xe6,
-	0x8b, 0xea, 0xfc, 0xfc, 0xa4, 0x9a, 0x2f, 0xeb, 0x31, 0x8f, 0x4a, 0x6b, 0x3b, 0xfa, 0xe9, 0x81,
-	0xe5, 0x0f, 0x48, 0xc8, 0x2b, 0x7a, 0xd9, 0xa6, 0x85, 0xfa, 0xb1, 0x49, 0xda, 0x2e, 0x05, 0xd3,
-	0xda, 0x2e, 0x93, 0x18, 0x05, 0x70, 0x44, 0x93, 0x58, 0x0e, 0x16, 0xbd, 0xe1, 0x90, 0xf4, 0xa3,
-	0xda, 0xfc, 0x71, 0x96, 0xf0, 0xab, 0xe3, 0x13, 0x4e, 0x80, 0x7a, 0x25, 0x3c, 0x96, 0x34, 0x55,
-	0xde, 0xfb, 0x43, 0x3b, 0xa1, 0x33, 0xc6, 0x44, 0xba, 0x9a, 0x84, 0xa5, 0xca, 0x9b, 0x92, 0x48,
-	0xe9, 0xaa, 0x22, 0x41, 0x8b, 0x7b, 0x78, 0x12, 0x5d, 0xd5, 0x31, 0x29, 0x5d, 0xd5, 0x3f, 0xd3,
-	0xd1, 0x6d, 0x3b, 0x20, 0x3e, 0xe3, 0xb8, 0xed, 0x39, 0xae, 0xf1, 0x62, 0xe6, 0xe8, 0xf6, 0x30,
-	0x20, 0xbe, 0x48, 0x88, 0x4a, 0xd1, 0xd1, 0x4d, 0x83, 0x69, 0x3c, 0x77, 0xc9, 0x46, 0x68, 0x1c,
-	0x2b, 0xe2, 0xa1, 0x52, 0x1a, 0x0f, 0x8d, 0xa0, 0x23, 0x85, 0x8c, 0x58, 0x23, 0xb4, 0x55, 0xb0,
-	0xe5, 0x0e, 0x88, 0xf1, 0x52, 0xe6, 0x48, 0xa1, 0xd0, 0x29, 0xc2, 0x74, 0xa4, 0xc8, 0x22, 0xa1,
-	0x2b, 0x7f, 0x19, 0x4f, 0x67, 0x64, 0x9c, 0x7a, 0x36, 0x73, 0xe5, 0xaf, 0x50, 0x4b, 0x51, 0xba,
-	0x06, 0x49, 0x13, 0xa0, 0x0f, 0x41, 0x6d, 0xe4, 0xb8, 0x03, 0xc3, 0x66, 0x44, 0xcf, 0x24, 0x88,
-	0x56, 0x1d, 0x77, 0xd0, 0x2b, 0x61, 0x26, 0x82, 0xde, 0x02, 0x18, 0xf9, 0x5e, 0x9f, 0x04, 0xc1,
-	0x0a, 0x79, 0x62, 0x10, 0x06, 0x30, 0x93, 0x00, 0x2e, 0xd0, 0x5d, 0x21, 0x74, 0x5c, 0x56, 0xe4,
-	0xd1, 0x4d, 0xd8, 0x27, 0x42, 0xa2, 0x97, 0x6f, 0x64, 0x4e, 0xfe, 0x22, 0x82, 0xd8, 0xdd, 0xa4,
-	0xa1, 0xe8, 0xda, 0x47, 0x44, 0x2c, 0x79, 0x2e, 0x31, 0x06, 0x99, 0x6b, 0x9f, 0x88, 0x84, 0x8a,
-	0xd0, 0x39, 0x96, 0x82, 0x40, 0x0b, 0x30, 0x1d, 0x6e, 0xfa, 0xc4, 0xb2, 0xd7, 0x42, 0x2b, 0xdc,
-	0x0e, 0x0c, 0x37, 0x73, 0x9a, 0xc6, 0x3f, 0x76, 0x1f, 0x30, 0x49, 0x3a, 0x05, 0x55, 0x31, 0x68,
-	0x05, 0x3a, 0x74, 0x21, 0x74, 0xd7, 0xd9, 0x72, 0x42, 0x4c, 0xac, 0xfe, 0x26, 0xb1, 0x0d, 0x2f,
-	0x73, 0x11, 0x45, 0xa7, 0xbd, 0x5d, 0x55, 0x8e, 0xce, 0x56, 0x92, 0x58, 0xd4, 0x83, 0x19, 0x1a,
-	0xb7, 0x36, 0xb2, 0xfa, 0xe4, 0x61, 0x60, 0x0d, 0x88, 0x31, 0xca, 0xd4, 0x40, 0xc6, 0x16, 0x4b,
-	0xd1, 0xc9, 0x8a, 0x8e, 0x8b, 0x98, 0xee, 0x7a, 0x7d, 0x6b, 0xc8, 0x99, 0x3e, 0x9d, 0xcf, 0x14,
-	0x4b, 0x45, 0x4c, 0x71, 0x8c, 0x56, 0x46, 0x5e, 0xf7, 0xb6, 0xb1, 0x53, 0x50, 0x46, 0x21, 0xa7,
-	0x95, 0x51, 0xc4, 0x51, 0x3e, 0xd7, 0x0b, 0x9d, 0x0d, 0xa7, 0x2f, 0xfa, 0xaf, 0x6b, 0x1b, 0x7e,
-	0x26, 0xdf, 0x8a, 0x22, 0xd6, 0x5d, 0xe3, 0x9e, 0xa5, 0x14, 0x16, 0x3d, 0x00, 0xa4, 0xc6, 0x09,
-	0xa5, 0x0a, 0x18, 0xe3, 0xec, 0x38, 0x46, 0xa9, 0x59, 0x19, 0x78, 0x9a, 0xcb, 0x91, 0xb5, 0x4b,
-	0x97, 0xb7, 0x0b, 0xbe, 0x67, 0xd9, 0x7d, 0x2b, 0x08, 0x8d, 0x30, 0x33, 0x97, 0xab, 0x5c, 0xac,
-	0x2b, 0xe5, 0x68, 0x2e, 0x93, 0x58, 0xca, 0xb7, 0x45, 0xb6, 0xd6, 0x89, 0x1f, 0x6c, 0x3a, 0x23,
-	0x91, 0xc7, 0xed, 0x4c, 0xbe, 0x7b, 0x52, 0x2c, 0xce, 0x61, 0x0a, 0x4b, 0x27, 0xe2, 0x71, 0xdc,
-	0x03, 0x87, 0xf8, 0x51, 0x6f, 0xfa, 0x5a, 0x39, 0xd3, 0xc8, 0x28, 0xac, 0x8a, 0x34, 0x9d, 0x88,
-	0x67, 0xd2, 0x50, 0x7e, 0xe6, 0x07, 0x5f, 0xdb, 0x75, 0xfb, 0x5c, 0xd9, 0x05, 0xff, 0x93, 0xcc,
-	0x89, 0x3e, 0xd3, 0xbc, 0x6e, 0x2c, 0x1c, 0x67, 0x3d, 0x9b, 0x06, 0xdd, 0x81, 0xfd, 0xa3, 0x0b,
-	0x23, 0x8d, 0xf9, 0x69, 0xe6, 0xc4, 0x7c, 0xf5, 0xc2, 0x6a, 0x92, 0x32, 0x89, 0xa4, 0x5d, 0xd9,
-	0xd9, 0x1a, 0x79, 0x7e, 0x78, 0xcb, 0x71, 0x9d, 0x60, 0xd3, 0xd8, 0xcd, 0xec, 0xca, 0xcb, 0x4c,
-	0xa4, 0xcb, 0x65, 0x68, 0x57, 0x56, 0x31, 0xe8, 0x12, 0x34, 0xfb, 0x9b, 0x56, 0x38, 0x6f, 0xdb,
-	0xc6, 0x17, 0x78, 0x15, 0x1e, 0x4e, 0xe0, 0x17, 0x37, 0xad, 0x50, 0xb8, 0x60, 0x22, 0x51, 0x74,
-	0x15, 0x80, 0xfe, 0x14, 0x25, 0xf8, 0x89, 0x72, 0xa6, 0x2d, 0x64, 0x40, 0x99, 0x7b, 0x05, 0x80,
-	0xde, 0x81, 0x67, 0xe2, 0x10, 0x35, 0x02, 0xdc, 0xa7, 0xf0, 0xc5, 0x72, 0xa6, 0x35, 0x57, 0x78,
-	0xa4, 0x6c, 0xaf, 0x84, 0xb3, 0x28, 0xe8, 0x20, 0x1c, 0x47, 0x47, 0x1b, 0x2e, 0xb1, 0xb1, 0xfb,
-	0xc9, 0x72, 0xa6, 0x6b, 0x4c, 0x49, 0x21, 0x85, 0xa1, 0x83, 0xf0, 0x18, 0xca, 0x64, 0x8a, 0x2e,
-	0x77, 0x01, 0xca, 0x14, 0xbf, 0x3a, 0x41, 0x8a, 0x09, 0x4c, 0x32, 0xc5, 0xc4, 0xe7, 0xcc, 0x32,
-	0xc6, 0x8a, 0x66, 0xfc, 0xd4, 0xa4, 0x65, 0x8c, 0x31, 0x99, 0x65, 0x8c, 0x3f, 0x47, 0xcd, 0x2d,
-	0x66, 0x50, 0x5f, 0x1a, 0xd3, 0xdc, 0x72, 0xb6, 0xa4, 0x00, 0xd0, 0x5d, 0xd8, 0x4f, 0x43, 0x94,
-	0x8c, 0x08, 0x95, 0xf9, 0x4a, 0x39, 0x53, 0xeb, 0x95, 0x4c, 0x32, 0x69, 0xaa, 0xf5, 0x09, 0x28,
-	0x9d, 0x08, 0xc4, 0x7d, 0xf7, 0xd1, 0x05, 0x41, 0xf8, 0xd3, 0xe5, 0x4c, 0xcb, 0x77, 0x4f, 0x91,
-	0x54, 0x2c, 0x5f, 0x9a, 0x00, 0x6d, 0x81, 0xa9, 0xc6, 0xae, 0xfa, 0x9e, 0xbd, 0xdd, 0x0f, 0xa3,
-	0x4e, 0xfa, 0x33, 0x9c, 0xfe, 0xcc, 0x38, 0x7a, 0x1d, 0xd2, 0x2b, 0xe1, 0x31, 0x84, 0x0b, 0x4d,
-	0xa8, 0xef, 0x58, 0xc3, 0x6d, 0x62, 0x7e, 0xbf, 0x01, 0x35, 0x5a, 0x6c, 0xf3, 0x1f, 0xcb, 0x50,
-	0xa5, 0x7d, 0x6b, 0x06, 0x2a, 0x8e, 0x6d, 0xf0, 0x4d, 0xb9, 0x8a, 0x63, 0x23, 0x03, 0x9a, 0x1e,
-	0x5d, 0x12, 0xc9, 0x2d, 0xc2, 0x28, 0x88, 0x66, 0x61, 0xda, 0xda, 0x08, 0x89, 0x7f, 0x5f, 0x7c,
-	0x6e, 0xb0, 0xcf, 0x5a, 0x1c, 0xed, 0xdf, 0x62, 0xbb, 0x51, 0xf8, 0x49, 0xcd, 0xc4, 0x16, 0x22,
-	0x4d, 0x3b, 0xd2, 0xea, 0x48, 0x14, 0x1d, 0x82, 0x46, 0xb0, 0xbd, 0xbe, 0x6c, 0x07, 0x46, 0xed,
-	0x58, 0xf5, 0x74, 0x1b, 0x8b, 0x10, 0x7a, 0x13, 0xa6, 0x6d, 0x32, 0x22, 0xae, 0x4d, 0xdc, 0xbe,
-	0x43, 0x02, 0xa3, 0xce, 0x36, 0x3a, 0x0f, 0x77, 0xf9, 0x26, 0x69, 0x37, 0xda, 0x24, 0xed, 0xae,
-	0xb1, 0x4d, 0x52, 0xac, 0x09, 0x9b, 0xe7, 0xa1, 0x21, 0x14, 0x22, 0x59, 0xc4, 0x38, 0xb9, 0x8a,
-	0x9a, 0x9c, 0xb9, 0x01, 0x0d, 0xd1, 0x3a, 0x49, 0x84, 0x52, 0xac, 0xca, 0x0f, 0x52, 0xac, 0xaa,
-	0x96, 0xce, 0xe7, 0x61, 0x7f, 0xd2, 0x90, 0x24, 0x13, 0x5c, 0x80, 0xb6, 0x2f, 0x0d, 0x55, 0x25,
-	0xe1, 0x57, 0x4d, 0x25, 0xd9, 0x95, 0x44, 0x38, 0x86, 0xe5, 0x26, 0xff, 0x71, 0x38, 0x9c, 0x67,
-	0x5d, 0x3a, 0x50, 0x75, 0x6c, 0xbe, 0xa1, 0xdc, 0xc6, 0xf4, 0x27, 0x25, 0x71, 0x02, 0x2a, 0xc1,
-	0x72, 0xd1, 0xc2, 0x22, 0x34, 0x09, 0x79, 0xd2, 0x90, 0xbc, 0x7f, 0xf2, 0xff, 0x9f, 0xc8, 0xb9,
-	0x62, 0x33, 0xd2, 0xe4, 0x26, 0xb4, 0x9c, 0x80, 0x4a, 0x90, 0x88, 0x5e, 0x86, 0x73, 0x13, 0x78,
-	0x08, 0x53, 0x8a, 0x39, 0x40, 0x5d, 0xa8, 0x07, 0xf4, 0x87, 0xd8, 0x95, 0x36, 0x32, 0x5a, 0x80,
-	0x09, 0x62, 0x2e, 0x96, 0xab, 0x58, 0x7f, 0xdc, 0x80, 0xa6, 0xd8, 0x28, 0x35, 0x57, 0xa0, 0xc6,
-	0xb6, 0xad, 0x9f, 0x85, 0xba, 0xe3, 0xda, 0xe4, 0x29, 0xe3, 0xae, 0x63, 0x1e, 0x40, 0xe7, 0xa1,
-	0x29, 0x36, 0x4d, 0x45, 0xab, 0xe7, 0x6d, 0xc1, 0x47, 0x62, 0xe6, 0xbb, 0xd0, 0x8c, 0xb6, 0xaf,
-	0x8f, 0x40, 0x7b, 0xe4, 0x7b, 0x74, 0x2a, 0xb8, 0x1c, 0xe9, 0x52, 0x1c, 0x81, 0x5e, 0x83, 0xa6,
-	0x2d, 0x36, 0xc8, 0x2b, 0x62, 0xe4, 0xcd, 0xe9, 0x47, 0x91, 0x9c, 0xf9, 0x85, 0x32, 0x34, 0xf8,
-	0x2e, 0xb6, 0xb9, 0x23, 0xfb, 0xc6, 0xeb, 0xd0, 0xe8, 0xb3, 0x38, 0x23, 0xb9, 0x83, 0xad, 0xe5,
-	0x50, 0x6c, 0x8b, 0x63, 0x21, 0x4c, 0x61, 0x01, 0x1f, 0x31, 0x2a, 0x63, 0x61, 0xbc, 0x3d, 0xb1,
-	0x10, 0xfe, 0x6f, 0x4b, 0xf7, 0x1f, 0x2a, 0xb0, 0x4f, 0xdf, 0x1c, 0x3f, 0x02, 0xed, 0xbe, 0xdc,
-	0x6e, 0x17, 0xb5, 0x2b, 0x23, 0xd0, 0x7d, 0x80, 0xfe, 0xd0, 0x21, 0x6e, 0xc8, 0xb6, 0x67, 0x2a,
-	0x99, 0xab, 0xfe, 0xcc, 0xbd, 0xf2, 0xee, 0xa2, 0x84, 0x61, 0x85, 0x02, 0x5d, 0x87, 0x7a, 0xd0,
-	0xf7, 0x46, 0xdc, 0x8e, 0xce, 0x28, 0x6e, 0x20, 0x3d, 0xdb, 0xf3, 0xdb, 0xe1, 0x26, 0x5f, 0x59,
-	0xcc, 0x8f, 0x9c, 0x35, 0x0a, 0xc0, 0x1c, 0x67, 0xfe, 0x42, 0x19, 0x20, 0xe6, 0x46, 0xc7, 0xe4,
-	0x4a, 0x6e, 0xc5, 0xda, 0x8a, 0x0a, 0xa0, 0x46, 0x29, 0x12, 0xab, 0x56, 0xb8, 0x29, 0xac, 0xbf,
-	0x1a, 0x85, 0x10, 0xd4, 0x5c, 0x0a, 0xe6, 0x27, 0x3d, 0xd8, 0x6f, 0x74, 0x16, 0x0e, 0x04, 0xce,
-	0xc0, 0xb5, 0xc2, 0x6d, 0x9f, 0x3c, 0x22, 0xbe, 0xb3, 0xe1, 0x10, 0x9b, 0xe5, 0xb9, 0x85, 0xd3,
-	0x1f, 0xcc, 0xd7, 0xe0, 0x40, 0xfa, 0x34, 0xc0, 0xd8, 0x9a, 0x35, 0xbf, 0xd6, 0x86, 0x06, 0x77,
-	0xf4, 0x98, 0xff, 0x5e, 0x91, 0xca, 0x6e, 0xfe, 0x45, 0x19, 0xea, 0x7c, 0xc3, 0x3b, 0x69, 0x3b,
-	0x6f, 0xa9, 0x8a, 0x5e, 0xcd, 0xf0, 0x82, 0x64, 0x1d, 0x00, 0xe8, 0xde, 0x21, 0xbb, 0x8f, 0xe8,
-	0x08, 0x29, 0xb5, 0x3f, 0xd7, 0x48, 0xdc, 0x86, 0x56, 0x24, 0x4c, 0xcd, 0xce, 0x63, 0xb2, 0x2b,
-	0x12, 0xa7, 0x3f, 0xd1, 0x59, 0x31, 0xd2, 0xca, 0xfe, 0x9b, 0xec, 0x64, 0x3c, 0x15, 0x31, 0x1c,
-	0x7f, 0x12, 0xaa, 0x6b, 0x24, 0x4c, 0x15, 0x61, 0xef, 0x7d, 0x35, 0x37, 0xb7, 0x8b, 0x50, 0xe7,
-	0x87, 0x0e, 0x92, 0x69, 0x20, 0xa8, 0x3d, 0x26, 0xbb, 0x91, 0xa9, 0x62, 0xbf, 0x73, 0x49, 0xfe,
-	0xbc, 0x0a, 0xd3, 0xea, 0x46, 0xab, 0x79, 0x33, 0x77, 0xf2, 0xc0, 0xa6, 0x03, 0xf1, 0xe4, 0x41,
-	0x04, 0xa9, 0xb9, 0x63, 0x5c, 0x4c, 0x35, 0xda, 0x98, 0x07, 0xcc, 0x2e, 0x34, 0xc4, 0xfe, 0x75,
-	0x92, 0x49, 0xca, 0x57, 0x54, 0xf9, 0xdb, 0xd0, 0x92, 0xdb, 0xd1, 0xef, 0x37, 0x6d, 0x1f, 0x5a,
-	0x72, 0xdf, 0xf9, 0x59, 0xa8, 0x87, 0x5e, 0x68, 0x0d, 0x19, 0x5d, 0x15, 0xf3, 0x00, 0xd5, 0x4b,
-	0x97, 0x3c, 0x0d, 0x17, 0xa5, 0x39, 0xae, 0xe2, 0x38, 0x82, 0x5b, 0x5b, 0xb2, 0xc3, 0xbf, 0x56,
-	0xf9, 0x57, 0x19, 0x11, 0xa7, 0x59, 0x53, 0xd3, 0xdc, 0x85, 0x86, 0xd8, 0x8c, 0x96, 0xdf, 0xcb,
-	0xca, 0x77, 0x34, 0x0f, 0xf5, 0x01, 0xfd, 0x2e, 0x5a, 0xfd, 0x95, 0x44, 0xa7, 0xe7, 0x3e, 0xa6,
-	0x45, 0xcf, 0x0d, 0xa9, 0x1a, 0xeb, 0x3e, 0x76, 0xcc, 0x91, 0xb4, 0x09, 0x7d, 0x7e, 0xb2, 0x80,
-	0x77, 0x42, 0x11, 0x32, 0x7f, 0xbb, 0x0c, 0x6d, 0x79, 0x94, 0xc3, 0x7c, 0x37, 0xaf, 0xf3, 0xcc,
-	0xc3, 0x3e, 0x5f, 0x48, 0xd1, 0x8e, 0x1a, 0x75, 0xa1, 0xe7, 0x13, 0x39, 0xc1, 0x8a, 0x0c, 0xd6,
-	0x11, 0xe6, 0x5b, 0xb9, 0x8d, 0x3a, 0x0b, 0xd3, 0x91, 0xe8, 0x9d, 0x58, 0xf5, 0xb4, 0x38, 0xd3,
-	0x94, 0xe8, 0xd4, 0x90, 0x6e, 0x6e, 0xc0, 0xb4, 0xba, 0xa1, 0x6b, 0x3e, 0xca, 0xee, 0x3d, 0xd7,
-	0x69, 0x32, 0xca, 0xe6, 0x71, 0x25, 0xe1, 0xb5, 0x8a, 0x8a, 0x10, 0x8b, 0x60, 0x0d, 0x60, 0x1e,
-	0x86, 0x3a, 0x3f, 0x66, 0x92, 0x60, 0x36, 0xff, 0xa9, 0x0f, 0x75, 0xd6, 0x08, 0xe6, 0x45, 0xde,
-	0x01, 0xce, 0x42, 0x83, 0xb9, 0x4c, 0xa3, 0x43, 0x78, 0xcf, 0x66, 0xb5, 0x18, 0x16, 0x32, 0xe6,
-	0x22, 0x4c, 0x29, 0x1b, 0xfc, 0x54, 0x63, 0xd9, 0x07, 0xa9, 0x05, 0x51, 0x90, 0xce, 0x5d, 0xe8,
-	0xa8, 0x2d, 0xec, 0x30, 0x2d, 0xbf, 0x0c, 0x9b, 0x27, 0xe4, 0xbc, 0xd6, 0x14, 0x07, 0x1a, 0x96,
-	0x65, 0x2d, 0xc9, 0xb0, 0xf9, 0x09, 0x68, 0xcb, 0x73, 0x00, 0xe8, 0x3e, 0x4c, 0x8b, 0x73, 0x00,
-	0xdc, 0x8d, 0x49, 0x85, 0x67, 0x0a, 0xb4, 0xeb, 0x01, 0x79, 0x1a, 0xb2, 0xa3, 0x04, 0xdd, 0x07,
-	0xbb, 0x23, 0x82, 0x35, 0x02, 0xf3, 0x2b, 0xa7, 0x59, 0xcd, 0x9b, 0x23, 0x68, 0xc9, 0xcd, 0xcf,
-	0x64, 0x2b, 0xcc, 0x71, 0xd3, 0x58, 0x29, 0xdc, 0xb9, 0xe7, 0x78, 0x6a, 0x80, 0x99, 0x05, 0x35,
-	0x9f, 0x87, 0xea, 0x1d, 0xb2, 0x4b, 0x7b, 0x08, 0x37, 0xa4, 0xa2, 0x87, 0x70, 0x83, 0xb9, 0x0c,
-	0x0d, 0x71, 0x08, 0x21, 0x99, 0xde, 0x39, 0x68, 0x6c, 0xf0, 0x73, 0x0d, 0x05, 0x26, 0x53, 0x88,
-	0x99, 0xd7, 0x61, 0x4a, 0x3d, 0x7a, 0x90, 0xe4, 0x3b, 0x06, 0x53, 0x7d, 0xe5, 0x70, 0x03, 0x6f,
-	0x06, 0x35, 0xca, 0x24, 0xba, 0x3a, 0xa6, 0x18, 0x6e, 0x66, 0xea, 0xe1, 0x4b, 0x99, 0xd5, 0x3e,
-	0x46, 0x1b, 0xef, 0xc0, 0xfe, 0xe4, 0x19, 0x83, 0x64, 0x4a, 0xa7, 0x61, 0xff, 0x7a, 0xe2, 0x44,
-	0x03, 0xb7, 0x81, 0xc9, 0x68, 0x73, 0x19, 0xea, 0x7c, 0x0f, 0x38, 0x49, 0x71, 0x1e, 0xea, 0x16,
-	0xdb, 0x63, 0xae, 0xb0, 0xf9, 0x86, 0x99, 0x99, 0x4b, 0x06, 0xc5, 0x5c, 0xd0, 0x74, 0x60, 0x9f,
-	0xbe, 0xad, 0x9c, 0xa4, 0xec, 0xc1, 0xbe, 0x1d, 0x6d, 0xfb, 0x9a, 0x53, 0xcf, 0x66, 0x52, 0x6b,
-	0x54, 0x58, 0x07, 0x9a, 0x5f, 0x6c, 0x40, 0x8d, 0x9d, 0x8b, 0x48, 0x26, 0x71, 0x19, 0x6a, 0x21,
-	0x79, 0x1a, 0x4d, 0x96, 0x67, 0xc7, 0x1e, 0xb2, 0xe0, 0xce, 0x79, 0x26, 0x8f, 0x3e, 0x4c, 0x67,
-	0xf6, 0xbb, 0xc3, 0x68, 0x95, 0x7a, 0x7c, 0x3c, 0x70, 0x8d, 0x8a, 0x62, 0x8e, 0xa0, 0x50, 0xd6,
-	0x17, 0xc4, 0x39, 0x9e, 0x02, 0x28, 0xeb, 0x84, 0x98, 0x23, 0xd0, 0x75, 0x68, 0xf6, 0x37, 0x49,
-	0xff, 0x31, 0xb1, 0xc5, 0x01, 0x9e, 0x93, 0xe3, 0xc1, 0x8b, 0x5c, 0x18, 0x47, 0x28, 0x9a, 0x76,
-	0x9f, 0xb5, 0x6e, 0x63, 0x92, 0xb4, 0x59, 0x8b, 0x63, 0x8e, 0x40, 0x37, 0xa1, 0xed, 0xf4, 0x3d,
-	0xf7, 0xe6, 0x96, 0xf7, 0x29, 0x47, 0x9c, 0xd4, 0x79, 0x79, 0x3c, 0x7c, 0x39, 0x12, 0xc7, 0x31,
-	0x32, 0xa2, 0x59, 0xde, 0xa2, 0x6b, 0xe1, 0xd6, 0xa4, 0x34, 0x4c, 0x1c, 0xc7, 0x48, 0xf3, 0x88,
-	0x68, 0xcf, 0xec, 0x4e, 0x7e, 0x0b, 0xea, 0xac, 0xca, 0xd1, 0x55, 0xf5, 0xf3, 0x8c, 0x92, 0x52,
-	0xae, 0xc5, 0x12, 0x4d, 0x25, 0x79, 0x58, 0xfd, 0xeb, 0x3c, 0x53, 0x93, 0xf0, 0x88, 0x76, 0xe3,
-	0x3c, 0x2f, 0x42, 0x53, 0x34, 0x85, 0x9e, 0xe1, 0x56, 0x24, 0xf0, 0x02, 0xd4, 0x79, 0xc7, 0xcc,
-	0x2e, 0xcf, 0x4b, 0xd0, 0x96, 0x95, 0x39, 0x5e, 0x84, 0xd5, 0x4e, 0x8e, 0xc8, 0x57, 0x2b, 0x50,
-	0xe7, 0xe7, 0x43, 0xd2, 0xa6, 0x56, 0xed, 0x05, 0xc7, 0xc7, 0x1f, 0x37, 0x51, 0xbb, 0xc1, 0x2d,
-	0xb6, 0x62, 0xa4, 0xf3, 0x7b, 0x79, 0xe6, 0xfb, 0x74, 0x01, 0x7a, 0x35, 0x92, 0xc7, 0x31, 0xb4,
-	0xa0, 0x39, 0xef, 0x43, 0x5b, 0xa2, 0xd0, 0x82, 0xde, 0xa4, 0x67, 0xc7, 0x36, 0x45, 0x32, 0x49,
-	0x41, 0xf8, 0xcb, 0x65, 0xa8, 0x2e, 0x39, 0x3b, 0xa9, 0x7a, 0x78, 0x23, 0xea, 0xd5, 0x45, 0xe6,
-	0x60, 0xc9, 0xd9, 0xd1, 0x3a, 0xb5, 0x79, 0x33, 0xd2, 0xb8, 0xb7, 0xf4, 0xec, 0x9d, 0x1a, 0x3f,
-	0x03, 0x8b, 0x69, 0x78, 0xc6, 0x7e, 0xae, 0x09, 0x35, 0x76, 0xf4, 0x2a, 0xcb, 0x4e, 0xed, 0x8e,
-	0x8a, 0x33, 0xc6, 0x36, 0x77, 0xd8, 0x80, 0xcb, 0xe4, 0xb9, 0x9d, 0xb2, 0xc2, 0x62, 0x3b, 0xc5,
-	0xf7, 0xaa, 0x54, 0x67, 0xc4, 0x65, 0xa8, 0x6d, 0x39, 0x62, 0xb1, 0x56, 0x98, 0xe4, 0x3d, 0x67,
-	0x8b, 0x60, 0x26, 0x4f, 0x71, 0x9b, 0x56, 0xb0, 0x29, 0x2c, 0x54, 0x01, 0xae, 0x67, 0x05, 0x9b,
-	0x98, 0xc9, 0x53, 0x1c, 0x5b, 0x1c, 0x36, 0x26, 0xc1, 0xd1, 0x05, 0xa7, 0x58, 0x40, 0x5e, 0x86,
-	0x5a, 0xe0, 0x7c, 0x86, 0x08, 0x9b, 0x54, 0x80, 0x5b, 0x73, 0x3e, 0x43, 0x30, 0x93, 0x8f, 0x4d,
-	0x78, 0x6b, 0xb2, 0xaa, 0x51, 0x4c, 0xf8, 0x03, 0x98, 0x09, 0xb5, 0x03, 0x04, 0xe2, 0xfc, 0xdf,
-	0xd9, 0x82, 0x76, 0xd1, 0x30, 0x38, 0xc1, 0x41, 0x3b, 0x01, 0x5b, 0x47, 0x67, 0x77, 0x82, 0x17,
-	0xa0, 0xfe, 0x51, 0xc7, 0x0e, 0x37, 0xf5, 0xcf, 0x75, 0xcd, 0xe4, 0xd1, 0x66, 0xdb, 0x93, 0xc9,
-	0x53, 0x5b, 0x9d, 0xf3, 0x2c, 0x41, 0x8d, 0xaa, 0xcf, 0xde, 0xf4, 0x38, 0xd6, 0xba, 0xf7, 0x65,
-	0x80, 0xd5, 0x8a, 0xe6, 0x3c, 0x47, 0xa0, 0x46, 0x35, 0x24, 0xa7, 0x4a, 0x8e, 0x40, 0x8d, 0xea,
-	0x5d, 0xfe, 0x57, 0xda, 0xda, 0xfa, 0xd7, 0x6a, 0xf4, 0xf5, 0x14, 0xcc, 0xe8, 0xcd, 0x91, 0xc3,
-	0xf2, 0x67, 0x4d, 0xa8, 0xb1, 0x73, 0x8c, 0xc9, 0x1e, 0xf9, 0x11, 0xd8, 0xc7, 0xdb, 0x6f, 0x41,
-	0x4c, 0xc1, 0x2b, 0x99, 0xfb, 0x18, 0xfa, 0xe9, 0x48, 0xa1, 0x02, 0x02, 0x82, 0x75, 0x86, 0xc9,
-	0x27, 0x15, 0x8c, 0x4a, 0xd3, 0xc8, 0xb7, 0xe4, 0xe4, 0xb5, 0x56, 0x70, 0x88, 0x96, 0x61, 0xf9,
-	0x14, 0x38, 0x9a, 0xc9, 0xa2, 0x05, 0x68, 0xd1, 0xa1, 0x95, 0x56, 0x97, 0xe8, 0xb6, 0xa7, 0xc6,
-	0xe3, 0x97, 0x85, 0x34, 0x96, 0x38, 0x3a, 0xb0, 0xf7, 0x2d, 0xdf, 0x66, 0xb9, 0x12, 0x7d, 0xf8,
-	0xe5, 0xf1, 0x24, 0x8b, 0x91, 0x38, 0x8e, 0x91, 0xe8, 0x0e, 0x4c, 0xd9, 0x44, 0xfa, 0x09, 0x44,
-	0xa7, 0xfe, 0xd0, 0x78, 0xa2, 0xa5, 0x18, 0x80, 0x55, 0x34, 0xcd, 0x53, 0xb4, 0x36, 0x0c, 0x0a,
-	0x27, 0x1b, 0x8c, 0x2a, 0xbe, 0xac, 0x10, 0x23, 0xcd, 0x93, 0xb0, 0x4f, 0x6b, 0xb7, 0x0f, 0x74,
-	0xd6, 0xa1, 0xb6, 0x25, 0xe7, 0x99, 0x93, 0x4b, 0x94, 0x57, 0xf5, 0x69, 0x47, 0xee, 0x8a, 0x44,
-	0x00, 0xef, 0x42, 0x2b, 0x6a, 0x18, 0x74, 0x43, 0xcf, 0xc3, 0x99, 0xe2, 0x3c, 0xc8, 0x36, 0x15,
-	0x6c, 0x2b, 0xd0, 0x96, 0x2d, 0x84, 0xe6, 0x75, 0xba, 0x57, 0x8a, 0xe9, 0xe2, 0xd6, 0x15, 0x7c,
-	0x18, 0xa6, 0x94, 0x86, 0x42, 0x8b, 0x3a, 0xe3, 0xab, 0xc5, 0x8c, 0x6a, 0x33, 0xc7, 0xb3, 0x1e,
-	0xd9, 0x62, 0x6a, 0xab, 0x54, 0xe3, 0x56, 0xf9, 0xfd, 0x26, 0xb4, 0xe4, 0xd9, 0xe1, 0x8c, 0x35,
-	0xe6, 0xb6, 0x3f, 0x2c, 0x5c, 0x63, 0x46, 0xf8, 0xee, 0x43, 0x7f, 0x88, 0x29, 0x82, 0x36, 0x71,
-	0xe8, 0x84, 0xb2, 0xab, 0xbe, 0x5c, 0x0c, 0x7d, 0x40, 0xc5, 0x31, 0x47, 0xa1, 0xfb, 0xba, 0x96,
-	0xd7, 0xc6, 0x9c, 0x2d, 0xd3, 0x48, 0x72, 0x35, 0x7d, 0x19, 0xda, 0x0e, 0x9d, 0xfa, 0xf5, 0xe2,
-	0x91, 0xf7, 0x95, 0x62, 0xba, 0xe5, 0x08, 0x82, 0x63, 0x34, 0xcd, 0xdb, 0x86, 0xb5, 0x43, 0xfb,
-	0x35, 0x23, 0x6b, 0x4c, 0x9a, 0xb7, 0x5b, 0x31, 0x08, 0xab, 0x0c, 0xe8, 0x8a, 0x98, 0xbb, 0x34,
-	0x0b, 0x2c, 0x4b, 0x5c, 0x55, 0xf1, 0xfc, 0xe5, 0x9d, 0xd4, 0x48, 0xcb, 0xbb, 0xf1, 0xf9, 0x09,
-	0x58, 0xc6, 0x8e, 0xb6, 0xb4, 0x05, 0xf9, 0xcc, 0xa8, 0x3d, 0x69, 0x0b, 0xaa, 0xb3, 0x23, 0xf3,
-	0x79, 0xa8, 0x3e, 0xf4, 0x87, 0xf9, 0x63, 0x35, 0x6b, 0xee, 0x9c, 0xcf, 0xc7, 0xf5, 0x9e, 0x90,
-	0x3f, 0xa1, 0x97, 0x6d, 0x92, 0xcb, 0xa3, 0x54, 0x7a, 0x8e, 0xd0, 0x55, 0x31, 0xa0, 0xbf, 0xae,
-	0xf7, 0xb7, 0x17, 0x13, 0xfd, 0x8d, 0xf6, 0xb0, 0x55, 0x9f, 0xf0, 0xe3, 0x93, 0xca, 0x48, 0x3e,
-	0xe9, 0x38, 0x79, 0x3b, 0x9a, 0x7f, 0xec, 0xc9, 0x52, 0x24, 0xeb, 0x96, 0x73, 0x7d, 0xb9, 0x0c,
-	0x2d, 0x79, 0x34, 0x3c, 0xed, 0x9d, 0x6f, 0x39, 0x41, 0x8f, 0x58, 0x36, 0xf1, 0x45, 0xbf, 0x3d,
-	0x53, 0x78, 0xe6, 0xbc, 0xbb, 0x2c, 0x10, 0x58, 0x62, 0xcd, 0x63, 0xd0, 0x8a, 0x62, 0x73, 0x16,
-	0x65, 0xdf, 0xad, 0x40, 0x43, 0x1c, 0x2a, 0x4f, 0x66, 0xe2, 0x1a, 0x34, 0x86, 0xd6, 0xae, 0xb7,
-	0x1d, 0x2d, 0x99, 0x4e, 0x15, 0x9c, 0x53, 0xef, 0xde, 0x65, 0xd2, 0x58, 0xa0, 0xd0, 0x9b, 0x50,
-	0x1f, 0x3a, 0x5b, 0x4e, 0x28, 0xcc, 0xc7, 0xc9, 0x42, 0x38, 0x3b, 0x7e, 0xc6, 0x31, 0x34, 0x71,
-	0x76, 0x96, 0x34, 0xba, 0x09, 0x54, 0x98, 0xf8, 0x23, 0x26, 0x8d, 0x05, 0xca, 0xbc, 0x0d, 0x0d,
-	0x9e, 0x9d, 0xbd, 0x0d, 0x12, 0x7a, 0x49, 0x62, 0x4d, 0x67, 0x79, 0xcb, 0x99, 0x95, 0x1e, 0x85,
-	0x06, 0x4f, 0x3c, 0x47, 0x6b, 0xbe, 0xf3, 0x1c, 0x5b, 0xef, 0x0c, 0xcd, 0xbb, 0xf1, 0x2e, 0xe4,
-	0xfb, 0xdf, 0xcb, 0x30, 0x1f, 0xc0, 0xfe, 0x25, 0x2b, 0xb4, 0xd6, 0xad, 0x80, 0x60, 0xd2, 0xf7,
-	0x7c, 0x3b, 0x93, 0xd5, 0xe7, 0x9f, 0x84, 0x87, 0x3a, 0x9f, 0x55, 0xc8, 0xfd, 0xc8, 0x75, 0xf8,
-	0x3f, 0xc7, 0x75, 0xf8, 0x07, 0xb5, 0x1c, 0x7f, 0xde, 0x24, 0x9e, 0x0c, 0xaa, 0x70, 0x29, 0x87,
-	0xde, 0x15, 0x7d, 0xee, 0x7d, 0xa2, 0x00, 0xa9, 0x4d, 0xbe, 0xaf, 0xe8, 0x1e, 0xbd, 0x22, 0xac,
-	0xe6, 0xd2, 0xbb, 0x91, 0x74, 0xe9, 0x9d, 0x2a, 0x40, 0xa7, 0x7c, 0x7a, 0x57, 0x74, 0x9f, 0x5e,
-	0x51, 0xea, 0xaa, 0x53, 0xef, 0xff, 0x98, 0x1b, 0xed, 0x57, 0x72, 0xdc, 0x3e, 0x1f, 0xd6, 0xdd,
-	0x3e, 0x63, 0xb4, 0xe6, 0x87, 0xe5, 0xf7, 0xf9, 0xd5, 0x46, 0x8e, 0xdf, 0x67, 0x4e, 0xf3, 0xfb,
-	0x8c, 0xc9, 0x59, 0xd2, 0xf1, 0x73, 0x45, 0x77, 0xfc, 0x9c, 0x28, 0x40, 0x6a, 0x9e, 0x9f, 0x39,
-	0xcd, 0xf3, 0x53, 0x94, 0xa8, 0xe2, 0xfa, 0x99, 0xd3, 0x5c, 0x3f, 0x45, 0x40, 0xc5, 0xf7, 0x33,
-	0xa7, 0xf9, 0x7e, 0x8a, 0x80, 0x8a, 0xf3, 0x67, 0x4e, 0x73, 0xfe, 0x14, 0x01, 0x15, 0xef, 0xcf,
-	0x15, 0xdd, 0xfb, 0x53, 0x5c, 0x3f, 0x4a, 0xa3, 0xff, 0xc8, 0x51, 0xf3, 0x5f, 0xe8, 0xa8, 0xf9,
-	0x7a, 0x35, 0xc7, 0x01, 0x83, 0xb3, 0x1d, 0x30, 0x67, 0xf3, 0x5b, 0xb2, 0xd8, 0x03, 0x33, 0xf9,
-	0x28, 0x90, 0x76, 0xc1, 0x5c, 0x4d, 0xb8, 0x60, 0x4e, 0x16, 0x80, 0x75, 0x1f, 0xcc, 0xff, 0x1a,
-	0x27, 0xc3, 0xef, 0x36, 0xc6, 0xac, 0xa7, 0xdf, 0x50, 0xd7, 0xd3, 0x63, 0x46, 0xb2, 0xf4, 0x82,
-	0xfa, 0x9a, 0xbe, 0xa0, 0x3e, 0x3d, 0x01, 0x56, 0x5b, 0x51, 0xaf, 0x66, 0xad, 0xa8, 0xbb, 0x13,
-	0xb0, 0xe4, 0x2e, 0xa9, 0x6f, 0xa7, 0x97, 0xd4, 0x67, 0x27, 0xe0, 0xcb, 0x5c, 0x53, 0xaf, 0x66,
-	0xad, 0xa9, 0x27, 0xc9, 0x5d, 0xee, 0xa2, 0xfa, 0x4d, 0x6d, 0x51, 0xfd, 0xf2, 0x24, 0xd5, 0x15,
-	0x0f, 0x0e, 0x1f, 0xcb, 0x59, 0x55, 0xbf, 0x36, 0x09, 0xcd, 0x78, 0x27, 0xf6, 0x8f, 0xd6, 0xc5,
-	0x7a, 0x32, 0xdf, 0x7f, 0x11, 0x5a, 0xd1, 0x41, 0x1b, 0xf3, 0xd3, 0xd0, 0x8c, 0x6e, 0x12, 0x67,
-	0x9c, 0x29, 0x16, 0x8b, 0x3a, 0x3e, 0x7b, 0x16, 0x21, 0x74, 0x0d, 0x6a, 0xf4, 0x97, 0xe8, 0x16,
-	0x67, 0x26, 0x3b, 0xd0, 0x43, 0x13, 0xc1, 0x0c, 0x67, 0xfe, 0xe9, 0x41, 0x00, 0xe5, 0x82, 0xe5,
-	0xa4, 0xc9, 0xbe, 0x4d, 0x8d, 0xd9, 0x30, 0x24, 0x3e, 0x3b, 0xc8, 0x55, 0x78, 0x01, 0x31, 0x4e,
-	0x81, 0x6a, 0x4b, 0x48, 0x7c, 0x2c, 0xe0, 0xe8, 0x1e, 0xb4, 0x22, 0x47, 0x2a, 0x3b, 0x9c, 0x9d,
-	0xa7, 0x64, 0x59, 0x54, 0x91, 0x6b, 0x0f, 0x4b, 0x0a, 0x34, 0x0f, 0xb5, 0xc0, 0xf3, 0x43, 0x71,
-	0x92, 0xfb, 0xd5, 0x89, 0xa9, 0xd6, 0x3c, 0x3f, 0xc4, 0x0c, 0xca, 0x8b, 0xa6, 0xbc, 0x5f, 0xb1,
-	0x97, 0xa2, 0x69, 0x16, 0xfb, 0x4f, 0x6a, 0xd2, 0x86, 0x2e, 0x8a, 0xde, 0xc8, 0x75, 0xe8, 0xdc,
-	0xe4, 0xad, 0xa4, 0xf6, 0xca, 0xe8, 0x74, 0x64, 0x45, 0x39, 0x1d, 0x79, 0x06, 0x3a, 0x7d, 0x6f,
-	0x87, 0xf8, 0x38, 0x3e, 0xe2, 0x24, 0x4e, 0xa1, 0xa5, 0xe2, 0x91, 0x09, 0xad, 0x4d, 0xc7, 0x26,
-	0xcb, 0x7d, 0x61, 0xff, 0x5a, 0x58, 0x86, 0xd1, 0x1d, 0x68, 0x31, 0x1f, 0x7b, 0xe4, 0xe1, 0xdf,
-	0x5b, 0x26, 0xb9, 0xab, 0x3f, 0x22, 0xa0, 0x09, 0xb1, 0xc4, 0x6f, 0x39, 0x21, 0xab, 0xc3, 0x16,
-	0x96, 0x61, 0x9a, 0x61, 0x76, 0x8e, 0x4c, 0xcd, 0x70, 0x93, 0x67, 0x38, 0x19, 0x8f, 0x4e, 0xc1,
-	0x0c, 0x71, 0x6d, 0x55, 0xb2, 0xc3, 0x24, 0x13, 0xb1, 0xe8, 0x12, 0x1c, 0x64, 0xd8, 0xc4, 0x52,
-	0x94, 0xbb, 0xf4, 0x5b, 0x38, 0xfb, 0x23, 0x3b, 0x5f, 0x67, 0x0d, 0xf8, 0xad, 0x36, 0xe6, 0xe4,
-	0xab, 0xe3, 0x38, 0x02, 0x9d, 0x85, 0x03, 0x36, 0xd9, 0xb0, 0xb6, 0x87, 0xe1, 0x03, 0xb2, 0x35,
-	0x1a, 0x5a, 0x21, 0x59, 0xb6, 0xd9, 0x53, 0x1b, 0x6d, 0x9c, 0xfe, 0x80, 0xce, 0xc3, 0x33, 0x22,
-	0x92, 0x77, 0x77, 0xda, 0x6a, 0xcb, 0x36, 0x7b, 0x79, 0xa2, 0x8d, 0xb3, 0x3e, 0xd1, 0x25, 0xfc,
-	0x13, 0xdf, 0x1a, 0x89, 0xfa, 0x64, 0xaf, 0x4b, 0xb4, 0xb0, 0x1a, 0x65, 0x7e, 0x87, 0xa9, 0x0f,
-	0xeb, 0x24, 0x6f, 0x43, 0xd5, 0xb2, 0x6d, 0x31, 0x00, 0x5f, 0xdc, 0x63, 0x57, 0x13, 0x97, 0x9d,
-	0x28, 0x03, 0x5a, 0x95, 0x87, 0xf7, 0xf8, 0x10, 0x7c, 0x79, 0xaf, 0x5c, 0xf2, 0x8d, 0x20, 0xc1,
-	0x43, 0x19, 0xb7, 0xf9, 0x9d, 0x92, 0xea, 0x0f, 0xc6, 0x28, 0xaf, 0x97, 0x08, 0x1e, 0x74, 0x1b,
-	0x6a, 0x2c, 0x87, 0x7c, 0x88, 0xbe, 0xb4, 0x57, 0xbe, 0x7b, 0x3c, 0x7f, 0x8c, 0xc3, 0xec, 0xf3,
-	0x53, 0x74, 0xca, 0xd1, 0xcd, 0xb2, 0x7e, 0x74, 0x73, 0x01, 0xea, 0x4e, 0x48, 0xb6, 0xd2, 0x27,
-	0x79, 0xc7, 0x2a, 0xbd, 0xb0, 0x61, 0x1c, 0x3a, 0xf6, 0x44, 0xe1, 0xbb, 0xb9, 0x57, 0x3e, 0x6e,
-	0x40, 0x8d, 0xc2, 0x53, 0xb3, 0xd2, 0x49, 0x12, 0x66, 0x48, 0xf3, 0x02, 0xd4, 0x68, 0x61, 0xc7,
-	0x94, 0x4e, 0xe4, 0xa7, 0x22, 0xf3, 0xb3, 0x30, 0x05, 0x6d, 0x6f, 0x44, 0x7c, 0xd6, 0x75, 0xcc,
-	0x7f, 0xad, 0x29, 0xc7, 0xeb, 0x96, 0x55, 0x1d, 0x7b, 0x7d, 0xcf, 0x36, 0x58, 0xd5, 0x32, 0x9c,
-	0xd0, 0xb2, 0x37, 0xf6, 0xce, 0x96, 0xd2, 0x33, 0x9c, 0xd0, 0xb3, 0x1f, 0x80, 0x33, 0xa5, 0x69,
-	0x77, 0x35, 0x4d, 0xbb, 0xbc, 0x77, 0x46, 0x4d, 0xd7, 0x48, 0x91, 0xae, 0x2d, 0xe9, 0xba, 0xd6,
-	0x9d, 0xac, 0xc9, 0xe5, 0x20, 0x37, 0x81, 0xb6, 0x7d, 0x22, 0x57, 0xdb, 0x16, 0x34, 0x6d, 0xdb,
-	0x6b, 0xd2, 0x1f, 0x90, 0xbe, 0xfd, 0x7d, 0x0d, 0x6a, 0x74, 0xa0, 0x45, 0x37, 0x55, 0x5d, 0x7b,
-	0x6d, 0x4f, 0x83, 0xb4, 0xaa, 0x67, 0x2b, 0x09, 0x3d, 0xbb, 0xb4, 0x37, 0xa6, 0x94, 0x8e, 0xad,
-	0x24, 0x74, 0x6c, 0x8f, 0x7c, 0x29, 0xfd, 0xea, 0x69, 0xfa, 0x75, 0x61, 0x6f, 0x6c, 0x9a, 0x6e,
-	0x59, 0x45, 0xba, 0x75, 0x43, 0xd7, 0xad, 0x09, 0xe7, 0x81, 0x6c, 0xd6, 0x33, 0x81, 0x5e, 0xbd,
-	0x93, 0xab, 0x57, 0xd7, 0x34, 0xbd, 0xda, 0x4b, 0xb2, 0x1f, 0x90, 0x4e, 0x5d, 0xe2, 0xd3, 0xd7,
-	0xfc, 0x9b, 0x78, 0x59, 0xd3, 0x57, 0xf3, 0x75, 0x68, 0xc7, 0x6f, 0xdd, 0x64, 0x1c, 0xf4, 0xe7,
-	0x62, 0x51, 0xaa, 0x51, 0xd0, 0xbc, 0x08, 0xed, 0xf8, 0xfd, 0x9a, 0xac, 0x5b, 0x7f, 0xec, 0xa3,
-	0xbc, 0x9c, 0xc5, 0x42, 0xe6, 0x4d, 0x38, 0x90, 0x7e, 0x5d, 0x23, 0xc3, 0xa3, 0xaf, 0x9c, 0x52,
-	0x8f, 0xee, 0xc6, 0x28, 0x51, 0xe6, 0x13, 0x98, 0x49, 0xbc, 0x97, 0xb1, 0x67, 0x0e, 0x74, 0x51,
-	0x99, 0x6c, 0x57, 0x13, 0xb7, 0xa3, 0xf5, 0x73, 0xf7, 0xf1, 0x94, 0xda, 0x5c, 0x82, 0x99, 0x82,
-	0xcc, 0x4f, 0x72, 0xec, 0xfe, 0x93, 0x30, 0x35, 0x2e, 0xef, 0x1f, 0xc0, 0xb5, 0x80, 0x10, 0x3a,
-	0xa9, 0xb7, 0x7e, 0x92, 0xc9, 0xac, 0x02, 0x0c, 0xa4, 0x8c, 0x50, 0xda, 0xf3, 0x7b, 0xb8, 0x04,
-	0xc1, 0x70, 0x58, 0xe1, 0x30, 0x7f, 0xab, 0x0c, 0x07, 0xd2, 0x0f, 0xfd, 0x4c, 0xba, 0x8c, 0x32,
-	0xa0, 0xc9, 0xb8, 0xe4, 0xdd, 0x91, 0x28, 0x88, 0xee, 0xc1, 0x74, 0x30, 0x74, 0xfa, 0x64, 0x71,
-	0xd3, 0x72, 0x07, 0x24, 0x10, 0x6b, 0xa3, 0x82, 0xc7, 0x7a, 0xd6, 0x62, 0x04, 0xd6, 0xe0, 0xe6,
-	0x13, 0x98, 0x52, 0x3e, 0xa2, 0xb7, 0xa0, 0xe2, 0x8d, 0x52, 0x27, 0x24, 0xf3, 0x39, 0xef, 0x47,
-	0xfd, 0x0d, 0x57, 0xbc, 0x51, 0xba, 0x4b, 0xaa, 0xdd, 0xb7, 0xaa, 0x75, 0x5f, 0xf3, 0x0e, 0x1c,
-	0x48, 0xbf, 0xa5, 0x93, 0xac, 0x9e, 0x53, 0x29, 0x7f, 0x03, 0xaf, 0xa6, 0xa4, 0xf3, 0x60, 0x0e,
-	0xf6, 0x27, 0x5f, 0xc8, 0xc9, 0xb8, 0xd7, 0x13, 0x5f, 0x8f, 0x8a, 0x1c, 0xff, 0xb3, 0x3f, 0x5b,
-	0x86, 0x19, 0xbd, 0x20, 0xe8, 0x10, 0x20, 0x3d, 0x66, 0xc5, 0x73, 0x49, 0xa7, 0x84, 0x0e, 0xc2,
-	0x01, 0x3d, 0x7e, 0xde, 0xb6, 0x3b, 0xe5, 0xb4, 0x38, 0x35, 0x5b, 0x9d, 0x0a, 0x32, 0xe0, 0xd9,
-	0x44, 0x0d, 0x31, 0x23, 0xda, 0xa9, 0xa2, 0xe7, 0xe0, 0x60, 0xf2, 0xcb, 0x68, 0x68, 0xf5, 0x49,
-	0xa7, 0x66, 0xfe, 0x5b, 0x05, 0x6a, 0x0f, 0x03, 0xe2, 0x9b, 0xff, 0x5c, 0x89, 0xee, 0x7b, 0xbc,
-	0x01, 0x35, 0xf6, 0x78, 0x8d, 0x72, 0x41, 0xb3, 0x9c, 0xb8, 0xa0, 0xa9, 0x5d, 0xf2, 0x8b, 0x2f,
-	0x68, 0xbe, 0x01, 0x35, 0xf6, 0x5c, 0xcd, 0xde, 0x91, 0x5f, 0x2a, 0x43, 0x3b, 0x7e, 0x3a, 0x66,
-	0xcf, 0x78, 0xf5, 0x7e, 0x49, 0x45, 0xbf, 0x5f, 0x72, 0x06, 0xea, 0x3e, 0xbb, 0x09, 0xc2, 0xad,
-	0x4c, 0xf2, 0xd6, 0x0a, 0x4b, 0x10, 0x73, 0x11, 0x93, 0xc0, 0x94, 0xfa, 0x30, 0xce, 0xde, 0xb3,
-	0x71, 0x42, 0xbc, 0x8a, 0xb7, 0x6c, 0x07, 0xf3, 0xbe, 0x6f, 0xed, 0x0a, 0xc5, 0xd4, 0x23, 0xcd,
-	0x23, 0x50, 0x5b, 0x75, 0xdc, 0x41, 0xf6, 0xbd, 0x58, 0xf3, 0x0f, 0xcb, 0xd0, 0x14, 0xc7, 0x80,
-	0xcd, 0x39, 0xa8, 0xae, 0x90, 0x27, 0x34, 0x23, 0xe2, 0x00, 0x72, 0x2a, 0x23, 0xf7, 0x58, 0x29,
-	0x84, 0x3c, 0x8e, 0xc4, 0xcc, 0x2b, 0x72, 0x98, 0xdc, 0x3b, 0xf6, 0x0d, 0xa8, 0xb1, 0xf7, 0x6c,
-	0xf6, 0x8e, 0xfc, 0xa3, 0x16, 0x34, 0xf8, 0xe5, 0x52, 0xf3, 0xf7, 0x5a, 0xd0, 0xe0, 0x6f, 0xdc,
-	0xa0, 0x6b, 0xd0, 0x0c, 0xb6, 0xb7, 0xb6, 0x2c, 0x7f, 0xd7, 0xc8, 0x7e, 0xb9, 0x59, 0x7b, 0x12,
-	0xa7, 0xbb, 0xc6, 0x65, 0x71, 0x04, 0x42, 0xaf, 0x43, 0xad, 0x6f, 0x6d, 0x90, 0xd4, 0xc6, 0x70,
-	0x16, 0x78, 0xd1, 0xda, 0x20, 0x98, 0x89, 0xa3, 0x1b, 0xd0, 0x12, 0xcd, 0x12, 0x08, 0xcf, 0xd0,
-	0xf8, 0x74, 0xa3, 0xc6, 0x94, 0x28, 0xf3, 0x36, 0x34, 0x45, 0x66, 0xd0, 0x75, 0x79, 0xb5, 0x36,
-	0xe9, 0xc3, 0xce, 0x2c, 0x82, 0xbc, 0xac, 0x2d, 0x2f, 0xd9, 0xfe, 0x65, 0x05, 0x6a, 0x34, 0x73,
-	0xef, 0x9b, 0x09, 0x1d, 0x05, 0x18, 0x5a, 0x41, 0xb8, 0xba, 0x3d, 0x1c, 0x8a, 0xeb, 0xde, 0x55,
-	0xac, 0xc4, 0xa0, 0xd3, 0xb0, 0x9f, 0x87, 0x82, 0xcd, 0xb5, 0xed, 0x7e, 0x9f, 0xc8, 0x3b, 0xaa,
-	0xc9, 0x68, 0x34, 0x0f, 0x75, 0xf6, 0xea, 0xaa, 0x98, 0x15, 0xbe, 0x52, 0x58, 0xb3, 0xdd, 0x55,
-	0xc7, 0x15, 0xb9, 0xe1, 0x48, 0xd3, 0x83, 0xb6, 0x8c, 0xa3, 0x9d, 0x70, 0xe4, 0xb8, 0xae, 0xe3,
-	0x0e, 0x84, 0x46, 0x47, 0x41, 0x3a, 0xe8, 0xd0, 0x9f, 0x22, 0xbf, 0x75, 0x2c, 0x42, 0x34, 0x7e,
-	0xc3, 0x72, 0x86, 0x22, 0x8b, 0x75, 0x2c, 0x42, 0x94, 0x69, 0x5b, 0xbc, 0x0c, 0x54, 0x63, 0x05,
-	0x8c, 0x82, 0xe6, 0x7b, 0x65, 0x79, 0xbf, 0x3c, 0xeb, 0x9a, 0x67, 0xca, 0x2b, 0x75, 0x44, 0x75,
-	0x8d, 0xf3, 0x01, 0x41, 0x71, 0x76, 0x1f, 0x82, 0x86, 0xe7, 0x0e, 0x1d, 0x97, 0x08, 0x2f, 0x94,
-	0x08, 0x25, 0xea, 0xb8, 0x9e, 0xaa, 0x63, 0xf1, 0xfd, 0xa6, 0xed, 0xd0, 0x2c, 0x36, 0xe2, 0xef,
-	0x3c, 0x06, 0x5d, 0x85, 0xa6, 0x4d, 0x76, 0x9c, 0x3e, 0x09, 0x8c, 0x26, 0x53, 0xbd, 0xe3, 0x63,
-	0xeb, 0x76, 0x89, 0xc9, 0xe2, 0x08, 0x63, 0x86, 0xd0, 0xe0, 0x51, 0xb2, 0x48, 0x65, 0xa5, 0x48,
-	0x71, 0xa6, 0x2b, 0x63, 0x32, 0x5d, 0x2d, 0xc8, 0x74, 0x2d, 0x99, 0xe9, 0xd9, 0xcf, 0x01, 0x28,
-	0xaf, 0x0c, 0x4c, 0x41, 0xf3, 0xa1, 0xfb, 0xd8, 0xf5, 0x9e, 0xb8, 0x9d, 0x12, 0x0d, 0xdc, 0xdf,
-	0xd8, 0xa0, 0xa9, 0x74, 0xca, 0x34, 0x40, 0xe5, 0x1c, 0x77, 0xd0, 0xa9, 0x20, 0x80, 0x06, 0x7f,
-	0x68, 0xa0, 0x53, 0xa5, 0xbf, 0x6f, 0xb1, 0xf6, 0xeb, 0xd4, 0xd0, 0x61, 0x78, 0x66, 0xd9, 0xed,
-	0x7b, 0x5b, 0x23, 0x2b, 0x74, 0xd6, 0x87, 0xe4, 0x11, 0xf1, 0x03, 0xc7, 0x73, 0x3b, 0x75, 0x3a,
-	0x7a, 0xad, 0x90, 0xf0, 0x89, 0xe7, 0x3f, 0x5e, 0x21, 0xc4, 0x16, 0x8f, 0x76, 0x74, 0x1a, 0xe6,
-	0x7f, 0x94, 0xf9, 0xbe, 0xb2, 0x79, 0x03, 0xa6, 0xb5, 0x27, 0xac, 0x8c, 0xf8, 0x41, 0xfd, 0xc4,
-	0x7b, 0xfa, 0x87, 0x98, 0xe7, 0x97, 0xc4, 0x53, 0x19, 0x1e, 0x32, 0x6f, 0x01, 0x28, 0x0f, 0x57,
-	0x1d, 0x05, 0x58, 0xdf, 0x0d, 0x49, 0xc0, 0x1f, 0xad, 0xa2, 0x14, 0x35, 0xac, 0xc4, 0xa8, 0xfc,
-	0x15, 0x8d, 0xdf, 0xbc, 0x0c, 0xa0, 0x3c, 0x5b, 0x45, 0xfb, 0x15, 0x0d, 0x2d, 0x24, 0xc9, 0x92,
-	0xd1, 0x66, 0x57, 0x94, 0x20, 0x7a, 0xa0, 0x2a, 0xca, 0x01, 0xf7, 0xef, 0xa9, 0x39, 0x60, 0x31,
-	0xe6, 0xd7, 0xcb, 0x00, 0xf1, 0x7b, 0x27, 0xe6, 0x9c, 0xb4, 0xdd, 0xaf, 0x42, 0xcd, 0xb6, 0x42,
-	0x4b, 0x98, 0xcd, 0xe7, 0x12, 0x43, 0x57, 0x0c, 0xc1, 0x4c, 0xcc, 0xbc, 0x05, 0x53, 0xea, 0x8b,
-	0x4b, 0x73, 0x50, 0x0f, 0x69, 0x50, 0xdc, 0xd7, 0x7c, 0x29, 0x17, 0x4e, 0x41, 0x74, 0x92, 0x85,
-	0xb9, 0xbc, 0xf9, 0x1b, 0x65, 0x98, 0x56, 0xdf, 0x5f, 0x31, 0xaf, 0xc9, 0x1c, 0x5d, 0xd2, 0x72,
-	0x74, 0x2c, 0x97, 0xf2, 0xd1, 0x05, 0x36, 0x6d, 0x13, 0x19, 0xfb, 0x08, 0xcc, 0xe8, 0x8f, 0xb4,
-	0xa0, 0xeb, 0xd0, 0x1a, 0x89, 0x18, 0x91, 0xbd, 0xe3, 0xe3, 0xb8, 0x04, 0x1a, 0x4b, 0x90, 0xf9,
-	0x9b, 0x65, 0x98, 0x56, 0x1f, 0xdf, 0x32, 0xdf, 0x86, 0x1a, 0x7b, 0xbd, 0xeb, 0x3a, 0x4c, 0xab,
-	0xaf, 0x6f, 0xa5, 0xfe, 0xcb, 0x02, 0x67, 0x57, 0xa1, 0x58, 0x03, 0x98, 0xcb, 0xb2, 0xb0, 0xef,
-	0x9b, 0xea, 0x3c, 0x34, 0xc5, 0x63, 0x5e, 0xe6, 0x49, 0x68, 0xc7, 0x6f, 0x77, 0x51, 0x43, 0xc9,
-	0xe3, 0x23, 0x95, 0x16, 0x41, 0xf3, 0x5b, 0x35, 0xa8, 0x33, 0xdd, 0x35, 0xff, 0xa5, 0xa2, 0x76,
-	0x47, 0xf3, 0xdb, 0x95, 0xdc, 0x85, 0xef, 0x45, 0xed, 0xd9, 0x87, 0x99, 0xd4, 0x9b, 0x75, 0xe2,
-	0x29, 0x2d, 0x7d, 0x14, 0xb9, 0x0c, 0x4d, 0x97, 0x77, 0x43, 0xf1, 0xea, 0xc2, 0x91, 0x4c, 0x94,
-	0xe8, 0xaa, 0x38, 0x12, 0x46, 0x97, 0xa0, 0x4e, 0x7c, 0xdf, 0xf3, 0x99, 0xfd, 0x98, 0x49, 0xbd,
-	0xfe, 0x16, 0x3f, 0xdb, 0x75, 0x93, 0x4a, 0x61, 0x2e, 0x8c, 0x2e, 0xc1, 0xc1, 0x80, 0x9b, 0x0c,
-	0x3e, 0x81, 0x0e, 0xc4, 0x75, 0x74, 0x61, 0x5a, 0xb3, 0x3f, 0x52, 0x94, 0xeb, 0x85, 0xdc, 0xbc,
-	0xb0, 0xcb, 0xc4, 0x11, 0x8a, 0x1b, 0xdc, 0xec, 0x8f, 0x14, 0xb5, 0xcd, 0x2e, 0x1d, 0x3b, 0xee,
-	0x40, 0x43, 0x35, 0x39, 0x2a, 0xf3, 0xe3, 0xec, 0x47, 0xa2, 0x99, 0x8b, 0x62, 0xd1, 0x4a, 0xaa,
-	0xa9, 0x2b, 0xa3, 0x36, 0xd4, 0x59, 0xa1, 0x3a, 0x15, 0xd5, 0x1e, 0x56, 0x73, 0x2c, 0x5a, 0x6d,
-	0xf6, 0x22, 0x34, 0x45, 0x3c, 0x95, 0x9f, 0xe7, 0xf5, 0xd4, 0x29, 0xa1, 0x69, 0x68, 0xad, 0x91,
-	0xe1, 0x46, 0xcf, 0x0b, 0xc2, 0x4e, 0x19, 0xed, 0x83, 0x36, 0x33, 0x32, 0xf7, 0xdd, 0xe1, 0x6e,
-	0xa7, 0x32, 0xfb, 0x0e, 0xb4, 0x65, 0xed, 0xa1, 0x16, 0xd4, 0x56, 0xb6, 0x87, 0xc3, 0x4e, 0x89,
-	0xcd, 0xf9, 0x43, 0xcf, 0x8f, 0xf6, 0x04, 0x6e, 0x3e, 0xa5, 0x03, 0x78, 0xa7, 0x9c, 0x67, 0x66,
-	0x2b, 0xa8, 0x03, 0xd3, 0x22, 0x71, 0x9e, 0xe7, 0xaa, 0xf9, 0xed, 0x32, 0xb4, 0xe5, 0xdb, 0x67,
-	0x74, 0xc2, 0x1d, 0xe9, 0x53, 0xbe, 0x81, 0x9d, 0x4b, 0x68, 0x56, 0xfe, 0x53, 0x6a, 0x09, 0xed,
-	0x3a, 0x05, 0x33, 0x62, 0x2c, 0x8b, 0x2a, 0x9f, 0x0f, 0x47, 0x89, 0xd8, 0xd9, 0xdb, 0xb2, 0xd6,
-	0x3b, 0xac, 0x3b, 0x2f, 0x7a, 0xae, 0x4b, 0xfa, 0x21, 0xab, 0xfb, 0xfd, 0x30, 0xb5, 0xe2, 0x85,
-	0xab, 0x5e, 0x10, 0xd0, 0x92, 0xf1, 0x9a, 0x8a, 0xbf, 0x57, 0xd0, 0x0c, 0x40, 0x74, 0x1c, 0x90,
-	0x8e, 0x3e, 0xe6, 0xaf, 0x97, 0xa1, 0xc1, 0x5f, 0x64, 0x33, 0x7f, 0xa9, 0x0c, 0x0d, 0xf1, 0x0a,
-	0xdb, 0x19, 0xe8, 0xf8, 0x1e, 0x25, 0x8e, 0x56, 0x6a, 0xcb, 0x4b, 0xa2, 0x94, 0xa9, 0x78, 0x34,
-	0x1b, 0xfd, 0xbb, 0x87, 0x40, 0x7d, 0x07, 0x41, 0x8b, 0x43, 0x57, 0x00, 0xf8, 0x2b, 0x6f, 0x0f,
-	0x76, 0xe5, 0x83, 0x25, 0xc9, 0x53, 0x80, 0xe2, 0x5d, 0x38, 0xb6, 0x5f, 0xa6, 0x48, 0xcf, 0x7e,
-	0x16, 0xf6, 0x61, 0x12, 0x8c, 0x3c, 0x37, 0x20, 0x3f, 0xac, 0xff, 0x6c, 0x93, 0xfb, 0x3f, 0x6a,
-	0x66, 0x7f, 0xa7, 0x01, 0x75, 0x36, 0x6d, 0x37, 0x7f, 0xad, 0x21, 0x17, 0x18, 0x29, 0x5b, 0x72,
-	0x41, 0x3d, 0x8b, 0xa5, 0x1a, 0x05, 0x6d, 0xc6, 0xaf, 0x9f, 0xc1, 0x7a, 0x93, 0xd9, 0xf2, 0x81,
-	0x4f, 0x17, 0x0a, 0xb5, 0xc4, 0xe3, 0x63, 0x3a, 0x6c, 0x55, 0x88, 0x61, 0x09, 0x50, 0x95, 0xaf,
-	0xae, 0x2b, 0xdf, 0x0d, 0x68, 0xdb, 0xbe, 0x37, 0x62, 0xbd, 0x54, 0xec, 0x7f, 0x1e, 0xcb, 0xe1,
-	0x5d, 0x8a, 0xe4, 0x7a, 0x25, 0x1c, 0x83, 0xa8, 0xfa, 0xf2, 0xda, 0x17, 0x47, 0x0f, 0x5e, 0xc8,
-	0x81, 0xf3, 0xf6, 0xea, 0x95, 0xb0, 0x10, 0xa7, 0x40, 0xf2, 0x94, 0x01, 0x5b, 0x63, 0x81, 0x37,
-	0x9f, 0x46, 0x40, 0x2e, 0x8e, 0xae, 0x42, 0x2b, 0xb0, 0x76, 0x08, 0x7b, 0xc7, 0xbe, 0x3d, 0xb6,
-	0x2a, 0xd6, 0x84, 0x58, 0xaf, 0x84, 0x25, 0x84, 0x16, 0x79, 0xcb, 0x19, 0xf0, 0x25, 0xba, 0x78,
-	0x4c, 0x3f, 0xaf, 0xc8, 0xf7, 0x22, 0x39, 0xf6, 0x9f, 0x0f, 0xa2, 0x00, 0xba, 0x05, 0x53, 0x23,
-	0x9f, 0x50, 0x03, 0xc7, 0xf2, 0x30, 0x9d, 0xb8, 0x68, 0x98, 0x6c, 0x0e, 0x29, 0xc9, 0xdf, 0x32,
-	0x95, 0x41, 0xba, 0x34, 0xe5, 0x66, 0x7e, 0x8a, 0x9f, 0x10, 0x60, 0x01, 0x73, 0x0a, 0xda, 0xb2,
-	0xaa, 0xcd, 0x96, 0xec, 0x6e, 0x2d, 0x68, 0xf0, 0x9a, 0x30, 0x01, 0x5a, 0x51, 0xc1, 0xa8, 0xb0,
-	0xcc, 0xa4, 0xb9, 0x0f, 0xa6, 0x94, 0xd4, 0xcc, 0x15, 0x68, 0x45, 0xba, 0x90, 0xf3, 0x20, 0x09,
-	0x82, 0x9a, 0xed, 0x89, 0x29, 0x6e, 0x15, 0xb3, 0xdf, 0x54, 0x57, 0xd4, 0x17, 0xd7, 0xda, 0xf2,
-	0xf9, 0xb1, 0xd9, 0xf9, 0xe8, 0xa4, 0x1a, 0xb5, 0x98, 0xdc, 0x79, 0x32, 0x05, 0x4d, 0xbc, 0xcd,
-	0x56, 0x1f, 0x9d, 0x32, 0x8d, 0xa6, 0x4b, 0xda, 0x4e, 0x85, 0x1a, 0xdf, 0x45, 0xcb, 0xed, 0x93,
-	0x21, 0x9b, 0xb1, 0x4a, 0x93, 0x5e, 0x5b, 0x68, 0x4b, 0xf2, 0x85, 0x23, 0x7f, 0xf5, 0xde, 0xd1,
-	0xf2, 0x37, 0xdf, 0x3b, 0x5a, 0xfe, 0xee, 0x7b, 0x47, 0xcb, 0x3f, 0xff, 0xbd, 0xa3, 0xa5, 0x6f,
-	0x7e, 0xef, 0x68, 0xe9, 0x5b, 0xdf, 0x3b, 0x5a, 0x7a, 0xb7, 0x32, 0x5a, 0x5f, 0x6f, 0xb0, 0xd3,
-	0x46, 0x17, 0xff, 0x33, 0x00, 0x00, 0xff, 0xff, 0x6a, 0xc2, 0x49, 0x38, 0x07, 0x6b, 0x00, 0x00,
+	// 6957 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x69, 0x8c, 0x1c, 0xc7,
+	0x75, 0xff, 0xce, 0x3d, 0xf3, 0xf6, 0xe0, 0xb0, 0x74, 0xb0, 0xd5, 0xa2, 0x28, 0x6a, 0x45, 0x51,
+	0x94, 0x44, 0x0d, 0xa5, 0x25, 0x45, 0xca, 0x94, 0x44, 0x72, 0x0f, 0x52, 0xbb, 0x3c, 0x96, 0xeb,
+	0x5a, 0x92, 0x96, 0x65, 0xe3, 0xff, 0x77, 0xef, 0x4c, 0xed, 0x6e, 0x9b, 0xb3, 0xdd, 0xe3, 0xee,
+	0xde, 0x25, 0xd7, 0xc7, 0xff, 0xef, 0xf8, 0x88, 0xe3, 0xc4, 0x4e, 0x02, 0x23, 0x48, 0x02, 0x24,
+	0x40, 0x90, 0x20, 0x01, 0xf2, 0x21, 0x08, 0x02, 0xe4, 0x4b, 0x02, 0x24, 0x41, 0x80, 0x20, 0x40,
+	0x4e, 0xc0, 0x41, 0xbe, 0xe4, 0x8b, 0x0f, 0xc8, 0xf9, 0x90, 0x0f, 0x49, 0x80, 0x38, 0x40, 0x90,
+	0x8f, 0xc1, 0xab, 0xa3, 0xbb, 0xaa, 0x8f, 0x99, 0x59, 0x4b, 0xce, 0x81, 0xf8, 0x0b, 0x39, 0xf5,
+	0xea, 0xbd, 0x5f, 0x5d, 0xaf, 0x5e, 0x55, 0xbd, 0x7a, 0x5d, 0x0b, 0x8f, 0x0f, 0x36, 0xce, 0x0c,
+	0x02, 0x3f, 0xf2, 0xc3, 0x33, 0x6c, 0x8f, 0x79, 0x51, 0xd8, 0xe1, 0x29, 0xd2, 0x70, 0xbc, 0xfd,
+	0x68, 0x7f, 0xc0, 0xec, 0x13, 0x83, 0xfb, 0x5b, 0x67, 0xfa, 0xee, 0xc6, 0x99, 0xc1, 0xc6, 0x99,
+	0x1d, 0xbf, 0xc7, 0xfa, 0x8a, 0x9d, 0x27, 0x24, 0xbb, 0x7d, 0x74, 0xcb, 0xf7, 0xb7, 0xfa, 0x4c,
+	0xe4, 0x6d, 0xec, 0x6e, 0x9e, 0x09, 0xa3, 0x60, 0xb7, 0x1b, 0x89, 0xdc, 0xd9, 0x2f, 0xff, 0x6d,
+	0x09, 0x6a, 0x57, 0x11, 0x9e, 0xcc, 0x41, 0x73, 0x87, 0x85, 0xa1, 0xb3, 0xc5, 0x42, 0xab, 0x74,
+	0xbc, 0x72, 0x6a, 0x72, 0xee, 0xf1, 0x8e, 0x2c, 0xaa, 0xc3, 0x39, 0x3a, 0xb7, 0x44, 0x36, 0x8d,
+	0xf9, 0xc8, 0x51, 0x68, 0x75, 0x7d, 0x2f, 0x62, 0x0f, 0xa3, 0x95, 0x9e, 0x55, 0x3e, 0x5e, 0x3a,
+	0xd5, 0xa2, 0x09, 0x81, 0x9c, 0x83, 0x96, 0xeb, 0xb9, 0x91, 0xeb, 0x44, 0x7e, 0x60, 0x55, 0x8e,
+	0x97, 0x0c, 0x48, 0x5e, 0xc9, 0xce, 0x7c, 0xb7, 0xeb, 0xef, 0x7a, 0x11, 0x4d, 0x18, 0x89, 0x05,
+	0x8d, 0x28, 0x70, 0xba, 0x6c, 0xa5, 0x67, 0x55, 0x39, 0xa2, 0x4a, 0xda, 0xff, 0x7c, 0x01, 0x1a,
+	0xb2, 0x0e, 0xe4, 0x09, 0x68, 0x84, 0x03, 0xc1, 0xf5, 0xa5, 0x92, 0x60, 0x93, 0x69, 0x72, 0x19,
+	0x26, 0x1d, 0x01, 0xbb, 0xbe, 0xed, 0x3f, 0xb0, 0x4a, 0xbc, 0xe0, 0x27, 0x53, 0x6d, 0x91, 0x05,
+	0x77, 0x90, 0x65, 0x79, 0x82, 0xea, 0x12, 0x64, 0x05, 0x66, 0x64, 0x72, 0x89, 0x45, 0x8e, 0xdb,
+	0x0f, 0xad, 0x3f, 0x17, 0x20, 0xc7, 0x0a, 0x40, 0x24, 0xdb, 0xf2, 0x04, 0x4d, 0x09, 0x92, 0x8f,
+	0xc2, 0x23, 0x92, 0xb2, 0xe8, 0x7b, 0x9b, 0xee, 0xd6, 0xdd, 0x41, 0xcf, 0x89, 0x98, 0xf5, 0x17,
+	0x02, 0xef, 0x44, 0x01, 0x9e, 0xe0, 0xed, 0x08, 0xe6, 0xe5, 0x09, 0x9a, 0x87, 0x41, 0xae, 0xc1,
+	0xb4, 0x24, 0x4b, 0xd0, 0xbf, 0x14, 0xa0, 0x4f, 0x15, 0x80, 0xc6, 0x68, 0xa6, 0x18, 0xf9, 0x18,
+	0x3c, 0x2a, 0x09, 0x37, 0x5d, 0xef, 0xfe, 0xe2, 0xb6, 0xd3, 0xef, 0x33, 0x6f, 0x8b, 0x59, 0x7f,
+	0x35, 0xbc, 0x8e, 0x06, 0xf3, 0xf2, 0x04, 0xcd, 0x05, 0x21, 0x5b, 0x60, 0xe5, 0xd1, 0x97, 0xdd,
+	0x1e, 0xb3, 0xfe, 0x5a, 0x14, 0x70, 0x6a, 0xac, 0x02, 0xdc, 0x1e, 0x16, 0x52, 0x08, 0x46, 0x6e,
+	0x43, 0xdb, 0xdf, 0xf8, 0x24, 0xeb, 0xaa, 0x9e, 0x5f, 0x67, 0x91, 0xd5, 0xe6, 0xf8, 0xcf, 0xa4,
+	0xf0, 0x6f, 0x73, 0x36, 0x35, 0x66, 0x9d, 0x75, 0x16, 0x2d, 0x4f, 0xd0, 0x8c, 0x30, 0xb9, 0x0b,
+	0xc4, 0xa0, 0xcd, 0xef, 0x30, 0xaf, 0x67, 0xcd, 0x71, 0xc8, 0x67, 0x87, 0x43, 0x72, 0xd6, 0xe5,
+	0x09, 0x9a, 0x03, 0x90, 0x81, 0xbd, 0xeb, 0x85, 0x2c, 0xb2, 0xce, 0x8e, 0x03, 0xcb, 0x59, 0x33,
+	0xb0, 0x9c, 0x8a, 0x83, 0x28, 0xa8, 0x94, 0xf5, 0x9d, 0xc8, 0xf5, 0x3d, 0x59, 0xdf, 0x73, 0x1c,
+	0xf8, 0xb9, 0x7c, 0xe0, 0x98, 0x37, 0xae, 0x71, 0x2e, 0x08, 0xf9, 0x3f, 0xf0, 0x58, 0x8a, 0x4e,
+	0xd9, 0x8e, 0xbf, 0xc7, 0xac, 0xd7, 0x38, 0xfa, 0xc9, 0x51, 0xe8, 0x82, 0x7b, 0x79, 0x82, 0xe6,
+	0xc3, 0x90, 0x05, 0x98, 0x52, 0x19, 0x1c, 0xf6, 0x3c, 0x87, 0x3d, 0x5a, 0x04, 0x2b, 0xc1, 0x0c,
+	0x19, 0x9c, 0xf4, 0x22, 0xbd, 0xd8, 0xf7, 0x43, 0x66, 0xcd, 0xe7, 0x4e, 0x7a, 0x09, 0xc1, 0x59,
+	0x70, 0xd2, 0x6b, 0x12, 0x7a, 0x23, 0xc3, 0x28, 0x70, 0xbb, 0xbc, 0x82, 0xa8, 0x45, 0x17, 0x86,
+	0x37, 0x32, 0x61, 0x96, 0xaa, 0x94, 0x0f, 0x43, 0x28, 0x1c, 0x0a, 0x77, 0x37, 0xc2, 0x6e, 0xe0,
+	0x0e, 0x90, 0x36, 0xdf, 0xeb, 0x59, 0x6f, 0x0e, 0x43, 0x5e, 0xd7, 0x98, 0x3b, 0xf3, 0x3d, 0x1c,
+	0x9d, 0x34, 0x00, 0xf9, 0x18, 0x10, 0x9d, 0x24, 0xbb, 0xef, 0x2d, 0x0e, 0xfb, 0xc2, 0x18, 0xb0,
+	0x71, 0x5f, 0xe6, 0xc0, 0x10, 0x07, 0x1e, 0xd5, 0xa9, 0x6b, 0x7e, 0xe8, 0xe2, 0xff, 0xd6, 0x25,
+	0x0e, 0xff, 0xd2, 0x18, 0xf0, 0x4a, 0x04, 0x15, 0x2b, 0x0f, 0x2a, 0x5d, 0xc4, 0x22, 0x4e, 0x6d,
+	0x16, 0x84, 0xd6, 0xe5, 0xb1, 0x8b, 0x50, 0x22, 0xe9, 0x22, 0x14, 0x3d, 0xdd, 0x45, 0x6f, 0x07,
+	0xfe, 0xee, 0x20, 0xb4, 0xae, 0x8c, 0xdd, 0x45, 0x42, 0x20, 0xdd, 0x45, 0x82, 0x4a, 0xce, 0x43,
+	0x73, 0xa3, 0xef, 0x77, 0xef, 0xcf, 0xf7, 0xc4, 0xea, 0x37, 0x39, 0x67, 0xa5, 0x20, 0x17, 0x30,
+	0x5b, 0x0e, 0x5f, 0xcc, 0x8b, 0xca, 0xca, 0x7f, 0x2f, 0xb1, 0x3e, 0x8b, 0x98, 0x55, 0xc9, 0x55,
+	0x56, 0x21, 0x2a, 0x58, 0x50, 0x59, 0x35, 0x09, 0xb2, 0x04, 0x93, 0x9b, 0x6e, 0x9f, 0x85, 0x77,
+	0x07, 0x7d, 0xdf, 0x11, 0xeb, 0xe4, 0xe4, 0xdc, 0xf1, 0x5c, 0x80, 0x6b, 0x09, 0x1f, 0xa2, 0x68,
+	0x62, 0xe4, 0x12, 0xb4, 0x76, 0x9c, 0xe0, 0x7e, 0xb8, 0xe2, 0x6d, 0xfa, 0x56, 0x2d, 0x77, 0x85,
+	0x13, 0x18, 0xb7, 0x14, 0xd7, 0xf2, 0x04, 0x4d, 0x44, 0x70, 0x9d, 0xe4, 0x95, 0x5a, 0x67, 0xd1,
+	0x35, 0x97, 0xf5, 0x7b, 0xa1, 0x55, 0xe7, 0x20, 0x4f, 0xe7, 0x82, 0xac, 0xb3, 0xa8, 0x23, 0xd8,
+	0x70, 0x9d, 0x34, 0x05, 0xc9, 0x3b, 0xf0, 0x88, 0xa2, 0x2c, 0x6e, 0xbb, 0xfd, 0x5e, 0xc0, 0xbc,
+	0x95, 0x5e, 0x68, 0x35, 0x72, 0x97, 0xa0, 0x04, 0x4f, 0xe3, 0xc5, 0x65, 0x32, 0x07, 0x02, 0x2d,
+	0xa3, 0x22, 0xeb, 0x53, 0xd2, 0x6a, 0xe6, 0x5a, 0xc6, 0x04, 0x5a, 0x67, 0x46, 0xed, 0xca, 0x03,
+	0x21, 0x3d, 0x38, 0xa2, 0xe8, 0x0b, 0x4e, 0xf7, 0xfe, 0x56, 0xe0, 0xef, 0x7a, 0xbd, 0x45, 0xbf,
+	0xef, 0x07, 0x56, 0x2b, 0x77, 0x71, 0x4b, 0xf0, 0x53, 0xfc, 0xcb, 0x13, 0xb4, 0x08, 0x8a, 0x2c,
+	0xc2, 0x94, 0xca, 0xba, 0xc3, 0x1e, 0x46, 0x16, 0xe4, 0xae, 0xf3, 0x09, 0x34, 0x32, 0xa1, 0x81,
+	0xd4, 0x85, 0x74, 0x10, 0x54, 0x09, 0x6b, 0x72, 0x04, 0x08, 0x32, 0xe9, 0x20, 0x98, 0xd6, 0x41,
+	0x70, 0x09, 0xb6, 0xa6, 0x47, 0x80, 0x20, 0x93, 0x0e, 0x82, 0x69, 0x5c, 0xaa, 0xe3, 0x96, 0xfa,
+	0xfe, 0x7d, 0xd4, 0x27, 0x6b, 0x26, 0x77, 0xa9, 0xd6, 0x7a, 0x4b, 0x32, 0xe2, 0x52, 0x9d, 0x16,
+	0xc6, 0x9d, 0x90, 0xa2, 0xcd, 0xf7, 0xdd, 0x2d, 0xcf, 0x3a, 0x34, 0x44, 0x97, 0x11, 0x8d, 0x73,
+	0xe1, 0x4e, 0xc8, 0x10, 0x23, 0x57, 0xe4, 0xb4, 0x5c, 0x67, 0xd1, 0x92, 0xbb, 0x67, 0x1d, 0xce,
+	0x5d, 0x86, 0x12, 0x94, 0x25, 0x77, 0x2f, 0x9e, 0x97, 0x42, 0x44, 0x6f, 0x9a, 0x5a, 0xe4, 0xac,
+	0xc7, 0x46, 0x34, 0x4d, 0x31, 0xea, 0x4d, 0x53, 0x34, 0xbd, 0x69, 0x37, 0x9d, 0x88, 0x3d, 0xb4,
+	0x9e, 0x18, 0xd1, 0x34, 0xce, 0xa5, 0x37, 0x8d, 0x13, 0x70, 0x75, 0x53, 0x84, 0x7b, 0x2c, 0x88,
+	0xdc, 0xae, 0xd3, 0x17, 0x5d, 0x75, 0x22, 0x77, 0x0d, 0x4a, 0xf0, 0x0c, 0x6e, 0x5c, 0xdd, 0x72,
+	0x61, 0xf4, 0x86, 0xdf, 0x71, 0x36, 0xfa, 0x8c, 0xfa, 0x0f, 0xac, 0xe7, 0x46, 0x34, 0x5c, 0x31,
+	0xea, 0x0d, 0x57, 0x34, 0xdd, 0xb6, 0x7c, 0xc4, 0xed, 0x6d, 0xb1, 0xc8, 0x3a, 0x35, 0xc2, 0xb6,
+	0x08, 0x36, 0xdd, 0xb6, 0x08, 0x4a, 0x6c, 0x01, 0x96, 0x9c, 0xc8, 0xd9, 0x73, 0xd9, 0x83, 0x7b,
+	0x2e, 0x7b, 0x80, 0x0b, 0xfb, 0x23, 0x43, 0x2c, 0x80, 0xe2, 0xed, 0x48, 0xe6, 0xd8, 0x02, 0xa4,
+	0x40, 0x62, 0x0b, 0xa0, 0xd3, 0xa5, 0x59, 0x7f, 0x74, 0x88, 0x05, 0x30, 0xf0, 0x63, 0x1b, 0x5f,
+	0x04, 0x45, 0x1c, 0x78, 0x3c, 0x93, 0x75, 0x3b, 0xe8, 0xb1, 0xc0, 0x7a, 0x8a, 0x17, 0xf2, 0xfc,
+	0xe8, 0x42, 0x38, 0xfb, 0xf2, 0x04, 0x2d, 0x00, 0xca, 0x14, 0xb1, 0xee, 0xef, 0x06, 0x5d, 0x86,
+	0xfd, 0xf4, 0xec, 0x38, 0x45, 0xc4, 0xec, 0x99, 0x22, 0xe2, 0x1c, 0xb2, 0x07, 0x4f, 0xc5, 0x39,
+	0x58, 0x30, 0x5f, 0x45, 0x79, 0xe9, 0xf2, 0x04, 0x73, 0x92, 0x97, 0xd4, 0x19, 0x5e, 0x52, 0x5a,
+	0x6a, 0x79, 0x82, 0x0e, 0x87, 0x25, 0xfb, 0x70, 0xcc, 0x60, 0x10, 0xeb, 0xbc, 0x5e, 0xf0, 0xf3,
+	0xbc, 0xe0, 0x33, 0xc3, 0x0b, 0xce, 0x88, 0x2d, 0x4f, 0xd0, 0x11, 0xc0, 0x64, 0x00, 0x4f, 0x1a,
+	0x9d, 0xa1, 0x26, 0xb6, 0x54, 0x91, 0xcf, 0xf2, 0x72, 0x4f, 0x0f, 0x2f, 0xd7, 0x94, 0x59, 0x9e,
+	0xa0, 0xc3, 0x20, 0xf1, 0xc4, 0x95, 0x9b, 0x8d, 0x23, 0xf9, 0x99, 0xdc, 0x6d, 0x4f, 0x41, 0x71,
+	0x62, 0x2c, 0x0b, 0xc1, 0x72, 0x35, 0x5f, 0x76, 0xe7, 0xe7, 0xc6, 0xd5, 0xfc, 0xb8, 0x1f, 0x8b,
+	0xa0, 0x8c, 0xb1, 0xc3, 0xac, 0x3b, 0x4e, 0xb0, 0xc5, 0x22, 0xd1, 0xd1, 0x2b, 0x3d, 0x6c, 0xd4,
+	0xff, 0x1b, 0x67, 0xec, 0x32, 0x62, 0xc6, 0xd8, 0xe5, 0x02, 0x93, 0x10, 0x8e, 0x1a, 0x1c, 0x2b,
+	0xe1, 0xa2, 0xdf, 0xef, 0xb3, 0xae, 0xea, 0xcd, 0xff, 0xcf, 0x0b, 0x7e, 0x79, 0x78, 0xc1, 0x29,
+	0xa1, 0xe5, 0x09, 0x3a, 0x14, 0x34, 0xd3, 0xde, 0xdb, 0xfd, 0x5e, 0x4a, 0x67, 0xac, 0xb1, 0x74,
+	0x35, 0x2d, 0x96, 0x69, 0x6f, 0x86, 0x23, 0xa3, 0xab, 0x1a, 0x07, 0x36, 0xf7, 0xc8, 0x38, 0xba,
+	0x6a, 0xca, 0x64, 0x74, 0xd5, 0xcc, 0xc6, 0xd5, 0x6d, 0x37, 0x64, 0x01, 0xc7, 0xb8, 0xee, 0xbb,
+	0x9e, 0xf5, 0x74, 0xee, 0xea, 0x76, 0x37, 0x64, 0x81, 0x2c, 0x08, 0xb9, 0x70, 0x75, 0x33, 0xc4,
+	0x0c, 0x9c, 0x9b, 0x6c, 0x33, 0xb2, 0x8e, 0x8f, 0xc2, 0x41, 0x2e, 0x03, 0x07, 0x09, 0xb8, 0x52,
+	0xc4, 0x84, 0x75, 0x86, 0xa3, 0x42, 0x1d, 0x74, 0x85, 0x3c, 0x93, 0xbb, 0x52, 0x68, 0x70, 0x1a,
+	0x33, 0xae, 0x14, 0x79, 0x20, 0x78, 0xf2, 0x8f, 0xe9, 0xb8, 0x23, 0x13, 0xd0, 0xb3, 0xb9, 0x27,
+	0x7f, 0x0d, 0x3a, 0x66, 0xc5, 0x33, 0x48, 0x16, 0x80, 0xbc, 0x00, 0xd5, 0x81, 0xeb, 0x6d, 0x59,
+	0x3d, 0x0e, 0xf4, 0x48, 0x0a, 0x68, 0xcd, 0xf5, 0xb6, 0x96, 0x27, 0x28, 0x67, 0x21, 0x6f, 0x02,
+	0x0c, 0x02, 0xbf, 0xcb, 0xc2, 0x70, 0x95, 0x3d, 0xb0, 0x18, 0x17, 0xb0, 0xd3, 0x02, 0x82, 0xa1,
+	0xb3, 0xca, 0x70, 0x5d, 0xd6, 0xf8, 0xc9, 0x55, 0x98, 0x96, 0x29, 0x39, 0xcb, 0x37, 0x73, 0x37,
+	0x7f, 0x0a, 0x20, 0x71, 0x37, 0x19, 0x52, 0x78, 0xf6, 0x91, 0x84, 0x25, 0xdf, 0x63, 0xd6, 0x56,
+	0xee, 0xd9, 0x47, 0x81, 0x20, 0x0b, 0xee, 0xb1, 0x34, 0x09, 0xf4, 0x16, 0x44, 0xdb, 0x01, 0x73,
+	0x7a, 0xeb, 0x91, 0x13, 0xed, 0x86, 0x96, 0x97, 0xbb, 0x4d, 0x13, 0x99, 0x9d, 0x3b, 0x9c, 0x13,
+	0xb7, 0xa0, 0xba, 0x0c, 0x59, 0x85, 0x36, 0x1e, 0x84, 0x6e, 0xba, 0x3b, 0x6e, 0x44, 0x99, 0xd3,
+	0xdd, 0x66, 0x3d, 0xcb, 0xcf, 0x3d, 0x44, 0xe1, 0xb6, 0xb7, 0xa3, 0xf3, 0xe1, 0x6e, 0x25, 0x2d,
+	0x4b, 0x96, 0x61, 0x06, 0x69, 0xeb, 0x03, 0xa7, 0xcb, 0xee, 0xa2, 0x7f, 0xd2, 0x1a, 0xe4, 0x6a,
+	0x20, 0x47, 0x4b, 0xb8, 0x70, 0xb3, 0x62, 0xca, 0x29, 0xa4, 0x9b, 0x7e, 0xd7, 0xe9, 0x0b, 0xa4,
+	0x4f, 0x15, 0x23, 0x25, 0x5c, 0x0a, 0x29, 0xa1, 0x18, 0x6d, 0x14, 0x7d, 0xdf, 0xb3, 0xf6, 0x46,
+	0xb4, 0x51, 0xf2, 0x19, 0x6d, 0x94, 0x34, 0xc4, 0xf3, 0xfc, 0xc8, 0xdd, 0x74, 0xbb, 0x72, 0xfe,
+	0x7a, 0x3d, 0x2b, 0xc8, 0xc5, 0x5b, 0xd5, 0xd8, 0x3a, 0xeb, 0xc2, 0xb3, 0x94, 0x91, 0x25, 0x77,
+	0x80, 0xe8, 0x34, 0xa9, 0x54, 0x21, 0x47, 0x9c, 0x1d, 0x86, 0x18, 0x6b, 0x56, 0x8e, 0x3c, 0xd6,
+	0x72, 0xe0, 0xec, 0xe3, 0xf1, 0x76, 0x21, 0xf0, 0x9d, 0x5e, 0xd7, 0x09, 0x23, 0x2b, 0xca, 0xad,
+	0xe5, 0x9a, 0x60, 0xeb, 0xc4, 0x7c, 0x58, 0xcb, 0xb4, 0x2c, 0xe2, 0xed, 0xb0, 0x9d, 0x0d, 0x16,
+	0x84, 0xdb, 0xee, 0x40, 0xd6, 0x71, 0x37, 0x17, 0xef, 0x56, 0xcc, 0x96, 0xd4, 0x30, 0x23, 0x8b,
+	0x1b, 0xf1, 0x84, 0x76, 0xc7, 0x65, 0x81, 0x9a, 0x4d, 0x5f, 0x2d, 0xe5, 0x1a, 0x19, 0x0d, 0x55,
+	0xe3, 0xc6, 0x8d, 0x78, 0x2e, 0x0c, 0xe2, 0x73, 0x3f, 0xf8, 0xfa, 0xbe, 0xd7, 0x15, 0xca, 0x2e,
+	0xf1, 0x1f, 0xe4, 0x6e, 0xf4, 0xb9, 0xe6, 0x75, 0x12, 0xe6, 0xa4, 0xea, 0xf9, 0x30, 0xe4, 0x06,
+	0x1c, 0x1a, 0xcc, 0x0d, 0x0c, 0xe4, 0x87, 0xb9, 0x1b, 0xf3, 0xb5, 0xb9, 0xb5, 0x34, 0x64, 0x5a,
+	0x12, 0xa7, 0xb2, 0xbb, 0x33, 0xf0, 0x83, 0xe8, 0x9a, 0xeb, 0xb9, 0xe1, 0xb6, 0xb5, 0x9f, 0x3b,
+	0x95, 0x57, 0x38, 0x4b, 0x47, 0xf0, 0xe0, 0x54, 0xd6, 0x65, 0xc8, 0x39, 0x68, 0x74, 0xb7, 0x9d,
+	0x08, 0x5d, 0x30, 0x9f, 0x17, 0x5d, 0x78, 0x24, 0x25, 0xbf, 0xb8, 0xed, 0x44, 0xd2, 0x05, 0xa3,
+	0x58, 0xc9, 0x5b, 0x00, 0xf8, 0x53, 0xb6, 0xe0, 0xc7, 0x4a, 0xb9, 0xb6, 0x90, 0x0b, 0xc6, 0xb5,
+	0xd7, 0x04, 0xd0, 0x5d, 0x91, 0xa4, 0xd0, 0x08, 0x08, 0x9f, 0xc2, 0x17, 0x4a, 0xb9, 0xd6, 0x5c,
+	0xc3, 0x89, 0x79, 0xd1, 0x5d, 0x91, 0x03, 0x81, 0x8b, 0x70, 0x42, 0x56, 0x17, 0x2e, 0x89, 0xb1,
+	0xfb, 0xf1, 0x52, 0xae, 0x6b, 0x4c, 0x2b, 0x21, 0x23, 0x83, 0x8b, 0xf0, 0x10, 0xc8, 0x74, 0x89,
+	0x9e, 0x70, 0x01, 0xc6, 0x25, 0x7e, 0x65, 0x8c, 0x12, 0x53, 0x32, 0xe9, 0x12, 0x53, 0xd9, 0xb9,
+	0x6d, 0x4c, 0x14, 0xcd, 0xfa, 0x89, 0x71, 0xdb, 0x98, 0xc8, 0xe4, 0xb6, 0x31, 0xc9, 0x56, 0xc3,
+	0x2d, 0x77, 0x50, 0x5f, 0x1c, 0x32, 0xdc, 0xf1, 0x6e, 0x49, 0x13, 0x20, 0x37, 0xe1, 0x10, 0xa6,
+	0x10, 0x8c, 0x49, 0x95, 0xf9, 0x72, 0x29, 0x57, 0xeb, 0xb5, 0x4a, 0xae, 0x47, 0x52, 0xeb, 0x53,
+	0xa2, 0xb8, 0x11, 0x48, 0xe6, 0xee, 0xbd, 0x39, 0x09, 0xf8, 0x93, 0xa5, 0x5c, 0xcb, 0x77, 0x4b,
+	0xe3, 0xd4, 0x2c, 0x5f, 0x16, 0x80, 0xec, 0x80, 0xad, 0x53, 0xd7, 0x02, 0xbf, 0xb7, 0xdb, 0x8d,
+	0xd4, 0x24, 0xfd, 0x29, 0x01, 0xff, 0xe2, 0x30, 0x78, 0x53, 0x64, 0x79, 0x82, 0x0e, 0x01, 0xc4,
+	0x9b, 0x2d, 0x6e, 0x21, 0xe6, 0xbb, 0x91, 0xbb, 0xe7, 0x46, 0xfb, 0xb2, 0x9c, 0xaf, 0xe5, 0xdf,
+	0x1a, 0x09, 0x3b, 0xa3, 0x78, 0xb5, 0x9b, 0xad, 0x1c, 0x8c, 0x85, 0x06, 0xd4, 0xf6, 0x9c, 0xfe,
+	0x2e, 0xb3, 0xbf, 0x5f, 0x87, 0x2a, 0xf6, 0xa8, 0xfd, 0xed, 0x12, 0x54, 0x70, 0xda, 0xce, 0x40,
+	0xd9, 0xed, 0x59, 0xe2, 0xbe, 0xaf, 0xec, 0xf6, 0xf0, 0xae, 0xd0, 0xc7, 0xd3, 0x56, 0x7c, 0xfb,
+	0xa8, 0x92, 0x64, 0x16, 0xa6, 0x9c, 0xcd, 0x88, 0x05, 0xb7, 0x65, 0x76, 0x9d, 0x67, 0x1b, 0x34,
+	0x34, 0x1d, 0xf2, 0x26, 0xd3, 0xaa, 0xa4, 0x34, 0x42, 0xdc, 0x4e, 0x62, 0xd9, 0x6a, 0xc2, 0x28,
+	0x56, 0xf2, 0x38, 0xd4, 0xc3, 0xdd, 0x0d, 0xf4, 0x4e, 0x56, 0x8f, 0x57, 0x4e, 0xb5, 0xa8, 0x4c,
+	0x91, 0x37, 0x60, 0xaa, 0xc7, 0x06, 0xcc, 0xeb, 0x31, 0xaf, 0xeb, 0xb2, 0xd0, 0xaa, 0xf1, 0x3b,
+	0xd4, 0x23, 0x1d, 0x71, 0xff, 0xda, 0x51, 0xf7, 0xaf, 0x9d, 0x75, 0x7e, 0xff, 0x4a, 0x0d, 0x66,
+	0xfb, 0x15, 0xa8, 0x4b, 0x5d, 0x4b, 0x37, 0x31, 0x29, 0xae, 0xac, 0x17, 0x67, 0x6f, 0x42, 0x5d,
+	0x8e, 0x44, 0x5a, 0x42, 0x6b, 0x56, 0xf9, 0x07, 0x69, 0x56, 0xc5, 0x28, 0xe7, 0x73, 0x70, 0x28,
+	0x6d, 0xa3, 0xd2, 0x05, 0x2e, 0x40, 0x2b, 0x50, 0x99, 0x56, 0x39, 0x35, 0xfe, 0x99, 0x22, 0x3b,
+	0x31, 0x10, 0x4d, 0xc4, 0x0a, 0x8b, 0xff, 0x18, 0x1c, 0x29, 0x32, 0x5c, 0x6d, 0xa8, 0xb8, 0x3d,
+	0x71, 0x57, 0xdd, 0xa2, 0xf8, 0x13, 0x41, 0xdc, 0x10, 0x39, 0x78, 0x2d, 0x9a, 0x54, 0xa6, 0xc6,
+	0x01, 0x4f, 0xdb, 0xa8, 0xf7, 0x0f, 0xfe, 0x7f, 0xe1, 0x48, 0x91, 0x39, 0xca, 0x82, 0xdb, 0xd0,
+	0x74, 0x43, 0xe4, 0x60, 0x0a, 0x3e, 0x4e, 0x17, 0x16, 0x70, 0x17, 0x26, 0x35, 0x4b, 0x43, 0x3a,
+	0x50, 0x0b, 0xf1, 0x87, 0x55, 0x4a, 0xdd, 0x44, 0x24, 0x23, 0xc0, 0x19, 0xa9, 0x60, 0x2b, 0x54,
+	0xac, 0x9f, 0x6e, 0x40, 0x43, 0xde, 0xc1, 0xda, 0xab, 0x50, 0xe5, 0x37, 0xe2, 0x8f, 0x42, 0xcd,
+	0xf5, 0x7a, 0xec, 0x21, 0xc7, 0xae, 0x51, 0x91, 0x20, 0xaf, 0x40, 0x43, 0xde, 0xc7, 0x5a, 0xe5,
+	0xa1, 0xb7, 0xfb, 0x8a, 0xcd, 0x7e, 0x17, 0x1a, 0xea, 0x66, 0xfc, 0x28, 0xb4, 0x06, 0x81, 0x8f,
+	0xbb, 0xcc, 0x15, 0xa5, 0x4b, 0x09, 0x81, 0xbc, 0x0a, 0x8d, 0x9e, 0x60, 0x94, 0xd0, 0x85, 0xf3,
+	0x48, 0xf1, 0xd9, 0x9f, 0x2f, 0x41, 0x5d, 0x5c, 0x90, 0xdb, 0x7b, 0xf1, 0xdc, 0x78, 0x0d, 0xea,
+	0x5d, 0x4e, 0xb3, 0xd2, 0x97, 0xe3, 0x46, 0x0d, 0xe5, 0x8d, 0x3b, 0x95, 0xcc, 0x28, 0x16, 0x8a,
+	0xc5, 0xa8, 0x3c, 0x54, 0x4c, 0x8c, 0x27, 0x95, 0xcc, 0xff, 0x65, 0xe5, 0xfe, 0x66, 0x05, 0xa6,
+	0xcd, 0x7b, 0x77, 0x0c, 0xcc, 0x50, 0x09, 0xd5, 0xbb, 0x31, 0x81, 0xdc, 0x06, 0xe8, 0xf6, 0x5d,
+	0xe6, 0x45, 0xfc, 0xe6, 0xa7, 0x9c, 0xeb, 0x50, 0xc8, 0xbd, 0x86, 0xef, 0x2c, 0xc6, 0x62, 0x54,
+	0x83, 0x20, 0x97, 0xa1, 0x16, 0x76, 0xfd, 0x81, 0xb0, 0xa3, 0x33, 0x73, 0x2f, 0x14, 0x54, 0x7b,
+	0x7e, 0x37, 0xda, 0x16, 0x87, 0x96, 0xf9, 0x81, 0xbb, 0x8e, 0x02, 0x54, 0xc8, 0xa1, 0xfe, 0xcb,
+	0xf0, 0x0d, 0x65, 0x56, 0xe3, 0x34, 0xe6, 0xe1, 0xd1, 0xed, 0xb6, 0xd7, 0xdf, 0xe7, 0xb7, 0x54,
+	0x4d, 0x1a, 0xa7, 0x31, 0x8f, 0x3d, 0x1c, 0xb8, 0x01, 0x9b, 0x8f, 0xb8, 0x89, 0xaf, 0xd0, 0x38,
+	0x6d, 0x7f, 0xa3, 0x04, 0x90, 0xd4, 0x97, 0x1c, 0x8f, 0x0f, 0x9e, 0xab, 0xce, 0x8e, 0xea, 0x14,
+	0x9d, 0xa4, 0x71, 0xac, 0x39, 0xd1, 0xb6, 0x5c, 0x51, 0x74, 0x12, 0x21, 0x50, 0xf5, 0x50, 0x58,
+	0x04, 0xa6, 0xf0, 0xdf, 0xe4, 0x34, 0x1c, 0x0e, 0xdd, 0x2d, 0xcf, 0x89, 0x76, 0x03, 0x76, 0x8f,
+	0x05, 0xee, 0xa6, 0xcb, 0x7a, 0xbc, 0x1f, 0x9a, 0x34, 0x9b, 0x61, 0xbf, 0x0a, 0x87, 0xb3, 0xc1,
+	0x0b, 0x43, 0x47, 0xcb, 0xfe, 0x6a, 0x0b, 0xea, 0xc2, 0x2f, 0x65, 0xff, 0x5b, 0x39, 0x9e, 0x40,
+	0xf6, 0x9f, 0x94, 0xa0, 0x26, 0xee, 0xe7, 0xd3, 0xf6, 0xf8, 0x9a, 0x3e, 0x79, 0x2a, 0x39, 0x4e,
+	0x9b, 0xbc, 0x78, 0x85, 0xce, 0x0d, 0xb6, 0x7f, 0x0f, 0x57, 0xdd, 0x78, 0x46, 0x15, 0x1a, 0x9e,
+	0xeb, 0xd0, 0x54, 0xcc, 0x68, 0xca, 0xee, 0xb3, 0x7d, 0x59, 0x38, 0xfe, 0x24, 0xa7, 0xe5, 0xea,
+	0x1d, 0xdb, 0x84, 0xf4, 0xc4, 0x15, 0xa5, 0xc8, 0x25, 0xfe, 0x13, 0x50, 0x41, 0x4f, 0x50, 0xba,
+	0x09, 0x07, 0x9f, 0xff, 0x85, 0xb5, 0x5d, 0x84, 0x9a, 0x88, 0x91, 0x48, 0x97, 0x41, 0xa0, 0x7a,
+	0x9f, 0xed, 0x2b, 0xf3, 0xc7, 0x7f, 0x17, 0x82, 0xfc, 0x71, 0x05, 0xa6, 0xf4, 0x7b, 0x61, 0xfb,
+	0x6a, 0xe1, 0x86, 0x84, 0x6f, 0x31, 0x92, 0x0d, 0x89, 0x4c, 0xa2, 0x09, 0xe5, 0x58, 0x5c, 0x35,
+	0x5a, 0x54, 0x24, 0xec, 0x0e, 0xd4, 0xe5, 0x75, 0x7b, 0x1a, 0x29, 0xe6, 0x2f, 0xeb, 0xfc, 0xd7,
+	0xa1, 0x19, 0xdf, 0x9e, 0xbf, 0xdf, 0xb2, 0x03, 0x68, 0xc6, 0xd7, 0xe4, 0x8f, 0x42, 0x2d, 0xf2,
+	0x23, 0xa7, 0xcf, 0xe1, 0x2a, 0x54, 0x24, 0x50, 0x2f, 0x3d, 0xf6, 0x30, 0x5a, 0x8c, 0x4d, 0x7c,
+	0x85, 0x26, 0x04, 0x61, 0xc1, 0xd9, 0x9e, 0xc8, 0xad, 0x88, 0xdc, 0x98, 0x90, 0x94, 0x59, 0xd5,
+	0xcb, 0xdc, 0x87, 0xba, 0xbc, 0x3b, 0x8f, 0xf3, 0x4b, 0x5a, 0x3e, 0x99, 0x87, 0x1a, 0xde, 0x7c,
+	0x0e, 0xac, 0x72, 0xea, 0x0c, 0x20, 0x0c, 0x89, 0x70, 0x89, 0x2d, 0xfa, 0x5e, 0x84, 0x6a, 0x6c,
+	0x5e, 0x09, 0x50, 0x21, 0x89, 0x43, 0x18, 0x88, 0x40, 0x08, 0x31, 0x09, 0x65, 0xca, 0xfe, 0x8d,
+	0x12, 0xb4, 0xe2, 0xc8, 0x13, 0xfb, 0xdd, 0xa2, 0xc9, 0x33, 0x0f, 0xd3, 0x81, 0xe4, 0xc2, 0x89,
+	0xaa, 0xa6, 0xd0, 0x93, 0xa9, 0x9a, 0x50, 0x8d, 0x87, 0x9a, 0x12, 0xf6, 0x9b, 0x85, 0x83, 0x3a,
+	0x0b, 0x53, 0x8a, 0xf5, 0x46, 0xa2, 0x7a, 0x06, 0xcd, 0xb6, 0x63, 0xe9, 0xcc, 0x36, 0xc1, 0xde,
+	0x84, 0x29, 0xfd, 0xfe, 0xd9, 0xbe, 0x97, 0x3f, 0x7b, 0x2e, 0x63, 0x31, 0x09, 0x9b, 0xec, 0xcc,
+	0x6c, 0x13, 0x12, 0x16, 0x6a, 0x08, 0xd8, 0x47, 0xa0, 0x26, 0xa2, 0x62, 0x52, 0xc8, 0xf6, 0xdf,
+	0x77, 0xa1, 0xc6, 0x07, 0xc1, 0x3e, 0x2b, 0x26, 0xc0, 0x69, 0xa8, 0x73, 0x0f, 0xaf, 0x8a, 0x19,
+	0x7c, 0x34, 0x6f, 0xc4, 0xa8, 0xe4, 0xb1, 0x17, 0x61, 0x52, 0x8b, 0x47, 0x40, 0x8d, 0xe5, 0x19,
+	0xb1, 0x16, 0xa8, 0x24, 0xda, 0x75, 0xdc, 0x09, 0x48, 0x3b, 0xcc, 0xd7, 0x03, 0x95, 0xb6, 0x4f,
+	0xc4, 0x7b, 0x65, 0x5b, 0xc6, 0x5f, 0xac, 0xc4, 0xbd, 0x14, 0xa7, 0xed, 0x8f, 0x43, 0x2b, 0x0e,
+	0x5b, 0x20, 0xb7, 0x61, 0x4a, 0x86, 0x2d, 0x08, 0xaf, 0x2b, 0x32, 0xcf, 0x8c, 0xd0, 0x2e, 0x74,
+	0xb1, 0xf2, 0xc8, 0x87, 0xce, 0x9d, 0xfd, 0x01, 0xa3, 0x06, 0x80, 0xfd, 0xe5, 0x53, 0xbc, 0xe7,
+	0xed, 0x01, 0x34, 0xe3, 0xbb, 0xda, 0xf4, 0x28, 0x5c, 0x10, 0xa6, 0xb1, 0x3c, 0x32, 0xd0, 0x40,
+	0xc8, 0xa3, 0x01, 0xe6, 0x16, 0xd4, 0x7e, 0x12, 0x2a, 0x37, 0xd8, 0x3e, 0xce, 0x10, 0x61, 0x48,
+	0xe5, 0x0c, 0xe1, 0x09, 0x7b, 0x05, 0xea, 0x32, 0x66, 0x22, 0x5d, 0xde, 0x19, 0xa8, 0x6f, 0xf2,
+	0x9c, 0x51, 0x26, 0x53, 0xb2, 0xd9, 0x97, 0x61, 0x52, 0x8f, 0x94, 0x48, 0xe3, 0x1d, 0x87, 0xc9,
+	0x6e, 0x92, 0x2d, 0x87, 0x41, 0x27, 0xd9, 0xcc, 0x54, 0xc7, 0x0c, 0xc2, 0xd5, 0x5c, 0x3d, 0x7c,
+	0x26, 0xb7, 0xdb, 0x87, 0x68, 0xe3, 0x0d, 0x38, 0x94, 0x0e, 0x89, 0x48, 0x97, 0x74, 0x0a, 0x0e,
+	0x6d, 0x98, 0x2c, 0xd2, 0x06, 0xa6, 0xc9, 0xf6, 0x0a, 0xd4, 0xc4, 0x95, 0x75, 0x1a, 0xe2, 0x15,
+	0xa8, 0x39, 0x98, 0xc1, 0x05, 0x67, 0xe6, 0xec, 0xdc, 0x5a, 0x72, 0x51, 0x2a, 0x18, 0x6d, 0x17,
+	0xa6, 0xcd, 0x5b, 0xf0, 0x34, 0xe4, 0x32, 0x4c, 0xef, 0xe9, 0x0c, 0x12, 0x7a, 0x36, 0x17, 0xda,
+	0x80, 0xa2, 0xa6, 0xa0, 0xfd, 0x85, 0x3a, 0x54, 0x79, 0x18, 0x47, 0xba, 0x88, 0xf3, 0x50, 0xc5,
+	0x68, 0x5b, 0xd9, 0xb5, 0xb3, 0x43, 0x63, 0x42, 0xf8, 0x3f, 0x94, 0xf3, 0x93, 0x0f, 0xe1, 0x69,
+	0x61, 0xbf, 0xaf, 0x4e, 0xbe, 0xcf, 0x0e, 0x17, 0x5c, 0x47, 0x56, 0x2a, 0x24, 0x50, 0x94, 0xcf,
+	0x05, 0xab, 0x3a, 0x8e, 0x28, 0x9f, 0x84, 0x54, 0x48, 0x90, 0xcb, 0xe8, 0xac, 0x63, 0xdd, 0xfb,
+	0xac, 0x67, 0xd5, 0x46, 0x4c, 0x0b, 0x2e, 0xbc, 0x28, 0x98, 0xa9, 0x92, 0xc2, 0xb2, 0xbb, 0x7c,
+	0x74, 0xeb, 0xe3, 0x94, 0xcd, 0x47, 0x9c, 0x0a, 0x09, 0x72, 0x15, 0x5a, 0x6e, 0xd7, 0xf7, 0xae,
+	0xee, 0xf8, 0x9f, 0x74, 0xad, 0xc6, 0x90, 0x3b, 0xed, 0x58, 0x7c, 0x45, 0xb1, 0xd3, 0x44, 0x52,
+	0xc1, 0xac, 0xec, 0xe0, 0xf9, 0xba, 0x39, 0x2e, 0x0c, 0x67, 0xa7, 0x89, 0xa4, 0x7d, 0x54, 0x8e,
+	0x67, 0xfe, 0x24, 0xbf, 0x06, 0x35, 0xde, 0xe5, 0xe4, 0x2d, 0x3d, 0x7b, 0x66, 0xee, 0xf9, 0x5c,
+	0xcd, 0x31, 0x2c, 0x96, 0x1c, 0xaa, 0x18, 0x87, 0xf7, 0xbf, 0x89, 0x33, 0x39, 0x0e, 0x8e, 0x1c,
+	0x37, 0x81, 0xf3, 0x34, 0x34, 0xe4, 0x50, 0x98, 0x15, 0x6e, 0x2a, 0x86, 0xa7, 0xa0, 0x26, 0x26,
+	0x66, 0x7e, 0x7b, 0x9e, 0x81, 0x56, 0xdc, 0x99, 0xc3, 0x59, 0x78, 0xef, 0x14, 0xb0, 0x7c, 0xa5,
+	0x0c, 0x35, 0x11, 0xce, 0x92, 0x35, 0xb5, 0xfa, 0x2c, 0x78, 0x76, 0x78, 0x74, 0x8c, 0x3e, 0x0d,
+	0xae, 0x41, 0x4b, 0xee, 0xef, 0xe3, 0x10, 0xf5, 0x53, 0x23, 0xa4, 0xd7, 0x14, 0x3f, 0x4d, 0x44,
+	0x47, 0x0c, 0xe7, 0x6d, 0x68, 0xc5, 0x52, 0x64, 0xc1, 0x1c, 0xd2, 0xd3, 0x43, 0x87, 0x22, 0x5d,
+	0xa4, 0x04, 0xfc, 0xf9, 0x12, 0x54, 0x30, 0xde, 0x28, 0xdd, 0x0f, 0xaf, 0xab, 0x59, 0x3d, 0xca,
+	0x1c, 0x2c, 0xb9, 0x7b, 0xc6, 0xa4, 0xb6, 0xaf, 0x2a, 0x8d, 0x7b, 0xd3, 0xac, 0xde, 0xc9, 0xe1,
+	0x3b, 0xb0, 0x04, 0x46, 0x54, 0xec, 0x67, 0x1a, 0x50, 0xe5, 0x91, 0x62, 0x79, 0x76, 0x6a, 0x7f,
+	0x30, 0xba, 0x62, 0x28, 0x2c, 0x16, 0x5c, 0xce, 0x4f, 0x3e, 0xa4, 0xbc, 0x1a, 0xa3, 0xec, 0x14,
+	0x17, 0x34, 0x1c, 0x1c, 0xe7, 0xa1, 0xba, 0xe3, 0xca, 0xc3, 0xda, 0xc8, 0x22, 0x6f, 0xb9, 0x3b,
+	0x8c, 0x72, 0x7e, 0x94, 0xdb, 0x76, 0xc2, 0x6d, 0xab, 0x36, 0x8e, 0xdc, 0xb2, 0x13, 0x6e, 0x53,
+	0xce, 0x8f, 0x72, 0xfc, 0x70, 0x58, 0x1f, 0x47, 0x0e, 0x0f, 0x9c, 0xf2, 0x00, 0x79, 0x1e, 0xaa,
+	0xa1, 0xfb, 0x69, 0x66, 0x35, 0xc6, 0x91, 0x5b, 0x77, 0x3f, 0xcd, 0x28, 0xe7, 0x4f, 0x4c, 0x78,
+	0x73, 0xbc, 0xae, 0xd1, 0x4c, 0xf8, 0x1d, 0x98, 0x89, 0x8c, 0x78, 0x07, 0x19, 0xae, 0x78, 0x7a,
+	0xc4, 0xb8, 0x18, 0x32, 0x34, 0x85, 0x81, 0x93, 0x80, 0x9f, 0xa3, 0xf3, 0x27, 0xc1, 0x53, 0x50,
+	0xfb, 0x88, 0xdb, 0x8b, 0xb6, 0xcd, 0xec, 0x9a, 0x61, 0xf2, 0x70, 0xd8, 0x0e, 0x64, 0xf2, 0xf4,
+	0x51, 0x17, 0x38, 0x4b, 0x50, 0x45, 0xf5, 0x39, 0x98, 0x1e, 0x27, 0x5a, 0xf7, 0xbe, 0x0c, 0xb0,
+	0xde, 0xd1, 0x02, 0xe7, 0x28, 0x54, 0x51, 0x43, 0x0a, 0xba, 0xe4, 0x28, 0x54, 0x51, 0xef, 0x8a,
+	0x73, 0x71, 0xb4, 0xcd, 0xdc, 0x8a, 0xca, 0x3d, 0x09, 0x33, 0xe6, 0x70, 0x14, 0xa0, 0xfc, 0x51,
+	0x03, 0xaa, 0x3c, 0xec, 0x32, 0x3d, 0x23, 0x3f, 0x0c, 0xd3, 0x62, 0xfc, 0x16, 0xe4, 0x16, 0xbc,
+	0x9c, 0x7b, 0xed, 0x62, 0x06, 0x73, 0x4a, 0x15, 0x90, 0x22, 0xd4, 0x44, 0x18, 0x7f, 0x53, 0xc1,
+	0xa1, 0x0c, 0x8d, 0x7c, 0x33, 0xde, 0xbc, 0x56, 0x47, 0xc4, 0xfc, 0x72, 0x59, 0xb1, 0x05, 0x56,
+	0x3b, 0x59, 0xb2, 0x00, 0x4d, 0x5c, 0x5a, 0xb1, 0xbb, 0xe4, 0xb4, 0x3d, 0x39, 0x5c, 0x7e, 0x45,
+	0x72, 0xd3, 0x58, 0x0e, 0x17, 0xf6, 0xae, 0x13, 0xf4, 0x78, 0xad, 0xe4, 0x1c, 0x7e, 0x7e, 0x38,
+	0xc8, 0xa2, 0x62, 0xa7, 0x89, 0x24, 0xb9, 0x01, 0x93, 0x3d, 0x16, 0xfb, 0x09, 0xe4, 0xa4, 0x7e,
+	0x61, 0x38, 0xd0, 0x52, 0x22, 0x40, 0x75, 0x69, 0xac, 0x93, 0x3a, 0x1b, 0x86, 0x23, 0x37, 0x1b,
+	0x1c, 0x2a, 0xf9, 0xb6, 0x22, 0x91, 0xb4, 0x9f, 0x83, 0x69, 0x63, 0xdc, 0x3e, 0xd0, 0x5d, 0x87,
+	0x3e, 0x96, 0x02, 0xe7, 0x42, 0x7c, 0x44, 0x79, 0xd9, 0xdc, 0x76, 0x14, 0x9e, 0x48, 0xa4, 0xe0,
+	0x4d, 0x68, 0xaa, 0x81, 0x21, 0x57, 0xcc, 0x3a, 0xbc, 0x38, 0xba, 0x0e, 0xf1, 0x98, 0x4a, 0xb4,
+	0x55, 0x68, 0xc5, 0x23, 0x84, 0x8e, 0x05, 0x1d, 0xee, 0xa5, 0xd1, 0x70, 0xc9, 0xe8, 0x4a, 0x3c,
+	0x0a, 0x93, 0xda, 0x40, 0x91, 0x45, 0x13, 0xf1, 0xe5, 0xd1, 0x88, 0xfa, 0x30, 0x27, 0xbb, 0x9e,
+	0x78, 0xc4, 0xf4, 0x51, 0xa9, 0x24, 0xa3, 0xf2, 0x3b, 0x0d, 0x68, 0xc6, 0xa1, 0xce, 0x39, 0x67,
+	0xcc, 0xdd, 0xa0, 0x3f, 0xf2, 0x8c, 0xa9, 0xe4, 0x3b, 0x77, 0x83, 0x3e, 0x45, 0x09, 0x1c, 0xe2,
+	0xc8, 0x8d, 0xe2, 0xa9, 0xfa, 0xfc, 0x68, 0xd1, 0x3b, 0xc8, 0x4e, 0x85, 0x14, 0xb9, 0x6d, 0x6a,
+	0x79, 0x75, 0x48, 0x28, 0x9c, 0x01, 0x52, 0xa8, 0xe9, 0x2b, 0xd0, 0x72, 0x71, 0xeb, 0xb7, 0x9c,
+	0xac, 0xbc, 0x2f, 0x8d, 0x86, 0x5b, 0x51, 0x22, 0x34, 0x91, 0xc6, 0xba, 0x6d, 0x3a, 0x7b, 0x38,
+	0xaf, 0x39, 0x58, 0x7d, 0xdc, 0xba, 0x5d, 0x4b, 0x84, 0xa8, 0x8e, 0x40, 0x2e, 0xca, 0xbd, 0x4b,
+	0x63, 0x84, 0x65, 0x49, 0xba, 0x2a, 0xd9, 0xbf, 0xbc, 0x93, 0x59, 0x69, 0xc5, 0x34, 0x7e, 0x65,
+	0x0c, 0x94, 0xa1, 0xab, 0x2d, 0x8e, 0xa0, 0xd8, 0x19, 0xb5, 0xc6, 0x1d, 0x41, 0x7d, 0x77, 0x84,
+	0x4e, 0x86, 0xbb, 0x41, 0xbf, 0x78, 0xad, 0xe6, 0xc3, 0x5d, 0x90, 0xfd, 0xac, 0x39, 0x13, 0x8a,
+	0x37, 0xf4, 0xf1, 0x98, 0x14, 0xe2, 0x68, 0x9d, 0x5e, 0xc0, 0xf4, 0x96, 0x5c, 0xd0, 0x5f, 0x33,
+	0xe7, 0xdb, 0xd3, 0xa9, 0xf9, 0x86, 0x33, 0x6c, 0x2d, 0x60, 0x22, 0xda, 0x53, 0x5b, 0xc9, 0xc7,
+	0x5d, 0x27, 0xaf, 0xab, 0xfd, 0xc7, 0x81, 0x2c, 0x45, 0xba, 0x6f, 0x05, 0xd6, 0x97, 0x4a, 0xd0,
+	0x8c, 0x23, 0xd9, 0xb3, 0xde, 0xf9, 0xa6, 0x1b, 0x2e, 0x33, 0x07, 0xa3, 0xb7, 0xcb, 0xb9, 0x97,
+	0xf2, 0xd9, 0x10, 0xf9, 0xce, 0x8a, 0x94, 0xa0, 0xb1, 0xac, 0x7d, 0x1c, 0x9a, 0x8a, 0x5a, 0x70,
+	0x28, 0xfb, 0x6e, 0x19, 0xea, 0x32, 0x06, 0x3e, 0x5d, 0x89, 0x4b, 0x50, 0xef, 0x3b, 0xfb, 0xfe,
+	0xae, 0x3a, 0x32, 0x9d, 0x1c, 0x11, 0x56, 0xdf, 0xb9, 0xc9, 0xb9, 0xa9, 0x94, 0x22, 0x6f, 0x40,
+	0xad, 0x8f, 0xc1, 0x61, 0x56, 0x65, 0x84, 0xe5, 0x51, 0xe2, 0xc8, 0x4c, 0x85, 0x0c, 0x16, 0xce,
+	0x43, 0x5f, 0xd5, 0x87, 0x4b, 0x23, 0x0b, 0xbf, 0xc7, 0xb9, 0xa9, 0x94, 0xb2, 0xaf, 0x43, 0x5d,
+	0x54, 0xe7, 0x60, 0x8b, 0x84, 0xd9, 0x92, 0x44, 0xd3, 0x79, 0xdd, 0x0a, 0x76, 0xa5, 0xc7, 0xa0,
+	0x2e, 0x0a, 0x2f, 0xd0, 0x9a, 0xef, 0x3c, 0xc1, 0xcf, 0x3b, 0x7d, 0xfb, 0x66, 0x72, 0xb3, 0xf9,
+	0xfe, 0xef, 0x32, 0xec, 0x3b, 0x70, 0x08, 0x9d, 0xdb, 0x1b, 0x4e, 0xc8, 0x28, 0xeb, 0xfa, 0x41,
+	0x2f, 0x17, 0x35, 0x10, 0x59, 0xd2, 0x43, 0x5d, 0x8c, 0x2a, 0xf9, 0x7e, 0xe4, 0x3a, 0xfc, 0xef,
+	0xe3, 0x3a, 0xfc, 0xdd, 0x6a, 0x81, 0x3f, 0x6f, 0x1c, 0x4f, 0x06, 0x2a, 0x5c, 0xc6, 0xa1, 0x77,
+	0xd1, 0xdc, 0x7b, 0x9f, 0x18, 0x21, 0x69, 0x6c, 0xbe, 0x2f, 0x9a, 0x1e, 0xbd, 0x51, 0xb2, 0x86,
+	0x4b, 0xef, 0x4a, 0xda, 0xa5, 0x77, 0x72, 0x84, 0x74, 0xc6, 0xa7, 0x77, 0xd1, 0xf4, 0xe9, 0x8d,
+	0x2a, 0x5d, 0x77, 0xea, 0xfd, 0x2f, 0x73, 0xa3, 0xfd, 0x42, 0x81, 0xdb, 0xe7, 0x43, 0xa6, 0xdb,
+	0x67, 0x88, 0xd6, 0xfc, 0xb0, 0xfc, 0x3e, 0xbf, 0x58, 0x2f, 0xf0, 0xfb, 0x5c, 0x30, 0xfc, 0x3e,
+	0x43, 0x6a, 0x96, 0x76, 0xfc, 0x5c, 0x34, 0x1d, 0x3f, 0x27, 0x46, 0x48, 0x1a, 0x9e, 0x9f, 0x0b,
+	0x86, 0xe7, 0x67, 0x54, 0xa1, 0x9a, 0xeb, 0xe7, 0x82, 0xe1, 0xfa, 0x19, 0x25, 0xa8, 0xf9, 0x7e,
+	0x2e, 0x18, 0xbe, 0x9f, 0x51, 0x82, 0x9a, 0xf3, 0xe7, 0x82, 0xe1, 0xfc, 0x19, 0x25, 0xa8, 0x79,
+	0x7f, 0x2e, 0x9a, 0xde, 0x9f, 0xd1, 0xfd, 0xa3, 0x0d, 0xfa, 0x8f, 0x1c, 0x35, 0xff, 0x89, 0x8e,
+	0x9a, 0xaf, 0x57, 0x0a, 0x1c, 0x30, 0x34, 0xdf, 0x01, 0x73, 0xba, 0x78, 0x24, 0x47, 0x7b, 0x60,
+	0xc6, 0x5f, 0x05, 0xb2, 0x2e, 0x98, 0xb7, 0x52, 0x2e, 0x98, 0xe7, 0x46, 0x08, 0x9b, 0x3e, 0x98,
+	0xff, 0x31, 0x4e, 0x86, 0xdf, 0xaa, 0x0f, 0x39, 0x4f, 0xbf, 0xae, 0x9f, 0xa7, 0x87, 0xac, 0x64,
+	0xd9, 0x03, 0xf5, 0x25, 0xf3, 0x40, 0x7d, 0x6a, 0x0c, 0x59, 0xe3, 0x44, 0xbd, 0x96, 0x77, 0xa2,
+	0xee, 0x8c, 0x81, 0x52, 0x78, 0xa4, 0xbe, 0x9e, 0x3d, 0x52, 0x9f, 0x1e, 0x03, 0x2f, 0xf7, 0x4c,
+	0xbd, 0x96, 0x77, 0xa6, 0x1e, 0xa7, 0x76, 0x85, 0x87, 0xea, 0x37, 0x8c, 0x43, 0xf5, 0xf3, 0xe3,
+	0x74, 0x57, 0xb2, 0x38, 0x7c, 0xb4, 0xe0, 0x54, 0xfd, 0xea, 0x38, 0x30, 0xc3, 0x9d, 0xd8, 0x3f,
+	0x3a, 0x17, 0x9b, 0xc5, 0x7c, 0xff, 0x69, 0x68, 0xaa, 0x40, 0x1b, 0xfb, 0x53, 0xd0, 0x50, 0x1f,
+	0x3e, 0xe7, 0xc4, 0x29, 0xcb, 0x43, 0x9d, 0xd8, 0x3d, 0xcb, 0x14, 0xb9, 0x04, 0x55, 0xfc, 0x25,
+	0xa7, 0xc5, 0x8b, 0xe3, 0x05, 0xf4, 0x60, 0x21, 0x94, 0xcb, 0xd9, 0x7f, 0xf8, 0x18, 0x80, 0xf6,
+	0x3d, 0xe8, 0xb8, 0xc5, 0xbe, 0x8d, 0xc6, 0xac, 0x1f, 0xb1, 0x80, 0x07, 0x72, 0x8d, 0xfc, 0x5e,
+	0x32, 0x29, 0x01, 0xb5, 0x25, 0x62, 0x01, 0x95, 0xe2, 0xe4, 0x16, 0x46, 0x1f, 0xca, 0x4f, 0xf9,
+	0xab, 0xc7, 0x2b, 0x85, 0x4a, 0x96, 0x07, 0xa5, 0x5c, 0x7b, 0x34, 0x86, 0x20, 0xf3, 0x50, 0x0d,
+	0xfd, 0x20, 0x92, 0xd1, 0xe1, 0x2f, 0x8f, 0x0d, 0xb5, 0xee, 0x07, 0x11, 0xe5, 0xa2, 0xa2, 0x69,
+	0xda, 0x73, 0x1b, 0x07, 0x69, 0x9a, 0x61, 0xb1, 0xff, 0xa0, 0x1a, 0xdb, 0xd0, 0x45, 0x39, 0x1b,
+	0x85, 0x0e, 0x9d, 0x19, 0x7f, 0x94, 0xf4, 0x59, 0xa9, 0xa2, 0x23, 0xcb, 0x5a, 0x74, 0xe4, 0x8b,
+	0xd0, 0xee, 0xfa, 0x7b, 0x2c, 0xa0, 0x49, 0x88, 0x93, 0x8c, 0x42, 0xcb, 0xd0, 0x31, 0x9c, 0x67,
+	0xdb, 0xed, 0xb1, 0x95, 0xae, 0xb4, 0x7f, 0x4d, 0x1a, 0xa7, 0xc9, 0x0d, 0x68, 0x72, 0x1f, 0xbb,
+	0xf2, 0xf0, 0x1f, 0xac, 0x92, 0xc2, 0xd5, 0xaf, 0x00, 0xb0, 0x20, 0x5e, 0xf8, 0x35, 0x57, 0x44,
+	0x8d, 0x36, 0x69, 0x9c, 0xc6, 0x0a, 0xf3, 0x38, 0x32, 0xbd, 0xc2, 0x0d, 0x51, 0xe1, 0x34, 0x9d,
+	0x9c, 0x84, 0x19, 0xe6, 0xf5, 0x74, 0xce, 0x36, 0xe7, 0x4c, 0x51, 0xc9, 0x39, 0x78, 0x8c, 0xcb,
+	0xa6, 0x8e, 0xa2, 0xc2, 0xa5, 0xdf, 0xa4, 0xf9, 0x99, 0x3c, 0xbe, 0xce, 0xd9, 0x12, 0x1f, 0xe1,
+	0x71, 0x27, 0x5f, 0x8d, 0x26, 0x04, 0x0c, 0x3b, 0xed, 0xb1, 0x4d, 0x67, 0xb7, 0x1f, 0xdd, 0x61,
+	0x3b, 0x83, 0xbe, 0x13, 0x61, 0x1c, 0x35, 0xf0, 0xe2, 0xb3, 0x19, 0xe4, 0x15, 0x78, 0x44, 0x12,
+	0xc5, 0x74, 0xc7, 0x51, 0x5b, 0xe9, 0xf1, 0x87, 0x32, 0x5a, 0x34, 0x2f, 0x0b, 0x8f, 0xf0, 0x0f,
+	0x02, 0x67, 0x20, 0xfb, 0x93, 0x3f, 0x86, 0xd1, 0xa4, 0x3a, 0xc9, 0xfe, 0x0e, 0x57, 0x1f, 0x3e,
+	0x49, 0xde, 0x86, 0x8a, 0xd3, 0xeb, 0xc9, 0x05, 0xf8, 0xec, 0x01, 0xa7, 0x9a, 0xfc, 0x36, 0x0b,
+	0x11, 0xc8, 0x5a, 0x1c, 0xbc, 0x27, 0x96, 0xe0, 0xf3, 0x07, 0xc5, 0x8a, 0x9f, 0x34, 0x92, 0x38,
+	0x88, 0xb8, 0xcb, 0x39, 0xac, 0xca, 0x0f, 0x86, 0x18, 0x7f, 0xab, 0x22, 0x71, 0xc8, 0x75, 0xa8,
+	0xf2, 0x1a, 0x8a, 0x25, 0xfa, 0xdc, 0x41, 0xf1, 0x6e, 0x89, 0xfa, 0x71, 0x0c, 0xbb, 0x2b, 0xa2,
+	0xe8, 0xb4, 0xd0, 0xcd, 0x92, 0x19, 0xba, 0xb9, 0x00, 0x35, 0x37, 0x62, 0x3b, 0xd9, 0x48, 0xde,
+	0xa1, 0x4a, 0x2f, 0x6d, 0x98, 0x10, 0x1d, 0x1a, 0x51, 0xf8, 0x6e, 0xe1, 0x67, 0x24, 0x57, 0xa0,
+	0x8a, 0xe2, 0x99, 0x5d, 0xe9, 0x38, 0x05, 0x73, 0x49, 0x7b, 0x0e, 0xaa, 0xd8, 0xd8, 0x21, 0xad,
+	0x93, 0xf5, 0x29, 0xc7, 0xf5, 0x59, 0x98, 0x84, 0x96, 0x3f, 0x60, 0x01, 0x9f, 0x3a, 0xf6, 0x3f,
+	0x55, 0xb5, 0xf0, 0xba, 0x15, 0x5d, 0xc7, 0x5e, 0x3b, 0xb0, 0x0d, 0xd6, 0xb5, 0x8c, 0xa6, 0xb4,
+	0xec, 0xf5, 0x83, 0xa3, 0x65, 0xf4, 0x8c, 0xa6, 0xf4, 0xec, 0x07, 0xc0, 0xcc, 0x68, 0xda, 0x4d,
+	0x43, 0xd3, 0xce, 0x1f, 0x1c, 0xd1, 0xd0, 0x35, 0x36, 0x4a, 0xd7, 0x96, 0x4c, 0x5d, 0xeb, 0x8c,
+	0x37, 0xe4, 0xf1, 0x22, 0x37, 0x86, 0xb6, 0x7d, 0xbc, 0x50, 0xdb, 0x16, 0x0c, 0x6d, 0x3b, 0x68,
+	0xd1, 0x1f, 0x90, 0xbe, 0xfd, 0x4d, 0x15, 0xaa, 0xb8, 0xd0, 0x92, 0xab, 0xba, 0xae, 0xbd, 0x7a,
+	0xa0, 0x45, 0x5a, 0xd7, 0xb3, 0xd5, 0x94, 0x9e, 0x9d, 0x3b, 0x18, 0x52, 0x46, 0xc7, 0x56, 0x53,
+	0x3a, 0x76, 0x40, 0xbc, 0x8c, 0x7e, 0x2d, 0x1b, 0xfa, 0x35, 0x77, 0x30, 0x34, 0x43, 0xb7, 0x9c,
+	0x51, 0xba, 0x75, 0xc5, 0xd4, 0xad, 0x31, 0xf7, 0x81, 0x58, 0xd0, 0x38, 0x7a, 0xf5, 0x4e, 0xa1,
+	0x5e, 0x5d, 0x32, 0xf4, 0xea, 0x20, 0xc5, 0x7e, 0x40, 0x3a, 0x75, 0x4e, 0x6c, 0x5f, 0x8b, 0xbf,
+	0xee, 0xcb, 0xdb, 0xbe, 0xda, 0xaf, 0x41, 0x2b, 0x79, 0x9a, 0x27, 0x27, 0xd0, 0x5f, 0xb0, 0xa9,
+	0x52, 0x55, 0xd2, 0x3e, 0x0b, 0xad, 0xe4, 0xb9, 0x9d, 0x9c, 0xb2, 0x42, 0x9e, 0x19, 0x7f, 0xf0,
+	0xc5, 0x53, 0xf6, 0x55, 0x38, 0x9c, 0x7d, 0x0c, 0x24, 0xc7, 0xa3, 0xaf, 0x45, 0xa9, 0xab, 0x6f,
+	0x63, 0x34, 0x92, 0xfd, 0x00, 0x66, 0x52, 0xcf, 0x7b, 0x1c, 0x18, 0x83, 0x9c, 0xd5, 0x36, 0xdb,
+	0x95, 0xd4, 0xc7, 0xdc, 0x66, 0xdc, 0x7d, 0xb2, 0xa5, 0xb6, 0x97, 0x60, 0x66, 0x44, 0xe5, 0xc7,
+	0x09, 0xbb, 0xff, 0x04, 0x4c, 0x0e, 0xab, 0xfb, 0x07, 0xf0, 0x59, 0x40, 0x04, 0xed, 0xcc, 0xd3,
+	0x44, 0xe9, 0x62, 0xd6, 0x00, 0xb6, 0x62, 0x1e, 0xab, 0x9c, 0xba, 0x2a, 0x1e, 0xfd, 0x11, 0x04,
+	0x97, 0xa3, 0x1a, 0x86, 0xfd, 0xeb, 0x25, 0x38, 0x9c, 0x7d, 0x97, 0x68, 0xdc, 0x63, 0x94, 0x05,
+	0x0d, 0x8e, 0x15, 0x7f, 0x3b, 0xa2, 0x92, 0xe4, 0x16, 0x4c, 0x85, 0x7d, 0xb7, 0xcb, 0x16, 0xb7,
+	0x31, 0x20, 0x3e, 0x94, 0x67, 0xa3, 0x11, 0x6f, 0x0b, 0xad, 0x27, 0x12, 0xd4, 0x10, 0xb7, 0x1f,
+	0xc0, 0xa4, 0x96, 0x49, 0xde, 0x84, 0xb2, 0x3f, 0xc8, 0x44, 0x48, 0x16, 0x63, 0xde, 0x56, 0xf3,
+	0x8d, 0x96, 0xfd, 0x41, 0x76, 0x4a, 0xea, 0xd3, 0xb7, 0x62, 0x4c, 0x5f, 0xfb, 0x06, 0x1c, 0xce,
+	0x3e, 0xfd, 0x93, 0xee, 0x9e, 0x93, 0x19, 0x7f, 0x83, 0xe8, 0xa6, 0x14, 0xd5, 0xbe, 0x00, 0x87,
+	0xd2, 0x0f, 0xfa, 0xe4, 0x7c, 0xd7, 0x93, 0x7c, 0x1e, 0xa5, 0x1c, 0xff, 0xb3, 0x5f, 0x2b, 0xc1,
+	0x8c, 0xd9, 0x10, 0xf2, 0x38, 0x10, 0x93, 0xb2, 0xea, 0x7b, 0xac, 0x3d, 0x41, 0x1e, 0x83, 0xc3,
+	0x26, 0x7d, 0xbe, 0xd7, 0x6b, 0x97, 0xb2, 0xec, 0x68, 0xb6, 0xda, 0x65, 0x62, 0xc1, 0xa3, 0xa9,
+	0x1e, 0xe2, 0x46, 0xb4, 0x5d, 0x21, 0x4f, 0xc0, 0x63, 0xe9, 0x9c, 0x41, 0xdf, 0xe9, 0xb2, 0x76,
+	0xd5, 0xfe, 0x97, 0x32, 0x54, 0xf1, 0x0d, 0x1a, 0xfb, 0x1f, 0xca, 0xea, 0x7b, 0x8f, 0xd7, 0xa1,
+	0xca, 0xdf, 0xda, 0xd1, 0x3e, 0xfa, 0x2c, 0xa5, 0x3e, 0xfa, 0x34, 0x3e, 0x1c, 0x4c, 0x3e, 0xfa,
+	0x7c, 0x1d, 0xaa, 0xfc, 0x75, 0x9d, 0x83, 0x4b, 0x7e, 0xb1, 0x04, 0xad, 0xe4, 0xa5, 0x9b, 0x03,
+	0xcb, 0xeb, 0xdf, 0x97, 0x94, 0xcd, 0xef, 0x4b, 0x5e, 0x84, 0x5a, 0x80, 0xa0, 0xd2, 0xca, 0xa4,
+	0xbf, 0x5a, 0xe1, 0x05, 0x52, 0xc1, 0x62, 0x33, 0x98, 0xd4, 0xdf, 0xf1, 0x39, 0x78, 0x35, 0x4e,
+	0xc8, 0x47, 0xfc, 0x56, 0x7a, 0xe1, 0x7c, 0x10, 0x38, 0xfb, 0x52, 0x31, 0x4d, 0x22, 0x7a, 0x91,
+	0xf1, 0xb5, 0x9e, 0xfc, 0x6f, 0x6d, 0xed, 0xdf, 0x2b, 0x41, 0x43, 0x86, 0x01, 0xdb, 0x17, 0xa0,
+	0x82, 0x0f, 0xf2, 0xbc, 0x02, 0x0d, 0x19, 0x80, 0x9c, 0xa9, 0xc8, 0x2d, 0xde, 0x0a, 0xc9, 0x4f,
+	0x15, 0x9b, 0x7d, 0x31, 0x5e, 0x26, 0x0f, 0x2e, 0xfb, 0x3a, 0x54, 0xf9, 0xf3, 0x3b, 0x07, 0x97,
+	0xfc, 0xfd, 0x26, 0xd4, 0xc5, 0x07, 0xab, 0xf6, 0x6f, 0x37, 0xa1, 0x2e, 0x9e, 0xe4, 0x21, 0x97,
+	0xa0, 0x11, 0xee, 0xee, 0xec, 0x38, 0xc1, 0xbe, 0x55, 0xf0, 0x64, 0x80, 0xfe, 0x82, 0x4f, 0x67,
+	0x5d, 0xf0, 0x52, 0x25, 0x44, 0x5e, 0x83, 0x6a, 0xd7, 0xd9, 0x64, 0x99, 0x8b, 0xe1, 0x3c, 0xe1,
+	0x45, 0x67, 0x93, 0x51, 0xce, 0x4e, 0xae, 0x40, 0x53, 0x0e, 0x4b, 0x28, 0x3d, 0x43, 0xc3, 0xcb,
+	0x55, 0x83, 0x19, 0x4b, 0xd9, 0xd7, 0xa1, 0x21, 0x2b, 0x43, 0x2e, 0xc7, 0x9f, 0xeb, 0xa6, 0x7d,
+	0xd8, 0xb9, 0x4d, 0x88, 0x3f, 0x00, 0x8f, 0x3f, 0xdc, 0xfd, 0xd3, 0x32, 0x54, 0xb1, 0x72, 0xef,
+	0x1b, 0x89, 0x1c, 0x03, 0xe8, 0x3b, 0x61, 0xb4, 0xb6, 0xdb, 0xef, 0xcb, 0x4f, 0xc8, 0x2b, 0x54,
+	0xa3, 0xe0, 0x2d, 0xb7, 0x48, 0x85, 0xdb, 0xeb, 0xbb, 0xdd, 0x2e, 0x8b, 0xbf, 0x51, 0x4d, 0x93,
+	0x31, 0xfe, 0x85, 0x3f, 0x12, 0x2b, 0x77, 0x85, 0x2f, 0x8d, 0xec, 0x59, 0x7c, 0x64, 0x4a, 0xd6,
+	0x46, 0x48, 0xda, 0x3e, 0xb4, 0x62, 0x1a, 0x4e, 0xc2, 0x81, 0xeb, 0x79, 0xf8, 0x46, 0x95, 0xd0,
+	0x68, 0x95, 0xc4, 0x45, 0x07, 0x7f, 0xca, 0xfa, 0xd6, 0xa8, 0x4c, 0x21, 0x7d, 0xd3, 0x71, 0xfb,
+	0xb2, 0x8a, 0x35, 0x2a, 0x53, 0x88, 0xb4, 0x2b, 0x1f, 0x32, 0xaa, 0xf2, 0x06, 0xaa, 0xa4, 0xfd,
+	0x5e, 0x29, 0xfe, 0x66, 0x3d, 0xef, 0x33, 0xcf, 0x8c, 0x57, 0xea, 0xa8, 0xee, 0x1a, 0x17, 0x0b,
+	0x42, 0x42, 0xc0, 0xf2, 0x7d, 0xaf, 0xef, 0x7a, 0x4c, 0x7a, 0xa1, 0x64, 0x2a, 0xd5, 0xc7, 0xb5,
+	0x4c, 0x1f, 0xcb, 0xfc, 0xab, 0x3d, 0x17, 0xab, 0x58, 0x4f, 0xf2, 0x05, 0x85, 0xbc, 0x85, 0x81,
+	0x20, 0x7b, 0x6e, 0x97, 0xe1, 0xc3, 0xb6, 0x95, 0x9c, 0xeb, 0x3e, 0xb3, 0x6f, 0x97, 0x38, 0x2f,
+	0x55, 0x32, 0x76, 0x84, 0xdf, 0xbd, 0xe1, 0xcf, 0xb8, 0x49, 0x25, 0xad, 0x49, 0x49, 0xa5, 0xcb,
+	0x43, 0x2a, 0x5d, 0x19, 0x51, 0xe9, 0x6a, 0xba, 0xd2, 0xb3, 0x9f, 0x05, 0x48, 0xd4, 0x8d, 0x4c,
+	0x42, 0xe3, 0xae, 0x77, 0xdf, 0xf3, 0x1f, 0x78, 0xed, 0x09, 0x4c, 0xdc, 0xde, 0xdc, 0xc4, 0x52,
+	0xda, 0x25, 0x4c, 0x20, 0x9f, 0xeb, 0x6d, 0xb5, 0xcb, 0x04, 0xa0, 0xbe, 0xce, 0x1f, 0x2f, 0x68,
+	0x57, 0xf0, 0xf7, 0x35, 0x3e, 0x7e, 0xed, 0x2a, 0x39, 0x02, 0x8f, 0xac, 0x78, 0x5d, 0x7f, 0x67,
+	0xe0, 0x44, 0xee, 0x46, 0x1f, 0xbf, 0x8a, 0x0e, 0x5d, 0xdf, 0x6b, 0xd7, 0x70, 0xf5, 0x5a, 0x65,
+	0xd1, 0x03, 0x3f, 0xb8, 0xbf, 0xca, 0x58, 0x4f, 0xbe, 0x31, 0xd2, 0xae, 0xdb, 0xff, 0x5e, 0x12,
+	0xf7, 0xca, 0xf6, 0x15, 0x98, 0x32, 0x5e, 0xdc, 0xb2, 0x92, 0xf7, 0xff, 0x53, 0xcf, 0xff, 0x3f,
+	0xce, 0x3d, 0xbf, 0x2c, 0xd9, 0xca, 0x88, 0x94, 0x7d, 0x0d, 0x40, 0x7b, 0x67, 0xeb, 0x18, 0xc0,
+	0xc6, 0x7e, 0xc4, 0x42, 0x9e, 0xe2, 0x10, 0x55, 0xaa, 0x51, 0x74, 0xfc, 0xb2, 0x81, 0x6f, 0x9f,
+	0x07, 0xd0, 0x5e, 0xd9, 0xc2, 0x79, 0x85, 0xa9, 0x85, 0x34, 0x58, 0x9a, 0x6c, 0x77, 0x64, 0x0b,
+	0xd4, 0x7b, 0x5a, 0xaa, 0x06, 0x9c, 0x68, 0xd4, 0x80, 0x53, 0xec, 0xaf, 0x97, 0x00, 0x92, 0xe7,
+	0x59, 0xf0, 0xbe, 0x4b, 0xda, 0xee, 0x97, 0xa1, 0xda, 0x73, 0x22, 0x47, 0x9a, 0xcd, 0x27, 0x52,
+	0x4b, 0x57, 0x22, 0x42, 0x39, 0x9b, 0x7d, 0x0d, 0x26, 0xf5, 0x07, 0xa2, 0x2e, 0xe0, 0x3d, 0x15,
+	0x0b, 0xd4, 0xf7, 0x9a, 0xcf, 0x14, 0x8a, 0xa3, 0x10, 0x6e, 0xb2, 0xa8, 0xe0, 0xb7, 0x7f, 0xb5,
+	0x04, 0x53, 0xfa, 0x73, 0x31, 0xf6, 0xa5, 0xb8, 0x46, 0xe7, 0x8c, 0x1a, 0x1d, 0x2f, 0x84, 0xbc,
+	0x37, 0xc7, 0xb7, 0x6d, 0xb2, 0x62, 0x1f, 0x86, 0x99, 0xd4, 0x9b, 0x32, 0x97, 0xa1, 0x39, 0x90,
+	0x14, 0xab, 0x94, 0x9a, 0x21, 0x39, 0x58, 0x52, 0x9a, 0xc6, 0x42, 0xf6, 0xaf, 0x95, 0x60, 0x4a,
+	0x7f, 0x2b, 0xcc, 0x7e, 0x1b, 0xaa, 0xfc, 0xb1, 0xb1, 0xcb, 0x30, 0xa5, 0x3f, 0x16, 0x96, 0xf9,
+	0xa3, 0x10, 0x02, 0x5d, 0x17, 0xa5, 0x86, 0x00, 0xc6, 0x4e, 0xc5, 0x95, 0x7c, 0x9f, 0x50, 0xaf,
+	0x40, 0x43, 0xbe, 0x3d, 0x66, 0x3f, 0x07, 0xad, 0xe4, 0xa9, 0x31, 0x34, 0x94, 0x82, 0xae, 0x54,
+	0x5a, 0x26, 0xed, 0x6f, 0x37, 0xa1, 0xc6, 0x75, 0xd7, 0xfe, 0x62, 0x15, 0x9a, 0xea, 0xb5, 0x1c,
+	0xfb, 0x5f, 0xf1, 0x6f, 0x77, 0x78, 0x51, 0xb0, 0x7f, 0x80, 0x77, 0x71, 0xd4, 0x77, 0x38, 0x95,
+	0xd4, 0x97, 0x88, 0xb9, 0xcf, 0xf4, 0x68, 0xbe, 0xfd, 0x13, 0x30, 0x3d, 0x70, 0x82, 0xc8, 0xed,
+	0xba, 0x03, 0xc7, 0x8b, 0xe2, 0xcf, 0xba, 0x4d, 0x22, 0xda, 0x55, 0x5f, 0xee, 0x85, 0xc5, 0x03,
+	0x38, 0x2d, 0x9a, 0x10, 0xd0, 0x6c, 0x45, 0xae, 0x0c, 0x92, 0xa8, 0x50, 0xfe, 0x1b, 0x5d, 0xf1,
+	0x68, 0x6c, 0xee, 0x20, 0xbd, 0xc1, 0xe9, 0x71, 0x1a, 0x77, 0x42, 0x62, 0x9f, 0xd5, 0x14, 0x3b,
+	0x21, 0x9e, 0xb0, 0x7f, 0xa9, 0x14, 0x0f, 0xc5, 0x50, 0x23, 0x90, 0xf7, 0xb8, 0x09, 0x9a, 0x60,
+	0xe6, 0x45, 0x81, 0xcb, 0xd4, 0xea, 0xff, 0xec, 0xf0, 0x1e, 0xe0, 0xdd, 0x4b, 0x95, 0x0c, 0x9e,
+	0x60, 0x77, 0x3d, 0x34, 0xce, 0xe2, 0xa3, 0xf7, 0x2a, 0xaf, 0x97, 0x4e, 0x9a, 0xed, 0xca, 0x5b,
+	0xb9, 0xc3, 0x30, 0x2d, 0x4e, 0x05, 0x8b, 0x01, 0xc3, 0xe9, 0xde, 0x9e, 0x20, 0x6d, 0x98, 0x12,
+	0x24, 0x61, 0x59, 0xdb, 0x25, 0x42, 0x60, 0x46, 0x50, 0xe6, 0x83, 0xee, 0xb6, 0xbb, 0xc7, 0x7a,
+	0xed, 0x32, 0x72, 0x09, 0x25, 0xc7, 0xdd, 0x36, 0x37, 0xa0, 0x6d, 0x98, 0x5a, 0xf4, 0x77, 0x76,
+	0x98, 0x87, 0x2f, 0x97, 0xa1, 0x19, 0xb5, 0xff, 0xb1, 0xac, 0x1b, 0x65, 0xfb, 0x5b, 0xe5, 0x42,
+	0xf7, 0xc7, 0x59, 0xe3, 0x41, 0x91, 0x99, 0xb9, 0x27, 0x73, 0x9b, 0x9b, 0xda, 0x4b, 0x9c, 0x87,
+	0x86, 0x27, 0x8c, 0xb1, 0x54, 0x93, 0xa3, 0xb9, 0x52, 0xd2, 0x60, 0x53, 0xc5, 0x4c, 0xce, 0x41,
+	0x8d, 0x05, 0x81, 0x1f, 0xf0, 0x7e, 0x99, 0x99, 0x3b, 0x96, 0x2b, 0x85, 0xf5, 0xbe, 0x8a, 0x5c,
+	0x54, 0x30, 0xe3, 0xe5, 0x48, 0x28, 0x16, 0x0e, 0xd1, 0x17, 0xa1, 0x7c, 0x94, 0x40, 0x2e, 0xb0,
+	0xf9, 0x99, 0x28, 0xe5, 0xf9, 0x91, 0x58, 0x64, 0xf8, 0x27, 0xe5, 0x4a, 0x4a, 0x28, 0x57, 0x7e,
+	0x26, 0x4a, 0xed, 0xf2, 0x4f, 0xcf, 0x5d, 0x6f, 0xcb, 0x90, 0x12, 0xaa, 0x97, 0x9f, 0x39, 0xfb,
+	0x61, 0xb5, 0x7f, 0xd5, 0xd6, 0xb5, 0x09, 0x7d, 0xc1, 0x2b, 0x91, 0x16, 0xd4, 0x78, 0xa3, 0xda,
+	0x65, 0x7d, 0x55, 0xac, 0x14, 0xac, 0x6b, 0xd5, 0xd9, 0xb3, 0xd0, 0x90, 0x74, 0xe4, 0x9f, 0x17,
+	0xfd, 0xd4, 0x9e, 0x20, 0x53, 0xd0, 0x5c, 0x67, 0xfd, 0xcd, 0x65, 0x3f, 0x8c, 0xda, 0x25, 0x32,
+	0x0d, 0x2d, 0xbe, 0xd4, 0xe0, 0x53, 0x27, 0xed, 0xf2, 0xec, 0x3b, 0xd0, 0x8a, 0x7b, 0x8f, 0x34,
+	0xa1, 0xba, 0xba, 0xdb, 0xef, 0xb7, 0x27, 0xf8, 0xc9, 0x2f, 0xf2, 0x03, 0x75, 0x33, 0x74, 0xf5,
+	0x21, 0x6e, 0xe3, 0xda, 0xa5, 0xa2, 0xc5, 0x96, 0xab, 0x98, 0x2c, 0x5c, 0xd4, 0xb9, 0x62, 0x7f,
+	0xab, 0x04, 0xad, 0xf8, 0xc1, 0x3e, 0x3c, 0x76, 0x8d, 0x9e, 0x61, 0x17, 0x52, 0x9a, 0x55, 0xfc,
+	0xfe, 0x5f, 0x4a, 0xbb, 0x4e, 0xc2, 0x8c, 0xdc, 0xd1, 0xa8, 0xce, 0x17, 0x9b, 0x92, 0x14, 0x75,
+	0xf6, 0x7a, 0xdc, 0xeb, 0x6d, 0x6e, 0xd4, 0x17, 0x7d, 0xcf, 0x63, 0x5d, 0x31, 0x95, 0x0e, 0xc1,
+	0xe4, 0xaa, 0x1f, 0xad, 0xf9, 0x61, 0x88, 0x2d, 0x13, 0x3d, 0x95, 0xe4, 0x97, 0xc9, 0x0c, 0x80,
+	0x0a, 0x0a, 0xc5, 0x29, 0x64, 0xff, 0x4a, 0x09, 0xea, 0xe2, 0x19, 0x41, 0xfb, 0xe7, 0x4a, 0x50,
+	0x97, 0x4f, 0x07, 0xbe, 0x08, 0xed, 0xc0, 0xf7, 0xa3, 0xe4, 0xbc, 0xbe, 0xb2, 0x24, 0x5b, 0x99,
+	0xa1, 0xa3, 0x0b, 0xc9, 0xd7, 0x34, 0x50, 0xee, 0xb0, 0x0d, 0x1a, 0xb9, 0x08, 0x20, 0x9e, 0x26,
+	0xbc, 0x93, 0x58, 0xd8, 0x74, 0x2c, 0xa8, 0xa8, 0x85, 0xb0, 0xac, 0x1a, 0xf7, 0xec, 0x67, 0x60,
+	0x9a, 0xb2, 0x70, 0xe0, 0x7b, 0x21, 0xfb, 0x61, 0xfd, 0x39, 0xa6, 0xc2, 0x3f, 0xac, 0x34, 0xfb,
+	0xcb, 0x2d, 0xa8, 0xf1, 0xc3, 0x9b, 0xfd, 0x8d, 0x56, 0x7c, 0xcc, 0xcc, 0xd8, 0x92, 0x39, 0x3d,
+	0x22, 0x4f, 0x37, 0x0a, 0xc6, 0xb9, 0xcf, 0x8c, 0xc4, 0x7b, 0x83, 0xaf, 0xe8, 0x5b, 0x01, 0x1e,
+	0x17, 0xab, 0xa9, 0x17, 0xf3, 0x4c, 0xb1, 0x35, 0xc9, 0x46, 0x63, 0x01, 0x5d, 0xf9, 0x6a, 0xa6,
+	0xf2, 0x5d, 0x81, 0x56, 0x2f, 0xf0, 0x07, 0x7c, 0x96, 0x5a, 0xf5, 0xd4, 0xae, 0xc3, 0xc4, 0x5d,
+	0x52, 0x7c, 0xf8, 0xb7, 0x2b, 0x62, 0x21, 0x54, 0x5f, 0xd1, 0xfb, 0x56, 0x23, 0xf5, 0xd2, 0x92,
+	0x29, 0x2e, 0xc6, 0x0b, 0x7d, 0xe6, 0x82, 0x1d, 0x05, 0xd9, 0x43, 0x2e, 0xd8, 0x1c, 0x2a, 0x78,
+	0xf5, 0xa1, 0x12, 0x14, 0xec, 0xe4, 0x2d, 0x68, 0x86, 0xce, 0x1e, 0xc3, 0xe2, 0xad, 0xd6, 0xd0,
+	0xae, 0x58, 0x97, 0x6c, 0xf8, 0x37, 0x43, 0x94, 0x08, 0x36, 0x79, 0xc7, 0xdd, 0x12, 0x8e, 0x1a,
+	0x0b, 0x86, 0x36, 0xf9, 0x96, 0xe2, 0xc3, 0x26, 0xc7, 0x42, 0xe4, 0x1a, 0x3e, 0x6f, 0xc4, 0xd0,
+	0xc0, 0xf1, 0x3a, 0x4c, 0xa5, 0x3e, 0x37, 0x4d, 0x0f, 0x47, 0xcc, 0x29, 0x1e, 0xe0, 0x8d, 0x93,
+	0xe4, 0x36, 0xcc, 0x08, 0xb5, 0xbf, 0x13, 0x38, 0x5e, 0xb8, 0xc9, 0x02, 0x6b, 0x3a, 0x15, 0x2f,
+	0x66, 0x42, 0xdd, 0x36, 0x98, 0xf1, 0xa5, 0x5a, 0x53, 0x1c, 0x01, 0x9d, 0xdd, 0x9e, 0x1b, 0xdd,
+	0xf4, 0xb7, 0x44, 0xaf, 0x59, 0x33, 0x43, 0x01, 0xe7, 0x0d, 0x66, 0x04, 0x34, 0xc5, 0xb1, 0xa5,
+	0x5c, 0x53, 0x28, 0x1b, 0x38, 0x6e, 0x60, 0x1d, 0x1a, 0xda, 0xd2, 0xf5, 0x84, 0x13, 0x5b, 0xaa,
+	0x09, 0xc6, 0x38, 0xfc, 0xf5, 0xa6, 0x7d, 0xab, 0x3d, 0x1a, 0x47, 0x70, 0xc6, 0x38, 0x22, 0x89,
+	0x1b, 0x19, 0xb1, 0x30, 0x4e, 0x8a, 0xc8, 0x1a, 0x9e, 0xb0, 0x27, 0xa1, 0x15, 0x2b, 0xa7, 0xdd,
+	0x8c, 0x0d, 0x54, 0x13, 0xea, 0xa2, 0x19, 0x36, 0x40, 0x53, 0xa9, 0x02, 0x32, 0xc7, 0xc3, 0x6a,
+	0x4f, 0xc3, 0xa4, 0x36, 0x3e, 0x76, 0x5b, 0x6d, 0x23, 0x54, 0x8f, 0x22, 0xc5, 0xec, 0x24, 0x14,
+	0xd1, 0x1a, 0x1a, 0x27, 0x45, 0x05, 0xed, 0x55, 0x68, 0xaa, 0xf9, 0x57, 0xf0, 0x14, 0x10, 0x81,
+	0x6a, 0xcf, 0x97, 0x87, 0xcb, 0x0a, 0xe5, 0xbf, 0x71, 0x7e, 0xea, 0xef, 0x27, 0xb6, 0xe2, 0xc7,
+	0x04, 0x67, 0xe7, 0x55, 0x8c, 0x28, 0xae, 0x52, 0xc2, 0x6d, 0x39, 0x09, 0x0d, 0xba, 0xcb, 0xcf,
+	0xfd, 0xed, 0x12, 0x69, 0x0a, 0x67, 0x52, 0xbb, 0x8c, 0x0b, 0xde, 0xa2, 0xe3, 0x75, 0x59, 0x9f,
+	0x6f, 0x75, 0xe2, 0x65, 0xb4, 0xba, 0xd0, 0x8a, 0xc1, 0x17, 0x8e, 0xfe, 0xd9, 0x7b, 0xc7, 0x4a,
+	0xdf, 0x7c, 0xef, 0x58, 0xe9, 0xbb, 0xef, 0x1d, 0x2b, 0xfd, 0xec, 0xf7, 0x8e, 0x4d, 0x7c, 0xf3,
+	0x7b, 0xc7, 0x26, 0xfe, 0xee, 0x7b, 0xc7, 0x26, 0xde, 0x2d, 0x0f, 0x36, 0x36, 0xea, 0x3c, 0xce,
+	0xef, 0xec, 0x7f, 0x0c, 0x00, 0x2d, 0x4e, 0xd3, 0xdd, 0x30, 0x6f, 0x00, 0x00,
 }
 
 func (m *Event) Marshal() (dAtA []byte, err error) {
@@ -15241,6 +15740,29 @@ func (m *EventMessageValueOfMembershipV2ProductsUpdate) MarshalToSizedBuffer(dAt
 	}
 	return len(dAtA) - i, nil
 }
+func (m *EventMessageValueOfSpaceActivityUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessageValueOfSpaceActivityUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SpaceActivityUpdate != nil {
+		{
+			size, err := m.SpaceActivityUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x8
+		i--
+		dAtA[i] = 0xe2
+	}
+	return len(dAtA) - i, nil
+}
 func (m *EventMessageValueOfAccountDetails) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
@@ -16038,6 +16560,30 @@ func (m *EventAccountLinkChallenge) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.ExpireAt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExpireAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ReadOnly {
+		i--
+		if m.ReadOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SpaceIds) > 0 {
+		for iNdEx := len(m.SpaceIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SpaceIds[iNdEx])
+			copy(dAtA[i:], m.SpaceIds[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.SpaceIds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Scope != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.Scope))
 		i--
@@ -17019,20 +17565,20 @@ func (m *EventBlockMarksInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.MarksInRange) > 0 {
-		dAtA100 := make([]byte, len(m.MarksInRange)*10)
-		var j99 int
+		dAtA101 := make([]byte, len(m.MarksInRange)*10)
+		var j100 int
 		for _, num := range m.MarksInRange {
 			for num >= 1<<7 {
-				dAtA100[j99] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA101[j100] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j99++
+				j100++
 			}
-			dAtA100[j99] = uint8(num)
-			j99++
+			dAtA101[j100] = uint8(num)
+			j100++
 		}
-		i -= j99
-		copy(dAtA[i:], dAtA100[:j99])
-		i = encodeVarintEvents(dAtA, i, uint64(j99))
+		i -= j100
+		copy(dAtA[i:], dAtA101[:j100])
+		i = encodeVarintEvents(dAtA, i, uint64(j100))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -23448,6 +23994,160 @@ func (m *EventSpace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventSpaceActivity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSpaceActivity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSpaceActivity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSpaceActivityEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSpaceActivityEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSpaceActivityEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.LastTime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.LastTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Time != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ObjectIds) > 0 {
+		for iNdEx := len(m.ObjectIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ObjectIds[iNdEx])
+			copy(dAtA[i:], m.ObjectIds[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectIds[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ParticipantId) > 0 {
+		i -= len(m.ParticipantId)
+		copy(dAtA[i:], m.ParticipantId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ParticipantId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Type != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSpaceActivityUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSpaceActivityUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSpaceActivityUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnreadCount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.UnreadCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SubIds) > 0 {
+		for iNdEx := len(m.SubIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SubIds[iNdEx])
+			copy(dAtA[i:], m.SubIds[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.SubIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SpaceId) > 0 {
+		i -= len(m.SpaceId)
+		copy(dAtA[i:], m.SpaceId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SpaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventSpaceSyncStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -23927,6 +24627,92 @@ func (m *ModelProcessMessageOfPreloadFile) MarshalToSizedBuffer(dAtA []byte) (in
 	}
 	return len(dAtA) - i, nil
 }
+func (m *ModelProcessMessageOfObjectTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModelProcessMessageOfObjectTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ObjectTransfer != nil {
+		{
+			size, err := m.ObjectTransfer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ModelProcessMessageOfAuditLogExport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModelProcessMessageOfAuditLogExport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AuditLogExport != nil {
+		{
+			size, err := m.AuditLogExport.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ModelProcessMessageOfSpaceRepair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModelProcessMessageOfSpaceRepair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SpaceRepair != nil {
+		{
+			size, err := m.SpaceRepair.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ModelProcessMessageOfSpaceVerify) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModelProcessMessageOfSpaceVerify) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SpaceVerify != nil {
+		{
+			size, err := m.SpaceVerify.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	return len(dAtA) - i, nil
+}
 func (m *ModelProcessDropFiles) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -24065,6 +24851,98 @@ func (m *ModelProcessPreloadFile) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ModelProcessObjectTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModelProcessObjectTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModelProcessObjectTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ModelProcessAuditLogExport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModelProcessAuditLogExport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModelProcessAuditLogExport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ModelProcessSpaceRepair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModelProcessSpaceRepair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModelProcessSpaceRepair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ModelProcessSpaceVerify) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModelProcessSpaceVerify) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModelProcessSpaceVerify) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *ModelProcessProgress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -25083,6 +25961,18 @@ func (m *EventMessageValueOfMembershipV2ProductsUpdate) Size() (n int) {
 	}
 	return n
 }
+func (m *EventMessageValueOfSpaceActivityUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceActivityUpdate != nil {
+		l = m.SpaceActivityUpdate.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
 func (m *EventMessageValueOfAccountDetails) Size() (n int) {
 	if m == nil {
 		return 0
@@ -25447,6 +26337,18 @@ func (m *EventAccountLinkChallenge) Size() (n int) {
 	if m.Scope != 0 {
 		n += 1 + sovEvents(uint64(m.Scope))
 	}
+	if len(m.SpaceIds) > 0 {
+		for _, s := range m.SpaceIds {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.ReadOnly {
+		n += 2
+	}
+	if m.ExpireAt != 0 {
+		n += 1 + sovEvents(uint64(m.ExpireAt))
+	}
 	return n
 }
 
@@ -28579,6 +29481,82 @@ func (m *EventSpace) Size() (n int) {
 	return n
 }
 
+func (m *EventSpaceActivity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EventSpaceActivityEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovEvents(uint64(m.Type))
+	}
+	l = len(m.ParticipantId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.ObjectIds) > 0 {
+		for _, s := range m.ObjectIds {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.Time != 0 {
+		n += 1 + sovEvents(uint64(m.Time))
+	}
+	if m.LastTime != 0 {
+		n += 1 + sovEvents(uint64(m.LastTime))
+	}
+	if m.Count != 0 {
+		n += 1 + sovEvents(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *EventSpaceActivityUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpaceId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.SubIds) > 0 {
+		for _, s := range m.SubIds {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.UnreadCount != 0 {
+		n += 1 + sovEvents(uint64(m.UnreadCount))
+	}
+	return n
+}
+
 func (m *EventSpaceSyncStatus) Size() (n int) {
 	if m == nil {
 		return 0
@@ -28810,6 +29788,54 @@ func (m *ModelProcessMessageOfPreloadFile) Size() (n int) {
 	}
 	return n
 }
+func (m *ModelProcessMessageOfObjectTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjectTransfer != nil {
+		l = m.ObjectTransfer.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *ModelProcessMessageOfAuditLogExport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuditLogExport != nil {
+		l = m.AuditLogExport.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *ModelProcessMessageOfSpaceRepair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceRepair != nil {
+		l = m.SpaceRepair.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *ModelProcessMessageOfSpaceVerify) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceVerify != nil {
+		l = m.SpaceVerify.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
 func (m *ModelProcessDropFiles) Size() (n int) {
 	if m == nil {
 		return 0
@@ -28864,6 +29890,42 @@ func (m *ModelProcessPreloadFile) Size() (n int) {
 	return n
 }
 
+func (m *ModelProcessObjectTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ModelProcessAuditLogExport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ModelProcessSpaceRepair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ModelProcessSpaceVerify) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *ModelProcessProgress) Size() (n int) {
 	if m == nil {
 		return 0
@@ -31829,6 +32891,41 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = &EventMessageValueOfMembershipV2ProductsUpdate{v}
 			iNdEx = postIndex
+		case 140:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceActivityUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventSpaceActivityUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &EventMessageValueOfSpaceActivityUpdate{v}
+			iNdEx = postIndex
 		case 201:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AccountDetails", wireType)
@@ -33940,91 +35037,9 @@ func (m *EventAccountLinkChallenge) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventAccountLinkChallengeClientInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClientInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClientInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProcessName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34052,11 +35067,11 @@ func (m *EventAccountLinkChallengeClientInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProcessPath = string(dAtA[iNdEx:postIndex])
+			m.SpaceIds = append(m.SpaceIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignatureVerified", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -34073,12 +35088,12 @@ func (m *EventAccountLinkChallengeClientInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.SignatureVerified = bool(v != 0)
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			m.ReadOnly = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireAt", wireType)
 			}
-			var stringLen uint64
+			m.ExpireAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -34088,24 +35103,177 @@ func (m *EventAccountLinkChallengeClientInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExpireAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAccountLinkChallengeClientInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureVerified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SignatureVerified = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -53551,15 +54719,451 @@ func (m *EventPayloadBroadcast) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Broadcast: wiretype end group for non-group")
+			return fmt.Errorf("proto: Broadcast: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Broadcast: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSpace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Space: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Space: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSpaceActivity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Activity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Activity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSpaceActivityEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Entry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Entry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= EventSpaceActivityType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParticipantId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectIds = append(m.ObjectIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTime", wireType)
+			}
+			m.LastTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSpaceActivityUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Update: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Broadcast: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Update: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -53587,58 +55191,93 @@ func (m *EventPayloadBroadcast) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = string(dAtA[iNdEx:postIndex])
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubIds", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventSpace) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			m.SubIds = append(m.SubIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Entries = append(m.Entries, &EventSpaceActivityEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnreadCount", wireType)
+			}
+			m.UnreadCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnreadCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Space: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Space: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -54553,18 +56192,155 @@ func (m *ModelProcess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Progress == nil {
-				m.Progress = &ModelProcessProgress{}
-			}
-			if err := m.Progress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Progress == nil {
+				m.Progress = &ModelProcessProgress{}
+			}
+			if err := m.Progress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropFiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ModelProcessDropFiles{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Message = &ModelProcessMessageOfDropFiles{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Import", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ModelProcessImport{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Message = &ModelProcessMessageOfImport{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Export", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ModelProcessExport{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Message = &ModelProcessMessageOfExport{v}
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SaveFile", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -54574,27 +56350,30 @@ func (m *ModelProcess) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			v := &ModelProcessSaveFile{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Message = &ModelProcessMessageOfSaveFile{v}
 			iNdEx = postIndex
-		case 6:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropFiles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Migration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -54621,17 +56400,17 @@ func (m *ModelProcess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ModelProcessDropFiles{}
+			v := &ModelProcessMigration{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Message = &ModelProcessMessageOfDropFiles{v}
+			m.Message = &ModelProcessMessageOfMigration{v}
 			iNdEx = postIndex
-		case 7:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Import", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -54641,30 +56420,27 @@ func (m *ModelProcess) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ModelProcessImport{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Message = &ModelProcessMessageOfImport{v}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Export", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreloadFile", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -54691,15 +56467,15 @@ func (m *ModelProcess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ModelProcessExport{}
+			v := &ModelProcessPreloadFile{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Message = &ModelProcessMessageOfExport{v}
+			m.Message = &ModelProcessMessageOfPreloadFile{v}
 			iNdEx = postIndex
-		case 9:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SaveFile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectTransfer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -54726,15 +56502,15 @@ func (m *ModelProcess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ModelProcessSaveFile{}
+			v := &ModelProcessObjectTransfer{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Message = &ModelProcessMessageOfSaveFile{v}
+			m.Message = &ModelProcessMessageOfObjectTransfer{v}
 			iNdEx = postIndex
-		case 10:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Migration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditLogExport", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -54761,17 +56537,17 @@ func (m *ModelProcess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ModelProcessMigration{}
+			v := &ModelProcessAuditLogExport{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Message = &ModelProcessMessageOfMigration{v}
+			m.Message = &ModelProcessMessageOfAuditLogExport{v}
 			iNdEx = postIndex
-		case 11:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceRepair", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -54781,27 +56557,30 @@ func (m *ModelProcess) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			v := &ModelProcessSpaceRepair{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Message = &ModelProcessMessageOfSpaceRepair{v}
 			iNdEx = postIndex
-		case 12:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreloadFile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceVerify", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -54828,11 +56607,11 @@ func (m *ModelProcess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ModelProcessPreloadFile{}
+			v := &ModelProcessSpaceVerify{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Message = &ModelProcessMessageOfPreloadFile{v}
+			m.Message = &ModelProcessMessageOfSpaceVerify{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -55155,6 +56934,206 @@ func (m *ModelProcessPreloadFile) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ModelProcessObjectTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModelProcessAuditLogExport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditLogExport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditLogExport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModelProcessSpaceRepair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpaceRepair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpaceRepair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModelProcessSpaceVerify) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpaceVerify: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpaceVerify: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ModelProcessProgress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
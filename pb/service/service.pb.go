@@ -26,395 +26,436 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("pb/protos/service/service.proto", fileDescriptor_93a29dc403579097) }
 
 var fileDescriptor_93a29dc403579097 = []byte{
-	// 6195 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x9d, 0x5f, 0x6f, 0x24, 0x49,
-	0x52, 0xc0, 0xcf, 0x3c, 0x70, 0xd0, 0xc7, 0x1d, 0xd0, 0x0b, 0xcb, 0xdd, 0x72, 0x37, 0xff, 0x67,
-	0xec, 0x19, 0xdb, 0xe5, 0x59, 0xcf, 0xce, 0xee, 0x72, 0x87, 0x04, 0x3d, 0xf6, 0xd8, 0xdb, 0xb7,
-	0xf6, 0xac, 0x71, 0xdb, 0x33, 0x62, 0x25, 0x24, 0x6a, 0xba, 0xd2, 0xdd, 0x85, 0xab, 0x2b, 0xeb,
-	0xaa, 0xb2, 0x3d, 0xd3, 0x87, 0x40, 0x20, 0x10, 0x08, 0x04, 0xe2, 0x04, 0x1c, 0x82, 0x27, 0x24,
-	0x3e, 0x01, 0x7c, 0x0b, 0x1e, 0xef, 0x91, 0x47, 0xb4, 0xfb, 0x45, 0x50, 0x65, 0x66, 0xe5, 0x9f,
-	0xa8, 0x88, 0xac, 0xf2, 0xf2, 0x34, 0x23, 0xc7, 0x2f, 0x22, 0xf2, 0x6f, 0x64, 0x46, 0x56, 0x56,
-	0xf5, 0xe0, 0x66, 0xf1, 0x7a, 0xa7, 0x28, 0xb9, 0xe0, 0xd5, 0x4e, 0xc5, 0xca, 0xab, 0x74, 0xca,
-	0x9a, 0x7f, 0x23, 0xf9, 0xe7, 0xe1, 0xd7, 0xe3, 0x7c, 0x25, 0x56, 0x05, 0x7b, 0xef, 0xdb, 0x96,
-	0x9c, 0xf2, 0xc5, 0x22, 0xce, 0x93, 0x4a, 0x21, 0xef, 0xbd, 0x6b, 0x25, 0xec, 0x8a, 0xe5, 0x42,
-	0xff, 0x7d, 0xf7, 0xbf, 0x7e, 0xfa, 0x73, 0x83, 0x6f, 0xed, 0x65, 0x29, 0xcb, 0xc5, 0x9e, 0xd6,
-	0x18, 0x7e, 0x3e, 0xf8, 0xe6, 0xa8, 0x28, 0x0e, 0x99, 0x78, 0xc9, 0xca, 0x2a, 0xe5, 0xf9, 0xf0,
-	0x6e, 0xa4, 0x1d, 0x44, 0xa7, 0xc5, 0x34, 0x1a, 0x15, 0x45, 0x64, 0x85, 0xd1, 0x29, 0xfb, 0xd1,
-	0x92, 0x55, 0xe2, 0xbd, 0x7b, 0x61, 0xa8, 0x2a, 0x78, 0x5e, 0xb1, 0xe1, 0xc5, 0xe0, 0x57, 0x47,
-	0x45, 0x31, 0x61, 0x62, 0x9f, 0xd5, 0x15, 0x98, 0x88, 0x58, 0xb0, 0xe1, 0x7a, 0x4b, 0xd5, 0x07,
-	0x8c, 0x8f, 0x8d, 0x6e, 0x50, 0xfb, 0x39, 0x1b, 0x7c, 0xa3, 0xf6, 0x33, 0x5f, 0x8a, 0x84, 0xbf,
-	0xc9, 0x87, 0xb7, 0xdb, 0x8a, 0x5a, 0x64, 0x6c, 0xdf, 0x09, 0x21, 0xda, 0xea, 0xab, 0xc1, 0x2f,
-	0xbd, 0x8a, 0xb3, 0x8c, 0x89, 0xbd, 0x92, 0xd5, 0x05, 0xf7, 0x75, 0x94, 0x28, 0x52, 0x32, 0x63,
-	0xf7, 0x6e, 0x90, 0xd1, 0x86, 0x3f, 0x1f, 0x7c, 0x53, 0x49, 0x4e, 0xd9, 0x94, 0x5f, 0xb1, 0x72,
-	0x88, 0x6a, 0x69, 0x21, 0xd1, 0xe4, 0x2d, 0x08, 0xda, 0xde, 0xe3, 0xf9, 0x15, 0x2b, 0x05, 0x6e,
-	0x5b, 0x0b, 0xc3, 0xb6, 0x2d, 0xa4, 0x6d, 0xff, 0xcd, 0xda, 0xe0, 0xbb, 0xa3, 0xe9, 0x94, 0x2f,
-	0x73, 0x71, 0xc4, 0xa7, 0x71, 0x76, 0x94, 0xe6, 0x97, 0x2f, 0xd8, 0x9b, 0xbd, 0x79, 0xcd, 0xe7,
-	0x33, 0x36, 0x7c, 0xe2, 0xb7, 0xaa, 0x42, 0x23, 0xc3, 0x46, 0x2e, 0x6c, 0x7c, 0x7f, 0x70, 0x3d,
-	0x25, 0x5d, 0x96, 0x7f, 0x58, 0x1b, 0xdc, 0x80, 0x65, 0x99, 0xf0, 0xec, 0x8a, 0xd9, 0xd2, 0x3c,
-	0xed, 0x30, 0xec, 0xe3, 0xa6, 0x3c, 0x1f, 0x5e, 0x57, 0x4d, 0x97, 0xe8, 0xcf, 0xd6, 0x06, 0xdf,
-	0x81, 0x25, 0x52, 0x3d, 0x3f, 0x2a, 0x8a, 0xe1, 0xe3, 0x0e, 0xab, 0x86, 0x34, 0xe5, 0x78, 0xff,
-	0x1a, 0x1a, 0xba, 0x08, 0x7f, 0x32, 0xf8, 0x36, 0x2c, 0xc1, 0x51, 0x5a, 0x89, 0x51, 0x51, 0x54,
-	0xc3, 0x9d, 0x0e, 0x73, 0x0d, 0x68, 0xfc, 0x3f, 0xee, 0xaf, 0x10, 0x68, 0x81, 0x53, 0x76, 0xc5,
-	0x2f, 0x7b, 0xb5, 0x80, 0x21, 0x7b, 0xb7, 0x80, 0xab, 0xa1, 0x8b, 0x90, 0x0d, 0xde, 0x71, 0xe7,
-	0xec, 0x84, 0x55, 0x32, 0xa6, 0x3d, 0xa4, 0xa7, 0xa5, 0x46, 0x8c, 0xd3, 0x47, 0x7d, 0x50, 0xed,
-	0x2d, 0x1d, 0x0c, 0xb5, 0xb7, 0x8c, 0x57, 0xc6, 0xd9, 0x06, 0x6a, 0xc1, 0x21, 0x8c, 0xaf, 0x87,
-	0x3d, 0x48, 0xed, 0xea, 0x0f, 0x07, 0xbf, 0xfc, 0x8a, 0x97, 0x97, 0x55, 0x11, 0x4f, 0x99, 0x8e,
-	0x47, 0xf7, 0x7d, 0xed, 0x46, 0x0a, 0x43, 0xd2, 0x83, 0x2e, 0xcc, 0x89, 0x1c, 0x8d, 0xf0, 0xb3,
-	0x82, 0xc1, 0x85, 0xc0, 0x2a, 0xd6, 0x42, 0x2a, 0x72, 0x40, 0x48, 0xdb, 0xbe, 0x1c, 0x0c, 0xad,
-	0xed, 0xd7, 0x7f, 0xc4, 0xa6, 0x62, 0x94, 0x24, 0xb0, 0x57, 0xac, 0xae, 0x24, 0xa2, 0x51, 0x92,
-	0x50, 0xbd, 0x82, 0xa3, 0xda, 0xd9, 0x9b, 0xc1, 0xbb, 0xc0, 0x99, 0x1c, 0xaa, 0x49, 0x32, 0xdc,
-	0x0e, 0x5b, 0xd1, 0x98, 0x71, 0x1a, 0xf5, 0xc5, 0x9d, 0xf1, 0x8f, 0x78, 0x3e, 0x65, 0x0b, 0x7e,
-	0xc5, 0xc0, 0xf8, 0x47, 0xad, 0x29, 0x92, 0x18, 0xff, 0x61, 0x0d, 0x64, 0x98, 0x4c, 0x58, 0xc6,
-	0xa6, 0x82, 0x1c, 0x26, 0x4a, 0xdc, 0x39, 0x4c, 0x0c, 0xe6, 0xcc, 0xb0, 0x46, 0x78, 0xc8, 0xc4,
-	0xde, 0xb2, 0x2c, 0x59, 0x2e, 0xc8, 0xbe, 0xb4, 0x48, 0x67, 0x5f, 0x7a, 0x28, 0x52, 0x9f, 0x43,
-	0x26, 0x46, 0x59, 0x46, 0xd6, 0x47, 0x89, 0x3b, 0xeb, 0x63, 0x30, 0xed, 0x61, 0x3a, 0xf8, 0x15,
-	0xa7, 0xc5, 0xc4, 0x38, 0xbf, 0xe0, 0x43, 0xba, 0x2d, 0xa4, 0xdc, 0xf8, 0x58, 0xef, 0xe4, 0x90,
-	0x6a, 0x3c, 0x7f, 0x5b, 0xf0, 0x92, 0xee, 0x16, 0x25, 0xee, 0xac, 0x86, 0xc1, 0xb4, 0x87, 0x3f,
-	0x18, 0x7c, 0x4b, 0x07, 0xc8, 0x66, 0x53, 0x71, 0x0f, 0x8d, 0x9e, 0x70, 0x57, 0x71, 0xbf, 0x83,
-	0x6a, 0x99, 0x3f, 0x4e, 0x67, 0x65, 0x1d, 0x7d, 0x70, 0xf3, 0x5a, 0xda, 0x61, 0xde, 0x52, 0xda,
-	0x3c, 0x1f, 0xfc, 0x9a, 0x6f, 0x7e, 0x2f, 0xce, 0xa7, 0x2c, 0x1b, 0x3e, 0x0a, 0xa9, 0x2b, 0xc6,
-	0xb8, 0xda, 0xec, 0xc5, 0xda, 0x60, 0xa7, 0x09, 0x1d, 0x4c, 0xef, 0xa2, 0xda, 0x20, 0x94, 0xde,
-	0x0b, 0x43, 0x2d, 0xdb, 0xfb, 0x2c, 0x63, 0xa4, 0x6d, 0x25, 0xec, 0xb0, 0x6d, 0x20, 0x6d, 0xbb,
-	0x1c, 0xfc, 0xba, 0xe9, 0xe6, 0x7a, 0x73, 0x26, 0xe5, 0xf5, 0xa2, 0xb3, 0x49, 0xf4, 0xa3, 0x0b,
-	0x19, 0x5f, 0x5b, 0xfd, 0xe0, 0x56, 0x7d, 0x74, 0x44, 0xc1, 0xeb, 0x03, 0xe2, 0xc9, 0xbd, 0x30,
-	0xa4, 0x6d, 0xff, 0xed, 0xda, 0xe0, 0x7b, 0x5a, 0xf6, 0x3c, 0x8f, 0x5f, 0x67, 0x4c, 0xae, 0xee,
-	0x2f, 0x98, 0x78, 0xc3, 0xcb, 0xcb, 0xc9, 0x2a, 0x9f, 0x12, 0x7b, 0x4a, 0x1c, 0xee, 0xd8, 0x53,
-	0x92, 0x4a, 0xba, 0x30, 0x7f, 0x6c, 0xb6, 0x4f, 0x7b, 0xf3, 0x38, 0x9f, 0xb1, 0x1f, 0x56, 0x3c,
-	0x1f, 0x15, 0xe9, 0x28, 0x49, 0xca, 0x61, 0x84, 0x77, 0x3d, 0xe4, 0x4c, 0x09, 0x76, 0x7a, 0xf3,
-	0x4e, 0x0e, 0xa3, 0x5b, 0x59, 0xf0, 0x02, 0xe6, 0x30, 0x4d, 0xf3, 0x09, 0x5e, 0x50, 0x39, 0x8c,
-	0x8f, 0xb4, 0xac, 0x1e, 0xd7, 0x6b, 0x10, 0x6e, 0xf5, 0xd8, 0x5d, 0x74, 0xee, 0x84, 0x10, 0xbb,
-	0x06, 0x34, 0x0d, 0xc5, 0xf3, 0x8b, 0x74, 0x76, 0x5e, 0x24, 0xf5, 0x1c, 0x7a, 0x88, 0xd7, 0xd9,
-	0x41, 0x88, 0x35, 0x80, 0x40, 0xb5, 0xb7, 0xbf, 0xb7, 0x5b, 0x7d, 0x1d, 0x97, 0x0e, 0x4a, 0xbe,
-	0x38, 0x62, 0xb3, 0x78, 0xba, 0xd2, 0xc1, 0xf4, 0x83, 0x50, 0x14, 0x83, 0xb4, 0x29, 0xc4, 0xd3,
-	0x6b, 0x6a, 0xe9, 0xf2, 0xfc, 0xfb, 0xda, 0xe0, 0x9e, 0x37, 0x4e, 0xf4, 0x60, 0x52, 0xa5, 0x1f,
-	0xe5, 0xc9, 0x29, 0xab, 0x44, 0x5c, 0x8a, 0xe1, 0xf7, 0x03, 0x63, 0x80, 0xd0, 0x31, 0x65, 0xfb,
-	0xc1, 0x57, 0xd2, 0xb5, 0xbd, 0x3e, 0xa9, 0x57, 0x09, 0x1d, 0x7f, 0xfc, 0x5e, 0x97, 0x12, 0x18,
-	0x7d, 0xee, 0x84, 0x10, 0xdb, 0xeb, 0x52, 0x30, 0xce, 0xaf, 0x52, 0xc1, 0x0e, 0x59, 0xce, 0xca,
-	0x76, 0xaf, 0x2b, 0x55, 0x1f, 0x21, 0x7a, 0x9d, 0x40, 0xed, 0xd9, 0x81, 0xe3, 0x4d, 0x55, 0x1c,
-	0x9c, 0x1d, 0xb8, 0x06, 0x14, 0x40, 0x9c, 0x1d, 0xa0, 0xa0, 0x8d, 0xa8, 0x5e, 0xad, 0xcc, 0x8e,
-	0x66, 0x33, 0x50, 0xd8, 0xd6, 0x9e, 0x66, 0xab, 0x1f, 0x4c, 0xb4, 0xa4, 0x38, 0xac, 0x8d, 0x04,
-	0x5b, 0x52, 0x21, 0xbd, 0x5a, 0xd2, 0xa0, 0x68, 0x4b, 0xaa, 0xa4, 0x29, 0xd0, 0x92, 0x0a, 0xe8,
-	0xd1, 0x92, 0x06, 0xb4, 0x9b, 0x1c, 0xc7, 0xcf, 0xcb, 0x94, 0xbd, 0x01, 0x9b, 0x1c, 0x57, 0xb9,
-	0x16, 0x13, 0x9b, 0x1c, 0x04, 0xd3, 0x1e, 0x5e, 0x0c, 0x7e, 0x51, 0x0a, 0x7f, 0xc8, 0xd3, 0x7c,
-	0x78, 0x13, 0x51, 0xaa, 0x05, 0xc6, 0xea, 0x2d, 0x1a, 0x00, 0x25, 0xae, 0xff, 0xaa, 0x77, 0x1c,
-	0xf7, 0x09, 0x25, 0xb0, 0xd9, 0x78, 0xd0, 0x85, 0xd9, 0xdd, 0xa5, 0x14, 0xd6, 0x51, 0x79, 0x32,
-	0x8f, 0xcb, 0x34, 0x9f, 0x0d, 0x31, 0x5d, 0x47, 0x4e, 0xec, 0x2e, 0x31, 0x0e, 0x0c, 0x27, 0xad,
-	0x38, 0x2a, 0x8a, 0xb2, 0x0e, 0xf6, 0xd8, 0x70, 0xf2, 0x91, 0xe0, 0x70, 0x6a, 0xa1, 0xb8, 0xb7,
-	0x7d, 0x36, 0xcd, 0xd2, 0x3c, 0xe8, 0x4d, 0x23, 0x7d, 0xbc, 0x59, 0x14, 0x0c, 0xde, 0x23, 0x16,
-	0x5f, 0xb1, 0xa6, 0x66, 0x58, 0xcb, 0xb8, 0x40, 0x70, 0xf0, 0x02, 0xd0, 0xa6, 0xf2, 0x52, 0x7c,
-	0x1c, 0x5f, 0xb2, 0xba, 0x81, 0x59, 0xbd, 0x55, 0x18, 0x62, 0xfa, 0x1e, 0x41, 0xa4, 0xf2, 0x38,
-	0xa9, 0x5d, 0x2d, 0x07, 0xef, 0x4a, 0xf9, 0x49, 0x5c, 0x8a, 0x74, 0x9a, 0x16, 0x71, 0xde, 0xa4,
-	0x88, 0x58, 0x14, 0x69, 0x51, 0xc6, 0xe5, 0x76, 0x4f, 0x5a, 0xbb, 0xfd, 0x97, 0xb5, 0xc1, 0x6d,
-	0xe8, 0xf7, 0x84, 0x95, 0x8b, 0x54, 0x9e, 0x34, 0x54, 0x3a, 0xc2, 0x7e, 0x14, 0x36, 0xda, 0x52,
-	0x30, 0xa5, 0xf9, 0xf8, 0xfa, 0x8a, 0x76, 0x7f, 0x39, 0xd1, 0xd9, 0xd7, 0x67, 0x65, 0xd2, 0x3a,
-	0x0e, 0x9d, 0x34, 0x29, 0x95, 0x14, 0x12, 0xfb, 0xcb, 0x16, 0x04, 0x66, 0xf8, 0x79, 0x5e, 0x35,
-	0xd6, 0xb1, 0x19, 0x6e, 0xc5, 0xc1, 0x19, 0xee, 0x61, 0x76, 0x86, 0x9f, 0x2c, 0x5f, 0x67, 0x69,
-	0x35, 0x4f, 0xf3, 0x99, 0x4e, 0x26, 0x7c, 0x5d, 0x2b, 0x86, 0xf9, 0xc4, 0x7a, 0x27, 0x87, 0x39,
-	0xd1, 0x83, 0x85, 0x74, 0x02, 0x86, 0xc9, 0x7a, 0x27, 0x67, 0x73, 0x3c, 0x2b, 0x3d, 0x4a, 0x2b,
-	0x01, 0x72, 0x3c, 0x47, 0xb5, 0x96, 0x12, 0x39, 0x5e, 0x9b, 0xb2, 0x39, 0x9e, 0x5b, 0x87, 0x8a,
-	0x67, 0x57, 0xec, 0xbc, 0x4c, 0x41, 0x8e, 0xe7, 0x95, 0xaf, 0x61, 0x88, 0x1c, 0x8f, 0x62, 0x6d,
-	0xa0, 0xb2, 0xc4, 0x21, 0x13, 0x13, 0x11, 0x8b, 0x65, 0x05, 0x02, 0x95, 0x63, 0xc3, 0x20, 0x44,
-	0xa0, 0x22, 0x50, 0xed, 0xed, 0xf7, 0x06, 0x03, 0x75, 0x2e, 0x23, 0xcf, 0xce, 0xfc, 0xb5, 0x47,
-	0x1f, 0xd8, 0x78, 0x07, 0x67, 0xb7, 0x03, 0x84, 0x9d, 0x18, 0xea, 0xef, 0xa7, 0xec, 0xa2, 0x64,
-	0xd5, 0x1c, 0x4c, 0x0c, 0xad, 0xa3, 0x85, 0xc4, 0xc4, 0x68, 0x41, 0x76, 0x8b, 0xa8, 0x44, 0xf2,
-	0xb8, 0x71, 0x88, 0x96, 0x46, 0x8a, 0x88, 0x2d, 0x22, 0x40, 0x60, 0x23, 0x4c, 0xe6, 0xfc, 0x0d,
-	0xde, 0x08, 0xb5, 0x24, 0xdc, 0x08, 0x9a, 0xb0, 0x4f, 0x61, 0x74, 0x41, 0xb1, 0xa7, 0x30, 0x4d,
-	0x31, 0x42, 0x4f, 0x61, 0x20, 0x63, 0xc7, 0xa3, 0x6b, 0xf8, 0x19, 0xe7, 0x97, 0x8b, 0xb8, 0xbc,
-	0x04, 0xe3, 0xd1, 0x53, 0x6e, 0x18, 0x62, 0x3c, 0x52, 0xac, 0x1d, 0x8f, 0xae, 0xc3, 0x3a, 0xc1,
-	0x38, 0x2f, 0x33, 0x30, 0x1e, 0x3d, 0x1b, 0x1a, 0x21, 0xc6, 0x23, 0x81, 0xda, 0xc8, 0xe7, 0x7a,
-	0x9b, 0x30, 0x78, 0xe4, 0xe4, 0xa9, 0x4f, 0x18, 0x75, 0xe4, 0x84, 0x60, 0x70, 0x08, 0x1d, 0x96,
-	0x71, 0x31, 0xc7, 0x87, 0x90, 0x14, 0x85, 0x87, 0x50, 0x83, 0xc0, 0xfe, 0x9e, 0xb0, 0xb8, 0x9c,
-	0xce, 0xf1, 0xfe, 0x56, 0xb2, 0x70, 0x7f, 0x1b, 0x06, 0xf6, 0xb7, 0x12, 0xbc, 0x4a, 0xc5, 0xfc,
-	0x98, 0x89, 0x18, 0xef, 0x6f, 0x9f, 0x09, 0xf7, 0x77, 0x8b, 0xb5, 0x99, 0x85, 0xeb, 0x70, 0xb2,
-	0x7c, 0x5d, 0x4d, 0xcb, 0xf4, 0x35, 0x1b, 0x06, 0xac, 0x18, 0x88, 0xc8, 0x2c, 0x48, 0x58, 0xfb,
-	0xfc, 0xc9, 0xda, 0xe0, 0x66, 0xd3, 0xed, 0xbc, 0xaa, 0xf4, 0xba, 0xea, 0xbb, 0x7f, 0x8a, 0xf7,
-	0x2f, 0x81, 0x13, 0xcf, 0xc5, 0x7a, 0xa8, 0x39, 0xfb, 0x0e, 0xbc, 0x48, 0xe7, 0x79, 0x65, 0x0a,
-	0xf5, 0x51, 0x1f, 0xeb, 0x8e, 0x02, 0xb1, 0xef, 0xe8, 0xa5, 0x68, 0xb7, 0x7c, 0xba, 0x7f, 0x1a,
-	0xd9, 0x38, 0xa9, 0xc0, 0x96, 0xaf, 0x69, 0x6f, 0x87, 0x20, 0xb6, 0x7c, 0x38, 0x09, 0x87, 0xc2,
-	0x61, 0xc9, 0x97, 0x45, 0xd5, 0x31, 0x14, 0x00, 0x14, 0x1e, 0x0a, 0x6d, 0x58, 0xfb, 0x7c, 0x3b,
-	0xf8, 0x0d, 0x77, 0xf8, 0xb9, 0x8d, 0xbd, 0x4d, 0x8f, 0x29, 0xac, 0x89, 0xa3, 0xbe, 0xb8, 0xdd,
-	0xad, 0x34, 0x9e, 0xc5, 0x3e, 0x13, 0x71, 0x9a, 0x55, 0xc3, 0x07, 0xb8, 0x8d, 0x46, 0x4e, 0xec,
-	0x56, 0x30, 0x0e, 0xc6, 0xb7, 0xfd, 0x65, 0x91, 0xa5, 0xd3, 0xf6, 0x03, 0x31, 0xad, 0x6b, 0xc4,
-	0xe1, 0xf8, 0xe6, 0x62, 0x30, 0x5e, 0xd7, 0xdb, 0x4a, 0xf9, 0x9f, 0xb3, 0x55, 0xc1, 0xf0, 0x78,
-	0xed, 0x21, 0xe1, 0x78, 0x0d, 0x51, 0x58, 0x9f, 0x09, 0x13, 0x47, 0xf1, 0x8a, 0x2f, 0x89, 0x78,
-	0x6d, 0xc4, 0xe1, 0xfa, 0xb8, 0x98, 0xcd, 0x3b, 0x8c, 0x87, 0x71, 0x2e, 0x58, 0x99, 0xc7, 0xd9,
-	0x41, 0x16, 0xcf, 0xaa, 0x21, 0x11, 0x63, 0x7c, 0x8a, 0xc8, 0x3b, 0x68, 0x1a, 0x69, 0xc6, 0x71,
-	0x75, 0x10, 0x5f, 0xf1, 0x32, 0x15, 0x74, 0x33, 0x5a, 0xa4, 0xb3, 0x19, 0x3d, 0x14, 0xf5, 0x36,
-	0x2a, 0xa7, 0xf3, 0xf4, 0x8a, 0x25, 0x01, 0x6f, 0x0d, 0xd2, 0xc3, 0x9b, 0x83, 0x22, 0x9d, 0x36,
-	0xe1, 0xcb, 0x72, 0xca, 0xc8, 0x4e, 0x53, 0xe2, 0xce, 0x4e, 0x33, 0x98, 0xf6, 0xf0, 0x97, 0x6b,
-	0x83, 0xdf, 0x54, 0x52, 0xf7, 0x29, 0xd5, 0x7e, 0x5c, 0xcd, 0x5f, 0xf3, 0xb8, 0x4c, 0x86, 0xef,
-	0x63, 0x76, 0x50, 0xd4, 0xb8, 0xde, 0xbd, 0x8e, 0x0a, 0x6c, 0xd6, 0x7a, 0x4f, 0x6f, 0x67, 0x1c,
-	0xda, 0xac, 0x1e, 0x12, 0x6e, 0x56, 0x88, 0xc2, 0x00, 0x22, 0xe5, 0xea, 0x10, 0xf3, 0x01, 0xa9,
-	0xef, 0x9f, 0x64, 0xae, 0x77, 0x72, 0x30, 0x3e, 0xd6, 0x42, 0x7f, 0xb4, 0x6c, 0x53, 0x36, 0xf0,
-	0x11, 0x13, 0xf5, 0xc5, 0x49, 0xcf, 0x66, 0x56, 0x84, 0x3d, 0xb7, 0x66, 0x46, 0xd4, 0x17, 0x27,
-	0x3c, 0x3b, 0x61, 0x2d, 0xe4, 0x19, 0x09, 0x6d, 0x51, 0x5f, 0x1c, 0xee, 0xbe, 0x34, 0xd3, 0xac,
-	0x0b, 0x8f, 0x02, 0x76, 0xe0, 0xda, 0xb0, 0xd9, 0x8b, 0xd5, 0x0e, 0xff, 0x7a, 0x6d, 0xf0, 0x5d,
-	0xeb, 0xf1, 0x98, 0x27, 0xe9, 0xc5, 0x4a, 0x41, 0x2f, 0xe3, 0x6c, 0xc9, 0xaa, 0xe1, 0x2e, 0x65,
-	0xad, 0xcd, 0x9a, 0x12, 0x3c, 0xb9, 0x96, 0x0e, 0x9c, 0x3b, 0xa3, 0xa2, 0xc8, 0x56, 0x67, 0x6c,
-	0x51, 0x64, 0xe4, 0xdc, 0xf1, 0x90, 0xf0, 0xdc, 0x81, 0x28, 0xdc, 0x95, 0x9f, 0xf1, 0x7a, 0xcf,
-	0x8f, 0xee, 0xca, 0xa5, 0x28, 0xbc, 0x2b, 0x6f, 0x10, 0xb8, 0x57, 0x3a, 0xe3, 0x7b, 0x3c, 0xcb,
-	0xd8, 0x54, 0xb4, 0x6f, 0xba, 0x18, 0x4d, 0x4b, 0x84, 0xf7, 0x4a, 0x80, 0xb4, 0x27, 0x7e, 0x4d,
-	0x0e, 0x19, 0x97, 0xec, 0xd9, 0xea, 0x28, 0xcd, 0x2f, 0x87, 0xf8, 0xb6, 0xc0, 0x02, 0xc4, 0x89,
-	0x1f, 0x0a, 0xc2, 0x5c, 0xf5, 0x3c, 0x4f, 0x38, 0x9e, 0xab, 0xd6, 0x92, 0x70, 0xae, 0xaa, 0x09,
-	0x68, 0xf2, 0x94, 0x51, 0x26, 0x6b, 0x49, 0xd8, 0xa4, 0x26, 0xb0, 0x50, 0xa8, 0x9f, 0x76, 0x91,
-	0xa1, 0x10, 0x3c, 0xdf, 0x5a, 0xef, 0xe4, 0x60, 0xce, 0xa5, 0x1d, 0xa0, 0x23, 0x02, 0x18, 0xbf,
-	0x1b, 0x64, 0xe0, 0xd0, 0x6f, 0xb2, 0xe1, 0x03, 0x26, 0xa6, 0x73, 0x7c, 0xe8, 0x7b, 0x48, 0x78,
-	0xe8, 0x43, 0x14, 0x56, 0x63, 0xbc, 0xa0, 0xab, 0xa1, 0x64, 0xe1, 0x6a, 0x18, 0x06, 0x76, 0x82,
-	0x12, 0xc8, 0xb3, 0xb1, 0x07, 0xb4, 0xa2, 0x77, 0x3a, 0xb6, 0xde, 0xc9, 0x69, 0x27, 0xff, 0x6c,
-	0x52, 0x37, 0x25, 0x7d, 0xc1, 0xeb, 0x79, 0xf1, 0x32, 0xce, 0xd2, 0x24, 0x16, 0xec, 0x8c, 0x5f,
-	0xb2, 0x1c, 0xcf, 0x92, 0x74, 0x69, 0x15, 0x1f, 0x79, 0x0a, 0xe1, 0x2c, 0x29, 0xac, 0x08, 0xbb,
-	0x50, 0xd1, 0xe7, 0x15, 0xdb, 0x8b, 0x2b, 0x22, 0x7a, 0x79, 0x48, 0xb8, 0x0b, 0x21, 0x0a, 0xf7,
-	0xa8, 0x4a, 0xfe, 0xfc, 0x6d, 0xc1, 0xca, 0x94, 0xe5, 0x53, 0x86, 0xef, 0x51, 0x21, 0x15, 0xde,
-	0xa3, 0x22, 0x34, 0xcc, 0xcf, 0xf6, 0x63, 0xc1, 0x9e, 0xad, 0xce, 0xd2, 0x05, 0xab, 0x44, 0xbc,
-	0x28, 0xf0, 0xfc, 0x0c, 0x40, 0xe1, 0xfc, 0xac, 0x0d, 0xb7, 0x8e, 0x83, 0x4c, 0x10, 0x6c, 0x5f,
-	0x8a, 0x83, 0x44, 0xe0, 0x52, 0x1c, 0x81, 0xc2, 0x86, 0xb5, 0x00, 0xfa, 0xd0, 0xa1, 0x65, 0x25,
-	0xf8, 0xd0, 0x81, 0xa6, 0x5b, 0x87, 0x6c, 0x86, 0x99, 0xd4, 0x53, 0xb3, 0xa3, 0xe8, 0x13, 0x77,
-	0x8a, 0x6e, 0xf6, 0x62, 0xf1, 0x53, 0xbd, 0x53, 0x96, 0xc5, 0x72, 0xa9, 0x0a, 0x1c, 0x9d, 0x35,
-	0x4c, 0x9f, 0x53, 0x3d, 0x87, 0xd5, 0x0e, 0xff, 0x7c, 0x6d, 0xf0, 0x1e, 0xe6, 0xf1, 0xb3, 0x42,
-	0xfa, 0x7d, 0xdc, 0x6d, 0x4b, 0x91, 0xc4, 0xad, 0xbf, 0xb0, 0x86, 0xbd, 0xb8, 0xd2, 0x88, 0xec,
-	0xa5, 0x40, 0x5d, 0x00, 0x7f, 0xa3, 0x66, 0xca, 0x0f, 0x39, 0xe2, 0xe2, 0x4a, 0x88, 0xb7, 0x39,
-	0x90, 0x5f, 0xae, 0x0a, 0xe4, 0x40, 0xc6, 0x86, 0x16, 0x13, 0x39, 0x10, 0x82, 0xd9, 0x0b, 0x9d,
-	0xbe, 0x07, 0xf3, 0xa4, 0x68, 0x3b, 0x64, 0xa1, 0xfd, 0xcc, 0x28, 0xea, 0x8b, 0xdb, 0xb0, 0xe0,
-	0xb6, 0xeb, 0xab, 0x54, 0xcc, 0xe5, 0xe6, 0x0e, 0x84, 0x05, 0xaf, 0x91, 0x0c, 0x44, 0x84, 0x05,
-	0x12, 0x86, 0xdb, 0x9f, 0x06, 0xac, 0x83, 0x02, 0xb6, 0x88, 0x18, 0x43, 0x6e, 0x48, 0xd8, 0xe8,
-	0x06, 0xe1, 0x44, 0x69, 0xc4, 0x3a, 0xcf, 0x7a, 0x14, 0xb2, 0x00, 0x72, 0xad, 0xcd, 0x5e, 0xac,
-	0x76, 0xf8, 0xa7, 0x83, 0xef, 0xb4, 0x2a, 0x76, 0xc0, 0x62, 0xb1, 0x2c, 0x59, 0x02, 0x6e, 0xa7,
-	0xb7, 0xcb, 0xdd, 0x80, 0xc4, 0xed, 0xf4, 0xa0, 0x42, 0x2b, 0x21, 0x68, 0x38, 0x35, 0x9e, 0x4d,
-	0x19, 0x76, 0x43, 0x26, 0x7d, 0x36, 0x98, 0x10, 0xd0, 0x3a, 0xad, 0x9c, 0xde, 0x1d, 0x5d, 0xa3,
-	0xab, 0x38, 0xcd, 0xe4, 0x53, 0xe7, 0xf7, 0x43, 0x46, 0x3d, 0x34, 0x98, 0xd3, 0x93, 0x2a, 0xad,
-	0x25, 0x41, 0x06, 0x17, 0x27, 0x17, 0xdc, 0xa2, 0x43, 0x10, 0x92, 0x0a, 0x6e, 0xf7, 0xa4, 0xb5,
-	0x5b, 0xd1, 0xac, 0xb5, 0xf5, 0x9f, 0xdd, 0x41, 0x8e, 0x79, 0xd5, 0xaa, 0xc8, 0x48, 0xdf, 0xee,
-	0x49, 0xdb, 0x57, 0x23, 0xda, 0x5e, 0xf5, 0x0a, 0xb8, 0xd3, 0x69, 0x0a, 0x2c, 0x82, 0x8f, 0xfb,
-	0x2b, 0x68, 0xf7, 0xff, 0x6a, 0x0e, 0xc1, 0x95, 0xff, 0x29, 0x5f, 0x2c, 0x58, 0x9e, 0xb0, 0xa4,
-	0xd1, 0xa8, 0xea, 0x64, 0xed, 0x63, 0xda, 0xae, 0x51, 0x88, 0x5c, 0x0d, 0x53, 0xa2, 0xdf, 0xfa,
-	0x0a, 0x9a, 0xba, 0x68, 0xff, 0xb9, 0x36, 0x78, 0x88, 0x16, 0xad, 0x19, 0xb8, 0x5e, 0x11, 0x7f,
-	0xb7, 0x8f, 0x23, 0x4c, 0xd3, 0x14, 0x75, 0xf4, 0xff, 0xb0, 0xa0, 0x8b, 0xfc, 0x6f, 0x6b, 0x83,
-	0x3b, 0x56, 0xb1, 0x1e, 0xde, 0x7b, 0x3c, 0xbf, 0xc8, 0xd2, 0xa9, 0x90, 0x8f, 0x96, 0xb5, 0x0a,
-	0xdd, 0x9c, 0x94, 0x46, 0x77, 0x73, 0x06, 0x34, 0x75, 0xd9, 0xfe, 0x69, 0x6d, 0x70, 0xcb, 0x6d,
-	0x4e, 0xf9, 0x5c, 0x5a, 0x1d, 0xc5, 0x36, 0x8a, 0xd5, 0xf0, 0x43, 0xba, 0x0d, 0x30, 0xde, 0x94,
-	0xeb, 0xa3, 0x6b, 0xeb, 0xb5, 0xf2, 0xf7, 0x55, 0x61, 0x2f, 0x5a, 0x6c, 0x50, 0xe6, 0x5a, 0x2b,
-	0xe7, 0xc3, 0x1e, 0xa4, 0x75, 0xf5, 0x49, 0x5a, 0x09, 0x5e, 0xae, 0x26, 0x73, 0xfe, 0xa6, 0x79,
-	0xab, 0xd0, 0x77, 0xa5, 0x81, 0xc8, 0x21, 0x08, 0x57, 0x38, 0xd9, 0x72, 0x65, 0xdf, 0x3e, 0xac,
-	0x08, 0x57, 0x0e, 0xd1, 0xe1, 0xca, 0x27, 0xed, 0xb2, 0xdc, 0xd4, 0xca, 0xbe, 0x2a, 0xb9, 0x8e,
-	0x17, 0xb5, 0xfd, 0xba, 0xe4, 0x46, 0x37, 0x68, 0xb3, 0x02, 0x2d, 0xde, 0x4f, 0x2f, 0x2e, 0x4c,
-	0x9d, 0xf0, 0x92, 0xba, 0x08, 0x91, 0x15, 0x10, 0xa8, 0x4d, 0x6c, 0x0f, 0xd2, 0x8c, 0xc9, 0x27,
-	0x65, 0x9f, 0x5d, 0x5c, 0x64, 0x3c, 0x4e, 0x40, 0x62, 0x5b, 0x8b, 0x23, 0x57, 0x4e, 0x24, 0xb6,
-	0x18, 0x67, 0xaf, 0x31, 0xd4, 0xd2, 0x7a, 0x7a, 0xe7, 0xd3, 0x34, 0x83, 0xf7, 0xe1, 0xa5, 0xa6,
-	0x11, 0x12, 0xd7, 0x18, 0x5a, 0x90, 0xdd, 0x7c, 0xd6, 0xa2, 0x7a, 0x5a, 0x36, 0xe5, 0xbf, 0xdf,
-	0x56, 0x74, 0xc4, 0xc4, 0xe6, 0x13, 0xc1, 0xec, 0x99, 0x4e, 0x2d, 0x3c, 0x2f, 0xa4, 0xf1, 0x5b,
-	0x6d, 0x2d, 0x25, 0x21, 0xce, 0x74, 0x7c, 0xc2, 0x9e, 0x53, 0xd4, 0x7f, 0xdf, 0xe7, 0x6f, 0x72,
-	0x69, 0xf4, 0x4e, 0x5b, 0xa5, 0x91, 0x11, 0xe7, 0x14, 0x90, 0xb1, 0xf3, 0x41, 0x1a, 0x4e, 0xab,
-	0x69, 0x5c, 0x26, 0x27, 0x25, 0x93, 0xe6, 0x37, 0x10, 0x55, 0x8f, 0x20, 0xe6, 0x03, 0x4e, 0x6a,
-	0x57, 0x9f, 0x0e, 0x7e, 0x41, 0xba, 0x2a, 0x79, 0x31, 0xbc, 0x81, 0xa8, 0x95, 0xce, 0x45, 0xf5,
-	0x9b, 0xa4, 0xdc, 0xde, 0x3c, 0x32, 0xc3, 0xf0, 0xbc, 0x8a, 0x67, 0xf0, 0xed, 0x12, 0x3b, 0xb8,
-	0xa4, 0x94, 0xb8, 0x79, 0xd4, 0xa6, 0xfc, 0x01, 0xf8, 0x82, 0x27, 0xda, 0x3a, 0xd2, 0x98, 0x46,
-	0x18, 0x1a, 0x80, 0x2e, 0x64, 0xe7, 0xab, 0x2c, 0x3a, 0x13, 0xa3, 0xa5, 0xe0, 0xa6, 0x4b, 0x91,
-	0x96, 0x04, 0x08, 0x31, 0x5f, 0x09, 0xd4, 0x46, 0xa1, 0x1a, 0xd8, 0x8b, 0xa7, 0x73, 0x3b, 0x7c,
-	0x90, 0x89, 0xe8, 0x01, 0x44, 0x14, 0x42, 0x41, 0xfb, 0x9c, 0xc0, 0xf8, 0x51, 0x57, 0x5a, 0x8d,
-	0xb7, 0x6d, 0xc2, 0x88, 0x8f, 0x11, 0x29, 0x57, 0x00, 0xb7, 0x29, 0xd7, 0x8b, 0xf8, 0x2a, 0x9d,
-	0x99, 0x6d, 0xb1, 0x5a, 0x6b, 0x2a, 0x90, 0x72, 0x59, 0x26, 0x72, 0x20, 0x22, 0xe5, 0x22, 0x61,
-	0x67, 0xc9, 0xb6, 0xcc, 0x61, 0xf3, 0x00, 0x63, 0x9c, 0x5f, 0xf0, 0x3a, 0x41, 0x3b, 0x4a, 0xf3,
-	0x4b, 0xb8, 0x64, 0x3b, 0x26, 0x71, 0x9e, 0x58, 0xb2, 0xfb, 0xe8, 0xd9, 0xa4, 0xbe, 0x39, 0xdd,
-	0xb7, 0x57, 0x7c, 0x94, 0x06, 0x48, 0xea, 0xcd, 0x43, 0x00, 0xc8, 0x11, 0x49, 0x7d, 0x88, 0xb7,
-	0x53, 0xc6, 0x38, 0xcf, 0x78, 0x0e, 0xa7, 0x8c, 0xb5, 0x50, 0x0b, 0x89, 0x29, 0xd3, 0x82, 0xec,
-	0x20, 0x6e, 0x44, 0xea, 0xbc, 0x78, 0x94, 0x65, 0x60, 0x10, 0x1b, 0x55, 0x03, 0x10, 0x83, 0x18,
-	0x05, 0xb5, 0x9f, 0xd3, 0xc1, 0x37, 0xea, 0x26, 0x3d, 0x29, 0xd9, 0x55, 0xca, 0xe0, 0x6d, 0x34,
-	0x47, 0x42, 0x84, 0x6e, 0x9f, 0xb0, 0x91, 0xea, 0x3c, 0xaf, 0x8a, 0x2c, 0xae, 0xe6, 0xfa, 0x7e,
-	0x92, 0x5f, 0xe7, 0x46, 0x08, 0x6f, 0x28, 0xdd, 0xef, 0xa0, 0xec, 0x7a, 0xdc, 0xc8, 0xcc, 0x84,
-	0x7b, 0x80, 0xab, 0xb6, 0x66, 0xda, 0x7a, 0x27, 0x67, 0x27, 0xf7, 0x61, 0x9c, 0x65, 0xac, 0x5c,
-	0x35, 0xb2, 0xe3, 0x38, 0x4f, 0x2f, 0x58, 0x25, 0xc0, 0xe4, 0xd6, 0x54, 0x04, 0x31, 0x62, 0x72,
-	0x07, 0x70, 0x7b, 0xe6, 0x00, 0x3c, 0x8f, 0xf3, 0x84, 0xbd, 0x05, 0x67, 0x0e, 0xd0, 0x8e, 0x64,
-	0x88, 0x33, 0x07, 0x8a, 0xb5, 0x0f, 0xc3, 0x9e, 0x65, 0x7c, 0x7a, 0xa9, 0x57, 0x6f, 0xbf, 0x83,
-	0xa5, 0x04, 0x2e, 0xdf, 0x77, 0x42, 0x88, 0x5d, 0xbf, 0xa5, 0xe0, 0x94, 0x15, 0x59, 0x3c, 0x85,
-	0x57, 0x12, 0x95, 0x8e, 0x96, 0x11, 0xeb, 0x37, 0x64, 0x40, 0x71, 0xf5, 0x55, 0x47, 0xac, 0xb8,
-	0xe0, 0xa6, 0xe3, 0x9d, 0x10, 0x62, 0x77, 0x30, 0x52, 0x30, 0x29, 0xb2, 0x54, 0x80, 0x69, 0xa0,
-	0x34, 0xa4, 0x84, 0x98, 0x06, 0x3e, 0x01, 0x4c, 0x1e, 0xb3, 0x72, 0xc6, 0x50, 0x93, 0x52, 0x12,
-	0x34, 0xd9, 0x10, 0xf6, 0xdd, 0x0e, 0x55, 0x77, 0x5e, 0xac, 0xc0, 0xbb, 0x1d, 0xba, 0x5a, 0xbc,
-	0x58, 0x11, 0xef, 0x76, 0x78, 0x00, 0x28, 0xe2, 0x49, 0x5c, 0x09, 0xbc, 0x88, 0x52, 0x12, 0x2c,
-	0x62, 0x43, 0xd8, 0x3d, 0x8f, 0x2a, 0xe2, 0x52, 0x80, 0x3d, 0x8f, 0x2e, 0x80, 0x73, 0x29, 0xe7,
-	0x26, 0x29, 0xb7, 0x91, 0x44, 0xf5, 0x0a, 0x13, 0x07, 0x29, 0xcb, 0x92, 0x0a, 0x44, 0x12, 0xdd,
-	0xee, 0x8d, 0x94, 0x88, 0x24, 0x6d, 0x0a, 0x0c, 0x25, 0xfd, 0x44, 0x0f, 0xab, 0x1d, 0x78, 0xa0,
-	0x77, 0x27, 0x84, 0xd8, 0xf8, 0xd4, 0x14, 0x7a, 0x2f, 0x2e, 0xcb, 0xb4, 0xde, 0x4c, 0x3d, 0xc0,
-	0x0b, 0xd4, 0xc8, 0x89, 0xf8, 0x84, 0x71, 0x60, 0x7a, 0x35, 0x81, 0x1b, 0x2b, 0x18, 0x0c, 0xdd,
-	0x77, 0x83, 0x8c, 0x4d, 0x16, 0xa4, 0xc4, 0xb9, 0x55, 0x82, 0xb5, 0x26, 0x72, 0xa9, 0xe4, 0x41,
-	0x17, 0xe6, 0xbc, 0xce, 0x6a, 0x5c, 0x1c, 0xf3, 0x2b, 0x76, 0xc6, 0x9f, 0xbf, 0x4d, 0x2b, 0x91,
-	0xe6, 0x33, 0xbd, 0x72, 0x3f, 0x21, 0x2c, 0x61, 0x30, 0xf1, 0x3a, 0x6b, 0xa7, 0x92, 0xdd, 0x40,
-	0x80, 0xb2, 0xbc, 0x60, 0x6f, 0xd0, 0x0d, 0x04, 0xb4, 0x68, 0x38, 0x62, 0x03, 0x11, 0xe2, 0xed,
-	0x69, 0xaf, 0x71, 0xae, 0x3f, 0x24, 0x73, 0xc6, 0x9b, 0xbd, 0x1c, 0x65, 0x0d, 0x82, 0xc4, 0x81,
-	0x5b, 0x50, 0xc1, 0xa6, 0x42, 0xc6, 0xbf, 0x9d, 0x62, 0x1b, 0x84, 0x9d, 0xf6, 0x34, 0x7b, 0xd8,
-	0x83, 0x44, 0x5c, 0xd9, 0xab, 0x51, 0x94, 0xab, 0xf6, 0xcd, 0xa8, 0x87, 0x3d, 0x48, 0xe7, 0xe4,
-	0xd8, 0xad, 0xd6, 0xb3, 0x78, 0x7a, 0x39, 0x2b, 0xf9, 0x32, 0x4f, 0xf6, 0x78, 0xc6, 0x4b, 0x70,
-	0x72, 0xec, 0x95, 0x1a, 0xa0, 0xc4, 0xc9, 0x71, 0x87, 0x8a, 0xdd, 0xc1, 0xb9, 0xa5, 0x18, 0x65,
-	0xe9, 0x0c, 0x1e, 0x86, 0x78, 0x86, 0x24, 0x40, 0xec, 0xe0, 0x50, 0x10, 0x19, 0x44, 0xea, 0xb0,
-	0x44, 0xa4, 0xd3, 0x38, 0x53, 0xfe, 0x76, 0x68, 0x33, 0x1e, 0xd8, 0x39, 0x88, 0x10, 0x05, 0xa4,
-	0x9e, 0x67, 0xcb, 0x32, 0x1f, 0xe7, 0x82, 0x93, 0xf5, 0x6c, 0x80, 0xce, 0x7a, 0x3a, 0x20, 0x08,
-	0xab, 0x67, 0xec, 0x6d, 0x5d, 0x9a, 0xfa, 0x1f, 0x2c, 0xac, 0xd6, 0x7f, 0x8f, 0xb4, 0x3c, 0x14,
-	0x56, 0x01, 0x07, 0x2a, 0xa3, 0x9d, 0xa8, 0x01, 0x13, 0xd0, 0xf6, 0x87, 0xc9, 0x46, 0x37, 0x88,
-	0xfb, 0x99, 0x88, 0x55, 0xc6, 0x42, 0x7e, 0x24, 0xd0, 0xc7, 0x4f, 0x03, 0xda, 0xcc, 0xdb, 0xab,
-	0xcf, 0x9c, 0x4d, 0x2f, 0x5b, 0x37, 0x3d, 0xfd, 0x82, 0x2a, 0x84, 0xc8, 0xbc, 0x09, 0x14, 0xef,
-	0xa2, 0xf1, 0x94, 0xe7, 0xa1, 0x2e, 0xaa, 0xe5, 0x7d, 0xba, 0x48, 0x73, 0x36, 0xf9, 0x35, 0x52,
-	0x3d, 0x32, 0x55, 0x37, 0x6d, 0x12, 0x16, 0x5c, 0x88, 0x48, 0x7e, 0x49, 0xd8, 0xee, 0xc9, 0xa1,
-	0xcf, 0xe3, 0xf6, 0x6b, 0x30, 0x2d, 0x2b, 0xc7, 0xf4, 0x6b, 0x30, 0x14, 0x4b, 0x57, 0x52, 0x8d,
-	0x91, 0x0e, 0x2b, 0xfe, 0x38, 0xd9, 0xea, 0x07, 0xdb, 0x94, 0xc7, 0xf3, 0xb9, 0x97, 0xb1, 0xb8,
-	0x54, 0x5e, 0xb7, 0x03, 0x86, 0x2c, 0x46, 0xa4, 0x3c, 0x01, 0x1c, 0x84, 0x30, 0xcf, 0xf3, 0x1e,
-	0xcf, 0x05, 0xcb, 0x05, 0x16, 0xc2, 0x7c, 0x63, 0x1a, 0x0c, 0x85, 0x30, 0x4a, 0x01, 0x8c, 0x5b,
-	0x7d, 0x48, 0xf5, 0x22, 0x5e, 0xa0, 0x3b, 0xb6, 0xe6, 0xd8, 0xa9, 0x96, 0x87, 0xc6, 0x2d, 0xe0,
-	0x9c, 0x3b, 0x10, 0xae, 0x97, 0xb3, 0xb8, 0x9c, 0x99, 0xd3, 0x8d, 0x64, 0xf8, 0x98, 0xb6, 0xe3,
-	0x93, 0xc4, 0x1d, 0x88, 0xb0, 0x06, 0x08, 0x3b, 0xe3, 0x45, 0x3c, 0x33, 0x35, 0x45, 0x6a, 0x20,
-	0xe5, 0xad, 0xaa, 0x6e, 0x74, 0x83, 0xc0, 0xcf, 0xcb, 0x34, 0x61, 0x3c, 0xe0, 0x47, 0xca, 0xfb,
-	0xf8, 0x81, 0x20, 0xd8, 0xbd, 0xc9, 0x73, 0x38, 0xf5, 0xa9, 0xb7, 0x3c, 0xd1, 0x79, 0x6c, 0x44,
-	0x34, 0x0f, 0xe0, 0x42, 0xbb, 0x37, 0x82, 0x07, 0x73, 0xb4, 0x39, 0x5b, 0x0f, 0xcd, 0x51, 0x73,
-	0x74, 0xde, 0x67, 0x8e, 0x62, 0xb0, 0xf6, 0xf9, 0x63, 0x3d, 0x47, 0xf7, 0x63, 0x11, 0xd7, 0xfb,
-	0xf6, 0x97, 0x29, 0x7b, 0xa3, 0x13, 0x61, 0xa4, 0xbe, 0x0d, 0x15, 0xc9, 0x2f, 0x04, 0x80, 0xac,
-	0x78, 0xa7, 0x37, 0x1f, 0xf0, 0xad, 0x33, 0x84, 0x4e, 0xdf, 0x20, 0x55, 0xd8, 0xe9, 0xcd, 0x07,
-	0x7c, 0xeb, 0x0f, 0xaa, 0x74, 0xfa, 0x06, 0x5f, 0x55, 0xd9, 0xe9, 0xcd, 0x6b, 0xdf, 0x7f, 0xd1,
-	0x4c, 0x5c, 0xd7, 0x79, 0xbd, 0x0f, 0x9b, 0x8a, 0xf4, 0x8a, 0x61, 0xdb, 0x49, 0xdf, 0x9e, 0x41,
-	0x43, 0xdb, 0x49, 0x5a, 0xc5, 0xf9, 0xae, 0x24, 0x56, 0x8a, 0x13, 0x5e, 0xa5, 0xf2, 0x0e, 0xd3,
-	0x93, 0x1e, 0x46, 0x1b, 0x38, 0x94, 0x34, 0x85, 0x94, 0xec, 0xa5, 0x08, 0x0f, 0xb5, 0x2f, 0x76,
-	0x6c, 0x05, 0xec, 0xb5, 0xdf, 0xef, 0xd8, 0xee, 0x49, 0xdb, 0xeb, 0x09, 0x1e, 0xd3, 0x3c, 0x58,
-	0x9e, 0x30, 0x74, 0x95, 0x30, 0xa6, 0xcc, 0x85, 0x03, 0xf7, 0x09, 0xfb, 0xe3, 0xfe, 0x0a, 0x1d,
-	0xee, 0x47, 0x49, 0xd2, 0xcf, 0xbd, 0x7b, 0x33, 0xe3, 0x71, 0x7f, 0x05, 0xed, 0xfe, 0xaf, 0x9a,
-	0xb4, 0x06, 0xfa, 0xd7, 0x73, 0x70, 0xb7, 0x8f, 0x45, 0x30, 0x0f, 0x9f, 0x5c, 0x4b, 0x47, 0x17,
-	0xe4, 0xef, 0x9a, 0xfc, 0xbd, 0x41, 0xe5, 0xdb, 0x75, 0xf2, 0x01, 0xb7, 0x9e, 0x92, 0xa1, 0x51,
-	0x65, 0x61, 0x38, 0x31, 0x9f, 0x5e, 0x53, 0xcb, 0xf9, 0xc8, 0xa9, 0x07, 0xeb, 0x37, 0xcc, 0x9d,
-	0xf2, 0x84, 0x2c, 0x3b, 0x34, 0x2c, 0xd0, 0x87, 0xd7, 0x55, 0xa3, 0xa6, 0xaa, 0x03, 0xcb, 0x2f,
-	0x4c, 0x3d, 0xe9, 0x69, 0xd8, 0xfb, 0xe6, 0xd4, 0x07, 0xd7, 0x53, 0xd2, 0x65, 0xf9, 0x8f, 0xb5,
-	0xc1, 0x7d, 0x8f, 0xb5, 0x8f, 0x33, 0xc0, 0xa1, 0xcb, 0x0f, 0x02, 0xf6, 0x29, 0x25, 0x53, 0xb8,
-	0xdf, 0xfe, 0x6a, 0xca, 0xf6, 0xee, 0xa2, 0xa7, 0x72, 0x90, 0x66, 0x82, 0x95, 0xed, 0x8f, 0x51,
-	0xfa, 0x76, 0x15, 0x15, 0xd1, 0x1f, 0xa3, 0x0c, 0xe0, 0xce, 0xc7, 0x28, 0x11, 0xcf, 0xe8, 0xc7,
-	0x28, 0x51, 0x6b, 0xc1, 0x8f, 0x51, 0x86, 0x35, 0xa8, 0xd5, 0xa5, 0x29, 0x82, 0x3a, 0x36, 0xef,
-	0x65, 0xd1, 0x3f, 0x45, 0xdf, 0xbd, 0x8e, 0x0a, 0xb1, 0xbe, 0x2a, 0x4e, 0xde, 0x42, 0xee, 0xd1,
-	0xa6, 0xde, 0x4d, 0xe4, 0x9d, 0xde, 0xbc, 0xf6, 0xfd, 0x23, 0x9d, 0x5c, 0x99, 0xd5, 0x84, 0x97,
-	0xf2, 0x43, 0xa4, 0x9b, 0xa1, 0xd5, 0xa1, 0xb6, 0xe0, 0xf6, 0xfc, 0x56, 0x3f, 0x98, 0xa8, 0x6e,
-	0x4d, 0xe8, 0x4e, 0x8f, 0xba, 0x0c, 0x81, 0x2e, 0xdf, 0xe9, 0xcd, 0x13, 0xcb, 0x88, 0xf2, 0xad,
-	0x7a, 0xbb, 0x87, 0x31, 0xbf, 0xaf, 0x1f, 0xf7, 0x57, 0xd0, 0xee, 0xaf, 0xf4, 0xae, 0xd5, 0x75,
-	0x2f, 0xfb, 0x79, 0xbb, 0xcb, 0xd4, 0xc4, 0xeb, 0xe6, 0xa8, 0x2f, 0x1e, 0xda, 0xbf, 0xb8, 0x4b,
-	0x68, 0xd7, 0xfe, 0x05, 0x5d, 0x46, 0x3f, 0xb8, 0x9e, 0x92, 0x2e, 0xcb, 0x3f, 0xae, 0x0d, 0x6e,
-	0x92, 0x65, 0xd1, 0xe3, 0xe0, 0xc3, 0xbe, 0x96, 0xc1, 0x78, 0xf8, 0xe8, 0xda, 0x7a, 0xba, 0x50,
-	0x3f, 0x5d, 0x1b, 0xdc, 0x0a, 0x14, 0x4a, 0x0d, 0x90, 0x6b, 0x58, 0xf7, 0x07, 0xca, 0xc7, 0xd7,
-	0x57, 0xa4, 0x96, 0x7b, 0x17, 0x9f, 0xb4, 0x3f, 0x2c, 0x18, 0xb0, 0x3d, 0xa1, 0x3f, 0x2c, 0xd8,
-	0xad, 0x05, 0xcf, 0x98, 0xe2, 0xd7, 0x4d, 0xce, 0x87, 0x9e, 0x31, 0xc9, 0x6b, 0xbc, 0xc1, 0x4f,
-	0x09, 0x61, 0x1c, 0xe6, 0xe4, 0xf9, 0xdb, 0x22, 0xce, 0x13, 0xda, 0x89, 0x92, 0x77, 0x3b, 0x31,
-	0x1c, 0x3c, 0x9b, 0xab, 0xa5, 0xa7, 0xbc, 0xc9, 0xe3, 0x1e, 0x52, 0xfa, 0x06, 0x09, 0x9e, 0xcd,
-	0xb5, 0x50, 0xc2, 0x9b, 0xde, 0x35, 0x86, 0xbc, 0x81, 0xcd, 0xe2, 0xa3, 0x3e, 0x28, 0xc8, 0x10,
-	0x8c, 0x37, 0x73, 0xe4, 0xbf, 0x15, 0xb2, 0xd2, 0x3a, 0xf6, 0xdf, 0xee, 0x49, 0x13, 0x6e, 0x27,
-	0x4c, 0x7c, 0xc2, 0xe2, 0x84, 0x95, 0x41, 0xb7, 0x86, 0xea, 0xe5, 0xd6, 0xa5, 0x31, 0xb7, 0x7b,
-	0x3c, 0x5b, 0x2e, 0x72, 0xdd, 0x99, 0xa4, 0x5b, 0x97, 0xea, 0x76, 0x0b, 0x68, 0x78, 0x2a, 0x69,
-	0xdd, 0xca, 0xed, 0xe5, 0xa3, 0xb0, 0x19, 0x6f, 0x57, 0xb9, 0xd9, 0x8b, 0xa5, 0xeb, 0xa9, 0x87,
-	0x51, 0x47, 0x3d, 0xc1, 0x48, 0xda, 0xee, 0x49, 0xc3, 0xe3, 0x41, 0xc7, 0xad, 0x19, 0x4f, 0x3b,
-	0x1d, 0xb6, 0x5a, 0x43, 0xea, 0x71, 0x7f, 0x05, 0x78, 0x18, 0xab, 0x47, 0xd5, 0x51, 0x5a, 0x89,
-	0x83, 0x34, 0xcb, 0x86, 0x9b, 0x81, 0x61, 0xd2, 0x40, 0xc1, 0xc3, 0x58, 0x04, 0x26, 0x46, 0x72,
-	0x73, 0x78, 0x99, 0x0f, 0xbb, 0xec, 0x48, 0xaa, 0xd7, 0x48, 0x76, 0x69, 0x70, 0xa0, 0xe6, 0x34,
-	0xb5, 0xa9, 0x6d, 0x14, 0x6e, 0xb8, 0x56, 0x85, 0x77, 0x7a, 0xf3, 0xe0, 0x69, 0xbf, 0xa4, 0xe4,
-	0xca, 0x72, 0x8f, 0x32, 0xe1, 0xad, 0x24, 0xf7, 0x3b, 0x28, 0x70, 0x28, 0xa9, 0xa6, 0xd1, 0xab,
-	0x34, 0x99, 0x31, 0x81, 0x3e, 0xa8, 0x72, 0x81, 0xe0, 0x83, 0x2a, 0x00, 0x82, 0xae, 0x53, 0x7f,
-	0x37, 0xa7, 0xb1, 0xe3, 0x04, 0xeb, 0x3a, 0xad, 0xec, 0x50, 0xa1, 0xae, 0x43, 0x69, 0x10, 0x0d,
-	0x8c, 0x5b, 0xfd, 0x81, 0x94, 0x47, 0x21, 0x33, 0xe0, 0x2b, 0x29, 0x9b, 0xbd, 0x58, 0xb0, 0xa2,
-	0x58, 0x87, 0xe9, 0x22, 0x15, 0xd8, 0x8a, 0xe2, 0xd8, 0xa8, 0x91, 0xd0, 0x8a, 0xd2, 0x46, 0xa9,
-	0xea, 0xd5, 0x7b, 0x84, 0x71, 0x12, 0xae, 0x9e, 0x62, 0xfa, 0x55, 0xcf, 0xb0, 0xad, 0xe7, 0xaa,
-	0xb9, 0x19, 0x32, 0x62, 0xae, 0x93, 0x65, 0x64, 0x6c, 0x3b, 0xbf, 0x37, 0x62, 0xc1, 0x50, 0xd4,
-	0xa1, 0x14, 0xe0, 0xf3, 0x82, 0xe6, 0x17, 0x4a, 0x26, 0x4c, 0x8c, 0x8a, 0x82, 0xc5, 0x65, 0x9c,
-	0x4f, 0xd1, 0xe4, 0xd4, 0xfc, 0xe2, 0x88, 0x47, 0x86, 0x92, 0x53, 0x52, 0x03, 0x3c, 0xb5, 0xf7,
-	0xdf, 0x4c, 0x47, 0xa6, 0x82, 0xf9, 0x90, 0x9b, 0xff, 0x62, 0xfa, 0xc3, 0x1e, 0x24, 0x7c, 0x6a,
-	0xdf, 0x00, 0xe6, 0xdc, 0x5d, 0x39, 0x7d, 0x3f, 0x60, 0xca, 0x47, 0x43, 0x89, 0x30, 0xad, 0x02,
-	0x06, 0xb5, 0x73, 0xb6, 0xf8, 0x29, 0x5b, 0x61, 0x83, 0xda, 0x3d, 0x24, 0xfc, 0x94, 0xad, 0x42,
-	0x83, 0xba, 0x8d, 0x82, 0x7d, 0xa6, 0x9b, 0x07, 0x3d, 0x08, 0xe8, 0xbb, 0xa9, 0xcf, 0x7a, 0x27,
-	0x07, 0x66, 0xce, 0x7e, 0x7a, 0xe5, 0x3d, 0xa6, 0x40, 0x0a, 0xba, 0x9f, 0x5e, 0xe1, 0x4f, 0x29,
-	0x36, 0x7b, 0xb1, 0xf0, 0x46, 0x40, 0x2c, 0xd8, 0xdb, 0xe6, 0x51, 0x3d, 0x52, 0x5c, 0x29, 0x6f,
-	0x3d, 0xab, 0xdf, 0xe8, 0x06, 0xed, 0xfd, 0xdb, 0x93, 0x92, 0x4f, 0x59, 0x55, 0xe9, 0xef, 0x12,
-	0xfb, 0x17, 0x9c, 0xb4, 0x2c, 0x02, 0x5f, 0x25, 0xbe, 0x17, 0x86, 0x9c, 0x8f, 0x89, 0x2a, 0x91,
-	0xfd, 0x0e, 0xd9, 0x03, 0x54, 0xb3, 0xfd, 0x09, 0xb2, 0xf5, 0x4e, 0xce, 0x4e, 0x2f, 0x2d, 0x75,
-	0x3f, 0x3c, 0xb6, 0x81, 0xaa, 0x63, 0xdf, 0x1c, 0x7b, 0xd8, 0x83, 0xd4, 0xae, 0x3e, 0x19, 0x7c,
-	0xfd, 0x88, 0xcf, 0x26, 0x2c, 0x4f, 0x86, 0xdf, 0xf3, 0x6f, 0xf0, 0xf2, 0x59, 0x54, 0xff, 0xd9,
-	0x18, 0xbd, 0x41, 0x89, 0xed, 0x1d, 0xc4, 0x7d, 0xf6, 0x7a, 0x39, 0x9b, 0x88, 0x58, 0x80, 0x3b,
-	0x88, 0xf2, 0xef, 0x51, 0x2d, 0x20, 0xee, 0x20, 0x7a, 0x00, 0xb0, 0x77, 0x56, 0x32, 0x86, 0xda,
-	0xab, 0x05, 0x41, 0x7b, 0x1a, 0xb0, 0xbb, 0x08, 0x63, 0xaf, 0xde, 0xa8, 0xc3, 0x3b, 0x83, 0x56,
-	0x47, 0x4a, 0x89, 0x5d, 0x44, 0x9b, 0xb2, 0x83, 0x5b, 0x55, 0x5f, 0x7e, 0x07, 0x6a, 0xb9, 0x58,
-	0xc4, 0xe5, 0x0a, 0x0c, 0x6e, 0x5d, 0x4b, 0x07, 0x20, 0x06, 0x37, 0x0a, 0xda, 0x59, 0xdb, 0x34,
-	0xf3, 0xf4, 0xf2, 0x90, 0x97, 0x7c, 0x29, 0xd2, 0x9c, 0xc1, 0x6f, 0x01, 0x99, 0x06, 0x75, 0x19,
-	0x62, 0xd6, 0x52, 0xac, 0xdd, 0xe5, 0x4a, 0x42, 0x5d, 0x67, 0x94, 0x3f, 0x00, 0x51, 0x09, 0x5e,
-	0xc2, 0xc7, 0x99, 0xca, 0x0a, 0x84, 0x88, 0x5d, 0x2e, 0x09, 0x83, 0xbe, 0x3f, 0x49, 0xf3, 0x19,
-	0xda, 0xf7, 0x27, 0xee, 0xb7, 0xbe, 0x6f, 0xd1, 0x80, 0x9d, 0x50, 0xaa, 0xd1, 0xd4, 0x04, 0xd0,
-	0x6f, 0xda, 0xa3, 0x8d, 0xee, 0x12, 0xc4, 0x84, 0xc2, 0x49, 0xe0, 0xea, 0xb3, 0x82, 0xe5, 0x2c,
-	0x69, 0x2e, 0xed, 0x61, 0xae, 0x3c, 0x22, 0xe8, 0x0a, 0x92, 0x36, 0x16, 0x49, 0xf9, 0xe9, 0x32,
-	0x3f, 0x29, 0xf9, 0x45, 0x9a, 0xb1, 0x12, 0xc4, 0x22, 0xa5, 0xee, 0xc8, 0x89, 0x58, 0x84, 0x71,
-	0xf6, 0xf6, 0x87, 0x94, 0x7a, 0xbf, 0x62, 0x72, 0x56, 0xc6, 0x53, 0x78, 0xfb, 0x43, 0xd9, 0x68,
-	0x63, 0xc4, 0xc9, 0x60, 0x00, 0x77, 0x36, 0x3a, 0xca, 0x75, 0xbe, 0x92, 0xe3, 0x43, 0xbf, 0x70,
-	0x2d, 0xbf, 0x80, 0x5d, 0x81, 0x8d, 0x8e, 0x36, 0x87, 0x91, 0xc4, 0x46, 0x27, 0xac, 0x61, 0x97,
-	0x12, 0xc9, 0xbd, 0xd0, 0xb7, 0x9a, 0xc0, 0x52, 0xa2, 0x6c, 0x34, 0x42, 0x62, 0x29, 0x69, 0x41,
-	0x20, 0x20, 0x35, 0xd3, 0x60, 0x86, 0x06, 0x24, 0x23, 0x0d, 0x06, 0x24, 0x97, 0xb2, 0x81, 0x62,
-	0x9c, 0xa7, 0x22, 0x8d, 0xb3, 0x09, 0x13, 0x27, 0x71, 0x19, 0x2f, 0x98, 0x60, 0x25, 0x0c, 0x14,
-	0x1a, 0x89, 0x3c, 0x86, 0x08, 0x14, 0x14, 0xab, 0x1d, 0xfe, 0xce, 0xe0, 0x9d, 0x7a, 0xdd, 0x67,
-	0xb9, 0xfe, 0xfd, 0xb5, 0xe7, 0xf2, 0xd7, 0x33, 0x87, 0xef, 0x1a, 0x1b, 0x13, 0x51, 0xb2, 0x78,
-	0xd1, 0xd8, 0xfe, 0x96, 0xf9, 0xbb, 0x04, 0x1f, 0xaf, 0xd5, 0xe3, 0xf9, 0x05, 0x17, 0xe9, 0x45,
-	0x9d, 0x66, 0xeb, 0x17, 0x98, 0xc0, 0x78, 0x76, 0xc5, 0x51, 0xe0, 0x4b, 0x41, 0x18, 0x67, 0xe3,
-	0xb4, 0x2b, 0x3d, 0x65, 0x45, 0x06, 0xe3, 0xb4, 0xa7, 0x2d, 0x01, 0x22, 0x4e, 0xa3, 0xa0, 0x9d,
-	0x9c, 0xae, 0xf8, 0x8c, 0x85, 0x2b, 0x73, 0xc6, 0xfa, 0x55, 0xe6, 0xcc, 0x7b, 0x27, 0x24, 0x1b,
-	0xbc, 0x73, 0xcc, 0x16, 0xaf, 0x59, 0x59, 0xcd, 0xd3, 0x82, 0xfa, 0x4a, 0xb7, 0x25, 0x3a, 0xbf,
-	0xd2, 0x4d, 0xa0, 0x76, 0x25, 0xb0, 0xc0, 0xb8, 0x7a, 0x11, 0x2f, 0x98, 0xfc, 0xee, 0x11, 0x58,
-	0x09, 0x1c, 0x23, 0x0e, 0x44, 0xac, 0x04, 0x24, 0xec, 0xbc, 0x5e, 0x66, 0x99, 0x53, 0x36, 0xab,
-	0x47, 0x58, 0x79, 0x12, 0xaf, 0x16, 0x2c, 0x17, 0xda, 0x24, 0x38, 0x93, 0x77, 0x4c, 0xe2, 0x3c,
-	0x71, 0x26, 0xdf, 0x47, 0xcf, 0x09, 0x4d, 0x5e, 0xc3, 0x9f, 0xf0, 0x52, 0xa8, 0x1f, 0x56, 0x3c,
-	0x2f, 0x33, 0x10, 0x9a, 0xfc, 0x46, 0xf5, 0x48, 0x22, 0x34, 0x85, 0x35, 0x9c, 0x5f, 0xd2, 0xf1,
-	0xca, 0xf0, 0x92, 0x95, 0x66, 0x9c, 0x3c, 0x5f, 0xc4, 0x69, 0xa6, 0x47, 0xc3, 0xf7, 0x03, 0xb6,
-	0x09, 0x1d, 0xe2, 0x97, 0x74, 0xfa, 0xea, 0x3a, 0xbf, 0x3d, 0x14, 0x2e, 0x21, 0x78, 0x44, 0xd0,
-	0x61, 0x9f, 0x78, 0x44, 0xd0, 0xad, 0x65, 0x33, 0x77, 0xcb, 0x4a, 0x6e, 0x25, 0x89, 0x3d, 0x9e,
-	0xc0, 0xf3, 0x42, 0xc7, 0x26, 0x00, 0x89, 0xcc, 0x3d, 0xa8, 0x60, 0xb7, 0x06, 0x16, 0x3b, 0x48,
-	0xf3, 0x38, 0x4b, 0x7f, 0x0c, 0xb7, 0xf5, 0x8e, 0x9d, 0x86, 0x20, 0xb6, 0x06, 0x38, 0x89, 0xb9,
-	0x3a, 0x64, 0xe2, 0x2c, 0xad, 0x43, 0xff, 0x46, 0xa0, 0xdd, 0x24, 0xd1, 0xed, 0xca, 0x21, 0x9d,
-	0xaf, 0x66, 0xc3, 0x66, 0x1d, 0x15, 0xc5, 0xa4, 0x5e, 0x55, 0x4f, 0xd9, 0x94, 0xa5, 0x85, 0x18,
-	0x3e, 0x0d, 0xb7, 0x15, 0xc0, 0x89, 0x8b, 0x16, 0x3d, 0xd4, 0xb0, 0x40, 0x55, 0xf7, 0xc1, 0xa1,
-	0xfe, 0x6d, 0x42, 0x32, 0x50, 0x39, 0x50, 0x77, 0xa0, 0xf2, 0x61, 0xbb, 0xdc, 0xfa, 0x3e, 0x4f,
-	0x59, 0xc2, 0xd8, 0x62, 0xf8, 0x28, 0x64, 0x45, 0x31, 0xc4, 0x72, 0x4b, 0xb1, 0x76, 0x63, 0xe6,
-	0x34, 0xfb, 0x6e, 0x1d, 0x28, 0x4a, 0x9e, 0x2c, 0xeb, 0xdd, 0xe6, 0x36, 0x61, 0xe7, 0xe5, 0x6e,
-	0xe4, 0x60, 0xc4, 0xc6, 0x2c, 0x80, 0x63, 0xcd, 0x2b, 0x3d, 0xeb, 0x48, 0xb3, 0x19, 0x34, 0x04,
-	0x42, 0xcb, 0x56, 0x3f, 0x18, 0x9d, 0xbb, 0xbb, 0x5e, 0x58, 0x24, 0xe7, 0xae, 0xae, 0x80, 0x01,
-	0x3b, 0xe7, 0x2e, 0xa2, 0x80, 0x46, 0xfc, 0x97, 0xbb, 0xa3, 0x7c, 0x55, 0xaf, 0x56, 0xe3, 0x4a,
-	0xad, 0x80, 0x01, 0x83, 0x3e, 0xd9, 0x19, 0xf1, 0x31, 0x0d, 0xe7, 0x28, 0x0c, 0x29, 0xc3, 0x28,
-	0xcb, 0xb8, 0x7c, 0xe4, 0xd1, 0x6d, 0xb2, 0x41, 0x89, 0xa3, 0xb0, 0x0e, 0x15, 0x6c, 0xd3, 0xf1,
-	0x72, 0x77, 0x2f, 0x2e, 0xc5, 0x21, 0x13, 0xe4, 0xa6, 0xe3, 0xe5, 0x6e, 0xa4, 0x91, 0xce, 0x4d,
-	0x87, 0x87, 0xda, 0x53, 0x73, 0xe8, 0x4d, 0xdf, 0xde, 0xda, 0x0a, 0x5b, 0x01, 0x97, 0xb6, 0xb6,
-	0x7b, 0xd2, 0xce, 0x0d, 0xa0, 0xba, 0xfa, 0x13, 0xf5, 0xf3, 0xf1, 0xe7, 0x15, 0x2b, 0x75, 0xae,
-	0x52, 0xd7, 0xf5, 0x31, 0x78, 0x2f, 0xdd, 0x70, 0x91, 0x03, 0x46, 0x6e, 0x95, 0xdf, 0xbf, 0x86,
-	0x86, 0xad, 0xb9, 0xc3, 0xe9, 0x8f, 0xd4, 0xc8, 0x1b, 0xd3, 0x5b, 0xa4, 0x31, 0x87, 0x22, 0x6a,
-	0x4e, 0xd3, 0x36, 0xae, 0xb4, 0xdd, 0x8e, 0xf2, 0xd5, 0x18, 0xde, 0xba, 0x42, 0x2c, 0x49, 0x8c,
-	0x88, 0x2b, 0x01, 0xdc, 0x79, 0x9e, 0x56, 0xf2, 0x38, 0x99, 0xc6, 0x95, 0x38, 0x89, 0x57, 0x19,
-	0x8f, 0x13, 0x99, 0x1a, 0xc0, 0xe7, 0x69, 0x0d, 0x13, 0xb9, 0x10, 0xf5, 0x3c, 0x8d, 0x82, 0xdd,
-	0x04, 0x4f, 0xfe, 0x2a, 0xbe, 0xbe, 0x8d, 0x0e, 0x13, 0x3c, 0x59, 0x5e, 0x78, 0x13, 0xfd, 0x5e,
-	0x18, 0xb2, 0x6f, 0xd1, 0x2a, 0x91, 0xcc, 0x64, 0x6e, 0x61, 0x3a, 0x5e, 0x0e, 0x73, 0x3b, 0x40,
-	0xd8, 0xef, 0x7f, 0xa9, 0xbf, 0x37, 0xbf, 0xc1, 0x29, 0xf4, 0xcf, 0x93, 0x6c, 0x61, 0xba, 0x2e,
-	0xe4, 0x5d, 0x72, 0xdd, 0xee, 0x49, 0xdb, 0x4c, 0x75, 0x6f, 0x1e, 0x8b, 0x51, 0x92, 0x1c, 0xb3,
-	0x0a, 0xf9, 0xc4, 0x48, 0x2d, 0x8c, 0xac, 0x94, 0xc8, 0x54, 0xdb, 0x94, 0x1d, 0xe8, 0xb5, 0xec,
-	0x79, 0x92, 0x0a, 0x2d, 0x6b, 0xde, 0xf1, 0xd8, 0x6a, 0x1b, 0x68, 0x53, 0x44, 0xad, 0x68, 0xda,
-	0x2e, 0x29, 0x35, 0x73, 0xc6, 0x67, 0xb3, 0x8c, 0x69, 0xe8, 0x94, 0xc5, 0xea, 0xeb, 0xcc, 0x3b,
-	0x6d, 0x5b, 0x28, 0x48, 0x2c, 0x29, 0x41, 0x05, 0x9b, 0x89, 0xd6, 0x98, 0x7a, 0xaa, 0xdd, 0x34,
-	0xec, 0x7a, 0xdb, 0x8c, 0x07, 0x10, 0x99, 0x28, 0x0a, 0xda, 0x37, 0x77, 0x6b, 0xf1, 0x21, 0x6b,
-	0x5a, 0x02, 0x7e, 0x63, 0x52, 0x2a, 0x3b, 0x62, 0xe2, 0xcd, 0x5d, 0x04, 0xb3, 0x7b, 0x1f, 0xe0,
-	0xe1, 0xd9, 0x6a, 0x9c, 0xc0, 0xa3, 0x06, 0xa8, 0x2f, 0x19, 0x62, 0xef, 0x43, 0xb1, 0x7e, 0xd7,
-	0x99, 0xa3, 0xf3, 0xa3, 0xb8, 0xb2, 0x95, 0x43, 0xba, 0x0e, 0x05, 0x43, 0x5d, 0x47, 0x29, 0xf8,
-	0x4d, 0xea, 0x9e, 0xce, 0x23, 0x4d, 0x8a, 0x1d, 0xcd, 0x3f, 0xe8, 0xc2, 0xec, 0xf1, 0x41, 0x2d,
-	0x3c, 0x65, 0x71, 0x62, 0x2a, 0x86, 0xe8, 0xba, 0x72, 0xe2, 0xf8, 0x00, 0xe3, 0xb4, 0x93, 0xdf,
-	0x1f, 0x0c, 0x55, 0x35, 0x4a, 0xd7, 0xcd, 0x2d, 0xac, 0x88, 0x35, 0x41, 0x04, 0x2a, 0x9f, 0x70,
-	0x72, 0x3f, 0xaf, 0x8b, 0xce, 0xb8, 0x76, 0xa0, 0xdf, 0x2c, 0xaf, 0x40, 0xee, 0xe7, 0x37, 0x7b,
-	0x8b, 0x26, 0x72, 0xbf, 0x6e, 0x2d, 0xe7, 0xab, 0x77, 0xa0, 0xcb, 0x0e, 0x4a, 0xbe, 0x80, 0x65,
-	0xfa, 0x38, 0xd8, 0x3d, 0x88, 0x06, 0xf1, 0xd5, 0xbb, 0x7e, 0x9a, 0xf0, 0xa7, 0xca, 0x74, 0x90,
-	0xc5, 0x7f, 0xaa, 0x4c, 0x0b, 0xc3, 0x3f, 0x55, 0x66, 0x21, 0xfb, 0x29, 0x83, 0x66, 0x1c, 0x8d,
-	0xb2, 0x6c, 0x78, 0x1b, 0x1f, 0x1a, 0xee, 0x37, 0x62, 0xee, 0x84, 0x10, 0xe7, 0x17, 0xcd, 0xc7,
-	0xaf, 0xca, 0x54, 0xa4, 0xf9, 0xec, 0x8c, 0xf3, 0x0c, 0x3e, 0x4b, 0x19, 0x8d, 0x23, 0x57, 0x4a,
-	0xfd, 0xa2, 0x79, 0x8b, 0xb2, 0x0b, 0xe7, 0x68, 0x3c, 0x5a, 0x0a, 0x7e, 0x91, 0x66, 0x19, 0x18,
-	0x8f, 0xa3, 0x71, 0xd4, 0x48, 0x88, 0xf1, 0xe8, 0x13, 0xce, 0xef, 0x70, 0x8f, 0xe5, 0x63, 0x49,
-	0xfd, 0x68, 0xe6, 0x2e, 0xd4, 0x71, 0x84, 0xd4, 0xef, 0x70, 0x43, 0xc8, 0xf9, 0x5d, 0xf1, 0x31,
-	0xf6, 0xeb, 0x64, 0x9b, 0x50, 0x1d, 0x81, 0xa8, 0xdf, 0x15, 0xa7, 0x60, 0xe7, 0x63, 0x09, 0x27,
-	0xcb, 0x6a, 0xee, 0x9f, 0x65, 0xaa, 0x53, 0x2b, 0xf5, 0xb9, 0xf3, 0x27, 0xe0, 0xf7, 0xf7, 0x7c,
-	0x36, 0xf2, 0x60, 0xe2, 0xde, 0x6c, 0xa7, 0x92, 0xf3, 0x75, 0x58, 0xc8, 0x4e, 0x98, 0x50, 0xbf,
-	0x09, 0xca, 0x13, 0xf8, 0x0e, 0x4a, 0xcb, 0xac, 0xcb, 0x12, 0xef, 0xa0, 0x74, 0xe9, 0x38, 0x87,
-	0x11, 0x48, 0x49, 0x0e, 0x78, 0xa9, 0xc8, 0x7a, 0x55, 0x7a, 0xda, 0x69, 0xd8, 0xc5, 0x89, 0xc3,
-	0x88, 0x1e, 0x6a, 0xf6, 0xea, 0x54, 0xbb, 0xa3, 0x2a, 0x26, 0xea, 0xa2, 0x44, 0x5d, 0xcd, 0xad,
-	0x38, 0xe2, 0xea, 0x54, 0x88, 0x57, 0xce, 0x9f, 0xdd, 0xfe, 0xef, 0x2f, 0x6e, 0xac, 0xfd, 0xec,
-	0x8b, 0x1b, 0x6b, 0xff, 0xfb, 0xc5, 0x8d, 0xb5, 0x9f, 0x7c, 0x79, 0xe3, 0x6b, 0x3f, 0xfb, 0xf2,
-	0xc6, 0xd7, 0xfe, 0xe7, 0xcb, 0x1b, 0x5f, 0xfb, 0xfc, 0xeb, 0x95, 0xda, 0x8b, 0xbf, 0xfe, 0xf9,
-	0xa2, 0xe4, 0x82, 0x3f, 0xf9, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x77, 0xa9, 0x88, 0x92, 0x3c,
-	0x8b, 0x00, 0x00,
+	// 6860 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x9d, 0xdd, 0x6f, 0x1d, 0xdb,
+	0x55, 0xc0, 0x6b, 0x1e, 0x28, 0x9c, 0xd2, 0x02, 0xa7, 0xed, 0xa5, 0xbd, 0xb4, 0xb9, 0x49, 0x6e,
+	0x12, 0xdb, 0xb1, 0x3d, 0xf6, 0x4d, 0xee, 0x17, 0x2d, 0x12, 0x9c, 0xd8, 0x89, 0xeb, 0x36, 0x4e,
+	0x8c, 0x8f, 0x93, 0x2b, 0x2a, 0x21, 0x31, 0x3e, 0xb3, 0x7d, 0x3c, 0x78, 0x3c, 0x33, 0x9d, 0x99,
+	0x73, 0x92, 0x53, 0x04, 0x02, 0x81, 0x40, 0x54, 0x20, 0x2a, 0xbe, 0x04, 0x4f, 0x48, 0xfc, 0x05,
+	0x3c, 0xf1, 0x37, 0xf0, 0xd8, 0x47, 0x1e, 0x51, 0xfb, 0x8f, 0xa0, 0xfd, 0xbd, 0xf7, 0xda, 0x6b,
+	0xed, 0x19, 0x97, 0x87, 0x28, 0x92, 0xd7, 0x6f, 0xad, 0xb5, 0xbf, 0xf7, 0xda, 0x1f, 0xb3, 0xcf,
+	0xe8, 0xbd, 0xfa, 0x7c, 0xb7, 0x6e, 0xaa, 0xae, 0x6a, 0x77, 0x5b, 0xd6, 0x2c, 0xf3, 0x19, 0xd3,
+	0xff, 0x27, 0xe2, 0xcf, 0xe3, 0xcf, 0xa7, 0xe5, 0xaa, 0x5b, 0xd5, 0xec, 0xdd, 0xaf, 0x59, 0x72,
+	0x56, 0x5d, 0x5f, 0xa7, 0x65, 0xd6, 0x4a, 0xe4, 0xdd, 0x77, 0xac, 0x84, 0x2d, 0x59, 0xd9, 0xa9,
+	0xbf, 0x3f, 0xfa, 0xd1, 0x7f, 0xfd, 0xc2, 0xe8, 0x4b, 0xfb, 0x45, 0xce, 0xca, 0x6e, 0x5f, 0x69,
+	0x8c, 0xbf, 0x3f, 0xfa, 0xe2, 0xa4, 0xae, 0x0f, 0x59, 0xf7, 0x9a, 0x35, 0x6d, 0x5e, 0x95, 0xe3,
+	0xf7, 0x13, 0xe5, 0x20, 0x39, 0xad, 0x67, 0xc9, 0xa4, 0xae, 0x13, 0x2b, 0x4c, 0x4e, 0xd9, 0x0f,
+	0x16, 0xac, 0xed, 0xde, 0xbd, 0x17, 0x87, 0xda, 0xba, 0x2a, 0x5b, 0x36, 0xbe, 0x18, 0xfd, 0xfa,
+	0xa4, 0xae, 0xa7, 0xac, 0x3b, 0x60, 0x3c, 0x03, 0xd3, 0x2e, 0xed, 0xd8, 0x78, 0x3d, 0x50, 0xf5,
+	0x01, 0xe3, 0x63, 0xa3, 0x1f, 0x54, 0x7e, 0xce, 0x46, 0x5f, 0xe0, 0x7e, 0x2e, 0x17, 0x5d, 0x56,
+	0xbd, 0x29, 0xc7, 0x77, 0x42, 0x45, 0x25, 0x32, 0xb6, 0xef, 0xc6, 0x10, 0x65, 0xf5, 0xb3, 0xd1,
+	0xaf, 0x7c, 0x96, 0x16, 0x05, 0xeb, 0xf6, 0x1b, 0xc6, 0x13, 0xee, 0xeb, 0x48, 0x51, 0x22, 0x65,
+	0xc6, 0xee, 0xfb, 0x51, 0x46, 0x19, 0xfe, 0xfe, 0xe8, 0x8b, 0x52, 0x72, 0xca, 0x66, 0xd5, 0x92,
+	0x35, 0x63, 0x54, 0x4b, 0x09, 0x89, 0x22, 0x0f, 0x20, 0x68, 0x7b, 0xbf, 0x2a, 0x97, 0xac, 0xe9,
+	0x70, 0xdb, 0x4a, 0x18, 0xb7, 0x6d, 0x21, 0x65, 0xfb, 0x6f, 0xd6, 0x46, 0xdf, 0x98, 0xcc, 0x66,
+	0xd5, 0xa2, 0xec, 0x9e, 0x57, 0xb3, 0xb4, 0x78, 0x9e, 0x97, 0x57, 0x2f, 0xd8, 0x9b, 0xfd, 0x4b,
+	0xce, 0x97, 0x73, 0x36, 0x7e, 0xec, 0x97, 0xaa, 0x44, 0x13, 0xc3, 0x26, 0x2e, 0x6c, 0x7c, 0x7f,
+	0x78, 0x33, 0x25, 0x95, 0x96, 0xbf, 0x5f, 0x1b, 0xdd, 0x82, 0x69, 0x99, 0x56, 0xc5, 0x92, 0xd9,
+	0xd4, 0x7c, 0xd4, 0x63, 0xd8, 0xc7, 0x4d, 0x7a, 0x3e, 0xbe, 0xa9, 0x9a, 0x4a, 0xd1, 0x9f, 0xad,
+	0x8d, 0xbe, 0x0e, 0x53, 0x24, 0x6b, 0x7e, 0x52, 0xd7, 0xe3, 0xbd, 0x1e, 0xab, 0x86, 0x34, 0xe9,
+	0xf8, 0xe0, 0x06, 0x1a, 0x2a, 0x09, 0x7f, 0x32, 0xfa, 0x1a, 0x4c, 0xc1, 0xf3, 0xbc, 0xed, 0x26,
+	0x75, 0xdd, 0x8e, 0x77, 0x7b, 0xcc, 0x69, 0xd0, 0xf8, 0xdf, 0x1b, 0xae, 0x10, 0x29, 0x81, 0x53,
+	0xb6, 0xac, 0xae, 0x06, 0x95, 0x80, 0x21, 0x07, 0x97, 0x80, 0xab, 0xa1, 0x92, 0x50, 0x8c, 0xbe,
+	0xec, 0xf6, 0xd9, 0x29, 0x6b, 0xc5, 0x98, 0xb6, 0x49, 0x77, 0x4b, 0x85, 0x18, 0xa7, 0x0f, 0x87,
+	0xa0, 0xca, 0x5b, 0x3e, 0x1a, 0x2b, 0x6f, 0x45, 0xd5, 0x1a, 0x67, 0x1b, 0xa8, 0x05, 0x87, 0x30,
+	0xbe, 0x36, 0x07, 0x90, 0xca, 0xd5, 0x1f, 0x8e, 0x7e, 0xf5, 0xb3, 0xaa, 0xb9, 0x6a, 0xeb, 0x74,
+	0xc6, 0xd4, 0x78, 0x74, 0xdf, 0xd7, 0xd6, 0x52, 0x38, 0x24, 0x3d, 0xe8, 0xc3, 0x9c, 0x91, 0x43,
+	0x0b, 0x5f, 0xd6, 0x0c, 0x4e, 0x04, 0x56, 0x91, 0x0b, 0xa9, 0x91, 0x03, 0x42, 0xca, 0xf6, 0xd5,
+	0x68, 0x6c, 0x6d, 0x9f, 0xff, 0x11, 0x9b, 0x75, 0x93, 0x2c, 0x83, 0xb5, 0x62, 0x75, 0x05, 0x91,
+	0x4c, 0xb2, 0x8c, 0xaa, 0x15, 0x1c, 0x55, 0xce, 0xde, 0x8c, 0xde, 0x01, 0xce, 0x44, 0x53, 0xcd,
+	0xb2, 0xf1, 0x4e, 0xdc, 0x8a, 0xc2, 0x8c, 0xd3, 0x64, 0x28, 0xee, 0xb4, 0x7f, 0xc4, 0xf3, 0x29,
+	0xbb, 0xae, 0x96, 0x0c, 0xb4, 0x7f, 0xd4, 0x9a, 0x24, 0x89, 0xf6, 0x1f, 0xd7, 0x40, 0x9a, 0xc9,
+	0x94, 0x15, 0x6c, 0xd6, 0x91, 0xcd, 0x44, 0x8a, 0x7b, 0x9b, 0x89, 0xc1, 0x9c, 0x1e, 0xa6, 0x85,
+	0x87, 0xac, 0xdb, 0x5f, 0x34, 0x0d, 0x2b, 0x3b, 0xb2, 0x2e, 0x2d, 0xd2, 0x5b, 0x97, 0x1e, 0x8a,
+	0xe4, 0xe7, 0x90, 0x75, 0x93, 0xa2, 0x20, 0xf3, 0x23, 0xc5, 0xbd, 0xf9, 0x31, 0x98, 0xf2, 0x30,
+	0x1b, 0xfd, 0x9a, 0x53, 0x62, 0xdd, 0x51, 0x79, 0x51, 0x8d, 0xe9, 0xb2, 0x10, 0x72, 0xe3, 0x63,
+	0xbd, 0x97, 0x43, 0xb2, 0xf1, 0xf4, 0x6d, 0x5d, 0x35, 0x74, 0xb5, 0x48, 0x71, 0x6f, 0x36, 0x0c,
+	0xa6, 0x3c, 0xfc, 0xc1, 0xe8, 0x4b, 0x6a, 0x80, 0xd4, 0x41, 0xc5, 0x3d, 0x74, 0xf4, 0x84, 0x51,
+	0xc5, 0xfd, 0x1e, 0x2a, 0x30, 0x7f, 0x9c, 0xcf, 0x1b, 0x3e, 0xfa, 0xe0, 0xe6, 0x95, 0xb4, 0xc7,
+	0xbc, 0xa5, 0x94, 0xf9, 0x6a, 0xf4, 0x15, 0xdf, 0xfc, 0x7e, 0x5a, 0xce, 0x58, 0x31, 0x7e, 0x18,
+	0x53, 0x97, 0x8c, 0x71, 0xb5, 0x35, 0x88, 0xb5, 0x83, 0x9d, 0x22, 0xd4, 0x60, 0xfa, 0x3e, 0xaa,
+	0x0d, 0x86, 0xd2, 0x7b, 0x71, 0x28, 0xb0, 0x7d, 0xc0, 0x0a, 0x46, 0xda, 0x96, 0xc2, 0x1e, 0xdb,
+	0x06, 0x52, 0xb6, 0x9b, 0xd1, 0x57, 0x4d, 0x35, 0xf3, 0xe0, 0x4c, 0xc8, 0xf9, 0xa4, 0xb3, 0x45,
+	0xd4, 0xa3, 0x0b, 0x19, 0x5f, 0xdb, 0xc3, 0xe0, 0x20, 0x3f, 0x6a, 0x44, 0xc1, 0xf3, 0x03, 0xc6,
+	0x93, 0x7b, 0x71, 0x48, 0xd9, 0xfe, 0xd1, 0xda, 0xe8, 0x9b, 0x4a, 0xf6, 0xb4, 0x4c, 0xcf, 0x0b,
+	0x26, 0x66, 0xf7, 0x17, 0xac, 0x7b, 0x53, 0x35, 0x57, 0xd3, 0x55, 0x39, 0x23, 0x62, 0x4a, 0x1c,
+	0xee, 0x89, 0x29, 0x49, 0x25, 0x95, 0x98, 0x3f, 0x36, 0xe1, 0xd3, 0xfe, 0x65, 0x5a, 0xce, 0xd9,
+	0x77, 0xdb, 0xaa, 0x9c, 0xd4, 0xf9, 0x24, 0xcb, 0x9a, 0x71, 0x82, 0x57, 0x3d, 0xe4, 0x4c, 0x0a,
+	0x76, 0x07, 0xf3, 0xce, 0x1a, 0x46, 0x95, 0x72, 0x57, 0xd5, 0x70, 0x0d, 0xa3, 0x8b, 0xaf, 0xab,
+	0x6a, 0x6a, 0x0d, 0xe3, 0x23, 0x81, 0xd5, 0x63, 0x3e, 0x07, 0xe1, 0x56, 0x8f, 0xdd, 0x49, 0xe7,
+	0x6e, 0x0c, 0xb1, 0x73, 0x80, 0x2e, 0xa8, 0xaa, 0xbc, 0xc8, 0xe7, 0xaf, 0xea, 0x8c, 0xf7, 0xa1,
+	0x4d, 0x3c, 0xcf, 0x0e, 0x42, 0xcc, 0x01, 0x04, 0xaa, 0xbc, 0xfd, 0x9d, 0x0d, 0xf5, 0xd5, 0xb8,
+	0xf4, 0xac, 0xa9, 0xae, 0x9f, 0xb3, 0x79, 0x3a, 0x5b, 0xa9, 0xc1, 0xf4, 0xc3, 0xd8, 0x28, 0x06,
+	0x69, 0x93, 0x88, 0x8f, 0x6e, 0xa8, 0xa5, 0xd2, 0xf3, 0xef, 0x6b, 0xa3, 0x7b, 0x5e, 0x3b, 0x51,
+	0x8d, 0x49, 0xa6, 0x7e, 0x52, 0x66, 0xa7, 0xac, 0xed, 0xd2, 0xa6, 0x1b, 0x7f, 0x2b, 0xd2, 0x06,
+	0x08, 0x1d, 0x93, 0xb6, 0x6f, 0xff, 0x5c, 0xba, 0xb6, 0xd6, 0xa7, 0x75, 0x3a, 0x63, 0x6a, 0xfc,
+	0xf1, 0x6b, 0x5d, 0x48, 0xe0, 0xe8, 0x73, 0x37, 0x86, 0xd8, 0x5a, 0x17, 0x82, 0xa3, 0x72, 0x99,
+	0x77, 0xec, 0x90, 0x95, 0xac, 0x09, 0x6b, 0x5d, 0xaa, 0xfa, 0x08, 0x51, 0xeb, 0x04, 0x6a, 0xf7,
+	0x0e, 0x1c, 0x6f, 0x32, 0xe3, 0x60, 0xef, 0xc0, 0x35, 0x20, 0x01, 0x62, 0xef, 0x00, 0x05, 0xed,
+	0x88, 0xea, 0xe5, 0xca, 0x44, 0x34, 0x5b, 0x91, 0xc4, 0x06, 0x31, 0xcd, 0xf6, 0x30, 0x98, 0x28,
+	0xc9, 0xee, 0x90, 0x1b, 0x89, 0x96, 0xa4, 0x44, 0x06, 0x95, 0xa4, 0x41, 0xd1, 0x92, 0x94, 0x8b,
+	0xa6, 0x48, 0x49, 0x4a, 0x60, 0x40, 0x49, 0x1a, 0xd0, 0x06, 0x39, 0x8e, 0x9f, 0xd7, 0x39, 0x7b,
+	0x03, 0x82, 0x1c, 0x57, 0x99, 0x8b, 0x89, 0x20, 0x07, 0xc1, 0x94, 0x87, 0x17, 0xa3, 0x5f, 0x16,
+	0xc2, 0xef, 0x56, 0x79, 0x39, 0x7e, 0x0f, 0x51, 0xe2, 0x02, 0x63, 0xf5, 0x36, 0x0d, 0x80, 0x14,
+	0xf3, 0xbf, 0xaa, 0x88, 0xe3, 0x3e, 0xa1, 0x04, 0x82, 0x8d, 0x07, 0x7d, 0x98, 0x8d, 0x2e, 0x85,
+	0x90, 0x8f, 0xca, 0xd3, 0xcb, 0xb4, 0xc9, 0xcb, 0xf9, 0x18, 0xd3, 0x75, 0xe4, 0x44, 0x74, 0x89,
+	0x71, 0xa0, 0x39, 0x29, 0xc5, 0x49, 0x5d, 0x37, 0x7c, 0xb0, 0xc7, 0x9a, 0x93, 0x8f, 0x44, 0x9b,
+	0x53, 0x80, 0xe2, 0xde, 0x0e, 0xd8, 0xac, 0xc8, 0xcb, 0xa8, 0x37, 0x85, 0x0c, 0xf1, 0x66, 0x51,
+	0xd0, 0x78, 0x9f, 0xb3, 0x74, 0xc9, 0x74, 0xce, 0xb0, 0x92, 0x71, 0x81, 0x68, 0xe3, 0x05, 0xa0,
+	0x5d, 0xca, 0x0b, 0xf1, 0x71, 0x7a, 0xc5, 0x78, 0x01, 0x33, 0x1e, 0x2a, 0x8c, 0x31, 0x7d, 0x8f,
+	0x20, 0x96, 0xf2, 0x38, 0xa9, 0x5c, 0x2d, 0x46, 0xef, 0x08, 0xf9, 0x49, 0xda, 0x74, 0xf9, 0x2c,
+	0xaf, 0xd3, 0x52, 0x2f, 0x11, 0xb1, 0x51, 0x24, 0xa0, 0x8c, 0xcb, 0x9d, 0x81, 0xb4, 0x72, 0xfb,
+	0x2f, 0x6b, 0xa3, 0x3b, 0xd0, 0xef, 0x09, 0x6b, 0xae, 0x73, 0xb1, 0xd3, 0xd0, 0xaa, 0x11, 0xf6,
+	0x93, 0xb8, 0xd1, 0x40, 0xc1, 0xa4, 0xe6, 0xd3, 0x9b, 0x2b, 0xaa, 0x84, 0xbd, 0x1d, 0xfd, 0x46,
+	0x50, 0x1e, 0x55, 0xc1, 0xa6, 0xac, 0x1b, 0xf7, 0x65, 0x51, 0x62, 0xc4, 0x82, 0x3d, 0x82, 0xdb,
+	0xc8, 0x76, 0xaa, 0xd6, 0x7d, 0x2f, 0x9b, 0x2c, 0xd8, 0x88, 0x9d, 0xea, 0xc5, 0x9c, 0x10, 0x12,
+	0x91, 0x6d, 0x00, 0x81, 0xb1, 0xe5, 0x55, 0xd9, 0x6a, 0xeb, 0xd8, 0xd8, 0x62, 0xc5, 0xd1, 0xb1,
+	0xc5, 0xc3, 0x94, 0x87, 0x4b, 0xd5, 0x35, 0x26, 0xb3, 0x2e, 0x5f, 0xe6, 0xdd, 0x8a, 0xef, 0x07,
+	0xa0, 0x2d, 0x56, 0x03, 0x62, 0xc7, 0x20, 0xda, 0x62, 0x21, 0x69, 0x77, 0x54, 0x3c, 0x4f, 0xd3,
+	0xc5, 0x79, 0x3b, 0x6b, 0xf2, 0x73, 0x86, 0x56, 0x90, 0x31, 0x62, 0xb0, 0x68, 0x05, 0xa1, 0xb8,
+	0xdd, 0xd0, 0xf4, 0x1c, 0xbf, 0x2a, 0x5b, 0xe3, 0x7a, 0x37, 0x66, 0xcb, 0x01, 0x89, 0x0d, 0xcd,
+	0xa8, 0x82, 0x72, 0xdf, 0xa9, 0xd8, 0x40, 0x53, 0xc7, 0x69, 0x73, 0x35, 0x65, 0xac, 0x44, 0x3b,
+	0xaa, 0x31, 0xa5, 0xa9, 0x68, 0x47, 0xc5, 0x68, 0x30, 0xc0, 0x4e, 0x16, 0x59, 0xde, 0x3d, 0xaf,
+	0xe6, 0x2a, 0xc6, 0x45, 0xeb, 0xcb, 0x43, 0xa2, 0x03, 0x6c, 0x80, 0xda, 0x19, 0xea, 0x64, 0x71,
+	0x5e, 0xe4, 0xed, 0x65, 0x5e, 0xce, 0xd5, 0x62, 0xd8, 0x6f, 0x81, 0x56, 0x0c, 0xd7, 0xc3, 0xeb,
+	0xbd, 0x1c, 0xe6, 0x44, 0x0d, 0x76, 0xa4, 0x13, 0x30, 0xcc, 0xad, 0xf7, 0x72, 0x76, 0x8f, 0xc2,
+	0x4a, 0x45, 0x67, 0xb8, 0x47, 0xa9, 0x7a, 0x1d, 0xe1, 0x7e, 0x0f, 0x65, 0xf7, 0x28, 0xdc, 0x3c,
+	0xb4, 0xfc, 0x18, 0xe0, 0x55, 0x93, 0x83, 0x3d, 0x0a, 0x2f, 0x7d, 0x9a, 0x21, 0xf6, 0x28, 0x28,
+	0xd6, 0xb6, 0x03, 0x4b, 0x1c, 0xb2, 0x6e, 0xda, 0xa5, 0xdd, 0xa2, 0x05, 0xed, 0xc0, 0xb1, 0x61,
+	0x10, 0xa2, 0x1d, 0x10, 0xa8, 0xf2, 0xf6, 0x7b, 0xa3, 0x91, 0xdc, 0x57, 0x14, 0x7b, 0xbf, 0x7e,
+	0xec, 0x24, 0x05, 0xfe, 0xc6, 0xef, 0x9d, 0x08, 0x61, 0x87, 0x57, 0xf9, 0xf7, 0x53, 0x76, 0xd1,
+	0xb0, 0xf6, 0x12, 0x0c, 0xaf, 0x4a, 0x47, 0x09, 0x89, 0xe1, 0x35, 0x80, 0x6c, 0xd8, 0x2e, 0x45,
+	0x7c, 0x09, 0x7f, 0x90, 0xa7, 0xf3, 0xb2, 0x6a, 0xbb, 0x7c, 0xd6, 0x82, 0xb0, 0x5d, 0xa9, 0x03,
+	0x88, 0x08, 0xdb, 0x49, 0xd8, 0xc6, 0x22, 0x12, 0x79, 0x56, 0x35, 0x33, 0x76, 0xca, 0x5a, 0xbe,
+	0x3f, 0xb1, 0x8e, 0x99, 0x70, 0x00, 0x22, 0x16, 0x41, 0x41, 0xbb, 0x7c, 0x93, 0x62, 0x71, 0x14,
+	0x30, 0x46, 0x4b, 0x5a, 0x88, 0x88, 0xe5, 0x1b, 0x40, 0x60, 0x05, 0x4f, 0x2f, 0xab, 0x37, 0x78,
+	0x05, 0x73, 0x49, 0xbc, 0x82, 0x15, 0x61, 0x4f, 0x48, 0x55, 0x42, 0xb1, 0x13, 0x52, 0x9d, 0x8c,
+	0xd8, 0x09, 0x29, 0x64, 0x6c, 0x5f, 0x73, 0x0d, 0x3f, 0xa9, 0xaa, 0xab, 0xeb, 0xb4, 0xb9, 0x02,
+	0x7d, 0xcd, 0x53, 0xd6, 0x0c, 0xd1, 0xd7, 0x28, 0xd6, 0xf6, 0x35, 0xd7, 0x21, 0x5f, 0xfc, 0xbf,
+	0x6a, 0x0a, 0xd0, 0xd7, 0x3c, 0x1b, 0x0a, 0x21, 0xfa, 0x1a, 0x81, 0xda, 0xd8, 0xc0, 0xf5, 0xc6,
+	0x23, 0x9d, 0xfb, 0xb4, 0xba, 0x1b, 0xe1, 0x3c, 0xe8, 0xc3, 0x60, 0x13, 0x3a, 0x6c, 0xd2, 0xfa,
+	0x12, 0x6f, 0x42, 0x42, 0x14, 0x6f, 0x42, 0x1a, 0x81, 0xf5, 0x3d, 0x65, 0x69, 0x33, 0xbb, 0xc4,
+	0xeb, 0x5b, 0xca, 0xe2, 0xf5, 0x6d, 0x18, 0x58, 0xdf, 0x52, 0xf0, 0x59, 0xde, 0x5d, 0x1e, 0xb3,
+	0x2e, 0xc5, 0xeb, 0xdb, 0x67, 0xe2, 0xf5, 0x1d, 0xb0, 0x76, 0xab, 0x4f, 0x75, 0xe5, 0x9c, 0xef,
+	0x9f, 0xd4, 0x05, 0x8f, 0x3f, 0x1b, 0xb6, 0xe4, 0x8b, 0xd6, 0x04, 0xed, 0xa8, 0x01, 0x47, 0x6c,
+	0xf5, 0xc5, 0x78, 0xbb, 0x00, 0x08, 0x9c, 0x4f, 0xea, 0xba, 0x58, 0x8d, 0xb7, 0x7b, 0x4c, 0x09,
+	0x8a, 0x88, 0x2b, 0x68, 0x3a, 0x18, 0x32, 0x45, 0xa1, 0xd8, 0x20, 0x2e, 0x52, 0x72, 0x61, 0x08,
+	0xb7, 0x3d, 0x0c, 0x56, 0x3e, 0x7f, 0xbc, 0x36, 0x7a, 0x4f, 0x37, 0xf5, 0xaa, 0x6d, 0x55, 0xb4,
+	0xed, 0xbb, 0xff, 0x08, 0x6f, 0xd3, 0x04, 0x4e, 0x9c, 0xd3, 0x0f, 0x50, 0x73, 0xd6, 0x41, 0x78,
+	0x92, 0xdc, 0xe8, 0xf2, 0x93, 0x21, 0xd6, 0xb1, 0x28, 0xf3, 0xd3, 0x9b, 0x2b, 0xda, 0x25, 0xa8,
+	0xaa, 0x1f, 0x2d, 0x3b, 0xca, 0xda, 0x31, 0x3a, 0x6d, 0xb8, 0x04, 0x11, 0xd0, 0xe3, 0x24, 0x6c,
+	0x0a, 0x87, 0x4d, 0xb5, 0xa8, 0xdb, 0x9e, 0xa6, 0x00, 0xa0, 0x78, 0x53, 0x08, 0x61, 0xbb, 0xcc,
+	0x73, 0x9b, 0x9f, 0x5b, 0xd8, 0x3b, 0x74, 0x9b, 0xc2, 0x8a, 0x38, 0x19, 0x8a, 0xdb, 0xe8, 0x53,
+	0x7b, 0xee, 0x0e, 0x58, 0x97, 0xe6, 0x45, 0x3b, 0x7e, 0x80, 0xdb, 0xd0, 0x72, 0x22, 0xfa, 0xc4,
+	0x38, 0x38, 0xa6, 0x1f, 0x2c, 0xea, 0x22, 0x9f, 0x85, 0x07, 0xf4, 0x4a, 0xd7, 0x88, 0xe3, 0x63,
+	0xba, 0x8b, 0xc1, 0x4a, 0x3b, 0x6b, 0xd2, 0xb2, 0xbd, 0x60, 0xcd, 0x59, 0x25, 0x9a, 0x14, 0x5e,
+	0x69, 0x00, 0x8a, 0x57, 0x5a, 0x08, 0xc3, 0x79, 0x91, 0x2f, 0x70, 0xa5, 0xf3, 0x55, 0xcd, 0xf0,
+	0x79, 0xd1, 0x43, 0xe2, 0xf3, 0x22, 0x44, 0x61, 0x19, 0x4e, 0x59, 0xf7, 0x3c, 0x5d, 0x55, 0x0b,
+	0x62, 0x5e, 0x34, 0xe2, 0x78, 0x19, 0xba, 0x18, 0x1c, 0x7a, 0xc5, 0x11, 0x6d, 0xc7, 0x9a, 0x32,
+	0x2d, 0x9e, 0x15, 0xe9, 0xbc, 0x1d, 0x13, 0xe3, 0x9a, 0x4f, 0xc5, 0x87, 0x5e, 0x84, 0x46, 0x8a,
+	0xf1, 0xa8, 0x7d, 0x96, 0x2e, 0xab, 0x26, 0xef, 0xe8, 0x62, 0xb4, 0x48, 0x6f, 0x31, 0x7a, 0x28,
+	0xea, 0x6d, 0xd2, 0xcc, 0x2e, 0xf3, 0x25, 0xcb, 0x22, 0xde, 0x34, 0x32, 0xc0, 0x9b, 0x83, 0x22,
+	0x95, 0x36, 0xad, 0x16, 0xcd, 0x8c, 0x91, 0x95, 0x26, 0xc5, 0xbd, 0x95, 0x66, 0x30, 0xe5, 0xe1,
+	0x2f, 0xd7, 0x46, 0xbf, 0x29, 0xa5, 0xee, 0x49, 0xfd, 0x41, 0xda, 0x5e, 0x9e, 0x57, 0x69, 0x93,
+	0x8d, 0x3f, 0xc0, 0xec, 0xa0, 0xa8, 0x71, 0xfd, 0xe8, 0x26, 0x2a, 0xb0, 0x58, 0xf9, 0xba, 0xd0,
+	0xf6, 0x72, 0xb4, 0x58, 0x3d, 0x24, 0x5e, 0xac, 0x10, 0x85, 0x83, 0x96, 0x90, 0xcb, 0x83, 0x9c,
+	0x07, 0xa4, 0xbe, 0x7f, 0x9a, 0xb3, 0xde, 0xcb, 0xc1, 0x31, 0x99, 0x0b, 0xfd, 0xd6, 0xb2, 0x43,
+	0xd9, 0xc0, 0x5b, 0x4c, 0x32, 0x14, 0x27, 0x3d, 0x9b, 0x5e, 0x11, 0xf7, 0x1c, 0xf4, 0x8c, 0x64,
+	0x28, 0x4e, 0x78, 0x76, 0x86, 0xb5, 0x98, 0x67, 0x64, 0x68, 0x4b, 0x86, 0xe2, 0x30, 0xca, 0x55,
+	0x8c, 0x9e, 0x8b, 0x1e, 0x46, 0xec, 0xc0, 0xf9, 0x68, 0x6b, 0x10, 0xab, 0x1c, 0xfe, 0xf5, 0xda,
+	0xe8, 0x1b, 0xd6, 0xe3, 0x71, 0x95, 0xe5, 0x17, 0x2b, 0x09, 0xbd, 0x4e, 0x8b, 0x05, 0x6b, 0xc7,
+	0x8f, 0x28, 0x6b, 0x21, 0x6b, 0x52, 0xf0, 0xf8, 0x46, 0x3a, 0xb0, 0xef, 0x88, 0x98, 0xf4, 0x8c,
+	0x5d, 0xd7, 0x05, 0xd9, 0x77, 0x3c, 0x24, 0xde, 0x77, 0x20, 0x0a, 0x57, 0x3f, 0x67, 0x15, 0x5f,
+	0x5b, 0xa1, 0xab, 0x1f, 0x21, 0x8a, 0xaf, 0x7e, 0x34, 0x02, 0xe3, 0xb3, 0xb3, 0x6a, 0xbf, 0x2a,
+	0x0a, 0x36, 0xeb, 0xc2, 0xdb, 0x7e, 0x46, 0xd3, 0x12, 0xf1, 0xf8, 0x0c, 0x90, 0x70, 0xa7, 0x41,
+	0x1c, 0x1f, 0x3c, 0x59, 0xf1, 0xeb, 0x8e, 0xf8, 0x4e, 0x83, 0x03, 0xc4, 0x77, 0x1a, 0x7c, 0x10,
+	0xee, 0x09, 0xbc, 0x2a, 0xb3, 0x0a, 0xdf, 0x13, 0xe0, 0x92, 0xf8, 0x9e, 0x80, 0x22, 0xa0, 0xc9,
+	0x53, 0x46, 0x99, 0x3c, 0x65, 0x7d, 0x26, 0x4f, 0x99, 0x6b, 0xd2, 0x1b, 0x0a, 0xd5, 0x6e, 0x28,
+	0x39, 0x14, 0x82, 0xad, 0xd0, 0xf5, 0x5e, 0x0e, 0xae, 0x6d, 0x95, 0x03, 0xb4, 0x45, 0x00, 0xe3,
+	0xef, 0x47, 0x19, 0xd8, 0xf4, 0xf5, 0xae, 0xc3, 0x33, 0xd6, 0xcd, 0x2e, 0xf1, 0xa6, 0xef, 0x21,
+	0xf1, 0xa6, 0x0f, 0x51, 0x98, 0x8d, 0xa3, 0x6b, 0x3a, 0x1b, 0x52, 0x16, 0xcf, 0x86, 0x61, 0x60,
+	0x25, 0x48, 0x81, 0xd8, 0x5f, 0x7d, 0x40, 0x2b, 0x7a, 0x3b, 0xac, 0xeb, 0xbd, 0x9c, 0x72, 0xf2,
+	0x4f, 0x66, 0xb9, 0x28, 0xa5, 0x2f, 0x2a, 0xde, 0x2f, 0x5e, 0xa7, 0x45, 0x9e, 0xa5, 0x1d, 0x3b,
+	0xab, 0xae, 0x58, 0x89, 0xaf, 0xcc, 0x54, 0x6a, 0x25, 0x9f, 0x78, 0x0a, 0xf1, 0x95, 0x59, 0x5c,
+	0x11, 0x56, 0xa1, 0xa4, 0x5f, 0xb5, 0x6c, 0x3f, 0x6d, 0x89, 0xd1, 0xcb, 0x43, 0xe2, 0x55, 0x08,
+	0x51, 0x18, 0xa3, 0x4a, 0xf9, 0xd3, 0xb7, 0x35, 0x6b, 0x72, 0x56, 0xce, 0x18, 0x1e, 0xa3, 0x42,
+	0x2a, 0x1e, 0xa3, 0x22, 0x34, 0x5c, 0x5e, 0x1c, 0xa4, 0x1d, 0x7b, 0xb2, 0x3a, 0xcb, 0xaf, 0x59,
+	0xdb, 0xa5, 0xd7, 0x35, 0xbe, 0xbc, 0x00, 0x50, 0x7c, 0x79, 0x11, 0xc2, 0xc1, 0xa2, 0x29, 0xed,
+	0xf8, 0xf9, 0x5f, 0x4b, 0x2d, 0x9a, 0xb4, 0xb8, 0x67, 0xd1, 0xe4, 0x60, 0xc1, 0xc6, 0x9e, 0x19,
+	0x66, 0xc3, 0xab, 0xc7, 0x90, 0x88, 0x5c, 0x3d, 0x26, 0x50, 0x58, 0x75, 0x16, 0x40, 0x8f, 0x76,
+	0x03, 0x2b, 0xd1, 0xa3, 0x5d, 0x9a, 0x0e, 0xb6, 0x4b, 0x0d, 0x33, 0xe5, 0x9d, 0xbf, 0x27, 0xe9,
+	0x53, 0x77, 0x10, 0xd8, 0x1a, 0xc4, 0xe2, 0xfb, 0xb3, 0xa7, 0xac, 0x48, 0xc5, 0x64, 0x18, 0xd9,
+	0x04, 0xd5, 0xcc, 0x90, 0xfd, 0x59, 0x87, 0x55, 0x0e, 0xff, 0x7c, 0x6d, 0xf4, 0x2e, 0xe6, 0xf1,
+	0x65, 0x2d, 0xfc, 0xee, 0xf5, 0xdb, 0x7a, 0x59, 0x7b, 0xde, 0x3f, 0xb8, 0x81, 0x86, 0xdd, 0x33,
+	0xd4, 0x22, 0x7b, 0xf5, 0x5a, 0x25, 0xc0, 0x0f, 0x05, 0x4d, 0xfa, 0x21, 0x47, 0xec, 0x19, 0xc6,
+	0x78, 0xdb, 0x53, 0xfc, 0x74, 0xb5, 0xa0, 0xa7, 0x18, 0x1b, 0x4a, 0x4c, 0xf4, 0x14, 0x04, 0xb3,
+	0x87, 0xbc, 0xbe, 0x07, 0x73, 0x2a, 0xbe, 0x13, 0xb3, 0x10, 0x9e, 0x8f, 0x27, 0x43, 0x71, 0x3b,
+	0xf0, 0xb8, 0xe5, 0xca, 0x37, 0x6b, 0x45, 0xf8, 0x08, 0x06, 0x1e, 0xaf, 0x90, 0x0c, 0x44, 0x0c,
+	0x3c, 0x24, 0x0c, 0x03, 0x2c, 0x0d, 0xf2, 0x41, 0x01, 0x9b, 0xa6, 0x8c, 0x21, 0x77, 0x48, 0xd8,
+	0xe8, 0x07, 0x61, 0x47, 0xd1, 0x62, 0xb5, 0x92, 0x7b, 0x18, 0xb3, 0x00, 0x56, 0x73, 0x5b, 0x83,
+	0x58, 0xe5, 0xf0, 0x4f, 0x47, 0x5f, 0x0f, 0x32, 0xf6, 0x8c, 0xa5, 0xdd, 0xa2, 0x61, 0xd9, 0x78,
+	0xb7, 0x27, 0xdd, 0x1a, 0x24, 0x8e, 0xcc, 0xa3, 0x0a, 0xc1, 0x92, 0x43, 0x73, 0xb2, 0x3d, 0x9b,
+	0x34, 0x3c, 0x8a, 0x99, 0xf4, 0xd9, 0xe8, 0x92, 0x83, 0xd6, 0x09, 0x76, 0x0d, 0xdc, 0xd6, 0x35,
+	0x59, 0xa6, 0x79, 0x21, 0xee, 0xf6, 0x7c, 0x10, 0x33, 0xea, 0xa1, 0xd1, 0x5d, 0x03, 0x52, 0x25,
+	0x98, 0x12, 0xc4, 0xe0, 0xe2, 0xac, 0x36, 0xb7, 0xe9, 0x21, 0x08, 0x59, 0x6c, 0xee, 0x0c, 0xa4,
+	0xed, 0xd5, 0x05, 0xfb, 0x67, 0xb7, 0x91, 0x63, 0x5e, 0x95, 0x2a, 0xd2, 0xd2, 0x77, 0x06, 0xd2,
+	0xf6, 0xbe, 0x46, 0xe8, 0x55, 0xcd, 0x80, 0xbb, 0xbd, 0xa6, 0xc0, 0x24, 0xb8, 0x37, 0x5c, 0x41,
+	0xb9, 0xff, 0x57, 0xb3, 0xb5, 0x2f, 0xfd, 0xf3, 0xcf, 0x62, 0x59, 0x99, 0xb1, 0x4c, 0x6b, 0xb4,
+	0x7c, 0x39, 0xf8, 0x29, 0x6d, 0xd7, 0x28, 0x24, 0xae, 0x86, 0x49, 0xd1, 0x6f, 0xfd, 0x1c, 0x9a,
+	0x2a, 0x69, 0xff, 0xb9, 0x36, 0xda, 0x44, 0x93, 0xa6, 0x1b, 0xae, 0x97, 0xc4, 0xdf, 0x1d, 0xe2,
+	0x08, 0xd3, 0x34, 0x49, 0x9d, 0xfc, 0x3f, 0x2c, 0xa8, 0x24, 0xff, 0xdb, 0xda, 0xe8, 0xae, 0x55,
+	0xe4, 0xcd, 0x9b, 0xdf, 0x38, 0x2e, 0xf2, 0x59, 0x27, 0x2e, 0x40, 0x28, 0x15, 0xba, 0x38, 0x29,
+	0x8d, 0xfe, 0xe2, 0x8c, 0x68, 0xaa, 0xb4, 0xfd, 0xe3, 0xda, 0xe8, 0xb6, 0x5b, 0x9c, 0xe2, 0xf6,
+	0x84, 0xdc, 0xec, 0xd5, 0x8a, 0xed, 0xf8, 0x63, 0xba, 0x0c, 0x30, 0xde, 0xa4, 0xeb, 0x93, 0x1b,
+	0xeb, 0x05, 0x3b, 0x04, 0xab, 0xda, 0x5e, 0x2a, 0xdb, 0xa0, 0xcc, 0x05, 0x33, 0xe7, 0xe6, 0x00,
+	0xd2, 0xba, 0xfa, 0x4e, 0xde, 0x76, 0x55, 0xb3, 0xe2, 0x47, 0xf2, 0xfa, 0xdb, 0x6d, 0xdf, 0x95,
+	0x02, 0x12, 0x87, 0x20, 0x5c, 0xe1, 0x64, 0xe0, 0xca, 0x7e, 0xe3, 0xdd, 0x12, 0xae, 0x1c, 0xa2,
+	0xc7, 0x95, 0x4f, 0xda, 0x69, 0x59, 0xe7, 0xca, 0x88, 0xc1, 0xb4, 0x6c, 0x92, 0x1a, 0x7e, 0x94,
+	0xbe, 0xd1, 0x0f, 0xda, 0x55, 0x81, 0x12, 0x1f, 0xe4, 0x17, 0x17, 0x26, 0x4f, 0x78, 0x4a, 0x5d,
+	0x84, 0x58, 0x15, 0x10, 0xa8, 0xdd, 0x71, 0xb4, 0x05, 0xf8, 0xa4, 0xa8, 0x66, 0x57, 0xc6, 0xe3,
+	0x0e, 0x55, 0x36, 0x1e, 0x46, 0x84, 0x56, 0x11, 0xdc, 0x86, 0x1f, 0x0a, 0x3a, 0x65, 0xfc, 0x3f,
+	0x26, 0x38, 0xb8, 0xe3, 0xa8, 0xed, 0x78, 0x0c, 0x11, 0x7e, 0x50, 0xac, 0xdd, 0x25, 0x78, 0x96,
+	0x17, 0x4c, 0x9c, 0x22, 0xbd, 0xbc, 0xb8, 0x28, 0xaa, 0x34, 0x03, 0xbb, 0x04, 0x5c, 0x9c, 0xb8,
+	0x72, 0x62, 0x97, 0x00, 0xe3, 0xec, 0xbd, 0x22, 0x2e, 0xe5, 0x23, 0x59, 0x39, 0xcb, 0x0b, 0xf8,
+	0x81, 0x95, 0xd0, 0x34, 0x42, 0xe2, 0x5e, 0x51, 0x00, 0xd9, 0x38, 0x9b, 0x8b, 0xf8, 0x08, 0xa4,
+	0xd3, 0x7f, 0x3f, 0x54, 0x74, 0xc4, 0x44, 0x9c, 0x8d, 0x60, 0x76, 0x83, 0x8c, 0x0b, 0x5f, 0xd5,
+	0xc2, 0xf8, 0xed, 0x50, 0xeb, 0x55, 0xed, 0xd9, 0xbd, 0x13, 0x21, 0xec, 0xa6, 0x0f, 0xff, 0xfb,
+	0x41, 0xf5, 0xa6, 0x14, 0x46, 0xef, 0x86, 0x2a, 0x5a, 0x46, 0x6c, 0xfa, 0x40, 0xc6, 0x76, 0x7d,
+	0x61, 0x38, 0x6f, 0x67, 0x69, 0x93, 0x9d, 0x34, 0x4c, 0x98, 0xdf, 0x40, 0x54, 0x3d, 0x82, 0xe8,
+	0xfa, 0x38, 0xe9, 0xbb, 0x3a, 0xba, 0x4e, 0xe7, 0x4c, 0x1e, 0x47, 0x56, 0xcd, 0x35, 0xe6, 0xca,
+	0x27, 0x62, 0xae, 0x02, 0x52, 0xb9, 0xfa, 0xde, 0xe8, 0x97, 0x44, 0xae, 0x9a, 0xaa, 0x1e, 0xdf,
+	0x42, 0x52, 0xd8, 0x38, 0x1f, 0x59, 0xbd, 0x47, 0xca, 0xed, 0xad, 0x43, 0xd3, 0xe2, 0x5f, 0xb5,
+	0xe9, 0x1c, 0x7e, 0x19, 0x69, 0xdb, 0xb1, 0x90, 0x12, 0xb7, 0x0e, 0x43, 0xca, 0x6f, 0xeb, 0x2f,
+	0xaa, 0x4c, 0x59, 0x47, 0xea, 0xcd, 0x08, 0x63, 0x6d, 0xdd, 0x85, 0xec, 0x28, 0x28, 0x92, 0xce,
+	0xba, 0xc9, 0xa2, 0xab, 0x4c, 0xeb, 0x41, 0x4a, 0x12, 0x20, 0xc4, 0x28, 0x48, 0xa0, 0x76, 0x6c,
+	0xe7, 0xc0, 0x7e, 0x3a, 0xbb, 0xb4, 0x2d, 0x15, 0xe9, 0xf3, 0x1e, 0x40, 0x8c, 0xed, 0x28, 0x68,
+	0x47, 0x5b, 0xe3, 0x47, 0x7e, 0x8e, 0x61, 0xbc, 0xed, 0x10, 0x46, 0x7c, 0x8c, 0x18, 0x6d, 0x23,
+	0xb8, 0xdf, 0x84, 0x55, 0x09, 0xe8, 0xe1, 0x63, 0x83, 0x2c, 0x23, 0x38, 0x82, 0x6c, 0x0e, 0x20,
+	0xed, 0x9a, 0x99, 0xcb, 0x1d, 0x99, 0xba, 0x1d, 0xba, 0x15, 0xda, 0x08, 0x20, 0x62, 0xcd, 0x4c,
+	0xc2, 0xd6, 0xe7, 0x8b, 0x74, 0x99, 0xcf, 0xcd, 0x5a, 0x4a, 0x06, 0x28, 0xd0, 0xa7, 0x65, 0x12,
+	0x07, 0x22, 0x7c, 0x92, 0xb0, 0x13, 0xe7, 0x59, 0xe6, 0x50, 0x9f, 0xab, 0xf1, 0xaf, 0xab, 0xf9,
+	0xaa, 0x9e, 0x9f, 0x66, 0xc0, 0x38, 0xcf, 0x31, 0x89, 0xf3, 0x44, 0x9c, 0x37, 0x44, 0xcf, 0xee,
+	0x04, 0xe9, 0x43, 0x27, 0x7b, 0xc3, 0x4f, 0x6a, 0x80, 0x9d, 0x20, 0x8d, 0x25, 0x90, 0x23, 0x76,
+	0x82, 0x62, 0xbc, 0x1d, 0x11, 0x8c, 0xf3, 0xa2, 0x2a, 0xe1, 0x88, 0x60, 0x2d, 0x70, 0x21, 0x31,
+	0x22, 0x04, 0x90, 0xed, 0xa3, 0x5a, 0x24, 0x8f, 0x31, 0xf8, 0x07, 0xf7, 0xeb, 0xb8, 0xaa, 0x01,
+	0x88, 0x3e, 0x8a, 0x82, 0x36, 0x2e, 0xd1, 0x62, 0x1e, 0x07, 0xa6, 0x4d, 0xce, 0x17, 0xcd, 0x30,
+	0x2e, 0x31, 0x16, 0x5c, 0x86, 0x88, 0x4b, 0x28, 0xd6, 0xd9, 0x3f, 0xd4, 0xc8, 0x51, 0x39, 0x2b,
+	0x16, 0x19, 0xe3, 0xd7, 0x7c, 0xf5, 0x95, 0xbf, 0x3d, 0xdc, 0x56, 0x48, 0x12, 0xfb, 0x87, 0x71,
+	0x8d, 0xb0, 0xd5, 0x38, 0x98, 0xbc, 0xf8, 0x97, 0xf4, 0x9a, 0xf3, 0xaf, 0xfe, 0xed, 0x0e, 0xe6,
+	0x6d, 0x5c, 0xf3, 0xdd, 0x6a, 0xc1, 0xaf, 0xa6, 0xbc, 0xac, 0x59, 0xf9, 0xa2, 0x0a, 0xae, 0x27,
+	0x29, 0x69, 0xa2, 0xc5, 0x44, 0x5c, 0x83, 0x60, 0x76, 0xf4, 0x53, 0xc2, 0x03, 0x71, 0x1b, 0x15,
+	0x3b, 0x1e, 0xd5, 0xda, 0x0e, 0x41, 0x8c, 0x7e, 0x38, 0x19, 0xb8, 0xe2, 0x17, 0xd9, 0x59, 0xc7,
+	0x17, 0x89, 0x2d, 0xe1, 0xca, 0x21, 0x7a, 0x5c, 0xf9, 0x64, 0xe0, 0x6a, 0xda, 0xeb, 0x6a, 0x3a,
+	0xd8, 0xd5, 0x94, 0x70, 0xb5, 0x9f, 0x16, 0xac, 0xcc, 0xd2, 0x46, 0x76, 0x19, 0xf1, 0x09, 0xa5,
+	0xef, 0x4a, 0x03, 0x89, 0x25, 0x08, 0x57, 0x38, 0x69, 0x83, 0x16, 0x2d, 0x57, 0xe7, 0x84, 0xf7,
+	0x70, 0x65, 0x70, 0x52, 0x78, 0xbf, 0x87, 0x0a, 0x73, 0xf2, 0x8c, 0xb1, 0x4c, 0xdd, 0x0e, 0x27,
+	0x72, 0x62, 0x89, 0xbe, 0x9c, 0x78, 0xa4, 0x5d, 0x70, 0xb8, 0xae, 0x90, 0x63, 0x49, 0x4f, 0x3d,
+	0x72, 0x2c, 0x89, 0x71, 0x78, 0x7e, 0xd4, 0x86, 0x56, 0x24, 0x3f, 0x60, 0x27, 0x6b, 0x73, 0x00,
+	0x69, 0xfb, 0xa9, 0xeb, 0xea, 0x30, 0xb8, 0x1a, 0xee, 0x69, 0x1f, 0x92, 0x57, 0xc3, 0x11, 0xcc,
+	0x79, 0xe7, 0x87, 0x9d, 0x5f, 0x56, 0xd5, 0x15, 0xfa, 0xf4, 0x85, 0x92, 0xc5, 0x9f, 0xbe, 0x08,
+	0x20, 0x7b, 0xf1, 0x42, 0x89, 0x44, 0x45, 0xdc, 0x41, 0x95, 0xbc, 0x3a, 0xb8, 0x1b, 0x43, 0x82,
+	0x14, 0xab, 0x92, 0xc7, 0x53, 0x0c, 0x0a, 0xfd, 0x5e, 0x1c, 0x72, 0x9e, 0xb3, 0x91, 0xa2, 0x03,
+	0x56, 0xe4, 0x4b, 0xd6, 0xc8, 0xcf, 0xe8, 0x36, 0x51, 0x65, 0x17, 0xa1, 0x9e, 0xb3, 0xc1, 0x51,
+	0xe5, 0xed, 0x74, 0xf4, 0x05, 0x1e, 0x4a, 0xe8, 0x69, 0xc7, 0x5f, 0xfc, 0x39, 0x12, 0x62, 0xf1,
+	0xe7, 0x13, 0xb6, 0x2f, 0xbf, 0x2a, 0xdb, 0xba, 0x48, 0xdb, 0x4b, 0x75, 0x2d, 0xdf, 0xcf, 0xb9,
+	0x16, 0xc2, 0x8b, 0xf9, 0xf7, 0x7b, 0x28, 0xdb, 0xc1, 0xb4, 0xcc, 0xc4, 0xd1, 0x0f, 0x70, 0xd5,
+	0x20, 0x80, 0x5e, 0xef, 0xe5, 0x6c, 0xcc, 0x7e, 0x98, 0x16, 0x05, 0x6b, 0x56, 0x5a, 0x76, 0x9c,
+	0x96, 0xf9, 0x05, 0x6b, 0xe1, 0x27, 0xa0, 0x8a, 0x4a, 0x20, 0x46, 0xc4, 0xec, 0x11, 0xdc, 0x46,
+	0x22, 0xc0, 0xf3, 0x51, 0x99, 0xb1, 0xb7, 0x20, 0x12, 0x81, 0x76, 0x04, 0x43, 0x44, 0x22, 0x14,
+	0xeb, 0x75, 0x91, 0x2c, 0x5d, 0x4e, 0xc5, 0x1b, 0x11, 0x41, 0x17, 0xc9, 0xd2, 0x65, 0x32, 0xf5,
+	0x9e, 0x82, 0xb8, 0x1b, 0x43, 0xec, 0xa6, 0x82, 0xb6, 0x5a, 0xd5, 0xa0, 0x5d, 0x19, 0x0d, 0x67,
+	0x59, 0x7b, 0x27, 0x42, 0x40, 0x93, 0xe2, 0x49, 0x24, 0xd4, 0xa4, 0xf7, 0x18, 0xd2, 0x9d, 0x08,
+	0x61, 0xf3, 0x2e, 0x36, 0x8c, 0xd4, 0xde, 0x87, 0xaf, 0x21, 0x24, 0x70, 0xf3, 0xe3, 0x6e, 0x0c,
+	0xb1, 0xbb, 0x1f, 0x42, 0xa0, 0xbe, 0x7a, 0x18, 0x63, 0x3a, 0x4a, 0x46, 0xec, 0x7e, 0x40, 0x06,
+	0x24, 0x57, 0x8d, 0x93, 0x58, 0x72, 0xc1, 0x28, 0x79, 0x37, 0x86, 0xd8, 0x72, 0x15, 0x82, 0x69,
+	0x5d, 0xe4, 0x1d, 0x28, 0x57, 0xa9, 0x21, 0x24, 0x44, 0xb9, 0xfa, 0x04, 0x30, 0x79, 0xcc, 0x9a,
+	0x39, 0x43, 0x4d, 0x0a, 0x49, 0xd4, 0xa4, 0x26, 0xec, 0x53, 0x0b, 0x32, 0xef, 0x55, 0xbd, 0x02,
+	0x4f, 0x2d, 0xa8, 0x6c, 0x55, 0xf5, 0x8a, 0x78, 0x6a, 0xc1, 0x03, 0x40, 0x12, 0x4f, 0xd2, 0xb6,
+	0xc3, 0x93, 0x28, 0x24, 0xd1, 0x24, 0x6a, 0xc2, 0x6e, 0xe3, 0xc8, 0x24, 0x2e, 0x3a, 0xb0, 0x8d,
+	0xa3, 0x12, 0xe0, 0xdc, 0x0f, 0x7f, 0x8f, 0x94, 0xdb, 0x51, 0x54, 0xd6, 0x0a, 0xeb, 0x9e, 0xe5,
+	0xac, 0xc8, 0x5a, 0x30, 0x8a, 0xaa, 0x72, 0xd7, 0x52, 0x62, 0x14, 0x0d, 0x29, 0xd0, 0x94, 0xd4,
+	0xe5, 0x32, 0x2c, 0x77, 0xe0, 0x6e, 0xd9, 0xdd, 0x18, 0x62, 0xc7, 0x66, 0x9d, 0xe8, 0xfd, 0xb4,
+	0x69, 0x72, 0xbe, 0x3f, 0xf4, 0x00, 0x4f, 0x90, 0x96, 0x13, 0x63, 0x33, 0xc6, 0x81, 0xee, 0xa5,
+	0x27, 0x2d, 0x2c, 0x61, 0x70, 0xda, 0x7a, 0x3f, 0xca, 0xd8, 0x50, 0x47, 0x48, 0x9c, 0x0b, 0xce,
+	0x58, 0x69, 0x22, 0xf7, 0x9b, 0x1f, 0xf4, 0x61, 0xce, 0xeb, 0x52, 0xc6, 0x05, 0x7f, 0xc2, 0xe8,
+	0xac, 0x7a, 0xfa, 0x36, 0x6f, 0x79, 0xdc, 0xad, 0x56, 0xeb, 0x8f, 0x09, 0x4b, 0x18, 0x4c, 0xbc,
+	0x2e, 0xd5, 0xab, 0x64, 0x97, 0x7f, 0x20, 0x2d, 0x2f, 0xd8, 0x1b, 0x74, 0xd3, 0x00, 0x5a, 0x34,
+	0x1c, 0xb1, 0xfc, 0x8b, 0xf1, 0xf6, 0x5a, 0x80, 0x71, 0xae, 0xde, 0x75, 0x3d, 0xab, 0xf4, 0xfe,
+	0x0d, 0x65, 0x0d, 0x82, 0xc4, 0xc9, 0x6c, 0x54, 0xc1, 0x46, 0xd0, 0xc6, 0xbf, 0xed, 0x62, 0x1b,
+	0x84, 0x9d, 0xb0, 0x9b, 0x6d, 0x0e, 0x20, 0x11, 0x57, 0xf6, 0x96, 0x3e, 0xe5, 0x2a, 0xbc, 0xa4,
+	0xbf, 0x39, 0x80, 0x74, 0xae, 0x18, 0xb8, 0xd9, 0x7a, 0x92, 0xce, 0xae, 0xe6, 0x4d, 0xb5, 0x28,
+	0xb3, 0xfd, 0xaa, 0xa8, 0x1a, 0x70, 0xc5, 0xc0, 0x4b, 0x35, 0x40, 0x89, 0x2b, 0x06, 0x3d, 0x2a,
+	0x76, 0xd7, 0xc6, 0x4d, 0xc5, 0xa4, 0xc8, 0xe7, 0xf0, 0xd4, 0xcc, 0x33, 0x24, 0x00, 0x62, 0xd7,
+	0x06, 0x05, 0x91, 0x46, 0x24, 0x4f, 0xd5, 0xba, 0x7c, 0x96, 0x16, 0xd2, 0xdf, 0x2e, 0x6d, 0xc6,
+	0x03, 0x7b, 0x1b, 0x11, 0xa2, 0x80, 0xe4, 0xf3, 0x6c, 0xd1, 0x94, 0x47, 0x65, 0x57, 0x91, 0xf9,
+	0xd4, 0x40, 0x6f, 0x3e, 0x1d, 0x10, 0x0c, 0xab, 0x67, 0xec, 0x2d, 0x4f, 0x0d, 0xff, 0x0f, 0x1b,
+	0x56, 0xf9, 0xdf, 0x13, 0x25, 0x8f, 0x0d, 0xab, 0x80, 0x03, 0x99, 0x51, 0x4e, 0x64, 0x83, 0x89,
+	0x68, 0xfb, 0xcd, 0x64, 0xa3, 0x1f, 0xc4, 0xfd, 0x4c, 0xbb, 0x55, 0xc1, 0x62, 0x7e, 0x04, 0x30,
+	0xc4, 0x8f, 0x06, 0xed, 0x42, 0xca, 0xcb, 0xcf, 0x25, 0x9b, 0x5d, 0x05, 0x1f, 0x1d, 0xf9, 0x09,
+	0x95, 0x08, 0xb1, 0x90, 0x22, 0x50, 0xbc, 0x8a, 0x8e, 0x66, 0x55, 0x19, 0xab, 0x22, 0x2e, 0x1f,
+	0x52, 0x45, 0x8a, 0xb3, 0x1b, 0xde, 0x46, 0xaa, 0x5a, 0xa6, 0xac, 0xa6, 0x2d, 0xc2, 0x82, 0x0b,
+	0x11, 0x1b, 0xde, 0x24, 0x6c, 0xd7, 0x23, 0xd0, 0xe7, 0x71, 0xf8, 0xe5, 0x7b, 0x60, 0xe5, 0x98,
+	0xfe, 0xf2, 0x9d, 0x62, 0xe9, 0x4c, 0xca, 0x36, 0xd2, 0x63, 0xc5, 0x6f, 0x27, 0xdb, 0xc3, 0x60,
+	0xbb, 0xdc, 0xf3, 0x7c, 0xee, 0x17, 0x2c, 0x6d, 0xa4, 0xd7, 0x9d, 0x88, 0x21, 0x8b, 0x11, 0xcb,
+	0xbd, 0x08, 0x0e, 0x86, 0x30, 0xcf, 0xf3, 0x7e, 0x55, 0x76, 0xac, 0xec, 0xb0, 0x21, 0xcc, 0x37,
+	0xa6, 0xc0, 0xd8, 0x10, 0x46, 0x29, 0x80, 0x76, 0xab, 0xce, 0x89, 0x5e, 0xa4, 0xd7, 0x68, 0xc4,
+	0xa6, 0xcf, 0x7e, 0xb8, 0x3c, 0xd6, 0x6e, 0x01, 0xe7, 0x6c, 0x76, 0xbb, 0x5e, 0xce, 0xd2, 0x66,
+	0x6e, 0x4e, 0x34, 0xb2, 0xf1, 0x1e, 0x6d, 0xc7, 0x27, 0x89, 0xcd, 0xee, 0xb8, 0x06, 0x18, 0x76,
+	0xc4, 0x19, 0xac, 0xce, 0x29, 0x92, 0x03, 0x21, 0x0f, 0xb2, 0xba, 0xd1, 0x0f, 0x02, 0x3f, 0xaf,
+	0xf3, 0x8c, 0x55, 0x11, 0x3f, 0x42, 0x3e, 0xc4, 0x0f, 0x04, 0x41, 0xf4, 0x26, 0x8e, 0x16, 0xe5,
+	0xcb, 0xeb, 0x65, 0xa6, 0xd6, 0xb1, 0x09, 0x51, 0x3c, 0x80, 0x8b, 0x45, 0x6f, 0x04, 0x0f, 0xfa,
+	0xa8, 0xbe, 0x99, 0x10, 0xeb, 0xa3, 0xe6, 0xe2, 0xc1, 0x90, 0x3e, 0x8a, 0xc1, 0xca, 0xe7, 0x0f,
+	0x55, 0x1f, 0x3d, 0x48, 0xbb, 0x94, 0xc7, 0xed, 0x7c, 0x03, 0x59, 0x2d, 0x84, 0x91, 0xfc, 0x6a,
+	0x2a, 0xe1, 0x18, 0x5c, 0x15, 0xef, 0x0e, 0xe6, 0x23, 0xbe, 0xd5, 0x0a, 0xa1, 0xd7, 0x37, 0x58,
+	0x2a, 0xec, 0x0e, 0xe6, 0x23, 0xbe, 0xd5, 0xfb, 0xa6, 0xbd, 0xbe, 0xc1, 0x23, 0xa7, 0xbb, 0x83,
+	0x79, 0xe5, 0xfb, 0x2f, 0x74, 0xc7, 0x75, 0x9d, 0xf3, 0x38, 0x6c, 0xd6, 0xe5, 0x4b, 0x86, 0x85,
+	0x93, 0xbe, 0x3d, 0x83, 0xc6, 0xc2, 0x49, 0x5a, 0xc5, 0xf9, 0x99, 0x07, 0x2c, 0x15, 0x27, 0x55,
+	0x9b, 0x8b, 0x33, 0x9d, 0xc7, 0x03, 0x8c, 0x6a, 0x38, 0xb6, 0x68, 0x8a, 0x29, 0xd9, 0xdb, 0xb3,
+	0x1e, 0x6a, 0xbf, 0x31, 0xde, 0x8e, 0xd8, 0x0b, 0x3f, 0x35, 0xde, 0x19, 0x48, 0xdb, 0x7b, 0xac,
+	0x1e, 0xa3, 0x6f, 0x20, 0x4e, 0x19, 0x3a, 0x4b, 0x18, 0x53, 0x9a, 0x4b, 0xdc, 0xab, 0x98, 0x7b,
+	0xc3, 0x15, 0x7a, 0xdc, 0xf3, 0xfb, 0xbb, 0x83, 0xdc, 0xbb, 0x57, 0x78, 0xf7, 0x86, 0x2b, 0x28,
+	0xf7, 0x7f, 0xa5, 0x97, 0x35, 0xd0, 0xbf, 0xea, 0x83, 0x8f, 0x86, 0x58, 0x04, 0xfd, 0xf0, 0xf1,
+	0x8d, 0x74, 0x54, 0x42, 0xfe, 0x56, 0xaf, 0xdf, 0x35, 0x2a, 0x1e, 0x97, 0x10, 0x37, 0x21, 0x55,
+	0x97, 0x8c, 0xb5, 0x2a, 0x0b, 0xc3, 0x8e, 0xf9, 0xd1, 0x0d, 0xb5, 0x9c, 0xdf, 0x1c, 0xf1, 0x60,
+	0xf5, 0x60, 0x96, 0x93, 0x9e, 0x98, 0x65, 0x87, 0x86, 0x09, 0xfa, 0xf8, 0xa6, 0x6a, 0x54, 0x57,
+	0x75, 0x60, 0xf1, 0xe0, 0xf3, 0xe3, 0x81, 0x86, 0xbd, 0x27, 0xa0, 0x3f, 0xbc, 0x99, 0x92, 0x4a,
+	0xcb, 0x7f, 0xac, 0x8d, 0xee, 0x7b, 0xac, 0xbd, 0xc2, 0x00, 0x36, 0x5d, 0xbe, 0x1d, 0xb1, 0x4f,
+	0x29, 0x99, 0xc4, 0xfd, 0xf6, 0xcf, 0xa7, 0x6c, 0x3f, 0x72, 0xf1, 0x54, 0x9e, 0xe5, 0x45, 0xc7,
+	0x9a, 0xf0, 0xb7, 0x21, 0x7c, 0xbb, 0x92, 0x4a, 0xe8, 0xdf, 0x86, 0x88, 0xe0, 0xce, 0x6f, 0x43,
+	0x20, 0x9e, 0xd1, 0xdf, 0x86, 0x40, 0xad, 0x45, 0x7f, 0x1b, 0x22, 0xae, 0x41, 0xcd, 0x2e, 0x3a,
+	0x09, 0x72, 0xdb, 0x7c, 0x90, 0x45, 0x7f, 0x17, 0xfd, 0xd1, 0x4d, 0x54, 0x88, 0xf9, 0x55, 0x72,
+	0xe2, 0x73, 0xb5, 0x01, 0x65, 0xea, 0x7d, 0xb2, 0xb6, 0x3b, 0x98, 0x57, 0xbe, 0x7f, 0x30, 0xfa,
+	0x8a, 0x47, 0x71, 0x29, 0xaf, 0xfb, 0xad, 0xd8, 0xec, 0xc0, 0x2d, 0xb8, 0x35, 0xbf, 0x3d, 0x0c,
+	0x26, 0xb2, 0xcb, 0x09, 0x55, 0xe9, 0x49, 0x9f, 0x21, 0x50, 0xe5, 0xbb, 0x83, 0x79, 0x62, 0x1a,
+	0x91, 0xbe, 0x65, 0x6d, 0x0f, 0x30, 0xe6, 0xd7, 0xf5, 0xde, 0x70, 0x05, 0xe5, 0x7e, 0x39, 0xfa,
+	0xaa, 0x87, 0x71, 0x8a, 0xff, 0x8b, 0x76, 0x35, 0x61, 0x6a, 0xea, 0x55, 0x73, 0x32, 0x14, 0x8f,
+	0xc5, 0x2f, 0xee, 0x14, 0xda, 0x17, 0xbf, 0xa0, 0xd3, 0xe8, 0x87, 0x37, 0x53, 0x52, 0x69, 0xf9,
+	0x87, 0xb5, 0xd1, 0x7b, 0x64, 0x5a, 0x54, 0x3b, 0xf8, 0x78, 0xa8, 0x65, 0xd0, 0x1e, 0x3e, 0xb9,
+	0xb1, 0x9e, 0x4a, 0xd4, 0x3f, 0xaf, 0x8d, 0x6e, 0x47, 0x12, 0x25, 0x1b, 0xc8, 0x0d, 0xac, 0xfb,
+	0x0d, 0xe5, 0xd3, 0x9b, 0x2b, 0x52, 0xd3, 0xbd, 0x8b, 0x4f, 0xc3, 0x77, 0xfe, 0x23, 0xb6, 0xa7,
+	0xf4, 0x3b, 0xff, 0xfd, 0x5a, 0x70, 0x8f, 0x29, 0x3d, 0xd7, 0x6b, 0x3e, 0x74, 0x8f, 0x89, 0x8b,
+	0xe3, 0x2f, 0xa3, 0x62, 0x1c, 0xe6, 0xe4, 0xe9, 0xdb, 0x3a, 0x2d, 0x33, 0xda, 0x89, 0x94, 0xf7,
+	0x3b, 0x31, 0x1c, 0xdc, 0x9b, 0xe3, 0xd2, 0xd3, 0x4a, 0xaf, 0xe3, 0x36, 0x29, 0x7d, 0x83, 0x44,
+	0xf7, 0xe6, 0x02, 0x94, 0xf0, 0xa6, 0xa2, 0xc6, 0x98, 0x37, 0x10, 0x2c, 0x3e, 0x1c, 0x82, 0x82,
+	0x15, 0x82, 0xf1, 0x66, 0xb6, 0xfc, 0xb7, 0x63, 0x56, 0x82, 0x6d, 0xff, 0x9d, 0x81, 0x34, 0xe1,
+	0x76, 0xca, 0xba, 0xef, 0xb0, 0x94, 0x7f, 0xee, 0x13, 0x73, 0x6b, 0xa8, 0x41, 0x6e, 0x5d, 0x1a,
+	0x73, 0xbb, 0x5f, 0x15, 0x8b, 0xeb, 0x52, 0x55, 0x26, 0xe9, 0xd6, 0xa5, 0xfa, 0xdd, 0x02, 0x1a,
+	0xee, 0x4a, 0x5a, 0xb7, 0x22, 0xbc, 0x7c, 0x18, 0x37, 0xe3, 0x45, 0x95, 0x5b, 0x83, 0x58, 0x3a,
+	0x9f, 0xaa, 0x19, 0xf5, 0xe4, 0x13, 0xb4, 0xa4, 0x9d, 0x81, 0x34, 0xdc, 0x1e, 0x74, 0xdc, 0x9a,
+	0xf6, 0xb4, 0xdb, 0x63, 0x2b, 0x68, 0x52, 0x7b, 0xc3, 0x15, 0xe0, 0x66, 0xac, 0x6a, 0x55, 0x7c,
+	0x6b, 0xe6, 0x59, 0x5e, 0x14, 0xe3, 0xad, 0x48, 0x33, 0xd1, 0x50, 0x74, 0x33, 0x16, 0x81, 0x89,
+	0x96, 0xac, 0x37, 0x2f, 0xcb, 0x71, 0x9f, 0x1d, 0x41, 0x0d, 0x6a, 0xc9, 0x2e, 0x0d, 0x36, 0xd4,
+	0x9c, 0xa2, 0x36, 0xb9, 0x4d, 0xe2, 0x05, 0x17, 0x64, 0x78, 0x77, 0x30, 0x0f, 0x4e, 0xfb, 0x05,
+	0x35, 0x0d, 0xef, 0x3f, 0x5a, 0xa1, 0x3f, 0x93, 0xdc, 0xef, 0xa1, 0xc0, 0xa6, 0xa4, 0xec, 0x46,
+	0x9f, 0xe5, 0xd9, 0x9c, 0x75, 0xe8, 0x41, 0x95, 0x0b, 0x44, 0x0f, 0xaa, 0x00, 0x08, 0xaa, 0x4e,
+	0xfe, 0xdd, 0xec, 0xc6, 0x1e, 0x65, 0x58, 0xd5, 0x29, 0x65, 0x87, 0x8a, 0x55, 0x1d, 0x4a, 0x83,
+	0xd1, 0xc0, 0xb8, 0x55, 0x6f, 0xf5, 0x3d, 0x8c, 0x99, 0x01, 0x0f, 0xf6, 0x6d, 0x0d, 0x62, 0xc1,
+	0x8c, 0x62, 0x1d, 0xe6, 0xd7, 0x79, 0x87, 0xcd, 0x28, 0x8e, 0x0d, 0x8e, 0xc4, 0x66, 0x94, 0x10,
+	0xa5, 0xb2, 0xc7, 0x63, 0x84, 0xa3, 0x2c, 0x9e, 0x3d, 0xc9, 0x0c, 0xcb, 0x9e, 0x61, 0x83, 0x73,
+	0xd5, 0xd2, 0x34, 0x99, 0xee, 0x52, 0x2d, 0x96, 0x91, 0xb6, 0xed, 0xfc, 0xfc, 0xa7, 0x05, 0x63,
+	0xa3, 0x0e, 0xa5, 0x00, 0xcf, 0x0b, 0xf4, 0x0f, 0x86, 0xf2, 0x4d, 0xc1, 0xba, 0x66, 0x69, 0x93,
+	0x96, 0x33, 0x74, 0x71, 0x6a, 0x7e, 0x00, 0xd4, 0x23, 0x63, 0x8b, 0x53, 0x52, 0x03, 0x9c, 0xda,
+	0xfb, 0x8f, 0x24, 0x21, 0x5d, 0x41, 0x03, 0x89, 0xff, 0x46, 0xd2, 0xe6, 0x00, 0x12, 0x9e, 0xda,
+	0x6b, 0xc0, 0xec, 0xbb, 0x4b, 0xa7, 0x1f, 0x44, 0x4c, 0xf9, 0x68, 0x6c, 0x21, 0x4c, 0xab, 0x80,
+	0x46, 0xed, 0xec, 0x2d, 0x7e, 0x8f, 0xad, 0xb0, 0x46, 0xed, 0x6e, 0x12, 0x7e, 0x8f, 0xad, 0x62,
+	0x8d, 0x3a, 0x44, 0x41, 0x9c, 0xe9, 0xae, 0x83, 0x1e, 0x44, 0xf4, 0xdd, 0xa5, 0xcf, 0x7a, 0x2f,
+	0x07, 0x7a, 0xce, 0x41, 0xbe, 0xf4, 0x8e, 0x29, 0x90, 0x84, 0x1e, 0xe4, 0x4b, 0xfc, 0x94, 0x62,
+	0x6b, 0x10, 0x0b, 0x6f, 0x04, 0xa4, 0x1d, 0x7b, 0xab, 0x8f, 0xea, 0x91, 0xe4, 0x0a, 0x79, 0x70,
+	0x56, 0xbf, 0xd1, 0x0f, 0xda, 0x1b, 0xc8, 0x27, 0x4d, 0x35, 0x63, 0x6d, 0xab, 0x7e, 0x26, 0xc8,
+	0xbf, 0xe0, 0xa4, 0x64, 0x09, 0xf8, 0x91, 0xa0, 0x7b, 0x71, 0xc8, 0xf9, 0x6d, 0x04, 0x29, 0xb2,
+	0xcf, 0xf0, 0x3e, 0x40, 0x35, 0xc3, 0x17, 0x78, 0xd7, 0x7b, 0x39, 0xdb, 0xbd, 0x94, 0xd4, 0x7d,
+	0x77, 0x77, 0x03, 0x55, 0xc7, 0x9e, 0xdc, 0xdd, 0x1c, 0x40, 0x2a, 0x57, 0xdf, 0x19, 0x7d, 0xfe,
+	0x79, 0x35, 0x9f, 0xb2, 0x32, 0x1b, 0x7f, 0xd3, 0xd3, 0x7a, 0x5e, 0xcd, 0x13, 0xfe, 0x67, 0x63,
+	0xf4, 0x16, 0x25, 0xb6, 0x77, 0x10, 0x0f, 0xd8, 0xf9, 0x62, 0x3e, 0xed, 0xd2, 0x0e, 0xdc, 0x41,
+	0x14, 0x7f, 0x4f, 0xb8, 0x80, 0xb8, 0x83, 0xe8, 0x01, 0xc0, 0xde, 0x59, 0xc3, 0x18, 0x6a, 0x8f,
+	0x0b, 0xa2, 0xf6, 0x14, 0x60, 0xa3, 0x08, 0x63, 0x8f, 0x07, 0xea, 0xf0, 0xce, 0xa0, 0xd5, 0x11,
+	0x52, 0x22, 0x8a, 0x08, 0x29, 0xdb, 0xb8, 0x65, 0xf6, 0xc5, 0x93, 0xa4, 0x8b, 0xeb, 0xeb, 0xb4,
+	0x59, 0x81, 0xc6, 0xad, 0x72, 0xe9, 0x00, 0x44, 0xe3, 0x46, 0x41, 0xdb, 0x00, 0xad, 0x9f, 0xd7,
+	0xac, 0xc9, 0x2f, 0x56, 0xa0, 0x01, 0x3a, 0xda, 0x52, 0x4e, 0x34, 0x40, 0x8c, 0xc3, 0x9c, 0x9c,
+	0xb2, 0x3a, 0xcd, 0x1b, 0xda, 0x89, 0x94, 0xf7, 0x3b, 0x31, 0x9c, 0x1d, 0x7f, 0x74, 0x83, 0x99,
+	0x5d, 0x1d, 0x56, 0x4d, 0xb5, 0xe8, 0xf2, 0x32, 0xf8, 0xac, 0xcc, 0x34, 0x0d, 0x97, 0x21, 0xc6,
+	0x1f, 0x8a, 0xb5, 0xf1, 0xba, 0x20, 0xe4, 0xc5, 0x4c, 0xf1, 0xcb, 0x92, 0xe2, 0xb3, 0xf8, 0x31,
+	0x66, 0x05, 0x42, 0x44, 0xbc, 0x4e, 0xc2, 0xa0, 0x15, 0x9f, 0xf0, 0xdf, 0x12, 0xc3, 0x5a, 0xf1,
+	0x89, 0xfb, 0x23, 0x62, 0xb7, 0x69, 0xc0, 0x0e, 0x0d, 0xb2, 0xd0, 0x64, 0x57, 0x56, 0x8f, 0x4b,
+	0xa1, 0xcd, 0xc7, 0x25, 0x88, 0xa1, 0x01, 0x27, 0x81, 0xab, 0x97, 0x35, 0x2b, 0x59, 0xa6, 0xaf,
+	0x1f, 0x62, 0xae, 0x3c, 0x22, 0xea, 0x0a, 0x92, 0xa0, 0xbd, 0x9d, 0x2e, 0xca, 0x93, 0xa6, 0xba,
+	0xc8, 0x0b, 0x86, 0xb7, 0x37, 0x47, 0x1e, 0x6d, 0x6f, 0x3e, 0x67, 0xef, 0xb1, 0x08, 0xa9, 0xf7,
+	0xf3, 0xa8, 0x67, 0x4d, 0x3a, 0x83, 0xf7, 0x58, 0xa4, 0x8d, 0x10, 0x23, 0xf6, 0x38, 0x23, 0xb8,
+	0x13, 0xb2, 0x49, 0xd7, 0xe5, 0x4a, 0xb4, 0x0f, 0xf5, 0xc6, 0x90, 0xf8, 0x69, 0xad, 0x16, 0x84,
+	0x6c, 0xca, 0x1c, 0x46, 0x12, 0x21, 0x5b, 0x5c, 0xc3, 0x4e, 0x8a, 0x82, 0x7b, 0xa1, 0xee, 0x67,
+	0x81, 0x49, 0x51, 0xda, 0xd0, 0x42, 0x62, 0x52, 0x0c, 0x20, 0x30, 0xb4, 0xea, 0x6e, 0x30, 0x47,
+	0x87, 0x56, 0x23, 0x8d, 0x0e, 0xad, 0x2e, 0x65, 0x07, 0x8a, 0xa3, 0x32, 0xef, 0x72, 0xf1, 0x55,
+	0xdf, 0x49, 0xda, 0xa4, 0xd7, 0xac, 0x63, 0x0d, 0x1c, 0x28, 0x14, 0x92, 0x78, 0x0c, 0x31, 0x50,
+	0x50, 0xac, 0x72, 0xf8, 0x3b, 0xa3, 0x2f, 0xf3, 0x08, 0x86, 0x95, 0xea, 0x87, 0xdd, 0x9f, 0x2e,
+	0x59, 0xd9, 0xb5, 0xe3, 0x77, 0x8c, 0x8d, 0x69, 0xd7, 0xb0, 0xf4, 0x5a, 0xdb, 0xfe, 0x92, 0xf9,
+	0xbb, 0x00, 0xf7, 0xd6, 0x78, 0x7b, 0xe6, 0x6f, 0x54, 0x5e, 0xe4, 0x33, 0xf3, 0xf9, 0x35, 0x68,
+	0xcf, 0xae, 0x38, 0x89, 0x7c, 0xe7, 0x86, 0x71, 0x76, 0xc6, 0x71, 0xa5, 0xa7, 0x8c, 0x7f, 0x9a,
+	0x1a, 0xd1, 0x16, 0x00, 0x31, 0xe3, 0xa0, 0xa0, 0xed, 0x9c, 0xae, 0xf8, 0x8c, 0xc5, 0x33, 0x73,
+	0xc6, 0x86, 0x65, 0xe6, 0xcc, 0xfb, 0xb2, 0xa7, 0x18, 0x7d, 0xf9, 0x98, 0x5d, 0x9f, 0xb3, 0xa6,
+	0xbd, 0xcc, 0x6b, 0xea, 0xe7, 0x93, 0x2c, 0xd1, 0xfb, 0xf3, 0x49, 0x04, 0x6a, 0x67, 0x02, 0x0b,
+	0x1c, 0xb5, 0xfc, 0xf2, 0x90, 0x78, 0x4c, 0x14, 0xcc, 0x04, 0x8e, 0x11, 0x07, 0x22, 0x66, 0x02,
+	0x12, 0x76, 0x3e, 0x8e, 0xb7, 0xcc, 0x29, 0x9b, 0xf3, 0x16, 0xd6, 0x9c, 0xa4, 0xab, 0x6b, 0x56,
+	0x76, 0xca, 0x24, 0x38, 0x5d, 0x70, 0x4c, 0xe2, 0x3c, 0x71, 0xba, 0x30, 0x44, 0xcf, 0x19, 0x9a,
+	0xbc, 0x82, 0x3f, 0xa9, 0x9a, 0x2e, 0x2d, 0xf8, 0x02, 0x90, 0xff, 0xa4, 0xce, 0x5e, 0xa4, 0x50,
+	0x3d, 0x92, 0x18, 0x9a, 0xe2, 0x1a, 0xce, 0x4f, 0xf4, 0x7a, 0x69, 0x10, 0xe1, 0x88, 0x6a, 0x27,
+	0x4f, 0xaf, 0xd3, 0xbc, 0x50, 0xad, 0xe1, 0x5b, 0x11, 0xdb, 0x84, 0x0e, 0xf1, 0x13, 0xbd, 0x43,
+	0x75, 0x9d, 0x1f, 0x35, 0x8e, 0xa7, 0x10, 0x1c, 0x76, 0xf4, 0xd8, 0x27, 0x0e, 0x3b, 0xfa, 0xb5,
+	0xec, 0x1e, 0x84, 0x65, 0x05, 0xb7, 0x12, 0xc4, 0x7e, 0x95, 0xc1, 0x9d, 0x4f, 0xc7, 0x26, 0x00,
+	0x89, 0x3d, 0x88, 0xa8, 0x82, 0x0d, 0x0d, 0x2c, 0xf6, 0x2c, 0x2f, 0xd3, 0x22, 0xff, 0x21, 0x5c,
+	0xa0, 0x38, 0x76, 0x34, 0x41, 0x84, 0x06, 0x38, 0x89, 0xb9, 0x3a, 0x64, 0xdd, 0x59, 0xce, 0x87,
+	0xfe, 0x8d, 0x48, 0xb9, 0x09, 0xa2, 0xdf, 0x95, 0x43, 0x3a, 0x3f, 0x7f, 0x03, 0x8b, 0x75, 0x52,
+	0xd7, 0x53, 0x3e, 0xab, 0x9e, 0xb2, 0x19, 0xcb, 0xeb, 0x6e, 0xfc, 0x51, 0xbc, 0xac, 0x00, 0x4e,
+	0x5c, 0x19, 0x19, 0xa0, 0x86, 0x0d, 0x54, 0xbc, 0x0e, 0x0e, 0x99, 0x78, 0xe6, 0x82, 0x1e, 0xa8,
+	0x1c, 0xa8, 0x7f, 0xa0, 0xf2, 0x61, 0x3b, 0xdd, 0xfa, 0x3e, 0x4f, 0x59, 0xc6, 0xd8, 0xf5, 0xf8,
+	0x61, 0xcc, 0x8a, 0x64, 0x88, 0xe9, 0x96, 0x62, 0x6d, 0x60, 0xe6, 0x14, 0xfb, 0x23, 0x3e, 0x50,
+	0x34, 0x55, 0xb6, 0xe0, 0xd1, 0xe6, 0x0e, 0x61, 0xe7, 0xf5, 0xa3, 0xc4, 0xc1, 0x88, 0xc0, 0x2c,
+	0x82, 0x63, 0xc5, 0x2b, 0x3c, 0xa3, 0x0f, 0xb3, 0x40, 0x43, 0xd1, 0x87, 0x59, 0x48, 0x18, 0xed,
+	0xbb, 0x8f, 0xbc, 0x61, 0x71, 0xbc, 0x1b, 0x35, 0x65, 0xc1, 0xde, 0xbe, 0x8b, 0x28, 0xa0, 0x23,
+	0xfe, 0xeb, 0x47, 0x93, 0x72, 0xc5, 0x67, 0xab, 0xa3, 0x56, 0xce, 0x80, 0x11, 0x83, 0x3e, 0xd9,
+	0x3b, 0xe2, 0x63, 0x1a, 0xce, 0xa6, 0x1e, 0x92, 0x86, 0x49, 0x51, 0x54, 0xe2, 0xf0, 0xa6, 0xdf,
+	0xa4, 0x46, 0x89, 0x4d, 0xbd, 0x1e, 0x15, 0x2c, 0xe8, 0x78, 0xfd, 0x68, 0x3f, 0x6d, 0x3a, 0xfe,
+	0x09, 0xff, 0x26, 0x6d, 0x4a, 0x21, 0xbd, 0x41, 0x87, 0x87, 0xda, 0xfd, 0x7f, 0xe8, 0x4d, 0xdd,
+	0x43, 0xdb, 0x8e, 0x5b, 0x01, 0xd7, 0xcf, 0x76, 0x06, 0xd2, 0xce, 0x5d, 0x26, 0x9e, 0xfd, 0x29,
+	0x6b, 0x96, 0x39, 0x7f, 0xb1, 0x8a, 0x35, 0x6a, 0xad, 0xc2, 0xf3, 0xba, 0x07, 0x5e, 0xd5, 0x31,
+	0x5c, 0xe2, 0x80, 0x89, 0x9b, 0xe5, 0x0f, 0x6e, 0xa0, 0x61, 0x73, 0xee, 0x70, 0xea, 0x5d, 0x46,
+	0xfe, 0x97, 0xf1, 0x36, 0x69, 0xcc, 0xa1, 0x88, 0x9c, 0xd3, 0xb4, 0x1d, 0x57, 0x42, 0xb7, 0x93,
+	0x72, 0x75, 0x04, 0xef, 0x8f, 0x21, 0x96, 0x04, 0x46, 0x8c, 0x2b, 0x11, 0xdc, 0x39, 0x19, 0x6c,
+	0xaa, 0x34, 0x9b, 0xa5, 0x6d, 0x77, 0x92, 0xae, 0xf8, 0xfd, 0x70, 0xb1, 0x34, 0x80, 0x27, 0x83,
+	0x9a, 0x49, 0x5c, 0x88, 0x3a, 0x19, 0xa4, 0x60, 0x77, 0x81, 0xc7, 0xd3, 0xa4, 0xef, 0xd5, 0xc3,
+	0x05, 0x1e, 0x97, 0x05, 0x77, 0xea, 0xef, 0xc5, 0x21, 0xfb, 0x3d, 0xb0, 0x14, 0x89, 0x95, 0xcc,
+	0x6d, 0x4c, 0xc7, 0x5b, 0xc3, 0xdc, 0x89, 0x10, 0xf6, 0xc9, 0x5b, 0xf9, 0xf7, 0x17, 0xac, 0x7b,
+	0x53, 0x35, 0x57, 0x7c, 0x98, 0xe4, 0x49, 0x1f, 0x6f, 0x63, 0xba, 0x2e, 0xe4, 0x5d, 0xd7, 0xdd,
+	0x19, 0x48, 0x3b, 0x4f, 0xa9, 0x5c, 0xa6, 0xfc, 0x1a, 0xd9, 0x31, 0x6b, 0x91, 0xf7, 0xdf, 0xb8,
+	0x30, 0xb1, 0x52, 0xea, 0x29, 0x95, 0x80, 0xb2, 0x0d, 0x9d, 0xcb, 0x9e, 0x66, 0x79, 0xa7, 0x64,
+	0xfa, 0x6b, 0x95, 0xed, 0xd0, 0x40, 0x48, 0x11, 0xb9, 0xa2, 0x69, 0x3b, 0xa5, 0x70, 0xe6, 0xac,
+	0x9a, 0xcf, 0x0b, 0xa6, 0xa0, 0x53, 0x96, 0xca, 0x37, 0x7d, 0x76, 0x43, 0x5b, 0x28, 0x48, 0x4c,
+	0x29, 0x51, 0x05, 0xbb, 0x12, 0xe5, 0x98, 0x3c, 0x9f, 0xd7, 0x05, 0xbb, 0x1e, 0x9a, 0xf1, 0x00,
+	0x62, 0x25, 0x8a, 0x82, 0xce, 0x73, 0x2b, 0x97, 0x29, 0x1f, 0xb7, 0x94, 0x08, 0x3e, 0xab, 0x2e,
+	0x94, 0x1d, 0x31, 0xf5, 0xdc, 0x4a, 0x88, 0xd9, 0xd8, 0x07, 0x78, 0x78, 0xb2, 0xe2, 0xbf, 0xeb,
+	0xf7, 0x30, 0xaa, 0x2f, 0x18, 0x22, 0xf6, 0xa1, 0x58, 0xbf, 0xea, 0xcc, 0x21, 0xc0, 0xf3, 0xb4,
+	0xb5, 0x99, 0x43, 0xaa, 0x0e, 0x05, 0x63, 0x55, 0x47, 0x29, 0xf8, 0x45, 0xea, 0x9e, 0x33, 0x20,
+	0x45, 0x8a, 0x1d, 0x32, 0x3c, 0xe8, 0xc3, 0x9c, 0x37, 0x7f, 0x2e, 0xd3, 0xee, 0x94, 0xa5, 0x99,
+	0xc9, 0x18, 0xa2, 0xeb, 0xca, 0xa9, 0x37, 0x7f, 0x10, 0x4e, 0x39, 0xf9, 0xfd, 0xd1, 0x58, 0x66,
+	0xa3, 0x71, 0xdd, 0xdc, 0xc6, 0x92, 0xc8, 0x09, 0x62, 0xa0, 0xf2, 0x09, 0x67, 0xed, 0xe7, 0x55,
+	0xd1, 0x59, 0xa5, 0x1c, 0xa8, 0x6f, 0xe4, 0x5b, 0xb0, 0xf6, 0xf3, 0x8b, 0x3d, 0xa0, 0x89, 0xb5,
+	0x5f, 0xbf, 0x96, 0xf3, 0xd0, 0x33, 0xa8, 0x32, 0x7e, 0x87, 0x1a, 0xa6, 0xe9, 0xd3, 0x68, 0xf5,
+	0x20, 0x1a, 0xc4, 0x43, 0xcf, 0xc3, 0x34, 0xe1, 0x6f, 0x48, 0xab, 0x41, 0x16, 0xff, 0x0d, 0x69,
+	0x25, 0x8c, 0xff, 0x86, 0xb4, 0x85, 0xec, 0xa3, 0x0c, 0xba, 0x1d, 0xf1, 0x77, 0xee, 0xee, 0xe0,
+	0x4d, 0xc3, 0x7d, 0xe1, 0xee, 0x6e, 0x0c, 0xb1, 0x13, 0xc2, 0xe4, 0xe8, 0xb3, 0x26, 0xe7, 0xd7,
+	0xcf, 0xcf, 0xaa, 0xaa, 0x80, 0xa7, 0x42, 0x93, 0xa3, 0xc4, 0x95, 0x12, 0x13, 0x42, 0x48, 0xd9,
+	0x89, 0x73, 0x72, 0xc4, 0x5f, 0x69, 0xbc, 0xe0, 0x37, 0x65, 0x6e, 0x43, 0x25, 0x2d, 0x21, 0xda,
+	0xa3, 0x4f, 0xd8, 0x32, 0x9e, 0x1c, 0x89, 0x03, 0x56, 0x75, 0xc8, 0xf4, 0x3e, 0xd4, 0x71, 0x84,
+	0x44, 0x19, 0x07, 0x90, 0x8d, 0x5b, 0x26, 0x47, 0xd8, 0x4f, 0x2b, 0x6f, 0x41, 0x75, 0x04, 0x22,
+	0xe2, 0x16, 0x12, 0x76, 0x9e, 0x7d, 0x38, 0x59, 0xb4, 0x97, 0xfe, 0x5e, 0xa6, 0xdc, 0xb5, 0x92,
+	0xbf, 0x21, 0xf4, 0x18, 0xfc, 0x30, 0xba, 0xcf, 0x26, 0x1e, 0x4c, 0xdc, 0x00, 0xee, 0x55, 0x72,
+	0x7e, 0x10, 0x01, 0xb2, 0xfc, 0x20, 0xbb, 0x4e, 0x67, 0xec, 0x98, 0x6f, 0xae, 0x3c, 0x8a, 0x9b,
+	0x75, 0x59, 0xe2, 0x6b, 0x9a, 0x3e, 0x1d, 0x67, 0x33, 0x02, 0x49, 0x89, 0xf8, 0x09, 0x72, 0x4e,
+	0xf2, 0x59, 0xe9, 0xa3, 0x5e, 0xc3, 0x2e, 0x4e, 0x6c, 0x46, 0x0c, 0x50, 0xb3, 0x97, 0xc0, 0xc2,
+	0x8a, 0x6a, 0xf9, 0x6d, 0xa3, 0x16, 0x5c, 0x02, 0x43, 0x8a, 0x5b, 0x72, 0xc4, 0x25, 0xb0, 0x18,
+	0x2f, 0x9d, 0x3f, 0xb9, 0xf3, 0xdf, 0x3f, 0xbd, 0xb5, 0xf6, 0x93, 0x9f, 0xde, 0x5a, 0xfb, 0xdf,
+	0x9f, 0xde, 0x5a, 0xfb, 0xf1, 0xcf, 0x6e, 0x7d, 0xee, 0x27, 0x3f, 0xbb, 0xf5, 0xb9, 0xff, 0xf9,
+	0xd9, 0xad, 0xcf, 0x7d, 0xff, 0xf3, 0xad, 0x8c, 0xc5, 0xcf, 0x7f, 0xb1, 0x6e, 0xaa, 0xae, 0x7a,
+	0xfc, 0x7f, 0x03, 0x00, 0x18, 0x4f, 0xf0, 0x22, 0x95, 0x9b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -490,8 +531,14 @@ type ClientCommandsClient interface {
 	SpaceMakeShareable(ctx context.Context, in *pb.RpcSpaceMakeShareableRequest, opts ...grpc.CallOption) (*pb.RpcSpaceMakeShareableResponse, error)
 	SpaceParticipantRemove(ctx context.Context, in *pb.RpcSpaceParticipantRemoveRequest, opts ...grpc.CallOption) (*pb.RpcSpaceParticipantRemoveResponse, error)
 	SpaceParticipantPermissionsChange(ctx context.Context, in *pb.RpcSpaceParticipantPermissionsChangeRequest, opts ...grpc.CallOption) (*pb.RpcSpaceParticipantPermissionsChangeResponse, error)
+	SpaceParticipantRoleSet(ctx context.Context, in *pb.RpcSpaceParticipantRoleSetRequest, opts ...grpc.CallOption) (*pb.RpcSpaceParticipantRoleSetResponse, error)
 	SpaceSetOrder(ctx context.Context, in *pb.RpcSpaceSetOrderRequest, opts ...grpc.CallOption) (*pb.RpcSpaceSetOrderResponse, error)
 	SpaceUnsetOrder(ctx context.Context, in *pb.RpcSpaceUnsetOrderRequest, opts ...grpc.CallOption) (*pb.RpcSpaceUnsetOrderResponse, error)
+	SpaceActivityList(ctx context.Context, in *pb.RpcSpaceActivityListRequest, opts ...grpc.CallOption) (*pb.RpcSpaceActivityListResponse, error)
+	SpaceActivitySubscribe(ctx context.Context, in *pb.RpcSpaceActivitySubscribeRequest, opts ...grpc.CallOption) (*pb.RpcSpaceActivitySubscribeResponse, error)
+	SpaceActivityUnsubscribe(ctx context.Context, in *pb.RpcSpaceActivityUnsubscribeRequest, opts ...grpc.CallOption) (*pb.RpcSpaceActivityUnsubscribeResponse, error)
+	SpaceActivityMarkSeen(ctx context.Context, in *pb.RpcSpaceActivityMarkSeenRequest, opts ...grpc.CallOption) (*pb.RpcSpaceActivityMarkSeenResponse, error)
+	SpaceAuditLogExport(ctx context.Context, in *pb.RpcSpaceAuditLogExportRequest, opts ...grpc.CallOption) (*pb.RpcSpaceAuditLogExportResponse, error)
 	// Publishing
 	// ***
 	PublishingCreate(ctx context.Context, in *pb.RpcPublishingCreateRequest, opts ...grpc.CallOption) (*pb.RpcPublishingCreateResponse, error)
@@ -503,6 +550,8 @@ type ClientCommandsClient interface {
 	// ***
 	ObjectOpen(ctx context.Context, in *pb.RpcObjectOpenRequest, opts ...grpc.CallOption) (*pb.RpcObjectOpenResponse, error)
 	ObjectRefresh(ctx context.Context, in *pb.RpcObjectRefreshRequest, opts ...grpc.CallOption) (*pb.RpcObjectRefreshResponse, error)
+	ObjectSyncDiagnostics(ctx context.Context, in *pb.RpcObjectSyncDiagnosticsRequest, opts ...grpc.CallOption) (*pb.RpcObjectSyncDiagnosticsResponse, error)
+	ObjectForceResync(ctx context.Context, in *pb.RpcObjectForceResyncRequest, opts ...grpc.CallOption) (*pb.RpcObjectForceResyncResponse, error)
 	ObjectClose(ctx context.Context, in *pb.RpcObjectCloseRequest, opts ...grpc.CallOption) (*pb.RpcObjectCloseResponse, error)
 	ObjectShow(ctx context.Context, in *pb.RpcObjectShowRequest, opts ...grpc.CallOption) (*pb.RpcObjectShowResponse, error)
 	// ObjectCreate just creates the new page, without adding the link to it from some other page
@@ -514,6 +563,8 @@ type ClientCommandsClient interface {
 	ObjectGraph(ctx context.Context, in *pb.RpcObjectGraphRequest, opts ...grpc.CallOption) (*pb.RpcObjectGraphResponse, error)
 	ObjectSearch(ctx context.Context, in *pb.RpcObjectSearchRequest, opts ...grpc.CallOption) (*pb.RpcObjectSearchResponse, error)
 	ObjectSearchWithMeta(ctx context.Context, in *pb.RpcObjectSearchWithMetaRequest, opts ...grpc.CallOption) (*pb.RpcObjectSearchWithMetaResponse, error)
+	ObjectFindReplacePreview(ctx context.Context, in *pb.RpcObjectFindReplacePreviewRequest, opts ...grpc.CallOption) (*pb.RpcObjectFindReplacePreviewResponse, error)
+	ObjectFindReplaceApply(ctx context.Context, in *pb.RpcObjectFindReplaceApplyRequest, opts ...grpc.CallOption) (*pb.RpcObjectFindReplaceApplyResponse, error)
 	ObjectSearchSubscribe(ctx context.Context, in *pb.RpcObjectSearchSubscribeRequest, opts ...grpc.CallOption) (*pb.RpcObjectSearchSubscribeResponse, error)
 	ObjectCrossSpaceSearchSubscribe(ctx context.Context, in *pb.RpcObjectCrossSpaceSearchSubscribeRequest, opts ...grpc.CallOption) (*pb.RpcObjectCrossSpaceSearchSubscribeResponse, error)
 	ObjectCrossSpaceSearchUnsubscribe(ctx context.Context, in *pb.RpcObjectCrossSpaceSearchUnsubscribeRequest, opts ...grpc.CallOption) (*pb.RpcObjectCrossSpaceSearchUnsubscribeResponse, error)
@@ -522,6 +573,7 @@ type ClientCommandsClient interface {
 	ObjectSearchUnsubscribe(ctx context.Context, in *pb.RpcObjectSearchUnsubscribeRequest, opts ...grpc.CallOption) (*pb.RpcObjectSearchUnsubscribeResponse, error)
 	ObjectSetDetails(ctx context.Context, in *pb.RpcObjectSetDetailsRequest, opts ...grpc.CallOption) (*pb.RpcObjectSetDetailsResponse, error)
 	ObjectDuplicate(ctx context.Context, in *pb.RpcObjectDuplicateRequest, opts ...grpc.CallOption) (*pb.RpcObjectDuplicateResponse, error)
+	ObjectTransferToSpace(ctx context.Context, in *pb.RpcObjectTransferToSpaceRequest, opts ...grpc.CallOption) (*pb.RpcObjectTransferToSpaceResponse, error)
 	// ObjectSetObjectType sets an existing object type to the object so it will appear in sets and suggests relations from this type
 	ObjectSetObjectType(ctx context.Context, in *pb.RpcObjectSetObjectTypeRequest, opts ...grpc.CallOption) (*pb.RpcObjectSetObjectTypeResponse, error)
 	ObjectSetLayout(ctx context.Context, in *pb.RpcObjectSetLayoutRequest, opts ...grpc.CallOption) (*pb.RpcObjectSetLayoutResponse, error)
@@ -553,6 +605,7 @@ type ClientCommandsClient interface {
 	ObjectImportUseCase(ctx context.Context, in *pb.RpcObjectImportUseCaseRequest, opts ...grpc.CallOption) (*pb.RpcObjectImportUseCaseResponse, error)
 	ObjectImportExperience(ctx context.Context, in *pb.RpcObjectImportExperienceRequest, opts ...grpc.CallOption) (*pb.RpcObjectImportExperienceResponse, error)
 	ObjectDateByTimestamp(ctx context.Context, in *pb.RpcObjectDateByTimestampRequest, opts ...grpc.CallOption) (*pb.RpcObjectDateByTimestampResponse, error)
+	ObjectDateParse(ctx context.Context, in *pb.RpcObjectDateParseRequest, opts ...grpc.CallOption) (*pb.RpcObjectDateParseResponse, error)
 	// Collections
 	// ***
 	ObjectCollectionAdd(ctx context.Context, in *pb.RpcObjectCollectionAddRequest, opts ...grpc.CallOption) (*pb.RpcObjectCollectionAddResponse, error)
@@ -587,6 +640,8 @@ type ClientCommandsClient interface {
 	HistoryGetVersions(ctx context.Context, in *pb.RpcHistoryGetVersionsRequest, opts ...grpc.CallOption) (*pb.RpcHistoryGetVersionsResponse, error)
 	HistorySetVersion(ctx context.Context, in *pb.RpcHistorySetVersionRequest, opts ...grpc.CallOption) (*pb.RpcHistorySetVersionResponse, error)
 	HistoryDiffVersions(ctx context.Context, in *pb.RpcHistoryDiffVersionsRequest, opts ...grpc.CallOption) (*pb.RpcHistoryDiffVersionsResponse, error)
+	HistoryGetBlockVersions(ctx context.Context, in *pb.RpcHistoryGetBlockVersionsRequest, opts ...grpc.CallOption) (*pb.RpcHistoryGetBlockVersionsResponse, error)
+	HistoryRestoreBlocks(ctx context.Context, in *pb.RpcHistoryRestoreBlocksRequest, opts ...grpc.CallOption) (*pb.RpcHistoryRestoreBlocksResponse, error)
 	// Files
 	// ***
 	FileSpaceOffload(ctx context.Context, in *pb.RpcFileSpaceOffloadRequest, opts ...grpc.CallOption) (*pb.RpcFileSpaceOffloadResponse, error)
@@ -595,17 +650,37 @@ type ClientCommandsClient interface {
 	FileUpload(ctx context.Context, in *pb.RpcFileUploadRequest, opts ...grpc.CallOption) (*pb.RpcFileUploadResponse, error)
 	FileDownload(ctx context.Context, in *pb.RpcFileDownloadRequest, opts ...grpc.CallOption) (*pb.RpcFileDownloadResponse, error)
 	FileDiscardPreload(ctx context.Context, in *pb.RpcFileDiscardPreloadRequest, opts ...grpc.CallOption) (*pb.RpcFileDiscardPreloadResponse, error)
+	FileImageTransform(ctx context.Context, in *pb.RpcFileImageTransformRequest, opts ...grpc.CallOption) (*pb.RpcFileImageTransformResponse, error)
 	FileDrop(ctx context.Context, in *pb.RpcFileDropRequest, opts ...grpc.CallOption) (*pb.RpcFileDropResponse, error)
 	FileSpaceUsage(ctx context.Context, in *pb.RpcFileSpaceUsageRequest, opts ...grpc.CallOption) (*pb.RpcFileSpaceUsageResponse, error)
 	FileNodeUsage(ctx context.Context, in *pb.RpcFileNodeUsageRequest, opts ...grpc.CallOption) (*pb.RpcFileNodeUsageResponse, error)
 	FileSetAutoDownload(ctx context.Context, in *pb.RpcFileSetAutoDownloadRequest, opts ...grpc.CallOption) (*pb.RpcFileSetAutoDownloadResponse, error)
 	FileCacheDownload(ctx context.Context, in *pb.RpcFileCacheDownloadRequest, opts ...grpc.CallOption) (*pb.RpcFileCacheDownloadResponse, error)
 	FileCacheCancelDownload(ctx context.Context, in *pb.RpcFileCacheCancelDownloadRequest, opts ...grpc.CallOption) (*pb.RpcFileCacheCancelDownloadResponse, error)
+	FileSetAutoOffload(ctx context.Context, in *pb.RpcFileSetAutoOffloadRequest, opts ...grpc.CallOption) (*pb.RpcFileSetAutoOffloadResponse, error)
+	FileAutoOffloadStatus(ctx context.Context, in *pb.RpcFileAutoOffloadStatusRequest, opts ...grpc.CallOption) (*pb.RpcFileAutoOffloadStatusResponse, error)
 	NavigationListObjects(ctx context.Context, in *pb.RpcNavigationListObjectsRequest, opts ...grpc.CallOption) (*pb.RpcNavigationListObjectsResponse, error)
 	NavigationGetObjectInfoWithLinks(ctx context.Context, in *pb.RpcNavigationGetObjectInfoWithLinksRequest, opts ...grpc.CallOption) (*pb.RpcNavigationGetObjectInfoWithLinksResponse, error)
 	TemplateCreateFromObject(ctx context.Context, in *pb.RpcTemplateCreateFromObjectRequest, opts ...grpc.CallOption) (*pb.RpcTemplateCreateFromObjectResponse, error)
 	TemplateClone(ctx context.Context, in *pb.RpcTemplateCloneRequest, opts ...grpc.CallOption) (*pb.RpcTemplateCloneResponse, error)
 	TemplateExportAll(ctx context.Context, in *pb.RpcTemplateExportAllRequest, opts ...grpc.CallOption) (*pb.RpcTemplateExportAllResponse, error)
+	TemplateGetVariables(ctx context.Context, in *pb.RpcTemplateGetVariablesRequest, opts ...grpc.CallOption) (*pb.RpcTemplateGetVariablesResponse, error)
+	TemplateIncludeSyncPreview(ctx context.Context, in *pb.RpcTemplateIncludeSyncPreviewRequest, opts ...grpc.CallOption) (*pb.RpcTemplateIncludeSyncPreviewResponse, error)
+	TemplateIncludeSyncApply(ctx context.Context, in *pb.RpcTemplateIncludeSyncApplyRequest, opts ...grpc.CallOption) (*pb.RpcTemplateIncludeSyncApplyResponse, error)
+	JournalOpenNote(ctx context.Context, in *pb.RpcJournalOpenNoteRequest, opts ...grpc.CallOption) (*pb.RpcJournalOpenNoteResponse, error)
+	JournalDateSection(ctx context.Context, in *pb.RpcJournalDateSectionRequest, opts ...grpc.CallOption) (*pb.RpcJournalDateSectionResponse, error)
+	JournalGetSettings(ctx context.Context, in *pb.RpcJournalGetSettingsRequest, opts ...grpc.CallOption) (*pb.RpcJournalGetSettingsResponse, error)
+	JournalSetSettings(ctx context.Context, in *pb.RpcJournalSetSettingsRequest, opts ...grpc.CallOption) (*pb.RpcJournalSetSettingsResponse, error)
+	CalendarExportView(ctx context.Context, in *pb.RpcCalendarExportViewRequest, opts ...grpc.CallOption) (*pb.RpcCalendarExportViewResponse, error)
+	CalendarImport(ctx context.Context, in *pb.RpcCalendarImportRequest, opts ...grpc.CallOption) (*pb.RpcCalendarImportResponse, error)
+	CalendarFeedCreate(ctx context.Context, in *pb.RpcCalendarFeedCreateRequest, opts ...grpc.CallOption) (*pb.RpcCalendarFeedCreateResponse, error)
+	CalendarFeedList(ctx context.Context, in *pb.RpcCalendarFeedListRequest, opts ...grpc.CallOption) (*pb.RpcCalendarFeedListResponse, error)
+	CalendarFeedRemove(ctx context.Context, in *pb.RpcCalendarFeedRemoveRequest, opts ...grpc.CallOption) (*pb.RpcCalendarFeedRemoveResponse, error)
+	CalendarFeedGet(ctx context.Context, in *pb.RpcCalendarFeedGetRequest, opts ...grpc.CallOption) (*pb.RpcCalendarFeedGetResponse, error)
+	WebhookCreate(ctx context.Context, in *pb.RpcWebhookCreateRequest, opts ...grpc.CallOption) (*pb.RpcWebhookCreateResponse, error)
+	WebhookList(ctx context.Context, in *pb.RpcWebhookListRequest, opts ...grpc.CallOption) (*pb.RpcWebhookListResponse, error)
+	WebhookRemove(ctx context.Context, in *pb.RpcWebhookRemoveRequest, opts ...grpc.CallOption) (*pb.RpcWebhookRemoveResponse, error)
+	WebhookDeliveryList(ctx context.Context, in *pb.RpcWebhookDeliveryListRequest, opts ...grpc.CallOption) (*pb.RpcWebhookDeliveryListResponse, error)
 	LinkPreview(ctx context.Context, in *pb.RpcLinkPreviewRequest, opts ...grpc.CallOption) (*pb.RpcLinkPreviewResponse, error)
 	UnsplashSearch(ctx context.Context, in *pb.RpcUnsplashSearchRequest, opts ...grpc.CallOption) (*pb.RpcUnsplashSearchResponse, error)
 	// UnsplashDownload downloads picture from unsplash by ID, put it to the IPFS and returns the hash.
@@ -613,6 +688,9 @@ type ClientCommandsClient interface {
 	UnsplashDownload(ctx context.Context, in *pb.RpcUnsplashDownloadRequest, opts ...grpc.CallOption) (*pb.RpcUnsplashDownloadResponse, error)
 	GalleryDownloadManifest(ctx context.Context, in *pb.RpcGalleryDownloadManifestRequest, opts ...grpc.CallOption) (*pb.RpcGalleryDownloadManifestResponse, error)
 	GalleryDownloadIndex(ctx context.Context, in *pb.RpcGalleryDownloadIndexRequest, opts ...grpc.CallOption) (*pb.RpcGalleryDownloadIndexResponse, error)
+	WebdavStart(ctx context.Context, in *pb.RpcWebdavStartRequest, opts ...grpc.CallOption) (*pb.RpcWebdavStartResponse, error)
+	WebdavStop(ctx context.Context, in *pb.RpcWebdavStopRequest, opts ...grpc.CallOption) (*pb.RpcWebdavStopResponse, error)
+	WebdavInfo(ctx context.Context, in *pb.RpcWebdavInfoRequest, opts ...grpc.CallOption) (*pb.RpcWebdavInfoResponse, error)
 	// General Block commands
 	// ***
 	BlockUpload(ctx context.Context, in *pb.RpcBlockUploadRequest, opts ...grpc.CallOption) (*pb.RpcBlockUploadResponse, error)
@@ -725,6 +803,8 @@ type ClientCommandsClient interface {
 	DebugTree(ctx context.Context, in *pb.RpcDebugTreeRequest, opts ...grpc.CallOption) (*pb.RpcDebugTreeResponse, error)
 	DebugTreeHeads(ctx context.Context, in *pb.RpcDebugTreeHeadsRequest, opts ...grpc.CallOption) (*pb.RpcDebugTreeHeadsResponse, error)
 	DebugSpaceSummary(ctx context.Context, in *pb.RpcDebugSpaceSummaryRequest, opts ...grpc.CallOption) (*pb.RpcDebugSpaceSummaryResponse, error)
+	DebugSpaceVerify(ctx context.Context, in *pb.RpcDebugSpaceVerifyRequest, opts ...grpc.CallOption) (*pb.RpcDebugSpaceVerifyResponse, error)
+	DebugSpaceRepair(ctx context.Context, in *pb.RpcDebugSpaceRepairRequest, opts ...grpc.CallOption) (*pb.RpcDebugSpaceRepairResponse, error)
 	DebugStackGoroutines(ctx context.Context, in *pb.RpcDebugStackGoroutinesRequest, opts ...grpc.CallOption) (*pb.RpcDebugStackGoroutinesResponse, error)
 	DebugExportLocalstore(ctx context.Context, in *pb.RpcDebugExportLocalstoreRequest, opts ...grpc.CallOption) (*pb.RpcDebugExportLocalstoreResponse, error)
 	DebugPing(ctx context.Context, in *pb.RpcDebugPingRequest, opts ...grpc.CallOption) (*pb.RpcDebugPingResponse, error)
@@ -1299,6 +1379,15 @@ func (c *clientCommandsClient) SpaceParticipantPermissionsChange(ctx context.Con
 	return out, nil
 }
 
+func (c *clientCommandsClient) SpaceParticipantRoleSet(ctx context.Context, in *pb.RpcSpaceParticipantRoleSetRequest, opts ...grpc.CallOption) (*pb.RpcSpaceParticipantRoleSetResponse, error) {
+	out := new(pb.RpcSpaceParticipantRoleSetResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/SpaceParticipantRoleSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) SpaceSetOrder(ctx context.Context, in *pb.RpcSpaceSetOrderRequest, opts ...grpc.CallOption) (*pb.RpcSpaceSetOrderResponse, error) {
 	out := new(pb.RpcSpaceSetOrderResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/SpaceSetOrder", in, out, opts...)
@@ -1317,6 +1406,51 @@ func (c *clientCommandsClient) SpaceUnsetOrder(ctx context.Context, in *pb.RpcSp
 	return out, nil
 }
 
+func (c *clientCommandsClient) SpaceActivityList(ctx context.Context, in *pb.RpcSpaceActivityListRequest, opts ...grpc.CallOption) (*pb.RpcSpaceActivityListResponse, error) {
+	out := new(pb.RpcSpaceActivityListResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/SpaceActivityList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) SpaceActivitySubscribe(ctx context.Context, in *pb.RpcSpaceActivitySubscribeRequest, opts ...grpc.CallOption) (*pb.RpcSpaceActivitySubscribeResponse, error) {
+	out := new(pb.RpcSpaceActivitySubscribeResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/SpaceActivitySubscribe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) SpaceActivityUnsubscribe(ctx context.Context, in *pb.RpcSpaceActivityUnsubscribeRequest, opts ...grpc.CallOption) (*pb.RpcSpaceActivityUnsubscribeResponse, error) {
+	out := new(pb.RpcSpaceActivityUnsubscribeResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/SpaceActivityUnsubscribe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) SpaceActivityMarkSeen(ctx context.Context, in *pb.RpcSpaceActivityMarkSeenRequest, opts ...grpc.CallOption) (*pb.RpcSpaceActivityMarkSeenResponse, error) {
+	out := new(pb.RpcSpaceActivityMarkSeenResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/SpaceActivityMarkSeen", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) SpaceAuditLogExport(ctx context.Context, in *pb.RpcSpaceAuditLogExportRequest, opts ...grpc.CallOption) (*pb.RpcSpaceAuditLogExportResponse, error) {
+	out := new(pb.RpcSpaceAuditLogExportResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/SpaceAuditLogExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) PublishingCreate(ctx context.Context, in *pb.RpcPublishingCreateRequest, opts ...grpc.CallOption) (*pb.RpcPublishingCreateResponse, error) {
 	out := new(pb.RpcPublishingCreateResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/PublishingCreate", in, out, opts...)
@@ -1380,6 +1514,24 @@ func (c *clientCommandsClient) ObjectRefresh(ctx context.Context, in *pb.RpcObje
 	return out, nil
 }
 
+func (c *clientCommandsClient) ObjectSyncDiagnostics(ctx context.Context, in *pb.RpcObjectSyncDiagnosticsRequest, opts ...grpc.CallOption) (*pb.RpcObjectSyncDiagnosticsResponse, error) {
+	out := new(pb.RpcObjectSyncDiagnosticsResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectSyncDiagnostics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) ObjectForceResync(ctx context.Context, in *pb.RpcObjectForceResyncRequest, opts ...grpc.CallOption) (*pb.RpcObjectForceResyncResponse, error) {
+	out := new(pb.RpcObjectForceResyncResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectForceResync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) ObjectClose(ctx context.Context, in *pb.RpcObjectCloseRequest, opts ...grpc.CallOption) (*pb.RpcObjectCloseResponse, error) {
 	out := new(pb.RpcObjectCloseResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectClose", in, out, opts...)
@@ -1461,6 +1613,24 @@ func (c *clientCommandsClient) ObjectSearchWithMeta(ctx context.Context, in *pb.
 	return out, nil
 }
 
+func (c *clientCommandsClient) ObjectFindReplacePreview(ctx context.Context, in *pb.RpcObjectFindReplacePreviewRequest, opts ...grpc.CallOption) (*pb.RpcObjectFindReplacePreviewResponse, error) {
+	out := new(pb.RpcObjectFindReplacePreviewResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectFindReplacePreview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) ObjectFindReplaceApply(ctx context.Context, in *pb.RpcObjectFindReplaceApplyRequest, opts ...grpc.CallOption) (*pb.RpcObjectFindReplaceApplyResponse, error) {
+	out := new(pb.RpcObjectFindReplaceApplyResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectFindReplaceApply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) ObjectSearchSubscribe(ctx context.Context, in *pb.RpcObjectSearchSubscribeRequest, opts ...grpc.CallOption) (*pb.RpcObjectSearchSubscribeResponse, error) {
 	out := new(pb.RpcObjectSearchSubscribeResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectSearchSubscribe", in, out, opts...)
@@ -1533,6 +1703,15 @@ func (c *clientCommandsClient) ObjectDuplicate(ctx context.Context, in *pb.RpcOb
 	return out, nil
 }
 
+func (c *clientCommandsClient) ObjectTransferToSpace(ctx context.Context, in *pb.RpcObjectTransferToSpaceRequest, opts ...grpc.CallOption) (*pb.RpcObjectTransferToSpaceResponse, error) {
+	out := new(pb.RpcObjectTransferToSpaceResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectTransferToSpace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) ObjectSetObjectType(ctx context.Context, in *pb.RpcObjectSetObjectTypeRequest, opts ...grpc.CallOption) (*pb.RpcObjectSetObjectTypeResponse, error) {
 	out := new(pb.RpcObjectSetObjectTypeResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectSetObjectType", in, out, opts...)
@@ -1794,6 +1973,15 @@ func (c *clientCommandsClient) ObjectDateByTimestamp(ctx context.Context, in *pb
 	return out, nil
 }
 
+func (c *clientCommandsClient) ObjectDateParse(ctx context.Context, in *pb.RpcObjectDateParseRequest, opts ...grpc.CallOption) (*pb.RpcObjectDateParseResponse, error) {
+	out := new(pb.RpcObjectDateParseResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectDateParse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) ObjectCollectionAdd(ctx context.Context, in *pb.RpcObjectCollectionAddRequest, opts ...grpc.CallOption) (*pb.RpcObjectCollectionAddResponse, error) {
 	out := new(pb.RpcObjectCollectionAddResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectCollectionAdd", in, out, opts...)
@@ -2028,6 +2216,24 @@ func (c *clientCommandsClient) HistoryDiffVersions(ctx context.Context, in *pb.R
 	return out, nil
 }
 
+func (c *clientCommandsClient) HistoryGetBlockVersions(ctx context.Context, in *pb.RpcHistoryGetBlockVersionsRequest, opts ...grpc.CallOption) (*pb.RpcHistoryGetBlockVersionsResponse, error) {
+	out := new(pb.RpcHistoryGetBlockVersionsResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/HistoryGetBlockVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) HistoryRestoreBlocks(ctx context.Context, in *pb.RpcHistoryRestoreBlocksRequest, opts ...grpc.CallOption) (*pb.RpcHistoryRestoreBlocksResponse, error) {
+	out := new(pb.RpcHistoryRestoreBlocksResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/HistoryRestoreBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) FileSpaceOffload(ctx context.Context, in *pb.RpcFileSpaceOffloadRequest, opts ...grpc.CallOption) (*pb.RpcFileSpaceOffloadResponse, error) {
 	out := new(pb.RpcFileSpaceOffloadResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/FileSpaceOffload", in, out, opts...)
@@ -2082,6 +2288,15 @@ func (c *clientCommandsClient) FileDiscardPreload(ctx context.Context, in *pb.Rp
 	return out, nil
 }
 
+func (c *clientCommandsClient) FileImageTransform(ctx context.Context, in *pb.RpcFileImageTransformRequest, opts ...grpc.CallOption) (*pb.RpcFileImageTransformResponse, error) {
+	out := new(pb.RpcFileImageTransformResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/FileImageTransform", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) FileDrop(ctx context.Context, in *pb.RpcFileDropRequest, opts ...grpc.CallOption) (*pb.RpcFileDropResponse, error) {
 	out := new(pb.RpcFileDropResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/FileDrop", in, out, opts...)
@@ -2136,6 +2351,24 @@ func (c *clientCommandsClient) FileCacheCancelDownload(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *clientCommandsClient) FileSetAutoOffload(ctx context.Context, in *pb.RpcFileSetAutoOffloadRequest, opts ...grpc.CallOption) (*pb.RpcFileSetAutoOffloadResponse, error) {
+	out := new(pb.RpcFileSetAutoOffloadResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/FileSetAutoOffload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) FileAutoOffloadStatus(ctx context.Context, in *pb.RpcFileAutoOffloadStatusRequest, opts ...grpc.CallOption) (*pb.RpcFileAutoOffloadStatusResponse, error) {
+	out := new(pb.RpcFileAutoOffloadStatusResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/FileAutoOffloadStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) NavigationListObjects(ctx context.Context, in *pb.RpcNavigationListObjectsRequest, opts ...grpc.CallOption) (*pb.RpcNavigationListObjectsResponse, error) {
 	out := new(pb.RpcNavigationListObjectsResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/NavigationListObjects", in, out, opts...)
@@ -2181,6 +2414,159 @@ func (c *clientCommandsClient) TemplateExportAll(ctx context.Context, in *pb.Rpc
 	return out, nil
 }
 
+func (c *clientCommandsClient) TemplateGetVariables(ctx context.Context, in *pb.RpcTemplateGetVariablesRequest, opts ...grpc.CallOption) (*pb.RpcTemplateGetVariablesResponse, error) {
+	out := new(pb.RpcTemplateGetVariablesResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/TemplateGetVariables", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) TemplateIncludeSyncPreview(ctx context.Context, in *pb.RpcTemplateIncludeSyncPreviewRequest, opts ...grpc.CallOption) (*pb.RpcTemplateIncludeSyncPreviewResponse, error) {
+	out := new(pb.RpcTemplateIncludeSyncPreviewResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/TemplateIncludeSyncPreview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) TemplateIncludeSyncApply(ctx context.Context, in *pb.RpcTemplateIncludeSyncApplyRequest, opts ...grpc.CallOption) (*pb.RpcTemplateIncludeSyncApplyResponse, error) {
+	out := new(pb.RpcTemplateIncludeSyncApplyResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/TemplateIncludeSyncApply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) JournalOpenNote(ctx context.Context, in *pb.RpcJournalOpenNoteRequest, opts ...grpc.CallOption) (*pb.RpcJournalOpenNoteResponse, error) {
+	out := new(pb.RpcJournalOpenNoteResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/JournalOpenNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) JournalDateSection(ctx context.Context, in *pb.RpcJournalDateSectionRequest, opts ...grpc.CallOption) (*pb.RpcJournalDateSectionResponse, error) {
+	out := new(pb.RpcJournalDateSectionResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/JournalDateSection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) JournalGetSettings(ctx context.Context, in *pb.RpcJournalGetSettingsRequest, opts ...grpc.CallOption) (*pb.RpcJournalGetSettingsResponse, error) {
+	out := new(pb.RpcJournalGetSettingsResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/JournalGetSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) JournalSetSettings(ctx context.Context, in *pb.RpcJournalSetSettingsRequest, opts ...grpc.CallOption) (*pb.RpcJournalSetSettingsResponse, error) {
+	out := new(pb.RpcJournalSetSettingsResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/JournalSetSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) CalendarExportView(ctx context.Context, in *pb.RpcCalendarExportViewRequest, opts ...grpc.CallOption) (*pb.RpcCalendarExportViewResponse, error) {
+	out := new(pb.RpcCalendarExportViewResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/CalendarExportView", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) CalendarImport(ctx context.Context, in *pb.RpcCalendarImportRequest, opts ...grpc.CallOption) (*pb.RpcCalendarImportResponse, error) {
+	out := new(pb.RpcCalendarImportResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/CalendarImport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) CalendarFeedCreate(ctx context.Context, in *pb.RpcCalendarFeedCreateRequest, opts ...grpc.CallOption) (*pb.RpcCalendarFeedCreateResponse, error) {
+	out := new(pb.RpcCalendarFeedCreateResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/CalendarFeedCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) CalendarFeedList(ctx context.Context, in *pb.RpcCalendarFeedListRequest, opts ...grpc.CallOption) (*pb.RpcCalendarFeedListResponse, error) {
+	out := new(pb.RpcCalendarFeedListResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/CalendarFeedList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) CalendarFeedRemove(ctx context.Context, in *pb.RpcCalendarFeedRemoveRequest, opts ...grpc.CallOption) (*pb.RpcCalendarFeedRemoveResponse, error) {
+	out := new(pb.RpcCalendarFeedRemoveResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/CalendarFeedRemove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) CalendarFeedGet(ctx context.Context, in *pb.RpcCalendarFeedGetRequest, opts ...grpc.CallOption) (*pb.RpcCalendarFeedGetResponse, error) {
+	out := new(pb.RpcCalendarFeedGetResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/CalendarFeedGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) WebhookCreate(ctx context.Context, in *pb.RpcWebhookCreateRequest, opts ...grpc.CallOption) (*pb.RpcWebhookCreateResponse, error) {
+	out := new(pb.RpcWebhookCreateResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/WebhookCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) WebhookList(ctx context.Context, in *pb.RpcWebhookListRequest, opts ...grpc.CallOption) (*pb.RpcWebhookListResponse, error) {
+	out := new(pb.RpcWebhookListResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/WebhookList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) WebhookRemove(ctx context.Context, in *pb.RpcWebhookRemoveRequest, opts ...grpc.CallOption) (*pb.RpcWebhookRemoveResponse, error) {
+	out := new(pb.RpcWebhookRemoveResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/WebhookRemove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) WebhookDeliveryList(ctx context.Context, in *pb.RpcWebhookDeliveryListRequest, opts ...grpc.CallOption) (*pb.RpcWebhookDeliveryListResponse, error) {
+	out := new(pb.RpcWebhookDeliveryListResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/WebhookDeliveryList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) LinkPreview(ctx context.Context, in *pb.RpcLinkPreviewRequest, opts ...grpc.CallOption) (*pb.RpcLinkPreviewResponse, error) {
 	out := new(pb.RpcLinkPreviewResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/LinkPreview", in, out, opts...)
@@ -2226,6 +2612,33 @@ func (c *clientCommandsClient) GalleryDownloadIndex(ctx context.Context, in *pb.
 	return out, nil
 }
 
+func (c *clientCommandsClient) WebdavStart(ctx context.Context, in *pb.RpcWebdavStartRequest, opts ...grpc.CallOption) (*pb.RpcWebdavStartResponse, error) {
+	out := new(pb.RpcWebdavStartResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/WebdavStart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) WebdavStop(ctx context.Context, in *pb.RpcWebdavStopRequest, opts ...grpc.CallOption) (*pb.RpcWebdavStopResponse, error) {
+	out := new(pb.RpcWebdavStopResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/WebdavStop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) WebdavInfo(ctx context.Context, in *pb.RpcWebdavInfoRequest, opts ...grpc.CallOption) (*pb.RpcWebdavInfoResponse, error) {
+	out := new(pb.RpcWebdavInfoResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/WebdavInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) BlockUpload(ctx context.Context, in *pb.RpcBlockUploadRequest, opts ...grpc.CallOption) (*pb.RpcBlockUploadResponse, error) {
 	out := new(pb.RpcBlockUploadResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/BlockUpload", in, out, opts...)
@@ -3108,6 +3521,24 @@ func (c *clientCommandsClient) DebugSpaceSummary(ctx context.Context, in *pb.Rpc
 	return out, nil
 }
 
+func (c *clientCommandsClient) DebugSpaceVerify(ctx context.Context, in *pb.RpcDebugSpaceVerifyRequest, opts ...grpc.CallOption) (*pb.RpcDebugSpaceVerifyResponse, error) {
+	out := new(pb.RpcDebugSpaceVerifyResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/DebugSpaceVerify", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) DebugSpaceRepair(ctx context.Context, in *pb.RpcDebugSpaceRepairRequest, opts ...grpc.CallOption) (*pb.RpcDebugSpaceRepairResponse, error) {
+	out := new(pb.RpcDebugSpaceRepairResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/DebugSpaceRepair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) DebugStackGoroutines(ctx context.Context, in *pb.RpcDebugStackGoroutinesRequest, opts ...grpc.CallOption) (*pb.RpcDebugStackGoroutinesResponse, error) {
 	out := new(pb.RpcDebugStackGoroutinesResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/DebugStackGoroutines", in, out, opts...)
@@ -3761,8 +4192,14 @@ type ClientCommandsServer interface {
 	SpaceMakeShareable(context.Context, *pb.RpcSpaceMakeShareableRequest) *pb.RpcSpaceMakeShareableResponse
 	SpaceParticipantRemove(context.Context, *pb.RpcSpaceParticipantRemoveRequest) *pb.RpcSpaceParticipantRemoveResponse
 	SpaceParticipantPermissionsChange(context.Context, *pb.RpcSpaceParticipantPermissionsChangeRequest) *pb.RpcSpaceParticipantPermissionsChangeResponse
+	SpaceParticipantRoleSet(context.Context, *pb.RpcSpaceParticipantRoleSetRequest) *pb.RpcSpaceParticipantRoleSetResponse
 	SpaceSetOrder(context.Context, *pb.RpcSpaceSetOrderRequest) *pb.RpcSpaceSetOrderResponse
 	SpaceUnsetOrder(context.Context, *pb.RpcSpaceUnsetOrderRequest) *pb.RpcSpaceUnsetOrderResponse
+	SpaceActivityList(context.Context, *pb.RpcSpaceActivityListRequest) *pb.RpcSpaceActivityListResponse
+	SpaceActivitySubscribe(context.Context, *pb.RpcSpaceActivitySubscribeRequest) *pb.RpcSpaceActivitySubscribeResponse
+	SpaceActivityUnsubscribe(context.Context, *pb.RpcSpaceActivityUnsubscribeRequest) *pb.RpcSpaceActivityUnsubscribeResponse
+	SpaceActivityMarkSeen(context.Context, *pb.RpcSpaceActivityMarkSeenRequest) *pb.RpcSpaceActivityMarkSeenResponse
+	SpaceAuditLogExport(context.Context, *pb.RpcSpaceAuditLogExportRequest) *pb.RpcSpaceAuditLogExportResponse
 	// Publishing
 	// ***
 	PublishingCreate(context.Context, *pb.RpcPublishingCreateRequest) *pb.RpcPublishingCreateResponse
@@ -3774,6 +4211,8 @@ type ClientCommandsServer interface {
 	// ***
 	ObjectOpen(context.Context, *pb.RpcObjectOpenRequest) *pb.RpcObjectOpenResponse
 	ObjectRefresh(context.Context, *pb.RpcObjectRefreshRequest) *pb.RpcObjectRefreshResponse
+	ObjectSyncDiagnostics(context.Context, *pb.RpcObjectSyncDiagnosticsRequest) *pb.RpcObjectSyncDiagnosticsResponse
+	ObjectForceResync(context.Context, *pb.RpcObjectForceResyncRequest) *pb.RpcObjectForceResyncResponse
 	ObjectClose(context.Context, *pb.RpcObjectCloseRequest) *pb.RpcObjectCloseResponse
 	ObjectShow(context.Context, *pb.RpcObjectShowRequest) *pb.RpcObjectShowResponse
 	// ObjectCreate just creates the new page, without adding the link to it from some other page
@@ -3785,6 +4224,8 @@ type ClientCommandsServer interface {
 	ObjectGraph(context.Context, *pb.RpcObjectGraphRequest) *pb.RpcObjectGraphResponse
 	ObjectSearch(context.Context, *pb.RpcObjectSearchRequest) *pb.RpcObjectSearchResponse
 	ObjectSearchWithMeta(context.Context, *pb.RpcObjectSearchWithMetaRequest) *pb.RpcObjectSearchWithMetaResponse
+	ObjectFindReplacePreview(context.Context, *pb.RpcObjectFindReplacePreviewRequest) *pb.RpcObjectFindReplacePreviewResponse
+	ObjectFindReplaceApply(context.Context, *pb.RpcObjectFindReplaceApplyRequest) *pb.RpcObjectFindReplaceApplyResponse
 	ObjectSearchSubscribe(context.Context, *pb.RpcObjectSearchSubscribeRequest) *pb.RpcObjectSearchSubscribeResponse
 	ObjectCrossSpaceSearchSubscribe(context.Context, *pb.RpcObjectCrossSpaceSearchSubscribeRequest) *pb.RpcObjectCrossSpaceSearchSubscribeResponse
 	ObjectCrossSpaceSearchUnsubscribe(context.Context, *pb.RpcObjectCrossSpaceSearchUnsubscribeRequest) *pb.RpcObjectCrossSpaceSearchUnsubscribeResponse
@@ -3793,6 +4234,7 @@ type ClientCommandsServer interface {
 	ObjectSearchUnsubscribe(context.Context, *pb.RpcObjectSearchUnsubscribeRequest) *pb.RpcObjectSearchUnsubscribeResponse
 	ObjectSetDetails(context.Context, *pb.RpcObjectSetDetailsRequest) *pb.RpcObjectSetDetailsResponse
 	ObjectDuplicate(context.Context, *pb.RpcObjectDuplicateRequest) *pb.RpcObjectDuplicateResponse
+	ObjectTransferToSpace(context.Context, *pb.RpcObjectTransferToSpaceRequest) *pb.RpcObjectTransferToSpaceResponse
 	// ObjectSetObjectType sets an existing object type to the object so it will appear in sets and suggests relations from this type
 	ObjectSetObjectType(context.Context, *pb.RpcObjectSetObjectTypeRequest) *pb.RpcObjectSetObjectTypeResponse
 	ObjectSetLayout(context.Context, *pb.RpcObjectSetLayoutRequest) *pb.RpcObjectSetLayoutResponse
@@ -3824,6 +4266,7 @@ type ClientCommandsServer interface {
 	ObjectImportUseCase(context.Context, *pb.RpcObjectImportUseCaseRequest) *pb.RpcObjectImportUseCaseResponse
 	ObjectImportExperience(context.Context, *pb.RpcObjectImportExperienceRequest) *pb.RpcObjectImportExperienceResponse
 	ObjectDateByTimestamp(context.Context, *pb.RpcObjectDateByTimestampRequest) *pb.RpcObjectDateByTimestampResponse
+	ObjectDateParse(context.Context, *pb.RpcObjectDateParseRequest) *pb.RpcObjectDateParseResponse
 	// Collections
 	// ***
 	ObjectCollectionAdd(context.Context, *pb.RpcObjectCollectionAddRequest) *pb.RpcObjectCollectionAddResponse
@@ -3858,6 +4301,8 @@ type ClientCommandsServer interface {
 	HistoryGetVersions(context.Context, *pb.RpcHistoryGetVersionsRequest) *pb.RpcHistoryGetVersionsResponse
 	HistorySetVersion(context.Context, *pb.RpcHistorySetVersionRequest) *pb.RpcHistorySetVersionResponse
 	HistoryDiffVersions(context.Context, *pb.RpcHistoryDiffVersionsRequest) *pb.RpcHistoryDiffVersionsResponse
+	HistoryGetBlockVersions(context.Context, *pb.RpcHistoryGetBlockVersionsRequest) *pb.RpcHistoryGetBlockVersionsResponse
+	HistoryRestoreBlocks(context.Context, *pb.RpcHistoryRestoreBlocksRequest) *pb.RpcHistoryRestoreBlocksResponse
 	// Files
 	// ***
 	FileSpaceOffload(context.Context, *pb.RpcFileSpaceOffloadRequest) *pb.RpcFileSpaceOffloadResponse
@@ -3866,17 +4311,37 @@ type ClientCommandsServer interface {
 	FileUpload(context.Context, *pb.RpcFileUploadRequest) *pb.RpcFileUploadResponse
 	FileDownload(context.Context, *pb.RpcFileDownloadRequest) *pb.RpcFileDownloadResponse
 	FileDiscardPreload(context.Context, *pb.RpcFileDiscardPreloadRequest) *pb.RpcFileDiscardPreloadResponse
+	FileImageTransform(context.Context, *pb.RpcFileImageTransformRequest) *pb.RpcFileImageTransformResponse
 	FileDrop(context.Context, *pb.RpcFileDropRequest) *pb.RpcFileDropResponse
 	FileSpaceUsage(context.Context, *pb.RpcFileSpaceUsageRequest) *pb.RpcFileSpaceUsageResponse
 	FileNodeUsage(context.Context, *pb.RpcFileNodeUsageRequest) *pb.RpcFileNodeUsageResponse
 	FileSetAutoDownload(context.Context, *pb.RpcFileSetAutoDownloadRequest) *pb.RpcFileSetAutoDownloadResponse
 	FileCacheDownload(context.Context, *pb.RpcFileCacheDownloadRequest) *pb.RpcFileCacheDownloadResponse
 	FileCacheCancelDownload(context.Context, *pb.RpcFileCacheCancelDownloadRequest) *pb.RpcFileCacheCancelDownloadResponse
+	FileSetAutoOffload(context.Context, *pb.RpcFileSetAutoOffloadRequest) *pb.RpcFileSetAutoOffloadResponse
+	FileAutoOffloadStatus(context.Context, *pb.RpcFileAutoOffloadStatusRequest) *pb.RpcFileAutoOffloadStatusResponse
 	NavigationListObjects(context.Context, *pb.RpcNavigationListObjectsRequest) *pb.RpcNavigationListObjectsResponse
 	NavigationGetObjectInfoWithLinks(context.Context, *pb.RpcNavigationGetObjectInfoWithLinksRequest) *pb.RpcNavigationGetObjectInfoWithLinksResponse
 	TemplateCreateFromObject(context.Context, *pb.RpcTemplateCreateFromObjectRequest) *pb.RpcTemplateCreateFromObjectResponse
 	TemplateClone(context.Context, *pb.RpcTemplateCloneRequest) *pb.RpcTemplateCloneResponse
 	TemplateExportAll(context.Context, *pb.RpcTemplateExportAllRequest) *pb.RpcTemplateExportAllResponse
+	TemplateGetVariables(context.Context, *pb.RpcTemplateGetVariablesRequest) *pb.RpcTemplateGetVariablesResponse
+	TemplateIncludeSyncPreview(context.Context, *pb.RpcTemplateIncludeSyncPreviewRequest) *pb.RpcTemplateIncludeSyncPreviewResponse
+	TemplateIncludeSyncApply(context.Context, *pb.RpcTemplateIncludeSyncApplyRequest) *pb.RpcTemplateIncludeSyncApplyResponse
+	JournalOpenNote(context.Context, *pb.RpcJournalOpenNoteRequest) *pb.RpcJournalOpenNoteResponse
+	JournalDateSection(context.Context, *pb.RpcJournalDateSectionRequest) *pb.RpcJournalDateSectionResponse
+	JournalGetSettings(context.Context, *pb.RpcJournalGetSettingsRequest) *pb.RpcJournalGetSettingsResponse
+	JournalSetSettings(context.Context, *pb.RpcJournalSetSettingsRequest) *pb.RpcJournalSetSettingsResponse
+	CalendarExportView(context.Context, *pb.RpcCalendarExportViewRequest) *pb.RpcCalendarExportViewResponse
+	CalendarImport(context.Context, *pb.RpcCalendarImportRequest) *pb.RpcCalendarImportResponse
+	CalendarFeedCreate(context.Context, *pb.RpcCalendarFeedCreateRequest) *pb.RpcCalendarFeedCreateResponse
+	CalendarFeedList(context.Context, *pb.RpcCalendarFeedListRequest) *pb.RpcCalendarFeedListResponse
+	CalendarFeedRemove(context.Context, *pb.RpcCalendarFeedRemoveRequest) *pb.RpcCalendarFeedRemoveResponse
+	CalendarFeedGet(context.Context, *pb.RpcCalendarFeedGetRequest) *pb.RpcCalendarFeedGetResponse
+	WebhookCreate(context.Context, *pb.RpcWebhookCreateRequest) *pb.RpcWebhookCreateResponse
+	WebhookList(context.Context, *pb.RpcWebhookListRequest) *pb.RpcWebhookListResponse
+	WebhookRemove(context.Context, *pb.RpcWebhookRemoveRequest) *pb.RpcWebhookRemoveResponse
+	WebhookDeliveryList(context.Context, *pb.RpcWebhookDeliveryListRequest) *pb.RpcWebhookDeliveryListResponse
 	LinkPreview(context.Context, *pb.RpcLinkPreviewRequest) *pb.RpcLinkPreviewResponse
 	UnsplashSearch(context.Context, *pb.RpcUnsplashSearchRequest) *pb.RpcUnsplashSearchResponse
 	// UnsplashDownload downloads picture from unsplash by ID, put it to the IPFS and returns the hash.
@@ -3884,6 +4349,9 @@ type ClientCommandsServer interface {
 	UnsplashDownload(context.Context, *pb.RpcUnsplashDownloadRequest) *pb.RpcUnsplashDownloadResponse
 	GalleryDownloadManifest(context.Context, *pb.RpcGalleryDownloadManifestRequest) *pb.RpcGalleryDownloadManifestResponse
 	GalleryDownloadIndex(context.Context, *pb.RpcGalleryDownloadIndexRequest) *pb.RpcGalleryDownloadIndexResponse
+	WebdavStart(context.Context, *pb.RpcWebdavStartRequest) *pb.RpcWebdavStartResponse
+	WebdavStop(context.Context, *pb.RpcWebdavStopRequest) *pb.RpcWebdavStopResponse
+	WebdavInfo(context.Context, *pb.RpcWebdavInfoRequest) *pb.RpcWebdavInfoResponse
 	// General Block commands
 	// ***
 	BlockUpload(context.Context, *pb.RpcBlockUploadRequest) *pb.RpcBlockUploadResponse
@@ -3996,6 +4464,8 @@ type ClientCommandsServer interface {
 	DebugTree(context.Context, *pb.RpcDebugTreeRequest) *pb.RpcDebugTreeResponse
 	DebugTreeHeads(context.Context, *pb.RpcDebugTreeHeadsRequest) *pb.RpcDebugTreeHeadsResponse
 	DebugSpaceSummary(context.Context, *pb.RpcDebugSpaceSummaryRequest) *pb.RpcDebugSpaceSummaryResponse
+	DebugSpaceVerify(context.Context, *pb.RpcDebugSpaceVerifyRequest) *pb.RpcDebugSpaceVerifyResponse
+	DebugSpaceRepair(context.Context, *pb.RpcDebugSpaceRepairRequest) *pb.RpcDebugSpaceRepairResponse
 	DebugStackGoroutines(context.Context, *pb.RpcDebugStackGoroutinesRequest) *pb.RpcDebugStackGoroutinesResponse
 	DebugExportLocalstore(context.Context, *pb.RpcDebugExportLocalstoreRequest) *pb.RpcDebugExportLocalstoreResponse
 	DebugPing(context.Context, *pb.RpcDebugPingRequest) *pb.RpcDebugPingResponse
@@ -4248,12 +4718,30 @@ func (*UnimplementedClientCommandsServer) SpaceParticipantRemove(ctx context.Con
 func (*UnimplementedClientCommandsServer) SpaceParticipantPermissionsChange(ctx context.Context, req *pb.RpcSpaceParticipantPermissionsChangeRequest) *pb.RpcSpaceParticipantPermissionsChangeResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) SpaceParticipantRoleSet(ctx context.Context, req *pb.RpcSpaceParticipantRoleSetRequest) *pb.RpcSpaceParticipantRoleSetResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) SpaceSetOrder(ctx context.Context, req *pb.RpcSpaceSetOrderRequest) *pb.RpcSpaceSetOrderResponse {
 	return nil
 }
 func (*UnimplementedClientCommandsServer) SpaceUnsetOrder(ctx context.Context, req *pb.RpcSpaceUnsetOrderRequest) *pb.RpcSpaceUnsetOrderResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) SpaceActivityList(ctx context.Context, req *pb.RpcSpaceActivityListRequest) *pb.RpcSpaceActivityListResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) SpaceActivitySubscribe(ctx context.Context, req *pb.RpcSpaceActivitySubscribeRequest) *pb.RpcSpaceActivitySubscribeResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) SpaceActivityUnsubscribe(ctx context.Context, req *pb.RpcSpaceActivityUnsubscribeRequest) *pb.RpcSpaceActivityUnsubscribeResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) SpaceActivityMarkSeen(ctx context.Context, req *pb.RpcSpaceActivityMarkSeenRequest) *pb.RpcSpaceActivityMarkSeenResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) SpaceAuditLogExport(ctx context.Context, req *pb.RpcSpaceAuditLogExportRequest) *pb.RpcSpaceAuditLogExportResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) PublishingCreate(ctx context.Context, req *pb.RpcPublishingCreateRequest) *pb.RpcPublishingCreateResponse {
 	return nil
 }
@@ -4275,6 +4763,12 @@ func (*UnimplementedClientCommandsServer) ObjectOpen(ctx context.Context, req *p
 func (*UnimplementedClientCommandsServer) ObjectRefresh(ctx context.Context, req *pb.RpcObjectRefreshRequest) *pb.RpcObjectRefreshResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) ObjectSyncDiagnostics(ctx context.Context, req *pb.RpcObjectSyncDiagnosticsRequest) *pb.RpcObjectSyncDiagnosticsResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) ObjectForceResync(ctx context.Context, req *pb.RpcObjectForceResyncRequest) *pb.RpcObjectForceResyncResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) ObjectClose(ctx context.Context, req *pb.RpcObjectCloseRequest) *pb.RpcObjectCloseResponse {
 	return nil
 }
@@ -4302,6 +4796,12 @@ func (*UnimplementedClientCommandsServer) ObjectSearch(ctx context.Context, req
 func (*UnimplementedClientCommandsServer) ObjectSearchWithMeta(ctx context.Context, req *pb.RpcObjectSearchWithMetaRequest) *pb.RpcObjectSearchWithMetaResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) ObjectFindReplacePreview(ctx context.Context, req *pb.RpcObjectFindReplacePreviewRequest) *pb.RpcObjectFindReplacePreviewResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) ObjectFindReplaceApply(ctx context.Context, req *pb.RpcObjectFindReplaceApplyRequest) *pb.RpcObjectFindReplaceApplyResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) ObjectSearchSubscribe(ctx context.Context, req *pb.RpcObjectSearchSubscribeRequest) *pb.RpcObjectSearchSubscribeResponse {
 	return nil
 }
@@ -4326,6 +4826,9 @@ func (*UnimplementedClientCommandsServer) ObjectSetDetails(ctx context.Context,
 func (*UnimplementedClientCommandsServer) ObjectDuplicate(ctx context.Context, req *pb.RpcObjectDuplicateRequest) *pb.RpcObjectDuplicateResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) ObjectTransferToSpace(ctx context.Context, req *pb.RpcObjectTransferToSpaceRequest) *pb.RpcObjectTransferToSpaceResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) ObjectSetObjectType(ctx context.Context, req *pb.RpcObjectSetObjectTypeRequest) *pb.RpcObjectSetObjectTypeResponse {
 	return nil
 }
@@ -4413,6 +4916,9 @@ func (*UnimplementedClientCommandsServer) ObjectImportExperience(ctx context.Con
 func (*UnimplementedClientCommandsServer) ObjectDateByTimestamp(ctx context.Context, req *pb.RpcObjectDateByTimestampRequest) *pb.RpcObjectDateByTimestampResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) ObjectDateParse(ctx context.Context, req *pb.RpcObjectDateParseRequest) *pb.RpcObjectDateParseResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) ObjectCollectionAdd(ctx context.Context, req *pb.RpcObjectCollectionAddRequest) *pb.RpcObjectCollectionAddResponse {
 	return nil
 }
@@ -4491,6 +4997,12 @@ func (*UnimplementedClientCommandsServer) HistorySetVersion(ctx context.Context,
 func (*UnimplementedClientCommandsServer) HistoryDiffVersions(ctx context.Context, req *pb.RpcHistoryDiffVersionsRequest) *pb.RpcHistoryDiffVersionsResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) HistoryGetBlockVersions(ctx context.Context, req *pb.RpcHistoryGetBlockVersionsRequest) *pb.RpcHistoryGetBlockVersionsResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) HistoryRestoreBlocks(ctx context.Context, req *pb.RpcHistoryRestoreBlocksRequest) *pb.RpcHistoryRestoreBlocksResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) FileSpaceOffload(ctx context.Context, req *pb.RpcFileSpaceOffloadRequest) *pb.RpcFileSpaceOffloadResponse {
 	return nil
 }
@@ -4509,6 +5021,9 @@ func (*UnimplementedClientCommandsServer) FileDownload(ctx context.Context, req
 func (*UnimplementedClientCommandsServer) FileDiscardPreload(ctx context.Context, req *pb.RpcFileDiscardPreloadRequest) *pb.RpcFileDiscardPreloadResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) FileImageTransform(ctx context.Context, req *pb.RpcFileImageTransformRequest) *pb.RpcFileImageTransformResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) FileDrop(ctx context.Context, req *pb.RpcFileDropRequest) *pb.RpcFileDropResponse {
 	return nil
 }
@@ -4527,6 +5042,12 @@ func (*UnimplementedClientCommandsServer) FileCacheDownload(ctx context.Context,
 func (*UnimplementedClientCommandsServer) FileCacheCancelDownload(ctx context.Context, req *pb.RpcFileCacheCancelDownloadRequest) *pb.RpcFileCacheCancelDownloadResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) FileSetAutoOffload(ctx context.Context, req *pb.RpcFileSetAutoOffloadRequest) *pb.RpcFileSetAutoOffloadResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) FileAutoOffloadStatus(ctx context.Context, req *pb.RpcFileAutoOffloadStatusRequest) *pb.RpcFileAutoOffloadStatusResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) NavigationListObjects(ctx context.Context, req *pb.RpcNavigationListObjectsRequest) *pb.RpcNavigationListObjectsResponse {
 	return nil
 }
@@ -4542,6 +5063,57 @@ func (*UnimplementedClientCommandsServer) TemplateClone(ctx context.Context, req
 func (*UnimplementedClientCommandsServer) TemplateExportAll(ctx context.Context, req *pb.RpcTemplateExportAllRequest) *pb.RpcTemplateExportAllResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) TemplateGetVariables(ctx context.Context, req *pb.RpcTemplateGetVariablesRequest) *pb.RpcTemplateGetVariablesResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) TemplateIncludeSyncPreview(ctx context.Context, req *pb.RpcTemplateIncludeSyncPreviewRequest) *pb.RpcTemplateIncludeSyncPreviewResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) TemplateIncludeSyncApply(ctx context.Context, req *pb.RpcTemplateIncludeSyncApplyRequest) *pb.RpcTemplateIncludeSyncApplyResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) JournalOpenNote(ctx context.Context, req *pb.RpcJournalOpenNoteRequest) *pb.RpcJournalOpenNoteResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) JournalDateSection(ctx context.Context, req *pb.RpcJournalDateSectionRequest) *pb.RpcJournalDateSectionResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) JournalGetSettings(ctx context.Context, req *pb.RpcJournalGetSettingsRequest) *pb.RpcJournalGetSettingsResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) JournalSetSettings(ctx context.Context, req *pb.RpcJournalSetSettingsRequest) *pb.RpcJournalSetSettingsResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) CalendarExportView(ctx context.Context, req *pb.RpcCalendarExportViewRequest) *pb.RpcCalendarExportViewResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) CalendarImport(ctx context.Context, req *pb.RpcCalendarImportRequest) *pb.RpcCalendarImportResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) CalendarFeedCreate(ctx context.Context, req *pb.RpcCalendarFeedCreateRequest) *pb.RpcCalendarFeedCreateResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) CalendarFeedList(ctx context.Context, req *pb.RpcCalendarFeedListRequest) *pb.RpcCalendarFeedListResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) CalendarFeedRemove(ctx context.Context, req *pb.RpcCalendarFeedRemoveRequest) *pb.RpcCalendarFeedRemoveResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) CalendarFeedGet(ctx context.Context, req *pb.RpcCalendarFeedGetRequest) *pb.RpcCalendarFeedGetResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) WebhookCreate(ctx context.Context, req *pb.RpcWebhookCreateRequest) *pb.RpcWebhookCreateResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) WebhookList(ctx context.Context, req *pb.RpcWebhookListRequest) *pb.RpcWebhookListResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) WebhookRemove(ctx context.Context, req *pb.RpcWebhookRemoveRequest) *pb.RpcWebhookRemoveResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) WebhookDeliveryList(ctx context.Context, req *pb.RpcWebhookDeliveryListRequest) *pb.RpcWebhookDeliveryListResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) LinkPreview(ctx context.Context, req *pb.RpcLinkPreviewRequest) *pb.RpcLinkPreviewResponse {
 	return nil
 }
@@ -4557,6 +5129,15 @@ func (*UnimplementedClientCommandsServer) GalleryDownloadManifest(ctx context.Co
 func (*UnimplementedClientCommandsServer) GalleryDownloadIndex(ctx context.Context, req *pb.RpcGalleryDownloadIndexRequest) *pb.RpcGalleryDownloadIndexResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) WebdavStart(ctx context.Context, req *pb.RpcWebdavStartRequest) *pb.RpcWebdavStartResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) WebdavStop(ctx context.Context, req *pb.RpcWebdavStopRequest) *pb.RpcWebdavStopResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) WebdavInfo(ctx context.Context, req *pb.RpcWebdavInfoRequest) *pb.RpcWebdavInfoResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) BlockUpload(ctx context.Context, req *pb.RpcBlockUploadRequest) *pb.RpcBlockUploadResponse {
 	return nil
 }
@@ -4851,6 +5432,12 @@ func (*UnimplementedClientCommandsServer) DebugTreeHeads(ctx context.Context, re
 func (*UnimplementedClientCommandsServer) DebugSpaceSummary(ctx context.Context, req *pb.RpcDebugSpaceSummaryRequest) *pb.RpcDebugSpaceSummaryResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) DebugSpaceVerify(ctx context.Context, req *pb.RpcDebugSpaceVerifyRequest) *pb.RpcDebugSpaceVerifyResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) DebugSpaceRepair(ctx context.Context, req *pb.RpcDebugSpaceRepairRequest) *pb.RpcDebugSpaceRepairResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) DebugStackGoroutines(ctx context.Context, req *pb.RpcDebugStackGoroutinesRequest) *pb.RpcDebugStackGoroutinesResponse {
 	return nil
 }
@@ -5999,6 +6586,24 @@ func _ClientCommands_SpaceParticipantPermissionsChange_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientCommands_SpaceParticipantRoleSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcSpaceParticipantRoleSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).SpaceParticipantRoleSet(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/SpaceParticipantRoleSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).SpaceParticipantRoleSet(ctx, req.(*pb.RpcSpaceParticipantRoleSetRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientCommands_SpaceSetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(pb.RpcSpaceSetOrderRequest)
 	if err := dec(in); err != nil {
@@ -6035,6 +6640,96 @@ func _ClientCommands_SpaceUnsetOrder_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientCommands_SpaceActivityList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcSpaceActivityListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).SpaceActivityList(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/SpaceActivityList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).SpaceActivityList(ctx, req.(*pb.RpcSpaceActivityListRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_SpaceActivitySubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcSpaceActivitySubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).SpaceActivitySubscribe(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/SpaceActivitySubscribe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).SpaceActivitySubscribe(ctx, req.(*pb.RpcSpaceActivitySubscribeRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_SpaceActivityUnsubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcSpaceActivityUnsubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).SpaceActivityUnsubscribe(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/SpaceActivityUnsubscribe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).SpaceActivityUnsubscribe(ctx, req.(*pb.RpcSpaceActivityUnsubscribeRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_SpaceActivityMarkSeen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcSpaceActivityMarkSeenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).SpaceActivityMarkSeen(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/SpaceActivityMarkSeen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).SpaceActivityMarkSeen(ctx, req.(*pb.RpcSpaceActivityMarkSeenRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_SpaceAuditLogExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcSpaceAuditLogExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).SpaceAuditLogExport(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/SpaceAuditLogExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).SpaceAuditLogExport(ctx, req.(*pb.RpcSpaceAuditLogExportRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientCommands_PublishingCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(pb.RpcPublishingCreateRequest)
 	if err := dec(in); err != nil {
@@ -6161,6 +6856,42 @@ func _ClientCommands_ObjectRefresh_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientCommands_ObjectSyncDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectSyncDiagnosticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectSyncDiagnostics(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectSyncDiagnostics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectSyncDiagnostics(ctx, req.(*pb.RpcObjectSyncDiagnosticsRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_ObjectForceResync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectForceResyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectForceResync(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectForceResync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectForceResync(ctx, req.(*pb.RpcObjectForceResyncRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientCommands_ObjectClose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(pb.RpcObjectCloseRequest)
 	if err := dec(in); err != nil {
@@ -6323,6 +7054,42 @@ func _ClientCommands_ObjectSearchWithMeta_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientCommands_ObjectFindReplacePreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectFindReplacePreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectFindReplacePreview(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectFindReplacePreview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectFindReplacePreview(ctx, req.(*pb.RpcObjectFindReplacePreviewRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_ObjectFindReplaceApply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectFindReplaceApplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectFindReplaceApply(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectFindReplaceApply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectFindReplaceApply(ctx, req.(*pb.RpcObjectFindReplaceApplyRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientCommands_ObjectSearchSubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(pb.RpcObjectSearchSubscribeRequest)
 	if err := dec(in); err != nil {
@@ -6467,6 +7234,24 @@ func _ClientCommands_ObjectDuplicate_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientCommands_ObjectTransferToSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectTransferToSpaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectTransferToSpace(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectTransferToSpace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectTransferToSpace(ctx, req.(*pb.RpcObjectTransferToSpaceRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientCommands_ObjectSetObjectType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(pb.RpcObjectSetObjectTypeRequest)
 	if err := dec(in); err != nil {
@@ -6989,6 +7774,24 @@ func _ClientCommands_ObjectDateByTimestamp_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientCommands_ObjectDateParse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectDateParseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectDateParse(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectDateParse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectDateParse(ctx, req.(*pb.RpcObjectDateParseRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientCommands_ObjectCollectionAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(pb.RpcObjectCollectionAddRequest)
 	if err := dec(in); err != nil {
@@ -7409,356 +8212,752 @@ func _ClientCommands_HistoryGetVersions_Handler(srv interface{}, ctx context.Con
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).HistoryGetVersions(ctx, in), nil
+		return srv.(ClientCommandsServer).HistoryGetVersions(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/HistoryGetVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).HistoryGetVersions(ctx, req.(*pb.RpcHistoryGetVersionsRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_HistorySetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcHistorySetVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).HistorySetVersion(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/HistorySetVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).HistorySetVersion(ctx, req.(*pb.RpcHistorySetVersionRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_HistoryDiffVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcHistoryDiffVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).HistoryDiffVersions(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/HistoryDiffVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).HistoryDiffVersions(ctx, req.(*pb.RpcHistoryDiffVersionsRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_HistoryGetBlockVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcHistoryGetBlockVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).HistoryGetBlockVersions(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/HistoryGetBlockVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).HistoryGetBlockVersions(ctx, req.(*pb.RpcHistoryGetBlockVersionsRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_HistoryRestoreBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcHistoryRestoreBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).HistoryRestoreBlocks(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/HistoryRestoreBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).HistoryRestoreBlocks(ctx, req.(*pb.RpcHistoryRestoreBlocksRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_FileSpaceOffload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFileSpaceOffloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).FileSpaceOffload(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/FileSpaceOffload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).FileSpaceOffload(ctx, req.(*pb.RpcFileSpaceOffloadRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_FileReconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFileReconcileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).FileReconcile(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/FileReconcile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).FileReconcile(ctx, req.(*pb.RpcFileReconcileRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_FileListOffload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFileListOffloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).FileListOffload(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/FileListOffload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).FileListOffload(ctx, req.(*pb.RpcFileListOffloadRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_FileUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFileUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).FileUpload(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/FileUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).FileUpload(ctx, req.(*pb.RpcFileUploadRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_FileDownload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFileDownloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).FileDownload(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/FileDownload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).FileDownload(ctx, req.(*pb.RpcFileDownloadRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_FileDiscardPreload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFileDiscardPreloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).FileDiscardPreload(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/FileDiscardPreload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).FileDiscardPreload(ctx, req.(*pb.RpcFileDiscardPreloadRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_FileImageTransform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFileImageTransformRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).FileImageTransform(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/FileImageTransform",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).FileImageTransform(ctx, req.(*pb.RpcFileImageTransformRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_FileDrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFileDropRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).FileDrop(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/FileDrop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).FileDrop(ctx, req.(*pb.RpcFileDropRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_FileSpaceUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFileSpaceUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).FileSpaceUsage(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/FileSpaceUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).FileSpaceUsage(ctx, req.(*pb.RpcFileSpaceUsageRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_FileNodeUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFileNodeUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).FileNodeUsage(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/FileNodeUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).FileNodeUsage(ctx, req.(*pb.RpcFileNodeUsageRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_FileSetAutoDownload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFileSetAutoDownloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).FileSetAutoDownload(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/FileSetAutoDownload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).FileSetAutoDownload(ctx, req.(*pb.RpcFileSetAutoDownloadRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_FileCacheDownload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFileCacheDownloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).FileCacheDownload(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/FileCacheDownload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).FileCacheDownload(ctx, req.(*pb.RpcFileCacheDownloadRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_FileCacheCancelDownload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFileCacheCancelDownloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).FileCacheCancelDownload(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/FileCacheCancelDownload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).FileCacheCancelDownload(ctx, req.(*pb.RpcFileCacheCancelDownloadRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_FileSetAutoOffload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFileSetAutoOffloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).FileSetAutoOffload(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/FileSetAutoOffload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).FileSetAutoOffload(ctx, req.(*pb.RpcFileSetAutoOffloadRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_FileAutoOffloadStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFileAutoOffloadStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).FileAutoOffloadStatus(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/FileAutoOffloadStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).FileAutoOffloadStatus(ctx, req.(*pb.RpcFileAutoOffloadStatusRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_NavigationListObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcNavigationListObjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).NavigationListObjects(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/NavigationListObjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).NavigationListObjects(ctx, req.(*pb.RpcNavigationListObjectsRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_NavigationGetObjectInfoWithLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcNavigationGetObjectInfoWithLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).NavigationGetObjectInfoWithLinks(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/NavigationGetObjectInfoWithLinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).NavigationGetObjectInfoWithLinks(ctx, req.(*pb.RpcNavigationGetObjectInfoWithLinksRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_TemplateCreateFromObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcTemplateCreateFromObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).TemplateCreateFromObject(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/HistoryGetVersions",
+		FullMethod: "/anytype.ClientCommands/TemplateCreateFromObject",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).HistoryGetVersions(ctx, req.(*pb.RpcHistoryGetVersionsRequest)), nil
+		return srv.(ClientCommandsServer).TemplateCreateFromObject(ctx, req.(*pb.RpcTemplateCreateFromObjectRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_HistorySetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcHistorySetVersionRequest)
+func _ClientCommands_TemplateClone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcTemplateCloneRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).HistorySetVersion(ctx, in), nil
+		return srv.(ClientCommandsServer).TemplateClone(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/HistorySetVersion",
+		FullMethod: "/anytype.ClientCommands/TemplateClone",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).HistorySetVersion(ctx, req.(*pb.RpcHistorySetVersionRequest)), nil
+		return srv.(ClientCommandsServer).TemplateClone(ctx, req.(*pb.RpcTemplateCloneRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_HistoryDiffVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcHistoryDiffVersionsRequest)
+func _ClientCommands_TemplateExportAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcTemplateExportAllRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).HistoryDiffVersions(ctx, in), nil
+		return srv.(ClientCommandsServer).TemplateExportAll(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/HistoryDiffVersions",
+		FullMethod: "/anytype.ClientCommands/TemplateExportAll",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).HistoryDiffVersions(ctx, req.(*pb.RpcHistoryDiffVersionsRequest)), nil
+		return srv.(ClientCommandsServer).TemplateExportAll(ctx, req.(*pb.RpcTemplateExportAllRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_FileSpaceOffload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcFileSpaceOffloadRequest)
+func _ClientCommands_TemplateGetVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcTemplateGetVariablesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).FileSpaceOffload(ctx, in), nil
+		return srv.(ClientCommandsServer).TemplateGetVariables(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/FileSpaceOffload",
+		FullMethod: "/anytype.ClientCommands/TemplateGetVariables",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).FileSpaceOffload(ctx, req.(*pb.RpcFileSpaceOffloadRequest)), nil
+		return srv.(ClientCommandsServer).TemplateGetVariables(ctx, req.(*pb.RpcTemplateGetVariablesRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_FileReconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcFileReconcileRequest)
+func _ClientCommands_TemplateIncludeSyncPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcTemplateIncludeSyncPreviewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).FileReconcile(ctx, in), nil
+		return srv.(ClientCommandsServer).TemplateIncludeSyncPreview(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/FileReconcile",
+		FullMethod: "/anytype.ClientCommands/TemplateIncludeSyncPreview",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).FileReconcile(ctx, req.(*pb.RpcFileReconcileRequest)), nil
+		return srv.(ClientCommandsServer).TemplateIncludeSyncPreview(ctx, req.(*pb.RpcTemplateIncludeSyncPreviewRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_FileListOffload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcFileListOffloadRequest)
+func _ClientCommands_TemplateIncludeSyncApply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcTemplateIncludeSyncApplyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).FileListOffload(ctx, in), nil
+		return srv.(ClientCommandsServer).TemplateIncludeSyncApply(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/FileListOffload",
+		FullMethod: "/anytype.ClientCommands/TemplateIncludeSyncApply",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).FileListOffload(ctx, req.(*pb.RpcFileListOffloadRequest)), nil
+		return srv.(ClientCommandsServer).TemplateIncludeSyncApply(ctx, req.(*pb.RpcTemplateIncludeSyncApplyRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_FileUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcFileUploadRequest)
+func _ClientCommands_JournalOpenNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcJournalOpenNoteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).FileUpload(ctx, in), nil
+		return srv.(ClientCommandsServer).JournalOpenNote(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/FileUpload",
+		FullMethod: "/anytype.ClientCommands/JournalOpenNote",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).FileUpload(ctx, req.(*pb.RpcFileUploadRequest)), nil
+		return srv.(ClientCommandsServer).JournalOpenNote(ctx, req.(*pb.RpcJournalOpenNoteRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_FileDownload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcFileDownloadRequest)
+func _ClientCommands_JournalDateSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcJournalDateSectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).FileDownload(ctx, in), nil
+		return srv.(ClientCommandsServer).JournalDateSection(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/FileDownload",
+		FullMethod: "/anytype.ClientCommands/JournalDateSection",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).FileDownload(ctx, req.(*pb.RpcFileDownloadRequest)), nil
+		return srv.(ClientCommandsServer).JournalDateSection(ctx, req.(*pb.RpcJournalDateSectionRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_FileDiscardPreload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcFileDiscardPreloadRequest)
+func _ClientCommands_JournalGetSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcJournalGetSettingsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).FileDiscardPreload(ctx, in), nil
+		return srv.(ClientCommandsServer).JournalGetSettings(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/FileDiscardPreload",
+		FullMethod: "/anytype.ClientCommands/JournalGetSettings",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).FileDiscardPreload(ctx, req.(*pb.RpcFileDiscardPreloadRequest)), nil
+		return srv.(ClientCommandsServer).JournalGetSettings(ctx, req.(*pb.RpcJournalGetSettingsRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_FileDrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcFileDropRequest)
+func _ClientCommands_JournalSetSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcJournalSetSettingsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).FileDrop(ctx, in), nil
+		return srv.(ClientCommandsServer).JournalSetSettings(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/FileDrop",
+		FullMethod: "/anytype.ClientCommands/JournalSetSettings",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).FileDrop(ctx, req.(*pb.RpcFileDropRequest)), nil
+		return srv.(ClientCommandsServer).JournalSetSettings(ctx, req.(*pb.RpcJournalSetSettingsRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_FileSpaceUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcFileSpaceUsageRequest)
+func _ClientCommands_CalendarExportView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcCalendarExportViewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).FileSpaceUsage(ctx, in), nil
+		return srv.(ClientCommandsServer).CalendarExportView(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/FileSpaceUsage",
+		FullMethod: "/anytype.ClientCommands/CalendarExportView",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).FileSpaceUsage(ctx, req.(*pb.RpcFileSpaceUsageRequest)), nil
+		return srv.(ClientCommandsServer).CalendarExportView(ctx, req.(*pb.RpcCalendarExportViewRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_FileNodeUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcFileNodeUsageRequest)
+func _ClientCommands_CalendarImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcCalendarImportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).FileNodeUsage(ctx, in), nil
+		return srv.(ClientCommandsServer).CalendarImport(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/FileNodeUsage",
+		FullMethod: "/anytype.ClientCommands/CalendarImport",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).FileNodeUsage(ctx, req.(*pb.RpcFileNodeUsageRequest)), nil
+		return srv.(ClientCommandsServer).CalendarImport(ctx, req.(*pb.RpcCalendarImportRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_FileSetAutoDownload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcFileSetAutoDownloadRequest)
+func _ClientCommands_CalendarFeedCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcCalendarFeedCreateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).FileSetAutoDownload(ctx, in), nil
+		return srv.(ClientCommandsServer).CalendarFeedCreate(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/FileSetAutoDownload",
+		FullMethod: "/anytype.ClientCommands/CalendarFeedCreate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).FileSetAutoDownload(ctx, req.(*pb.RpcFileSetAutoDownloadRequest)), nil
+		return srv.(ClientCommandsServer).CalendarFeedCreate(ctx, req.(*pb.RpcCalendarFeedCreateRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_FileCacheDownload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcFileCacheDownloadRequest)
+func _ClientCommands_CalendarFeedList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcCalendarFeedListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).FileCacheDownload(ctx, in), nil
+		return srv.(ClientCommandsServer).CalendarFeedList(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/FileCacheDownload",
+		FullMethod: "/anytype.ClientCommands/CalendarFeedList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).FileCacheDownload(ctx, req.(*pb.RpcFileCacheDownloadRequest)), nil
+		return srv.(ClientCommandsServer).CalendarFeedList(ctx, req.(*pb.RpcCalendarFeedListRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_FileCacheCancelDownload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcFileCacheCancelDownloadRequest)
+func _ClientCommands_CalendarFeedRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcCalendarFeedRemoveRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).FileCacheCancelDownload(ctx, in), nil
+		return srv.(ClientCommandsServer).CalendarFeedRemove(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/FileCacheCancelDownload",
+		FullMethod: "/anytype.ClientCommands/CalendarFeedRemove",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).FileCacheCancelDownload(ctx, req.(*pb.RpcFileCacheCancelDownloadRequest)), nil
+		return srv.(ClientCommandsServer).CalendarFeedRemove(ctx, req.(*pb.RpcCalendarFeedRemoveRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_NavigationListObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcNavigationListObjectsRequest)
+func _ClientCommands_CalendarFeedGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcCalendarFeedGetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).NavigationListObjects(ctx, in), nil
+		return srv.(ClientCommandsServer).CalendarFeedGet(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/NavigationListObjects",
+		FullMethod: "/anytype.ClientCommands/CalendarFeedGet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).NavigationListObjects(ctx, req.(*pb.RpcNavigationListObjectsRequest)), nil
+		return srv.(ClientCommandsServer).CalendarFeedGet(ctx, req.(*pb.RpcCalendarFeedGetRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_NavigationGetObjectInfoWithLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcNavigationGetObjectInfoWithLinksRequest)
+func _ClientCommands_WebhookCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcWebhookCreateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).NavigationGetObjectInfoWithLinks(ctx, in), nil
+		return srv.(ClientCommandsServer).WebhookCreate(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/NavigationGetObjectInfoWithLinks",
+		FullMethod: "/anytype.ClientCommands/WebhookCreate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).NavigationGetObjectInfoWithLinks(ctx, req.(*pb.RpcNavigationGetObjectInfoWithLinksRequest)), nil
+		return srv.(ClientCommandsServer).WebhookCreate(ctx, req.(*pb.RpcWebhookCreateRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_TemplateCreateFromObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcTemplateCreateFromObjectRequest)
+func _ClientCommands_WebhookList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcWebhookListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).TemplateCreateFromObject(ctx, in), nil
+		return srv.(ClientCommandsServer).WebhookList(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/TemplateCreateFromObject",
+		FullMethod: "/anytype.ClientCommands/WebhookList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).TemplateCreateFromObject(ctx, req.(*pb.RpcTemplateCreateFromObjectRequest)), nil
+		return srv.(ClientCommandsServer).WebhookList(ctx, req.(*pb.RpcWebhookListRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_TemplateClone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcTemplateCloneRequest)
+func _ClientCommands_WebhookRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcWebhookRemoveRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).TemplateClone(ctx, in), nil
+		return srv.(ClientCommandsServer).WebhookRemove(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/TemplateClone",
+		FullMethod: "/anytype.ClientCommands/WebhookRemove",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).TemplateClone(ctx, req.(*pb.RpcTemplateCloneRequest)), nil
+		return srv.(ClientCommandsServer).WebhookRemove(ctx, req.(*pb.RpcWebhookRemoveRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_TemplateExportAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcTemplateExportAllRequest)
+func _ClientCommands_WebhookDeliveryList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcWebhookDeliveryListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).TemplateExportAll(ctx, in), nil
+		return srv.(ClientCommandsServer).WebhookDeliveryList(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/TemplateExportAll",
+		FullMethod: "/anytype.ClientCommands/WebhookDeliveryList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).TemplateExportAll(ctx, req.(*pb.RpcTemplateExportAllRequest)), nil
+		return srv.(ClientCommandsServer).WebhookDeliveryList(ctx, req.(*pb.RpcWebhookDeliveryListRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -7853,6 +9052,60 @@ func _ClientCommands_GalleryDownloadIndex_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientCommands_WebdavStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcWebdavStartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).WebdavStart(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/WebdavStart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).WebdavStart(ctx, req.(*pb.RpcWebdavStartRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_WebdavStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcWebdavStopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).WebdavStop(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/WebdavStop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).WebdavStop(ctx, req.(*pb.RpcWebdavStopRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_WebdavInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcWebdavInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).WebdavInfo(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/WebdavInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).WebdavInfo(ctx, req.(*pb.RpcWebdavInfoRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientCommands_BlockUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(pb.RpcBlockUploadRequest)
 	if err := dec(in); err != nil {
@@ -9617,6 +10870,42 @@ func _ClientCommands_DebugSpaceSummary_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientCommands_DebugSpaceVerify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcDebugSpaceVerifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).DebugSpaceVerify(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/DebugSpaceVerify",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).DebugSpaceVerify(ctx, req.(*pb.RpcDebugSpaceVerifyRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_DebugSpaceRepair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcDebugSpaceRepairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).DebugSpaceRepair(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/DebugSpaceRepair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).DebugSpaceRepair(ctx, req.(*pb.RpcDebugSpaceRepairRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientCommands_DebugStackGoroutines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(pb.RpcDebugStackGoroutinesRequest)
 	if err := dec(in); err != nil {
@@ -10971,6 +12260,10 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SpaceParticipantPermissionsChange",
 			Handler:    _ClientCommands_SpaceParticipantPermissionsChange_Handler,
 		},
+		{
+			MethodName: "SpaceParticipantRoleSet",
+			Handler:    _ClientCommands_SpaceParticipantRoleSet_Handler,
+		},
 		{
 			MethodName: "SpaceSetOrder",
 			Handler:    _ClientCommands_SpaceSetOrder_Handler,
@@ -10979,6 +12272,26 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SpaceUnsetOrder",
 			Handler:    _ClientCommands_SpaceUnsetOrder_Handler,
 		},
+		{
+			MethodName: "SpaceActivityList",
+			Handler:    _ClientCommands_SpaceActivityList_Handler,
+		},
+		{
+			MethodName: "SpaceActivitySubscribe",
+			Handler:    _ClientCommands_SpaceActivitySubscribe_Handler,
+		},
+		{
+			MethodName: "SpaceActivityUnsubscribe",
+			Handler:    _ClientCommands_SpaceActivityUnsubscribe_Handler,
+		},
+		{
+			MethodName: "SpaceActivityMarkSeen",
+			Handler:    _ClientCommands_SpaceActivityMarkSeen_Handler,
+		},
+		{
+			MethodName: "SpaceAuditLogExport",
+			Handler:    _ClientCommands_SpaceAuditLogExport_Handler,
+		},
 		{
 			MethodName: "PublishingCreate",
 			Handler:    _ClientCommands_PublishingCreate_Handler,
@@ -11007,6 +12320,14 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ObjectRefresh",
 			Handler:    _ClientCommands_ObjectRefresh_Handler,
 		},
+		{
+			MethodName: "ObjectSyncDiagnostics",
+			Handler:    _ClientCommands_ObjectSyncDiagnostics_Handler,
+		},
+		{
+			MethodName: "ObjectForceResync",
+			Handler:    _ClientCommands_ObjectForceResync_Handler,
+		},
 		{
 			MethodName: "ObjectClose",
 			Handler:    _ClientCommands_ObjectClose_Handler,
@@ -11043,6 +12364,14 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ObjectSearchWithMeta",
 			Handler:    _ClientCommands_ObjectSearchWithMeta_Handler,
 		},
+		{
+			MethodName: "ObjectFindReplacePreview",
+			Handler:    _ClientCommands_ObjectFindReplacePreview_Handler,
+		},
+		{
+			MethodName: "ObjectFindReplaceApply",
+			Handler:    _ClientCommands_ObjectFindReplaceApply_Handler,
+		},
 		{
 			MethodName: "ObjectSearchSubscribe",
 			Handler:    _ClientCommands_ObjectSearchSubscribe_Handler,
@@ -11075,6 +12404,10 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ObjectDuplicate",
 			Handler:    _ClientCommands_ObjectDuplicate_Handler,
 		},
+		{
+			MethodName: "ObjectTransferToSpace",
+			Handler:    _ClientCommands_ObjectTransferToSpace_Handler,
+		},
 		{
 			MethodName: "ObjectSetObjectType",
 			Handler:    _ClientCommands_ObjectSetObjectType_Handler,
@@ -11191,6 +12524,10 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ObjectDateByTimestamp",
 			Handler:    _ClientCommands_ObjectDateByTimestamp_Handler,
 		},
+		{
+			MethodName: "ObjectDateParse",
+			Handler:    _ClientCommands_ObjectDateParse_Handler,
+		},
 		{
 			MethodName: "ObjectCollectionAdd",
 			Handler:    _ClientCommands_ObjectCollectionAdd_Handler,
@@ -11295,6 +12632,14 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "HistoryDiffVersions",
 			Handler:    _ClientCommands_HistoryDiffVersions_Handler,
 		},
+		{
+			MethodName: "HistoryGetBlockVersions",
+			Handler:    _ClientCommands_HistoryGetBlockVersions_Handler,
+		},
+		{
+			MethodName: "HistoryRestoreBlocks",
+			Handler:    _ClientCommands_HistoryRestoreBlocks_Handler,
+		},
 		{
 			MethodName: "FileSpaceOffload",
 			Handler:    _ClientCommands_FileSpaceOffload_Handler,
@@ -11319,6 +12664,10 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FileDiscardPreload",
 			Handler:    _ClientCommands_FileDiscardPreload_Handler,
 		},
+		{
+			MethodName: "FileImageTransform",
+			Handler:    _ClientCommands_FileImageTransform_Handler,
+		},
 		{
 			MethodName: "FileDrop",
 			Handler:    _ClientCommands_FileDrop_Handler,
@@ -11343,6 +12692,14 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FileCacheCancelDownload",
 			Handler:    _ClientCommands_FileCacheCancelDownload_Handler,
 		},
+		{
+			MethodName: "FileSetAutoOffload",
+			Handler:    _ClientCommands_FileSetAutoOffload_Handler,
+		},
+		{
+			MethodName: "FileAutoOffloadStatus",
+			Handler:    _ClientCommands_FileAutoOffloadStatus_Handler,
+		},
 		{
 			MethodName: "NavigationListObjects",
 			Handler:    _ClientCommands_NavigationListObjects_Handler,
@@ -11363,6 +12720,74 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TemplateExportAll",
 			Handler:    _ClientCommands_TemplateExportAll_Handler,
 		},
+		{
+			MethodName: "TemplateGetVariables",
+			Handler:    _ClientCommands_TemplateGetVariables_Handler,
+		},
+		{
+			MethodName: "TemplateIncludeSyncPreview",
+			Handler:    _ClientCommands_TemplateIncludeSyncPreview_Handler,
+		},
+		{
+			MethodName: "TemplateIncludeSyncApply",
+			Handler:    _ClientCommands_TemplateIncludeSyncApply_Handler,
+		},
+		{
+			MethodName: "JournalOpenNote",
+			Handler:    _ClientCommands_JournalOpenNote_Handler,
+		},
+		{
+			MethodName: "JournalDateSection",
+			Handler:    _ClientCommands_JournalDateSection_Handler,
+		},
+		{
+			MethodName: "JournalGetSettings",
+			Handler:    _ClientCommands_JournalGetSettings_Handler,
+		},
+		{
+			MethodName: "JournalSetSettings",
+			Handler:    _ClientCommands_JournalSetSettings_Handler,
+		},
+		{
+			MethodName: "CalendarExportView",
+			Handler:    _ClientCommands_CalendarExportView_Handler,
+		},
+		{
+			MethodName: "CalendarImport",
+			Handler:    _ClientCommands_CalendarImport_Handler,
+		},
+		{
+			MethodName: "CalendarFeedCreate",
+			Handler:    _ClientCommands_CalendarFeedCreate_Handler,
+		},
+		{
+			MethodName: "CalendarFeedList",
+			Handler:    _ClientCommands_CalendarFeedList_Handler,
+		},
+		{
+			MethodName: "CalendarFeedRemove",
+			Handler:    _ClientCommands_CalendarFeedRemove_Handler,
+		},
+		{
+			MethodName: "CalendarFeedGet",
+			Handler:    _ClientCommands_CalendarFeedGet_Handler,
+		},
+		{
+			MethodName: "WebhookCreate",
+			Handler:    _ClientCommands_WebhookCreate_Handler,
+		},
+		{
+			MethodName: "WebhookList",
+			Handler:    _ClientCommands_WebhookList_Handler,
+		},
+		{
+			MethodName: "WebhookRemove",
+			Handler:    _ClientCommands_WebhookRemove_Handler,
+		},
+		{
+			MethodName: "WebhookDeliveryList",
+			Handler:    _ClientCommands_WebhookDeliveryList_Handler,
+		},
 		{
 			MethodName: "LinkPreview",
 			Handler:    _ClientCommands_LinkPreview_Handler,
@@ -11383,6 +12808,18 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GalleryDownloadIndex",
 			Handler:    _ClientCommands_GalleryDownloadIndex_Handler,
 		},
+		{
+			MethodName: "WebdavStart",
+			Handler:    _ClientCommands_WebdavStart_Handler,
+		},
+		{
+			MethodName: "WebdavStop",
+			Handler:    _ClientCommands_WebdavStop_Handler,
+		},
+		{
+			MethodName: "WebdavInfo",
+			Handler:    _ClientCommands_WebdavInfo_Handler,
+		},
 		{
 			MethodName: "BlockUpload",
 			Handler:    _ClientCommands_BlockUpload_Handler,
@@ -11775,6 +13212,14 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DebugSpaceSummary",
 			Handler:    _ClientCommands_DebugSpaceSummary_Handler,
 		},
+		{
+			MethodName: "DebugSpaceVerify",
+			Handler:    _ClientCommands_DebugSpaceVerify_Handler,
+		},
+		{
+			MethodName: "DebugSpaceRepair",
+			Handler:    _ClientCommands_DebugSpaceRepair_Handler,
+		},
 		{
 			MethodName: "DebugStackGoroutines",
 			Handler:    _ClientCommands_DebugStackGoroutines_Handler,
@@ -9,7 +9,7 @@ import (
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 )
 
-const RelationChecksum = "fc4e288e670900b4c987ec16963687b7591fd700788b567702ebc1f7a7bf48e7"
+const RelationChecksum = "aa34d157cb3ff18c1ea27ba12deb0cb15ded0a1bab8e12998265b0b42d5913a8"
 const (
 	RelationKeyTag                                  domain.RelationKey = "tag"
 	RelationKeyCamera                               domain.RelationKey = "camera"
@@ -190,6 +190,16 @@ const (
 	RelationKeyFileAvailableOffline                 domain.RelationKey = "fileAvailableOffline"
 	RelationKeyAnalyticsChatId                      domain.RelationKey = "analyticsChatId"
 	RelationKeyAnalyticsSpaceId                     domain.RelationKey = "analyticsSpaceId"
+	RelationKeySpaceCommenterIds                    domain.RelationKey = "spaceCommenterIds"
+	RelationKeySpaceCollectionEditorIds             domain.RelationKey = "spaceCollectionEditorIds"
+	RelationKeyCollectionEditorIds                  domain.RelationKey = "collectionEditorIds"
+	RelationKeySpaceInviteExpiresAt                 domain.RelationKey = "spaceInviteExpiresAt"
+	RelationKeySpaceInviteMaxUses                   domain.RelationKey = "spaceInviteMaxUses"
+	RelationKeyJournalKey                           domain.RelationKey = "journalKey"
+	RelationKeySpaceJournalTypeKey                  domain.RelationKey = "spaceJournalTypeKey"
+	RelationKeySpaceJournalDailyTemplateId          domain.RelationKey = "spaceJournalDailyTemplateId"
+	RelationKeySpaceJournalWeeklyTemplateId         domain.RelationKey = "spaceJournalWeeklyTemplateId"
+	RelationKeySpaceJournalMonthlyTemplateId        domain.RelationKey = "spaceJournalMonthlyTemplateId"
 	RelationKey_score                               domain.RelationKey = "_score"
 )
 
@@ -411,6 +421,20 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyCollectionEditorIds: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Participants who can edit objects of the collection",
+			Format:           model.RelationFormat_object,
+			Hidden:           true,
+			Id:               "_brcollectionEditorIds",
+			Key:              "collectionEditorIds",
+			Name:             "Collection editors",
+			ObjectTypes:      []string{TypePrefix + "participant"},
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyCollectionOf: {
 
 			DataSource:       model.Relation_details,
@@ -1247,6 +1271,20 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyJournalKey: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Key of the journal period the note belongs to, derived from the start date of the period",
+			Format:           model.RelationFormat_shorttext,
+			Hidden:           true,
+			Id:               "_brjournalKey",
+			Key:              "journalKey",
+			MaxCount:         1,
+			Name:             "Journal key",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyLastChangeId: {
 
 			DataSource:       model.Relation_derived,
@@ -2094,6 +2132,34 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeySpaceCollectionEditorIds: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Participants who can edit only objects of collections where they are listed as editors",
+			Format:           model.RelationFormat_object,
+			Hidden:           true,
+			Id:               "_brspaceCollectionEditorIds",
+			Key:              "spaceCollectionEditorIds",
+			Name:             "Collection editors",
+			ObjectTypes:      []string{TypePrefix + "participant"},
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
+		RelationKeySpaceCommenterIds: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Participants who can only comment and react in the space",
+			Format:           model.RelationFormat_object,
+			Hidden:           true,
+			Id:               "_brspaceCommenterIds",
+			Key:              "spaceCommenterIds",
+			Name:             "Commenters",
+			ObjectTypes:      []string{TypePrefix + "participant"},
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeySpaceDashboardId: {
 
 			DataSource:       model.Relation_details,
@@ -2123,6 +2189,21 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeySpaceInviteExpiresAt: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Date after which the current invite of the space is revoked",
+			Format:           model.RelationFormat_date,
+			Hidden:           true,
+			Id:               "_brspaceInviteExpiresAt",
+			IncludeTime:      true,
+			Key:              "spaceInviteExpiresAt",
+			MaxCount:         1,
+			Name:             "Invite expiration date",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeySpaceInviteFileCid: {
 
 			DataSource:       model.Relation_details,
@@ -2179,6 +2260,20 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeySpaceInviteMaxUses: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Number of joins after which the current invite of the space is revoked",
+			Format:           model.RelationFormat_number,
+			Hidden:           true,
+			Id:               "_brspaceInviteMaxUses",
+			Key:              "spaceInviteMaxUses",
+			MaxCount:         1,
+			Name:             "Invite max uses",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeySpaceInvitePermissions: {
 
 			DataSource:       model.Relation_details,
@@ -2221,6 +2316,62 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeySpaceJournalDailyTemplateId: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Template of daily journal notes in the space",
+			Format:           model.RelationFormat_object,
+			Hidden:           true,
+			Id:               "_brspaceJournalDailyTemplateId",
+			Key:              "spaceJournalDailyTemplateId",
+			MaxCount:         1,
+			Name:             "Daily journal template",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
+		RelationKeySpaceJournalMonthlyTemplateId: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Template of monthly journal notes in the space",
+			Format:           model.RelationFormat_object,
+			Hidden:           true,
+			Id:               "_brspaceJournalMonthlyTemplateId",
+			Key:              "spaceJournalMonthlyTemplateId",
+			MaxCount:         1,
+			Name:             "Monthly journal template",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
+		RelationKeySpaceJournalTypeKey: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Key of the type of journal notes in the space",
+			Format:           model.RelationFormat_shorttext,
+			Hidden:           true,
+			Id:               "_brspaceJournalTypeKey",
+			Key:              "spaceJournalTypeKey",
+			MaxCount:         1,
+			Name:             "Journal type key",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
+		RelationKeySpaceJournalWeeklyTemplateId: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Template of weekly journal notes in the space",
+			Format:           model.RelationFormat_object,
+			Hidden:           true,
+			Id:               "_brspaceJournalWeeklyTemplateId",
+			Key:              "spaceJournalWeeklyTemplateId",
+			MaxCount:         1,
+			Name:             "Weekly journal template",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeySpaceLocalStatus: {
 
 			DataSource:       model.Relation_derived,
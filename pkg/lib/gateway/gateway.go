@@ -49,10 +49,23 @@ func New() Gateway {
 // Gateway is a HTTP API for getting files and links from IPFS
 type Gateway interface {
 	Addr() string
+	// FileContent returns the content of the file object
+	FileContent(ctx context.Context, objectId string) (*Content, error)
+	// ImageContent returns the content of the image object. If the width is positive, the smallest
+	// variant of the image not narrower than the width is returned
+	ImageContent(ctx context.Context, objectId string, width int) (*Content, error)
 	app.ComponentRunnable
 	app.ComponentStatable
 }
 
+// Content is the content of a file with its metadata
+type Content struct {
+	Name     string
+	MimeType string
+	Added    time.Time
+	Reader   io.ReadSeeker
+}
+
 type gateway struct {
 	fileService       files.Service
 	fileObjectService fileobject.Service
@@ -260,8 +273,10 @@ func (g *gateway) fileHandler(w http.ResponseWriter, r *http.Request) {
 func (g *gateway) getFile(ctx context.Context, r *http.Request) (files.File, io.ReadSeeker, error) {
 	fileIdAndPath := strings.TrimPrefix(r.URL.Path, "/file/")
 	parts := strings.Split(fileIdAndPath, "/")
-	objectId := parts[0]
+	return g.readFile(ctx, parts[0])
+}
 
+func (g *gateway) readFile(ctx context.Context, objectId string) (files.File, io.ReadSeeker, error) {
 	var file files.File
 	var reader io.ReadSeeker
 	file, err := g.fileObjectService.GetFileData(ctx, objectId)
@@ -311,6 +326,18 @@ func (g *gateway) getImage(ctx context.Context, r *http.Request) (*getImageReade
 	urlParts := strings.Split(r.URL.Path, "/")
 	imageId := urlParts[2]
 
+	var width int
+	if wantWidthStr := r.URL.Query().Get("width"); wantWidthStr != "" {
+		var err error
+		width, err = strconv.Atoi(wantWidthStr)
+		if err != nil {
+			return nil, fmt.Errorf("parse width: %w", err)
+		}
+	}
+	return g.readImage(ctx, imageId, width)
+}
+
+func (g *gateway) readImage(ctx context.Context, imageId string, width int) (*getImageReaderResult, error) {
 	retryOptions := []retry.Option{
 		retry.Context(ctx),
 		retry.Attempts(0),
@@ -334,7 +361,7 @@ func (g *gateway) getImage(ctx context.Context, r *http.Request) (*getImageReade
 				return nil, fmt.Errorf("get image data: %w", err)
 			}
 		}
-		res, err := g.getImageReader(ctx, img, r)
+		res, err := g.getImageReader(ctx, img, width)
 		if err != nil {
 			return nil, fmt.Errorf("get image reader: %w", err)
 		}
@@ -391,11 +418,8 @@ func (r *retryReadSeeker) Seek(offset int64, whence int) (int64, error) {
 	}, r.options...)
 }
 
-func (g *gateway) getImageReader(ctx context.Context, image files.Image, req *http.Request) (*getImageReaderResult, error) {
+func (g *gateway) getImageReader(ctx context.Context, image files.Image, wantWidth int) (*getImageReaderResult, error) {
 	var file files.File
-	query := req.URL.Query()
-	wantWidthStr := query.Get("width")
-
 	orig, err := image.GetOriginalFile()
 	if err != nil {
 		return nil, fmt.Errorf("get original file: %w", err)
@@ -405,13 +429,9 @@ func (g *gateway) getImageReader(ctx context.Context, image files.Image, req *ht
 		return g.handleSVGFile(ctx, orig)
 	}
 
-	if wantWidthStr == "" {
+	if wantWidth <= 0 {
 		file = orig
 	} else {
-		wantWidth, err := strconv.Atoi(wantWidthStr)
-		if err != nil {
-			return nil, fmt.Errorf("parse width: %w", err)
-		}
 		file, err = image.GetFileForWidth(wantWidth)
 		if err != nil {
 			return nil, fmt.Errorf("get image file: %w", err)
@@ -443,6 +463,34 @@ func (g *gateway) handleSVGFile(ctx context.Context, file files.File) (*getImage
 	}, nil
 }
 
+func (g *gateway) FileContent(ctx context.Context, objectId string) (*Content, error) {
+	file, reader, err := g.readFile(rpcstore.ContextWithWaitAvailable(ctx), objectId)
+	if err != nil {
+		return nil, err
+	}
+	meta := file.Meta()
+	return &Content{
+		Name:     meta.Name,
+		MimeType: meta.Media,
+		Added:    meta.Added,
+		Reader:   reader,
+	}, nil
+}
+
+func (g *gateway) ImageContent(ctx context.Context, objectId string, width int) (*Content, error) {
+	res, err := g.readImage(rpcstore.ContextWithWaitAvailable(ctx), objectId, width)
+	if err != nil {
+		return nil, err
+	}
+	meta := res.file.Meta()
+	return &Content{
+		Name:     meta.Name,
+		MimeType: res.mimeType,
+		Added:    meta.Added,
+		Reader:   res.reader,
+	}, nil
+}
+
 func cleanUpPathForLogging(input string) string {
 	parts := strings.SplitN(strings.TrimPrefix(input, "/"), "/", 2)
 	if len(parts) < 2 {
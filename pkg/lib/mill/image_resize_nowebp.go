@@ -10,3 +10,7 @@ import (
 func (m *ImageResize) resizeWEBP(_ *image.Config, _ io.ReadSeeker) (*Result, error) {
 	return nil, ErrFormatSupportNotEnabled
 }
+
+func encodeWEBP(_ io.Writer, _ image.Image, _ int) error {
+	return ErrFormatSupportNotEnabled
+}
@@ -0,0 +1,212 @@
+package mill
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+
+	"github.com/kovidgoyal/imaging"
+)
+
+const defaultTransformQuality = 95
+
+var (
+	ErrInvalidCrop     = errors.New("crop rectangle is out of image bounds")
+	ErrInvalidRotation = errors.New("rotation should be a multiple of 90 degrees")
+)
+
+// ImageTransformOpts describes an edit of the image. Operations are applied in the following order:
+// orientation normalization (for JPEG images with EXIF orientation), crop, rotation, flip.
+// The result is always re-encoded, so it never contains EXIF metadata, including GPS location
+type ImageTransformOpts struct {
+	// Crop is a rectangle in the coordinates of the normalized image. Empty rectangle means no crop
+	Crop           image.Rectangle
+	Rotate         int // clockwise, in degrees
+	FlipHorizontal bool
+	FlipVertical   bool
+	// Format is the format of the result. Empty format means the source format, or PNG if the source format can't be encoded
+	Format  Format
+	Quality int // JPEG and WEBP quality, 0 means default
+}
+
+// TransformImage decodes the image, applies transformations and encodes it into the requested format.
+// Animated GIF images keep all frames, unless they are converted to another format.
+// Result meta contains width, height and format of the resulting image
+func TransformImage(r io.ReadSeeker, opts ImageTransformOpts) (*Result, error) {
+	if opts.Rotate%90 != 0 {
+		return nil, ErrInvalidRotation
+	}
+
+	img, sourceFormat, err := decodeNormalized(r)
+	if err != nil {
+		return nil, err
+	}
+
+	format := opts.Format
+	if format == "" {
+		format = sourceFormat
+	}
+	if sourceFormat == GIF && format == GIF {
+		return transformGIF(r, opts)
+	}
+
+	img, err = transformFrame(img, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	quality := opts.Quality
+	if quality <= 0 || quality > 100 {
+		quality = defaultTransformQuality
+	}
+
+	buf := pool.Get()
+	defer func() {
+		_ = buf.Close()
+	}()
+	format, err = encodeImage(buf, img, format, quality)
+	if err != nil {
+		return nil, err
+	}
+
+	readSeekCloser, err := buf.GetReadSeekCloser()
+	if err != nil {
+		return nil, err
+	}
+	return &Result{
+		File: readSeekCloser,
+		Meta: map[string]interface{}{
+			"width":  img.Bounds().Dx(),
+			"height": img.Bounds().Dy(),
+			"format": string(format),
+		},
+	}, nil
+}
+
+// transformFrame applies crop, rotation and flips to the image
+func transformFrame(img image.Image, opts ImageTransformOpts) (image.Image, error) {
+	if !opts.Crop.Empty() {
+		if !opts.Crop.In(img.Bounds()) {
+			return nil, ErrInvalidCrop
+		}
+		img = imaging.Crop(img, opts.Crop)
+	}
+
+	switch (opts.Rotate%360 + 360) % 360 {
+	case 90:
+		// imaging rotates counter-clockwise
+		img = imaging.Rotate270(img)
+	case 180:
+		img = imaging.Rotate180(img)
+	case 270:
+		img = imaging.Rotate90(img)
+	}
+	if opts.FlipHorizontal {
+		img = imaging.FlipH(img)
+	}
+	if opts.FlipVertical {
+		img = imaging.FlipV(img)
+	}
+	return img, nil
+}
+
+// transformGIF applies transformations to every frame, so animation, frame delays and loop count are kept
+func transformGIF(r io.ReadSeeker, opts ImageTransformOpts) (*Result, error) {
+	_, err := r.Seek(0, io.SeekStart)
+	if err != nil {
+		return nil, err
+	}
+	gifImg, err := gif.DecodeAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("decode gif: %w", err)
+	}
+
+	// frames can cover only a part of the image, so they are drawn over the previous ones before transforming
+	rgba := image.NewRGBA(image.Rect(0, 0, gifImg.Config.Width, gifImg.Config.Height))
+	for index, frame := range gifImg.Image {
+		bounds := frame.Bounds()
+		draw.Draw(rgba, bounds, frame, bounds.Min, draw.Over)
+		transformed, err := transformFrame(rgba, opts)
+		if err != nil {
+			return nil, err
+		}
+		gifImg.Image[index] = imageToPaletted(transformed)
+	}
+	// transformed frames cover the whole image, so they shouldn't be disposed
+	gifImg.Disposal = nil
+	gifImg.Config.Width, gifImg.Config.Height = gifImg.Image[0].Bounds().Dx(), gifImg.Image[0].Bounds().Dy()
+
+	buf := pool.Get()
+	defer func() {
+		_ = buf.Close()
+	}()
+	if err = gif.EncodeAll(buf, gifImg); err != nil {
+		return nil, err
+	}
+
+	readSeekCloser, err := buf.GetReadSeekCloser()
+	if err != nil {
+		return nil, err
+	}
+	return &Result{
+		File: readSeekCloser,
+		Meta: map[string]interface{}{
+			"width":  gifImg.Config.Width,
+			"height": gifImg.Config.Height,
+			"format": string(GIF),
+		},
+	}, nil
+}
+
+// decodeNormalized decodes the image and applies EXIF orientation, so the result looks like it is shown to the user
+func decodeNormalized(r io.ReadSeeker) (image.Image, Format, error) {
+	img, formatStr, err := image.Decode(r)
+	if err != nil {
+		return nil, "", fmt.Errorf("decode image: %w", err)
+	}
+	format := Format(formatStr)
+	if format != JPEG {
+		return img, format, nil
+	}
+
+	_, err = r.Seek(0, io.SeekStart)
+	if err != nil {
+		return nil, "", err
+	}
+	exifData, err := getExifData(r)
+	if err != nil {
+		log.Errorf("failed to get exif data: %v", err)
+		return img, format, nil
+	}
+	if exifData == nil {
+		return img, format, nil
+	}
+	orientation, err := getJpegOrientation(exifData)
+	if err != nil {
+		log.Errorf("failed to get jpeg orientation: %v", err)
+	}
+	if orientation > 1 {
+		img = reverseOrientation(img, orientation)
+	}
+	return img, format, nil
+}
+
+func encodeImage(w io.Writer, img image.Image, format Format, quality int) (Format, error) {
+	switch format {
+	case JPEG:
+		return format, jpeg.Encode(w, img, &jpeg.Options{Quality: quality})
+	case GIF:
+		return format, gif.Encode(w, img, nil)
+	case WEBP:
+		return format, encodeWEBP(w, img, quality)
+	case PNG:
+		return format, png.Encode(w, img)
+	default:
+		return PNG, png.Encode(w, img)
+	}
+}
@@ -0,0 +1,166 @@
+package mill
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/gif"
+	"io"
+	"os"
+	"testing"
+
+	exif2 "github.com/dsoprea/go-exif/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/mill/testdata"
+)
+
+func transformTestImage(t *testing.T, path string, opts ImageTransformOpts) (*Result, image.Config, Format) {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	res, err := TransformImage(file, opts)
+	require.NoError(t, err)
+
+	data, err := io.ReadAll(res.File)
+	require.NoError(t, err)
+	_, err = res.File.Seek(0, io.SeekStart)
+	require.NoError(t, err)
+
+	cfg, format, err := image.DecodeConfig(res.File)
+	require.NoError(t, err)
+
+	_, err = exif2.SearchAndExtractExif(data)
+	assert.ErrorIs(t, err, exif2.ErrNoExif)
+	return res, cfg, Format(format)
+}
+
+func TestTransformImage(t *testing.T) {
+	// Landscape_8.jpg is stored as 1200x1800 with EXIF orientation, so it is shown as 1800x1200
+	landscape := testdata.Images[0].Path
+
+	t.Run("normalize orientation and keep format", func(t *testing.T) {
+		res, cfg, format := transformTestImage(t, landscape, ImageTransformOpts{})
+
+		assert.Equal(t, JPEG, format)
+		assert.Equal(t, 1800, cfg.Width)
+		assert.Equal(t, 1200, cfg.Height)
+		assert.Equal(t, "jpeg", res.Meta["format"])
+	})
+
+	t.Run("crop", func(t *testing.T) {
+		_, cfg, _ := transformTestImage(t, landscape, ImageTransformOpts{
+			Crop: image.Rect(100, 100, 600, 400),
+		})
+
+		assert.Equal(t, 500, cfg.Width)
+		assert.Equal(t, 300, cfg.Height)
+	})
+
+	t.Run("crop and rotate", func(t *testing.T) {
+		res, cfg, _ := transformTestImage(t, landscape, ImageTransformOpts{
+			Crop:   image.Rect(0, 0, 500, 300),
+			Rotate: -90,
+		})
+
+		assert.Equal(t, 300, cfg.Width)
+		assert.Equal(t, 500, cfg.Height)
+		assert.Equal(t, 300, res.Meta["width"])
+		assert.Equal(t, 500, res.Meta["height"])
+	})
+
+	t.Run("convert to png", func(t *testing.T) {
+		_, cfg, format := transformTestImage(t, landscape, ImageTransformOpts{
+			Format:         PNG,
+			FlipHorizontal: true,
+		})
+
+		assert.Equal(t, PNG, format)
+		assert.Equal(t, 1800, cfg.Width)
+	})
+
+	t.Run("unsupported target format falls back to png", func(t *testing.T) {
+		res, _, format := transformTestImage(t, landscape, ImageTransformOpts{Format: TIFF})
+
+		assert.Equal(t, PNG, format)
+		assert.Equal(t, "png", res.Meta["format"])
+	})
+
+	t.Run("invalid crop", func(t *testing.T) {
+		file, err := os.Open(landscape)
+		require.NoError(t, err)
+		defer file.Close()
+
+		_, err = TransformImage(file, ImageTransformOpts{Crop: image.Rect(1000, 1000, 2000, 2000)})
+		assert.ErrorIs(t, err, ErrInvalidCrop)
+	})
+
+	t.Run("invalid rotation", func(t *testing.T) {
+		file, err := os.Open(landscape)
+		require.NoError(t, err)
+		defer file.Close()
+
+		_, err = TransformImage(file, ImageTransformOpts{Rotate: 45})
+		assert.ErrorIs(t, err, ErrInvalidRotation)
+	})
+}
+
+func TestTransformImage_AnimatedGIF(t *testing.T) {
+	// two frames of 4x2, the second one covers only the right half
+	red := color.RGBA{R: 0xff, A: 0xff}
+	blue := color.RGBA{B: 0xff, A: 0xff}
+	first := image.NewPaletted(image.Rect(0, 0, 4, 2), color.Palette{red, blue})
+	second := image.NewPaletted(image.Rect(2, 0, 4, 2), color.Palette{red, blue})
+	for x := 2; x < 4; x++ {
+		for y := 0; y < 2; y++ {
+			second.Set(x, y, blue)
+		}
+	}
+	buf := &bytes.Buffer{}
+	err := gif.EncodeAll(buf, &gif.GIF{
+		Image:     []*image.Paletted{first, second},
+		Delay:     []int{10, 20},
+		LoopCount: 3,
+	})
+	require.NoError(t, err)
+
+	res, err := TransformImage(bytes.NewReader(buf.Bytes()), ImageTransformOpts{Rotate: 90})
+	require.NoError(t, err)
+	assert.Equal(t, "gif", res.Meta["format"])
+	assert.Equal(t, 2, res.Meta["width"])
+	assert.Equal(t, 4, res.Meta["height"])
+
+	result, err := gif.DecodeAll(res.File)
+	require.NoError(t, err)
+	require.Len(t, result.Image, 2)
+	assert.Equal(t, []int{10, 20}, result.Delay)
+	assert.Equal(t, 3, result.LoopCount)
+	for _, frame := range result.Image {
+		assert.Equal(t, image.Rect(0, 0, 2, 4), frame.Bounds())
+	}
+	// after rotating clockwise the right half of the image is at the bottom
+	assertColor := func(want color.Color, got color.Color) {
+		wr, wg, wb, _ := want.RGBA()
+		gr, gg, gb, _ := got.RGBA()
+		assert.Equal(t, []uint32{wr, wg, wb}, []uint32{gr, gg, gb})
+	}
+	assertColor(red, result.Image[0].At(0, 3))
+	assertColor(red, result.Image[1].At(0, 0))
+	assertColor(blue, result.Image[1].At(0, 3))
+}
+
+func TestTransformImage_GIFToPNG(t *testing.T) {
+	buf := &bytes.Buffer{}
+	frame := image.NewPaletted(image.Rect(0, 0, 4, 2), color.Palette{color.White})
+	require.NoError(t, gif.EncodeAll(buf, &gif.GIF{Image: []*image.Paletted{frame, frame}, Delay: []int{0, 0}}))
+
+	res, err := TransformImage(bytes.NewReader(buf.Bytes()), ImageTransformOpts{Format: PNG, Crop: image.Rect(0, 0, 2, 2)})
+	require.NoError(t, err)
+
+	cfg, format, err := image.DecodeConfig(res.File)
+	require.NoError(t, err)
+	assert.Equal(t, "png", format)
+	assert.Equal(t, 2, cfg.Width)
+}
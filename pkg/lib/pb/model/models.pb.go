@@ -8712,6 +8712,8 @@ type InvitePayload struct {
 	SpaceIconEncryptionKeys   []*FileEncryptionKey `protobuf:"bytes,7,rep,name=spaceIconEncryptionKeys,proto3" json:"spaceIconEncryptionKeys,omitempty"`
 	InviteType                InviteType           `protobuf:"varint,8,opt,name=inviteType,proto3,enum=anytype.model.InviteType" json:"inviteType,omitempty"`
 	GuestKey                  []byte               `protobuf:"bytes,9,opt,name=guestKey,proto3" json:"guestKey,omitempty"`
+	ExpiresAt                 int64                `protobuf:"varint,14,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	MaxUses                   int32                `protobuf:"varint,15,opt,name=maxUses,proto3" json:"maxUses,omitempty"`
 }
 
 func (m *InvitePayload) Reset()         { *m = InvitePayload{} }
@@ -8838,6 +8840,20 @@ func (m *InvitePayload) GetGuestKey() []byte {
 	return nil
 }
 
+func (m *InvitePayload) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *InvitePayload) GetMaxUses() int32 {
+	if m != nil {
+		return m.MaxUses
+	}
+	return 0
+}
+
 type IdentityProfile struct {
 	Identity           string               `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
 	Name               string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -11077,656 +11093,654 @@ func init() {
 }
 
 var fileDescriptor_98a910b73321e591 = []byte{
-	// 10377 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0xbd, 0x5d, 0x8c, 0x23, 0x47,
-	0x92, 0x18, 0xdc, 0xfc, 0x27, 0x83, 0xcd, 0xee, 0xec, 0x9c, 0x3f, 0x8a, 0x3b, 0x37, 0xdf, 0x1c,
-	0x57, 0x2b, 0x8d, 0x66, 0xb5, 0x2d, 0x69, 0x24, 0xad, 0xb4, 0xba, 0x95, 0x56, 0xec, 0x6e, 0xf6,
-	0x34, 0x35, 0xfd, 0xa7, 0x22, 0x67, 0x66, 0x25, 0xdc, 0xdd, 0x5c, 0x35, 0x2b, 0x9b, 0xac, 0xed,
-	0x62, 0x15, 0xaf, 0x2a, 0xd9, 0xd3, 0x2d, 0x7c, 0x3e, 0x9c, 0xff, 0xee, 0x7c, 0x80, 0x1f, 0xd6,
-	0x86, 0xef, 0x6c, 0xe3, 0x60, 0xdc, 0xde, 0x83, 0xe1, 0xc3, 0x79, 0x01, 0x3f, 0x9d, 0xed, 0xf3,
-	0xcf, 0x83, 0xed, 0x07, 0x1b, 0xf0, 0x83, 0xd7, 0x30, 0x60, 0xac, 0xe1, 0x07, 0x1b, 0xbb, 0x80,
-	0x01, 0xc3, 0x3f, 0xeb, 0x9f, 0x97, 0x05, 0x6c, 0xd8, 0x46, 0x44, 0x66, 0xfd, 0x91, 0xec, 0x1e,
-	0x8e, 0x76, 0xcf, 0xf0, 0x53, 0x33, 0xa3, 0x22, 0x22, 0xff, 0x22, 0x23, 0x23, 0x22, 0x23, 0xb3,
-	0xe1, 0xc5, 0xf1, 0xc9, 0xe0, 0x35, 0xc7, 0x3e, 0x7a, 0x6d, 0x7c, 0xf4, 0xda, 0xc8, 0xb3, 0x84,
-	0xf3, 0xda, 0xd8, 0xf7, 0xa4, 0x17, 0xa8, 0x42, 0xb0, 0x4e, 0x25, 0x5e, 0x33, 0xdd, 0x73, 0x79,
-	0x3e, 0x16, 0xeb, 0x04, 0x6d, 0xdc, 0x1c, 0x78, 0xde, 0xc0, 0x11, 0x0a, 0xf5, 0x68, 0x72, 0xfc,
-	0x5a, 0x20, 0xfd, 0x49, 0x5f, 0x2a, 0xe4, 0xe6, 0xf7, 0xf2, 0x70, 0xbd, 0x3b, 0x32, 0x7d, 0xb9,
-	0xe1, 0x78, 0xfd, 0x93, 0xae, 0x6b, 0x8e, 0x83, 0xa1, 0x27, 0x37, 0xcc, 0x40, 0xf0, 0x57, 0xa1,
-	0x78, 0x84, 0xc0, 0xa0, 0x9e, 0xb9, 0x9d, 0xbb, 0x53, 0xbd, 0x77, 0x75, 0x3d, 0xc5, 0x78, 0x9d,
-	0x28, 0x0c, 0x8d, 0xc3, 0xdf, 0x80, 0x92, 0x25, 0xa4, 0x69, 0x3b, 0x41, 0x3d, 0x7b, 0x3b, 0x73,
-	0xa7, 0x7a, 0xef, 0xc6, 0xba, 0xaa, 0x78, 0x3d, 0xac, 0x78, 0xbd, 0x4b, 0x15, 0x1b, 0x21, 0x1e,
-	0x7f, 0x07, 0xca, 0xc7, 0xb6, 0x23, 0x1e, 0x88, 0xf3, 0xa0, 0x9e, 0xbb, 0x94, 0x66, 0x23, 0x5b,
-	0xcf, 0x18, 0x11, 0x32, 0xdf, 0x84, 0x15, 0x71, 0x26, 0x7d, 0xd3, 0x10, 0x8e, 0x29, 0x6d, 0xcf,
-	0x0d, 0xea, 0x79, 0x6a, 0xe1, 0x8d, 0xa9, 0x16, 0x86, 0xdf, 0x89, 0x7c, 0x8a, 0x84, 0xdf, 0x86,
-	0xaa, 0x77, 0xf4, 0x2d, 0xd1, 0x97, 0xbd, 0xf3, 0xb1, 0x08, 0xea, 0x85, 0xdb, 0xb9, 0x3b, 0x15,
-	0x23, 0x09, 0xe2, 0x5f, 0x83, 0x6a, 0xdf, 0x73, 0x1c, 0xd1, 0x57, 0x75, 0x14, 0x2f, 0xef, 0x56,
-	0x12, 0x97, 0xbf, 0x05, 0xd7, 0x7c, 0x31, 0xf2, 0x4e, 0x85, 0xb5, 0x19, 0x41, 0xa9, 0x9f, 0x65,
-	0xaa, 0x66, 0xfe, 0x47, 0xde, 0x82, 0x9a, 0xaf, 0xdb, 0xb7, 0x6b, 0xbb, 0x27, 0x41, 0xbd, 0x44,
-	0xdd, 0xfa, 0xc2, 0x05, 0xdd, 0x42, 0x1c, 0x23, 0x4d, 0xc1, 0x19, 0xe4, 0x4e, 0xc4, 0x79, 0xbd,
-	0x72, 0x3b, 0x73, 0xa7, 0x62, 0xe0, 0x4f, 0xfe, 0x1e, 0xd4, 0x3d, 0xdf, 0x1e, 0xd8, 0xae, 0xe9,
-	0x6c, 0xfa, 0xc2, 0x94, 0xc2, 0xea, 0xd9, 0x23, 0x11, 0x48, 0x73, 0x34, 0xae, 0xc3, 0xed, 0xcc,
-	0x9d, 0x9c, 0x71, 0xe1, 0x77, 0xfe, 0xa6, 0x9a, 0xa1, 0x8e, 0x7b, 0xec, 0xd5, 0xab, 0xba, 0xfb,
-	0xe9, 0xb6, 0x6c, 0xeb, 0xcf, 0x46, 0x84, 0xd8, 0xfc, 0x71, 0x16, 0x8a, 0x5d, 0x61, 0xfa, 0xfd,
-	0x61, 0xe3, 0xd7, 0x33, 0x50, 0x34, 0x44, 0x30, 0x71, 0x24, 0x6f, 0x40, 0x59, 0x8d, 0x6d, 0xc7,
-	0xaa, 0x67, 0xa8, 0x75, 0x51, 0xf9, 0xf3, 0xc8, 0xce, 0x3a, 0xe4, 0x47, 0x42, 0x9a, 0xf5, 0x1c,
-	0x8d, 0x50, 0x63, 0xaa, 0x55, 0xaa, 0xfa, 0xf5, 0x3d, 0x21, 0x4d, 0x83, 0xf0, 0x1a, 0x3f, 0xcc,
-	0x40, 0x1e, 0x8b, 0xfc, 0x26, 0x54, 0x86, 0xf6, 0x60, 0xe8, 0xd8, 0x83, 0xa1, 0xd4, 0x0d, 0x89,
-	0x01, 0xfc, 0x03, 0x58, 0x8d, 0x0a, 0x86, 0xe9, 0x0e, 0x04, 0xb6, 0x68, 0x9e, 0xf0, 0xd3, 0x47,
-	0x63, 0x1a, 0x99, 0xd7, 0xa1, 0x44, 0xeb, 0xa1, 0x63, 0x91, 0x44, 0x57, 0x8c, 0xb0, 0x88, 0xe2,
-	0x16, 0xce, 0xd4, 0x03, 0x71, 0x5e, 0xcf, 0xd3, 0xd7, 0x24, 0x88, 0xb7, 0x60, 0x35, 0x2c, 0x6e,
-	0xe9, 0xd1, 0x28, 0x5c, 0x3e, 0x1a, 0xd3, 0xf8, 0xcd, 0x6f, 0xef, 0x43, 0x81, 0x96, 0x25, 0x5f,
-	0x81, 0xac, 0x1d, 0x0e, 0x74, 0xd6, 0xb6, 0xf8, 0x6b, 0x50, 0x3c, 0xb6, 0x85, 0x63, 0x3d, 0x73,
-	0x84, 0x35, 0x1a, 0x6f, 0xc3, 0xb2, 0x2f, 0x02, 0xe9, 0xdb, 0x5a, 0xfa, 0xd5, 0x02, 0xfd, 0xd9,
-	0x79, 0x3a, 0x60, 0xdd, 0x48, 0x20, 0x1a, 0x29, 0x32, 0xec, 0x76, 0x7f, 0x68, 0x3b, 0x96, 0x2f,
-	0xdc, 0x8e, 0xa5, 0xd6, 0x69, 0xc5, 0x48, 0x82, 0xf8, 0x1d, 0x58, 0x3d, 0x32, 0xfb, 0x27, 0x03,
-	0xdf, 0x9b, 0xb8, 0xb8, 0x20, 0x3c, 0x9f, 0xba, 0x5d, 0x31, 0xa6, 0xc1, 0xfc, 0x75, 0x28, 0x98,
-	0x8e, 0x3d, 0x70, 0x69, 0x25, 0xae, 0xcc, 0x4c, 0xba, 0x6a, 0x4b, 0x0b, 0x31, 0x0c, 0x85, 0xc8,
-	0x77, 0xa0, 0x76, 0x2a, 0x7c, 0x69, 0xf7, 0x4d, 0x87, 0xe0, 0xf5, 0x12, 0x51, 0x36, 0xe7, 0x52,
-	0x3e, 0x4a, 0x62, 0x1a, 0x69, 0x42, 0xde, 0x01, 0x08, 0x50, 0x4d, 0xd2, 0x74, 0xea, 0xb5, 0xf0,
-	0xf2, 0x5c, 0x36, 0x9b, 0x9e, 0x2b, 0x85, 0x2b, 0xd7, 0xbb, 0x11, 0xfa, 0xce, 0x92, 0x91, 0x20,
-	0xe6, 0xef, 0x40, 0x5e, 0x8a, 0x33, 0x59, 0x5f, 0xb9, 0x64, 0x44, 0x43, 0x26, 0x3d, 0x71, 0x26,
-	0x77, 0x96, 0x0c, 0x22, 0x40, 0x42, 0x5c, 0x64, 0xf5, 0xd5, 0x05, 0x08, 0x71, 0x5d, 0x22, 0x21,
-	0x12, 0xf0, 0xf7, 0xa1, 0xe8, 0x98, 0xe7, 0xde, 0x44, 0xd6, 0x19, 0x91, 0x7e, 0xf1, 0x52, 0xd2,
-	0x5d, 0x42, 0xdd, 0x59, 0x32, 0x34, 0x11, 0x7f, 0x0b, 0x72, 0x96, 0x7d, 0x5a, 0x5f, 0x23, 0xda,
-	0xdb, 0x97, 0xd2, 0x6e, 0xd9, 0xa7, 0x3b, 0x4b, 0x06, 0xa2, 0xf3, 0x4d, 0x28, 0x1f, 0x79, 0xde,
-	0xc9, 0xc8, 0xf4, 0x4f, 0xea, 0x9c, 0x48, 0xbf, 0x74, 0x29, 0xe9, 0x86, 0x46, 0xde, 0x59, 0x32,
-	0x22, 0x42, 0xec, 0xb2, 0xdd, 0xf7, 0xdc, 0xfa, 0x95, 0x05, 0xba, 0xdc, 0xe9, 0x7b, 0x2e, 0x76,
-	0x19, 0x09, 0x90, 0xd0, 0xb1, 0xdd, 0x93, 0xfa, 0xd5, 0x05, 0x08, 0x51, 0x73, 0x22, 0x21, 0x12,
-	0x60, 0xb3, 0x2d, 0x53, 0x9a, 0xa7, 0xb6, 0x78, 0x5a, 0xbf, 0xb6, 0x40, 0xb3, 0xb7, 0x34, 0x32,
-	0x36, 0x3b, 0x24, 0x44, 0x26, 0xe1, 0xd2, 0xac, 0x5f, 0x5f, 0x80, 0x49, 0xa8, 0xd1, 0x91, 0x49,
-	0x48, 0xc8, 0x7f, 0x11, 0xd6, 0x8e, 0x85, 0x29, 0x27, 0xbe, 0xb0, 0xe2, 0x8d, 0xee, 0x06, 0x71,
-	0x5b, 0xbf, 0x7c, 0xee, 0xa7, 0xa9, 0x76, 0x96, 0x8c, 0x59, 0x56, 0xfc, 0x3d, 0x28, 0x38, 0xa6,
-	0x14, 0x67, 0xf5, 0x3a, 0xf1, 0x6c, 0x3e, 0x43, 0x28, 0xa4, 0x38, 0xdb, 0x59, 0x32, 0x14, 0x09,
-	0xff, 0x26, 0xac, 0x4a, 0xf3, 0xc8, 0x11, 0x07, 0xc7, 0x1a, 0x21, 0xa8, 0xbf, 0x40, 0x5c, 0x5e,
-	0xbd, 0x5c, 0x9c, 0xd3, 0x34, 0x3b, 0x4b, 0xc6, 0x34, 0x1b, 0x6c, 0x15, 0x81, 0xea, 0x8d, 0x05,
-	0x5a, 0x45, 0xfc, 0xb0, 0x55, 0x44, 0xc2, 0x77, 0xa1, 0x4a, 0x3f, 0x36, 0x3d, 0x67, 0x32, 0x72,
-	0xeb, 0x5f, 0x20, 0x0e, 0x77, 0x9e, 0xcd, 0x41, 0xe1, 0xef, 0x2c, 0x19, 0x49, 0x72, 0x9c, 0x44,
-	0x2a, 0x1a, 0xde, 0xd3, 0xfa, 0xcd, 0x05, 0x26, 0xb1, 0xa7, 0x91, 0x71, 0x12, 0x43, 0x42, 0x5c,
-	0x7a, 0x4f, 0x6d, 0x6b, 0x20, 0x64, 0xfd, 0x67, 0x16, 0x58, 0x7a, 0x8f, 0x09, 0x15, 0x97, 0x9e,
-	0x22, 0x42, 0x31, 0xee, 0x0f, 0x4d, 0x59, 0xbf, 0xb5, 0x80, 0x18, 0x6f, 0x0e, 0x4d, 0xd2, 0x15,
-	0x48, 0xd0, 0xf8, 0x0c, 0x96, 0x93, 0x5a, 0x99, 0x73, 0xc8, 0xfb, 0xc2, 0x54, 0x3b, 0x42, 0xd9,
-	0xa0, 0xdf, 0x08, 0x13, 0x96, 0x2d, 0x69, 0x47, 0x28, 0x1b, 0xf4, 0x9b, 0x5f, 0x87, 0xa2, 0xb2,
-	0x4d, 0x48, 0xe1, 0x97, 0x0d, 0x5d, 0x42, 0x5c, 0xcb, 0x37, 0x07, 0xb4, 0x6f, 0x95, 0x0d, 0xfa,
-	0x8d, 0xb8, 0x96, 0xef, 0x8d, 0x0f, 0x5c, 0x52, 0xd8, 0x65, 0x43, 0x97, 0x1a, 0xff, 0xfd, 0x03,
-	0x28, 0xe9, 0x46, 0x35, 0xfe, 0x4a, 0x06, 0x8a, 0x4a, 0xa1, 0xf0, 0x6f, 0x40, 0x21, 0x90, 0xe7,
-	0x8e, 0xa0, 0x36, 0xac, 0xdc, 0x7b, 0x65, 0x01, 0x25, 0xb4, 0xde, 0x45, 0x02, 0x43, 0xd1, 0x35,
-	0x0d, 0x28, 0x50, 0x99, 0x97, 0x20, 0x67, 0x78, 0x4f, 0xd9, 0x12, 0x07, 0x28, 0xaa, 0xc9, 0x62,
-	0x19, 0x04, 0x6e, 0xd9, 0xa7, 0x2c, 0x8b, 0xc0, 0x1d, 0x61, 0x5a, 0xc2, 0x67, 0x39, 0x5e, 0x83,
-	0x4a, 0x38, 0x2d, 0x01, 0xcb, 0x73, 0x06, 0xcb, 0x89, 0x09, 0x0f, 0x58, 0xa1, 0xf1, 0xdf, 0xf2,
-	0x90, 0xc7, 0xf5, 0xcf, 0x5f, 0x84, 0x9a, 0x34, 0xfd, 0x81, 0x50, 0x86, 0x70, 0x64, 0xa4, 0xa4,
-	0x81, 0xfc, 0xfd, 0xb0, 0x0f, 0x59, 0xea, 0xc3, 0xcb, 0xcf, 0xd4, 0x2b, 0xa9, 0x1e, 0x24, 0x76,
-	0xe1, 0xdc, 0x62, 0xbb, 0xf0, 0x36, 0x94, 0x51, 0x9d, 0x75, 0xed, 0xcf, 0x04, 0x0d, 0xfd, 0xca,
-	0xbd, 0xbb, 0xcf, 0xae, 0xb2, 0xa3, 0x29, 0x8c, 0x88, 0x96, 0x77, 0xa0, 0xd2, 0x37, 0x7d, 0x8b,
-	0x1a, 0x43, 0xb3, 0xb5, 0x72, 0xef, 0xcb, 0xcf, 0x66, 0xb4, 0x19, 0x92, 0x18, 0x31, 0x35, 0x3f,
-	0x80, 0xaa, 0x25, 0x82, 0xbe, 0x6f, 0x8f, 0x49, 0xbd, 0xa9, 0xbd, 0xf8, 0x2b, 0xcf, 0x66, 0xb6,
-	0x15, 0x13, 0x19, 0x49, 0x0e, 0x68, 0x91, 0xf9, 0x91, 0x7e, 0x2b, 0x91, 0x81, 0x10, 0x03, 0x9a,
-	0xef, 0x40, 0x39, 0xec, 0x0f, 0x5f, 0x86, 0x32, 0xfe, 0xdd, 0xf7, 0x5c, 0xc1, 0x96, 0x70, 0x6e,
-	0xb1, 0xd4, 0x1d, 0x99, 0x8e, 0xc3, 0x32, 0x7c, 0x05, 0x00, 0x8b, 0x7b, 0xc2, 0xb2, 0x27, 0x23,
-	0x96, 0x6d, 0xfe, 0x5c, 0x28, 0x2d, 0x65, 0xc8, 0x1f, 0x9a, 0x03, 0xa4, 0x58, 0x86, 0x72, 0xa8,
-	0xae, 0x59, 0x06, 0xe9, 0xb7, 0xcc, 0x60, 0x78, 0xe4, 0x99, 0xbe, 0xc5, 0xb2, 0xbc, 0x0a, 0xa5,
-	0x96, 0xdf, 0x1f, 0xda, 0xa7, 0x82, 0xe5, 0x9a, 0xaf, 0x41, 0x35, 0xd1, 0x5e, 0x64, 0xa1, 0x2b,
-	0xad, 0x40, 0xa1, 0x65, 0x59, 0xc2, 0x62, 0x19, 0x24, 0xd0, 0x1d, 0x64, 0xd9, 0xe6, 0x97, 0xa1,
-	0x12, 0x8d, 0x16, 0xa2, 0xe3, 0xc6, 0xcd, 0x96, 0xf0, 0x17, 0x82, 0x59, 0x06, 0xa5, 0xb2, 0xe3,
-	0x3a, 0xb6, 0x2b, 0x58, 0xb6, 0xf1, 0x4b, 0x24, 0xaa, 0xfc, 0xeb, 0xe9, 0x05, 0xf1, 0xd2, 0xb3,
-	0x76, 0xd6, 0xf4, 0x6a, 0xf8, 0x42, 0xa2, 0x7f, 0xbb, 0x36, 0x35, 0xae, 0x0c, 0xf9, 0x2d, 0x4f,
-	0x06, 0x2c, 0xd3, 0xf8, 0x0f, 0x59, 0x28, 0x87, 0x1b, 0x2a, 0xfa, 0x04, 0x13, 0xdf, 0xd1, 0x02,
-	0x8d, 0x3f, 0xf9, 0x55, 0x28, 0x48, 0x5b, 0x6a, 0x31, 0xae, 0x18, 0xaa, 0x80, 0xb6, 0x5a, 0x72,
-	0x66, 0x95, 0x01, 0x3b, 0x3d, 0x55, 0xf6, 0xc8, 0x1c, 0x88, 0x1d, 0x33, 0x18, 0x6a, 0x13, 0x36,
-	0x06, 0x20, 0xfd, 0xb1, 0x79, 0x8a, 0x32, 0x47, 0xdf, 0x95, 0x15, 0x97, 0x04, 0xf1, 0x37, 0x21,
-	0x8f, 0x1d, 0xd4, 0x42, 0xf3, 0xff, 0x4d, 0x75, 0x18, 0xc5, 0xe4, 0xd0, 0x17, 0x38, 0x3d, 0xeb,
-	0xe8, 0x81, 0x19, 0x84, 0xcc, 0x5f, 0x82, 0x15, 0xb5, 0x08, 0x0f, 0x42, 0xff, 0xa1, 0x44, 0x9c,
-	0xa7, 0xa0, 0xbc, 0x85, 0xc3, 0x69, 0x4a, 0x51, 0x2f, 0x2f, 0x20, 0xdf, 0xe1, 0xe0, 0xac, 0x77,
-	0x91, 0xc4, 0x50, 0x94, 0xcd, 0xb7, 0x71, 0x4c, 0x4d, 0x29, 0x70, 0x9a, 0xdb, 0xa3, 0xb1, 0x3c,
-	0x57, 0x42, 0xb3, 0x2d, 0x64, 0x7f, 0x68, 0xbb, 0x03, 0x96, 0x51, 0x43, 0x8c, 0x93, 0x48, 0x28,
-	0xbe, 0xef, 0xf9, 0x2c, 0xd7, 0x68, 0x40, 0x1e, 0x65, 0x14, 0x95, 0xa4, 0x6b, 0x8e, 0x84, 0x1e,
-	0x69, 0xfa, 0xdd, 0xb8, 0x02, 0x6b, 0x33, 0xfb, 0x71, 0xe3, 0x0f, 0x8b, 0x4a, 0x42, 0x90, 0x82,
-	0x6c, 0x41, 0x4d, 0x41, 0x66, 0xde, 0x73, 0xe9, 0x18, 0xe4, 0x92, 0xd6, 0x31, 0xef, 0x43, 0x01,
-	0x3b, 0x16, 0xaa, 0x98, 0x05, 0xc8, 0xf7, 0x10, 0xdd, 0x50, 0x54, 0xe8, 0xc1, 0xf4, 0x87, 0xa2,
-	0x7f, 0x22, 0x2c, 0xad, 0xeb, 0xc3, 0x22, 0x0a, 0x4d, 0x3f, 0x61, 0x9e, 0xab, 0x02, 0x89, 0x44,
-	0xdf, 0x73, 0xdb, 0x23, 0xef, 0x5b, 0x36, 0xcd, 0x2b, 0x8a, 0x44, 0x08, 0x08, 0xbf, 0x76, 0x50,
-	0x46, 0xf4, 0xb4, 0xc5, 0x80, 0x46, 0x1b, 0x0a, 0x54, 0x37, 0xae, 0x04, 0xd5, 0x66, 0x15, 0x69,
-	0x78, 0x69, 0xb1, 0x36, 0xeb, 0x26, 0x37, 0xbe, 0x9b, 0x85, 0x3c, 0x96, 0xf9, 0x5d, 0x28, 0xf8,
-	0xe8, 0x87, 0xd1, 0x70, 0x5e, 0xe4, 0xb3, 0x29, 0x14, 0xfe, 0x0d, 0x2d, 0x8a, 0xd9, 0x05, 0x84,
-	0x25, 0xaa, 0x31, 0x29, 0x96, 0x57, 0xa1, 0x30, 0x36, 0x7d, 0x73, 0xa4, 0xd7, 0x89, 0x2a, 0x34,
-	0xbf, 0x93, 0x81, 0x3c, 0x22, 0xf1, 0x35, 0xa8, 0x75, 0xa5, 0x6f, 0x9f, 0x08, 0x39, 0xf4, 0xbd,
-	0xc9, 0x60, 0xa8, 0x24, 0xe9, 0x81, 0x38, 0x57, 0xfa, 0x46, 0x29, 0x04, 0x69, 0x3a, 0x76, 0x9f,
-	0x65, 0x51, 0xaa, 0x36, 0x3c, 0xc7, 0x62, 0x39, 0xbe, 0x0a, 0xd5, 0x87, 0xae, 0x25, 0xfc, 0xa0,
-	0xef, 0xf9, 0xc2, 0x62, 0x79, 0xbd, 0xba, 0x4f, 0x58, 0x81, 0xf6, 0x32, 0x71, 0x26, 0xc9, 0x17,
-	0x62, 0x45, 0x7e, 0x05, 0x56, 0x37, 0xd2, 0x0e, 0x12, 0x2b, 0xa1, 0x4e, 0xda, 0x13, 0x2e, 0x0a,
-	0x19, 0x2b, 0x2b, 0x21, 0xf6, 0xbe, 0x65, 0xb3, 0x0a, 0x56, 0xa6, 0xd6, 0x09, 0x83, 0xe6, 0xdf,
-	0xcb, 0x84, 0x9a, 0xa3, 0x06, 0x95, 0x43, 0xd3, 0x37, 0x07, 0xbe, 0x39, 0xc6, 0xf6, 0x55, 0xa1,
-	0xa4, 0x36, 0xce, 0x37, 0x94, 0x76, 0x53, 0x85, 0x7b, 0x4a, 0x37, 0xaa, 0xc2, 0x9b, 0x2c, 0x17,
-	0x17, 0xde, 0x62, 0x79, 0xac, 0xe3, 0xe3, 0x89, 0x27, 0x05, 0x2b, 0x90, 0xae, 0xf3, 0x2c, 0xc1,
-	0x8a, 0x08, 0xec, 0xa1, 0x46, 0x61, 0x25, 0xec, 0xf3, 0x26, 0xca, 0xcf, 0x91, 0x77, 0xc6, 0xca,
-	0xd8, 0x0c, 0x1c, 0x46, 0x61, 0xb1, 0x0a, 0x7e, 0xd9, 0x9f, 0x8c, 0x8e, 0x04, 0x76, 0x13, 0xf0,
-	0x4b, 0xcf, 0x1b, 0x0c, 0x1c, 0xc1, 0xaa, 0x38, 0x06, 0x09, 0xe5, 0xcb, 0x96, 0x49, 0xd3, 0x9a,
-	0x8e, 0xe3, 0x4d, 0x24, 0xab, 0x35, 0x7e, 0x9c, 0x83, 0x3c, 0x7a, 0x37, 0xb8, 0x76, 0x86, 0xa8,
-	0x67, 0xf4, 0xda, 0xc1, 0xdf, 0xd1, 0x0a, 0xcc, 0xc6, 0x2b, 0x90, 0xbf, 0xa7, 0x67, 0x3a, 0xb7,
-	0x80, 0x96, 0x45, 0xc6, 0xc9, 0x49, 0xe6, 0x90, 0x1f, 0xd9, 0x23, 0xa1, 0x75, 0x1d, 0xfd, 0x46,
-	0x58, 0x80, 0xfb, 0x71, 0x81, 0x82, 0x27, 0xf4, 0x1b, 0x57, 0x8d, 0x89, 0xdb, 0x42, 0x4b, 0xd2,
-	0x1a, 0xc8, 0x19, 0x61, 0x71, 0x8e, 0xf6, 0xaa, 0xcc, 0xd5, 0x5e, 0xef, 0x87, 0xda, 0xab, 0xb4,
-	0xc0, 0xaa, 0xa7, 0x66, 0x26, 0x35, 0x57, 0xac, 0x34, 0xca, 0x8b, 0x93, 0x27, 0x36, 0x93, 0x2d,
-	0x2d, 0xb5, 0xf1, 0x46, 0x57, 0x56, 0xa3, 0xcc, 0x32, 0x38, 0x9b, 0xb4, 0x5c, 0x95, 0xce, 0x7b,
-	0x64, 0x5b, 0xc2, 0x63, 0x39, 0xda, 0x08, 0x27, 0x96, 0xed, 0xb1, 0x3c, 0x5a, 0x5e, 0x87, 0x5b,
-	0xdb, 0xac, 0xd0, 0x7c, 0x29, 0xb1, 0x25, 0xb5, 0x26, 0xd2, 0x53, 0x6c, 0x48, 0x7c, 0x33, 0x4a,
-	0x1a, 0x8f, 0x84, 0xc5, 0xb2, 0xcd, 0xaf, 0xce, 0x51, 0xb3, 0x35, 0xa8, 0x3c, 0x1c, 0x3b, 0x9e,
-	0x69, 0x5d, 0xa2, 0x67, 0x97, 0x01, 0x62, 0xaf, 0xba, 0xf1, 0xa3, 0x2f, 0xc6, 0xdb, 0x39, 0xda,
-	0xa2, 0x81, 0x37, 0xf1, 0xfb, 0x82, 0x54, 0x48, 0xc5, 0xd0, 0x25, 0xfe, 0x21, 0x14, 0xf0, 0x7b,
-	0x18, 0xc6, 0xb9, 0xbb, 0x90, 0x2f, 0xb7, 0xfe, 0xc8, 0x16, 0x4f, 0x0d, 0x45, 0xc8, 0x6f, 0x01,
-	0x98, 0x7d, 0x69, 0x9f, 0x0a, 0x04, 0xea, 0xc5, 0x9e, 0x80, 0xf0, 0xb7, 0x93, 0xe6, 0xcb, 0xe5,
-	0x71, 0xc8, 0x84, 0x5d, 0xc3, 0x0d, 0xa8, 0xe2, 0xd2, 0x1d, 0x1f, 0xf8, 0xb8, 0xda, 0xeb, 0xcb,
-	0x44, 0xf8, 0xfa, 0x62, 0xcd, 0xbb, 0x1f, 0x11, 0x1a, 0x49, 0x26, 0xfc, 0x21, 0x2c, 0xab, 0x98,
-	0x9a, 0x66, 0x5a, 0x23, 0xa6, 0x6f, 0x2c, 0xc6, 0xf4, 0x20, 0xa6, 0x34, 0x52, 0x6c, 0x66, 0xc3,
-	0x92, 0x85, 0xe7, 0x0e, 0x4b, 0xbe, 0x04, 0x2b, 0xbd, 0xf4, 0x2a, 0x50, 0x5b, 0xc5, 0x14, 0x94,
-	0x37, 0x61, 0xd9, 0x0e, 0xe2, 0xa8, 0x28, 0xc5, 0x48, 0xca, 0x46, 0x0a, 0xd6, 0xf8, 0xed, 0x12,
-	0xe4, 0x69, 0xe4, 0xa7, 0x63, 0x5c, 0x9b, 0x29, 0x95, 0xfe, 0xda, 0xe2, 0x53, 0x3d, 0xb5, 0xe2,
-	0x49, 0x83, 0xe4, 0x12, 0x1a, 0xe4, 0x43, 0x28, 0x04, 0x9e, 0x2f, 0xc3, 0xe9, 0x5d, 0x50, 0x88,
-	0xba, 0x9e, 0x2f, 0x0d, 0x45, 0xc8, 0xb7, 0xa1, 0x74, 0x6c, 0x3b, 0x12, 0x27, 0x45, 0x0d, 0xde,
-	0xab, 0x8b, 0xf1, 0xd8, 0x26, 0x22, 0x23, 0x24, 0xe6, 0xbb, 0x49, 0x61, 0x2b, 0x12, 0xa7, 0xf5,
-	0xc5, 0x38, 0xcd, 0x93, 0xc1, 0xbb, 0xc0, 0xfa, 0xde, 0xa9, 0xf0, 0x8d, 0x44, 0x60, 0x52, 0x6d,
-	0xd2, 0x33, 0x70, 0xde, 0x80, 0xf2, 0xd0, 0xb6, 0x04, 0xda, 0x39, 0xa4, 0x63, 0xca, 0x46, 0x54,
-	0xe6, 0x0f, 0xa0, 0x4c, 0xfe, 0x01, 0x6a, 0xc5, 0xca, 0x73, 0x0f, 0xbe, 0x72, 0x55, 0x42, 0x06,
-	0x58, 0x11, 0x55, 0xbe, 0x6d, 0x4b, 0x8a, 0x4f, 0x97, 0x8d, 0xa8, 0x8c, 0x0d, 0x26, 0x79, 0x4f,
-	0x36, 0xb8, 0xaa, 0x1a, 0x3c, 0x0d, 0xe7, 0x6f, 0xc1, 0x35, 0x82, 0x4d, 0x6d, 0x92, 0xb8, 0xd4,
-	0x90, 0xe9, 0xfc, 0x8f, 0x68, 0xb0, 0x8c, 0xcd, 0x81, 0xd8, 0xb5, 0x47, 0xb6, 0xac, 0xd7, 0x6e,
-	0x67, 0xee, 0x14, 0x8c, 0x18, 0xc0, 0x5f, 0x85, 0x35, 0x4b, 0x1c, 0x9b, 0x13, 0x47, 0xf6, 0xc4,
-	0x68, 0xec, 0x98, 0x52, 0x74, 0x2c, 0x92, 0xd1, 0x8a, 0x31, 0xfb, 0x81, 0xbf, 0x0e, 0x57, 0x34,
-	0xf0, 0x20, 0x3a, 0x55, 0xe8, 0x58, 0x14, 0xbe, 0xab, 0x18, 0xf3, 0x3e, 0xe1, 0x32, 0x11, 0xae,
-	0x95, 0xec, 0x1d, 0x53, 0xcb, 0x24, 0x0d, 0x45, 0x4b, 0xfb, 0xa9, 0x6f, 0x8e, 0xf5, 0x78, 0x52,
-	0x64, 0xae, 0x6c, 0x24, 0x41, 0xcd, 0x3d, 0xad, 0xd0, 0x71, 0x2b, 0x46, 0x8f, 0x37, 0x54, 0xc5,
-	0x81, 0x54, 0x7b, 0xfb, 0x7d, 0xd3, 0x71, 0x84, 0x7f, 0xae, 0xdc, 0xe5, 0x07, 0xa6, 0x7b, 0x64,
-	0xba, 0x2c, 0x47, 0xbb, 0xb5, 0xe9, 0x08, 0xd7, 0x32, 0x7d, 0xb5, 0xb7, 0xdf, 0x27, 0xd3, 0xa0,
-	0xd0, 0xbc, 0x03, 0x79, 0x9a, 0x9c, 0x0a, 0x14, 0x94, 0xbf, 0x45, 0xbe, 0xb7, 0xf6, 0xb5, 0x48,
-	0xb7, 0xef, 0xe2, 0x42, 0x66, 0xd9, 0xc6, 0x5f, 0x2d, 0x42, 0x39, 0x6c, 0x6a, 0x78, 0x1a, 0x91,
-	0x89, 0x4f, 0x23, 0xd0, 0x20, 0x0c, 0x1e, 0xd9, 0x81, 0x7d, 0xa4, 0x0d, 0xdc, 0xb2, 0x11, 0x03,
-	0xd0, 0xa6, 0x7a, 0x6a, 0x5b, 0x72, 0x48, 0xab, 0xaf, 0x60, 0xa8, 0x02, 0xbf, 0x03, 0xab, 0x16,
-	0x8e, 0xa8, 0xdb, 0x77, 0x26, 0x96, 0xe8, 0xe1, 0x7e, 0xac, 0x02, 0x0e, 0xd3, 0x60, 0xfe, 0x09,
-	0x80, 0xb4, 0x47, 0x62, 0xdb, 0xf3, 0x47, 0xa6, 0xd4, 0x5e, 0xc6, 0xd7, 0x9e, 0x6f, 0x7d, 0xac,
-	0xf7, 0x22, 0x06, 0x46, 0x82, 0x19, 0xb2, 0xc6, 0xda, 0x34, 0xeb, 0xd2, 0xe7, 0x62, 0xbd, 0x15,
-	0x31, 0x30, 0x12, 0xcc, 0x78, 0x0f, 0x4a, 0xc7, 0x9e, 0x3f, 0x9a, 0x38, 0xa6, 0xde, 0xbd, 0xdf,
-	0x7b, 0x4e, 0xbe, 0xdb, 0x8a, 0x9a, 0xb4, 0x58, 0xc8, 0x2a, 0x8e, 0x96, 0x57, 0x16, 0x8c, 0x96,
-	0x37, 0x7f, 0x1e, 0x20, 0x6e, 0x21, 0xbf, 0x0e, 0x7c, 0xcf, 0x73, 0xe5, 0xb0, 0x75, 0x74, 0xe4,
-	0x6f, 0x88, 0x63, 0xcf, 0x17, 0x5b, 0x26, 0x6e, 0xd4, 0xd7, 0x60, 0x2d, 0x82, 0xb7, 0x8e, 0xa5,
-	0xf0, 0x11, 0x4c, 0x22, 0xd0, 0x1d, 0x7a, 0xbe, 0x54, 0xd6, 0x22, 0xfd, 0x7c, 0xd8, 0x65, 0x39,
-	0x34, 0x0e, 0x3a, 0xdd, 0x03, 0x96, 0x6f, 0xde, 0x01, 0x88, 0x87, 0x96, 0xbc, 0x2a, 0xfa, 0xf5,
-	0xc6, 0x3d, 0xed, 0x63, 0x51, 0xe9, 0xde, 0x5b, 0x2c, 0xd3, 0xfc, 0x41, 0x06, 0xaa, 0x89, 0x2e,
-	0xa5, 0xbd, 0xef, 0x4d, 0x6f, 0xe2, 0x4a, 0xe5, 0xee, 0xd3, 0xcf, 0x47, 0xa6, 0x33, 0x41, 0x33,
-	0x61, 0x0d, 0x6a, 0x54, 0xde, 0xb2, 0x03, 0x69, 0xbb, 0x7d, 0xc9, 0x72, 0x11, 0x8a, 0x32, 0x31,
-	0xf2, 0x11, 0xca, 0xbe, 0xa7, 0x41, 0x05, 0xce, 0x60, 0xf9, 0x50, 0xf8, 0x7d, 0x11, 0x22, 0x91,
-	0x59, 0xad, 0x21, 0x11, 0x9a, 0x32, 0xab, 0x4d, 0x39, 0xec, 0x4e, 0x46, 0xac, 0x8c, 0xe6, 0x29,
-	0x16, 0x5a, 0xa7, 0xc2, 0x47, 0xab, 0xa8, 0x82, 0xf5, 0x20, 0x00, 0x57, 0x83, 0xe9, 0x32, 0x08,
-	0xb1, 0xf7, 0x6c, 0x97, 0x55, 0xa3, 0x82, 0x79, 0xc6, 0x96, 0xb1, 0xfd, 0xe4, 0x84, 0xb0, 0x5a,
-	0xe3, 0xdf, 0xe5, 0x20, 0x8f, 0x3b, 0x04, 0xae, 0xe5, 0xe4, 0x82, 0x57, 0x6b, 0x25, 0x09, 0xfa,
-	0x7c, 0xfb, 0x1a, 0xf2, 0x4e, 0xee, 0x6b, 0xef, 0x42, 0xb5, 0x3f, 0x09, 0xa4, 0x37, 0xa2, 0x4d,
-	0x5d, 0x9f, 0x9b, 0x5d, 0x9f, 0x89, 0x3f, 0xd1, 0x70, 0x1a, 0x49, 0x54, 0xfe, 0x36, 0x14, 0x8f,
-	0x95, 0xd4, 0xab, 0x08, 0xd4, 0xcf, 0x5c, 0xb0, 0xef, 0x6b, 0xc9, 0xd6, 0xc8, 0xd8, 0x2f, 0x7b,
-	0x66, 0xc5, 0x26, 0x41, 0x7a, 0xff, 0x2e, 0x46, 0xfb, 0xf7, 0xcf, 0xc3, 0x8a, 0xc0, 0x01, 0x3f,
-	0x74, 0xcc, 0xbe, 0x18, 0xa1, 0x62, 0x53, 0xcb, 0xec, 0xad, 0xe7, 0xe8, 0x31, 0xcd, 0x18, 0x75,
-	0x7b, 0x8a, 0x17, 0x6a, 0x1e, 0xd7, 0x43, 0x33, 0x22, 0x0c, 0x11, 0x94, 0x8d, 0x18, 0xd0, 0xfc,
-	0x92, 0xd6, 0x97, 0x25, 0xc8, 0xb5, 0x82, 0xbe, 0x8e, 0xa5, 0x88, 0xa0, 0xaf, 0x1c, 0xb5, 0x4d,
-	0x1a, 0x0e, 0x96, 0x6d, 0xbe, 0x01, 0x95, 0xa8, 0x06, 0x14, 0x9e, 0x7d, 0x4f, 0x76, 0xc7, 0xa2,
-	0x6f, 0x1f, 0xdb, 0xc2, 0x52, 0xf2, 0xd9, 0x95, 0xa6, 0x2f, 0x55, 0x38, 0xb2, 0xed, 0x5a, 0x2c,
-	0xdb, 0xf8, 0x7e, 0x19, 0x8a, 0x6a, 0x1b, 0xd7, 0x1d, 0xae, 0x44, 0x1d, 0xfe, 0x18, 0xca, 0xde,
-	0x58, 0xf8, 0xa6, 0xf4, 0x7c, 0x1d, 0x03, 0x7a, 0xfb, 0x79, 0xcc, 0x82, 0xf5, 0x03, 0x4d, 0x6c,
-	0x44, 0x6c, 0xa6, 0xa5, 0x29, 0x3b, 0x2b, 0x4d, 0x77, 0x81, 0x85, 0x16, 0xc0, 0xa1, 0x8f, 0x74,
-	0xf2, 0x5c, 0x7b, 0xf4, 0x33, 0x70, 0xde, 0x83, 0x4a, 0xdf, 0x73, 0x2d, 0x3b, 0x8a, 0x07, 0xad,
-	0xdc, 0xfb, 0xea, 0x73, 0xb5, 0x70, 0x33, 0xa4, 0x36, 0x62, 0x46, 0xfc, 0x55, 0x28, 0x9c, 0xa2,
-	0x98, 0x91, 0x3c, 0x5d, 0x2c, 0x84, 0x0a, 0x89, 0x7f, 0x0a, 0xd5, 0x5f, 0x9e, 0xd8, 0xfd, 0x93,
-	0x83, 0x64, 0xbc, 0xf1, 0xdd, 0xe7, 0x6a, 0xc5, 0xc7, 0x31, 0xbd, 0x91, 0x64, 0x96, 0x10, 0xed,
-	0xd2, 0x4f, 0x20, 0xda, 0xe5, 0x59, 0xd1, 0x36, 0xa0, 0xe6, 0x8a, 0x40, 0x0a, 0x6b, 0x5b, 0x5b,
-	0x7d, 0xf0, 0x39, 0xac, 0xbe, 0x34, 0x8b, 0xe6, 0x17, 0xa1, 0x1c, 0x4e, 0x38, 0x2f, 0x42, 0x76,
-	0x1f, 0xdd, 0xab, 0x22, 0x64, 0x0f, 0x7c, 0x25, 0x6d, 0x2d, 0x94, 0xb6, 0xe6, 0x8f, 0x32, 0x50,
-	0x89, 0x06, 0x3d, 0xad, 0x39, 0xdb, 0xbf, 0x3c, 0x31, 0x1d, 0x96, 0x21, 0xc7, 0xdb, 0x93, 0xaa,
-	0x44, 0xca, 0xfa, 0x3e, 0x1d, 0xfb, 0xfb, 0x2c, 0x47, 0x26, 0x82, 0x08, 0x02, 0x96, 0xe7, 0x1c,
-	0x56, 0x34, 0xf8, 0xc0, 0x57, 0xa8, 0x05, 0x54, 0x7c, 0xf8, 0x35, 0x04, 0x14, 0x95, 0x45, 0x71,
-	0x22, 0x94, 0x82, 0xdc, 0xf7, 0x24, 0x15, 0xca, 0xd8, 0xa8, 0x8e, 0xcb, 0x2a, 0x58, 0xe7, 0xbe,
-	0x27, 0x3b, 0xa8, 0x12, 0x23, 0x47, 0xaf, 0x1a, 0x56, 0x4f, 0x25, 0xd2, 0x88, 0x2d, 0xc7, 0xe9,
-	0xb8, 0xac, 0xa6, 0x3f, 0xa8, 0xd2, 0x0a, 0x72, 0x6c, 0x9f, 0x99, 0x7d, 0x24, 0x5f, 0x45, 0x0d,
-	0x8b, 0x34, 0xba, 0xcc, 0x70, 0x49, 0xb6, 0xcf, 0xec, 0x40, 0x06, 0x6c, 0xad, 0xf9, 0xe3, 0x0c,
-	0x54, 0x13, 0x13, 0x8c, 0x8e, 0x24, 0x21, 0xe2, 0x56, 0xa6, 0xfc, 0xca, 0x4f, 0x70, 0x18, 0x7d,
-	0x2b, 0xdc, 0xa6, 0x7a, 0x1e, 0xfe, 0xcc, 0x62, 0x7d, 0x3d, 0x6f, 0xe4, 0xf9, 0xbe, 0xf7, 0x54,
-	0x99, 0x3e, 0xbb, 0x66, 0x20, 0x1f, 0x0b, 0x71, 0xc2, 0xf2, 0xd8, 0xd5, 0xcd, 0x89, 0xef, 0x0b,
-	0x57, 0x01, 0x0a, 0xd4, 0x38, 0x71, 0xa6, 0x4a, 0x45, 0x64, 0x8a, 0xc8, 0xb4, 0x0f, 0xb2, 0x12,
-	0x2a, 0x02, 0x8d, 0xad, 0x20, 0x65, 0x44, 0x40, 0x74, 0x55, 0xac, 0xe0, 0xa6, 0xa2, 0x62, 0x1d,
-	0x07, 0xc7, 0x5b, 0xe6, 0x79, 0xd0, 0x1a, 0x78, 0x0c, 0xa6, 0x81, 0xfb, 0xde, 0x53, 0x35, 0x3a,
-	0xc8, 0xf9, 0x13, 0x61, 0xfa, 0x6c, 0x39, 0xd1, 0x0c, 0x02, 0xd4, 0xc2, 0x66, 0x50, 0x69, 0xa5,
-	0x31, 0x01, 0x88, 0x5d, 0x41, 0x74, 0x81, 0x51, 0x7a, 0xa2, 0xa3, 0x0b, 0x5d, 0xe2, 0x07, 0x00,
-	0xf8, 0x8b, 0x30, 0x43, 0x3f, 0xf8, 0x39, 0xec, 0x73, 0xa2, 0x33, 0x12, 0x2c, 0x1a, 0x7f, 0x0c,
-	0x2a, 0xd1, 0x07, 0x5e, 0x87, 0x12, 0x59, 0xd2, 0x51, 0xb5, 0x61, 0x11, 0x8d, 0x39, 0xdb, 0xb5,
-	0xc4, 0x19, 0x29, 0xa1, 0x82, 0xa1, 0x0a, 0xd8, 0xca, 0xa1, 0x6d, 0x59, 0xc2, 0x0d, 0x0f, 0x98,
-	0x54, 0x69, 0x5e, 0x1a, 0x40, 0x7e, 0x6e, 0x1a, 0x40, 0xe3, 0x17, 0xa0, 0x9a, 0xf0, 0x55, 0x2f,
-	0xec, 0x76, 0xa2, 0x61, 0xd9, 0x74, 0xc3, 0x6e, 0x42, 0x25, 0x4c, 0x3d, 0x09, 0x68, 0x23, 0xac,
-	0x18, 0x31, 0xa0, 0xf1, 0xb7, 0xb2, 0x68, 0xf6, 0x62, 0xd7, 0xa6, 0xfd, 0xcb, 0x6d, 0x28, 0x06,
-	0xd2, 0x94, 0x93, 0x30, 0x87, 0x62, 0xc1, 0xd5, 0xdc, 0x25, 0x9a, 0x9d, 0x25, 0x43, 0x53, 0xf3,
-	0xf7, 0x21, 0x27, 0xcd, 0x81, 0x8e, 0xcf, 0xbe, 0xb2, 0x18, 0x93, 0x9e, 0x39, 0xd8, 0x59, 0x32,
-	0x90, 0x8e, 0xef, 0x42, 0xb9, 0xaf, 0x43, 0x6a, 0x5a, 0x83, 0x2e, 0xe8, 0x02, 0x86, 0x81, 0xb8,
-	0x9d, 0x25, 0x23, 0xe2, 0xc0, 0x3f, 0x84, 0x3c, 0x9a, 0xa2, 0x3a, 0xd5, 0x64, 0x41, 0xd7, 0x16,
-	0xd7, 0xd6, 0xce, 0x92, 0x41, 0x94, 0x1b, 0x25, 0x28, 0x90, 0xc2, 0x6e, 0xd4, 0xa1, 0xa8, 0xfa,
-	0x3a, 0x3d, 0x72, 0x8d, 0x1b, 0x90, 0xeb, 0x99, 0x03, 0x74, 0x07, 0x6c, 0x2b, 0xd0, 0x11, 0x1a,
-	0xfc, 0xd9, 0x78, 0x31, 0x0e, 0x0f, 0x26, 0x23, 0xcf, 0x99, 0x54, 0xe4, 0xb9, 0x51, 0x84, 0x3c,
-	0xd6, 0xd8, 0xb8, 0x79, 0x99, 0x6b, 0xd1, 0xf8, 0x47, 0x39, 0xf4, 0x42, 0xa4, 0x38, 0x9b, 0x1b,
-	0x55, 0xff, 0x08, 0x2a, 0x63, 0xdf, 0xeb, 0x8b, 0x20, 0xf0, 0x7c, 0x6d, 0x49, 0xbd, 0xfa, 0xec,
-	0x13, 0xef, 0xf5, 0xc3, 0x90, 0xc6, 0x88, 0xc9, 0x9b, 0xff, 0x2a, 0x0b, 0x95, 0xe8, 0x83, 0x72,
-	0x7e, 0xa4, 0x38, 0x53, 0x11, 0xd4, 0x3d, 0xe1, 0x8f, 0x4c, 0xdb, 0x52, 0xaa, 0x66, 0x73, 0x68,
-	0x86, 0x16, 0xf1, 0x27, 0xde, 0x44, 0x4e, 0x8e, 0x84, 0x8a, 0x9c, 0x3d, 0xb2, 0x47, 0xc2, 0x63,
-	0x79, 0x3a, 0xb3, 0x42, 0xc1, 0xee, 0x3b, 0xde, 0xc4, 0x62, 0x05, 0x2c, 0xdf, 0xa7, 0xbd, 0x70,
-	0xcf, 0x1c, 0x07, 0x4a, 0xc1, 0xee, 0xd9, 0xbe, 0xc7, 0x4a, 0x48, 0xb4, 0x6d, 0x0f, 0x46, 0x26,
-	0x2b, 0x23, 0xb3, 0xde, 0x53, 0x5b, 0xa2, 0xc6, 0xae, 0xa0, 0x4d, 0x7b, 0x30, 0x16, 0x6e, 0x57,
-	0xfa, 0x42, 0xc8, 0x3d, 0x73, 0xac, 0x42, 0xa9, 0x86, 0xb0, 0x2c, 0x5b, 0x2a, 0x75, 0xb2, 0x6d,
-	0xf6, 0xc5, 0x91, 0xe7, 0x9d, 0xb0, 0x65, 0xd4, 0x4a, 0x1d, 0x37, 0x90, 0xe6, 0xc0, 0x37, 0x47,
-	0x4a, 0x99, 0xf4, 0x84, 0x23, 0xa8, 0xb4, 0x42, 0x75, 0xdb, 0x72, 0x38, 0x39, 0xba, 0x8f, 0x4e,
-	0xe2, 0xaa, 0x3a, 0xde, 0xb2, 0xc4, 0x58, 0xa0, 0xc2, 0x5d, 0x86, 0xf2, 0x86, 0xed, 0xd8, 0x47,
-	0xb6, 0x63, 0xb3, 0x35, 0x44, 0x6d, 0x9f, 0xf5, 0x4d, 0xc7, 0xb6, 0x7c, 0xf3, 0x29, 0xe3, 0xd8,
-	0xb8, 0x07, 0xbe, 0x77, 0x62, 0xb3, 0x2b, 0x88, 0x48, 0x3e, 0xe3, 0xa9, 0xfd, 0x19, 0xbb, 0x4a,
-	0x47, 0x74, 0x27, 0x42, 0xf6, 0x87, 0xc7, 0xe6, 0x11, 0xbb, 0x16, 0x47, 0x12, 0xaf, 0x63, 0x23,
-	0xb7, 0x7c, 0xf3, 0xa9, 0xed, 0xb1, 0x1b, 0xe4, 0x2f, 0x8c, 0x3d, 0x69, 0x1f, 0x9f, 0xb3, 0x7a,
-	0x63, 0x0d, 0x56, 0xa7, 0xb2, 0x04, 0x1a, 0x25, 0xed, 0xc3, 0x36, 0x6a, 0x50, 0x4d, 0x1c, 0xdf,
-	0x36, 0x5e, 0x82, 0x72, 0x78, 0xb8, 0xcb, 0x1b, 0x50, 0xb6, 0x03, 0x15, 0x96, 0xd6, 0xd2, 0x13,
-	0x95, 0x1b, 0xff, 0x3a, 0x03, 0x45, 0x75, 0xb2, 0xce, 0x37, 0xa2, 0x4c, 0x98, 0xcc, 0x02, 0xa7,
-	0xa9, 0x8a, 0x48, 0x9f, 0x45, 0x47, 0xe9, 0x30, 0x57, 0xa1, 0xe0, 0x50, 0x78, 0x40, 0xeb, 0x35,
-	0x2a, 0x24, 0xd4, 0x50, 0x2e, 0xa5, 0x86, 0x6e, 0x42, 0xc5, 0x9c, 0x48, 0x8f, 0x0e, 0x0d, 0xf5,
-	0x89, 0x4a, 0x0c, 0x68, 0xb6, 0xa2, 0xd3, 0xf1, 0x30, 0x50, 0x4a, 0x96, 0x67, 0xcf, 0x17, 0x42,
-	0x05, 0x41, 0xc9, 0x63, 0xcf, 0xd2, 0x46, 0xe0, 0x8d, 0xc6, 0x66, 0x5f, 0x12, 0x80, 0x76, 0x6a,
-	0xd4, 0xc1, 0x2c, 0x8f, 0x8b, 0x63, 0x73, 0x68, 0xca, 0xe6, 0x31, 0x94, 0x0f, 0xbd, 0x60, 0x7a,
-	0xdf, 0x2f, 0x41, 0xae, 0xe7, 0x8d, 0x95, 0x15, 0xbb, 0xe1, 0x49, 0xb2, 0x62, 0xd5, 0x36, 0x7f,
-	0x2c, 0x95, 0x2c, 0x1a, 0xf6, 0x60, 0x28, 0x95, 0xb7, 0xdf, 0x71, 0x5d, 0xe1, 0xb3, 0x02, 0x4e,
-	0x88, 0x21, 0xc6, 0x68, 0x39, 0xb3, 0x22, 0x4e, 0x36, 0xc1, 0xb7, 0x6d, 0x3f, 0x90, 0xac, 0xd4,
-	0xec, 0xe0, 0x8e, 0x6d, 0x0f, 0x68, 0xa3, 0xa5, 0x1f, 0xc4, 0x6a, 0x09, 0x9b, 0x48, 0xc5, 0x4d,
-	0xe1, 0xa2, 0x68, 0x92, 0x87, 0xa6, 0xdc, 0x4b, 0xaa, 0x20, 0x8b, 0xbb, 0x24, 0x95, 0x3f, 0x9a,
-	0x04, 0x34, 0xd7, 0xb9, 0xe6, 0x63, 0xa8, 0xa5, 0x92, 0xae, 0xf8, 0x55, 0x60, 0x29, 0x00, 0x36,
-	0x7d, 0x89, 0xdf, 0x80, 0x2b, 0x29, 0xe8, 0x9e, 0x6d, 0x59, 0x14, 0x99, 0x9e, 0xfe, 0x10, 0x76,
-	0x70, 0xa3, 0x02, 0xa5, 0xbe, 0x0e, 0x84, 0x1c, 0x42, 0x8d, 0x26, 0x75, 0x4f, 0x48, 0xf3, 0xc0,
-	0x75, 0xce, 0x7f, 0xe2, 0xcc, 0xb8, 0xe6, 0x97, 0xb5, 0x13, 0x87, 0x6a, 0xe6, 0xd8, 0xf7, 0x46,
-	0xc4, 0xab, 0x60, 0xd0, 0x6f, 0xe4, 0x2e, 0x3d, 0x2d, 0x19, 0x59, 0xe9, 0x35, 0xbf, 0xbb, 0x0c,
-	0xa5, 0x56, 0xbf, 0x8f, 0x6e, 0xe7, 0x4c, 0xcd, 0x6f, 0x43, 0xb1, 0xef, 0xb9, 0xc7, 0xf6, 0x40,
-	0xab, 0xf1, 0x69, 0xeb, 0x53, 0xd3, 0xa1, 0x38, 0x1e, 0xdb, 0x03, 0x43, 0x23, 0x23, 0x99, 0xde,
-	0x86, 0x0a, 0x97, 0x92, 0x29, 0x5d, 0x1c, 0xed, 0x3a, 0xaf, 0x41, 0xde, 0x76, 0x8f, 0x3d, 0x9d,
-	0xc6, 0xfa, 0x85, 0x0b, 0x88, 0x28, 0x97, 0x93, 0x10, 0x1b, 0xff, 0x26, 0x03, 0x45, 0x55, 0xb5,
-	0x8a, 0x4b, 0xe1, 0x52, 0x0b, 0x77, 0x00, 0xbd, 0xc6, 0xa6, 0xa0, 0x68, 0x18, 0x6b, 0x88, 0x38,
-	0x9a, 0x0c, 0x74, 0x7c, 0x27, 0x09, 0xe2, 0xef, 0xc2, 0x0d, 0x55, 0x3c, 0xf4, 0x85, 0x2f, 0x1c,
-	0x61, 0x06, 0x62, 0x73, 0x68, 0xba, 0xae, 0x70, 0xb4, 0x3d, 0x70, 0xd1, 0x67, 0xde, 0x84, 0x65,
-	0xf5, 0xa9, 0x3b, 0x36, 0xfb, 0x22, 0xd0, 0x6b, 0x29, 0x05, 0xe3, 0x5f, 0x81, 0x02, 0x65, 0xf9,
-	0xd6, 0xad, 0xcb, 0xa7, 0x52, 0x61, 0x35, 0xbc, 0x68, 0xc3, 0x6a, 0x01, 0xa8, 0x61, 0x42, 0xc7,
-	0x4e, 0xeb, 0x86, 0x9f, 0xbd, 0x74, 0x5c, 0xc9, 0xc7, 0x4c, 0x10, 0x61, 0xfb, 0x2c, 0xe1, 0x08,
-	0x4a, 0xc7, 0xc4, 0x0d, 0x35, 0x4b, 0xe7, 0x40, 0x29, 0x58, 0xe3, 0x3b, 0x05, 0xc8, 0xe3, 0x08,
-	0x23, 0xf2, 0xd0, 0x1b, 0x89, 0x28, 0x1a, 0xae, 0x2c, 0x94, 0x14, 0x0c, 0x2d, 0x22, 0x53, 0x25,
-	0x24, 0x44, 0x68, 0x4a, 0xb5, 0x4c, 0x83, 0x11, 0x73, 0xec, 0x7b, 0xc7, 0xb6, 0x13, 0x63, 0x6a,
-	0xdb, 0x69, 0x0a, 0xcc, 0xbf, 0x0a, 0xd7, 0x47, 0xa6, 0x7f, 0x22, 0x24, 0xad, 0xee, 0xc7, 0x9e,
-	0x7f, 0x12, 0xe0, 0xc8, 0x75, 0x2c, 0x1d, 0x46, 0xbd, 0xe0, 0x2b, 0x7f, 0x15, 0xd6, 0x9e, 0x86,
-	0xc5, 0xa8, 0x0e, 0x15, 0xc8, 0x9c, 0xfd, 0x80, 0x62, 0x40, 0x00, 0xd4, 0x4b, 0x1d, 0x4b, 0xc7,
-	0x30, 0x93, 0x20, 0x54, 0xd7, 0x96, 0x38, 0xb5, 0xa9, 0xe6, 0xb2, 0xca, 0x06, 0x0e, 0xcb, 0x28,
-	0x6c, 0xa6, 0x1a, 0xea, 0xae, 0x6e, 0x9b, 0x3e, 0x31, 0x4b, 0x43, 0x51, 0xb3, 0xaa, 0x2c, 0xa9,
-	0xa0, 0x63, 0x51, 0xa4, 0xb8, 0x62, 0xc4, 0x80, 0xa8, 0x0d, 0x8f, 0x94, 0x52, 0xae, 0x25, 0xda,
-	0xa0, 0x40, 0x88, 0x21, 0x45, 0x7f, 0x18, 0x56, 0xa2, 0xc2, 0xb8, 0x49, 0x10, 0xbf, 0x05, 0x30,
-	0x30, 0xa5, 0x78, 0x6a, 0x9e, 0x3f, 0xf4, 0x9d, 0xba, 0x50, 0x47, 0x3f, 0x31, 0x04, 0x5d, 0x69,
-	0xc7, 0xeb, 0x9b, 0x4e, 0x57, 0x7a, 0xbe, 0x39, 0x10, 0x87, 0xa6, 0x1c, 0xd6, 0x07, 0xca, 0x95,
-	0x9e, 0x86, 0x63, 0x8f, 0xa5, 0x3d, 0x12, 0x9f, 0x7a, 0xae, 0xa8, 0x0f, 0x55, 0x8f, 0xc3, 0x32,
-	0xb6, 0xc4, 0x74, 0x4d, 0xe7, 0x5c, 0xda, 0x7d, 0xec, 0x8b, 0xad, 0x5a, 0x92, 0x00, 0x51, 0xf0,
-	0x42, 0x48, 0x1c, 0xe9, 0x8e, 0x55, 0xff, 0x96, 0xea, 0x6b, 0x04, 0xc0, 0xf9, 0x17, 0x72, 0x28,
-	0x7c, 0x31, 0x19, 0xb5, 0x2c, 0xcb, 0x17, 0x41, 0x50, 0x3f, 0x51, 0xf3, 0x3f, 0x05, 0xc6, 0x9a,
-	0x46, 0x42, 0x9a, 0xb8, 0x60, 0x1f, 0x88, 0xf3, 0xba, 0xa3, 0x6a, 0x4a, 0x80, 0x1a, 0xbf, 0x9f,
-	0xa5, 0xb3, 0xbb, 0x61, 0xe3, 0x3f, 0x66, 0xa0, 0xd4, 0x1a, 0x8f, 0x49, 0x5c, 0xeb, 0x50, 0x32,
-	0xc7, 0xe3, 0x9d, 0xf8, 0xb4, 0x35, 0x2c, 0xea, 0x2f, 0xfb, 0xf1, 0x99, 0x6b, 0x58, 0xc4, 0x0d,
-	0xd1, 0x1c, 0x8f, 0xe3, 0x5c, 0x67, 0x5d, 0xc2, 0xae, 0xf4, 0x55, 0x9e, 0x79, 0x4b, 0xea, 0x33,
-	0xd4, 0x18, 0x80, 0xc3, 0x24, 0xce, 0xc6, 0xb6, 0x2f, 0xa2, 0x93, 0xd4, 0xa8, 0x4c, 0x09, 0x64,
-	0x7d, 0x6f, 0x1c, 0x1e, 0x91, 0xbe, 0x72, 0xc1, 0xfa, 0xc4, 0xd6, 0xaf, 0xef, 0xe2, 0xf8, 0xb7,
-	0xc6, 0x76, 0x17, 0x09, 0x0c, 0x45, 0xa7, 0x8c, 0x84, 0x16, 0x1d, 0xdd, 0x85, 0x67, 0x18, 0x61,
-	0xb9, 0xf9, 0x26, 0xd4, 0x52, 0x34, 0xb8, 0x09, 0x52, 0xd0, 0x9f, 0x02, 0x3b, 0x55, 0x28, 0x7d,
-	0x14, 0x78, 0x6e, 0xeb, 0xb0, 0xa3, 0xb6, 0xe5, 0xed, 0x89, 0xe3, 0xb0, 0x6c, 0xf3, 0x00, 0x20,
-	0xd6, 0x06, 0xb8, 0xc5, 0x2a, 0x66, 0x6c, 0x49, 0x85, 0x11, 0x5d, 0xcb, 0x76, 0x07, 0x5b, 0x5a,
-	0x01, 0xb0, 0x0c, 0x02, 0x29, 0x3c, 0x24, 0xac, 0x08, 0x48, 0xb6, 0x21, 0x95, 0x84, 0xc5, 0x72,
-	0xcd, 0xff, 0x99, 0x81, 0x6a, 0x22, 0x0d, 0xe6, 0xa7, 0x98, 0xba, 0x83, 0x7d, 0x47, 0xdb, 0x0b,
-	0x25, 0x59, 0x4d, 0x48, 0x54, 0x46, 0x39, 0xd7, 0x59, 0x3a, 0xf8, 0x55, 0x05, 0x83, 0x12, 0x90,
-	0xcf, 0x95, 0xb6, 0xd3, 0xbc, 0xa7, 0x23, 0x6a, 0x55, 0x28, 0x3d, 0x74, 0x4f, 0x5c, 0xef, 0xa9,
-	0xab, 0x6c, 0x1b, 0xca, 0xc5, 0x4a, 0x9d, 0x2a, 0x87, 0xe9, 0x52, 0xb9, 0xe6, 0xdf, 0xcd, 0x4f,
-	0xa5, 0x2d, 0xb6, 0xa1, 0xa8, 0x3c, 0x33, 0x72, 0x1a, 0x66, 0xf3, 0xcc, 0x92, 0xc8, 0xfa, 0x04,
-	0x33, 0x01, 0x32, 0x34, 0x31, 0xba, 0x4c, 0x51, 0x52, 0x6f, 0x76, 0xee, 0x49, 0x6b, 0x8a, 0x51,
-	0xb8, 0x9f, 0xa5, 0xf2, 0xda, 0x23, 0x0e, 0x8d, 0x3f, 0x9d, 0x81, 0xab, 0xf3, 0x50, 0x92, 0xd9,
-	0xff, 0x99, 0x74, 0xf6, 0x7f, 0x77, 0x2a, 0x9b, 0x3e, 0x4b, 0xbd, 0x79, 0xed, 0x39, 0x1b, 0x91,
-	0xce, 0xad, 0x6f, 0xfe, 0x61, 0x06, 0xd6, 0x66, 0xfa, 0x9c, 0xb0, 0xfd, 0xd0, 0xc6, 0x26, 0xc9,
-	0x52, 0xc9, 0x6e, 0x51, 0xfa, 0x91, 0x3a, 0xf4, 0x21, 0xab, 0x28, 0x50, 0xf9, 0x1c, 0xfa, 0xfe,
-	0x80, 0xf2, 0x48, 0x70, 0xd6, 0x70, 0xd3, 0x1d, 0x08, 0x15, 0x20, 0x57, 0x06, 0xaa, 0x86, 0x14,
-	0x95, 0xd7, 0xa0, 0xce, 0xb8, 0x58, 0x89, 0x92, 0xe8, 0x26, 0x63, 0xc7, 0xee, 0x63, 0xb1, 0xcc,
-	0x1b, 0x70, 0x5d, 0x5d, 0x22, 0xd1, 0x1e, 0xfa, 0x71, 0x6f, 0x68, 0xd3, 0xe2, 0x60, 0x15, 0xac,
-	0xe7, 0x70, 0x72, 0xe4, 0xd8, 0xc1, 0x90, 0x41, 0xd3, 0x80, 0x2b, 0x73, 0x3a, 0x48, 0x4d, 0x7e,
-	0xa4, 0x9b, 0xbf, 0x02, 0xb0, 0xf5, 0x28, 0x6c, 0x34, 0xcb, 0x70, 0x0e, 0x2b, 0x5b, 0x8f, 0x92,
-	0xdc, 0xf5, 0xe2, 0x79, 0x84, 0xfa, 0x3c, 0x60, 0xb9, 0xe6, 0xaf, 0x65, 0xc2, 0x2c, 0x97, 0xc6,
-	0xff, 0x0f, 0x35, 0xd5, 0xe0, 0x43, 0xf3, 0xdc, 0xf1, 0x4c, 0x8b, 0xb7, 0x61, 0x25, 0x88, 0xae,
-	0x39, 0x25, 0x36, 0xf9, 0x69, 0xe3, 0xa9, 0x9b, 0x42, 0x32, 0xa6, 0x88, 0x42, 0xaf, 0x33, 0x1b,
-	0x1f, 0x68, 0x71, 0xf2, 0x9f, 0x4d, 0x5a, 0x72, 0xcb, 0xe4, 0x11, 0x9b, 0xcd, 0xaf, 0xc0, 0x5a,
-	0x37, 0xde, 0x10, 0x95, 0x17, 0x82, 0xc2, 0xa1, 0x76, 0xd3, 0xad, 0x50, 0x38, 0x74, 0xb1, 0xf9,
-	0xef, 0x4b, 0x00, 0xf1, 0x31, 0xe0, 0x9c, 0x35, 0x3f, 0x2f, 0xab, 0x65, 0xe6, 0x50, 0x3e, 0xf7,
-	0xdc, 0x87, 0xf2, 0xef, 0x46, 0xce, 0x90, 0x0a, 0xec, 0x4f, 0xa7, 0xf6, 0xc7, 0x6d, 0x9a, 0x76,
-	0x81, 0x52, 0x49, 0x5f, 0x85, 0xe9, 0xa4, 0xaf, 0xdb, 0xb3, 0x19, 0xa2, 0x53, 0xca, 0x28, 0x0e,
-	0x02, 0x95, 0x52, 0x41, 0xa0, 0x06, 0x94, 0x7d, 0x61, 0x5a, 0x9e, 0xeb, 0x9c, 0x87, 0x67, 0xbf,
-	0x61, 0x99, 0xbf, 0x09, 0x05, 0x49, 0x37, 0xb5, 0xca, 0xb4, 0x76, 0x9e, 0x31, 0x71, 0x0a, 0x17,
-	0x35, 0x9b, 0x1d, 0xe8, 0xb4, 0x4e, 0x65, 0x47, 0x94, 0x8d, 0x04, 0x84, 0xaf, 0x03, 0xb7, 0xd1,
-	0x23, 0x76, 0x1c, 0x61, 0x6d, 0x9c, 0x6f, 0xa9, 0x23, 0x59, 0xb2, 0x85, 0xca, 0xc6, 0x9c, 0x2f,
-	0xe1, 0xfc, 0x2f, 0xc7, 0xf3, 0x4f, 0x4d, 0x3e, 0xb5, 0x03, 0xec, 0x69, 0x4d, 0x6d, 0x58, 0x61,
-	0x19, 0xad, 0xad, 0x70, 0xc1, 0xaa, 0xb1, 0x24, 0xe9, 0x8d, 0xf3, 0x1a, 0x2e, 0xf8, 0x1a, 0x0e,
-	0xaf, 0x8a, 0x82, 0xad, 0xaa, 0x2d, 0x32, 0x02, 0x90, 0x26, 0xef, 0x7b, 0x2e, 0xed, 0xb9, 0x4c,
-	0x6b, 0x72, 0x5d, 0xc6, 0xfe, 0x8e, 0x9d, 0x89, 0x6f, 0x3a, 0xf4, 0x75, 0x4d, 0x69, 0xf2, 0x18,
-	0xd2, 0xfc, 0x5f, 0xd9, 0xc8, 0xe1, 0xac, 0x40, 0xe1, 0xc8, 0x0c, 0xec, 0xbe, 0xda, 0xdd, 0xb4,
-	0xa1, 0xa8, 0x76, 0x37, 0xe9, 0x59, 0x1e, 0xcb, 0xa2, 0xef, 0x18, 0x08, 0x7d, 0x90, 0x16, 0xdf,
-	0x8b, 0x63, 0x79, 0x54, 0x01, 0xa1, 0x24, 0xa9, 0xbc, 0x2f, 0x22, 0xa5, 0xb0, 0xa8, 0x15, 0x65,
-	0xd4, 0x52, 0xcc, 0x82, 0xb6, 0x18, 0x56, 0x46, 0x1c, 0xd7, 0x93, 0x42, 0x05, 0x85, 0x49, 0xee,
-	0x19, 0x20, 0x9b, 0xf0, 0xa2, 0x07, 0xab, 0xa2, 0x33, 0x17, 0x32, 0x55, 0x91, 0xdc, 0x80, 0x5c,
-	0xdd, 0x65, 0x5c, 0xf7, 0xe9, 0x0f, 0xac, 0x86, 0x2d, 0x8a, 0xaf, 0xdb, 0xb1, 0x15, 0xe4, 0x6a,
-	0x52, 0x36, 0xd2, 0x2a, 0xfe, 0x3c, 0xa5, 0x1c, 0x25, 0x86, 0xb5, 0x5a, 0xa8, 0x97, 0xd6, 0xb0,
-	0x65, 0x91, 0xe9, 0xc7, 0x38, 0xfa, 0xaa, 0x63, 0x13, 0x1d, 0x47, 0x7b, 0x6c, 0xba, 0x92, 0x5d,
-	0xc1, 0xae, 0x8e, 0xad, 0x63, 0x76, 0x15, 0x2b, 0xeb, 0x0f, 0x4d, 0xb9, 0x25, 0xc6, 0xbe, 0x40,
-	0x9d, 0x66, 0xb1, 0x6b, 0x88, 0xad, 0x60, 0x3e, 0xca, 0x0c, 0xbb, 0x8e, 0xd8, 0xd2, 0x1c, 0xb0,
-	0x1b, 0xa8, 0x1d, 0x5d, 0x4f, 0xda, 0xc7, 0xa8, 0xfe, 0xb0, 0x21, 0x75, 0xbe, 0x06, 0xb5, 0x91,
-	0x1d, 0x04, 0xb6, 0x3b, 0xd0, 0x3a, 0xea, 0x05, 0x1c, 0x5d, 0x65, 0xdb, 0x06, 0xac, 0xd1, 0xfc,
-	0xcd, 0x38, 0x1f, 0xfe, 0xf5, 0xc8, 0x1d, 0x5c, 0x64, 0xe9, 0xa1, 0xc3, 0x38, 0x4f, 0x0f, 0xb4,
-	0x61, 0xcd, 0x17, 0xbf, 0x3c, 0xb1, 0x53, 0xb7, 0x44, 0x72, 0x97, 0xa7, 0x21, 0xcd, 0x52, 0x34,
-	0x4f, 0x61, 0x2d, 0x2c, 0x3c, 0xb6, 0xe5, 0x90, 0x02, 0x7a, 0xfc, 0xcd, 0xc4, 0x35, 0x96, 0xcc,
-	0xdc, 0xeb, 0x7f, 0x11, 0xcb, 0xf8, 0xda, 0x4a, 0x74, 0xba, 0x93, 0x5d, 0xe0, 0x74, 0xa7, 0xf9,
-	0x37, 0x4b, 0x89, 0x98, 0x9e, 0x72, 0x90, 0xad, 0xc8, 0x41, 0x9e, 0x4d, 0x1f, 0x88, 0x0f, 0x6c,
-	0xb2, 0xcf, 0x73, 0x60, 0x33, 0x2f, 0xa9, 0xe7, 0x3d, 0xf4, 0xd7, 0x68, 0x55, 0x3f, 0x5a, 0xe0,
-	0x30, 0x2a, 0x85, 0xcb, 0x37, 0x28, 0x19, 0xc0, 0xec, 0xaa, 0x8c, 0xb3, 0xc2, 0xdc, 0x4b, 0x65,
-	0xc9, 0x53, 0x7f, 0x8d, 0x69, 0x24, 0xa8, 0x12, 0x3a, 0xb0, 0x38, 0x4f, 0x07, 0x1e, 0xa0, 0x0e,
-	0x2c, 0xc5, 0x3a, 0x90, 0x62, 0x17, 0x74, 0x76, 0xa7, 0x7e, 0x87, 0xec, 0x49, 0x3f, 0x94, 0x8d,
-	0x19, 0x38, 0x1a, 0x8a, 0xa3, 0x89, 0x23, 0x6d, 0x6d, 0xe9, 0xaa, 0xc2, 0xf4, 0xad, 0xd7, 0xca,
-	0xec, 0xad, 0xd7, 0x0f, 0x00, 0x02, 0x81, 0x2b, 0x6b, 0xcb, 0xee, 0x4b, 0x9d, 0x97, 0x76, 0xeb,
-	0xa2, 0xbe, 0xe9, 0x43, 0xb5, 0x04, 0x05, 0xb6, 0x7f, 0x64, 0x9e, 0xd1, 0x41, 0xbb, 0x4e, 0xa0,
-	0x89, 0xca, 0xd3, 0x3b, 0xc3, 0xca, 0xec, 0xce, 0xf0, 0x66, 0x68, 0xe3, 0x5f, 0xbd, 0x74, 0x7e,
-	0xd7, 0x53, 0x76, 0x7d, 0x1d, 0x4a, 0xe4, 0x41, 0x78, 0x3e, 0x5d, 0xd9, 0xaa, 0x18, 0x61, 0x31,
-	0xa5, 0x9d, 0xaf, 0x4f, 0x69, 0xe7, 0xa9, 0x53, 0xbc, 0x1b, 0x33, 0xa7, 0x78, 0x8d, 0x5f, 0x81,
-	0xa2, 0x3e, 0x54, 0x9a, 0x0e, 0xdd, 0x84, 0x11, 0xe6, 0x6c, 0x22, 0xc2, 0x1c, 0xe5, 0x47, 0xe7,
-	0x92, 0xf9, 0xd1, 0x53, 0xf7, 0x3e, 0x0b, 0xb3, 0xf7, 0x3e, 0xeb, 0x50, 0xf2, 0x7c, 0x4b, 0xf8,
-	0x51, 0x52, 0x5c, 0x58, 0x6c, 0x7e, 0x0a, 0x05, 0xe5, 0x8b, 0x40, 0x68, 0x06, 0x2b, 0x13, 0x1a,
-	0x07, 0x84, 0x65, 0xf8, 0x55, 0x60, 0x81, 0x20, 0x1b, 0x4b, 0x74, 0xcd, 0x91, 0x20, 0xe5, 0x9c,
-	0xe5, 0x75, 0xb8, 0xaa, 0x70, 0x83, 0xf4, 0x17, 0x32, 0xf4, 0x1c, 0xfb, 0xc8, 0x37, 0xfd, 0x73,
-	0x96, 0x6f, 0x7e, 0x40, 0xc9, 0x1e, 0xa1, 0x30, 0x56, 0xa3, 0x1b, 0xb8, 0x6a, 0x3b, 0xb0, 0xb4,
-	0xae, 0xa3, 0x5c, 0x21, 0xed, 0x77, 0xab, 0x5c, 0x4c, 0x72, 0x6c, 0x29, 0x76, 0xb7, 0x9c, 0xb4,
-	0x2d, 0x7e, 0x6a, 0x6b, 0xb5, 0xb9, 0x91, 0xb0, 0x54, 0xd3, 0xc9, 0x95, 0x99, 0x45, 0x93, 0x2b,
-	0x9b, 0x0f, 0x60, 0xd5, 0x48, 0xef, 0x25, 0xfc, 0x5d, 0x28, 0x79, 0xe3, 0x24, 0x9f, 0x67, 0xc9,
-	0x74, 0x88, 0xde, 0xfc, 0x83, 0x0c, 0x2c, 0x77, 0x5c, 0x29, 0x7c, 0xd7, 0x74, 0xb6, 0x1d, 0x73,
-	0xc0, 0xdf, 0x09, 0x35, 0xdc, 0xfc, 0x38, 0x51, 0x12, 0x37, 0xad, 0xec, 0x1c, 0x7d, 0x52, 0xc2,
-	0xaf, 0xc1, 0x9a, 0xb0, 0x6c, 0xe9, 0xf9, 0xca, 0x3e, 0x0f, 0x73, 0x60, 0xaf, 0x02, 0x53, 0xe0,
-	0x2e, 0x2d, 0xa7, 0x9e, 0x9a, 0xe6, 0x3a, 0x5c, 0x4d, 0x41, 0x43, 0xe3, 0x3b, 0xcb, 0x6f, 0x42,
-	0x3d, 0xde, 0x05, 0xb7, 0x3c, 0x57, 0x76, 0x5c, 0x4b, 0x9c, 0x91, 0x71, 0xc7, 0x72, 0xcd, 0xdf,
-	0x88, 0xcc, 0xca, 0x47, 0x3a, 0x43, 0xd6, 0xf7, 0xbc, 0xf8, 0xfa, 0xb5, 0x2e, 0x25, 0xae, 0xf9,
-	0x67, 0x17, 0xb8, 0xe6, 0xff, 0x41, 0x7c, 0x55, 0x5b, 0x6d, 0x32, 0x2f, 0xce, 0xdd, 0xb9, 0x28,
-	0xb1, 0x4f, 0x7b, 0x15, 0x5d, 0x91, 0xb8, 0xb7, 0xfd, 0x86, 0x76, 0x25, 0xf3, 0x8b, 0x58, 0xdf,
-	0x2a, 0x73, 0xe5, 0xed, 0xe9, 0xfb, 0x41, 0x8b, 0x25, 0xd8, 0xce, 0x18, 0xc8, 0xf0, 0xdc, 0x06,
-	0xf2, 0x37, 0xa6, 0xbc, 0xb6, 0xf2, 0xdc, 0xd0, 0xe9, 0x25, 0xb7, 0x9f, 0xbf, 0x01, 0xa5, 0xa1,
-	0x1d, 0x48, 0xcf, 0x57, 0x37, 0xf2, 0x67, 0x6f, 0x10, 0x26, 0x46, 0x6b, 0x47, 0x21, 0x52, 0x36,
-	0x64, 0x48, 0xc5, 0xbf, 0x09, 0x6b, 0x34, 0xf0, 0x87, 0xb1, 0xb5, 0x12, 0xd4, 0xab, 0x73, 0xb3,
-	0x50, 0x13, 0xac, 0x36, 0xa6, 0x48, 0x8c, 0x59, 0x26, 0x8d, 0x01, 0x40, 0x3c, 0x3f, 0x33, 0xfa,
-	0xed, 0x73, 0xdc, 0xc8, 0xbf, 0x0e, 0xc5, 0x60, 0x72, 0x14, 0x1f, 0xa9, 0xea, 0x52, 0xe3, 0x0c,
-	0x1a, 0x33, 0x96, 0xc5, 0xa1, 0xf0, 0x55, 0x73, 0x2f, 0x7d, 0x16, 0xe0, 0x83, 0xe4, 0xc4, 0x2b,
-	0xe1, 0xbc, 0x7d, 0xc1, 0xec, 0x45, 0x9c, 0x13, 0x12, 0xd0, 0x78, 0x1b, 0xaa, 0x89, 0x41, 0x45,
-	0x9d, 0x3d, 0x71, 0x2d, 0x2f, 0x0c, 0xd7, 0xe3, 0x6f, 0x75, 0x2d, 0xd2, 0x0a, 0x03, 0xf6, 0xf4,
-	0xbb, 0x61, 0x00, 0x9b, 0x1e, 0xc0, 0x4b, 0x3c, 0xfb, 0x17, 0xa1, 0x96, 0x30, 0x25, 0xa3, 0x50,
-	0x6e, 0x1a, 0xd8, 0x3c, 0x85, 0x2f, 0x24, 0xd8, 0x1d, 0x0a, 0x9f, 0x8c, 0x44, 0xcf, 0x55, 0x4e,
-	0x2a, 0x99, 0xf4, 0x96, 0x70, 0xa5, 0x2d, 0x43, 0x0d, 0x1a, 0x95, 0xf9, 0xcf, 0x41, 0x61, 0x2c,
-	0xfc, 0x51, 0xa0, 0xb5, 0xe8, 0xb4, 0x04, 0xcd, 0x65, 0x1b, 0x18, 0x8a, 0xa6, 0xf9, 0x7b, 0x19,
-	0x28, 0xef, 0x09, 0x69, 0xa2, 0xdd, 0xc1, 0xf7, 0xa6, 0x6a, 0x99, 0x4d, 0x03, 0x08, 0x51, 0xd7,
-	0xb5, 0xdb, 0xbc, 0xde, 0xd1, 0xf8, 0xba, 0xbc, 0xb3, 0x14, 0x37, 0xac, 0xb1, 0x01, 0x25, 0x0d,
-	0x6e, 0xbc, 0x03, 0xab, 0x53, 0x98, 0x34, 0x2e, 0xca, 0xa7, 0xe8, 0x9e, 0x8f, 0xc2, 0xc4, 0xb6,
-	0x65, 0x23, 0x0d, 0xdc, 0xa8, 0x40, 0x69, 0xac, 0x08, 0x9a, 0xff, 0xf0, 0x1a, 0xa5, 0x53, 0x45,
-	0xc6, 0xf4, 0x8c, 0x4c, 0xde, 0x02, 0x50, 0x71, 0x42, 0xda, 0xae, 0x55, 0x78, 0x3d, 0x01, 0xe1,
-	0xef, 0x45, 0xe7, 0x22, 0xf9, 0xb9, 0x06, 0x59, 0x92, 0xf9, 0xf4, 0xe1, 0x48, 0x1d, 0x4a, 0x76,
-	0x40, 0xf1, 0x3f, 0x9d, 0xa8, 0x16, 0x16, 0xf9, 0xd7, 0xa1, 0x68, 0x8f, 0xc6, 0x9e, 0x2f, 0xf5,
-	0xc1, 0xc9, 0xa5, 0x5c, 0x3b, 0x84, 0xb9, 0xb3, 0x64, 0x68, 0x1a, 0xa4, 0x16, 0x67, 0x44, 0x5d,
-	0x7e, 0x36, 0x75, 0xfb, 0x2c, 0xa4, 0x56, 0x34, 0xfc, 0x63, 0xa8, 0x0d, 0x54, 0x9e, 0xae, 0x62,
-	0xac, 0x95, 0xc8, 0x2b, 0x97, 0x31, 0xb9, 0x9f, 0x24, 0xd8, 0x59, 0x32, 0xd2, 0x1c, 0x90, 0x25,
-	0x1a, 0xff, 0x22, 0x90, 0x3d, 0xef, 0x23, 0xcf, 0x76, 0xc9, 0xcd, 0x7e, 0x06, 0x4b, 0x23, 0x49,
-	0x80, 0x2c, 0x53, 0x1c, 0xf8, 0x57, 0xd1, 0x16, 0x0a, 0xa4, 0x7e, 0x14, 0xe1, 0xf6, 0x65, 0x9c,
-	0x7a, 0x22, 0xd0, 0xcf, 0x19, 0x04, 0x92, 0x9f, 0x41, 0x23, 0xb1, 0x48, 0x74, 0x25, 0xad, 0xf1,
-	0xd8, 0xf7, 0xd0, 0x57, 0xaf, 0x11, 0xb7, 0xaf, 0x5e, 0xc6, 0xed, 0xf0, 0x42, 0xea, 0x9d, 0x25,
-	0xe3, 0x12, 0xde, 0xbc, 0x87, 0x1e, 0xa5, 0xee, 0xc2, 0xae, 0x30, 0x4f, 0xc3, 0x27, 0x15, 0xee,
-	0x2e, 0x34, 0x0a, 0x44, 0xb1, 0xb3, 0x64, 0x4c, 0xf1, 0xe0, 0xbf, 0x00, 0x6b, 0xa9, 0x3a, 0xe9,
-	0x16, 0xb5, 0x7a, 0x70, 0xe1, 0x2b, 0x0b, 0x77, 0x03, 0x89, 0x76, 0x96, 0x8c, 0x59, 0x4e, 0x7c,
-	0x02, 0x2f, 0xcc, 0x76, 0x69, 0x4b, 0xf4, 0x1d, 0xdb, 0x15, 0xfa, 0x6d, 0x86, 0xb7, 0x9f, 0x6f,
-	0xb4, 0x34, 0xf1, 0xce, 0x92, 0x71, 0x31, 0x67, 0xfe, 0x2b, 0x70, 0x73, 0x3c, 0x57, 0xc5, 0x28,
-	0xd5, 0xa5, 0x9f, 0x76, 0x78, 0x77, 0xc1, 0x9a, 0x67, 0xe8, 0x77, 0x96, 0x8c, 0x4b, 0xf9, 0xa3,
-	0x55, 0x4d, 0x9e, 0xbb, 0xbe, 0x98, 0xa0, 0x0a, 0x74, 0xaa, 0xde, 0x77, 0x76, 0x84, 0x69, 0x45,
-	0x27, 0x37, 0x31, 0xa0, 0xf1, 0x9f, 0x32, 0x50, 0xd4, 0xf2, 0x7e, 0x33, 0x4a, 0xfb, 0x88, 0x54,
-	0x77, 0x0c, 0xe0, 0xef, 0x43, 0x45, 0xf8, 0xbe, 0xe7, 0x6f, 0x7a, 0x56, 0x98, 0x5e, 0x3b, 0x1d,
-	0xdd, 0x56, 0x7c, 0xd6, 0xdb, 0x21, 0x9a, 0x11, 0x53, 0xf0, 0xf7, 0x00, 0xd4, 0x3a, 0xef, 0xc5,
-	0xf7, 0xcb, 0x1a, 0xf3, 0xe9, 0xd5, 0x71, 0x61, 0x8c, 0x1d, 0x87, 0x03, 0xc3, 0xb3, 0xba, 0xb0,
-	0x18, 0x39, 0xab, 0x85, 0x84, 0xb3, 0x7a, 0x53, 0xc7, 0x2f, 0x28, 0xac, 0xa3, 0x6f, 0x59, 0x46,
-	0x80, 0xc6, 0x3f, 0xc8, 0x40, 0x51, 0x29, 0x0f, 0xde, 0x9e, 0xed, 0xd1, 0xcb, 0xcf, 0xd6, 0x39,
-	0xeb, 0xd3, 0x3d, 0xfb, 0x3a, 0x80, 0xd2, 0x41, 0x89, 0x9e, 0xdd, 0x9c, 0xe2, 0xa3, 0x49, 0xc3,
-	0x84, 0xf6, 0x18, 0xbf, 0x79, 0x4f, 0xdd, 0x04, 0xa4, 0x50, 0xf4, 0xc3, 0xdd, 0x5d, 0xb6, 0xc4,
-	0xd7, 0xa0, 0xf6, 0x70, 0xff, 0xc1, 0xfe, 0xc1, 0xe3, 0xfd, 0x27, 0x6d, 0xc3, 0x38, 0x30, 0x54,
-	0x44, 0x7a, 0xa3, 0xb5, 0xf5, 0xa4, 0xb3, 0x7f, 0xf8, 0xb0, 0xc7, 0xb2, 0x8d, 0xbf, 0x9d, 0x81,
-	0x5a, 0x4a, 0x77, 0xfd, 0xd1, 0x4e, 0x5d, 0x62, 0xf8, 0x73, 0xf3, 0x87, 0x3f, 0x7f, 0xd1, 0xf0,
-	0x17, 0xa6, 0x87, 0xff, 0xaf, 0x67, 0xa0, 0x96, 0xd2, 0x91, 0x49, 0xee, 0x99, 0x34, 0xf7, 0xe4,
-	0x4e, 0x9f, 0x9d, 0xda, 0xe9, 0x9b, 0xb0, 0x1c, 0xfe, 0xde, 0x8f, 0xa3, 0x15, 0x29, 0x58, 0x12,
-	0x87, 0xae, 0xe2, 0xe4, 0xd3, 0x38, 0x74, 0x1d, 0xe7, 0xf2, 0xd6, 0xd2, 0xd5, 0xe3, 0x80, 0x5e,
-	0x66, 0x68, 0x5c, 0xac, 0x41, 0x2f, 0xe9, 0xc2, 0x7d, 0xa8, 0x8e, 0xe3, 0x65, 0xfa, 0x7c, 0x66,
-	0x49, 0x92, 0xf2, 0x19, 0xed, 0xfc, 0x6e, 0x06, 0x56, 0xd2, 0x3a, 0xf7, 0xff, 0xe9, 0x61, 0xfd,
-	0x1b, 0x19, 0x58, 0x9b, 0xd1, 0xe4, 0x97, 0x1a, 0x76, 0xd3, 0xed, 0xca, 0x2e, 0xd0, 0xae, 0xdc,
-	0x9c, 0x76, 0x5d, 0xac, 0x49, 0x2e, 0x6f, 0x71, 0x17, 0x5e, 0xb8, 0x70, 0x4f, 0xb8, 0x64, 0xa8,
-	0x53, 0x4c, 0x73, 0xd3, 0x4c, 0x7f, 0x27, 0x03, 0x37, 0x2f, 0xd3, 0xf7, 0xff, 0xd7, 0xe5, 0x6a,
-	0xba, 0x85, 0xcd, 0x77, 0xa2, 0xa4, 0x8f, 0x2a, 0x94, 0xf4, 0x8b, 0x67, 0x3a, 0x75, 0x7f, 0xe8,
-	0x3d, 0x75, 0x55, 0x04, 0xdc, 0x10, 0xa6, 0x7e, 0x13, 0xc2, 0x10, 0x63, 0xc7, 0xa6, 0xb3, 0xd9,
-	0x1b, 0x00, 0x2d, 0xf2, 0xeb, 0xc2, 0x8b, 0x55, 0x9b, 0xbb, 0x07, 0xdd, 0x36, 0x5b, 0x4a, 0x1a,
-	0xb1, 0x9f, 0x86, 0x8a, 0xb8, 0x79, 0x08, 0xc5, 0xf8, 0xaa, 0xcb, 0x9e, 0xe9, 0x9f, 0x58, 0xea,
-	0x04, 0x74, 0x19, 0xca, 0x87, 0xda, 0x85, 0x52, 0x55, 0x7d, 0xd4, 0x3d, 0xd8, 0x57, 0xc1, 0xf6,
-	0xad, 0x83, 0x9e, 0xba, 0x30, 0xd3, 0x7d, 0x74, 0x5f, 0x1d, 0xc5, 0xdd, 0x37, 0x5a, 0x87, 0x3b,
-	0x4f, 0x08, 0xa3, 0xd0, 0xfc, 0x6b, 0xf9, 0x70, 0x57, 0x6b, 0xfe, 0x92, 0x3e, 0x5b, 0x05, 0x28,
-	0xa2, 0x36, 0xf7, 0x34, 0xe3, 0xa8, 0x1a, 0x4a, 0xf2, 0x6e, 0x9f, 0xa9, 0x38, 0x04, 0xcb, 0xf2,
-	0x22, 0x64, 0x0f, 0x8f, 0x54, 0xd6, 0xd8, 0x8e, 0x1c, 0x39, 0xea, 0xce, 0x6e, 0xef, 0x4c, 0xb2,
-	0x02, 0xfe, 0xd8, 0x0c, 0x4e, 0xd5, 0xb9, 0xde, 0xc1, 0x51, 0x60, 0xd3, 0x1d, 0x96, 0x52, 0xf3,
-	0xef, 0xe4, 0xa0, 0x12, 0x29, 0xce, 0xe7, 0x51, 0xe4, 0x9c, 0xc3, 0x4a, 0x67, 0xbf, 0xd7, 0x36,
-	0xf6, 0x5b, 0xbb, 0x1a, 0x25, 0xc7, 0xaf, 0xc0, 0xea, 0x76, 0x67, 0xb7, 0xfd, 0x64, 0xf7, 0xa0,
-	0xb5, 0xa5, 0x81, 0x65, 0x7e, 0x1d, 0x78, 0x67, 0xef, 0xf0, 0xc0, 0xe8, 0x3d, 0xe9, 0x74, 0x9f,
-	0x6c, 0xb6, 0xf6, 0x37, 0xdb, 0xbb, 0xed, 0x2d, 0x56, 0xe4, 0x2f, 0xc2, 0xed, 0xfd, 0x83, 0x5e,
-	0xe7, 0x60, 0xff, 0xc9, 0xfe, 0xc1, 0x93, 0x83, 0x8d, 0x8f, 0xda, 0x9b, 0xbd, 0xee, 0x93, 0xce,
-	0xfe, 0x13, 0xe4, 0x7a, 0xdf, 0x68, 0xe1, 0x17, 0x56, 0xe0, 0xb7, 0xe1, 0xa6, 0xc6, 0xea, 0xb6,
-	0x8d, 0x47, 0x6d, 0x03, 0x99, 0x3c, 0xdc, 0x6f, 0x3d, 0x6a, 0x75, 0x76, 0x5b, 0x1b, 0xbb, 0x6d,
-	0xb6, 0xcc, 0x6f, 0x41, 0x43, 0x63, 0x18, 0xad, 0x5e, 0xfb, 0xc9, 0x6e, 0x67, 0xaf, 0xd3, 0x7b,
-	0xd2, 0xfe, 0xe6, 0x66, 0xbb, 0xbd, 0xd5, 0xde, 0x62, 0x35, 0xfe, 0x0a, 0x7c, 0x89, 0x1a, 0xa5,
-	0x1b, 0x91, 0xae, 0xec, 0xd3, 0xce, 0xe1, 0x93, 0x96, 0xb1, 0xb9, 0xd3, 0x79, 0xd4, 0x66, 0x2b,
-	0xfc, 0x65, 0xf8, 0xe2, 0xc5, 0xa8, 0x5b, 0x1d, 0xa3, 0xbd, 0xd9, 0x3b, 0x30, 0x3e, 0x61, 0x6b,
-	0xfc, 0x67, 0xe0, 0x85, 0x9d, 0xde, 0xde, 0xee, 0x93, 0xc7, 0xc6, 0xc1, 0xfe, 0xfd, 0x27, 0xf4,
-	0xb3, 0xdb, 0x33, 0x1e, 0x6e, 0xf6, 0x1e, 0x1a, 0x6d, 0x06, 0xbc, 0x01, 0xd7, 0x0f, 0x37, 0x9e,
-	0xec, 0x1f, 0xf4, 0x9e, 0xb4, 0xf6, 0x3f, 0xd9, 0xd8, 0x3d, 0xd8, 0x7c, 0xf0, 0x64, 0xfb, 0xc0,
-	0xd8, 0x6b, 0xf5, 0x58, 0x95, 0x7f, 0x19, 0x5e, 0xde, 0xec, 0x3e, 0xd2, 0xcd, 0x3c, 0xd8, 0x7e,
-	0x62, 0x1c, 0x3c, 0xee, 0x3e, 0x39, 0x30, 0x9e, 0x18, 0xed, 0x5d, 0xea, 0x73, 0x37, 0x6e, 0x7b,
-	0x89, 0xdf, 0x84, 0x7a, 0x67, 0xbf, 0xfb, 0x70, 0x7b, 0xbb, 0xb3, 0xd9, 0x69, 0xef, 0xf7, 0x9e,
-	0x1c, 0xb6, 0x8d, 0xbd, 0x4e, 0xb7, 0x8b, 0x68, 0xac, 0xd2, 0xfc, 0x10, 0x8a, 0x1d, 0xf7, 0xd4,
-	0x96, 0xb4, 0xda, 0xb4, 0x68, 0x6a, 0xff, 0x2b, 0x2c, 0xd2, 0x22, 0xb1, 0x07, 0x2e, 0xbd, 0x4c,
-	0x41, 0x6b, 0x6d, 0xd9, 0x88, 0x01, 0xcd, 0x7f, 0x99, 0x87, 0x9a, 0x62, 0x11, 0xfa, 0x73, 0x77,
-	0x60, 0x55, 0x07, 0x55, 0x3b, 0x69, 0x85, 0x36, 0x0d, 0xa6, 0x27, 0xdf, 0x14, 0x28, 0xa1, 0xd6,
-	0x92, 0x20, 0xfe, 0x12, 0xac, 0x84, 0x44, 0x7d, 0xcf, 0xdd, 0xb4, 0x2d, 0x7d, 0xa0, 0x36, 0x05,
-	0xe5, 0xbf, 0x08, 0x2f, 0x24, 0x20, 0x6d, 0xb7, 0xef, 0x9f, 0x8f, 0xa3, 0x97, 0x14, 0x6b, 0x73,
-	0x03, 0x02, 0xdb, 0xb6, 0x23, 0x52, 0x88, 0xc6, 0xc5, 0x2c, 0x28, 0x45, 0xa5, 0xef, 0xa0, 0x73,
-	0xaa, 0x4e, 0x6f, 0x75, 0xe9, 0xf3, 0x6a, 0x54, 0xd4, 0xd6, 0x0a, 0x51, 0xf7, 0x4a, 0x19, 0x6a,
-	0x29, 0x18, 0x8e, 0x63, 0x54, 0xd6, 0x57, 0x5a, 0xd0, 0x05, 0xab, 0x19, 0xd3, 0xe0, 0x28, 0x83,
-	0xe9, 0xe1, 0x19, 0x19, 0x61, 0x55, 0xc2, 0x4a, 0x82, 0xf8, 0xa7, 0x70, 0x23, 0x22, 0x9a, 0x1a,
-	0x9d, 0xd2, 0x82, 0xa3, 0x73, 0x11, 0x03, 0xfe, 0x35, 0x00, 0x9b, 0x04, 0x80, 0x2a, 0x57, 0xf7,
-	0x12, 0x5f, 0x98, 0x89, 0x74, 0x86, 0x08, 0x46, 0x02, 0x19, 0x37, 0xbd, 0x01, 0xee, 0x25, 0x0f,
-	0xf4, 0x43, 0x94, 0xcb, 0x46, 0x54, 0x6e, 0xfe, 0x28, 0x93, 0x08, 0x15, 0xa8, 0x50, 0xc0, 0xa5,
-	0x9b, 0xe4, 0xbc, 0x23, 0x2f, 0x74, 0xd6, 0xf5, 0x08, 0x6b, 0xdb, 0x4d, 0x17, 0xf9, 0x21, 0x70,
-	0x7b, 0x76, 0x2c, 0xf2, 0x0b, 0x8e, 0xc5, 0x1c, 0xda, 0xe9, 0x13, 0x8b, 0xc2, 0xec, 0x89, 0xc5,
-	0x2d, 0x80, 0x81, 0xe3, 0x1d, 0xe9, 0x23, 0xd7, 0xa2, 0x4e, 0x12, 0x8b, 0x20, 0xcd, 0x3f, 0x9b,
-	0x81, 0xeb, 0x53, 0x3d, 0x7e, 0x6c, 0xcb, 0x21, 0xca, 0xd9, 0x0e, 0xac, 0xda, 0xe9, 0x2f, 0x3a,
-	0x2e, 0x33, 0x1d, 0x9b, 0x9e, 0xa2, 0x37, 0xa6, 0xc9, 0x50, 0xaa, 0xb4, 0x37, 0x1a, 0x86, 0x70,
-	0xf4, 0x9a, 0x9e, 0x06, 0x37, 0x1d, 0x28, 0x87, 0x6f, 0x76, 0xa2, 0xfc, 0xd3, 0xab, 0x9d, 0x51,
-	0x48, 0x58, 0x95, 0xf8, 0x0e, 0xac, 0x88, 0xf4, 0x10, 0x66, 0x17, 0x1c, 0xc2, 0x29, 0xba, 0xe6,
-	0xd7, 0x60, 0x6d, 0x06, 0x09, 0xe7, 0x74, 0x6c, 0xca, 0xe8, 0xe1, 0x0e, 0xfc, 0x3d, 0x9b, 0x58,
-	0xd1, 0xfc, 0x17, 0x59, 0x58, 0xde, 0x33, 0x5d, 0xfb, 0x58, 0x04, 0x92, 0x5a, 0x7b, 0x03, 0x8a,
-	0x41, 0x7f, 0x28, 0x46, 0x66, 0x68, 0x38, 0xbc, 0xa8, 0x8a, 0x3a, 0x50, 0x94, 0x4d, 0x1e, 0xce,
-	0xcc, 0x9c, 0xf6, 0xe1, 0x52, 0x9f, 0xc8, 0x61, 0x74, 0xab, 0x44, 0x97, 0x50, 0x96, 0x1c, 0xbb,
-	0x2f, 0xdc, 0x20, 0x5c, 0xce, 0x61, 0x31, 0x4e, 0xb4, 0x2a, 0x5e, 0x92, 0x68, 0x55, 0x9a, 0x95,
-	0x07, 0x5c, 0xb6, 0x7d, 0x5f, 0x08, 0x37, 0x18, 0x7a, 0x32, 0x7c, 0xf0, 0x35, 0x09, 0xa2, 0x4c,
-	0x51, 0xef, 0xa9, 0x8b, 0x6a, 0x75, 0xd7, 0x76, 0x4f, 0x74, 0x7a, 0x63, 0x0a, 0x86, 0x6b, 0x82,
-	0xc2, 0x64, 0xf6, 0x67, 0x82, 0xf4, 0x43, 0xc1, 0x88, 0xca, 0x14, 0x08, 0x33, 0xa5, 0x18, 0x78,
-	0xbe, 0x2d, 0x54, 0x34, 0xb8, 0x62, 0x24, 0x20, 0x48, 0xeb, 0x98, 0xee, 0x60, 0x62, 0x0e, 0x84,
-	0x56, 0xac, 0x51, 0xb9, 0xf9, 0x9f, 0x0b, 0x00, 0x7b, 0x62, 0x74, 0x24, 0xfc, 0x60, 0x68, 0x8f,
-	0xe9, 0x1c, 0xcb, 0xd6, 0x29, 0xf3, 0x35, 0x83, 0x7e, 0xf3, 0x77, 0x53, 0xd7, 0x5c, 0x66, 0xcf,
-	0xa6, 0x63, 0xf2, 0xe9, 0x28, 0x1a, 0x0e, 0x8e, 0x29, 0x85, 0xce, 0x71, 0xa3, 0xf1, 0xcf, 0x1b,
-	0x49, 0x10, 0xe5, 0x7d, 0x9a, 0x52, 0xb4, 0x5d, 0x4b, 0x45, 0xe9, 0xf2, 0x46, 0x54, 0xa6, 0xf3,
-	0xb8, 0xa0, 0x35, 0x91, 0x9e, 0x21, 0x5c, 0xf1, 0x34, 0xba, 0x30, 0x1a, 0x83, 0xf8, 0x1e, 0xd4,
-	0xc6, 0xe6, 0xf9, 0x48, 0xb8, 0x28, 0xce, 0x43, 0xcf, 0xd2, 0x09, 0x69, 0x2f, 0x5f, 0xdc, 0xc0,
-	0xc3, 0x24, 0xba, 0x91, 0xa6, 0x46, 0x99, 0x70, 0x03, 0x5a, 0xb5, 0x6a, 0x1a, 0x75, 0x89, 0x6f,
-	0x00, 0xa8, 0x5f, 0x09, 0xd5, 0x37, 0x13, 0xb8, 0x33, 0x47, 0x22, 0x10, 0xfe, 0xa9, 0xad, 0x54,
-	0xbf, 0xd2, 0x81, 0x31, 0x15, 0x6e, 0x14, 0x93, 0x40, 0xf8, 0xed, 0x91, 0x69, 0x3b, 0x7a, 0x82,
-	0x63, 0x00, 0x7f, 0x0b, 0xae, 0x05, 0x93, 0x23, 0x94, 0x99, 0x23, 0xd1, 0xf3, 0xf6, 0xc5, 0xd3,
-	0xc0, 0x11, 0x52, 0x0a, 0x5f, 0x27, 0xbd, 0xcc, 0xff, 0xd8, 0x1c, 0x44, 0x96, 0x2b, 0x3d, 0x2e,
-	0x84, 0xbf, 0xe2, 0xcc, 0xba, 0x08, 0xa4, 0xd3, 0x0e, 0x59, 0x86, 0x33, 0x58, 0x56, 0x20, 0x9d,
-	0x95, 0x98, 0xe5, 0x5f, 0x82, 0x9f, 0x4d, 0x21, 0x19, 0x2a, 0x0f, 0x20, 0xd8, 0xb6, 0x5d, 0xd3,
-	0xb1, 0x3f, 0x53, 0x49, 0x0c, 0xb9, 0xe6, 0x18, 0x6a, 0xa9, 0x81, 0xa3, 0x1b, 0xce, 0xf4, 0x4b,
-	0xa7, 0x66, 0x31, 0x58, 0x56, 0xe5, 0xae, 0xf4, 0x6d, 0x3a, 0xa4, 0x8a, 0x20, 0x9b, 0xb8, 0xd0,
-	0x3d, 0x96, 0xe5, 0x57, 0x81, 0x29, 0x48, 0xc7, 0x35, 0xc7, 0xe3, 0xd6, 0x78, 0xec, 0x08, 0x96,
-	0xa3, 0xdb, 0xe3, 0x31, 0x54, 0x5d, 0x76, 0x61, 0xf9, 0xe6, 0x37, 0xe1, 0x06, 0x8d, 0xcc, 0x23,
-	0xe1, 0x47, 0xb1, 0x09, 0xdd, 0xd7, 0x6b, 0xb0, 0xa6, 0x7e, 0xed, 0x7b, 0x52, 0x7d, 0x26, 0x7b,
-	0x9d, 0xc3, 0x8a, 0x02, 0xa3, 0x81, 0xda, 0x15, 0x74, 0x27, 0x3c, 0x82, 0x45, 0x78, 0xd9, 0xe6,
-	0x3f, 0x2d, 0x02, 0x8f, 0x05, 0xa2, 0x67, 0x0b, 0x7f, 0xcb, 0x94, 0x66, 0x22, 0xb8, 0x5c, 0xbb,
-	0x30, 0xb5, 0xe2, 0xd9, 0x49, 0x95, 0xd7, 0xa1, 0x68, 0x07, 0xe8, 0x4d, 0xeb, 0x6c, 0x74, 0x5d,
-	0xe2, 0xbb, 0x00, 0x63, 0xe1, 0xdb, 0x9e, 0x45, 0x12, 0x54, 0x98, 0x7b, 0xdb, 0x68, 0xb6, 0x51,
-	0xeb, 0x87, 0x11, 0x8d, 0x91, 0xa0, 0xc7, 0x76, 0xa8, 0x92, 0x4a, 0x54, 0x28, 0x2a, 0x43, 0x20,
-	0x01, 0xe2, 0xaf, 0xc3, 0x95, 0xb1, 0x6f, 0xf7, 0x85, 0x9a, 0x8e, 0x87, 0x81, 0xb5, 0x49, 0x4f,
-	0x72, 0x96, 0x08, 0x73, 0xde, 0x27, 0x94, 0x40, 0xd3, 0x25, 0x1f, 0x33, 0xa0, 0xa3, 0x79, 0xfd,
-	0x8a, 0x82, 0xca, 0xc6, 0xae, 0x19, 0xf3, 0x3f, 0xf2, 0xbb, 0xc0, 0xf4, 0x87, 0x3d, 0xdb, 0xdd,
-	0x15, 0xee, 0x40, 0x0e, 0x49, 0xb8, 0x6b, 0xc6, 0x0c, 0x9c, 0x34, 0x98, 0x7a, 0xf8, 0x4c, 0x1d,
-	0xbd, 0x55, 0x8c, 0xa8, 0xac, 0xde, 0xf8, 0x70, 0x3c, 0xbf, 0x2b, 0x7d, 0x9d, 0x78, 0x1e, 0x95,
-	0xc9, 0x40, 0xa2, 0xb6, 0x1e, 0xfa, 0x9e, 0x35, 0xa1, 0x83, 0x21, 0xa5, 0xc4, 0xa6, 0xc1, 0x31,
-	0xe6, 0x9e, 0xe9, 0xea, 0xcc, 0xd6, 0x5a, 0x12, 0x33, 0x02, 0x93, 0x1b, 0xed, 0x05, 0x31, 0xc3,
-	0x55, 0xed, 0x46, 0x27, 0x60, 0x1a, 0x27, 0x66, 0xc5, 0x22, 0x9c, 0x98, 0x0f, 0xf5, 0xdf, 0xf2,
-	0x3d, 0xdb, 0x8a, 0x79, 0xa9, 0x24, 0xab, 0x19, 0x78, 0x02, 0x37, 0xe6, 0xc9, 0x53, 0xb8, 0x31,
-	0xdf, 0xab, 0x50, 0xf0, 0x8e, 0x8f, 0x85, 0x4f, 0xef, 0xdc, 0x56, 0x0c, 0x55, 0x68, 0x7e, 0x3b,
-	0x03, 0x10, 0x8b, 0x04, 0x2e, 0x84, 0xb8, 0x14, 0x2f, 0xfc, 0x1b, 0x70, 0x25, 0x09, 0x76, 0x74,
-	0xce, 0x32, 0xad, 0x86, 0xf8, 0xc3, 0x96, 0x79, 0x1e, 0xb0, 0xac, 0x7e, 0xdd, 0x40, 0xc3, 0x1e,
-	0x0b, 0x41, 0x09, 0xa0, 0x57, 0x81, 0xc5, 0x40, 0xba, 0xb2, 0x1a, 0xb0, 0x7c, 0x1a, 0xf5, 0x13,
-	0x61, 0xfa, 0x01, 0x2b, 0x34, 0xbf, 0xcf, 0x71, 0x9d, 0x87, 0x82, 0xfb, 0xe8, 0x5e, 0x63, 0x1b,
-	0x8a, 0xad, 0x11, 0xe5, 0x76, 0xe0, 0x9c, 0xd2, 0x5d, 0xd5, 0x7e, 0x64, 0xc5, 0x85, 0x65, 0x4a,
-	0x70, 0x27, 0x2c, 0x25, 0x97, 0xea, 0xec, 0x26, 0x09, 0x6a, 0x3c, 0x86, 0x52, 0xc7, 0x3d, 0xf5,
-	0xec, 0xbe, 0xd0, 0x19, 0x95, 0xca, 0x14, 0xca, 0xab, 0x3b, 0x86, 0xfc, 0x5d, 0x28, 0x48, 0x4f,
-	0x9a, 0x8e, 0x3e, 0x8d, 0x6c, 0x5e, 0xb8, 0x96, 0x1e, 0xdd, 0x5b, 0x57, 0xed, 0x31, 0x14, 0x41,
-	0xe3, 0xf7, 0xb2, 0x50, 0xde, 0x0e, 0xe5, 0x0e, 0x0d, 0x74, 0x95, 0x93, 0xbf, 0x71, 0x2e, 0x45,
-	0xa0, 0xab, 0x48, 0xc1, 0x22, 0x23, 0xde, 0xa0, 0xb4, 0x4d, 0xd5, 0xd8, 0x9a, 0x91, 0x82, 0x45,
-	0x38, 0x8f, 0x7d, 0x9b, 0x6e, 0x77, 0xe7, 0x12, 0x38, 0x1a, 0x46, 0x38, 0x43, 0xd3, 0x17, 0x56,
-	0xe2, 0xbe, 0x0a, 0xe2, 0x24, 0x60, 0xb8, 0x4b, 0x48, 0x61, 0x8e, 0xba, 0xc2, 0x94, 0xea, 0x36,
-	0x4f, 0xcd, 0x88, 0x01, 0xc8, 0x41, 0xaf, 0x2a, 0x95, 0x4d, 0xa3, 0x16, 0x7e, 0x0a, 0xc6, 0x5f,
-	0x84, 0x5a, 0x6a, 0xe5, 0xe9, 0x35, 0x9f, 0x06, 0xaa, 0xc3, 0x38, 0xfb, 0x14, 0x37, 0x62, 0xd5,
-	0x18, 0xb5, 0xca, 0xd3, 0xc0, 0xc6, 0x3f, 0xcf, 0x41, 0x49, 0xcb, 0xef, 0xbc, 0x84, 0x97, 0xcf,
-	0xa1, 0x1f, 0x29, 0xfd, 0xb2, 0xe7, 0x8d, 0x77, 0xc5, 0xa9, 0x70, 0xb4, 0x8e, 0x4c, 0x40, 0xf4,
-	0xad, 0x3d, 0x95, 0x05, 0x55, 0x88, 0x6e, 0xed, 0xa9, 0x3c, 0x28, 0x3a, 0x92, 0xeb, 0xb8, 0xd2,
-	0xf7, 0x74, 0x82, 0x54, 0x58, 0xc4, 0xde, 0xd8, 0xc1, 0xc3, 0xf1, 0xc0, 0x37, 0x2d, 0x41, 0x4f,
-	0x05, 0xab, 0x34, 0xa9, 0x34, 0x90, 0x6f, 0xc3, 0x32, 0x29, 0xbe, 0x00, 0x65, 0xd7, 0x39, 0x27,
-	0x43, 0x6c, 0x31, 0xc9, 0x49, 0xd1, 0xf1, 0x1d, 0x1a, 0xbb, 0xbe, 0x08, 0x68, 0x65, 0x38, 0xe7,
-	0x94, 0x33, 0xb5, 0x18, 0xa3, 0x34, 0x61, 0x4a, 0xeb, 0xc1, 0x94, 0xd6, 0x8b, 0x34, 0x40, 0x35,
-	0xa1, 0x01, 0xf8, 0x87, 0x09, 0x1d, 0xba, 0x4c, 0x92, 0xff, 0xe2, 0x65, 0xd5, 0x86, 0x72, 0x1e,
-	0x6b, 0xda, 0xc6, 0xef, 0x67, 0x60, 0xf9, 0x70, 0xe2, 0xf7, 0x87, 0x66, 0xa0, 0x6c, 0xfe, 0x29,
-	0x1b, 0x2d, 0x73, 0xb9, 0x8d, 0x96, 0xbd, 0xdc, 0x46, 0xcb, 0xcd, 0xda, 0x68, 0xef, 0x41, 0x51,
-	0xed, 0x4c, 0x17, 0x9c, 0xc2, 0xa6, 0x1a, 0xac, 0x14, 0x8c, 0xa1, 0x29, 0x1a, 0xff, 0x38, 0x03,
-	0x35, 0x2d, 0x80, 0x7a, 0xf3, 0xdf, 0x89, 0x6c, 0x51, 0x95, 0x6b, 0xf3, 0xfa, 0xa5, 0xdc, 0x92,
-	0xa4, 0x53, 0xb6, 0x69, 0xd3, 0xf9, 0x89, 0x8d, 0xa7, 0xbb, 0xf0, 0xd2, 0x5c, 0xe3, 0xa9, 0xa5,
-	0x96, 0x5a, 0xcb, 0x71, 0x3c, 0x9d, 0x06, 0x9a, 0x6b, 0xfc, 0x97, 0x0c, 0xb0, 0x70, 0xd8, 0xc3,
-	0x3d, 0x81, 0xbf, 0x4f, 0x69, 0xb6, 0xf8, 0x53, 0xbb, 0x79, 0x5f, 0x5c, 0xa0, 0x37, 0x46, 0x48,
-	0xc3, 0x77, 0x61, 0x79, 0x9c, 0x98, 0x49, 0xad, 0x0a, 0xef, 0x5c, 0xca, 0x23, 0x81, 0x6f, 0xa4,
-	0xa8, 0xf9, 0x01, 0x9d, 0xcf, 0xc7, 0xe3, 0x75, 0xc1, 0x75, 0xf4, 0x8b, 0x07, 0xd8, 0x48, 0xd3,
-	0x37, 0x7e, 0x35, 0x03, 0xd5, 0x4d, 0xd3, 0x97, 0x3f, 0xa5, 0xde, 0x92, 0x6a, 0xd0, 0x4b, 0x37,
-	0x1b, 0xaa, 0x06, 0xbd, 0x24, 0x2f, 0x78, 0xa4, 0xba, 0xf1, 0xbf, 0x33, 0xf4, 0xdc, 0xae, 0xe4,
-	0x9b, 0x50, 0xd6, 0x7c, 0xc2, 0x6c, 0xaf, 0x97, 0x2f, 0xab, 0x3c, 0xd1, 0x6c, 0x23, 0x22, 0xfc,
-	0xfc, 0x7b, 0x0e, 0xdf, 0x07, 0x46, 0x3f, 0xf6, 0xc5, 0x99, 0xd4, 0xbb, 0x9a, 0x1e, 0xde, 0x45,
-	0x98, 0xcc, 0xd0, 0xa2, 0xa1, 0xe3, 0xc6, 0x45, 0xba, 0x5d, 0xa8, 0x9c, 0xa7, 0x69, 0x70, 0xe3,
-	0xdb, 0x59, 0xba, 0x2a, 0x6f, 0xf2, 0x9d, 0x99, 0x11, 0x78, 0x75, 0x11, 0x41, 0xb1, 0x66, 0x87,
-	0xa1, 0x0d, 0xd5, 0x44, 0x2d, 0x7a, 0x30, 0x2e, 0x9d, 0x4b, 0x8d, 0x6a, 0x24, 0xe9, 0xd4, 0x6d,
-	0x3b, 0x73, 0x74, 0xf0, 0xd4, 0x15, 0x7e, 0x67, 0x2b, 0xdc, 0x2c, 0x12, 0x20, 0xfe, 0x10, 0x56,
-	0xb5, 0x7f, 0x76, 0xe8, 0x7b, 0xa7, 0xb6, 0x25, 0x7c, 0xad, 0x42, 0xbe, 0x7c, 0x69, 0xcb, 0xd3,
-	0x24, 0xc6, 0x34, 0x8f, 0xa6, 0x05, 0xab, 0x53, 0x38, 0xe9, 0x2b, 0x32, 0x91, 0x0b, 0x03, 0x50,
-	0x8c, 0x9c, 0x97, 0x35, 0xa8, 0x6d, 0xd8, 0x8e, 0x63, 0xbb, 0x83, 0x43, 0xcf, 0x97, 0xa6, 0xa3,
-	0x1e, 0x08, 0x69, 0x8d, 0xc7, 0x5d, 0xe9, 0xf9, 0x42, 0x07, 0xe7, 0xc9, 0x79, 0x39, 0x74, 0xcc,
-	0x73, 0x56, 0x68, 0x7e, 0x08, 0x45, 0xa5, 0xcc, 0xe8, 0x21, 0xcb, 0xc8, 0x0a, 0x53, 0x4f, 0x02,
-	0x28, 0xfd, 0xaf, 0xaa, 0x50, 0x22, 0xcc, 0xb2, 0x74, 0xd3, 0x66, 0xe8, 0x0b, 0x6d, 0x5a, 0xe5,
-	0x9a, 0x3b, 0x50, 0x54, 0x09, 0x59, 0x73, 0x52, 0x29, 0x9f, 0x2f, 0xa7, 0xfa, 0xcf, 0x64, 0x00,
-	0xb6, 0xd4, 0x6d, 0x4a, 0x5c, 0xe9, 0x8b, 0x6c, 0xe5, 0xea, 0xfd, 0x52, 0x92, 0xac, 0x5c, 0xf4,
-	0x7e, 0x29, 0x16, 0x71, 0x1d, 0x9a, 0xe1, 0xfd, 0x09, 0xb5, 0x81, 0x47, 0x65, 0xb5, 0x1b, 0x6c,
-	0x7a, 0xae, 0x2b, 0xfa, 0xb8, 0x97, 0x44, 0x1e, 0x7b, 0x04, 0x6a, 0xfe, 0x56, 0x16, 0x2a, 0x9b,
-	0x43, 0x53, 0xaa, 0xe7, 0x3e, 0x3f, 0x84, 0xf2, 0x48, 0x04, 0x81, 0x39, 0xd0, 0xa6, 0xd7, 0xec,
-	0x76, 0x16, 0xe1, 0xae, 0x3f, 0x74, 0x7d, 0x61, 0x5a, 0xea, 0x8d, 0xd3, 0x88, 0x4a, 0x71, 0x70,
-	0x65, 0x74, 0x40, 0xf4, 0x1c, 0x1c, 0xdc, 0xe8, 0x1f, 0x92, 0x38, 0x66, 0xa0, 0x50, 0xa2, 0xc3,
-	0xdf, 0x24, 0x88, 0xb6, 0x62, 0x3f, 0x94, 0xbe, 0x9c, 0xa1, 0x0a, 0x8d, 0x3d, 0xa8, 0x26, 0x18,
-	0xa2, 0x0d, 0xe2, 0x39, 0x96, 0x08, 0xd4, 0x23, 0x24, 0xf1, 0x73, 0xf1, 0x29, 0x20, 0x25, 0x26,
-	0xe3, 0x9a, 0x16, 0xbe, 0xce, 0x30, 0x0b, 0x8b, 0xcd, 0x7f, 0x56, 0x86, 0x2a, 0x36, 0x75, 0x4f,
-	0xf5, 0x6c, 0x66, 0x92, 0x12, 0x49, 0xc1, 0xd9, 0x54, 0x52, 0x70, 0x32, 0xd9, 0x39, 0x97, 0x4e,
-	0x76, 0x4e, 0xdd, 0xac, 0xcc, 0x4f, 0xdf, 0xac, 0xbc, 0x05, 0x30, 0xf2, 0x2c, 0x72, 0x8b, 0x5b,
-	0x2a, 0x93, 0x28, 0x67, 0x24, 0x20, 0x14, 0xf4, 0xd6, 0x83, 0x52, 0xd5, 0x41, 0x6f, 0x3d, 0x20,
-	0x94, 0x75, 0x3e, 0x76, 0xce, 0x7b, 0x9e, 0x6e, 0x6d, 0xc7, 0x8a, 0x5f, 0x8c, 0x4a, 0xc3, 0xf9,
-	0x26, 0x94, 0xf4, 0x64, 0xe9, 0x7c, 0xa9, 0x57, 0xe6, 0xcc, 0x8f, 0x46, 0x5f, 0xd7, 0x7f, 0xf5,
-	0x73, 0x0b, 0x46, 0x48, 0xc9, 0xef, 0x43, 0xd5, 0x94, 0xd2, 0xec, 0x0f, 0x47, 0xda, 0x8d, 0xcd,
-	0xcd, 0x49, 0x9d, 0x4c, 0x32, 0x6a, 0x45, 0xd8, 0x46, 0x92, 0x92, 0x6f, 0x40, 0xc5, 0x17, 0x66,
-	0x2a, 0x7b, 0xf3, 0xc5, 0x4b, 0xd8, 0x18, 0x21, 0xae, 0x11, 0x93, 0x45, 0xff, 0x39, 0x01, 0x12,
-	0xff, 0x39, 0x81, 0xae, 0xd1, 0x92, 0x40, 0xa1, 0x47, 0xa0, 0x5f, 0x94, 0x4c, 0x82, 0x70, 0xb4,
-	0x87, 0x66, 0xa0, 0xdf, 0x62, 0xd6, 0xd7, 0x7d, 0x12, 0x10, 0xca, 0x96, 0x3c, 0x77, 0xfb, 0x3a,
-	0xd1, 0xa9, 0x6c, 0xe8, 0x52, 0xe3, 0x77, 0x33, 0xb0, 0x92, 0x1e, 0x96, 0x3f, 0x8a, 0x37, 0xc2,
-	0xbf, 0x1e, 0xbf, 0x11, 0xfe, 0x39, 0xde, 0xdb, 0xfe, 0x9d, 0x0c, 0x40, 0x3c, 0xe2, 0xd8, 0x15,
-	0xf5, 0x96, 0x71, 0x18, 0x45, 0x56, 0x25, 0xbe, 0x93, 0x7a, 0xb6, 0xee, 0xad, 0x85, 0xa6, 0x2f,
-	0xf1, 0x33, 0x71, 0x95, 0xf4, 0x35, 0x58, 0x49, 0xc3, 0xe9, 0x0a, 0x6e, 0x67, 0xb7, 0xad, 0xce,
-	0x6d, 0x3b, 0x7b, 0xad, 0xfb, 0x6d, 0xfd, 0x5c, 0x46, 0x67, 0xff, 0x01, 0xcb, 0x36, 0xfe, 0x6b,
-	0x06, 0x2a, 0xd1, 0x64, 0xf2, 0x8f, 0x93, 0x52, 0xa0, 0x36, 0xc3, 0x37, 0x17, 0x91, 0x82, 0xf8,
-	0x57, 0xdb, 0x95, 0xfe, 0x79, 0x42, 0x28, 0x1a, 0x1e, 0xac, 0xa4, 0x3f, 0xce, 0x51, 0xda, 0xf7,
-	0xd3, 0x4a, 0xfb, 0x8d, 0x85, 0xaa, 0x0c, 0x23, 0xfd, 0xbb, 0x76, 0x20, 0xb5, 0x3e, 0x7f, 0x2f,
-	0xfb, 0x6e, 0xa6, 0x71, 0x1b, 0x96, 0x93, 0x9f, 0x66, 0x9f, 0xd2, 0xb9, 0xfb, 0x3f, 0x72, 0xb0,
-	0x92, 0xce, 0x9f, 0xa6, 0x17, 0x38, 0x54, 0xee, 0xfe, 0x81, 0x63, 0x25, 0x6e, 0xdf, 0x32, 0xbe,
-	0x0a, 0x55, 0x7d, 0x74, 0x40, 0x80, 0x35, 0x3a, 0x19, 0xf6, 0x46, 0x82, 0xdd, 0x4e, 0xfe, 0x1f,
-	0x84, 0xd7, 0x71, 0xc3, 0x52, 0x8f, 0xa0, 0xb0, 0x31, 0xaf, 0xe8, 0x17, 0xa1, 0x7f, 0x35, 0xcb,
-	0x6b, 0x89, 0x3b, 0xa0, 0xdf, 0xc9, 0xf2, 0xab, 0xb0, 0xba, 0x31, 0x71, 0x2d, 0x47, 0x58, 0x11,
-	0xf4, 0x77, 0x93, 0xd0, 0xe8, 0x12, 0xe7, 0xaf, 0xe2, 0xc6, 0x59, 0xe9, 0x4e, 0x8e, 0xf4, 0xe5,
-	0xa8, 0x3f, 0x9e, 0xe7, 0xd7, 0x61, 0x4d, 0x63, 0xc5, 0x77, 0x9e, 0xd8, 0x9f, 0xc8, 0xf3, 0x2b,
-	0xb0, 0xd2, 0x52, 0xe3, 0xa5, 0x1b, 0xca, 0xfe, 0x64, 0x1e, 0x9b, 0x40, 0xcf, 0x7a, 0xfd, 0x29,
-	0xe2, 0x13, 0x3d, 0x50, 0xc0, 0x7e, 0x2d, 0xcf, 0x57, 0x01, 0xba, 0xbd, 0xa8, 0xa2, 0xdf, 0xc8,
-	0xf3, 0x2a, 0x14, 0xbb, 0x3d, 0xe2, 0xf6, 0xed, 0x3c, 0xbf, 0x06, 0x2c, 0xfe, 0xaa, 0x6f, 0x91,
-	0xfd, 0x39, 0xd5, 0x98, 0xe8, 0x5a, 0xd8, 0x9f, 0xcf, 0x63, 0xbf, 0xc2, 0x51, 0x66, 0x7f, 0x21,
-	0xcf, 0x19, 0x54, 0x13, 0xf9, 0x06, 0xec, 0xb7, 0xf2, 0x9c, 0x43, 0x6d, 0x2f, 0x75, 0xbd, 0xeb,
-	0xd7, 0xa9, 0xe6, 0xed, 0xe8, 0x8d, 0x05, 0xf6, 0x9b, 0x79, 0x7e, 0x03, 0x78, 0x32, 0xc7, 0x4a,
-	0x7f, 0xf8, 0x8b, 0x44, 0xad, 0xf6, 0xe5, 0x40, 0xc3, 0xfe, 0x52, 0x9e, 0xbf, 0x00, 0x57, 0x51,
-	0x12, 0x14, 0x20, 0x71, 0xed, 0xec, 0x2f, 0xd3, 0xd0, 0x6c, 0xc6, 0xf7, 0xce, 0x34, 0xc9, 0x77,
-	0x88, 0x4d, 0x38, 0xad, 0x0a, 0xf6, 0xbb, 0xf9, 0xbb, 0x7f, 0x40, 0xd9, 0x32, 0xc9, 0x0b, 0x15,
-	0x68, 0xb0, 0x38, 0x9e, 0x3b, 0x90, 0xea, 0x3f, 0x51, 0xd4, 0xa0, 0x12, 0x0c, 0x3d, 0x5f, 0x52,
-	0x91, 0x2c, 0x11, 0x97, 0x1e, 0x1f, 0x53, 0x97, 0x81, 0x95, 0x93, 0xa4, 0xb2, 0x0f, 0xa4, 0x39,
-	0x60, 0xd5, 0xe8, 0xee, 0x5c, 0x3e, 0xba, 0xdf, 0x47, 0x8f, 0xa0, 0x85, 0xef, 0x46, 0xb1, 0x22,
-	0xa2, 0x4e, 0x7c, 0x47, 0xdd, 0xf3, 0x13, 0x23, 0xd3, 0x76, 0xd4, 0x93, 0xf3, 0xe3, 0x21, 0x9a,
-	0x56, 0x15, 0x05, 0xf5, 0xbe, 0x65, 0xab, 0x17, 0x89, 0xf4, 0xf5, 0x15, 0x32, 0x8f, 0xa2, 0x0c,
-	0x6d, 0x26, 0xee, 0xfe, 0x76, 0x06, 0x96, 0xc3, 0xd7, 0xbc, 0xec, 0x81, 0xed, 0xaa, 0x9b, 0x82,
-	0xe1, 0xff, 0xf7, 0xe8, 0x3b, 0xf6, 0x38, 0x7c, 0x2f, 0x7f, 0x15, 0xaa, 0x96, 0x6f, 0x0e, 0x5a,
-	0xae, 0xb5, 0xe5, 0x7b, 0x63, 0xd5, 0x6c, 0x95, 0x4f, 0xa7, 0x6e, 0x28, 0x3e, 0x15, 0x47, 0x88,
-	0x3e, 0x16, 0x3e, 0xcb, 0xd3, 0xd5, 0x98, 0xa1, 0xe9, 0xdb, 0xee, 0xa0, 0x7d, 0x26, 0x85, 0x1b,
-	0xa8, 0x9b, 0x8a, 0x55, 0x28, 0x4d, 0x02, 0xd1, 0x37, 0x03, 0xc1, 0x8a, 0x58, 0x38, 0x9a, 0xd8,
-	0x8e, 0xb4, 0x5d, 0xf5, 0x4c, 0x7d, 0x74, 0x15, 0xb1, 0x8c, 0x3d, 0x33, 0xc7, 0x36, 0xab, 0xdc,
-	0xfd, 0xfb, 0x19, 0xa8, 0x92, 0x80, 0xc4, 0x19, 0x23, 0xb1, 0xd3, 0x58, 0x85, 0xd2, 0x6e, 0xf4,
-	0x5e, 0x79, 0x11, 0xb2, 0x07, 0x27, 0x2a, 0x63, 0x44, 0x0b, 0x88, 0x7a, 0x5d, 0x47, 0x3d, 0x5d,
-	0x8e, 0xd3, 0x7c, 0xcd, 0x10, 0x23, 0x4f, 0x8a, 0xc7, 0xa6, 0x2d, 0x93, 0xaf, 0x02, 0x14, 0xd0,
-	0xd6, 0x54, 0x9f, 0xc2, 0x67, 0x00, 0x8a, 0xe4, 0x7c, 0x62, 0xb5, 0x21, 0xa4, 0x84, 0xbd, 0x27,
-	0x88, 0xf6, 0x46, 0xcb, 0x11, 0xca, 0x47, 0x9e, 0xed, 0x62, 0x6d, 0xf4, 0x14, 0x54, 0x57, 0x05,
-	0xa9, 0x46, 0xde, 0x29, 0x82, 0xe0, 0xee, 0x3e, 0x5c, 0x9f, 0x9f, 0x30, 0xa3, 0x1e, 0x89, 0xa2,
-	0x7f, 0x92, 0x43, 0x46, 0xb0, 0x0a, 0x5a, 0xa9, 0x6b, 0xf9, 0x64, 0x8f, 0x2b, 0x1b, 0x78, 0xdf,
-	0x4b, 0xd0, 0xb0, 0xdc, 0xdd, 0x77, 0x00, 0xe2, 0x33, 0x5f, 0xf5, 0x02, 0x30, 0xc9, 0x10, 0xa9,
-	0xe1, 0xfb, 0x13, 0x11, 0xe8, 0x28, 0xfc, 0x63, 0x5b, 0x0e, 0xbd, 0x49, 0x98, 0x4c, 0xc6, 0xb2,
-	0x77, 0xfb, 0xa9, 0xe4, 0xa8, 0x78, 0x34, 0xc3, 0xd6, 0x2f, 0x25, 0x1e, 0x4f, 0xc8, 0xa8, 0xb4,
-	0x1b, 0xfa, 0x07, 0x89, 0xea, 0x99, 0x3e, 0x9d, 0x94, 0x64, 0x29, 0x2b, 0x3c, 0xea, 0x5f, 0x5e,
-	0xbd, 0x57, 0xec, 0xf6, 0x85, 0x23, 0x2c, 0x56, 0xb8, 0xfb, 0x2e, 0xac, 0xea, 0x31, 0xea, 0x8b,
-	0x20, 0x08, 0x1f, 0x1f, 0x38, 0x54, 0x31, 0x2e, 0x9d, 0x7a, 0x23, 0xfc, 0xc0, 0x73, 0xe9, 0x19,
-	0x44, 0x34, 0xfd, 0x29, 0x16, 0xc7, 0xb2, 0x77, 0xdb, 0x7a, 0x74, 0xf5, 0xb9, 0x7a, 0xea, 0x15,
-	0x7c, 0x74, 0xa7, 0x34, 0xba, 0xf4, 0x85, 0xa9, 0xdf, 0x4f, 0xc2, 0x85, 0xa9, 0x9a, 0x73, 0xe0,
-	0x8a, 0x9e, 0x77, 0xe0, 0x0a, 0x96, 0xbf, 0xbb, 0x09, 0x15, 0x7a, 0xd3, 0xe0, 0x81, 0xed, 0x5a,
-	0x38, 0x22, 0x1b, 0xfa, 0x7e, 0x2d, 0x3d, 0x5b, 0x7b, 0x4a, 0xe3, 0x5b, 0x56, 0xff, 0x2a, 0x84,
-	0x65, 0xf9, 0x75, 0xe0, 0xad, 0x89, 0xf4, 0x46, 0x26, 0xbd, 0x5f, 0xe4, 0x9c, 0xab, 0x7f, 0x2b,
-	0x93, 0xbb, 0xfb, 0x0d, 0xe0, 0xea, 0x80, 0xd6, 0x12, 0x67, 0xb6, 0x3b, 0x88, 0x5e, 0x54, 0x03,
-	0x7a, 0x4b, 0xd1, 0x12, 0x67, 0xa1, 0x5b, 0x11, 0x16, 0xc2, 0x17, 0x1d, 0xb7, 0xbd, 0x89, 0x8b,
-	0x9d, 0x79, 0x04, 0x57, 0x95, 0xcc, 0x62, 0xef, 0xe8, 0x71, 0x9c, 0x0b, 0xe3, 0x1e, 0xea, 0x41,
-	0x0a, 0x39, 0x09, 0x22, 0x5c, 0x96, 0xc1, 0x86, 0x45, 0x07, 0x2e, 0x31, 0x3c, 0x7b, 0xb7, 0x09,
-	0x57, 0xe6, 0x9c, 0x7a, 0xd1, 0x7e, 0xa1, 0x22, 0x20, 0x6c, 0xe9, 0xee, 0x07, 0xb0, 0xa6, 0x34,
-	0xdc, 0xbe, 0x7a, 0x9c, 0x24, 0x1c, 0xce, 0xc7, 0x9d, 0xed, 0x8e, 0x9a, 0x81, 0xcd, 0xf6, 0xee,
-	0xee, 0xc3, 0xdd, 0x96, 0xc1, 0x32, 0x24, 0x60, 0x07, 0xbd, 0x27, 0x9b, 0x07, 0xfb, 0xfb, 0xed,
-	0xcd, 0x5e, 0x7b, 0x8b, 0x65, 0xef, 0x5a, 0x00, 0xdd, 0x73, 0xb7, 0xaf, 0x5b, 0x7c, 0x15, 0x58,
-	0x5c, 0xea, 0x92, 0x81, 0xa4, 0x1e, 0x20, 0x4e, 0x43, 0xd5, 0x0a, 0xc4, 0xbe, 0x44, 0x60, 0xb5,
-	0xec, 0xb2, 0x69, 0x0e, 0x1f, 0x4f, 0xc4, 0x84, 0x86, 0x38, 0x80, 0x0a, 0x42, 0x09, 0x89, 0x86,
-	0x25, 0x2c, 0xec, 0x4f, 0xe8, 0x69, 0xeb, 0xdb, 0x70, 0x33, 0x02, 0x75, 0xdc, 0xbe, 0x37, 0x1a,
-	0x9b, 0xd2, 0x3e, 0x72, 0xc4, 0x23, 0xe1, 0x07, 0xea, 0xd1, 0x8e, 0x17, 0xe0, 0x5a, 0x4c, 0xa4,
-	0xba, 0xaa, 0xff, 0x49, 0x01, 0x0d, 0x5f, 0xf8, 0xe9, 0xe0, 0x14, 0x29, 0x3e, 0x13, 0x16, 0xcb,
-	0x6f, 0xdc, 0xfd, 0x27, 0x3f, 0xb8, 0x95, 0xf9, 0xde, 0x0f, 0x6e, 0x65, 0xfe, 0xed, 0x0f, 0x6e,
-	0x65, 0xbe, 0xfd, 0xc3, 0x5b, 0x4b, 0xdf, 0xfb, 0xe1, 0xad, 0xa5, 0xef, 0xff, 0xf0, 0xd6, 0xd2,
-	0xa7, 0x6c, 0xfa, 0xdf, 0xba, 0x1e, 0x15, 0xc9, 0xb1, 0x7b, 0xf3, 0xff, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0xa0, 0x60, 0xd5, 0x1b, 0xf1, 0x75, 0x00, 0x00,
+	// 10338 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0xbd, 0x5d, 0x6c, 0x24, 0xd7,
+	0x95, 0x18, 0xcc, 0xfe, 0xef, 0x3e, 0x64, 0x93, 0x97, 0xa5, 0xd1, 0x4c, 0xab, 0x3d, 0x3b, 0xdf,
+	0x6c, 0x5b, 0x96, 0x64, 0x5a, 0xa6, 0xa4, 0x91, 0x64, 0xc9, 0x5a, 0x4b, 0x56, 0x93, 0x6c, 0x0e,
+	0x5b, 0x43, 0xb2, 0xa9, 0xea, 0x1e, 0x8e, 0x25, 0xec, 0x2e, 0xb7, 0xd8, 0x75, 0xd9, 0x5d, 0x9e,
+	0xea, 0xaa, 0xde, 0xaa, 0x6a, 0x0e, 0x69, 0x7c, 0x59, 0x6c, 0xfe, 0x76, 0xb3, 0x40, 0x10, 0x38,
+	0x41, 0x76, 0x93, 0x60, 0x11, 0xac, 0xfd, 0x10, 0x64, 0xb1, 0x31, 0x90, 0xa7, 0x4d, 0xb2, 0xf9,
+	0x79, 0x48, 0xf2, 0x90, 0x00, 0x79, 0x88, 0x83, 0xbc, 0x38, 0xc8, 0x43, 0x02, 0x1b, 0x08, 0x10,
+	0xe4, 0x67, 0xf3, 0xf3, 0x62, 0x20, 0x41, 0x12, 0x9c, 0x73, 0x6e, 0xfd, 0x75, 0x37, 0x39, 0x3d,
+	0xb2, 0x37, 0xc8, 0x13, 0xfb, 0x9e, 0x3a, 0xe7, 0xd4, 0xbd, 0xb7, 0xce, 0x3d, 0xf7, 0xfc, 0xdd,
+	0x4b, 0x78, 0x71, 0xfc, 0x78, 0xf0, 0x9a, 0x6d, 0x9d, 0xbe, 0x36, 0x3e, 0x7d, 0x6d, 0xe4, 0x9a,
+	0xd2, 0x7e, 0x6d, 0xec, 0xb9, 0x81, 0xeb, 0x73, 0xc3, 0xdf, 0xa4, 0x96, 0x56, 0x35, 0x9c, 0xcb,
+	0xe0, 0x72, 0x2c, 0x37, 0x09, 0x5a, 0xbf, 0x3d, 0x70, 0xdd, 0x81, 0x2d, 0x19, 0xf5, 0x74, 0x72,
+	0xf6, 0x9a, 0x1f, 0x78, 0x93, 0x7e, 0xc0, 0xc8, 0x8d, 0xef, 0xe7, 0xe1, 0x66, 0x77, 0x64, 0x78,
+	0xc1, 0x96, 0xed, 0xf6, 0x1f, 0x77, 0x1d, 0x63, 0xec, 0x0f, 0xdd, 0x60, 0xcb, 0xf0, 0xa5, 0xf6,
+	0x2a, 0x14, 0x4f, 0x11, 0xe8, 0xd7, 0x32, 0x77, 0x73, 0xaf, 0x2c, 0xdf, 0xbb, 0xb1, 0x99, 0x62,
+	0xbc, 0x49, 0x14, 0xba, 0xc2, 0xd1, 0xde, 0x80, 0x92, 0x29, 0x03, 0xc3, 0xb2, 0xfd, 0x5a, 0xf6,
+	0x6e, 0xe6, 0x95, 0xe5, 0x7b, 0xb7, 0x36, 0xf9, 0xc5, 0x9b, 0xe1, 0x8b, 0x37, 0xbb, 0xf4, 0x62,
+	0x3d, 0xc4, 0xd3, 0xde, 0x81, 0xf2, 0x99, 0x65, 0xcb, 0x07, 0xf2, 0xd2, 0xaf, 0xe5, 0xae, 0xa5,
+	0xd9, 0xca, 0xd6, 0x32, 0x7a, 0x84, 0xac, 0x6d, 0xc3, 0xaa, 0xbc, 0x08, 0x3c, 0x43, 0x97, 0xb6,
+	0x11, 0x58, 0xae, 0xe3, 0xd7, 0xf2, 0xd4, 0xc3, 0x5b, 0x53, 0x3d, 0x0c, 0x9f, 0x13, 0xf9, 0x14,
+	0x89, 0x76, 0x17, 0x96, 0xdd, 0xd3, 0x6f, 0xca, 0x7e, 0xd0, 0xbb, 0x1c, 0x4b, 0xbf, 0x56, 0xb8,
+	0x9b, 0x7b, 0xa5, 0xa2, 0x27, 0x41, 0xda, 0x57, 0x61, 0xb9, 0xef, 0xda, 0xb6, 0xec, 0xf3, 0x3b,
+	0x8a, 0xd7, 0x0f, 0x2b, 0x89, 0xab, 0xbd, 0x05, 0xcf, 0x7b, 0x72, 0xe4, 0x9e, 0x4b, 0x73, 0x3b,
+	0x82, 0xd2, 0x38, 0xcb, 0xf4, 0x9a, 0xf9, 0x0f, 0xb5, 0x26, 0x54, 0x3d, 0xd5, 0xbf, 0x7d, 0xcb,
+	0x79, 0xec, 0xd7, 0x4a, 0x34, 0xac, 0xcf, 0x5d, 0x31, 0x2c, 0xc4, 0xd1, 0xd3, 0x14, 0x9a, 0x80,
+	0xdc, 0x63, 0x79, 0x59, 0xab, 0xdc, 0xcd, 0xbc, 0x52, 0xd1, 0xf1, 0xa7, 0xf6, 0x1e, 0xd4, 0x5c,
+	0xcf, 0x1a, 0x58, 0x8e, 0x61, 0x6f, 0x7b, 0xd2, 0x08, 0xa4, 0xd9, 0xb3, 0x46, 0xd2, 0x0f, 0x8c,
+	0xd1, 0xb8, 0x06, 0x77, 0x33, 0xaf, 0xe4, 0xf4, 0x2b, 0x9f, 0x6b, 0x6f, 0xf2, 0x17, 0x6a, 0x3b,
+	0x67, 0x6e, 0x6d, 0x59, 0x0d, 0x3f, 0xdd, 0x97, 0x5d, 0xf5, 0x58, 0x8f, 0x10, 0x1b, 0x3f, 0xce,
+	0x42, 0xb1, 0x2b, 0x0d, 0xaf, 0x3f, 0xac, 0xff, 0x7a, 0x06, 0x8a, 0xba, 0xf4, 0x27, 0x76, 0xa0,
+	0xd5, 0xa1, 0xcc, 0x73, 0xdb, 0x36, 0x6b, 0x19, 0xea, 0x5d, 0xd4, 0xfe, 0x2c, 0xb2, 0xb3, 0x09,
+	0xf9, 0x91, 0x0c, 0x8c, 0x5a, 0x8e, 0x66, 0xa8, 0x3e, 0xd5, 0x2b, 0x7e, 0xfd, 0xe6, 0x81, 0x0c,
+	0x0c, 0x9d, 0xf0, 0xea, 0x3f, 0xca, 0x40, 0x1e, 0x9b, 0xda, 0x6d, 0xa8, 0x0c, 0xad, 0xc1, 0xd0,
+	0xb6, 0x06, 0xc3, 0x40, 0x75, 0x24, 0x06, 0x68, 0x1f, 0xc0, 0x5a, 0xd4, 0xd0, 0x0d, 0x67, 0x20,
+	0xb1, 0x47, 0xf3, 0x84, 0x9f, 0x1e, 0xea, 0xd3, 0xc8, 0x5a, 0x0d, 0x4a, 0xb4, 0x1e, 0xda, 0x26,
+	0x49, 0x74, 0x45, 0x0f, 0x9b, 0x28, 0x6e, 0xe1, 0x97, 0x7a, 0x20, 0x2f, 0x6b, 0x79, 0x7a, 0x9a,
+	0x04, 0x69, 0x4d, 0x58, 0x0b, 0x9b, 0x3b, 0x6a, 0x36, 0x0a, 0xd7, 0xcf, 0xc6, 0x34, 0x7e, 0xe3,
+	0xdb, 0x87, 0x50, 0xa0, 0x65, 0xa9, 0xad, 0x42, 0xd6, 0x0a, 0x27, 0x3a, 0x6b, 0x99, 0xda, 0x6b,
+	0x50, 0x3c, 0xb3, 0xa4, 0x6d, 0x3e, 0x75, 0x86, 0x15, 0x9a, 0xd6, 0x82, 0x15, 0x4f, 0xfa, 0x81,
+	0x67, 0x29, 0xe9, 0xe7, 0x05, 0xfa, 0xb3, 0xf3, 0x74, 0xc0, 0xa6, 0x9e, 0x40, 0xd4, 0x53, 0x64,
+	0x38, 0xec, 0xfe, 0xd0, 0xb2, 0x4d, 0x4f, 0x3a, 0x6d, 0x93, 0xd7, 0x69, 0x45, 0x4f, 0x82, 0xb4,
+	0x57, 0x60, 0xed, 0xd4, 0xe8, 0x3f, 0x1e, 0x78, 0xee, 0xc4, 0xc1, 0x05, 0xe1, 0x7a, 0x34, 0xec,
+	0x8a, 0x3e, 0x0d, 0xd6, 0x5e, 0x87, 0x82, 0x61, 0x5b, 0x03, 0x87, 0x56, 0xe2, 0xea, 0xbd, 0xfa,
+	0xdc, 0xbe, 0x34, 0x11, 0x43, 0x67, 0x44, 0x6d, 0x0f, 0xaa, 0xe7, 0xd2, 0x0b, 0xac, 0xbe, 0x61,
+	0x13, 0xbc, 0x56, 0x22, 0xca, 0xc6, 0x5c, 0xca, 0xe3, 0x24, 0xa6, 0x9e, 0x26, 0xd4, 0xda, 0x00,
+	0x3e, 0xaa, 0x49, 0xfa, 0x9c, 0x6a, 0x2d, 0xbc, 0x3c, 0x97, 0xcd, 0xb6, 0xeb, 0x04, 0xd2, 0x09,
+	0x36, 0xbb, 0x11, 0xfa, 0xde, 0x92, 0x9e, 0x20, 0xd6, 0xde, 0x81, 0x7c, 0x20, 0x2f, 0x82, 0xda,
+	0xea, 0x35, 0x33, 0x1a, 0x32, 0xe9, 0xc9, 0x8b, 0x60, 0x6f, 0x49, 0x27, 0x02, 0x24, 0xc4, 0x45,
+	0x56, 0x5b, 0x5b, 0x80, 0x10, 0xd7, 0x25, 0x12, 0x22, 0x81, 0xf6, 0x3e, 0x14, 0x6d, 0xe3, 0xd2,
+	0x9d, 0x04, 0x35, 0x41, 0xa4, 0x9f, 0xbf, 0x96, 0x74, 0x9f, 0x50, 0xf7, 0x96, 0x74, 0x45, 0xa4,
+	0xbd, 0x05, 0x39, 0xd3, 0x3a, 0xaf, 0xad, 0x13, 0xed, 0xdd, 0x6b, 0x69, 0x77, 0xac, 0xf3, 0xbd,
+	0x25, 0x1d, 0xd1, 0xb5, 0x6d, 0x28, 0x9f, 0xba, 0xee, 0xe3, 0x91, 0xe1, 0x3d, 0xae, 0x69, 0x44,
+	0xfa, 0x85, 0x6b, 0x49, 0xb7, 0x14, 0xf2, 0xde, 0x92, 0x1e, 0x11, 0xe2, 0x90, 0xad, 0xbe, 0xeb,
+	0xd4, 0x9e, 0x5b, 0x60, 0xc8, 0xed, 0xbe, 0xeb, 0xe0, 0x90, 0x91, 0x00, 0x09, 0x6d, 0xcb, 0x79,
+	0x5c, 0xbb, 0xb1, 0x00, 0x21, 0x6a, 0x4e, 0x24, 0x44, 0x02, 0xec, 0xb6, 0x69, 0x04, 0xc6, 0xb9,
+	0x25, 0x9f, 0xd4, 0x9e, 0x5f, 0xa0, 0xdb, 0x3b, 0x0a, 0x19, 0xbb, 0x1d, 0x12, 0x22, 0x93, 0x70,
+	0x69, 0xd6, 0x6e, 0x2e, 0xc0, 0x24, 0xd4, 0xe8, 0xc8, 0x24, 0x24, 0xd4, 0x7e, 0x11, 0xd6, 0xcf,
+	0xa4, 0x11, 0x4c, 0x3c, 0x69, 0xc6, 0x1b, 0xdd, 0x2d, 0xe2, 0xb6, 0x79, 0xfd, 0xb7, 0x9f, 0xa6,
+	0xda, 0x5b, 0xd2, 0x67, 0x59, 0x69, 0xef, 0x41, 0xc1, 0x36, 0x02, 0x79, 0x51, 0xab, 0x11, 0xcf,
+	0xc6, 0x53, 0x84, 0x22, 0x90, 0x17, 0x7b, 0x4b, 0x3a, 0x93, 0x68, 0xdf, 0x80, 0xb5, 0xc0, 0x38,
+	0xb5, 0x65, 0xe7, 0x4c, 0x21, 0xf8, 0xb5, 0x17, 0x88, 0xcb, 0xab, 0xd7, 0x8b, 0x73, 0x9a, 0x66,
+	0x6f, 0x49, 0x9f, 0x66, 0x83, 0xbd, 0x22, 0x50, 0xad, 0xbe, 0x40, 0xaf, 0x88, 0x1f, 0xf6, 0x8a,
+	0x48, 0xb4, 0x7d, 0x58, 0xa6, 0x1f, 0xdb, 0xae, 0x3d, 0x19, 0x39, 0xb5, 0xcf, 0x11, 0x87, 0x57,
+	0x9e, 0xce, 0x81, 0xf1, 0xf7, 0x96, 0xf4, 0x24, 0x39, 0x7e, 0x44, 0x6a, 0xea, 0xee, 0x93, 0xda,
+	0xed, 0x05, 0x3e, 0x62, 0x4f, 0x21, 0xe3, 0x47, 0x0c, 0x09, 0x71, 0xe9, 0x3d, 0xb1, 0xcc, 0x81,
+	0x0c, 0x6a, 0x3f, 0xb3, 0xc0, 0xd2, 0x7b, 0x44, 0xa8, 0xb8, 0xf4, 0x98, 0x08, 0xc5, 0xb8, 0x3f,
+	0x34, 0x82, 0xda, 0x9d, 0x05, 0xc4, 0x78, 0x7b, 0x68, 0x90, 0xae, 0x40, 0x82, 0xfa, 0xb7, 0x60,
+	0x25, 0xa9, 0x95, 0x35, 0x0d, 0xf2, 0x9e, 0x34, 0x78, 0x47, 0x28, 0xeb, 0xf4, 0x1b, 0x61, 0xd2,
+	0xb4, 0x02, 0xda, 0x11, 0xca, 0x3a, 0xfd, 0xd6, 0x6e, 0x42, 0x91, 0x6d, 0x13, 0x52, 0xf8, 0x65,
+	0x5d, 0xb5, 0x10, 0xd7, 0xf4, 0x8c, 0x01, 0xed, 0x5b, 0x65, 0x9d, 0x7e, 0x23, 0xae, 0xe9, 0xb9,
+	0xe3, 0x8e, 0x43, 0x0a, 0xbb, 0xac, 0xab, 0x56, 0xfd, 0xbf, 0x7f, 0x00, 0x25, 0xd5, 0xa9, 0xfa,
+	0x5f, 0xcd, 0x40, 0x91, 0x15, 0x8a, 0xf6, 0x75, 0x28, 0xf8, 0xc1, 0xa5, 0x2d, 0xa9, 0x0f, 0xab,
+	0xf7, 0xbe, 0xb8, 0x80, 0x12, 0xda, 0xec, 0x22, 0x81, 0xce, 0x74, 0x0d, 0x1d, 0x0a, 0xd4, 0xd6,
+	0x4a, 0x90, 0xd3, 0xdd, 0x27, 0x62, 0x49, 0x03, 0x28, 0xf2, 0xc7, 0x12, 0x19, 0x04, 0xee, 0x58,
+	0xe7, 0x22, 0x8b, 0xc0, 0x3d, 0x69, 0x98, 0xd2, 0x13, 0x39, 0xad, 0x0a, 0x95, 0xf0, 0xb3, 0xf8,
+	0x22, 0xaf, 0x09, 0x58, 0x49, 0x7c, 0x70, 0x5f, 0x14, 0xea, 0xff, 0x2d, 0x0f, 0x79, 0x5c, 0xff,
+	0xda, 0x8b, 0x50, 0x0d, 0x0c, 0x6f, 0x20, 0xd9, 0x10, 0x8e, 0x8c, 0x94, 0x34, 0x50, 0x7b, 0x3f,
+	0x1c, 0x43, 0x96, 0xc6, 0xf0, 0xf2, 0x53, 0xf5, 0x4a, 0x6a, 0x04, 0x89, 0x5d, 0x38, 0xb7, 0xd8,
+	0x2e, 0xbc, 0x0b, 0x65, 0x54, 0x67, 0x5d, 0xeb, 0x5b, 0x92, 0xa6, 0x7e, 0xf5, 0xde, 0xc6, 0xd3,
+	0x5f, 0xd9, 0x56, 0x14, 0x7a, 0x44, 0xab, 0xb5, 0xa1, 0xd2, 0x37, 0x3c, 0x93, 0x3a, 0x43, 0x5f,
+	0x6b, 0xf5, 0xde, 0x97, 0x9e, 0xce, 0x68, 0x3b, 0x24, 0xd1, 0x63, 0x6a, 0xad, 0x03, 0xcb, 0xa6,
+	0xf4, 0xfb, 0x9e, 0x35, 0x26, 0xf5, 0xc6, 0x7b, 0xf1, 0x97, 0x9f, 0xce, 0x6c, 0x27, 0x26, 0xd2,
+	0x93, 0x1c, 0xd0, 0x22, 0xf3, 0x22, 0xfd, 0x56, 0x22, 0x03, 0x21, 0x06, 0x34, 0xde, 0x81, 0x72,
+	0x38, 0x1e, 0x6d, 0x05, 0xca, 0xf8, 0xf7, 0xd0, 0x75, 0xa4, 0x58, 0xc2, 0x6f, 0x8b, 0xad, 0xee,
+	0xc8, 0xb0, 0x6d, 0x91, 0xd1, 0x56, 0x01, 0xb0, 0x79, 0x20, 0x4d, 0x6b, 0x32, 0x12, 0xd9, 0xc6,
+	0xcf, 0x85, 0xd2, 0x52, 0x86, 0xfc, 0x91, 0x31, 0x40, 0x8a, 0x15, 0x28, 0x87, 0xea, 0x5a, 0x64,
+	0x90, 0x7e, 0xc7, 0xf0, 0x87, 0xa7, 0xae, 0xe1, 0x99, 0x22, 0xab, 0x2d, 0x43, 0xa9, 0xe9, 0xf5,
+	0x87, 0xd6, 0xb9, 0x14, 0xb9, 0xc6, 0x6b, 0xb0, 0x9c, 0xe8, 0x2f, 0xb2, 0x50, 0x2f, 0xad, 0x40,
+	0xa1, 0x69, 0x9a, 0xd2, 0x14, 0x19, 0x24, 0x50, 0x03, 0x14, 0xd9, 0xc6, 0x97, 0xa0, 0x12, 0xcd,
+	0x16, 0xa2, 0xe3, 0xc6, 0x2d, 0x96, 0xf0, 0x17, 0x82, 0x45, 0x06, 0xa5, 0xb2, 0xed, 0xd8, 0x96,
+	0x23, 0x45, 0xb6, 0xfe, 0x4b, 0x24, 0xaa, 0xda, 0xd7, 0xd2, 0x0b, 0xe2, 0xa5, 0xa7, 0xed, 0xac,
+	0xe9, 0xd5, 0xf0, 0xb9, 0xc4, 0xf8, 0xf6, 0x2d, 0xea, 0x5c, 0x19, 0xf2, 0x3b, 0x6e, 0xe0, 0x8b,
+	0x4c, 0xfd, 0x3f, 0x64, 0xa1, 0x1c, 0x6e, 0xa8, 0xe8, 0x13, 0x4c, 0x3c, 0x5b, 0x09, 0x34, 0xfe,
+	0xd4, 0x6e, 0x40, 0x21, 0xb0, 0x02, 0x25, 0xc6, 0x15, 0x9d, 0x1b, 0x68, 0xab, 0x25, 0xbf, 0x2c,
+	0x1b, 0xb0, 0xd3, 0x9f, 0xca, 0x1a, 0x19, 0x03, 0xb9, 0x67, 0xf8, 0x43, 0x65, 0xc2, 0xc6, 0x00,
+	0xa4, 0x3f, 0x33, 0xce, 0x51, 0xe6, 0xe8, 0x39, 0x5b, 0x71, 0x49, 0x90, 0xf6, 0x26, 0xe4, 0x71,
+	0x80, 0x4a, 0x68, 0xfe, 0xbf, 0xa9, 0x01, 0xa3, 0x98, 0x1c, 0x79, 0x12, 0x3f, 0xcf, 0x26, 0x7a,
+	0x60, 0x3a, 0x21, 0x6b, 0x2f, 0xc1, 0x2a, 0x2f, 0xc2, 0x4e, 0xe8, 0x3f, 0x94, 0x88, 0xf3, 0x14,
+	0x54, 0x6b, 0xe2, 0x74, 0x1a, 0x81, 0xac, 0x95, 0x17, 0x90, 0xef, 0x70, 0x72, 0x36, 0xbb, 0x48,
+	0xa2, 0x33, 0x65, 0xe3, 0x6d, 0x9c, 0x53, 0x23, 0x90, 0xf8, 0x99, 0x5b, 0xa3, 0x71, 0x70, 0xc9,
+	0x42, 0xb3, 0x2b, 0x83, 0xfe, 0xd0, 0x72, 0x06, 0x22, 0xc3, 0x53, 0x8c, 0x1f, 0x91, 0x50, 0x3c,
+	0xcf, 0xf5, 0x44, 0xae, 0x5e, 0x87, 0x3c, 0xca, 0x28, 0x2a, 0x49, 0xc7, 0x18, 0x49, 0x35, 0xd3,
+	0xf4, 0xbb, 0xfe, 0x1c, 0xac, 0xcf, 0xec, 0xc7, 0xf5, 0x3f, 0x28, 0xb2, 0x84, 0x20, 0x05, 0xd9,
+	0x82, 0x8a, 0x02, 0x7f, 0x3f, 0x9b, 0x8e, 0x41, 0x2e, 0x69, 0x1d, 0xf3, 0x3e, 0x14, 0x70, 0x60,
+	0xa1, 0x8a, 0x59, 0x80, 0xfc, 0x00, 0xd1, 0x75, 0xa6, 0x42, 0x0f, 0xa6, 0x3f, 0x94, 0xfd, 0xc7,
+	0xd2, 0x54, 0xba, 0x3e, 0x6c, 0xa2, 0xd0, 0xf4, 0x13, 0xe6, 0x39, 0x37, 0x48, 0x24, 0xfa, 0xae,
+	0xd3, 0x1a, 0xb9, 0xdf, 0xb4, 0x6a, 0x45, 0x25, 0x12, 0x21, 0x20, 0x7c, 0xda, 0x46, 0x19, 0x51,
+	0x9f, 0x2d, 0x06, 0xd4, 0x5b, 0x50, 0xa0, 0x77, 0xe3, 0x4a, 0xe0, 0x3e, 0x73, 0xa4, 0xe1, 0xa5,
+	0xc5, 0xfa, 0xac, 0xba, 0x5c, 0xff, 0x5e, 0x16, 0xf2, 0xd8, 0xd6, 0x36, 0xa0, 0xe0, 0xa1, 0x1f,
+	0x46, 0xd3, 0x79, 0x95, 0xcf, 0xc6, 0x28, 0xda, 0xd7, 0x95, 0x28, 0x66, 0x17, 0x10, 0x96, 0xe8,
+	0x8d, 0x49, 0xb1, 0xbc, 0x01, 0x85, 0xb1, 0xe1, 0x19, 0x23, 0xb5, 0x4e, 0xb8, 0xd1, 0xf8, 0x4e,
+	0x06, 0xf2, 0x88, 0xa4, 0xad, 0x43, 0xb5, 0x1b, 0x78, 0xd6, 0x63, 0x19, 0x0c, 0x3d, 0x77, 0x32,
+	0x18, 0xb2, 0x24, 0x3d, 0x90, 0x97, 0xa7, 0x6e, 0xac, 0x10, 0x02, 0xc3, 0xb6, 0xfa, 0x22, 0x8b,
+	0x52, 0xb5, 0xe5, 0xda, 0xa6, 0xc8, 0x69, 0x6b, 0xb0, 0xfc, 0xd0, 0x31, 0xa5, 0xe7, 0xf7, 0x5d,
+	0x4f, 0x9a, 0x22, 0xaf, 0x56, 0xf7, 0x63, 0x51, 0xa0, 0xbd, 0x4c, 0x5e, 0x04, 0xe4, 0x0b, 0x89,
+	0xa2, 0xf6, 0x1c, 0xac, 0x6d, 0xa5, 0x1d, 0x24, 0x51, 0x42, 0x9d, 0x74, 0x20, 0x1d, 0x14, 0x32,
+	0x51, 0x66, 0x21, 0x76, 0xbf, 0x69, 0x89, 0x0a, 0xbe, 0x8c, 0xd7, 0x89, 0x80, 0xc6, 0xdf, 0xcf,
+	0x84, 0x9a, 0xa3, 0x0a, 0x95, 0x23, 0xc3, 0x33, 0x06, 0x9e, 0x31, 0xc6, 0xfe, 0x2d, 0x43, 0x89,
+	0x37, 0xce, 0x37, 0x44, 0x26, 0x6e, 0xdc, 0x13, 0xd9, 0xb8, 0xf1, 0xa6, 0xc8, 0xc5, 0x8d, 0xb7,
+	0x44, 0x1e, 0xdf, 0xf1, 0xf1, 0xc4, 0x0d, 0xa4, 0x28, 0x90, 0xae, 0x73, 0x4d, 0x29, 0x8a, 0x08,
+	0xec, 0xa1, 0x46, 0x11, 0x25, 0x1c, 0xf3, 0x36, 0xca, 0xcf, 0xa9, 0x7b, 0x21, 0xca, 0xd8, 0x0d,
+	0x9c, 0x46, 0x69, 0x8a, 0x0a, 0x3e, 0x39, 0x9c, 0x8c, 0x4e, 0x25, 0x0e, 0x13, 0xf0, 0x49, 0xcf,
+	0x1d, 0x0c, 0x6c, 0x29, 0x96, 0xb5, 0xb5, 0x94, 0xf2, 0x15, 0x2b, 0xa4, 0x69, 0x0d, 0xdb, 0x76,
+	0x27, 0x81, 0xa8, 0xd6, 0x7f, 0x9c, 0x83, 0x3c, 0x7a, 0x37, 0xb8, 0x76, 0x86, 0xa8, 0x67, 0xd4,
+	0xda, 0xc1, 0xdf, 0xd1, 0x0a, 0xcc, 0xc6, 0x2b, 0x50, 0x7b, 0x4f, 0x7d, 0xe9, 0xdc, 0x02, 0x5a,
+	0x16, 0x19, 0x27, 0x3f, 0xb2, 0x06, 0xf9, 0x91, 0x35, 0x92, 0x4a, 0xd7, 0xd1, 0x6f, 0x84, 0xf9,
+	0xb8, 0x1f, 0x17, 0x28, 0x78, 0x42, 0xbf, 0x71, 0xd5, 0x18, 0xb8, 0x2d, 0x34, 0x03, 0x5a, 0x03,
+	0x39, 0x3d, 0x6c, 0xce, 0xd1, 0x5e, 0x95, 0xb9, 0xda, 0xeb, 0xfd, 0x50, 0x7b, 0x95, 0x16, 0x58,
+	0xf5, 0xd4, 0xcd, 0xa4, 0xe6, 0x8a, 0x95, 0x46, 0x79, 0x71, 0xf2, 0xc4, 0x66, 0xb2, 0xa3, 0xa4,
+	0x36, 0xde, 0xe8, 0xca, 0x3c, 0xcb, 0x22, 0x83, 0x5f, 0x93, 0x96, 0x2b, 0xeb, 0xbc, 0x63, 0xcb,
+	0x94, 0xae, 0xc8, 0xd1, 0x46, 0x38, 0x31, 0x2d, 0x57, 0xe4, 0xd1, 0xf2, 0x3a, 0xda, 0xd9, 0x15,
+	0x85, 0xc6, 0x4b, 0x89, 0x2d, 0xa9, 0x39, 0x09, 0x5c, 0xb1, 0x14, 0x89, 0x6f, 0x86, 0xa5, 0xf1,
+	0x54, 0x9a, 0x22, 0xdb, 0xf8, 0xca, 0x1c, 0x35, 0x5b, 0x85, 0xca, 0xc3, 0xb1, 0xed, 0x1a, 0xe6,
+	0x35, 0x7a, 0x76, 0x05, 0x20, 0xf6, 0xaa, 0xeb, 0x7f, 0xf8, 0xf9, 0x78, 0x3b, 0x47, 0x5b, 0xd4,
+	0x77, 0x27, 0x5e, 0x5f, 0x92, 0x0a, 0xa9, 0xe8, 0xaa, 0xa5, 0x7d, 0x08, 0x05, 0x7c, 0x1e, 0x86,
+	0x71, 0x36, 0x16, 0xf2, 0xe5, 0x36, 0x8f, 0x2d, 0xf9, 0x44, 0x67, 0x42, 0xed, 0x0e, 0x80, 0xd1,
+	0x0f, 0xac, 0x73, 0x89, 0x40, 0xb5, 0xd8, 0x13, 0x10, 0xed, 0xed, 0xa4, 0xf9, 0x72, 0x7d, 0x1c,
+	0x32, 0x61, 0xd7, 0x68, 0x3a, 0x2c, 0xe3, 0xd2, 0x1d, 0x77, 0x3c, 0x5c, 0xed, 0xb5, 0x15, 0x22,
+	0x7c, 0x7d, 0xb1, 0xee, 0xdd, 0x8f, 0x08, 0xf5, 0x24, 0x13, 0xed, 0x21, 0xac, 0x70, 0x4c, 0x4d,
+	0x31, 0xad, 0x12, 0xd3, 0x37, 0x16, 0x63, 0xda, 0x89, 0x29, 0xf5, 0x14, 0x9b, 0xd9, 0xb0, 0x64,
+	0xe1, 0x99, 0xc3, 0x92, 0x2f, 0xc1, 0x6a, 0x2f, 0xbd, 0x0a, 0x78, 0xab, 0x98, 0x82, 0x6a, 0x0d,
+	0x58, 0xb1, 0xfc, 0x38, 0x2a, 0x4a, 0x31, 0x92, 0xb2, 0x9e, 0x82, 0xd5, 0x7f, 0xbb, 0x04, 0x79,
+	0x9a, 0xf9, 0xe9, 0x18, 0xd7, 0x76, 0x4a, 0xa5, 0xbf, 0xb6, 0xf8, 0xa7, 0x9e, 0x5a, 0xf1, 0xa4,
+	0x41, 0x72, 0x09, 0x0d, 0xf2, 0x21, 0x14, 0x7c, 0xd7, 0x0b, 0xc2, 0xcf, 0xbb, 0xa0, 0x10, 0x75,
+	0x5d, 0x2f, 0xd0, 0x99, 0x50, 0xdb, 0x85, 0xd2, 0x99, 0x65, 0x07, 0xd2, 0x0b, 0x27, 0xef, 0xd5,
+	0xc5, 0x78, 0xec, 0x12, 0x91, 0x1e, 0x12, 0x6b, 0xfb, 0x49, 0x61, 0x2b, 0xde, 0xcd, 0x3d, 0x35,
+	0x16, 0x10, 0x71, 0x9a, 0x27, 0x83, 0x1b, 0x20, 0xfa, 0xee, 0xb9, 0xf4, 0xf4, 0x44, 0x60, 0x92,
+	0x37, 0xe9, 0x19, 0x38, 0xc6, 0x6f, 0x87, 0x96, 0x29, 0xd1, 0xce, 0x21, 0x1d, 0x53, 0xd6, 0xa3,
+	0xb6, 0xf6, 0x00, 0xca, 0xe4, 0x1f, 0xa0, 0x56, 0xac, 0x3c, 0xf3, 0xe4, 0xb3, 0xab, 0x12, 0x32,
+	0xc0, 0x17, 0xd1, 0xcb, 0x77, 0xad, 0x80, 0xe2, 0xd3, 0x65, 0x3d, 0x6a, 0x63, 0x87, 0x49, 0xde,
+	0x93, 0x1d, 0x5e, 0xe6, 0x0e, 0x4f, 0xc3, 0x31, 0x04, 0x4f, 0xb0, 0xa9, 0x4d, 0x12, 0x97, 0x1a,
+	0x32, 0x9d, 0xff, 0x10, 0x0d, 0x96, 0xb1, 0x31, 0x90, 0xfb, 0xd6, 0xc8, 0x0a, 0x6a, 0xd5, 0xbb,
+	0x99, 0x57, 0x0a, 0x7a, 0x0c, 0xd0, 0x5e, 0x85, 0x75, 0x53, 0x9e, 0x19, 0x13, 0x3b, 0xe8, 0xc9,
+	0xd1, 0xd8, 0x36, 0x02, 0xd9, 0x36, 0x49, 0x46, 0x2b, 0xfa, 0xec, 0x03, 0xed, 0x75, 0x78, 0x4e,
+	0x01, 0x3b, 0x51, 0x56, 0xa1, 0x6d, 0x52, 0xf8, 0xae, 0xa2, 0xcf, 0x7b, 0x84, 0xcb, 0x44, 0x3a,
+	0x66, 0x72, 0x74, 0x82, 0x97, 0x49, 0x1a, 0x8a, 0x96, 0xf6, 0x13, 0xcf, 0x18, 0xab, 0xf9, 0xa4,
+	0xc8, 0x5c, 0x59, 0x4f, 0x82, 0x1a, 0x07, 0x4a, 0xa1, 0xe3, 0x56, 0x8c, 0x1e, 0x6f, 0xa8, 0x8a,
+	0xfd, 0x80, 0xf7, 0xf6, 0xfb, 0x86, 0x6d, 0x4b, 0xef, 0x92, 0xdd, 0xe5, 0x07, 0x86, 0x73, 0x6a,
+	0x38, 0x22, 0x47, 0xbb, 0xb5, 0x61, 0x4b, 0xc7, 0x34, 0x3c, 0xde, 0xdb, 0xef, 0x93, 0x69, 0x50,
+	0x68, 0xbc, 0x02, 0x79, 0xfa, 0x38, 0x15, 0x28, 0xb0, 0xbf, 0x45, 0xbe, 0xb7, 0xf2, 0xb5, 0x48,
+	0xb7, 0xef, 0xe3, 0x42, 0x16, 0xd9, 0xfa, 0x5f, 0x2b, 0x42, 0x39, 0xec, 0x6a, 0x98, 0x8d, 0xc8,
+	0xc4, 0xd9, 0x08, 0x34, 0x08, 0xfd, 0x63, 0xcb, 0xb7, 0x4e, 0x95, 0x81, 0x5b, 0xd6, 0x63, 0x00,
+	0xda, 0x54, 0x4f, 0x2c, 0x33, 0x18, 0xd2, 0xea, 0x2b, 0xe8, 0xdc, 0xc0, 0x08, 0xb1, 0x89, 0x33,
+	0xea, 0xf4, 0xed, 0x89, 0x29, 0x31, 0x3b, 0xa1, 0x02, 0x0e, 0xd3, 0x60, 0xed, 0x13, 0x80, 0xc0,
+	0x1a, 0xc9, 0x5d, 0xd7, 0x1b, 0x19, 0x81, 0xf2, 0x32, 0xbe, 0xfa, 0x6c, 0xeb, 0x63, 0xb3, 0x17,
+	0x31, 0xd0, 0x13, 0xcc, 0x90, 0x35, 0xbe, 0x4d, 0xb1, 0x2e, 0x7d, 0x26, 0xd6, 0x3b, 0x11, 0x03,
+	0x3d, 0xc1, 0x4c, 0xeb, 0x41, 0xe9, 0xcc, 0xf5, 0x46, 0x13, 0xdb, 0x50, 0xbb, 0xf7, 0x7b, 0xcf,
+	0xc8, 0x77, 0x97, 0xa9, 0x49, 0x8b, 0x85, 0xac, 0xe2, 0x68, 0x79, 0x65, 0xc1, 0x68, 0x79, 0xe3,
+	0xe7, 0x01, 0xe2, 0x1e, 0x6a, 0x37, 0x41, 0x3b, 0x70, 0x9d, 0x60, 0xd8, 0x3c, 0x3d, 0xf5, 0xb6,
+	0xe4, 0x99, 0xeb, 0xc9, 0x1d, 0x03, 0x37, 0xea, 0xe7, 0x61, 0x3d, 0x82, 0x37, 0xcf, 0x02, 0xe9,
+	0x21, 0x98, 0x44, 0xa0, 0x3b, 0x74, 0xbd, 0x80, 0xad, 0x45, 0xfa, 0xf9, 0xb0, 0x2b, 0x72, 0x68,
+	0x1c, 0xb4, 0xbb, 0x1d, 0x91, 0x6f, 0xbc, 0x02, 0x10, 0x4f, 0x2d, 0x79, 0x55, 0xf4, 0xeb, 0x8d,
+	0x7b, 0x62, 0x29, 0x6e, 0xdd, 0x7b, 0x4b, 0x64, 0x1a, 0x3f, 0xcc, 0xc0, 0x72, 0x62, 0x48, 0x69,
+	0xef, 0x7b, 0xdb, 0x9d, 0x38, 0x01, 0xbb, 0xfb, 0xf4, 0xf3, 0xd8, 0xb0, 0x27, 0x68, 0x26, 0xac,
+	0x43, 0x95, 0xda, 0x3b, 0x96, 0x1f, 0x58, 0x4e, 0x3f, 0x10, 0xb9, 0x08, 0x85, 0x4d, 0x8c, 0x7c,
+	0x84, 0x72, 0xe8, 0x2a, 0x50, 0x01, 0x03, 0x42, 0x47, 0xd2, 0xeb, 0xcb, 0x10, 0x89, 0xcc, 0x6a,
+	0x05, 0x89, 0xd0, 0xd8, 0xac, 0x36, 0x82, 0x61, 0x77, 0x32, 0x12, 0x65, 0x34, 0x4f, 0xb1, 0xd1,
+	0x3c, 0x97, 0x1e, 0x5a, 0x45, 0x15, 0x7c, 0x0f, 0x02, 0x70, 0x35, 0x18, 0x8e, 0x80, 0x10, 0xfb,
+	0xc0, 0x72, 0xc4, 0x72, 0xd4, 0x30, 0x2e, 0xc4, 0x0a, 0xf6, 0x9f, 0x9c, 0x10, 0x51, 0xad, 0xff,
+	0xbb, 0x1c, 0xe4, 0x71, 0x87, 0xc0, 0xb5, 0x9c, 0x5c, 0xf0, 0xbc, 0x56, 0x92, 0xa0, 0xcf, 0xb6,
+	0xaf, 0x21, 0xef, 0xe4, 0xbe, 0xf6, 0x2e, 0x2c, 0xf7, 0x27, 0x7e, 0xe0, 0x8e, 0x68, 0x53, 0x57,
+	0x79, 0xb3, 0x9b, 0x33, 0xf1, 0x27, 0x9a, 0x4e, 0x3d, 0x89, 0xaa, 0xbd, 0x0d, 0xc5, 0x33, 0x96,
+	0x7a, 0x8e, 0x40, 0xfd, 0xcc, 0x15, 0xfb, 0xbe, 0x92, 0x6c, 0x85, 0x8c, 0xe3, 0xb2, 0x66, 0x56,
+	0x6c, 0x12, 0xa4, 0xf6, 0xef, 0x62, 0xb4, 0x7f, 0xff, 0x3c, 0xac, 0x4a, 0x9c, 0xf0, 0x23, 0xdb,
+	0xe8, 0xcb, 0x91, 0x74, 0xc2, 0x65, 0xf6, 0xd6, 0x33, 0x8c, 0x98, 0xbe, 0x18, 0x0d, 0x7b, 0x8a,
+	0x17, 0x6a, 0x1e, 0xc7, 0x45, 0x33, 0x22, 0x0c, 0x11, 0x94, 0xf5, 0x18, 0xd0, 0xf8, 0x82, 0xd2,
+	0x97, 0x25, 0xc8, 0x35, 0xfd, 0xbe, 0x8a, 0xa5, 0x48, 0xbf, 0xcf, 0x8e, 0xda, 0x36, 0x4d, 0x87,
+	0xc8, 0x36, 0xde, 0x80, 0x4a, 0xf4, 0x06, 0x14, 0x9e, 0x43, 0x37, 0xe8, 0x8e, 0x65, 0xdf, 0x3a,
+	0xb3, 0xa4, 0xc9, 0xf2, 0xd9, 0x0d, 0x0c, 0x2f, 0xe0, 0x70, 0x64, 0xcb, 0x31, 0x45, 0xb6, 0xfe,
+	0x83, 0x32, 0x14, 0x79, 0x1b, 0x57, 0x03, 0xae, 0x44, 0x03, 0xfe, 0x18, 0xca, 0xee, 0x58, 0x7a,
+	0x46, 0xe0, 0x7a, 0x2a, 0x06, 0xf4, 0xf6, 0xb3, 0x98, 0x05, 0x9b, 0x1d, 0x45, 0xac, 0x47, 0x6c,
+	0xa6, 0xa5, 0x29, 0x3b, 0x2b, 0x4d, 0x1b, 0x20, 0x42, 0x0b, 0xe0, 0xc8, 0x43, 0xba, 0xe0, 0x52,
+	0x79, 0xf4, 0x33, 0x70, 0xad, 0x07, 0x95, 0xbe, 0xeb, 0x98, 0x56, 0x14, 0x0f, 0x5a, 0xbd, 0xf7,
+	0x95, 0x67, 0xea, 0xe1, 0x76, 0x48, 0xad, 0xc7, 0x8c, 0xb4, 0x57, 0xa1, 0x70, 0x8e, 0x62, 0x46,
+	0xf2, 0x74, 0xb5, 0x10, 0x32, 0x92, 0xf6, 0x29, 0x2c, 0xff, 0xf2, 0xc4, 0xea, 0x3f, 0xee, 0x24,
+	0xe3, 0x8d, 0xef, 0x3e, 0x53, 0x2f, 0x3e, 0x8e, 0xe9, 0xf5, 0x24, 0xb3, 0x84, 0x68, 0x97, 0x7e,
+	0x02, 0xd1, 0x2e, 0xcf, 0x8a, 0xb6, 0x0e, 0x55, 0x47, 0xfa, 0x81, 0x34, 0x77, 0x95, 0xd5, 0x07,
+	0x9f, 0xc1, 0xea, 0x4b, 0xb3, 0x68, 0x7c, 0x1e, 0xca, 0xe1, 0x07, 0xd7, 0x8a, 0x90, 0x3d, 0x44,
+	0xf7, 0xaa, 0x08, 0xd9, 0x8e, 0xc7, 0xd2, 0xd6, 0x44, 0x69, 0x6b, 0xfc, 0x61, 0x06, 0x2a, 0xd1,
+	0xa4, 0xa7, 0x35, 0x67, 0xeb, 0x97, 0x27, 0x06, 0x06, 0x4a, 0xd1, 0xf1, 0x76, 0x03, 0x6e, 0x91,
+	0xb2, 0xbe, 0x4f, 0x69, 0x7f, 0x0c, 0x97, 0xa3, 0x89, 0x20, 0x7d, 0x8c, 0x94, 0x6b, 0xb0, 0xaa,
+	0xc0, 0x1d, 0x8f, 0x51, 0x0b, 0xa8, 0xf8, 0xf0, 0x69, 0x08, 0x28, 0x12, 0xba, 0xf5, 0x58, 0xb2,
+	0x82, 0x3c, 0x74, 0x03, 0x6a, 0x94, 0xb1, 0x53, 0x6d, 0x47, 0x54, 0xf0, 0x9d, 0x87, 0x6e, 0xd0,
+	0x46, 0x95, 0x18, 0x39, 0x7a, 0xcb, 0xe1, 0xeb, 0xa9, 0x45, 0x1a, 0xb1, 0x69, 0xdb, 0x6d, 0x47,
+	0x54, 0xd5, 0x03, 0x6e, 0xad, 0x22, 0xc7, 0xd6, 0x85, 0xd1, 0x47, 0xf2, 0x35, 0xd4, 0xb0, 0x48,
+	0xa3, 0xda, 0x02, 0x97, 0x64, 0xeb, 0xc2, 0xf2, 0x03, 0x5f, 0xac, 0x37, 0x7e, 0x9c, 0x81, 0xe5,
+	0xc4, 0x07, 0x46, 0x47, 0x92, 0x10, 0x71, 0x2b, 0x63, 0xbf, 0xf2, 0x13, 0x9c, 0x46, 0xcf, 0x0c,
+	0xb7, 0xa9, 0x9e, 0x8b, 0x3f, 0xb3, 0xf8, 0xbe, 0x9e, 0x3b, 0x72, 0x3d, 0xcf, 0x7d, 0xc2, 0xa6,
+	0xcf, 0xbe, 0xe1, 0x07, 0x8f, 0xa4, 0x7c, 0x2c, 0xf2, 0x38, 0xd4, 0xed, 0x89, 0xe7, 0x49, 0x87,
+	0x01, 0x05, 0xea, 0x9c, 0xbc, 0xe0, 0x56, 0x11, 0x99, 0x22, 0x32, 0xed, 0x83, 0xa2, 0x84, 0x8a,
+	0x40, 0x61, 0x33, 0xa4, 0x8c, 0x08, 0x88, 0xce, 0xcd, 0x0a, 0x6e, 0x2a, 0x1c, 0xeb, 0xe8, 0x9c,
+	0xed, 0x18, 0x97, 0x7e, 0x73, 0xe0, 0x0a, 0x98, 0x06, 0x1e, 0xba, 0x4f, 0x78, 0x76, 0x90, 0xf3,
+	0x27, 0xd2, 0xf0, 0xc4, 0x4a, 0xa2, 0x1b, 0x04, 0xa8, 0x86, 0xdd, 0xa0, 0xd6, 0x6a, 0x7d, 0x02,
+	0x10, 0xbb, 0x82, 0xe8, 0x02, 0xa3, 0xf4, 0x44, 0xa9, 0x0b, 0xd5, 0xd2, 0x3a, 0x00, 0xf8, 0x8b,
+	0x30, 0x43, 0x3f, 0xf8, 0x19, 0xec, 0x73, 0xa2, 0xd3, 0x13, 0x2c, 0xea, 0x7f, 0x0c, 0x2a, 0xd1,
+	0x03, 0x8c, 0x7c, 0x90, 0x25, 0x1d, 0xbd, 0x36, 0x6c, 0xa2, 0x31, 0x67, 0x39, 0xa6, 0xbc, 0x20,
+	0x25, 0x54, 0xd0, 0xb9, 0x81, 0xbd, 0x1c, 0x5a, 0xa6, 0x29, 0x9d, 0x30, 0xc1, 0xc4, 0xad, 0x79,
+	0x65, 0x00, 0xf9, 0xb9, 0x65, 0x00, 0xf5, 0x5f, 0x80, 0xe5, 0x84, 0xaf, 0x7a, 0xe5, 0xb0, 0x13,
+	0x1d, 0xcb, 0xa6, 0x3b, 0x76, 0x1b, 0x2a, 0x61, 0xe9, 0x89, 0x4f, 0x1b, 0x61, 0x45, 0x8f, 0x01,
+	0xf5, 0xbf, 0x9d, 0x85, 0x02, 0x0f, 0x6d, 0xda, 0xbf, 0xdc, 0x85, 0xa2, 0x1f, 0x18, 0xc1, 0x24,
+	0xac, 0xa1, 0x58, 0x70, 0x35, 0x77, 0x89, 0x06, 0x93, 0x7a, 0x4c, 0xad, 0xbd, 0x0f, 0xb9, 0xc0,
+	0x18, 0xa8, 0xf8, 0xec, 0x17, 0x17, 0x63, 0xd2, 0x33, 0x06, 0x98, 0x58, 0x0f, 0x8c, 0x81, 0xb6,
+	0x0f, 0xe5, 0xbe, 0x0a, 0xa9, 0x29, 0x0d, 0xba, 0xa0, 0x0b, 0x18, 0x06, 0xe2, 0x30, 0x41, 0x19,
+	0x72, 0xd0, 0x3e, 0x84, 0xbc, 0x89, 0x3b, 0x22, 0x97, 0x9a, 0x2c, 0xe8, 0xda, 0xe2, 0xda, 0xc2,
+	0x54, 0x23, 0x52, 0x6e, 0x95, 0xa0, 0x40, 0x0a, 0xbb, 0x5e, 0x83, 0x22, 0x8f, 0x75, 0x7a, 0xe6,
+	0xea, 0xb7, 0x20, 0xd7, 0x33, 0x06, 0xe8, 0x0e, 0x58, 0xa6, 0xaf, 0x22, 0x34, 0xf8, 0xb3, 0xfe,
+	0x62, 0x1c, 0x1e, 0x4c, 0x46, 0x9e, 0x33, 0xa9, 0xc8, 0x73, 0xbd, 0x08, 0x79, 0x7c, 0x63, 0xfd,
+	0xf6, 0x75, 0xae, 0x45, 0xfd, 0x1f, 0xe7, 0xd0, 0x0b, 0xc1, 0xec, 0xf4, 0xbc, 0xa8, 0xfa, 0x47,
+	0x50, 0x19, 0x7b, 0x6e, 0x5f, 0xfa, 0xbe, 0xeb, 0x29, 0x4b, 0xea, 0xd5, 0xa7, 0x67, 0xbc, 0x37,
+	0x8f, 0x42, 0x1a, 0x3d, 0x26, 0x6f, 0xfc, 0xab, 0x2c, 0x54, 0xa2, 0x07, 0xec, 0xfc, 0x04, 0xf2,
+	0x82, 0x23, 0xa8, 0x07, 0xd2, 0x1b, 0x19, 0x96, 0xc9, 0xaa, 0x66, 0x7b, 0x68, 0x84, 0x16, 0xf1,
+	0x27, 0xee, 0x24, 0x98, 0x9c, 0x4a, 0x8e, 0x9c, 0x1d, 0x5b, 0x23, 0x89, 0x91, 0x33, 0xcc, 0x59,
+	0xa1, 0x60, 0xf7, 0x6d, 0x77, 0x62, 0x8a, 0x02, 0xb6, 0xef, 0xd3, 0x5e, 0x78, 0x60, 0x8c, 0x7d,
+	0x56, 0xb0, 0x07, 0x96, 0xe7, 0x8a, 0x12, 0x12, 0xed, 0x5a, 0x83, 0x91, 0x21, 0xca, 0xc8, 0xac,
+	0xf7, 0xc4, 0x0a, 0x50, 0x63, 0x57, 0xd0, 0xa6, 0xed, 0x8c, 0xa5, 0xd3, 0x0d, 0x3c, 0x29, 0x83,
+	0x03, 0x63, 0xcc, 0xa1, 0x54, 0x5d, 0x9a, 0xa6, 0x15, 0xb0, 0x3a, 0xd9, 0x35, 0xfa, 0x12, 0xeb,
+	0x29, 0xc4, 0x0a, 0x6a, 0xa5, 0xb6, 0xe3, 0x07, 0x18, 0xf0, 0x1d, 0xb1, 0x32, 0xe9, 0x49, 0x5b,
+	0x52, 0x6b, 0x95, 0xde, 0x6d, 0x05, 0xc3, 0xc9, 0xe9, 0x7d, 0x74, 0x12, 0xd7, 0x38, 0xbd, 0x65,
+	0xca, 0xb1, 0x44, 0x85, 0xbb, 0x02, 0xe5, 0x2d, 0xcb, 0xb6, 0x4e, 0x2d, 0xdb, 0x12, 0xeb, 0x88,
+	0xda, 0xba, 0xe8, 0x1b, 0xb6, 0x65, 0x7a, 0xc6, 0x13, 0xa1, 0x61, 0xe7, 0x1e, 0x78, 0xee, 0x63,
+	0x4b, 0x3c, 0x87, 0x88, 0xe4, 0x33, 0x9e, 0x5b, 0xdf, 0x12, 0x37, 0x28, 0x45, 0xf7, 0x18, 0x93,
+	0x27, 0x67, 0xc6, 0xa9, 0x78, 0x3e, 0x8e, 0x24, 0xde, 0xc4, 0x4e, 0xee, 0x78, 0xc6, 0x13, 0xcb,
+	0x15, 0xb7, 0xc8, 0x5f, 0x18, 0xbb, 0x81, 0x75, 0x76, 0x29, 0x6a, 0xf5, 0x75, 0x58, 0x9b, 0xaa,
+	0x12, 0xa8, 0x97, 0x94, 0x0f, 0x5b, 0xaf, 0xc2, 0x72, 0x22, 0x7d, 0x5b, 0x7f, 0x09, 0xca, 0x61,
+	0x72, 0x17, 0xa3, 0x06, 0x96, 0xcf, 0x61, 0x69, 0x25, 0x3d, 0x51, 0xbb, 0xfe, 0xaf, 0x33, 0x50,
+	0xe4, 0xcc, 0xba, 0xb6, 0x15, 0x55, 0xc2, 0x64, 0x16, 0xc8, 0xa6, 0x32, 0x91, 0xca, 0x45, 0x47,
+	0xe5, 0x30, 0x37, 0xa0, 0x60, 0x53, 0x78, 0x40, 0xe9, 0x35, 0x6a, 0x24, 0xd4, 0x50, 0x2e, 0xa5,
+	0x86, 0x6e, 0x43, 0xc5, 0x98, 0x04, 0x2e, 0x25, 0x0d, 0x55, 0x46, 0x25, 0x06, 0x34, 0x9a, 0x51,
+	0x76, 0x3c, 0x0c, 0x94, 0x92, 0xe5, 0xd9, 0xf3, 0xa4, 0x14, 0x99, 0xc8, 0x63, 0xcf, 0xd2, 0x46,
+	0xe0, 0x8e, 0xc6, 0x46, 0x3f, 0x20, 0x00, 0xed, 0xd4, 0xa8, 0x83, 0x45, 0x1e, 0x17, 0x07, 0x66,
+	0xfe, 0x1b, 0x67, 0x50, 0x3e, 0x72, 0xfd, 0xe9, 0x7d, 0xbf, 0x04, 0xb9, 0x9e, 0x3b, 0x66, 0x2b,
+	0x76, 0xcb, 0x0d, 0xc8, 0x8a, 0x25, 0xbe, 0xf2, 0x2c, 0x60, 0x59, 0xd4, 0xb1, 0x7c, 0x8d, 0xbd,
+	0xfd, 0xb6, 0xe3, 0x48, 0x4f, 0x14, 0xf0, 0x83, 0xe8, 0x72, 0x8c, 0x96, 0xb3, 0x28, 0xe2, 0xc7,
+	0x26, 0xf8, 0xae, 0xe5, 0xf9, 0x81, 0x28, 0x35, 0xda, 0x50, 0xe0, 0x92, 0xa8, 0x2a, 0x54, 0xe8,
+	0x07, 0xb1, 0x5a, 0xc2, 0x2e, 0x52, 0x73, 0x5b, 0x3a, 0x28, 0x9a, 0xe4, 0xa1, 0x11, 0x80, 0x5f,
+	0x90, 0xc5, 0x5d, 0x92, 0xda, 0x1f, 0x4d, 0x7c, 0xfa, 0xd6, 0xb9, 0xc6, 0x23, 0xa8, 0xa6, 0x8a,
+	0xae, 0xb4, 0x1b, 0x20, 0x52, 0x00, 0xec, 0xfa, 0x92, 0x76, 0x0b, 0x9e, 0x4b, 0x41, 0x0f, 0x2c,
+	0xd3, 0xa4, 0xc8, 0xf4, 0xf4, 0x83, 0x70, 0x80, 0x5b, 0x15, 0x28, 0xf5, 0x55, 0x20, 0xe4, 0x08,
+	0xaa, 0xf4, 0x51, 0xb1, 0xf8, 0xaf, 0xe3, 0xd8, 0x97, 0x3f, 0x71, 0x65, 0x5c, 0xe3, 0x4b, 0xca,
+	0x89, 0x43, 0x35, 0x73, 0xe6, 0xb9, 0x23, 0xe2, 0x55, 0xd0, 0xe9, 0x37, 0x72, 0x0f, 0x5c, 0x25,
+	0x19, 0xd9, 0xc0, 0x6d, 0x7c, 0x6f, 0x05, 0x4a, 0xcd, 0x7e, 0x1f, 0xdd, 0xce, 0x99, 0x37, 0xbf,
+	0x0d, 0xc5, 0xbe, 0xeb, 0x9c, 0x59, 0x03, 0xa5, 0xc6, 0xa7, 0xad, 0x4f, 0x45, 0x87, 0xe2, 0x78,
+	0x66, 0x0d, 0x74, 0x85, 0x8c, 0x64, 0x6a, 0x1b, 0x2a, 0x5c, 0x4b, 0xc6, 0xba, 0x38, 0xda, 0x75,
+	0x5e, 0x83, 0xbc, 0x85, 0x75, 0x9c, 0x5c, 0xc6, 0xfa, 0xb9, 0x2b, 0x88, 0xa8, 0x96, 0x93, 0x10,
+	0xeb, 0xff, 0x26, 0x83, 0xd5, 0x15, 0xf4, 0x4a, 0x8a, 0x4b, 0xe1, 0x52, 0x0b, 0x77, 0x00, 0xb5,
+	0xc6, 0xa6, 0xa0, 0x68, 0x18, 0x2b, 0x88, 0x3c, 0x9d, 0x0c, 0x54, 0x7c, 0x27, 0x09, 0xd2, 0xde,
+	0x85, 0x5b, 0xdc, 0x3c, 0xf2, 0xa4, 0x27, 0x6d, 0x69, 0xf8, 0x72, 0x7b, 0x68, 0x38, 0x8e, 0xb4,
+	0x95, 0x3d, 0x70, 0xd5, 0x63, 0x0c, 0x0d, 0xf3, 0xa3, 0xee, 0xd8, 0xe8, 0x4b, 0x5f, 0xad, 0xa5,
+	0x14, 0x4c, 0xfb, 0x32, 0x14, 0xa8, 0xca, 0xb7, 0x66, 0x5e, 0xff, 0x29, 0x19, 0xab, 0xee, 0x46,
+	0x1b, 0x56, 0x13, 0x80, 0xa7, 0x09, 0x1d, 0x3b, 0xa5, 0x1b, 0x7e, 0xf6, 0xda, 0x79, 0x45, 0x44,
+	0x3d, 0x41, 0x84, 0xfd, 0x33, 0xa5, 0x2d, 0xa9, 0x1c, 0x13, 0x37, 0xd4, 0x2c, 0xe5, 0x81, 0x52,
+	0xb0, 0xfa, 0x77, 0x0a, 0x90, 0xc7, 0x19, 0x46, 0xe4, 0xa1, 0x3b, 0x92, 0x51, 0x34, 0x9c, 0x2d,
+	0x94, 0x14, 0x0c, 0x2d, 0x22, 0x83, 0x0b, 0x12, 0x22, 0x34, 0x56, 0x2d, 0xd3, 0x60, 0xc4, 0x1c,
+	0x7b, 0x2e, 0x96, 0xfa, 0x45, 0x98, 0xca, 0x76, 0x9a, 0x02, 0x6b, 0x5f, 0x81, 0x9b, 0x98, 0x33,
+	0x95, 0x01, 0xad, 0xee, 0x47, 0xae, 0xf7, 0xd8, 0xc7, 0x99, 0x6b, 0x9b, 0x2a, 0x8c, 0x7a, 0xc5,
+	0x53, 0x0c, 0x7c, 0x3e, 0x09, 0x9b, 0xd1, 0x3b, 0x38, 0x90, 0x39, 0xfb, 0x00, 0xc5, 0x80, 0x00,
+	0xa8, 0x97, 0xda, 0xa6, 0x8a, 0x61, 0x26, 0x41, 0xa8, 0xae, 0x4d, 0x79, 0x6e, 0xd1, 0x9b, 0xcb,
+	0xf4, 0x38, 0x6a, 0xa3, 0xb0, 0x19, 0x3c, 0xd5, 0x5d, 0xd5, 0x37, 0x95, 0x31, 0x4b, 0x43, 0x51,
+	0xb3, 0x72, 0x95, 0x94, 0xdf, 0x36, 0x29, 0x52, 0x5c, 0xd1, 0x63, 0x40, 0xd4, 0x87, 0x63, 0x56,
+	0xca, 0xd5, 0x44, 0x1f, 0x18, 0x84, 0x18, 0x81, 0xec, 0x0f, 0xc3, 0x97, 0x70, 0x18, 0x37, 0x09,
+	0xc2, 0xd4, 0xcf, 0xc0, 0x08, 0xe4, 0x13, 0xe3, 0xf2, 0xa1, 0x67, 0xd7, 0x24, 0x21, 0x24, 0x20,
+	0xe8, 0x4a, 0xdb, 0x6e, 0xdf, 0xb0, 0xbb, 0x81, 0x8b, 0xa1, 0xa0, 0x23, 0x23, 0x18, 0xd6, 0x06,
+	0x84, 0x35, 0x03, 0xc7, 0x11, 0x63, 0x34, 0xf1, 0x53, 0xd7, 0x91, 0xb5, 0x21, 0x8f, 0x38, 0x6c,
+	0x63, 0x4f, 0x0c, 0xc7, 0xb0, 0x2f, 0x03, 0xab, 0x8f, 0x63, 0xb1, 0xb8, 0x27, 0x09, 0x10, 0x8e,
+	0xd5, 0x91, 0x01, 0xce, 0x74, 0xdb, 0xac, 0x7d, 0x93, 0xc7, 0x1a, 0x01, 0xf0, 0xfb, 0xcb, 0x60,
+	0x28, 0x3d, 0x39, 0x19, 0x35, 0x4d, 0xd3, 0x93, 0xbe, 0x5f, 0x7b, 0xcc, 0xdf, 0x7f, 0x0a, 0x8c,
+	0x6f, 0x1a, 0xc9, 0xc0, 0xc0, 0x05, 0x8b, 0xe1, 0x01, 0x9b, 0xdf, 0x94, 0x00, 0xd5, 0x7f, 0x2f,
+	0x4b, 0xb9, 0xbb, 0x61, 0xfd, 0x3f, 0x66, 0xa0, 0xd4, 0x1c, 0x8f, 0x49, 0x5c, 0x31, 0xbd, 0x39,
+	0x1e, 0xef, 0xc5, 0xd9, 0xd6, 0xb0, 0xa9, 0x9e, 0x1c, 0xc6, 0x39, 0xd7, 0xb0, 0x89, 0x1b, 0xa2,
+	0x31, 0x1e, 0xc7, 0xb5, 0xce, 0xaa, 0x85, 0x43, 0xe9, 0x73, 0x9d, 0x79, 0x33, 0x50, 0x39, 0xd4,
+	0x18, 0x80, 0xd3, 0x24, 0x2f, 0xc6, 0x96, 0x27, 0xa3, 0x4c, 0x6a, 0xd4, 0xa6, 0x02, 0xb2, 0xbe,
+	0x3b, 0x0e, 0x53, 0xa4, 0x5f, 0xbc, 0x62, 0x7d, 0x62, 0xef, 0x37, 0xf7, 0x71, 0xfe, 0x9b, 0x63,
+	0xab, 0x8b, 0x04, 0x3a, 0xd3, 0xb1, 0x91, 0xd0, 0xa4, 0xd4, 0x5d, 0x98, 0xc3, 0x08, 0xdb, 0x8d,
+	0x37, 0xa1, 0x9a, 0xa2, 0xc1, 0x4d, 0x90, 0x82, 0xfe, 0x14, 0xd8, 0x59, 0x86, 0xd2, 0x47, 0xbe,
+	0xeb, 0x34, 0x8f, 0xda, 0xbc, 0x2d, 0xef, 0x4e, 0x6c, 0x5b, 0x64, 0x1b, 0x1d, 0x80, 0x58, 0x1b,
+	0xe0, 0x16, 0xcb, 0xcc, 0xc4, 0x12, 0x87, 0x11, 0x1d, 0x4c, 0x66, 0xee, 0x28, 0x05, 0x20, 0x32,
+	0x08, 0xa4, 0xf0, 0x90, 0x34, 0x23, 0x20, 0xd9, 0x86, 0xd4, 0x92, 0xa6, 0xc8, 0x35, 0xfe, 0x67,
+	0x06, 0x96, 0x13, 0x65, 0x30, 0x3f, 0xc5, 0xd2, 0x1d, 0x1c, 0x3b, 0xda, 0x5e, 0x28, 0xc9, 0xfc,
+	0x41, 0xa2, 0x36, 0xca, 0xb9, 0xaa, 0xd2, 0xc1, 0xa7, 0x1c, 0x0c, 0x4a, 0x40, 0x3e, 0x53, 0xd9,
+	0x4e, 0xe3, 0x9e, 0x8a, 0xa8, 0x2d, 0x43, 0xe9, 0xa1, 0xf3, 0xd8, 0x71, 0x9f, 0x38, 0x62, 0x29,
+	0xaa, 0xc5, 0x4a, 0x65, 0x95, 0xc3, 0x72, 0xa9, 0x5c, 0xe3, 0xef, 0xe5, 0xa7, 0xca, 0x16, 0x5b,
+	0x50, 0x64, 0xcf, 0x8c, 0x9c, 0x86, 0xd9, 0x3a, 0xb3, 0x24, 0xb2, 0xca, 0x60, 0x26, 0x40, 0xba,
+	0x22, 0x46, 0x97, 0x29, 0x2a, 0xea, 0xcd, 0xce, 0xcd, 0xb4, 0xa6, 0x18, 0x85, 0xfb, 0x59, 0x12,
+	0x18, 0x57, 0xf7, 0xd6, 0xff, 0x74, 0x06, 0x6e, 0xcc, 0x43, 0x49, 0x56, 0xff, 0x67, 0xd2, 0xd5,
+	0xff, 0xdd, 0xa9, 0x6a, 0xfa, 0x2c, 0x8d, 0xe6, 0xb5, 0x67, 0xec, 0x44, 0xba, 0xb6, 0xbe, 0xf1,
+	0x07, 0x19, 0x58, 0x9f, 0x19, 0x73, 0xc2, 0xf6, 0x43, 0x1b, 0x9b, 0x24, 0x8b, 0x8b, 0xdd, 0xa2,
+	0xf2, 0x23, 0x4e, 0xfa, 0x90, 0x55, 0xe4, 0x73, 0x3d, 0x87, 0x3a, 0x3f, 0xc0, 0x1e, 0x09, 0x7e,
+	0x35, 0xdc, 0x74, 0x07, 0x92, 0x03, 0xe4, 0x6c, 0xa0, 0x2a, 0x48, 0x91, 0xbd, 0x06, 0xce, 0x71,
+	0x89, 0x12, 0x15, 0xd1, 0x4d, 0xc6, 0xb6, 0xd5, 0xc7, 0x66, 0x59, 0xab, 0xc3, 0x4d, 0x3e, 0x44,
+	0xa2, 0x3c, 0xf4, 0xb3, 0xde, 0xd0, 0xa2, 0xc5, 0x21, 0x2a, 0xf8, 0x9e, 0xa3, 0xc9, 0xa9, 0x6d,
+	0xf9, 0x43, 0x01, 0x0d, 0x1d, 0x9e, 0x9b, 0x33, 0x40, 0xea, 0xf2, 0xb1, 0xea, 0xfe, 0x2a, 0xc0,
+	0xce, 0x71, 0xd8, 0x69, 0x91, 0xc1, 0x90, 0xd4, 0xce, 0x71, 0x92, 0xbb, 0x5a, 0x3c, 0xc7, 0xa8,
+	0xcf, 0x7d, 0x91, 0x6b, 0xfc, 0x5a, 0x26, 0xac, 0x72, 0xa9, 0xff, 0xff, 0x50, 0xe5, 0x0e, 0x1f,
+	0x19, 0x97, 0xb6, 0x6b, 0x98, 0x5a, 0x0b, 0x56, 0xfd, 0xe8, 0x98, 0x53, 0x62, 0x93, 0x9f, 0x36,
+	0x9e, 0xba, 0x29, 0x24, 0x7d, 0x8a, 0x28, 0xf4, 0x3a, 0xb3, 0x71, 0x42, 0x4b, 0x23, 0xff, 0xd9,
+	0xa0, 0x25, 0xb7, 0x42, 0x1e, 0xb1, 0xd1, 0xf8, 0x32, 0xac, 0x77, 0xe3, 0x0d, 0x91, 0xbd, 0x10,
+	0x14, 0x0e, 0xde, 0x4d, 0x77, 0x42, 0xe1, 0x50, 0xcd, 0xc6, 0xbf, 0x2f, 0x01, 0xc4, 0x69, 0xc0,
+	0x39, 0x6b, 0x7e, 0x5e, 0x55, 0xcb, 0x4c, 0x52, 0x3e, 0xf7, 0xcc, 0x49, 0xf9, 0x77, 0x23, 0x67,
+	0x88, 0x03, 0xfb, 0xd3, 0xa5, 0xfd, 0x71, 0x9f, 0xa6, 0x5d, 0xa0, 0x54, 0xd1, 0x57, 0x61, 0xba,
+	0xe8, 0xeb, 0xee, 0x6c, 0x85, 0xe8, 0x94, 0x32, 0x8a, 0x83, 0x40, 0xa5, 0x54, 0x10, 0xa8, 0x8e,
+	0x75, 0xf3, 0x86, 0xe9, 0x3a, 0xf6, 0x65, 0x98, 0xfb, 0x0d, 0xdb, 0xda, 0x9b, 0x50, 0x08, 0xe8,
+	0xa4, 0x56, 0xf9, 0x6e, 0xee, 0xe9, 0x1f, 0x8e, 0x71, 0x51, 0xb3, 0x59, 0xbe, 0x2a, 0xeb, 0x64,
+	0x3b, 0xa2, 0xac, 0x27, 0x20, 0xda, 0x26, 0x68, 0x16, 0x7a, 0xc4, 0xb6, 0x2d, 0xcd, 0xad, 0xcb,
+	0x1d, 0x4e, 0xc9, 0x92, 0x2d, 0x54, 0xd6, 0xe7, 0x3c, 0x09, 0xbf, 0xff, 0x4a, 0xfc, 0xfd, 0xa9,
+	0xcb, 0xe7, 0x96, 0x8f, 0x23, 0xad, 0xf2, 0x86, 0x15, 0xb6, 0xd1, 0xda, 0x0a, 0x17, 0x2c, 0xcf,
+	0x25, 0x49, 0x6f, 0x5c, 0xd7, 0x70, 0xc5, 0xd3, 0x70, 0x7a, 0x39, 0x0a, 0xb6, 0xc6, 0x5b, 0x64,
+	0x04, 0x20, 0x4d, 0xde, 0x77, 0x1d, 0xda, 0x73, 0x85, 0xd2, 0xe4, 0xaa, 0x8d, 0xe3, 0x1d, 0xdb,
+	0x13, 0xcf, 0xb0, 0xe9, 0xe9, 0x3a, 0x3d, 0x4d, 0x40, 0x1a, 0xff, 0x2b, 0x1b, 0x39, 0x9c, 0x15,
+	0x28, 0x9c, 0x1a, 0xbe, 0xd5, 0xe7, 0xdd, 0x4d, 0x19, 0x8a, 0xbc, 0xbb, 0x05, 0xae, 0xe9, 0x8a,
+	0x2c, 0xfa, 0x8e, 0xbe, 0x54, 0x89, 0xb4, 0xf8, 0x5c, 0x9c, 0xc8, 0xa3, 0x0a, 0x08, 0x25, 0x89,
+	0xeb, 0xbe, 0x88, 0x94, 0xc2, 0xa2, 0x66, 0x54, 0x51, 0x4b, 0x31, 0x0b, 0xda, 0x62, 0x44, 0x19,
+	0x71, 0x1c, 0x37, 0x90, 0x1c, 0x14, 0x26, 0xb9, 0x17, 0x80, 0x6c, 0xc2, 0x83, 0x1e, 0x62, 0x19,
+	0x9d, 0xb9, 0x90, 0x29, 0x47, 0x72, 0x7d, 0x72, 0x75, 0x57, 0x70, 0xdd, 0xa7, 0x1f, 0x88, 0x2a,
+	0xf6, 0x28, 0x3e, 0x6e, 0x27, 0x56, 0x91, 0xab, 0x41, 0xd5, 0x48, 0x6b, 0xf8, 0xf3, 0x9c, 0x6a,
+	0x94, 0x04, 0xbe, 0xd5, 0x44, 0xbd, 0xb4, 0x8e, 0x3d, 0x8b, 0x4c, 0x3f, 0xa1, 0xa1, 0xaf, 0x3a,
+	0x36, 0xd0, 0x71, 0xb4, 0xc6, 0x86, 0x13, 0x88, 0xe7, 0x70, 0xa8, 0x63, 0xf3, 0x4c, 0xdc, 0xc0,
+	0x97, 0x61, 0xfd, 0xfc, 0x8e, 0x1c, 0x7b, 0x12, 0x75, 0x9a, 0x29, 0x9e, 0x47, 0x6c, 0x86, 0x79,
+	0x28, 0x33, 0xe2, 0x26, 0x62, 0x07, 0xc6, 0x40, 0xdc, 0x42, 0xed, 0xe8, 0x60, 0xe0, 0x02, 0xd5,
+	0x1f, 0x76, 0xa4, 0x86, 0xf1, 0x98, 0x91, 0xe5, 0xfb, 0x96, 0x33, 0x50, 0x3a, 0xea, 0x05, 0x9c,
+	0x5d, 0xb6, 0x6d, 0x7d, 0x51, 0x6f, 0xfc, 0x66, 0x5c, 0x0f, 0xff, 0x7a, 0xe4, 0x0e, 0x2e, 0xb2,
+	0xf4, 0xd0, 0x61, 0x9c, 0xa7, 0x07, 0x5a, 0xb0, 0xee, 0xc9, 0x5f, 0x9e, 0x58, 0xa9, 0x53, 0x22,
+	0xb9, 0xeb, 0xcb, 0x90, 0x66, 0x29, 0x1a, 0xe7, 0xb0, 0x1e, 0x36, 0x1e, 0x59, 0xc1, 0x90, 0x02,
+	0x7a, 0x78, 0xfc, 0x2f, 0x3a, 0xc6, 0x92, 0x99, 0x7b, 0xfc, 0x2f, 0x62, 0x19, 0x21, 0xc6, 0xd9,
+	0x9d, 0xec, 0x02, 0xd9, 0x9d, 0xc6, 0xdf, 0x2a, 0x25, 0x62, 0x7a, 0xec, 0x20, 0x9b, 0x91, 0x83,
+	0x3c, 0x5b, 0x3e, 0x10, 0x27, 0x6c, 0xb2, 0xcf, 0x92, 0xb0, 0x99, 0x57, 0xd4, 0xf3, 0x1e, 0xfa,
+	0x6b, 0xb4, 0xaa, 0x8f, 0x17, 0x48, 0x46, 0xa5, 0x70, 0xb5, 0x2d, 0x2a, 0x06, 0x30, 0xba, 0x5c,
+	0x71, 0x56, 0x98, 0x7b, 0xa8, 0x2c, 0x99, 0xf5, 0x57, 0x98, 0x7a, 0x82, 0x2a, 0xa1, 0x03, 0x8b,
+	0xf3, 0x74, 0x20, 0xc6, 0x2a, 0x94, 0x76, 0x8c, 0xda, 0x9c, 0xbb, 0xe3, 0xdf, 0x21, 0x7b, 0xd2,
+	0x0f, 0x65, 0x7d, 0x06, 0x8e, 0x86, 0xe2, 0x68, 0x62, 0x07, 0x96, 0xb2, 0x74, 0xb9, 0x31, 0x7d,
+	0xea, 0xb5, 0x32, 0x7b, 0xea, 0xf5, 0x03, 0x00, 0x5f, 0xe2, 0xca, 0xda, 0xb1, 0xfa, 0x81, 0xaa,
+	0x4b, 0xbb, 0x73, 0xd5, 0xd8, 0x54, 0x52, 0x2d, 0x41, 0x81, 0xfd, 0x1f, 0x19, 0x17, 0x94, 0x68,
+	0x57, 0x05, 0x34, 0x51, 0x7b, 0x7a, 0x67, 0x58, 0x9d, 0xdd, 0x19, 0xde, 0x0c, 0x6d, 0xfc, 0x1b,
+	0xd7, 0x7e, 0xdf, 0xcd, 0x94, 0x5d, 0x8f, 0x91, 0x63, 0xd4, 0x9d, 0xae, 0x47, 0x47, 0xb6, 0x2a,
+	0x7a, 0xd8, 0x4c, 0x69, 0xe7, 0x9b, 0x53, 0xda, 0x79, 0x2a, 0x8b, 0x77, 0x6b, 0x26, 0x8b, 0x57,
+	0xff, 0x15, 0x28, 0x76, 0xc6, 0x09, 0xc9, 0x8c, 0x43, 0x37, 0x61, 0x84, 0x39, 0x9b, 0x88, 0x30,
+	0x47, 0xf5, 0xd1, 0xb9, 0x64, 0x7d, 0xf4, 0xd4, 0xb9, 0xcf, 0xc2, 0xec, 0xb9, 0xcf, 0x1a, 0x94,
+	0x5c, 0xcc, 0x64, 0x44, 0x45, 0x71, 0x61, 0xb3, 0xf1, 0x29, 0x14, 0xd8, 0x17, 0x81, 0xd0, 0x0c,
+	0x66, 0x13, 0x1a, 0x27, 0x44, 0x64, 0x30, 0x5a, 0xe6, 0x4b, 0xb2, 0xb1, 0x64, 0xd7, 0x18, 0x49,
+	0x52, 0xce, 0x59, 0xad, 0x06, 0x37, 0x18, 0xd7, 0x4f, 0x3f, 0x21, 0x43, 0xcf, 0xb6, 0x4e, 0x3d,
+	0xc3, 0xbb, 0x14, 0xf9, 0xc6, 0x07, 0x54, 0xec, 0x11, 0x0a, 0xe3, 0x72, 0x74, 0x02, 0x97, 0xb7,
+	0x03, 0x53, 0xe9, 0x3a, 0xaa, 0x15, 0x52, 0x7e, 0x37, 0xd7, 0x62, 0x92, 0x63, 0x4b, 0xb1, 0xbb,
+	0x95, 0xa4, 0x6d, 0xf1, 0x53, 0x5b, 0xab, 0x8d, 0xad, 0x84, 0xa5, 0x9a, 0x2e, 0xae, 0xcc, 0x2c,
+	0x5a, 0x5c, 0xd9, 0x78, 0x00, 0x6b, 0x7a, 0x7a, 0x2f, 0xd1, 0xde, 0x85, 0x92, 0x3b, 0x4e, 0xf2,
+	0x79, 0x9a, 0x4c, 0x87, 0xe8, 0x8d, 0xdf, 0xcf, 0xc0, 0x4a, 0xdb, 0x09, 0xa4, 0xe7, 0x18, 0xf6,
+	0xae, 0x6d, 0x0c, 0xb4, 0x77, 0x42, 0x0d, 0x37, 0x3f, 0x4e, 0x94, 0xc4, 0x4d, 0x2b, 0x3b, 0x5b,
+	0x65, 0x4a, 0xb0, 0x86, 0x46, 0x9a, 0x56, 0xe0, 0x7a, 0x6c, 0x9f, 0x87, 0x35, 0xb0, 0x37, 0x40,
+	0x30, 0xb8, 0x4b, 0xcb, 0xa9, 0xc7, 0x9f, 0xb9, 0x06, 0x37, 0x52, 0xd0, 0xd0, 0xf8, 0xce, 0x6a,
+	0xb7, 0xa1, 0x16, 0xef, 0x82, 0x3b, 0xae, 0x13, 0xb4, 0x31, 0xc5, 0x46, 0xc6, 0x9d, 0xc8, 0x35,
+	0x7e, 0x23, 0x32, 0x2b, 0x8f, 0x55, 0x85, 0xac, 0xe7, 0xba, 0xf1, 0xf1, 0x6b, 0xd5, 0x4a, 0x1c,
+	0xf3, 0xcf, 0x2e, 0x70, 0xcc, 0xff, 0x83, 0xf8, 0xa8, 0x36, 0x6f, 0x32, 0x2f, 0xce, 0xdd, 0xb9,
+	0x8e, 0x29, 0x4b, 0xc4, 0x88, 0x5d, 0x99, 0x38, 0xb7, 0xfd, 0x86, 0x72, 0x25, 0xf3, 0x8b, 0x58,
+	0xdf, 0x84, 0xaa, 0xbd, 0x3d, 0x7d, 0x3e, 0x68, 0xb1, 0x02, 0xdb, 0x19, 0x03, 0x19, 0x9e, 0xd9,
+	0x40, 0xfe, 0xfa, 0x94, 0xd7, 0x56, 0x9e, 0x1b, 0x3a, 0xbd, 0xe6, 0xf4, 0xf3, 0xd7, 0xa1, 0x34,
+	0xb4, 0xfc, 0xc0, 0xf5, 0xf8, 0x44, 0xfe, 0xec, 0x09, 0xc2, 0xc4, 0x6c, 0xed, 0x31, 0x22, 0x55,
+	0x43, 0x86, 0x54, 0xda, 0x37, 0x60, 0x9d, 0x26, 0xfe, 0x28, 0xb6, 0x56, 0xfc, 0xda, 0xf2, 0xdc,
+	0x2a, 0xd4, 0x04, 0xab, 0xad, 0x29, 0x12, 0x7d, 0x96, 0x49, 0x7d, 0x00, 0x10, 0x7f, 0x9f, 0x19,
+	0xfd, 0xf6, 0x19, 0x4e, 0xe4, 0x63, 0x05, 0xf6, 0xe4, 0x34, 0x4e, 0xa9, 0xaa, 0x56, 0xfd, 0x02,
+	0xea, 0x33, 0x96, 0xc5, 0x91, 0xf4, 0xb8, 0xbb, 0xd7, 0x5e, 0x0b, 0xf0, 0x41, 0xf2, 0xc3, 0xb3,
+	0x70, 0xde, 0xbd, 0xe2, 0xeb, 0x45, 0x9c, 0x13, 0x12, 0x50, 0x7f, 0x1b, 0x96, 0x13, 0x93, 0x8a,
+	0x3a, 0x7b, 0xe2, 0x98, 0x6e, 0x18, 0xae, 0xc7, 0xdf, 0x1a, 0x1d, 0x8b, 0x34, 0xc3, 0x80, 0x3d,
+	0xfd, 0xae, 0xeb, 0x20, 0xa6, 0x27, 0xf0, 0x1a, 0xcf, 0xfe, 0x45, 0xa8, 0x26, 0x4c, 0xc9, 0x28,
+	0x94, 0x9b, 0x06, 0x36, 0xce, 0xe1, 0x73, 0x09, 0x76, 0x47, 0xd2, 0x23, 0x23, 0xd1, 0x75, 0xd8,
+	0x49, 0x25, 0x93, 0xde, 0x94, 0x4e, 0x60, 0x05, 0xa1, 0x06, 0x8d, 0xda, 0xda, 0xcf, 0x41, 0x61,
+	0x2c, 0xbd, 0x91, 0xaf, 0xb4, 0xe8, 0xb4, 0x04, 0xcd, 0x65, 0xeb, 0xeb, 0x4c, 0xd3, 0xf8, 0xdd,
+	0x0c, 0x94, 0x31, 0xf3, 0x61, 0x1a, 0x81, 0xa1, 0x1d, 0x4c, 0xbd, 0x65, 0xb6, 0x0c, 0x20, 0x44,
+	0xdd, 0x54, 0x6e, 0xf3, 0x66, 0x5b, 0xe1, 0xab, 0x36, 0x66, 0x8e, 0x43, 0x16, 0xf5, 0x2d, 0x28,
+	0x29, 0x70, 0xfd, 0x1d, 0x58, 0x9b, 0xc2, 0xa4, 0x79, 0x61, 0x9f, 0xa2, 0x7b, 0x39, 0x0a, 0x0b,
+	0xdb, 0x56, 0xf4, 0x34, 0x10, 0x13, 0x35, 0x63, 0x26, 0x68, 0xfc, 0xa3, 0xe7, 0xa9, 0x9c, 0x2a,
+	0x32, 0xa6, 0x67, 0x64, 0xf2, 0x0e, 0x00, 0xc7, 0x09, 0x69, 0xbb, 0xe6, 0xf0, 0x7a, 0x02, 0xa2,
+	0xbd, 0x17, 0xe5, 0x45, 0xf2, 0x73, 0x0d, 0xb2, 0x24, 0xf3, 0xe9, 0xe4, 0x48, 0x0d, 0x4a, 0x96,
+	0x4f, 0xf1, 0x3f, 0x55, 0xa8, 0x16, 0x36, 0xb5, 0xaf, 0x41, 0xd1, 0x1a, 0x8d, 0x5d, 0x2f, 0x50,
+	0x89, 0x93, 0x6b, 0xb9, 0xb6, 0x09, 0x13, 0x53, 0xfd, 0x4c, 0x83, 0xd4, 0xf2, 0x82, 0xa8, 0xcb,
+	0x4f, 0xa7, 0x6e, 0x5d, 0x84, 0xd4, 0x4c, 0xa3, 0x7d, 0x0c, 0xd5, 0x01, 0xd7, 0xe9, 0x32, 0x63,
+	0xa5, 0x44, 0xbe, 0x78, 0x1d, 0x93, 0xfb, 0x49, 0x82, 0xbd, 0x25, 0x3d, 0xcd, 0x01, 0x59, 0xa2,
+	0xf1, 0x2f, 0xfd, 0xa0, 0xe7, 0x7e, 0xe4, 0x5a, 0x4e, 0x0d, 0x9e, 0xce, 0x52, 0x4f, 0x12, 0x20,
+	0xcb, 0x14, 0x07, 0xed, 0x2b, 0x68, 0x0b, 0xf9, 0x81, 0xba, 0x14, 0xe1, 0xee, 0x75, 0x9c, 0x7a,
+	0xd2, 0x57, 0xd7, 0x19, 0xf8, 0x81, 0x76, 0x01, 0xf5, 0xc4, 0x22, 0x51, 0x2f, 0x69, 0x8e, 0xc7,
+	0x1e, 0xde, 0x8c, 0x42, 0xa6, 0xe3, 0xf2, 0xbd, 0xaf, 0x5c, 0xc7, 0xed, 0xe8, 0x4a, 0xea, 0xbd,
+	0x25, 0xfd, 0x1a, 0xde, 0x5a, 0x0f, 0x3d, 0x4a, 0x35, 0x84, 0x7d, 0x69, 0x9c, 0x87, 0x57, 0x2a,
+	0x6c, 0x2c, 0x34, 0x0b, 0x44, 0xb1, 0xb7, 0xa4, 0x4f, 0xf1, 0xd0, 0x7e, 0x01, 0xd6, 0x53, 0xef,
+	0xa4, 0x53, 0xd4, 0x7c, 0xe1, 0xc2, 0x97, 0x17, 0x1e, 0x06, 0x12, 0xe1, 0x71, 0xfd, 0x19, 0x4e,
+	0xda, 0x04, 0x5e, 0x98, 0x1d, 0xd2, 0x8e, 0xec, 0xdb, 0x96, 0x23, 0xd5, 0xdd, 0x0c, 0x6f, 0x3f,
+	0xdb, 0x6c, 0x29, 0xe2, 0xbd, 0x25, 0xfd, 0x6a, 0xce, 0xda, 0xaf, 0xc0, 0xed, 0xf1, 0x5c, 0x15,
+	0xc3, 0xaa, 0x4b, 0x5d, 0xed, 0xf0, 0xee, 0x82, 0x6f, 0x9e, 0xa1, 0xdf, 0x5b, 0xd2, 0xaf, 0xe5,
+	0x8f, 0x56, 0x35, 0x79, 0xee, 0xea, 0x60, 0x02, 0x37, 0x28, 0xab, 0xde, 0xb7, 0x31, 0xb2, 0x16,
+	0x65, 0x6e, 0x62, 0x40, 0xfd, 0x3f, 0x65, 0xa0, 0xa8, 0xe4, 0xfd, 0x76, 0x54, 0xf6, 0x11, 0xa9,
+	0xee, 0x18, 0xa0, 0xbd, 0x0f, 0x15, 0xe9, 0x79, 0xae, 0x87, 0x85, 0x0e, 0xb5, 0xec, 0xdc, 0xe8,
+	0x36, 0xf3, 0xd9, 0x6c, 0x85, 0x68, 0x7a, 0x4c, 0xa1, 0xbd, 0x07, 0xc0, 0xeb, 0xbc, 0x17, 0x9f,
+	0x2f, 0xab, 0xcf, 0xa7, 0xe7, 0x74, 0x61, 0x8c, 0x1d, 0x87, 0x03, 0xc3, 0x5c, 0x5d, 0xd8, 0x8c,
+	0x9c, 0xd5, 0x42, 0xc2, 0x59, 0xbd, 0xad, 0xe2, 0x17, 0x14, 0xd6, 0x51, 0xa7, 0x2c, 0x23, 0x40,
+	0xfd, 0x1f, 0x66, 0xb0, 0x1e, 0x8e, 0xc6, 0xdb, 0x9a, 0x1d, 0xd1, 0xcb, 0x4f, 0xd7, 0x39, 0x9b,
+	0xd3, 0x23, 0xfb, 0x1a, 0x80, 0xbc, 0x08, 0xfb, 0xaa, 0x46, 0x76, 0x7b, 0x8a, 0x8f, 0x22, 0x0d,
+	0x0b, 0xda, 0x63, 0x7c, 0x0c, 0xfd, 0x13, 0x17, 0x0c, 0x45, 0x3f, 0xdc, 0xdf, 0x17, 0x4b, 0x18,
+	0x16, 0x79, 0x78, 0xf8, 0xe0, 0xb0, 0xf3, 0xe8, 0xf0, 0xa4, 0xa5, 0xeb, 0x1d, 0x9d, 0x23, 0xd2,
+	0x5b, 0xcd, 0x9d, 0x93, 0xf6, 0xe1, 0xd1, 0xc3, 0x9e, 0xc8, 0xd6, 0xff, 0x4e, 0x06, 0xaa, 0x29,
+	0xdd, 0xf5, 0x47, 0xfb, 0xe9, 0x12, 0xd3, 0x9f, 0x9b, 0x3f, 0xfd, 0xf9, 0xab, 0xa6, 0xbf, 0x30,
+	0x3d, 0xfd, 0x7f, 0x23, 0x03, 0xd5, 0x94, 0x8e, 0x4c, 0x72, 0xcf, 0xa4, 0xb9, 0x27, 0x77, 0xfa,
+	0xec, 0xd4, 0x4e, 0x8f, 0x87, 0x9f, 0xd4, 0xef, 0xc3, 0x38, 0x5a, 0x91, 0x82, 0x25, 0x71, 0xe8,
+	0x28, 0x4e, 0x3e, 0x8d, 0x83, 0xb0, 0xa7, 0xf4, 0x96, 0x8e, 0x1e, 0xfb, 0x74, 0x33, 0x43, 0xfd,
+	0x6a, 0x0d, 0x7a, 0xcd, 0x10, 0xee, 0xc3, 0xf2, 0x38, 0x5e, 0xa6, 0xcf, 0x66, 0x96, 0x24, 0x29,
+	0x9f, 0xd2, 0xcf, 0xef, 0x65, 0x60, 0x35, 0xad, 0x73, 0xff, 0x9f, 0x9e, 0xd6, 0xbf, 0x99, 0x81,
+	0xf5, 0x19, 0x4d, 0x7e, 0xad, 0x61, 0x37, 0xdd, 0xaf, 0xec, 0x02, 0xfd, 0xca, 0xcd, 0xe9, 0xd7,
+	0xd5, 0x9a, 0xe4, 0xfa, 0x1e, 0x77, 0xe1, 0x85, 0x2b, 0xf7, 0x84, 0x6b, 0xa6, 0x3a, 0xc5, 0x34,
+	0x37, 0xcd, 0xf4, 0x77, 0x32, 0x70, 0xfb, 0x3a, 0x7d, 0xff, 0x7f, 0x5d, 0xae, 0xa6, 0x7b, 0xd8,
+	0x78, 0x27, 0x2a, 0xfa, 0xc0, 0x0a, 0x37, 0x4e, 0x3c, 0xab, 0xd2, 0xfd, 0x21, 0x26, 0x29, 0x29,
+	0x02, 0xae, 0x4b, 0x43, 0xdd, 0x09, 0x81, 0x85, 0x50, 0x16, 0xe5, 0x66, 0x6f, 0x01, 0x34, 0xc9,
+	0xaf, 0x0b, 0x0f, 0x56, 0x6d, 0xef, 0x77, 0xba, 0x2d, 0xb1, 0x94, 0x34, 0x62, 0x3f, 0x0d, 0x15,
+	0x71, 0xe3, 0x08, 0x8a, 0xf1, 0x51, 0x17, 0x3c, 0xf4, 0x6c, 0x72, 0x06, 0x74, 0x05, 0xca, 0x47,
+	0xca, 0x85, 0xe2, 0x57, 0x7d, 0xd4, 0xed, 0x1c, 0x72, 0xb0, 0x7d, 0xa7, 0xd3, 0xe3, 0x03, 0x33,
+	0xdd, 0xe3, 0xfb, 0x9c, 0x8a, 0xbb, 0xaf, 0x37, 0x8f, 0xf6, 0x4e, 0x08, 0xa3, 0xd0, 0xf8, 0xeb,
+	0xf9, 0x70, 0x57, 0x6b, 0xfc, 0x92, 0xca, 0xad, 0x02, 0x14, 0x51, 0x9b, 0xbb, 0x8a, 0x71, 0xf4,
+	0x1a, 0x2a, 0xf2, 0x6e, 0x5d, 0x70, 0x1c, 0x42, 0x64, 0xb1, 0x22, 0xfb, 0xe8, 0x94, 0xab, 0xc6,
+	0xf6, 0x82, 0x91, 0xcd, 0x67, 0x76, 0x7b, 0x17, 0x81, 0x28, 0xe0, 0x8f, 0x6d, 0xff, 0x9c, 0xf3,
+	0x7a, 0x9d, 0x53, 0xdf, 0xa2, 0x33, 0x2c, 0xa5, 0xc6, 0xdf, 0xcd, 0x41, 0x25, 0x52, 0x9c, 0xcf,
+	0xa2, 0xc8, 0x31, 0x82, 0xde, 0x3e, 0xec, 0xb5, 0xf4, 0xc3, 0xe6, 0xbe, 0x42, 0xc9, 0x61, 0xe2,
+	0x7b, 0xb7, 0xbd, 0xdf, 0x3a, 0xd9, 0xef, 0x34, 0x77, 0x14, 0xb0, 0x8c, 0x07, 0x8c, 0xda, 0x07,
+	0x47, 0x1d, 0xbd, 0x77, 0xd2, 0xee, 0x9e, 0x6c, 0x37, 0x0f, 0xb7, 0x5b, 0xfb, 0xad, 0x1d, 0x51,
+	0xd4, 0x5e, 0x84, 0xbb, 0x87, 0x9d, 0x5e, 0xbb, 0x73, 0x78, 0x72, 0xd8, 0x39, 0xe9, 0x6c, 0x7d,
+	0xd4, 0xda, 0xee, 0x75, 0x4f, 0xda, 0x87, 0x27, 0xc8, 0xf5, 0xbe, 0xde, 0xc4, 0x27, 0xa2, 0xa0,
+	0xdd, 0x85, 0xdb, 0x0a, 0xab, 0xdb, 0xd2, 0x8f, 0x5b, 0x3a, 0x32, 0x79, 0x78, 0xd8, 0x3c, 0x6e,
+	0xb6, 0xf7, 0x9b, 0x5b, 0xfb, 0x2d, 0xb1, 0xa2, 0xdd, 0x81, 0xba, 0xc2, 0xd0, 0x9b, 0xbd, 0xd6,
+	0xc9, 0x7e, 0xfb, 0xa0, 0xdd, 0x3b, 0x69, 0x7d, 0x63, 0xbb, 0xd5, 0xda, 0x69, 0xed, 0x88, 0xaa,
+	0xf6, 0x45, 0xf8, 0x02, 0x75, 0x4a, 0x75, 0x22, 0xfd, 0xb2, 0x4f, 0xdb, 0x47, 0x27, 0x4d, 0x7d,
+	0x7b, 0xaf, 0x7d, 0xdc, 0x12, 0xab, 0xda, 0xcb, 0xf0, 0xf9, 0xab, 0x51, 0x77, 0xda, 0x7a, 0x6b,
+	0xbb, 0xd7, 0xd1, 0x3f, 0x11, 0xeb, 0xda, 0xcf, 0xc0, 0x0b, 0x7b, 0xbd, 0x83, 0xfd, 0x93, 0x47,
+	0x7a, 0xe7, 0xf0, 0xfe, 0x09, 0xfd, 0xec, 0xf6, 0xf4, 0x87, 0xdb, 0xbd, 0x87, 0x7a, 0x4b, 0x00,
+	0xa6, 0x47, 0x8f, 0xb6, 0x4e, 0x0e, 0x3b, 0xbd, 0x93, 0xe6, 0xe1, 0x27, 0x5b, 0xfb, 0x9d, 0xed,
+	0x07, 0x27, 0xbb, 0x1d, 0xfd, 0xa0, 0xd9, 0x13, 0xcb, 0xda, 0x97, 0xe0, 0xe5, 0xed, 0xee, 0xb1,
+	0xea, 0x66, 0x67, 0xf7, 0x44, 0xef, 0x3c, 0xea, 0x9e, 0x74, 0xf4, 0x13, 0xbd, 0xb5, 0x4f, 0x63,
+	0xee, 0xc6, 0x7d, 0x2f, 0x61, 0xe4, 0xa7, 0x7d, 0xd8, 0x7d, 0xb8, 0xbb, 0xdb, 0xde, 0x6e, 0xb7,
+	0x0e, 0x7b, 0x27, 0x47, 0x2d, 0xfd, 0xa0, 0xdd, 0xed, 0x22, 0x9a, 0xa8, 0x34, 0x3e, 0xc4, 0xbb,
+	0x46, 0xce, 0xad, 0x80, 0x56, 0x9b, 0x12, 0x4d, 0xe5, 0x7f, 0x85, 0x4d, 0x5a, 0x24, 0xd6, 0xc0,
+	0xa1, 0x9b, 0x29, 0x68, 0xad, 0xad, 0xe8, 0x31, 0xa0, 0xf1, 0xe7, 0x0a, 0x50, 0x65, 0x16, 0xa1,
+	0x3f, 0xf7, 0x0a, 0xac, 0xa9, 0xa0, 0x6a, 0x3b, 0xad, 0xd0, 0xa6, 0xc1, 0x74, 0xe5, 0x1b, 0x83,
+	0x12, 0x6a, 0x2d, 0x09, 0xc2, 0x0a, 0x9f, 0x90, 0x08, 0xb3, 0x5a, 0x96, 0xa9, 0x12, 0x6a, 0x53,
+	0x50, 0xed, 0x17, 0xe1, 0x85, 0x04, 0xa4, 0xe5, 0xf4, 0xbd, 0xcb, 0x71, 0x74, 0x93, 0x62, 0x75,
+	0x6e, 0x40, 0x00, 0xcf, 0xac, 0xa7, 0x10, 0xf5, 0xab, 0x59, 0x50, 0x89, 0x4a, 0xdf, 0x46, 0xe7,
+	0x94, 0xb3, 0xb7, 0xaa, 0xf5, 0x59, 0x35, 0x2a, 0x6a, 0x6b, 0x46, 0x54, 0xa3, 0x62, 0x43, 0x2d,
+	0x05, 0xc3, 0x79, 0x8c, 0xda, 0xea, 0x48, 0x0b, 0xba, 0x60, 0x55, 0x7d, 0x1a, 0x1c, 0x55, 0x30,
+	0x3d, 0xbc, 0x20, 0x23, 0x6c, 0x99, 0xb0, 0x92, 0x20, 0xed, 0x53, 0xb8, 0x15, 0x11, 0x4d, 0xcd,
+	0x4e, 0x69, 0xc1, 0xd9, 0xb9, 0x8a, 0x81, 0xf6, 0x55, 0x00, 0x8b, 0x04, 0x80, 0x5e, 0xce, 0xe7,
+	0x12, 0x5f, 0x98, 0x89, 0x74, 0x86, 0x08, 0x7a, 0x02, 0x19, 0x37, 0xbd, 0x01, 0xee, 0x25, 0x0f,
+	0xd4, 0x45, 0x94, 0x2b, 0x7a, 0xd4, 0xc6, 0x09, 0xe4, 0x7a, 0x1e, 0xbf, 0xc9, 0x37, 0xe0, 0xe5,
+	0xf4, 0x18, 0x80, 0x13, 0x3f, 0x32, 0x2e, 0x1e, 0xfa, 0xd2, 0x27, 0x8f, 0xac, 0xa0, 0x87, 0x4d,
+	0x3c, 0xd7, 0x12, 0x87, 0x18, 0x38, 0x84, 0x70, 0xed, 0xe6, 0x3a, 0x2f, 0x55, 0x86, 0x4e, 0xbe,
+	0xfa, 0x32, 0xca, 0xe6, 0x53, 0x4d, 0xed, 0x08, 0x34, 0x6b, 0x76, 0x0e, 0xf3, 0x0b, 0xce, 0xe1,
+	0x1c, 0xda, 0xe9, 0x4c, 0x47, 0x61, 0x36, 0xd3, 0x81, 0xc5, 0x65, 0xb6, 0x7b, 0xaa, 0x52, 0xb5,
+	0x45, 0x55, 0x5c, 0x16, 0x41, 0x1a, 0x7f, 0x36, 0x03, 0x37, 0xa7, 0x46, 0x8c, 0x51, 0x2e, 0x9c,
+	0xc4, 0x3d, 0x58, 0xb3, 0xd2, 0x4f, 0x54, 0x3c, 0x67, 0x3a, 0xa6, 0x3d, 0x45, 0xaf, 0x4f, 0x93,
+	0xa1, 0x34, 0x2a, 0x2f, 0x36, 0x0c, 0xfd, 0x28, 0x5d, 0x30, 0x0d, 0x6e, 0xd8, 0x50, 0x0e, 0xef,
+	0xfa, 0xc4, 0x75, 0x83, 0xd4, 0x71, 0x28, 0x99, 0x5b, 0xda, 0x1e, 0x96, 0x89, 0xa6, 0xa6, 0x30,
+	0xbb, 0xe0, 0x14, 0x4e, 0xd1, 0x35, 0xbe, 0x0a, 0xeb, 0x33, 0x48, 0xf8, 0x4d, 0xc7, 0x58, 0x62,
+	0xc7, 0x2f, 0xa5, 0xdf, 0xb3, 0x05, 0x19, 0x8d, 0x7f, 0x99, 0x85, 0x95, 0x03, 0xc3, 0xb1, 0xce,
+	0xa4, 0x1f, 0x50, 0x6f, 0x6f, 0x41, 0xd1, 0xef, 0x0f, 0xe5, 0xc8, 0x08, 0x0d, 0x8e, 0x17, 0xb9,
+	0xa9, 0x02, 0x4c, 0xd9, 0x64, 0x52, 0x67, 0x26, 0x4b, 0x88, 0x2a, 0x62, 0x12, 0x0c, 0xa3, 0xd3,
+	0x28, 0xaa, 0x85, 0xb2, 0x64, 0x5b, 0x7d, 0xe9, 0xf8, 0xa1, 0x1a, 0x08, 0x9b, 0x71, 0x81, 0x56,
+	0xf1, 0x9a, 0x02, 0xad, 0xd2, 0xac, 0x3c, 0xe0, 0x72, 0xef, 0x7b, 0x52, 0x3a, 0xfe, 0xd0, 0x0d,
+	0xc2, 0x8b, 0x62, 0x93, 0x20, 0xaa, 0x30, 0x75, 0x9f, 0x38, 0xa8, 0x8e, 0x31, 0x3e, 0xad, 0xca,
+	0x22, 0x53, 0x30, 0x5c, 0x13, 0x14, 0x5e, 0xc3, 0xa3, 0xf8, 0xc0, 0xd9, 0xb7, 0xb0, 0x4d, 0x01,
+	0x34, 0x23, 0x90, 0x03, 0xd7, 0xb3, 0x24, 0x47, 0x91, 0x2b, 0x7a, 0x02, 0x82, 0xb4, 0xb6, 0xe1,
+	0x0c, 0x26, 0x78, 0x57, 0x0f, 0x2b, 0xe4, 0xa8, 0xdd, 0xf8, 0xcf, 0x05, 0x80, 0x03, 0x89, 0xa7,
+	0x95, 0xfc, 0xa1, 0x35, 0xc6, 0xa9, 0x0a, 0x2c, 0x55, 0x6a, 0x5f, 0xd5, 0xe9, 0x37, 0x96, 0x93,
+	0x24, 0x8e, 0xc7, 0xcc, 0xe6, 0xb4, 0x63, 0xf2, 0xe9, 0xe8, 0x1b, 0x4e, 0x8e, 0x11, 0x48, 0x55,
+	0x1b, 0x47, 0xf3, 0x9f, 0xd7, 0x93, 0x20, 0xec, 0x1a, 0x36, 0x5b, 0x8e, 0xc9, 0xd1, 0xbd, 0xbc,
+	0x1e, 0xb5, 0x91, 0xda, 0xf2, 0xf1, 0xba, 0x11, 0x5d, 0x3a, 0xf2, 0x49, 0x74, 0xd0, 0x34, 0x06,
+	0x69, 0x07, 0x18, 0xa3, 0xbd, 0x1c, 0xe1, 0xf9, 0x2c, 0x19, 0x0c, 0x5d, 0xb3, 0x56, 0x9c, 0xeb,
+	0x18, 0x27, 0x3a, 0x78, 0x94, 0x44, 0xd7, 0xd3, 0xd4, 0x28, 0x13, 0x8e, 0x4f, 0xab, 0x96, 0x3f,
+	0xa3, 0x6a, 0x61, 0x56, 0x98, 0x7f, 0x25, 0x54, 0xe6, 0x4c, 0xc0, 0xcf, 0x18, 0x49, 0x5f, 0x7a,
+	0x58, 0x0e, 0x10, 0x62, 0xea, 0x09, 0x2a, 0xd4, 0x8f, 0x13, 0x5f, 0x7a, 0xad, 0x91, 0x61, 0xd9,
+	0xea, 0x03, 0xc7, 0x00, 0xbc, 0xd3, 0xc0, 0x9f, 0x9c, 0xa2, 0xcc, 0x9c, 0xca, 0x9e, 0x7b, 0x28,
+	0x9f, 0xf8, 0xb6, 0x0c, 0x02, 0xe9, 0xa9, 0x62, 0x99, 0xf9, 0x0f, 0x1b, 0x83, 0xc8, 0xe2, 0xa5,
+	0x4b, 0x89, 0xf0, 0x57, 0x5c, 0x91, 0x17, 0x81, 0x54, 0xb9, 0xa2, 0xc8, 0x60, 0x55, 0x03, 0x83,
+	0x54, 0x35, 0x63, 0x56, 0xfb, 0x02, 0xfc, 0x6c, 0x0a, 0x49, 0xe7, 0xfa, 0x01, 0x7f, 0xd7, 0x72,
+	0x0c, 0xdb, 0xfa, 0x16, 0x17, 0x3f, 0xe4, 0x1a, 0x63, 0xa8, 0xa6, 0x26, 0x8e, 0x4e, 0x46, 0xd3,
+	0x2f, 0x55, 0xd2, 0x25, 0x60, 0x85, 0xdb, 0x78, 0x35, 0x12, 0x25, 0xb7, 0x22, 0xc8, 0x36, 0x2e,
+	0x74, 0xac, 0x3a, 0xb9, 0x01, 0x82, 0x21, 0x6d, 0xc7, 0x18, 0x8f, 0x9b, 0xe3, 0xb1, 0x8d, 0xb9,
+	0x4b, 0x3c, 0x75, 0x1e, 0x43, 0xf9, 0x90, 0x8c, 0xc8, 0x37, 0xbe, 0x01, 0xb7, 0x68, 0x66, 0x8e,
+	0xa5, 0x17, 0xc5, 0x34, 0xd4, 0x58, 0x9f, 0x87, 0x75, 0xfe, 0x75, 0xe8, 0x06, 0xfc, 0x98, 0xec,
+	0x7c, 0x0d, 0x56, 0x19, 0x8c, 0x86, 0x6d, 0x57, 0xd2, 0x59, 0xf2, 0x08, 0x16, 0xe1, 0x65, 0x1b,
+	0xff, 0xac, 0x08, 0x5a, 0x2c, 0x10, 0x3d, 0x0b, 0xcf, 0xb9, 0x07, 0x46, 0x22, 0x28, 0x5d, 0xbd,
+	0xb2, 0x24, 0xe3, 0xe9, 0xc5, 0x98, 0x37, 0xa1, 0x68, 0xf9, 0xe8, 0x85, 0xab, 0x2a, 0x76, 0xd5,
+	0xd2, 0xf6, 0x01, 0xc6, 0xd2, 0xb3, 0x5c, 0x93, 0x24, 0xa8, 0x30, 0xf7, 0x94, 0xd2, 0x6c, 0xa7,
+	0x36, 0x8f, 0x22, 0x1a, 0x3d, 0x41, 0x8f, 0xfd, 0xe0, 0x16, 0x17, 0x38, 0x14, 0xd9, 0x80, 0x48,
+	0x80, 0xf0, 0x86, 0x8a, 0xb1, 0x67, 0xf5, 0x25, 0x7f, 0x8e, 0x87, 0xbe, 0xb9, 0x4d, 0x57, 0x79,
+	0x96, 0x08, 0x73, 0xde, 0x23, 0x94, 0x40, 0xc3, 0x21, 0xdf, 0xd4, 0xa7, 0x94, 0xbe, 0xba, 0x7d,
+	0x81, 0xab, 0xb8, 0xab, 0xfa, 0xfc, 0x87, 0x58, 0xb7, 0xa0, 0x1e, 0x1c, 0x58, 0xce, 0xbe, 0x74,
+	0x06, 0xc1, 0x90, 0x84, 0xbb, 0xaa, 0xcf, 0xc0, 0x49, 0x83, 0xf1, 0x85, 0x69, 0x9c, 0xb2, 0xab,
+	0xe8, 0x51, 0x5b, 0xa3, 0xbb, 0x41, 0x6c, 0xd7, 0xeb, 0x06, 0x9e, 0x2a, 0x58, 0x8f, 0xda, 0x64,
+	0x58, 0x51, 0x5f, 0x8f, 0x3c, 0xd7, 0x9c, 0x50, 0x42, 0x89, 0x95, 0xd8, 0x34, 0x38, 0xc6, 0x3c,
+	0x30, 0x1c, 0x55, 0x11, 0x5b, 0x4d, 0x62, 0x46, 0x60, 0x72, 0xbf, 0x5d, 0x3f, 0x66, 0xb8, 0xa6,
+	0xdc, 0xef, 0x04, 0x4c, 0xe1, 0xc4, 0xac, 0x44, 0x84, 0x13, 0xf3, 0xa1, 0xf1, 0x9b, 0x9e, 0x6b,
+	0x99, 0x31, 0x2f, 0x2e, 0xce, 0x9a, 0x81, 0x27, 0x70, 0x63, 0x9e, 0x5a, 0x0a, 0x37, 0xe6, 0x7b,
+	0x03, 0x0a, 0xee, 0xd9, 0x99, 0xf4, 0xe8, 0x7e, 0xdc, 0x8a, 0xce, 0x8d, 0xc6, 0xb7, 0x33, 0x00,
+	0xb1, 0x48, 0xe0, 0x42, 0x88, 0x5b, 0xf1, 0xc2, 0xbf, 0x05, 0xcf, 0x25, 0xc1, 0xb6, 0xaa, 0x75,
+	0xa6, 0xd5, 0x10, 0x3f, 0xc0, 0x93, 0xa9, 0x22, 0xab, 0x6e, 0x45, 0x50, 0x30, 0x3c, 0x04, 0x8b,
+	0x85, 0xa3, 0x37, 0x40, 0xc4, 0x40, 0x3a, 0xea, 0x8a, 0x15, 0xa4, 0x29, 0x54, 0x3c, 0xa8, 0xea,
+	0x8b, 0x42, 0xe3, 0x07, 0x1a, 0xac, 0xc4, 0x82, 0x7b, 0x7c, 0xaf, 0xbe, 0x0b, 0xc5, 0xe6, 0x88,
+	0x6a, 0x42, 0xf0, 0x9b, 0xd2, 0x19, 0xd7, 0x7e, 0x64, 0xc5, 0x85, 0x6d, 0x94, 0x60, 0x83, 0xb0,
+	0x58, 0x2e, 0x39, 0xe7, 0x93, 0x04, 0xd5, 0x1f, 0x41, 0xa9, 0xed, 0x9c, 0xbb, 0x56, 0x5f, 0xaa,
+	0x4a, 0x4c, 0x36, 0x85, 0xf2, 0x7c, 0x36, 0x51, 0x7b, 0x17, 0x0a, 0x81, 0x1b, 0x18, 0xb6, 0xca,
+	0x62, 0x36, 0xae, 0x5c, 0x4b, 0xc7, 0xf7, 0x36, 0xb9, 0x3f, 0x3a, 0x13, 0xd4, 0x7f, 0x37, 0x8b,
+	0xf7, 0xfe, 0x29, 0xb9, 0x43, 0xc3, 0x9e, 0x6b, 0xf9, 0xb7, 0x2e, 0x03, 0xe9, 0xab, 0x57, 0xa4,
+	0x60, 0x91, 0xf1, 0xaf, 0x53, 0xb9, 0x27, 0x77, 0xb6, 0xaa, 0xa7, 0x60, 0x11, 0xce, 0x23, 0xcf,
+	0xa2, 0x53, 0xe1, 0xb9, 0x04, 0x8e, 0x82, 0x11, 0xce, 0xd0, 0xf0, 0xa4, 0x99, 0x38, 0xe7, 0x52,
+	0xd5, 0x53, 0x30, 0xdc, 0x25, 0x02, 0x69, 0x8c, 0xba, 0xd2, 0x08, 0xf8, 0x14, 0x50, 0x55, 0x8f,
+	0x01, 0xc8, 0x41, 0xad, 0x2a, 0xae, 0xc2, 0xe1, 0x85, 0x9f, 0x82, 0x61, 0x7a, 0x2e, 0xb5, 0xf2,
+	0xd4, 0x9a, 0x4f, 0x03, 0x39, 0x89, 0x67, 0x9d, 0xe3, 0x46, 0xcc, 0x9d, 0xe1, 0x55, 0x9e, 0x06,
+	0xd6, 0xff, 0x45, 0x0e, 0x4a, 0x4a, 0x7e, 0xe7, 0x15, 0xca, 0x7c, 0x06, 0xfd, 0x48, 0x65, 0x9b,
+	0x3d, 0x77, 0xbc, 0x2f, 0xcf, 0xa5, 0xad, 0x74, 0x64, 0x02, 0xa2, 0x4e, 0xfb, 0x71, 0xf5, 0x54,
+	0x21, 0x3a, 0xed, 0x47, 0x6d, 0x4e, 0xe5, 0xb5, 0x9d, 0xc0, 0x73, 0x55, 0x61, 0x55, 0xd8, 0xc4,
+	0xd1, 0x58, 0xfe, 0xc3, 0xf1, 0xc0, 0x33, 0x4c, 0x49, 0x57, 0x0c, 0x73, 0x79, 0x55, 0x1a, 0xa8,
+	0xed, 0xc2, 0x0a, 0x29, 0x3e, 0x1f, 0x65, 0xd7, 0xbe, 0x24, 0x43, 0x6c, 0x31, 0xc9, 0x49, 0xd1,
+	0xe1, 0xdd, 0xe3, 0xdc, 0xa6, 0x95, 0x61, 0x5f, 0xd6, 0x2a, 0x0b, 0x33, 0x4a, 0x13, 0xa6, 0xb4,
+	0x1e, 0x4c, 0x69, 0xbd, 0x48, 0x03, 0x2c, 0x27, 0x34, 0x80, 0xf6, 0x61, 0x42, 0x87, 0xae, 0xdc,
+	0xcd, 0xcc, 0x29, 0xd3, 0x48, 0xbd, 0x36, 0x94, 0xf3, 0x58, 0xd3, 0xd6, 0x7f, 0x2f, 0x03, 0x2b,
+	0x47, 0x13, 0xaf, 0x3f, 0x34, 0x7c, 0xb6, 0xf9, 0xa7, 0x6c, 0xb4, 0xcc, 0xf5, 0x36, 0x5a, 0xf6,
+	0x7a, 0x1b, 0x2d, 0x37, 0x6b, 0xa3, 0xbd, 0x07, 0x45, 0xde, 0x99, 0xae, 0xc8, 0xde, 0xa6, 0x3a,
+	0xcc, 0x0a, 0x46, 0x57, 0x14, 0xf5, 0x7f, 0x92, 0x81, 0xaa, 0x12, 0x40, 0xb5, 0xf9, 0xef, 0x45,
+	0xb6, 0x28, 0xd7, 0xe8, 0xbc, 0x7e, 0x2d, 0xb7, 0x24, 0xe9, 0x94, 0x6d, 0xda, 0xb0, 0x7f, 0x62,
+	0xe3, 0x69, 0x03, 0x5e, 0x9a, 0x6b, 0x3c, 0x35, 0x79, 0xa9, 0x35, 0x6d, 0xdb, 0x55, 0xe5, 0xa3,
+	0xb9, 0xfa, 0x7f, 0xc9, 0x80, 0x08, 0xa7, 0x3d, 0xdc, 0x13, 0xb4, 0xf7, 0xa9, 0x3c, 0x17, 0x7f,
+	0xd6, 0x32, 0x73, 0x2f, 0x91, 0x9e, 0x37, 0x1a, 0x3d, 0xa4, 0xd1, 0xf6, 0x61, 0x65, 0x9c, 0xf8,
+	0x92, 0xb5, 0xec, 0xdc, 0x6b, 0xb1, 0xd3, 0x3c, 0x12, 0xf8, 0x7a, 0x8a, 0x5a, 0xeb, 0x40, 0x55,
+	0x31, 0xe6, 0x41, 0x5d, 0x71, 0x8c, 0xfd, 0xea, 0x09, 0xd6, 0xd3, 0xf4, 0xf5, 0x5f, 0xcd, 0xc0,
+	0xf2, 0xb6, 0xe1, 0x05, 0x3f, 0xa5, 0xd1, 0x92, 0x6a, 0x50, 0x4b, 0x37, 0x1b, 0xaa, 0x06, 0x6e,
+	0x5f, 0x75, 0xb9, 0x75, 0xfd, 0x7f, 0x67, 0xe8, 0x9a, 0xde, 0x00, 0xaf, 0xfc, 0x56, 0x7c, 0xc2,
+	0x2a, 0xb1, 0x97, 0xaf, 0x7b, 0x79, 0xa2, 0xdb, 0x7a, 0x44, 0xf8, 0xd9, 0xf7, 0x1c, 0xed, 0x10,
+	0x04, 0xfd, 0xc0, 0x2b, 0x1e, 0xd4, 0xae, 0x56, 0xcb, 0x2d, 0xcc, 0x64, 0x86, 0x16, 0x0d, 0x1d,
+	0x27, 0x6e, 0xd2, 0xa9, 0x44, 0x76, 0x9e, 0xa6, 0xc1, 0xf5, 0x6f, 0x67, 0xe9, 0x88, 0xbd, 0xa1,
+	0xed, 0xcd, 0xcc, 0xc0, 0xab, 0x8b, 0x08, 0x8a, 0x39, 0x3b, 0x0d, 0x2d, 0x58, 0x4e, 0xbc, 0xa5,
+	0x96, 0x7d, 0xfa, 0xb7, 0x54, 0xa8, 0x7a, 0x92, 0x8e, 0x4f, 0xe9, 0x19, 0xa3, 0xce, 0x13, 0x47,
+	0x7a, 0xed, 0x9d, 0x70, 0xb3, 0x48, 0x80, 0xb4, 0x87, 0xb0, 0xa6, 0xfc, 0xb3, 0x23, 0xcf, 0xc5,
+	0xd2, 0x6f, 0xaf, 0x96, 0x9f, 0x7b, 0xa9, 0x6b, 0xba, 0xe7, 0x69, 0x12, 0x7d, 0x9a, 0x47, 0xc3,
+	0x84, 0xb5, 0x29, 0x9c, 0xf4, 0xd1, 0x9a, 0xc8, 0x85, 0xc1, 0xfb, 0x81, 0x42, 0xe7, 0x65, 0x1d,
+	0xaa, 0x5b, 0x96, 0x6d, 0x5b, 0xce, 0xe0, 0xc8, 0xf5, 0x02, 0x2c, 0x94, 0xc4, 0xb8, 0x7a, 0x73,
+	0x3c, 0xc6, 0xa3, 0x80, 0x52, 0x05, 0xf5, 0xc9, 0x79, 0x39, 0xb2, 0x8d, 0x4b, 0x51, 0xc0, 0x50,
+	0x2d, 0x2b, 0x33, 0xba, 0x00, 0x33, 0xb2, 0xc2, 0xf8, 0x2a, 0x01, 0xd6, 0xff, 0xfc, 0x0a, 0x16,
+	0x61, 0x91, 0x45, 0x0e, 0xbd, 0xa1, 0x27, 0x95, 0x69, 0x95, 0x6b, 0xec, 0xe1, 0x29, 0x9f, 0x00,
+	0xbd, 0xc3, 0xd9, 0x12, 0xcc, 0x67, 0xab, 0xc5, 0xfe, 0x33, 0x19, 0xac, 0x09, 0xa3, 0x53, 0x98,
+	0xb8, 0xd2, 0x17, 0xd9, 0xca, 0xf9, 0xde, 0x53, 0x92, 0xac, 0x5c, 0x74, 0xef, 0x29, 0x36, 0x71,
+	0x1d, 0x1a, 0xe1, 0xb9, 0x0b, 0xde, 0xc0, 0xa3, 0x36, 0xef, 0x06, 0xdb, 0xae, 0xe3, 0xc8, 0x3e,
+	0xee, 0x25, 0x91, 0xc7, 0x1e, 0x81, 0x1a, 0xbf, 0x95, 0x85, 0x0a, 0x1e, 0x15, 0xe5, 0x6b, 0x42,
+	0x3f, 0x84, 0xf2, 0x48, 0xfa, 0xbe, 0x31, 0x50, 0xa6, 0xd7, 0xec, 0x76, 0x16, 0xe1, 0x6e, 0x3e,
+	0x74, 0x3c, 0x69, 0x98, 0xf4, 0x5b, 0x8f, 0xa8, 0x98, 0x83, 0x13, 0x44, 0x89, 0xa5, 0x67, 0xe0,
+	0xe0, 0x44, 0xff, 0xc8, 0xc4, 0x36, 0x7c, 0x46, 0x89, 0x92, 0xc6, 0x49, 0x10, 0x6d, 0xc5, 0x5e,
+	0x28, 0x7d, 0x39, 0x9d, 0x1b, 0xf5, 0x03, 0x58, 0x4e, 0x30, 0x44, 0x1b, 0xc4, 0xb5, 0x4d, 0xe9,
+	0xf3, 0xe5, 0x25, 0xf1, 0x35, 0xf3, 0x29, 0x20, 0x4e, 0x2b, 0x95, 0xdc, 0x4a, 0x4f, 0x55, 0xa6,
+	0x85, 0xcd, 0xc6, 0x3f, 0x2f, 0xc3, 0x32, 0x76, 0xf5, 0x80, 0x47, 0x36, 0xf3, 0x91, 0x12, 0xc5,
+	0xc4, 0xd9, 0x54, 0x31, 0x71, 0xb2, 0x48, 0x3a, 0x97, 0x2e, 0x92, 0x4e, 0x9d, 0xc8, 0xcc, 0x4f,
+	0x9f, 0xc8, 0xbc, 0x03, 0x30, 0x72, 0x4d, 0x72, 0x8b, 0x9b, 0x5c, 0x81, 0x94, 0xd3, 0x13, 0x10,
+	0xe4, 0xeb, 0xab, 0x49, 0x61, 0x1b, 0x24, 0x6c, 0x72, 0xb5, 0xfa, 0xd8, 0xbe, 0xec, 0xb9, 0xaa,
+	0xb7, 0x6d, 0x33, 0xbe, 0x69, 0x2a, 0x0d, 0xd7, 0xb6, 0xa1, 0xa4, 0x3e, 0x56, 0xad, 0x38, 0x77,
+	0x43, 0x49, 0x0c, 0x7a, 0x53, 0xfd, 0x55, 0xd7, 0x34, 0xe8, 0x21, 0x25, 0x66, 0x10, 0x8d, 0x20,
+	0x30, 0xfa, 0xc3, 0x91, 0x72, 0x63, 0x73, 0x73, 0x4a, 0x2e, 0x93, 0x8c, 0x9a, 0x11, 0xb6, 0x9e,
+	0xa4, 0xd4, 0xb6, 0xb0, 0xf2, 0xd0, 0x48, 0x55, 0x7d, 0xbe, 0x78, 0x0d, 0x1b, 0x3d, 0xc4, 0xd5,
+	0x63, 0xb2, 0xe8, 0x3f, 0x2e, 0x40, 0xe2, 0x3f, 0x2e, 0xd0, 0xf1, 0x5b, 0x12, 0x28, 0xf4, 0x08,
+	0xd4, 0x4d, 0x94, 0x49, 0x10, 0xce, 0xf6, 0xd0, 0xf0, 0xd5, 0x1d, 0xce, 0xea, 0x98, 0x50, 0x02,
+	0x42, 0x55, 0x96, 0x97, 0x4e, 0x5f, 0x15, 0x48, 0x95, 0x75, 0xd5, 0xaa, 0x7f, 0x37, 0x03, 0xab,
+	0xe9, 0x69, 0xf9, 0xa3, 0xb8, 0x5b, 0xfc, 0x6b, 0xf1, 0xdd, 0xe2, 0x9f, 0xe1, 0x9e, 0xee, 0xdf,
+	0xc9, 0x00, 0xc4, 0x33, 0x8e, 0x43, 0xe1, 0x3b, 0x90, 0xc3, 0x28, 0x32, 0xb7, 0xb4, 0xbd, 0xd4,
+	0x75, 0x77, 0x6f, 0x2d, 0xf4, 0xf9, 0x12, 0x3f, 0x13, 0x47, 0x50, 0x5f, 0x83, 0xd5, 0x34, 0x9c,
+	0x8e, 0xee, 0xb6, 0xf7, 0x5b, 0x9c, 0xef, 0x6d, 0x1f, 0x34, 0xef, 0xb7, 0xd4, 0x35, 0x1b, 0xed,
+	0xc3, 0x07, 0x22, 0x5b, 0xff, 0xaf, 0x19, 0x2c, 0x3e, 0x0f, 0xbf, 0xe0, 0xc7, 0x49, 0x29, 0xe0,
+	0xcd, 0xf0, 0xcd, 0x45, 0xa4, 0x20, 0xfe, 0xd5, 0x72, 0x02, 0xef, 0x32, 0x21, 0x14, 0x75, 0x17,
+	0x6b, 0x1a, 0x92, 0x0f, 0xe7, 0x28, 0xed, 0xfb, 0x69, 0xa5, 0xfd, 0xc6, 0x42, 0xaf, 0x0c, 0x23,
+	0xfd, 0x78, 0x66, 0x4a, 0xe9, 0xf3, 0xf7, 0xb2, 0xef, 0x66, 0xea, 0x77, 0x61, 0x25, 0xf9, 0x68,
+	0xf6, 0x0a, 0x9e, 0x8d, 0xff, 0x91, 0x83, 0xd5, 0x74, 0xdd, 0x35, 0xdd, 0xdc, 0xc1, 0x35, 0xff,
+	0x1d, 0xdb, 0x4c, 0x9c, 0xda, 0x15, 0x78, 0x14, 0x4a, 0xa5, 0x0e, 0x08, 0xb0, 0x4e, 0x19, 0x65,
+	0x77, 0x24, 0xc5, 0xdd, 0xe4, 0xff, 0x4f, 0x78, 0x1d, 0x37, 0x2c, 0xbe, 0x3c, 0x45, 0x8c, 0xb5,
+	0x8a, 0xba, 0x49, 0xfa, 0x57, 0xb3, 0x5a, 0x35, 0x71, 0x76, 0xf4, 0x3b, 0x18, 0xea, 0x5b, 0xdb,
+	0x9a, 0x38, 0xa6, 0x2d, 0xcd, 0x08, 0xfa, 0xdd, 0x24, 0x34, 0x3a, 0xfc, 0xf9, 0xab, 0xb8, 0x71,
+	0x56, 0xba, 0x93, 0x53, 0x75, 0xa8, 0xea, 0x8f, 0xe7, 0xb5, 0x9b, 0xb0, 0xae, 0xb0, 0xe2, 0xb3,
+	0x52, 0xe2, 0x4f, 0x60, 0xf8, 0x61, 0xb5, 0xc9, 0xf3, 0xa5, 0x3a, 0x2a, 0xfe, 0x24, 0xde, 0x6d,
+	0x42, 0x17, 0x08, 0x89, 0x3f, 0x45, 0x7c, 0xa2, 0x8b, 0x0d, 0xc4, 0xaf, 0xe1, 0x4d, 0x5f, 0xd0,
+	0xed, 0x45, 0x2f, 0xfa, 0x8d, 0xbc, 0xb6, 0x0c, 0xc5, 0x6e, 0x8f, 0xb8, 0x7d, 0x3b, 0xaf, 0x3d,
+	0x0f, 0x22, 0x7e, 0xaa, 0x4e, 0x9f, 0xfd, 0x79, 0xee, 0x4c, 0x74, 0x9c, 0xec, 0x2f, 0xe4, 0x71,
+	0x5c, 0xe1, 0x2c, 0x8b, 0xbf, 0x88, 0xff, 0x66, 0x64, 0x39, 0x51, 0xa7, 0x20, 0x7e, 0x0b, 0xaf,
+	0x53, 0xab, 0x1e, 0xa4, 0x8e, 0x85, 0xfd, 0x3a, 0xbd, 0x79, 0x37, 0xba, 0x9b, 0x41, 0xfc, 0x66,
+	0x5e, 0xbb, 0x05, 0x5a, 0xb2, 0x36, 0x4b, 0x3d, 0xf8, 0x4b, 0x44, 0xcd, 0xfb, 0xb2, 0xaf, 0x60,
+	0x7f, 0x39, 0xaf, 0xbd, 0x00, 0x37, 0x50, 0x12, 0x18, 0x90, 0x38, 0xae, 0xf6, 0x57, 0x68, 0x6a,
+	0xb6, 0xe3, 0xf3, 0x6a, 0x8a, 0xe4, 0x3b, 0xc4, 0x26, 0xfc, 0xac, 0x0c, 0xfb, 0x6e, 0x7e, 0xe3,
+	0xf7, 0xa9, 0xca, 0x26, 0x79, 0x10, 0x03, 0x0d, 0x16, 0xdb, 0x75, 0x06, 0x01, 0xff, 0x07, 0x0b,
+	0x3c, 0x39, 0x37, 0x74, 0xbd, 0x80, 0x9a, 0x64, 0x89, 0x38, 0x74, 0x69, 0x19, 0x1f, 0x22, 0x66,
+	0x27, 0x49, 0xe4, 0xc2, 0x23, 0x71, 0xcb, 0xd1, 0x99, 0xbb, 0x7c, 0x74, 0x2e, 0x90, 0x2e, 0x4f,
+	0x0b, 0xef, 0x9b, 0x12, 0x45, 0x44, 0x9d, 0x78, 0x36, 0x9f, 0x0f, 0x94, 0x18, 0xbc, 0xe5, 0xab,
+	0xea, 0xc7, 0x43, 0xd7, 0x51, 0x07, 0x04, 0x25, 0xdd, 0x5a, 0x0f, 0x89, 0x63, 0x2f, 0x64, 0x1e,
+	0x45, 0x95, 0xdd, 0x42, 0x6e, 0xfc, 0x76, 0x06, 0x56, 0xc2, 0x5b, 0xc0, 0xf0, 0x9f, 0xd7, 0xf1,
+	0x09, 0xc3, 0xf0, 0xff, 0x82, 0xf4, 0x6d, 0x6b, 0x1c, 0xde, 0xb3, 0xbf, 0x06, 0xcb, 0xf8, 0xdf,
+	0x6a, 0x9a, 0x8e, 0xb9, 0xe3, 0xb9, 0x63, 0xee, 0x36, 0xd7, 0xe1, 0xf1, 0xc9, 0xc6, 0x27, 0xf2,
+	0x14, 0xd1, 0xc7, 0x12, 0xaf, 0xbc, 0xc5, 0x23, 0x35, 0x43, 0xc3, 0xb3, 0x9c, 0x01, 0x56, 0x4f,
+	0x38, 0x3e, 0x9f, 0x70, 0x5c, 0x86, 0xd2, 0xc4, 0x97, 0x7d, 0xc3, 0xc7, 0x43, 0x8e, 0xcb, 0x50,
+	0x3a, 0x9d, 0x58, 0x76, 0x60, 0x39, 0xa2, 0x94, 0x3a, 0xc2, 0x58, 0xc6, 0x91, 0x19, 0x63, 0x4b,
+	0x54, 0x36, 0xfe, 0x41, 0x06, 0x96, 0x49, 0x40, 0xe2, 0x4a, 0x93, 0xd8, 0x69, 0xc4, 0x8b, 0x05,
+	0xa2, 0x7b, 0xce, 0xf1, 0x02, 0xbf, 0xc7, 0x5c, 0x69, 0xa2, 0x04, 0x84, 0x6f, 0xe5, 0xe1, 0x2b,
+	0xcf, 0xf1, 0x33, 0x3f, 0x8f, 0xa5, 0x44, 0x81, 0x7c, 0x64, 0x58, 0x41, 0xf2, 0x36, 0x81, 0x02,
+	0xda, 0x9a, 0xfc, 0x28, 0xbc, 0x3e, 0xa0, 0x48, 0xce, 0x27, 0xbe, 0x36, 0x84, 0x94, 0x70, 0xf4,
+	0x04, 0x51, 0xde, 0x68, 0x39, 0x42, 0xc1, 0x3a, 0x35, 0x7c, 0x1b, 0x5d, 0x21, 0xd5, 0xe5, 0x20,
+	0xd5, 0xc8, 0x3d, 0x47, 0x10, 0x6c, 0x1c, 0xc2, 0xcd, 0xf9, 0x85, 0x36, 0x7c, 0xb9, 0x14, 0xfd,
+	0x73, 0x1d, 0x32, 0x82, 0x39, 0x68, 0xc5, 0xc7, 0xf9, 0xc9, 0x1e, 0x67, 0x1b, 0xf8, 0xd0, 0x4d,
+	0xd0, 0x88, 0xdc, 0xc6, 0x3b, 0x78, 0x87, 0x50, 0x94, 0x1f, 0xa6, 0x9b, 0x83, 0x49, 0x86, 0x48,
+	0x0d, 0xdf, 0xc7, 0x3c, 0x23, 0xc7, 0x1d, 0x31, 0xc1, 0xe9, 0x4e, 0xc2, 0x22, 0x34, 0x91, 0xdd,
+	0xe8, 0xa7, 0x8a, 0xaa, 0xe2, 0xd9, 0x0c, 0x7b, 0xbf, 0x94, 0xb8, 0x74, 0x21, 0xc3, 0xe5, 0x3a,
+	0xf4, 0x8f, 0x15, 0xf9, 0x7a, 0x3f, 0x55, 0xcc, 0x64, 0xb2, 0x15, 0x1e, 0x8d, 0x8f, 0x0e, 0xb0,
+	0x6e, 0x1b, 0x4e, 0x5f, 0xda, 0xd2, 0x14, 0x85, 0x8d, 0x77, 0x61, 0x4d, 0xcd, 0x51, 0x5f, 0xfa,
+	0x7e, 0x78, 0x69, 0xc1, 0x11, 0xc7, 0xb8, 0x54, 0xc9, 0x8e, 0xf4, 0x7c, 0xd7, 0xa1, 0xeb, 0x13,
+	0xd1, 0xf4, 0xa7, 0x58, 0x9c, 0xc8, 0x6e, 0xb4, 0xd4, 0xec, 0xaa, 0x7c, 0x7c, 0xea, 0xf6, 0x7c,
+	0x74, 0xa7, 0x14, 0x7a, 0xe0, 0x49, 0x43, 0xdd, 0xbb, 0x84, 0x0b, 0x93, 0xbb, 0xd3, 0x71, 0x64,
+	0xcf, 0xed, 0x38, 0x52, 0xe4, 0x37, 0xb6, 0xa1, 0x42, 0x77, 0x21, 0x3c, 0xb0, 0x1c, 0x13, 0x67,
+	0x64, 0x4b, 0x9d, 0xcb, 0xa5, 0xeb, 0x6e, 0xcf, 0x69, 0x7e, 0xcb, 0xfc, 0x2f, 0x46, 0x44, 0x16,
+	0xcb, 0x66, 0x30, 0x6a, 0x32, 0x32, 0xe8, 0xde, 0x23, 0xfb, 0x92, 0xff, 0x1d, 0x4d, 0x6e, 0xe3,
+	0xeb, 0xa0, 0x71, 0x82, 0xd6, 0x94, 0x17, 0x96, 0x33, 0x88, 0x6e, 0x62, 0x03, 0xba, 0x83, 0xd1,
+	0x94, 0x17, 0xa1, 0x5b, 0x11, 0x36, 0xc2, 0x9b, 0x20, 0x77, 0xdd, 0x09, 0x5e, 0x1d, 0xb9, 0x71,
+	0x0c, 0x37, 0x58, 0x66, 0x71, 0x74, 0x74, 0xa9, 0xce, 0x95, 0x71, 0x0f, 0xbe, 0xc8, 0x22, 0x98,
+	0xf8, 0x11, 0xae, 0xc8, 0x60, 0xc7, 0xa2, 0x84, 0x4b, 0x0c, 0xcf, 0x6e, 0x34, 0xe0, 0xb9, 0x39,
+	0x59, 0x2f, 0xda, 0x2f, 0x38, 0x02, 0x22, 0x96, 0x36, 0x3e, 0x80, 0x75, 0xd6, 0x70, 0x87, 0x7c,
+	0xa9, 0x49, 0x38, 0x9d, 0x8f, 0xda, 0xbb, 0x6d, 0xfe, 0x02, 0xdb, 0xad, 0xfd, 0xfd, 0x87, 0xfb,
+	0x4d, 0x2c, 0x38, 0x42, 0x01, 0xeb, 0xf4, 0x4e, 0xb6, 0x3b, 0x87, 0x87, 0xad, 0xed, 0x5e, 0x6b,
+	0x47, 0x64, 0x37, 0x4c, 0x80, 0xee, 0xa5, 0xd3, 0x57, 0x3d, 0xbe, 0x01, 0x22, 0x6e, 0x75, 0xc9,
+	0x40, 0xe2, 0x8b, 0x8b, 0xd3, 0x50, 0x5e, 0x81, 0x38, 0x96, 0x08, 0xcc, 0xcb, 0x2e, 0x9b, 0xe6,
+	0xf0, 0xf1, 0x44, 0x4e, 0x68, 0x8a, 0x7d, 0xa8, 0x20, 0x94, 0x90, 0x68, 0x5a, 0xc2, 0xc6, 0xe1,
+	0x84, 0xae, 0xc4, 0xbe, 0x0b, 0xb7, 0x23, 0x50, 0xdb, 0xe9, 0xbb, 0xa3, 0xb1, 0x11, 0xe0, 0xbd,
+	0xd6, 0xc7, 0xd2, 0xf3, 0xf9, 0xb2, 0x8f, 0x17, 0xe0, 0xf9, 0x98, 0x88, 0x87, 0xca, 0xaf, 0xcc,
+	0xd1, 0xf4, 0x85, 0x8f, 0x3a, 0xe7, 0x48, 0xf1, 0x2d, 0xfc, 0x07, 0x20, 0x5b, 0x1b, 0xff, 0xf4,
+	0x87, 0x77, 0x32, 0xdf, 0xff, 0xe1, 0x9d, 0xcc, 0xbf, 0xfd, 0xe1, 0x9d, 0xcc, 0xb7, 0x7f, 0x74,
+	0x67, 0xe9, 0xfb, 0x3f, 0xba, 0xb3, 0xf4, 0x83, 0x1f, 0xdd, 0x59, 0xfa, 0x54, 0x4c, 0xff, 0x3b,
+	0xd8, 0xd3, 0x22, 0x39, 0x76, 0x6f, 0xfe, 0x9f, 0x01, 0x00, 0xe2, 0x7f, 0xc6, 0x22, 0x29, 0x76,
+	0x00, 0x00,
 }
 
 func (m *SmartBlockSnapshotBase) Marshal() (dAtA []byte, err error) {
@@ -17019,6 +17033,16 @@ func (m *InvitePayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxUses != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.MaxUses))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x70
+	}
 	if len(m.CreatorIconEncryptionKeys) > 0 {
 		for iNdEx := len(m.CreatorIconEncryptionKeys) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -21253,6 +21277,12 @@ func (m *InvitePayload) Size() (n int) {
 			n += 1 + l + sovModels(uint64(l))
 		}
 	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovModels(uint64(m.ExpiresAt))
+	}
+	if m.MaxUses != 0 {
+		n += 1 + sovModels(uint64(m.MaxUses))
+	}
 	return n
 }
 
@@ -36700,6 +36730,44 @@ func (m *InvitePayload) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUses", wireType)
+			}
+			m.MaxUses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUses |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])